@@ -43,15 +43,16 @@ import (
 	_ "github.com/gogo/protobuf/types"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	v1 "go.temporal.io/api/common/v1"
-	v16 "go.temporal.io/api/enums/v1"
+	v17 "go.temporal.io/api/enums/v1"
 	v19 "go.temporal.io/api/version/v1"
-	v17 "go.temporal.io/api/workflow/v1"
-	v18 "go.temporal.io/server/api/cluster/v1"
-	v13 "go.temporal.io/server/api/enums/v1"
-	v14 "go.temporal.io/server/api/history/v1"
+	v18 "go.temporal.io/api/workflow/v1"
+	v13 "go.temporal.io/server/api/cluster/v1"
+	v14 "go.temporal.io/server/api/enums/v1"
+	v15 "go.temporal.io/server/api/history/v1"
 	v12 "go.temporal.io/server/api/namespace/v1"
 	v11 "go.temporal.io/server/api/persistence/v1"
-	v15 "go.temporal.io/server/api/replication/v1"
+	v16 "go.temporal.io/server/api/replication/v1"
+	v110 "go.temporal.io/server/api/workflow/v1"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -344,6 +345,7 @@ type DescribeHistoryHostResponse struct {
 	ShardIds       []int32                 `protobuf:"varint,2,rep,packed,name=shard_ids,json=shardIds,proto3" json:"shard_ids,omitempty"`
 	NamespaceCache *v12.NamespaceCacheInfo `protobuf:"bytes,3,opt,name=namespace_cache,json=namespaceCache,proto3" json:"namespace_cache,omitempty"`
 	Address        string                  `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	Certificates   []*v13.CertificateInfo  `protobuf:"bytes,6,rep,name=certificates,proto3" json:"certificates,omitempty"`
 }
 
 func (m *DescribeHistoryHostResponse) Reset()      { *m = DescribeHistoryHostResponse{} }
@@ -406,6 +408,13 @@ func (m *DescribeHistoryHostResponse) GetAddress() string {
 	return ""
 }
 
+func (m *DescribeHistoryHostResponse) GetCertificates() []*v13.CertificateInfo {
+	if m != nil {
+		return m.Certificates
+	}
+	return nil
+}
+
 type CloseShardRequest struct {
 	ShardId int32 `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
 }
@@ -572,8 +581,8 @@ func (m *GetShardResponse) GetShardInfo() *v11.ShardInfo {
 
 type ListHistoryTasksRequest struct {
 	ShardId       int32            `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
-	Category      v13.TaskCategory `protobuf:"varint,2,opt,name=category,proto3,enum=temporal.server.api.enums.v1.TaskCategory" json:"category,omitempty"`
-	TaskRange     *v14.TaskRange   `protobuf:"bytes,3,opt,name=task_range,json=taskRange,proto3" json:"task_range,omitempty"`
+	Category      v14.TaskCategory `protobuf:"varint,2,opt,name=category,proto3,enum=temporal.server.api.enums.v1.TaskCategory" json:"category,omitempty"`
+	TaskRange     *v15.TaskRange   `protobuf:"bytes,3,opt,name=task_range,json=taskRange,proto3" json:"task_range,omitempty"`
 	BatchSize     int32            `protobuf:"varint,4,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
 	NextPageToken []byte           `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
@@ -617,14 +626,14 @@ func (m *ListHistoryTasksRequest) GetShardId() int32 {
 	return 0
 }
 
-func (m *ListHistoryTasksRequest) GetCategory() v13.TaskCategory {
+func (m *ListHistoryTasksRequest) GetCategory() v14.TaskCategory {
 	if m != nil {
 		return m.Category
 	}
-	return v13.TASK_CATEGORY_UNSPECIFIED
+	return v14.TASK_CATEGORY_UNSPECIFIED
 }
 
-func (m *ListHistoryTasksRequest) GetTaskRange() *v14.TaskRange {
+func (m *ListHistoryTasksRequest) GetTaskRange() *v15.TaskRange {
 	if m != nil {
 		return m.TaskRange
 	}
@@ -701,7 +710,7 @@ type Task struct {
 	WorkflowId  string       `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
 	RunId       string       `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
 	TaskId      int64        `protobuf:"varint,4,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	TaskType    v13.TaskType `protobuf:"varint,5,opt,name=task_type,json=taskType,proto3,enum=temporal.server.api.enums.v1.TaskType" json:"task_type,omitempty"`
+	TaskType    v14.TaskType `protobuf:"varint,5,opt,name=task_type,json=taskType,proto3,enum=temporal.server.api.enums.v1.TaskType" json:"task_type,omitempty"`
 	FireTime    *time.Time   `protobuf:"bytes,6,opt,name=fire_time,json=fireTime,proto3,stdtime" json:"fire_time,omitempty"`
 	Version     int64        `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
 }
@@ -766,11 +775,11 @@ func (m *Task) GetTaskId() int64 {
 	return 0
 }
 
-func (m *Task) GetTaskType() v13.TaskType {
+func (m *Task) GetTaskType() v14.TaskType {
 	if m != nil {
 		return m.TaskType
 	}
-	return v13.TASK_TYPE_UNSPECIFIED
+	return v14.TASK_TYPE_UNSPECIFIED
 }
 
 func (m *Task) GetFireTime() *time.Time {
@@ -789,7 +798,7 @@ func (m *Task) GetVersion() int64 {
 
 type RemoveTaskRequest struct {
 	ShardId        int32            `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
-	Category       v13.TaskCategory `protobuf:"varint,2,opt,name=category,proto3,enum=temporal.server.api.enums.v1.TaskCategory" json:"category,omitempty"`
+	Category       v14.TaskCategory `protobuf:"varint,2,opt,name=category,proto3,enum=temporal.server.api.enums.v1.TaskCategory" json:"category,omitempty"`
 	TaskId         int64            `protobuf:"varint,3,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
 	VisibilityTime *time.Time       `protobuf:"bytes,4,opt,name=visibility_time,json=visibilityTime,proto3,stdtime" json:"visibility_time,omitempty"`
 }
@@ -833,11 +842,11 @@ func (m *RemoveTaskRequest) GetShardId() int32 {
 	return 0
 }
 
-func (m *RemoveTaskRequest) GetCategory() v13.TaskCategory {
+func (m *RemoveTaskRequest) GetCategory() v14.TaskCategory {
 	if m != nil {
 		return m.Category
 	}
-	return v13.TASK_CATEGORY_UNSPECIFIED
+	return v14.TASK_CATEGORY_UNSPECIFIED
 }
 
 func (m *RemoveTaskRequest) GetTaskId() int64 {
@@ -889,7 +898,6 @@ func (m *RemoveTaskResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RemoveTaskResponse proto.InternalMessageInfo
 
-// *
 // StartEventId defines the beginning of the event to fetch. The first event is exclusive.
 // EndEventId and EndEventVersion defines the end of the event to fetch. The end event is exclusive.
 type GetWorkflowExecutionRawHistoryV2Request struct {
@@ -996,7 +1004,7 @@ func (m *GetWorkflowExecutionRawHistoryV2Request) GetNextPageToken() []byte {
 type GetWorkflowExecutionRawHistoryV2Response struct {
 	NextPageToken  []byte              `protobuf:"bytes,1,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	HistoryBatches []*v1.DataBlob      `protobuf:"bytes,2,rep,name=history_batches,json=historyBatches,proto3" json:"history_batches,omitempty"`
-	VersionHistory *v14.VersionHistory `protobuf:"bytes,3,opt,name=version_history,json=versionHistory,proto3" json:"version_history,omitempty"`
+	VersionHistory *v15.VersionHistory `protobuf:"bytes,3,opt,name=version_history,json=versionHistory,proto3" json:"version_history,omitempty"`
 	HistoryNodeIds []int64             `protobuf:"varint,4,rep,packed,name=history_node_ids,json=historyNodeIds,proto3" json:"history_node_ids,omitempty"`
 }
 
@@ -1048,7 +1056,7 @@ func (m *GetWorkflowExecutionRawHistoryV2Response) GetHistoryBatches() []*v1.Dat
 	return nil
 }
 
-func (m *GetWorkflowExecutionRawHistoryV2Response) GetVersionHistory() *v14.VersionHistory {
+func (m *GetWorkflowExecutionRawHistoryV2Response) GetVersionHistory() *v15.VersionHistory {
 	if m != nil {
 		return m.VersionHistory
 	}
@@ -1063,7 +1071,7 @@ func (m *GetWorkflowExecutionRawHistoryV2Response) GetHistoryNodeIds() []int64 {
 }
 
 type GetReplicationMessagesRequest struct {
-	Tokens      []*v15.ReplicationToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	Tokens      []*v16.ReplicationToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
 	ClusterName string                  `protobuf:"bytes,2,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
 }
 
@@ -1099,7 +1107,7 @@ func (m *GetReplicationMessagesRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GetReplicationMessagesRequest proto.InternalMessageInfo
 
-func (m *GetReplicationMessagesRequest) GetTokens() []*v15.ReplicationToken {
+func (m *GetReplicationMessagesRequest) GetTokens() []*v16.ReplicationToken {
 	if m != nil {
 		return m.Tokens
 	}
@@ -1114,7 +1122,7 @@ func (m *GetReplicationMessagesRequest) GetClusterName() string {
 }
 
 type GetReplicationMessagesResponse struct {
-	ShardMessages map[int32]*v15.ReplicationMessages `protobuf:"bytes,1,rep,name=shard_messages,json=shardMessages,proto3" json:"shard_messages,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ShardMessages map[int32]*v16.ReplicationMessages `protobuf:"bytes,1,rep,name=shard_messages,json=shardMessages,proto3" json:"shard_messages,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *GetReplicationMessagesResponse) Reset()      { *m = GetReplicationMessagesResponse{} }
@@ -1149,7 +1157,7 @@ func (m *GetReplicationMessagesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GetReplicationMessagesResponse proto.InternalMessageInfo
 
-func (m *GetReplicationMessagesResponse) GetShardMessages() map[int32]*v15.ReplicationMessages {
+func (m *GetReplicationMessagesResponse) GetShardMessages() map[int32]*v16.ReplicationMessages {
 	if m != nil {
 		return m.ShardMessages
 	}
@@ -1222,7 +1230,7 @@ func (m *GetNamespaceReplicationMessagesRequest) GetClusterName() string {
 }
 
 type GetNamespaceReplicationMessagesResponse struct {
-	Messages *v15.ReplicationMessages `protobuf:"bytes,1,opt,name=messages,proto3" json:"messages,omitempty"`
+	Messages *v16.ReplicationMessages `protobuf:"bytes,1,opt,name=messages,proto3" json:"messages,omitempty"`
 }
 
 func (m *GetNamespaceReplicationMessagesResponse) Reset() {
@@ -1259,7 +1267,7 @@ func (m *GetNamespaceReplicationMessagesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GetNamespaceReplicationMessagesResponse proto.InternalMessageInfo
 
-func (m *GetNamespaceReplicationMessagesResponse) GetMessages() *v15.ReplicationMessages {
+func (m *GetNamespaceReplicationMessagesResponse) GetMessages() *v16.ReplicationMessages {
 	if m != nil {
 		return m.Messages
 	}
@@ -1267,7 +1275,7 @@ func (m *GetNamespaceReplicationMessagesResponse) GetMessages() *v15.Replication
 }
 
 type GetDLQReplicationMessagesRequest struct {
-	TaskInfos []*v15.ReplicationTaskInfo `protobuf:"bytes,1,rep,name=task_infos,json=taskInfos,proto3" json:"task_infos,omitempty"`
+	TaskInfos []*v16.ReplicationTaskInfo `protobuf:"bytes,1,rep,name=task_infos,json=taskInfos,proto3" json:"task_infos,omitempty"`
 }
 
 func (m *GetDLQReplicationMessagesRequest) Reset()      { *m = GetDLQReplicationMessagesRequest{} }
@@ -1302,7 +1310,7 @@ func (m *GetDLQReplicationMessagesRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GetDLQReplicationMessagesRequest proto.InternalMessageInfo
 
-func (m *GetDLQReplicationMessagesRequest) GetTaskInfos() []*v15.ReplicationTaskInfo {
+func (m *GetDLQReplicationMessagesRequest) GetTaskInfos() []*v16.ReplicationTaskInfo {
 	if m != nil {
 		return m.TaskInfos
 	}
@@ -1310,7 +1318,7 @@ func (m *GetDLQReplicationMessagesRequest) GetTaskInfos() []*v15.ReplicationTask
 }
 
 type GetDLQReplicationMessagesResponse struct {
-	ReplicationTasks []*v15.ReplicationTask `protobuf:"bytes,1,rep,name=replication_tasks,json=replicationTasks,proto3" json:"replication_tasks,omitempty"`
+	ReplicationTasks []*v16.ReplicationTask `protobuf:"bytes,1,rep,name=replication_tasks,json=replicationTasks,proto3" json:"replication_tasks,omitempty"`
 }
 
 func (m *GetDLQReplicationMessagesResponse) Reset()      { *m = GetDLQReplicationMessagesResponse{} }
@@ -1345,7 +1353,7 @@ func (m *GetDLQReplicationMessagesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GetDLQReplicationMessagesResponse proto.InternalMessageInfo
 
-func (m *GetDLQReplicationMessagesResponse) GetReplicationTasks() []*v15.ReplicationTask {
+func (m *GetDLQReplicationMessagesResponse) GetReplicationTasks() []*v16.ReplicationTask {
 	if m != nil {
 		return m.ReplicationTasks
 	}
@@ -1448,7 +1456,7 @@ func (m *ReapplyEventsResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_ReapplyEventsResponse proto.InternalMessageInfo
 
 type AddSearchAttributesRequest struct {
-	SearchAttributes map[string]v16.IndexedValueType `protobuf:"bytes,1,rep,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=temporal.api.enums.v1.IndexedValueType"`
+	SearchAttributes map[string]v17.IndexedValueType `protobuf:"bytes,1,rep,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=temporal.api.enums.v1.IndexedValueType"`
 	IndexName        string                          `protobuf:"bytes,2,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
 	SkipSchemaUpdate bool                            `protobuf:"varint,3,opt,name=skip_schema_update,json=skipSchemaUpdate,proto3" json:"skip_schema_update,omitempty"`
 	Namespace        string                          `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
@@ -1486,7 +1494,7 @@ func (m *AddSearchAttributesRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AddSearchAttributesRequest proto.InternalMessageInfo
 
-func (m *AddSearchAttributesRequest) GetSearchAttributes() map[string]v16.IndexedValueType {
+func (m *AddSearchAttributesRequest) GetSearchAttributes() map[string]v17.IndexedValueType {
 	if m != nil {
 		return m.SearchAttributes
 	}
@@ -1695,11 +1703,11 @@ func (m *GetSearchAttributesRequest) GetNamespace() string {
 }
 
 type GetSearchAttributesResponse struct {
-	CustomAttributes map[string]v16.IndexedValueType `protobuf:"bytes,1,rep,name=custom_attributes,json=customAttributes,proto3" json:"custom_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=temporal.api.enums.v1.IndexedValueType"`
-	SystemAttributes map[string]v16.IndexedValueType `protobuf:"bytes,2,rep,name=system_attributes,json=systemAttributes,proto3" json:"system_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=temporal.api.enums.v1.IndexedValueType"`
+	CustomAttributes map[string]v17.IndexedValueType `protobuf:"bytes,1,rep,name=custom_attributes,json=customAttributes,proto3" json:"custom_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=temporal.api.enums.v1.IndexedValueType"`
+	SystemAttributes map[string]v17.IndexedValueType `protobuf:"bytes,2,rep,name=system_attributes,json=systemAttributes,proto3" json:"system_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=temporal.api.enums.v1.IndexedValueType"`
 	Mapping          map[string]string               `protobuf:"bytes,3,rep,name=mapping,proto3" json:"mapping,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// State of the workflow that adds search attributes to the system.
-	AddWorkflowExecutionInfo *v17.WorkflowExecutionInfo `protobuf:"bytes,4,opt,name=add_workflow_execution_info,json=addWorkflowExecutionInfo,proto3" json:"add_workflow_execution_info,omitempty"`
+	AddWorkflowExecutionInfo *v18.WorkflowExecutionInfo `protobuf:"bytes,4,opt,name=add_workflow_execution_info,json=addWorkflowExecutionInfo,proto3" json:"add_workflow_execution_info,omitempty"`
 }
 
 func (m *GetSearchAttributesResponse) Reset()      { *m = GetSearchAttributesResponse{} }
@@ -1734,14 +1742,14 @@ func (m *GetSearchAttributesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GetSearchAttributesResponse proto.InternalMessageInfo
 
-func (m *GetSearchAttributesResponse) GetCustomAttributes() map[string]v16.IndexedValueType {
+func (m *GetSearchAttributesResponse) GetCustomAttributes() map[string]v17.IndexedValueType {
 	if m != nil {
 		return m.CustomAttributes
 	}
 	return nil
 }
 
-func (m *GetSearchAttributesResponse) GetSystemAttributes() map[string]v16.IndexedValueType {
+func (m *GetSearchAttributesResponse) GetSystemAttributes() map[string]v17.IndexedValueType {
 	if m != nil {
 		return m.SystemAttributes
 	}
@@ -1755,7 +1763,7 @@ func (m *GetSearchAttributesResponse) GetMapping() map[string]string {
 	return nil
 }
 
-func (m *GetSearchAttributesResponse) GetAddWorkflowExecutionInfo() *v17.WorkflowExecutionInfo {
+func (m *GetSearchAttributesResponse) GetAddWorkflowExecutionInfo() *v18.WorkflowExecutionInfo {
 	if m != nil {
 		return m.AddWorkflowExecutionInfo
 	}
@@ -1808,7 +1816,7 @@ func (m *DescribeClusterRequest) GetClusterName() string {
 type DescribeClusterResponse struct {
 	SupportedClients         map[string]string   `protobuf:"bytes,1,rep,name=supported_clients,json=supportedClients,proto3" json:"supported_clients,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	ServerVersion            string              `protobuf:"bytes,2,opt,name=server_version,json=serverVersion,proto3" json:"server_version,omitempty"`
-	MembershipInfo           *v18.MembershipInfo `protobuf:"bytes,3,opt,name=membership_info,json=membershipInfo,proto3" json:"membership_info,omitempty"`
+	MembershipInfo           *v13.MembershipInfo `protobuf:"bytes,3,opt,name=membership_info,json=membershipInfo,proto3" json:"membership_info,omitempty"`
 	ClusterId                string              `protobuf:"bytes,4,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
 	ClusterName              string              `protobuf:"bytes,5,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
 	HistoryShardCount        int32               `protobuf:"varint,6,opt,name=history_shard_count,json=historyShardCount,proto3" json:"history_shard_count,omitempty"`
@@ -1818,6 +1826,10 @@ type DescribeClusterResponse struct {
 	FailoverVersionIncrement int64               `protobuf:"varint,10,opt,name=failover_version_increment,json=failoverVersionIncrement,proto3" json:"failover_version_increment,omitempty"`
 	InitialFailoverVersion   int64               `protobuf:"varint,11,opt,name=initial_failover_version,json=initialFailoverVersion,proto3" json:"initial_failover_version,omitempty"`
 	IsGlobalNamespaceEnabled bool                `protobuf:"varint,12,opt,name=is_global_namespace_enabled,json=isGlobalNamespaceEnabled,proto3" json:"is_global_namespace_enabled,omitempty"`
+	// TLS certificates of the frontend host that served the request
+	Certificates []*v13.CertificateInfo `protobuf:"bytes,13,rep,name=certificates,proto3" json:"certificates,omitempty"`
+	// Persistence faults that have not expired yet.
+	PersistenceFaults []*v11.PersistenceFault `protobuf:"bytes,14,rep,name=persistence_faults,json=persistenceFaults,proto3" json:"persistence_faults,omitempty"`
 }
 
 func (m *DescribeClusterResponse) Reset()      { *m = DescribeClusterResponse{} }
@@ -1866,7 +1878,7 @@ func (m *DescribeClusterResponse) GetServerVersion() string {
 	return ""
 }
 
-func (m *DescribeClusterResponse) GetMembershipInfo() *v18.MembershipInfo {
+func (m *DescribeClusterResponse) GetMembershipInfo() *v13.MembershipInfo {
 	if m != nil {
 		return m.MembershipInfo
 	}
@@ -1936,6 +1948,20 @@ func (m *DescribeClusterResponse) GetIsGlobalNamespaceEnabled() bool {
 	return false
 }
 
+func (m *DescribeClusterResponse) GetCertificates() []*v13.CertificateInfo {
+	if m != nil {
+		return m.Certificates
+	}
+	return nil
+}
+
+func (m *DescribeClusterResponse) GetPersistenceFaults() []*v11.PersistenceFault {
+	if m != nil {
+		return m.PersistenceFaults
+	}
+	return nil
+}
+
 type ListClustersRequest struct {
 	PageSize      int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	NextPageToken []byte `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
@@ -2208,7 +2234,7 @@ type ListClusterMembersRequest struct {
 	LastHeartbeatWithin *time.Duration        `protobuf:"bytes,1,opt,name=last_heartbeat_within,json=lastHeartbeatWithin,proto3,stdduration" json:"last_heartbeat_within,omitempty"`
 	RpcAddress          string                `protobuf:"bytes,2,opt,name=rpc_address,json=rpcAddress,proto3" json:"rpc_address,omitempty"`
 	HostId              string                `protobuf:"bytes,3,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
-	Role                v13.ClusterMemberRole `protobuf:"varint,4,opt,name=role,proto3,enum=temporal.server.api.enums.v1.ClusterMemberRole" json:"role,omitempty"`
+	Role                v14.ClusterMemberRole `protobuf:"varint,4,opt,name=role,proto3,enum=temporal.server.api.enums.v1.ClusterMemberRole" json:"role,omitempty"`
 	// (-- api-linter: core::0140::prepositions=disabled
 	//     aip.dev/not-precedent: "after" is used to indicate a time range. --)
 	SessionStartedAfterTime *time.Time `protobuf:"bytes,5,opt,name=session_started_after_time,json=sessionStartedAfterTime,proto3,stdtime" json:"session_started_after_time,omitempty"`
@@ -2269,11 +2295,11 @@ func (m *ListClusterMembersRequest) GetHostId() string {
 	return ""
 }
 
-func (m *ListClusterMembersRequest) GetRole() v13.ClusterMemberRole {
+func (m *ListClusterMembersRequest) GetRole() v14.ClusterMemberRole {
 	if m != nil {
 		return m.Role
 	}
-	return v13.CLUSTER_MEMBER_ROLE_UNSPECIFIED
+	return v14.CLUSTER_MEMBER_ROLE_UNSPECIFIED
 }
 
 func (m *ListClusterMembersRequest) GetSessionStartedAfterTime() *time.Time {
@@ -2298,7 +2324,7 @@ func (m *ListClusterMembersRequest) GetNextPageToken() []byte {
 }
 
 type ListClusterMembersResponse struct {
-	ActiveMembers []*v18.ClusterMember `protobuf:"bytes,1,rep,name=active_members,json=activeMembers,proto3" json:"active_members,omitempty"`
+	ActiveMembers []*v13.ClusterMember `protobuf:"bytes,1,rep,name=active_members,json=activeMembers,proto3" json:"active_members,omitempty"`
 	NextPageToken []byte               `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
@@ -2334,7 +2360,7 @@ func (m *ListClusterMembersResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ListClusterMembersResponse proto.InternalMessageInfo
 
-func (m *ListClusterMembersResponse) GetActiveMembers() []*v18.ClusterMember {
+func (m *ListClusterMembersResponse) GetActiveMembers() []*v13.ClusterMember {
 	if m != nil {
 		return m.ActiveMembers
 	}
@@ -2349,7 +2375,7 @@ func (m *ListClusterMembersResponse) GetNextPageToken() []byte {
 }
 
 type GetDLQMessagesRequest struct {
-	Type                  v13.DeadLetterQueueType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
+	Type                  v14.DeadLetterQueueType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
 	ShardId               int32                   `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
 	SourceCluster         string                  `protobuf:"bytes,3,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
 	InclusiveEndMessageId int64                   `protobuf:"varint,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
@@ -2389,11 +2415,11 @@ func (m *GetDLQMessagesRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GetDLQMessagesRequest proto.InternalMessageInfo
 
-func (m *GetDLQMessagesRequest) GetType() v13.DeadLetterQueueType {
+func (m *GetDLQMessagesRequest) GetType() v14.DeadLetterQueueType {
 	if m != nil {
 		return m.Type
 	}
-	return v13.DEAD_LETTER_QUEUE_TYPE_UNSPECIFIED
+	return v14.DEAD_LETTER_QUEUE_TYPE_UNSPECIFIED
 }
 
 func (m *GetDLQMessagesRequest) GetShardId() int32 {
@@ -2432,8 +2458,8 @@ func (m *GetDLQMessagesRequest) GetNextPageToken() []byte {
 }
 
 type GetDLQMessagesResponse struct {
-	Type             v13.DeadLetterQueueType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
-	ReplicationTasks []*v15.ReplicationTask  `protobuf:"bytes,2,rep,name=replication_tasks,json=replicationTasks,proto3" json:"replication_tasks,omitempty"`
+	Type             v14.DeadLetterQueueType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
+	ReplicationTasks []*v16.ReplicationTask  `protobuf:"bytes,2,rep,name=replication_tasks,json=replicationTasks,proto3" json:"replication_tasks,omitempty"`
 	NextPageToken    []byte                  `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
@@ -2469,14 +2495,14 @@ func (m *GetDLQMessagesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GetDLQMessagesResponse proto.InternalMessageInfo
 
-func (m *GetDLQMessagesResponse) GetType() v13.DeadLetterQueueType {
+func (m *GetDLQMessagesResponse) GetType() v14.DeadLetterQueueType {
 	if m != nil {
 		return m.Type
 	}
-	return v13.DEAD_LETTER_QUEUE_TYPE_UNSPECIFIED
+	return v14.DEAD_LETTER_QUEUE_TYPE_UNSPECIFIED
 }
 
-func (m *GetDLQMessagesResponse) GetReplicationTasks() []*v15.ReplicationTask {
+func (m *GetDLQMessagesResponse) GetReplicationTasks() []*v16.ReplicationTask {
 	if m != nil {
 		return m.ReplicationTasks
 	}
@@ -2491,7 +2517,7 @@ func (m *GetDLQMessagesResponse) GetNextPageToken() []byte {
 }
 
 type PurgeDLQMessagesRequest struct {
-	Type                  v13.DeadLetterQueueType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
+	Type                  v14.DeadLetterQueueType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
 	ShardId               int32                   `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
 	SourceCluster         string                  `protobuf:"bytes,3,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
 	InclusiveEndMessageId int64                   `protobuf:"varint,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
@@ -2529,11 +2555,11 @@ func (m *PurgeDLQMessagesRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PurgeDLQMessagesRequest proto.InternalMessageInfo
 
-func (m *PurgeDLQMessagesRequest) GetType() v13.DeadLetterQueueType {
+func (m *PurgeDLQMessagesRequest) GetType() v14.DeadLetterQueueType {
 	if m != nil {
 		return m.Type
 	}
-	return v13.DEAD_LETTER_QUEUE_TYPE_UNSPECIFIED
+	return v14.DEAD_LETTER_QUEUE_TYPE_UNSPECIFIED
 }
 
 func (m *PurgeDLQMessagesRequest) GetShardId() int32 {
@@ -2593,7 +2619,7 @@ func (m *PurgeDLQMessagesResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_PurgeDLQMessagesResponse proto.InternalMessageInfo
 
 type MergeDLQMessagesRequest struct {
-	Type                  v13.DeadLetterQueueType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
+	Type                  v14.DeadLetterQueueType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
 	ShardId               int32                   `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
 	SourceCluster         string                  `protobuf:"bytes,3,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
 	InclusiveEndMessageId int64                   `protobuf:"varint,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
@@ -2633,11 +2659,11 @@ func (m *MergeDLQMessagesRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MergeDLQMessagesRequest proto.InternalMessageInfo
 
-func (m *MergeDLQMessagesRequest) GetType() v13.DeadLetterQueueType {
+func (m *MergeDLQMessagesRequest) GetType() v14.DeadLetterQueueType {
 	if m != nil {
 		return m.Type
 	}
-	return v13.DEAD_LETTER_QUEUE_TYPE_UNSPECIFIED
+	return v14.DEAD_LETTER_QUEUE_TYPE_UNSPECIFIED
 }
 
 func (m *MergeDLQMessagesRequest) GetShardId() int32 {
@@ -2941,7 +2967,7 @@ var xxx_messageInfo_ResendReplicationTasksResponse proto.InternalMessageInfo
 type GetTaskQueueTasksRequest struct {
 	Namespace     string            `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	TaskQueue     string            `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
-	TaskQueueType v16.TaskQueueType `protobuf:"varint,3,opt,name=task_queue_type,json=taskQueueType,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_queue_type,omitempty"`
+	TaskQueueType v17.TaskQueueType `protobuf:"varint,3,opt,name=task_queue_type,json=taskQueueType,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_queue_type,omitempty"`
 	MinTaskId     int64             `protobuf:"varint,4,opt,name=min_task_id,json=minTaskId,proto3" json:"min_task_id,omitempty"`
 	MaxTaskId     int64             `protobuf:"varint,5,opt,name=max_task_id,json=maxTaskId,proto3" json:"max_task_id,omitempty"`
 	BatchSize     int32             `protobuf:"varint,6,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
@@ -2994,11 +3020,11 @@ func (m *GetTaskQueueTasksRequest) GetTaskQueue() string {
 	return ""
 }
 
-func (m *GetTaskQueueTasksRequest) GetTaskQueueType() v16.TaskQueueType {
+func (m *GetTaskQueueTasksRequest) GetTaskQueueType() v17.TaskQueueType {
 	if m != nil {
 		return m.TaskQueueType
 	}
-	return v16.TASK_QUEUE_TYPE_UNSPECIFIED
+	return v17.TASK_QUEUE_TYPE_UNSPECIFIED
 }
 
 func (m *GetTaskQueueTasksRequest) GetMinTaskId() int64 {
@@ -3174,1124 +3200,2245 @@ func (m *DeleteWorkflowExecutionResponse) GetWarnings() []string {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*RebuildMutableStateRequest)(nil), "temporal.server.api.adminservice.v1.RebuildMutableStateRequest")
-	proto.RegisterType((*RebuildMutableStateResponse)(nil), "temporal.server.api.adminservice.v1.RebuildMutableStateResponse")
-	proto.RegisterType((*DescribeMutableStateRequest)(nil), "temporal.server.api.adminservice.v1.DescribeMutableStateRequest")
-	proto.RegisterType((*DescribeMutableStateResponse)(nil), "temporal.server.api.adminservice.v1.DescribeMutableStateResponse")
-	proto.RegisterType((*DescribeHistoryHostRequest)(nil), "temporal.server.api.adminservice.v1.DescribeHistoryHostRequest")
-	proto.RegisterType((*DescribeHistoryHostResponse)(nil), "temporal.server.api.adminservice.v1.DescribeHistoryHostResponse")
-	proto.RegisterType((*CloseShardRequest)(nil), "temporal.server.api.adminservice.v1.CloseShardRequest")
-	proto.RegisterType((*CloseShardResponse)(nil), "temporal.server.api.adminservice.v1.CloseShardResponse")
-	proto.RegisterType((*GetShardRequest)(nil), "temporal.server.api.adminservice.v1.GetShardRequest")
-	proto.RegisterType((*GetShardResponse)(nil), "temporal.server.api.adminservice.v1.GetShardResponse")
-	proto.RegisterType((*ListHistoryTasksRequest)(nil), "temporal.server.api.adminservice.v1.ListHistoryTasksRequest")
-	proto.RegisterType((*ListHistoryTasksResponse)(nil), "temporal.server.api.adminservice.v1.ListHistoryTasksResponse")
-	proto.RegisterType((*Task)(nil), "temporal.server.api.adminservice.v1.Task")
-	proto.RegisterType((*RemoveTaskRequest)(nil), "temporal.server.api.adminservice.v1.RemoveTaskRequest")
-	proto.RegisterType((*RemoveTaskResponse)(nil), "temporal.server.api.adminservice.v1.RemoveTaskResponse")
-	proto.RegisterType((*GetWorkflowExecutionRawHistoryV2Request)(nil), "temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request")
-	proto.RegisterType((*GetWorkflowExecutionRawHistoryV2Response)(nil), "temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response")
-	proto.RegisterType((*GetReplicationMessagesRequest)(nil), "temporal.server.api.adminservice.v1.GetReplicationMessagesRequest")
-	proto.RegisterType((*GetReplicationMessagesResponse)(nil), "temporal.server.api.adminservice.v1.GetReplicationMessagesResponse")
-	proto.RegisterMapType((map[int32]*v15.ReplicationMessages)(nil), "temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry")
-	proto.RegisterType((*GetNamespaceReplicationMessagesRequest)(nil), "temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesRequest")
-	proto.RegisterType((*GetNamespaceReplicationMessagesResponse)(nil), "temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse")
-	proto.RegisterType((*GetDLQReplicationMessagesRequest)(nil), "temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest")
-	proto.RegisterType((*GetDLQReplicationMessagesResponse)(nil), "temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse")
-	proto.RegisterType((*ReapplyEventsRequest)(nil), "temporal.server.api.adminservice.v1.ReapplyEventsRequest")
-	proto.RegisterType((*ReapplyEventsResponse)(nil), "temporal.server.api.adminservice.v1.ReapplyEventsResponse")
-	proto.RegisterType((*AddSearchAttributesRequest)(nil), "temporal.server.api.adminservice.v1.AddSearchAttributesRequest")
-	proto.RegisterMapType((map[string]v16.IndexedValueType)(nil), "temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry")
-	proto.RegisterType((*AddSearchAttributesResponse)(nil), "temporal.server.api.adminservice.v1.AddSearchAttributesResponse")
-	proto.RegisterType((*RemoveSearchAttributesRequest)(nil), "temporal.server.api.adminservice.v1.RemoveSearchAttributesRequest")
-	proto.RegisterType((*RemoveSearchAttributesResponse)(nil), "temporal.server.api.adminservice.v1.RemoveSearchAttributesResponse")
-	proto.RegisterType((*GetSearchAttributesRequest)(nil), "temporal.server.api.adminservice.v1.GetSearchAttributesRequest")
-	proto.RegisterType((*GetSearchAttributesResponse)(nil), "temporal.server.api.adminservice.v1.GetSearchAttributesResponse")
-	proto.RegisterMapType((map[string]v16.IndexedValueType)(nil), "temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry")
-	proto.RegisterMapType((map[string]string)(nil), "temporal.server.api.adminservice.v1.GetSearchAttributesResponse.MappingEntry")
-	proto.RegisterMapType((map[string]v16.IndexedValueType)(nil), "temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry")
-	proto.RegisterType((*DescribeClusterRequest)(nil), "temporal.server.api.adminservice.v1.DescribeClusterRequest")
-	proto.RegisterType((*DescribeClusterResponse)(nil), "temporal.server.api.adminservice.v1.DescribeClusterResponse")
-	proto.RegisterMapType((map[string]string)(nil), "temporal.server.api.adminservice.v1.DescribeClusterResponse.SupportedClientsEntry")
-	proto.RegisterType((*ListClustersRequest)(nil), "temporal.server.api.adminservice.v1.ListClustersRequest")
-	proto.RegisterType((*ListClustersResponse)(nil), "temporal.server.api.adminservice.v1.ListClustersResponse")
-	proto.RegisterType((*AddOrUpdateRemoteClusterRequest)(nil), "temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterRequest")
-	proto.RegisterType((*AddOrUpdateRemoteClusterResponse)(nil), "temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterResponse")
-	proto.RegisterType((*RemoveRemoteClusterRequest)(nil), "temporal.server.api.adminservice.v1.RemoveRemoteClusterRequest")
-	proto.RegisterType((*RemoveRemoteClusterResponse)(nil), "temporal.server.api.adminservice.v1.RemoveRemoteClusterResponse")
-	proto.RegisterType((*ListClusterMembersRequest)(nil), "temporal.server.api.adminservice.v1.ListClusterMembersRequest")
-	proto.RegisterType((*ListClusterMembersResponse)(nil), "temporal.server.api.adminservice.v1.ListClusterMembersResponse")
-	proto.RegisterType((*GetDLQMessagesRequest)(nil), "temporal.server.api.adminservice.v1.GetDLQMessagesRequest")
-	proto.RegisterType((*GetDLQMessagesResponse)(nil), "temporal.server.api.adminservice.v1.GetDLQMessagesResponse")
-	proto.RegisterType((*PurgeDLQMessagesRequest)(nil), "temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest")
-	proto.RegisterType((*PurgeDLQMessagesResponse)(nil), "temporal.server.api.adminservice.v1.PurgeDLQMessagesResponse")
-	proto.RegisterType((*MergeDLQMessagesRequest)(nil), "temporal.server.api.adminservice.v1.MergeDLQMessagesRequest")
-	proto.RegisterType((*MergeDLQMessagesResponse)(nil), "temporal.server.api.adminservice.v1.MergeDLQMessagesResponse")
-	proto.RegisterType((*RefreshWorkflowTasksRequest)(nil), "temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest")
-	proto.RegisterType((*RefreshWorkflowTasksResponse)(nil), "temporal.server.api.adminservice.v1.RefreshWorkflowTasksResponse")
-	proto.RegisterType((*ResendReplicationTasksRequest)(nil), "temporal.server.api.adminservice.v1.ResendReplicationTasksRequest")
-	proto.RegisterType((*ResendReplicationTasksResponse)(nil), "temporal.server.api.adminservice.v1.ResendReplicationTasksResponse")
-	proto.RegisterType((*GetTaskQueueTasksRequest)(nil), "temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest")
-	proto.RegisterType((*GetTaskQueueTasksResponse)(nil), "temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse")
-	proto.RegisterType((*DeleteWorkflowExecutionRequest)(nil), "temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest")
-	proto.RegisterType((*DeleteWorkflowExecutionResponse)(nil), "temporal.server.api.adminservice.v1.DeleteWorkflowExecutionResponse")
+type ResetWorkflowExecutionsRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Visibility query that selects the executions to resolve. Only allowed for dry runs, since
+	// resetting executions changes what the query matches while paging through it.
+	Query              string                   `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	ResetPointSelector *v110.ResetPointSelector `protobuf:"bytes,3,opt,name=reset_point_selector,json=resetPointSelector,proto3" json:"reset_point_selector,omitempty"`
+	DryRun             bool                     `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Reason             string                   `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Identity           string                   `protobuf:"bytes,6,opt,name=identity,proto3" json:"identity,omitempty"`
+	ResetReapplyType   v17.ResetReapplyType     `protobuf:"varint,7,opt,name=reset_reapply_type,json=resetReapplyType,proto3,enum=temporal.api.enums.v1.ResetReapplyType" json:"reset_reapply_type,omitempty"`
+	MaximumPageSize    int32                    `protobuf:"varint,8,opt,name=maximum_page_size,json=maximumPageSize,proto3" json:"maximum_page_size,omitempty"`
+	NextPageToken      []byte                   `protobuf:"bytes,9,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Executions to resolve or reset, e.g. the results of a dry run. Required unless dry_run is
+	// set. A run id pins the run that is resolved and reset.
+	Executions []*v1.WorkflowExecution `protobuf:"bytes,10,rep,name=executions,proto3" json:"executions,omitempty"`
+}
+
+func (m *ResetWorkflowExecutionsRequest) Reset()      { *m = ResetWorkflowExecutionsRequest{} }
+func (*ResetWorkflowExecutionsRequest) ProtoMessage() {}
+func (*ResetWorkflowExecutionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{55}
+}
+func (m *ResetWorkflowExecutionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func init() {
-	proto.RegisterFile("temporal/server/api/adminservice/v1/request_response.proto", fileDescriptor_cc07c1a2abe7cb51)
+func (m *ResetWorkflowExecutionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResetWorkflowExecutionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResetWorkflowExecutionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetWorkflowExecutionsRequest.Merge(m, src)
+}
+func (m *ResetWorkflowExecutionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResetWorkflowExecutionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetWorkflowExecutionsRequest.DiscardUnknown(m)
 }
 
-var fileDescriptor_cc07c1a2abe7cb51 = []byte{
-	// 3001 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3a, 0x4d, 0x6c, 0x1b, 0xc7,
-	0xd5, 0x5e, 0xfe, 0x89, 0x7c, 0x92, 0x28, 0x69, 0x6d, 0x59, 0x34, 0x15, 0x51, 0x0a, 0xe3, 0x38,
-	0xb6, 0xbf, 0x84, 0xfa, 0xac, 0xb4, 0x8d, 0x93, 0xd4, 0x08, 0x64, 0xd9, 0x91, 0x95, 0x4a, 0xf9,
-	0x59, 0x39, 0x76, 0x1b, 0x20, 0xd8, 0x0c, 0x77, 0x47, 0xd4, 0xc2, 0xcb, 0xdd, 0xcd, 0xce, 0x90,
-	0xb6, 0x02, 0xf4, 0x07, 0x4d, 0x8b, 0xa2, 0x87, 0xa2, 0x06, 0x8a, 0x02, 0x41, 0x4e, 0x3d, 0xb6,
-	0x40, 0x8b, 0xde, 0x7a, 0xef, 0xad, 0xc7, 0xa0, 0xbd, 0x04, 0x2d, 0xd0, 0x34, 0xf2, 0xa5, 0xbd,
-	0xe5, 0xdc, 0x53, 0x31, 0x7f, 0xcb, 0x5d, 0x72, 0x49, 0x51, 0xb5, 0x9d, 0x02, 0xb9, 0x71, 0xdf,
-	0xbc, 0xf7, 0xe6, 0xcd, 0xfb, 0x9b, 0xf7, 0xde, 0x10, 0x5e, 0xa2, 0xb8, 0x1d, 0xf8, 0x21, 0x72,
-	0x57, 0x09, 0x0e, 0xbb, 0x38, 0x5c, 0x45, 0x81, 0xb3, 0x8a, 0xec, 0xb6, 0xe3, 0xb1, 0x6f, 0xc7,
-	0xc2, 0xab, 0xdd, 0x4b, 0xab, 0x21, 0x7e, 0xbf, 0x83, 0x09, 0x35, 0x43, 0x4c, 0x02, 0xdf, 0x23,
-	0xb8, 0x11, 0x84, 0x3e, 0xf5, 0xf5, 0xa7, 0x14, 0x6d, 0x43, 0xd0, 0x36, 0x50, 0xe0, 0x34, 0xe2,
-	0xb4, 0x8d, 0xee, 0xa5, 0xea, 0x72, 0xcb, 0xf7, 0x5b, 0x2e, 0x5e, 0xe5, 0x24, 0xcd, 0xce, 0xde,
-	0x2a, 0x75, 0xda, 0x98, 0x50, 0xd4, 0x0e, 0x04, 0x97, 0x6a, 0xad, 0x1f, 0xc1, 0xee, 0x84, 0x88,
-	0x3a, 0xbe, 0x27, 0xd7, 0x9f, 0xb4, 0x71, 0x80, 0x3d, 0x1b, 0x7b, 0x96, 0x83, 0xc9, 0x6a, 0xcb,
-	0x6f, 0xf9, 0x1c, 0xce, 0x7f, 0x49, 0x94, 0x7a, 0x74, 0x08, 0x26, 0x3d, 0xf6, 0x3a, 0x6d, 0xc2,
-	0xc4, 0xb6, 0xfc, 0x76, 0x3b, 0x62, 0x73, 0x2e, 0x1d, 0x87, 0x22, 0x72, 0xc7, 0x7c, 0xbf, 0x83,
-	0x3b, 0xf2, 0x50, 0xd5, 0xb3, 0x09, 0x3c, 0xc1, 0x82, 0x21, 0xb6, 0x31, 0x21, 0xa8, 0xa5, 0xb0,
-	0x9e, 0x4e, 0x60, 0x75, 0x71, 0x48, 0x9c, 0x34, 0xb4, 0xe4, 0xa6, 0x77, 0xfd, 0xf0, 0xce, 0x9e,
-	0xeb, 0xdf, 0x1d, 0xc4, 0x7b, 0x36, 0xcd, 0x0a, 0x96, 0xdb, 0x21, 0x14, 0x87, 0x83, 0xd8, 0x17,
-	0xd2, 0xb0, 0xd3, 0x4f, 0x7d, 0x71, 0x34, 0xaa, 0xd8, 0x41, 0xe2, 0x3e, 0x33, 0x12, 0x97, 0x29,
-	0x6a, 0x94, 0xb4, 0xfb, 0x0e, 0xa1, 0x7e, 0x78, 0x30, 0x28, 0x6d, 0x23, 0x0d, 0xdb, 0x43, 0x6d,
-	0x4c, 0x02, 0x24, 0xdc, 0x2b, 0x89, 0xff, 0xff, 0x69, 0xf8, 0x21, 0x0e, 0x5c, 0xc7, 0xe2, 0x6e,
-	0x31, 0x48, 0xf1, 0x62, 0x1a, 0x45, 0xc0, 0x6c, 0x42, 0x28, 0xf6, 0xc4, 0x1e, 0xf2, 0xa8, 0x66,
-	0x1b, 0x53, 0x64, 0x23, 0x8a, 0x24, 0xe9, 0xf3, 0x63, 0x90, 0xe2, 0x7b, 0xd8, 0xea, 0xb0, 0x9d,
-	0x89, 0x24, 0x7a, 0x65, 0x0c, 0x22, 0x65, 0x6b, 0xb3, 0xdd, 0xa1, 0xa8, 0xe9, 0x62, 0x93, 0x50,
-	0x44, 0x47, 0xaa, 0xa4, 0x8f, 0x01, 0xd3, 0xb7, 0xdc, 0xb0, 0xfe, 0xa1, 0x06, 0x55, 0x03, 0x37,
-	0x3b, 0x8e, 0x6b, 0xef, 0x08, 0x76, 0xbb, 0x8c, 0x9b, 0x21, 0xc2, 0x52, 0x7f, 0x02, 0x4a, 0x91,
-	0x3e, 0x2b, 0xda, 0x8a, 0x76, 0xbe, 0x64, 0xf4, 0x00, 0xfa, 0x26, 0x94, 0xa2, 0x13, 0x54, 0x32,
-	0x2b, 0xda, 0xf9, 0xc9, 0xb5, 0x0b, 0x91, 0x00, 0x3c, 0x64, 0xa5, 0xc7, 0x74, 0x2f, 0x35, 0x6e,
-	0x4b, 0xa9, 0xaf, 0x2b, 0x02, 0xa3, 0x47, 0x5b, 0x5f, 0x82, 0xc5, 0x54, 0x21, 0x44, 0x4e, 0xa8,
-	0xff, 0x48, 0x83, 0xc5, 0x6b, 0x98, 0x58, 0xa1, 0xd3, 0xc4, 0xff, 0x43, 0x29, 0xff, 0x90, 0x81,
-	0x27, 0xd2, 0xc5, 0x10, 0x72, 0xea, 0x67, 0xa0, 0x48, 0xf6, 0x51, 0x68, 0x9b, 0x8e, 0x2d, 0xc5,
-	0x98, 0xe0, 0xdf, 0x5b, 0xb6, 0xfe, 0x24, 0x4c, 0x49, 0x37, 0x36, 0x91, 0x6d, 0x87, 0x5c, 0x8e,
-	0x92, 0x31, 0x29, 0x61, 0xeb, 0xb6, 0x1d, 0xea, 0xfb, 0x70, 0xd2, 0x42, 0xd6, 0x3e, 0x4e, 0xda,
-	0xb5, 0x92, 0xe5, 0x12, 0x5f, 0x6e, 0xa4, 0x65, 0xc4, 0x98, 0x61, 0xe3, 0xd2, 0x27, 0x84, 0x9b,
-	0xe3, 0x4c, 0xe3, 0x20, 0xdd, 0x83, 0xd3, 0xcc, 0x51, 0x9b, 0x88, 0xf4, 0x6f, 0x96, 0x7b, 0xc8,
-	0xcd, 0x4e, 0x29, 0xbe, 0x71, 0x68, 0xfd, 0xcf, 0x1a, 0x54, 0x95, 0xe2, 0x6e, 0x88, 0x13, 0xdf,
-	0xf0, 0x09, 0x55, 0xe6, 0x63, 0xba, 0xf1, 0x09, 0xe5, 0x8a, 0xc1, 0x84, 0x48, 0xd5, 0x4d, 0x32,
-	0xd8, 0xba, 0x00, 0x25, 0x34, 0xcb, 0x54, 0x97, 0xef, 0x69, 0x36, 0x61, 0xfc, 0x6c, 0xbf, 0xf1,
-	0xbf, 0x0d, 0x7a, 0x14, 0x2f, 0x3d, 0x2f, 0xc8, 0x1d, 0xd7, 0x0b, 0xe6, 0xee, 0xf6, 0x83, 0xea,
-	0x7f, 0x8f, 0x39, 0x65, 0xe2, 0x50, 0xd2, 0x19, 0x9e, 0x82, 0x69, 0x2e, 0x22, 0x31, 0xbd, 0x4e,
-	0xbb, 0x89, 0x43, 0x7e, 0xac, 0xbc, 0x31, 0x25, 0x80, 0xaf, 0x73, 0x98, 0xbe, 0x08, 0x25, 0x75,
-	0x2e, 0x52, 0xc9, 0xac, 0x64, 0xcf, 0xe7, 0x8d, 0xa2, 0x3c, 0x18, 0xd1, 0xdf, 0x85, 0x99, 0xe8,
-	0x20, 0x26, 0xb7, 0xa2, 0x74, 0x86, 0xaf, 0xa5, 0xda, 0x27, 0xc2, 0x65, 0x47, 0x78, 0x5d, 0x7d,
-	0x6c, 0x30, 0xba, 0x2d, 0x6f, 0xcf, 0x37, 0xca, 0x5e, 0x02, 0xa6, 0x57, 0x60, 0x42, 0x69, 0x3c,
-	0x2f, 0x9c, 0x55, 0x7e, 0xbe, 0x96, 0x2b, 0xe6, 0x66, 0xf3, 0xf5, 0x06, 0xcc, 0x6d, 0xb8, 0x3e,
-	0xc1, 0xbb, 0x4c, 0x1e, 0x65, 0xab, 0x7e, 0x17, 0xef, 0x19, 0xa2, 0x7e, 0x0a, 0xf4, 0x38, 0xbe,
-	0x8c, 0xdd, 0x67, 0x61, 0x66, 0x13, 0xd3, 0x71, 0x79, 0xbc, 0x07, 0xb3, 0x3d, 0x6c, 0xa9, 0xc8,
-	0x6d, 0x00, 0x89, 0xee, 0xed, 0xf9, 0x9c, 0x60, 0x72, 0xed, 0xb9, 0x71, 0x3c, 0x94, 0xb3, 0xe1,
-	0x47, 0x17, 0x4a, 0x66, 0x3f, 0xeb, 0x3f, 0xcb, 0xc0, 0xc2, 0xb6, 0x43, 0xa8, 0x34, 0xd9, 0x4d,
-	0x96, 0x0b, 0x8f, 0x16, 0x4c, 0x7f, 0x15, 0x8a, 0x16, 0xa2, 0xb8, 0xe5, 0x87, 0x07, 0xdc, 0x01,
-	0xcb, 0x6b, 0x17, 0x53, 0x45, 0xe0, 0x97, 0x1a, 0xdb, 0x9c, 0x31, 0xde, 0x90, 0x14, 0x46, 0x44,
-	0xab, 0xdf, 0x00, 0xe0, 0x75, 0x41, 0x88, 0xbc, 0x96, 0x32, 0xe7, 0x85, 0x54, 0x4e, 0x32, 0x35,
-	0x28, 0x5e, 0x06, 0x23, 0x30, 0x4a, 0x54, 0xfd, 0xd4, 0x97, 0x00, 0x9a, 0x88, 0x5a, 0xfb, 0x26,
-	0x71, 0x3e, 0x10, 0x81, 0x9b, 0x37, 0x4a, 0x1c, 0xb2, 0xeb, 0x7c, 0x80, 0xf5, 0x73, 0x30, 0xe3,
-	0xe1, 0x7b, 0xd4, 0x0c, 0x50, 0x0b, 0x9b, 0xd4, 0xbf, 0x83, 0x3d, 0x6e, 0xe5, 0x29, 0x63, 0x9a,
-	0x81, 0xdf, 0x44, 0x2d, 0x7c, 0x93, 0x01, 0xd9, 0x05, 0x50, 0x19, 0xd4, 0x87, 0x54, 0xfd, 0x2b,
-	0x90, 0xe7, 0x97, 0x45, 0x45, 0x5b, 0xc9, 0x0e, 0x15, 0xb4, 0xaf, 0x2c, 0x13, 0xd2, 0x0a, 0xba,
-	0x34, 0x29, 0x32, 0x69, 0x52, 0x7c, 0x94, 0x81, 0x1c, 0xa3, 0x63, 0xb9, 0xa0, 0xe7, 0xf3, 0x51,
-	0x1a, 0x9d, 0x8c, 0x60, 0x5b, 0xb6, 0xbe, 0x0c, 0x93, 0x51, 0x48, 0xcb, 0x74, 0x50, 0x32, 0x40,
-	0x81, 0xb6, 0x6c, 0x7d, 0x1e, 0x0a, 0x61, 0xc7, 0x63, 0x6b, 0x22, 0x1d, 0xe4, 0xc3, 0x8e, 0xb7,
-	0x65, 0xeb, 0x0b, 0x30, 0xc1, 0x55, 0xef, 0xd8, 0x5c, 0x5b, 0x59, 0xa3, 0xc0, 0x3e, 0xb7, 0x6c,
-	0x7d, 0x03, 0xb8, 0x5a, 0x4d, 0x7a, 0x10, 0x60, 0xae, 0xa4, 0xf2, 0xda, 0xb9, 0xa3, 0x8d, 0x7b,
-	0xf3, 0x20, 0xc0, 0x46, 0x91, 0xca, 0x5f, 0xfa, 0x15, 0x28, 0xed, 0x39, 0x21, 0x36, 0x59, 0x0d,
-	0x5a, 0x29, 0x70, 0xbb, 0x56, 0x1b, 0xa2, 0xfe, 0x6c, 0xa8, 0xfa, 0xb3, 0x71, 0x53, 0x15, 0xa8,
-	0x57, 0x73, 0xf7, 0x3f, 0x5b, 0xd6, 0x8c, 0x22, 0x23, 0x61, 0x40, 0x16, 0x8c, 0xb2, 0xd4, 0xab,
-	0x4c, 0x70, 0xe1, 0xd4, 0x67, 0xfd, 0xaf, 0x1a, 0xcc, 0x19, 0xb8, 0xed, 0x77, 0x31, 0x57, 0xec,
-	0x97, 0xe7, 0xaa, 0x31, 0x7d, 0x65, 0x13, 0xfa, 0xda, 0x82, 0x99, 0xae, 0x43, 0x9c, 0xa6, 0xe3,
-	0x3a, 0xf4, 0x40, 0x1c, 0x38, 0x37, 0xe6, 0x81, 0xcb, 0x3d, 0x42, 0xb6, 0xc4, 0x72, 0x46, 0xfc,
-	0x6c, 0x32, 0x67, 0xfc, 0x22, 0x0b, 0xcf, 0x6c, 0x62, 0x3a, 0x98, 0x86, 0xd1, 0x5d, 0xe9, 0xa6,
-	0xb7, 0xd6, 0x62, 0x97, 0x47, 0xc2, 0x61, 0x4a, 0x83, 0x0e, 0xf3, 0xa8, 0x0a, 0x00, 0xfd, 0x2c,
-	0x94, 0x09, 0x45, 0x21, 0x35, 0x71, 0x17, 0x7b, 0xb4, 0xa7, 0x98, 0x29, 0x0e, 0xbd, 0xce, 0x80,
-	0x5b, 0xb6, 0xde, 0x80, 0x93, 0x71, 0x2c, 0x65, 0x56, 0xe1, 0x73, 0x73, 0x3d, 0xd4, 0x5b, 0x62,
-	0x41, 0x5f, 0x81, 0x29, 0xec, 0xd9, 0x3d, 0x9e, 0x79, 0x8e, 0x08, 0xd8, 0xb3, 0x15, 0xc7, 0x8b,
-	0x30, 0xd7, 0xc3, 0x50, 0xfc, 0x0a, 0x1c, 0x6d, 0x46, 0xa1, 0x29, 0x6e, 0x17, 0x61, 0xae, 0x8d,
-	0xee, 0x39, 0xed, 0x4e, 0x5b, 0x04, 0x1d, 0xcf, 0x0e, 0x13, 0xdc, 0x43, 0x66, 0xe4, 0x02, 0x0b,
-	0xbb, 0x61, 0x39, 0xa2, 0x98, 0x12, 0x9d, 0xaf, 0xe5, 0x8a, 0xda, 0x6c, 0xa6, 0xfe, 0xab, 0x0c,
-	0x9c, 0x3f, 0xda, 0x2a, 0x32, 0x73, 0xa4, 0xb0, 0xd6, 0x52, 0x58, 0x33, 0x5f, 0x52, 0x75, 0x11,
-	0xcf, 0x5d, 0x58, 0x5c, 0x83, 0x93, 0x6b, 0x2b, 0xc3, 0x2c, 0x74, 0x0d, 0x51, 0x74, 0xd5, 0xf5,
-	0x9b, 0x46, 0x59, 0x12, 0x5e, 0x15, 0x74, 0xfa, 0x6d, 0x98, 0x91, 0xba, 0x31, 0xe5, 0x8a, 0xcc,
-	0xaf, 0x8d, 0xa3, 0xf2, 0xab, 0xd4, 0x9d, 0x3c, 0x85, 0x51, 0xee, 0x26, 0xbe, 0xf5, 0xf3, 0x30,
-	0xab, 0x64, 0xf4, 0x7c, 0x1b, 0xf3, 0xbb, 0x3a, 0xb7, 0x92, 0x3d, 0x9f, 0x8d, 0x44, 0x78, 0xdd,
-	0xb7, 0xf1, 0x96, 0x4d, 0xea, 0xf7, 0x35, 0x58, 0xda, 0xc4, 0xd4, 0xe8, 0xb5, 0x14, 0x3b, 0xa2,
-	0x9d, 0x88, 0xae, 0x98, 0x6d, 0x28, 0x70, 0x6d, 0xa8, 0x94, 0x9a, 0x7e, 0x95, 0xc7, 0x7a, 0x12,
-	0x26, 0x5f, 0x8c, 0x1f, 0xd7, 0x9a, 0x21, 0x79, 0x30, 0xe7, 0x57, 0xdd, 0x07, 0x73, 0x78, 0x55,
-	0x55, 0x4a, 0x18, 0xab, 0x01, 0xea, 0x1f, 0x67, 0xa0, 0x36, 0x4c, 0x24, 0x69, 0xab, 0xef, 0x42,
-	0x59, 0xe4, 0x12, 0xd9, 0xfb, 0x28, 0xd9, 0x6e, 0x8d, 0x95, 0xee, 0x47, 0x33, 0x17, 0x97, 0xb0,
-	0x82, 0x5e, 0xf7, 0x68, 0x78, 0x60, 0x88, 0xba, 0x48, 0xc1, 0xaa, 0x07, 0xa0, 0x0f, 0x22, 0xe9,
-	0xb3, 0x90, 0xbd, 0x83, 0x0f, 0x64, 0x6e, 0x63, 0x3f, 0xf5, 0x1d, 0xc8, 0x77, 0x91, 0xdb, 0xc1,
-	0x32, 0x84, 0x5f, 0x38, 0xa6, 0xe6, 0x22, 0xc9, 0x04, 0x97, 0x97, 0x32, 0x97, 0xb5, 0xfa, 0x1f,
-	0x35, 0x38, 0xb7, 0x89, 0x69, 0x54, 0x2c, 0x8d, 0x30, 0xdc, 0x8b, 0x70, 0xc6, 0x45, 0x7c, 0x50,
-	0x41, 0x43, 0x07, 0x77, 0x71, 0xa4, 0x2d, 0x95, 0x81, 0xb3, 0xc6, 0x69, 0x86, 0x60, 0xa8, 0x75,
-	0xc9, 0x60, 0xcb, 0x8e, 0x48, 0x83, 0xd0, 0xb7, 0x30, 0x21, 0x49, 0xd2, 0x4c, 0x8f, 0xf4, 0x4d,
-	0xb5, 0xde, 0x23, 0xed, 0x37, 0x70, 0x76, 0xd0, 0xc0, 0xdf, 0xe3, 0xb9, 0x72, 0xf4, 0x11, 0xa4,
-	0xa1, 0x77, 0xa1, 0x18, 0x33, 0xf1, 0x43, 0x29, 0x31, 0x62, 0x54, 0xff, 0x00, 0x56, 0x36, 0x31,
-	0xbd, 0xb6, 0xfd, 0xd6, 0x08, 0xe5, 0xdd, 0x92, 0x55, 0x0f, 0xab, 0xe0, 0x94, 0x77, 0x1d, 0x77,
-	0x6b, 0x76, 0x43, 0x88, 0x62, 0x8e, 0xca, 0x5f, 0xa4, 0xfe, 0x63, 0x0d, 0x9e, 0x1c, 0xb1, 0xb9,
-	0x3c, 0xf6, 0x7b, 0x30, 0x17, 0x63, 0x6b, 0xc6, 0x2b, 0x9a, 0xe7, 0xff, 0x0b, 0x21, 0x8c, 0xd9,
-	0x30, 0x09, 0x20, 0xf5, 0xbf, 0x68, 0x70, 0xca, 0xc0, 0x28, 0x08, 0xdc, 0x03, 0x9e, 0x8c, 0xc9,
-	0xb0, 0xdb, 0x29, 0x37, 0x78, 0x3b, 0xa5, 0x77, 0x28, 0x99, 0x87, 0xef, 0x50, 0xf4, 0xcb, 0x50,
-	0xe0, 0x57, 0x06, 0x91, 0x79, 0xf0, 0xe8, 0x94, 0x2a, 0xf1, 0x65, 0xc2, 0x5f, 0x80, 0xf9, 0xbe,
-	0x43, 0xc9, 0xfb, 0xf9, 0xdf, 0x19, 0xa8, 0xae, 0xdb, 0xf6, 0x2e, 0x46, 0xa1, 0xb5, 0xbf, 0x4e,
-	0x69, 0xe8, 0x34, 0x3b, 0xb4, 0x67, 0xed, 0x1f, 0x6a, 0x30, 0x47, 0xf8, 0x9a, 0x89, 0xa2, 0x45,
-	0xa9, 0xf0, 0xb7, 0xc7, 0xca, 0x29, 0xc3, 0x99, 0x37, 0xfa, 0xe1, 0x22, 0xa5, 0xcc, 0x92, 0x3e,
-	0x30, 0x2b, 0x8f, 0x1d, 0xcf, 0xc6, 0xf7, 0xe2, 0x89, 0xb1, 0xc4, 0x21, 0x2c, 0x54, 0xf4, 0x67,
-	0x41, 0x27, 0x77, 0x9c, 0xc0, 0x24, 0xd6, 0x3e, 0x6e, 0x23, 0xb3, 0x13, 0xd8, 0xaa, 0xd7, 0x2e,
-	0x1a, 0xb3, 0x6c, 0x65, 0x97, 0x2f, 0xbc, 0xcd, 0xe1, 0xc9, 0x1e, 0x33, 0xd7, 0xd7, 0x63, 0x56,
-	0x5d, 0x98, 0x4f, 0x95, 0x2a, 0x9e, 0xc3, 0x4a, 0x22, 0x87, 0x5d, 0x89, 0xe7, 0xb0, 0xf2, 0xda,
-	0x33, 0x49, 0x8b, 0x44, 0x15, 0xd9, 0x16, 0x93, 0x13, 0xdb, 0xb7, 0x18, 0x2a, 0xaf, 0x33, 0x63,
-	0x39, 0x6b, 0x09, 0x16, 0x53, 0xd5, 0x23, 0x6d, 0xf3, 0x53, 0x0d, 0x96, 0x44, 0x49, 0x35, 0xcc,
-	0x3c, 0xff, 0x37, 0xcc, 0x3a, 0xa5, 0xe3, 0xab, 0x71, 0x64, 0xf3, 0x5d, 0x5f, 0x81, 0xda, 0x30,
-	0x51, 0xa4, 0xb4, 0xdf, 0x81, 0x2a, 0xeb, 0xf7, 0x86, 0x48, 0x9a, 0xdc, 0x5c, 0x1b, 0xb9, 0x79,
-	0xa6, 0x7f, 0xf3, 0x8f, 0x0b, 0xb0, 0x98, 0xca, 0x5b, 0x66, 0x85, 0x0f, 0x35, 0x98, 0xb3, 0x3a,
-	0x84, 0xfa, 0xed, 0x41, 0x2f, 0x1d, 0xfb, 0xe6, 0x1b, 0xc6, 0xbd, 0xb1, 0xc1, 0x39, 0x0f, 0xb8,
-	0xa9, 0xd5, 0x07, 0xe6, 0x52, 0x90, 0x03, 0x42, 0x71, 0x42, 0x8a, 0xcc, 0x23, 0x92, 0x62, 0x97,
-	0x73, 0x1e, 0x0c, 0x96, 0x3e, 0xb0, 0xde, 0x82, 0x89, 0x36, 0x0a, 0x02, 0xc7, 0x6b, 0x55, 0xb2,
-	0x7c, 0xeb, 0x9d, 0x87, 0xde, 0x7a, 0x47, 0xf0, 0x13, 0x3b, 0x2a, 0xee, 0xba, 0x07, 0x8b, 0xc8,
-	0xb6, 0xcd, 0xc1, 0x84, 0x27, 0x9a, 0x7b, 0xd1, 0x46, 0xac, 0x26, 0xa3, 0x42, 0x21, 0xa7, 0xe6,
-	0x3d, 0x7e, 0x23, 0x54, 0x90, 0x6d, 0xa7, 0xae, 0xb0, 0xd0, 0x4c, 0xb5, 0xc4, 0x63, 0x09, 0x4d,
-	0x9e, 0x08, 0xd2, 0x34, 0xfe, 0x78, 0x76, 0x7b, 0x09, 0xa6, 0xe2, 0x4a, 0x4e, 0xd9, 0xe4, 0x54,
-	0x7c, 0x93, 0x52, 0x3c, 0x89, 0xbc, 0x0c, 0xa7, 0xd5, 0xec, 0x6a, 0x43, 0xd4, 0x12, 0xb1, 0x1b,
-	0x2b, 0x51, 0x71, 0x68, 0x83, 0x15, 0xc7, 0x6f, 0x0a, 0xb0, 0x30, 0x40, 0x2d, 0xa3, 0xea, 0xfb,
-	0x30, 0x47, 0x3a, 0x41, 0xe0, 0x87, 0x14, 0xdb, 0xa6, 0xe5, 0x3a, 0xfc, 0xfa, 0x11, 0x41, 0x65,
-	0x8c, 0xe5, 0x53, 0x43, 0x18, 0x37, 0x76, 0x15, 0xd7, 0x0d, 0xc1, 0x54, 0xb9, 0x72, 0x1f, 0x58,
-	0x7f, 0x1a, 0xca, 0x82, 0x7b, 0xd4, 0x28, 0x89, 0xc3, 0x4f, 0x0b, 0xa8, 0x6a, 0x93, 0x6e, 0xc3,
-	0x4c, 0x1b, 0xb7, 0x9b, 0x38, 0x24, 0xfb, 0x4e, 0x20, 0x9c, 0x6f, 0x54, 0xb3, 0xa0, 0x9e, 0x33,
-	0xba, 0x97, 0x1a, 0x3b, 0x11, 0x99, 0x98, 0xaa, 0xb5, 0x13, 0xdf, 0x2c, 0x67, 0x29, 0xfd, 0x45,
-	0xf7, 0x7d, 0x49, 0x42, 0x52, 0x0a, 0xba, 0xfc, 0x80, 0x7a, 0x59, 0xff, 0xa8, 0xda, 0x0d, 0x51,
-	0x96, 0x5b, 0x7e, 0xc7, 0xa3, 0xbc, 0xdf, 0xcb, 0x1b, 0x73, 0x72, 0x89, 0x57, 0xcc, 0x1b, 0x6c,
-	0x81, 0xe5, 0xf3, 0xd8, 0xe0, 0xcb, 0x64, 0xcb, 0xa2, 0xe3, 0x2b, 0x19, 0xb3, 0xb1, 0x85, 0x5d,
-	0x06, 0xd7, 0x2f, 0xc0, 0x6c, 0xac, 0x77, 0x17, 0xb8, 0x45, 0x8e, 0x1b, 0xeb, 0xe9, 0x05, 0xea,
-	0x26, 0x4c, 0xa9, 0x7e, 0x8a, 0xeb, 0xa7, 0xc4, 0xf5, 0x73, 0x36, 0xe9, 0xa9, 0x12, 0x23, 0xd6,
-	0x45, 0x71, 0xad, 0x4c, 0x76, 0x7b, 0x1f, 0xfa, 0x37, 0xa1, 0xba, 0x87, 0x1c, 0xd7, 0x8f, 0x19,
-	0xc5, 0x74, 0x3c, 0x2b, 0xc4, 0x6d, 0xec, 0xd1, 0x0a, 0xf0, 0x02, 0xb8, 0xa2, 0x30, 0x22, 0x2e,
-	0x72, 0x5d, 0xbf, 0x0c, 0x15, 0xc7, 0x73, 0xa8, 0x83, 0x5c, 0xb3, 0x9f, 0x4b, 0x65, 0x52, 0x14,
-	0xcf, 0x72, 0xfd, 0xd5, 0x24, 0x0b, 0xfd, 0x0a, 0x2c, 0x3a, 0xc4, 0x6c, 0xb9, 0x7e, 0x13, 0xb9,
-	0x66, 0xaf, 0x0c, 0xc3, 0x1e, 0x6a, 0xba, 0xd8, 0xae, 0x4c, 0xf1, 0xcb, 0xbe, 0xe2, 0x90, 0x4d,
-	0x8e, 0x11, 0x55, 0xd0, 0xd7, 0xc5, 0x7a, 0x75, 0x03, 0xe6, 0x53, 0x9d, 0xee, 0x58, 0x81, 0xf6,
-	0x0e, 0x9c, 0xdc, 0x76, 0x08, 0x95, 0xde, 0x1c, 0xdd, 0x6c, 0x8b, 0x50, 0xea, 0x75, 0xe7, 0xa2,
-	0xc7, 0x29, 0x06, 0x23, 0xda, 0xf2, 0xd4, 0xa1, 0xd9, 0xcf, 0x35, 0x38, 0x95, 0x64, 0x2e, 0x83,
-	0xf0, 0x0d, 0x28, 0x4a, 0x87, 0x1a, 0x5d, 0xe7, 0xf6, 0xcd, 0x4b, 0x25, 0x9f, 0x1d, 0xf9, 0x8e,
-	0x65, 0x44, 0x4c, 0xc6, 0x96, 0xe8, 0x97, 0x1a, 0x2c, 0xaf, 0xdb, 0xf6, 0x1b, 0xa1, 0xa8, 0x9b,
-	0xd8, 0xe5, 0x4f, 0xfb, 0x13, 0xcc, 0x05, 0x98, 0xdd, 0x0b, 0x7d, 0x8f, 0x62, 0xcf, 0xee, 0x9b,
-	0xf8, 0xcf, 0x28, 0xb8, 0x9a, 0xfa, 0x6f, 0xc2, 0x8a, 0x30, 0x96, 0x19, 0x72, 0x4e, 0xa6, 0x0a,
-	0x1d, 0xcb, 0xf7, 0x3c, 0x6c, 0x45, 0x85, 0x72, 0xd1, 0x58, 0x12, 0x78, 0x89, 0x0d, 0x37, 0x22,
-	0xa4, 0x7a, 0x1d, 0x56, 0x86, 0x8b, 0x25, 0x4b, 0x91, 0x57, 0xa0, 0x2a, 0x8a, 0x95, 0x54, 0xa9,
-	0xc7, 0x48, 0x8b, 0xfc, 0x11, 0x2b, 0x85, 0x41, 0x6f, 0xa8, 0x75, 0x26, 0x66, 0x2d, 0x99, 0x46,
-	0x14, 0xff, 0x5d, 0x98, 0xe7, 0x3d, 0xe2, 0x3e, 0x46, 0x21, 0x6d, 0x62, 0x44, 0xcd, 0xbb, 0x0e,
-	0xdd, 0x77, 0x3c, 0xd9, 0xa7, 0x9d, 0x19, 0x98, 0xac, 0x5d, 0x93, 0x4f, 0xd9, 0x57, 0x73, 0x1f,
-	0x7d, 0xb6, 0xac, 0x19, 0x27, 0x19, 0xf5, 0x0d, 0x45, 0x7c, 0x9b, 0xd3, 0xea, 0xcb, 0x30, 0x19,
-	0x06, 0x56, 0xa4, 0x65, 0x39, 0x29, 0x0d, 0x03, 0x4b, 0x29, 0x78, 0x01, 0x26, 0xf8, 0xcb, 0x4b,
-	0x34, 0x2a, 0x2d, 0xb0, 0x4f, 0x3e, 0x12, 0xcd, 0x85, 0xbe, 0x2b, 0x6a, 0xdd, 0x72, 0xfc, 0x42,
-	0x4e, 0x9b, 0x1f, 0x26, 0x4e, 0x64, 0xf8, 0x2e, 0x36, 0x38, 0xb1, 0xfe, 0x2e, 0x54, 0x09, 0x26,
-	0x3c, 0xdc, 0xf9, 0xd4, 0x0b, 0xdb, 0x26, 0xda, 0x63, 0x1a, 0xe4, 0x23, 0xc3, 0xfc, 0x98, 0x23,
-	0xc3, 0x05, 0xc9, 0x63, 0x57, 0xb0, 0x58, 0x67, 0x1c, 0xf8, 0xc8, 0x34, 0x11, 0x43, 0x85, 0xa3,
-	0x63, 0x68, 0x22, 0xcd, 0x63, 0x3f, 0xd6, 0xa0, 0x9a, 0x66, 0x15, 0x19, 0x49, 0x37, 0xa1, 0x8c,
-	0x2c, 0xea, 0x74, 0xb1, 0x29, 0xd3, 0xbc, 0x8c, 0xa7, 0xe7, 0x8e, 0xba, 0x25, 0x92, 0x3a, 0x99,
-	0x16, 0x4c, 0x24, 0xf7, 0xb1, 0xc3, 0xe9, 0x77, 0x19, 0x98, 0x17, 0xed, 0x6d, 0x7f, 0x43, 0x7d,
-	0x1d, 0x72, 0x7c, 0x5a, 0xad, 0x71, 0xfb, 0x5c, 0x1a, 0x6d, 0x9f, 0x6b, 0x18, 0xd9, 0xdb, 0x98,
-	0x52, 0x1c, 0xbe, 0xd5, 0xc1, 0xb2, 0x8e, 0xe0, 0xe4, 0xa3, 0x9e, 0xd5, 0xd8, 0x3d, 0xea, 0x77,
-	0x42, 0x2b, 0x0a, 0x3a, 0xe9, 0x21, 0xd3, 0x02, 0x2a, 0xcf, 0xa7, 0xbf, 0xc0, 0xb2, 0x33, 0xc3,
-	0x60, 0x3a, 0x62, 0x21, 0x1d, 0x1b, 0x6d, 0x88, 0x89, 0xe7, 0x7c, 0xb4, 0x7e, 0xdd, 0x8b, 0x4d,
-	0x36, 0x52, 0xe7, 0x94, 0xf9, 0xb1, 0xe7, 0x94, 0x85, 0x34, 0x7d, 0xfd, 0x4b, 0x83, 0xd3, 0xfd,
-	0xfa, 0x92, 0x86, 0x7c, 0x44, 0x0a, 0x4b, 0x1d, 0x25, 0x64, 0x1e, 0xe1, 0x28, 0x21, 0xed, 0xac,
-	0xd9, 0xb4, 0xb3, 0xfe, 0x4d, 0x83, 0x85, 0x37, 0x3b, 0x61, 0x0b, 0x7f, 0x15, 0xbd, 0xa3, 0x5e,
-	0x85, 0xca, 0xe0, 0xe1, 0x64, 0x22, 0xfd, 0x7d, 0x06, 0x16, 0x76, 0xf0, 0x57, 0xf4, 0xe4, 0x8f,
-	0x25, 0x2e, 0xae, 0x42, 0x65, 0x50, 0x61, 0xc7, 0x1b, 0xd4, 0xb3, 0x62, 0x63, 0xd1, 0xc0, 0x7b,
-	0x21, 0x26, 0xfb, 0xaa, 0xd5, 0x4a, 0xbc, 0x9d, 0xf6, 0x4f, 0xba, 0xb2, 0x8f, 0xef, 0x1d, 0x46,
-	0x8e, 0xa7, 0x6a, 0xf0, 0x44, 0xba, 0x40, 0x3d, 0x3f, 0x59, 0x32, 0x30, 0xc1, 0x9e, 0xdd, 0x17,
-	0x75, 0x43, 0x65, 0x7e, 0x84, 0x8f, 0x8d, 0x4f, 0x43, 0x39, 0x59, 0xb3, 0xc8, 0x56, 0x60, 0x3a,
-	0x8c, 0x17, 0x07, 0x29, 0x2f, 0x4a, 0xf9, 0x94, 0x17, 0xa5, 0xa7, 0x60, 0x5a, 0x60, 0x25, 0xdf,
-	0x7e, 0x04, 0xd2, 0xb0, 0x67, 0xa4, 0x89, 0x81, 0x67, 0xa4, 0x65, 0x98, 0x64, 0x18, 0x8a, 0x49,
-	0x31, 0x42, 0x90, 0x2c, 0xc4, 0xbc, 0x26, 0x5d, 0x61, 0x52, 0xa7, 0xbf, 0xcd, 0x40, 0x65, 0x13,
-	0x53, 0x06, 0x14, 0x31, 0x13, 0x57, 0xe7, 0xe8, 0xbf, 0xe1, 0x2c, 0xc9, 0x19, 0x30, 0xff, 0x47,
-	0x9c, 0x1a, 0xd7, 0x50, 0xc5, 0x48, 0xdf, 0x86, 0x99, 0xde, 0xb2, 0x78, 0x8a, 0xcd, 0xf2, 0x20,
-	0x3e, 0x3b, 0xa4, 0x35, 0xee, 0xc9, 0xc0, 0xe2, 0x76, 0x9a, 0xc6, 0x3f, 0xf5, 0x1a, 0x4c, 0xb6,
-	0x1d, 0x91, 0x9f, 0x7b, 0x11, 0x57, 0x6a, 0x3b, 0x62, 0x8a, 0x6c, 0xf3, 0x75, 0x74, 0x2f, 0x5a,
-	0xcf, 0xcb, 0x75, 0x74, 0x4f, 0xae, 0x27, 0x1f, 0xd7, 0x0b, 0x63, 0x3c, 0xae, 0xa7, 0x56, 0x17,
-	0xf7, 0x35, 0x38, 0x93, 0xa2, 0x2e, 0x19, 0x7a, 0xdf, 0x4a, 0xbe, 0xae, 0x7f, 0x7d, 0x9c, 0x1a,
-	0x7d, 0xdd, 0x75, 0x7d, 0x0b, 0x51, 0x6c, 0x47, 0xe3, 0xf0, 0x63, 0xbe, 0xb4, 0xff, 0x44, 0x83,
-	0xda, 0x35, 0xec, 0x62, 0x8a, 0x07, 0x43, 0xec, 0xcb, 0xfd, 0x3b, 0xd5, 0x15, 0x58, 0x1e, 0x2a,
-	0x88, 0xd4, 0x50, 0x15, 0x8a, 0x77, 0x51, 0xe8, 0x39, 0x5e, 0x4b, 0x4d, 0x28, 0xa3, 0xef, 0xab,
-	0xee, 0x27, 0x9f, 0xd7, 0x4e, 0x7c, 0xfa, 0x79, 0xed, 0xc4, 0x17, 0x9f, 0xd7, 0xb4, 0x1f, 0x1c,
-	0xd6, 0xb4, 0x5f, 0x1f, 0xd6, 0xb4, 0x3f, 0x1d, 0xd6, 0xb4, 0x4f, 0x0e, 0x6b, 0xda, 0x3f, 0x0e,
-	0x6b, 0xda, 0x3f, 0x0f, 0x6b, 0x27, 0xbe, 0x38, 0xac, 0x69, 0xf7, 0x1f, 0xd4, 0x4e, 0x7c, 0xf2,
-	0xa0, 0x76, 0xe2, 0xd3, 0x07, 0xb5, 0x13, 0xef, 0x7c, 0xa3, 0xe5, 0xf7, 0x84, 0x75, 0xfc, 0x11,
-	0x7f, 0x4d, 0x7d, 0x39, 0xfe, 0xdd, 0x2c, 0xf0, 0xfa, 0xf4, 0xf9, 0xff, 0x04, 0x00, 0x00, 0xff,
-	0xff, 0x83, 0x7d, 0xbf, 0xba, 0xd5, 0x2a, 0x00, 0x00,
+var xxx_messageInfo_ResetWorkflowExecutionsRequest proto.InternalMessageInfo
+
+func (m *ResetWorkflowExecutionsRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
 }
 
-func (this *RebuildMutableStateRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *ResetWorkflowExecutionsRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
 	}
+	return ""
+}
 
-	that1, ok := that.(*RebuildMutableStateRequest)
-	if !ok {
-		that2, ok := that.(RebuildMutableStateRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *ResetWorkflowExecutionsRequest) GetResetPointSelector() *v110.ResetPointSelector {
+	if m != nil {
+		return m.ResetPointSelector
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return nil
+}
+
+func (m *ResetWorkflowExecutionsRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
 	}
-	if this.Namespace != that1.Namespace {
-		return false
+	return false
+}
+
+func (m *ResetWorkflowExecutionsRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	if !this.Execution.Equal(that1.Execution) {
-		return false
+	return ""
+}
+
+func (m *ResetWorkflowExecutionsRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
 	}
-	return true
+	return ""
 }
-func (this *RebuildMutableStateResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ResetWorkflowExecutionsRequest) GetResetReapplyType() v17.ResetReapplyType {
+	if m != nil {
+		return m.ResetReapplyType
 	}
+	return v17.RESET_REAPPLY_TYPE_UNSPECIFIED
+}
 
-	that1, ok := that.(*RebuildMutableStateResponse)
-	if !ok {
-		that2, ok := that.(RebuildMutableStateResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *ResetWorkflowExecutionsRequest) GetMaximumPageSize() int32 {
+	if m != nil {
+		return m.MaximumPageSize
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return 0
+}
+
+func (m *ResetWorkflowExecutionsRequest) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
 	}
-	return true
+	return nil
 }
-func (this *DescribeMutableStateRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ResetWorkflowExecutionsRequest) GetExecutions() []*v1.WorkflowExecution {
+	if m != nil {
+		return m.Executions
 	}
+	return nil
+}
 
-	that1, ok := that.(*DescribeMutableStateRequest)
-	if !ok {
-		that2, ok := that.(DescribeMutableStateRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type ResetWorkflowExecutionsResponse struct {
+	Results       []*v110.ResetPointResolution `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	NextPageToken []byte                       `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (m *ResetWorkflowExecutionsResponse) Reset()      { *m = ResetWorkflowExecutionsResponse{} }
+func (*ResetWorkflowExecutionsResponse) ProtoMessage() {}
+func (*ResetWorkflowExecutionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{56}
+}
+func (m *ResetWorkflowExecutionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResetWorkflowExecutionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResetWorkflowExecutionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Namespace != that1.Namespace {
-		return false
-	}
-	if !this.Execution.Equal(that1.Execution) {
-		return false
+}
+func (m *ResetWorkflowExecutionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetWorkflowExecutionsResponse.Merge(m, src)
+}
+func (m *ResetWorkflowExecutionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResetWorkflowExecutionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetWorkflowExecutionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetWorkflowExecutionsResponse proto.InternalMessageInfo
+
+func (m *ResetWorkflowExecutionsResponse) GetResults() []*v110.ResetPointResolution {
+	if m != nil {
+		return m.Results
 	}
-	return true
+	return nil
 }
-func (this *DescribeMutableStateResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ResetWorkflowExecutionsResponse) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
 	}
+	return nil
+}
 
-	that1, ok := that.(*DescribeMutableStateResponse)
-	if !ok {
-		that2, ok := that.(DescribeMutableStateResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type PauseWorkflowExecutionRequest struct {
+	Namespace string                `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Execution *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	Reason    string                `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Identity  string                `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
+}
+
+func (m *PauseWorkflowExecutionRequest) Reset()      { *m = PauseWorkflowExecutionRequest{} }
+func (*PauseWorkflowExecutionRequest) ProtoMessage() {}
+func (*PauseWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{57}
+}
+func (m *PauseWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseWorkflowExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *PauseWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseWorkflowExecutionRequest.Merge(m, src)
+}
+func (m *PauseWorkflowExecutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseWorkflowExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseWorkflowExecutionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseWorkflowExecutionRequest proto.InternalMessageInfo
+
+func (m *PauseWorkflowExecutionRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	if this.ShardId != that1.ShardId {
-		return false
+	return ""
+}
+
+func (m *PauseWorkflowExecutionRequest) GetExecution() *v1.WorkflowExecution {
+	if m != nil {
+		return m.Execution
 	}
-	if this.HistoryAddr != that1.HistoryAddr {
-		return false
-	}
-	if !this.CacheMutableState.Equal(that1.CacheMutableState) {
-		return false
-	}
-	if !this.DatabaseMutableState.Equal(that1.DatabaseMutableState) {
-		return false
+	return nil
+}
+
+func (m *PauseWorkflowExecutionRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return true
+	return ""
 }
-func (this *DescribeHistoryHostRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *PauseWorkflowExecutionRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
 	}
+	return ""
+}
 
-	that1, ok := that.(*DescribeHistoryHostRequest)
-	if !ok {
-		that2, ok := that.(DescribeHistoryHostRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type PauseWorkflowExecutionResponse struct {
+}
+
+func (m *PauseWorkflowExecutionResponse) Reset()      { *m = PauseWorkflowExecutionResponse{} }
+func (*PauseWorkflowExecutionResponse) ProtoMessage() {}
+func (*PauseWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{58}
+}
+func (m *PauseWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseWorkflowExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.HostAddress != that1.HostAddress {
-		return false
-	}
-	if this.ShardId != that1.ShardId {
-		return false
-	}
-	if this.Namespace != that1.Namespace {
-		return false
-	}
-	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
-		return false
-	}
-	return true
 }
-func (this *DescribeHistoryHostResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *PauseWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseWorkflowExecutionResponse.Merge(m, src)
+}
+func (m *PauseWorkflowExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseWorkflowExecutionResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*DescribeHistoryHostResponse)
-	if !ok {
-		that2, ok := that.(DescribeHistoryHostResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_PauseWorkflowExecutionResponse proto.InternalMessageInfo
+
+type UnpauseWorkflowExecutionRequest struct {
+	Namespace string                `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Execution *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	Identity  string                `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
+}
+
+func (m *UnpauseWorkflowExecutionRequest) Reset()      { *m = UnpauseWorkflowExecutionRequest{} }
+func (*UnpauseWorkflowExecutionRequest) ProtoMessage() {}
+func (*UnpauseWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{59}
+}
+func (m *UnpauseWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnpauseWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnpauseWorkflowExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *UnpauseWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpauseWorkflowExecutionRequest.Merge(m, src)
+}
+func (m *UnpauseWorkflowExecutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnpauseWorkflowExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpauseWorkflowExecutionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnpauseWorkflowExecutionRequest proto.InternalMessageInfo
+
+func (m *UnpauseWorkflowExecutionRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	if this.ShardsNumber != that1.ShardsNumber {
-		return false
+	return ""
+}
+
+func (m *UnpauseWorkflowExecutionRequest) GetExecution() *v1.WorkflowExecution {
+	if m != nil {
+		return m.Execution
 	}
-	if len(this.ShardIds) != len(that1.ShardIds) {
-		return false
+	return nil
+}
+
+func (m *UnpauseWorkflowExecutionRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
 	}
-	for i := range this.ShardIds {
-		if this.ShardIds[i] != that1.ShardIds[i] {
-			return false
+	return ""
+}
+
+type UnpauseWorkflowExecutionResponse struct {
+}
+
+func (m *UnpauseWorkflowExecutionResponse) Reset()      { *m = UnpauseWorkflowExecutionResponse{} }
+func (*UnpauseWorkflowExecutionResponse) ProtoMessage() {}
+func (*UnpauseWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{60}
+}
+func (m *UnpauseWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnpauseWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnpauseWorkflowExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if !this.NamespaceCache.Equal(that1.NamespaceCache) {
-		return false
-	}
-	if this.Address != that1.Address {
-		return false
-	}
-	return true
 }
-func (this *CloseShardRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *UnpauseWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpauseWorkflowExecutionResponse.Merge(m, src)
+}
+func (m *UnpauseWorkflowExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnpauseWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpauseWorkflowExecutionResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*CloseShardRequest)
-	if !ok {
-		that2, ok := that.(CloseShardRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_UnpauseWorkflowExecutionResponse proto.InternalMessageInfo
+
+type PauseActivityExecutionRequest struct {
+	Namespace  string                `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Execution  *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	ActivityId string                `protobuf:"bytes,3,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	Reason     string                `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Identity   string                `protobuf:"bytes,5,opt,name=identity,proto3" json:"identity,omitempty"`
+}
+
+func (m *PauseActivityExecutionRequest) Reset()      { *m = PauseActivityExecutionRequest{} }
+func (*PauseActivityExecutionRequest) ProtoMessage() {}
+func (*PauseActivityExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{61}
+}
+func (m *PauseActivityExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseActivityExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseActivityExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.ShardId != that1.ShardId {
-		return false
+}
+func (m *PauseActivityExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseActivityExecutionRequest.Merge(m, src)
+}
+func (m *PauseActivityExecutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseActivityExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseActivityExecutionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseActivityExecutionRequest proto.InternalMessageInfo
+
+func (m *PauseActivityExecutionRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	return true
+	return ""
 }
-func (this *CloseShardResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *PauseActivityExecutionRequest) GetExecution() *v1.WorkflowExecution {
+	if m != nil {
+		return m.Execution
 	}
+	return nil
+}
 
-	that1, ok := that.(*CloseShardResponse)
-	if !ok {
-		that2, ok := that.(CloseShardResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *PauseActivityExecutionRequest) GetActivityId() string {
+	if m != nil {
+		return m.ActivityId
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *PauseActivityExecutionRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return true
+	return ""
 }
-func (this *GetShardRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *PauseActivityExecutionRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
 	}
+	return ""
+}
 
-	that1, ok := that.(*GetShardRequest)
-	if !ok {
-		that2, ok := that.(GetShardRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type PauseActivityExecutionResponse struct {
+}
+
+func (m *PauseActivityExecutionResponse) Reset()      { *m = PauseActivityExecutionResponse{} }
+func (*PauseActivityExecutionResponse) ProtoMessage() {}
+func (*PauseActivityExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{62}
+}
+func (m *PauseActivityExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseActivityExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseActivityExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.ShardId != that1.ShardId {
-		return false
-	}
-	return true
 }
-func (this *GetShardResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *PauseActivityExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseActivityExecutionResponse.Merge(m, src)
+}
+func (m *PauseActivityExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseActivityExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseActivityExecutionResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*GetShardResponse)
-	if !ok {
-		that2, ok := that.(GetShardResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.ShardInfo.Equal(that1.ShardInfo) {
-		return false
-	}
-	return true
+var xxx_messageInfo_PauseActivityExecutionResponse proto.InternalMessageInfo
+
+type UnpauseActivityExecutionRequest struct {
+	Namespace  string                `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Execution  *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	ActivityId string                `protobuf:"bytes,3,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	Identity   string                `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
 }
-func (this *ListHistoryTasksRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*ListHistoryTasksRequest)
-	if !ok {
-		that2, ok := that.(ListHistoryTasksRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *UnpauseActivityExecutionRequest) Reset()      { *m = UnpauseActivityExecutionRequest{} }
+func (*UnpauseActivityExecutionRequest) ProtoMessage() {}
+func (*UnpauseActivityExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{63}
+}
+func (m *UnpauseActivityExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnpauseActivityExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnpauseActivityExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.ShardId != that1.ShardId {
-		return false
-	}
-	if this.Category != that1.Category {
-		return false
-	}
-	if !this.TaskRange.Equal(that1.TaskRange) {
-		return false
+}
+func (m *UnpauseActivityExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpauseActivityExecutionRequest.Merge(m, src)
+}
+func (m *UnpauseActivityExecutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnpauseActivityExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpauseActivityExecutionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnpauseActivityExecutionRequest proto.InternalMessageInfo
+
+func (m *UnpauseActivityExecutionRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	if this.BatchSize != that1.BatchSize {
-		return false
+	return ""
+}
+
+func (m *UnpauseActivityExecutionRequest) GetExecution() *v1.WorkflowExecution {
+	if m != nil {
+		return m.Execution
 	}
-	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
-		return false
+	return nil
+}
+
+func (m *UnpauseActivityExecutionRequest) GetActivityId() string {
+	if m != nil {
+		return m.ActivityId
 	}
-	return true
+	return ""
 }
-func (this *ListHistoryTasksResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *UnpauseActivityExecutionRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
 	}
+	return ""
+}
 
-	that1, ok := that.(*ListHistoryTasksResponse)
-	if !ok {
-		that2, ok := that.(ListHistoryTasksResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type UnpauseActivityExecutionResponse struct {
+}
+
+func (m *UnpauseActivityExecutionResponse) Reset()      { *m = UnpauseActivityExecutionResponse{} }
+func (*UnpauseActivityExecutionResponse) ProtoMessage() {}
+func (*UnpauseActivityExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{64}
+}
+func (m *UnpauseActivityExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnpauseActivityExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnpauseActivityExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.Tasks) != len(that1.Tasks) {
-		return false
-	}
-	for i := range this.Tasks {
-		if !this.Tasks[i].Equal(that1.Tasks[i]) {
-			return false
+}
+func (m *UnpauseActivityExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpauseActivityExecutionResponse.Merge(m, src)
+}
+func (m *UnpauseActivityExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnpauseActivityExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpauseActivityExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnpauseActivityExecutionResponse proto.InternalMessageInfo
+
+type ResetActivityExecutionRequest struct {
+	Namespace  string                `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Execution  *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	ActivityId string                `protobuf:"bytes,3,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	Identity   string                `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
+}
+
+func (m *ResetActivityExecutionRequest) Reset()      { *m = ResetActivityExecutionRequest{} }
+func (*ResetActivityExecutionRequest) ProtoMessage() {}
+func (*ResetActivityExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{65}
+}
+func (m *ResetActivityExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResetActivityExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResetActivityExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
-		return false
-	}
-	return true
 }
-func (this *Task) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *ResetActivityExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetActivityExecutionRequest.Merge(m, src)
+}
+func (m *ResetActivityExecutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResetActivityExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetActivityExecutionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetActivityExecutionRequest proto.InternalMessageInfo
+
+func (m *ResetActivityExecutionRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
+	return ""
+}
 
-	that1, ok := that.(*Task)
-	if !ok {
-		that2, ok := that.(Task)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *ResetActivityExecutionRequest) GetExecution() *v1.WorkflowExecution {
+	if m != nil {
+		return m.Execution
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return nil
+}
+
+func (m *ResetActivityExecutionRequest) GetActivityId() string {
+	if m != nil {
+		return m.ActivityId
 	}
-	if this.NamespaceId != that1.NamespaceId {
-		return false
+	return ""
+}
+
+func (m *ResetActivityExecutionRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
 	}
-	if this.WorkflowId != that1.WorkflowId {
-		return false
+	return ""
+}
+
+type ResetActivityExecutionResponse struct {
+}
+
+func (m *ResetActivityExecutionResponse) Reset()      { *m = ResetActivityExecutionResponse{} }
+func (*ResetActivityExecutionResponse) ProtoMessage() {}
+func (*ResetActivityExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{66}
+}
+func (m *ResetActivityExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResetActivityExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResetActivityExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.RunId != that1.RunId {
-		return false
+}
+func (m *ResetActivityExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetActivityExecutionResponse.Merge(m, src)
+}
+func (m *ResetActivityExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResetActivityExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetActivityExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetActivityExecutionResponse proto.InternalMessageInfo
+
+type UpdateActivityExecutionOptionsRequest struct {
+	Namespace  string                `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Execution  *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	ActivityId string                `protobuf:"bytes,3,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	Options    *v110.ActivityOptions `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
+	Identity   string                `protobuf:"bytes,5,opt,name=identity,proto3" json:"identity,omitempty"`
+}
+
+func (m *UpdateActivityExecutionOptionsRequest) Reset()      { *m = UpdateActivityExecutionOptionsRequest{} }
+func (*UpdateActivityExecutionOptionsRequest) ProtoMessage() {}
+func (*UpdateActivityExecutionOptionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{67}
+}
+func (m *UpdateActivityExecutionOptionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateActivityExecutionOptionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateActivityExecutionOptionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.TaskId != that1.TaskId {
-		return false
+}
+func (m *UpdateActivityExecutionOptionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateActivityExecutionOptionsRequest.Merge(m, src)
+}
+func (m *UpdateActivityExecutionOptionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateActivityExecutionOptionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateActivityExecutionOptionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateActivityExecutionOptionsRequest proto.InternalMessageInfo
+
+func (m *UpdateActivityExecutionOptionsRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	if this.TaskType != that1.TaskType {
-		return false
+	return ""
+}
+
+func (m *UpdateActivityExecutionOptionsRequest) GetExecution() *v1.WorkflowExecution {
+	if m != nil {
+		return m.Execution
 	}
-	if that1.FireTime == nil {
-		if this.FireTime != nil {
-			return false
-		}
-	} else if !this.FireTime.Equal(*that1.FireTime) {
-		return false
+	return nil
+}
+
+func (m *UpdateActivityExecutionOptionsRequest) GetActivityId() string {
+	if m != nil {
+		return m.ActivityId
 	}
-	if this.Version != that1.Version {
-		return false
+	return ""
+}
+
+func (m *UpdateActivityExecutionOptionsRequest) GetOptions() *v110.ActivityOptions {
+	if m != nil {
+		return m.Options
 	}
-	return true
+	return nil
 }
-func (this *RemoveTaskRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *UpdateActivityExecutionOptionsRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
 	}
+	return ""
+}
 
-	that1, ok := that.(*RemoveTaskRequest)
-	if !ok {
-		that2, ok := that.(RemoveTaskRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.ShardId != that1.ShardId {
-		return false
-	}
-	if this.Category != that1.Category {
-		return false
-	}
-	if this.TaskId != that1.TaskId {
-		return false
-	}
-	if that1.VisibilityTime == nil {
-		if this.VisibilityTime != nil {
-			return false
-		}
-	} else if !this.VisibilityTime.Equal(*that1.VisibilityTime) {
-		return false
-	}
-	return true
+type UpdateActivityExecutionOptionsResponse struct {
 }
-func (this *RemoveTaskResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*RemoveTaskResponse)
-	if !ok {
-		that2, ok := that.(RemoveTaskResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *UpdateActivityExecutionOptionsResponse) Reset() {
+	*m = UpdateActivityExecutionOptionsResponse{}
+}
+func (*UpdateActivityExecutionOptionsResponse) ProtoMessage() {}
+func (*UpdateActivityExecutionOptionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{68}
+}
+func (m *UpdateActivityExecutionOptionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateActivityExecutionOptionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateActivityExecutionOptionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	return true
 }
-func (this *GetWorkflowExecutionRawHistoryV2Request) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *UpdateActivityExecutionOptionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateActivityExecutionOptionsResponse.Merge(m, src)
+}
+func (m *UpdateActivityExecutionOptionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateActivityExecutionOptionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateActivityExecutionOptionsResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*GetWorkflowExecutionRawHistoryV2Request)
-	if !ok {
-		that2, ok := that.(GetWorkflowExecutionRawHistoryV2Request)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_UpdateActivityExecutionOptionsResponse proto.InternalMessageInfo
+
+type ListAuditRecordsRequest struct {
+	// Filters, empty values match any record.
+	Namespace     string     `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Principal     string     `protobuf:"bytes,2,opt,name=principal,proto3" json:"principal,omitempty"`
+	ApiName       string     `protobuf:"bytes,3,opt,name=api_name,json=apiName,proto3" json:"api_name,omitempty"`
+	StartTime     *time.Time `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty"`
+	EndTime       *time.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty"`
+	PageSize      int32      `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken []byte     `protobuf:"bytes,7,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (m *ListAuditRecordsRequest) Reset()      { *m = ListAuditRecordsRequest{} }
+func (*ListAuditRecordsRequest) ProtoMessage() {}
+func (*ListAuditRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{69}
+}
+func (m *ListAuditRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListAuditRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListAuditRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.NamespaceId != that1.NamespaceId {
-		return false
-	}
-	if !this.Execution.Equal(that1.Execution) {
-		return false
-	}
-	if this.StartEventId != that1.StartEventId {
-		return false
+}
+func (m *ListAuditRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAuditRecordsRequest.Merge(m, src)
+}
+func (m *ListAuditRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListAuditRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAuditRecordsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAuditRecordsRequest proto.InternalMessageInfo
+
+func (m *ListAuditRecordsRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	if this.StartEventVersion != that1.StartEventVersion {
-		return false
+	return ""
+}
+
+func (m *ListAuditRecordsRequest) GetPrincipal() string {
+	if m != nil {
+		return m.Principal
 	}
-	if this.EndEventId != that1.EndEventId {
-		return false
+	return ""
+}
+
+func (m *ListAuditRecordsRequest) GetApiName() string {
+	if m != nil {
+		return m.ApiName
 	}
-	if this.EndEventVersion != that1.EndEventVersion {
-		return false
+	return ""
+}
+
+func (m *ListAuditRecordsRequest) GetStartTime() *time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	if this.MaximumPageSize != that1.MaximumPageSize {
-		return false
+	return nil
+}
+
+func (m *ListAuditRecordsRequest) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
 	}
-	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
-		return false
+	return nil
+}
+
+func (m *ListAuditRecordsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
 	}
-	return true
+	return 0
 }
-func (this *GetWorkflowExecutionRawHistoryV2Response) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ListAuditRecordsRequest) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
 	}
+	return nil
+}
 
-	that1, ok := that.(*GetWorkflowExecutionRawHistoryV2Response)
-	if !ok {
-		that2, ok := that.(GetWorkflowExecutionRawHistoryV2Response)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type ListAuditRecordsResponse struct {
+	Records       []*v11.AuditRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	NextPageToken []byte             `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (m *ListAuditRecordsResponse) Reset()      { *m = ListAuditRecordsResponse{} }
+func (*ListAuditRecordsResponse) ProtoMessage() {}
+func (*ListAuditRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{70}
+}
+func (m *ListAuditRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListAuditRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListAuditRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
-		return false
+}
+func (m *ListAuditRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAuditRecordsResponse.Merge(m, src)
+}
+func (m *ListAuditRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListAuditRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAuditRecordsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAuditRecordsResponse proto.InternalMessageInfo
+
+func (m *ListAuditRecordsResponse) GetRecords() []*v11.AuditRecord {
+	if m != nil {
+		return m.Records
 	}
-	if len(this.HistoryBatches) != len(that1.HistoryBatches) {
-		return false
+	return nil
+}
+
+func (m *ListAuditRecordsResponse) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
 	}
-	for i := range this.HistoryBatches {
-		if !this.HistoryBatches[i].Equal(that1.HistoryBatches[i]) {
-			return false
+	return nil
+}
+
+type CreateNamespaceApiKeyRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Role granted on the namespace: read, write, worker or admin.
+	Role string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	// Optional lifetime of the key, keys without one do not expire.
+	Ttl      *time.Duration `protobuf:"bytes,4,opt,name=ttl,proto3,stdduration" json:"ttl,omitempty"`
+	Identity string         `protobuf:"bytes,5,opt,name=identity,proto3" json:"identity,omitempty"`
+}
+
+func (m *CreateNamespaceApiKeyRequest) Reset()      { *m = CreateNamespaceApiKeyRequest{} }
+func (*CreateNamespaceApiKeyRequest) ProtoMessage() {}
+func (*CreateNamespaceApiKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{71}
+}
+func (m *CreateNamespaceApiKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateNamespaceApiKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateNamespaceApiKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if !this.VersionHistory.Equal(that1.VersionHistory) {
-		return false
+}
+func (m *CreateNamespaceApiKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateNamespaceApiKeyRequest.Merge(m, src)
+}
+func (m *CreateNamespaceApiKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateNamespaceApiKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateNamespaceApiKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateNamespaceApiKeyRequest proto.InternalMessageInfo
+
+func (m *CreateNamespaceApiKeyRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	if len(this.HistoryNodeIds) != len(that1.HistoryNodeIds) {
-		return false
+	return ""
+}
+
+func (m *CreateNamespaceApiKeyRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	for i := range this.HistoryNodeIds {
-		if this.HistoryNodeIds[i] != that1.HistoryNodeIds[i] {
-			return false
-		}
+	return ""
+}
+
+func (m *CreateNamespaceApiKeyRequest) GetRole() string {
+	if m != nil {
+		return m.Role
 	}
-	return true
+	return ""
 }
-func (this *GetReplicationMessagesRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *CreateNamespaceApiKeyRequest) GetTtl() *time.Duration {
+	if m != nil {
+		return m.Ttl
 	}
+	return nil
+}
 
-	that1, ok := that.(*GetReplicationMessagesRequest)
-	if !ok {
-		that2, ok := that.(GetReplicationMessagesRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *CreateNamespaceApiKeyRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.Tokens) != len(that1.Tokens) {
-		return false
-	}
-	for i := range this.Tokens {
-		if !this.Tokens[i].Equal(that1.Tokens[i]) {
-			return false
+	return ""
+}
+
+type CreateNamespaceApiKeyResponse struct {
+	// The key with its secret hash cleared.
+	ApiKey *v11.NamespaceApiKey `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	// Bearer token of the key. It is only returned once and cannot be retrieved later.
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (m *CreateNamespaceApiKeyResponse) Reset()      { *m = CreateNamespaceApiKeyResponse{} }
+func (*CreateNamespaceApiKeyResponse) ProtoMessage() {}
+func (*CreateNamespaceApiKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{72}
+}
+func (m *CreateNamespaceApiKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateNamespaceApiKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateNamespaceApiKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if this.ClusterName != that1.ClusterName {
-		return false
+}
+func (m *CreateNamespaceApiKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateNamespaceApiKeyResponse.Merge(m, src)
+}
+func (m *CreateNamespaceApiKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateNamespaceApiKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateNamespaceApiKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateNamespaceApiKeyResponse proto.InternalMessageInfo
+
+func (m *CreateNamespaceApiKeyResponse) GetApiKey() *v11.NamespaceApiKey {
+	if m != nil {
+		return m.ApiKey
 	}
-	return true
+	return nil
 }
-func (this *GetReplicationMessagesResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *CreateNamespaceApiKeyResponse) GetToken() string {
+	if m != nil {
+		return m.Token
 	}
+	return ""
+}
 
-	that1, ok := that.(*GetReplicationMessagesResponse)
-	if !ok {
-		that2, ok := that.(GetReplicationMessagesResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type ListNamespaceApiKeysRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (m *ListNamespaceApiKeysRequest) Reset()      { *m = ListNamespaceApiKeysRequest{} }
+func (*ListNamespaceApiKeysRequest) ProtoMessage() {}
+func (*ListNamespaceApiKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{73}
+}
+func (m *ListNamespaceApiKeysRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListNamespaceApiKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListNamespaceApiKeysRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.ShardMessages) != len(that1.ShardMessages) {
-		return false
+}
+func (m *ListNamespaceApiKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListNamespaceApiKeysRequest.Merge(m, src)
+}
+func (m *ListNamespaceApiKeysRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListNamespaceApiKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListNamespaceApiKeysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListNamespaceApiKeysRequest proto.InternalMessageInfo
+
+func (m *ListNamespaceApiKeysRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	for i := range this.ShardMessages {
-		if !this.ShardMessages[i].Equal(that1.ShardMessages[i]) {
-			return false
+	return ""
+}
+
+type ListNamespaceApiKeysResponse struct {
+	ApiKeys []*v11.NamespaceApiKey `protobuf:"bytes,1,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
+}
+
+func (m *ListNamespaceApiKeysResponse) Reset()      { *m = ListNamespaceApiKeysResponse{} }
+func (*ListNamespaceApiKeysResponse) ProtoMessage() {}
+func (*ListNamespaceApiKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{74}
+}
+func (m *ListNamespaceApiKeysResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListNamespaceApiKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListNamespaceApiKeysResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *GetNamespaceReplicationMessagesRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *ListNamespaceApiKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListNamespaceApiKeysResponse.Merge(m, src)
+}
+func (m *ListNamespaceApiKeysResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListNamespaceApiKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListNamespaceApiKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListNamespaceApiKeysResponse proto.InternalMessageInfo
+
+func (m *ListNamespaceApiKeysResponse) GetApiKeys() []*v11.NamespaceApiKey {
+	if m != nil {
+		return m.ApiKeys
 	}
+	return nil
+}
 
-	that1, ok := that.(*GetNamespaceReplicationMessagesRequest)
-	if !ok {
-		that2, ok := that.(GetNamespaceReplicationMessagesRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type RotateNamespaceApiKeyRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	KeyId     string `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	// Optional new lifetime of the key, counted from the rotation. The current expiration is kept if not set.
+	Ttl *time.Duration `protobuf:"bytes,3,opt,name=ttl,proto3,stdduration" json:"ttl,omitempty"`
+}
+
+func (m *RotateNamespaceApiKeyRequest) Reset()      { *m = RotateNamespaceApiKeyRequest{} }
+func (*RotateNamespaceApiKeyRequest) ProtoMessage() {}
+func (*RotateNamespaceApiKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{75}
+}
+func (m *RotateNamespaceApiKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RotateNamespaceApiKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RotateNamespaceApiKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.LastRetrievedMessageId != that1.LastRetrievedMessageId {
-		return false
-	}
-	if this.LastProcessedMessageId != that1.LastProcessedMessageId {
-		return false
+}
+func (m *RotateNamespaceApiKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotateNamespaceApiKeyRequest.Merge(m, src)
+}
+func (m *RotateNamespaceApiKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RotateNamespaceApiKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotateNamespaceApiKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RotateNamespaceApiKeyRequest proto.InternalMessageInfo
+
+func (m *RotateNamespaceApiKeyRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	if this.ClusterName != that1.ClusterName {
-		return false
+	return ""
+}
+
+func (m *RotateNamespaceApiKeyRequest) GetKeyId() string {
+	if m != nil {
+		return m.KeyId
 	}
-	return true
+	return ""
 }
-func (this *GetNamespaceReplicationMessagesResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *RotateNamespaceApiKeyRequest) GetTtl() *time.Duration {
+	if m != nil {
+		return m.Ttl
 	}
+	return nil
+}
 
-	that1, ok := that.(*GetNamespaceReplicationMessagesResponse)
-	if !ok {
-		that2, ok := that.(GetNamespaceReplicationMessagesResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type RotateNamespaceApiKeyResponse struct {
+	ApiKey *v11.NamespaceApiKey `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	Token  string               `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (m *RotateNamespaceApiKeyResponse) Reset()      { *m = RotateNamespaceApiKeyResponse{} }
+func (*RotateNamespaceApiKeyResponse) ProtoMessage() {}
+func (*RotateNamespaceApiKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{76}
+}
+func (m *RotateNamespaceApiKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RotateNamespaceApiKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RotateNamespaceApiKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Messages.Equal(that1.Messages) {
-		return false
+}
+func (m *RotateNamespaceApiKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotateNamespaceApiKeyResponse.Merge(m, src)
+}
+func (m *RotateNamespaceApiKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RotateNamespaceApiKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotateNamespaceApiKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RotateNamespaceApiKeyResponse proto.InternalMessageInfo
+
+func (m *RotateNamespaceApiKeyResponse) GetApiKey() *v11.NamespaceApiKey {
+	if m != nil {
+		return m.ApiKey
 	}
-	return true
+	return nil
 }
-func (this *GetDLQReplicationMessagesRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *RotateNamespaceApiKeyResponse) GetToken() string {
+	if m != nil {
+		return m.Token
 	}
+	return ""
+}
 
-	that1, ok := that.(*GetDLQReplicationMessagesRequest)
-	if !ok {
-		that2, ok := that.(GetDLQReplicationMessagesRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type RevokeNamespaceApiKeyRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	KeyId     string `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+}
+
+func (m *RevokeNamespaceApiKeyRequest) Reset()      { *m = RevokeNamespaceApiKeyRequest{} }
+func (*RevokeNamespaceApiKeyRequest) ProtoMessage() {}
+func (*RevokeNamespaceApiKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{77}
+}
+func (m *RevokeNamespaceApiKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevokeNamespaceApiKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevokeNamespaceApiKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.TaskInfos) != len(that1.TaskInfos) {
-		return false
-	}
-	for i := range this.TaskInfos {
-		if !this.TaskInfos[i].Equal(that1.TaskInfos[i]) {
-			return false
-		}
+}
+func (m *RevokeNamespaceApiKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeNamespaceApiKeyRequest.Merge(m, src)
+}
+func (m *RevokeNamespaceApiKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevokeNamespaceApiKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeNamespaceApiKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeNamespaceApiKeyRequest proto.InternalMessageInfo
+
+func (m *RevokeNamespaceApiKeyRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	return true
+	return ""
 }
-func (this *GetDLQReplicationMessagesResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *RevokeNamespaceApiKeyRequest) GetKeyId() string {
+	if m != nil {
+		return m.KeyId
 	}
+	return ""
+}
 
-	that1, ok := that.(*GetDLQReplicationMessagesResponse)
-	if !ok {
-		that2, ok := that.(GetDLQReplicationMessagesResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.ReplicationTasks) != len(that1.ReplicationTasks) {
-		return false
-	}
-	for i := range this.ReplicationTasks {
-		if !this.ReplicationTasks[i].Equal(that1.ReplicationTasks[i]) {
-			return false
+type RevokeNamespaceApiKeyResponse struct {
+}
+
+func (m *RevokeNamespaceApiKeyResponse) Reset()      { *m = RevokeNamespaceApiKeyResponse{} }
+func (*RevokeNamespaceApiKeyResponse) ProtoMessage() {}
+func (*RevokeNamespaceApiKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{78}
+}
+func (m *RevokeNamespaceApiKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevokeNamespaceApiKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevokeNamespaceApiKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *ReapplyEventsRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *RevokeNamespaceApiKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeNamespaceApiKeyResponse.Merge(m, src)
+}
+func (m *RevokeNamespaceApiKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevokeNamespaceApiKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeNamespaceApiKeyResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*ReapplyEventsRequest)
-	if !ok {
-		that2, ok := that.(ReapplyEventsRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_RevokeNamespaceApiKeyResponse proto.InternalMessageInfo
+
+type GetReplicationStatusRequest struct {
+	// Remote clusters to report on, all remote clusters if empty.
+	RemoteClusters []string `protobuf:"bytes,1,rep,name=remote_clusters,json=remoteClusters,proto3" json:"remote_clusters,omitempty"`
+	// Include the status of every shard in addition to the per cluster summary.
+	IncludeShards bool `protobuf:"varint,2,opt,name=include_shards,json=includeShards,proto3" json:"include_shards,omitempty"`
+}
+
+func (m *GetReplicationStatusRequest) Reset()      { *m = GetReplicationStatusRequest{} }
+func (*GetReplicationStatusRequest) ProtoMessage() {}
+func (*GetReplicationStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{79}
+}
+func (m *GetReplicationStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetReplicationStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetReplicationStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.NamespaceId != that1.NamespaceId {
-		return false
-	}
-	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
-		return false
-	}
-	if !this.Events.Equal(that1.Events) {
-		return false
+}
+func (m *GetReplicationStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReplicationStatusRequest.Merge(m, src)
+}
+func (m *GetReplicationStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetReplicationStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReplicationStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReplicationStatusRequest proto.InternalMessageInfo
+
+func (m *GetReplicationStatusRequest) GetRemoteClusters() []string {
+	if m != nil {
+		return m.RemoteClusters
 	}
-	return true
+	return nil
 }
-func (this *ReapplyEventsResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *GetReplicationStatusRequest) GetIncludeShards() bool {
+	if m != nil {
+		return m.IncludeShards
 	}
+	return false
+}
 
-	that1, ok := that.(*ReapplyEventsResponse)
-	if !ok {
-		that2, ok := that.(ReapplyEventsResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type GetReplicationStatusResponse struct {
+	// Summary over all shards, keyed by remote cluster name.
+	RemoteClusters map[string]*ClusterReplicationStatus `protobuf:"bytes,1,rep,name=remote_clusters,json=remoteClusters,proto3" json:"remote_clusters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Shards         []*ShardReplicationStatus            `protobuf:"bytes,2,rep,name=shards,proto3" json:"shards,omitempty"`
+}
+
+func (m *GetReplicationStatusResponse) Reset()      { *m = GetReplicationStatusResponse{} }
+func (*GetReplicationStatusResponse) ProtoMessage() {}
+func (*GetReplicationStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{80}
+}
+func (m *GetReplicationStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetReplicationStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetReplicationStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *GetReplicationStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReplicationStatusResponse.Merge(m, src)
+}
+func (m *GetReplicationStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetReplicationStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReplicationStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReplicationStatusResponse proto.InternalMessageInfo
+
+func (m *GetReplicationStatusResponse) GetRemoteClusters() map[string]*ClusterReplicationStatus {
+	if m != nil {
+		return m.RemoteClusters
 	}
-	return true
+	return nil
 }
-func (this *AddSearchAttributesRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *GetReplicationStatusResponse) GetShards() []*ShardReplicationStatus {
+	if m != nil {
+		return m.Shards
 	}
+	return nil
+}
 
-	that1, ok := that.(*AddSearchAttributesRequest)
-	if !ok {
-		that2, ok := that.(AddSearchAttributesRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type ClusterReplicationStatus struct {
+	ShardCount int32 `protobuf:"varint,1,opt,name=shard_count,json=shardCount,proto3" json:"shard_count,omitempty"`
+	// Max over all shards of the time replication tasks wait to be acked by the remote cluster.
+	MaxOutboundLag *time.Duration `protobuf:"bytes,2,opt,name=max_outbound_lag,json=maxOutboundLag,proto3,stdduration" json:"max_outbound_lag,omitempty"`
+	// Max over all shards of the time since the last task applied from the remote cluster was created.
+	MaxInboundLag          *time.Duration `protobuf:"bytes,3,opt,name=max_inbound_lag,json=maxInboundLag,proto3,stdduration" json:"max_inbound_lag,omitempty"`
+	InboundDlqTaskCount    int64          `protobuf:"varint,4,opt,name=inbound_dlq_task_count,json=inboundDlqTaskCount,proto3" json:"inbound_dlq_task_count,omitempty"`
+	RetryingProcessorCount int32          `protobuf:"varint,5,opt,name=retrying_processor_count,json=retryingProcessorCount,proto3" json:"retrying_processor_count,omitempty"`
+	StoppedProcessorCount  int32          `protobuf:"varint,6,opt,name=stopped_processor_count,json=stoppedProcessorCount,proto3" json:"stopped_processor_count,omitempty"`
+}
+
+func (m *ClusterReplicationStatus) Reset()      { *m = ClusterReplicationStatus{} }
+func (*ClusterReplicationStatus) ProtoMessage() {}
+func (*ClusterReplicationStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{81}
+}
+func (m *ClusterReplicationStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterReplicationStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterReplicationStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.SearchAttributes) != len(that1.SearchAttributes) {
-		return false
+}
+func (m *ClusterReplicationStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterReplicationStatus.Merge(m, src)
+}
+func (m *ClusterReplicationStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterReplicationStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterReplicationStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterReplicationStatus proto.InternalMessageInfo
+
+func (m *ClusterReplicationStatus) GetShardCount() int32 {
+	if m != nil {
+		return m.ShardCount
 	}
-	for i := range this.SearchAttributes {
-		if this.SearchAttributes[i] != that1.SearchAttributes[i] {
-			return false
-		}
+	return 0
+}
+
+func (m *ClusterReplicationStatus) GetMaxOutboundLag() *time.Duration {
+	if m != nil {
+		return m.MaxOutboundLag
 	}
-	if this.IndexName != that1.IndexName {
-		return false
+	return nil
+}
+
+func (m *ClusterReplicationStatus) GetMaxInboundLag() *time.Duration {
+	if m != nil {
+		return m.MaxInboundLag
 	}
-	if this.SkipSchemaUpdate != that1.SkipSchemaUpdate {
-		return false
+	return nil
+}
+
+func (m *ClusterReplicationStatus) GetInboundDlqTaskCount() int64 {
+	if m != nil {
+		return m.InboundDlqTaskCount
 	}
-	if this.Namespace != that1.Namespace {
-		return false
+	return 0
+}
+
+func (m *ClusterReplicationStatus) GetRetryingProcessorCount() int32 {
+	if m != nil {
+		return m.RetryingProcessorCount
 	}
-	return true
+	return 0
 }
-func (this *AddSearchAttributesResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ClusterReplicationStatus) GetStoppedProcessorCount() int32 {
+	if m != nil {
+		return m.StoppedProcessorCount
 	}
+	return 0
+}
 
-	that1, ok := that.(*AddSearchAttributesResponse)
-	if !ok {
-		that2, ok := that.(AddSearchAttributesResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type ShardReplicationStatus struct {
+	ShardId int32 `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	// Max replication task id and creation time of this cluster
+	MaxReplicationTaskId             int64      `protobuf:"varint,2,opt,name=max_replication_task_id,json=maxReplicationTaskId,proto3" json:"max_replication_task_id,omitempty"`
+	MaxReplicationTaskVisibilityTime *time.Time `protobuf:"bytes,3,opt,name=max_replication_task_visibility_time,json=maxReplicationTaskVisibilityTime,proto3,stdtime" json:"max_replication_task_visibility_time,omitempty"`
+	// Keyed by remote cluster name.
+	RemoteClusters map[string]*ShardClusterReplicationStatus `protobuf:"bytes,4,rep,name=remote_clusters,json=remoteClusters,proto3" json:"remote_clusters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Max replication task id when the namespace moved to handover state, keyed by the name of namespaces
+	// in handover state.
+	HandoverReplicationTaskIds map[string]int64 `protobuf:"bytes,5,rep,name=handover_replication_task_ids,json=handoverReplicationTaskIds,proto3" json:"handover_replication_task_ids,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (m *ShardReplicationStatus) Reset()      { *m = ShardReplicationStatus{} }
+func (*ShardReplicationStatus) ProtoMessage() {}
+func (*ShardReplicationStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{82}
+}
+func (m *ShardReplicationStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShardReplicationStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShardReplicationStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	return true
 }
-func (this *RemoveSearchAttributesRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *ShardReplicationStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardReplicationStatus.Merge(m, src)
+}
+func (m *ShardReplicationStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShardReplicationStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardReplicationStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardReplicationStatus proto.InternalMessageInfo
+
+func (m *ShardReplicationStatus) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
 	}
+	return 0
+}
 
-	that1, ok := that.(*RemoveSearchAttributesRequest)
-	if !ok {
-		that2, ok := that.(RemoveSearchAttributesRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *ShardReplicationStatus) GetMaxReplicationTaskId() int64 {
+	if m != nil {
+		return m.MaxReplicationTaskId
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return 0
+}
+
+func (m *ShardReplicationStatus) GetMaxReplicationTaskVisibilityTime() *time.Time {
+	if m != nil {
+		return m.MaxReplicationTaskVisibilityTime
 	}
-	if len(this.SearchAttributes) != len(that1.SearchAttributes) {
-		return false
+	return nil
+}
+
+func (m *ShardReplicationStatus) GetRemoteClusters() map[string]*ShardClusterReplicationStatus {
+	if m != nil {
+		return m.RemoteClusters
 	}
-	for i := range this.SearchAttributes {
-		if this.SearchAttributes[i] != that1.SearchAttributes[i] {
-			return false
+	return nil
+}
+
+func (m *ShardReplicationStatus) GetHandoverReplicationTaskIds() map[string]int64 {
+	if m != nil {
+		return m.HandoverReplicationTaskIds
+	}
+	return nil
+}
+
+type ShardClusterReplicationStatus struct {
+	// Replication from this cluster to the remote cluster: the last task acked by the remote cluster, and
+	// an upper bound of the time tasks wait to be acked, zero if all tasks are acked.
+	AckedTaskId             int64          `protobuf:"varint,1,opt,name=acked_task_id,json=ackedTaskId,proto3" json:"acked_task_id,omitempty"`
+	AckedTaskVisibilityTime *time.Time     `protobuf:"bytes,2,opt,name=acked_task_visibility_time,json=ackedTaskVisibilityTime,proto3,stdtime" json:"acked_task_visibility_time,omitempty"`
+	OutboundLag             *time.Duration `protobuf:"bytes,3,opt,name=outbound_lag,json=outboundLag,proto3,stdduration" json:"outbound_lag,omitempty"`
+	// Replication from the remote cluster to this cluster.
+	Inbound *v16.ShardReplicationInboundStatus `protobuf:"bytes,4,opt,name=inbound,proto3" json:"inbound,omitempty"`
+}
+
+func (m *ShardClusterReplicationStatus) Reset()      { *m = ShardClusterReplicationStatus{} }
+func (*ShardClusterReplicationStatus) ProtoMessage() {}
+func (*ShardClusterReplicationStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{83}
+}
+func (m *ShardClusterReplicationStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShardClusterReplicationStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShardClusterReplicationStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if this.IndexName != that1.IndexName {
-		return false
+}
+func (m *ShardClusterReplicationStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardClusterReplicationStatus.Merge(m, src)
+}
+func (m *ShardClusterReplicationStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShardClusterReplicationStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardClusterReplicationStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardClusterReplicationStatus proto.InternalMessageInfo
+
+func (m *ShardClusterReplicationStatus) GetAckedTaskId() int64 {
+	if m != nil {
+		return m.AckedTaskId
 	}
-	if this.Namespace != that1.Namespace {
-		return false
+	return 0
+}
+
+func (m *ShardClusterReplicationStatus) GetAckedTaskVisibilityTime() *time.Time {
+	if m != nil {
+		return m.AckedTaskVisibilityTime
 	}
-	return true
+	return nil
 }
-func (this *RemoveSearchAttributesResponse) Equal(that interface{}) bool {
+
+func (m *ShardClusterReplicationStatus) GetOutboundLag() *time.Duration {
+	if m != nil {
+		return m.OutboundLag
+	}
+	return nil
+}
+
+func (m *ShardClusterReplicationStatus) GetInbound() *v16.ShardReplicationInboundStatus {
+	if m != nil {
+		return m.Inbound
+	}
+	return nil
+}
+
+type SetPersistenceFaultRequest struct {
+	// The fault to add. A fault with the same id is replaced, a new id is generated if empty.
+	// expire_time is ignored, the fault expires after ttl.
+	Fault *v11.PersistenceFault `protobuf:"bytes,1,opt,name=fault,proto3" json:"fault,omitempty"`
+	Ttl   *time.Duration        `protobuf:"bytes,2,opt,name=ttl,proto3,stdduration" json:"ttl,omitempty"`
+}
+
+func (m *SetPersistenceFaultRequest) Reset()      { *m = SetPersistenceFaultRequest{} }
+func (*SetPersistenceFaultRequest) ProtoMessage() {}
+func (*SetPersistenceFaultRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{84}
+}
+func (m *SetPersistenceFaultRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetPersistenceFaultRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetPersistenceFaultRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetPersistenceFaultRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetPersistenceFaultRequest.Merge(m, src)
+}
+func (m *SetPersistenceFaultRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetPersistenceFaultRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetPersistenceFaultRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetPersistenceFaultRequest proto.InternalMessageInfo
+
+func (m *SetPersistenceFaultRequest) GetFault() *v11.PersistenceFault {
+	if m != nil {
+		return m.Fault
+	}
+	return nil
+}
+
+func (m *SetPersistenceFaultRequest) GetTtl() *time.Duration {
+	if m != nil {
+		return m.Ttl
+	}
+	return nil
+}
+
+type SetPersistenceFaultResponse struct {
+	Fault *v11.PersistenceFault `protobuf:"bytes,1,opt,name=fault,proto3" json:"fault,omitempty"`
+}
+
+func (m *SetPersistenceFaultResponse) Reset()      { *m = SetPersistenceFaultResponse{} }
+func (*SetPersistenceFaultResponse) ProtoMessage() {}
+func (*SetPersistenceFaultResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{85}
+}
+func (m *SetPersistenceFaultResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetPersistenceFaultResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetPersistenceFaultResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetPersistenceFaultResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetPersistenceFaultResponse.Merge(m, src)
+}
+func (m *SetPersistenceFaultResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetPersistenceFaultResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetPersistenceFaultResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetPersistenceFaultResponse proto.InternalMessageInfo
+
+func (m *SetPersistenceFaultResponse) GetFault() *v11.PersistenceFault {
+	if m != nil {
+		return m.Fault
+	}
+	return nil
+}
+
+type RemovePersistenceFaultRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *RemovePersistenceFaultRequest) Reset()      { *m = RemovePersistenceFaultRequest{} }
+func (*RemovePersistenceFaultRequest) ProtoMessage() {}
+func (*RemovePersistenceFaultRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{86}
+}
+func (m *RemovePersistenceFaultRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemovePersistenceFaultRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemovePersistenceFaultRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemovePersistenceFaultRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemovePersistenceFaultRequest.Merge(m, src)
+}
+func (m *RemovePersistenceFaultRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemovePersistenceFaultRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemovePersistenceFaultRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemovePersistenceFaultRequest proto.InternalMessageInfo
+
+func (m *RemovePersistenceFaultRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type RemovePersistenceFaultResponse struct {
+}
+
+func (m *RemovePersistenceFaultResponse) Reset()      { *m = RemovePersistenceFaultResponse{} }
+func (*RemovePersistenceFaultResponse) ProtoMessage() {}
+func (*RemovePersistenceFaultResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{87}
+}
+func (m *RemovePersistenceFaultResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemovePersistenceFaultResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemovePersistenceFaultResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemovePersistenceFaultResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemovePersistenceFaultResponse.Merge(m, src)
+}
+func (m *RemovePersistenceFaultResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemovePersistenceFaultResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemovePersistenceFaultResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemovePersistenceFaultResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*RebuildMutableStateRequest)(nil), "temporal.server.api.adminservice.v1.RebuildMutableStateRequest")
+	proto.RegisterType((*RebuildMutableStateResponse)(nil), "temporal.server.api.adminservice.v1.RebuildMutableStateResponse")
+	proto.RegisterType((*DescribeMutableStateRequest)(nil), "temporal.server.api.adminservice.v1.DescribeMutableStateRequest")
+	proto.RegisterType((*DescribeMutableStateResponse)(nil), "temporal.server.api.adminservice.v1.DescribeMutableStateResponse")
+	proto.RegisterType((*DescribeHistoryHostRequest)(nil), "temporal.server.api.adminservice.v1.DescribeHistoryHostRequest")
+	proto.RegisterType((*DescribeHistoryHostResponse)(nil), "temporal.server.api.adminservice.v1.DescribeHistoryHostResponse")
+	proto.RegisterType((*CloseShardRequest)(nil), "temporal.server.api.adminservice.v1.CloseShardRequest")
+	proto.RegisterType((*CloseShardResponse)(nil), "temporal.server.api.adminservice.v1.CloseShardResponse")
+	proto.RegisterType((*GetShardRequest)(nil), "temporal.server.api.adminservice.v1.GetShardRequest")
+	proto.RegisterType((*GetShardResponse)(nil), "temporal.server.api.adminservice.v1.GetShardResponse")
+	proto.RegisterType((*ListHistoryTasksRequest)(nil), "temporal.server.api.adminservice.v1.ListHistoryTasksRequest")
+	proto.RegisterType((*ListHistoryTasksResponse)(nil), "temporal.server.api.adminservice.v1.ListHistoryTasksResponse")
+	proto.RegisterType((*Task)(nil), "temporal.server.api.adminservice.v1.Task")
+	proto.RegisterType((*RemoveTaskRequest)(nil), "temporal.server.api.adminservice.v1.RemoveTaskRequest")
+	proto.RegisterType((*RemoveTaskResponse)(nil), "temporal.server.api.adminservice.v1.RemoveTaskResponse")
+	proto.RegisterType((*GetWorkflowExecutionRawHistoryV2Request)(nil), "temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request")
+	proto.RegisterType((*GetWorkflowExecutionRawHistoryV2Response)(nil), "temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response")
+	proto.RegisterType((*GetReplicationMessagesRequest)(nil), "temporal.server.api.adminservice.v1.GetReplicationMessagesRequest")
+	proto.RegisterType((*GetReplicationMessagesResponse)(nil), "temporal.server.api.adminservice.v1.GetReplicationMessagesResponse")
+	proto.RegisterMapType((map[int32]*v16.ReplicationMessages)(nil), "temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry")
+	proto.RegisterType((*GetNamespaceReplicationMessagesRequest)(nil), "temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesRequest")
+	proto.RegisterType((*GetNamespaceReplicationMessagesResponse)(nil), "temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse")
+	proto.RegisterType((*GetDLQReplicationMessagesRequest)(nil), "temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest")
+	proto.RegisterType((*GetDLQReplicationMessagesResponse)(nil), "temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse")
+	proto.RegisterType((*ReapplyEventsRequest)(nil), "temporal.server.api.adminservice.v1.ReapplyEventsRequest")
+	proto.RegisterType((*ReapplyEventsResponse)(nil), "temporal.server.api.adminservice.v1.ReapplyEventsResponse")
+	proto.RegisterType((*AddSearchAttributesRequest)(nil), "temporal.server.api.adminservice.v1.AddSearchAttributesRequest")
+	proto.RegisterMapType((map[string]v17.IndexedValueType)(nil), "temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry")
+	proto.RegisterType((*AddSearchAttributesResponse)(nil), "temporal.server.api.adminservice.v1.AddSearchAttributesResponse")
+	proto.RegisterType((*RemoveSearchAttributesRequest)(nil), "temporal.server.api.adminservice.v1.RemoveSearchAttributesRequest")
+	proto.RegisterType((*RemoveSearchAttributesResponse)(nil), "temporal.server.api.adminservice.v1.RemoveSearchAttributesResponse")
+	proto.RegisterType((*GetSearchAttributesRequest)(nil), "temporal.server.api.adminservice.v1.GetSearchAttributesRequest")
+	proto.RegisterType((*GetSearchAttributesResponse)(nil), "temporal.server.api.adminservice.v1.GetSearchAttributesResponse")
+	proto.RegisterMapType((map[string]v17.IndexedValueType)(nil), "temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry")
+	proto.RegisterMapType((map[string]string)(nil), "temporal.server.api.adminservice.v1.GetSearchAttributesResponse.MappingEntry")
+	proto.RegisterMapType((map[string]v17.IndexedValueType)(nil), "temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry")
+	proto.RegisterType((*DescribeClusterRequest)(nil), "temporal.server.api.adminservice.v1.DescribeClusterRequest")
+	proto.RegisterType((*DescribeClusterResponse)(nil), "temporal.server.api.adminservice.v1.DescribeClusterResponse")
+	proto.RegisterMapType((map[string]string)(nil), "temporal.server.api.adminservice.v1.DescribeClusterResponse.SupportedClientsEntry")
+	proto.RegisterType((*ListClustersRequest)(nil), "temporal.server.api.adminservice.v1.ListClustersRequest")
+	proto.RegisterType((*ListClustersResponse)(nil), "temporal.server.api.adminservice.v1.ListClustersResponse")
+	proto.RegisterType((*AddOrUpdateRemoteClusterRequest)(nil), "temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterRequest")
+	proto.RegisterType((*AddOrUpdateRemoteClusterResponse)(nil), "temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterResponse")
+	proto.RegisterType((*RemoveRemoteClusterRequest)(nil), "temporal.server.api.adminservice.v1.RemoveRemoteClusterRequest")
+	proto.RegisterType((*RemoveRemoteClusterResponse)(nil), "temporal.server.api.adminservice.v1.RemoveRemoteClusterResponse")
+	proto.RegisterType((*ListClusterMembersRequest)(nil), "temporal.server.api.adminservice.v1.ListClusterMembersRequest")
+	proto.RegisterType((*ListClusterMembersResponse)(nil), "temporal.server.api.adminservice.v1.ListClusterMembersResponse")
+	proto.RegisterType((*GetDLQMessagesRequest)(nil), "temporal.server.api.adminservice.v1.GetDLQMessagesRequest")
+	proto.RegisterType((*GetDLQMessagesResponse)(nil), "temporal.server.api.adminservice.v1.GetDLQMessagesResponse")
+	proto.RegisterType((*PurgeDLQMessagesRequest)(nil), "temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest")
+	proto.RegisterType((*PurgeDLQMessagesResponse)(nil), "temporal.server.api.adminservice.v1.PurgeDLQMessagesResponse")
+	proto.RegisterType((*MergeDLQMessagesRequest)(nil), "temporal.server.api.adminservice.v1.MergeDLQMessagesRequest")
+	proto.RegisterType((*MergeDLQMessagesResponse)(nil), "temporal.server.api.adminservice.v1.MergeDLQMessagesResponse")
+	proto.RegisterType((*RefreshWorkflowTasksRequest)(nil), "temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest")
+	proto.RegisterType((*RefreshWorkflowTasksResponse)(nil), "temporal.server.api.adminservice.v1.RefreshWorkflowTasksResponse")
+	proto.RegisterType((*ResendReplicationTasksRequest)(nil), "temporal.server.api.adminservice.v1.ResendReplicationTasksRequest")
+	proto.RegisterType((*ResendReplicationTasksResponse)(nil), "temporal.server.api.adminservice.v1.ResendReplicationTasksResponse")
+	proto.RegisterType((*GetTaskQueueTasksRequest)(nil), "temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest")
+	proto.RegisterType((*GetTaskQueueTasksResponse)(nil), "temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse")
+	proto.RegisterType((*DeleteWorkflowExecutionRequest)(nil), "temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest")
+	proto.RegisterType((*DeleteWorkflowExecutionResponse)(nil), "temporal.server.api.adminservice.v1.DeleteWorkflowExecutionResponse")
+	proto.RegisterType((*ResetWorkflowExecutionsRequest)(nil), "temporal.server.api.adminservice.v1.ResetWorkflowExecutionsRequest")
+	proto.RegisterType((*ResetWorkflowExecutionsResponse)(nil), "temporal.server.api.adminservice.v1.ResetWorkflowExecutionsResponse")
+	proto.RegisterType((*PauseWorkflowExecutionRequest)(nil), "temporal.server.api.adminservice.v1.PauseWorkflowExecutionRequest")
+	proto.RegisterType((*PauseWorkflowExecutionResponse)(nil), "temporal.server.api.adminservice.v1.PauseWorkflowExecutionResponse")
+	proto.RegisterType((*UnpauseWorkflowExecutionRequest)(nil), "temporal.server.api.adminservice.v1.UnpauseWorkflowExecutionRequest")
+	proto.RegisterType((*UnpauseWorkflowExecutionResponse)(nil), "temporal.server.api.adminservice.v1.UnpauseWorkflowExecutionResponse")
+	proto.RegisterType((*PauseActivityExecutionRequest)(nil), "temporal.server.api.adminservice.v1.PauseActivityExecutionRequest")
+	proto.RegisterType((*PauseActivityExecutionResponse)(nil), "temporal.server.api.adminservice.v1.PauseActivityExecutionResponse")
+	proto.RegisterType((*UnpauseActivityExecutionRequest)(nil), "temporal.server.api.adminservice.v1.UnpauseActivityExecutionRequest")
+	proto.RegisterType((*UnpauseActivityExecutionResponse)(nil), "temporal.server.api.adminservice.v1.UnpauseActivityExecutionResponse")
+	proto.RegisterType((*ResetActivityExecutionRequest)(nil), "temporal.server.api.adminservice.v1.ResetActivityExecutionRequest")
+	proto.RegisterType((*ResetActivityExecutionResponse)(nil), "temporal.server.api.adminservice.v1.ResetActivityExecutionResponse")
+	proto.RegisterType((*UpdateActivityExecutionOptionsRequest)(nil), "temporal.server.api.adminservice.v1.UpdateActivityExecutionOptionsRequest")
+	proto.RegisterType((*UpdateActivityExecutionOptionsResponse)(nil), "temporal.server.api.adminservice.v1.UpdateActivityExecutionOptionsResponse")
+	proto.RegisterType((*ListAuditRecordsRequest)(nil), "temporal.server.api.adminservice.v1.ListAuditRecordsRequest")
+	proto.RegisterType((*ListAuditRecordsResponse)(nil), "temporal.server.api.adminservice.v1.ListAuditRecordsResponse")
+	proto.RegisterType((*CreateNamespaceApiKeyRequest)(nil), "temporal.server.api.adminservice.v1.CreateNamespaceApiKeyRequest")
+	proto.RegisterType((*CreateNamespaceApiKeyResponse)(nil), "temporal.server.api.adminservice.v1.CreateNamespaceApiKeyResponse")
+	proto.RegisterType((*ListNamespaceApiKeysRequest)(nil), "temporal.server.api.adminservice.v1.ListNamespaceApiKeysRequest")
+	proto.RegisterType((*ListNamespaceApiKeysResponse)(nil), "temporal.server.api.adminservice.v1.ListNamespaceApiKeysResponse")
+	proto.RegisterType((*RotateNamespaceApiKeyRequest)(nil), "temporal.server.api.adminservice.v1.RotateNamespaceApiKeyRequest")
+	proto.RegisterType((*RotateNamespaceApiKeyResponse)(nil), "temporal.server.api.adminservice.v1.RotateNamespaceApiKeyResponse")
+	proto.RegisterType((*RevokeNamespaceApiKeyRequest)(nil), "temporal.server.api.adminservice.v1.RevokeNamespaceApiKeyRequest")
+	proto.RegisterType((*RevokeNamespaceApiKeyResponse)(nil), "temporal.server.api.adminservice.v1.RevokeNamespaceApiKeyResponse")
+	proto.RegisterType((*GetReplicationStatusRequest)(nil), "temporal.server.api.adminservice.v1.GetReplicationStatusRequest")
+	proto.RegisterType((*GetReplicationStatusResponse)(nil), "temporal.server.api.adminservice.v1.GetReplicationStatusResponse")
+	proto.RegisterMapType((map[string]*ClusterReplicationStatus)(nil), "temporal.server.api.adminservice.v1.GetReplicationStatusResponse.RemoteClustersEntry")
+	proto.RegisterType((*ClusterReplicationStatus)(nil), "temporal.server.api.adminservice.v1.ClusterReplicationStatus")
+	proto.RegisterType((*ShardReplicationStatus)(nil), "temporal.server.api.adminservice.v1.ShardReplicationStatus")
+	proto.RegisterMapType((map[string]int64)(nil), "temporal.server.api.adminservice.v1.ShardReplicationStatus.HandoverReplicationTaskIdsEntry")
+	proto.RegisterMapType((map[string]*ShardClusterReplicationStatus)(nil), "temporal.server.api.adminservice.v1.ShardReplicationStatus.RemoteClustersEntry")
+	proto.RegisterType((*ShardClusterReplicationStatus)(nil), "temporal.server.api.adminservice.v1.ShardClusterReplicationStatus")
+	proto.RegisterType((*SetPersistenceFaultRequest)(nil), "temporal.server.api.adminservice.v1.SetPersistenceFaultRequest")
+	proto.RegisterType((*SetPersistenceFaultResponse)(nil), "temporal.server.api.adminservice.v1.SetPersistenceFaultResponse")
+	proto.RegisterType((*RemovePersistenceFaultRequest)(nil), "temporal.server.api.adminservice.v1.RemovePersistenceFaultRequest")
+	proto.RegisterType((*RemovePersistenceFaultResponse)(nil), "temporal.server.api.adminservice.v1.RemovePersistenceFaultResponse")
+}
+
+func init() {
+	proto.RegisterFile("temporal/server/api/adminservice/v1/request_response.proto", fileDescriptor_cc07c1a2abe7cb51)
+}
+
+var fileDescriptor_cc07c1a2abe7cb51 = []byte{
+	// 4189 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3c, 0x4d, 0x6c, 0x1c, 0xd7,
+	0x79, 0x9a, 0xfd, 0xdf, 0x8f, 0xe4, 0x92, 0x1c, 0x89, 0xe2, 0x6a, 0x29, 0x2e, 0xe9, 0x8d, 0x2c,
+	0x4b, 0xae, 0xbd, 0x8c, 0xe4, 0x26, 0x51, 0xac, 0x0a, 0x06, 0x49, 0xc9, 0x14, 0x1d, 0xd1, 0x52,
+	0x66, 0x65, 0x39, 0x4d, 0x60, 0x4c, 0x86, 0x3b, 0x8f, 0xcb, 0x01, 0x77, 0x67, 0x46, 0xf3, 0xde,
+	0x52, 0x5c, 0x03, 0x4d, 0x83, 0xba, 0x69, 0xd1, 0x43, 0x50, 0x03, 0x45, 0xd1, 0xc0, 0x05, 0x8a,
+	0x1e, 0x7a, 0xe8, 0xa1, 0x45, 0x0f, 0x06, 0x7a, 0xe8, 0xad, 0x08, 0x0a, 0xf4, 0x54, 0x18, 0xed,
+	0x25, 0x68, 0x0e, 0xad, 0xe5, 0x4b, 0x7b, 0xcb, 0x29, 0x87, 0x1e, 0x8a, 0xe2, 0xfd, 0xcd, 0xdf,
+	0xce, 0x2c, 0x87, 0x16, 0x2d, 0xa7, 0x39, 0x69, 0xe7, 0xbd, 0xef, 0xfb, 0xde, 0xf7, 0xff, 0xbe,
+	0xf7, 0xbd, 0x47, 0xc1, 0xeb, 0x04, 0x0d, 0x5c, 0xc7, 0x33, 0xfa, 0x6b, 0x18, 0x79, 0x87, 0xc8,
+	0x5b, 0x33, 0x5c, 0x6b, 0xcd, 0x30, 0x07, 0x96, 0x4d, 0xbf, 0xad, 0x2e, 0x5a, 0x3b, 0xbc, 0xb6,
+	0xe6, 0xa1, 0xc7, 0x43, 0x84, 0x89, 0xee, 0x21, 0xec, 0x3a, 0x36, 0x46, 0x6d, 0xd7, 0x73, 0x88,
+	0xa3, 0x7e, 0x45, 0xe2, 0xb6, 0x39, 0x6e, 0xdb, 0x70, 0xad, 0x76, 0x18, 0xb7, 0x7d, 0x78, 0xad,
+	0xb1, 0xd2, 0x73, 0x9c, 0x5e, 0x1f, 0xad, 0x31, 0x94, 0xdd, 0xe1, 0xde, 0x1a, 0xb1, 0x06, 0x08,
+	0x13, 0x63, 0xe0, 0x72, 0x2a, 0x8d, 0x66, 0x1c, 0xc0, 0x1c, 0x7a, 0x06, 0xb1, 0x1c, 0x5b, 0xcc,
+	0xbf, 0x60, 0x22, 0x17, 0xd9, 0x26, 0xb2, 0xbb, 0x16, 0xc2, 0x6b, 0x3d, 0xa7, 0xe7, 0xb0, 0x71,
+	0xf6, 0x4b, 0x80, 0xb4, 0x7c, 0x21, 0x28, 0xf7, 0xc8, 0x1e, 0x0e, 0x30, 0x65, 0xbb, 0xeb, 0x0c,
+	0x06, 0x01, 0x99, 0x64, 0x18, 0x0f, 0x61, 0x44, 0x04, 0xc8, 0xe5, 0x64, 0x10, 0x62, 0xe0, 0x03,
+	0xfd, 0xf1, 0x10, 0x0d, 0x85, 0xdc, 0x8d, 0x4b, 0x11, 0x38, 0xbe, 0x0a, 0x05, 0x1c, 0x20, 0x8c,
+	0x8d, 0x9e, 0x84, 0x7a, 0x31, 0x02, 0x75, 0x88, 0x3c, 0x6c, 0x25, 0x81, 0x45, 0x17, 0x7d, 0xe2,
+	0x78, 0x07, 0x7b, 0x7d, 0xe7, 0xc9, 0x38, 0xdc, 0x2b, 0x49, 0x86, 0xea, 0xf6, 0x87, 0x98, 0x20,
+	0x6f, 0x1c, 0xfa, 0x6a, 0x12, 0x74, 0xb2, 0x62, 0x5e, 0x9e, 0x0c, 0xca, 0x57, 0x10, 0xb0, 0x2f,
+	0x4d, 0x84, 0xa5, 0x8a, 0x9a, 0xc4, 0xed, 0xbe, 0x85, 0x89, 0xe3, 0x8d, 0xc6, 0xb9, 0x6d, 0x27,
+	0x41, 0xdb, 0xc6, 0x00, 0x61, 0xd7, 0xe8, 0xa2, 0x71, 0xf8, 0xaf, 0x26, 0xc1, 0x7b, 0xc8, 0xed,
+	0x5b, 0x5d, 0xe6, 0x39, 0x19, 0x57, 0x70, 0xa9, 0x4d, 0x30, 0x41, 0x36, 0x5f, 0xc3, 0x18, 0x9a,
+	0x96, 0x74, 0x85, 0xd7, 0x32, 0xc0, 0xfb, 0x0c, 0x62, 0x81, 0xf4, 0xcd, 0x0c, 0x48, 0x42, 0x9f,
+	0xfa, 0x00, 0x11, 0xc3, 0x34, 0x88, 0x71, 0x82, 0xf5, 0xd0, 0x11, 0xea, 0x0e, 0xa9, 0x78, 0x72,
+	0xbd, 0x37, 0x32, 0x20, 0x49, 0x87, 0xd2, 0x07, 0x43, 0x62, 0xec, 0xf6, 0x91, 0x8e, 0x89, 0x41,
+	0x4e, 0xa2, 0x15, 0x6a, 0x54, 0xb9, 0xe0, 0xab, 0x49, 0xf0, 0xa9, 0x2e, 0xdb, 0xfa, 0x40, 0x81,
+	0x86, 0x86, 0x76, 0x87, 0x56, 0xdf, 0xdc, 0xe1, 0xab, 0x77, 0xe8, 0xe2, 0x1a, 0xcf, 0x26, 0xea,
+	0x45, 0xa8, 0xfa, 0x2a, 0xac, 0x2b, 0xab, 0xca, 0x95, 0xaa, 0x16, 0x0c, 0xa8, 0x5b, 0x50, 0xf5,
+	0x05, 0xae, 0xe7, 0x56, 0x95, 0x2b, 0x53, 0xd7, 0xaf, 0xfa, 0xfc, 0xb2, 0x4c, 0x23, 0xbc, 0xf8,
+	0xf0, 0x5a, 0xfb, 0x5d, 0xc1, 0xc2, 0x1d, 0x89, 0xa0, 0x05, 0xb8, 0xad, 0x65, 0x58, 0x4a, 0x64,
+	0x82, 0xa7, 0xb2, 0xd6, 0xef, 0x2b, 0xb0, 0x74, 0x1b, 0xe1, 0xae, 0x67, 0xed, 0xa2, 0x2f, 0x91,
+	0xcb, 0xbf, 0xcf, 0xc1, 0xc5, 0x64, 0x36, 0x38, 0x9f, 0xea, 0x05, 0xa8, 0xe0, 0x7d, 0xc3, 0x33,
+	0x75, 0xcb, 0x14, 0x6c, 0x94, 0xd9, 0xf7, 0xb6, 0xa9, 0xbe, 0x00, 0xd3, 0x22, 0xb4, 0x74, 0xc3,
+	0x34, 0x3d, 0xc6, 0x47, 0x55, 0x9b, 0x12, 0x63, 0xeb, 0xa6, 0xe9, 0xa9, 0xfb, 0x70, 0xb6, 0x6b,
+	0x74, 0xf7, 0x51, 0xd4, 0x0d, 0xea, 0x79, 0xc6, 0xf1, 0x8d, 0x76, 0x52, 0x22, 0x0f, 0xf9, 0x41,
+	0x98, 0xfb, 0x08, 0x73, 0xf3, 0x8c, 0x68, 0x78, 0x48, 0xb5, 0xe1, 0x3c, 0xf5, 0xeb, 0x5d, 0x03,
+	0xc7, 0x17, 0x2b, 0x3c, 0xe3, 0x62, 0xe7, 0x24, 0xdd, 0xf0, 0x68, 0xeb, 0x5f, 0x15, 0x68, 0x48,
+	0xc5, 0xdd, 0xe5, 0x12, 0xdf, 0x75, 0x30, 0x91, 0xe6, 0xa3, 0xba, 0x71, 0x30, 0x61, 0x8a, 0x41,
+	0x18, 0x0b, 0xd5, 0x4d, 0xd1, 0xb1, 0x75, 0x3e, 0x14, 0xd1, 0x2c, 0x55, 0x5d, 0x31, 0xd0, 0x6c,
+	0xc4, 0xf8, 0xf9, 0xb8, 0xf1, 0xbf, 0x03, 0xaa, 0x1f, 0x5e, 0x81, 0x17, 0x14, 0x4e, 0xea, 0x05,
+	0xf3, 0x4f, 0xe2, 0x43, 0xad, 0x8f, 0x73, 0xb0, 0x94, 0x28, 0x94, 0x70, 0x86, 0xaf, 0xc0, 0x0c,
+	0x63, 0x11, 0xeb, 0xf6, 0x70, 0xb0, 0x8b, 0x3c, 0x26, 0x56, 0x51, 0x9b, 0xe6, 0x83, 0x6f, 0xb3,
+	0x31, 0x75, 0x09, 0xaa, 0x52, 0x2e, 0x5c, 0xcf, 0xad, 0xe6, 0xaf, 0x14, 0xb5, 0x8a, 0x10, 0x0c,
+	0xab, 0xef, 0xc1, 0xac, 0x2f, 0x88, 0xce, 0xac, 0x28, 0x9c, 0xe1, 0x37, 0x13, 0xed, 0xe3, 0xc3,
+	0x52, 0x11, 0xde, 0x96, 0x1f, 0x9b, 0x14, 0x6f, 0xdb, 0xde, 0x73, 0xb4, 0x9a, 0x1d, 0x19, 0x53,
+	0xeb, 0x50, 0x96, 0x1a, 0x2f, 0x72, 0x67, 0x15, 0x9f, 0x6a, 0x07, 0xa6, 0xbb, 0xc8, 0x23, 0xd6,
+	0x1e, 0xcd, 0xd5, 0x08, 0xd7, 0x4b, 0xab, 0xf9, 0x2b, 0x53, 0xd7, 0xd7, 0x12, 0x57, 0x95, 0x9b,
+	0xcf, 0xe1, 0xb5, 0xf6, 0x66, 0x80, 0xc3, 0x16, 0x8c, 0x10, 0x79, 0xab, 0x50, 0x29, 0xcc, 0x15,
+	0x5b, 0x6d, 0x98, 0xdf, 0xec, 0x3b, 0x18, 0x75, 0xa8, 0x90, 0xd2, 0x01, 0xe2, 0x71, 0x13, 0x58,
+	0xb7, 0x75, 0x0e, 0xd4, 0x30, 0xbc, 0x48, 0x08, 0xaf, 0xc0, 0xec, 0x16, 0x22, 0x59, 0x69, 0x7c,
+	0x1f, 0xe6, 0x02, 0x68, 0x61, 0x9d, 0x7b, 0x00, 0x02, 0xdc, 0xde, 0x73, 0x18, 0xc2, 0xd4, 0xf5,
+	0x57, 0xb3, 0xb8, 0x3d, 0x23, 0xc3, 0xc4, 0xab, 0x62, 0xf9, 0xb3, 0xf5, 0xe3, 0x1c, 0x2c, 0xde,
+	0xb3, 0x30, 0x11, 0x7e, 0xf0, 0x90, 0xe6, 0xe3, 0xe3, 0x19, 0x53, 0xdf, 0x84, 0x0a, 0xd5, 0x4d,
+	0xcf, 0xf1, 0x46, 0xcc, 0xab, 0x6b, 0xd7, 0x5f, 0x4e, 0x64, 0x81, 0xed, 0xde, 0x74, 0x71, 0x4a,
+	0x78, 0x53, 0x60, 0x68, 0x3e, 0xae, 0x7a, 0x17, 0x80, 0x15, 0x40, 0x9e, 0x61, 0xf7, 0xa4, 0x8f,
+	0x5c, 0x4d, 0xa4, 0x24, 0xf2, 0x8d, 0xa4, 0xa5, 0x51, 0x04, 0xad, 0x4a, 0xe4, 0x4f, 0x75, 0x19,
+	0x60, 0xd7, 0x20, 0xdd, 0x7d, 0x1d, 0x5b, 0xef, 0xf3, 0x6c, 0x50, 0xd4, 0xaa, 0x6c, 0xa4, 0x63,
+	0xbd, 0x8f, 0xd4, 0xcb, 0x30, 0x6b, 0xa3, 0x23, 0xa2, 0xbb, 0x46, 0x0f, 0xe9, 0xc4, 0x39, 0x40,
+	0x36, 0x73, 0x9d, 0x69, 0x6d, 0x86, 0x0e, 0x3f, 0x30, 0x7a, 0xe8, 0x21, 0x1d, 0xa4, 0xbb, 0x4a,
+	0x7d, 0x5c, 0x1f, 0x42, 0xf5, 0x6f, 0x40, 0x91, 0x2e, 0x48, 0xe3, 0x3c, 0x9f, 0xca, 0x68, 0xac,
+	0x44, 0xe5, 0xdc, 0x72, 0xbc, 0x24, 0x2e, 0x72, 0x49, 0x5c, 0xfc, 0x24, 0x07, 0x05, 0x8a, 0x47,
+	0x13, 0x4c, 0x10, 0x48, 0x7e, 0x6e, 0x9e, 0xf2, 0xc7, 0xb6, 0x4d, 0x75, 0x05, 0xa6, 0xfc, 0x3c,
+	0x21, 0x72, 0x4c, 0x55, 0x03, 0x39, 0xb4, 0x6d, 0xaa, 0x0b, 0x50, 0xf2, 0x86, 0x36, 0x9d, 0xe3,
+	0x39, 0xa6, 0xe8, 0x0d, 0xed, 0x6d, 0x53, 0x5d, 0x84, 0x32, 0x53, 0xbd, 0x65, 0x32, 0x6d, 0xe5,
+	0xb5, 0x12, 0xfd, 0xdc, 0x36, 0xd5, 0x4d, 0x60, 0x6a, 0xd5, 0xc9, 0xc8, 0x45, 0x4c, 0x49, 0xb5,
+	0xeb, 0x97, 0x8f, 0x37, 0xee, 0xc3, 0x91, 0x8b, 0xb4, 0x0a, 0x11, 0xbf, 0xd4, 0x5b, 0x50, 0xdd,
+	0xb3, 0x3c, 0xa4, 0x13, 0x6b, 0x80, 0xea, 0x25, 0x66, 0xd7, 0x46, 0x9b, 0xd7, 0xe2, 0x6d, 0x59,
+	0x8b, 0xb7, 0x1f, 0xca, 0x62, 0x7d, 0xa3, 0xf0, 0xe1, 0x7f, 0xac, 0x28, 0x5a, 0x85, 0xa2, 0xd0,
+	0x41, 0x1a, 0xe1, 0xa2, 0xa6, 0xad, 0x97, 0x19, 0x73, 0xf2, 0xb3, 0xf5, 0xef, 0x0a, 0xcc, 0x6b,
+	0x68, 0xe0, 0x1c, 0x22, 0xa6, 0xd8, 0xe7, 0xe7, 0xaa, 0x21, 0x7d, 0xe5, 0x23, 0xfa, 0xda, 0x86,
+	0xd9, 0x43, 0x0b, 0x5b, 0xbb, 0x56, 0xdf, 0x22, 0x23, 0x2e, 0x70, 0x21, 0xa3, 0xc0, 0xb5, 0x00,
+	0x91, 0x4e, 0xd1, 0x9c, 0x11, 0x96, 0x4d, 0xe4, 0x8c, 0x3f, 0xc9, 0xc3, 0x4b, 0x5b, 0x88, 0x8c,
+	0xe7, 0x76, 0xe3, 0x89, 0x70, 0xd3, 0x47, 0xd7, 0x43, 0x3b, 0x52, 0xc4, 0x61, 0xaa, 0xe3, 0x0e,
+	0x73, 0x5a, 0x55, 0x85, 0x7a, 0x09, 0x6a, 0x98, 0x18, 0x1e, 0xd1, 0xd1, 0x21, 0xb2, 0x49, 0xa0,
+	0x98, 0x69, 0x36, 0x7a, 0x87, 0x0e, 0x6e, 0x9b, 0x6a, 0x1b, 0xce, 0x86, 0xa1, 0xa4, 0x59, 0xb9,
+	0xcf, 0xcd, 0x07, 0xa0, 0x8f, 0xf8, 0x84, 0xba, 0x0a, 0xd3, 0xc8, 0x36, 0x03, 0x9a, 0x45, 0x06,
+	0x08, 0xc8, 0x36, 0x25, 0xc5, 0x97, 0x61, 0x3e, 0x80, 0x90, 0xf4, 0x4a, 0x0c, 0x6c, 0x56, 0x82,
+	0x49, 0x6a, 0x2f, 0xc3, 0xfc, 0xc0, 0x38, 0xb2, 0x06, 0xc3, 0x01, 0x0f, 0x3a, 0x96, 0x1d, 0xca,
+	0xcc, 0x43, 0x66, 0xc5, 0x04, 0x0d, 0xbb, 0xb4, 0x1c, 0x51, 0x49, 0x88, 0xce, 0xb7, 0x0a, 0x15,
+	0x65, 0x2e, 0xd7, 0xfa, 0xcb, 0x1c, 0x5c, 0x39, 0xde, 0x2a, 0x22, 0x73, 0x24, 0x90, 0x56, 0x12,
+	0x48, 0x53, 0x5f, 0x92, 0xc5, 0x16, 0xcb, 0x5d, 0x88, 0xef, 0xad, 0x53, 0xd7, 0x57, 0xd3, 0x2c,
+	0x74, 0xdb, 0x20, 0xc6, 0x46, 0xdf, 0xd9, 0xd5, 0x6a, 0x02, 0x71, 0x83, 0xe3, 0xa9, 0xef, 0xc2,
+	0xac, 0xd0, 0x8d, 0x2e, 0x66, 0x44, 0x7e, 0x6d, 0x1f, 0x97, 0x5f, 0x85, 0xee, 0x84, 0x14, 0x5a,
+	0xed, 0x30, 0xf2, 0xad, 0x5e, 0x81, 0x39, 0xc9, 0xa3, 0xed, 0x98, 0x88, 0x15, 0x00, 0x85, 0xd5,
+	0xfc, 0x95, 0xbc, 0xcf, 0xc2, 0xdb, 0x8e, 0x89, 0xb6, 0x4d, 0xdc, 0xfa, 0x50, 0x81, 0xe5, 0x2d,
+	0x44, 0xb4, 0xe0, 0xec, 0xb4, 0xc3, 0x4b, 0x78, 0x7f, 0x8b, 0xb9, 0x07, 0x25, 0xa6, 0x0d, 0x99,
+	0x52, 0x93, 0xeb, 0x83, 0xd0, 0xe1, 0x8b, 0xf2, 0x17, 0xa2, 0xc7, 0xb4, 0xa6, 0x09, 0x1a, 0xd4,
+	0xf9, 0xe5, 0x09, 0x88, 0x3a, 0xbc, 0x2c, 0x55, 0xc5, 0x18, 0x2d, 0x2c, 0x5a, 0x1f, 0xe5, 0xa0,
+	0x99, 0xc6, 0x92, 0xb0, 0xd5, 0xef, 0x40, 0x8d, 0xe7, 0x12, 0x71, 0xde, 0x90, 0xbc, 0x3d, 0xca,
+	0x94, 0xee, 0x27, 0x13, 0xe7, 0x9b, 0xb0, 0x1c, 0xbd, 0x63, 0x13, 0x6f, 0xa4, 0xcd, 0xe0, 0xf0,
+	0x58, 0x63, 0x04, 0xea, 0x38, 0x90, 0x3a, 0x07, 0xf9, 0x03, 0x34, 0x12, 0xb9, 0x8d, 0xfe, 0x54,
+	0x77, 0xa0, 0x78, 0x68, 0xf4, 0x87, 0x48, 0x84, 0xf0, 0x37, 0x4e, 0xa8, 0x39, 0x9f, 0x33, 0x4e,
+	0xe5, 0xf5, 0xdc, 0x0d, 0xa5, 0xf5, 0x8f, 0x0a, 0x5c, 0xde, 0x42, 0xc4, 0xaf, 0xc0, 0x26, 0x18,
+	0xee, 0x9b, 0x70, 0xa1, 0x6f, 0xb0, 0xa6, 0x0d, 0xf1, 0x2c, 0x74, 0x88, 0x7c, 0x6d, 0xc9, 0x0c,
+	0x9c, 0xd7, 0xce, 0x53, 0x00, 0x4d, 0xce, 0x0b, 0x02, 0xdb, 0xa6, 0x8f, 0xea, 0x7a, 0x4e, 0x17,
+	0x61, 0x1c, 0x45, 0xcd, 0x05, 0xa8, 0x0f, 0xe4, 0x7c, 0x80, 0x1a, 0x37, 0x70, 0x7e, 0xdc, 0xc0,
+	0x3f, 0x60, 0xb9, 0x72, 0xb2, 0x08, 0xc2, 0xd0, 0x1d, 0xa8, 0x84, 0x4c, 0xfc, 0x4c, 0x4a, 0xf4,
+	0x09, 0xb5, 0xde, 0x87, 0xd5, 0x2d, 0x44, 0x6e, 0xdf, 0xfb, 0xf6, 0x04, 0xe5, 0x3d, 0x12, 0x55,
+	0x0f, 0xad, 0xe0, 0xa4, 0x77, 0x9d, 0x74, 0x69, 0xba, 0x43, 0xf0, 0x62, 0x8e, 0x88, 0x5f, 0xb8,
+	0xf5, 0x23, 0x05, 0x5e, 0x98, 0xb0, 0xb8, 0x10, 0xfb, 0xfb, 0x30, 0x1f, 0x22, 0xab, 0x87, 0x2b,
+	0x9a, 0xd7, 0x3e, 0x07, 0x13, 0xda, 0x9c, 0x17, 0x1d, 0xc0, 0xad, 0x7f, 0x53, 0xe0, 0x9c, 0x86,
+	0x0c, 0xd7, 0xed, 0x8f, 0x58, 0x32, 0xc6, 0x69, 0xbb, 0x53, 0x61, 0x7c, 0x77, 0x4a, 0x3e, 0xf6,
+	0xe4, 0x9e, 0xfd, 0xd8, 0xa3, 0xde, 0x80, 0x12, 0xdb, 0x32, 0xb0, 0xc8, 0x83, 0xc7, 0xa7, 0x54,
+	0x01, 0x2f, 0x12, 0xfe, 0x22, 0x2c, 0xc4, 0x84, 0x12, 0xfb, 0xf3, 0xff, 0xe4, 0xa0, 0xb1, 0x6e,
+	0x9a, 0x1d, 0x64, 0x78, 0xdd, 0xfd, 0x75, 0x42, 0x3c, 0x6b, 0x77, 0x48, 0x02, 0x6b, 0xff, 0x9e,
+	0x02, 0xf3, 0x98, 0xcd, 0xe9, 0x86, 0x3f, 0x29, 0x14, 0xfe, 0x4e, 0xa6, 0x9c, 0x92, 0x4e, 0xbc,
+	0x1d, 0x1f, 0xe7, 0x29, 0x65, 0x0e, 0xc7, 0x86, 0x69, 0x79, 0x6c, 0xd9, 0x26, 0x3a, 0x0a, 0x27,
+	0xc6, 0x2a, 0x1b, 0xa1, 0xa1, 0xa2, 0xbe, 0x02, 0x2a, 0x3e, 0xb0, 0x5c, 0x1d, 0x77, 0xf7, 0xd1,
+	0xc0, 0xd0, 0x87, 0xae, 0x29, 0x0f, 0xf0, 0x15, 0x6d, 0x8e, 0xce, 0x74, 0xd8, 0xc4, 0x3b, 0x6c,
+	0x3c, 0x7a, 0x70, 0x2d, 0xc4, 0x0e, 0xae, 0x8d, 0x3e, 0x2c, 0x24, 0x72, 0x15, 0xce, 0x61, 0x55,
+	0x9e, 0xc3, 0x6e, 0x85, 0x73, 0x58, 0xed, 0xfa, 0x4b, 0x51, 0x8b, 0xf8, 0x15, 0xd9, 0x36, 0xe5,
+	0x13, 0x99, 0x8f, 0x28, 0x28, 0xab, 0x33, 0x43, 0x39, 0x6b, 0x19, 0x96, 0x12, 0xd5, 0x23, 0x6c,
+	0xf3, 0x47, 0x0a, 0x2c, 0xf3, 0x92, 0x2a, 0xcd, 0x3c, 0xbf, 0x91, 0x66, 0x9d, 0xea, 0xc9, 0xd5,
+	0x38, 0xf1, 0x44, 0xdf, 0x5a, 0x85, 0x66, 0x1a, 0x2b, 0x82, 0xdb, 0xdf, 0x86, 0x06, 0x3d, 0xef,
+	0xa5, 0x70, 0x1a, 0x5d, 0x5c, 0x99, 0xb8, 0x78, 0x2e, 0xbe, 0xf8, 0x47, 0x25, 0x58, 0x4a, 0xa4,
+	0x2d, 0xb2, 0xc2, 0x07, 0x0a, 0xcc, 0x77, 0x87, 0x98, 0x38, 0x83, 0x71, 0x2f, 0xcd, 0xbc, 0xf3,
+	0xa5, 0x51, 0x6f, 0x6f, 0x32, 0xca, 0x63, 0x6e, 0xda, 0x8d, 0x0d, 0x33, 0x2e, 0xf0, 0x08, 0x13,
+	0x14, 0xe1, 0x22, 0x77, 0x4a, 0x5c, 0x74, 0x18, 0xe5, 0xf1, 0x60, 0x89, 0x0d, 0xab, 0x3d, 0x28,
+	0x0f, 0x0c, 0xd7, 0xb5, 0xec, 0x5e, 0x3d, 0xcf, 0x96, 0xde, 0x79, 0xe6, 0xa5, 0x77, 0x38, 0x3d,
+	0xbe, 0xa2, 0xa4, 0xae, 0xda, 0xb0, 0x64, 0x98, 0xa6, 0x3e, 0x9e, 0xf0, 0xf8, 0xe1, 0x9e, 0x1f,
+	0x23, 0xd6, 0xa2, 0x51, 0x21, 0x81, 0x13, 0xf3, 0x1e, 0xdb, 0x11, 0xea, 0x86, 0x69, 0x26, 0xce,
+	0xd0, 0xd0, 0x4c, 0xb4, 0xc4, 0x17, 0x12, 0x9a, 0x2c, 0x11, 0x24, 0x69, 0xfc, 0x8b, 0x59, 0xed,
+	0x75, 0x98, 0x0e, 0x2b, 0x39, 0x61, 0x91, 0x73, 0xe1, 0x45, 0xaa, 0xe1, 0x24, 0x72, 0x13, 0xce,
+	0xcb, 0x86, 0xd8, 0x26, 0xaf, 0x25, 0x42, 0x3b, 0x56, 0xa4, 0xe2, 0x50, 0xc6, 0x2b, 0x8e, 0xcf,
+	0xca, 0xb0, 0x38, 0x86, 0x2d, 0xa2, 0xea, 0x77, 0x61, 0x1e, 0x0f, 0x5d, 0xd7, 0xf1, 0x08, 0x32,
+	0xf5, 0x6e, 0xdf, 0x62, 0xdb, 0x0f, 0x0f, 0x2a, 0x2d, 0x93, 0x4f, 0xa5, 0x10, 0x6e, 0x77, 0x24,
+	0xd5, 0x4d, 0x4e, 0x54, 0xba, 0x72, 0x6c, 0x58, 0x7d, 0x11, 0x6a, 0x9c, 0xba, 0x7f, 0x50, 0xe2,
+	0xc2, 0xcf, 0xf0, 0x51, 0x79, 0x4c, 0x7a, 0x17, 0x66, 0x07, 0x88, 0xf6, 0xf5, 0xf0, 0xbe, 0xe5,
+	0x72, 0xe7, 0x9b, 0x74, 0x58, 0x08, 0xb5, 0xce, 0x76, 0x7c, 0x34, 0xde, 0xaa, 0x1b, 0x44, 0xbe,
+	0x69, 0xce, 0x92, 0xfa, 0xf3, 0xf7, 0xfb, 0xaa, 0x18, 0x49, 0x28, 0xe8, 0x8a, 0x63, 0xea, 0xa5,
+	0xe7, 0x47, 0x79, 0xdc, 0xe0, 0x65, 0x79, 0xd7, 0x19, 0xda, 0x84, 0x9d, 0xf7, 0x8a, 0xda, 0xbc,
+	0x98, 0x62, 0x15, 0xf3, 0x26, 0x9d, 0xa0, 0xf9, 0x3c, 0xd4, 0xf8, 0xd2, 0xe9, 0x34, 0x3f, 0xf1,
+	0x55, 0xb5, 0xb9, 0xd0, 0x44, 0x87, 0x8e, 0xab, 0x57, 0x61, 0x2e, 0x74, 0x76, 0xe7, 0xb0, 0x15,
+	0x06, 0x1b, 0x3a, 0xd3, 0x73, 0xd0, 0x2d, 0x98, 0x96, 0xe7, 0x29, 0xa6, 0x9f, 0x2a, 0xd3, 0xcf,
+	0xa5, 0xa8, 0xa7, 0x0a, 0x88, 0xd0, 0x29, 0x8a, 0x69, 0x65, 0xea, 0x30, 0xf8, 0x50, 0x7f, 0x0b,
+	0x1a, 0x7b, 0x86, 0xd5, 0x77, 0x42, 0x46, 0xd1, 0x2d, 0xbb, 0xeb, 0xa1, 0x01, 0xb2, 0x49, 0x1d,
+	0x58, 0x01, 0x5c, 0x97, 0x10, 0x3e, 0x15, 0x31, 0xaf, 0xde, 0x80, 0xba, 0x65, 0x5b, 0xc4, 0x32,
+	0xfa, 0x7a, 0x9c, 0x4a, 0x7d, 0x8a, 0x17, 0xcf, 0x62, 0xfe, 0xcd, 0x28, 0x09, 0xf5, 0x16, 0x2c,
+	0x59, 0x58, 0xef, 0xf5, 0x9d, 0x5d, 0xa3, 0xaf, 0x07, 0x65, 0x18, 0xb2, 0x69, 0xbb, 0xdb, 0xac,
+	0x4f, 0xb3, 0xcd, 0xbe, 0x6e, 0xe1, 0x2d, 0x06, 0xe1, 0x57, 0xd0, 0x77, 0xf8, 0xfc, 0x58, 0x6b,
+	0x75, 0xe6, 0x14, 0x5a, 0xab, 0x6a, 0x17, 0xd4, 0xb0, 0xb1, 0xf6, 0x8c, 0x61, 0x9f, 0xe0, 0x7a,
+	0x6d, 0xc2, 0x59, 0x30, 0xd6, 0xd4, 0x7c, 0x10, 0x7c, 0xbe, 0x49, 0x91, 0xb5, 0x79, 0x37, 0x36,
+	0x82, 0x1b, 0x9b, 0xb0, 0x90, 0x18, 0x2e, 0x27, 0x4a, 0x11, 0xdf, 0x85, 0xb3, 0xb4, 0x2f, 0x28,
+	0xe2, 0xd0, 0xdf, 0x93, 0x97, 0xa0, 0x1a, 0xf4, 0x15, 0xf8, 0xe9, 0xac, 0xe2, 0x4e, 0x68, 0x28,
+	0x24, 0xb6, 0xfb, 0xfe, 0x58, 0x81, 0x73, 0x51, 0xe2, 0x22, 0x7d, 0xdc, 0x87, 0x8a, 0x50, 0xe5,
+	0xe4, 0x0a, 0x3d, 0xa6, 0x14, 0x41, 0x67, 0x47, 0xdc, 0x02, 0x6a, 0x3e, 0x91, 0xcc, 0x1c, 0xfd,
+	0xa9, 0x02, 0x2b, 0xeb, 0xa6, 0x79, 0xdf, 0xe3, 0x15, 0x1f, 0x2d, 0x5b, 0x48, 0x3c, 0x35, 0x5e,
+	0x85, 0xb9, 0x3d, 0xcf, 0xb1, 0x09, 0xed, 0xc5, 0x44, 0x2f, 0x40, 0x66, 0xe5, 0xb8, 0xbc, 0x04,
+	0xd9, 0x82, 0x55, 0xee, 0x66, 0xba, 0xc7, 0x28, 0xe9, 0x32, 0xe8, 0xbb, 0x8e, 0x6d, 0xa3, 0xae,
+	0x5f, 0xe2, 0x57, 0xb4, 0x65, 0x0e, 0x17, 0x59, 0x70, 0xd3, 0x07, 0x6a, 0xb5, 0x60, 0x35, 0x9d,
+	0x2d, 0x51, 0x44, 0xbd, 0x01, 0x0d, 0x5e, 0x66, 0x25, 0x72, 0x9d, 0x21, 0xa1, 0xb3, 0x3b, 0xbd,
+	0x04, 0x02, 0x41, 0x3b, 0xee, 0x42, 0xc8, 0x5a, 0x22, 0x01, 0x4a, 0xfa, 0x1d, 0x58, 0x60, 0xa7,
+	0xdb, 0x7d, 0x64, 0x78, 0x64, 0x17, 0x19, 0x44, 0x7f, 0x62, 0x91, 0x7d, 0xcb, 0x16, 0x27, 0xcc,
+	0x0b, 0x63, 0x3d, 0xc1, 0xdb, 0xe2, 0x41, 0xc2, 0x46, 0xe1, 0x27, 0xb4, 0x25, 0x78, 0x96, 0x62,
+	0xdf, 0x95, 0xc8, 0xef, 0x32, 0x5c, 0xda, 0xe3, 0xf5, 0xdc, 0xae, 0xaf, 0x65, 0xd1, 0xe3, 0xf5,
+	0xdc, 0xae, 0x54, 0xf0, 0x22, 0x94, 0xd9, 0x45, 0x94, 0xdf, 0xe4, 0x2d, 0xd1, 0x4f, 0xd6, 0xcc,
+	0x2d, 0x78, 0x4e, 0x9f, 0x57, 0xe9, 0xb5, 0x94, 0x68, 0xf5, 0xb7, 0xd7, 0x88, 0x44, 0x9a, 0xd3,
+	0x47, 0x1a, 0x43, 0x56, 0xdf, 0x83, 0x06, 0x46, 0x98, 0x25, 0x2a, 0xd6, 0xaf, 0x43, 0xa6, 0x6e,
+	0xec, 0x51, 0x0d, 0x12, 0x4b, 0xe4, 0xec, 0x2c, 0xcd, 0xce, 0x45, 0x41, 0xa3, 0xc3, 0x49, 0xac,
+	0x53, 0x0a, 0x14, 0x26, 0x1a, 0x43, 0xa5, 0xe3, 0x63, 0xa8, 0x9c, 0xe4, 0xb1, 0x1f, 0x29, 0xd0,
+	0x48, 0xb2, 0x8a, 0x88, 0xa4, 0x87, 0x50, 0x33, 0xba, 0xc4, 0x3a, 0x44, 0xba, 0xd8, 0xa0, 0x44,
+	0x3c, 0xbd, 0x7a, 0x6c, 0xfe, 0x8a, 0xe8, 0x64, 0x86, 0x13, 0x11, 0xd4, 0x33, 0x87, 0xd3, 0xdf,
+	0xe6, 0x60, 0x81, 0x1f, 0xcc, 0xe3, 0xad, 0x80, 0x3b, 0x50, 0x60, 0x7d, 0x76, 0x85, 0xd9, 0xe7,
+	0xda, 0x64, 0xfb, 0xdc, 0x46, 0x86, 0x79, 0x0f, 0x11, 0x82, 0xbc, 0x6f, 0x0f, 0x91, 0xa8, 0x80,
+	0x18, 0xfa, 0xa4, 0x5b, 0x46, 0x5a, 0x01, 0x38, 0x43, 0xaf, 0xeb, 0x07, 0x9d, 0xf0, 0x90, 0x19,
+	0x3e, 0x2a, 0xe4, 0x53, 0xbf, 0x41, 0xf7, 0x15, 0x0a, 0x41, 0x75, 0x44, 0x43, 0x3a, 0xd4, 0x94,
+	0xe1, 0xbd, 0xda, 0x05, 0x7f, 0xfe, 0x8e, 0x1d, 0xea, 0xc9, 0x24, 0x76, 0x58, 0x8b, 0x99, 0x3b,
+	0xac, 0xa5, 0x24, 0x7d, 0xfd, 0xb7, 0x02, 0xe7, 0xe3, 0xfa, 0x12, 0x86, 0x3c, 0x25, 0x85, 0x25,
+	0x36, 0x41, 0x72, 0xa7, 0xd8, 0x04, 0x49, 0x92, 0x35, 0x9f, 0x24, 0xeb, 0xcf, 0x15, 0x58, 0x7c,
+	0x30, 0xf4, 0x7a, 0xe8, 0xd7, 0xd1, 0x3b, 0x5a, 0x0d, 0xa8, 0x8f, 0x0b, 0x27, 0x12, 0xe9, 0xdf,
+	0xe5, 0x60, 0x71, 0x07, 0xfd, 0x9a, 0x4a, 0xfe, 0x85, 0xc4, 0xc5, 0x06, 0xd4, 0x77, 0x50, 0xb2,
+	0x36, 0xb3, 0x5e, 0x31, 0xd0, 0x62, 0x63, 0x49, 0x43, 0x7b, 0x1e, 0xc2, 0xfb, 0xf2, 0x90, 0x18,
+	0xb9, 0xf5, 0x8d, 0xf7, 0xe8, 0xf2, 0x5f, 0xdc, 0x0d, 0x92, 0x68, 0xac, 0x35, 0xe1, 0x62, 0x32,
+	0x43, 0x81, 0x9f, 0x2c, 0x6b, 0x08, 0x23, 0xdb, 0x8c, 0x45, 0x5d, 0x2a, 0xcf, 0xa7, 0x78, 0x4d,
+	0xfa, 0x22, 0xd4, 0xa2, 0x35, 0x8b, 0x38, 0xc4, 0xcc, 0x78, 0xe1, 0xe2, 0x20, 0xe1, 0x2e, 0xac,
+	0x98, 0x70, 0x17, 0x46, 0x5f, 0x56, 0x30, 0xa8, 0xe8, 0xad, 0x15, 0x07, 0x4a, 0xbb, 0x00, 0x2b,
+	0x8f, 0x5d, 0x80, 0xad, 0xc0, 0x14, 0x85, 0x90, 0x44, 0x2a, 0x3e, 0x80, 0x20, 0xc1, 0x3b, 0x4d,
+	0xc9, 0x0a, 0x13, 0x3a, 0xfd, 0x9b, 0x1c, 0xd4, 0xb7, 0x10, 0xa1, 0x83, 0x3c, 0x66, 0xc2, 0xea,
+	0x9c, 0xfc, 0x2a, 0x69, 0x19, 0x20, 0x78, 0xb4, 0x28, 0x1b, 0x4d, 0x44, 0x12, 0x52, 0xef, 0xc1,
+	0x6c, 0x30, 0xcd, 0x2f, 0x91, 0xf3, 0x2c, 0x88, 0x2f, 0xa5, 0x1c, 0xea, 0x03, 0x1e, 0x68, 0xdc,
+	0xce, 0x90, 0xf0, 0xa7, 0xda, 0x84, 0xa9, 0x81, 0xc5, 0xf3, 0x73, 0x10, 0x71, 0xd5, 0x81, 0xc5,
+	0xfb, 0xdf, 0x26, 0x9b, 0x37, 0x8e, 0xfc, 0xf9, 0xa2, 0x98, 0x37, 0x8e, 0xc4, 0x7c, 0xf4, 0x59,
+	0x40, 0x29, 0xc3, 0xb3, 0x80, 0xc4, 0xea, 0xe2, 0x43, 0x05, 0x2e, 0x24, 0xa8, 0x4b, 0x84, 0xde,
+	0xb7, 0xa2, 0xef, 0x02, 0xbe, 0x96, 0xa5, 0x46, 0x5f, 0xef, 0xf7, 0x9d, 0xae, 0x41, 0x90, 0xe9,
+	0x37, 0xf2, 0x4f, 0xf8, 0x46, 0xe0, 0x0f, 0x15, 0x68, 0xde, 0x46, 0x7d, 0x44, 0xd0, 0x78, 0x88,
+	0x3d, 0xdf, 0xd7, 0x65, 0xb7, 0x60, 0x25, 0x95, 0x11, 0xa1, 0xa1, 0x06, 0x54, 0x9e, 0x18, 0x9e,
+	0x6d, 0xd9, 0x3d, 0xd9, 0x5b, 0xf5, 0xbf, 0x5b, 0xff, 0x9b, 0xe7, 0xde, 0x3a, 0x7e, 0x95, 0x9a,
+	0xd1, 0x21, 0xcf, 0x41, 0xf1, 0xf1, 0x10, 0x89, 0xeb, 0xfd, 0xaa, 0xc6, 0x3f, 0x54, 0x04, 0xe7,
+	0x3c, 0x4a, 0x55, 0x77, 0x1d, 0xcb, 0x26, 0x3a, 0x46, 0x7d, 0xd4, 0x25, 0x8e, 0x27, 0xfa, 0x1a,
+	0xc9, 0x9b, 0x7c, 0xb8, 0xb7, 0xc6, 0x58, 0x7a, 0x40, 0x71, 0x3b, 0x02, 0x55, 0x53, 0xbd, 0xb1,
+	0x31, 0x5a, 0x79, 0x9b, 0xde, 0x48, 0xf7, 0x86, 0xfc, 0x4a, 0xbb, 0xa2, 0x95, 0x4c, 0x6f, 0xa4,
+	0x0d, 0x6d, 0xf5, 0x3c, 0x94, 0x3c, 0x64, 0x60, 0xc7, 0x16, 0x4d, 0x0d, 0xf1, 0x45, 0x55, 0x61,
+	0x99, 0xc8, 0x26, 0x16, 0x19, 0x31, 0x7f, 0xac, 0x6a, 0xfe, 0xb7, 0xfa, 0x0e, 0xf0, 0x25, 0x74,
+	0x8f, 0x5f, 0x34, 0xf0, 0xf0, 0x29, 0x4f, 0xec, 0x89, 0x31, 0x3e, 0xc5, 0xc5, 0x04, 0x8b, 0xa0,
+	0x39, 0x2f, 0x36, 0x92, 0xbc, 0x15, 0x55, 0x32, 0x6f, 0x45, 0xd5, 0xe4, 0x9b, 0x6a, 0xf0, 0x3d,
+	0x00, 0xd7, 0x21, 0xfe, 0x20, 0xe6, 0x38, 0xf7, 0x09, 0x21, 0xd3, 0xd2, 0x7d, 0x25, 0xd5, 0x01,
+	0xfc, 0x93, 0x70, 0xd9, 0x43, 0x98, 0x75, 0x07, 0x26, 0x05, 0x59, 0xb2, 0x01, 0x35, 0x84, 0x9d,
+	0x3e, 0x5f, 0x57, 0x52, 0xc9, 0x1c, 0x66, 0xff, 0xa0, 0xc0, 0xf2, 0x03, 0x63, 0x88, 0xbf, 0xec,
+	0x28, 0x0b, 0xf9, 0x53, 0x3e, 0xd5, 0x9f, 0x0a, 0x51, 0x7f, 0xa2, 0xfb, 0x40, 0x1a, 0xef, 0x62,
+	0x1f, 0xf8, 0x2b, 0x05, 0x56, 0xde, 0xb1, 0xdd, 0x5f, 0x05, 0x01, 0xc3, 0x82, 0xe4, 0x63, 0x82,
+	0xb4, 0x60, 0x35, 0x9d, 0x4b, 0x21, 0xca, 0xcf, 0xa5, 0xa5, 0xd6, 0xe9, 0x19, 0xcd, 0x22, 0xa3,
+	0x2f, 0x4b, 0x90, 0x15, 0x98, 0x32, 0x04, 0x0b, 0x41, 0x39, 0x01, 0x72, 0x68, 0xdb, 0x0c, 0x99,
+	0xb2, 0x90, 0x6a, 0xca, 0x62, 0x8a, 0x29, 0x13, 0x84, 0x13, 0xf2, 0xff, 0x53, 0x60, 0xca, 0x5f,
+	0x79, 0x0d, 0x4c, 0x72, 0xda, 0xc0, 0xd6, 0xe9, 0xb2, 0xfe, 0x54, 0xe1, 0x25, 0x21, 0xf9, 0x7f,
+	0x2d, 0xa9, 0x28, 0xd3, 0x48, 0xba, 0x9c, 0x7f, 0x96, 0x83, 0x17, 0x79, 0xaf, 0x6b, 0x0c, 0xe6,
+	0xbe, 0x7b, 0x82, 0x2d, 0xf2, 0xf9, 0xc9, 0xfb, 0x16, 0x94, 0x1d, 0xce, 0x99, 0xb8, 0xbe, 0xfa,
+	0xea, 0xb1, 0x89, 0x5a, 0x8a, 0x26, 0x25, 0x92, 0x04, 0x26, 0xc6, 0xc3, 0x15, 0xb8, 0x7c, 0x9c,
+	0x62, 0x84, 0x0e, 0x3f, 0x16, 0x4f, 0x5c, 0xd7, 0xe9, 0x5f, 0x60, 0x68, 0xa8, 0xeb, 0x78, 0x66,
+	0x46, 0xad, 0x5d, 0x84, 0xaa, 0xeb, 0x59, 0x76, 0xd7, 0x72, 0x8d, 0xbe, 0x2c, 0x74, 0xfd, 0x01,
+	0x7a, 0xb6, 0x34, 0x5c, 0x2b, 0xfc, 0x10, 0xa5, 0x6c, 0xb8, 0x16, 0xbb, 0xb3, 0x78, 0x03, 0x80,
+	0xd7, 0xf9, 0x27, 0x7a, 0x0d, 0x58, 0x65, 0x38, 0x74, 0x54, 0xbd, 0x09, 0x15, 0x5a, 0xe1, 0x9f,
+	0xa8, 0xbf, 0x56, 0x46, 0xb6, 0x79, 0x7a, 0xfd, 0xb4, 0x1f, 0x8b, 0x87, 0xb0, 0x51, 0xad, 0x89,
+	0xdd, 0x78, 0x9b, 0xee, 0xc6, 0x6c, 0x48, 0xec, 0xc6, 0x6b, 0x99, 0x4a, 0xde, 0x80, 0x94, 0x26,
+	0xf1, 0x33, 0xef, 0xc3, 0x1f, 0x2b, 0x70, 0x71, 0xd3, 0x43, 0x06, 0x41, 0xfe, 0xcd, 0xc4, 0xba,
+	0x6b, 0x7d, 0x0b, 0x8d, 0xb2, 0x99, 0x52, 0x85, 0x42, 0xe8, 0xca, 0x9e, 0xfd, 0xa6, 0x63, 0xac,
+	0x37, 0xca, 0x8d, 0xc7, 0x7e, 0xab, 0xd7, 0x20, 0x4f, 0x48, 0xbf, 0x5e, 0xc8, 0xd6, 0xac, 0xa5,
+	0xb0, 0x13, 0xbd, 0xf4, 0x03, 0x05, 0x96, 0x53, 0xb8, 0xf6, 0x9f, 0x73, 0x53, 0xaf, 0xd1, 0xe5,
+	0x3d, 0x44, 0xc6, 0x0e, 0x7f, 0x9c, 0x5a, 0xc9, 0x60, 0xff, 0xd2, 0x52, 0x38, 0xd0, 0x61, 0x55,
+	0xe3, 0x1f, 0xad, 0x9b, 0xb0, 0x44, 0x4d, 0x19, 0x43, 0xca, 0x16, 0x04, 0x2d, 0x1b, 0x2e, 0x26,
+	0x23, 0x0b, 0x01, 0xde, 0xe6, 0x61, 0x70, 0x80, 0x46, 0x27, 0xba, 0xa3, 0x88, 0x4b, 0x50, 0xe6,
+	0x12, 0xe0, 0xd6, 0x1f, 0x28, 0x70, 0x51, 0x73, 0xc8, 0xe7, 0x35, 0xf4, 0x02, 0x94, 0x0e, 0xd0,
+	0x28, 0x38, 0xe2, 0x17, 0x0f, 0x10, 0x4d, 0x4b, 0xc2, 0xae, 0xf9, 0xec, 0x76, 0x65, 0xb6, 0x4b,
+	0x61, 0xe4, 0x39, 0xda, 0xae, 0x43, 0x9b, 0x23, 0x87, 0xce, 0xc1, 0x69, 0x6a, 0xa3, 0xb5, 0x02,
+	0xcb, 0x29, 0x44, 0x45, 0xce, 0x1c, 0xb0, 0xc7, 0x22, 0xa1, 0xee, 0x01, 0xfd, 0x73, 0x98, 0xa1,
+	0xef, 0x31, 0x2f, 0xc1, 0x6c, 0xb4, 0x29, 0x22, 0x4f, 0x75, 0xb5, 0x48, 0x57, 0x84, 0x5d, 0x3f,
+	0xb3, 0xee, 0x98, 0x89, 0xf8, 0xe5, 0x2d, 0x16, 0xd7, 0x3c, 0x33, 0x62, 0x94, 0xdd, 0xdb, 0xe2,
+	0xd6, 0x2f, 0x73, 0x70, 0x31, 0x79, 0x3d, 0xa1, 0xe9, 0x1f, 0x24, 0x2f, 0x98, 0xf5, 0x01, 0xd5,
+	0x24, 0xda, 0xed, 0xc8, 0x2d, 0x8f, 0xb8, 0x48, 0x8f, 0xcb, 0xd1, 0x81, 0x92, 0xcf, 0x3f, 0x5d,
+	0xf6, 0x66, 0xa6, 0x65, 0xc5, 0x1f, 0x6e, 0xc4, 0x17, 0x16, 0xa4, 0x1a, 0x3f, 0x54, 0xe0, 0x6c,
+	0xc2, 0xe2, 0x09, 0xd7, 0x92, 0x9d, 0xe8, 0x5b, 0xcf, 0x5b, 0x99, 0x56, 0xf7, 0xef, 0xad, 0xe2,
+	0xeb, 0x87, 0x6e, 0x35, 0x7f, 0x99, 0x83, 0x7a, 0x1a, 0x1c, 0xdd, 0xeb, 0xc3, 0x37, 0xee, 0xfc,
+	0x76, 0x13, 0x70, 0x70, 0xd5, 0xbe, 0x0d, 0x73, 0xb4, 0xf9, 0xe2, 0x0c, 0xc9, 0xae, 0x33, 0xb4,
+	0x4d, 0xbd, 0x6f, 0xf4, 0xea, 0xb9, 0x6c, 0x11, 0x56, 0x1b, 0x18, 0x47, 0xf7, 0x05, 0xde, 0x3d,
+	0xa3, 0xa7, 0x6e, 0x01, 0x3d, 0x89, 0xea, 0x96, 0x1d, 0x50, 0xca, 0x18, 0xab, 0x33, 0x03, 0xe3,
+	0x68, 0xdb, 0xf6, 0x09, 0xbd, 0x06, 0xe7, 0x25, 0x11, 0xb3, 0xff, 0x98, 0x37, 0x86, 0x38, 0xff,
+	0xbc, 0x77, 0x74, 0x56, 0xcc, 0xde, 0xee, 0x3f, 0x66, 0x0f, 0xfd, 0x99, 0x20, 0x37, 0xa0, 0xee,
+	0x21, 0xe2, 0x8d, 0x2c, 0xbb, 0x27, 0x9f, 0xa5, 0x3a, 0x9e, 0x40, 0xe3, 0x2d, 0xdb, 0xf3, 0x72,
+	0xfe, 0x81, 0x9c, 0xe6, 0x98, 0x5f, 0x87, 0x45, 0x4c, 0x1c, 0xd7, 0x45, 0xe6, 0x18, 0x22, 0xdf,
+	0x79, 0x17, 0xc4, 0x74, 0x14, 0xaf, 0xf5, 0x2f, 0x45, 0x38, 0x9f, 0xec, 0x1e, 0x93, 0xfe, 0x96,
+	0xe1, 0x6b, 0xb0, 0x48, 0xb5, 0x14, 0xbf, 0xb9, 0x08, 0x1e, 0xce, 0x9e, 0x1b, 0x18, 0x47, 0xf1,
+	0x47, 0xa2, 0xa6, 0xea, 0xc2, 0xa5, 0x44, 0xb4, 0xf8, 0x9f, 0x2d, 0xe4, 0x33, 0x56, 0x1a, 0xab,
+	0xe3, 0xab, 0x3c, 0x8a, 0xfc, 0x21, 0x83, 0x7a, 0x34, 0x1e, 0xaf, 0x05, 0x16, 0x38, 0xf7, 0x9f,
+	0x21, 0x70, 0x32, 0x45, 0xea, 0x5f, 0x28, 0xb0, 0xbc, 0x6f, 0xd8, 0x26, 0x7b, 0x18, 0x91, 0xa0,
+	0x28, 0xfa, 0x27, 0x63, 0x94, 0x91, 0xef, 0x3d, 0x0b, 0x23, 0x77, 0xc5, 0x02, 0x63, 0xaa, 0x16,
+	0x4c, 0x35, 0xf6, 0x53, 0x01, 0x1a, 0x3f, 0xca, 0x1c, 0xf5, 0xdf, 0x89, 0x46, 0xfd, 0x46, 0x76,
+	0x8e, 0x33, 0x84, 0x7e, 0x63, 0x07, 0x56, 0x8e, 0x11, 0xe3, 0xb8, 0xf7, 0x11, 0xf9, 0x70, 0x26,
+	0xf9, 0x69, 0x0e, 0x96, 0x27, 0xae, 0xad, 0xb6, 0x60, 0xc6, 0xe8, 0x1e, 0x20, 0xd3, 0x77, 0x59,
+	0xfe, 0x4c, 0x7c, 0x8a, 0x0d, 0x0a, 0x4f, 0x7d, 0x0f, 0x1a, 0x21, 0x98, 0xb8, 0x7f, 0xe6, 0xb2,
+	0xde, 0x34, 0xfb, 0x24, 0x63, 0x6e, 0xb9, 0x01, 0xd3, 0x91, 0x64, 0x95, 0x31, 0xc5, 0x4c, 0x39,
+	0xa1, 0x4c, 0xf5, 0x3d, 0x28, 0x8b, 0x14, 0x22, 0xaa, 0xc4, 0xf5, 0x2c, 0xf7, 0x85, 0x71, 0x47,
+	0x12, 0x19, 0x4b, 0x98, 0x45, 0x52, 0x6c, 0xfd, 0xb9, 0x02, 0x8d, 0x0e, 0x22, 0x63, 0xaf, 0x5a,
+	0xc4, 0xbe, 0xfb, 0x16, 0x14, 0xd9, 0x13, 0x19, 0x51, 0x6e, 0x7c, 0xbe, 0x17, 0x32, 0x9c, 0x84,
+	0xac, 0x88, 0x72, 0x27, 0xa8, 0x88, 0x2c, 0x58, 0x4a, 0x64, 0x4e, 0x6c, 0xd2, 0xa7, 0xc8, 0x5d,
+	0x6b, 0x4d, 0x3e, 0xdb, 0x4d, 0x53, 0x45, 0x0d, 0x72, 0xfe, 0x45, 0x4f, 0xce, 0x32, 0x83, 0xc7,
+	0xb5, 0x69, 0xec, 0x6d, 0xf4, 0x3f, 0xf9, 0xb4, 0x79, 0xe6, 0x67, 0x9f, 0x36, 0xcf, 0xfc, 0xe2,
+	0xd3, 0xa6, 0xf2, 0xc3, 0xa7, 0x4d, 0xe5, 0xaf, 0x9f, 0x36, 0x95, 0x7f, 0x7e, 0xda, 0x54, 0x3e,
+	0x79, 0xda, 0x54, 0xfe, 0xf3, 0x69, 0x53, 0xf9, 0xaf, 0xa7, 0xcd, 0x33, 0xbf, 0x78, 0xda, 0x54,
+	0x3e, 0xfc, 0xac, 0x79, 0xe6, 0x93, 0xcf, 0x9a, 0x67, 0x7e, 0xf6, 0x59, 0xf3, 0xcc, 0x77, 0xbf,
+	0xde, 0x73, 0x02, 0x39, 0x2c, 0x67, 0xc2, 0x7f, 0x64, 0x71, 0x33, 0xfc, 0xbd, 0x5b, 0x62, 0x9a,
+	0x7c, 0xed, 0xff, 0x06, 0x00, 0x3b, 0x17, 0xef, 0x14, 0x03, 0x43, 0x00, 0x00,
+}
+
+func (this *RebuildMutableStateRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RemoveSearchAttributesResponse)
+	that1, ok := that.(*RebuildMutableStateRequest)
 	if !ok {
-		that2, ok := that.(RemoveSearchAttributesResponse)
+		that2, ok := that.(RebuildMutableStateRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4303,16 +5450,22 @@ func (this *RemoveSearchAttributesResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.Namespace != that1.Namespace {
+		return false
+	}
+	if !this.Execution.Equal(that1.Execution) {
+		return false
+	}
 	return true
 }
-func (this *GetSearchAttributesRequest) Equal(that interface{}) bool {
+func (this *RebuildMutableStateResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GetSearchAttributesRequest)
+	that1, ok := that.(*RebuildMutableStateResponse)
 	if !ok {
-		that2, ok := that.(GetSearchAttributesRequest)
+		that2, ok := that.(RebuildMutableStateResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4324,22 +5477,16 @@ func (this *GetSearchAttributesRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.IndexName != that1.IndexName {
-		return false
-	}
-	if this.Namespace != that1.Namespace {
-		return false
-	}
 	return true
 }
-func (this *GetSearchAttributesResponse) Equal(that interface{}) bool {
+func (this *DescribeMutableStateRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GetSearchAttributesResponse)
+	that1, ok := that.(*DescribeMutableStateRequest)
 	if !ok {
-		that2, ok := that.(GetSearchAttributesResponse)
+		that2, ok := that.(DescribeMutableStateRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4351,43 +5498,22 @@ func (this *GetSearchAttributesResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.CustomAttributes) != len(that1.CustomAttributes) {
+	if this.Namespace != that1.Namespace {
 		return false
 	}
-	for i := range this.CustomAttributes {
-		if this.CustomAttributes[i] != that1.CustomAttributes[i] {
-			return false
-		}
-	}
-	if len(this.SystemAttributes) != len(that1.SystemAttributes) {
-		return false
-	}
-	for i := range this.SystemAttributes {
-		if this.SystemAttributes[i] != that1.SystemAttributes[i] {
-			return false
-		}
-	}
-	if len(this.Mapping) != len(that1.Mapping) {
-		return false
-	}
-	for i := range this.Mapping {
-		if this.Mapping[i] != that1.Mapping[i] {
-			return false
-		}
-	}
-	if !this.AddWorkflowExecutionInfo.Equal(that1.AddWorkflowExecutionInfo) {
+	if !this.Execution.Equal(that1.Execution) {
 		return false
 	}
 	return true
 }
-func (this *DescribeClusterRequest) Equal(that interface{}) bool {
+func (this *DescribeMutableStateResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DescribeClusterRequest)
+	that1, ok := that.(*DescribeMutableStateResponse)
 	if !ok {
-		that2, ok := that.(DescribeClusterRequest)
+		that2, ok := that.(DescribeMutableStateResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4399,19 +5525,28 @@ func (this *DescribeClusterRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.ClusterName != that1.ClusterName {
+	if this.ShardId != that1.ShardId {
+		return false
+	}
+	if this.HistoryAddr != that1.HistoryAddr {
+		return false
+	}
+	if !this.CacheMutableState.Equal(that1.CacheMutableState) {
+		return false
+	}
+	if !this.DatabaseMutableState.Equal(that1.DatabaseMutableState) {
 		return false
 	}
 	return true
 }
-func (this *DescribeClusterResponse) Equal(that interface{}) bool {
+func (this *DescribeHistoryHostRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DescribeClusterResponse)
+	that1, ok := that.(*DescribeHistoryHostRequest)
 	if !ok {
-		that2, ok := that.(DescribeClusterResponse)
+		that2, ok := that.(DescribeHistoryHostRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4423,57 +5558,28 @@ func (this *DescribeClusterResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.SupportedClients) != len(that1.SupportedClients) {
-		return false
-	}
-	for i := range this.SupportedClients {
-		if this.SupportedClients[i] != that1.SupportedClients[i] {
-			return false
-		}
-	}
-	if this.ServerVersion != that1.ServerVersion {
-		return false
-	}
-	if !this.MembershipInfo.Equal(that1.MembershipInfo) {
-		return false
-	}
-	if this.ClusterId != that1.ClusterId {
-		return false
-	}
-	if this.ClusterName != that1.ClusterName {
-		return false
-	}
-	if this.HistoryShardCount != that1.HistoryShardCount {
-		return false
-	}
-	if this.PersistenceStore != that1.PersistenceStore {
-		return false
-	}
-	if this.VisibilityStore != that1.VisibilityStore {
-		return false
-	}
-	if !this.VersionInfo.Equal(that1.VersionInfo) {
+	if this.HostAddress != that1.HostAddress {
 		return false
 	}
-	if this.FailoverVersionIncrement != that1.FailoverVersionIncrement {
+	if this.ShardId != that1.ShardId {
 		return false
 	}
-	if this.InitialFailoverVersion != that1.InitialFailoverVersion {
+	if this.Namespace != that1.Namespace {
 		return false
 	}
-	if this.IsGlobalNamespaceEnabled != that1.IsGlobalNamespaceEnabled {
+	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
 		return false
 	}
 	return true
 }
-func (this *ListClustersRequest) Equal(that interface{}) bool {
+func (this *DescribeHistoryHostResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ListClustersRequest)
+	that1, ok := that.(*DescribeHistoryHostResponse)
 	if !ok {
-		that2, ok := that.(ListClustersRequest)
+		that2, ok := that.(DescribeHistoryHostResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4485,54 +5591,41 @@ func (this *ListClustersRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.PageSize != that1.PageSize {
+	if this.ShardsNumber != that1.ShardsNumber {
 		return false
 	}
-	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+	if len(this.ShardIds) != len(that1.ShardIds) {
 		return false
 	}
-	return true
-}
-func (this *ListClustersResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*ListClustersResponse)
-	if !ok {
-		that2, ok := that.(ListClustersResponse)
-		if ok {
-			that1 = &that2
-		} else {
+	for i := range this.ShardIds {
+		if this.ShardIds[i] != that1.ShardIds[i] {
 			return false
 		}
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
+	if !this.NamespaceCache.Equal(that1.NamespaceCache) {
 		return false
 	}
-	if len(this.Clusters) != len(that1.Clusters) {
+	if this.Address != that1.Address {
 		return false
 	}
-	for i := range this.Clusters {
-		if !this.Clusters[i].Equal(that1.Clusters[i]) {
+	if len(this.Certificates) != len(that1.Certificates) {
+		return false
+	}
+	for i := range this.Certificates {
+		if !this.Certificates[i].Equal(that1.Certificates[i]) {
 			return false
 		}
 	}
-	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
-		return false
-	}
 	return true
 }
-func (this *AddOrUpdateRemoteClusterRequest) Equal(that interface{}) bool {
+func (this *CloseShardRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*AddOrUpdateRemoteClusterRequest)
+	that1, ok := that.(*CloseShardRequest)
 	if !ok {
-		that2, ok := that.(AddOrUpdateRemoteClusterRequest)
+		that2, ok := that.(CloseShardRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4544,22 +5637,19 @@ func (this *AddOrUpdateRemoteClusterRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.FrontendAddress != that1.FrontendAddress {
-		return false
-	}
-	if this.EnableRemoteClusterConnection != that1.EnableRemoteClusterConnection {
+	if this.ShardId != that1.ShardId {
 		return false
 	}
 	return true
 }
-func (this *AddOrUpdateRemoteClusterResponse) Equal(that interface{}) bool {
+func (this *CloseShardResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*AddOrUpdateRemoteClusterResponse)
+	that1, ok := that.(*CloseShardResponse)
 	if !ok {
-		that2, ok := that.(AddOrUpdateRemoteClusterResponse)
+		that2, ok := that.(CloseShardResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4573,14 +5663,14 @@ func (this *AddOrUpdateRemoteClusterResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *RemoveRemoteClusterRequest) Equal(that interface{}) bool {
+func (this *GetShardRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RemoveRemoteClusterRequest)
+	that1, ok := that.(*GetShardRequest)
 	if !ok {
-		that2, ok := that.(RemoveRemoteClusterRequest)
+		that2, ok := that.(GetShardRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4592,19 +5682,19 @@ func (this *RemoveRemoteClusterRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.ClusterName != that1.ClusterName {
+	if this.ShardId != that1.ShardId {
 		return false
 	}
 	return true
 }
-func (this *RemoveRemoteClusterResponse) Equal(that interface{}) bool {
+func (this *GetShardResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RemoveRemoteClusterResponse)
+	that1, ok := that.(*GetShardResponse)
 	if !ok {
-		that2, ok := that.(RemoveRemoteClusterResponse)
+		that2, ok := that.(GetShardResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4616,16 +5706,19 @@ func (this *RemoveRemoteClusterResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if !this.ShardInfo.Equal(that1.ShardInfo) {
+		return false
+	}
 	return true
 }
-func (this *ListClusterMembersRequest) Equal(that interface{}) bool {
+func (this *ListHistoryTasksRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ListClusterMembersRequest)
+	that1, ok := that.(*ListHistoryTasksRequest)
 	if !ok {
-		that2, ok := that.(ListClusterMembersRequest)
+		that2, ok := that.(ListHistoryTasksRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4637,32 +5730,16 @@ func (this *ListClusterMembersRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.LastHeartbeatWithin != nil && that1.LastHeartbeatWithin != nil {
-		if *this.LastHeartbeatWithin != *that1.LastHeartbeatWithin {
-			return false
-		}
-	} else if this.LastHeartbeatWithin != nil {
-		return false
-	} else if that1.LastHeartbeatWithin != nil {
-		return false
-	}
-	if this.RpcAddress != that1.RpcAddress {
-		return false
-	}
-	if this.HostId != that1.HostId {
+	if this.ShardId != that1.ShardId {
 		return false
 	}
-	if this.Role != that1.Role {
+	if this.Category != that1.Category {
 		return false
 	}
-	if that1.SessionStartedAfterTime == nil {
-		if this.SessionStartedAfterTime != nil {
-			return false
-		}
-	} else if !this.SessionStartedAfterTime.Equal(*that1.SessionStartedAfterTime) {
+	if !this.TaskRange.Equal(that1.TaskRange) {
 		return false
 	}
-	if this.PageSize != that1.PageSize {
+	if this.BatchSize != that1.BatchSize {
 		return false
 	}
 	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
@@ -4670,14 +5747,14 @@ func (this *ListClusterMembersRequest) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *ListClusterMembersResponse) Equal(that interface{}) bool {
+func (this *ListHistoryTasksResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ListClusterMembersResponse)
+	that1, ok := that.(*ListHistoryTasksResponse)
 	if !ok {
-		that2, ok := that.(ListClusterMembersResponse)
+		that2, ok := that.(ListHistoryTasksResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4689,11 +5766,11 @@ func (this *ListClusterMembersResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.ActiveMembers) != len(that1.ActiveMembers) {
+	if len(this.Tasks) != len(that1.Tasks) {
 		return false
 	}
-	for i := range this.ActiveMembers {
-		if !this.ActiveMembers[i].Equal(that1.ActiveMembers[i]) {
+	for i := range this.Tasks {
+		if !this.Tasks[i].Equal(that1.Tasks[i]) {
 			return false
 		}
 	}
@@ -4702,14 +5779,14 @@ func (this *ListClusterMembersResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *GetDLQMessagesRequest) Equal(that interface{}) bool {
+func (this *Task) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GetDLQMessagesRequest)
+	that1, ok := that.(*Task)
 	if !ok {
-		that2, ok := that.(GetDLQMessagesRequest)
+		that2, ok := that.(Task)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4721,34 +5798,41 @@ func (this *GetDLQMessagesRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Type != that1.Type {
+	if this.NamespaceId != that1.NamespaceId {
 		return false
 	}
-	if this.ShardId != that1.ShardId {
+	if this.WorkflowId != that1.WorkflowId {
 		return false
 	}
-	if this.SourceCluster != that1.SourceCluster {
+	if this.RunId != that1.RunId {
 		return false
 	}
-	if this.InclusiveEndMessageId != that1.InclusiveEndMessageId {
+	if this.TaskId != that1.TaskId {
 		return false
 	}
-	if this.MaximumPageSize != that1.MaximumPageSize {
+	if this.TaskType != that1.TaskType {
 		return false
 	}
-	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+	if that1.FireTime == nil {
+		if this.FireTime != nil {
+			return false
+		}
+	} else if !this.FireTime.Equal(*that1.FireTime) {
+		return false
+	}
+	if this.Version != that1.Version {
 		return false
 	}
 	return true
 }
-func (this *GetDLQMessagesResponse) Equal(that interface{}) bool {
+func (this *RemoveTaskRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GetDLQMessagesResponse)
+	that1, ok := that.(*RemoveTaskRequest)
 	if !ok {
-		that2, ok := that.(GetDLQMessagesResponse)
+		that2, ok := that.(RemoveTaskRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4760,30 +5844,32 @@ func (this *GetDLQMessagesResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Type != that1.Type {
+	if this.ShardId != that1.ShardId {
 		return false
 	}
-	if len(this.ReplicationTasks) != len(that1.ReplicationTasks) {
+	if this.Category != that1.Category {
 		return false
 	}
-	for i := range this.ReplicationTasks {
-		if !this.ReplicationTasks[i].Equal(that1.ReplicationTasks[i]) {
+	if this.TaskId != that1.TaskId {
+		return false
+	}
+	if that1.VisibilityTime == nil {
+		if this.VisibilityTime != nil {
 			return false
 		}
-	}
-	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+	} else if !this.VisibilityTime.Equal(*that1.VisibilityTime) {
 		return false
 	}
 	return true
 }
-func (this *PurgeDLQMessagesRequest) Equal(that interface{}) bool {
+func (this *RemoveTaskResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*PurgeDLQMessagesRequest)
+	that1, ok := that.(*RemoveTaskResponse)
 	if !ok {
-		that2, ok := that.(PurgeDLQMessagesRequest)
+		that2, ok := that.(RemoveTaskResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4795,28 +5881,16 @@ func (this *PurgeDLQMessagesRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Type != that1.Type {
-		return false
-	}
-	if this.ShardId != that1.ShardId {
-		return false
-	}
-	if this.SourceCluster != that1.SourceCluster {
-		return false
-	}
-	if this.InclusiveEndMessageId != that1.InclusiveEndMessageId {
-		return false
-	}
-	return true
-}
-func (this *PurgeDLQMessagesResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+	return true
+}
+func (this *GetWorkflowExecutionRawHistoryV2Request) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
 
-	that1, ok := that.(*PurgeDLQMessagesResponse)
+	that1, ok := that.(*GetWorkflowExecutionRawHistoryV2Request)
 	if !ok {
-		that2, ok := that.(PurgeDLQMessagesResponse)
+		that2, ok := that.(GetWorkflowExecutionRawHistoryV2Request)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4828,16 +5902,40 @@ func (this *PurgeDLQMessagesResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if !this.Execution.Equal(that1.Execution) {
+		return false
+	}
+	if this.StartEventId != that1.StartEventId {
+		return false
+	}
+	if this.StartEventVersion != that1.StartEventVersion {
+		return false
+	}
+	if this.EndEventId != that1.EndEventId {
+		return false
+	}
+	if this.EndEventVersion != that1.EndEventVersion {
+		return false
+	}
+	if this.MaximumPageSize != that1.MaximumPageSize {
+		return false
+	}
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
+	}
 	return true
 }
-func (this *MergeDLQMessagesRequest) Equal(that interface{}) bool {
+func (this *GetWorkflowExecutionRawHistoryV2Response) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MergeDLQMessagesRequest)
+	that1, ok := that.(*GetWorkflowExecutionRawHistoryV2Response)
 	if !ok {
-		that2, ok := that.(MergeDLQMessagesRequest)
+		that2, ok := that.(GetWorkflowExecutionRawHistoryV2Response)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4849,34 +5947,38 @@ func (this *MergeDLQMessagesRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Type != that1.Type {
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
 		return false
 	}
-	if this.ShardId != that1.ShardId {
+	if len(this.HistoryBatches) != len(that1.HistoryBatches) {
 		return false
 	}
-	if this.SourceCluster != that1.SourceCluster {
-		return false
+	for i := range this.HistoryBatches {
+		if !this.HistoryBatches[i].Equal(that1.HistoryBatches[i]) {
+			return false
+		}
 	}
-	if this.InclusiveEndMessageId != that1.InclusiveEndMessageId {
+	if !this.VersionHistory.Equal(that1.VersionHistory) {
 		return false
 	}
-	if this.MaximumPageSize != that1.MaximumPageSize {
+	if len(this.HistoryNodeIds) != len(that1.HistoryNodeIds) {
 		return false
 	}
-	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
-		return false
+	for i := range this.HistoryNodeIds {
+		if this.HistoryNodeIds[i] != that1.HistoryNodeIds[i] {
+			return false
+		}
 	}
 	return true
 }
-func (this *MergeDLQMessagesResponse) Equal(that interface{}) bool {
+func (this *GetReplicationMessagesRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MergeDLQMessagesResponse)
+	that1, ok := that.(*GetReplicationMessagesRequest)
 	if !ok {
-		that2, ok := that.(MergeDLQMessagesResponse)
+		that2, ok := that.(GetReplicationMessagesRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4888,19 +5990,27 @@ func (this *MergeDLQMessagesResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+	if len(this.Tokens) != len(that1.Tokens) {
+		return false
+	}
+	for i := range this.Tokens {
+		if !this.Tokens[i].Equal(that1.Tokens[i]) {
+			return false
+		}
+	}
+	if this.ClusterName != that1.ClusterName {
 		return false
 	}
 	return true
 }
-func (this *RefreshWorkflowTasksRequest) Equal(that interface{}) bool {
+func (this *GetReplicationMessagesResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RefreshWorkflowTasksRequest)
+	that1, ok := that.(*GetReplicationMessagesResponse)
 	if !ok {
-		that2, ok := that.(RefreshWorkflowTasksRequest)
+		that2, ok := that.(GetReplicationMessagesResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4912,22 +6022,24 @@ func (this *RefreshWorkflowTasksRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.NamespaceId != that1.NamespaceId {
+	if len(this.ShardMessages) != len(that1.ShardMessages) {
 		return false
 	}
-	if !this.Execution.Equal(that1.Execution) {
-		return false
+	for i := range this.ShardMessages {
+		if !this.ShardMessages[i].Equal(that1.ShardMessages[i]) {
+			return false
+		}
 	}
 	return true
 }
-func (this *RefreshWorkflowTasksResponse) Equal(that interface{}) bool {
+func (this *GetNamespaceReplicationMessagesRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RefreshWorkflowTasksResponse)
+	that1, ok := that.(*GetNamespaceReplicationMessagesRequest)
 	if !ok {
-		that2, ok := that.(RefreshWorkflowTasksResponse)
+		that2, ok := that.(GetNamespaceReplicationMessagesRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4939,16 +6051,25 @@ func (this *RefreshWorkflowTasksResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.LastRetrievedMessageId != that1.LastRetrievedMessageId {
+		return false
+	}
+	if this.LastProcessedMessageId != that1.LastProcessedMessageId {
+		return false
+	}
+	if this.ClusterName != that1.ClusterName {
+		return false
+	}
 	return true
 }
-func (this *ResendReplicationTasksRequest) Equal(that interface{}) bool {
+func (this *GetNamespaceReplicationMessagesResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ResendReplicationTasksRequest)
+	that1, ok := that.(*GetNamespaceReplicationMessagesResponse)
 	if !ok {
-		that2, ok := that.(ResendReplicationTasksRequest)
+		that2, ok := that.(GetNamespaceReplicationMessagesResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4960,40 +6081,48 @@ func (this *ResendReplicationTasksRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.NamespaceId != that1.NamespaceId {
-		return false
-	}
-	if this.WorkflowId != that1.WorkflowId {
-		return false
-	}
-	if this.RunId != that1.RunId {
+	if !this.Messages.Equal(that1.Messages) {
 		return false
 	}
-	if this.RemoteCluster != that1.RemoteCluster {
-		return false
+	return true
+}
+func (this *GetDLQReplicationMessagesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if this.StartEventId != that1.StartEventId {
-		return false
+
+	that1, ok := that.(*GetDLQReplicationMessagesRequest)
+	if !ok {
+		that2, ok := that.(GetDLQReplicationMessagesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if this.StartVersion != that1.StartVersion {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if this.EndEventId != that1.EndEventId {
+	if len(this.TaskInfos) != len(that1.TaskInfos) {
 		return false
 	}
-	if this.EndVersion != that1.EndVersion {
-		return false
+	for i := range this.TaskInfos {
+		if !this.TaskInfos[i].Equal(that1.TaskInfos[i]) {
+			return false
+		}
 	}
 	return true
 }
-func (this *ResendReplicationTasksResponse) Equal(that interface{}) bool {
+func (this *GetDLQReplicationMessagesResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ResendReplicationTasksResponse)
+	that1, ok := that.(*GetDLQReplicationMessagesResponse)
 	if !ok {
-		that2, ok := that.(ResendReplicationTasksResponse)
+		that2, ok := that.(GetDLQReplicationMessagesResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5005,16 +6134,24 @@ func (this *ResendReplicationTasksResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if len(this.ReplicationTasks) != len(that1.ReplicationTasks) {
+		return false
+	}
+	for i := range this.ReplicationTasks {
+		if !this.ReplicationTasks[i].Equal(that1.ReplicationTasks[i]) {
+			return false
+		}
+	}
 	return true
 }
-func (this *GetTaskQueueTasksRequest) Equal(that interface{}) bool {
+func (this *ReapplyEventsRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GetTaskQueueTasksRequest)
+	that1, ok := that.(*ReapplyEventsRequest)
 	if !ok {
-		that2, ok := that.(GetTaskQueueTasksRequest)
+		that2, ok := that.(ReapplyEventsRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5026,37 +6163,46 @@ func (this *GetTaskQueueTasksRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Namespace != that1.Namespace {
-		return false
-	}
-	if this.TaskQueue != that1.TaskQueue {
+	if this.NamespaceId != that1.NamespaceId {
 		return false
 	}
-	if this.TaskQueueType != that1.TaskQueueType {
+	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
 		return false
 	}
-	if this.MinTaskId != that1.MinTaskId {
+	if !this.Events.Equal(that1.Events) {
 		return false
 	}
-	if this.MaxTaskId != that1.MaxTaskId {
-		return false
+	return true
+}
+func (this *ReapplyEventsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if this.BatchSize != that1.BatchSize {
-		return false
+
+	that1, ok := that.(*ReapplyEventsResponse)
+	if !ok {
+		that2, ok := that.(ReapplyEventsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
 	return true
 }
-func (this *GetTaskQueueTasksResponse) Equal(that interface{}) bool {
+func (this *AddSearchAttributesRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GetTaskQueueTasksResponse)
+	that1, ok := that.(*AddSearchAttributesRequest)
 	if !ok {
-		that2, ok := that.(GetTaskQueueTasksResponse)
+		that2, ok := that.(AddSearchAttributesRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5068,27 +6214,33 @@ func (this *GetTaskQueueTasksResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.Tasks) != len(that1.Tasks) {
+	if len(this.SearchAttributes) != len(that1.SearchAttributes) {
 		return false
 	}
-	for i := range this.Tasks {
-		if !this.Tasks[i].Equal(that1.Tasks[i]) {
+	for i := range this.SearchAttributes {
+		if this.SearchAttributes[i] != that1.SearchAttributes[i] {
 			return false
 		}
 	}
-	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+	if this.IndexName != that1.IndexName {
+		return false
+	}
+	if this.SkipSchemaUpdate != that1.SkipSchemaUpdate {
+		return false
+	}
+	if this.Namespace != that1.Namespace {
 		return false
 	}
 	return true
 }
-func (this *DeleteWorkflowExecutionRequest) Equal(that interface{}) bool {
+func (this *AddSearchAttributesResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DeleteWorkflowExecutionRequest)
+	that1, ok := that.(*AddSearchAttributesResponse)
 	if !ok {
-		that2, ok := that.(DeleteWorkflowExecutionRequest)
+		that2, ok := that.(AddSearchAttributesResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5100,22 +6252,16 @@ func (this *DeleteWorkflowExecutionRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Namespace != that1.Namespace {
-		return false
-	}
-	if !this.Execution.Equal(that1.Execution) {
-		return false
-	}
 	return true
 }
-func (this *DeleteWorkflowExecutionResponse) Equal(that interface{}) bool {
+func (this *RemoveSearchAttributesRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DeleteWorkflowExecutionResponse)
+	that1, ok := that.(*RemoveSearchAttributesRequest)
 	if !ok {
-		that2, ok := that.(DeleteWorkflowExecutionResponse)
+		that2, ok := that.(RemoveSearchAttributesRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5127,5105 +6273,14631 @@ func (this *DeleteWorkflowExecutionResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.Warnings) != len(that1.Warnings) {
+	if len(this.SearchAttributes) != len(that1.SearchAttributes) {
 		return false
 	}
-	for i := range this.Warnings {
-		if this.Warnings[i] != that1.Warnings[i] {
+	for i := range this.SearchAttributes {
+		if this.SearchAttributes[i] != that1.SearchAttributes[i] {
 			return false
 		}
 	}
+	if this.IndexName != that1.IndexName {
+		return false
+	}
+	if this.Namespace != that1.Namespace {
+		return false
+	}
 	return true
 }
-func (this *RebuildMutableStateRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *RemoveSearchAttributesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&adminservice.RebuildMutableStateRequest{")
-	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
-	if this.Execution != nil {
-		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+
+	that1, ok := that.(*RemoveSearchAttributesResponse)
+	if !ok {
+		that2, ok := that.(RemoveSearchAttributesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *RebuildMutableStateResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&adminservice.RebuildMutableStateResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *DescribeMutableStateRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *GetSearchAttributesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&adminservice.DescribeMutableStateRequest{")
-	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
-	if this.Execution != nil {
-		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+
+	that1, ok := that.(*GetSearchAttributesRequest)
+	if !ok {
+		that2, ok := that.(GetSearchAttributesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *DescribeMutableStateResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&adminservice.DescribeMutableStateResponse{")
-	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
-	s = append(s, "HistoryAddr: "+fmt.Sprintf("%#v", this.HistoryAddr)+",\n")
-	if this.CacheMutableState != nil {
-		s = append(s, "CacheMutableState: "+fmt.Sprintf("%#v", this.CacheMutableState)+",\n")
+	if this.IndexName != that1.IndexName {
+		return false
 	}
-	if this.DatabaseMutableState != nil {
-		s = append(s, "DatabaseMutableState: "+fmt.Sprintf("%#v", this.DatabaseMutableState)+",\n")
+	if this.Namespace != that1.Namespace {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *DescribeHistoryHostRequest) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 8)
-	s = append(s, "&adminservice.DescribeHistoryHostRequest{")
-	s = append(s, "HostAddress: "+fmt.Sprintf("%#v", this.HostAddress)+",\n")
-	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
-	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
-	if this.WorkflowExecution != nil {
-		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
+func (this *GetSearchAttributesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *DescribeHistoryHostResponse) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*GetSearchAttributesResponse)
+	if !ok {
+		that2, ok := that.(GetSearchAttributesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&adminservice.DescribeHistoryHostResponse{")
-	s = append(s, "ShardsNumber: "+fmt.Sprintf("%#v", this.ShardsNumber)+",\n")
-	s = append(s, "ShardIds: "+fmt.Sprintf("%#v", this.ShardIds)+",\n")
-	if this.NamespaceCache != nil {
-		s = append(s, "NamespaceCache: "+fmt.Sprintf("%#v", this.NamespaceCache)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *CloseShardRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if len(this.CustomAttributes) != len(that1.CustomAttributes) {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&adminservice.CloseShardRequest{")
-	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *CloseShardResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	for i := range this.CustomAttributes {
+		if this.CustomAttributes[i] != that1.CustomAttributes[i] {
+			return false
+		}
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&adminservice.CloseShardResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *GetShardRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if len(this.SystemAttributes) != len(that1.SystemAttributes) {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&adminservice.GetShardRequest{")
-	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *GetShardResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	for i := range this.SystemAttributes {
+		if this.SystemAttributes[i] != that1.SystemAttributes[i] {
+			return false
+		}
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&adminservice.GetShardResponse{")
-	if this.ShardInfo != nil {
-		s = append(s, "ShardInfo: "+fmt.Sprintf("%#v", this.ShardInfo)+",\n")
+	if len(this.Mapping) != len(that1.Mapping) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ListHistoryTasksRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	for i := range this.Mapping {
+		if this.Mapping[i] != that1.Mapping[i] {
+			return false
+		}
 	}
-	s := make([]string, 0, 9)
-	s = append(s, "&adminservice.ListHistoryTasksRequest{")
-	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
-	s = append(s, "Category: "+fmt.Sprintf("%#v", this.Category)+",\n")
-	if this.TaskRange != nil {
-		s = append(s, "TaskRange: "+fmt.Sprintf("%#v", this.TaskRange)+",\n")
+	if !this.AddWorkflowExecutionInfo.Equal(that1.AddWorkflowExecutionInfo) {
+		return false
 	}
-	s = append(s, "BatchSize: "+fmt.Sprintf("%#v", this.BatchSize)+",\n")
-	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *ListHistoryTasksResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *DescribeClusterRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&adminservice.ListHistoryTasksResponse{")
-	if this.Tasks != nil {
-		s = append(s, "Tasks: "+fmt.Sprintf("%#v", this.Tasks)+",\n")
+
+	that1, ok := that.(*DescribeClusterRequest)
+	if !ok {
+		that2, ok := that.(DescribeClusterRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *Task) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 11)
-	s = append(s, "&adminservice.Task{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
-	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
-	s = append(s, "TaskId: "+fmt.Sprintf("%#v", this.TaskId)+",\n")
-	s = append(s, "TaskType: "+fmt.Sprintf("%#v", this.TaskType)+",\n")
-	s = append(s, "FireTime: "+fmt.Sprintf("%#v", this.FireTime)+",\n")
-	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *RemoveTaskRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.ClusterName != that1.ClusterName {
+		return false
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&adminservice.RemoveTaskRequest{")
-	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
-	s = append(s, "Category: "+fmt.Sprintf("%#v", this.Category)+",\n")
-	s = append(s, "TaskId: "+fmt.Sprintf("%#v", this.TaskId)+",\n")
-	s = append(s, "VisibilityTime: "+fmt.Sprintf("%#v", this.VisibilityTime)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *RemoveTaskResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *DescribeClusterResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&adminservice.RemoveTaskResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *GetWorkflowExecutionRawHistoryV2Request) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*DescribeClusterResponse)
+	if !ok {
+		that2, ok := that.(DescribeClusterResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 12)
-	s = append(s, "&adminservice.GetWorkflowExecutionRawHistoryV2Request{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.Execution != nil {
-		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "StartEventId: "+fmt.Sprintf("%#v", this.StartEventId)+",\n")
-	s = append(s, "StartEventVersion: "+fmt.Sprintf("%#v", this.StartEventVersion)+",\n")
-	s = append(s, "EndEventId: "+fmt.Sprintf("%#v", this.EndEventId)+",\n")
-	s = append(s, "EndEventVersion: "+fmt.Sprintf("%#v", this.EndEventVersion)+",\n")
-	s = append(s, "MaximumPageSize: "+fmt.Sprintf("%#v", this.MaximumPageSize)+",\n")
-	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *GetWorkflowExecutionRawHistoryV2Response) GoString() string {
-	if this == nil {
-		return "nil"
+	if len(this.SupportedClients) != len(that1.SupportedClients) {
+		return false
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&adminservice.GetWorkflowExecutionRawHistoryV2Response{")
-	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
-	if this.HistoryBatches != nil {
-		s = append(s, "HistoryBatches: "+fmt.Sprintf("%#v", this.HistoryBatches)+",\n")
+	for i := range this.SupportedClients {
+		if this.SupportedClients[i] != that1.SupportedClients[i] {
+			return false
+		}
 	}
-	if this.VersionHistory != nil {
-		s = append(s, "VersionHistory: "+fmt.Sprintf("%#v", this.VersionHistory)+",\n")
+	if this.ServerVersion != that1.ServerVersion {
+		return false
 	}
-	s = append(s, "HistoryNodeIds: "+fmt.Sprintf("%#v", this.HistoryNodeIds)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *GetReplicationMessagesRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if !this.MembershipInfo.Equal(that1.MembershipInfo) {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&adminservice.GetReplicationMessagesRequest{")
-	if this.Tokens != nil {
-		s = append(s, "Tokens: "+fmt.Sprintf("%#v", this.Tokens)+",\n")
+	if this.ClusterId != that1.ClusterId {
+		return false
 	}
-	s = append(s, "ClusterName: "+fmt.Sprintf("%#v", this.ClusterName)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *GetReplicationMessagesResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.ClusterName != that1.ClusterName {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&adminservice.GetReplicationMessagesResponse{")
-	keysForShardMessages := make([]int32, 0, len(this.ShardMessages))
-	for k, _ := range this.ShardMessages {
-		keysForShardMessages = append(keysForShardMessages, k)
+	if this.HistoryShardCount != that1.HistoryShardCount {
+		return false
 	}
-	github_com_gogo_protobuf_sortkeys.Int32s(keysForShardMessages)
-	mapStringForShardMessages := "map[int32]*v15.ReplicationMessages{"
-	for _, k := range keysForShardMessages {
-		mapStringForShardMessages += fmt.Sprintf("%#v: %#v,", k, this.ShardMessages[k])
+	if this.PersistenceStore != that1.PersistenceStore {
+		return false
 	}
-	mapStringForShardMessages += "}"
-	if this.ShardMessages != nil {
-		s = append(s, "ShardMessages: "+mapStringForShardMessages+",\n")
+	if this.VisibilityStore != that1.VisibilityStore {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *GetNamespaceReplicationMessagesRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if !this.VersionInfo.Equal(that1.VersionInfo) {
+		return false
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&adminservice.GetNamespaceReplicationMessagesRequest{")
-	s = append(s, "LastRetrievedMessageId: "+fmt.Sprintf("%#v", this.LastRetrievedMessageId)+",\n")
-	s = append(s, "LastProcessedMessageId: "+fmt.Sprintf("%#v", this.LastProcessedMessageId)+",\n")
-	s = append(s, "ClusterName: "+fmt.Sprintf("%#v", this.ClusterName)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *GetNamespaceReplicationMessagesResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.FailoverVersionIncrement != that1.FailoverVersionIncrement {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&adminservice.GetNamespaceReplicationMessagesResponse{")
-	if this.Messages != nil {
-		s = append(s, "Messages: "+fmt.Sprintf("%#v", this.Messages)+",\n")
+	if this.InitialFailoverVersion != that1.InitialFailoverVersion {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *GetDLQReplicationMessagesRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.IsGlobalNamespaceEnabled != that1.IsGlobalNamespaceEnabled {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&adminservice.GetDLQReplicationMessagesRequest{")
-	if this.TaskInfos != nil {
-		s = append(s, "TaskInfos: "+fmt.Sprintf("%#v", this.TaskInfos)+",\n")
+	if len(this.Certificates) != len(that1.Certificates) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *GetDLQReplicationMessagesResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	for i := range this.Certificates {
+		if !this.Certificates[i].Equal(that1.Certificates[i]) {
+			return false
+		}
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&adminservice.GetDLQReplicationMessagesResponse{")
-	if this.ReplicationTasks != nil {
-		s = append(s, "ReplicationTasks: "+fmt.Sprintf("%#v", this.ReplicationTasks)+",\n")
+	if len(this.PersistenceFaults) != len(that1.PersistenceFaults) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	for i := range this.PersistenceFaults {
+		if !this.PersistenceFaults[i].Equal(that1.PersistenceFaults[i]) {
+			return false
+		}
+	}
+	return true
 }
-func (this *ReapplyEventsRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *ListClustersRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&adminservice.ReapplyEventsRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.WorkflowExecution != nil {
-		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
+
+	that1, ok := that.(*ListClustersRequest)
+	if !ok {
+		that2, ok := that.(ListClustersRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if this.Events != nil {
-		s = append(s, "Events: "+fmt.Sprintf("%#v", this.Events)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ReapplyEventsResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.PageSize != that1.PageSize {
+		return false
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&adminservice.ReapplyEventsResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
+	}
+	return true
 }
-func (this *AddSearchAttributesRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *ListClustersResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&adminservice.AddSearchAttributesRequest{")
-	keysForSearchAttributes := make([]string, 0, len(this.SearchAttributes))
-	for k, _ := range this.SearchAttributes {
-		keysForSearchAttributes = append(keysForSearchAttributes, k)
+
+	that1, ok := that.(*ListClustersResponse)
+	if !ok {
+		that2, ok := that.(ListClustersResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForSearchAttributes)
-	mapStringForSearchAttributes := "map[string]v16.IndexedValueType{"
-	for _, k := range keysForSearchAttributes {
-		mapStringForSearchAttributes += fmt.Sprintf("%#v: %#v,", k, this.SearchAttributes[k])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	mapStringForSearchAttributes += "}"
-	if this.SearchAttributes != nil {
-		s = append(s, "SearchAttributes: "+mapStringForSearchAttributes+",\n")
+	if len(this.Clusters) != len(that1.Clusters) {
+		return false
 	}
-	s = append(s, "IndexName: "+fmt.Sprintf("%#v", this.IndexName)+",\n")
-	s = append(s, "SkipSchemaUpdate: "+fmt.Sprintf("%#v", this.SkipSchemaUpdate)+",\n")
-	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *AddSearchAttributesResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	for i := range this.Clusters {
+		if !this.Clusters[i].Equal(that1.Clusters[i]) {
+			return false
+		}
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&adminservice.AddSearchAttributesResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
+	}
+	return true
 }
-func (this *RemoveSearchAttributesRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *AddOrUpdateRemoteClusterRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&adminservice.RemoveSearchAttributesRequest{")
-	s = append(s, "SearchAttributes: "+fmt.Sprintf("%#v", this.SearchAttributes)+",\n")
-	s = append(s, "IndexName: "+fmt.Sprintf("%#v", this.IndexName)+",\n")
-	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *RemoveSearchAttributesResponse) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*AddOrUpdateRemoteClusterRequest)
+	if !ok {
+		that2, ok := that.(AddOrUpdateRemoteClusterRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&adminservice.RemoveSearchAttributesResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *GetSearchAttributesRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&adminservice.GetSearchAttributesRequest{")
-	s = append(s, "IndexName: "+fmt.Sprintf("%#v", this.IndexName)+",\n")
-	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *GetSearchAttributesResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.FrontendAddress != that1.FrontendAddress {
+		return false
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&adminservice.GetSearchAttributesResponse{")
-	keysForCustomAttributes := make([]string, 0, len(this.CustomAttributes))
-	for k, _ := range this.CustomAttributes {
-		keysForCustomAttributes = append(keysForCustomAttributes, k)
+	if this.EnableRemoteClusterConnection != that1.EnableRemoteClusterConnection {
+		return false
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForCustomAttributes)
-	mapStringForCustomAttributes := "map[string]v16.IndexedValueType{"
-	for _, k := range keysForCustomAttributes {
-		mapStringForCustomAttributes += fmt.Sprintf("%#v: %#v,", k, this.CustomAttributes[k])
+	return true
+}
+func (this *AddOrUpdateRemoteClusterResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	mapStringForCustomAttributes += "}"
-	if this.CustomAttributes != nil {
-		s = append(s, "CustomAttributes: "+mapStringForCustomAttributes+",\n")
+
+	that1, ok := that.(*AddOrUpdateRemoteClusterResponse)
+	if !ok {
+		that2, ok := that.(AddOrUpdateRemoteClusterResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	keysForSystemAttributes := make([]string, 0, len(this.SystemAttributes))
-	for k, _ := range this.SystemAttributes {
-		keysForSystemAttributes = append(keysForSystemAttributes, k)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForSystemAttributes)
-	mapStringForSystemAttributes := "map[string]v16.IndexedValueType{"
-	for _, k := range keysForSystemAttributes {
-		mapStringForSystemAttributes += fmt.Sprintf("%#v: %#v,", k, this.SystemAttributes[k])
+	return true
+}
+func (this *RemoveRemoteClusterRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	mapStringForSystemAttributes += "}"
-	if this.SystemAttributes != nil {
-		s = append(s, "SystemAttributes: "+mapStringForSystemAttributes+",\n")
+
+	that1, ok := that.(*RemoveRemoteClusterRequest)
+	if !ok {
+		that2, ok := that.(RemoveRemoteClusterRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	keysForMapping := make([]string, 0, len(this.Mapping))
-	for k, _ := range this.Mapping {
-		keysForMapping = append(keysForMapping, k)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForMapping)
-	mapStringForMapping := "map[string]string{"
-	for _, k := range keysForMapping {
-		mapStringForMapping += fmt.Sprintf("%#v: %#v,", k, this.Mapping[k])
+	if this.ClusterName != that1.ClusterName {
+		return false
 	}
-	mapStringForMapping += "}"
-	if this.Mapping != nil {
-		s = append(s, "Mapping: "+mapStringForMapping+",\n")
+	return true
+}
+func (this *RemoveRemoteClusterResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if this.AddWorkflowExecutionInfo != nil {
-		s = append(s, "AddWorkflowExecutionInfo: "+fmt.Sprintf("%#v", this.AddWorkflowExecutionInfo)+",\n")
+
+	that1, ok := that.(*RemoveRemoteClusterResponse)
+	if !ok {
+		that2, ok := that.(RemoveRemoteClusterResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *DescribeClusterRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&adminservice.DescribeClusterRequest{")
-	s = append(s, "ClusterName: "+fmt.Sprintf("%#v", this.ClusterName)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *DescribeClusterResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *ListClusterMembersRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 16)
-	s = append(s, "&adminservice.DescribeClusterResponse{")
-	keysForSupportedClients := make([]string, 0, len(this.SupportedClients))
-	for k, _ := range this.SupportedClients {
-		keysForSupportedClients = append(keysForSupportedClients, k)
+
+	that1, ok := that.(*ListClusterMembersRequest)
+	if !ok {
+		that2, ok := that.(ListClusterMembersRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForSupportedClients)
-	mapStringForSupportedClients := "map[string]string{"
-	for _, k := range keysForSupportedClients {
-		mapStringForSupportedClients += fmt.Sprintf("%#v: %#v,", k, this.SupportedClients[k])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	mapStringForSupportedClients += "}"
-	if this.SupportedClients != nil {
-		s = append(s, "SupportedClients: "+mapStringForSupportedClients+",\n")
+	if this.LastHeartbeatWithin != nil && that1.LastHeartbeatWithin != nil {
+		if *this.LastHeartbeatWithin != *that1.LastHeartbeatWithin {
+			return false
+		}
+	} else if this.LastHeartbeatWithin != nil {
+		return false
+	} else if that1.LastHeartbeatWithin != nil {
+		return false
 	}
-	s = append(s, "ServerVersion: "+fmt.Sprintf("%#v", this.ServerVersion)+",\n")
-	if this.MembershipInfo != nil {
-		s = append(s, "MembershipInfo: "+fmt.Sprintf("%#v", this.MembershipInfo)+",\n")
+	if this.RpcAddress != that1.RpcAddress {
+		return false
 	}
-	s = append(s, "ClusterId: "+fmt.Sprintf("%#v", this.ClusterId)+",\n")
-	s = append(s, "ClusterName: "+fmt.Sprintf("%#v", this.ClusterName)+",\n")
-	s = append(s, "HistoryShardCount: "+fmt.Sprintf("%#v", this.HistoryShardCount)+",\n")
-	s = append(s, "PersistenceStore: "+fmt.Sprintf("%#v", this.PersistenceStore)+",\n")
-	s = append(s, "VisibilityStore: "+fmt.Sprintf("%#v", this.VisibilityStore)+",\n")
-	if this.VersionInfo != nil {
-		s = append(s, "VersionInfo: "+fmt.Sprintf("%#v", this.VersionInfo)+",\n")
+	if this.HostId != that1.HostId {
+		return false
 	}
-	s = append(s, "FailoverVersionIncrement: "+fmt.Sprintf("%#v", this.FailoverVersionIncrement)+",\n")
-	s = append(s, "InitialFailoverVersion: "+fmt.Sprintf("%#v", this.InitialFailoverVersion)+",\n")
-	s = append(s, "IsGlobalNamespaceEnabled: "+fmt.Sprintf("%#v", this.IsGlobalNamespaceEnabled)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ListClustersRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Role != that1.Role {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&adminservice.ListClustersRequest{")
-	s = append(s, "PageSize: "+fmt.Sprintf("%#v", this.PageSize)+",\n")
-	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ListClustersResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1.SessionStartedAfterTime == nil {
+		if this.SessionStartedAfterTime != nil {
+			return false
+		}
+	} else if !this.SessionStartedAfterTime.Equal(*that1.SessionStartedAfterTime) {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&adminservice.ListClustersResponse{")
-	if this.Clusters != nil {
-		s = append(s, "Clusters: "+fmt.Sprintf("%#v", this.Clusters)+",\n")
+	if this.PageSize != that1.PageSize {
+		return false
 	}
-	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *AddOrUpdateRemoteClusterRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&adminservice.AddOrUpdateRemoteClusterRequest{")
-	s = append(s, "FrontendAddress: "+fmt.Sprintf("%#v", this.FrontendAddress)+",\n")
-	s = append(s, "EnableRemoteClusterConnection: "+fmt.Sprintf("%#v", this.EnableRemoteClusterConnection)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *AddOrUpdateRemoteClusterResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *ListClusterMembersResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&adminservice.AddOrUpdateRemoteClusterResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *RemoveRemoteClusterRequest) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*ListClusterMembersResponse)
+	if !ok {
+		that2, ok := that.(ListClusterMembersResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&adminservice.RemoveRemoteClusterRequest{")
-	s = append(s, "ClusterName: "+fmt.Sprintf("%#v", this.ClusterName)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *RemoveRemoteClusterResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&adminservice.RemoveRemoteClusterResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ListClusterMembersRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if len(this.ActiveMembers) != len(that1.ActiveMembers) {
+		return false
 	}
-	s := make([]string, 0, 11)
-	s = append(s, "&adminservice.ListClusterMembersRequest{")
-	s = append(s, "LastHeartbeatWithin: "+fmt.Sprintf("%#v", this.LastHeartbeatWithin)+",\n")
-	s = append(s, "RpcAddress: "+fmt.Sprintf("%#v", this.RpcAddress)+",\n")
-	s = append(s, "HostId: "+fmt.Sprintf("%#v", this.HostId)+",\n")
-	s = append(s, "Role: "+fmt.Sprintf("%#v", this.Role)+",\n")
-	s = append(s, "SessionStartedAfterTime: "+fmt.Sprintf("%#v", this.SessionStartedAfterTime)+",\n")
-	s = append(s, "PageSize: "+fmt.Sprintf("%#v", this.PageSize)+",\n")
-	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ListClusterMembersResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	for i := range this.ActiveMembers {
+		if !this.ActiveMembers[i].Equal(that1.ActiveMembers[i]) {
+			return false
+		}
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&adminservice.ListClusterMembersResponse{")
-	if this.ActiveMembers != nil {
-		s = append(s, "ActiveMembers: "+fmt.Sprintf("%#v", this.ActiveMembers)+",\n")
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
 	}
-	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *GetDLQMessagesRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *GetDLQMessagesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 10)
-	s = append(s, "&adminservice.GetDLQMessagesRequest{")
-	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
-	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
-	s = append(s, "SourceCluster: "+fmt.Sprintf("%#v", this.SourceCluster)+",\n")
-	s = append(s, "InclusiveEndMessageId: "+fmt.Sprintf("%#v", this.InclusiveEndMessageId)+",\n")
-	s = append(s, "MaximumPageSize: "+fmt.Sprintf("%#v", this.MaximumPageSize)+",\n")
-	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *GetDLQMessagesResponse) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*GetDLQMessagesRequest)
+	if !ok {
+		that2, ok := that.(GetDLQMessagesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&adminservice.GetDLQMessagesResponse{")
-	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
-	if this.ReplicationTasks != nil {
-		s = append(s, "ReplicationTasks: "+fmt.Sprintf("%#v", this.ReplicationTasks)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *PurgeDLQMessagesRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Type != that1.Type {
+		return false
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&adminservice.PurgeDLQMessagesRequest{")
-	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
-	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
-	s = append(s, "SourceCluster: "+fmt.Sprintf("%#v", this.SourceCluster)+",\n")
-	s = append(s, "InclusiveEndMessageId: "+fmt.Sprintf("%#v", this.InclusiveEndMessageId)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *PurgeDLQMessagesResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.ShardId != that1.ShardId {
+		return false
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&adminservice.PurgeDLQMessagesResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *MergeDLQMessagesRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.SourceCluster != that1.SourceCluster {
+		return false
 	}
-	s := make([]string, 0, 10)
-	s = append(s, "&adminservice.MergeDLQMessagesRequest{")
-	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
-	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
-	s = append(s, "SourceCluster: "+fmt.Sprintf("%#v", this.SourceCluster)+",\n")
-	s = append(s, "InclusiveEndMessageId: "+fmt.Sprintf("%#v", this.InclusiveEndMessageId)+",\n")
-	s = append(s, "MaximumPageSize: "+fmt.Sprintf("%#v", this.MaximumPageSize)+",\n")
-	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *MergeDLQMessagesResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.InclusiveEndMessageId != that1.InclusiveEndMessageId {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&adminservice.MergeDLQMessagesResponse{")
-	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *RefreshWorkflowTasksRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.MaximumPageSize != that1.MaximumPageSize {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&adminservice.RefreshWorkflowTasksRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.Execution != nil {
-		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *RefreshWorkflowTasksResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *GetDLQMessagesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&adminservice.RefreshWorkflowTasksResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ResendReplicationTasksRequest) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*GetDLQMessagesResponse)
+	if !ok {
+		that2, ok := that.(GetDLQMessagesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 12)
-	s = append(s, "&adminservice.ResendReplicationTasksRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
-	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
-	s = append(s, "RemoteCluster: "+fmt.Sprintf("%#v", this.RemoteCluster)+",\n")
-	s = append(s, "StartEventId: "+fmt.Sprintf("%#v", this.StartEventId)+",\n")
-	s = append(s, "StartVersion: "+fmt.Sprintf("%#v", this.StartVersion)+",\n")
-	s = append(s, "EndEventId: "+fmt.Sprintf("%#v", this.EndEventId)+",\n")
-	s = append(s, "EndVersion: "+fmt.Sprintf("%#v", this.EndVersion)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ResendReplicationTasksResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&adminservice.ResendReplicationTasksResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *GetTaskQueueTasksRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Type != that1.Type {
+		return false
 	}
-	s := make([]string, 0, 11)
-	s = append(s, "&adminservice.GetTaskQueueTasksRequest{")
-	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
-	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
-	s = append(s, "TaskQueueType: "+fmt.Sprintf("%#v", this.TaskQueueType)+",\n")
-	s = append(s, "MinTaskId: "+fmt.Sprintf("%#v", this.MinTaskId)+",\n")
-	s = append(s, "MaxTaskId: "+fmt.Sprintf("%#v", this.MaxTaskId)+",\n")
-	s = append(s, "BatchSize: "+fmt.Sprintf("%#v", this.BatchSize)+",\n")
-	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *GetTaskQueueTasksResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if len(this.ReplicationTasks) != len(that1.ReplicationTasks) {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&adminservice.GetTaskQueueTasksResponse{")
-	if this.Tasks != nil {
-		s = append(s, "Tasks: "+fmt.Sprintf("%#v", this.Tasks)+",\n")
+	for i := range this.ReplicationTasks {
+		if !this.ReplicationTasks[i].Equal(that1.ReplicationTasks[i]) {
+			return false
+		}
 	}
-	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
+	}
+	return true
 }
-func (this *DeleteWorkflowExecutionRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *PurgeDLQMessagesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&adminservice.DeleteWorkflowExecutionRequest{")
-	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
-	if this.Execution != nil {
-		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+
+	that1, ok := that.(*PurgeDLQMessagesRequest)
+	if !ok {
+		that2, ok := that.(PurgeDLQMessagesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *DeleteWorkflowExecutionResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&adminservice.DeleteWorkflowExecutionResponse{")
-	s = append(s, "Warnings: "+fmt.Sprintf("%#v", this.Warnings)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func valueToGoStringRequestResponse(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	if this.Type != that1.Type {
+		return false
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
-}
-func (m *RebuildMutableStateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.ShardId != that1.ShardId {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RebuildMutableStateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.SourceCluster != that1.SourceCluster {
+		return false
+	}
+	if this.InclusiveEndMessageId != that1.InclusiveEndMessageId {
+		return false
+	}
+	return true
 }
+func (this *PurgeDLQMessagesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *RebuildMutableStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Execution != nil {
-		{
-			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+	that1, ok := that.(*PurgeDLQMessagesResponse)
+	if !ok {
+		that2, ok := that.(PurgeDLQMessagesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *RebuildMutableStateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *MergeDLQMessagesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RebuildMutableStateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RebuildMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
 
-func (m *DescribeMutableStateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*MergeDLQMessagesRequest)
+	if !ok {
+		that2, ok := that.(MergeDLQMessagesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DescribeMutableStateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.ShardId != that1.ShardId {
+		return false
+	}
+	if this.SourceCluster != that1.SourceCluster {
+		return false
+	}
+	if this.InclusiveEndMessageId != that1.InclusiveEndMessageId {
+		return false
+	}
+	if this.MaximumPageSize != that1.MaximumPageSize {
+		return false
+	}
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
+	}
+	return true
 }
+func (this *MergeDLQMessagesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DescribeMutableStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Execution != nil {
-		{
-			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+	that1, ok := that.(*MergeDLQMessagesResponse)
+	if !ok {
+		that2, ok := that.(MergeDLQMessagesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DescribeMutableStateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DescribeMutableStateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *RefreshWorkflowTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DescribeMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DatabaseMutableState != nil {
-		{
-			size, err := m.DatabaseMutableState.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+	that1, ok := that.(*RefreshWorkflowTasksRequest)
+	if !ok {
+		that2, ok := that.(RefreshWorkflowTasksRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x22
 	}
-	if m.CacheMutableState != nil {
-		{
-			size, err := m.CacheMutableState.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.HistoryAddr) > 0 {
-		i -= len(m.HistoryAddr)
-		copy(dAtA[i:], m.HistoryAddr)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.HistoryAddr)))
-		i--
-		dAtA[i] = 0x12
+	if this.NamespaceId != that1.NamespaceId {
+		return false
 	}
-	if len(m.ShardId) > 0 {
-		i -= len(m.ShardId)
-		copy(dAtA[i:], m.ShardId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ShardId)))
-		i--
-		dAtA[i] = 0xa
+	if !this.Execution.Equal(that1.Execution) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *DescribeHistoryHostRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *RefreshWorkflowTasksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *DescribeHistoryHostRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*RefreshWorkflowTasksResponse)
+	if !ok {
+		that2, ok := that.(RefreshWorkflowTasksResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
+func (this *ResendReplicationTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DescribeHistoryHostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.WorkflowExecution != nil {
-		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+	that1, ok := that.(*ResendReplicationTasksRequest)
+	if !ok {
+		that2, ok := that.(ResendReplicationTasksRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x22
 	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0x1a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.ShardId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
-		i--
-		dAtA[i] = 0x10
+	if this.NamespaceId != that1.NamespaceId {
+		return false
 	}
-	if len(m.HostAddress) > 0 {
-		i -= len(m.HostAddress)
-		copy(dAtA[i:], m.HostAddress)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.HostAddress)))
-		i--
-		dAtA[i] = 0xa
+	if this.WorkflowId != that1.WorkflowId {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DescribeHistoryHostResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.RunId != that1.RunId {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DescribeHistoryHostResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *DescribeHistoryHostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x2a
+	if this.RemoteCluster != that1.RemoteCluster {
+		return false
 	}
-	if m.NamespaceCache != nil {
-		{
-			size, err := m.NamespaceCache.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	if this.StartEventId != that1.StartEventId {
+		return false
 	}
-	if len(m.ShardIds) > 0 {
-		dAtA8 := make([]byte, len(m.ShardIds)*10)
-		var j7 int
-		for _, num1 := range m.ShardIds {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j7++
-			}
-			dAtA8[j7] = uint8(num)
-			j7++
-		}
-		i -= j7
-		copy(dAtA[i:], dAtA8[:j7])
-		i = encodeVarintRequestResponse(dAtA, i, uint64(j7))
-		i--
-		dAtA[i] = 0x12
+	if this.StartVersion != that1.StartVersion {
+		return false
 	}
-	if m.ShardsNumber != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardsNumber))
-		i--
-		dAtA[i] = 0x8
+	if this.EndEventId != that1.EndEventId {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *CloseShardRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.EndVersion != that1.EndVersion {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CloseShardRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
-
-func (m *CloseShardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ShardId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
-		i--
-		dAtA[i] = 0x8
+func (this *ResendReplicationTasksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return len(dAtA) - i, nil
-}
 
-func (m *CloseShardResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*ResendReplicationTasksResponse)
+	if !ok {
+		that2, ok := that.(ResendReplicationTasksResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CloseShardResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *CloseShardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *GetShardRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
+	return true
 }
-
-func (m *GetShardRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GetShardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ShardId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *GetShardResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *GetTaskQueueTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetShardResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *GetShardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ShardInfo != nil {
-		{
-			size, err := m.ShardInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+	that1, ok := that.(*GetTaskQueueTasksRequest)
+	if !ok {
+		that2, ok := that.(GetTaskQueueTasksRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ListHistoryTasksRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ListHistoryTasksRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ListHistoryTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
-		i--
-		dAtA[i] = 0x2a
+	if this.Namespace != that1.Namespace {
+		return false
 	}
-	if m.BatchSize != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.BatchSize))
-		i--
-		dAtA[i] = 0x20
+	if this.TaskQueue != that1.TaskQueue {
+		return false
 	}
-	if m.TaskRange != nil {
-		{
-			size, err := m.TaskRange.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	if this.TaskQueueType != that1.TaskQueueType {
+		return false
 	}
-	if m.Category != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Category))
-		i--
-		dAtA[i] = 0x10
+	if this.MinTaskId != that1.MinTaskId {
+		return false
 	}
-	if m.ShardId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
-		i--
-		dAtA[i] = 0x8
+	if this.MaxTaskId != that1.MaxTaskId {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ListHistoryTasksResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.BatchSize != that1.BatchSize {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ListHistoryTasksResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
+	}
+	return true
 }
+func (this *GetTaskQueueTasksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ListHistoryTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
-		i--
-		dAtA[i] = 0x12
+	that1, ok := that.(*GetTaskQueueTasksResponse)
+	if !ok {
+		that2, ok := that.(GetTaskQueueTasksResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.Tasks) > 0 {
-		for iNdEx := len(m.Tasks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Tasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Tasks) != len(that1.Tasks) {
+		return false
+	}
+	for i := range this.Tasks {
+		if !this.Tasks[i].Equal(that1.Tasks[i]) {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Task) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Task) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
-
-func (m *Task) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Version != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x38
+func (this *DeleteWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.FireTime != nil {
-		n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.FireTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.FireTime):])
-		if err11 != nil {
-			return 0, err11
+
+	that1, ok := that.(*DeleteWorkflowExecutionRequest)
+	if !ok {
+		that2, ok := that.(DeleteWorkflowExecutionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i -= n11
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n11))
-		i--
-		dAtA[i] = 0x32
 	}
-	if m.TaskType != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.TaskType))
-		i--
-		dAtA[i] = 0x28
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.TaskId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.TaskId))
-		i--
-		dAtA[i] = 0x20
+	if this.Namespace != that1.Namespace {
+		return false
 	}
-	if len(m.RunId) > 0 {
-		i -= len(m.RunId)
-		copy(dAtA[i:], m.RunId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.RunId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.WorkflowId) > 0 {
-		i -= len(m.WorkflowId)
-		copy(dAtA[i:], m.WorkflowId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.WorkflowId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.NamespaceId) > 0 {
-		i -= len(m.NamespaceId)
-		copy(dAtA[i:], m.NamespaceId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
-		i--
-		dAtA[i] = 0xa
+	if !this.Execution.Equal(that1.Execution) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *RemoveTaskRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *DeleteWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RemoveTaskRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *RemoveTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.VisibilityTime != nil {
-		n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
-		if err12 != nil {
-			return 0, err12
+	that1, ok := that.(*DeleteWorkflowExecutionResponse)
+	if !ok {
+		that2, ok := that.(DeleteWorkflowExecutionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i -= n12
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n12))
-		i--
-		dAtA[i] = 0x22
 	}
-	if m.TaskId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.TaskId))
-		i--
-		dAtA[i] = 0x18
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Category != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Category))
-		i--
-		dAtA[i] = 0x10
+	if len(this.Warnings) != len(that1.Warnings) {
+		return false
 	}
-	if m.ShardId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
-		i--
-		dAtA[i] = 0x8
+	for i := range this.Warnings {
+		if this.Warnings[i] != that1.Warnings[i] {
+			return false
+		}
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *RemoveTaskResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ResetWorkflowExecutionsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RemoveTaskResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RemoveTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
 
-func (m *GetWorkflowExecutionRawHistoryV2Request) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*ResetWorkflowExecutionsRequest)
+	if !ok {
+		that2, ok := that.(ResetWorkflowExecutionsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetWorkflowExecutionRawHistoryV2Request) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GetWorkflowExecutionRawHistoryV2Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NamespaceId) > 0 {
-		i -= len(m.NamespaceId)
-		copy(dAtA[i:], m.NamespaceId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
-		i--
-		dAtA[i] = 0x4a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
-		i--
-		dAtA[i] = 0x42
+	if this.Namespace != that1.Namespace {
+		return false
 	}
-	if m.MaximumPageSize != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.MaximumPageSize))
-		i--
-		dAtA[i] = 0x38
+	if this.Query != that1.Query {
+		return false
 	}
-	if m.EndEventVersion != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.EndEventVersion))
-		i--
-		dAtA[i] = 0x30
+	if !this.ResetPointSelector.Equal(that1.ResetPointSelector) {
+		return false
 	}
-	if m.EndEventId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.EndEventId))
-		i--
-		dAtA[i] = 0x28
+	if this.DryRun != that1.DryRun {
+		return false
 	}
-	if m.StartEventVersion != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.StartEventVersion))
-		i--
-		dAtA[i] = 0x20
+	if this.Reason != that1.Reason {
+		return false
 	}
-	if m.StartEventId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.StartEventId))
-		i--
-		dAtA[i] = 0x18
+	if this.Identity != that1.Identity {
+		return false
 	}
-	if m.Execution != nil {
-		{
-			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+	if this.ResetReapplyType != that1.ResetReapplyType {
+		return false
+	}
+	if this.MaximumPageSize != that1.MaximumPageSize {
+		return false
+	}
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
+	}
+	if len(this.Executions) != len(that1.Executions) {
+		return false
+	}
+	for i := range this.Executions {
+		if !this.Executions[i].Equal(that1.Executions[i]) {
+			return false
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *GetWorkflowExecutionRawHistoryV2Response) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ResetWorkflowExecutionsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetWorkflowExecutionRawHistoryV2Response) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *GetWorkflowExecutionRawHistoryV2Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.HistoryNodeIds) > 0 {
-		dAtA15 := make([]byte, len(m.HistoryNodeIds)*10)
-		var j14 int
-		for _, num1 := range m.HistoryNodeIds {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j14++
-			}
-			dAtA15[j14] = uint8(num)
-			j14++
+	that1, ok := that.(*ResetWorkflowExecutionsResponse)
+	if !ok {
+		that2, ok := that.(ResetWorkflowExecutionsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i -= j14
-		copy(dAtA[i:], dAtA15[:j14])
-		i = encodeVarintRequestResponse(dAtA, i, uint64(j14))
-		i--
-		dAtA[i] = 0x22
 	}
-	if m.VersionHistory != nil {
-		{
-			size, err := m.VersionHistory.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Results) != len(that1.Results) {
+		return false
+	}
+	for i := range this.Results {
+		if !this.Results[i].Equal(that1.Results[i]) {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	if len(m.HistoryBatches) > 0 {
-		for iNdEx := len(m.HistoryBatches) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.HistoryBatches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
-		i--
-		dAtA[i] = 0xa
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *GetReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *PauseWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetReplicationMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *GetReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ClusterName) > 0 {
-		i -= len(m.ClusterName)
-		copy(dAtA[i:], m.ClusterName)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ClusterName)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Tokens) > 0 {
-		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	that1, ok := that.(*PauseWorkflowExecutionRequest)
+	if !ok {
+		that2, ok := that.(PauseWorkflowExecutionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *GetReplicationMessagesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetReplicationMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.Namespace != that1.Namespace {
+		return false
+	}
+	if !this.Execution.Equal(that1.Execution) {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if this.Identity != that1.Identity {
+		return false
+	}
+	return true
 }
+func (this *PauseWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GetReplicationMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ShardMessages) > 0 {
-		for k := range m.ShardMessages {
-			v := m.ShardMessages[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i = encodeVarintRequestResponse(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
+	that1, ok := that.(*PauseWorkflowExecutionResponse)
+	if !ok {
+		that2, ok := that.(PauseWorkflowExecutionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *GetNamespaceReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetNamespaceReplicationMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *UnpauseWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GetNamespaceReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ClusterName) > 0 {
-		i -= len(m.ClusterName)
-		copy(dAtA[i:], m.ClusterName)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ClusterName)))
-		i--
-		dAtA[i] = 0x1a
+	that1, ok := that.(*UnpauseWorkflowExecutionRequest)
+	if !ok {
+		that2, ok := that.(UnpauseWorkflowExecutionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.LastProcessedMessageId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.LastProcessedMessageId))
-		i--
-		dAtA[i] = 0x10
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.LastRetrievedMessageId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.LastRetrievedMessageId))
-		i--
-		dAtA[i] = 0x8
+	if this.Namespace != that1.Namespace {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *GetNamespaceReplicationMessagesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.Execution.Equal(that1.Execution) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetNamespaceReplicationMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.Identity != that1.Identity {
+		return false
+	}
+	return true
 }
+func (this *UnpauseWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GetNamespaceReplicationMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Messages != nil {
-		{
-			size, err := m.Messages.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+	that1, ok := that.(*UnpauseWorkflowExecutionResponse)
+	if !ok {
+		that2, ok := that.(UnpauseWorkflowExecutionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *GetDLQReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetDLQReplicationMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *PauseActivityExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GetDLQReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TaskInfos) > 0 {
-		for iNdEx := len(m.TaskInfos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TaskInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	that1, ok := that.(*PauseActivityExecutionRequest)
+	if !ok {
+		that2, ok := that.(PauseActivityExecutionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Namespace != that1.Namespace {
+		return false
+	}
+	if !this.Execution.Equal(that1.Execution) {
+		return false
+	}
+	if this.ActivityId != that1.ActivityId {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if this.Identity != that1.Identity {
+		return false
+	}
+	return true
 }
+func (this *PauseActivityExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GetDLQReplicationMessagesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*PauseActivityExecutionResponse)
+	if !ok {
+		that2, ok := that.(PauseActivityExecutionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
+func (this *UnpauseActivityExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GetDLQReplicationMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*UnpauseActivityExecutionRequest)
+	if !ok {
+		that2, ok := that.(UnpauseActivityExecutionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Namespace != that1.Namespace {
+		return false
+	}
+	if !this.Execution.Equal(that1.Execution) {
+		return false
+	}
+	if this.ActivityId != that1.ActivityId {
+		return false
+	}
+	if this.Identity != that1.Identity {
+		return false
+	}
+	return true
 }
+func (this *UnpauseActivityExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GetDLQReplicationMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ReplicationTasks) > 0 {
-		for iNdEx := len(m.ReplicationTasks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ReplicationTasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	that1, ok := that.(*UnpauseActivityExecutionResponse)
+	if !ok {
+		that2, ok := that.(UnpauseActivityExecutionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
+func (this *ResetActivityExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ReapplyEventsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*ResetActivityExecutionRequest)
+	if !ok {
+		that2, ok := that.(ResetActivityExecutionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Namespace != that1.Namespace {
+		return false
+	}
+	if !this.Execution.Equal(that1.Execution) {
+		return false
+	}
+	if this.ActivityId != that1.ActivityId {
+		return false
+	}
+	if this.Identity != that1.Identity {
+		return false
+	}
+	return true
 }
+func (this *ResetActivityExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ReapplyEventsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*ResetActivityExecutionResponse)
+	if !ok {
+		that2, ok := that.(ResetActivityExecutionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
-
-func (m *ReapplyEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NamespaceId) > 0 {
-		i -= len(m.NamespaceId)
-		copy(dAtA[i:], m.NamespaceId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
-		i--
-		dAtA[i] = 0x22
+func (this *UpdateActivityExecutionOptionsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.Events != nil {
-		{
-			size, err := m.Events.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+
+	that1, ok := that.(*UpdateActivityExecutionOptionsRequest)
+	if !ok {
+		that2, ok := that.(UpdateActivityExecutionOptionsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	if m.WorkflowExecution != nil {
-		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
+	if this.Namespace != that1.Namespace {
+		return false
+	}
+	if !this.Execution.Equal(that1.Execution) {
+		return false
+	}
+	if this.ActivityId != that1.ActivityId {
+		return false
+	}
+	if !this.Options.Equal(that1.Options) {
+		return false
+	}
+	if this.Identity != that1.Identity {
+		return false
+	}
+	return true
 }
+func (this *UpdateActivityExecutionOptionsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ReapplyEventsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*UpdateActivityExecutionOptionsResponse)
+	if !ok {
+		that2, ok := that.(UpdateActivityExecutionOptionsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
+func (this *ListAuditRecordsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ReapplyEventsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ReapplyEventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *AddSearchAttributesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*ListAuditRecordsRequest)
+	if !ok {
+		that2, ok := that.(ListAuditRecordsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AddSearchAttributesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Namespace != that1.Namespace {
+		return false
+	}
+	if this.Principal != that1.Principal {
+		return false
+	}
+	if this.ApiName != that1.ApiName {
+		return false
+	}
+	if that1.StartTime == nil {
+		if this.StartTime != nil {
+			return false
+		}
+	} else if !this.StartTime.Equal(*that1.StartTime) {
+		return false
+	}
+	if that1.EndTime == nil {
+		if this.EndTime != nil {
+			return false
+		}
+	} else if !this.EndTime.Equal(*that1.EndTime) {
+		return false
+	}
+	if this.PageSize != that1.PageSize {
+		return false
+	}
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
+	}
+	return true
 }
-
-func (m *AddSearchAttributesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0x22
+func (this *ListAuditRecordsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.SkipSchemaUpdate {
-		i--
-		if m.SkipSchemaUpdate {
-			dAtA[i] = 1
+
+	that1, ok := that.(*ListAuditRecordsResponse)
+	if !ok {
+		that2, ok := that.(ListAuditRecordsResponse)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x18
 	}
-	if len(m.IndexName) > 0 {
-		i -= len(m.IndexName)
-		copy(dAtA[i:], m.IndexName)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.IndexName)))
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.SearchAttributes) > 0 {
-		for k := range m.SearchAttributes {
-			v := m.SearchAttributes[k]
-			baseI := i
-			i = encodeVarintRequestResponse(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintRequestResponse(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
+	if len(this.Records) != len(that1.Records) {
+		return false
+	}
+	for i := range this.Records {
+		if !this.Records[i].Equal(that1.Records[i]) {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *AddSearchAttributesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AddSearchAttributesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *AddSearchAttributesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *RemoveSearchAttributesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *CreateNamespaceApiKeyRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RemoveSearchAttributesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *RemoveSearchAttributesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0x1a
+	that1, ok := that.(*CreateNamespaceApiKeyRequest)
+	if !ok {
+		that2, ok := that.(CreateNamespaceApiKeyRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.IndexName) > 0 {
-		i -= len(m.IndexName)
-		copy(dAtA[i:], m.IndexName)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.IndexName)))
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.SearchAttributes) > 0 {
-		for iNdEx := len(m.SearchAttributes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.SearchAttributes[iNdEx])
-			copy(dAtA[i:], m.SearchAttributes[iNdEx])
-			i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.SearchAttributes[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+	if this.Namespace != that1.Namespace {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Role != that1.Role {
+		return false
+	}
+	if this.Ttl != nil && that1.Ttl != nil {
+		if *this.Ttl != *that1.Ttl {
+			return false
 		}
+	} else if this.Ttl != nil {
+		return false
+	} else if that1.Ttl != nil {
+		return false
 	}
-	return len(dAtA) - i, nil
+	if this.Identity != that1.Identity {
+		return false
+	}
+	return true
 }
+func (this *CreateNamespaceApiKeyResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *RemoveSearchAttributesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*CreateNamespaceApiKeyResponse)
+	if !ok {
+		that2, ok := that.(CreateNamespaceApiKeyResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApiKey.Equal(that1.ApiKey) {
+		return false
+	}
+	if this.Token != that1.Token {
+		return false
+	}
+	return true
 }
+func (this *ListNamespaceApiKeysRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *RemoveSearchAttributesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*ListNamespaceApiKeysRequest)
+	if !ok {
+		that2, ok := that.(ListNamespaceApiKeysRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Namespace != that1.Namespace {
+		return false
+	}
+	return true
 }
+func (this *ListNamespaceApiKeysResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *RemoveSearchAttributesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	that1, ok := that.(*ListNamespaceApiKeysResponse)
+	if !ok {
+		that2, ok := that.(ListNamespaceApiKeysResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.ApiKeys) != len(that1.ApiKeys) {
+		return false
+	}
+	for i := range this.ApiKeys {
+		if !this.ApiKeys[i].Equal(that1.ApiKeys[i]) {
+			return false
+		}
+	}
+	return true
 }
+func (this *RotateNamespaceApiKeyRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GetSearchAttributesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*RotateNamespaceApiKeyRequest)
+	if !ok {
+		that2, ok := that.(RotateNamespaceApiKeyRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Namespace != that1.Namespace {
+		return false
+	}
+	if this.KeyId != that1.KeyId {
+		return false
+	}
+	if this.Ttl != nil && that1.Ttl != nil {
+		if *this.Ttl != *that1.Ttl {
+			return false
+		}
+	} else if this.Ttl != nil {
+		return false
+	} else if that1.Ttl != nil {
+		return false
+	}
+	return true
 }
+func (this *RotateNamespaceApiKeyResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GetSearchAttributesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*RotateNamespaceApiKeyResponse)
+	if !ok {
+		that2, ok := that.(RotateNamespaceApiKeyResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApiKey.Equal(that1.ApiKey) {
+		return false
+	}
+	if this.Token != that1.Token {
+		return false
+	}
+	return true
 }
+func (this *RevokeNamespaceApiKeyRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GetSearchAttributesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0x12
+	that1, ok := that.(*RevokeNamespaceApiKeyRequest)
+	if !ok {
+		that2, ok := that.(RevokeNamespaceApiKeyRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.IndexName) > 0 {
-		i -= len(m.IndexName)
-		copy(dAtA[i:], m.IndexName)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.IndexName)))
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
+	if this.Namespace != that1.Namespace {
+		return false
+	}
+	if this.KeyId != that1.KeyId {
+		return false
+	}
+	return true
 }
+func (this *RevokeNamespaceApiKeyResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GetSearchAttributesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*RevokeNamespaceApiKeyResponse)
+	if !ok {
+		that2, ok := that.(RevokeNamespaceApiKeyResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
+func (this *GetReplicationStatusRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GetSearchAttributesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*GetReplicationStatusRequest)
+	if !ok {
+		that2, ok := that.(GetReplicationStatusRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.RemoteClusters) != len(that1.RemoteClusters) {
+		return false
+	}
+	for i := range this.RemoteClusters {
+		if this.RemoteClusters[i] != that1.RemoteClusters[i] {
+			return false
+		}
+	}
+	if this.IncludeShards != that1.IncludeShards {
+		return false
+	}
+	return true
 }
+func (this *GetReplicationStatusResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GetSearchAttributesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AddWorkflowExecutionInfo != nil {
-		{
-			size, err := m.AddWorkflowExecutionInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+	that1, ok := that.(*GetReplicationStatusResponse)
+	if !ok {
+		that2, ok := that.(GetReplicationStatusResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x22
 	}
-	if len(m.Mapping) > 0 {
-		for k := range m.Mapping {
-			v := m.Mapping[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintRequestResponse(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintRequestResponse(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1a
-		}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.SystemAttributes) > 0 {
-		for k := range m.SystemAttributes {
-			v := m.SystemAttributes[k]
-			baseI := i
-			i = encodeVarintRequestResponse(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintRequestResponse(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x12
+	if len(this.RemoteClusters) != len(that1.RemoteClusters) {
+		return false
+	}
+	for i := range this.RemoteClusters {
+		if !this.RemoteClusters[i].Equal(that1.RemoteClusters[i]) {
+			return false
 		}
 	}
-	if len(m.CustomAttributes) > 0 {
-		for k := range m.CustomAttributes {
-			v := m.CustomAttributes[k]
-			baseI := i
-			i = encodeVarintRequestResponse(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintRequestResponse(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
+	if len(this.Shards) != len(that1.Shards) {
+		return false
+	}
+	for i := range this.Shards {
+		if !this.Shards[i].Equal(that1.Shards[i]) {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *DescribeClusterRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ClusterReplicationStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *DescribeClusterRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *DescribeClusterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ClusterName) > 0 {
-		i -= len(m.ClusterName)
-		copy(dAtA[i:], m.ClusterName)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ClusterName)))
-		i--
-		dAtA[i] = 0xa
+	that1, ok := that.(*ClusterReplicationStatus)
+	if !ok {
+		that2, ok := that.(ClusterReplicationStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DescribeClusterResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DescribeClusterResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.ShardCount != that1.ShardCount {
+		return false
+	}
+	if this.MaxOutboundLag != nil && that1.MaxOutboundLag != nil {
+		if *this.MaxOutboundLag != *that1.MaxOutboundLag {
+			return false
+		}
+	} else if this.MaxOutboundLag != nil {
+		return false
+	} else if that1.MaxOutboundLag != nil {
+		return false
+	}
+	if this.MaxInboundLag != nil && that1.MaxInboundLag != nil {
+		if *this.MaxInboundLag != *that1.MaxInboundLag {
+			return false
+		}
+	} else if this.MaxInboundLag != nil {
+		return false
+	} else if that1.MaxInboundLag != nil {
+		return false
+	}
+	if this.InboundDlqTaskCount != that1.InboundDlqTaskCount {
+		return false
+	}
+	if this.RetryingProcessorCount != that1.RetryingProcessorCount {
+		return false
+	}
+	if this.StoppedProcessorCount != that1.StoppedProcessorCount {
+		return false
+	}
+	return true
 }
+func (this *ShardReplicationStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DescribeClusterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsGlobalNamespaceEnabled {
-		i--
-		if m.IsGlobalNamespaceEnabled {
-			dAtA[i] = 1
+	that1, ok := that.(*ShardReplicationStatus)
+	if !ok {
+		that2, ok := that.(ShardReplicationStatus)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x60
 	}
-	if m.InitialFailoverVersion != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.InitialFailoverVersion))
-		i--
-		dAtA[i] = 0x58
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.FailoverVersionIncrement != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.FailoverVersionIncrement))
-		i--
-		dAtA[i] = 0x50
+	if this.ShardId != that1.ShardId {
+		return false
 	}
-	if m.VersionInfo != nil {
-		{
-			size, err := m.VersionInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+	if this.MaxReplicationTaskId != that1.MaxReplicationTaskId {
+		return false
+	}
+	if that1.MaxReplicationTaskVisibilityTime == nil {
+		if this.MaxReplicationTaskVisibilityTime != nil {
+			return false
 		}
-		i--
-		dAtA[i] = 0x4a
+	} else if !this.MaxReplicationTaskVisibilityTime.Equal(*that1.MaxReplicationTaskVisibilityTime) {
+		return false
 	}
-	if len(m.VisibilityStore) > 0 {
-		i -= len(m.VisibilityStore)
-		copy(dAtA[i:], m.VisibilityStore)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.VisibilityStore)))
-		i--
-		dAtA[i] = 0x42
+	if len(this.RemoteClusters) != len(that1.RemoteClusters) {
+		return false
 	}
-	if len(m.PersistenceStore) > 0 {
-		i -= len(m.PersistenceStore)
-		copy(dAtA[i:], m.PersistenceStore)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.PersistenceStore)))
-		i--
-		dAtA[i] = 0x3a
+	for i := range this.RemoteClusters {
+		if !this.RemoteClusters[i].Equal(that1.RemoteClusters[i]) {
+			return false
+		}
 	}
-	if m.HistoryShardCount != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.HistoryShardCount))
-		i--
-		dAtA[i] = 0x30
+	if len(this.HandoverReplicationTaskIds) != len(that1.HandoverReplicationTaskIds) {
+		return false
 	}
-	if len(m.ClusterName) > 0 {
-		i -= len(m.ClusterName)
-		copy(dAtA[i:], m.ClusterName)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ClusterName)))
-		i--
-		dAtA[i] = 0x2a
+	for i := range this.HandoverReplicationTaskIds {
+		if this.HandoverReplicationTaskIds[i] != that1.HandoverReplicationTaskIds[i] {
+			return false
+		}
 	}
-	if len(m.ClusterId) > 0 {
-		i -= len(m.ClusterId)
-		copy(dAtA[i:], m.ClusterId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ClusterId)))
-		i--
-		dAtA[i] = 0x22
+	return true
+}
+func (this *ShardClusterReplicationStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.MembershipInfo != nil {
-		{
-			size, err := m.MembershipInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+
+	that1, ok := that.(*ShardClusterReplicationStatus)
+	if !ok {
+		that2, ok := that.(ShardClusterReplicationStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	if len(m.ServerVersion) > 0 {
-		i -= len(m.ServerVersion)
-		copy(dAtA[i:], m.ServerVersion)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ServerVersion)))
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.SupportedClients) > 0 {
-		for k := range m.SupportedClients {
-			v := m.SupportedClients[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintRequestResponse(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintRequestResponse(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
+	if this.AckedTaskId != that1.AckedTaskId {
+		return false
+	}
+	if that1.AckedTaskVisibilityTime == nil {
+		if this.AckedTaskVisibilityTime != nil {
+			return false
+		}
+	} else if !this.AckedTaskVisibilityTime.Equal(*that1.AckedTaskVisibilityTime) {
+		return false
+	}
+	if this.OutboundLag != nil && that1.OutboundLag != nil {
+		if *this.OutboundLag != *that1.OutboundLag {
+			return false
 		}
+	} else if this.OutboundLag != nil {
+		return false
+	} else if that1.OutboundLag != nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ListClustersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.Inbound.Equal(that1.Inbound) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ListClustersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *SetPersistenceFaultRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ListClustersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
-		i--
-		dAtA[i] = 0x12
+	that1, ok := that.(*SetPersistenceFaultRequest)
+	if !ok {
+		that2, ok := that.(SetPersistenceFaultRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.PageSize != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.PageSize))
-		i--
-		dAtA[i] = 0x8
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
+	if !this.Fault.Equal(that1.Fault) {
+		return false
+	}
+	if this.Ttl != nil && that1.Ttl != nil {
+		if *this.Ttl != *that1.Ttl {
+			return false
+		}
+	} else if this.Ttl != nil {
+		return false
+	} else if that1.Ttl != nil {
+		return false
+	}
+	return true
 }
+func (this *SetPersistenceFaultResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ListClustersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*SetPersistenceFaultResponse)
+	if !ok {
+		that2, ok := that.(SetPersistenceFaultResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ListClustersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ListClustersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Clusters) > 0 {
-		for iNdEx := len(m.Clusters) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Clusters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if !this.Fault.Equal(that1.Fault) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *AddOrUpdateRemoteClusterRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *RemovePersistenceFaultRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *AddOrUpdateRemoteClusterRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*RemovePersistenceFaultRequest)
+	if !ok {
+		that2, ok := that.(RemovePersistenceFaultRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	return true
 }
+func (this *RemovePersistenceFaultResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *AddOrUpdateRemoteClusterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EnableRemoteClusterConnection {
-		i--
-		if m.EnableRemoteClusterConnection {
-			dAtA[i] = 1
+	that1, ok := that.(*RemovePersistenceFaultResponse)
+	if !ok {
+		that2, ok := that.(RemovePersistenceFaultResponse)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x10
 	}
-	if len(m.FrontendAddress) > 0 {
-		i -= len(m.FrontendAddress)
-		copy(dAtA[i:], m.FrontendAddress)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.FrontendAddress)))
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *AddOrUpdateRemoteClusterResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *RebuildMutableStateRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 6)
+	s = append(s, "&adminservice.RebuildMutableStateRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *AddOrUpdateRemoteClusterResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *RebuildMutableStateResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.RebuildMutableStateResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *AddOrUpdateRemoteClusterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (this *DescribeMutableStateRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&adminservice.DescribeMutableStateRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *RemoveRemoteClusterRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *DescribeMutableStateResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 8)
+	s = append(s, "&adminservice.DescribeMutableStateResponse{")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "HistoryAddr: "+fmt.Sprintf("%#v", this.HistoryAddr)+",\n")
+	if this.CacheMutableState != nil {
+		s = append(s, "CacheMutableState: "+fmt.Sprintf("%#v", this.CacheMutableState)+",\n")
+	}
+	if this.DatabaseMutableState != nil {
+		s = append(s, "DatabaseMutableState: "+fmt.Sprintf("%#v", this.DatabaseMutableState)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *RemoveRemoteClusterRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *DescribeHistoryHostRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&adminservice.DescribeHistoryHostRequest{")
+	s = append(s, "HostAddress: "+fmt.Sprintf("%#v", this.HostAddress)+",\n")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	if this.WorkflowExecution != nil {
+		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *RemoveRemoteClusterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ClusterName) > 0 {
-		i -= len(m.ClusterName)
-		copy(dAtA[i:], m.ClusterName)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ClusterName)))
-		i--
-		dAtA[i] = 0xa
+func (this *DescribeHistoryHostResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return len(dAtA) - i, nil
+	s := make([]string, 0, 9)
+	s = append(s, "&adminservice.DescribeHistoryHostResponse{")
+	s = append(s, "ShardsNumber: "+fmt.Sprintf("%#v", this.ShardsNumber)+",\n")
+	s = append(s, "ShardIds: "+fmt.Sprintf("%#v", this.ShardIds)+",\n")
+	if this.NamespaceCache != nil {
+		s = append(s, "NamespaceCache: "+fmt.Sprintf("%#v", this.NamespaceCache)+",\n")
+	}
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	if this.Certificates != nil {
+		s = append(s, "Certificates: "+fmt.Sprintf("%#v", this.Certificates)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *RemoveRemoteClusterResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *CloseShardRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 5)
+	s = append(s, "&adminservice.CloseShardRequest{")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *RemoveRemoteClusterResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *CloseShardResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.CloseShardResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *RemoveRemoteClusterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *ListClusterMembersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *GetShardRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ListClusterMembersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	s := make([]string, 0, 5)
+	s = append(s, "&adminservice.GetShardRequest{")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ListClusterMembersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.PageSize != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.PageSize))
-		i--
-		dAtA[i] = 0x30
+func (this *GetShardResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.SessionStartedAfterTime != nil {
-		n24, err24 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.SessionStartedAfterTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.SessionStartedAfterTime):])
-		if err24 != nil {
-			return 0, err24
-		}
-		i -= n24
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n24))
-		i--
-		dAtA[i] = 0x2a
+	s := make([]string, 0, 5)
+	s = append(s, "&adminservice.GetShardResponse{")
+	if this.ShardInfo != nil {
+		s = append(s, "ShardInfo: "+fmt.Sprintf("%#v", this.ShardInfo)+",\n")
 	}
-	if m.Role != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Role))
-		i--
-		dAtA[i] = 0x20
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ListHistoryTasksRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.HostId) > 0 {
-		i -= len(m.HostId)
-		copy(dAtA[i:], m.HostId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.HostId)))
-		i--
-		dAtA[i] = 0x1a
+	s := make([]string, 0, 9)
+	s = append(s, "&adminservice.ListHistoryTasksRequest{")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "Category: "+fmt.Sprintf("%#v", this.Category)+",\n")
+	if this.TaskRange != nil {
+		s = append(s, "TaskRange: "+fmt.Sprintf("%#v", this.TaskRange)+",\n")
 	}
-	if len(m.RpcAddress) > 0 {
-		i -= len(m.RpcAddress)
-		copy(dAtA[i:], m.RpcAddress)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.RpcAddress)))
-		i--
-		dAtA[i] = 0x12
+	s = append(s, "BatchSize: "+fmt.Sprintf("%#v", this.BatchSize)+",\n")
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ListHistoryTasksResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.LastHeartbeatWithin != nil {
-		n25, err25 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.LastHeartbeatWithin, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.LastHeartbeatWithin):])
-		if err25 != nil {
-			return 0, err25
-		}
-		i -= n25
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n25))
-		i--
-		dAtA[i] = 0xa
+	s := make([]string, 0, 6)
+	s = append(s, "&adminservice.ListHistoryTasksResponse{")
+	if this.Tasks != nil {
+		s = append(s, "Tasks: "+fmt.Sprintf("%#v", this.Tasks)+",\n")
 	}
-	return len(dAtA) - i, nil
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ListClusterMembersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *Task) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ListClusterMembersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	s := make([]string, 0, 11)
+	s = append(s, "&adminservice.Task{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
+	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
+	s = append(s, "TaskId: "+fmt.Sprintf("%#v", this.TaskId)+",\n")
+	s = append(s, "TaskType: "+fmt.Sprintf("%#v", this.TaskType)+",\n")
+	s = append(s, "FireTime: "+fmt.Sprintf("%#v", this.FireTime)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ListClusterMembersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ActiveMembers) > 0 {
-		for iNdEx := len(m.ActiveMembers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ActiveMembers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (this *RemoveTaskRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return len(dAtA) - i, nil
+	s := make([]string, 0, 8)
+	s = append(s, "&adminservice.RemoveTaskRequest{")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "Category: "+fmt.Sprintf("%#v", this.Category)+",\n")
+	s = append(s, "TaskId: "+fmt.Sprintf("%#v", this.TaskId)+",\n")
+	s = append(s, "VisibilityTime: "+fmt.Sprintf("%#v", this.VisibilityTime)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *GetDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *RemoveTaskResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.RemoveTaskResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *GetDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
-		i--
-		dAtA[i] = 0x32
+func (this *GetWorkflowExecutionRawHistoryV2Request) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.MaximumPageSize != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.MaximumPageSize))
-		i--
-		dAtA[i] = 0x28
+	s := make([]string, 0, 12)
+	s = append(s, "&adminservice.GetWorkflowExecutionRawHistoryV2Request{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
 	}
-	if m.InclusiveEndMessageId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.InclusiveEndMessageId))
-		i--
-		dAtA[i] = 0x20
+	s = append(s, "StartEventId: "+fmt.Sprintf("%#v", this.StartEventId)+",\n")
+	s = append(s, "StartEventVersion: "+fmt.Sprintf("%#v", this.StartEventVersion)+",\n")
+	s = append(s, "EndEventId: "+fmt.Sprintf("%#v", this.EndEventId)+",\n")
+	s = append(s, "EndEventVersion: "+fmt.Sprintf("%#v", this.EndEventVersion)+",\n")
+	s = append(s, "MaximumPageSize: "+fmt.Sprintf("%#v", this.MaximumPageSize)+",\n")
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetWorkflowExecutionRawHistoryV2Response) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.SourceCluster) > 0 {
-		i -= len(m.SourceCluster)
-		copy(dAtA[i:], m.SourceCluster)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.SourceCluster)))
-		i--
-		dAtA[i] = 0x1a
+	s := make([]string, 0, 8)
+	s = append(s, "&adminservice.GetWorkflowExecutionRawHistoryV2Response{")
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	if this.HistoryBatches != nil {
+		s = append(s, "HistoryBatches: "+fmt.Sprintf("%#v", this.HistoryBatches)+",\n")
 	}
-	if m.ShardId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
-		i--
-		dAtA[i] = 0x10
+	if this.VersionHistory != nil {
+		s = append(s, "VersionHistory: "+fmt.Sprintf("%#v", this.VersionHistory)+",\n")
 	}
-	if m.Type != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+	s = append(s, "HistoryNodeIds: "+fmt.Sprintf("%#v", this.HistoryNodeIds)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *GetDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *GetReplicationMessagesRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	s := make([]string, 0, 6)
+	s = append(s, "&adminservice.GetReplicationMessagesRequest{")
+	if this.Tokens != nil {
+		s = append(s, "Tokens: "+fmt.Sprintf("%#v", this.Tokens)+",\n")
+	}
+	s = append(s, "ClusterName: "+fmt.Sprintf("%#v", this.ClusterName)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *GetDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
-		i--
-		dAtA[i] = 0x1a
+func (this *GetReplicationMessagesResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.ReplicationTasks) > 0 {
-		for iNdEx := len(m.ReplicationTasks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ReplicationTasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	s := make([]string, 0, 5)
+	s = append(s, "&adminservice.GetReplicationMessagesResponse{")
+	keysForShardMessages := make([]int32, 0, len(this.ShardMessages))
+	for k, _ := range this.ShardMessages {
+		keysForShardMessages = append(keysForShardMessages, k)
 	}
-	if m.Type != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x8
+	github_com_gogo_protobuf_sortkeys.Int32s(keysForShardMessages)
+	mapStringForShardMessages := "map[int32]*v16.ReplicationMessages{"
+	for _, k := range keysForShardMessages {
+		mapStringForShardMessages += fmt.Sprintf("%#v: %#v,", k, this.ShardMessages[k])
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *PurgeDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	mapStringForShardMessages += "}"
+	if this.ShardMessages != nil {
+		s = append(s, "ShardMessages: "+mapStringForShardMessages+",\n")
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PurgeDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *PurgeDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.InclusiveEndMessageId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.InclusiveEndMessageId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.SourceCluster) > 0 {
-		i -= len(m.SourceCluster)
-		copy(dAtA[i:], m.SourceCluster)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.SourceCluster)))
-		i--
-		dAtA[i] = 0x1a
+func (this *GetNamespaceReplicationMessagesRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.ShardId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
-		i--
-		dAtA[i] = 0x10
+	s := make([]string, 0, 7)
+	s = append(s, "&adminservice.GetNamespaceReplicationMessagesRequest{")
+	s = append(s, "LastRetrievedMessageId: "+fmt.Sprintf("%#v", this.LastRetrievedMessageId)+",\n")
+	s = append(s, "LastProcessedMessageId: "+fmt.Sprintf("%#v", this.LastProcessedMessageId)+",\n")
+	s = append(s, "ClusterName: "+fmt.Sprintf("%#v", this.ClusterName)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetNamespaceReplicationMessagesResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.Type != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x8
+	s := make([]string, 0, 5)
+	s = append(s, "&adminservice.GetNamespaceReplicationMessagesResponse{")
+	if this.Messages != nil {
+		s = append(s, "Messages: "+fmt.Sprintf("%#v", this.Messages)+",\n")
 	}
-	return len(dAtA) - i, nil
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *PurgeDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *GetDLQReplicationMessagesRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PurgeDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *PurgeDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MergeDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	s := make([]string, 0, 5)
+	s = append(s, "&adminservice.GetDLQReplicationMessagesRequest{")
+	if this.TaskInfos != nil {
+		s = append(s, "TaskInfos: "+fmt.Sprintf("%#v", this.TaskInfos)+",\n")
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MergeDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *MergeDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.MaximumPageSize != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.MaximumPageSize))
-		i--
-		dAtA[i] = 0x28
+func (this *GetDLQReplicationMessagesResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.InclusiveEndMessageId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.InclusiveEndMessageId))
-		i--
-		dAtA[i] = 0x20
+	s := make([]string, 0, 5)
+	s = append(s, "&adminservice.GetDLQReplicationMessagesResponse{")
+	if this.ReplicationTasks != nil {
+		s = append(s, "ReplicationTasks: "+fmt.Sprintf("%#v", this.ReplicationTasks)+",\n")
 	}
-	if len(m.SourceCluster) > 0 {
-		i -= len(m.SourceCluster)
-		copy(dAtA[i:], m.SourceCluster)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.SourceCluster)))
-		i--
-		dAtA[i] = 0x1a
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ReapplyEventsRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.ShardId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
-		i--
-		dAtA[i] = 0x10
+	s := make([]string, 0, 7)
+	s = append(s, "&adminservice.ReapplyEventsRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.WorkflowExecution != nil {
+		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
 	}
-	if m.Type != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x8
+	if this.Events != nil {
+		s = append(s, "Events: "+fmt.Sprintf("%#v", this.Events)+",\n")
 	}
-	return len(dAtA) - i, nil
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *MergeDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ReapplyEventsResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.ReapplyEventsResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *MergeDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MergeDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
-		i--
-		dAtA[i] = 0xa
+func (this *AddSearchAttributesRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *RefreshWorkflowTasksRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	s := make([]string, 0, 8)
+	s = append(s, "&adminservice.AddSearchAttributesRequest{")
+	keysForSearchAttributes := make([]string, 0, len(this.SearchAttributes))
+	for k, _ := range this.SearchAttributes {
+		keysForSearchAttributes = append(keysForSearchAttributes, k)
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RefreshWorkflowTasksRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RefreshWorkflowTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NamespaceId) > 0 {
-		i -= len(m.NamespaceId)
-		copy(dAtA[i:], m.NamespaceId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
-		i--
-		dAtA[i] = 0x1a
+	github_com_gogo_protobuf_sortkeys.Strings(keysForSearchAttributes)
+	mapStringForSearchAttributes := "map[string]v17.IndexedValueType{"
+	for _, k := range keysForSearchAttributes {
+		mapStringForSearchAttributes += fmt.Sprintf("%#v: %#v,", k, this.SearchAttributes[k])
 	}
-	if m.Execution != nil {
-		{
-			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	mapStringForSearchAttributes += "}"
+	if this.SearchAttributes != nil {
+		s = append(s, "SearchAttributes: "+mapStringForSearchAttributes+",\n")
 	}
-	return len(dAtA) - i, nil
+	s = append(s, "IndexName: "+fmt.Sprintf("%#v", this.IndexName)+",\n")
+	s = append(s, "SkipSchemaUpdate: "+fmt.Sprintf("%#v", this.SkipSchemaUpdate)+",\n")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *RefreshWorkflowTasksResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *AddSearchAttributesResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RefreshWorkflowTasksResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.AddSearchAttributesResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *RefreshWorkflowTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (this *RemoveSearchAttributesRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&adminservice.RemoveSearchAttributesRequest{")
+	s = append(s, "SearchAttributes: "+fmt.Sprintf("%#v", this.SearchAttributes)+",\n")
+	s = append(s, "IndexName: "+fmt.Sprintf("%#v", this.IndexName)+",\n")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ResendReplicationTasksRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *RemoveSearchAttributesResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.RemoveSearchAttributesResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ResendReplicationTasksRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *GetSearchAttributesRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&adminservice.GetSearchAttributesRequest{")
+	s = append(s, "IndexName: "+fmt.Sprintf("%#v", this.IndexName)+",\n")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ResendReplicationTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EndVersion != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.EndVersion))
-		i--
-		dAtA[i] = 0x40
+func (this *GetSearchAttributesResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.EndEventId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.EndEventId))
-		i--
-		dAtA[i] = 0x38
+	s := make([]string, 0, 8)
+	s = append(s, "&adminservice.GetSearchAttributesResponse{")
+	keysForCustomAttributes := make([]string, 0, len(this.CustomAttributes))
+	for k, _ := range this.CustomAttributes {
+		keysForCustomAttributes = append(keysForCustomAttributes, k)
 	}
-	if m.StartVersion != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.StartVersion))
-		i--
-		dAtA[i] = 0x30
+	github_com_gogo_protobuf_sortkeys.Strings(keysForCustomAttributes)
+	mapStringForCustomAttributes := "map[string]v17.IndexedValueType{"
+	for _, k := range keysForCustomAttributes {
+		mapStringForCustomAttributes += fmt.Sprintf("%#v: %#v,", k, this.CustomAttributes[k])
 	}
-	if m.StartEventId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.StartEventId))
-		i--
-		dAtA[i] = 0x28
+	mapStringForCustomAttributes += "}"
+	if this.CustomAttributes != nil {
+		s = append(s, "CustomAttributes: "+mapStringForCustomAttributes+",\n")
 	}
-	if len(m.RemoteCluster) > 0 {
-		i -= len(m.RemoteCluster)
-		copy(dAtA[i:], m.RemoteCluster)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.RemoteCluster)))
-		i--
-		dAtA[i] = 0x22
+	keysForSystemAttributes := make([]string, 0, len(this.SystemAttributes))
+	for k, _ := range this.SystemAttributes {
+		keysForSystemAttributes = append(keysForSystemAttributes, k)
 	}
-	if len(m.RunId) > 0 {
-		i -= len(m.RunId)
-		copy(dAtA[i:], m.RunId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.RunId)))
-		i--
-		dAtA[i] = 0x1a
+	github_com_gogo_protobuf_sortkeys.Strings(keysForSystemAttributes)
+	mapStringForSystemAttributes := "map[string]v17.IndexedValueType{"
+	for _, k := range keysForSystemAttributes {
+		mapStringForSystemAttributes += fmt.Sprintf("%#v: %#v,", k, this.SystemAttributes[k])
 	}
-	if len(m.WorkflowId) > 0 {
-		i -= len(m.WorkflowId)
-		copy(dAtA[i:], m.WorkflowId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.WorkflowId)))
-		i--
-		dAtA[i] = 0x12
+	mapStringForSystemAttributes += "}"
+	if this.SystemAttributes != nil {
+		s = append(s, "SystemAttributes: "+mapStringForSystemAttributes+",\n")
 	}
-	if len(m.NamespaceId) > 0 {
-		i -= len(m.NamespaceId)
-		copy(dAtA[i:], m.NamespaceId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
-		i--
-		dAtA[i] = 0xa
+	keysForMapping := make([]string, 0, len(this.Mapping))
+	for k, _ := range this.Mapping {
+		keysForMapping = append(keysForMapping, k)
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ResendReplicationTasksResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	github_com_gogo_protobuf_sortkeys.Strings(keysForMapping)
+	mapStringForMapping := "map[string]string{"
+	for _, k := range keysForMapping {
+		mapStringForMapping += fmt.Sprintf("%#v: %#v,", k, this.Mapping[k])
 	}
-	return dAtA[:n], nil
+	mapStringForMapping += "}"
+	if this.Mapping != nil {
+		s = append(s, "Mapping: "+mapStringForMapping+",\n")
+	}
+	if this.AddWorkflowExecutionInfo != nil {
+		s = append(s, "AddWorkflowExecutionInfo: "+fmt.Sprintf("%#v", this.AddWorkflowExecutionInfo)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ResendReplicationTasksResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *DescribeClusterRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&adminservice.DescribeClusterRequest{")
+	s = append(s, "ClusterName: "+fmt.Sprintf("%#v", this.ClusterName)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ResendReplicationTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *GetTaskQueueTasksRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *DescribeClusterResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetTaskQueueTasksRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GetTaskQueueTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
-		i--
-		dAtA[i] = 0x3a
+	s := make([]string, 0, 18)
+	s = append(s, "&adminservice.DescribeClusterResponse{")
+	keysForSupportedClients := make([]string, 0, len(this.SupportedClients))
+	for k, _ := range this.SupportedClients {
+		keysForSupportedClients = append(keysForSupportedClients, k)
 	}
-	if m.BatchSize != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.BatchSize))
-		i--
-		dAtA[i] = 0x30
+	github_com_gogo_protobuf_sortkeys.Strings(keysForSupportedClients)
+	mapStringForSupportedClients := "map[string]string{"
+	for _, k := range keysForSupportedClients {
+		mapStringForSupportedClients += fmt.Sprintf("%#v: %#v,", k, this.SupportedClients[k])
 	}
-	if m.MaxTaskId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.MaxTaskId))
-		i--
-		dAtA[i] = 0x28
+	mapStringForSupportedClients += "}"
+	if this.SupportedClients != nil {
+		s = append(s, "SupportedClients: "+mapStringForSupportedClients+",\n")
 	}
-	if m.MinTaskId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.MinTaskId))
-		i--
-		dAtA[i] = 0x20
+	s = append(s, "ServerVersion: "+fmt.Sprintf("%#v", this.ServerVersion)+",\n")
+	if this.MembershipInfo != nil {
+		s = append(s, "MembershipInfo: "+fmt.Sprintf("%#v", this.MembershipInfo)+",\n")
 	}
-	if m.TaskQueueType != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.TaskQueueType))
-		i--
-		dAtA[i] = 0x18
+	s = append(s, "ClusterId: "+fmt.Sprintf("%#v", this.ClusterId)+",\n")
+	s = append(s, "ClusterName: "+fmt.Sprintf("%#v", this.ClusterName)+",\n")
+	s = append(s, "HistoryShardCount: "+fmt.Sprintf("%#v", this.HistoryShardCount)+",\n")
+	s = append(s, "PersistenceStore: "+fmt.Sprintf("%#v", this.PersistenceStore)+",\n")
+	s = append(s, "VisibilityStore: "+fmt.Sprintf("%#v", this.VisibilityStore)+",\n")
+	if this.VersionInfo != nil {
+		s = append(s, "VersionInfo: "+fmt.Sprintf("%#v", this.VersionInfo)+",\n")
 	}
-	if len(m.TaskQueue) > 0 {
-		i -= len(m.TaskQueue)
-		copy(dAtA[i:], m.TaskQueue)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskQueue)))
-		i--
-		dAtA[i] = 0x12
+	s = append(s, "FailoverVersionIncrement: "+fmt.Sprintf("%#v", this.FailoverVersionIncrement)+",\n")
+	s = append(s, "InitialFailoverVersion: "+fmt.Sprintf("%#v", this.InitialFailoverVersion)+",\n")
+	s = append(s, "IsGlobalNamespaceEnabled: "+fmt.Sprintf("%#v", this.IsGlobalNamespaceEnabled)+",\n")
+	if this.Certificates != nil {
+		s = append(s, "Certificates: "+fmt.Sprintf("%#v", this.Certificates)+",\n")
 	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0xa
+	if this.PersistenceFaults != nil {
+		s = append(s, "PersistenceFaults: "+fmt.Sprintf("%#v", this.PersistenceFaults)+",\n")
 	}
-	return len(dAtA) - i, nil
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *GetTaskQueueTasksResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ListClustersRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetTaskQueueTasksResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	s := make([]string, 0, 6)
+	s = append(s, "&adminservice.ListClustersRequest{")
+	s = append(s, "PageSize: "+fmt.Sprintf("%#v", this.PageSize)+",\n")
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *GetTaskQueueTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
-		i--
-		dAtA[i] = 0x12
+func (this *ListClustersResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.Tasks) > 0 {
-		for iNdEx := len(m.Tasks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Tasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	s := make([]string, 0, 6)
+	s = append(s, "&adminservice.ListClustersResponse{")
+	if this.Clusters != nil {
+		s = append(s, "Clusters: "+fmt.Sprintf("%#v", this.Clusters)+",\n")
 	}
-	return len(dAtA) - i, nil
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *DeleteWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *AddOrUpdateRemoteClusterRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DeleteWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	s := make([]string, 0, 6)
+	s = append(s, "&adminservice.AddOrUpdateRemoteClusterRequest{")
+	s = append(s, "FrontendAddress: "+fmt.Sprintf("%#v", this.FrontendAddress)+",\n")
+	s = append(s, "EnableRemoteClusterConnection: "+fmt.Sprintf("%#v", this.EnableRemoteClusterConnection)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *DeleteWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Execution != nil {
-		{
-			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0xa
+func (this *AddOrUpdateRemoteClusterResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return len(dAtA) - i, nil
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.AddOrUpdateRemoteClusterResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *DeleteWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *RemoveRemoteClusterRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DeleteWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	s := make([]string, 0, 5)
+	s = append(s, "&adminservice.RemoveRemoteClusterRequest{")
+	s = append(s, "ClusterName: "+fmt.Sprintf("%#v", this.ClusterName)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *DeleteWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Warnings) > 0 {
-		for iNdEx := len(m.Warnings) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Warnings[iNdEx])
-			copy(dAtA[i:], m.Warnings[iNdEx])
-			i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Warnings[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+func (this *RemoveRemoteClusterResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return len(dAtA) - i, nil
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.RemoveRemoteClusterResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func encodeVarintRequestResponse(dAtA []byte, offset int, v uint64) int {
-	offset -= sovRequestResponse(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (this *ListClusterMembersRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *RebuildMutableStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 11)
+	s = append(s, "&adminservice.ListClusterMembersRequest{")
+	s = append(s, "LastHeartbeatWithin: "+fmt.Sprintf("%#v", this.LastHeartbeatWithin)+",\n")
+	s = append(s, "RpcAddress: "+fmt.Sprintf("%#v", this.RpcAddress)+",\n")
+	s = append(s, "HostId: "+fmt.Sprintf("%#v", this.HostId)+",\n")
+	s = append(s, "Role: "+fmt.Sprintf("%#v", this.Role)+",\n")
+	s = append(s, "SessionStartedAfterTime: "+fmt.Sprintf("%#v", this.SessionStartedAfterTime)+",\n")
+	s = append(s, "PageSize: "+fmt.Sprintf("%#v", this.PageSize)+",\n")
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ListClusterMembersResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.Execution != nil {
-		l = m.Execution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 6)
+	s = append(s, "&adminservice.ListClusterMembersResponse{")
+	if this.ActiveMembers != nil {
+		s = append(s, "ActiveMembers: "+fmt.Sprintf("%#v", this.ActiveMembers)+",\n")
 	}
-	return n
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *RebuildMutableStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *GetDLQMessagesRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	return n
+	s := make([]string, 0, 10)
+	s = append(s, "&adminservice.GetDLQMessagesRequest{")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "SourceCluster: "+fmt.Sprintf("%#v", this.SourceCluster)+",\n")
+	s = append(s, "InclusiveEndMessageId: "+fmt.Sprintf("%#v", this.InclusiveEndMessageId)+",\n")
+	s = append(s, "MaximumPageSize: "+fmt.Sprintf("%#v", this.MaximumPageSize)+",\n")
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *DescribeMutableStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+func (this *GetDLQMessagesResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.Execution != nil {
-		l = m.Execution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 7)
+	s = append(s, "&adminservice.GetDLQMessagesResponse{")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	if this.ReplicationTasks != nil {
+		s = append(s, "ReplicationTasks: "+fmt.Sprintf("%#v", this.ReplicationTasks)+",\n")
 	}
-	return n
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *DescribeMutableStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ShardId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+func (this *PurgeDLQMessagesRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	l = len(m.HistoryAddr)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 8)
+	s = append(s, "&adminservice.PurgeDLQMessagesRequest{")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "SourceCluster: "+fmt.Sprintf("%#v", this.SourceCluster)+",\n")
+	s = append(s, "InclusiveEndMessageId: "+fmt.Sprintf("%#v", this.InclusiveEndMessageId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PurgeDLQMessagesResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.CacheMutableState != nil {
-		l = m.CacheMutableState.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.PurgeDLQMessagesResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MergeDLQMessagesRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.DatabaseMutableState != nil {
-		l = m.DatabaseMutableState.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 10)
+	s = append(s, "&adminservice.MergeDLQMessagesRequest{")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "SourceCluster: "+fmt.Sprintf("%#v", this.SourceCluster)+",\n")
+	s = append(s, "InclusiveEndMessageId: "+fmt.Sprintf("%#v", this.InclusiveEndMessageId)+",\n")
+	s = append(s, "MaximumPageSize: "+fmt.Sprintf("%#v", this.MaximumPageSize)+",\n")
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MergeDLQMessagesResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return n
+	s := make([]string, 0, 5)
+	s = append(s, "&adminservice.MergeDLQMessagesResponse{")
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *DescribeHistoryHostRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *RefreshWorkflowTasksRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	l = len(m.HostAddress)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 6)
+	s = append(s, "&adminservice.RefreshWorkflowTasksRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
 	}
-	if m.ShardId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RefreshWorkflowTasksResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.RefreshWorkflowTasksResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ResendReplicationTasksRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 12)
+	s = append(s, "&adminservice.ResendReplicationTasksRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
+	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
+	s = append(s, "RemoteCluster: "+fmt.Sprintf("%#v", this.RemoteCluster)+",\n")
+	s = append(s, "StartEventId: "+fmt.Sprintf("%#v", this.StartEventId)+",\n")
+	s = append(s, "StartVersion: "+fmt.Sprintf("%#v", this.StartVersion)+",\n")
+	s = append(s, "EndEventId: "+fmt.Sprintf("%#v", this.EndEventId)+",\n")
+	s = append(s, "EndVersion: "+fmt.Sprintf("%#v", this.EndVersion)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ResendReplicationTasksResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return n
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.ResendReplicationTasksResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *DescribeHistoryHostResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *GetTaskQueueTasksRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	if m.ShardsNumber != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ShardsNumber))
+	s := make([]string, 0, 11)
+	s = append(s, "&adminservice.GetTaskQueueTasksRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	s = append(s, "TaskQueueType: "+fmt.Sprintf("%#v", this.TaskQueueType)+",\n")
+	s = append(s, "MinTaskId: "+fmt.Sprintf("%#v", this.MinTaskId)+",\n")
+	s = append(s, "MaxTaskId: "+fmt.Sprintf("%#v", this.MaxTaskId)+",\n")
+	s = append(s, "BatchSize: "+fmt.Sprintf("%#v", this.BatchSize)+",\n")
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetTaskQueueTasksResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.ShardIds) > 0 {
-		l = 0
-		for _, e := range m.ShardIds {
-			l += sovRequestResponse(uint64(e))
-		}
-		n += 1 + sovRequestResponse(uint64(l)) + l
+	s := make([]string, 0, 6)
+	s = append(s, "&adminservice.GetTaskQueueTasksResponse{")
+	if this.Tasks != nil {
+		s = append(s, "Tasks: "+fmt.Sprintf("%#v", this.Tasks)+",\n")
 	}
-	if m.NamespaceCache != nil {
-		l = m.NamespaceCache.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DeleteWorkflowExecutionRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 6)
+	s = append(s, "&adminservice.DeleteWorkflowExecutionRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *CloseShardRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ShardId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ShardId))
+func (this *DeleteWorkflowExecutionResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return n
+	s := make([]string, 0, 5)
+	s = append(s, "&adminservice.DeleteWorkflowExecutionResponse{")
+	s = append(s, "Warnings: "+fmt.Sprintf("%#v", this.Warnings)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *CloseShardResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *ResetWorkflowExecutionsRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	return n
+	s := make([]string, 0, 14)
+	s = append(s, "&adminservice.ResetWorkflowExecutionsRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "Query: "+fmt.Sprintf("%#v", this.Query)+",\n")
+	if this.ResetPointSelector != nil {
+		s = append(s, "ResetPointSelector: "+fmt.Sprintf("%#v", this.ResetPointSelector)+",\n")
+	}
+	s = append(s, "DryRun: "+fmt.Sprintf("%#v", this.DryRun)+",\n")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "ResetReapplyType: "+fmt.Sprintf("%#v", this.ResetReapplyType)+",\n")
+	s = append(s, "MaximumPageSize: "+fmt.Sprintf("%#v", this.MaximumPageSize)+",\n")
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	if this.Executions != nil {
+		s = append(s, "Executions: "+fmt.Sprintf("%#v", this.Executions)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *GetShardRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *ResetWorkflowExecutionsResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	if m.ShardId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	s := make([]string, 0, 6)
+	s = append(s, "&adminservice.ResetWorkflowExecutionsResponse{")
+	if this.Results != nil {
+		s = append(s, "Results: "+fmt.Sprintf("%#v", this.Results)+",\n")
 	}
-	return n
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *GetShardResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *PauseWorkflowExecutionRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	if m.ShardInfo != nil {
-		l = m.ShardInfo.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 8)
+	s = append(s, "&adminservice.PauseWorkflowExecutionRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
 	}
-	return n
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ListHistoryTasksRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *PauseWorkflowExecutionResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	if m.ShardId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.PauseWorkflowExecutionResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UnpauseWorkflowExecutionRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.Category != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Category))
+	s := make([]string, 0, 7)
+	s = append(s, "&adminservice.UnpauseWorkflowExecutionRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
 	}
-	if m.TaskRange != nil {
-		l = m.TaskRange.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UnpauseWorkflowExecutionResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.BatchSize != 0 {
-		n += 1 + sovRequestResponse(uint64(m.BatchSize))
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.UnpauseWorkflowExecutionResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PauseActivityExecutionRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	l = len(m.NextPageToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 9)
+	s = append(s, "&adminservice.PauseActivityExecutionRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
 	}
-	return n
+	s = append(s, "ActivityId: "+fmt.Sprintf("%#v", this.ActivityId)+",\n")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ListHistoryTasksResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *PauseActivityExecutionResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	if len(m.Tasks) > 0 {
-		for _, e := range m.Tasks {
-			l = e.Size()
-			n += 1 + l + sovRequestResponse(uint64(l))
-		}
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.PauseActivityExecutionResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UnpauseActivityExecutionRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	l = len(m.NextPageToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 8)
+	s = append(s, "&adminservice.UnpauseActivityExecutionRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
 	}
-	return n
+	s = append(s, "ActivityId: "+fmt.Sprintf("%#v", this.ActivityId)+",\n")
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *Task) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+func (this *UnpauseActivityExecutionResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	l = len(m.WorkflowId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.UnpauseActivityExecutionResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ResetActivityExecutionRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	l = len(m.RunId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 8)
+	s = append(s, "&adminservice.ResetActivityExecutionRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
 	}
-	if m.TaskId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.TaskId))
+	s = append(s, "ActivityId: "+fmt.Sprintf("%#v", this.ActivityId)+",\n")
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ResetActivityExecutionResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.TaskType != 0 {
-		n += 1 + sovRequestResponse(uint64(m.TaskType))
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.ResetActivityExecutionResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UpdateActivityExecutionOptionsRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.FireTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.FireTime)
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 9)
+	s = append(s, "&adminservice.UpdateActivityExecutionOptionsRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
 	}
-	if m.Version != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Version))
+	s = append(s, "ActivityId: "+fmt.Sprintf("%#v", this.ActivityId)+",\n")
+	if this.Options != nil {
+		s = append(s, "Options: "+fmt.Sprintf("%#v", this.Options)+",\n")
 	}
-	return n
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *RemoveTaskRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ShardId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ShardId))
+func (this *UpdateActivityExecutionOptionsResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.Category != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Category))
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.UpdateActivityExecutionOptionsResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ListAuditRecordsRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.TaskId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.TaskId))
+	s := make([]string, 0, 11)
+	s = append(s, "&adminservice.ListAuditRecordsRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "Principal: "+fmt.Sprintf("%#v", this.Principal)+",\n")
+	s = append(s, "ApiName: "+fmt.Sprintf("%#v", this.ApiName)+",\n")
+	s = append(s, "StartTime: "+fmt.Sprintf("%#v", this.StartTime)+",\n")
+	s = append(s, "EndTime: "+fmt.Sprintf("%#v", this.EndTime)+",\n")
+	s = append(s, "PageSize: "+fmt.Sprintf("%#v", this.PageSize)+",\n")
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ListAuditRecordsResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.VisibilityTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime)
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 6)
+	s = append(s, "&adminservice.ListAuditRecordsResponse{")
+	if this.Records != nil {
+		s = append(s, "Records: "+fmt.Sprintf("%#v", this.Records)+",\n")
 	}
-	return n
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *RemoveTaskResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *CreateNamespaceApiKeyRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	return n
+	s := make([]string, 0, 9)
+	s = append(s, "&adminservice.CreateNamespaceApiKeyRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Role: "+fmt.Sprintf("%#v", this.Role)+",\n")
+	s = append(s, "Ttl: "+fmt.Sprintf("%#v", this.Ttl)+",\n")
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *GetWorkflowExecutionRawHistoryV2Request) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *CreateNamespaceApiKeyResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	if m.Execution != nil {
-		l = m.Execution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 6)
+	s = append(s, "&adminservice.CreateNamespaceApiKeyResponse{")
+	if this.ApiKey != nil {
+		s = append(s, "ApiKey: "+fmt.Sprintf("%#v", this.ApiKey)+",\n")
 	}
-	if m.StartEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.StartEventId))
+	s = append(s, "Token: "+fmt.Sprintf("%#v", this.Token)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ListNamespaceApiKeysRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.StartEventVersion != 0 {
-		n += 1 + sovRequestResponse(uint64(m.StartEventVersion))
+	s := make([]string, 0, 5)
+	s = append(s, "&adminservice.ListNamespaceApiKeysRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ListNamespaceApiKeysResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.EndEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.EndEventId))
+	s := make([]string, 0, 5)
+	s = append(s, "&adminservice.ListNamespaceApiKeysResponse{")
+	if this.ApiKeys != nil {
+		s = append(s, "ApiKeys: "+fmt.Sprintf("%#v", this.ApiKeys)+",\n")
 	}
-	if m.EndEventVersion != 0 {
-		n += 1 + sovRequestResponse(uint64(m.EndEventVersion))
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RotateNamespaceApiKeyRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.MaximumPageSize != 0 {
-		n += 1 + sovRequestResponse(uint64(m.MaximumPageSize))
+	s := make([]string, 0, 7)
+	s = append(s, "&adminservice.RotateNamespaceApiKeyRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "KeyId: "+fmt.Sprintf("%#v", this.KeyId)+",\n")
+	s = append(s, "Ttl: "+fmt.Sprintf("%#v", this.Ttl)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RotateNamespaceApiKeyResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	l = len(m.NextPageToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 6)
+	s = append(s, "&adminservice.RotateNamespaceApiKeyResponse{")
+	if this.ApiKey != nil {
+		s = append(s, "ApiKey: "+fmt.Sprintf("%#v", this.ApiKey)+",\n")
 	}
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s = append(s, "Token: "+fmt.Sprintf("%#v", this.Token)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RevokeNamespaceApiKeyRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return n
+	s := make([]string, 0, 6)
+	s = append(s, "&adminservice.RevokeNamespaceApiKeyRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "KeyId: "+fmt.Sprintf("%#v", this.KeyId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *GetWorkflowExecutionRawHistoryV2Response) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *RevokeNamespaceApiKeyResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	l = len(m.NextPageToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.RevokeNamespaceApiKeyResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetReplicationStatusRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.HistoryBatches) > 0 {
-		for _, e := range m.HistoryBatches {
-			l = e.Size()
-			n += 1 + l + sovRequestResponse(uint64(l))
-		}
+	s := make([]string, 0, 6)
+	s = append(s, "&adminservice.GetReplicationStatusRequest{")
+	s = append(s, "RemoteClusters: "+fmt.Sprintf("%#v", this.RemoteClusters)+",\n")
+	s = append(s, "IncludeShards: "+fmt.Sprintf("%#v", this.IncludeShards)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetReplicationStatusResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.VersionHistory != nil {
-		l = m.VersionHistory.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 6)
+	s = append(s, "&adminservice.GetReplicationStatusResponse{")
+	keysForRemoteClusters := make([]string, 0, len(this.RemoteClusters))
+	for k, _ := range this.RemoteClusters {
+		keysForRemoteClusters = append(keysForRemoteClusters, k)
 	}
-	if len(m.HistoryNodeIds) > 0 {
-		l = 0
-		for _, e := range m.HistoryNodeIds {
-			l += sovRequestResponse(uint64(e))
-		}
-		n += 1 + sovRequestResponse(uint64(l)) + l
+	github_com_gogo_protobuf_sortkeys.Strings(keysForRemoteClusters)
+	mapStringForRemoteClusters := "map[string]*ClusterReplicationStatus{"
+	for _, k := range keysForRemoteClusters {
+		mapStringForRemoteClusters += fmt.Sprintf("%#v: %#v,", k, this.RemoteClusters[k])
 	}
-	return n
-}
-
-func (m *GetReplicationMessagesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	mapStringForRemoteClusters += "}"
+	if this.RemoteClusters != nil {
+		s = append(s, "RemoteClusters: "+mapStringForRemoteClusters+",\n")
 	}
-	var l int
-	_ = l
-	if len(m.Tokens) > 0 {
-		for _, e := range m.Tokens {
-			l = e.Size()
-			n += 1 + l + sovRequestResponse(uint64(l))
-		}
+	if this.Shards != nil {
+		s = append(s, "Shards: "+fmt.Sprintf("%#v", this.Shards)+",\n")
 	}
-	l = len(m.ClusterName)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ClusterReplicationStatus) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return n
+	s := make([]string, 0, 10)
+	s = append(s, "&adminservice.ClusterReplicationStatus{")
+	s = append(s, "ShardCount: "+fmt.Sprintf("%#v", this.ShardCount)+",\n")
+	s = append(s, "MaxOutboundLag: "+fmt.Sprintf("%#v", this.MaxOutboundLag)+",\n")
+	s = append(s, "MaxInboundLag: "+fmt.Sprintf("%#v", this.MaxInboundLag)+",\n")
+	s = append(s, "InboundDlqTaskCount: "+fmt.Sprintf("%#v", this.InboundDlqTaskCount)+",\n")
+	s = append(s, "RetryingProcessorCount: "+fmt.Sprintf("%#v", this.RetryingProcessorCount)+",\n")
+	s = append(s, "StoppedProcessorCount: "+fmt.Sprintf("%#v", this.StoppedProcessorCount)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *GetReplicationMessagesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *ShardReplicationStatus) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	if len(m.ShardMessages) > 0 {
-		for k, v := range m.ShardMessages {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovRequestResponse(uint64(l))
-			}
-			mapEntrySize := 1 + sovRequestResponse(uint64(k)) + l
-			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
-		}
+	s := make([]string, 0, 9)
+	s = append(s, "&adminservice.ShardReplicationStatus{")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "MaxReplicationTaskId: "+fmt.Sprintf("%#v", this.MaxReplicationTaskId)+",\n")
+	s = append(s, "MaxReplicationTaskVisibilityTime: "+fmt.Sprintf("%#v", this.MaxReplicationTaskVisibilityTime)+",\n")
+	keysForRemoteClusters := make([]string, 0, len(this.RemoteClusters))
+	for k, _ := range this.RemoteClusters {
+		keysForRemoteClusters = append(keysForRemoteClusters, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForRemoteClusters)
+	mapStringForRemoteClusters := "map[string]*ShardClusterReplicationStatus{"
+	for _, k := range keysForRemoteClusters {
+		mapStringForRemoteClusters += fmt.Sprintf("%#v: %#v,", k, this.RemoteClusters[k])
+	}
+	mapStringForRemoteClusters += "}"
+	if this.RemoteClusters != nil {
+		s = append(s, "RemoteClusters: "+mapStringForRemoteClusters+",\n")
+	}
+	keysForHandoverReplicationTaskIds := make([]string, 0, len(this.HandoverReplicationTaskIds))
+	for k, _ := range this.HandoverReplicationTaskIds {
+		keysForHandoverReplicationTaskIds = append(keysForHandoverReplicationTaskIds, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHandoverReplicationTaskIds)
+	mapStringForHandoverReplicationTaskIds := "map[string]int64{"
+	for _, k := range keysForHandoverReplicationTaskIds {
+		mapStringForHandoverReplicationTaskIds += fmt.Sprintf("%#v: %#v,", k, this.HandoverReplicationTaskIds[k])
+	}
+	mapStringForHandoverReplicationTaskIds += "}"
+	if this.HandoverReplicationTaskIds != nil {
+		s = append(s, "HandoverReplicationTaskIds: "+mapStringForHandoverReplicationTaskIds+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *GetNamespaceReplicationMessagesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *ShardClusterReplicationStatus) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	if m.LastRetrievedMessageId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.LastRetrievedMessageId))
+	s := make([]string, 0, 8)
+	s = append(s, "&adminservice.ShardClusterReplicationStatus{")
+	s = append(s, "AckedTaskId: "+fmt.Sprintf("%#v", this.AckedTaskId)+",\n")
+	s = append(s, "AckedTaskVisibilityTime: "+fmt.Sprintf("%#v", this.AckedTaskVisibilityTime)+",\n")
+	s = append(s, "OutboundLag: "+fmt.Sprintf("%#v", this.OutboundLag)+",\n")
+	if this.Inbound != nil {
+		s = append(s, "Inbound: "+fmt.Sprintf("%#v", this.Inbound)+",\n")
 	}
-	if m.LastProcessedMessageId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.LastProcessedMessageId))
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SetPersistenceFaultRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	l = len(m.ClusterName)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 6)
+	s = append(s, "&adminservice.SetPersistenceFaultRequest{")
+	if this.Fault != nil {
+		s = append(s, "Fault: "+fmt.Sprintf("%#v", this.Fault)+",\n")
 	}
-	return n
+	s = append(s, "Ttl: "+fmt.Sprintf("%#v", this.Ttl)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *GetNamespaceReplicationMessagesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *SetPersistenceFaultResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	if m.Messages != nil {
-		l = m.Messages.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 5)
+	s = append(s, "&adminservice.SetPersistenceFaultResponse{")
+	if this.Fault != nil {
+		s = append(s, "Fault: "+fmt.Sprintf("%#v", this.Fault)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *GetDLQReplicationMessagesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *RemovePersistenceFaultRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	if len(m.TaskInfos) > 0 {
-		for _, e := range m.TaskInfos {
-			l = e.Size()
-			n += 1 + l + sovRequestResponse(uint64(l))
-		}
+	s := make([]string, 0, 5)
+	s = append(s, "&adminservice.RemovePersistenceFaultRequest{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RemovePersistenceFaultResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return n
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.RemovePersistenceFaultResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *GetDLQReplicationMessagesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func valueToGoStringRequestResponse(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
 	}
-	var l int
-	_ = l
-	if len(m.ReplicationTasks) > 0 {
-		for _, e := range m.ReplicationTasks {
-			l = e.Size()
-			n += 1 + l + sovRequestResponse(uint64(l))
-		}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *RebuildMutableStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ReapplyEventsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RebuildMutableStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RebuildMutableStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.Events != nil {
-		l = m.Events.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.Execution != nil {
+		{
+			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ReapplyEventsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RebuildMutableStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AddSearchAttributesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.SearchAttributes) > 0 {
-		for k, v := range m.SearchAttributes {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovRequestResponse(uint64(len(k))) + 1 + sovRequestResponse(uint64(v))
-			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
-		}
-	}
-	l = len(m.IndexName)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.SkipSchemaUpdate {
-		n += 2
-	}
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	return n
+func (m *RebuildMutableStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AddSearchAttributesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RebuildMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RemoveSearchAttributesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DescribeMutableStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeMutableStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeMutableStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.SearchAttributes) > 0 {
-		for _, s := range m.SearchAttributes {
-			l = len(s)
-			n += 1 + l + sovRequestResponse(uint64(l))
+	if m.Execution != nil {
+		{
+			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.IndexName)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RemoveSearchAttributesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DescribeMutableStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetSearchAttributesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.IndexName)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	return n
+func (m *DescribeMutableStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetSearchAttributesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DescribeMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.CustomAttributes) > 0 {
-		for k, v := range m.CustomAttributes {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovRequestResponse(uint64(len(k))) + 1 + sovRequestResponse(uint64(v))
-			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
+	if m.DatabaseMutableState != nil {
+		{
+			size, err := m.DatabaseMutableState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.SystemAttributes) > 0 {
-		for k, v := range m.SystemAttributes {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovRequestResponse(uint64(len(k))) + 1 + sovRequestResponse(uint64(v))
-			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
+	if m.CacheMutableState != nil {
+		{
+			size, err := m.CacheMutableState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Mapping) > 0 {
-		for k, v := range m.Mapping {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovRequestResponse(uint64(len(k))) + 1 + len(v) + sovRequestResponse(uint64(len(v)))
-			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
-		}
+	if len(m.HistoryAddr) > 0 {
+		i -= len(m.HistoryAddr)
+		copy(dAtA[i:], m.HistoryAddr)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.HistoryAddr)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.AddWorkflowExecutionInfo != nil {
-		l = m.AddWorkflowExecutionInfo.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.ShardId) > 0 {
+		i -= len(m.ShardId)
+		copy(dAtA[i:], m.ShardId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ShardId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DescribeClusterRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ClusterName)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+func (m *DescribeHistoryHostRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DescribeClusterResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DescribeHistoryHostRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeHistoryHostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.SupportedClients) > 0 {
-		for k, v := range m.SupportedClients {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovRequestResponse(uint64(len(k))) + 1 + len(v) + sovRequestResponse(uint64(len(v)))
-			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
-		}
-	}
-	l = len(m.ServerVersion)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.MembershipInfo != nil {
-		l = m.MembershipInfo.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.ClusterId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.ClusterName)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.HistoryShardCount != 0 {
-		n += 1 + sovRequestResponse(uint64(m.HistoryShardCount))
-	}
-	l = len(m.PersistenceStore)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.VisibilityStore)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.VersionInfo != nil {
-		l = m.VersionInfo.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.FailoverVersionIncrement != 0 {
-		n += 1 + sovRequestResponse(uint64(m.FailoverVersionIncrement))
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.InitialFailoverVersion != 0 {
-		n += 1 + sovRequestResponse(uint64(m.InitialFailoverVersion))
+	if m.ShardId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.IsGlobalNamespaceEnabled {
-		n += 2
+	if len(m.HostAddress) > 0 {
+		i -= len(m.HostAddress)
+		copy(dAtA[i:], m.HostAddress)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.HostAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ListClustersRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DescribeHistoryHostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeHistoryHostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeHistoryHostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PageSize != 0 {
-		n += 1 + sovRequestResponse(uint64(m.PageSize))
+	if len(m.Certificates) > 0 {
+		for iNdEx := len(m.Certificates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Certificates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	l = len(m.NextPageToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
-}
-
-func (m *ListClustersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.NamespaceCache != nil {
+		{
+			size, err := m.NamespaceCache.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	if len(m.Clusters) > 0 {
-		for _, e := range m.Clusters {
-			l = e.Size()
-			n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.ShardIds) > 0 {
+		dAtA8 := make([]byte, len(m.ShardIds)*10)
+		var j7 int
+		for _, num1 := range m.ShardIds {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
 		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintRequestResponse(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.NextPageToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.ShardsNumber != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardsNumber))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AddOrUpdateRemoteClusterRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CloseShardRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CloseShardRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CloseShardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FrontendAddress)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.EnableRemoteClusterConnection {
-		n += 2
+	if m.ShardId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AddOrUpdateRemoteClusterResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CloseShardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CloseShardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CloseShardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RemoveRemoteClusterRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetShardRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetShardRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetShardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ClusterName)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.ShardId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RemoveRemoteClusterResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetShardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ListClusterMembersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.LastHeartbeatWithin != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.LastHeartbeatWithin)
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.RpcAddress)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.HostId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.Role != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Role))
-	}
-	if m.SessionStartedAfterTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.SessionStartedAfterTime)
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.PageSize != 0 {
-		n += 1 + sovRequestResponse(uint64(m.PageSize))
-	}
-	l = len(m.NextPageToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	return n
+func (m *GetShardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListClusterMembersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetShardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ActiveMembers) > 0 {
-		for _, e := range m.ActiveMembers {
-			l = e.Size()
-			n += 1 + l + sovRequestResponse(uint64(l))
+	if m.ShardInfo != nil {
+		{
+			size, err := m.ShardInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.NextPageToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetDLQMessagesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListHistoryTasksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListHistoryTasksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListHistoryTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Type))
-	}
-	if m.ShardId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.SourceCluster)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.BatchSize != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.BatchSize))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.InclusiveEndMessageId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.InclusiveEndMessageId))
+	if m.TaskRange != nil {
+		{
+			size, err := m.TaskRange.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.MaximumPageSize != 0 {
-		n += 1 + sovRequestResponse(uint64(m.MaximumPageSize))
+	if m.Category != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Category))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.NextPageToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.ShardId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetDLQMessagesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListHistoryTasksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListHistoryTasksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListHistoryTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Type))
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.ReplicationTasks) > 0 {
-		for _, e := range m.ReplicationTasks {
-			l = e.Size()
-			n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.Tasks) > 0 {
+		for iNdEx := len(m.Tasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	l = len(m.NextPageToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PurgeDLQMessagesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Type != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Type))
-	}
-	if m.ShardId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ShardId))
-	}
-	l = len(m.SourceCluster)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.InclusiveEndMessageId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.InclusiveEndMessageId))
+func (m *Task) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PurgeDLQMessagesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *Task) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MergeDLQMessagesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Task) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Type))
-	}
-	if m.ShardId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	if m.Version != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x38
 	}
-	l = len(m.SourceCluster)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.FireTime != nil {
+		n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.FireTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.FireTime):])
+		if err11 != nil {
+			return 0, err11
+		}
+		i -= n11
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n11))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.InclusiveEndMessageId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.InclusiveEndMessageId))
+	if m.TaskType != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.TaskType))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.MaximumPageSize != 0 {
-		n += 1 + sovRequestResponse(uint64(m.MaximumPageSize))
+	if m.TaskId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.NextPageToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.RunId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
-}
-
-func (m *MergeDLQMessagesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.WorkflowId) > 0 {
+		i -= len(m.WorkflowId)
+		copy(dAtA[i:], m.WorkflowId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.WorkflowId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	l = len(m.NextPageToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RefreshWorkflowTasksRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Execution != nil {
-		l = m.Execution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+func (m *RemoveTaskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RefreshWorkflowTasksResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *RemoveTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ResendReplicationTasksRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RemoveTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.WorkflowId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.RunId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.RemoteCluster)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.StartEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.StartEventId))
+	if m.VisibilityTime != nil {
+		n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+		if err12 != nil {
+			return 0, err12
+		}
+		i -= n12
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n12))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.StartVersion != 0 {
-		n += 1 + sovRequestResponse(uint64(m.StartVersion))
+	if m.TaskId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.EndEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.EndEventId))
+	if m.Category != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Category))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.EndVersion != 0 {
-		n += 1 + sovRequestResponse(uint64(m.EndVersion))
+	if m.ShardId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ResendReplicationTasksResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RemoveTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetTaskQueueTasksRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetWorkflowExecutionRawHistoryV2Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetWorkflowExecutionRawHistoryV2Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetWorkflowExecutionRawHistoryV2Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.TaskQueue)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	if m.TaskQueueType != 0 {
-		n += 1 + sovRequestResponse(uint64(m.TaskQueueType))
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.MinTaskId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.MinTaskId))
+	if m.MaximumPageSize != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.MaximumPageSize))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.MaxTaskId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.MaxTaskId))
+	if m.EndEventVersion != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.EndEventVersion))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.BatchSize != 0 {
-		n += 1 + sovRequestResponse(uint64(m.BatchSize))
+	if m.EndEventId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.EndEventId))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.NextPageToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.StartEventVersion != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.StartEventVersion))
+		i--
+		dAtA[i] = 0x20
 	}
-	return n
-}
-
-func (m *GetTaskQueueTasksResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.StartEventId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.StartEventId))
+		i--
+		dAtA[i] = 0x18
 	}
-	var l int
-	_ = l
-	if len(m.Tasks) > 0 {
-		for _, e := range m.Tasks {
-			l = e.Size()
-			n += 1 + l + sovRequestResponse(uint64(l))
+	if m.Execution != nil {
+		{
+			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.NextPageToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DeleteWorkflowExecutionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.Execution != nil {
-		l = m.Execution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+func (m *GetWorkflowExecutionRawHistoryV2Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DeleteWorkflowExecutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+func (m *GetWorkflowExecutionRawHistoryV2Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetWorkflowExecutionRawHistoryV2Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
 	_ = l
-	if len(m.Warnings) > 0 {
-		for _, s := range m.Warnings {
-			l = len(s)
-			n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.HistoryNodeIds) > 0 {
+		dAtA15 := make([]byte, len(m.HistoryNodeIds)*10)
+		var j14 int
+		for _, num1 := range m.HistoryNodeIds {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j14++
+			}
+			dAtA15[j14] = uint8(num)
+			j14++
 		}
+		i -= j14
+		copy(dAtA[i:], dAtA15[:j14])
+		i = encodeVarintRequestResponse(dAtA, i, uint64(j14))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
-}
-
-func sovRequestResponse(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozRequestResponse(x uint64) (n int) {
-	return sovRequestResponse(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *RebuildMutableStateRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.VersionHistory != nil {
+		{
+			size, err := m.VersionHistory.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	s := strings.Join([]string{`&RebuildMutableStateRequest{`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RebuildMutableStateResponse) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.HistoryBatches) > 0 {
+		for iNdEx := len(m.HistoryBatches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HistoryBatches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	s := strings.Join([]string{`&RebuildMutableStateResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *DescribeMutableStateRequest) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0xa
 	}
-	s := strings.Join([]string{`&DescribeMutableStateRequest{`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *DescribeMutableStateResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GetReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&DescribeMutableStateResponse{`,
-		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
-		`HistoryAddr:` + fmt.Sprintf("%v", this.HistoryAddr) + `,`,
-		`CacheMutableState:` + strings.Replace(fmt.Sprintf("%v", this.CacheMutableState), "WorkflowMutableState", "v11.WorkflowMutableState", 1) + `,`,
-		`DatabaseMutableState:` + strings.Replace(fmt.Sprintf("%v", this.DatabaseMutableState), "WorkflowMutableState", "v11.WorkflowMutableState", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *DescribeHistoryHostRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&DescribeHistoryHostRequest{`,
-		`HostAddress:` + fmt.Sprintf("%v", this.HostAddress) + `,`,
-		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *GetReplicationMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *DescribeHistoryHostResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GetReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClusterName) > 0 {
+		i -= len(m.ClusterName)
+		copy(dAtA[i:], m.ClusterName)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ClusterName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	s := strings.Join([]string{`&DescribeHistoryHostResponse{`,
-		`ShardsNumber:` + fmt.Sprintf("%v", this.ShardsNumber) + `,`,
-		`ShardIds:` + fmt.Sprintf("%v", this.ShardIds) + `,`,
-		`NamespaceCache:` + strings.Replace(fmt.Sprintf("%v", this.NamespaceCache), "NamespaceCacheInfo", "v12.NamespaceCacheInfo", 1) + `,`,
-		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *CloseShardRequest) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	s := strings.Join([]string{`&CloseShardRequest{`,
-		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *CloseShardResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GetReplicationMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&CloseShardResponse{`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *GetShardRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&GetShardRequest{`,
-		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *GetReplicationMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *GetShardResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GetReplicationMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ShardMessages) > 0 {
+		for k := range m.ShardMessages {
+			v := m.ShardMessages[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintRequestResponse(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	s := strings.Join([]string{`&GetShardResponse{`,
-		`ShardInfo:` + strings.Replace(fmt.Sprintf("%v", this.ShardInfo), "ShardInfo", "v11.ShardInfo", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *ListHistoryTasksRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GetNamespaceReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&ListHistoryTasksRequest{`,
-		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
-		`Category:` + fmt.Sprintf("%v", this.Category) + `,`,
-		`TaskRange:` + strings.Replace(fmt.Sprintf("%v", this.TaskRange), "TaskRange", "v14.TaskRange", 1) + `,`,
-		`BatchSize:` + fmt.Sprintf("%v", this.BatchSize) + `,`,
-		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *ListHistoryTasksResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GetNamespaceReplicationMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetNamespaceReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClusterName) > 0 {
+		i -= len(m.ClusterName)
+		copy(dAtA[i:], m.ClusterName)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ClusterName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	repeatedStringForTasks := "[]*Task{"
-	for _, f := range this.Tasks {
-		repeatedStringForTasks += strings.Replace(f.String(), "Task", "Task", 1) + ","
+	if m.LastProcessedMessageId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.LastProcessedMessageId))
+		i--
+		dAtA[i] = 0x10
 	}
-	repeatedStringForTasks += "}"
-	s := strings.Join([]string{`&ListHistoryTasksResponse{`,
-		`Tasks:` + repeatedStringForTasks + `,`,
-		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Task) String() string {
-	if this == nil {
-		return "nil"
+	if m.LastRetrievedMessageId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.LastRetrievedMessageId))
+		i--
+		dAtA[i] = 0x8
 	}
-	s := strings.Join([]string{`&Task{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
-		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
-		`TaskId:` + fmt.Sprintf("%v", this.TaskId) + `,`,
-		`TaskType:` + fmt.Sprintf("%v", this.TaskType) + `,`,
-		`FireTime:` + strings.Replace(fmt.Sprintf("%v", this.FireTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *RemoveTaskRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GetNamespaceReplicationMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&RemoveTaskRequest{`,
-		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
-		`Category:` + fmt.Sprintf("%v", this.Category) + `,`,
-		`TaskId:` + fmt.Sprintf("%v", this.TaskId) + `,`,
-		`VisibilityTime:` + strings.Replace(fmt.Sprintf("%v", this.VisibilityTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *RemoveTaskResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&RemoveTaskResponse{`,
-		`}`,
-	}, "")
-	return s
+
+func (m *GetNamespaceReplicationMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *GetWorkflowExecutionRawHistoryV2Request) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GetNamespaceReplicationMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Messages != nil {
+		{
+			size, err := m.Messages.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	s := strings.Join([]string{`&GetWorkflowExecutionRawHistoryV2Request{`,
-		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
-		`StartEventId:` + fmt.Sprintf("%v", this.StartEventId) + `,`,
-		`StartEventVersion:` + fmt.Sprintf("%v", this.StartEventVersion) + `,`,
-		`EndEventId:` + fmt.Sprintf("%v", this.EndEventId) + `,`,
-		`EndEventVersion:` + fmt.Sprintf("%v", this.EndEventVersion) + `,`,
-		`MaximumPageSize:` + fmt.Sprintf("%v", this.MaximumPageSize) + `,`,
-		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *GetWorkflowExecutionRawHistoryV2Response) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForHistoryBatches := "[]*DataBlob{"
-	for _, f := range this.HistoryBatches {
-		repeatedStringForHistoryBatches += strings.Replace(fmt.Sprintf("%v", f), "DataBlob", "v1.DataBlob", 1) + ","
+
+func (m *GetDLQReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	repeatedStringForHistoryBatches += "}"
-	s := strings.Join([]string{`&GetWorkflowExecutionRawHistoryV2Response{`,
-		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
-		`HistoryBatches:` + repeatedStringForHistoryBatches + `,`,
-		`VersionHistory:` + strings.Replace(fmt.Sprintf("%v", this.VersionHistory), "VersionHistory", "v14.VersionHistory", 1) + `,`,
-		`HistoryNodeIds:` + fmt.Sprintf("%v", this.HistoryNodeIds) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *GetReplicationMessagesRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForTokens := "[]*ReplicationToken{"
-	for _, f := range this.Tokens {
-		repeatedStringForTokens += strings.Replace(fmt.Sprintf("%v", f), "ReplicationToken", "v15.ReplicationToken", 1) + ","
-	}
-	repeatedStringForTokens += "}"
-	s := strings.Join([]string{`&GetReplicationMessagesRequest{`,
-		`Tokens:` + repeatedStringForTokens + `,`,
-		`ClusterName:` + fmt.Sprintf("%v", this.ClusterName) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *GetDLQReplicationMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *GetReplicationMessagesResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	keysForShardMessages := make([]int32, 0, len(this.ShardMessages))
-	for k, _ := range this.ShardMessages {
-		keysForShardMessages = append(keysForShardMessages, k)
-	}
-	github_com_gogo_protobuf_sortkeys.Int32s(keysForShardMessages)
-	mapStringForShardMessages := "map[int32]*v15.ReplicationMessages{"
-	for _, k := range keysForShardMessages {
-		mapStringForShardMessages += fmt.Sprintf("%v: %v,", k, this.ShardMessages[k])
+
+func (m *GetDLQReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TaskInfos) > 0 {
+		for iNdEx := len(m.TaskInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaskInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	mapStringForShardMessages += "}"
-	s := strings.Join([]string{`&GetReplicationMessagesResponse{`,
-		`ShardMessages:` + mapStringForShardMessages + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *GetNamespaceReplicationMessagesRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GetDLQReplicationMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&GetNamespaceReplicationMessagesRequest{`,
-		`LastRetrievedMessageId:` + fmt.Sprintf("%v", this.LastRetrievedMessageId) + `,`,
-		`LastProcessedMessageId:` + fmt.Sprintf("%v", this.LastProcessedMessageId) + `,`,
-		`ClusterName:` + fmt.Sprintf("%v", this.ClusterName) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *GetNamespaceReplicationMessagesResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&GetNamespaceReplicationMessagesResponse{`,
-		`Messages:` + strings.Replace(fmt.Sprintf("%v", this.Messages), "ReplicationMessages", "v15.ReplicationMessages", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *GetDLQReplicationMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *GetDLQReplicationMessagesRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GetDLQReplicationMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReplicationTasks) > 0 {
+		for iNdEx := len(m.ReplicationTasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReplicationTasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	repeatedStringForTaskInfos := "[]*ReplicationTaskInfo{"
-	for _, f := range this.TaskInfos {
-		repeatedStringForTaskInfos += strings.Replace(fmt.Sprintf("%v", f), "ReplicationTaskInfo", "v15.ReplicationTaskInfo", 1) + ","
-	}
-	repeatedStringForTaskInfos += "}"
-	s := strings.Join([]string{`&GetDLQReplicationMessagesRequest{`,
-		`TaskInfos:` + repeatedStringForTaskInfos + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GetDLQReplicationMessagesResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForReplicationTasks := "[]*ReplicationTask{"
-	for _, f := range this.ReplicationTasks {
-		repeatedStringForReplicationTasks += strings.Replace(fmt.Sprintf("%v", f), "ReplicationTask", "v15.ReplicationTask", 1) + ","
-	}
-	repeatedStringForReplicationTasks += "}"
-	s := strings.Join([]string{`&GetDLQReplicationMessagesResponse{`,
-		`ReplicationTasks:` + repeatedStringForReplicationTasks + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *ReapplyEventsRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ReapplyEventsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&ReapplyEventsRequest{`,
-		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
-		`Events:` + strings.Replace(fmt.Sprintf("%v", this.Events), "DataBlob", "v1.DataBlob", 1) + `,`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *ReapplyEventsResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ReapplyEventsResponse{`,
-		`}`,
-	}, "")
-	return s
+
+func (m *ReapplyEventsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *AddSearchAttributesRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ReapplyEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0x22
 	}
-	keysForSearchAttributes := make([]string, 0, len(this.SearchAttributes))
-	for k, _ := range this.SearchAttributes {
-		keysForSearchAttributes = append(keysForSearchAttributes, k)
+	if m.Events != nil {
+		{
+			size, err := m.Events.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForSearchAttributes)
-	mapStringForSearchAttributes := "map[string]v16.IndexedValueType{"
-	for _, k := range keysForSearchAttributes {
-		mapStringForSearchAttributes += fmt.Sprintf("%v: %v,", k, this.SearchAttributes[k])
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	mapStringForSearchAttributes += "}"
-	s := strings.Join([]string{`&AddSearchAttributesRequest{`,
-		`SearchAttributes:` + mapStringForSearchAttributes + `,`,
-		`IndexName:` + fmt.Sprintf("%v", this.IndexName) + `,`,
-		`SkipSchemaUpdate:` + fmt.Sprintf("%v", this.SkipSchemaUpdate) + `,`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *AddSearchAttributesResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ReapplyEventsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&AddSearchAttributesResponse{`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *RemoveSearchAttributesRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&RemoveSearchAttributesRequest{`,
-		`SearchAttributes:` + fmt.Sprintf("%v", this.SearchAttributes) + `,`,
-		`IndexName:` + fmt.Sprintf("%v", this.IndexName) + `,`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *ReapplyEventsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *RemoveSearchAttributesResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&RemoveSearchAttributesResponse{`,
-		`}`,
-	}, "")
-	return s
+
+func (m *ReapplyEventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
 }
-func (this *GetSearchAttributesRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *AddSearchAttributesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&GetSearchAttributesRequest{`,
-		`IndexName:` + fmt.Sprintf("%v", this.IndexName) + `,`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *GetSearchAttributesResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	keysForCustomAttributes := make([]string, 0, len(this.CustomAttributes))
-	for k, _ := range this.CustomAttributes {
-		keysForCustomAttributes = append(keysForCustomAttributes, k)
-	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForCustomAttributes)
-	mapStringForCustomAttributes := "map[string]v16.IndexedValueType{"
-	for _, k := range keysForCustomAttributes {
-		mapStringForCustomAttributes += fmt.Sprintf("%v: %v,", k, this.CustomAttributes[k])
-	}
-	mapStringForCustomAttributes += "}"
-	keysForSystemAttributes := make([]string, 0, len(this.SystemAttributes))
-	for k, _ := range this.SystemAttributes {
-		keysForSystemAttributes = append(keysForSystemAttributes, k)
+
+func (m *AddSearchAttributesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddSearchAttributesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x22
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForSystemAttributes)
-	mapStringForSystemAttributes := "map[string]v16.IndexedValueType{"
-	for _, k := range keysForSystemAttributes {
-		mapStringForSystemAttributes += fmt.Sprintf("%v: %v,", k, this.SystemAttributes[k])
+	if m.SkipSchemaUpdate {
+		i--
+		if m.SkipSchemaUpdate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	mapStringForSystemAttributes += "}"
-	keysForMapping := make([]string, 0, len(this.Mapping))
-	for k, _ := range this.Mapping {
-		keysForMapping = append(keysForMapping, k)
+	if len(m.IndexName) > 0 {
+		i -= len(m.IndexName)
+		copy(dAtA[i:], m.IndexName)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.IndexName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForMapping)
-	mapStringForMapping := "map[string]string{"
-	for _, k := range keysForMapping {
-		mapStringForMapping += fmt.Sprintf("%v: %v,", k, this.Mapping[k])
+	if len(m.SearchAttributes) > 0 {
+		for k := range m.SearchAttributes {
+			v := m.SearchAttributes[k]
+			baseI := i
+			i = encodeVarintRequestResponse(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	mapStringForMapping += "}"
-	s := strings.Join([]string{`&GetSearchAttributesResponse{`,
-		`CustomAttributes:` + mapStringForCustomAttributes + `,`,
-		`SystemAttributes:` + mapStringForSystemAttributes + `,`,
-		`Mapping:` + mapStringForMapping + `,`,
-		`AddWorkflowExecutionInfo:` + strings.Replace(fmt.Sprintf("%v", this.AddWorkflowExecutionInfo), "WorkflowExecutionInfo", "v17.WorkflowExecutionInfo", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *DescribeClusterRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&DescribeClusterRequest{`,
-		`ClusterName:` + fmt.Sprintf("%v", this.ClusterName) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *DescribeClusterResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	keysForSupportedClients := make([]string, 0, len(this.SupportedClients))
-	for k, _ := range this.SupportedClients {
-		keysForSupportedClients = append(keysForSupportedClients, k)
-	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForSupportedClients)
-	mapStringForSupportedClients := "map[string]string{"
-	for _, k := range keysForSupportedClients {
-		mapStringForSupportedClients += fmt.Sprintf("%v: %v,", k, this.SupportedClients[k])
+
+func (m *AddSearchAttributesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	mapStringForSupportedClients += "}"
-	s := strings.Join([]string{`&DescribeClusterResponse{`,
-		`SupportedClients:` + mapStringForSupportedClients + `,`,
-		`ServerVersion:` + fmt.Sprintf("%v", this.ServerVersion) + `,`,
-		`MembershipInfo:` + strings.Replace(fmt.Sprintf("%v", this.MembershipInfo), "MembershipInfo", "v18.MembershipInfo", 1) + `,`,
-		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
-		`ClusterName:` + fmt.Sprintf("%v", this.ClusterName) + `,`,
-		`HistoryShardCount:` + fmt.Sprintf("%v", this.HistoryShardCount) + `,`,
-		`PersistenceStore:` + fmt.Sprintf("%v", this.PersistenceStore) + `,`,
-		`VisibilityStore:` + fmt.Sprintf("%v", this.VisibilityStore) + `,`,
-		`VersionInfo:` + strings.Replace(fmt.Sprintf("%v", this.VersionInfo), "VersionInfo", "v19.VersionInfo", 1) + `,`,
-		`FailoverVersionIncrement:` + fmt.Sprintf("%v", this.FailoverVersionIncrement) + `,`,
-		`InitialFailoverVersion:` + fmt.Sprintf("%v", this.InitialFailoverVersion) + `,`,
-		`IsGlobalNamespaceEnabled:` + fmt.Sprintf("%v", this.IsGlobalNamespaceEnabled) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *ListClustersRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *AddSearchAttributesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddSearchAttributesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveSearchAttributesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&ListClustersRequest{`,
-		`PageSize:` + fmt.Sprintf("%v", this.PageSize) + `,`,
-		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *ListClustersResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RemoveSearchAttributesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveSearchAttributesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	repeatedStringForClusters := "[]*ClusterMetadata{"
-	for _, f := range this.Clusters {
-		repeatedStringForClusters += strings.Replace(fmt.Sprintf("%v", f), "ClusterMetadata", "v11.ClusterMetadata", 1) + ","
+	if len(m.IndexName) > 0 {
+		i -= len(m.IndexName)
+		copy(dAtA[i:], m.IndexName)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.IndexName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	repeatedStringForClusters += "}"
-	s := strings.Join([]string{`&ListClustersResponse{`,
-		`Clusters:` + repeatedStringForClusters + `,`,
-		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *AddOrUpdateRemoteClusterRequest) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.SearchAttributes) > 0 {
+		for iNdEx := len(m.SearchAttributes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SearchAttributes[iNdEx])
+			copy(dAtA[i:], m.SearchAttributes[iNdEx])
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.SearchAttributes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	s := strings.Join([]string{`&AddOrUpdateRemoteClusterRequest{`,
-		`FrontendAddress:` + fmt.Sprintf("%v", this.FrontendAddress) + `,`,
-		`EnableRemoteClusterConnection:` + fmt.Sprintf("%v", this.EnableRemoteClusterConnection) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *AddOrUpdateRemoteClusterResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RemoveSearchAttributesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&AddOrUpdateRemoteClusterResponse{`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *RemoveRemoteClusterRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&RemoveRemoteClusterRequest{`,
-		`ClusterName:` + fmt.Sprintf("%v", this.ClusterName) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *RemoveSearchAttributesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *RemoveRemoteClusterResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&RemoveRemoteClusterResponse{`,
-		`}`,
-	}, "")
-	return s
+
+func (m *RemoveSearchAttributesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
 }
-func (this *ListClusterMembersRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GetSearchAttributesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&ListClusterMembersRequest{`,
-		`LastHeartbeatWithin:` + strings.Replace(fmt.Sprintf("%v", this.LastHeartbeatWithin), "Duration", "types.Duration", 1) + `,`,
-		`RpcAddress:` + fmt.Sprintf("%v", this.RpcAddress) + `,`,
-		`HostId:` + fmt.Sprintf("%v", this.HostId) + `,`,
-		`Role:` + fmt.Sprintf("%v", this.Role) + `,`,
-		`SessionStartedAfterTime:` + strings.Replace(fmt.Sprintf("%v", this.SessionStartedAfterTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`PageSize:` + fmt.Sprintf("%v", this.PageSize) + `,`,
-		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *ListClusterMembersResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GetSearchAttributesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSearchAttributesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
 	}
-	repeatedStringForActiveMembers := "[]*ClusterMember{"
-	for _, f := range this.ActiveMembers {
-		repeatedStringForActiveMembers += strings.Replace(fmt.Sprintf("%v", f), "ClusterMember", "v18.ClusterMember", 1) + ","
+	if len(m.IndexName) > 0 {
+		i -= len(m.IndexName)
+		copy(dAtA[i:], m.IndexName)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.IndexName)))
+		i--
+		dAtA[i] = 0xa
 	}
-	repeatedStringForActiveMembers += "}"
-	s := strings.Join([]string{`&ListClusterMembersResponse{`,
-		`ActiveMembers:` + repeatedStringForActiveMembers + `,`,
-		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *GetDLQMessagesRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GetSearchAttributesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&GetDLQMessagesRequest{`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
-		`SourceCluster:` + fmt.Sprintf("%v", this.SourceCluster) + `,`,
-		`InclusiveEndMessageId:` + fmt.Sprintf("%v", this.InclusiveEndMessageId) + `,`,
-		`MaximumPageSize:` + fmt.Sprintf("%v", this.MaximumPageSize) + `,`,
-		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *GetDLQMessagesResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForReplicationTasks := "[]*ReplicationTask{"
-	for _, f := range this.ReplicationTasks {
-		repeatedStringForReplicationTasks += strings.Replace(fmt.Sprintf("%v", f), "ReplicationTask", "v15.ReplicationTask", 1) + ","
-	}
-	repeatedStringForReplicationTasks += "}"
-	s := strings.Join([]string{`&GetDLQMessagesResponse{`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`ReplicationTasks:` + repeatedStringForReplicationTasks + `,`,
-		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *GetSearchAttributesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *PurgeDLQMessagesRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GetSearchAttributesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AddWorkflowExecutionInfo != nil {
+		{
+			size, err := m.AddWorkflowExecutionInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	s := strings.Join([]string{`&PurgeDLQMessagesRequest{`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
-		`SourceCluster:` + fmt.Sprintf("%v", this.SourceCluster) + `,`,
-		`InclusiveEndMessageId:` + fmt.Sprintf("%v", this.InclusiveEndMessageId) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PurgeDLQMessagesResponse) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Mapping) > 0 {
+		for k := range m.Mapping {
+			v := m.Mapping[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	s := strings.Join([]string{`&PurgeDLQMessagesResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MergeDLQMessagesRequest) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.SystemAttributes) > 0 {
+		for k := range m.SystemAttributes {
+			v := m.SystemAttributes[k]
+			baseI := i
+			i = encodeVarintRequestResponse(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	s := strings.Join([]string{`&MergeDLQMessagesRequest{`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
-		`SourceCluster:` + fmt.Sprintf("%v", this.SourceCluster) + `,`,
-		`InclusiveEndMessageId:` + fmt.Sprintf("%v", this.InclusiveEndMessageId) + `,`,
-		`MaximumPageSize:` + fmt.Sprintf("%v", this.MaximumPageSize) + `,`,
-		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MergeDLQMessagesResponse) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.CustomAttributes) > 0 {
+		for k := range m.CustomAttributes {
+			v := m.CustomAttributes[k]
+			baseI := i
+			i = encodeVarintRequestResponse(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	s := strings.Join([]string{`&MergeDLQMessagesResponse{`,
-		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *RefreshWorkflowTasksRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *DescribeClusterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&RefreshWorkflowTasksRequest{`,
-		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *RefreshWorkflowTasksResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&RefreshWorkflowTasksResponse{`,
-		`}`,
-	}, "")
-	return s
+
+func (m *DescribeClusterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *ResendReplicationTasksRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *DescribeClusterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClusterName) > 0 {
+		i -= len(m.ClusterName)
+		copy(dAtA[i:], m.ClusterName)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ClusterName)))
+		i--
+		dAtA[i] = 0xa
 	}
-	s := strings.Join([]string{`&ResendReplicationTasksRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
-		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
-		`RemoteCluster:` + fmt.Sprintf("%v", this.RemoteCluster) + `,`,
-		`StartEventId:` + fmt.Sprintf("%v", this.StartEventId) + `,`,
-		`StartVersion:` + fmt.Sprintf("%v", this.StartVersion) + `,`,
-		`EndEventId:` + fmt.Sprintf("%v", this.EndEventId) + `,`,
-		`EndVersion:` + fmt.Sprintf("%v", this.EndVersion) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *ResendReplicationTasksResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *DescribeClusterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&ResendReplicationTasksResponse{`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *GetTaskQueueTasksRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&GetTaskQueueTasksRequest{`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
-		`TaskQueueType:` + fmt.Sprintf("%v", this.TaskQueueType) + `,`,
-		`MinTaskId:` + fmt.Sprintf("%v", this.MinTaskId) + `,`,
-		`MaxTaskId:` + fmt.Sprintf("%v", this.MaxTaskId) + `,`,
-		`BatchSize:` + fmt.Sprintf("%v", this.BatchSize) + `,`,
-		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *DescribeClusterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *GetTaskQueueTasksResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *DescribeClusterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PersistenceFaults) > 0 {
+		for iNdEx := len(m.PersistenceFaults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PersistenceFaults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
 	}
-	repeatedStringForTasks := "[]*AllocatedTaskInfo{"
-	for _, f := range this.Tasks {
-		repeatedStringForTasks += strings.Replace(fmt.Sprintf("%v", f), "AllocatedTaskInfo", "v11.AllocatedTaskInfo", 1) + ","
+	if len(m.Certificates) > 0 {
+		for iNdEx := len(m.Certificates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Certificates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.IsGlobalNamespaceEnabled {
+		i--
+		if m.IsGlobalNamespaceEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.InitialFailoverVersion != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.InitialFailoverVersion))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.FailoverVersionIncrement != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.FailoverVersionIncrement))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.VersionInfo != nil {
+		{
+			size, err := m.VersionInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.VisibilityStore) > 0 {
+		i -= len(m.VisibilityStore)
+		copy(dAtA[i:], m.VisibilityStore)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.VisibilityStore)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.PersistenceStore) > 0 {
+		i -= len(m.PersistenceStore)
+		copy(dAtA[i:], m.PersistenceStore)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.PersistenceStore)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.HistoryShardCount != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.HistoryShardCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ClusterName) > 0 {
+		i -= len(m.ClusterName)
+		copy(dAtA[i:], m.ClusterName)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ClusterName)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MembershipInfo != nil {
+		{
+			size, err := m.MembershipInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ServerVersion) > 0 {
+		i -= len(m.ServerVersion)
+		copy(dAtA[i:], m.ServerVersion)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ServerVersion)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SupportedClients) > 0 {
+		for k := range m.SupportedClients {
+			v := m.SupportedClients[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListClustersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListClustersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListClustersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListClustersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListClustersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListClustersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Clusters) > 0 {
+		for iNdEx := len(m.Clusters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Clusters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddOrUpdateRemoteClusterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddOrUpdateRemoteClusterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddOrUpdateRemoteClusterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EnableRemoteClusterConnection {
+		i--
+		if m.EnableRemoteClusterConnection {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FrontendAddress) > 0 {
+		i -= len(m.FrontendAddress)
+		copy(dAtA[i:], m.FrontendAddress)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.FrontendAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddOrUpdateRemoteClusterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddOrUpdateRemoteClusterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddOrUpdateRemoteClusterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveRemoteClusterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveRemoteClusterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveRemoteClusterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClusterName) > 0 {
+		i -= len(m.ClusterName)
+		copy(dAtA[i:], m.ClusterName)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ClusterName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveRemoteClusterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveRemoteClusterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveRemoteClusterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ListClusterMembersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListClusterMembersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListClusterMembersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SessionStartedAfterTime != nil {
+		n24, err24 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.SessionStartedAfterTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.SessionStartedAfterTime):])
+		if err24 != nil {
+			return 0, err24
+		}
+		i -= n24
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n24))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Role != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.HostId) > 0 {
+		i -= len(m.HostId)
+		copy(dAtA[i:], m.HostId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.HostId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RpcAddress) > 0 {
+		i -= len(m.RpcAddress)
+		copy(dAtA[i:], m.RpcAddress)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.RpcAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.LastHeartbeatWithin != nil {
+		n25, err25 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.LastHeartbeatWithin, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.LastHeartbeatWithin):])
+		if err25 != nil {
+			return 0, err25
+		}
+		i -= n25
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n25))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListClusterMembersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListClusterMembersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListClusterMembersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ActiveMembers) > 0 {
+		for iNdEx := len(m.ActiveMembers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ActiveMembers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MaximumPageSize != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.MaximumPageSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.InclusiveEndMessageId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.InclusiveEndMessageId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.SourceCluster) > 0 {
+		i -= len(m.SourceCluster)
+		copy(dAtA[i:], m.SourceCluster)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.SourceCluster)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ReplicationTasks) > 0 {
+		for iNdEx := len(m.ReplicationTasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReplicationTasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Type != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PurgeDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PurgeDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PurgeDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InclusiveEndMessageId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.InclusiveEndMessageId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.SourceCluster) > 0 {
+		i -= len(m.SourceCluster)
+		copy(dAtA[i:], m.SourceCluster)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.SourceCluster)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PurgeDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PurgeDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PurgeDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MergeDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MergeDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MergeDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MaximumPageSize != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.MaximumPageSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.InclusiveEndMessageId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.InclusiveEndMessageId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.SourceCluster) > 0 {
+		i -= len(m.SourceCluster)
+		copy(dAtA[i:], m.SourceCluster)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.SourceCluster)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MergeDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MergeDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MergeDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RefreshWorkflowTasksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefreshWorkflowTasksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefreshWorkflowTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Execution != nil {
+		{
+			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RefreshWorkflowTasksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefreshWorkflowTasksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefreshWorkflowTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ResendReplicationTasksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResendReplicationTasksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResendReplicationTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndVersion != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.EndVersion))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.EndEventId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.EndEventId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.StartVersion != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.StartVersion))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartEventId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.StartEventId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.RemoteCluster) > 0 {
+		i -= len(m.RemoteCluster)
+		copy(dAtA[i:], m.RemoteCluster)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.RemoteCluster)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.RunId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.WorkflowId) > 0 {
+		i -= len(m.WorkflowId)
+		copy(dAtA[i:], m.WorkflowId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.WorkflowId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResendReplicationTasksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResendReplicationTasksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResendReplicationTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTaskQueueTasksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTaskQueueTasksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTaskQueueTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.BatchSize != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.BatchSize))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxTaskId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.MaxTaskId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MinTaskId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.MinTaskId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TaskQueueType != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.TaskQueueType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TaskQueue) > 0 {
+		i -= len(m.TaskQueue)
+		copy(dAtA[i:], m.TaskQueue)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskQueue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTaskQueueTasksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTaskQueueTasksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTaskQueueTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tasks) > 0 {
+		for iNdEx := len(m.Tasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Execution != nil {
+		{
+			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Warnings) > 0 {
+		for iNdEx := len(m.Warnings) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Warnings[iNdEx])
+			copy(dAtA[i:], m.Warnings[iNdEx])
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Warnings[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResetWorkflowExecutionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResetWorkflowExecutionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResetWorkflowExecutionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Executions) > 0 {
+		for iNdEx := len(m.Executions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Executions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.MaximumPageSize != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.MaximumPageSize))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ResetReapplyType != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ResetReapplyType))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ResetPointSelector != nil {
+		{
+			size, err := m.ResetPointSelector.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResetWorkflowExecutionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResetWorkflowExecutionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResetWorkflowExecutionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PauseWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Execution != nil {
+		{
+			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PauseWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *UnpauseWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnpauseWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnpauseWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Execution != nil {
+		{
+			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnpauseWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnpauseWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnpauseWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *PauseActivityExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseActivityExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseActivityExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ActivityId) > 0 {
+		i -= len(m.ActivityId)
+		copy(dAtA[i:], m.ActivityId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ActivityId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Execution != nil {
+		{
+			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PauseActivityExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseActivityExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseActivityExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *UnpauseActivityExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnpauseActivityExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnpauseActivityExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ActivityId) > 0 {
+		i -= len(m.ActivityId)
+		copy(dAtA[i:], m.ActivityId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ActivityId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Execution != nil {
+		{
+			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnpauseActivityExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnpauseActivityExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnpauseActivityExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ResetActivityExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResetActivityExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResetActivityExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ActivityId) > 0 {
+		i -= len(m.ActivityId)
+		copy(dAtA[i:], m.ActivityId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ActivityId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Execution != nil {
+		{
+			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResetActivityExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResetActivityExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResetActivityExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateActivityExecutionOptionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateActivityExecutionOptionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateActivityExecutionOptionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Options != nil {
+		{
+			size, err := m.Options.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ActivityId) > 0 {
+		i -= len(m.ActivityId)
+		copy(dAtA[i:], m.ActivityId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ActivityId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Execution != nil {
+		{
+			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateActivityExecutionOptionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateActivityExecutionOptionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateActivityExecutionOptionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ListAuditRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListAuditRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListAuditRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EndTime != nil {
+		n36, err36 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime):])
+		if err36 != nil {
+			return 0, err36
+		}
+		i -= n36
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n36))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.StartTime != nil {
+		n37, err37 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
+		if err37 != nil {
+			return 0, err37
+		}
+		i -= n37
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n37))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ApiName) > 0 {
+		i -= len(m.ApiName)
+		copy(dAtA[i:], m.ApiName)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ApiName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Principal) > 0 {
+		i -= len(m.Principal)
+		copy(dAtA[i:], m.Principal)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Principal)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListAuditRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListAuditRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListAuditRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateNamespaceApiKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateNamespaceApiKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateNamespaceApiKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Ttl != nil {
+		n38, err38 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Ttl, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Ttl):])
+		if err38 != nil {
+			return 0, err38
+		}
+		i -= n38
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n38))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateNamespaceApiKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateNamespaceApiKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateNamespaceApiKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ApiKey != nil {
+		{
+			size, err := m.ApiKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListNamespaceApiKeysRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListNamespaceApiKeysRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListNamespaceApiKeysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListNamespaceApiKeysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListNamespaceApiKeysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListNamespaceApiKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ApiKeys) > 0 {
+		for iNdEx := len(m.ApiKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ApiKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RotateNamespaceApiKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RotateNamespaceApiKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RotateNamespaceApiKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Ttl != nil {
+		n40, err40 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Ttl, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Ttl):])
+		if err40 != nil {
+			return 0, err40
+		}
+		i -= n40
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n40))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.KeyId) > 0 {
+		i -= len(m.KeyId)
+		copy(dAtA[i:], m.KeyId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.KeyId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RotateNamespaceApiKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RotateNamespaceApiKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RotateNamespaceApiKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ApiKey != nil {
+		{
+			size, err := m.ApiKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RevokeNamespaceApiKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevokeNamespaceApiKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevokeNamespaceApiKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyId) > 0 {
+		i -= len(m.KeyId)
+		copy(dAtA[i:], m.KeyId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.KeyId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RevokeNamespaceApiKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevokeNamespaceApiKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevokeNamespaceApiKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetReplicationStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetReplicationStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetReplicationStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeShards {
+		i--
+		if m.IncludeShards {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RemoteClusters) > 0 {
+		for iNdEx := len(m.RemoteClusters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemoteClusters[iNdEx])
+			copy(dAtA[i:], m.RemoteClusters[iNdEx])
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.RemoteClusters[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetReplicationStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetReplicationStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetReplicationStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Shards) > 0 {
+		for iNdEx := len(m.Shards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RemoteClusters) > 0 {
+		for k := range m.RemoteClusters {
+			v := m.RemoteClusters[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClusterReplicationStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterReplicationStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterReplicationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StoppedProcessorCount != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.StoppedProcessorCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RetryingProcessorCount != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.RetryingProcessorCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.InboundDlqTaskCount != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.InboundDlqTaskCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxInboundLag != nil {
+		n43, err43 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.MaxInboundLag, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.MaxInboundLag):])
+		if err43 != nil {
+			return 0, err43
+		}
+		i -= n43
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n43))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MaxOutboundLag != nil {
+		n44, err44 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.MaxOutboundLag, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.MaxOutboundLag):])
+		if err44 != nil {
+			return 0, err44
+		}
+		i -= n44
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n44))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ShardCount != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ShardReplicationStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShardReplicationStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShardReplicationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HandoverReplicationTaskIds) > 0 {
+		for k := range m.HandoverReplicationTaskIds {
+			v := m.HandoverReplicationTaskIds[k]
+			baseI := i
+			i = encodeVarintRequestResponse(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RemoteClusters) > 0 {
+		for k := range m.RemoteClusters {
+			v := m.RemoteClusters[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MaxReplicationTaskVisibilityTime != nil {
+		n46, err46 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.MaxReplicationTaskVisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.MaxReplicationTaskVisibilityTime):])
+		if err46 != nil {
+			return 0, err46
+		}
+		i -= n46
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n46))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MaxReplicationTaskId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.MaxReplicationTaskId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ShardClusterReplicationStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShardClusterReplicationStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShardClusterReplicationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Inbound != nil {
+		{
+			size, err := m.Inbound.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.OutboundLag != nil {
+		n48, err48 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.OutboundLag, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.OutboundLag):])
+		if err48 != nil {
+			return 0, err48
+		}
+		i -= n48
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n48))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AckedTaskVisibilityTime != nil {
+		n49, err49 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.AckedTaskVisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.AckedTaskVisibilityTime):])
+		if err49 != nil {
+			return 0, err49
+		}
+		i -= n49
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n49))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AckedTaskId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.AckedTaskId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetPersistenceFaultRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetPersistenceFaultRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetPersistenceFaultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Ttl != nil {
+		n50, err50 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Ttl, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Ttl):])
+		if err50 != nil {
+			return 0, err50
+		}
+		i -= n50
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n50))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Fault != nil {
+		{
+			size, err := m.Fault.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetPersistenceFaultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetPersistenceFaultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetPersistenceFaultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Fault != nil {
+		{
+			size, err := m.Fault.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemovePersistenceFaultRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemovePersistenceFaultRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemovePersistenceFaultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemovePersistenceFaultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemovePersistenceFaultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemovePersistenceFaultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRequestResponse(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRequestResponse(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RebuildMutableStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *RebuildMutableStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DescribeMutableStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *DescribeMutableStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ShardId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.HistoryAddr)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.CacheMutableState != nil {
+		l = m.CacheMutableState.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.DatabaseMutableState != nil {
+		l = m.DatabaseMutableState.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *DescribeHistoryHostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HostAddress)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *DescribeHistoryHostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardsNumber != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardsNumber))
+	}
+	if len(m.ShardIds) > 0 {
+		l = 0
+		for _, e := range m.ShardIds {
+			l += sovRequestResponse(uint64(e))
+		}
+		n += 1 + sovRequestResponse(uint64(l)) + l
+	}
+	if m.NamespaceCache != nil {
+		l = m.NamespaceCache.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if len(m.Certificates) > 0 {
+		for _, e := range m.Certificates {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CloseShardRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	}
+	return n
+}
+
+func (m *CloseShardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetShardRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	}
+	return n
+}
+
+func (m *GetShardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardInfo != nil {
+		l = m.ShardInfo.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *ListHistoryTasksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	}
+	if m.Category != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Category))
+	}
+	if m.TaskRange != nil {
+		l = m.TaskRange.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.BatchSize != 0 {
+		n += 1 + sovRequestResponse(uint64(m.BatchSize))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *ListHistoryTasksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tasks) > 0 {
+		for _, e := range m.Tasks {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *Task) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.TaskId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.TaskId))
+	}
+	if m.TaskType != 0 {
+		n += 1 + sovRequestResponse(uint64(m.TaskType))
+	}
+	if m.FireTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.FireTime)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *RemoveTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	}
+	if m.Category != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Category))
+	}
+	if m.TaskId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.TaskId))
+	}
+	if m.VisibilityTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoveTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetWorkflowExecutionRawHistoryV2Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.StartEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.StartEventId))
+	}
+	if m.StartEventVersion != 0 {
+		n += 1 + sovRequestResponse(uint64(m.StartEventVersion))
+	}
+	if m.EndEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.EndEventId))
+	}
+	if m.EndEventVersion != 0 {
+		n += 1 + sovRequestResponse(uint64(m.EndEventVersion))
+	}
+	if m.MaximumPageSize != 0 {
+		n += 1 + sovRequestResponse(uint64(m.MaximumPageSize))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *GetWorkflowExecutionRawHistoryV2Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if len(m.HistoryBatches) > 0 {
+		for _, e := range m.HistoryBatches {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	if m.VersionHistory != nil {
+		l = m.VersionHistory.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if len(m.HistoryNodeIds) > 0 {
+		l = 0
+		for _, e := range m.HistoryNodeIds {
+			l += sovRequestResponse(uint64(e))
+		}
+		n += 1 + sovRequestResponse(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *GetReplicationMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	l = len(m.ClusterName)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *GetReplicationMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ShardMessages) > 0 {
+		for k, v := range m.ShardMessages {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovRequestResponse(uint64(l))
+			}
+			mapEntrySize := 1 + sovRequestResponse(uint64(k)) + l
+			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *GetNamespaceReplicationMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastRetrievedMessageId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.LastRetrievedMessageId))
+	}
+	if m.LastProcessedMessageId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.LastProcessedMessageId))
+	}
+	l = len(m.ClusterName)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *GetNamespaceReplicationMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Messages != nil {
+		l = m.Messages.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *GetDLQReplicationMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TaskInfos) > 0 {
+		for _, e := range m.TaskInfos {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetDLQReplicationMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ReplicationTasks) > 0 {
+		for _, e := range m.ReplicationTasks {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ReapplyEventsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Events != nil {
+		l = m.Events.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *ReapplyEventsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AddSearchAttributesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SearchAttributes) > 0 {
+		for k, v := range m.SearchAttributes {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovRequestResponse(uint64(len(k))) + 1 + sovRequestResponse(uint64(v))
+			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.IndexName)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.SkipSchemaUpdate {
+		n += 2
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *AddSearchAttributesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RemoveSearchAttributesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SearchAttributes) > 0 {
+		for _, s := range m.SearchAttributes {
+			l = len(s)
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	l = len(m.IndexName)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoveSearchAttributesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetSearchAttributesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IndexName)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *GetSearchAttributesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CustomAttributes) > 0 {
+		for k, v := range m.CustomAttributes {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovRequestResponse(uint64(len(k))) + 1 + sovRequestResponse(uint64(v))
+			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
+		}
+	}
+	if len(m.SystemAttributes) > 0 {
+		for k, v := range m.SystemAttributes {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovRequestResponse(uint64(len(k))) + 1 + sovRequestResponse(uint64(v))
+			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Mapping) > 0 {
+		for k, v := range m.Mapping {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovRequestResponse(uint64(len(k))) + 1 + len(v) + sovRequestResponse(uint64(len(v)))
+			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
+		}
+	}
+	if m.AddWorkflowExecutionInfo != nil {
+		l = m.AddWorkflowExecutionInfo.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *DescribeClusterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClusterName)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *DescribeClusterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SupportedClients) > 0 {
+		for k, v := range m.SupportedClients {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovRequestResponse(uint64(len(k))) + 1 + len(v) + sovRequestResponse(uint64(len(v)))
+			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.ServerVersion)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.MembershipInfo != nil {
+		l = m.MembershipInfo.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.ClusterName)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.HistoryShardCount != 0 {
+		n += 1 + sovRequestResponse(uint64(m.HistoryShardCount))
+	}
+	l = len(m.PersistenceStore)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.VisibilityStore)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.VersionInfo != nil {
+		l = m.VersionInfo.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.FailoverVersionIncrement != 0 {
+		n += 1 + sovRequestResponse(uint64(m.FailoverVersionIncrement))
+	}
+	if m.InitialFailoverVersion != 0 {
+		n += 1 + sovRequestResponse(uint64(m.InitialFailoverVersion))
+	}
+	if m.IsGlobalNamespaceEnabled {
+		n += 2
+	}
+	if len(m.Certificates) > 0 {
+		for _, e := range m.Certificates {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	if len(m.PersistenceFaults) > 0 {
+		for _, e := range m.PersistenceFaults {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ListClustersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PageSize != 0 {
+		n += 1 + sovRequestResponse(uint64(m.PageSize))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *ListClustersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Clusters) > 0 {
+		for _, e := range m.Clusters {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *AddOrUpdateRemoteClusterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FrontendAddress)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.EnableRemoteClusterConnection {
+		n += 2
+	}
+	return n
+}
+
+func (m *AddOrUpdateRemoteClusterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RemoveRemoteClusterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClusterName)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoveRemoteClusterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ListClusterMembersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastHeartbeatWithin != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.LastHeartbeatWithin)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.RpcAddress)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.HostId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Role))
+	}
+	if m.SessionStartedAfterTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.SessionStartedAfterTime)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovRequestResponse(uint64(m.PageSize))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *ListClusterMembersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ActiveMembers) > 0 {
+		for _, e := range m.ActiveMembers {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *GetDLQMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Type))
+	}
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	}
+	l = len(m.SourceCluster)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.InclusiveEndMessageId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.InclusiveEndMessageId))
+	}
+	if m.MaximumPageSize != 0 {
+		n += 1 + sovRequestResponse(uint64(m.MaximumPageSize))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *GetDLQMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Type))
+	}
+	if len(m.ReplicationTasks) > 0 {
+		for _, e := range m.ReplicationTasks {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *PurgeDLQMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Type))
+	}
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	}
+	l = len(m.SourceCluster)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.InclusiveEndMessageId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.InclusiveEndMessageId))
+	}
+	return n
+}
+
+func (m *PurgeDLQMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MergeDLQMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Type))
+	}
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	}
+	l = len(m.SourceCluster)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.InclusiveEndMessageId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.InclusiveEndMessageId))
+	}
+	if m.MaximumPageSize != 0 {
+		n += 1 + sovRequestResponse(uint64(m.MaximumPageSize))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *MergeDLQMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *RefreshWorkflowTasksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *RefreshWorkflowTasksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ResendReplicationTasksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.RemoteCluster)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.StartEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.StartEventId))
+	}
+	if m.StartVersion != 0 {
+		n += 1 + sovRequestResponse(uint64(m.StartVersion))
+	}
+	if m.EndEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.EndEventId))
+	}
+	if m.EndVersion != 0 {
+		n += 1 + sovRequestResponse(uint64(m.EndVersion))
+	}
+	return n
+}
+
+func (m *ResendReplicationTasksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetTaskQueueTasksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.TaskQueue)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.TaskQueueType != 0 {
+		n += 1 + sovRequestResponse(uint64(m.TaskQueueType))
+	}
+	if m.MinTaskId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.MinTaskId))
+	}
+	if m.MaxTaskId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.MaxTaskId))
+	}
+	if m.BatchSize != 0 {
+		n += 1 + sovRequestResponse(uint64(m.BatchSize))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *GetTaskQueueTasksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tasks) > 0 {
+		for _, e := range m.Tasks {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Warnings) > 0 {
+		for _, s := range m.Warnings {
+			l = len(s)
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ResetWorkflowExecutionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.ResetPointSelector != nil {
+		l = m.ResetPointSelector.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.DryRun {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.ResetReapplyType != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ResetReapplyType))
+	}
+	if m.MaximumPageSize != 0 {
+		n += 1 + sovRequestResponse(uint64(m.MaximumPageSize))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if len(m.Executions) > 0 {
+		for _, e := range m.Executions {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ResetWorkflowExecutionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *PauseWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *PauseWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *UnpauseWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *UnpauseWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PauseActivityExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.ActivityId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *PauseActivityExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *UnpauseActivityExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.ActivityId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *UnpauseActivityExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ResetActivityExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.ActivityId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *ResetActivityExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *UpdateActivityExecutionOptionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.ActivityId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Options != nil {
+		l = m.Options.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateActivityExecutionOptionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ListAuditRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Principal)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.ApiName)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.StartTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovRequestResponse(uint64(m.PageSize))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *ListAuditRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateNamespaceApiKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Ttl != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Ttl)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateNamespaceApiKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ApiKey != nil {
+		l = m.ApiKey.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *ListNamespaceApiKeysRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *ListNamespaceApiKeysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ApiKeys) > 0 {
+		for _, e := range m.ApiKeys {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RotateNamespaceApiKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.KeyId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Ttl != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Ttl)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *RotateNamespaceApiKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ApiKey != nil {
+		l = m.ApiKey.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *RevokeNamespaceApiKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.KeyId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *RevokeNamespaceApiKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetReplicationStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RemoteClusters) > 0 {
+		for _, s := range m.RemoteClusters {
+			l = len(s)
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	if m.IncludeShards {
+		n += 2
+	}
+	return n
+}
+
+func (m *GetReplicationStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RemoteClusters) > 0 {
+		for k, v := range m.RemoteClusters {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovRequestResponse(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovRequestResponse(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Shards) > 0 {
+		for _, e := range m.Shards {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClusterReplicationStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardCount != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardCount))
+	}
+	if m.MaxOutboundLag != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.MaxOutboundLag)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.MaxInboundLag != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.MaxInboundLag)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.InboundDlqTaskCount != 0 {
+		n += 1 + sovRequestResponse(uint64(m.InboundDlqTaskCount))
+	}
+	if m.RetryingProcessorCount != 0 {
+		n += 1 + sovRequestResponse(uint64(m.RetryingProcessorCount))
+	}
+	if m.StoppedProcessorCount != 0 {
+		n += 1 + sovRequestResponse(uint64(m.StoppedProcessorCount))
+	}
+	return n
+}
+
+func (m *ShardReplicationStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	}
+	if m.MaxReplicationTaskId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.MaxReplicationTaskId))
+	}
+	if m.MaxReplicationTaskVisibilityTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.MaxReplicationTaskVisibilityTime)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if len(m.RemoteClusters) > 0 {
+		for k, v := range m.RemoteClusters {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovRequestResponse(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovRequestResponse(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
+		}
+	}
+	if len(m.HandoverReplicationTaskIds) > 0 {
+		for k, v := range m.HandoverReplicationTaskIds {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovRequestResponse(uint64(len(k))) + 1 + sovRequestResponse(uint64(v))
+			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *ShardClusterReplicationStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AckedTaskId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.AckedTaskId))
+	}
+	if m.AckedTaskVisibilityTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.AckedTaskVisibilityTime)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.OutboundLag != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.OutboundLag)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Inbound != nil {
+		l = m.Inbound.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *SetPersistenceFaultRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Fault != nil {
+		l = m.Fault.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Ttl != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Ttl)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *SetPersistenceFaultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Fault != nil {
+		l = m.Fault.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *RemovePersistenceFaultRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *RemovePersistenceFaultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovRequestResponse(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRequestResponse(x uint64) (n int) {
+	return sovRequestResponse(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *RebuildMutableStateRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RebuildMutableStateRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RebuildMutableStateResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RebuildMutableStateResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeMutableStateRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DescribeMutableStateRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeMutableStateResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DescribeMutableStateResponse{`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`HistoryAddr:` + fmt.Sprintf("%v", this.HistoryAddr) + `,`,
+		`CacheMutableState:` + strings.Replace(fmt.Sprintf("%v", this.CacheMutableState), "WorkflowMutableState", "v11.WorkflowMutableState", 1) + `,`,
+		`DatabaseMutableState:` + strings.Replace(fmt.Sprintf("%v", this.DatabaseMutableState), "WorkflowMutableState", "v11.WorkflowMutableState", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeHistoryHostRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DescribeHistoryHostRequest{`,
+		`HostAddress:` + fmt.Sprintf("%v", this.HostAddress) + `,`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeHistoryHostResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForCertificates := "[]*CertificateInfo{"
+	for _, f := range this.Certificates {
+		repeatedStringForCertificates += strings.Replace(fmt.Sprintf("%v", f), "CertificateInfo", "v13.CertificateInfo", 1) + ","
+	}
+	repeatedStringForCertificates += "}"
+	s := strings.Join([]string{`&DescribeHistoryHostResponse{`,
+		`ShardsNumber:` + fmt.Sprintf("%v", this.ShardsNumber) + `,`,
+		`ShardIds:` + fmt.Sprintf("%v", this.ShardIds) + `,`,
+		`NamespaceCache:` + strings.Replace(fmt.Sprintf("%v", this.NamespaceCache), "NamespaceCacheInfo", "v12.NamespaceCacheInfo", 1) + `,`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Certificates:` + repeatedStringForCertificates + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CloseShardRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CloseShardRequest{`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CloseShardResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CloseShardResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetShardRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetShardRequest{`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetShardResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetShardResponse{`,
+		`ShardInfo:` + strings.Replace(fmt.Sprintf("%v", this.ShardInfo), "ShardInfo", "v11.ShardInfo", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListHistoryTasksRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListHistoryTasksRequest{`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`Category:` + fmt.Sprintf("%v", this.Category) + `,`,
+		`TaskRange:` + strings.Replace(fmt.Sprintf("%v", this.TaskRange), "TaskRange", "v15.TaskRange", 1) + `,`,
+		`BatchSize:` + fmt.Sprintf("%v", this.BatchSize) + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListHistoryTasksResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTasks := "[]*Task{"
+	for _, f := range this.Tasks {
+		repeatedStringForTasks += strings.Replace(f.String(), "Task", "Task", 1) + ","
+	}
+	repeatedStringForTasks += "}"
+	s := strings.Join([]string{`&ListHistoryTasksResponse{`,
+		`Tasks:` + repeatedStringForTasks + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Task) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Task{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
+		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
+		`TaskId:` + fmt.Sprintf("%v", this.TaskId) + `,`,
+		`TaskType:` + fmt.Sprintf("%v", this.TaskType) + `,`,
+		`FireTime:` + strings.Replace(fmt.Sprintf("%v", this.FireTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RemoveTaskRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RemoveTaskRequest{`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`Category:` + fmt.Sprintf("%v", this.Category) + `,`,
+		`TaskId:` + fmt.Sprintf("%v", this.TaskId) + `,`,
+		`VisibilityTime:` + strings.Replace(fmt.Sprintf("%v", this.VisibilityTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RemoveTaskResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RemoveTaskResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetWorkflowExecutionRawHistoryV2Request) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetWorkflowExecutionRawHistoryV2Request{`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
+		`StartEventId:` + fmt.Sprintf("%v", this.StartEventId) + `,`,
+		`StartEventVersion:` + fmt.Sprintf("%v", this.StartEventVersion) + `,`,
+		`EndEventId:` + fmt.Sprintf("%v", this.EndEventId) + `,`,
+		`EndEventVersion:` + fmt.Sprintf("%v", this.EndEventVersion) + `,`,
+		`MaximumPageSize:` + fmt.Sprintf("%v", this.MaximumPageSize) + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetWorkflowExecutionRawHistoryV2Response) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForHistoryBatches := "[]*DataBlob{"
+	for _, f := range this.HistoryBatches {
+		repeatedStringForHistoryBatches += strings.Replace(fmt.Sprintf("%v", f), "DataBlob", "v1.DataBlob", 1) + ","
+	}
+	repeatedStringForHistoryBatches += "}"
+	s := strings.Join([]string{`&GetWorkflowExecutionRawHistoryV2Response{`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`HistoryBatches:` + repeatedStringForHistoryBatches + `,`,
+		`VersionHistory:` + strings.Replace(fmt.Sprintf("%v", this.VersionHistory), "VersionHistory", "v15.VersionHistory", 1) + `,`,
+		`HistoryNodeIds:` + fmt.Sprintf("%v", this.HistoryNodeIds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetReplicationMessagesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTokens := "[]*ReplicationToken{"
+	for _, f := range this.Tokens {
+		repeatedStringForTokens += strings.Replace(fmt.Sprintf("%v", f), "ReplicationToken", "v16.ReplicationToken", 1) + ","
+	}
+	repeatedStringForTokens += "}"
+	s := strings.Join([]string{`&GetReplicationMessagesRequest{`,
+		`Tokens:` + repeatedStringForTokens + `,`,
+		`ClusterName:` + fmt.Sprintf("%v", this.ClusterName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetReplicationMessagesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForShardMessages := make([]int32, 0, len(this.ShardMessages))
+	for k, _ := range this.ShardMessages {
+		keysForShardMessages = append(keysForShardMessages, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Int32s(keysForShardMessages)
+	mapStringForShardMessages := "map[int32]*v16.ReplicationMessages{"
+	for _, k := range keysForShardMessages {
+		mapStringForShardMessages += fmt.Sprintf("%v: %v,", k, this.ShardMessages[k])
+	}
+	mapStringForShardMessages += "}"
+	s := strings.Join([]string{`&GetReplicationMessagesResponse{`,
+		`ShardMessages:` + mapStringForShardMessages + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetNamespaceReplicationMessagesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetNamespaceReplicationMessagesRequest{`,
+		`LastRetrievedMessageId:` + fmt.Sprintf("%v", this.LastRetrievedMessageId) + `,`,
+		`LastProcessedMessageId:` + fmt.Sprintf("%v", this.LastProcessedMessageId) + `,`,
+		`ClusterName:` + fmt.Sprintf("%v", this.ClusterName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetNamespaceReplicationMessagesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetNamespaceReplicationMessagesResponse{`,
+		`Messages:` + strings.Replace(fmt.Sprintf("%v", this.Messages), "ReplicationMessages", "v16.ReplicationMessages", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetDLQReplicationMessagesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTaskInfos := "[]*ReplicationTaskInfo{"
+	for _, f := range this.TaskInfos {
+		repeatedStringForTaskInfos += strings.Replace(fmt.Sprintf("%v", f), "ReplicationTaskInfo", "v16.ReplicationTaskInfo", 1) + ","
+	}
+	repeatedStringForTaskInfos += "}"
+	s := strings.Join([]string{`&GetDLQReplicationMessagesRequest{`,
+		`TaskInfos:` + repeatedStringForTaskInfos + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetDLQReplicationMessagesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForReplicationTasks := "[]*ReplicationTask{"
+	for _, f := range this.ReplicationTasks {
+		repeatedStringForReplicationTasks += strings.Replace(fmt.Sprintf("%v", f), "ReplicationTask", "v16.ReplicationTask", 1) + ","
+	}
+	repeatedStringForReplicationTasks += "}"
+	s := strings.Join([]string{`&GetDLQReplicationMessagesResponse{`,
+		`ReplicationTasks:` + repeatedStringForReplicationTasks + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReapplyEventsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReapplyEventsRequest{`,
+		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
+		`Events:` + strings.Replace(fmt.Sprintf("%v", this.Events), "DataBlob", "v1.DataBlob", 1) + `,`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReapplyEventsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReapplyEventsResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddSearchAttributesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForSearchAttributes := make([]string, 0, len(this.SearchAttributes))
+	for k, _ := range this.SearchAttributes {
+		keysForSearchAttributes = append(keysForSearchAttributes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForSearchAttributes)
+	mapStringForSearchAttributes := "map[string]v17.IndexedValueType{"
+	for _, k := range keysForSearchAttributes {
+		mapStringForSearchAttributes += fmt.Sprintf("%v: %v,", k, this.SearchAttributes[k])
+	}
+	mapStringForSearchAttributes += "}"
+	s := strings.Join([]string{`&AddSearchAttributesRequest{`,
+		`SearchAttributes:` + mapStringForSearchAttributes + `,`,
+		`IndexName:` + fmt.Sprintf("%v", this.IndexName) + `,`,
+		`SkipSchemaUpdate:` + fmt.Sprintf("%v", this.SkipSchemaUpdate) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddSearchAttributesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddSearchAttributesResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RemoveSearchAttributesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RemoveSearchAttributesRequest{`,
+		`SearchAttributes:` + fmt.Sprintf("%v", this.SearchAttributes) + `,`,
+		`IndexName:` + fmt.Sprintf("%v", this.IndexName) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RemoveSearchAttributesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RemoveSearchAttributesResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetSearchAttributesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetSearchAttributesRequest{`,
+		`IndexName:` + fmt.Sprintf("%v", this.IndexName) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetSearchAttributesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForCustomAttributes := make([]string, 0, len(this.CustomAttributes))
+	for k, _ := range this.CustomAttributes {
+		keysForCustomAttributes = append(keysForCustomAttributes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForCustomAttributes)
+	mapStringForCustomAttributes := "map[string]v17.IndexedValueType{"
+	for _, k := range keysForCustomAttributes {
+		mapStringForCustomAttributes += fmt.Sprintf("%v: %v,", k, this.CustomAttributes[k])
+	}
+	mapStringForCustomAttributes += "}"
+	keysForSystemAttributes := make([]string, 0, len(this.SystemAttributes))
+	for k, _ := range this.SystemAttributes {
+		keysForSystemAttributes = append(keysForSystemAttributes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForSystemAttributes)
+	mapStringForSystemAttributes := "map[string]v17.IndexedValueType{"
+	for _, k := range keysForSystemAttributes {
+		mapStringForSystemAttributes += fmt.Sprintf("%v: %v,", k, this.SystemAttributes[k])
+	}
+	mapStringForSystemAttributes += "}"
+	keysForMapping := make([]string, 0, len(this.Mapping))
+	for k, _ := range this.Mapping {
+		keysForMapping = append(keysForMapping, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForMapping)
+	mapStringForMapping := "map[string]string{"
+	for _, k := range keysForMapping {
+		mapStringForMapping += fmt.Sprintf("%v: %v,", k, this.Mapping[k])
+	}
+	mapStringForMapping += "}"
+	s := strings.Join([]string{`&GetSearchAttributesResponse{`,
+		`CustomAttributes:` + mapStringForCustomAttributes + `,`,
+		`SystemAttributes:` + mapStringForSystemAttributes + `,`,
+		`Mapping:` + mapStringForMapping + `,`,
+		`AddWorkflowExecutionInfo:` + strings.Replace(fmt.Sprintf("%v", this.AddWorkflowExecutionInfo), "WorkflowExecutionInfo", "v18.WorkflowExecutionInfo", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeClusterRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DescribeClusterRequest{`,
+		`ClusterName:` + fmt.Sprintf("%v", this.ClusterName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeClusterResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForCertificates := "[]*CertificateInfo{"
+	for _, f := range this.Certificates {
+		repeatedStringForCertificates += strings.Replace(fmt.Sprintf("%v", f), "CertificateInfo", "v13.CertificateInfo", 1) + ","
+	}
+	repeatedStringForCertificates += "}"
+	repeatedStringForPersistenceFaults := "[]*PersistenceFault{"
+	for _, f := range this.PersistenceFaults {
+		repeatedStringForPersistenceFaults += strings.Replace(fmt.Sprintf("%v", f), "PersistenceFault", "v11.PersistenceFault", 1) + ","
+	}
+	repeatedStringForPersistenceFaults += "}"
+	keysForSupportedClients := make([]string, 0, len(this.SupportedClients))
+	for k, _ := range this.SupportedClients {
+		keysForSupportedClients = append(keysForSupportedClients, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForSupportedClients)
+	mapStringForSupportedClients := "map[string]string{"
+	for _, k := range keysForSupportedClients {
+		mapStringForSupportedClients += fmt.Sprintf("%v: %v,", k, this.SupportedClients[k])
+	}
+	mapStringForSupportedClients += "}"
+	s := strings.Join([]string{`&DescribeClusterResponse{`,
+		`SupportedClients:` + mapStringForSupportedClients + `,`,
+		`ServerVersion:` + fmt.Sprintf("%v", this.ServerVersion) + `,`,
+		`MembershipInfo:` + strings.Replace(fmt.Sprintf("%v", this.MembershipInfo), "MembershipInfo", "v13.MembershipInfo", 1) + `,`,
+		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
+		`ClusterName:` + fmt.Sprintf("%v", this.ClusterName) + `,`,
+		`HistoryShardCount:` + fmt.Sprintf("%v", this.HistoryShardCount) + `,`,
+		`PersistenceStore:` + fmt.Sprintf("%v", this.PersistenceStore) + `,`,
+		`VisibilityStore:` + fmt.Sprintf("%v", this.VisibilityStore) + `,`,
+		`VersionInfo:` + strings.Replace(fmt.Sprintf("%v", this.VersionInfo), "VersionInfo", "v19.VersionInfo", 1) + `,`,
+		`FailoverVersionIncrement:` + fmt.Sprintf("%v", this.FailoverVersionIncrement) + `,`,
+		`InitialFailoverVersion:` + fmt.Sprintf("%v", this.InitialFailoverVersion) + `,`,
+		`IsGlobalNamespaceEnabled:` + fmt.Sprintf("%v", this.IsGlobalNamespaceEnabled) + `,`,
+		`Certificates:` + repeatedStringForCertificates + `,`,
+		`PersistenceFaults:` + repeatedStringForPersistenceFaults + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListClustersRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListClustersRequest{`,
+		`PageSize:` + fmt.Sprintf("%v", this.PageSize) + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListClustersResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForClusters := "[]*ClusterMetadata{"
+	for _, f := range this.Clusters {
+		repeatedStringForClusters += strings.Replace(fmt.Sprintf("%v", f), "ClusterMetadata", "v11.ClusterMetadata", 1) + ","
+	}
+	repeatedStringForClusters += "}"
+	s := strings.Join([]string{`&ListClustersResponse{`,
+		`Clusters:` + repeatedStringForClusters + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddOrUpdateRemoteClusterRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddOrUpdateRemoteClusterRequest{`,
+		`FrontendAddress:` + fmt.Sprintf("%v", this.FrontendAddress) + `,`,
+		`EnableRemoteClusterConnection:` + fmt.Sprintf("%v", this.EnableRemoteClusterConnection) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddOrUpdateRemoteClusterResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddOrUpdateRemoteClusterResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RemoveRemoteClusterRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RemoveRemoteClusterRequest{`,
+		`ClusterName:` + fmt.Sprintf("%v", this.ClusterName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RemoveRemoteClusterResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RemoveRemoteClusterResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListClusterMembersRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListClusterMembersRequest{`,
+		`LastHeartbeatWithin:` + strings.Replace(fmt.Sprintf("%v", this.LastHeartbeatWithin), "Duration", "types.Duration", 1) + `,`,
+		`RpcAddress:` + fmt.Sprintf("%v", this.RpcAddress) + `,`,
+		`HostId:` + fmt.Sprintf("%v", this.HostId) + `,`,
+		`Role:` + fmt.Sprintf("%v", this.Role) + `,`,
+		`SessionStartedAfterTime:` + strings.Replace(fmt.Sprintf("%v", this.SessionStartedAfterTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`PageSize:` + fmt.Sprintf("%v", this.PageSize) + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListClusterMembersResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForActiveMembers := "[]*ClusterMember{"
+	for _, f := range this.ActiveMembers {
+		repeatedStringForActiveMembers += strings.Replace(fmt.Sprintf("%v", f), "ClusterMember", "v13.ClusterMember", 1) + ","
+	}
+	repeatedStringForActiveMembers += "}"
+	s := strings.Join([]string{`&ListClusterMembersResponse{`,
+		`ActiveMembers:` + repeatedStringForActiveMembers + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetDLQMessagesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetDLQMessagesRequest{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`SourceCluster:` + fmt.Sprintf("%v", this.SourceCluster) + `,`,
+		`InclusiveEndMessageId:` + fmt.Sprintf("%v", this.InclusiveEndMessageId) + `,`,
+		`MaximumPageSize:` + fmt.Sprintf("%v", this.MaximumPageSize) + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetDLQMessagesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForReplicationTasks := "[]*ReplicationTask{"
+	for _, f := range this.ReplicationTasks {
+		repeatedStringForReplicationTasks += strings.Replace(fmt.Sprintf("%v", f), "ReplicationTask", "v16.ReplicationTask", 1) + ","
+	}
+	repeatedStringForReplicationTasks += "}"
+	s := strings.Join([]string{`&GetDLQMessagesResponse{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`ReplicationTasks:` + repeatedStringForReplicationTasks + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PurgeDLQMessagesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PurgeDLQMessagesRequest{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`SourceCluster:` + fmt.Sprintf("%v", this.SourceCluster) + `,`,
+		`InclusiveEndMessageId:` + fmt.Sprintf("%v", this.InclusiveEndMessageId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PurgeDLQMessagesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PurgeDLQMessagesResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MergeDLQMessagesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MergeDLQMessagesRequest{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`SourceCluster:` + fmt.Sprintf("%v", this.SourceCluster) + `,`,
+		`InclusiveEndMessageId:` + fmt.Sprintf("%v", this.InclusiveEndMessageId) + `,`,
+		`MaximumPageSize:` + fmt.Sprintf("%v", this.MaximumPageSize) + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MergeDLQMessagesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MergeDLQMessagesResponse{`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RefreshWorkflowTasksRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RefreshWorkflowTasksRequest{`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RefreshWorkflowTasksResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RefreshWorkflowTasksResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResendReplicationTasksRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResendReplicationTasksRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
+		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
+		`RemoteCluster:` + fmt.Sprintf("%v", this.RemoteCluster) + `,`,
+		`StartEventId:` + fmt.Sprintf("%v", this.StartEventId) + `,`,
+		`StartVersion:` + fmt.Sprintf("%v", this.StartVersion) + `,`,
+		`EndEventId:` + fmt.Sprintf("%v", this.EndEventId) + `,`,
+		`EndVersion:` + fmt.Sprintf("%v", this.EndVersion) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResendReplicationTasksResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResendReplicationTasksResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetTaskQueueTasksRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetTaskQueueTasksRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
+		`TaskQueueType:` + fmt.Sprintf("%v", this.TaskQueueType) + `,`,
+		`MinTaskId:` + fmt.Sprintf("%v", this.MinTaskId) + `,`,
+		`MaxTaskId:` + fmt.Sprintf("%v", this.MaxTaskId) + `,`,
+		`BatchSize:` + fmt.Sprintf("%v", this.BatchSize) + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetTaskQueueTasksResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTasks := "[]*AllocatedTaskInfo{"
+	for _, f := range this.Tasks {
+		repeatedStringForTasks += strings.Replace(fmt.Sprintf("%v", f), "AllocatedTaskInfo", "v11.AllocatedTaskInfo", 1) + ","
+	}
+	repeatedStringForTasks += "}"
+	s := strings.Join([]string{`&GetTaskQueueTasksResponse{`,
+		`Tasks:` + repeatedStringForTasks + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteWorkflowExecutionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteWorkflowExecutionRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteWorkflowExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteWorkflowExecutionResponse{`,
+		`Warnings:` + fmt.Sprintf("%v", this.Warnings) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResetWorkflowExecutionsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForExecutions := "[]*WorkflowExecution{"
+	for _, f := range this.Executions {
+		repeatedStringForExecutions += strings.Replace(fmt.Sprintf("%v", f), "WorkflowExecution", "v1.WorkflowExecution", 1) + ","
+	}
+	repeatedStringForExecutions += "}"
+	s := strings.Join([]string{`&ResetWorkflowExecutionsRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Query:` + fmt.Sprintf("%v", this.Query) + `,`,
+		`ResetPointSelector:` + strings.Replace(fmt.Sprintf("%v", this.ResetPointSelector), "ResetPointSelector", "v110.ResetPointSelector", 1) + `,`,
+		`DryRun:` + fmt.Sprintf("%v", this.DryRun) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`ResetReapplyType:` + fmt.Sprintf("%v", this.ResetReapplyType) + `,`,
+		`MaximumPageSize:` + fmt.Sprintf("%v", this.MaximumPageSize) + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`Executions:` + repeatedStringForExecutions + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResetWorkflowExecutionsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForResults := "[]*ResetPointResolution{"
+	for _, f := range this.Results {
+		repeatedStringForResults += strings.Replace(fmt.Sprintf("%v", f), "ResetPointResolution", "v110.ResetPointResolution", 1) + ","
+	}
+	repeatedStringForResults += "}"
+	s := strings.Join([]string{`&ResetWorkflowExecutionsResponse{`,
+		`Results:` + repeatedStringForResults + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PauseWorkflowExecutionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PauseWorkflowExecutionRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PauseWorkflowExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PauseWorkflowExecutionResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UnpauseWorkflowExecutionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UnpauseWorkflowExecutionRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UnpauseWorkflowExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UnpauseWorkflowExecutionResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PauseActivityExecutionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PauseActivityExecutionRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
+		`ActivityId:` + fmt.Sprintf("%v", this.ActivityId) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PauseActivityExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PauseActivityExecutionResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UnpauseActivityExecutionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UnpauseActivityExecutionRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
+		`ActivityId:` + fmt.Sprintf("%v", this.ActivityId) + `,`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UnpauseActivityExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UnpauseActivityExecutionResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResetActivityExecutionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResetActivityExecutionRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
+		`ActivityId:` + fmt.Sprintf("%v", this.ActivityId) + `,`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResetActivityExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResetActivityExecutionResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateActivityExecutionOptionsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateActivityExecutionOptionsRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
+		`ActivityId:` + fmt.Sprintf("%v", this.ActivityId) + `,`,
+		`Options:` + strings.Replace(fmt.Sprintf("%v", this.Options), "ActivityOptions", "v110.ActivityOptions", 1) + `,`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateActivityExecutionOptionsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateActivityExecutionOptionsResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListAuditRecordsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListAuditRecordsRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Principal:` + fmt.Sprintf("%v", this.Principal) + `,`,
+		`ApiName:` + fmt.Sprintf("%v", this.ApiName) + `,`,
+		`StartTime:` + strings.Replace(fmt.Sprintf("%v", this.StartTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`EndTime:` + strings.Replace(fmt.Sprintf("%v", this.EndTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`PageSize:` + fmt.Sprintf("%v", this.PageSize) + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListAuditRecordsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRecords := "[]*AuditRecord{"
+	for _, f := range this.Records {
+		repeatedStringForRecords += strings.Replace(fmt.Sprintf("%v", f), "AuditRecord", "v11.AuditRecord", 1) + ","
+	}
+	repeatedStringForRecords += "}"
+	s := strings.Join([]string{`&ListAuditRecordsResponse{`,
+		`Records:` + repeatedStringForRecords + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateNamespaceApiKeyRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateNamespaceApiKeyRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Role:` + fmt.Sprintf("%v", this.Role) + `,`,
+		`Ttl:` + strings.Replace(fmt.Sprintf("%v", this.Ttl), "Duration", "types.Duration", 1) + `,`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateNamespaceApiKeyResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateNamespaceApiKeyResponse{`,
+		`ApiKey:` + strings.Replace(fmt.Sprintf("%v", this.ApiKey), "NamespaceApiKey", "v11.NamespaceApiKey", 1) + `,`,
+		`Token:` + fmt.Sprintf("%v", this.Token) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListNamespaceApiKeysRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListNamespaceApiKeysRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListNamespaceApiKeysResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForApiKeys := "[]*NamespaceApiKey{"
+	for _, f := range this.ApiKeys {
+		repeatedStringForApiKeys += strings.Replace(fmt.Sprintf("%v", f), "NamespaceApiKey", "v11.NamespaceApiKey", 1) + ","
+	}
+	repeatedStringForApiKeys += "}"
+	s := strings.Join([]string{`&ListNamespaceApiKeysResponse{`,
+		`ApiKeys:` + repeatedStringForApiKeys + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RotateNamespaceApiKeyRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RotateNamespaceApiKeyRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`KeyId:` + fmt.Sprintf("%v", this.KeyId) + `,`,
+		`Ttl:` + strings.Replace(fmt.Sprintf("%v", this.Ttl), "Duration", "types.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RotateNamespaceApiKeyResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RotateNamespaceApiKeyResponse{`,
+		`ApiKey:` + strings.Replace(fmt.Sprintf("%v", this.ApiKey), "NamespaceApiKey", "v11.NamespaceApiKey", 1) + `,`,
+		`Token:` + fmt.Sprintf("%v", this.Token) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RevokeNamespaceApiKeyRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RevokeNamespaceApiKeyRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`KeyId:` + fmt.Sprintf("%v", this.KeyId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RevokeNamespaceApiKeyResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RevokeNamespaceApiKeyResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetReplicationStatusRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetReplicationStatusRequest{`,
+		`RemoteClusters:` + fmt.Sprintf("%v", this.RemoteClusters) + `,`,
+		`IncludeShards:` + fmt.Sprintf("%v", this.IncludeShards) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetReplicationStatusResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForShards := "[]*ShardReplicationStatus{"
+	for _, f := range this.Shards {
+		repeatedStringForShards += strings.Replace(f.String(), "ShardReplicationStatus", "ShardReplicationStatus", 1) + ","
+	}
+	repeatedStringForShards += "}"
+	keysForRemoteClusters := make([]string, 0, len(this.RemoteClusters))
+	for k, _ := range this.RemoteClusters {
+		keysForRemoteClusters = append(keysForRemoteClusters, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForRemoteClusters)
+	mapStringForRemoteClusters := "map[string]*ClusterReplicationStatus{"
+	for _, k := range keysForRemoteClusters {
+		mapStringForRemoteClusters += fmt.Sprintf("%v: %v,", k, this.RemoteClusters[k])
+	}
+	mapStringForRemoteClusters += "}"
+	s := strings.Join([]string{`&GetReplicationStatusResponse{`,
+		`RemoteClusters:` + mapStringForRemoteClusters + `,`,
+		`Shards:` + repeatedStringForShards + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterReplicationStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClusterReplicationStatus{`,
+		`ShardCount:` + fmt.Sprintf("%v", this.ShardCount) + `,`,
+		`MaxOutboundLag:` + strings.Replace(fmt.Sprintf("%v", this.MaxOutboundLag), "Duration", "types.Duration", 1) + `,`,
+		`MaxInboundLag:` + strings.Replace(fmt.Sprintf("%v", this.MaxInboundLag), "Duration", "types.Duration", 1) + `,`,
+		`InboundDlqTaskCount:` + fmt.Sprintf("%v", this.InboundDlqTaskCount) + `,`,
+		`RetryingProcessorCount:` + fmt.Sprintf("%v", this.RetryingProcessorCount) + `,`,
+		`StoppedProcessorCount:` + fmt.Sprintf("%v", this.StoppedProcessorCount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ShardReplicationStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForRemoteClusters := make([]string, 0, len(this.RemoteClusters))
+	for k, _ := range this.RemoteClusters {
+		keysForRemoteClusters = append(keysForRemoteClusters, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForRemoteClusters)
+	mapStringForRemoteClusters := "map[string]*ShardClusterReplicationStatus{"
+	for _, k := range keysForRemoteClusters {
+		mapStringForRemoteClusters += fmt.Sprintf("%v: %v,", k, this.RemoteClusters[k])
+	}
+	mapStringForRemoteClusters += "}"
+	keysForHandoverReplicationTaskIds := make([]string, 0, len(this.HandoverReplicationTaskIds))
+	for k, _ := range this.HandoverReplicationTaskIds {
+		keysForHandoverReplicationTaskIds = append(keysForHandoverReplicationTaskIds, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHandoverReplicationTaskIds)
+	mapStringForHandoverReplicationTaskIds := "map[string]int64{"
+	for _, k := range keysForHandoverReplicationTaskIds {
+		mapStringForHandoverReplicationTaskIds += fmt.Sprintf("%v: %v,", k, this.HandoverReplicationTaskIds[k])
+	}
+	mapStringForHandoverReplicationTaskIds += "}"
+	s := strings.Join([]string{`&ShardReplicationStatus{`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`MaxReplicationTaskId:` + fmt.Sprintf("%v", this.MaxReplicationTaskId) + `,`,
+		`MaxReplicationTaskVisibilityTime:` + strings.Replace(fmt.Sprintf("%v", this.MaxReplicationTaskVisibilityTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`RemoteClusters:` + mapStringForRemoteClusters + `,`,
+		`HandoverReplicationTaskIds:` + mapStringForHandoverReplicationTaskIds + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ShardClusterReplicationStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ShardClusterReplicationStatus{`,
+		`AckedTaskId:` + fmt.Sprintf("%v", this.AckedTaskId) + `,`,
+		`AckedTaskVisibilityTime:` + strings.Replace(fmt.Sprintf("%v", this.AckedTaskVisibilityTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`OutboundLag:` + strings.Replace(fmt.Sprintf("%v", this.OutboundLag), "Duration", "types.Duration", 1) + `,`,
+		`Inbound:` + strings.Replace(fmt.Sprintf("%v", this.Inbound), "ShardReplicationInboundStatus", "v16.ShardReplicationInboundStatus", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetPersistenceFaultRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetPersistenceFaultRequest{`,
+		`Fault:` + strings.Replace(fmt.Sprintf("%v", this.Fault), "PersistenceFault", "v11.PersistenceFault", 1) + `,`,
+		`Ttl:` + strings.Replace(fmt.Sprintf("%v", this.Ttl), "Duration", "types.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetPersistenceFaultResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetPersistenceFaultResponse{`,
+		`Fault:` + strings.Replace(fmt.Sprintf("%v", this.Fault), "PersistenceFault", "v11.PersistenceFault", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RemovePersistenceFaultRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RemovePersistenceFaultRequest{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RemovePersistenceFaultResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RemovePersistenceFaultResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringRequestResponse(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *RebuildMutableStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RebuildMutableStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RebuildMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Execution == nil {
+				m.Execution = &v1.WorkflowExecution{}
+			}
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RebuildMutableStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RebuildMutableStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RebuildMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeMutableStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeMutableStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Execution == nil {
+				m.Execution = &v1.WorkflowExecution{}
+			}
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeMutableStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeMutableStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShardId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HistoryAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheMutableState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CacheMutableState == nil {
+				m.CacheMutableState = &v11.WorkflowMutableState{}
+			}
+			if err := m.CacheMutableState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseMutableState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DatabaseMutableState == nil {
+				m.DatabaseMutableState = &v11.WorkflowMutableState{}
+			}
+			if err := m.DatabaseMutableState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeHistoryHostRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeHistoryHostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeHistoryHostResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeHistoryHostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardsNumber", wireType)
+			}
+			m.ShardsNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardsNumber |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ShardIds = append(m.ShardIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRequestResponse
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRequestResponse
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ShardIds) == 0 {
+					m.ShardIds = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ShardIds = append(m.ShardIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardIds", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceCache", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NamespaceCache == nil {
+				m.NamespaceCache = &v12.NamespaceCacheInfo{}
+			}
+			if err := m.NamespaceCache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Certificates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Certificates = append(m.Certificates, &v13.CertificateInfo{})
+			if err := m.Certificates[len(m.Certificates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CloseShardRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CloseShardRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CloseShardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CloseShardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CloseShardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CloseShardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetShardRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetShardRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetShardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetShardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetShardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetShardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ShardInfo == nil {
+				m.ShardInfo = &v11.ShardInfo{}
+			}
+			if err := m.ShardInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListHistoryTasksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListHistoryTasksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListHistoryTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			}
+			m.Category = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Category |= v14.TaskCategory(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskRange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskRange == nil {
+				m.TaskRange = &v15.TaskRange{}
+			}
+			if err := m.TaskRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
+			}
+			m.BatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListHistoryTasksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListHistoryTasksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListHistoryTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tasks = append(m.Tasks, &Task{})
+			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Task) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Task: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Task: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskType", wireType)
+			}
+			m.TaskType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskType |= v14.TaskType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FireTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FireTime == nil {
+				m.FireTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.FireTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			}
+			m.Category = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Category |= v14.TaskCategory(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VisibilityTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VisibilityTime == nil {
+				m.VisibilityTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.VisibilityTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetWorkflowExecutionRawHistoryV2Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetWorkflowExecutionRawHistoryV2Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetWorkflowExecutionRawHistoryV2Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Execution == nil {
+				m.Execution = &v1.WorkflowExecution{}
+			}
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEventId", wireType)
+			}
+			m.StartEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEventVersion", wireType)
+			}
+			m.StartEventVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartEventVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndEventId", wireType)
+			}
+			m.EndEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndEventVersion", wireType)
+			}
+			m.EndEventVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndEventVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumPageSize", wireType)
+			}
+			m.MaximumPageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaximumPageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetWorkflowExecutionRawHistoryV2Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetWorkflowExecutionRawHistoryV2Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetWorkflowExecutionRawHistoryV2Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryBatches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HistoryBatches = append(m.HistoryBatches, &v1.DataBlob{})
+			if err := m.HistoryBatches[len(m.HistoryBatches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VersionHistory == nil {
+				m.VersionHistory = &v15.VersionHistory{}
+			}
+			if err := m.VersionHistory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.HistoryNodeIds = append(m.HistoryNodeIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRequestResponse
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRequestResponse
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.HistoryNodeIds) == 0 {
+					m.HistoryNodeIds = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.HistoryNodeIds = append(m.HistoryNodeIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryNodeIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetReplicationMessagesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetReplicationMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, &v16.ReplicationToken{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetReplicationMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetReplicationMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardMessages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ShardMessages == nil {
+				m.ShardMessages = make(map[int32]*v16.ReplicationMessages)
+			}
+			var mapkey int32
+			var mapvalue *v16.ReplicationMessages
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v16.ReplicationMessages{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRequestResponse(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ShardMessages[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetNamespaceReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetNamespaceReplicationMessagesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetNamespaceReplicationMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRetrievedMessageId", wireType)
+			}
+			m.LastRetrievedMessageId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRetrievedMessageId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastProcessedMessageId", wireType)
+			}
+			m.LastProcessedMessageId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastProcessedMessageId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetNamespaceReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetNamespaceReplicationMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetNamespaceReplicationMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Messages == nil {
+				m.Messages = &v16.ReplicationMessages{}
+			}
+			if err := m.Messages.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetDLQReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDLQReplicationMessagesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDLQReplicationMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskInfos = append(m.TaskInfos, &v16.ReplicationTaskInfo{})
+			if err := m.TaskInfos[len(m.TaskInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetDLQReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDLQReplicationMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDLQReplicationMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReplicationTasks = append(m.ReplicationTasks, &v16.ReplicationTask{})
+			if err := m.ReplicationTasks[len(m.ReplicationTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReapplyEventsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReapplyEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Events == nil {
+				m.Events = &v1.DataBlob{}
+			}
+			if err := m.Events.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReapplyEventsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReapplyEventsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReapplyEventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddSearchAttributesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddSearchAttributesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddSearchAttributesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SearchAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SearchAttributes == nil {
+				m.SearchAttributes = make(map[string]v17.IndexedValueType)
+			}
+			var mapkey string
+			var mapvalue v17.IndexedValueType
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= v17.IndexedValueType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRequestResponse(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.SearchAttributes[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipSchemaUpdate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SkipSchemaUpdate = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddSearchAttributesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddSearchAttributesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddSearchAttributesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveSearchAttributesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveSearchAttributesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveSearchAttributesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SearchAttributes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SearchAttributes = append(m.SearchAttributes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveSearchAttributesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveSearchAttributesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveSearchAttributesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetSearchAttributesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetSearchAttributesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetSearchAttributesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetSearchAttributesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetSearchAttributesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetSearchAttributesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CustomAttributes == nil {
+				m.CustomAttributes = make(map[string]v17.IndexedValueType)
+			}
+			var mapkey string
+			var mapvalue v17.IndexedValueType
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= v17.IndexedValueType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRequestResponse(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.CustomAttributes[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SystemAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SystemAttributes == nil {
+				m.SystemAttributes = make(map[string]v17.IndexedValueType)
+			}
+			var mapkey string
+			var mapvalue v17.IndexedValueType
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= v17.IndexedValueType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRequestResponse(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.SystemAttributes[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mapping", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mapping == nil {
+				m.Mapping = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRequestResponse(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Mapping[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddWorkflowExecutionInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AddWorkflowExecutionInfo == nil {
+				m.AddWorkflowExecutionInfo = &v18.WorkflowExecutionInfo{}
+			}
+			if err := m.AddWorkflowExecutionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	repeatedStringForTasks += "}"
-	s := strings.Join([]string{`&GetTaskQueueTasksResponse{`,
-		`Tasks:` + repeatedStringForTasks + `,`,
-		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *DeleteWorkflowExecutionRequest) String() string {
-	if this == nil {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	s := strings.Join([]string{`&DeleteWorkflowExecutionRequest{`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return nil
 }
-func (this *DeleteWorkflowExecutionResponse) String() string {
-	if this == nil {
-		return "nil"
+func (m *DescribeClusterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeClusterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeClusterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	s := strings.Join([]string{`&DeleteWorkflowExecutionResponse{`,
-		`Warnings:` + fmt.Sprintf("%v", this.Warnings) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringRequestResponse(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *RebuildMutableStateRequest) Unmarshal(dAtA []byte) error {
+func (m *DescribeClusterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10244,19 +20916,146 @@ func (m *RebuildMutableStateRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RebuildMutableStateRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RebuildMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeClusterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeClusterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupportedClients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SupportedClients == nil {
+				m.SupportedClients = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRequestResponse(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.SupportedClients[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10284,11 +21083,11 @@ func (m *RebuildMutableStateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.ServerVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MembershipInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10315,122 +21114,99 @@ func (m *RebuildMutableStateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Execution == nil {
-				m.Execution = &v1.WorkflowExecution{}
+			if m.MembershipInfo == nil {
+				m.MembershipInfo = &v13.MembershipInfo{}
 			}
-			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MembershipInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RebuildMutableStateResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RebuildMutableStateResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RebuildMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DescribeMutableStateRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryShardCount", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.HistoryShardCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryShardCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeMutableStateRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PersistenceStore", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10458,13 +21234,13 @@ func (m *DescribeMutableStateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.PersistenceStore = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VisibilityStore", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -10474,86 +21250,29 @@ func (m *DescribeMutableStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Execution == nil {
-				m.Execution = &v1.WorkflowExecution{}
-			}
-			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.VisibilityStore = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DescribeMutableStateResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeMutableStateResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -10563,29 +21282,33 @@ func (m *DescribeMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ShardId = string(dAtA[iNdEx:postIndex])
+			if m.VersionInfo == nil {
+				m.VersionInfo = &v19.VersionInfo{}
+			}
+			if err := m.VersionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoryAddr", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailoverVersionIncrement", wireType)
 			}
-			var stringLen uint64
+			m.FailoverVersionIncrement = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -10595,27 +21318,53 @@ func (m *DescribeMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.FailoverVersionIncrement |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialFailoverVersion", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
+			m.InitialFailoverVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialFailoverVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsGlobalNamespaceEnabled", wireType)
 			}
-			m.HistoryAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsGlobalNamespaceEnabled = bool(v != 0)
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CacheMutableState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Certificates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10642,16 +21391,14 @@ func (m *DescribeMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CacheMutableState == nil {
-				m.CacheMutableState = &v11.WorkflowMutableState{}
-			}
-			if err := m.CacheMutableState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Certificates = append(m.Certificates, &v13.CertificateInfo{})
+			if err := m.Certificates[len(m.Certificates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseMutableState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PersistenceFaults", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10678,10 +21425,8 @@ func (m *DescribeMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DatabaseMutableState == nil {
-				m.DatabaseMutableState = &v11.WorkflowMutableState{}
-			}
-			if err := m.DatabaseMutableState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PersistenceFaults = append(m.PersistenceFaults, &v11.PersistenceFault{})
+			if err := m.PersistenceFaults[len(m.PersistenceFaults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10709,7 +21454,7 @@ func (m *DescribeMutableStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
+func (m *ListClustersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10732,17 +21477,17 @@ func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeHistoryHostRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListClustersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeHistoryHostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListClustersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
 			}
-			var stringLen uint64
+			m.PageSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -10752,29 +21497,16 @@ func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PageSize |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HostAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
-			m.ShardId = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -10784,16 +21516,84 @@ func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListClustersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListClustersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListClustersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -10803,29 +21603,31 @@ func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Clusters = append(m.Clusters, &v11.ClusterMetadata{})
+			if err := m.Clusters[len(m.Clusters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -10835,26 +21637,24 @@ func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v1.WorkflowExecution{}
-			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -10881,7 +21681,7 @@ func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
+func (m *AddOrUpdateRemoteClusterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10904,112 +21704,17 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeHistoryHostResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddOrUpdateRemoteClusterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeHistoryHostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddOrUpdateRemoteClusterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardsNumber", wireType)
-			}
-			m.ShardsNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ShardsNumber |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType == 0 {
-				var v int32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRequestResponse
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.ShardIds = append(m.ShardIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRequestResponse
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthRequestResponse
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthRequestResponse
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.ShardIds) == 0 {
-					m.ShardIds = make([]int32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ShardIds = append(m.ShardIds, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardIds", wireType)
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceCache", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FrontendAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -11019,33 +21724,29 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NamespaceCache == nil {
-				m.NamespaceCache = &v12.NamespaceCacheInfo{}
-			}
-			if err := m.NamespaceCache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FrontendAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableRemoteClusterConnection", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -11055,24 +21756,12 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.EnableRemoteClusterConnection = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -11097,7 +21786,7 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CloseShardRequest) Unmarshal(dAtA []byte) error {
+func (m *AddOrUpdateRemoteClusterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11120,31 +21809,12 @@ func (m *CloseShardRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CloseShardRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddOrUpdateRemoteClusterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CloseShardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddOrUpdateRemoteClusterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
-			}
-			m.ShardId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -11169,7 +21839,7 @@ func (m *CloseShardRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CloseShardResponse) Unmarshal(dAtA []byte) error {
+func (m *RemoveRemoteClusterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11192,12 +21862,44 @@ func (m *CloseShardResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CloseShardResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveRemoteClusterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CloseShardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveRemoteClusterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -11222,7 +21924,7 @@ func (m *CloseShardResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetShardRequest) Unmarshal(dAtA []byte) error {
+func (m *RemoveRemoteClusterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11245,31 +21947,12 @@ func (m *GetShardRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetShardRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveRemoteClusterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetShardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveRemoteClusterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
-			}
-			m.ShardId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -11294,7 +21977,7 @@ func (m *GetShardRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetShardResponse) Unmarshal(dAtA []byte) error {
+func (m *ListClusterMembersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11317,15 +22000,15 @@ func (m *GetShardResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetShardResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListClusterMembersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetShardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListClusterMembersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeartbeatWithin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11352,71 +22035,50 @@ func (m *GetShardResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ShardInfo == nil {
-				m.ShardInfo = &v11.ShardInfo{}
+			if m.LastHeartbeatWithin == nil {
+				m.LastHeartbeatWithin = new(time.Duration)
 			}
-			if err := m.ShardInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.LastHeartbeatWithin, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RpcAddress", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListHistoryTasksRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListHistoryTasksRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListHistoryTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			m.RpcAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostId", wireType)
 			}
-			m.ShardId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -11426,16 +22088,29 @@ func (m *ListHistoryTasksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
-			m.Category = 0
+			m.Role = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -11445,14 +22120,14 @@ func (m *ListHistoryTasksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Category |= v13.TaskCategory(b&0x7F) << shift
+				m.Role |= v14.ClusterMemberRole(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskRange", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionStartedAfterTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11479,18 +22154,18 @@ func (m *ListHistoryTasksRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TaskRange == nil {
-				m.TaskRange = &v14.TaskRange{}
+			if m.SessionStartedAfterTime == nil {
+				m.SessionStartedAfterTime = new(time.Time)
 			}
-			if err := m.TaskRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.SessionStartedAfterTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
 			}
-			m.BatchSize = 0
+			m.PageSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -11500,12 +22175,12 @@ func (m *ListHistoryTasksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BatchSize |= int32(b&0x7F) << shift
+				m.PageSize |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
@@ -11563,7 +22238,7 @@ func (m *ListHistoryTasksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListHistoryTasksResponse) Unmarshal(dAtA []byte) error {
+func (m *ListClusterMembersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11586,15 +22261,15 @@ func (m *ListHistoryTasksResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListHistoryTasksResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListClusterMembersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListHistoryTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListClusterMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveMembers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11621,8 +22296,8 @@ func (m *ListHistoryTasksResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tasks = append(m.Tasks, &Task{})
-			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ActiveMembers = append(m.ActiveMembers, &v13.ClusterMember{})
+			if err := m.ActiveMembers[len(m.ActiveMembers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11684,7 +22359,7 @@ func (m *ListHistoryTasksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Task) Unmarshal(dAtA []byte) error {
+func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11707,17 +22382,17 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Task: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetDLQMessagesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Task: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var stringLen uint64
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -11727,27 +22402,33 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Type |= v14.DeadLetterQueueType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11775,13 +22456,51 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkflowId = string(dAtA[iNdEx:postIndex])
+			m.SourceCluster = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
+			}
+			m.InclusiveEndMessageId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InclusiveEndMessageId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumPageSize", wireType)
+			}
+			m.MaximumPageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaximumPageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -11791,48 +22510,84 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RunId = string(dAtA[iNdEx:postIndex])
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.TaskId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TaskId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-		case 5:
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDLQMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.TaskType = 0
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -11842,14 +22597,14 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskType |= v13.TaskType(b&0x7F) << shift
+				m.Type |= v14.DeadLetterQueueType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FireTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11876,18 +22631,16 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FireTime == nil {
-				m.FireTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.FireTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.ReplicationTasks = append(m.ReplicationTasks, &v16.ReplicationTask{})
+			if err := m.ReplicationTasks[len(m.ReplicationTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
-			m.Version = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -11897,11 +22650,26 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= int64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -11926,7 +22694,7 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
+func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11949,17 +22717,17 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveTaskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PurgeDLQMessagesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PurgeDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.ShardId = 0
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -11969,16 +22737,16 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
+				m.Type |= v14.DeadLetterQueueType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
 			}
-			m.Category = 0
+			m.ShardId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -11988,16 +22756,16 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Category |= v13.TaskCategory(b&0x7F) << shift
+				m.ShardId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
 			}
-			m.TaskId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -12007,16 +22775,29 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VisibilityTime", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
 			}
-			var msglen int
+			m.InclusiveEndMessageId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -12026,28 +22807,11 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.InclusiveEndMessageId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.VisibilityTime == nil {
-				m.VisibilityTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.VisibilityTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -12072,7 +22836,7 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *PurgeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12095,10 +22859,10 @@ func (m *RemoveTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PurgeDLQMessagesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PurgeDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -12125,7 +22889,7 @@ func (m *RemoveTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetWorkflowExecutionRawHistoryV2Request) Unmarshal(dAtA []byte) error {
+func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12148,53 +22912,17 @@ func (m *GetWorkflowExecutionRawHistoryV2Request) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetWorkflowExecutionRawHistoryV2Request: wiretype end group for non-group")
+			return fmt.Errorf("proto: MergeDLQMessagesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetWorkflowExecutionRawHistoryV2Request: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MergeDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Execution == nil {
-				m.Execution = &v1.WorkflowExecution{}
-			}
-			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartEventId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.StartEventId = 0
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -12204,16 +22932,16 @@ func (m *GetWorkflowExecutionRawHistoryV2Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartEventId |= int64(b&0x7F) << shift
+				m.Type |= v14.DeadLetterQueueType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartEventVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
 			}
-			m.StartEventVersion = 0
+			m.ShardId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -12223,16 +22951,16 @@ func (m *GetWorkflowExecutionRawHistoryV2Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartEventVersion |= int64(b&0x7F) << shift
+				m.ShardId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndEventId", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
 			}
-			m.EndEventId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -12242,16 +22970,29 @@ func (m *GetWorkflowExecutionRawHistoryV2Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EndEventId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndEventVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
 			}
-			m.EndEventVersion = 0
+			m.InclusiveEndMessageId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -12261,12 +23002,12 @@ func (m *GetWorkflowExecutionRawHistoryV2Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EndEventVersion |= int64(b&0x7F) << shift
+				m.InclusiveEndMessageId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MaximumPageSize", wireType)
 			}
@@ -12285,7 +23026,7 @@ func (m *GetWorkflowExecutionRawHistoryV2Request) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 8:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
@@ -12319,38 +23060,6 @@ func (m *GetWorkflowExecutionRawHistoryV2Request) Unmarshal(dAtA []byte) error {
 				m.NextPageToken = []byte{}
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -12375,7 +23084,7 @@ func (m *GetWorkflowExecutionRawHistoryV2Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetWorkflowExecutionRawHistoryV2Response) Unmarshal(dAtA []byte) error {
+func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12398,10 +23107,10 @@ func (m *GetWorkflowExecutionRawHistoryV2Response) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetWorkflowExecutionRawHistoryV2Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: MergeDLQMessagesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetWorkflowExecutionRawHistoryV2Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MergeDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12438,9 +23147,62 @@ func (m *GetWorkflowExecutionRawHistoryV2Response) Unmarshal(dAtA []byte) error
 				m.NextPageToken = []byte{}
 			}
 			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RefreshWorkflowTasksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RefreshWorkflowTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoryBatches", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12467,16 +23229,18 @@ func (m *GetWorkflowExecutionRawHistoryV2Response) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HistoryBatches = append(m.HistoryBatches, &v1.DataBlob{})
-			if err := m.HistoryBatches[len(m.HistoryBatches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Execution == nil {
+				m.Execution = &v1.WorkflowExecution{}
+			}
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -12486,104 +23250,77 @@ func (m *GetWorkflowExecutionRawHistoryV2Response) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VersionHistory == nil {
-				m.VersionHistory = &v14.VersionHistory{}
-			}
-			if err := m.VersionHistory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRequestResponse
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.HistoryNodeIds = append(m.HistoryNodeIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRequestResponse
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthRequestResponse
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthRequestResponse
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.HistoryNodeIds) == 0 {
-					m.HistoryNodeIds = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.HistoryNodeIds = append(m.HistoryNodeIds, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoryNodeIds", wireType)
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RefreshWorkflowTasksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RefreshWorkflowTasksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RefreshWorkflowTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -12608,7 +23345,7 @@ func (m *GetWorkflowExecutionRawHistoryV2Response) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *ResendReplicationTasksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12631,17 +23368,17 @@ func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetReplicationMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResendReplicationTasksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetReplicationMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResendReplicationTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -12651,29 +23388,27 @@ func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tokens = append(m.Tokens, &v15.ReplicationToken{})
-			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12701,66 +23436,45 @@ func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetReplicationMessagesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetReplicationMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardMessages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteCluster", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -12770,107 +23484,100 @@ func (m *GetReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ShardMessages == nil {
-				m.ShardMessages = make(map[int32]*v15.ReplicationMessages)
+			m.RemoteCluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEventId", wireType)
 			}
-			var mapkey int32
-			var mapvalue *v15.ReplicationMessages
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRequestResponse
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			m.StartEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &v15.ReplicationMessages{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipRequestResponse(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartVersion", wireType)
+			}
+			m.StartVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndEventId", wireType)
+			}
+			m.EndEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndVersion", wireType)
+			}
+			m.EndVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.ShardMessages[mapkey] = mapvalue
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -12895,7 +23602,7 @@ func (m *GetReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetNamespaceReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *ResendReplicationTasksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12918,17 +23625,70 @@ func (m *GetNamespaceReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetNamespaceReplicationMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResendReplicationTasksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetNamespaceReplicationMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResendReplicationTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTaskQueueTasksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTaskQueueTasksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTaskQueueTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastRetrievedMessageId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			m.LastRetrievedMessageId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -12938,16 +23698,29 @@ func (m *GetNamespaceReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastRetrievedMessageId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastProcessedMessageId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
 			}
-			m.LastProcessedMessageId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -12957,16 +23730,105 @@ func (m *GetNamespaceReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastProcessedMessageId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskQueue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueueType", wireType)
+			}
+			m.TaskQueueType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskQueueType |= v17.TaskQueueType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTaskId", wireType)
+			}
+			m.MinTaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinTaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTaskId", wireType)
+			}
+			m.MaxTaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
+			}
+			m.BatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -12976,23 +23838,25 @@ func (m *GetNamespaceReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13018,7 +23882,7 @@ func (m *GetNamespaceReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetNamespaceReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *GetTaskQueueTasksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13041,15 +23905,15 @@ func (m *GetNamespaceReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetNamespaceReplicationMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTaskQueueTasksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetNamespaceReplicationMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTaskQueueTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13076,13 +23940,45 @@ func (m *GetNamespaceReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Messages == nil {
-				m.Messages = &v15.ReplicationMessages{}
-			}
-			if err := m.Messages.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tasks = append(m.Tasks, &v11.AllocatedTaskInfo{})
+			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -13107,7 +24003,7 @@ func (m *GetNamespaceReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetDLQReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13130,15 +24026,47 @@ func (m *GetDLQReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetDLQReplicationMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDLQReplicationMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskInfos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13165,8 +24093,10 @@ func (m *GetDLQReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskInfos = append(m.TaskInfos, &v15.ReplicationTaskInfo{})
-			if err := m.TaskInfos[len(m.TaskInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Execution == nil {
+				m.Execution = &v1.WorkflowExecution{}
+			}
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13194,7 +24124,7 @@ func (m *GetDLQReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetDLQReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *DeleteWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13217,17 +24147,17 @@ func (m *GetDLQReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetDLQReplicationMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDLQReplicationMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Warnings", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -13237,25 +24167,23 @@ func (m *GetDLQReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReplicationTasks = append(m.ReplicationTasks, &v15.ReplicationTask{})
-			if err := m.ReplicationTasks[len(m.ReplicationTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Warnings = append(m.Warnings, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13281,7 +24209,7 @@ func (m *GetDLQReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
+func (m *ResetWorkflowExecutionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13304,15 +24232,79 @@ func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReapplyEventsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResetWorkflowExecutionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReapplyEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResetWorkflowExecutionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetPointSelector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13339,18 +24331,38 @@ func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v1.WorkflowExecution{}
+			if m.ResetPointSelector == nil {
+				m.ResetPointSelector = &v110.ResetPointSelector{}
 			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ResetPointSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -13360,33 +24372,133 @@ func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetReapplyType", wireType)
+			}
+			m.ResetReapplyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResetReapplyType |= v17.ResetReapplyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumPageSize", wireType)
+			}
+			m.MaximumPageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaximumPageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Events == nil {
-				m.Events = &v1.DataBlob{}
-			}
-			if err := m.Events.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Executions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -13396,23 +24508,25 @@ func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			m.Executions = append(m.Executions, &v1.WorkflowExecution{})
+			if err := m.Executions[len(m.Executions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13438,7 +24552,7 @@ func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReapplyEventsResponse) Unmarshal(dAtA []byte) error {
+func (m *ResetWorkflowExecutionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13461,12 +24575,80 @@ func (m *ReapplyEventsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReapplyEventsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResetWorkflowExecutionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReapplyEventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResetWorkflowExecutionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &v110.ResetPointResolution{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -13491,7 +24673,7 @@ func (m *ReapplyEventsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddSearchAttributesRequest) Unmarshal(dAtA []byte) error {
+func (m *PauseWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13514,17 +24696,17 @@ func (m *AddSearchAttributesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddSearchAttributesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PauseWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddSearchAttributesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PauseWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SearchAttributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -13534,110 +24716,29 @@ func (m *AddSearchAttributesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SearchAttributes == nil {
-				m.SearchAttributes = make(map[string]v16.IndexedValueType)
-			}
-			var mapkey string
-			var mapvalue v16.IndexedValueType
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRequestResponse
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvalue |= v16.IndexedValueType(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipRequestResponse(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.SearchAttributes[mapkey] = mapvalue
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -13647,29 +24748,33 @@ func (m *AddSearchAttributesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IndexName = string(dAtA[iNdEx:postIndex])
+			if m.Execution == nil {
+				m.Execution = &v1.WorkflowExecution{}
+			}
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SkipSchemaUpdate", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -13679,15 +24784,27 @@ func (m *AddSearchAttributesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SkipSchemaUpdate = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13715,7 +24832,7 @@ func (m *AddSearchAttributesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Identity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13741,7 +24858,7 @@ func (m *AddSearchAttributesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddSearchAttributesResponse) Unmarshal(dAtA []byte) error {
+func (m *PauseWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13764,10 +24881,10 @@ func (m *AddSearchAttributesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddSearchAttributesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PauseWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddSearchAttributesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PauseWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -13794,7 +24911,7 @@ func (m *AddSearchAttributesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveSearchAttributesRequest) Unmarshal(dAtA []byte) error {
+func (m *UnpauseWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13817,15 +24934,15 @@ func (m *RemoveSearchAttributesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveSearchAttributesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnpauseWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveSearchAttributesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnpauseWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SearchAttributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13853,13 +24970,13 @@ func (m *RemoveSearchAttributesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SearchAttributes = append(m.SearchAttributes, string(dAtA[iNdEx:postIndex]))
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -13869,27 +24986,31 @@ func (m *RemoveSearchAttributesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IndexName = string(dAtA[iNdEx:postIndex])
+			if m.Execution == nil {
+				m.Execution = &v1.WorkflowExecution{}
+			}
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13917,7 +25038,7 @@ func (m *RemoveSearchAttributesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Identity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13943,7 +25064,7 @@ func (m *RemoveSearchAttributesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveSearchAttributesResponse) Unmarshal(dAtA []byte) error {
+func (m *UnpauseWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13966,10 +25087,10 @@ func (m *RemoveSearchAttributesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveSearchAttributesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnpauseWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveSearchAttributesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnpauseWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -13996,7 +25117,7 @@ func (m *RemoveSearchAttributesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetSearchAttributesRequest) Unmarshal(dAtA []byte) error {
+func (m *PauseActivityExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14019,15 +25140,15 @@ func (m *GetSearchAttributesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetSearchAttributesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PauseActivityExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetSearchAttributesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PauseActivityExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14055,11 +25176,47 @@ func (m *GetSearchAttributesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IndexName = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Execution == nil {
+				m.Execution = &v1.WorkflowExecution{}
+			}
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14087,7 +25244,71 @@ func (m *GetSearchAttributesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.ActivityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14113,7 +25334,7 @@ func (m *GetSearchAttributesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetSearchAttributesResponse) Unmarshal(dAtA []byte) error {
+func (m *PauseActivityExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14136,17 +25357,70 @@ func (m *GetSearchAttributesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetSearchAttributesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PauseActivityExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetSearchAttributesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PauseActivityExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnpauseActivityExecutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnpauseActivityExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnpauseActivityExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CustomAttributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -14156,108 +25430,27 @@ func (m *GetSearchAttributesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.CustomAttributes == nil {
-				m.CustomAttributes = make(map[string]v16.IndexedValueType)
-			}
-			var mapkey string
-			var mapvalue v16.IndexedValueType
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRequestResponse
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvalue |= v16.IndexedValueType(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipRequestResponse(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+				return io.ErrUnexpectedEOF
 			}
-			m.CustomAttributes[mapkey] = mapvalue
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SystemAttributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14284,95 +25477,18 @@ func (m *GetSearchAttributesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SystemAttributes == nil {
-				m.SystemAttributes = make(map[string]v16.IndexedValueType)
+			if m.Execution == nil {
+				m.Execution = &v1.WorkflowExecution{}
 			}
-			var mapkey string
-			var mapvalue v16.IndexedValueType
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRequestResponse
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvalue |= v16.IndexedValueType(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipRequestResponse(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.SystemAttributes[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mapping", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -14382,124 +25498,29 @@ func (m *GetSearchAttributesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Mapping == nil {
-				m.Mapping = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRequestResponse
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipRequestResponse(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Mapping[mapkey] = mapvalue
+			m.ActivityId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddWorkflowExecutionInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -14509,28 +25530,77 @@ func (m *GetSearchAttributesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AddWorkflowExecutionInfo == nil {
-				m.AddWorkflowExecutionInfo = &v17.WorkflowExecutionInfo{}
-			}
-			if err := m.AddWorkflowExecutionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnpauseActivityExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnpauseActivityExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnpauseActivityExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -14555,7 +25625,7 @@ func (m *GetSearchAttributesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeClusterRequest) Unmarshal(dAtA []byte) error {
+func (m *ResetActivityExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14578,15 +25648,15 @@ func (m *DescribeClusterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeClusterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResetActivityExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeClusterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResetActivityExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14614,7 +25684,107 @@ func (m *DescribeClusterRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Execution == nil {
+				m.Execution = &v1.WorkflowExecution{}
+			}
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActivityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14640,7 +25810,7 @@ func (m *DescribeClusterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeClusterResponse) Unmarshal(dAtA []byte) error {
+func (m *ResetActivityExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14663,142 +25833,68 @@ func (m *DescribeClusterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeClusterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResetActivityExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeClusterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResetActivityExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SupportedClients", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SupportedClients == nil {
-				m.SupportedClients = make(map[string]string)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateActivityExecutionOptionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRequestResponse
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipRequestResponse(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.SupportedClients[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateActivityExecutionOptionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateActivityExecutionOptionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14826,11 +25922,11 @@ func (m *DescribeClusterResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServerVersion = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MembershipInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14857,16 +25953,16 @@ func (m *DescribeClusterResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MembershipInfo == nil {
-				m.MembershipInfo = &v18.MembershipInfo{}
+			if m.Execution == nil {
+				m.Execution = &v1.WorkflowExecution{}
 			}
-			if err := m.MembershipInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14894,13 +25990,13 @@ func (m *DescribeClusterResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			m.ActivityId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -14910,78 +26006,31 @@ func (m *DescribeClusterResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoryShardCount", wireType)
-			}
-			m.HistoryShardCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.HistoryShardCount |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PersistenceStore", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
+			if m.Options == nil {
+				m.Options = &v110.ActivityOptions{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Options.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.PersistenceStore = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VisibilityStore", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15009,102 +26058,8 @@ func (m *DescribeClusterResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VisibilityStore = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionInfo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.VersionInfo == nil {
-				m.VersionInfo = &v19.VersionInfo{}
-			}
-			if err := m.VersionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailoverVersionIncrement", wireType)
-			}
-			m.FailoverVersionIncrement = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FailoverVersionIncrement |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialFailoverVersion", wireType)
-			}
-			m.InitialFailoverVersion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.InitialFailoverVersion |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsGlobalNamespaceEnabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsGlobalNamespaceEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -15129,7 +26084,7 @@ func (m *DescribeClusterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListClustersRequest) Unmarshal(dAtA []byte) error {
+func (m *UpdateActivityExecutionOptionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15144,73 +26099,20 @@ func (m *ListClustersRequest) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListClustersRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListClustersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
-			}
-			m.PageSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PageSize |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateActivityExecutionOptionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateActivityExecutionOptionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -15235,7 +26137,7 @@ func (m *ListClustersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListClustersResponse) Unmarshal(dAtA []byte) error {
+func (m *ListAuditRecordsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15258,17 +26160,17 @@ func (m *ListClustersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListClustersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListAuditRecordsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListClustersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListAuditRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -15278,31 +26180,29 @@ func (m *ListClustersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Clusters = append(m.Clusters, &v11.ClusterMetadata{})
-			if err := m.Clusters[len(m.Clusters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -15312,82 +26212,27 @@ func (m *ListClustersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
-			}
+			m.Principal = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AddOrUpdateRemoteClusterRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AddOrUpdateRemoteClusterRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddOrUpdateRemoteClusterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FrontendAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15415,13 +26260,13 @@ func (m *AddOrUpdateRemoteClusterRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FrontendAddress = string(dAtA[iNdEx:postIndex])
+			m.ApiName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableRemoteClusterConnection", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -15431,123 +26276,88 @@ func (m *AddOrUpdateRemoteClusterRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableRemoteClusterConnection = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AddOrUpdateRemoteClusterResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AddOrUpdateRemoteClusterResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddOrUpdateRemoteClusterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RemoveRemoteClusterRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveRemoteClusterRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveRemoteClusterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -15557,23 +26367,25 @@ func (m *RemoveRemoteClusterRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			}
+			if byteLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15599,7 +26411,7 @@ func (m *RemoveRemoteClusterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveRemoteClusterResponse) Unmarshal(dAtA []byte) error {
+func (m *ListAuditRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15622,12 +26434,80 @@ func (m *RemoveRemoteClusterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveRemoteClusterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListAuditRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveRemoteClusterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListAuditRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &v11.AuditRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -15652,7 +26532,7 @@ func (m *RemoveRemoteClusterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListClusterMembersRequest) Unmarshal(dAtA []byte) error {
+func (m *CreateNamespaceApiKeyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15675,17 +26555,17 @@ func (m *ListClusterMembersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListClusterMembersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateNamespaceApiKeyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListClusterMembersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateNamespaceApiKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastHeartbeatWithin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -15695,31 +26575,27 @@ func (m *ListClusterMembersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastHeartbeatWithin == nil {
-				m.LastHeartbeatWithin = new(time.Duration)
-			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.LastHeartbeatWithin, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RpcAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15747,11 +26623,11 @@ func (m *ListClusterMembersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RpcAddress = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15779,13 +26655,13 @@ func (m *ListClusterMembersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostId = string(dAtA[iNdEx:postIndex])
+			m.Role = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
 			}
-			m.Role = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -15795,14 +26671,116 @@ func (m *ListClusterMembersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Role |= v13.ClusterMemberRole(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ttl == nil {
+				m.Ttl = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Ttl, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionStartedAfterTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateNamespaceApiKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateNamespaceApiKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateNamespaceApiKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15829,18 +26807,18 @@ func (m *ListClusterMembersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SessionStartedAfterTime == nil {
-				m.SessionStartedAfterTime = new(time.Time)
+			if m.ApiKey == nil {
+				m.ApiKey = &v11.NamespaceApiKey{}
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.SessionStartedAfterTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ApiKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
-			m.PageSize = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -15850,16 +26828,82 @@ func (m *ListClusterMembersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PageSize |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListNamespaceApiKeysRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListNamespaceApiKeysRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListNamespaceApiKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -15869,25 +26913,23 @@ func (m *ListClusterMembersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
-			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15913,7 +26955,7 @@ func (m *ListClusterMembersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListClusterMembersResponse) Unmarshal(dAtA []byte) error {
+func (m *ListNamespaceApiKeysResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15936,15 +26978,15 @@ func (m *ListClusterMembersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListClusterMembersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListNamespaceApiKeysResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListClusterMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListNamespaceApiKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveMembers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiKeys", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15971,45 +27013,11 @@ func (m *ListClusterMembersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActiveMembers = append(m.ActiveMembers, &v18.ClusterMember{})
-			if err := m.ActiveMembers[len(m.ActiveMembers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ApiKeys = append(m.ApiKeys, &v11.NamespaceApiKey{})
+			if err := m.ApiKeys[len(m.ApiKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -16034,7 +27042,7 @@ func (m *ListClusterMembersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *RotateNamespaceApiKeyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16057,53 +27065,15 @@ func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetDLQMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RotateNamespaceApiKeyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RotateNamespaceApiKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= v13.DeadLetterQueueType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
-			}
-			m.ShardId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16131,13 +27101,13 @@ func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
 			}
-			m.InclusiveEndMessageId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -16147,35 +27117,29 @@ func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InclusiveEndMessageId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaximumPageSize", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-			m.MaximumPageSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaximumPageSize |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-		case 6:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -16185,24 +27149,26 @@ func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
+			if m.Ttl == nil {
+				m.Ttl = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Ttl, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -16229,7 +27195,7 @@ func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *RotateNamespaceApiKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16252,34 +27218,15 @@ func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetDLQMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RotateNamespaceApiKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RotateNamespaceApiKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= v13.DeadLetterQueueType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16306,16 +27253,18 @@ func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReplicationTasks = append(m.ReplicationTasks, &v15.ReplicationTask{})
-			if err := m.ReplicationTasks[len(m.ReplicationTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ApiKey == nil {
+				m.ApiKey = &v11.NamespaceApiKey{}
+			}
+			if err := m.ApiKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -16325,25 +27274,23 @@ func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
-			}
+			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16369,7 +27316,7 @@ func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *RevokeNamespaceApiKeyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16392,53 +27339,15 @@ func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PurgeDLQMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RevokeNamespaceApiKeyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PurgeDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RevokeNamespaceApiKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= v13.DeadLetterQueueType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
-			}
-			m.ShardId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16466,13 +27375,13 @@ func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
 			}
-			m.InclusiveEndMessageId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -16482,11 +27391,24 @@ func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InclusiveEndMessageId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -16511,7 +27433,7 @@ func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PurgeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *RevokeNamespaceApiKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16534,10 +27456,10 @@ func (m *PurgeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PurgeDLQMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RevokeNamespaceApiKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PurgeDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RevokeNamespaceApiKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -16564,7 +27486,7 @@ func (m *PurgeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *GetReplicationStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16587,53 +27509,15 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MergeDLQMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetReplicationStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MergeDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetReplicationStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= v13.DeadLetterQueueType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
-			}
-			m.ShardId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteClusters", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16661,51 +27545,13 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			m.RemoteClusters = append(m.RemoteClusters, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
-			}
-			m.InclusiveEndMessageId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.InclusiveEndMessageId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaximumPageSize", wireType)
-			}
-			m.MaximumPageSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaximumPageSize |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeShards", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -16715,26 +27561,12 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
-			}
-			iNdEx = postIndex
+			m.IncludeShards = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -16759,7 +27591,7 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *GetReplicationStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16782,17 +27614,146 @@ func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MergeDLQMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetReplicationStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MergeDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetReplicationStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteClusters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RemoteClusters == nil {
+				m.RemoteClusters = make(map[string]*ClusterReplicationStatus)
+			}
+			var mapkey string
+			var mapvalue *ClusterReplicationStatus
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &ClusterReplicationStatus{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRequestResponse(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.RemoteClusters[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shards", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -16802,24 +27763,24 @@ func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
+			m.Shards = append(m.Shards, &ShardReplicationStatus{})
+			if err := m.Shards[len(m.Shards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -16846,7 +27807,7 @@ func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
+func (m *ClusterReplicationStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16869,15 +27830,34 @@ func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RefreshWorkflowTasksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterReplicationStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RefreshWorkflowTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterReplicationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardCount", wireType)
+			}
+			m.ShardCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOutboundLag", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16904,18 +27884,18 @@ func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Execution == nil {
-				m.Execution = &v1.WorkflowExecution{}
+			if m.MaxOutboundLag == nil {
+				m.MaxOutboundLag = new(time.Duration)
 			}
-			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.MaxOutboundLag, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInboundLag", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -16925,77 +27905,85 @@ func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
+			if m.MaxInboundLag == nil {
+				m.MaxInboundLag = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.MaxInboundLag, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundDlqTaskCount", wireType)
 			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRequestResponse
+			m.InboundDlqTaskCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InboundDlqTaskCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryingProcessorCount", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RefreshWorkflowTasksResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
+			m.RetryingProcessorCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryingProcessorCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoppedProcessorCount", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.StoppedProcessorCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StoppedProcessorCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RefreshWorkflowTasksResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RefreshWorkflowTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -17020,7 +28008,7 @@ func (m *RefreshWorkflowTasksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResendReplicationTasksRequest) Unmarshal(dAtA []byte) error {
+func (m *ShardReplicationStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17043,113 +28031,17 @@ func (m *ResendReplicationTasksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResendReplicationTasksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShardReplicationStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResendReplicationTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShardReplicationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.WorkflowId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RunId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteCluster", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
 			}
-			var stringLen uint64
+			m.ShardId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -17159,29 +28051,16 @@ func (m *ResendReplicationTasksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ShardId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RemoteCluster = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartEventId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReplicationTaskId", wireType)
 			}
-			m.StartEventId = 0
+			m.MaxReplicationTaskId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -17191,16 +28070,16 @@ func (m *ResendReplicationTasksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartEventId |= int64(b&0x7F) << shift
+				m.MaxReplicationTaskId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartVersion", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReplicationTaskVisibilityTime", wireType)
 			}
-			m.StartVersion = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -17210,16 +28089,33 @@ func (m *ResendReplicationTasksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartVersion |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndEventId", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-			m.EndEventId = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxReplicationTaskVisibilityTime == nil {
+				m.MaxReplicationTaskVisibilityTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.MaxReplicationTaskVisibilityTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteClusters", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -17229,16 +28125,126 @@ func (m *ResendReplicationTasksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EndEventId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndVersion", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-			m.EndVersion = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RemoteClusters == nil {
+				m.RemoteClusters = make(map[string]*ShardClusterReplicationStatus)
+			}
+			var mapkey string
+			var mapvalue *ShardClusterReplicationStatus
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &ShardClusterReplicationStatus{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRequestResponse(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.RemoteClusters[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HandoverReplicationTaskIds", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -17248,64 +28254,105 @@ func (m *ResendReplicationTasksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EndVersion |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResendReplicationTasksResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.HandoverReplicationTaskIds == nil {
+				m.HandoverReplicationTaskIds = make(map[string]int64)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var mapkey string
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRequestResponse(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResendReplicationTasksResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResendReplicationTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.HandoverReplicationTaskIds[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -17330,7 +28377,7 @@ func (m *ResendReplicationTasksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTaskQueueTasksRequest) Unmarshal(dAtA []byte) error {
+func (m *ShardClusterReplicationStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17353,17 +28400,17 @@ func (m *GetTaskQueueTasksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTaskQueueTasksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShardClusterReplicationStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTaskQueueTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShardClusterReplicationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckedTaskId", wireType)
 			}
-			var stringLen uint64
+			m.AckedTaskId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -17373,29 +28420,16 @@ func (m *GetTaskQueueTasksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AckedTaskId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AckedTaskVisibilityTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -17405,29 +28439,33 @@ func (m *GetTaskQueueTasksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskQueue = string(dAtA[iNdEx:postIndex])
+			if m.AckedTaskVisibilityTime == nil {
+				m.AckedTaskVisibilityTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.AckedTaskVisibilityTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueueType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundLag", wireType)
 			}
-			m.TaskQueueType = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -17437,73 +28475,33 @@ func (m *GetTaskQueueTasksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskQueueType |= v16.TaskQueueType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinTaskId", wireType)
-			}
-			m.MinTaskId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MinTaskId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTaskId", wireType)
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-			m.MaxTaskId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxTaskId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
+			if m.OutboundLag == nil {
+				m.OutboundLag = new(time.Duration)
 			}
-			m.BatchSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BatchSize |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.OutboundLag, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 7:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Inbound", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -17513,24 +28511,26 @@ func (m *GetTaskQueueTasksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
+			if m.Inbound == nil {
+				m.Inbound = &v16.ShardReplicationInboundStatus{}
+			}
+			if err := m.Inbound.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -17557,7 +28557,7 @@ func (m *GetTaskQueueTasksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTaskQueueTasksResponse) Unmarshal(dAtA []byte) error {
+func (m *SetPersistenceFaultRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17580,15 +28580,15 @@ func (m *GetTaskQueueTasksResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTaskQueueTasksResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetPersistenceFaultRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTaskQueueTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetPersistenceFaultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fault", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17615,16 +28615,18 @@ func (m *GetTaskQueueTasksResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tasks = append(m.Tasks, &v11.AllocatedTaskInfo{})
-			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Fault == nil {
+				m.Fault = &v11.PersistenceFault{}
+			}
+			if err := m.Fault.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -17634,24 +28636,26 @@ func (m *GetTaskQueueTasksResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
+			if m.Ttl == nil {
+				m.Ttl = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Ttl, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -17678,7 +28682,7 @@ func (m *GetTaskQueueTasksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *SetPersistenceFaultResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17701,47 +28705,15 @@ func (m *DeleteWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetPersistenceFaultResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetPersistenceFaultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fault", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17768,10 +28740,10 @@ func (m *DeleteWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Execution == nil {
-				m.Execution = &v1.WorkflowExecution{}
+			if m.Fault == nil {
+				m.Fault = &v11.PersistenceFault{}
 			}
-			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Fault.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17799,7 +28771,7 @@ func (m *DeleteWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+func (m *RemovePersistenceFaultRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17822,15 +28794,15 @@ func (m *DeleteWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteWorkflowExecutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemovePersistenceFaultRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemovePersistenceFaultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Warnings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17858,7 +28830,7 @@ func (m *DeleteWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Warnings = append(m.Warnings, string(dAtA[iNdEx:postIndex]))
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17884,6 +28856,59 @@ func (m *DeleteWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RemovePersistenceFaultResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemovePersistenceFaultResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemovePersistenceFaultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipRequestResponse(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
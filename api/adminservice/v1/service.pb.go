@@ -54,61 +54,78 @@ func init() {
 }
 
 var fileDescriptor_cf5ca5e0c737570d = []byte{
-	// 862 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x98, 0xcd, 0x6b, 0x13, 0x4f,
-	0x18, 0xc7, 0x33, 0x97, 0x1f, 0x3f, 0x86, 0xfe, 0x5e, 0x5c, 0xc5, 0x97, 0x1e, 0xd6, 0xa2, 0xf7,
-	0x84, 0x56, 0xad, 0xf6, 0xbd, 0x69, 0x12, 0x53, 0x30, 0x51, 0x9b, 0xf8, 0x02, 0x5e, 0x64, 0x93,
-	0x7d, 0xda, 0x2e, 0xdd, 0x64, 0xd7, 0x99, 0xd9, 0xd4, 0x9e, 0xf4, 0x22, 0x08, 0x82, 0x28, 0x08,
-	0x82, 0xe0, 0x49, 0x10, 0x05, 0xff, 0x06, 0xc1, 0x9b, 0xc7, 0x1e, 0x7b, 0xb4, 0xe9, 0xc5, 0x63,
-	0x4f, 0x9e, 0x65, 0xbb, 0x99, 0xe9, 0x6e, 0x32, 0x96, 0x99, 0x4d, 0x6f, 0x4d, 0x77, 0x3f, 0xdf,
-	0xe7, 0x93, 0x27, 0x3b, 0xf3, 0x4c, 0x82, 0xc7, 0x19, 0xb4, 0x7c, 0x8f, 0x58, 0x6e, 0x8e, 0x02,
-	0xe9, 0x00, 0xc9, 0x59, 0xbe, 0x93, 0xb3, 0xec, 0x96, 0xd3, 0x0e, 0x5f, 0x3b, 0x4d, 0xc8, 0x75,
-	0xc6, 0x73, 0xbd, 0x3f, 0xb3, 0x3e, 0xf1, 0x98, 0x67, 0x5c, 0xe4, 0x48, 0x36, 0x42, 0xb2, 0x96,
-	0xef, 0x64, 0xe3, 0x48, 0xb6, 0x33, 0x3e, 0x3a, 0xad, 0x92, 0x4b, 0xe0, 0x51, 0x00, 0x94, 0x3d,
-	0x24, 0x40, 0x7d, 0xaf, 0x4d, 0x7b, 0x05, 0x26, 0x7e, 0x8d, 0xe1, 0x91, 0x7c, 0x78, 0x6b, 0x3d,
-	0xba, 0xd5, 0x78, 0x87, 0xf0, 0xc9, 0x1a, 0x34, 0x02, 0xc7, 0xb5, 0xab, 0x01, 0xb3, 0x1a, 0x2e,
-	0xd4, 0x99, 0xc5, 0xc0, 0x58, 0xc8, 0x2a, 0xa8, 0x64, 0x25, 0x64, 0x2d, 0x2a, 0x3c, 0xba, 0x98,
-	0x3e, 0x20, 0x32, 0xbe, 0x90, 0x31, 0xde, 0x23, 0x7c, 0xaa, 0x08, 0xb4, 0x49, 0x9c, 0x06, 0x24,
-	0xec, 0xd4, 0xc2, 0x65, 0x28, 0xd7, 0xcb, 0x0f, 0x91, 0x20, 0xfc, 0xc2, 0xe6, 0xf1, 0x5b, 0x96,
-	0x1d, 0xca, 0x3c, 0xb2, 0xb5, 0xec, 0x51, 0xa6, 0xd8, 0x3c, 0x09, 0xa9, 0xd7, 0x3c, 0x69, 0x80,
-	0x90, 0xdb, 0xc2, 0x7f, 0x97, 0x81, 0xd5, 0xd7, 0x2d, 0x62, 0x1b, 0x97, 0x95, 0xf2, 0xf8, 0xed,
-	0xdc, 0xe2, 0x8a, 0x26, 0x25, 0x4a, 0x3f, 0xc1, 0xb8, 0xe0, 0x7a, 0x14, 0xa2, 0xe2, 0x93, 0x4a,
-	0x31, 0x87, 0x00, 0x2f, 0x7f, 0x55, 0x9b, 0x13, 0x02, 0xaf, 0x11, 0xfe, 0xbf, 0xe2, 0x50, 0xd6,
-	0xeb, 0xcc, 0x1d, 0x8b, 0x6e, 0x50, 0x63, 0x56, 0x29, 0xaf, 0x1f, 0xe3, 0x36, 0x73, 0x29, 0xe9,
-	0x78, 0x53, 0x6a, 0xd0, 0xf2, 0x3a, 0x10, 0x5e, 0x50, 0x6c, 0xca, 0x21, 0xa0, 0xd7, 0x94, 0x38,
-	0x27, 0x04, 0xbe, 0x21, 0x3c, 0x56, 0x06, 0x76, 0xdf, 0x23, 0x1b, 0xab, 0xae, 0xb7, 0x59, 0x7a,
-	0x0c, 0xcd, 0x80, 0x39, 0x5e, 0xbb, 0x66, 0x6d, 0xf6, 0x94, 0xef, 0x4d, 0x18, 0x15, 0xd5, 0xcf,
-	0xfc, 0xc8, 0x18, 0x6e, 0x5b, 0x3d, 0xa6, 0x34, 0xf1, 0x1e, 0x3e, 0x20, 0x7c, 0xba, 0x0c, 0xac,
-	0x06, 0xbe, 0xeb, 0x34, 0xad, 0xf0, 0xc6, 0x2a, 0x50, 0x6a, 0xad, 0x01, 0x35, 0x96, 0x54, 0x6b,
-	0x49, 0x60, 0xee, 0x5b, 0x18, 0x2a, 0x43, 0x58, 0x7e, 0x45, 0xf8, 0x7c, 0x19, 0xd8, 0x4d, 0xab,
-	0x05, 0xd4, 0xb7, 0x9a, 0x20, 0xd3, 0xbd, 0xa1, 0x5a, 0xea, 0xa8, 0x14, 0xee, 0x5d, 0x39, 0x9e,
-	0x30, 0xf1, 0x06, 0xbe, 0x20, 0x7c, 0xae, 0x0c, 0xac, 0x58, 0x59, 0x91, 0xa9, 0x97, 0x54, 0xab,
-	0xc9, 0x79, 0x2e, 0x7d, 0x7d, 0xd8, 0x18, 0xa1, 0xfb, 0x1c, 0xe1, 0x7f, 0x6a, 0x60, 0xf9, 0xbe,
-	0xbb, 0x55, 0xea, 0x40, 0x9b, 0x51, 0x63, 0x4a, 0x71, 0x99, 0xc4, 0x18, 0xae, 0x35, 0x9d, 0x06,
-	0x4d, 0x8c, 0x84, 0xbc, 0x6d, 0xd7, 0xc1, 0x22, 0xcd, 0xf5, 0x3c, 0x63, 0xc4, 0x69, 0x04, 0x0c,
-	0xa8, 0xe2, 0x48, 0x90, 0x90, 0x7a, 0x23, 0x41, 0x1a, 0x90, 0x58, 0x3d, 0xd1, 0xd6, 0x30, 0xe0,
-	0xb7, 0xa4, 0xb1, 0xaf, 0xfc, 0x49, 0xb1, 0x30, 0x54, 0x46, 0xa2, 0x85, 0xe1, 0x50, 0x49, 0xd7,
-	0x42, 0x09, 0xa9, 0xd7, 0x42, 0x69, 0x80, 0x90, 0x7b, 0x89, 0xf0, 0x7f, 0x7c, 0xee, 0x16, 0xdc,
-	0x80, 0x32, 0x20, 0xc6, 0x8c, 0xd6, 0xb4, 0xee, 0x51, 0x5c, 0x6a, 0x36, 0x1d, 0x2c, 0x84, 0x9e,
-	0x21, 0x3c, 0x12, 0x4e, 0x9d, 0xde, 0x15, 0x6a, 0x5c, 0x53, 0x1e, 0x54, 0x1c, 0xe1, 0x2a, 0x53,
-	0x29, 0x48, 0xe1, 0xf1, 0x16, 0x61, 0x23, 0x76, 0xa9, 0x0a, 0xad, 0x46, 0x68, 0x33, 0xaf, 0x9b,
-	0xd9, 0x03, 0xb9, 0xd3, 0x42, 0x6a, 0x5e, 0x98, 0x7d, 0x46, 0xf8, 0x6c, 0xde, 0xb6, 0x6f, 0x91,
-	0xbb, 0xbe, 0x7d, 0x70, 0x7e, 0x6b, 0x79, 0x4c, 0x7c, 0x76, 0x45, 0xd5, 0x65, 0x25, 0xc5, 0xb9,
-	0x65, 0x69, 0xc8, 0x94, 0xc4, 0xb3, 0x1f, 0x2d, 0x90, 0xa4, 0xe6, 0x82, 0xc6, 0xd2, 0x92, 0x1a,
-	0x2e, 0xa6, 0x0f, 0x10, 0x72, 0x2f, 0x10, 0xfe, 0x37, 0xda, 0x8e, 0xc5, 0x28, 0x98, 0xd6, 0xd8,
-	0xc3, 0xfb, 0xf7, 0xff, 0x99, 0x54, 0x6c, 0xe2, 0x8c, 0x77, 0x3b, 0x20, 0x6b, 0x10, 0xf7, 0x51,
-	0x5b, 0x4d, 0xfd, 0x98, 0xde, 0x19, 0x6f, 0x90, 0x4e, 0x38, 0x55, 0x21, 0x95, 0x53, 0x3f, 0xa6,
-	0xe7, 0x34, 0x48, 0x27, 0xbe, 0x44, 0xd5, 0x60, 0x95, 0x00, 0x5d, 0xe7, 0xa7, 0xac, 0xe8, 0x3c,
-	0xac, 0xfa, 0x48, 0x0c, 0xa2, 0x7a, 0x5f, 0xa2, 0xe4, 0x09, 0x7d, 0x43, 0x89, 0x42, 0xdb, 0x8e,
-	0x0d, 0xf9, 0xc8, 0x50, 0x75, 0x28, 0xc9, 0x60, 0xdd, 0xa1, 0x24, 0xcf, 0x10, 0x96, 0x6f, 0x10,
-	0x3e, 0x51, 0x06, 0x16, 0xfe, 0x7b, 0x25, 0x80, 0x00, 0x22, 0xc1, 0x39, 0xd5, 0x47, 0x38, 0xc9,
-	0x71, 0xb7, 0xf9, 0xb4, 0xb8, 0xd0, 0xfa, 0x88, 0xf0, 0x99, 0x22, 0xb8, 0xc0, 0x60, 0xe0, 0x04,
-	0x6d, 0x14, 0x14, 0x27, 0x8b, 0x94, 0xe6, 0x8a, 0xc5, 0xe1, 0x42, 0xb8, 0xe8, 0x92, 0xbb, 0xbd,
-	0x6b, 0x66, 0x76, 0x76, 0xcd, 0xcc, 0xfe, 0xae, 0x89, 0x9e, 0x76, 0x4d, 0xf4, 0xa9, 0x6b, 0xa2,
-	0xef, 0x5d, 0x13, 0x6d, 0x77, 0x4d, 0xf4, 0xa3, 0x6b, 0xa2, 0x9f, 0x5d, 0x33, 0xb3, 0xdf, 0x35,
-	0xd1, 0xab, 0x3d, 0x33, 0xb3, 0xbd, 0x67, 0x66, 0x76, 0xf6, 0xcc, 0xcc, 0x83, 0xc9, 0x35, 0xef,
-	0xb0, 0xbe, 0xe3, 0x1d, 0xf1, 0x83, 0xc7, 0x4c, 0xfc, 0x75, 0xe3, 0xaf, 0x83, 0x5f, 0x3b, 0x2e,
-	0xfd, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xb2, 0x73, 0xcc, 0x7e, 0x83, 0x11, 0x00, 0x00,
+	// 1129 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x99, 0xcd, 0x8b, 0x23, 0xc5,
+	0x1b, 0xc7, 0x53, 0x97, 0x1f, 0x3f, 0x8a, 0xf5, 0xad, 0x7d, 0xdf, 0x43, 0x2b, 0x7a, 0x4f, 0x98,
+	0x75, 0x5d, 0xdd, 0x99, 0xdd, 0x9d, 0xcd, 0x24, 0xd9, 0x2c, 0xce, 0xc4, 0x9d, 0x4d, 0x5c, 0x05,
+	0x2f, 0xd2, 0x49, 0x3f, 0x3b, 0xd3, 0x4c, 0x27, 0xdd, 0x56, 0x55, 0x67, 0xcd, 0x49, 0x2f, 0x82,
+	0x20, 0x88, 0x82, 0x20, 0x08, 0x82, 0x20, 0xf8, 0x02, 0xfe, 0x07, 0x82, 0xe0, 0xcd, 0xe3, 0x1c,
+	0xf7, 0xe8, 0x64, 0x2e, 0x1e, 0xf7, 0x4f, 0x90, 0xde, 0x4e, 0xd5, 0xa4, 0xd2, 0x4f, 0x87, 0xaa,
+	0xee, 0xb9, 0x4d, 0x26, 0xf5, 0xfd, 0xd6, 0xa7, 0xbf, 0xf5, 0xf2, 0x54, 0x57, 0xe8, 0x86, 0x80,
+	0x71, 0x1c, 0x31, 0x2f, 0x6c, 0x70, 0x60, 0x53, 0x60, 0x0d, 0x2f, 0x0e, 0x1a, 0x9e, 0x3f, 0x0e,
+	0x26, 0xe9, 0xe7, 0x60, 0x04, 0x8d, 0xe9, 0x46, 0x63, 0xf1, 0x67, 0x3d, 0x66, 0x91, 0x88, 0x9c,
+	0xd7, 0xa5, 0xa4, 0x9e, 0x49, 0xea, 0x5e, 0x1c, 0xd4, 0x97, 0x25, 0xf5, 0xe9, 0xc6, 0xc5, 0x4d,
+	0x13, 0x5f, 0x06, 0x1f, 0x27, 0xc0, 0xc5, 0x47, 0x0c, 0x78, 0x1c, 0x4d, 0xf8, 0xa2, 0x83, 0x4b,
+	0xbf, 0x5c, 0xa6, 0x17, 0x9a, 0x69, 0xd3, 0x41, 0xd6, 0xd4, 0xf9, 0x9e, 0xd0, 0x67, 0xfb, 0x30,
+	0x4c, 0x82, 0xd0, 0xef, 0x25, 0xc2, 0x1b, 0x86, 0x30, 0x10, 0x9e, 0x00, 0x67, 0xbb, 0x6e, 0x80,
+	0x52, 0x47, 0x94, 0xfd, 0xac, 0xe3, 0x8b, 0x37, 0xcb, 0x1b, 0x64, 0xc4, 0xaf, 0xd5, 0x9c, 0x1f,
+	0x08, 0x7d, 0xae, 0x0d, 0x7c, 0xc4, 0x82, 0x21, 0x68, 0x74, 0x66, 0xe6, 0x98, 0x54, 0xe2, 0x35,
+	0x2b, 0x38, 0x28, 0xbe, 0x34, 0x3c, 0xd9, 0xe4, 0x76, 0xc0, 0x45, 0xc4, 0x66, 0xb7, 0x23, 0x2e,
+	0x0c, 0xc3, 0x43, 0x94, 0x76, 0xe1, 0xa1, 0x06, 0x0a, 0x6e, 0x46, 0xff, 0xdf, 0x05, 0x31, 0x38,
+	0xf4, 0x98, 0xef, 0x5c, 0x36, 0xf2, 0x93, 0xcd, 0x25, 0xc5, 0x9b, 0x96, 0x2a, 0xd5, 0xf5, 0xa7,
+	0x94, 0xb6, 0xc2, 0x88, 0x43, 0xd6, 0xf9, 0x15, 0x23, 0x9b, 0x33, 0x81, 0xec, 0xfe, 0x2d, 0x6b,
+	0x9d, 0x02, 0xf8, 0x86, 0xd0, 0xa7, 0xf7, 0x02, 0x2e, 0x16, 0xc9, 0xbc, 0xe7, 0xf1, 0x23, 0xee,
+	0x5c, 0x33, 0xf2, 0x5b, 0x95, 0x49, 0x9a, 0xeb, 0x25, 0xd5, 0xcb, 0xa1, 0xf4, 0x61, 0x1c, 0x4d,
+	0x21, 0xfd, 0xc2, 0x30, 0x94, 0x33, 0x81, 0x5d, 0x28, 0xcb, 0x3a, 0x05, 0xf0, 0x17, 0xa1, 0xaf,
+	0x76, 0x41, 0x7c, 0x10, 0xb1, 0xa3, 0xfb, 0x61, 0xf4, 0xa0, 0xf3, 0x09, 0x8c, 0x12, 0x11, 0x44,
+	0x93, 0xbe, 0xf7, 0x60, 0x81, 0xfc, 0xfe, 0x25, 0x67, 0xcf, 0x74, 0xcc, 0xd7, 0xda, 0x48, 0xda,
+	0xde, 0x39, 0xb9, 0xa9, 0x67, 0xf8, 0x89, 0xd0, 0x17, 0xba, 0x20, 0xfa, 0x10, 0x87, 0xc1, 0xc8,
+	0x4b, 0x1b, 0xf6, 0x80, 0x73, 0xef, 0x00, 0xb8, 0xb3, 0x63, 0xda, 0x17, 0x22, 0x96, 0xbc, 0xad,
+	0x4a, 0x1e, 0x8a, 0xf2, 0x4f, 0x42, 0x5f, 0xe9, 0x82, 0x78, 0xd7, 0x1b, 0x03, 0x8f, 0xbd, 0x11,
+	0x60, 0xb8, 0xbb, 0xa6, 0x5d, 0xad, 0x73, 0x91, 0xdc, 0x7b, 0xe7, 0x63, 0xa6, 0x1e, 0xe0, 0x77,
+	0x42, 0x5f, 0xee, 0x82, 0x68, 0xef, 0xdd, 0xc5, 0xd0, 0x3b, 0xa6, 0xbd, 0xe1, 0x7a, 0x09, 0x7d,
+	0xab, 0xaa, 0x8d, 0xc2, 0xfd, 0x82, 0xd0, 0x27, 0xfa, 0xe0, 0xc5, 0x71, 0x38, 0xeb, 0x4c, 0x61,
+	0x22, 0xb8, 0x73, 0xd5, 0x70, 0x99, 0x2c, 0x69, 0x24, 0xd6, 0x66, 0x19, 0xa9, 0x56, 0x12, 0x9a,
+	0xbe, 0x3f, 0x00, 0x8f, 0x8d, 0x0e, 0x9b, 0x42, 0xb0, 0x60, 0x98, 0x08, 0xe0, 0x86, 0x25, 0x01,
+	0x51, 0xda, 0x95, 0x04, 0xd4, 0x40, 0x5b, 0x3d, 0xd9, 0xd6, 0x90, 0xe3, 0xdb, 0xb1, 0xd8, 0x57,
+	0x8a, 0x10, 0x5b, 0x95, 0x3c, 0xb4, 0x08, 0xd3, 0xa2, 0x52, 0x2e, 0x42, 0x44, 0x69, 0x17, 0x21,
+	0x6a, 0xa0, 0xe0, 0xbe, 0x22, 0xf4, 0x29, 0x59, 0x77, 0x5b, 0x61, 0xc2, 0x05, 0x30, 0x67, 0xcb,
+	0xaa, 0x5a, 0x2f, 0x54, 0x12, 0xea, 0x5a, 0x39, 0xb1, 0x02, 0xfa, 0x9c, 0xd0, 0x0b, 0x69, 0xd5,
+	0x59, 0x7c, 0xc3, 0x9d, 0xb7, 0x8d, 0x0b, 0x95, 0x94, 0x48, 0x94, 0xab, 0x25, 0x94, 0x8a, 0xe3,
+	0x3b, 0x42, 0x9d, 0xa5, 0xaf, 0x7a, 0x30, 0x1e, 0xa6, 0x34, 0x37, 0x6c, 0x3d, 0x17, 0x42, 0xc9,
+	0xb4, 0x5d, 0x5a, 0xaf, 0xc8, 0x7e, 0x23, 0xf4, 0xa5, 0xa6, 0xef, 0xdf, 0x61, 0xf7, 0x62, 0xff,
+	0xf1, 0xf9, 0x6d, 0x1c, 0x09, 0x35, 0x76, 0x6d, 0xd3, 0x65, 0x85, 0xca, 0x25, 0x65, 0xa7, 0xa2,
+	0x8b, 0x36, 0xf7, 0xb3, 0x05, 0xa2, 0x63, 0x6e, 0x5b, 0x2c, 0x2d, 0x94, 0xf0, 0x66, 0x79, 0x03,
+	0x05, 0xf7, 0x25, 0xa1, 0x4f, 0x66, 0xdb, 0xb1, 0x2a, 0x05, 0x9b, 0x16, 0x7b, 0xf8, 0xea, 0xfe,
+	0xbf, 0x55, 0x4a, 0xab, 0x9d, 0xf1, 0xf6, 0x13, 0x76, 0x00, 0xcb, 0x3c, 0x66, 0xab, 0x69, 0x55,
+	0x66, 0x77, 0xc6, 0xcb, 0xab, 0x35, 0xa6, 0x1e, 0x94, 0x62, 0xea, 0x41, 0x15, 0xa6, 0x1e, 0x14,
+	0x32, 0xa5, 0x2f, 0x51, 0x7d, 0xb8, 0xcf, 0x80, 0x1f, 0xca, 0x53, 0x56, 0x76, 0x1e, 0x36, 0x9d,
+	0x12, 0x79, 0xa9, 0xdd, 0x4b, 0x14, 0xee, 0xb0, 0x52, 0x94, 0x38, 0x4c, 0xfc, 0xa5, 0x22, 0x9f,
+	0x11, 0x9a, 0x16, 0x25, 0x4c, 0x6c, 0x5b, 0x94, 0x70, 0x0f, 0x45, 0xf9, 0x2d, 0xa1, 0xcf, 0x74,
+	0x41, 0xa4, 0xff, 0xbe, 0x9b, 0x40, 0x02, 0x19, 0xe0, 0x75, 0xd3, 0x29, 0xac, 0xeb, 0x24, 0xdb,
+	0x8d, 0xb2, 0x72, 0x85, 0xf5, 0x33, 0xa1, 0x2f, 0xb6, 0x21, 0x04, 0x01, 0xb9, 0x13, 0xb4, 0xd3,
+	0x32, 0xac, 0x2c, 0xa8, 0x5a, 0x22, 0xb6, 0xab, 0x99, 0x68, 0xa0, 0x69, 0xc8, 0xf9, 0x93, 0x3e,
+	0x77, 0xcc, 0x87, 0x08, 0x51, 0xdb, 0x81, 0x16, 0x9a, 0x68, 0xd3, 0x71, 0xdf, 0x4b, 0x38, 0x12,
+	0xa8, 0xd9, 0x74, 0xc4, 0xc5, 0x76, 0xd3, 0xb1, 0xc8, 0x43, 0xab, 0x69, 0xf7, 0x26, 0x31, 0xce,
+	0x69, 0x16, 0x45, 0x91, 0xdc, 0xae, 0xa6, 0x15, 0xbb, 0xe4, 0x13, 0x6d, 0x8e, 0x44, 0x30, 0x0d,
+	0xc4, 0xac, 0x54, 0xa2, 0x39, 0x71, 0x89, 0x44, 0x11, 0x0f, 0x2c, 0xd1, 0x3c, 0xa7, 0x55, 0xa2,
+	0x85, 0xa4, 0x9d, 0x8a, 0x2e, 0xb9, 0x2d, 0x53, 0x94, 0x4d, 0x14, 0x17, 0xdb, 0x6f, 0x99, 0x62,
+	0x1d, 0xe5, 0x1f, 0x84, 0xba, 0xd9, 0x69, 0x27, 0xd7, 0xea, 0x4e, 0x9c, 0xad, 0xfc, 0x77, 0xcc,
+	0x12, 0x59, 0x6b, 0x22, 0xa9, 0x77, 0xcf, 0xc5, 0x2b, 0x77, 0x85, 0xd4, 0x4c, 0xfc, 0x40, 0xf4,
+	0x61, 0x14, 0x31, 0xdf, 0xe6, 0x0a, 0x69, 0x59, 0x66, 0x7f, 0x85, 0xa4, 0xab, 0x15, 0xd3, 0x8f,
+	0x84, 0x3e, 0xdf, 0x62, 0xe0, 0x09, 0x50, 0xef, 0xf1, 0xcd, 0x38, 0xd8, 0x85, 0x99, 0x63, 0x56,
+	0x89, 0x51, 0xad, 0xa4, 0xdb, 0xa9, 0x62, 0xa1, 0x9d, 0x36, 0xd2, 0x27, 0x58, 0x69, 0x61, 0x7a,
+	0xda, 0xc0, 0xa4, 0x76, 0xa7, 0x0d, 0xdc, 0x41, 0x8b, 0xb0, 0x1f, 0x89, 0xd2, 0x11, 0xa2, 0x5a,
+	0xbb, 0x08, 0x0b, 0x2c, 0x74, 0x44, 0x98, 0x46, 0x47, 0x65, 0x11, 0x31, 0xad, 0x25, 0x22, 0x6e,
+	0xa1, 0x8d, 0xb2, 0x7e, 0x0b, 0x96, 0x5e, 0x8d, 0x27, 0xa6, 0xa3, 0x8c, 0x49, 0xed, 0x46, 0x19,
+	0x77, 0xd0, 0x5e, 0xa3, 0x06, 0x20, 0xf6, 0x81, 0xf1, 0x80, 0x0b, 0x98, 0x8c, 0xe0, 0x96, 0x97,
+	0x84, 0xa6, 0x17, 0xf3, 0x88, 0xd2, 0xee, 0x35, 0x0a, 0x35, 0x40, 0x6e, 0x61, 0x72, 0x7c, 0x36,
+	0xb7, 0x30, 0x45, 0x88, 0xad, 0x4a, 0x1e, 0x92, 0x72, 0x27, 0x3c, 0x3e, 0x71, 0x6b, 0x0f, 0x4f,
+	0xdc, 0xda, 0xa3, 0x13, 0x97, 0x7c, 0x36, 0x77, 0xc9, 0xaf, 0x73, 0x97, 0xfc, 0x3d, 0x77, 0xc9,
+	0xf1, 0xdc, 0x25, 0xff, 0xcc, 0x5d, 0xf2, 0xef, 0xdc, 0xad, 0x3d, 0x9a, 0xbb, 0xe4, 0xeb, 0x53,
+	0xb7, 0x76, 0x7c, 0xea, 0xd6, 0x1e, 0x9e, 0xba, 0xb5, 0x0f, 0xaf, 0x1c, 0x44, 0x67, 0xdd, 0x07,
+	0xd1, 0x9a, 0x5f, 0xa8, 0xb6, 0x96, 0x3f, 0x0f, 0xff, 0xf7, 0xf8, 0xe7, 0xa9, 0x37, 0xfe, 0x1b,
+	0x00, 0x92, 0x3e, 0x54, 0x9b, 0x34, 0x1b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -182,6 +199,47 @@ type AdminServiceClient interface {
 	GetTaskQueueTasks(ctx context.Context, in *GetTaskQueueTasksRequest, opts ...grpc.CallOption) (*GetTaskQueueTasksResponse, error)
 	// DeleteWorkflowExecution force deletes a workflow's visibility record, current & concrete execution record and history if possible
 	DeleteWorkflowExecution(ctx context.Context, in *DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*DeleteWorkflowExecutionResponse, error)
+	// ResetWorkflowExecutions resets the given workflow executions, each to the event picked by a
+	// reset point selector. The number of executions reset in one call is limited by the
+	// frontend.maxResetWorkflowExecutions dynamic config. With dry_run set nothing is reset, and the
+	// response only reports the reset point and the number of discarded events for each execution.
+	// A dry run can select one page of the executions matching a visibility query instead.
+	ResetWorkflowExecutions(ctx context.Context, in *ResetWorkflowExecutionsRequest, opts ...grpc.CallOption) (*ResetWorkflowExecutionsResponse, error)
+	// PauseWorkflowExecution stops dispatching workflow and activity tasks of a running workflow
+	// execution and holds its timers until it is unpaused.
+	PauseWorkflowExecution(ctx context.Context, in *PauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*PauseWorkflowExecutionResponse, error)
+	// UnpauseWorkflowExecution resumes a paused workflow execution.
+	UnpauseWorkflowExecution(ctx context.Context, in *UnpauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*UnpauseWorkflowExecutionResponse, error)
+	// PauseActivityExecution stops dispatching a pending activity, including its retries, until it is
+	// unpaused. An attempt that is already running is allowed to complete.
+	PauseActivityExecution(ctx context.Context, in *PauseActivityExecutionRequest, opts ...grpc.CallOption) (*PauseActivityExecutionResponse, error)
+	// UnpauseActivityExecution resumes dispatching a paused activity.
+	UnpauseActivityExecution(ctx context.Context, in *UnpauseActivityExecutionRequest, opts ...grpc.CallOption) (*UnpauseActivityExecutionResponse, error)
+	// ResetActivityExecution resets the attempt count of a pending activity. The activity is not
+	// dispatched again: an activity waiting for its next retry is dispatched by its pending retry
+	// timer, and a running attempt keeps running with its retries counting from 1.
+	ResetActivityExecution(ctx context.Context, in *ResetActivityExecutionRequest, opts ...grpc.CallOption) (*ResetActivityExecutionResponse, error)
+	// UpdateActivityExecutionOptions updates the retry policy and timeouts of a pending activity.
+	UpdateActivityExecutionOptions(ctx context.Context, in *UpdateActivityExecutionOptionsRequest, opts ...grpc.CallOption) (*UpdateActivityExecutionOptionsResponse, error)
+	// ListAuditRecords lists the audit records of mutating API calls written by the persistence audit sink.
+	ListAuditRecords(ctx context.Context, in *ListAuditRecordsRequest, opts ...grpc.CallOption) (*ListAuditRecordsResponse, error)
+	// CreateNamespaceApiKey issues an API key granting a role on a namespace. The returned token is
+	// accepted as `Authorization: Bearer tk_...` and is not stored by the server.
+	CreateNamespaceApiKey(ctx context.Context, in *CreateNamespaceApiKeyRequest, opts ...grpc.CallOption) (*CreateNamespaceApiKeyResponse, error)
+	// ListNamespaceApiKeys lists the API keys of a namespace.
+	ListNamespaceApiKeys(ctx context.Context, in *ListNamespaceApiKeysRequest, opts ...grpc.CallOption) (*ListNamespaceApiKeysResponse, error)
+	// RotateNamespaceApiKey replaces the secret of an API key. The previous token stops working.
+	RotateNamespaceApiKey(ctx context.Context, in *RotateNamespaceApiKeyRequest, opts ...grpc.CallOption) (*RotateNamespaceApiKeyResponse, error)
+	// RevokeNamespaceApiKey deletes an API key.
+	RevokeNamespaceApiKey(ctx context.Context, in *RevokeNamespaceApiKeyRequest, opts ...grpc.CallOption) (*RevokeNamespaceApiKeyResponse, error)
+	// GetReplicationStatus reports, per remote cluster and per shard, how far replication from and to
+	// the remote cluster is behind, the replication DLQ sizes and the state of the task processors.
+	GetReplicationStatus(ctx context.Context, in *GetReplicationStatusRequest, opts ...grpc.CallOption) (*GetReplicationStatusResponse, error)
+	// SetPersistenceFault adds or replaces a fault injected into the persistence layer of the cluster.
+	// The fault is picked up by all hosts within the refresh interval and is removed once its TTL expires.
+	SetPersistenceFault(ctx context.Context, in *SetPersistenceFaultRequest, opts ...grpc.CallOption) (*SetPersistenceFaultResponse, error)
+	// RemovePersistenceFault removes a persistence fault before its TTL expires.
+	RemovePersistenceFault(ctx context.Context, in *RemovePersistenceFaultRequest, opts ...grpc.CallOption) (*RemovePersistenceFaultResponse, error)
 }
 
 type adminServiceClient struct {
@@ -435,6 +493,141 @@ func (c *adminServiceClient) DeleteWorkflowExecution(ctx context.Context, in *De
 	return out, nil
 }
 
+func (c *adminServiceClient) ResetWorkflowExecutions(ctx context.Context, in *ResetWorkflowExecutionsRequest, opts ...grpc.CallOption) (*ResetWorkflowExecutionsResponse, error) {
+	out := new(ResetWorkflowExecutionsResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ResetWorkflowExecutions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) PauseWorkflowExecution(ctx context.Context, in *PauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*PauseWorkflowExecutionResponse, error) {
+	out := new(PauseWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/PauseWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UnpauseWorkflowExecution(ctx context.Context, in *UnpauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*UnpauseWorkflowExecutionResponse, error) {
+	out := new(UnpauseWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/UnpauseWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) PauseActivityExecution(ctx context.Context, in *PauseActivityExecutionRequest, opts ...grpc.CallOption) (*PauseActivityExecutionResponse, error) {
+	out := new(PauseActivityExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/PauseActivityExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UnpauseActivityExecution(ctx context.Context, in *UnpauseActivityExecutionRequest, opts ...grpc.CallOption) (*UnpauseActivityExecutionResponse, error) {
+	out := new(UnpauseActivityExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/UnpauseActivityExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ResetActivityExecution(ctx context.Context, in *ResetActivityExecutionRequest, opts ...grpc.CallOption) (*ResetActivityExecutionResponse, error) {
+	out := new(ResetActivityExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ResetActivityExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateActivityExecutionOptions(ctx context.Context, in *UpdateActivityExecutionOptionsRequest, opts ...grpc.CallOption) (*UpdateActivityExecutionOptionsResponse, error) {
+	out := new(UpdateActivityExecutionOptionsResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/UpdateActivityExecutionOptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListAuditRecords(ctx context.Context, in *ListAuditRecordsRequest, opts ...grpc.CallOption) (*ListAuditRecordsResponse, error) {
+	out := new(ListAuditRecordsResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ListAuditRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreateNamespaceApiKey(ctx context.Context, in *CreateNamespaceApiKeyRequest, opts ...grpc.CallOption) (*CreateNamespaceApiKeyResponse, error) {
+	out := new(CreateNamespaceApiKeyResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/CreateNamespaceApiKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListNamespaceApiKeys(ctx context.Context, in *ListNamespaceApiKeysRequest, opts ...grpc.CallOption) (*ListNamespaceApiKeysResponse, error) {
+	out := new(ListNamespaceApiKeysResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ListNamespaceApiKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RotateNamespaceApiKey(ctx context.Context, in *RotateNamespaceApiKeyRequest, opts ...grpc.CallOption) (*RotateNamespaceApiKeyResponse, error) {
+	out := new(RotateNamespaceApiKeyResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/RotateNamespaceApiKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RevokeNamespaceApiKey(ctx context.Context, in *RevokeNamespaceApiKeyRequest, opts ...grpc.CallOption) (*RevokeNamespaceApiKeyResponse, error) {
+	out := new(RevokeNamespaceApiKeyResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/RevokeNamespaceApiKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetReplicationStatus(ctx context.Context, in *GetReplicationStatusRequest, opts ...grpc.CallOption) (*GetReplicationStatusResponse, error) {
+	out := new(GetReplicationStatusResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/GetReplicationStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetPersistenceFault(ctx context.Context, in *SetPersistenceFaultRequest, opts ...grpc.CallOption) (*SetPersistenceFaultResponse, error) {
+	out := new(SetPersistenceFaultResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/SetPersistenceFault", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RemovePersistenceFault(ctx context.Context, in *RemovePersistenceFaultRequest, opts ...grpc.CallOption) (*RemovePersistenceFaultResponse, error) {
+	out := new(RemovePersistenceFaultResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/RemovePersistenceFault", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 type AdminServiceServer interface {
 	// RebuildMutableState attempts to rebuild mutable state according to persisted history events.
@@ -496,6 +689,47 @@ type AdminServiceServer interface {
 	GetTaskQueueTasks(context.Context, *GetTaskQueueTasksRequest) (*GetTaskQueueTasksResponse, error)
 	// DeleteWorkflowExecution force deletes a workflow's visibility record, current & concrete execution record and history if possible
 	DeleteWorkflowExecution(context.Context, *DeleteWorkflowExecutionRequest) (*DeleteWorkflowExecutionResponse, error)
+	// ResetWorkflowExecutions resets the given workflow executions, each to the event picked by a
+	// reset point selector. The number of executions reset in one call is limited by the
+	// frontend.maxResetWorkflowExecutions dynamic config. With dry_run set nothing is reset, and the
+	// response only reports the reset point and the number of discarded events for each execution.
+	// A dry run can select one page of the executions matching a visibility query instead.
+	ResetWorkflowExecutions(context.Context, *ResetWorkflowExecutionsRequest) (*ResetWorkflowExecutionsResponse, error)
+	// PauseWorkflowExecution stops dispatching workflow and activity tasks of a running workflow
+	// execution and holds its timers until it is unpaused.
+	PauseWorkflowExecution(context.Context, *PauseWorkflowExecutionRequest) (*PauseWorkflowExecutionResponse, error)
+	// UnpauseWorkflowExecution resumes a paused workflow execution.
+	UnpauseWorkflowExecution(context.Context, *UnpauseWorkflowExecutionRequest) (*UnpauseWorkflowExecutionResponse, error)
+	// PauseActivityExecution stops dispatching a pending activity, including its retries, until it is
+	// unpaused. An attempt that is already running is allowed to complete.
+	PauseActivityExecution(context.Context, *PauseActivityExecutionRequest) (*PauseActivityExecutionResponse, error)
+	// UnpauseActivityExecution resumes dispatching a paused activity.
+	UnpauseActivityExecution(context.Context, *UnpauseActivityExecutionRequest) (*UnpauseActivityExecutionResponse, error)
+	// ResetActivityExecution resets the attempt count of a pending activity. The activity is not
+	// dispatched again: an activity waiting for its next retry is dispatched by its pending retry
+	// timer, and a running attempt keeps running with its retries counting from 1.
+	ResetActivityExecution(context.Context, *ResetActivityExecutionRequest) (*ResetActivityExecutionResponse, error)
+	// UpdateActivityExecutionOptions updates the retry policy and timeouts of a pending activity.
+	UpdateActivityExecutionOptions(context.Context, *UpdateActivityExecutionOptionsRequest) (*UpdateActivityExecutionOptionsResponse, error)
+	// ListAuditRecords lists the audit records of mutating API calls written by the persistence audit sink.
+	ListAuditRecords(context.Context, *ListAuditRecordsRequest) (*ListAuditRecordsResponse, error)
+	// CreateNamespaceApiKey issues an API key granting a role on a namespace. The returned token is
+	// accepted as `Authorization: Bearer tk_...` and is not stored by the server.
+	CreateNamespaceApiKey(context.Context, *CreateNamespaceApiKeyRequest) (*CreateNamespaceApiKeyResponse, error)
+	// ListNamespaceApiKeys lists the API keys of a namespace.
+	ListNamespaceApiKeys(context.Context, *ListNamespaceApiKeysRequest) (*ListNamespaceApiKeysResponse, error)
+	// RotateNamespaceApiKey replaces the secret of an API key. The previous token stops working.
+	RotateNamespaceApiKey(context.Context, *RotateNamespaceApiKeyRequest) (*RotateNamespaceApiKeyResponse, error)
+	// RevokeNamespaceApiKey deletes an API key.
+	RevokeNamespaceApiKey(context.Context, *RevokeNamespaceApiKeyRequest) (*RevokeNamespaceApiKeyResponse, error)
+	// GetReplicationStatus reports, per remote cluster and per shard, how far replication from and to
+	// the remote cluster is behind, the replication DLQ sizes and the state of the task processors.
+	GetReplicationStatus(context.Context, *GetReplicationStatusRequest) (*GetReplicationStatusResponse, error)
+	// SetPersistenceFault adds or replaces a fault injected into the persistence layer of the cluster.
+	// The fault is picked up by all hosts within the refresh interval and is removed once its TTL expires.
+	SetPersistenceFault(context.Context, *SetPersistenceFaultRequest) (*SetPersistenceFaultResponse, error)
+	// RemovePersistenceFault removes a persistence fault before its TTL expires.
+	RemovePersistenceFault(context.Context, *RemovePersistenceFaultRequest) (*RemovePersistenceFaultResponse, error)
 }
 
 // UnimplementedAdminServiceServer can be embedded to have forward compatible implementations.
@@ -583,6 +817,51 @@ func (*UnimplementedAdminServiceServer) GetTaskQueueTasks(ctx context.Context, r
 func (*UnimplementedAdminServiceServer) DeleteWorkflowExecution(ctx context.Context, req *DeleteWorkflowExecutionRequest) (*DeleteWorkflowExecutionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkflowExecution not implemented")
 }
+func (*UnimplementedAdminServiceServer) ResetWorkflowExecutions(ctx context.Context, req *ResetWorkflowExecutionsRequest) (*ResetWorkflowExecutionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetWorkflowExecutions not implemented")
+}
+func (*UnimplementedAdminServiceServer) PauseWorkflowExecution(ctx context.Context, req *PauseWorkflowExecutionRequest) (*PauseWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseWorkflowExecution not implemented")
+}
+func (*UnimplementedAdminServiceServer) UnpauseWorkflowExecution(ctx context.Context, req *UnpauseWorkflowExecutionRequest) (*UnpauseWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseWorkflowExecution not implemented")
+}
+func (*UnimplementedAdminServiceServer) PauseActivityExecution(ctx context.Context, req *PauseActivityExecutionRequest) (*PauseActivityExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseActivityExecution not implemented")
+}
+func (*UnimplementedAdminServiceServer) UnpauseActivityExecution(ctx context.Context, req *UnpauseActivityExecutionRequest) (*UnpauseActivityExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseActivityExecution not implemented")
+}
+func (*UnimplementedAdminServiceServer) ResetActivityExecution(ctx context.Context, req *ResetActivityExecutionRequest) (*ResetActivityExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetActivityExecution not implemented")
+}
+func (*UnimplementedAdminServiceServer) UpdateActivityExecutionOptions(ctx context.Context, req *UpdateActivityExecutionOptionsRequest) (*UpdateActivityExecutionOptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateActivityExecutionOptions not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListAuditRecords(ctx context.Context, req *ListAuditRecordsRequest) (*ListAuditRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditRecords not implemented")
+}
+func (*UnimplementedAdminServiceServer) CreateNamespaceApiKey(ctx context.Context, req *CreateNamespaceApiKeyRequest) (*CreateNamespaceApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNamespaceApiKey not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListNamespaceApiKeys(ctx context.Context, req *ListNamespaceApiKeysRequest) (*ListNamespaceApiKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNamespaceApiKeys not implemented")
+}
+func (*UnimplementedAdminServiceServer) RotateNamespaceApiKey(ctx context.Context, req *RotateNamespaceApiKeyRequest) (*RotateNamespaceApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateNamespaceApiKey not implemented")
+}
+func (*UnimplementedAdminServiceServer) RevokeNamespaceApiKey(ctx context.Context, req *RevokeNamespaceApiKeyRequest) (*RevokeNamespaceApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeNamespaceApiKey not implemented")
+}
+func (*UnimplementedAdminServiceServer) GetReplicationStatus(ctx context.Context, req *GetReplicationStatusRequest) (*GetReplicationStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReplicationStatus not implemented")
+}
+func (*UnimplementedAdminServiceServer) SetPersistenceFault(ctx context.Context, req *SetPersistenceFaultRequest) (*SetPersistenceFaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPersistenceFault not implemented")
+}
+func (*UnimplementedAdminServiceServer) RemovePersistenceFault(ctx context.Context, req *RemovePersistenceFaultRequest) (*RemovePersistenceFaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePersistenceFault not implemented")
+}
 
 func RegisterAdminServiceServer(s *grpc.Server, srv AdminServiceServer) {
 	s.RegisterService(&_AdminService_serviceDesc, srv)
@@ -1074,6 +1353,276 @@ func _AdminService_DeleteWorkflowExecution_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_ResetWorkflowExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetWorkflowExecutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ResetWorkflowExecutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ResetWorkflowExecutions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ResetWorkflowExecutions(ctx, req.(*ResetWorkflowExecutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_PauseWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PauseWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/PauseWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PauseWorkflowExecution(ctx, req.(*PauseWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UnpauseWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpauseWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UnpauseWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/UnpauseWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UnpauseWorkflowExecution(ctx, req.(*UnpauseWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_PauseActivityExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseActivityExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PauseActivityExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/PauseActivityExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PauseActivityExecution(ctx, req.(*PauseActivityExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UnpauseActivityExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpauseActivityExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UnpauseActivityExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/UnpauseActivityExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UnpauseActivityExecution(ctx, req.(*UnpauseActivityExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ResetActivityExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetActivityExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ResetActivityExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ResetActivityExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ResetActivityExecution(ctx, req.(*ResetActivityExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateActivityExecutionOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateActivityExecutionOptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateActivityExecutionOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/UpdateActivityExecutionOptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateActivityExecutionOptions(ctx, req.(*UpdateActivityExecutionOptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListAuditRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListAuditRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ListAuditRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListAuditRecords(ctx, req.(*ListAuditRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreateNamespaceApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNamespaceApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateNamespaceApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/CreateNamespaceApiKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateNamespaceApiKey(ctx, req.(*CreateNamespaceApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListNamespaceApiKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNamespaceApiKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListNamespaceApiKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ListNamespaceApiKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListNamespaceApiKeys(ctx, req.(*ListNamespaceApiKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RotateNamespaceApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateNamespaceApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RotateNamespaceApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/RotateNamespaceApiKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RotateNamespaceApiKey(ctx, req.(*RotateNamespaceApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RevokeNamespaceApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeNamespaceApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RevokeNamespaceApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/RevokeNamespaceApiKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RevokeNamespaceApiKey(ctx, req.(*RevokeNamespaceApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetReplicationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReplicationStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetReplicationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetReplicationStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetReplicationStatus(ctx, req.(*GetReplicationStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetPersistenceFault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPersistenceFaultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetPersistenceFault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/SetPersistenceFault",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetPersistenceFault(ctx, req.(*SetPersistenceFaultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RemovePersistenceFault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePersistenceFaultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RemovePersistenceFault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/RemovePersistenceFault",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RemovePersistenceFault(ctx, req.(*RemovePersistenceFaultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AdminService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "temporal.server.api.adminservice.v1.AdminService",
 	HandlerType: (*AdminServiceServer)(nil),
@@ -1186,6 +1735,66 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteWorkflowExecution",
 			Handler:    _AdminService_DeleteWorkflowExecution_Handler,
 		},
+		{
+			MethodName: "ResetWorkflowExecutions",
+			Handler:    _AdminService_ResetWorkflowExecutions_Handler,
+		},
+		{
+			MethodName: "PauseWorkflowExecution",
+			Handler:    _AdminService_PauseWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "UnpauseWorkflowExecution",
+			Handler:    _AdminService_UnpauseWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "PauseActivityExecution",
+			Handler:    _AdminService_PauseActivityExecution_Handler,
+		},
+		{
+			MethodName: "UnpauseActivityExecution",
+			Handler:    _AdminService_UnpauseActivityExecution_Handler,
+		},
+		{
+			MethodName: "ResetActivityExecution",
+			Handler:    _AdminService_ResetActivityExecution_Handler,
+		},
+		{
+			MethodName: "UpdateActivityExecutionOptions",
+			Handler:    _AdminService_UpdateActivityExecutionOptions_Handler,
+		},
+		{
+			MethodName: "ListAuditRecords",
+			Handler:    _AdminService_ListAuditRecords_Handler,
+		},
+		{
+			MethodName: "CreateNamespaceApiKey",
+			Handler:    _AdminService_CreateNamespaceApiKey_Handler,
+		},
+		{
+			MethodName: "ListNamespaceApiKeys",
+			Handler:    _AdminService_ListNamespaceApiKeys_Handler,
+		},
+		{
+			MethodName: "RotateNamespaceApiKey",
+			Handler:    _AdminService_RotateNamespaceApiKey_Handler,
+		},
+		{
+			MethodName: "RevokeNamespaceApiKey",
+			Handler:    _AdminService_RevokeNamespaceApiKey_Handler,
+		},
+		{
+			MethodName: "GetReplicationStatus",
+			Handler:    _AdminService_GetReplicationStatus_Handler,
+		},
+		{
+			MethodName: "SetPersistenceFault",
+			Handler:    _AdminService_SetPersistenceFault_Handler,
+		},
+		{
+			MethodName: "RemovePersistenceFault",
+			Handler:    _AdminService_RemovePersistenceFault_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "temporal/server/api/adminservice/v1/service.proto",
@@ -120,6 +120,26 @@ func (mr *MockAdminServiceClientMockRecorder) CloseShard(ctx, in interface{}, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseShard", reflect.TypeOf((*MockAdminServiceClient)(nil).CloseShard), varargs...)
 }
 
+// CreateNamespaceApiKey mocks base method.
+func (m *MockAdminServiceClient) CreateNamespaceApiKey(ctx context.Context, in *adminservice.CreateNamespaceApiKeyRequest, opts ...grpc.CallOption) (*adminservice.CreateNamespaceApiKeyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateNamespaceApiKey", varargs...)
+	ret0, _ := ret[0].(*adminservice.CreateNamespaceApiKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNamespaceApiKey indicates an expected call of CreateNamespaceApiKey.
+func (mr *MockAdminServiceClientMockRecorder) CreateNamespaceApiKey(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNamespaceApiKey", reflect.TypeOf((*MockAdminServiceClient)(nil).CreateNamespaceApiKey), varargs...)
+}
+
 // DeleteWorkflowExecution mocks base method.
 func (m *MockAdminServiceClient) DeleteWorkflowExecution(ctx context.Context, in *adminservice.DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.DeleteWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -280,6 +300,26 @@ func (mr *MockAdminServiceClientMockRecorder) GetReplicationMessages(ctx, in int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).GetReplicationMessages), varargs...)
 }
 
+// GetReplicationStatus mocks base method.
+func (m *MockAdminServiceClient) GetReplicationStatus(ctx context.Context, in *adminservice.GetReplicationStatusRequest, opts ...grpc.CallOption) (*adminservice.GetReplicationStatusResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetReplicationStatus", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetReplicationStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationStatus indicates an expected call of GetReplicationStatus.
+func (mr *MockAdminServiceClientMockRecorder) GetReplicationStatus(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationStatus", reflect.TypeOf((*MockAdminServiceClient)(nil).GetReplicationStatus), varargs...)
+}
+
 // GetSearchAttributes mocks base method.
 func (m *MockAdminServiceClient) GetSearchAttributes(ctx context.Context, in *adminservice.GetSearchAttributesRequest, opts ...grpc.CallOption) (*adminservice.GetSearchAttributesResponse, error) {
 	m.ctrl.T.Helper()
@@ -360,6 +400,26 @@ func (mr *MockAdminServiceClientMockRecorder) GetWorkflowExecutionRawHistoryV2(c
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionRawHistoryV2", reflect.TypeOf((*MockAdminServiceClient)(nil).GetWorkflowExecutionRawHistoryV2), varargs...)
 }
 
+// ListAuditRecords mocks base method.
+func (m *MockAdminServiceClient) ListAuditRecords(ctx context.Context, in *adminservice.ListAuditRecordsRequest, opts ...grpc.CallOption) (*adminservice.ListAuditRecordsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAuditRecords", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListAuditRecordsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditRecords indicates an expected call of ListAuditRecords.
+func (mr *MockAdminServiceClientMockRecorder) ListAuditRecords(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditRecords", reflect.TypeOf((*MockAdminServiceClient)(nil).ListAuditRecords), varargs...)
+}
+
 // ListClusterMembers mocks base method.
 func (m *MockAdminServiceClient) ListClusterMembers(ctx context.Context, in *adminservice.ListClusterMembersRequest, opts ...grpc.CallOption) (*adminservice.ListClusterMembersResponse, error) {
 	m.ctrl.T.Helper()
@@ -420,6 +480,26 @@ func (mr *MockAdminServiceClientMockRecorder) ListHistoryTasks(ctx, in interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHistoryTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ListHistoryTasks), varargs...)
 }
 
+// ListNamespaceApiKeys mocks base method.
+func (m *MockAdminServiceClient) ListNamespaceApiKeys(ctx context.Context, in *adminservice.ListNamespaceApiKeysRequest, opts ...grpc.CallOption) (*adminservice.ListNamespaceApiKeysResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNamespaceApiKeys", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListNamespaceApiKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNamespaceApiKeys indicates an expected call of ListNamespaceApiKeys.
+func (mr *MockAdminServiceClientMockRecorder) ListNamespaceApiKeys(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNamespaceApiKeys", reflect.TypeOf((*MockAdminServiceClient)(nil).ListNamespaceApiKeys), varargs...)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockAdminServiceClient) MergeDLQMessages(ctx context.Context, in *adminservice.MergeDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -440,6 +520,46 @@ func (mr *MockAdminServiceClientMockRecorder) MergeDLQMessages(ctx, in interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).MergeDLQMessages), varargs...)
 }
 
+// PauseActivityExecution mocks base method.
+func (m *MockAdminServiceClient) PauseActivityExecution(ctx context.Context, in *adminservice.PauseActivityExecutionRequest, opts ...grpc.CallOption) (*adminservice.PauseActivityExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PauseActivityExecution", varargs...)
+	ret0, _ := ret[0].(*adminservice.PauseActivityExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseActivityExecution indicates an expected call of PauseActivityExecution.
+func (mr *MockAdminServiceClientMockRecorder) PauseActivityExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseActivityExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).PauseActivityExecution), varargs...)
+}
+
+// PauseWorkflowExecution mocks base method.
+func (m *MockAdminServiceClient) PauseWorkflowExecution(ctx context.Context, in *adminservice.PauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.PauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*adminservice.PauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseWorkflowExecution indicates an expected call of PauseWorkflowExecution.
+func (mr *MockAdminServiceClientMockRecorder) PauseWorkflowExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).PauseWorkflowExecution), varargs...)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockAdminServiceClient) PurgeDLQMessages(ctx context.Context, in *adminservice.PurgeDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.PurgeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -520,6 +640,26 @@ func (mr *MockAdminServiceClientMockRecorder) RefreshWorkflowTasks(ctx, in inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshWorkflowTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).RefreshWorkflowTasks), varargs...)
 }
 
+// RemovePersistenceFault mocks base method.
+func (m *MockAdminServiceClient) RemovePersistenceFault(ctx context.Context, in *adminservice.RemovePersistenceFaultRequest, opts ...grpc.CallOption) (*adminservice.RemovePersistenceFaultResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemovePersistenceFault", varargs...)
+	ret0, _ := ret[0].(*adminservice.RemovePersistenceFaultResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemovePersistenceFault indicates an expected call of RemovePersistenceFault.
+func (mr *MockAdminServiceClientMockRecorder) RemovePersistenceFault(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePersistenceFault", reflect.TypeOf((*MockAdminServiceClient)(nil).RemovePersistenceFault), varargs...)
+}
+
 // RemoveRemoteCluster mocks base method.
 func (m *MockAdminServiceClient) RemoveRemoteCluster(ctx context.Context, in *adminservice.RemoveRemoteClusterRequest, opts ...grpc.CallOption) (*adminservice.RemoveRemoteClusterResponse, error) {
 	m.ctrl.T.Helper()
@@ -600,6 +740,166 @@ func (mr *MockAdminServiceClientMockRecorder) ResendReplicationTasks(ctx, in int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ResendReplicationTasks), varargs...)
 }
 
+// ResetActivityExecution mocks base method.
+func (m *MockAdminServiceClient) ResetActivityExecution(ctx context.Context, in *adminservice.ResetActivityExecutionRequest, opts ...grpc.CallOption) (*adminservice.ResetActivityExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResetActivityExecution", varargs...)
+	ret0, _ := ret[0].(*adminservice.ResetActivityExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetActivityExecution indicates an expected call of ResetActivityExecution.
+func (mr *MockAdminServiceClientMockRecorder) ResetActivityExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetActivityExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).ResetActivityExecution), varargs...)
+}
+
+// ResetWorkflowExecutions mocks base method.
+func (m *MockAdminServiceClient) ResetWorkflowExecutions(ctx context.Context, in *adminservice.ResetWorkflowExecutionsRequest, opts ...grpc.CallOption) (*adminservice.ResetWorkflowExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResetWorkflowExecutions", varargs...)
+	ret0, _ := ret[0].(*adminservice.ResetWorkflowExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetWorkflowExecutions indicates an expected call of ResetWorkflowExecutions.
+func (mr *MockAdminServiceClientMockRecorder) ResetWorkflowExecutions(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetWorkflowExecutions", reflect.TypeOf((*MockAdminServiceClient)(nil).ResetWorkflowExecutions), varargs...)
+}
+
+// RevokeNamespaceApiKey mocks base method.
+func (m *MockAdminServiceClient) RevokeNamespaceApiKey(ctx context.Context, in *adminservice.RevokeNamespaceApiKeyRequest, opts ...grpc.CallOption) (*adminservice.RevokeNamespaceApiKeyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevokeNamespaceApiKey", varargs...)
+	ret0, _ := ret[0].(*adminservice.RevokeNamespaceApiKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeNamespaceApiKey indicates an expected call of RevokeNamespaceApiKey.
+func (mr *MockAdminServiceClientMockRecorder) RevokeNamespaceApiKey(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeNamespaceApiKey", reflect.TypeOf((*MockAdminServiceClient)(nil).RevokeNamespaceApiKey), varargs...)
+}
+
+// RotateNamespaceApiKey mocks base method.
+func (m *MockAdminServiceClient) RotateNamespaceApiKey(ctx context.Context, in *adminservice.RotateNamespaceApiKeyRequest, opts ...grpc.CallOption) (*adminservice.RotateNamespaceApiKeyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RotateNamespaceApiKey", varargs...)
+	ret0, _ := ret[0].(*adminservice.RotateNamespaceApiKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateNamespaceApiKey indicates an expected call of RotateNamespaceApiKey.
+func (mr *MockAdminServiceClientMockRecorder) RotateNamespaceApiKey(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateNamespaceApiKey", reflect.TypeOf((*MockAdminServiceClient)(nil).RotateNamespaceApiKey), varargs...)
+}
+
+// SetPersistenceFault mocks base method.
+func (m *MockAdminServiceClient) SetPersistenceFault(ctx context.Context, in *adminservice.SetPersistenceFaultRequest, opts ...grpc.CallOption) (*adminservice.SetPersistenceFaultResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetPersistenceFault", varargs...)
+	ret0, _ := ret[0].(*adminservice.SetPersistenceFaultResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetPersistenceFault indicates an expected call of SetPersistenceFault.
+func (mr *MockAdminServiceClientMockRecorder) SetPersistenceFault(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPersistenceFault", reflect.TypeOf((*MockAdminServiceClient)(nil).SetPersistenceFault), varargs...)
+}
+
+// UnpauseActivityExecution mocks base method.
+func (m *MockAdminServiceClient) UnpauseActivityExecution(ctx context.Context, in *adminservice.UnpauseActivityExecutionRequest, opts ...grpc.CallOption) (*adminservice.UnpauseActivityExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnpauseActivityExecution", varargs...)
+	ret0, _ := ret[0].(*adminservice.UnpauseActivityExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseActivityExecution indicates an expected call of UnpauseActivityExecution.
+func (mr *MockAdminServiceClientMockRecorder) UnpauseActivityExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseActivityExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).UnpauseActivityExecution), varargs...)
+}
+
+// UnpauseWorkflowExecution mocks base method.
+func (m *MockAdminServiceClient) UnpauseWorkflowExecution(ctx context.Context, in *adminservice.UnpauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.UnpauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnpauseWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*adminservice.UnpauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseWorkflowExecution indicates an expected call of UnpauseWorkflowExecution.
+func (mr *MockAdminServiceClientMockRecorder) UnpauseWorkflowExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).UnpauseWorkflowExecution), varargs...)
+}
+
+// UpdateActivityExecutionOptions mocks base method.
+func (m *MockAdminServiceClient) UpdateActivityExecutionOptions(ctx context.Context, in *adminservice.UpdateActivityExecutionOptionsRequest, opts ...grpc.CallOption) (*adminservice.UpdateActivityExecutionOptionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateActivityExecutionOptions", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateActivityExecutionOptionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateActivityExecutionOptions indicates an expected call of UpdateActivityExecutionOptions.
+func (mr *MockAdminServiceClientMockRecorder) UpdateActivityExecutionOptions(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivityExecutionOptions", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateActivityExecutionOptions), varargs...)
+}
+
 // MockAdminServiceServer is a mock of AdminServiceServer interface.
 type MockAdminServiceServer struct {
 	ctrl     *gomock.Controller
@@ -668,6 +968,21 @@ func (mr *MockAdminServiceServerMockRecorder) CloseShard(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseShard", reflect.TypeOf((*MockAdminServiceServer)(nil).CloseShard), arg0, arg1)
 }
 
+// CreateNamespaceApiKey mocks base method.
+func (m *MockAdminServiceServer) CreateNamespaceApiKey(arg0 context.Context, arg1 *adminservice.CreateNamespaceApiKeyRequest) (*adminservice.CreateNamespaceApiKeyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNamespaceApiKey", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.CreateNamespaceApiKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNamespaceApiKey indicates an expected call of CreateNamespaceApiKey.
+func (mr *MockAdminServiceServerMockRecorder) CreateNamespaceApiKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNamespaceApiKey", reflect.TypeOf((*MockAdminServiceServer)(nil).CreateNamespaceApiKey), arg0, arg1)
+}
+
 // DeleteWorkflowExecution mocks base method.
 func (m *MockAdminServiceServer) DeleteWorkflowExecution(arg0 context.Context, arg1 *adminservice.DeleteWorkflowExecutionRequest) (*adminservice.DeleteWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -788,6 +1103,21 @@ func (mr *MockAdminServiceServerMockRecorder) GetReplicationMessages(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).GetReplicationMessages), arg0, arg1)
 }
 
+// GetReplicationStatus mocks base method.
+func (m *MockAdminServiceServer) GetReplicationStatus(arg0 context.Context, arg1 *adminservice.GetReplicationStatusRequest) (*adminservice.GetReplicationStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplicationStatus", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetReplicationStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationStatus indicates an expected call of GetReplicationStatus.
+func (mr *MockAdminServiceServerMockRecorder) GetReplicationStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationStatus", reflect.TypeOf((*MockAdminServiceServer)(nil).GetReplicationStatus), arg0, arg1)
+}
+
 // GetSearchAttributes mocks base method.
 func (m *MockAdminServiceServer) GetSearchAttributes(arg0 context.Context, arg1 *adminservice.GetSearchAttributesRequest) (*adminservice.GetSearchAttributesResponse, error) {
 	m.ctrl.T.Helper()
@@ -848,6 +1178,21 @@ func (mr *MockAdminServiceServerMockRecorder) GetWorkflowExecutionRawHistoryV2(a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionRawHistoryV2", reflect.TypeOf((*MockAdminServiceServer)(nil).GetWorkflowExecutionRawHistoryV2), arg0, arg1)
 }
 
+// ListAuditRecords mocks base method.
+func (m *MockAdminServiceServer) ListAuditRecords(arg0 context.Context, arg1 *adminservice.ListAuditRecordsRequest) (*adminservice.ListAuditRecordsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuditRecords", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListAuditRecordsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditRecords indicates an expected call of ListAuditRecords.
+func (mr *MockAdminServiceServerMockRecorder) ListAuditRecords(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditRecords", reflect.TypeOf((*MockAdminServiceServer)(nil).ListAuditRecords), arg0, arg1)
+}
+
 // ListClusterMembers mocks base method.
 func (m *MockAdminServiceServer) ListClusterMembers(arg0 context.Context, arg1 *adminservice.ListClusterMembersRequest) (*adminservice.ListClusterMembersResponse, error) {
 	m.ctrl.T.Helper()
@@ -893,6 +1238,21 @@ func (mr *MockAdminServiceServerMockRecorder) ListHistoryTasks(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHistoryTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ListHistoryTasks), arg0, arg1)
 }
 
+// ListNamespaceApiKeys mocks base method.
+func (m *MockAdminServiceServer) ListNamespaceApiKeys(arg0 context.Context, arg1 *adminservice.ListNamespaceApiKeysRequest) (*adminservice.ListNamespaceApiKeysResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNamespaceApiKeys", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListNamespaceApiKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNamespaceApiKeys indicates an expected call of ListNamespaceApiKeys.
+func (mr *MockAdminServiceServerMockRecorder) ListNamespaceApiKeys(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNamespaceApiKeys", reflect.TypeOf((*MockAdminServiceServer)(nil).ListNamespaceApiKeys), arg0, arg1)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockAdminServiceServer) MergeDLQMessages(arg0 context.Context, arg1 *adminservice.MergeDLQMessagesRequest) (*adminservice.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -908,6 +1268,36 @@ func (mr *MockAdminServiceServerMockRecorder) MergeDLQMessages(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).MergeDLQMessages), arg0, arg1)
 }
 
+// PauseActivityExecution mocks base method.
+func (m *MockAdminServiceServer) PauseActivityExecution(arg0 context.Context, arg1 *adminservice.PauseActivityExecutionRequest) (*adminservice.PauseActivityExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseActivityExecution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.PauseActivityExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseActivityExecution indicates an expected call of PauseActivityExecution.
+func (mr *MockAdminServiceServerMockRecorder) PauseActivityExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseActivityExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).PauseActivityExecution), arg0, arg1)
+}
+
+// PauseWorkflowExecution mocks base method.
+func (m *MockAdminServiceServer) PauseWorkflowExecution(arg0 context.Context, arg1 *adminservice.PauseWorkflowExecutionRequest) (*adminservice.PauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.PauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseWorkflowExecution indicates an expected call of PauseWorkflowExecution.
+func (mr *MockAdminServiceServerMockRecorder) PauseWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).PauseWorkflowExecution), arg0, arg1)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockAdminServiceServer) PurgeDLQMessages(arg0 context.Context, arg1 *adminservice.PurgeDLQMessagesRequest) (*adminservice.PurgeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -968,6 +1358,21 @@ func (mr *MockAdminServiceServerMockRecorder) RefreshWorkflowTasks(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshWorkflowTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).RefreshWorkflowTasks), arg0, arg1)
 }
 
+// RemovePersistenceFault mocks base method.
+func (m *MockAdminServiceServer) RemovePersistenceFault(arg0 context.Context, arg1 *adminservice.RemovePersistenceFaultRequest) (*adminservice.RemovePersistenceFaultResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePersistenceFault", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.RemovePersistenceFaultResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemovePersistenceFault indicates an expected call of RemovePersistenceFault.
+func (mr *MockAdminServiceServerMockRecorder) RemovePersistenceFault(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePersistenceFault", reflect.TypeOf((*MockAdminServiceServer)(nil).RemovePersistenceFault), arg0, arg1)
+}
+
 // RemoveRemoteCluster mocks base method.
 func (m *MockAdminServiceServer) RemoveRemoteCluster(arg0 context.Context, arg1 *adminservice.RemoveRemoteClusterRequest) (*adminservice.RemoveRemoteClusterResponse, error) {
 	m.ctrl.T.Helper()
@@ -1027,3 +1432,123 @@ func (mr *MockAdminServiceServerMockRecorder) ResendReplicationTasks(arg0, arg1
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ResendReplicationTasks), arg0, arg1)
 }
+
+// ResetActivityExecution mocks base method.
+func (m *MockAdminServiceServer) ResetActivityExecution(arg0 context.Context, arg1 *adminservice.ResetActivityExecutionRequest) (*adminservice.ResetActivityExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetActivityExecution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ResetActivityExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetActivityExecution indicates an expected call of ResetActivityExecution.
+func (mr *MockAdminServiceServerMockRecorder) ResetActivityExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetActivityExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).ResetActivityExecution), arg0, arg1)
+}
+
+// ResetWorkflowExecutions mocks base method.
+func (m *MockAdminServiceServer) ResetWorkflowExecutions(arg0 context.Context, arg1 *adminservice.ResetWorkflowExecutionsRequest) (*adminservice.ResetWorkflowExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetWorkflowExecutions", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ResetWorkflowExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetWorkflowExecutions indicates an expected call of ResetWorkflowExecutions.
+func (mr *MockAdminServiceServerMockRecorder) ResetWorkflowExecutions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetWorkflowExecutions", reflect.TypeOf((*MockAdminServiceServer)(nil).ResetWorkflowExecutions), arg0, arg1)
+}
+
+// RevokeNamespaceApiKey mocks base method.
+func (m *MockAdminServiceServer) RevokeNamespaceApiKey(arg0 context.Context, arg1 *adminservice.RevokeNamespaceApiKeyRequest) (*adminservice.RevokeNamespaceApiKeyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeNamespaceApiKey", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.RevokeNamespaceApiKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeNamespaceApiKey indicates an expected call of RevokeNamespaceApiKey.
+func (mr *MockAdminServiceServerMockRecorder) RevokeNamespaceApiKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeNamespaceApiKey", reflect.TypeOf((*MockAdminServiceServer)(nil).RevokeNamespaceApiKey), arg0, arg1)
+}
+
+// RotateNamespaceApiKey mocks base method.
+func (m *MockAdminServiceServer) RotateNamespaceApiKey(arg0 context.Context, arg1 *adminservice.RotateNamespaceApiKeyRequest) (*adminservice.RotateNamespaceApiKeyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateNamespaceApiKey", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.RotateNamespaceApiKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateNamespaceApiKey indicates an expected call of RotateNamespaceApiKey.
+func (mr *MockAdminServiceServerMockRecorder) RotateNamespaceApiKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateNamespaceApiKey", reflect.TypeOf((*MockAdminServiceServer)(nil).RotateNamespaceApiKey), arg0, arg1)
+}
+
+// SetPersistenceFault mocks base method.
+func (m *MockAdminServiceServer) SetPersistenceFault(arg0 context.Context, arg1 *adminservice.SetPersistenceFaultRequest) (*adminservice.SetPersistenceFaultResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPersistenceFault", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.SetPersistenceFaultResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetPersistenceFault indicates an expected call of SetPersistenceFault.
+func (mr *MockAdminServiceServerMockRecorder) SetPersistenceFault(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPersistenceFault", reflect.TypeOf((*MockAdminServiceServer)(nil).SetPersistenceFault), arg0, arg1)
+}
+
+// UnpauseActivityExecution mocks base method.
+func (m *MockAdminServiceServer) UnpauseActivityExecution(arg0 context.Context, arg1 *adminservice.UnpauseActivityExecutionRequest) (*adminservice.UnpauseActivityExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseActivityExecution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UnpauseActivityExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseActivityExecution indicates an expected call of UnpauseActivityExecution.
+func (mr *MockAdminServiceServerMockRecorder) UnpauseActivityExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseActivityExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).UnpauseActivityExecution), arg0, arg1)
+}
+
+// UnpauseWorkflowExecution mocks base method.
+func (m *MockAdminServiceServer) UnpauseWorkflowExecution(arg0 context.Context, arg1 *adminservice.UnpauseWorkflowExecutionRequest) (*adminservice.UnpauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UnpauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseWorkflowExecution indicates an expected call of UnpauseWorkflowExecution.
+func (mr *MockAdminServiceServerMockRecorder) UnpauseWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).UnpauseWorkflowExecution), arg0, arg1)
+}
+
+// UpdateActivityExecutionOptions mocks base method.
+func (m *MockAdminServiceServer) UpdateActivityExecutionOptions(arg0 context.Context, arg1 *adminservice.UpdateActivityExecutionOptionsRequest) (*adminservice.UpdateActivityExecutionOptionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateActivityExecutionOptions", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateActivityExecutionOptionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateActivityExecutionOptions indicates an expected call of UpdateActivityExecutionOptions.
+func (mr *MockAdminServiceServerMockRecorder) UpdateActivityExecutionOptions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivityExecutionOptions", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateActivityExecutionOptions), arg0, arg1)
+}
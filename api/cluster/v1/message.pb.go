@@ -307,11 +307,99 @@ func (m *ClusterMember) GetRecordExpiryTime() *time.Time {
 	return nil
 }
 
+// TLS certificate served or presented by a host
+type CertificateInfo struct {
+	// Config group of the certificate: internode, frontend, system-worker, frontend-host/<server name>
+	// or remote-cluster/<host name>
+	Group string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	// Hex encoded MD5 thumbprint
+	Thumbprint     string     `protobuf:"bytes,2,opt,name=thumbprint,proto3" json:"thumbprint,omitempty"`
+	CommonName     string     `protobuf:"bytes,3,opt,name=common_name,json=commonName,proto3" json:"common_name,omitempty"`
+	DnsNames       []string   `protobuf:"bytes,4,rep,name=dns_names,json=dnsNames,proto3" json:"dns_names,omitempty"`
+	IsCa           bool       `protobuf:"varint,5,opt,name=is_ca,json=isCa,proto3" json:"is_ca,omitempty"`
+	ExpirationTime *time.Time `protobuf:"bytes,6,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time,omitempty"`
+}
+
+func (m *CertificateInfo) Reset()      { *m = CertificateInfo{} }
+func (*CertificateInfo) ProtoMessage() {}
+func (*CertificateInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fcc65697c8eece3a, []int{4}
+}
+func (m *CertificateInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CertificateInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CertificateInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CertificateInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CertificateInfo.Merge(m, src)
+}
+func (m *CertificateInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *CertificateInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CertificateInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CertificateInfo proto.InternalMessageInfo
+
+func (m *CertificateInfo) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *CertificateInfo) GetThumbprint() string {
+	if m != nil {
+		return m.Thumbprint
+	}
+	return ""
+}
+
+func (m *CertificateInfo) GetCommonName() string {
+	if m != nil {
+		return m.CommonName
+	}
+	return ""
+}
+
+func (m *CertificateInfo) GetDnsNames() []string {
+	if m != nil {
+		return m.DnsNames
+	}
+	return nil
+}
+
+func (m *CertificateInfo) GetIsCa() bool {
+	if m != nil {
+		return m.IsCa
+	}
+	return false
+}
+
+func (m *CertificateInfo) GetExpirationTime() *time.Time {
+	if m != nil {
+		return m.ExpirationTime
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*HostInfo)(nil), "temporal.server.api.cluster.v1.HostInfo")
 	proto.RegisterType((*RingInfo)(nil), "temporal.server.api.cluster.v1.RingInfo")
 	proto.RegisterType((*MembershipInfo)(nil), "temporal.server.api.cluster.v1.MembershipInfo")
 	proto.RegisterType((*ClusterMember)(nil), "temporal.server.api.cluster.v1.ClusterMember")
+	proto.RegisterType((*CertificateInfo)(nil), "temporal.server.api.cluster.v1.CertificateInfo")
 }
 
 func init() {
@@ -319,43 +407,49 @@ func init() {
 }
 
 var fileDescriptor_fcc65697c8eece3a = []byte{
-	// 571 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xbd, 0x6e, 0x13, 0x4d,
-	0x14, 0xdd, 0x89, 0x93, 0xd8, 0x19, 0xe7, 0x8b, 0xf2, 0x4d, 0x83, 0x71, 0x31, 0x71, 0x5c, 0x20,
-	0x0b, 0xa2, 0x5d, 0x25, 0x94, 0x48, 0x48, 0x24, 0x42, 0x4a, 0x84, 0x40, 0x68, 0xa1, 0xa2, 0x59,
-	0xed, 0xcf, 0xcd, 0x7a, 0xa4, 0xdd, 0x9d, 0xd1, 0xcc, 0x38, 0x22, 0x1d, 0x0d, 0x7d, 0x5a, 0xde,
-	0x80, 0x47, 0x41, 0x54, 0x29, 0xd3, 0x81, 0xd7, 0x0d, 0x65, 0x1e, 0x01, 0xcd, 0xcc, 0x6e, 0x00,
-	0xc9, 0x42, 0xa6, 0xbb, 0x3f, 0xe7, 0x9c, 0x3d, 0x7b, 0xef, 0xd5, 0xe0, 0x03, 0x0d, 0xa5, 0xe0,
-	0x32, 0x2e, 0x02, 0x05, 0xf2, 0x02, 0x64, 0x10, 0x0b, 0x16, 0xa4, 0xc5, 0x4c, 0x69, 0x90, 0xc1,
-	0xc5, 0x61, 0x50, 0x82, 0x52, 0x71, 0x0e, 0xbe, 0x90, 0x5c, 0x73, 0x42, 0x5b, 0xb4, 0xef, 0xd0,
-	0x7e, 0x2c, 0x98, 0xdf, 0xa0, 0xfd, 0x8b, 0xc3, 0xe1, 0x5e, 0xce, 0x79, 0x5e, 0x40, 0x60, 0xd1,
-	0xc9, 0xec, 0x3c, 0xd0, 0xac, 0x04, 0xa5, 0xe3, 0x52, 0x38, 0x81, 0xe1, 0x7e, 0x06, 0x02, 0xaa,
-	0x0c, 0xaa, 0x94, 0x81, 0x0a, 0x72, 0x9e, 0x73, 0x5b, 0xb7, 0x51, 0x03, 0x79, 0xb8, 0xcc, 0x11,
-	0x54, 0xb3, 0x52, 0x19, 0x3f, 0xed, 0xc7, 0x2c, 0x76, 0xfc, 0x00, 0xf7, 0x4e, 0xb9, 0xd2, 0x67,
-	0xd5, 0x39, 0x27, 0x43, 0xdc, 0x63, 0x19, 0x54, 0x9a, 0xe9, 0xcb, 0x01, 0x1a, 0xa1, 0xc9, 0x56,
-	0x78, 0x97, 0x8f, 0x3f, 0x22, 0xdc, 0x0b, 0x59, 0x95, 0x5b, 0x20, 0xc1, 0xeb, 0x92, 0x17, 0xd0,
-	0x80, 0x6c, 0x4c, 0xf6, 0xf1, 0x76, 0x09, 0x65, 0x02, 0x32, 0x4a, 0xf9, 0xac, 0xd2, 0x83, 0xb5,
-	0x11, 0x9a, 0x6c, 0x84, 0x7d, 0x57, 0x3b, 0x31, 0x25, 0x72, 0x8c, 0xbb, 0x2e, 0x55, 0x83, 0xce,
-	0xa8, 0x33, 0xe9, 0x1f, 0x4d, 0xfc, 0xbf, 0x4f, 0xc3, 0x6f, 0xad, 0x85, 0x2d, 0x71, 0xfc, 0x15,
-	0xe1, 0x9d, 0x97, 0x2e, 0x9e, 0x32, 0x61, 0xdd, 0xbc, 0xc0, 0xdb, 0xe9, 0x4c, 0x4a, 0xa8, 0x74,
-	0x34, 0xe5, 0x4a, 0x5b, 0x57, 0xff, 0xa2, 0xdd, 0x6f, 0xd8, 0xa6, 0x40, 0x1e, 0xe1, 0xff, 0x25,
-	0xc4, 0xe9, 0x34, 0x4e, 0x0a, 0x88, 0x5a, 0xb7, 0x6b, 0xa3, 0xce, 0x64, 0x2b, 0xdc, 0xbd, 0x6b,
-	0x34, 0x06, 0xc8, 0x53, 0xbc, 0x21, 0x59, 0x95, 0xaf, 0xfc, 0x3b, 0xed, 0x00, 0x43, 0x47, 0x1b,
-	0x7f, 0xea, 0xe0, 0xff, 0x4e, 0x5c, 0xdb, 0x49, 0x92, 0x93, 0xdf, 0x26, 0xbb, 0x73, 0x14, 0x2c,
-	0x15, 0xb4, 0x9b, 0x34, 0x72, 0x7f, 0x50, 0x43, 0x5e, 0x40, 0xb3, 0x8a, 0x7b, 0xb8, 0x6b, 0x06,
-	0x11, 0xb1, 0xcc, 0x6e, 0x61, 0x2b, 0xdc, 0x34, 0xe9, 0x59, 0x46, 0xf6, 0x70, 0x5f, 0x8a, 0x34,
-	0x8a, 0xb3, 0x4c, 0x82, 0x32, 0xae, 0x4d, 0x13, 0x4b, 0x91, 0x3e, 0x73, 0x15, 0x72, 0x1f, 0xf7,
-	0x0c, 0x40, 0x70, 0xa9, 0x07, 0xeb, 0x76, 0x81, 0x5d, 0x29, 0xd2, 0xd7, 0x5c, 0x6a, 0xf2, 0x0a,
-	0x13, 0x05, 0x4a, 0x31, 0x5e, 0x45, 0x4a, 0xc7, 0x52, 0x47, 0xe6, 0x30, 0x07, 0x1b, 0x76, 0xd6,
-	0x43, 0xdf, 0x5d, 0xad, 0xdf, 0x5e, 0xad, 0xff, 0xb6, 0xbd, 0xda, 0xe3, 0xf5, 0xab, 0x6f, 0x7b,
-	0x28, 0xdc, 0x6d, 0xb8, 0x6f, 0x0c, 0xd5, 0x34, 0x8d, 0x5e, 0x11, 0x2b, 0x1d, 0x4d, 0x21, 0x96,
-	0x3a, 0x61, 0x8d, 0xde, 0xe6, 0xaa, 0x7a, 0x86, 0x7b, 0xda, 0x50, 0x5b, 0x3d, 0x09, 0x29, 0x97,
-	0x59, 0x04, 0xef, 0x05, 0x93, 0x97, 0x4e, 0xaf, 0xbb, 0xaa, 0x9e, 0xe3, 0x3e, 0xb7, 0x54, 0xd3,
-	0x3c, 0x4e, 0xae, 0xe7, 0xd4, 0xbb, 0x99, 0x53, 0xef, 0x76, 0x4e, 0xd1, 0x87, 0x9a, 0xa2, 0xcf,
-	0x35, 0x45, 0x5f, 0x6a, 0x8a, 0xae, 0x6b, 0x8a, 0xbe, 0xd7, 0x14, 0xfd, 0xa8, 0xa9, 0x77, 0x5b,
-	0x53, 0x74, 0xb5, 0xa0, 0xde, 0xf5, 0x82, 0x7a, 0x37, 0x0b, 0xea, 0xbd, 0x3b, 0xc8, 0xf9, 0xaf,
-	0x9d, 0x31, 0xbe, 0xfc, 0x49, 0x78, 0xd2, 0x84, 0xc9, 0xa6, 0xf5, 0xf3, 0xf8, 0x67, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xa4, 0x67, 0x85, 0x8f, 0x43, 0x04, 0x00, 0x00,
+	// 671 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x41, 0x6b, 0x1b, 0x39,
+	0x18, 0xf5, 0xc4, 0x76, 0x6c, 0xcb, 0xd9, 0x24, 0xab, 0x5d, 0x58, 0xaf, 0x0b, 0x8a, 0xe3, 0x43,
+	0x31, 0x6d, 0x98, 0x21, 0xe9, 0xb1, 0x50, 0x68, 0x4c, 0x21, 0xa1, 0x34, 0x94, 0x69, 0x4f, 0xbd,
+	0x0c, 0xf2, 0xcc, 0x97, 0xb1, 0xc0, 0x23, 0x09, 0x49, 0x0e, 0xcd, 0xad, 0x97, 0xde, 0x73, 0xed,
+	0x3f, 0xe8, 0x4f, 0x29, 0x3d, 0xe5, 0x98, 0x5b, 0x1b, 0x87, 0x42, 0x8f, 0xf9, 0x09, 0x45, 0xd2,
+	0x4c, 0x92, 0x42, 0x28, 0xc9, 0x4d, 0xfa, 0xbe, 0xf7, 0x9e, 0xdf, 0x3c, 0x3d, 0x8c, 0xb6, 0x0c,
+	0x14, 0x52, 0x28, 0x3a, 0x8b, 0x34, 0xa8, 0x23, 0x50, 0x11, 0x95, 0x2c, 0x4a, 0x67, 0x73, 0x6d,
+	0x40, 0x45, 0x47, 0xdb, 0x51, 0x01, 0x5a, 0xd3, 0x1c, 0x42, 0xa9, 0x84, 0x11, 0x98, 0x54, 0xe8,
+	0xd0, 0xa3, 0x43, 0x2a, 0x59, 0x58, 0xa2, 0xc3, 0xa3, 0xed, 0xfe, 0x46, 0x2e, 0x44, 0x3e, 0x83,
+	0xc8, 0xa1, 0x27, 0xf3, 0xc3, 0xc8, 0xb0, 0x02, 0xb4, 0xa1, 0x85, 0xf4, 0x02, 0xfd, 0xcd, 0x0c,
+	0x24, 0xf0, 0x0c, 0x78, 0xca, 0x40, 0x47, 0xb9, 0xc8, 0x85, 0x9b, 0xbb, 0x53, 0x09, 0x79, 0x74,
+	0x9b, 0x23, 0xe0, 0xf3, 0x42, 0x5b, 0x3f, 0xd5, 0x8f, 0x39, 0xec, 0xf0, 0x21, 0x6a, 0xef, 0x09,
+	0x6d, 0xf6, 0xf9, 0xa1, 0xc0, 0x7d, 0xd4, 0x66, 0x19, 0x70, 0xc3, 0xcc, 0x71, 0x2f, 0x18, 0x04,
+	0xa3, 0x4e, 0x7c, 0x75, 0x1f, 0x7e, 0x0c, 0x50, 0x3b, 0x66, 0x3c, 0x77, 0x40, 0x8c, 0x1a, 0x4a,
+	0xcc, 0xa0, 0x04, 0xb9, 0x33, 0xde, 0x44, 0x2b, 0x05, 0x14, 0x13, 0x50, 0x49, 0x2a, 0xe6, 0xdc,
+	0xf4, 0x96, 0x06, 0xc1, 0xa8, 0x19, 0x77, 0xfd, 0x6c, 0x6c, 0x47, 0x78, 0x17, 0xb5, 0xfc, 0x55,
+	0xf7, 0xea, 0x83, 0xfa, 0xa8, 0xbb, 0x33, 0x0a, 0xff, 0x9c, 0x46, 0x58, 0x59, 0x8b, 0x2b, 0xe2,
+	0xf0, 0x6b, 0x80, 0x56, 0x5f, 0xf9, 0xf3, 0x94, 0x49, 0xe7, 0xe6, 0x25, 0x5a, 0x49, 0xe7, 0x4a,
+	0x01, 0x37, 0xc9, 0x54, 0x68, 0xe3, 0x5c, 0xdd, 0x47, 0xbb, 0x5b, 0xb2, 0xed, 0x00, 0x3f, 0x46,
+	0x7f, 0x2b, 0xa0, 0xe9, 0x94, 0x4e, 0x66, 0x90, 0x54, 0x6e, 0x97, 0x06, 0xf5, 0x51, 0x27, 0x5e,
+	0xbf, 0x5a, 0x94, 0x06, 0xf0, 0x33, 0xd4, 0x54, 0x8c, 0xe7, 0x77, 0xfe, 0x9c, 0x2a, 0xc0, 0xd8,
+	0xd3, 0x86, 0x9f, 0xea, 0xe8, 0xaf, 0xb1, 0x5f, 0x7b, 0x49, 0x3c, 0xbe, 0x91, 0xec, 0xea, 0x4e,
+	0x74, 0xab, 0xa0, 0x7b, 0x49, 0x2b, 0xf7, 0x1b, 0x35, 0x16, 0x33, 0x28, 0x9f, 0xe2, 0x3f, 0xd4,
+	0xb2, 0x41, 0x24, 0x2c, 0x73, 0xaf, 0xd0, 0x89, 0x97, 0xed, 0x75, 0x3f, 0xc3, 0x1b, 0xa8, 0xab,
+	0x64, 0x9a, 0xd0, 0x2c, 0x53, 0xa0, 0xad, 0x6b, 0xbb, 0x44, 0x4a, 0xa6, 0xcf, 0xfd, 0x04, 0xff,
+	0x8f, 0xda, 0x16, 0x20, 0x85, 0x32, 0xbd, 0x86, 0x7b, 0xc0, 0x96, 0x92, 0xe9, 0x6b, 0xa1, 0x0c,
+	0x3e, 0x40, 0x58, 0x83, 0xd6, 0x4c, 0xf0, 0x44, 0x1b, 0xaa, 0x4c, 0x62, 0x8b, 0xd9, 0x6b, 0xba,
+	0xac, 0xfb, 0xa1, 0x6f, 0x6d, 0x58, 0xb5, 0x36, 0x7c, 0x5b, 0xb5, 0x76, 0xb7, 0x71, 0xf2, 0x6d,
+	0x23, 0x88, 0xd7, 0x4b, 0xee, 0x1b, 0x4b, 0xb5, 0x4b, 0xab, 0x37, 0xa3, 0xda, 0x24, 0x53, 0xa0,
+	0xca, 0x4c, 0x58, 0xa9, 0xb7, 0x7c, 0x57, 0x3d, 0xcb, 0xdd, 0x2b, 0xa9, 0x95, 0x9e, 0x82, 0x54,
+	0xa8, 0x2c, 0x81, 0xf7, 0x92, 0xa9, 0x63, 0xaf, 0xd7, 0xba, 0xab, 0x9e, 0xe7, 0xbe, 0x70, 0x54,
+	0xbb, 0x1c, 0xfe, 0x08, 0xd0, 0xda, 0x18, 0x94, 0x61, 0x87, 0x2c, 0xa5, 0x06, 0x5c, 0xd3, 0xfe,
+	0x45, 0xcd, 0x5c, 0x89, 0xb9, 0x2c, 0x8b, 0xef, 0x2f, 0x98, 0x20, 0x64, 0xa6, 0xf3, 0x62, 0x22,
+	0x15, 0x2b, 0x7b, 0xdf, 0x89, 0x6f, 0x4c, 0x6c, 0xea, 0xa9, 0x28, 0x0a, 0xc1, 0x13, 0x4e, 0x0b,
+	0xa8, 0x52, 0xf7, 0xa3, 0x03, 0x5a, 0x00, 0x7e, 0x80, 0x3a, 0x19, 0xd7, 0x6e, 0xab, 0x7b, 0x0d,
+	0xd7, 0xb5, 0x76, 0xc6, 0xb5, 0xdd, 0x69, 0xfc, 0x0f, 0x6a, 0x32, 0x9d, 0xa4, 0xd4, 0x45, 0xdd,
+	0x8e, 0x1b, 0x4c, 0x8f, 0x29, 0xde, 0x47, 0x6b, 0xee, 0x2b, 0xa9, 0xb1, 0xef, 0x71, 0xaf, 0xe4,
+	0x56, 0xaf, 0x89, 0x76, 0xb5, 0x3b, 0x39, 0x3d, 0x27, 0xb5, 0xb3, 0x73, 0x52, 0xbb, 0x3c, 0x27,
+	0xc1, 0x87, 0x05, 0x09, 0x3e, 0x2f, 0x48, 0xf0, 0x65, 0x41, 0x82, 0xd3, 0x05, 0x09, 0xbe, 0x2f,
+	0x48, 0xf0, 0x73, 0x41, 0x6a, 0x97, 0x0b, 0x12, 0x9c, 0x5c, 0x90, 0xda, 0xe9, 0x05, 0xa9, 0x9d,
+	0x5d, 0x90, 0xda, 0xbb, 0xad, 0x5c, 0x5c, 0x77, 0x93, 0x89, 0xdb, 0xff, 0xfa, 0x9e, 0x96, 0xc7,
+	0xc9, 0xb2, 0x73, 0xf3, 0xe4, 0xd7, 0x00, 0x0f, 0x20, 0xd7, 0xab, 0x2b, 0x05, 0x00, 0x00,
 }
 
 func (this *HostInfo) Equal(that interface{}) bool {
@@ -511,6 +605,54 @@ func (this *ClusterMember) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *CertificateInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CertificateInfo)
+	if !ok {
+		that2, ok := that.(CertificateInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Group != that1.Group {
+		return false
+	}
+	if this.Thumbprint != that1.Thumbprint {
+		return false
+	}
+	if this.CommonName != that1.CommonName {
+		return false
+	}
+	if len(this.DnsNames) != len(that1.DnsNames) {
+		return false
+	}
+	for i := range this.DnsNames {
+		if this.DnsNames[i] != that1.DnsNames[i] {
+			return false
+		}
+	}
+	if this.IsCa != that1.IsCa {
+		return false
+	}
+	if that1.ExpirationTime == nil {
+		if this.ExpirationTime != nil {
+			return false
+		}
+	} else if !this.ExpirationTime.Equal(*that1.ExpirationTime) {
+		return false
+	}
+	return true
+}
 func (this *HostInfo) GoString() string {
 	if this == nil {
 		return "nil"
@@ -567,6 +709,21 @@ func (this *ClusterMember) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *CertificateInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&cluster.CertificateInfo{")
+	s = append(s, "Group: "+fmt.Sprintf("%#v", this.Group)+",\n")
+	s = append(s, "Thumbprint: "+fmt.Sprintf("%#v", this.Thumbprint)+",\n")
+	s = append(s, "CommonName: "+fmt.Sprintf("%#v", this.CommonName)+",\n")
+	s = append(s, "DnsNames: "+fmt.Sprintf("%#v", this.DnsNames)+",\n")
+	s = append(s, "IsCa: "+fmt.Sprintf("%#v", this.IsCa)+",\n")
+	s = append(s, "ExpirationTime: "+fmt.Sprintf("%#v", this.ExpirationTime)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringMessage(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -789,6 +946,79 @@ func (m *ClusterMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CertificateInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CertificateInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CertificateInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpirationTime != nil {
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpirationTime):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintMessage(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.IsCa {
+		i--
+		if m.IsCa {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.DnsNames) > 0 {
+		for iNdEx := len(m.DnsNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DnsNames[iNdEx])
+			copy(dAtA[i:], m.DnsNames[iNdEx])
+			i = encodeVarintMessage(dAtA, i, uint64(len(m.DnsNames[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.CommonName) > 0 {
+		i -= len(m.CommonName)
+		copy(dAtA[i:], m.CommonName)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.CommonName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Thumbprint) > 0 {
+		i -= len(m.Thumbprint)
+		copy(dAtA[i:], m.Thumbprint)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Thumbprint)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMessage(v)
 	base := offset
@@ -895,6 +1125,40 @@ func (m *ClusterMember) Size() (n int) {
 	return n
 }
 
+func (m *CertificateInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.Thumbprint)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.CommonName)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if len(m.DnsNames) > 0 {
+		for _, s := range m.DnsNames {
+			l = len(s)
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if m.IsCa {
+		n += 2
+	}
+	if m.ExpirationTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpirationTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
 func sovMessage(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -961,6 +1225,21 @@ func (this *ClusterMember) String() string {
 	}, "")
 	return s
 }
+func (this *CertificateInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CertificateInfo{`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`Thumbprint:` + fmt.Sprintf("%v", this.Thumbprint) + `,`,
+		`CommonName:` + fmt.Sprintf("%v", this.CommonName) + `,`,
+		`DnsNames:` + fmt.Sprintf("%v", this.DnsNames) + `,`,
+		`IsCa:` + fmt.Sprintf("%v", this.IsCa) + `,`,
+		`ExpirationTime:` + strings.Replace(fmt.Sprintf("%v", this.ExpirationTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringMessage(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -1610,6 +1889,243 @@ func (m *ClusterMember) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CertificateInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CertificateInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CertificateInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Thumbprint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Thumbprint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommonName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommonName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DnsNames", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DnsNames = append(m.DnsNames, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsCa", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsCa = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationTime == nil {
+				m.ExpirationTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMessage(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
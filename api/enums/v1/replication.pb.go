@@ -85,6 +85,34 @@ func (ReplicationTaskType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_3f4df3039790445d, []int{0}
 }
 
+type ReplicationProcessorState int32
+
+const (
+	REPLICATION_PROCESSOR_STATE_UNSPECIFIED ReplicationProcessorState = 0
+	REPLICATION_PROCESSOR_STATE_RUNNING     ReplicationProcessorState = 1
+	// The last poll or apply of replication tasks failed and the processor waits to retry.
+	REPLICATION_PROCESSOR_STATE_RETRYING ReplicationProcessorState = 2
+	REPLICATION_PROCESSOR_STATE_STOPPED  ReplicationProcessorState = 3
+)
+
+var ReplicationProcessorState_name = map[int32]string{
+	0: "Unspecified",
+	1: "Running",
+	2: "Retrying",
+	3: "Stopped",
+}
+
+var ReplicationProcessorState_value = map[string]int32{
+	"Unspecified": 0,
+	"Running":     1,
+	"Retrying":    2,
+	"Stopped":     3,
+}
+
+func (ReplicationProcessorState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3f4df3039790445d, []int{1}
+}
+
 type NamespaceOperation int32
 
 const (
@@ -106,11 +134,12 @@ var NamespaceOperation_value = map[string]int32{
 }
 
 func (NamespaceOperation) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_3f4df3039790445d, []int{1}
+	return fileDescriptor_3f4df3039790445d, []int{2}
 }
 
 func init() {
 	proto.RegisterEnum("temporal.server.api.enums.v1.ReplicationTaskType", ReplicationTaskType_name, ReplicationTaskType_value)
+	proto.RegisterEnum("temporal.server.api.enums.v1.ReplicationProcessorState", ReplicationProcessorState_name, ReplicationProcessorState_value)
 	proto.RegisterEnum("temporal.server.api.enums.v1.NamespaceOperation", NamespaceOperation_name, NamespaceOperation_value)
 }
 
@@ -119,32 +148,36 @@ func init() {
 }
 
 var fileDescriptor_3f4df3039790445d = []byte{
-	// 395 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x4f, 0x8b, 0xda, 0x40,
-	0x18, 0xc6, 0x33, 0xb6, 0xb5, 0x30, 0x27, 0x99, 0xde, 0x4a, 0x99, 0xd2, 0x3f, 0x16, 0x6b, 0x65,
-	0x52, 0xed, 0xb1, 0xa7, 0x69, 0x32, 0x62, 0x50, 0x93, 0x90, 0x19, 0x15, 0x7b, 0x68, 0x48, 0x65,
-	0x28, 0xa1, 0x6a, 0x86, 0xc4, 0x0a, 0xde, 0xfa, 0x11, 0xfa, 0x31, 0xfa, 0x51, 0xf6, 0xe8, 0xd1,
-	0xe3, 0x1a, 0x2f, 0x7b, 0xf4, 0x13, 0x2c, 0xcb, 0x26, 0xee, 0xca, 0x42, 0x36, 0xb7, 0x61, 0xde,
-	0xdf, 0xf3, 0xf2, 0xf0, 0xbc, 0x0f, 0x24, 0x2b, 0xb9, 0x50, 0x51, 0x1c, 0xcc, 0xf5, 0x44, 0xc6,
-	0x6b, 0x19, 0xeb, 0x81, 0x0a, 0x75, 0xb9, 0xfc, 0xb3, 0x48, 0xf4, 0x75, 0x5b, 0x8f, 0xa5, 0x9a,
-	0x87, 0xb3, 0x60, 0x15, 0x46, 0x4b, 0xa2, 0xe2, 0x68, 0x15, 0xa1, 0x57, 0x77, 0x3c, 0xc9, 0x79,
-	0x12, 0xa8, 0x90, 0x64, 0x3c, 0x59, 0xb7, 0x9b, 0xd7, 0x15, 0xf8, 0xc2, 0x3b, 0x6b, 0x44, 0x90,
-	0xfc, 0x16, 0x1b, 0x25, 0x51, 0x1d, 0xbe, 0xf1, 0x98, 0x3b, 0xb0, 0x0c, 0x2a, 0x2c, 0xc7, 0xf6,
-	0x05, 0xe5, 0x7d, 0x5f, 0x4c, 0x5d, 0xe6, 0x8f, 0x6c, 0xee, 0x32, 0xc3, 0xea, 0x5a, 0xcc, 0xac,
-	0x69, 0xa8, 0x01, 0xdf, 0x17, 0x63, 0x36, 0x1d, 0x32, 0xee, 0x52, 0x83, 0x65, 0x7f, 0x35, 0x80,
-	0x3e, 0xc0, 0xb7, 0xc5, 0x64, 0xcf, 0xe2, 0xc2, 0xf1, 0xa6, 0x39, 0x57, 0x41, 0x9f, 0x61, 0xab,
-	0x98, 0xe3, 0x53, 0xdb, 0xf0, 0x79, 0x8f, 0x7a, 0xa6, 0xcf, 0x05, 0x15, 0x23, 0x9e, 0x2b, 0x9e,
-	0xa0, 0x16, 0x6c, 0x94, 0x28, 0xa8, 0x21, 0xac, 0xb1, 0x25, 0x4e, 0xfb, 0x9f, 0x22, 0x1d, 0x7e,
-	0x2a, 0xf7, 0x31, 0x64, 0x82, 0x9a, 0x54, 0xd0, 0x5c, 0xf0, 0x0c, 0x7d, 0x84, 0xf5, 0x72, 0xc1,
-	0xb8, 0x93, 0xa3, 0x55, 0xd4, 0x81, 0xa4, 0xc4, 0xc9, 0xc4, 0xf1, 0xfa, 0xdd, 0x81, 0x33, 0xc9,
-	0xec, 0x9f, 0x72, 0x79, 0xde, 0xdc, 0x40, 0x64, 0x07, 0x0b, 0x99, 0xa8, 0x60, 0x26, 0x1d, 0x25,
-	0xe3, 0xec, 0x0c, 0xe8, 0x1d, 0x7c, 0x7d, 0x4e, 0xd0, 0x71, 0x99, 0x97, 0x6f, 0x7c, 0x18, 0x3e,
-	0x86, 0x2f, 0x8b, 0x20, 0xc3, 0x63, 0x54, 0xb0, 0x1a, 0x78, 0x6c, 0x3e, 0x72, 0xcd, 0xdb, 0x79,
-	0xe5, 0xdb, 0x8f, 0xed, 0x1e, 0x6b, 0xbb, 0x3d, 0xd6, 0x8e, 0x7b, 0x0c, 0xfe, 0xa6, 0x18, 0xfc,
-	0x4f, 0x31, 0xb8, 0x48, 0x31, 0xd8, 0xa6, 0x18, 0x5c, 0xa6, 0x18, 0x5c, 0xa5, 0x58, 0x3b, 0xa6,
-	0x18, 0xfc, 0x3b, 0x60, 0x6d, 0x7b, 0xc0, 0xda, 0xee, 0x80, 0xb5, 0xef, 0x8d, 0x5f, 0xd1, 0x7d,
-	0x05, 0x49, 0x18, 0x15, 0xb5, 0xf0, 0x6b, 0xf6, 0xf8, 0x59, 0xcd, 0x0a, 0xf8, 0xe5, 0x26, 0x00,
-	0x00, 0xff, 0xff, 0x87, 0x6b, 0x7d, 0xf7, 0xb2, 0x02, 0x00, 0x00,
+	// 459 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xb1, 0x6e, 0xd4, 0x30,
+	0x1c, 0xc6, 0xe3, 0x2b, 0x14, 0xc9, 0x53, 0x64, 0x26, 0x10, 0x32, 0x82, 0x52, 0x7a, 0x5c, 0xab,
+	0x84, 0x96, 0x91, 0xc9, 0x24, 0x2e, 0x8d, 0xda, 0xc6, 0x96, 0xed, 0x6b, 0x15, 0x06, 0xa2, 0x70,
+	0xb2, 0x50, 0x44, 0xaf, 0x89, 0x9c, 0x70, 0x52, 0x37, 0x1e, 0x81, 0xc7, 0xe0, 0x41, 0x18, 0x18,
+	0x6f, 0xec, 0xc8, 0xe5, 0x16, 0xc6, 0x3e, 0x01, 0x42, 0x4d, 0x0e, 0xda, 0x83, 0x90, 0x6e, 0x51,
+	0xfe, 0xbf, 0xef, 0xd3, 0x67, 0xff, 0xfd, 0x41, 0xa7, 0xd4, 0xe3, 0x3c, 0x33, 0xc9, 0x89, 0x5b,
+	0x68, 0x33, 0xd1, 0xc6, 0x4d, 0xf2, 0xd4, 0xd5, 0xa7, 0x1f, 0xc7, 0x85, 0x3b, 0xd9, 0x76, 0x8d,
+	0xce, 0x4f, 0xd2, 0x51, 0x52, 0xa6, 0xd9, 0xa9, 0x93, 0x9b, 0xac, 0xcc, 0xd0, 0x83, 0xdf, 0xbc,
+	0xd3, 0xf0, 0x4e, 0x92, 0xa7, 0x4e, 0xcd, 0x3b, 0x93, 0xed, 0xc1, 0xcf, 0x1e, 0xbc, 0x2b, 0xae,
+	0x34, 0x2a, 0x29, 0x3e, 0xa8, 0xb3, 0x5c, 0xa3, 0x75, 0xf8, 0x48, 0x50, 0x7e, 0x10, 0x78, 0x44,
+	0x05, 0x2c, 0x8c, 0x15, 0x91, 0xfb, 0xb1, 0x8a, 0x38, 0x8d, 0x87, 0xa1, 0xe4, 0xd4, 0x0b, 0x76,
+	0x03, 0xea, 0xdb, 0x16, 0xea, 0xc3, 0x27, 0xed, 0x58, 0x48, 0x0e, 0xa9, 0xe4, 0xc4, 0xa3, 0xf5,
+	0x3f, 0x1b, 0xa0, 0xa7, 0xf0, 0x71, 0x3b, 0xb9, 0x17, 0x48, 0xc5, 0x44, 0xd4, 0x70, 0x3d, 0xf4,
+	0x1c, 0x6e, 0xb5, 0x73, 0x32, 0x0a, 0xbd, 0x58, 0xee, 0x11, 0xe1, 0xc7, 0x52, 0x11, 0x35, 0x94,
+	0x8d, 0x62, 0x05, 0x6d, 0xc1, 0x7e, 0x87, 0x82, 0x78, 0x2a, 0x38, 0x0a, 0xd4, 0xc2, 0xff, 0x16,
+	0x72, 0xe1, 0x66, 0x77, 0x8e, 0x43, 0xaa, 0x88, 0x4f, 0x14, 0x69, 0x04, 0xb7, 0xd1, 0x33, 0xb8,
+	0xde, 0x2d, 0x38, 0xda, 0x69, 0xd0, 0x55, 0xb4, 0x03, 0x9d, 0x8e, 0x24, 0xc7, 0x4c, 0xec, 0xef,
+	0x1e, 0xb0, 0xe3, 0x3a, 0xfe, 0xe2, 0x5e, 0xee, 0x0c, 0xbe, 0x02, 0x78, 0xef, 0xda, 0x02, 0xb8,
+	0xc9, 0x46, 0xba, 0x28, 0x32, 0x23, 0xcb, 0xa4, 0xd4, 0x68, 0x13, 0x6e, 0x5c, 0x77, 0xe4, 0x82,
+	0x79, 0x54, 0x4a, 0x26, 0x16, 0x1e, 0xcb, 0xcb, 0xd8, 0x80, 0x6b, 0x5d, 0xb0, 0x18, 0x86, 0x61,
+	0x10, 0xbe, 0xb6, 0xc1, 0xdf, 0x5b, 0xfb, 0x07, 0xa4, 0x4a, 0x44, 0x97, 0x64, 0xef, 0x26, 0x4b,
+	0xa9, 0x18, 0xe7, 0xd4, 0xb7, 0x57, 0x06, 0x67, 0x10, 0x85, 0xc9, 0x58, 0x17, 0x79, 0x32, 0xd2,
+	0x2c, 0xd7, 0xa6, 0x3e, 0x0c, 0x5a, 0x83, 0x0f, 0xaf, 0x1e, 0x02, 0xe3, 0x54, 0x34, 0x36, 0xcb,
+	0xb1, 0x31, 0xbc, 0xdf, 0x06, 0x79, 0x82, 0x12, 0x45, 0x6d, 0xf0, 0xbf, 0xf9, 0x90, 0xfb, 0x97,
+	0xf3, 0xde, 0xab, 0xb7, 0xd3, 0x19, 0xb6, 0xce, 0x67, 0xd8, 0xba, 0x98, 0x61, 0xf0, 0xa9, 0xc2,
+	0xe0, 0x4b, 0x85, 0xc1, 0xb7, 0x0a, 0x83, 0x69, 0x85, 0xc1, 0xf7, 0x0a, 0x83, 0x1f, 0x15, 0xb6,
+	0x2e, 0x2a, 0x0c, 0x3e, 0xcf, 0xb1, 0x35, 0x9d, 0x63, 0xeb, 0x7c, 0x8e, 0xad, 0x37, 0xfd, 0xf7,
+	0xd9, 0x9f, 0x26, 0x39, 0x69, 0xd6, 0x56, 0xa6, 0x97, 0xf5, 0xc7, 0xbb, 0xd5, 0xba, 0x47, 0x2f,
+	0x7e, 0x0d, 0x00, 0xd3, 0x8e, 0x0b, 0xc1, 0x79, 0x03, 0x00, 0x00,
 }
 
 func (x ReplicationTaskType) String() string {
@@ -154,6 +187,13 @@ func (x ReplicationTaskType) String() string {
 	}
 	return strconv.Itoa(int(x))
 }
+func (x ReplicationProcessorState) String() string {
+	s, ok := ReplicationProcessorState_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
 func (x NamespaceOperation) String() string {
 	s, ok := NamespaceOperation_name[int32(x)]
 	if ok {
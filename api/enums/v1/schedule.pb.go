@@ -0,0 +1,117 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: temporal/server/api/enums/v1/schedule.proto
+
+package enums
+
+import (
+	fmt "fmt"
+	math "math"
+	strconv "strconv"
+
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// What a schedule does with a start that is still waiting on its upstream schedule when the
+// dependency timeout expires.
+type ScheduleDependencyTimeoutPolicy int32
+
+const (
+	SCHEDULE_DEPENDENCY_TIMEOUT_POLICY_UNSPECIFIED ScheduleDependencyTimeoutPolicy = 0
+	// Drop the start (this is the default).
+	SCHEDULE_DEPENDENCY_TIMEOUT_POLICY_SKIP ScheduleDependencyTimeoutPolicy = 1
+	// Start the workflow anyway.
+	SCHEDULE_DEPENDENCY_TIMEOUT_POLICY_START ScheduleDependencyTimeoutPolicy = 2
+	// Drop the start and pause the schedule.
+	SCHEDULE_DEPENDENCY_TIMEOUT_POLICY_FAIL ScheduleDependencyTimeoutPolicy = 3
+)
+
+var ScheduleDependencyTimeoutPolicy_name = map[int32]string{
+	0: "Unspecified",
+	1: "Skip",
+	2: "Start",
+	3: "Fail",
+}
+
+var ScheduleDependencyTimeoutPolicy_value = map[string]int32{
+	"Unspecified": 0,
+	"Skip":        1,
+	"Start":       2,
+	"Fail":        3,
+}
+
+func (ScheduleDependencyTimeoutPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0c7ac3450fe0d23b, []int{0}
+}
+
+func init() {
+	proto.RegisterEnum("temporal.server.api.enums.v1.ScheduleDependencyTimeoutPolicy", ScheduleDependencyTimeoutPolicy_name, ScheduleDependencyTimeoutPolicy_value)
+}
+
+func init() {
+	proto.RegisterFile("temporal/server/api/enums/v1/schedule.proto", fileDescriptor_0c7ac3450fe0d23b)
+}
+
+var fileDescriptor_0c7ac3450fe0d23b = []byte{
+	// 277 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x2e, 0x49, 0xcd, 0x2d,
+	0xc8, 0x2f, 0x4a, 0xcc, 0xd1, 0x2f, 0x4e, 0x2d, 0x2a, 0x4b, 0x2d, 0xd2, 0x4f, 0x2c, 0xc8, 0xd4,
+	0x4f, 0xcd, 0x2b, 0xcd, 0x2d, 0xd6, 0x2f, 0x33, 0xd4, 0x2f, 0x4e, 0xce, 0x48, 0x4d, 0x29, 0xcd,
+	0x49, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x81, 0x29, 0xd6, 0x83, 0x28, 0xd6, 0x4b,
+	0x2c, 0xc8, 0xd4, 0x03, 0x2b, 0xd6, 0x2b, 0x33, 0xd4, 0xba, 0xcb, 0xc8, 0x25, 0x1f, 0x0c, 0xd5,
+	0xe0, 0x92, 0x5a, 0x90, 0x9a, 0x97, 0x92, 0x9a, 0x97, 0x5c, 0x19, 0x92, 0x99, 0x9b, 0x9a, 0x5f,
+	0x5a, 0x12, 0x90, 0x9f, 0x93, 0x99, 0x5c, 0x29, 0x64, 0xc4, 0xa5, 0x17, 0xec, 0xec, 0xe1, 0xea,
+	0x12, 0xea, 0xe3, 0x1a, 0xef, 0xe2, 0x1a, 0xe0, 0xea, 0xe7, 0xe2, 0xea, 0xe7, 0x1c, 0x19, 0x1f,
+	0xe2, 0xe9, 0xeb, 0xea, 0x1f, 0x1a, 0x12, 0x1f, 0xe0, 0xef, 0xe3, 0xe9, 0x1c, 0x19, 0x1f, 0xea,
+	0x17, 0x1c, 0xe0, 0xea, 0xec, 0xe9, 0xe6, 0xe9, 0xea, 0x22, 0xc0, 0x20, 0xa4, 0xcd, 0xa5, 0x4e,
+	0x84, 0x9e, 0x60, 0x6f, 0xcf, 0x00, 0x01, 0x46, 0x21, 0x1d, 0x2e, 0x0d, 0x62, 0x14, 0x87, 0x38,
+	0x06, 0x85, 0x08, 0x30, 0x11, 0x69, 0xb4, 0x9b, 0xa3, 0xa7, 0x8f, 0x00, 0xb3, 0x53, 0xdc, 0x85,
+	0x87, 0x72, 0x0c, 0x37, 0x1e, 0xca, 0x31, 0x7c, 0x78, 0x28, 0xc7, 0xd8, 0xf0, 0x48, 0x8e, 0x71,
+	0xc5, 0x23, 0x39, 0xc6, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e,
+	0xf1, 0xc5, 0x23, 0x39, 0x86, 0x0f, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58,
+	0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x8d, 0xf4, 0x7c, 0x3d, 0x78, 0xb0, 0x65, 0xe6, 0x63,
+	0x0b, 0x66, 0x6b, 0x30, 0x23, 0x89, 0x0d, 0x1c, 0xc8, 0xc6, 0x80, 0x01, 0x00, 0xa7, 0x58, 0x1b,
+	0xed, 0x93, 0x01, 0x00, 0x00,
+}
+
+func (x ScheduleDependencyTimeoutPolicy) String() string {
+	s, ok := ScheduleDependencyTimeoutPolicy_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
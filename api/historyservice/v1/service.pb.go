@@ -54,86 +54,94 @@ func init() {
 }
 
 var fileDescriptor_655983da427ae822 = []byte{
-	// 1255 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x99, 0xcd, 0x6b, 0x24, 0x45,
-	0x18, 0xc6, 0xa7, 0x2e, 0x22, 0x85, 0xae, 0xda, 0x8a, 0x1f, 0x51, 0x1b, 0x51, 0xf4, 0x38, 0x61,
-	0x77, 0x41, 0xf7, 0x23, 0x71, 0x4d, 0x26, 0xc9, 0x24, 0xbb, 0x89, 0x9a, 0x99, 0x6c, 0x04, 0x2f,
-	0x52, 0x33, 0xf3, 0x26, 0x53, 0xa4, 0x33, 0xdd, 0x56, 0xd5, 0x8c, 0xce, 0x41, 0x10, 0x3c, 0x2d,
-	0x08, 0x8a, 0x20, 0x78, 0x12, 0x3c, 0x29, 0x82, 0x20, 0x08, 0x82, 0x20, 0x78, 0x12, 0x3c, 0x49,
-	0x6e, 0xee, 0xd1, 0x4c, 0x2e, 0x1e, 0xf7, 0x4f, 0x90, 0x99, 0xee, 0xaa, 0x4c, 0x75, 0x57, 0x4f,
-	0xaa, 0xba, 0xe7, 0xb6, 0x3b, 0x53, 0xcf, 0xaf, 0x9f, 0xaa, 0x7a, 0xe7, 0xad, 0x27, 0xd5, 0xf8,
-	0xaa, 0x80, 0xe3, 0x28, 0x64, 0x24, 0x58, 0xe4, 0xc0, 0x06, 0xc0, 0x16, 0x49, 0x44, 0x17, 0xbb,
-	0x94, 0x8b, 0x90, 0x0d, 0xc7, 0x9f, 0xd0, 0x36, 0x2c, 0x0e, 0x2e, 0x2f, 0x26, 0xff, 0xac, 0x46,
-	0x2c, 0x14, 0xa1, 0xf7, 0xaa, 0x14, 0x55, 0x63, 0x51, 0x95, 0x44, 0xb4, 0xaa, 0x8b, 0xaa, 0x83,
-	0xcb, 0x0b, 0x4b, 0x76, 0x6c, 0x06, 0x1f, 0xf6, 0x81, 0x8b, 0x0f, 0x18, 0xf0, 0x28, 0xec, 0xf1,
-	0xe4, 0x21, 0x57, 0xee, 0x2d, 0xe3, 0x4b, 0x9b, 0xf1, 0xe0, 0x66, 0x3c, 0xd8, 0xfb, 0x1e, 0xe1,
-	0xa7, 0x9b, 0x82, 0x30, 0xf1, 0x5e, 0xc8, 0x8e, 0x0e, 0x82, 0xf0, 0xa3, 0xf5, 0x8f, 0xa1, 0xdd,
-	0x17, 0x34, 0xec, 0x79, 0x6b, 0x55, 0x2b, 0x4f, 0x55, 0xb3, 0xbc, 0x11, 0x5b, 0x58, 0x58, 0x2f,
-	0x49, 0x89, 0x27, 0xf0, 0x72, 0xc5, 0xfb, 0x0a, 0xe1, 0xc7, 0xea, 0x20, 0x76, 0xfa, 0x82, 0xb4,
-	0x02, 0x68, 0x0a, 0x22, 0xc0, 0x5b, 0xb6, 0x84, 0xa7, 0x74, 0xd2, 0xdb, 0x9b, 0x45, 0xe5, 0xca,
-	0xd4, 0xd7, 0x08, 0x3f, 0xfe, 0x6e, 0x18, 0x04, 0x9a, 0x2b, 0x5b, 0x6c, 0x5a, 0x28, 0x6d, 0xdd,
-	0x2a, 0xac, 0x57, 0xbe, 0xbe, 0x43, 0xf8, 0xa9, 0x06, 0x70, 0x10, 0x4d, 0x41, 0xdb, 0x47, 0xc3,
-	0x3d, 0xc2, 0x8f, 0x76, 0xfb, 0xd0, 0x07, 0x6f, 0xd5, 0x92, 0x6d, 0x12, 0x4b, 0x7f, 0xb5, 0x52,
-	0x0c, 0xe5, 0xf1, 0x67, 0x84, 0x9f, 0x6b, 0x40, 0x3b, 0x64, 0x1d, 0xb9, 0xed, 0xe3, 0x51, 0x93,
-	0x3a, 0x80, 0x8e, 0x57, 0xb7, 0x7e, 0x48, 0x0e, 0x41, 0xba, 0xdd, 0x2c, 0x0f, 0x32, 0x58, 0x5e,
-	0x69, 0x0b, 0x3a, 0xa0, 0x62, 0x58, 0xdc, 0xb2, 0x81, 0x50, 0xcc, 0xb2, 0x11, 0xa4, 0x2c, 0xff,
-	0x86, 0xf0, 0x0b, 0xf1, 0x7f, 0xb5, 0xb9, 0xd5, 0xc2, 0xe3, 0x28, 0x80, 0xb1, 0xeb, 0xdb, 0xf6,
-	0xbb, 0x99, 0x0b, 0x91, 0xc6, 0xef, 0xcc, 0x85, 0x95, 0x5a, 0xee, 0xcc, 0xd0, 0x0d, 0x42, 0x03,
-	0xa7, 0xe5, 0xce, 0x21, 0xb8, 0x2f, 0x77, 0x2e, 0x48, 0x59, 0xfe, 0x15, 0xe1, 0xe7, 0xb3, 0xdb,
-	0xb2, 0x09, 0x84, 0x89, 0x16, 0x10, 0xe1, 0x6d, 0x15, 0xde, 0x5a, 0xc5, 0x90, 0xb6, 0x6f, 0xcf,
-	0x03, 0x65, 0xaa, 0x93, 0xe9, 0xa1, 0x85, 0xeb, 0xc4, 0x08, 0x29, 0x58, 0x27, 0x39, 0x2c, 0x53,
-	0x9d, 0x4c, 0x0f, 0x2d, 0x56, 0x27, 0x59, 0x42, 0xc1, 0x3a, 0x31, 0x81, 0x52, 0x75, 0x92, 0x9d,
-	0x1d, 0xe9, 0xb5, 0x61, 0x6c, 0x7a, 0xab, 0xc4, 0x0a, 0x25, 0x0c, 0xf7, 0x3a, 0x99, 0x81, 0x52,
-	0xc6, 0x7f, 0x44, 0xf8, 0x99, 0x26, 0x3d, 0xec, 0x91, 0x20, 0x9b, 0x18, 0xac, 0xcf, 0x7a, 0xb3,
-	0x5e, 0x1a, 0xde, 0x28, 0x8b, 0x51, 0x66, 0xff, 0x44, 0xf8, 0xa5, 0x64, 0x14, 0x15, 0xdd, 0x9c,
-	0x9c, 0xf3, 0xb6, 0xdb, 0xe3, 0x72, 0x41, 0xd2, 0xfe, 0x3b, 0x73, 0xe3, 0xa9, 0x79, 0xfc, 0x84,
-	0xf0, 0xb3, 0x0d, 0x38, 0x0e, 0x07, 0x10, 0x8b, 0xb4, 0xb8, 0xb1, 0x61, 0xbd, 0xbf, 0x66, 0x80,
-	0xf4, 0x5d, 0x2f, 0xcd, 0x51, 0x7e, 0x7f, 0x41, 0x78, 0x61, 0x0f, 0xd8, 0x31, 0xed, 0x11, 0x01,
-	0xd9, 0x15, 0xb7, 0xfd, 0x21, 0xe5, 0x23, 0xa4, 0xe7, 0xad, 0x39, 0x90, 0xb4, 0xd2, 0x5e, 0x83,
-	0x71, 0x73, 0x29, 0x5e, 0xda, 0x39, 0x7a, 0xd7, 0xd2, 0xce, 0xc5, 0x28, 0xb3, 0xe3, 0xe0, 0x3e,
-	0x09, 0x58, 0xc5, 0x83, 0xbb, 0x59, 0xee, 0x1a, 0xdc, 0xf3, 0x28, 0xca, 0xe9, 0x1f, 0x08, 0xfb,
-	0x09, 0x34, 0xee, 0x27, 0x59, 0xc7, 0xdb, 0xd6, 0xcf, 0x9a, 0x85, 0x91, 0xce, 0x77, 0xe6, 0x44,
-	0xd3, 0xd2, 0x74, 0xb3, 0xdd, 0x85, 0x4e, 0x3f, 0x80, 0xe9, 0xd3, 0xdf, 0x3a, 0x4d, 0x9b, 0xc4,
-	0xae, 0x69, 0xda, 0xcc, 0xd0, 0x5a, 0xdd, 0x3e, 0x30, 0x7a, 0x30, 0xdc, 0xa0, 0x8c, 0x0b, 0x2d,
-	0xc7, 0x26, 0xca, 0x8e, 0x75, 0xab, 0xbb, 0x08, 0xe4, 0xda, 0xea, 0x2e, 0xe6, 0xa9, 0x79, 0xfc,
-	0x8e, 0xf0, 0x8b, 0x71, 0x62, 0xa9, 0x75, 0x69, 0xd0, 0x51, 0xdb, 0x71, 0x1e, 0x44, 0xee, 0x38,
-	0xe5, 0x9e, 0x1c, 0x8a, 0x9c, 0xc1, 0xf6, 0x7c, 0x60, 0xca, 0xfe, 0x3f, 0x08, 0xbf, 0x16, 0xcf,
-	0xd6, 0x38, 0x76, 0x52, 0x57, 0x63, 0x12, 0x74, 0xbc, 0x3d, 0xa7, 0xc5, 0xbb, 0x08, 0x27, 0x27,
-	0x74, 0x77, 0xce, 0x54, 0x2d, 0x64, 0xad, 0x01, 0x6f, 0x33, 0xda, 0x32, 0xf4, 0xc7, 0xba, 0x75,
-	0x63, 0xcb, 0x21, 0xb8, 0x86, 0xac, 0x19, 0x20, 0x65, 0xf9, 0x1b, 0x84, 0x9f, 0x68, 0x40, 0x14,
-	0xd0, 0x36, 0x11, 0xb0, 0x3e, 0x80, 0x9e, 0xe0, 0xfb, 0x57, 0xbc, 0x5b, 0xd6, 0x5b, 0x9e, 0x52,
-	0x4a, 0x8b, 0x6f, 0x15, 0x07, 0xa4, 0xda, 0x77, 0xf2, 0xbd, 0x9c, 0x43, 0x7c, 0x9e, 0xaf, 0xb9,
-	0xe2, 0x35, 0xb9, 0x7b, 0xfb, 0x36, 0x53, 0xb4, 0x7b, 0x97, 0xe6, 0xb0, 0xd7, 0x6e, 0x76, 0x09,
-	0xeb, 0x8c, 0xbf, 0xec, 0x73, 0xeb, 0x7b, 0x97, 0x94, 0xce, 0xf5, 0xde, 0x25, 0x23, 0x57, 0xa6,
-	0xee, 0x21, 0xfc, 0xc8, 0xf8, 0x5b, 0x19, 0x56, 0xbd, 0x1b, 0x0e, 0x48, 0x29, 0x92, 0x76, 0x6e,
-	0x16, 0xd2, 0x6a, 0xa7, 0x83, 0xac, 0x46, 0x2d, 0x98, 0xad, 0x3a, 0x96, 0xb2, 0x29, 0x94, 0xd5,
-	0x4a, 0x31, 0x94, 0xc7, 0x6f, 0x11, 0x7e, 0x52, 0x0e, 0x49, 0x6e, 0x00, 0x37, 0x43, 0x2e, 0xbc,
-	0x15, 0x47, 0xfc, 0x94, 0x56, 0x3a, 0x5c, 0x2d, 0x83, 0x50, 0x06, 0x3f, 0x43, 0x18, 0xd7, 0x82,
-	0x90, 0xc3, 0x64, 0xbf, 0xbd, 0x6b, 0x96, 0xd0, 0x73, 0x89, 0xb4, 0x73, 0xbd, 0x80, 0x52, 0xb9,
-	0xf8, 0x04, 0x3f, 0x5c, 0x07, 0x11, 0x5b, 0x78, 0xdd, 0xfe, 0x72, 0x50, 0x33, 0xf0, 0x86, 0xb3,
-	0x4e, 0x5b, 0x84, 0x38, 0x5d, 0x4f, 0xd2, 0xc5, 0x35, 0xa7, 0x40, 0x3e, 0x9d, 0x29, 0xae, 0x17,
-	0x50, 0x6a, 0xad, 0xa9, 0x0e, 0x42, 0x36, 0x06, 0x1a, 0xf6, 0x76, 0x80, 0x73, 0x72, 0x08, 0xdc,
-	0xba, 0x35, 0x99, 0xe5, 0xae, 0xad, 0x29, 0x8f, 0xa2, 0x1d, 0x49, 0x75, 0x10, 0x6b, 0xdb, 0xbb,
-	0x26, 0xb3, 0x75, 0xfb, 0xc7, 0x98, 0x09, 0xae, 0x47, 0xd2, 0x0c, 0x90, 0xb2, 0xfc, 0x39, 0xc2,
-	0x8f, 0xee, 0xf6, 0x81, 0x0d, 0x65, 0xbb, 0xf5, 0x6c, 0xbb, 0x8f, 0xa6, 0x92, 0xd6, 0x96, 0x8a,
-	0x89, 0x35, 0x3b, 0x0d, 0x20, 0x51, 0x14, 0x0c, 0xe3, 0x43, 0xca, 0xda, 0x8e, 0xa6, 0x72, 0xb5,
-	0x93, 0x12, 0x2b, 0x3b, 0x5f, 0x20, 0x7c, 0x29, 0x5e, 0x45, 0xb5, 0x8b, 0x4b, 0x4e, 0x8b, 0x9f,
-	0xde, 0xba, 0xe5, 0x82, 0x6a, 0xfd, 0x82, 0xbf, 0xcf, 0x0e, 0x61, 0xda, 0x93, 0xf5, 0x05, 0x7f,
-	0x4a, 0xe8, 0x7c, 0xc1, 0x9f, 0xd1, 0x6b, 0xbe, 0x76, 0xa0, 0xa0, 0xaf, 0xb4, 0xd0, 0xd5, 0x57,
-	0x56, 0x9f, 0x7a, 0xf1, 0x70, 0xc0, 0x80, 0x77, 0xa7, 0x93, 0x3e, 0x77, 0x78, 0xf1, 0x90, 0x15,
-	0xbb, 0xbf, 0x78, 0x30, 0x31, 0x94, 0xc7, 0xbf, 0x11, 0x7e, 0xa5, 0x0e, 0x3d, 0x60, 0x44, 0xc0,
-	0x36, 0xe1, 0x22, 0x39, 0x91, 0xa6, 0x7e, 0xb8, 0xb1, 0xe5, 0x5d, 0xeb, 0xe2, 0xb9, 0x90, 0x25,
-	0x67, 0xd0, 0x98, 0x27, 0x52, 0x5b, 0x74, 0xbd, 0x59, 0x26, 0x39, 0x6d, 0xb5, 0x50, 0xa7, 0xd5,
-	0xc3, 0x5a, 0xad, 0x14, 0x43, 0x4b, 0x20, 0x0d, 0x68, 0xf5, 0x69, 0xd0, 0xd1, 0x42, 0xd2, 0x8a,
-	0xf5, 0x9e, 0x66, 0xb4, 0xae, 0x09, 0xc4, 0x88, 0xd0, 0xae, 0x29, 0xf4, 0x6b, 0x97, 0x7d, 0xca,
-	0x69, 0x8b, 0x06, 0x93, 0xb4, 0x37, 0xfe, 0x73, 0xc8, 0xfa, 0x9a, 0x62, 0x36, 0xc6, 0xf5, 0x9a,
-	0xe2, 0x22, 0x9a, 0x76, 0x7f, 0x75, 0x37, 0xea, 0x90, 0x32, 0xf7, 0x57, 0x39, 0x7a, 0xd7, 0xfb,
-	0xab, 0x5c, 0x8c, 0x34, 0xbb, 0x1a, 0x9d, 0x9c, 0xfa, 0x95, 0xfb, 0xa7, 0x7e, 0xe5, 0xc1, 0xa9,
-	0x8f, 0x3e, 0x1d, 0xf9, 0xe8, 0x87, 0x91, 0x8f, 0xfe, 0x1a, 0xf9, 0xe8, 0x64, 0xe4, 0xa3, 0x7f,
-	0x47, 0x3e, 0xfa, 0x6f, 0xe4, 0x57, 0x1e, 0x8c, 0x7c, 0xf4, 0xe5, 0x99, 0x5f, 0x39, 0x39, 0xf3,
-	0x2b, 0xf7, 0xcf, 0xfc, 0xca, 0xfb, 0x37, 0x0e, 0xc3, 0x73, 0x07, 0x34, 0x9c, 0xf9, 0x16, 0xfc,
-	0xa6, 0xfe, 0x49, 0xeb, 0xa1, 0xc9, 0x4b, 0xf0, 0xab, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x2d,
-	0x24, 0xcb, 0xbd, 0xa0, 0x1f, 0x00, 0x00,
+	// 1387 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x9a, 0xcd, 0x6b, 0x24, 0x45,
+	0x18, 0xc6, 0xa7, 0x2e, 0x22, 0x85, 0xae, 0xda, 0x8a, 0x1f, 0xab, 0x36, 0xa2, 0xe8, 0x71, 0xc2,
+	0xee, 0x82, 0xee, 0xa7, 0x6b, 0x32, 0x49, 0x3a, 0xd9, 0xcd, 0xb8, 0xc9, 0x4c, 0x12, 0xc1, 0x8b,
+	0xf4, 0xcc, 0xbc, 0xc9, 0x14, 0xe9, 0x74, 0xb7, 0x5d, 0x35, 0xa3, 0x73, 0x10, 0x04, 0x4f, 0x82,
+	0xa0, 0x08, 0x82, 0x27, 0xc1, 0x93, 0x22, 0x08, 0x82, 0x20, 0x08, 0x82, 0x27, 0xc1, 0x93, 0xe4,
+	0xe6, 0x1e, 0xcd, 0xe4, 0xe2, 0x71, 0xfd, 0x0f, 0x64, 0xa6, 0xa7, 0x2a, 0x53, 0xdd, 0xd5, 0x33,
+	0x55, 0xd5, 0x73, 0x11, 0x37, 0x53, 0xcf, 0xaf, 0x9f, 0xfa, 0xc8, 0x5b, 0x4f, 0xbf, 0x13, 0x7c,
+	0x85, 0xc1, 0x71, 0x1c, 0x25, 0x7e, 0xb0, 0x44, 0x21, 0xe9, 0x43, 0xb2, 0xe4, 0xc7, 0x64, 0xa9,
+	0x4b, 0x28, 0x8b, 0x92, 0xc1, 0xe8, 0x27, 0xa4, 0x0d, 0x4b, 0xfd, 0x4b, 0x4b, 0x93, 0xff, 0xad,
+	0xc6, 0x49, 0xc4, 0x22, 0xe7, 0x55, 0x2e, 0xaa, 0xa6, 0xa2, 0xaa, 0x1f, 0x93, 0xaa, 0x2c, 0xaa,
+	0xf6, 0x2f, 0x5d, 0xbc, 0xa9, 0xc7, 0x4e, 0xe0, 0xfd, 0x1e, 0x50, 0xf6, 0x5e, 0x02, 0x34, 0x8e,
+	0x42, 0x3a, 0x79, 0xc8, 0xe5, 0xff, 0x3c, 0x7c, 0x61, 0x23, 0x1d, 0xdc, 0x4c, 0x07, 0x3b, 0xdf,
+	0x21, 0xfc, 0x74, 0x93, 0xf9, 0x09, 0x7b, 0x27, 0x4a, 0x8e, 0x0e, 0x82, 0xe8, 0x83, 0xb5, 0x0f,
+	0xa1, 0xdd, 0x63, 0x24, 0x0a, 0x9d, 0xd5, 0xaa, 0x96, 0xa7, 0xaa, 0x5a, 0xde, 0x48, 0x2d, 0x5c,
+	0x5c, 0x2b, 0x49, 0x49, 0x27, 0xf0, 0x72, 0xc5, 0xf9, 0x12, 0xe1, 0xc7, 0x3c, 0x60, 0xf5, 0x1e,
+	0xf3, 0x5b, 0x01, 0x34, 0x99, 0xcf, 0xc0, 0xb9, 0xa5, 0x09, 0xcf, 0xe8, 0xb8, 0xb7, 0x37, 0x6d,
+	0xe5, 0xc2, 0xd4, 0x57, 0x08, 0x3f, 0xbe, 0x1d, 0x05, 0x81, 0xe4, 0x4a, 0x17, 0x9b, 0x15, 0x72,
+	0x5b, 0xb7, 0xad, 0xf5, 0xc2, 0xd7, 0xb7, 0x08, 0x3f, 0xd5, 0x00, 0x0a, 0xac, 0xc9, 0x48, 0xfb,
+	0x68, 0xb0, 0xeb, 0xd3, 0xa3, 0x9d, 0x1e, 0xf4, 0xc0, 0x59, 0xd1, 0x64, 0xab, 0xc4, 0xdc, 0x5f,
+	0xad, 0x14, 0x43, 0x78, 0xfc, 0x09, 0xe1, 0xe7, 0x1a, 0xd0, 0x8e, 0x92, 0x0e, 0xdf, 0xf6, 0xd1,
+	0xa8, 0xf1, 0x39, 0x80, 0x8e, 0xe3, 0x69, 0x3f, 0xa4, 0x80, 0xc0, 0xdd, 0x6e, 0x94, 0x07, 0x29,
+	0x2c, 0x2f, 0xb7, 0x19, 0xe9, 0x13, 0x36, 0xb0, 0xb7, 0xac, 0x20, 0xd8, 0x59, 0x56, 0x82, 0x84,
+	0xe5, 0x5f, 0x11, 0x7e, 0x21, 0xfd, 0xa7, 0x34, 0xb7, 0x5a, 0x74, 0x1c, 0x07, 0x30, 0x72, 0x7d,
+	0x47, 0x7f, 0x37, 0x0b, 0x21, 0xdc, 0xf8, 0xdd, 0x85, 0xb0, 0x32, 0xcb, 0x9d, 0x1b, 0xba, 0xee,
+	0x93, 0xc0, 0x68, 0xb9, 0x0b, 0x08, 0xe6, 0xcb, 0x5d, 0x08, 0x12, 0x96, 0x7f, 0x41, 0xf8, 0xf9,
+	0xfc, 0xb6, 0x6c, 0x80, 0x9f, 0xb0, 0x16, 0xf8, 0xcc, 0xd9, 0xb4, 0xde, 0x5a, 0xc1, 0xe0, 0xb6,
+	0xef, 0x2c, 0x02, 0xa5, 0x3a, 0x27, 0xd3, 0x43, 0xad, 0xcf, 0x89, 0x12, 0x62, 0x79, 0x4e, 0x0a,
+	0x58, 0xaa, 0x73, 0x32, 0x3d, 0xd4, 0xee, 0x9c, 0xe4, 0x09, 0x96, 0xe7, 0x44, 0x05, 0xca, 0x9c,
+	0x93, 0xfc, 0xec, 0xfc, 0xb0, 0x0d, 0x23, 0xd3, 0x9b, 0x25, 0x56, 0x68, 0xc2, 0x30, 0x3f, 0x27,
+	0x33, 0x50, 0xc2, 0xf8, 0x0f, 0x08, 0x3f, 0xd3, 0x24, 0x87, 0xa1, 0x1f, 0xe4, 0x13, 0x83, 0xf6,
+	0x5d, 0xaf, 0xd6, 0x73, 0xc3, 0xeb, 0x65, 0x31, 0xc2, 0xec, 0x1f, 0x08, 0xbf, 0x34, 0x19, 0x45,
+	0x58, 0xb7, 0x20, 0xe7, 0xbc, 0x6d, 0xf6, 0xb8, 0x42, 0x10, 0xb7, 0x7f, 0x6f, 0x61, 0x3c, 0x31,
+	0x8f, 0x1f, 0x11, 0x7e, 0xb6, 0x01, 0xc7, 0x51, 0x1f, 0x52, 0x91, 0x14, 0x37, 0xd6, 0xb5, 0xf7,
+	0x57, 0x0d, 0xe0, 0xbe, 0xbd, 0xd2, 0x1c, 0xe1, 0xf7, 0x67, 0x84, 0x2f, 0xee, 0x42, 0x72, 0x4c,
+	0x42, 0x9f, 0x41, 0x7e, 0xc5, 0x75, 0x7f, 0x91, 0x8a, 0x11, 0xdc, 0xf3, 0xe6, 0x02, 0x48, 0xd2,
+	0xd1, 0x5e, 0x85, 0x00, 0x18, 0xd8, 0x1f, 0xed, 0x02, 0xbd, 0xe9, 0xd1, 0x2e, 0xc4, 0x08, 0xb3,
+	0xa3, 0xe0, 0x3e, 0x0e, 0x58, 0xf6, 0xc1, 0x5d, 0x2d, 0x37, 0x0d, 0xee, 0x45, 0x14, 0xe1, 0xf4,
+	0x77, 0x84, 0xdd, 0x09, 0x34, 0xad, 0x27, 0x79, 0xc7, 0x5b, 0xda, 0xcf, 0x9a, 0x85, 0xe1, 0xce,
+	0xeb, 0x0b, 0xa2, 0x49, 0x69, 0xba, 0xd9, 0xee, 0x42, 0xa7, 0x17, 0xc0, 0xf4, 0xed, 0xaf, 0x9d,
+	0xa6, 0x55, 0x62, 0xd3, 0x34, 0xad, 0x66, 0x48, 0xa5, 0x6e, 0x1f, 0x12, 0x72, 0x30, 0x58, 0x27,
+	0x09, 0x65, 0x52, 0x8e, 0x9d, 0x28, 0x3b, 0xda, 0xa5, 0x6e, 0x1e, 0xc8, 0xb4, 0xd4, 0xcd, 0xe7,
+	0x89, 0x79, 0xfc, 0x86, 0xf0, 0x8b, 0x69, 0x62, 0xa9, 0x75, 0x49, 0xd0, 0x11, 0xdb, 0x71, 0x1e,
+	0x44, 0xee, 0x1a, 0xe5, 0x9e, 0x02, 0x0a, 0x9f, 0xc1, 0xd6, 0x62, 0x60, 0xc2, 0xfe, 0xdf, 0x08,
+	0xbf, 0x96, 0xce, 0x56, 0x39, 0x76, 0x7c, 0xae, 0x46, 0x24, 0xe8, 0x38, 0xbb, 0x46, 0x8b, 0x37,
+	0x0f, 0xc7, 0x27, 0xb4, 0xb7, 0x60, 0xaa, 0x14, 0xb2, 0x56, 0x81, 0xb6, 0x13, 0xd2, 0x52, 0xd4,
+	0x47, 0x4f, 0xbb, 0xb0, 0x15, 0x10, 0x4c, 0x43, 0xd6, 0x0c, 0x90, 0xb0, 0xfc, 0x35, 0xc2, 0x4f,
+	0x34, 0x20, 0x0e, 0x48, 0xdb, 0x67, 0xb0, 0xd6, 0x87, 0x90, 0xd1, 0xfd, 0xcb, 0xce, 0x6d, 0xed,
+	0x2d, 0xcf, 0x28, 0xb9, 0xc5, 0xb7, 0xec, 0x01, 0x99, 0xf2, 0x3d, 0xf9, 0x9c, 0xcf, 0x21, 0xbd,
+	0xcf, 0x57, 0x4d, 0xf1, 0x92, 0xdc, 0xbc, 0x7c, 0xab, 0x29, 0x52, 0xdf, 0xa5, 0x39, 0x08, 0xdb,
+	0xcd, 0xae, 0x9f, 0x74, 0x46, 0x1f, 0xf6, 0xa8, 0x76, 0xdf, 0x25, 0xa3, 0x33, 0xed, 0xbb, 0xe4,
+	0xe4, 0xc2, 0xd4, 0xa7, 0x08, 0x3f, 0x32, 0xfa, 0x94, 0x87, 0x55, 0xe7, 0xba, 0x01, 0x92, 0x8b,
+	0xb8, 0x9d, 0x1b, 0x56, 0x5a, 0xe9, 0x76, 0xe0, 0xa7, 0x51, 0x0a, 0x66, 0x2b, 0x86, 0x47, 0x59,
+	0x15, 0xca, 0x6a, 0xa5, 0x18, 0xc2, 0xe3, 0x37, 0x08, 0x3f, 0xc9, 0x87, 0x4c, 0x3a, 0x80, 0x1b,
+	0x11, 0x65, 0xce, 0xb2, 0x21, 0x7e, 0x4a, 0xcb, 0x1d, 0xae, 0x94, 0x41, 0x08, 0x83, 0x9f, 0x20,
+	0x8c, 0x6b, 0x41, 0x44, 0x61, 0xbc, 0xdf, 0xce, 0x55, 0x4d, 0xe8, 0xb9, 0x84, 0xdb, 0xb9, 0x66,
+	0xa1, 0x14, 0x2e, 0x3e, 0xc2, 0x0f, 0x7b, 0xc0, 0x52, 0x0b, 0xaf, 0xeb, 0x37, 0x07, 0x25, 0x03,
+	0x6f, 0x18, 0xeb, 0xa4, 0x45, 0x48, 0xd3, 0xf5, 0x38, 0x5d, 0x5c, 0x35, 0x0a, 0xe4, 0xd3, 0x99,
+	0xe2, 0x9a, 0x85, 0x52, 0x2a, 0x4d, 0x1e, 0x30, 0x5e, 0x18, 0x48, 0x14, 0xd6, 0x81, 0x52, 0xff,
+	0x10, 0xa8, 0x76, 0x69, 0x52, 0xcb, 0x4d, 0x4b, 0x53, 0x11, 0x45, 0xba, 0x92, 0x3c, 0x60, 0xab,
+	0x5b, 0x3b, 0x2a, 0xb3, 0x9e, 0xfe, 0x63, 0xd4, 0x04, 0xd3, 0x2b, 0x69, 0x06, 0x48, 0x58, 0xfe,
+	0x0c, 0xe1, 0x47, 0x77, 0x7a, 0x90, 0x0c, 0x78, 0xb9, 0x75, 0x74, 0xab, 0x8f, 0xa4, 0xe2, 0xd6,
+	0x6e, 0xda, 0x89, 0x25, 0x3b, 0x0d, 0xf0, 0xe3, 0x38, 0x18, 0xa4, 0x97, 0x94, 0xb6, 0x1d, 0x49,
+	0x65, 0x6a, 0x27, 0x23, 0x16, 0x76, 0x3e, 0x47, 0xf8, 0x42, 0xba, 0x8a, 0x62, 0x17, 0x6f, 0x1a,
+	0x2d, 0x7e, 0x76, 0xeb, 0x6e, 0x59, 0xaa, 0xe5, 0x06, 0x7f, 0x2f, 0x39, 0x84, 0x69, 0x4f, 0xda,
+	0x0d, 0xfe, 0x8c, 0xd0, 0xb8, 0xc1, 0x9f, 0xd3, 0x4b, 0xbe, 0xea, 0x60, 0xe9, 0xab, 0x0e, 0xe5,
+	0x7c, 0xd5, 0xa1, 0xd0, 0x57, 0xfa, 0xc5, 0xc3, 0x41, 0x02, 0xb4, 0x3b, 0x9d, 0xf4, 0xa9, 0xc1,
+	0x17, 0x0f, 0x79, 0xb1, 0xf9, 0x17, 0x0f, 0x2a, 0x86, 0xf0, 0xf8, 0x17, 0xc2, 0xaf, 0x78, 0x10,
+	0x42, 0xe2, 0x33, 0xd8, 0xf2, 0x29, 0x9b, 0xdc, 0x48, 0x53, 0xbf, 0xb8, 0xa9, 0xe5, 0x1d, 0xed,
+	0xc3, 0x33, 0x97, 0xc5, 0x67, 0xd0, 0x58, 0x24, 0x52, 0x5a, 0x74, 0xb9, 0x58, 0x4e, 0x72, 0xda,
+	0x8a, 0x55, 0xa5, 0x95, 0xc3, 0x5a, 0xad, 0x14, 0x43, 0x4a, 0x20, 0x0d, 0x68, 0xf5, 0x48, 0xd0,
+	0x91, 0x42, 0xd2, 0xb2, 0xf6, 0x9e, 0xe6, 0xb4, 0xa6, 0x09, 0x44, 0x89, 0x90, 0xda, 0x14, 0x72,
+	0xdb, 0x65, 0x9f, 0x50, 0xd2, 0x22, 0xc1, 0x38, 0xed, 0x8d, 0x5e, 0x87, 0xb4, 0xdb, 0x14, 0xb3,
+	0x31, 0xa6, 0x6d, 0x8a, 0x79, 0x34, 0xa9, 0x7f, 0xb5, 0x17, 0x77, 0xfc, 0x32, 0xfd, 0xab, 0x02,
+	0xbd, 0x69, 0xff, 0xaa, 0x10, 0x93, 0x79, 0x37, 0xa3, 0x51, 0xd0, 0x87, 0x71, 0x07, 0x69, 0x3b,
+	0x22, 0x21, 0x33, 0x78, 0x37, 0xcb, 0x28, 0xcd, 0xdf, 0xcd, 0x72, 0x00, 0x29, 0x00, 0x6d, 0xfb,
+	0x3d, 0x0a, 0xf6, 0xad, 0x35, 0xb5, 0xdc, 0x34, 0x00, 0x15, 0x51, 0xa4, 0xbe, 0xf0, 0x5e, 0x18,
+	0xab, 0xbd, 0x6a, 0xef, 0x55, 0x18, 0xcf, 0x74, 0xeb, 0x95, 0xe6, 0xe4, 0x57, 0x96, 0xbf, 0x46,
+	0x59, 0xae, 0x6c, 0x4e, 0x6e, 0xb5, 0xb2, 0x0a, 0x8a, 0x6a, 0x65, 0xf3, 0x5e, 0x0d, 0x57, 0xb6,
+	0xd0, 0xad, 0x57, 0x9a, 0x93, 0x6f, 0x07, 0xdb, 0xaf, 0xac, 0x5a, 0x6e, 0xd5, 0x0e, 0x9e, 0xe5,
+	0x74, 0x54, 0x67, 0xd3, 0xf2, 0x90, 0x1b, 0x75, 0x2f, 0x1e, 0xfd, 0x97, 0x6a, 0xd7, 0xd9, 0xd9,
+	0x18, 0xd3, 0x3a, 0x3b, 0x8f, 0x26, 0xd5, 0x59, 0x7e, 0x41, 0x9f, 0x97, 0xe3, 0x34, 0x33, 0xac,
+	0x19, 0x5e, 0xf0, 0x19, 0xbd, 0x69, 0x9d, 0x2d, 0xc4, 0x70, 0xb3, 0x2b, 0xf1, 0xc9, 0xa9, 0x5b,
+	0xb9, 0x7f, 0xea, 0x56, 0x1e, 0x9c, 0xba, 0xe8, 0xe3, 0xa1, 0x8b, 0xbe, 0x1f, 0xba, 0xe8, 0xcf,
+	0xa1, 0x8b, 0x4e, 0x86, 0x2e, 0xfa, 0x67, 0xe8, 0xa2, 0x7f, 0x87, 0x6e, 0xe5, 0xc1, 0xd0, 0x45,
+	0x5f, 0x9c, 0xb9, 0x95, 0x93, 0x33, 0xb7, 0x72, 0xff, 0xcc, 0xad, 0xbc, 0x7b, 0xfd, 0x30, 0x3a,
+	0x77, 0x40, 0xa2, 0x99, 0x7f, 0x6d, 0x74, 0x43, 0xfe, 0x49, 0xeb, 0xa1, 0xf1, 0x1f, 0x1b, 0x5d,
+	0xf9, 0x7f, 0x00, 0x7f, 0x36, 0x97, 0x23, 0x08, 0x25, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -311,6 +319,29 @@ type HistoryServiceClient interface {
 	// (-- api-linter: core::0134=disabled
 	//     aip.dev/not-precedent: This service does not follow the update method API --)
 	UpdateWorkflowExecution(ctx context.Context, in *UpdateWorkflowExecutionRequest, opts ...grpc.CallOption) (*UpdateWorkflowExecutionResponse, error)
+	// ResolveResetPoint finds the workflow task finish event to reset a workflow execution to,
+	// according to the given selector.
+	ResolveResetPoint(ctx context.Context, in *ResolveResetPointRequest, opts ...grpc.CallOption) (*ResolveResetPointResponse, error)
+	// PauseWorkflowExecution stops dispatching workflow and activity tasks of a running workflow
+	// execution and holds its timers, without terminating it.
+	PauseWorkflowExecution(ctx context.Context, in *PauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*PauseWorkflowExecutionResponse, error)
+	// UnpauseWorkflowExecution resumes a paused workflow execution. Tasks skipped while it was paused
+	// are regenerated from mutable state.
+	UnpauseWorkflowExecution(ctx context.Context, in *UnpauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*UnpauseWorkflowExecutionResponse, error)
+	// PauseActivityExecution stops dispatching a pending activity, including its retries, until it is
+	// unpaused. An attempt that is already running is allowed to complete.
+	PauseActivityExecution(ctx context.Context, in *PauseActivityExecutionRequest, opts ...grpc.CallOption) (*PauseActivityExecutionResponse, error)
+	// UnpauseActivityExecution resumes dispatching a paused activity.
+	UnpauseActivityExecution(ctx context.Context, in *UnpauseActivityExecutionRequest, opts ...grpc.CallOption) (*UnpauseActivityExecutionResponse, error)
+	// ResetActivityExecution resets the attempt count of a pending activity. An activity waiting for
+	// its next retry is dispatched right away.
+	ResetActivityExecution(ctx context.Context, in *ResetActivityExecutionRequest, opts ...grpc.CallOption) (*ResetActivityExecutionResponse, error)
+	// UpdateActivityExecutionOptions updates the retry policy and timeouts of a pending activity.
+	UpdateActivityExecutionOptions(ctx context.Context, in *UpdateActivityExecutionOptionsRequest, opts ...grpc.CallOption) (*UpdateActivityExecutionOptionsResponse, error)
+	// GenerateVisibilityTasks re-enqueues the visibility tasks that write a workflow's current state to
+	// visibility: start and upsert tasks for a running workflow, a close task for a closed one.
+	// This is used by the visibility scanner to repair records that drifted from mutable state.
+	GenerateVisibilityTasks(ctx context.Context, in *GenerateVisibilityTasksRequest, opts ...grpc.CallOption) (*GenerateVisibilityTasksResponse, error)
 }
 
 type historyServiceClient struct {
@@ -735,6 +766,78 @@ func (c *historyServiceClient) UpdateWorkflowExecution(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *historyServiceClient) ResolveResetPoint(ctx context.Context, in *ResolveResetPointRequest, opts ...grpc.CallOption) (*ResolveResetPointResponse, error) {
+	out := new(ResolveResetPointResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/ResolveResetPoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) PauseWorkflowExecution(ctx context.Context, in *PauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*PauseWorkflowExecutionResponse, error) {
+	out := new(PauseWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/PauseWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) UnpauseWorkflowExecution(ctx context.Context, in *UnpauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*UnpauseWorkflowExecutionResponse, error) {
+	out := new(UnpauseWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/UnpauseWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) PauseActivityExecution(ctx context.Context, in *PauseActivityExecutionRequest, opts ...grpc.CallOption) (*PauseActivityExecutionResponse, error) {
+	out := new(PauseActivityExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/PauseActivityExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) UnpauseActivityExecution(ctx context.Context, in *UnpauseActivityExecutionRequest, opts ...grpc.CallOption) (*UnpauseActivityExecutionResponse, error) {
+	out := new(UnpauseActivityExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/UnpauseActivityExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) ResetActivityExecution(ctx context.Context, in *ResetActivityExecutionRequest, opts ...grpc.CallOption) (*ResetActivityExecutionResponse, error) {
+	out := new(ResetActivityExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/ResetActivityExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) UpdateActivityExecutionOptions(ctx context.Context, in *UpdateActivityExecutionOptionsRequest, opts ...grpc.CallOption) (*UpdateActivityExecutionOptionsResponse, error) {
+	out := new(UpdateActivityExecutionOptionsResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/UpdateActivityExecutionOptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) GenerateVisibilityTasks(ctx context.Context, in *GenerateVisibilityTasksRequest, opts ...grpc.CallOption) (*GenerateVisibilityTasksResponse, error) {
+	out := new(GenerateVisibilityTasksResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/GenerateVisibilityTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HistoryServiceServer is the server API for HistoryService service.
 type HistoryServiceServer interface {
 	// StartWorkflowExecution starts a new long running workflow instance.  It will create the instance with
@@ -900,6 +1003,29 @@ type HistoryServiceServer interface {
 	// (-- api-linter: core::0134=disabled
 	//     aip.dev/not-precedent: This service does not follow the update method API --)
 	UpdateWorkflowExecution(context.Context, *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error)
+	// ResolveResetPoint finds the workflow task finish event to reset a workflow execution to,
+	// according to the given selector.
+	ResolveResetPoint(context.Context, *ResolveResetPointRequest) (*ResolveResetPointResponse, error)
+	// PauseWorkflowExecution stops dispatching workflow and activity tasks of a running workflow
+	// execution and holds its timers, without terminating it.
+	PauseWorkflowExecution(context.Context, *PauseWorkflowExecutionRequest) (*PauseWorkflowExecutionResponse, error)
+	// UnpauseWorkflowExecution resumes a paused workflow execution. Tasks skipped while it was paused
+	// are regenerated from mutable state.
+	UnpauseWorkflowExecution(context.Context, *UnpauseWorkflowExecutionRequest) (*UnpauseWorkflowExecutionResponse, error)
+	// PauseActivityExecution stops dispatching a pending activity, including its retries, until it is
+	// unpaused. An attempt that is already running is allowed to complete.
+	PauseActivityExecution(context.Context, *PauseActivityExecutionRequest) (*PauseActivityExecutionResponse, error)
+	// UnpauseActivityExecution resumes dispatching a paused activity.
+	UnpauseActivityExecution(context.Context, *UnpauseActivityExecutionRequest) (*UnpauseActivityExecutionResponse, error)
+	// ResetActivityExecution resets the attempt count of a pending activity. An activity waiting for
+	// its next retry is dispatched right away.
+	ResetActivityExecution(context.Context, *ResetActivityExecutionRequest) (*ResetActivityExecutionResponse, error)
+	// UpdateActivityExecutionOptions updates the retry policy and timeouts of a pending activity.
+	UpdateActivityExecutionOptions(context.Context, *UpdateActivityExecutionOptionsRequest) (*UpdateActivityExecutionOptionsResponse, error)
+	// GenerateVisibilityTasks re-enqueues the visibility tasks that write a workflow's current state to
+	// visibility: start and upsert tasks for a running workflow, a close task for a closed one.
+	// This is used by the visibility scanner to repair records that drifted from mutable state.
+	GenerateVisibilityTasks(context.Context, *GenerateVisibilityTasksRequest) (*GenerateVisibilityTasksResponse, error)
 }
 
 // UnimplementedHistoryServiceServer can be embedded to have forward compatible implementations.
@@ -1044,6 +1170,30 @@ func (*UnimplementedHistoryServiceServer) DeleteWorkflowVisibilityRecord(ctx con
 func (*UnimplementedHistoryServiceServer) UpdateWorkflowExecution(ctx context.Context, req *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkflowExecution not implemented")
 }
+func (*UnimplementedHistoryServiceServer) ResolveResetPoint(ctx context.Context, req *ResolveResetPointRequest) (*ResolveResetPointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveResetPoint not implemented")
+}
+func (*UnimplementedHistoryServiceServer) PauseWorkflowExecution(ctx context.Context, req *PauseWorkflowExecutionRequest) (*PauseWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseWorkflowExecution not implemented")
+}
+func (*UnimplementedHistoryServiceServer) UnpauseWorkflowExecution(ctx context.Context, req *UnpauseWorkflowExecutionRequest) (*UnpauseWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseWorkflowExecution not implemented")
+}
+func (*UnimplementedHistoryServiceServer) PauseActivityExecution(ctx context.Context, req *PauseActivityExecutionRequest) (*PauseActivityExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseActivityExecution not implemented")
+}
+func (*UnimplementedHistoryServiceServer) UnpauseActivityExecution(ctx context.Context, req *UnpauseActivityExecutionRequest) (*UnpauseActivityExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseActivityExecution not implemented")
+}
+func (*UnimplementedHistoryServiceServer) ResetActivityExecution(ctx context.Context, req *ResetActivityExecutionRequest) (*ResetActivityExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetActivityExecution not implemented")
+}
+func (*UnimplementedHistoryServiceServer) UpdateActivityExecutionOptions(ctx context.Context, req *UpdateActivityExecutionOptionsRequest) (*UpdateActivityExecutionOptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateActivityExecutionOptions not implemented")
+}
+func (*UnimplementedHistoryServiceServer) GenerateVisibilityTasks(ctx context.Context, req *GenerateVisibilityTasksRequest) (*GenerateVisibilityTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateVisibilityTasks not implemented")
+}
 
 func RegisterHistoryServiceServer(s *grpc.Server, srv HistoryServiceServer) {
 	s.RegisterService(&_HistoryService_serviceDesc, srv)
@@ -1877,6 +2027,150 @@ func _HistoryService_UpdateWorkflowExecution_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_ResolveResetPoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveResetPointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).ResolveResetPoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/ResolveResetPoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).ResolveResetPoint(ctx, req.(*ResolveResetPointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_PauseWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).PauseWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/PauseWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).PauseWorkflowExecution(ctx, req.(*PauseWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_UnpauseWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpauseWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).UnpauseWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/UnpauseWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).UnpauseWorkflowExecution(ctx, req.(*UnpauseWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_PauseActivityExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseActivityExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).PauseActivityExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/PauseActivityExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).PauseActivityExecution(ctx, req.(*PauseActivityExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_UnpauseActivityExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpauseActivityExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).UnpauseActivityExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/UnpauseActivityExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).UnpauseActivityExecution(ctx, req.(*UnpauseActivityExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_ResetActivityExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetActivityExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).ResetActivityExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/ResetActivityExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).ResetActivityExecution(ctx, req.(*ResetActivityExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_UpdateActivityExecutionOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateActivityExecutionOptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).UpdateActivityExecutionOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/UpdateActivityExecutionOptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).UpdateActivityExecutionOptions(ctx, req.(*UpdateActivityExecutionOptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_GenerateVisibilityTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateVisibilityTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).GenerateVisibilityTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/GenerateVisibilityTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).GenerateVisibilityTasks(ctx, req.(*GenerateVisibilityTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _HistoryService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "temporal.server.api.historyservice.v1.HistoryService",
 	HandlerType: (*HistoryServiceServer)(nil),
@@ -2065,6 +2359,38 @@ var _HistoryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateWorkflowExecution",
 			Handler:    _HistoryService_UpdateWorkflowExecution_Handler,
 		},
+		{
+			MethodName: "ResolveResetPoint",
+			Handler:    _HistoryService_ResolveResetPoint_Handler,
+		},
+		{
+			MethodName: "PauseWorkflowExecution",
+			Handler:    _HistoryService_PauseWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "UnpauseWorkflowExecution",
+			Handler:    _HistoryService_UnpauseWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "PauseActivityExecution",
+			Handler:    _HistoryService_PauseActivityExecution_Handler,
+		},
+		{
+			MethodName: "UnpauseActivityExecution",
+			Handler:    _HistoryService_UnpauseActivityExecution_Handler,
+		},
+		{
+			MethodName: "ResetActivityExecution",
+			Handler:    _HistoryService_ResetActivityExecution_Handler,
+		},
+		{
+			MethodName: "UpdateActivityExecutionOptions",
+			Handler:    _HistoryService_UpdateActivityExecutionOptions_Handler,
+		},
+		{
+			MethodName: "GenerateVisibilityTasks",
+			Handler:    _HistoryService_GenerateVisibilityTasks_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "temporal/server/api/historyservice/v1/service.proto",
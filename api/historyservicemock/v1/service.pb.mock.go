@@ -200,6 +200,26 @@ func (mr *MockHistoryServiceClientMockRecorder) GenerateLastHistoryReplicationTa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateLastHistoryReplicationTasks", reflect.TypeOf((*MockHistoryServiceClient)(nil).GenerateLastHistoryReplicationTasks), varargs...)
 }
 
+// GenerateVisibilityTasks mocks base method.
+func (m *MockHistoryServiceClient) GenerateVisibilityTasks(ctx context.Context, in *historyservice.GenerateVisibilityTasksRequest, opts ...grpc.CallOption) (*historyservice.GenerateVisibilityTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GenerateVisibilityTasks", varargs...)
+	ret0, _ := ret[0].(*historyservice.GenerateVisibilityTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateVisibilityTasks indicates an expected call of GenerateVisibilityTasks.
+func (mr *MockHistoryServiceClientMockRecorder) GenerateVisibilityTasks(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateVisibilityTasks", reflect.TypeOf((*MockHistoryServiceClient)(nil).GenerateVisibilityTasks), varargs...)
+}
+
 // GetDLQMessages mocks base method.
 func (m *MockHistoryServiceClient) GetDLQMessages(ctx context.Context, in *historyservice.GetDLQMessagesRequest, opts ...grpc.CallOption) (*historyservice.GetDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -340,6 +360,46 @@ func (mr *MockHistoryServiceClientMockRecorder) MergeDLQMessages(ctx, in interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessages", reflect.TypeOf((*MockHistoryServiceClient)(nil).MergeDLQMessages), varargs...)
 }
 
+// PauseActivityExecution mocks base method.
+func (m *MockHistoryServiceClient) PauseActivityExecution(ctx context.Context, in *historyservice.PauseActivityExecutionRequest, opts ...grpc.CallOption) (*historyservice.PauseActivityExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PauseActivityExecution", varargs...)
+	ret0, _ := ret[0].(*historyservice.PauseActivityExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseActivityExecution indicates an expected call of PauseActivityExecution.
+func (mr *MockHistoryServiceClientMockRecorder) PauseActivityExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseActivityExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).PauseActivityExecution), varargs...)
+}
+
+// PauseWorkflowExecution mocks base method.
+func (m *MockHistoryServiceClient) PauseWorkflowExecution(ctx context.Context, in *historyservice.PauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.PauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*historyservice.PauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseWorkflowExecution indicates an expected call of PauseWorkflowExecution.
+func (mr *MockHistoryServiceClientMockRecorder) PauseWorkflowExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkflowExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).PauseWorkflowExecution), varargs...)
+}
+
 // PollMutableState mocks base method.
 func (m *MockHistoryServiceClient) PollMutableState(ctx context.Context, in *historyservice.PollMutableStateRequest, opts ...grpc.CallOption) (*historyservice.PollMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -640,6 +700,26 @@ func (mr *MockHistoryServiceClientMockRecorder) RequestCancelWorkflowExecution(c
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestCancelWorkflowExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).RequestCancelWorkflowExecution), varargs...)
 }
 
+// ResetActivityExecution mocks base method.
+func (m *MockHistoryServiceClient) ResetActivityExecution(ctx context.Context, in *historyservice.ResetActivityExecutionRequest, opts ...grpc.CallOption) (*historyservice.ResetActivityExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResetActivityExecution", varargs...)
+	ret0, _ := ret[0].(*historyservice.ResetActivityExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetActivityExecution indicates an expected call of ResetActivityExecution.
+func (mr *MockHistoryServiceClientMockRecorder) ResetActivityExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetActivityExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).ResetActivityExecution), varargs...)
+}
+
 // ResetStickyTaskQueue mocks base method.
 func (m *MockHistoryServiceClient) ResetStickyTaskQueue(ctx context.Context, in *historyservice.ResetStickyTaskQueueRequest, opts ...grpc.CallOption) (*historyservice.ResetStickyTaskQueueResponse, error) {
 	m.ctrl.T.Helper()
@@ -680,6 +760,26 @@ func (mr *MockHistoryServiceClientMockRecorder) ResetWorkflowExecution(ctx, in i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetWorkflowExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).ResetWorkflowExecution), varargs...)
 }
 
+// ResolveResetPoint mocks base method.
+func (m *MockHistoryServiceClient) ResolveResetPoint(ctx context.Context, in *historyservice.ResolveResetPointRequest, opts ...grpc.CallOption) (*historyservice.ResolveResetPointResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResolveResetPoint", varargs...)
+	ret0, _ := ret[0].(*historyservice.ResolveResetPointResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveResetPoint indicates an expected call of ResolveResetPoint.
+func (mr *MockHistoryServiceClientMockRecorder) ResolveResetPoint(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveResetPoint", reflect.TypeOf((*MockHistoryServiceClient)(nil).ResolveResetPoint), varargs...)
+}
+
 // RespondActivityTaskCanceled mocks base method.
 func (m *MockHistoryServiceClient) RespondActivityTaskCanceled(ctx context.Context, in *historyservice.RespondActivityTaskCanceledRequest, opts ...grpc.CallOption) (*historyservice.RespondActivityTaskCanceledResponse, error) {
 	m.ctrl.T.Helper()
@@ -920,6 +1020,66 @@ func (mr *MockHistoryServiceClientMockRecorder) TerminateWorkflowExecution(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateWorkflowExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).TerminateWorkflowExecution), varargs...)
 }
 
+// UnpauseActivityExecution mocks base method.
+func (m *MockHistoryServiceClient) UnpauseActivityExecution(ctx context.Context, in *historyservice.UnpauseActivityExecutionRequest, opts ...grpc.CallOption) (*historyservice.UnpauseActivityExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnpauseActivityExecution", varargs...)
+	ret0, _ := ret[0].(*historyservice.UnpauseActivityExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseActivityExecution indicates an expected call of UnpauseActivityExecution.
+func (mr *MockHistoryServiceClientMockRecorder) UnpauseActivityExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseActivityExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).UnpauseActivityExecution), varargs...)
+}
+
+// UnpauseWorkflowExecution mocks base method.
+func (m *MockHistoryServiceClient) UnpauseWorkflowExecution(ctx context.Context, in *historyservice.UnpauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.UnpauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnpauseWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*historyservice.UnpauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseWorkflowExecution indicates an expected call of UnpauseWorkflowExecution.
+func (mr *MockHistoryServiceClientMockRecorder) UnpauseWorkflowExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).UnpauseWorkflowExecution), varargs...)
+}
+
+// UpdateActivityExecutionOptions mocks base method.
+func (m *MockHistoryServiceClient) UpdateActivityExecutionOptions(ctx context.Context, in *historyservice.UpdateActivityExecutionOptionsRequest, opts ...grpc.CallOption) (*historyservice.UpdateActivityExecutionOptionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateActivityExecutionOptions", varargs...)
+	ret0, _ := ret[0].(*historyservice.UpdateActivityExecutionOptionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateActivityExecutionOptions indicates an expected call of UpdateActivityExecutionOptions.
+func (mr *MockHistoryServiceClientMockRecorder) UpdateActivityExecutionOptions(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivityExecutionOptions", reflect.TypeOf((*MockHistoryServiceClient)(nil).UpdateActivityExecutionOptions), varargs...)
+}
+
 // UpdateWorkflowExecution mocks base method.
 func (m *MockHistoryServiceClient) UpdateWorkflowExecution(ctx context.Context, in *historyservice.UpdateWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.UpdateWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1108,6 +1268,21 @@ func (mr *MockHistoryServiceServerMockRecorder) GenerateLastHistoryReplicationTa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateLastHistoryReplicationTasks", reflect.TypeOf((*MockHistoryServiceServer)(nil).GenerateLastHistoryReplicationTasks), arg0, arg1)
 }
 
+// GenerateVisibilityTasks mocks base method.
+func (m *MockHistoryServiceServer) GenerateVisibilityTasks(arg0 context.Context, arg1 *historyservice.GenerateVisibilityTasksRequest) (*historyservice.GenerateVisibilityTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateVisibilityTasks", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.GenerateVisibilityTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateVisibilityTasks indicates an expected call of GenerateVisibilityTasks.
+func (mr *MockHistoryServiceServerMockRecorder) GenerateVisibilityTasks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateVisibilityTasks", reflect.TypeOf((*MockHistoryServiceServer)(nil).GenerateVisibilityTasks), arg0, arg1)
+}
+
 // GetDLQMessages mocks base method.
 func (m *MockHistoryServiceServer) GetDLQMessages(arg0 context.Context, arg1 *historyservice.GetDLQMessagesRequest) (*historyservice.GetDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1213,6 +1388,36 @@ func (mr *MockHistoryServiceServerMockRecorder) MergeDLQMessages(arg0, arg1 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessages", reflect.TypeOf((*MockHistoryServiceServer)(nil).MergeDLQMessages), arg0, arg1)
 }
 
+// PauseActivityExecution mocks base method.
+func (m *MockHistoryServiceServer) PauseActivityExecution(arg0 context.Context, arg1 *historyservice.PauseActivityExecutionRequest) (*historyservice.PauseActivityExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseActivityExecution", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.PauseActivityExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseActivityExecution indicates an expected call of PauseActivityExecution.
+func (mr *MockHistoryServiceServerMockRecorder) PauseActivityExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseActivityExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).PauseActivityExecution), arg0, arg1)
+}
+
+// PauseWorkflowExecution mocks base method.
+func (m *MockHistoryServiceServer) PauseWorkflowExecution(arg0 context.Context, arg1 *historyservice.PauseWorkflowExecutionRequest) (*historyservice.PauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.PauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseWorkflowExecution indicates an expected call of PauseWorkflowExecution.
+func (mr *MockHistoryServiceServerMockRecorder) PauseWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkflowExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).PauseWorkflowExecution), arg0, arg1)
+}
+
 // PollMutableState mocks base method.
 func (m *MockHistoryServiceServer) PollMutableState(arg0 context.Context, arg1 *historyservice.PollMutableStateRequest) (*historyservice.PollMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -1438,6 +1643,21 @@ func (mr *MockHistoryServiceServerMockRecorder) RequestCancelWorkflowExecution(a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestCancelWorkflowExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).RequestCancelWorkflowExecution), arg0, arg1)
 }
 
+// ResetActivityExecution mocks base method.
+func (m *MockHistoryServiceServer) ResetActivityExecution(arg0 context.Context, arg1 *historyservice.ResetActivityExecutionRequest) (*historyservice.ResetActivityExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetActivityExecution", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.ResetActivityExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetActivityExecution indicates an expected call of ResetActivityExecution.
+func (mr *MockHistoryServiceServerMockRecorder) ResetActivityExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetActivityExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).ResetActivityExecution), arg0, arg1)
+}
+
 // ResetStickyTaskQueue mocks base method.
 func (m *MockHistoryServiceServer) ResetStickyTaskQueue(arg0 context.Context, arg1 *historyservice.ResetStickyTaskQueueRequest) (*historyservice.ResetStickyTaskQueueResponse, error) {
 	m.ctrl.T.Helper()
@@ -1468,6 +1688,21 @@ func (mr *MockHistoryServiceServerMockRecorder) ResetWorkflowExecution(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetWorkflowExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).ResetWorkflowExecution), arg0, arg1)
 }
 
+// ResolveResetPoint mocks base method.
+func (m *MockHistoryServiceServer) ResolveResetPoint(arg0 context.Context, arg1 *historyservice.ResolveResetPointRequest) (*historyservice.ResolveResetPointResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveResetPoint", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.ResolveResetPointResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveResetPoint indicates an expected call of ResolveResetPoint.
+func (mr *MockHistoryServiceServerMockRecorder) ResolveResetPoint(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveResetPoint", reflect.TypeOf((*MockHistoryServiceServer)(nil).ResolveResetPoint), arg0, arg1)
+}
+
 // RespondActivityTaskCanceled mocks base method.
 func (m *MockHistoryServiceServer) RespondActivityTaskCanceled(arg0 context.Context, arg1 *historyservice.RespondActivityTaskCanceledRequest) (*historyservice.RespondActivityTaskCanceledResponse, error) {
 	m.ctrl.T.Helper()
@@ -1648,6 +1883,51 @@ func (mr *MockHistoryServiceServerMockRecorder) TerminateWorkflowExecution(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateWorkflowExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).TerminateWorkflowExecution), arg0, arg1)
 }
 
+// UnpauseActivityExecution mocks base method.
+func (m *MockHistoryServiceServer) UnpauseActivityExecution(arg0 context.Context, arg1 *historyservice.UnpauseActivityExecutionRequest) (*historyservice.UnpauseActivityExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseActivityExecution", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.UnpauseActivityExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseActivityExecution indicates an expected call of UnpauseActivityExecution.
+func (mr *MockHistoryServiceServerMockRecorder) UnpauseActivityExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseActivityExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).UnpauseActivityExecution), arg0, arg1)
+}
+
+// UnpauseWorkflowExecution mocks base method.
+func (m *MockHistoryServiceServer) UnpauseWorkflowExecution(arg0 context.Context, arg1 *historyservice.UnpauseWorkflowExecutionRequest) (*historyservice.UnpauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.UnpauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseWorkflowExecution indicates an expected call of UnpauseWorkflowExecution.
+func (mr *MockHistoryServiceServerMockRecorder) UnpauseWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).UnpauseWorkflowExecution), arg0, arg1)
+}
+
+// UpdateActivityExecutionOptions mocks base method.
+func (m *MockHistoryServiceServer) UpdateActivityExecutionOptions(arg0 context.Context, arg1 *historyservice.UpdateActivityExecutionOptionsRequest) (*historyservice.UpdateActivityExecutionOptionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateActivityExecutionOptions", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.UpdateActivityExecutionOptionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateActivityExecutionOptions indicates an expected call of UpdateActivityExecutionOptions.
+func (mr *MockHistoryServiceServerMockRecorder) UpdateActivityExecutionOptions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivityExecutionOptions", reflect.TypeOf((*MockHistoryServiceServer)(nil).UpdateActivityExecutionOptions), arg0, arg1)
+}
+
 // UpdateWorkflowExecution mocks base method.
 func (m *MockHistoryServiceServer) UpdateWorkflowExecution(arg0 context.Context, arg1 *historyservice.UpdateWorkflowExecutionRequest) (*historyservice.UpdateWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
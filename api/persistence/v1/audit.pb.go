@@ -0,0 +1,1191 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: temporal/server/api/persistence/v1/audit.proto
+
+package persistence
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+	time "time"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AuditRecord records a mutating API call. Records written by the same sink form a hash chain:
+// each record carries the digest of the record written before it.
+type AuditRecord struct {
+	Time *time.Time `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time,omitempty"`
+	// Subject of the caller claims, empty if the call was not authenticated.
+	Principal string `protobuf:"bytes,2,opt,name=principal,proto3" json:"principal,omitempty"`
+	// Full API name, such as "/temporal.api.workflowservice.v1.WorkflowService/TerminateWorkflowExecution".
+	ApiName    string `protobuf:"bytes,3,opt,name=api_name,json=apiName,proto3" json:"api_name,omitempty"`
+	Namespace  string `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	WorkflowId string `protobuf:"bytes,5,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	RunId      string `protobuf:"bytes,6,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	// Hex encoded SHA-256 digest of the serialized request.
+	RequestDigest string `protobuf:"bytes,7,opt,name=request_digest,json=requestDigest,proto3" json:"request_digest,omitempty"`
+	// Error returned to the caller, empty if the call succeeded.
+	Error string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	// Identifies the sink that wrote the record and so the hash chain it belongs to.
+	WriterId string `protobuf:"bytes,9,opt,name=writer_id,json=writerId,proto3" json:"writer_id,omitempty"`
+	// Hex encoded SHA-256 digest of the previous record of the same writer, empty for the first one.
+	PreviousRecordDigest string `protobuf:"bytes,10,opt,name=previous_record_digest,json=previousRecordDigest,proto3" json:"previous_record_digest,omitempty"`
+}
+
+func (m *AuditRecord) Reset()      { *m = AuditRecord{} }
+func (*AuditRecord) ProtoMessage() {}
+func (*AuditRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d04d596213ed7b5, []int{0}
+}
+func (m *AuditRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuditRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuditRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuditRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditRecord.Merge(m, src)
+}
+func (m *AuditRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuditRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditRecord proto.InternalMessageInfo
+
+func (m *AuditRecord) GetTime() *time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *AuditRecord) GetPrincipal() string {
+	if m != nil {
+		return m.Principal
+	}
+	return ""
+}
+
+func (m *AuditRecord) GetApiName() string {
+	if m != nil {
+		return m.ApiName
+	}
+	return ""
+}
+
+func (m *AuditRecord) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *AuditRecord) GetWorkflowId() string {
+	if m != nil {
+		return m.WorkflowId
+	}
+	return ""
+}
+
+func (m *AuditRecord) GetRunId() string {
+	if m != nil {
+		return m.RunId
+	}
+	return ""
+}
+
+func (m *AuditRecord) GetRequestDigest() string {
+	if m != nil {
+		return m.RequestDigest
+	}
+	return ""
+}
+
+func (m *AuditRecord) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *AuditRecord) GetWriterId() string {
+	if m != nil {
+		return m.WriterId
+	}
+	return ""
+}
+
+func (m *AuditRecord) GetPreviousRecordDigest() string {
+	if m != nil {
+		return m.PreviousRecordDigest
+	}
+	return ""
+}
+
+// AuditRecordBatch is a batch of audit records written by the same sink, stored as one queue message.
+type AuditRecordBatch struct {
+	Records []*AuditRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+}
+
+func (m *AuditRecordBatch) Reset()      { *m = AuditRecordBatch{} }
+func (*AuditRecordBatch) ProtoMessage() {}
+func (*AuditRecordBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d04d596213ed7b5, []int{1}
+}
+func (m *AuditRecordBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuditRecordBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuditRecordBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuditRecordBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditRecordBatch.Merge(m, src)
+}
+func (m *AuditRecordBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuditRecordBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditRecordBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditRecordBatch proto.InternalMessageInfo
+
+func (m *AuditRecordBatch) GetRecords() []*AuditRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*AuditRecord)(nil), "temporal.server.api.persistence.v1.AuditRecord")
+	proto.RegisterType((*AuditRecordBatch)(nil), "temporal.server.api.persistence.v1.AuditRecordBatch")
+}
+
+func init() {
+	proto.RegisterFile("temporal/server/api/persistence/v1/audit.proto", fileDescriptor_5d04d596213ed7b5)
+}
+
+var fileDescriptor_5d04d596213ed7b5 = []byte{
+	// 447 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0x3d, 0x8f, 0xd3, 0x3e,
+	0x18, 0x8f, 0xef, 0xfa, 0xea, 0xea, 0xff, 0x17, 0xb2, 0x0e, 0x14, 0x0a, 0x72, 0x4b, 0x25, 0xa4,
+	0x4e, 0x8e, 0xee, 0xb8, 0x8d, 0x89, 0x8a, 0xa5, 0x0b, 0x43, 0xc5, 0x84, 0x84, 0x2a, 0x5f, 0xf2,
+	0x5c, 0x30, 0xb4, 0xb1, 0x79, 0xec, 0xb4, 0x2b, 0x1f, 0xe1, 0x3e, 0x06, 0x1f, 0x85, 0xb1, 0xe3,
+	0x6d, 0xd0, 0x74, 0x61, 0xbc, 0x8f, 0x80, 0x62, 0x13, 0xae, 0x0b, 0x62, 0x8b, 0x7f, 0xaf, 0xd2,
+	0x2f, 0x0f, 0x15, 0x0e, 0xd6, 0x46, 0xa3, 0x5c, 0x25, 0x16, 0x70, 0x03, 0x98, 0x48, 0xa3, 0x12,
+	0x03, 0x68, 0x95, 0x75, 0x50, 0xa4, 0x90, 0x6c, 0xce, 0x13, 0x59, 0x66, 0xca, 0x09, 0x83, 0xda,
+	0x69, 0x36, 0x69, 0xf4, 0x22, 0xe8, 0x85, 0x34, 0x4a, 0x1c, 0xe9, 0xc5, 0xe6, 0x7c, 0x38, 0xca,
+	0xb5, 0xce, 0x57, 0x90, 0x78, 0xc7, 0x55, 0x79, 0x9d, 0x38, 0xb5, 0x06, 0xeb, 0xe4, 0xda, 0x84,
+	0x90, 0xe1, 0xb3, 0x0c, 0x0c, 0x14, 0x19, 0x14, 0xa9, 0x02, 0x9b, 0xe4, 0x3a, 0xd7, 0x1e, 0xf7,
+	0x5f, 0x41, 0x32, 0xa9, 0x4e, 0xe8, 0xe0, 0x55, 0xdd, 0xbb, 0x80, 0x54, 0x63, 0xc6, 0x2e, 0x69,
+	0xab, 0x4e, 0x89, 0xc9, 0x98, 0x4c, 0x07, 0x17, 0x43, 0x11, 0x2a, 0x44, 0x53, 0x21, 0xde, 0x36,
+	0x15, 0xb3, 0xd6, 0xcd, 0xf7, 0x11, 0x59, 0x78, 0x35, 0x7b, 0x4a, 0xfb, 0x06, 0x55, 0x91, 0x2a,
+	0x23, 0x57, 0xf1, 0xc9, 0x98, 0x4c, 0xfb, 0x8b, 0x7b, 0x80, 0x3d, 0xa6, 0x3d, 0x69, 0xd4, 0xb2,
+	0x90, 0x6b, 0x88, 0x4f, 0x3d, 0xd9, 0x95, 0x46, 0xbd, 0x91, 0xc1, 0x58, 0xc3, 0xd6, 0xc8, 0x14,
+	0xe2, 0x56, 0x30, 0xfe, 0x01, 0xd8, 0x88, 0x0e, 0xb6, 0x1a, 0x3f, 0x5d, 0xaf, 0xf4, 0x76, 0xa9,
+	0xb2, 0xb8, 0xed, 0x79, 0xda, 0x40, 0xf3, 0x8c, 0x3d, 0xa4, 0x1d, 0x2c, 0x8b, 0x9a, 0xeb, 0x78,
+	0xae, 0x8d, 0x65, 0x31, 0xcf, 0xd8, 0x73, 0xfa, 0x3f, 0xc2, 0xe7, 0x12, 0xac, 0x5b, 0x66, 0x2a,
+	0x07, 0xeb, 0xe2, 0xae, 0xa7, 0xff, 0xfb, 0x8d, 0xbe, 0xf6, 0x20, 0x3b, 0xa3, 0x6d, 0x40, 0xd4,
+	0x18, 0xf7, 0x82, 0xd9, 0x3f, 0xd8, 0x13, 0xda, 0xdf, 0xa2, 0x72, 0x80, 0x75, 0x6c, 0xdf, 0x33,
+	0xbd, 0x00, 0xcc, 0xeb, 0x79, 0x1e, 0x19, 0x84, 0x8d, 0xd2, 0xa5, 0x5d, 0xa2, 0x5f, 0xac, 0x69,
+	0xa0, 0x5e, 0x79, 0xd6, 0xb0, 0x61, 0xce, 0x50, 0x34, 0x79, 0x4f, 0x1f, 0x1c, 0x6d, 0x3c, 0x93,
+	0x2e, 0xfd, 0xc0, 0xe6, 0xb4, 0x1b, 0x02, 0x6c, 0x4c, 0xc6, 0xa7, 0xd3, 0xc1, 0x45, 0x22, 0xfe,
+	0xfd, 0xcb, 0xc5, 0x51, 0xcc, 0xa2, 0xf1, 0xcf, 0x3e, 0xee, 0xf6, 0x3c, 0xba, 0xdd, 0xf3, 0xe8,
+	0x6e, 0xcf, 0xc9, 0x97, 0x8a, 0x93, 0xaf, 0x15, 0x27, 0xdf, 0x2a, 0x4e, 0x76, 0x15, 0x27, 0x3f,
+	0x2a, 0x4e, 0x7e, 0x56, 0x3c, 0xba, 0xab, 0x38, 0xb9, 0x39, 0xf0, 0x68, 0x77, 0xe0, 0xd1, 0xed,
+	0x81, 0x47, 0xef, 0x2e, 0x73, 0x7d, 0xdf, 0xa8, 0xf4, 0xdf, 0xef, 0xf2, 0xe5, 0xd1, 0xf3, 0xaa,
+	0xe3, 0x2f, 0xe1, 0xc5, 0xaf, 0x01, 0x00, 0xaf, 0xcb, 0x0a, 0x9a, 0xd0, 0x02, 0x00, 0x00,
+}
+
+func (this *AuditRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AuditRecord)
+	if !ok {
+		that2, ok := that.(AuditRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.Time == nil {
+		if this.Time != nil {
+			return false
+		}
+	} else if !this.Time.Equal(*that1.Time) {
+		return false
+	}
+	if this.Principal != that1.Principal {
+		return false
+	}
+	if this.ApiName != that1.ApiName {
+		return false
+	}
+	if this.Namespace != that1.Namespace {
+		return false
+	}
+	if this.WorkflowId != that1.WorkflowId {
+		return false
+	}
+	if this.RunId != that1.RunId {
+		return false
+	}
+	if this.RequestDigest != that1.RequestDigest {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	if this.WriterId != that1.WriterId {
+		return false
+	}
+	if this.PreviousRecordDigest != that1.PreviousRecordDigest {
+		return false
+	}
+	return true
+}
+func (this *AuditRecordBatch) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AuditRecordBatch)
+	if !ok {
+		that2, ok := that.(AuditRecordBatch)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Records) != len(that1.Records) {
+		return false
+	}
+	for i := range this.Records {
+		if !this.Records[i].Equal(that1.Records[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *AuditRecord) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 14)
+	s = append(s, "&persistence.AuditRecord{")
+	s = append(s, "Time: "+fmt.Sprintf("%#v", this.Time)+",\n")
+	s = append(s, "Principal: "+fmt.Sprintf("%#v", this.Principal)+",\n")
+	s = append(s, "ApiName: "+fmt.Sprintf("%#v", this.ApiName)+",\n")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
+	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
+	s = append(s, "RequestDigest: "+fmt.Sprintf("%#v", this.RequestDigest)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "WriterId: "+fmt.Sprintf("%#v", this.WriterId)+",\n")
+	s = append(s, "PreviousRecordDigest: "+fmt.Sprintf("%#v", this.PreviousRecordDigest)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AuditRecordBatch) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&persistence.AuditRecordBatch{")
+	if this.Records != nil {
+		s = append(s, "Records: "+fmt.Sprintf("%#v", this.Records)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringAudit(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *AuditRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuditRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuditRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PreviousRecordDigest) > 0 {
+		i -= len(m.PreviousRecordDigest)
+		copy(dAtA[i:], m.PreviousRecordDigest)
+		i = encodeVarintAudit(dAtA, i, uint64(len(m.PreviousRecordDigest)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.WriterId) > 0 {
+		i -= len(m.WriterId)
+		copy(dAtA[i:], m.WriterId)
+		i = encodeVarintAudit(dAtA, i, uint64(len(m.WriterId)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintAudit(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.RequestDigest) > 0 {
+		i -= len(m.RequestDigest)
+		copy(dAtA[i:], m.RequestDigest)
+		i = encodeVarintAudit(dAtA, i, uint64(len(m.RequestDigest)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintAudit(dAtA, i, uint64(len(m.RunId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.WorkflowId) > 0 {
+		i -= len(m.WorkflowId)
+		copy(dAtA[i:], m.WorkflowId)
+		i = encodeVarintAudit(dAtA, i, uint64(len(m.WorkflowId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintAudit(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ApiName) > 0 {
+		i -= len(m.ApiName)
+		copy(dAtA[i:], m.ApiName)
+		i = encodeVarintAudit(dAtA, i, uint64(len(m.ApiName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Principal) > 0 {
+		i -= len(m.Principal)
+		copy(dAtA[i:], m.Principal)
+		i = encodeVarintAudit(dAtA, i, uint64(len(m.Principal)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Time != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Time):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintAudit(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuditRecordBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuditRecordBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuditRecordBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAudit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAudit(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAudit(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AuditRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Time != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Time)
+		n += 1 + l + sovAudit(uint64(l))
+	}
+	l = len(m.Principal)
+	if l > 0 {
+		n += 1 + l + sovAudit(uint64(l))
+	}
+	l = len(m.ApiName)
+	if l > 0 {
+		n += 1 + l + sovAudit(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovAudit(uint64(l))
+	}
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovAudit(uint64(l))
+	}
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovAudit(uint64(l))
+	}
+	l = len(m.RequestDigest)
+	if l > 0 {
+		n += 1 + l + sovAudit(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovAudit(uint64(l))
+	}
+	l = len(m.WriterId)
+	if l > 0 {
+		n += 1 + l + sovAudit(uint64(l))
+	}
+	l = len(m.PreviousRecordDigest)
+	if l > 0 {
+		n += 1 + l + sovAudit(uint64(l))
+	}
+	return n
+}
+
+func (m *AuditRecordBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovAudit(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAudit(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAudit(x uint64) (n int) {
+	return sovAudit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *AuditRecord) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AuditRecord{`,
+		`Time:` + strings.Replace(fmt.Sprintf("%v", this.Time), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Principal:` + fmt.Sprintf("%v", this.Principal) + `,`,
+		`ApiName:` + fmt.Sprintf("%v", this.ApiName) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
+		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
+		`RequestDigest:` + fmt.Sprintf("%v", this.RequestDigest) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`WriterId:` + fmt.Sprintf("%v", this.WriterId) + `,`,
+		`PreviousRecordDigest:` + fmt.Sprintf("%v", this.PreviousRecordDigest) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AuditRecordBatch) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRecords := "[]*AuditRecord{"
+	for _, f := range this.Records {
+		repeatedStringForRecords += strings.Replace(f.String(), "AuditRecord", "AuditRecord", 1) + ","
+	}
+	repeatedStringForRecords += "}"
+	s := strings.Join([]string{`&AuditRecordBatch{`,
+		`Records:` + repeatedStringForRecords + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringAudit(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *AuditRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAudit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuditRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuditRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAudit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAudit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Time == nil {
+				m.Time = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAudit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAudit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Principal = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAudit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAudit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAudit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAudit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAudit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAudit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAudit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAudit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestDigest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAudit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAudit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestDigest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAudit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAudit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAudit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAudit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WriterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousRecordDigest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAudit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAudit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousRecordDigest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAudit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAudit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAudit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuditRecordBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAudit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuditRecordBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuditRecordBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAudit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAudit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &AuditRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAudit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAudit
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAudit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAudit(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAudit
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAudit
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAudit
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAudit
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAudit        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAudit          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAudit = fmt.Errorf("proto: unexpected end of group")
+)
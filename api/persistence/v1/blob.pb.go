@@ -0,0 +1,578 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: temporal/server/api/persistence/v1/blob.proto
+
+package persistence
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+
+	proto "github.com/gogo/protobuf/proto"
+	v1 "go.temporal.io/api/enums/v1"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// A history event or mutable state blob that was encrypted or compressed before it was written. It is
+// stored as a blob with an unspecified encoding type, and keeps the encoding metadata needed to restore
+// the original blob.
+type EncodedDataBlob struct {
+	// The encoding type of the original blob.
+	EncodingType v1.EncodingType `protobuf:"varint,1,opt,name=encoding_type,json=encodingType,proto3,enum=temporal.api.enums.v1.EncodingType" json:"encoding_type,omitempty"`
+	// The ID of the key that encrypted data, or empty if data is not encrypted.
+	EncryptionKeyId string `protobuf:"bytes,2,opt,name=encryption_key_id,json=encryptionKeyId,proto3" json:"encryption_key_id,omitempty"`
+	// The algorithm that compressed data, or empty if data is not compressed. Data is compressed
+	// before it is encrypted.
+	Compression string `protobuf:"bytes,3,opt,name=compression,proto3" json:"compression,omitempty"`
+	Data        []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *EncodedDataBlob) Reset()      { *m = EncodedDataBlob{} }
+func (*EncodedDataBlob) ProtoMessage() {}
+func (*EncodedDataBlob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a093977e6d222493, []int{0}
+}
+func (m *EncodedDataBlob) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EncodedDataBlob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EncodedDataBlob.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EncodedDataBlob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncodedDataBlob.Merge(m, src)
+}
+func (m *EncodedDataBlob) XXX_Size() int {
+	return m.Size()
+}
+func (m *EncodedDataBlob) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncodedDataBlob.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EncodedDataBlob proto.InternalMessageInfo
+
+func (m *EncodedDataBlob) GetEncodingType() v1.EncodingType {
+	if m != nil {
+		return m.EncodingType
+	}
+	return v1.ENCODING_TYPE_UNSPECIFIED
+}
+
+func (m *EncodedDataBlob) GetEncryptionKeyId() string {
+	if m != nil {
+		return m.EncryptionKeyId
+	}
+	return ""
+}
+
+func (m *EncodedDataBlob) GetCompression() string {
+	if m != nil {
+		return m.Compression
+	}
+	return ""
+}
+
+func (m *EncodedDataBlob) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*EncodedDataBlob)(nil), "temporal.server.api.persistence.v1.EncodedDataBlob")
+}
+
+func init() {
+	proto.RegisterFile("temporal/server/api/persistence/v1/blob.proto", fileDescriptor_a093977e6d222493)
+}
+
+var fileDescriptor_a093977e6d222493 = []byte{
+	// 314 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xb1, 0x4e, 0xeb, 0x30,
+	0x14, 0x86, 0xe3, 0x7b, 0xab, 0x2b, 0xdd, 0x50, 0xa8, 0xc8, 0x14, 0x31, 0x1c, 0x45, 0x65, 0xa9,
+	0x90, 0x70, 0x54, 0x60, 0x63, 0xab, 0xa8, 0x04, 0x62, 0xab, 0x98, 0x58, 0x2a, 0x27, 0x39, 0xaa,
+	0x0c, 0x8d, 0x8f, 0xe5, 0x98, 0x48, 0xd9, 0x78, 0x04, 0x1e, 0x83, 0x97, 0x60, 0x67, 0xec, 0xd8,
+	0x91, 0xba, 0x0b, 0x63, 0x1f, 0x01, 0x35, 0x85, 0x36, 0x0b, 0x9b, 0xcf, 0xf1, 0xa7, 0xef, 0xd7,
+	0xf9, 0xfd, 0x53, 0x8b, 0xb9, 0x26, 0x23, 0xa6, 0x71, 0x81, 0xa6, 0x44, 0x13, 0x0b, 0x2d, 0x63,
+	0x8d, 0xa6, 0x90, 0x85, 0x45, 0x95, 0x62, 0x5c, 0xf6, 0xe3, 0x64, 0x4a, 0x09, 0xd7, 0x86, 0x2c,
+	0x05, 0xdd, 0x1f, 0x9c, 0x6f, 0x70, 0x2e, 0xb4, 0xe4, 0x0d, 0x9c, 0x97, 0xfd, 0xa3, 0x2d, 0x53,
+	0xbb, 0x50, 0x3d, 0xe5, 0xc5, 0xda, 0x92, 0x52, 0x9e, 0x93, 0xda, 0x78, 0xba, 0x6f, 0xcc, 0xef,
+	0x0c, 0x55, 0x4a, 0x19, 0x66, 0x57, 0xc2, 0x8a, 0xc1, 0x94, 0x92, 0xe0, 0xda, 0xdf, 0xc7, 0xf5,
+	0x4a, 0xaa, 0xc9, 0xd8, 0x56, 0x1a, 0x43, 0x16, 0xb1, 0xde, 0xc1, 0xd9, 0x31, 0xdf, 0x66, 0xae,
+	0xc3, 0x6a, 0x1f, 0x2f, 0xfb, 0x7c, 0xf8, 0xcd, 0xde, 0x55, 0x1a, 0x47, 0x6d, 0x6c, 0x4c, 0xc1,
+	0x89, 0x7f, 0x88, 0x2a, 0x35, 0x95, 0xb6, 0x92, 0xd4, 0xf8, 0x11, 0xab, 0xb1, 0xcc, 0xc2, 0x3f,
+	0x11, 0xeb, 0xfd, 0x1f, 0x75, 0x76, 0x1f, 0xb7, 0x58, 0xdd, 0x64, 0x41, 0xe4, 0xef, 0xa5, 0x94,
+	0x6b, 0x83, 0x45, 0x21, 0x49, 0x85, 0x7f, 0x6b, 0xaa, 0xb9, 0x0a, 0x02, 0xbf, 0x95, 0x09, 0x2b,
+	0xc2, 0x56, 0xc4, 0x7a, 0xed, 0x51, 0xfd, 0x1e, 0x3c, 0xcc, 0x16, 0xe0, 0xcd, 0x17, 0xe0, 0xad,
+	0x16, 0xc0, 0x9e, 0x1d, 0xb0, 0x57, 0x07, 0xec, 0xdd, 0x01, 0x9b, 0x39, 0x60, 0x1f, 0x0e, 0xd8,
+	0xa7, 0x03, 0x6f, 0xe5, 0x80, 0xbd, 0x2c, 0xc1, 0x9b, 0x2d, 0xc1, 0x9b, 0x2f, 0xc1, 0xbb, 0xbf,
+	0x98, 0xd0, 0xee, 0x18, 0x49, 0xbf, 0x57, 0x7e, 0xd9, 0x18, 0x93, 0x7f, 0x75, 0x65, 0xe7, 0x5f,
+	0x03, 0x00, 0xf4, 0x22, 0xa5, 0xb0, 0xab, 0x01, 0x00, 0x00,
+}
+
+func (this *EncodedDataBlob) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EncodedDataBlob)
+	if !ok {
+		that2, ok := that.(EncodedDataBlob)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.EncodingType != that1.EncodingType {
+		return false
+	}
+	if this.EncryptionKeyId != that1.EncryptionKeyId {
+		return false
+	}
+	if this.Compression != that1.Compression {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	return true
+}
+func (this *EncodedDataBlob) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&persistence.EncodedDataBlob{")
+	s = append(s, "EncodingType: "+fmt.Sprintf("%#v", this.EncodingType)+",\n")
+	s = append(s, "EncryptionKeyId: "+fmt.Sprintf("%#v", this.EncryptionKeyId)+",\n")
+	s = append(s, "Compression: "+fmt.Sprintf("%#v", this.Compression)+",\n")
+	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringBlob(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *EncodedDataBlob) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EncodedDataBlob) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EncodedDataBlob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintBlob(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Compression) > 0 {
+		i -= len(m.Compression)
+		copy(dAtA[i:], m.Compression)
+		i = encodeVarintBlob(dAtA, i, uint64(len(m.Compression)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EncryptionKeyId) > 0 {
+		i -= len(m.EncryptionKeyId)
+		copy(dAtA[i:], m.EncryptionKeyId)
+		i = encodeVarintBlob(dAtA, i, uint64(len(m.EncryptionKeyId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EncodingType != 0 {
+		i = encodeVarintBlob(dAtA, i, uint64(m.EncodingType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBlob(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBlob(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EncodedDataBlob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EncodingType != 0 {
+		n += 1 + sovBlob(uint64(m.EncodingType))
+	}
+	l = len(m.EncryptionKeyId)
+	if l > 0 {
+		n += 1 + l + sovBlob(uint64(l))
+	}
+	l = len(m.Compression)
+	if l > 0 {
+		n += 1 + l + sovBlob(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovBlob(uint64(l))
+	}
+	return n
+}
+
+func sovBlob(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBlob(x uint64) (n int) {
+	return sovBlob(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *EncodedDataBlob) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EncodedDataBlob{`,
+		`EncodingType:` + fmt.Sprintf("%v", this.EncodingType) + `,`,
+		`EncryptionKeyId:` + fmt.Sprintf("%v", this.EncryptionKeyId) + `,`,
+		`Compression:` + fmt.Sprintf("%v", this.Compression) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringBlob(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *EncodedDataBlob) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlob
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EncodedDataBlob: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EncodedDataBlob: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncodingType", wireType)
+			}
+			m.EncodingType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlob
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EncodingType |= v1.EncodingType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptionKeyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlob
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlob
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlob
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptionKeyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlob
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlob
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlob
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Compression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlob
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlob
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlob
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlob(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlob
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBlob
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBlob(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBlob
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBlob
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBlob
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBlob
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBlob
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBlob
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBlob        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBlob          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBlob = fmt.Errorf("proto: unexpected end of group")
+)
@@ -28,15 +28,20 @@
 package persistence
 
 import (
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	io "io"
 	math "math"
 	math_bits "math/bits"
 	reflect "reflect"
 	strings "strings"
+	time "time"
 
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	v11 "go.temporal.io/api/enums/v1"
 	v1 "go.temporal.io/api/version/v1"
 )
@@ -45,6 +50,7 @@ import (
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -65,6 +71,8 @@ type ClusterMetadata struct {
 	IsGlobalNamespaceEnabled bool                              `protobuf:"varint,9,opt,name=is_global_namespace_enabled,json=isGlobalNamespaceEnabled,proto3" json:"is_global_namespace_enabled,omitempty"`
 	IsConnectionEnabled      bool                              `protobuf:"varint,10,opt,name=is_connection_enabled,json=isConnectionEnabled,proto3" json:"is_connection_enabled,omitempty"`
 	UseClusterIdMembership   bool                              `protobuf:"varint,11,opt,name=use_cluster_id_membership,json=useClusterIdMembership,proto3" json:"use_cluster_id_membership,omitempty"`
+	// Faults injected into the persistence layer at runtime, see PersistenceFault.
+	PersistenceFaults []*PersistenceFault `protobuf:"bytes,12,rep,name=persistence_faults,json=persistenceFaults,proto3" json:"persistence_faults,omitempty"`
 }
 
 func (m *ClusterMetadata) Reset()      { *m = ClusterMetadata{} }
@@ -176,6 +184,13 @@ func (m *ClusterMetadata) GetUseClusterIdMembership() bool {
 	return false
 }
 
+func (m *ClusterMetadata) GetPersistenceFaults() []*PersistenceFault {
+	if m != nil {
+		return m.PersistenceFaults
+	}
+	return nil
+}
+
 type IndexSearchAttributes struct {
 	CustomSearchAttributes map[string]v11.IndexedValueType `protobuf:"bytes,1,rep,name=custom_search_attributes,json=customSearchAttributes,proto3" json:"custom_search_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=temporal.api.enums.v1.IndexedValueType"`
 }
@@ -219,11 +234,137 @@ func (m *IndexSearchAttributes) GetCustomSearchAttributes() map[string]v11.Index
 	return nil
 }
 
+// PersistenceFault injects errors and latency into the persistence calls matching all of its non-empty
+// filters until it expires.
+type PersistenceFault struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Service name, e.g. "history".
+	Service string `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	// Data store name, e.g. "ExecutionStore". Faults are never injected into the ClusterMDStore.
+	DataStore string `protobuf:"bytes,3,opt,name=data_store,json=dataStore,proto3" json:"data_store,omitempty"`
+	// Data store method name, e.g. "UpdateWorkflowExecution".
+	Method string `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	// Namespace name of the caller.
+	Namespace string `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ShardId   int32  `protobuf:"varint,6,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	// Probability in [0, 1] to fail a matching call with error_type.
+	ErrorRate float64 `protobuf:"fixed64,7,opt,name=error_rate,json=errorRate,proto3" json:"error_rate,omitempty"`
+	// One of the error names accepted by the targeted fault injection config, e.g. "ShardOwnershipLostError".
+	// A random persistence error is returned if empty.
+	ErrorType string `protobuf:"bytes,8,opt,name=error_type,json=errorType,proto3" json:"error_type,omitempty"`
+	// Delay added to every matching call.
+	Latency    *time.Duration `protobuf:"bytes,9,opt,name=latency,proto3,stdduration" json:"latency,omitempty"`
+	ExpireTime *time.Time     `protobuf:"bytes,10,opt,name=expire_time,json=expireTime,proto3,stdtime" json:"expire_time,omitempty"`
+}
+
+func (m *PersistenceFault) Reset()      { *m = PersistenceFault{} }
+func (*PersistenceFault) ProtoMessage() {}
+func (*PersistenceFault) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f4771d63f405884, []int{2}
+}
+func (m *PersistenceFault) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PersistenceFault) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PersistenceFault.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PersistenceFault) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PersistenceFault.Merge(m, src)
+}
+func (m *PersistenceFault) XXX_Size() int {
+	return m.Size()
+}
+func (m *PersistenceFault) XXX_DiscardUnknown() {
+	xxx_messageInfo_PersistenceFault.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PersistenceFault proto.InternalMessageInfo
+
+func (m *PersistenceFault) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PersistenceFault) GetService() string {
+	if m != nil {
+		return m.Service
+	}
+	return ""
+}
+
+func (m *PersistenceFault) GetDataStore() string {
+	if m != nil {
+		return m.DataStore
+	}
+	return ""
+}
+
+func (m *PersistenceFault) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *PersistenceFault) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *PersistenceFault) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *PersistenceFault) GetErrorRate() float64 {
+	if m != nil {
+		return m.ErrorRate
+	}
+	return 0
+}
+
+func (m *PersistenceFault) GetErrorType() string {
+	if m != nil {
+		return m.ErrorType
+	}
+	return ""
+}
+
+func (m *PersistenceFault) GetLatency() *time.Duration {
+	if m != nil {
+		return m.Latency
+	}
+	return nil
+}
+
+func (m *PersistenceFault) GetExpireTime() *time.Time {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ClusterMetadata)(nil), "temporal.server.api.persistence.v1.ClusterMetadata")
 	proto.RegisterMapType((map[string]*IndexSearchAttributes)(nil), "temporal.server.api.persistence.v1.ClusterMetadata.IndexSearchAttributesEntry")
 	proto.RegisterType((*IndexSearchAttributes)(nil), "temporal.server.api.persistence.v1.IndexSearchAttributes")
 	proto.RegisterMapType((map[string]v11.IndexedValueType)(nil), "temporal.server.api.persistence.v1.IndexSearchAttributes.CustomSearchAttributesEntry")
+	proto.RegisterType((*PersistenceFault)(nil), "temporal.server.api.persistence.v1.PersistenceFault")
 }
 
 func init() {
@@ -231,49 +372,65 @@ func init() {
 }
 
 var fileDescriptor_1f4771d63f405884 = []byte{
-	// 670 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x4f, 0x13, 0x41,
-	0x14, 0xef, 0x50, 0x41, 0x98, 0x12, 0xd0, 0x25, 0xe0, 0x58, 0xe2, 0xa6, 0x12, 0x0d, 0x3d, 0x6d,
-	0x43, 0xf5, 0x00, 0x2a, 0x07, 0x6c, 0x90, 0x70, 0x00, 0x93, 0xa2, 0x1c, 0xbc, 0x6c, 0xa6, 0xbb,
-	0x0f, 0x3a, 0xba, 0x3b, 0xb3, 0x99, 0x99, 0xdd, 0xd8, 0x9b, 0x89, 0x89, 0x57, 0xfd, 0x02, 0xde,
-	0x8d, 0x9f, 0xc4, 0x23, 0x47, 0x8e, 0x52, 0x2e, 0x1e, 0xf9, 0x08, 0x66, 0x67, 0x77, 0xdb, 0x42,
-	0x8a, 0x1a, 0x6f, 0x3b, 0xef, 0xf7, 0x67, 0xde, 0xfc, 0xe6, 0xed, 0xe0, 0x0d, 0x0d, 0x61, 0x24,
-	0x24, 0x0d, 0x1a, 0x0a, 0x64, 0x02, 0xb2, 0x41, 0x23, 0xd6, 0x88, 0x40, 0x2a, 0xa6, 0x34, 0x70,
-	0x0f, 0x1a, 0xc9, 0x5a, 0xc3, 0x0b, 0x62, 0xa5, 0x41, 0xba, 0x21, 0x68, 0xea, 0x53, 0x4d, 0x9d,
-	0x48, 0x0a, 0x2d, 0xac, 0x95, 0x42, 0xea, 0x64, 0x52, 0x87, 0x46, 0xcc, 0x19, 0x91, 0x3a, 0xc9,
-	0x5a, 0x75, 0xc0, 0x31, 0xbe, 0xc0, 0xe3, 0x50, 0x19, 0x47, 0x11, 0x86, 0x82, 0x67, 0x3e, 0xd5,
-	0x87, 0x97, 0x38, 0x49, 0x6a, 0x20, 0x78, 0xca, 0x0a, 0x41, 0x29, 0x7a, 0x0c, 0x19, 0x6d, 0xe5,
-	0xfb, 0x14, 0x9e, 0x6f, 0x65, 0x9d, 0xec, 0xe5, 0x8d, 0x58, 0xf7, 0xf1, 0x6c, 0xd1, 0x1c, 0xa7,
-	0x21, 0x10, 0x54, 0x43, 0xf5, 0x99, 0x76, 0x25, 0xaf, 0xed, 0xd3, 0x10, 0x2c, 0x07, 0x2f, 0x74,
-	0x99, 0xd2, 0x42, 0xf6, 0x5c, 0xd5, 0xa5, 0xd2, 0x77, 0x3d, 0x11, 0x73, 0x4d, 0x26, 0x6a, 0xa8,
-	0x3e, 0xd9, 0xbe, 0x9d, 0x43, 0x07, 0x29, 0xd2, 0x4a, 0x01, 0xeb, 0x1e, 0xc6, 0x85, 0x25, 0xf3,
-	0x49, 0xd9, 0x18, 0xce, 0xe4, 0x95, 0x5d, 0xdf, 0xda, 0xc1, 0xb3, 0x79, 0x87, 0x2e, 0xe3, 0x47,
-	0x82, 0xdc, 0xa8, 0xa1, 0x7a, 0xa5, 0xf9, 0xc0, 0x19, 0x64, 0x91, 0x86, 0x90, 0x33, 0x9c, 0x64,
-	0xcd, 0x39, 0xcc, 0x3e, 0x77, 0xf9, 0x91, 0x68, 0x57, 0x92, 0xe1, 0xc2, 0xfa, 0x84, 0xf0, 0x1d,
-	0xc6, 0x7d, 0x78, 0xef, 0x2a, 0xa0, 0xd2, 0xeb, 0xba, 0x54, 0x6b, 0xc9, 0x3a, 0xb1, 0x06, 0x45,
-	0x26, 0x6b, 0xe5, 0x7a, 0xa5, 0xb9, 0xef, 0xfc, 0x3d, 0x60, 0xe7, 0x4a, 0x22, 0xce, 0x6e, 0x6a,
-	0x79, 0x60, 0x1c, 0xb7, 0x06, 0x86, 0xdb, 0x5c, 0xcb, 0x5e, 0x7b, 0x91, 0x8d, 0xc3, 0xac, 0x55,
-	0x3c, 0x5f, 0x1c, 0x98, 0xfa, 0xbe, 0x04, 0xa5, 0xc8, 0x94, 0x39, 0xf5, 0x5c, 0x5e, 0xde, 0xca,
-	0xaa, 0xd6, 0x33, 0x5c, 0x3d, 0xa2, 0x2c, 0x10, 0x09, 0x48, 0x77, 0x98, 0x81, 0x27, 0x21, 0x04,
-	0xae, 0xc9, 0xcd, 0x1a, 0xaa, 0x97, 0xdb, 0xa4, 0x60, 0x0c, 0xce, 0x9d, 0xe3, 0xd6, 0x3a, 0x26,
-	0x8c, 0x33, 0xcd, 0x68, 0xe0, 0x5e, 0x75, 0x21, 0xd3, 0x46, 0xbb, 0x94, 0xe3, 0x2f, 0x2e, 0x5b,
-	0x58, 0x9b, 0x78, 0x99, 0x29, 0xf7, 0x38, 0x10, 0x1d, 0x1a, 0x98, 0x6b, 0x56, 0x11, 0xf5, 0xc0,
-	0x05, 0x4e, 0x3b, 0x01, 0xf8, 0x64, 0xa6, 0x86, 0xea, 0xd3, 0x6d, 0xc2, 0xd4, 0x8e, 0x61, 0xec,
-	0x17, 0x84, 0xed, 0x0c, 0xb7, 0x9a, 0x78, 0x91, 0x29, 0xd7, 0x13, 0x9c, 0x83, 0xa7, 0xd3, 0x9e,
-	0x0b, 0x21, 0x36, 0xc2, 0x05, 0xa6, 0x5a, 0x03, 0xac, 0xd0, 0x6c, 0xe0, 0xbb, 0xb1, 0x02, 0x77,
-	0x38, 0x08, 0x6e, 0x08, 0x61, 0x07, 0xa4, 0xea, 0xb2, 0x88, 0x54, 0x8c, 0x6e, 0x29, 0x56, 0xd0,
-	0x2a, 0xc6, 0x62, 0x6f, 0x80, 0x56, 0x3f, 0x22, 0x5c, 0xbd, 0xfe, 0x12, 0xac, 0x5b, 0xb8, 0xfc,
-	0x0e, 0x7a, 0xf9, 0xa0, 0xa6, 0x9f, 0xd6, 0x4b, 0x3c, 0x99, 0xd0, 0x20, 0x06, 0x33, 0x92, 0x95,
-	0xe6, 0xc6, 0xbf, 0xdc, 0xfa, 0xd8, 0x0d, 0xda, 0x99, 0xcf, 0x93, 0x89, 0x75, 0xb4, 0xf2, 0x75,
-	0x02, 0x2f, 0x8e, 0x25, 0x59, 0x9f, 0x11, 0x26, 0x5e, 0xac, 0xb4, 0x08, 0xc7, 0x0c, 0x1e, 0x32,
-	0x83, 0xf7, 0xfa, 0xbf, 0x5b, 0x70, 0x5a, 0xc6, 0x79, 0xfc, 0xfc, 0x2d, 0x79, 0x63, 0xc1, 0xaa,
-	0xc4, 0xcb, 0x7f, 0x90, 0x8d, 0x49, 0x6c, 0x73, 0x34, 0xb1, 0xb9, 0xe6, 0xea, 0xe5, 0x9f, 0xcf,
-	0x3c, 0x32, 0x83, 0x0e, 0xc1, 0x3f, 0x4c, 0xa9, 0xaf, 0x7a, 0x11, 0x8c, 0xe4, 0xf3, 0xfc, 0xed,
-	0xc9, 0x99, 0x5d, 0x3a, 0x3d, 0xb3, 0x4b, 0x17, 0x67, 0x36, 0xfa, 0xd0, 0xb7, 0xd1, 0xb7, 0xbe,
-	0x8d, 0x7e, 0xf4, 0x6d, 0x74, 0xd2, 0xb7, 0xd1, 0xcf, 0xbe, 0x8d, 0x7e, 0xf5, 0xed, 0xd2, 0x45,
-	0xdf, 0x46, 0x5f, 0xce, 0xed, 0xd2, 0xc9, 0xb9, 0x5d, 0x3a, 0x3d, 0xb7, 0x4b, 0x6f, 0x1e, 0x1f,
-	0x8b, 0xe1, 0x5e, 0x4c, 0x5c, 0xff, 0x64, 0x3e, 0x1d, 0x59, 0x76, 0xa6, 0xcc, 0xfb, 0xf5, 0xe8,
-	0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x6e, 0x7d, 0x0e, 0x79, 0x6b, 0x05, 0x00, 0x00,
+	// 919 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xbd, 0x6f, 0x1b, 0x37,
+	0x14, 0x17, 0xe5, 0x0f, 0x59, 0x3c, 0xc3, 0x49, 0x18, 0xd8, 0x3d, 0x2b, 0xed, 0x45, 0x31, 0x5a,
+	0x44, 0xd3, 0x09, 0x56, 0x33, 0xc4, 0x6d, 0x33, 0xd8, 0x6a, 0x12, 0x78, 0x88, 0x5b, 0x9c, 0xd3,
+	0x0c, 0x5d, 0x0e, 0xd4, 0xdd, 0x93, 0xc4, 0xf6, 0xee, 0x78, 0x20, 0x79, 0x42, 0xb4, 0x15, 0x28,
+	0xd0, 0x35, 0x19, 0xbb, 0x74, 0xef, 0x9f, 0xd2, 0xd1, 0x63, 0xb6, 0xd6, 0xf2, 0x92, 0x31, 0x7f,
+	0x42, 0x41, 0xde, 0x87, 0x64, 0x55, 0x69, 0x83, 0x6e, 0xe4, 0xfb, 0xfd, 0x7e, 0x8f, 0xef, 0x83,
+	0x8f, 0xc4, 0x47, 0x0a, 0xe2, 0x94, 0x0b, 0x1a, 0x75, 0x25, 0x88, 0x09, 0x88, 0x2e, 0x4d, 0x59,
+	0x37, 0x05, 0x21, 0x99, 0x54, 0x90, 0x04, 0xd0, 0x9d, 0x1c, 0x76, 0x83, 0x28, 0x93, 0x0a, 0x84,
+	0x1f, 0x83, 0xa2, 0x21, 0x55, 0xd4, 0x4d, 0x05, 0x57, 0x9c, 0x1c, 0x94, 0x52, 0x37, 0x97, 0xba,
+	0x34, 0x65, 0xee, 0x82, 0xd4, 0x9d, 0x1c, 0xb6, 0x9c, 0x11, 0xe7, 0xa3, 0x08, 0xba, 0x46, 0x31,
+	0xc8, 0x86, 0xdd, 0x30, 0x13, 0x54, 0x31, 0x9e, 0xe4, 0x3e, 0x5a, 0x77, 0x97, 0x71, 0xc5, 0x62,
+	0x90, 0x8a, 0xc6, 0x69, 0x41, 0xb8, 0x17, 0x42, 0x0a, 0x49, 0x08, 0x49, 0xc0, 0x40, 0x76, 0x47,
+	0x7c, 0xc4, 0x8d, 0xdd, 0xac, 0x0a, 0x4a, 0x15, 0x87, 0x89, 0x1d, 0x92, 0x2c, 0x96, 0x26, 0x6a,
+	0x1e, 0xc7, 0xd5, 0x39, 0x9f, 0x5d, 0xe3, 0x4c, 0x74, 0x90, 0x3c, 0xd1, 0xac, 0x18, 0xa4, 0xa4,
+	0x23, 0xc8, 0x69, 0x07, 0xaf, 0x1a, 0xf8, 0x46, 0x3f, 0xcf, 0xf6, 0x59, 0x91, 0x2c, 0xb9, 0x87,
+	0xb7, 0xcb, 0x02, 0x24, 0x34, 0x06, 0x1b, 0xb5, 0x51, 0xa7, 0xe9, 0x59, 0x85, 0xed, 0x8c, 0xc6,
+	0x40, 0x5c, 0x7c, 0x7b, 0xcc, 0xa4, 0xe2, 0x62, 0xea, 0xcb, 0x31, 0x15, 0xa1, 0x1f, 0xf0, 0x2c,
+	0x51, 0x76, 0xbd, 0x8d, 0x3a, 0x1b, 0xde, 0xad, 0x02, 0x3a, 0xd7, 0x48, 0x5f, 0x03, 0xe4, 0x13,
+	0x8c, 0x4b, 0x97, 0x2c, 0xb4, 0xd7, 0x8c, 0xc3, 0x66, 0x61, 0x39, 0x0d, 0xc9, 0x53, 0xbc, 0x5d,
+	0x44, 0xe8, 0xb3, 0x64, 0xc8, 0xed, 0xf5, 0x36, 0xea, 0x58, 0xbd, 0x4f, 0xdd, 0xaa, 0xde, 0xba,
+	0xd0, 0x05, 0xc3, 0x9d, 0x1c, 0xba, 0x2f, 0xf2, 0xe5, 0x69, 0x32, 0xe4, 0x9e, 0x35, 0x99, 0x6f,
+	0xc8, 0x2f, 0x08, 0x7f, 0xc4, 0x92, 0x10, 0x5e, 0xfa, 0x12, 0xa8, 0x08, 0xc6, 0x3e, 0x55, 0x4a,
+	0xb0, 0x41, 0xa6, 0x40, 0xda, 0x1b, 0xed, 0xb5, 0x8e, 0xd5, 0x3b, 0x73, 0xff, 0xbb, 0x89, 0xee,
+	0x52, 0x45, 0xdc, 0x53, 0xed, 0xf2, 0xdc, 0x78, 0x3c, 0xae, 0x1c, 0x3e, 0x4e, 0x94, 0x98, 0x7a,
+	0xbb, 0x6c, 0x15, 0x46, 0xee, 0xe3, 0x1b, 0x65, 0xc2, 0x34, 0x0c, 0x05, 0x48, 0x69, 0x6f, 0x9a,
+	0xac, 0x77, 0x0a, 0xf3, 0x71, 0x6e, 0x25, 0x5f, 0xe1, 0xd6, 0x90, 0xb2, 0x88, 0x4f, 0x40, 0xf8,
+	0xf3, 0x1a, 0x04, 0x02, 0x62, 0x48, 0x94, 0xdd, 0x68, 0xa3, 0xce, 0x9a, 0x67, 0x97, 0x8c, 0x2a,
+	0xef, 0x02, 0x27, 0x0f, 0xb1, 0xcd, 0x12, 0xa6, 0x18, 0x8d, 0xfc, 0x65, 0x2f, 0xf6, 0x96, 0xd1,
+	0xee, 0x15, 0xf8, 0x93, 0xeb, 0x2e, 0xc8, 0x23, 0x7c, 0x87, 0x49, 0x7f, 0x14, 0xf1, 0x01, 0x8d,
+	0x4c, 0x9b, 0x65, 0x4a, 0x03, 0xf0, 0x21, 0xa1, 0x83, 0x08, 0x42, 0xbb, 0xd9, 0x46, 0x9d, 0x2d,
+	0xcf, 0x66, 0xf2, 0xa9, 0x61, 0x9c, 0x95, 0x84, 0xc7, 0x39, 0x4e, 0x7a, 0x78, 0x97, 0x49, 0x3f,
+	0xe0, 0x49, 0x02, 0x81, 0xbe, 0xdd, 0x95, 0x10, 0x1b, 0xe1, 0x6d, 0x26, 0xfb, 0x15, 0x56, 0x6a,
+	0x8e, 0xf0, 0x7e, 0x26, 0xc1, 0x9f, 0x5f, 0x04, 0x3f, 0x86, 0x78, 0x00, 0x42, 0x8e, 0x59, 0x6a,
+	0x5b, 0x46, 0xb7, 0x97, 0x49, 0xe8, 0x97, 0xd7, 0xe2, 0x59, 0x85, 0x92, 0x00, 0x93, 0x85, 0x16,
+	0xf9, 0x43, 0x9a, 0x45, 0x4a, 0xda, 0xdb, 0xa6, 0xa3, 0x0f, 0x3e, 0xa4, 0xa3, 0xdf, 0xce, 0xb7,
+	0x4f, 0xb4, 0xd8, 0xbb, 0x95, 0x2e, 0x59, 0x64, 0xeb, 0x67, 0x84, 0x5b, 0xef, 0xef, 0x34, 0xb9,
+	0x89, 0xd7, 0x7e, 0x84, 0x69, 0x31, 0x0d, 0x7a, 0x49, 0xbe, 0xc1, 0x1b, 0x13, 0x1a, 0x65, 0x60,
+	0xee, 0xbd, 0xd5, 0x3b, 0xfa, 0x90, 0x40, 0x56, 0x1e, 0xe0, 0xe5, 0x7e, 0xbe, 0xa8, 0x3f, 0x44,
+	0x07, 0xbf, 0xd5, 0xf1, 0xee, 0x4a, 0x12, 0x79, 0x85, 0xb0, 0x1d, 0x64, 0x52, 0xf1, 0x78, 0xc5,
+	0xed, 0x46, 0xa6, 0x16, 0xdf, 0xfd, 0xef, 0x10, 0xdc, 0xbe, 0xf1, 0xbc, 0xfa, 0x92, 0xef, 0x05,
+	0x2b, 0xc1, 0x96, 0xc0, 0x77, 0xfe, 0x45, 0xb6, 0xa2, 0x62, 0x8f, 0x16, 0x2b, 0xb6, 0xd3, 0xbb,
+	0x7f, 0x7d, 0xc2, 0xcd, 0x4b, 0x56, 0x45, 0x08, 0xe1, 0x0b, 0x4d, 0x7d, 0x3e, 0x4d, 0x61, 0xb1,
+	0x3e, 0x6f, 0xeb, 0xf8, 0xe6, 0x72, 0x37, 0xc9, 0x0e, 0xae, 0xb3, 0xb0, 0x38, 0xa8, 0xce, 0x42,
+	0x62, 0xe3, 0x86, 0xce, 0x9f, 0x05, 0xf9, 0x49, 0x4d, 0xaf, 0xdc, 0xea, 0x97, 0x48, 0x8f, 0xb4,
+	0xaf, 0x5f, 0x28, 0x28, 0x5f, 0x22, 0x6d, 0x39, 0xd7, 0x06, 0xb2, 0x87, 0x37, 0x63, 0x50, 0x63,
+	0x1e, 0x9a, 0x37, 0xa8, 0xe9, 0x15, 0x3b, 0xf2, 0x31, 0x6e, 0x56, 0x43, 0x62, 0x6f, 0xe4, 0xaa,
+	0xca, 0x40, 0xf6, 0xf1, 0x56, 0xfe, 0x0c, 0xb2, 0xd0, 0x8c, 0xf9, 0x86, 0xd7, 0x30, 0xfb, 0xd3,
+	0x50, 0x9f, 0x07, 0x42, 0x70, 0xe1, 0x0b, 0xaa, 0xc0, 0xcc, 0x33, 0xf2, 0x9a, 0xc6, 0xe2, 0x51,
+	0x05, 0x73, 0x58, 0x4d, 0x53, 0x30, 0x23, 0xdb, 0x2c, 0x60, 0x9d, 0x37, 0x39, 0xc2, 0x8d, 0x88,
+	0xea, 0x3c, 0xa7, 0x66, 0x22, 0xad, 0xde, 0xbe, 0x9b, 0xff, 0x1f, 0x6e, 0xf9, 0x7f, 0xb8, 0x5f,
+	0x17, 0xff, 0xcb, 0xc9, 0xfa, 0xaf, 0x7f, 0xde, 0x45, 0x5e, 0xc9, 0x27, 0xc7, 0xd8, 0x82, 0x97,
+	0x29, 0x13, 0xe0, 0xeb, 0x1f, 0xc6, 0xcc, 0xa5, 0xd5, 0x6b, 0xfd, 0x43, 0xfe, 0xbc, 0xfc, 0x7e,
+	0x4e, 0xd6, 0x5f, 0x6b, 0x3d, 0xce, 0x45, 0xda, 0x7c, 0xf2, 0xc3, 0xc5, 0xa5, 0x53, 0x7b, 0x73,
+	0xe9, 0xd4, 0xde, 0x5d, 0x3a, 0xe8, 0xa7, 0x99, 0x83, 0x7e, 0x9f, 0x39, 0xe8, 0x8f, 0x99, 0x83,
+	0x2e, 0x66, 0x0e, 0xfa, 0x6b, 0xe6, 0xa0, 0xb7, 0x33, 0xa7, 0xf6, 0x6e, 0xe6, 0xa0, 0xd7, 0x57,
+	0x4e, 0xed, 0xe2, 0xca, 0xa9, 0xbd, 0xb9, 0x72, 0x6a, 0xdf, 0x3f, 0x18, 0xf1, 0x79, 0x5b, 0x19,
+	0x7f, 0xff, 0x37, 0xfb, 0xe5, 0xc2, 0x76, 0xb0, 0x69, 0x22, 0xfa, 0xfc, 0xef, 0x01, 0x00, 0x32,
+	0x6e, 0xae, 0xe7, 0x9f, 0x07, 0x00, 0x00,
 }
 
 func (this *ClusterMetadata) Equal(that interface{}) bool {
@@ -333,6 +490,14 @@ func (this *ClusterMetadata) Equal(that interface{}) bool {
 	if this.UseClusterIdMembership != that1.UseClusterIdMembership {
 		return false
 	}
+	if len(this.PersistenceFaults) != len(that1.PersistenceFaults) {
+		return false
+	}
+	for i := range this.PersistenceFaults {
+		if !this.PersistenceFaults[i].Equal(that1.PersistenceFaults[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *IndexSearchAttributes) Equal(that interface{}) bool {
@@ -364,11 +529,72 @@ func (this *IndexSearchAttributes) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *PersistenceFault) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PersistenceFault)
+	if !ok {
+		that2, ok := that.(PersistenceFault)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Service != that1.Service {
+		return false
+	}
+	if this.DataStore != that1.DataStore {
+		return false
+	}
+	if this.Method != that1.Method {
+		return false
+	}
+	if this.Namespace != that1.Namespace {
+		return false
+	}
+	if this.ShardId != that1.ShardId {
+		return false
+	}
+	if this.ErrorRate != that1.ErrorRate {
+		return false
+	}
+	if this.ErrorType != that1.ErrorType {
+		return false
+	}
+	if this.Latency != nil && that1.Latency != nil {
+		if *this.Latency != *that1.Latency {
+			return false
+		}
+	} else if this.Latency != nil {
+		return false
+	} else if that1.Latency != nil {
+		return false
+	}
+	if that1.ExpireTime == nil {
+		if this.ExpireTime != nil {
+			return false
+		}
+	} else if !this.ExpireTime.Equal(*that1.ExpireTime) {
+		return false
+	}
+	return true
+}
 func (this *ClusterMetadata) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 15)
+	s := make([]string, 0, 16)
 	s = append(s, "&persistence.ClusterMetadata{")
 	s = append(s, "ClusterName: "+fmt.Sprintf("%#v", this.ClusterName)+",\n")
 	s = append(s, "HistoryShardCount: "+fmt.Sprintf("%#v", this.HistoryShardCount)+",\n")
@@ -395,6 +621,9 @@ func (this *ClusterMetadata) GoString() string {
 	s = append(s, "IsGlobalNamespaceEnabled: "+fmt.Sprintf("%#v", this.IsGlobalNamespaceEnabled)+",\n")
 	s = append(s, "IsConnectionEnabled: "+fmt.Sprintf("%#v", this.IsConnectionEnabled)+",\n")
 	s = append(s, "UseClusterIdMembership: "+fmt.Sprintf("%#v", this.UseClusterIdMembership)+",\n")
+	if this.PersistenceFaults != nil {
+		s = append(s, "PersistenceFaults: "+fmt.Sprintf("%#v", this.PersistenceFaults)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -420,6 +649,25 @@ func (this *IndexSearchAttributes) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *PersistenceFault) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 14)
+	s = append(s, "&persistence.PersistenceFault{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "Service: "+fmt.Sprintf("%#v", this.Service)+",\n")
+	s = append(s, "DataStore: "+fmt.Sprintf("%#v", this.DataStore)+",\n")
+	s = append(s, "Method: "+fmt.Sprintf("%#v", this.Method)+",\n")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "ErrorRate: "+fmt.Sprintf("%#v", this.ErrorRate)+",\n")
+	s = append(s, "ErrorType: "+fmt.Sprintf("%#v", this.ErrorType)+",\n")
+	s = append(s, "Latency: "+fmt.Sprintf("%#v", this.Latency)+",\n")
+	s = append(s, "ExpireTime: "+fmt.Sprintf("%#v", this.ExpireTime)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringClusterMetadata(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -448,6 +696,20 @@ func (m *ClusterMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PersistenceFaults) > 0 {
+		for iNdEx := len(m.PersistenceFaults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PersistenceFaults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClusterMetadata(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	if m.UseClusterIdMembership {
 		i--
 		if m.UseClusterIdMembership {
@@ -595,6 +857,102 @@ func (m *IndexSearchAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PersistenceFault) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PersistenceFault) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PersistenceFault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpireTime != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpireTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpireTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Latency != nil {
+		n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Latency, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Latency):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ErrorType) > 0 {
+		i -= len(m.ErrorType)
+		copy(dAtA[i:], m.ErrorType)
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(len(m.ErrorType)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ErrorRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ErrorRate))))
+		i--
+		dAtA[i] = 0x39
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DataStore) > 0 {
+		i -= len(m.DataStore)
+		copy(dAtA[i:], m.DataStore)
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(len(m.DataStore)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintClusterMetadata(dAtA []byte, offset int, v uint64) int {
 	offset -= sovClusterMetadata(v)
 	base := offset
@@ -659,6 +1017,12 @@ func (m *ClusterMetadata) Size() (n int) {
 	if m.UseClusterIdMembership {
 		n += 2
 	}
+	if len(m.PersistenceFaults) > 0 {
+		for _, e := range m.PersistenceFaults {
+			l = e.Size()
+			n += 1 + l + sovClusterMetadata(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -679,6 +1043,53 @@ func (m *IndexSearchAttributes) Size() (n int) {
 	return n
 }
 
+func (m *PersistenceFault) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	l = len(m.DataStore)
+	if l > 0 {
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	if m.ShardId != 0 {
+		n += 1 + sovClusterMetadata(uint64(m.ShardId))
+	}
+	if m.ErrorRate != 0 {
+		n += 9
+	}
+	l = len(m.ErrorType)
+	if l > 0 {
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	if m.Latency != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Latency)
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	if m.ExpireTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpireTime)
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	return n
+}
+
 func sovClusterMetadata(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -689,6 +1100,11 @@ func (this *ClusterMetadata) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForPersistenceFaults := "[]*PersistenceFault{"
+	for _, f := range this.PersistenceFaults {
+		repeatedStringForPersistenceFaults += strings.Replace(f.String(), "PersistenceFault", "PersistenceFault", 1) + ","
+	}
+	repeatedStringForPersistenceFaults += "}"
 	keysForIndexSearchAttributes := make([]string, 0, len(this.IndexSearchAttributes))
 	for k, _ := range this.IndexSearchAttributes {
 		keysForIndexSearchAttributes = append(keysForIndexSearchAttributes, k)
@@ -711,6 +1127,7 @@ func (this *ClusterMetadata) String() string {
 		`IsGlobalNamespaceEnabled:` + fmt.Sprintf("%v", this.IsGlobalNamespaceEnabled) + `,`,
 		`IsConnectionEnabled:` + fmt.Sprintf("%v", this.IsConnectionEnabled) + `,`,
 		`UseClusterIdMembership:` + fmt.Sprintf("%v", this.UseClusterIdMembership) + `,`,
+		`PersistenceFaults:` + repeatedStringForPersistenceFaults + `,`,
 		`}`,
 	}, "")
 	return s
@@ -735,6 +1152,25 @@ func (this *IndexSearchAttributes) String() string {
 	}, "")
 	return s
 }
+func (this *PersistenceFault) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PersistenceFault{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Service:` + fmt.Sprintf("%v", this.Service) + `,`,
+		`DataStore:` + fmt.Sprintf("%v", this.DataStore) + `,`,
+		`Method:` + fmt.Sprintf("%v", this.Method) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`ErrorRate:` + fmt.Sprintf("%v", this.ErrorRate) + `,`,
+		`ErrorType:` + fmt.Sprintf("%v", this.ErrorType) + `,`,
+		`Latency:` + strings.Replace(fmt.Sprintf("%v", this.Latency), "Duration", "types.Duration", 1) + `,`,
+		`ExpireTime:` + strings.Replace(fmt.Sprintf("%v", this.ExpireTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringClusterMetadata(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -1150,6 +1586,40 @@ func (m *ClusterMetadata) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.UseClusterIdMembership = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PersistenceFaults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PersistenceFaults = append(m.PersistenceFaults, &PersistenceFault{})
+			if err := m.PersistenceFaults[len(m.PersistenceFaults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClusterMetadata(dAtA[iNdEx:])
@@ -1340,6 +1810,353 @@ func (m *IndexSearchAttributes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PersistenceFault) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClusterMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PersistenceFault: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PersistenceFault: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataStore", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataStore = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ErrorRate = float64(math.Float64frombits(v))
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Latency", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Latency == nil {
+				m.Latency = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Latency, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpireTime == nil {
+				m.ExpireTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpireTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClusterMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipClusterMetadata(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -245,6 +245,13 @@ type WorkflowExecutionInfo struct {
 	CloseVisibilityTaskId        int64      `protobuf:"varint,65,opt,name=close_visibility_task_id,json=closeVisibilityTaskId,proto3" json:"close_visibility_task_id,omitempty"`
 	CloseTime                    *time.Time `protobuf:"bytes,66,opt,name=close_time,json=closeTime,proto3,stdtime" json:"close_time,omitempty"`
 	CloseVisibilityTaskCompleted bool       `protobuf:"varint,67,opt,name=close_visibility_task_completed,json=closeVisibilityTaskCompleted,proto3" json:"close_visibility_task_completed,omitempty"`
+	// Set while the workflow execution is paused. No workflow or activity tasks are dispatched
+	// and no user timers fire until it is unpaused.
+	PauseInfo *WorkflowPauseInfo `protobuf:"bytes,71,opt,name=pause_info,json=pauseInfo,proto3" json:"pause_info,omitempty"`
+	// Set when the workflow execution started in a global namespace but its workflow type is not selected
+	// for replication. Such executions are never replicated to remote clusters, the decision is made at
+	// start so that a remote cluster never has a partial copy of the execution.
+	ReplicationExcluded bool `protobuf:"varint,72,opt,name=replication_excluded,json=replicationExcluded,proto3" json:"replication_excluded,omitempty"`
 }
 
 func (m *WorkflowExecutionInfo) Reset()      { *m = WorkflowExecutionInfo{} }
@@ -699,6 +706,79 @@ func (m *WorkflowExecutionInfo) GetCloseVisibilityTaskCompleted() bool {
 	return false
 }
 
+func (m *WorkflowExecutionInfo) GetPauseInfo() *WorkflowPauseInfo {
+	if m != nil {
+		return m.PauseInfo
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionInfo) GetReplicationExcluded() bool {
+	if m != nil {
+		return m.ReplicationExcluded
+	}
+	return false
+}
+
+type WorkflowPauseInfo struct {
+	PauseTime *time.Time `protobuf:"bytes,1,opt,name=pause_time,json=pauseTime,proto3,stdtime" json:"pause_time,omitempty"`
+	Reason    string     `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Identity  string     `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
+}
+
+func (m *WorkflowPauseInfo) Reset()      { *m = WorkflowPauseInfo{} }
+func (*WorkflowPauseInfo) ProtoMessage() {}
+func (*WorkflowPauseInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67a714d0e7ba9f37, []int{2}
+}
+func (m *WorkflowPauseInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowPauseInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowPauseInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowPauseInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowPauseInfo.Merge(m, src)
+}
+func (m *WorkflowPauseInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowPauseInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowPauseInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowPauseInfo proto.InternalMessageInfo
+
+func (m *WorkflowPauseInfo) GetPauseTime() *time.Time {
+	if m != nil {
+		return m.PauseTime
+	}
+	return nil
+}
+
+func (m *WorkflowPauseInfo) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *WorkflowPauseInfo) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
 type ExecutionStats struct {
 	HistorySize int64 `protobuf:"varint,1,opt,name=history_size,json=historySize,proto3" json:"history_size,omitempty"`
 }
@@ -706,7 +786,7 @@ type ExecutionStats struct {
 func (m *ExecutionStats) Reset()      { *m = ExecutionStats{} }
 func (*ExecutionStats) ProtoMessage() {}
 func (*ExecutionStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{2}
+	return fileDescriptor_67a714d0e7ba9f37, []int{3}
 }
 func (m *ExecutionStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -753,7 +833,7 @@ type WorkflowExecutionState struct {
 func (m *WorkflowExecutionState) Reset()      { *m = WorkflowExecutionState{} }
 func (*WorkflowExecutionState) ProtoMessage() {}
 func (*WorkflowExecutionState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{3}
+	return fileDescriptor_67a714d0e7ba9f37, []int{4}
 }
 func (m *WorkflowExecutionState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -836,7 +916,7 @@ type TransferTaskInfo struct {
 func (m *TransferTaskInfo) Reset()      { *m = TransferTaskInfo{} }
 func (*TransferTaskInfo) ProtoMessage() {}
 func (*TransferTaskInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{4}
+	return fileDescriptor_67a714d0e7ba9f37, []int{5}
 }
 func (m *TransferTaskInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1008,7 +1088,7 @@ func (m *TransferTaskInfo_CloseExecutionTaskDetails) Reset() {
 }
 func (*TransferTaskInfo_CloseExecutionTaskDetails) ProtoMessage() {}
 func (*TransferTaskInfo_CloseExecutionTaskDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{4, 0}
+	return fileDescriptor_67a714d0e7ba9f37, []int{5, 0}
 }
 func (m *TransferTaskInfo_CloseExecutionTaskDetails) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1064,7 +1144,7 @@ type ReplicationTaskInfo struct {
 func (m *ReplicationTaskInfo) Reset()      { *m = ReplicationTaskInfo{} }
 func (*ReplicationTaskInfo) ProtoMessage() {}
 func (*ReplicationTaskInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{5}
+	return fileDescriptor_67a714d0e7ba9f37, []int{6}
 }
 func (m *ReplicationTaskInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1201,7 +1281,7 @@ type VisibilityTaskInfo struct {
 func (m *VisibilityTaskInfo) Reset()      { *m = VisibilityTaskInfo{} }
 func (*VisibilityTaskInfo) ProtoMessage() {}
 func (*VisibilityTaskInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{6}
+	return fileDescriptor_67a714d0e7ba9f37, []int{7}
 }
 func (m *VisibilityTaskInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1321,7 +1401,7 @@ type TimerTaskInfo struct {
 func (m *TimerTaskInfo) Reset()      { *m = TimerTaskInfo{} }
 func (*TimerTaskInfo) ProtoMessage() {}
 func (*TimerTaskInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{7}
+	return fileDescriptor_67a714d0e7ba9f37, []int{8}
 }
 func (m *TimerTaskInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1454,7 +1534,7 @@ type ArchivalTaskInfo struct {
 func (m *ArchivalTaskInfo) Reset()      { *m = ArchivalTaskInfo{} }
 func (*ArchivalTaskInfo) ProtoMessage() {}
 func (*ArchivalTaskInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{8}
+	return fileDescriptor_67a714d0e7ba9f37, []int{9}
 }
 func (m *ArchivalTaskInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1569,12 +1649,13 @@ type ActivityInfo struct {
 	ScheduledEventId            int64          `protobuf:"varint,30,opt,name=scheduled_event_id,json=scheduledEventId,proto3" json:"scheduled_event_id,omitempty"`
 	LastHeartbeatDetails        *v12.Payloads  `protobuf:"bytes,31,opt,name=last_heartbeat_details,json=lastHeartbeatDetails,proto3" json:"last_heartbeat_details,omitempty"`
 	LastHeartbeatUpdateTime     *time.Time     `protobuf:"bytes,32,opt,name=last_heartbeat_update_time,json=lastHeartbeatUpdateTime,proto3,stdtime" json:"last_heartbeat_update_time,omitempty"`
+	Paused                      bool           `protobuf:"varint,33,opt,name=paused,proto3" json:"paused,omitempty"`
 }
 
 func (m *ActivityInfo) Reset()      { *m = ActivityInfo{} }
 func (*ActivityInfo) ProtoMessage() {}
 func (*ActivityInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{9}
+	return fileDescriptor_67a714d0e7ba9f37, []int{10}
 }
 func (m *ActivityInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1806,6 +1887,13 @@ func (m *ActivityInfo) GetLastHeartbeatUpdateTime() *time.Time {
 	return nil
 }
 
+func (m *ActivityInfo) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
 // timer_map column
 type TimerInfo struct {
 	Version        int64      `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
@@ -1819,7 +1907,7 @@ type TimerInfo struct {
 func (m *TimerInfo) Reset()      { *m = TimerInfo{} }
 func (*TimerInfo) ProtoMessage() {}
 func (*TimerInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{10}
+	return fileDescriptor_67a714d0e7ba9f37, []int{11}
 }
 func (m *TimerInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1902,7 +1990,7 @@ type ChildExecutionInfo struct {
 func (m *ChildExecutionInfo) Reset()      { *m = ChildExecutionInfo{} }
 func (*ChildExecutionInfo) ProtoMessage() {}
 func (*ChildExecutionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{11}
+	return fileDescriptor_67a714d0e7ba9f37, []int{12}
 }
 func (m *ChildExecutionInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2026,7 +2114,7 @@ type RequestCancelInfo struct {
 func (m *RequestCancelInfo) Reset()      { *m = RequestCancelInfo{} }
 func (*RequestCancelInfo) ProtoMessage() {}
 func (*RequestCancelInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{12}
+	return fileDescriptor_67a714d0e7ba9f37, []int{13}
 }
 func (m *RequestCancelInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2094,7 +2182,7 @@ type SignalInfo struct {
 func (m *SignalInfo) Reset()      { *m = SignalInfo{} }
 func (*SignalInfo) ProtoMessage() {}
 func (*SignalInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{13}
+	return fileDescriptor_67a714d0e7ba9f37, []int{14}
 }
 func (m *SignalInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2161,7 +2249,7 @@ type Checksum struct {
 func (m *Checksum) Reset()      { *m = Checksum{} }
 func (*Checksum) ProtoMessage() {}
 func (*Checksum) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{14}
+	return fileDescriptor_67a714d0e7ba9f37, []int{15}
 }
 func (m *Checksum) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2219,6 +2307,7 @@ func init() {
 	proto.RegisterType((*WorkflowExecutionInfo)(nil), "temporal.server.api.persistence.v1.WorkflowExecutionInfo")
 	proto.RegisterMapType((map[string]*v12.Payload)(nil), "temporal.server.api.persistence.v1.WorkflowExecutionInfo.MemoEntry")
 	proto.RegisterMapType((map[string]*v12.Payload)(nil), "temporal.server.api.persistence.v1.WorkflowExecutionInfo.SearchAttributesEntry")
+	proto.RegisterType((*WorkflowPauseInfo)(nil), "temporal.server.api.persistence.v1.WorkflowPauseInfo")
 	proto.RegisterType((*ExecutionStats)(nil), "temporal.server.api.persistence.v1.ExecutionStats")
 	proto.RegisterType((*WorkflowExecutionState)(nil), "temporal.server.api.persistence.v1.WorkflowExecutionState")
 	proto.RegisterType((*TransferTaskInfo)(nil), "temporal.server.api.persistence.v1.TransferTaskInfo")
@@ -2240,228 +2329,234 @@ func init() {
 }
 
 var fileDescriptor_67a714d0e7ba9f37 = []byte{
-	// 3537 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3a, 0xcd, 0x73, 0xdb, 0xc6,
-	0x77, 0xa6, 0x05, 0x89, 0xe0, 0x23, 0x45, 0x41, 0xd0, 0x17, 0xa4, 0xc8, 0x94, 0xcc, 0xd8, 0x89,
-	0x9c, 0x38, 0x54, 0x24, 0x3b, 0x75, 0x3e, 0xda, 0xb8, 0x92, 0x2c, 0xc7, 0x64, 0x1d, 0xdb, 0x81,
-	0x94, 0x38, 0x93, 0x26, 0xc3, 0x81, 0x80, 0x95, 0x84, 0x8a, 0x04, 0x68, 0x00, 0x94, 0xcc, 0x4c,
-	0x0f, 0x39, 0x74, 0x7a, 0x4e, 0x6f, 0xfd, 0x13, 0x7a, 0xec, 0xa5, 0xa7, 0x5e, 0x7a, 0xe8, 0x74,
-	0x7a, 0xea, 0xe4, 0xd6, 0xdc, 0xda, 0x38, 0x97, 0x5e, 0x3a, 0xc9, 0xfc, 0xfe, 0x82, 0xdf, 0xec,
-	0xdb, 0x5d, 0x7c, 0x11, 0x92, 0x20, 0xff, 0xe2, 0x43, 0x6e, 0xc4, 0xbe, 0xcf, 0x7d, 0xfb, 0xf6,
-	0x7d, 0x2d, 0xe1, 0x56, 0x40, 0xba, 0x3d, 0xd7, 0x33, 0x3a, 0xab, 0x3e, 0xf1, 0x8e, 0x89, 0xb7,
-	0x6a, 0xf4, 0xec, 0xd5, 0x1e, 0xf1, 0x7c, 0xdb, 0x0f, 0x88, 0x63, 0x92, 0xd5, 0xe3, 0xb5, 0x55,
-	0xf2, 0x9c, 0x98, 0xfd, 0xc0, 0x76, 0x1d, 0xbf, 0xd1, 0xf3, 0xdc, 0xc0, 0x55, 0xeb, 0x82, 0xa8,
-	0xc1, 0x88, 0x1a, 0x46, 0xcf, 0x6e, 0xc4, 0x88, 0x1a, 0xc7, 0x6b, 0x0b, 0xb5, 0x03, 0xd7, 0x3d,
-	0xe8, 0x90, 0x55, 0xa4, 0xd8, 0xeb, 0xef, 0xaf, 0x5a, 0x7d, 0xcf, 0xa0, 0x4c, 0x18, 0x8f, 0x85,
-	0xa5, 0x34, 0x3c, 0xb0, 0xbb, 0xc4, 0x0f, 0x8c, 0x6e, 0x8f, 0x23, 0x5c, 0xb5, 0x48, 0x8f, 0x38,
-	0x16, 0x71, 0x4c, 0x9b, 0xf8, 0xab, 0x07, 0xee, 0x81, 0x8b, 0xeb, 0xf8, 0x8b, 0xa3, 0x5c, 0x0b,
-	0x95, 0xa7, 0x5a, 0x9b, 0x6e, 0xb7, 0xeb, 0x3a, 0x54, 0xe1, 0x2e, 0xf1, 0x7d, 0xe3, 0x80, 0x64,
-	0x62, 0x11, 0xa7, 0xdf, 0xf5, 0x29, 0xd2, 0x89, 0xeb, 0x1d, 0xed, 0x77, 0xdc, 0x13, 0x8e, 0x75,
-	0x3d, 0x81, 0xb5, 0x6f, 0xd8, 0x9d, 0xbe, 0x47, 0x86, 0x99, 0xbd, 0x91, 0x40, 0x13, 0x3c, 0x86,
-	0xf1, 0xde, 0xca, 0xb2, 0xab, 0xd9, 0x71, 0xcd, 0xa3, 0x61, 0xdc, 0x1b, 0x59, 0xb8, 0xa1, 0x9e,
-	0x6c, 0x5b, 0x1c, 0xf5, 0xed, 0x33, 0x51, 0x53, 0x5b, 0x7a, 0xf3, 0x4c, 0xe4, 0xc0, 0xf0, 0x8f,
-	0x38, 0xe2, 0x7b, 0xb9, 0xb8, 0xb6, 0x29, 0x45, 0x3b, 0x18, 0xf4, 0x84, 0xde, 0x37, 0xb3, 0xc8,
-	0x0e, 0x6d, 0x3f, 0x70, 0xbd, 0xc1, 0xf0, 0x2e, 0x57, 0x73, 0x78, 0xda, 0xb3, 0x3e, 0xe9, 0x13,
-	0xee, 0x65, 0xf5, 0xff, 0x28, 0x42, 0x69, 0xe7, 0xd0, 0xf0, 0xac, 0xa6, 0xb3, 0xef, 0xaa, 0xf3,
-	0x20, 0xfb, 0xf4, 0xa3, 0x6d, 0x5b, 0x5a, 0x61, 0xb9, 0xb0, 0x32, 0xaa, 0x17, 0xf1, 0xbb, 0x69,
-	0x51, 0x90, 0x67, 0x38, 0x07, 0x84, 0x82, 0x2e, 0x2f, 0x17, 0x56, 0x46, 0xf4, 0x22, 0x7e, 0x37,
-	0x2d, 0x75, 0x1a, 0x46, 0xdd, 0x13, 0x87, 0x78, 0xda, 0xc8, 0x72, 0x61, 0xa5, 0xa4, 0xb3, 0x0f,
-	0xf5, 0x26, 0xa8, 0x7e, 0xe0, 0x76, 0x88, 0xd3, 0xf6, 0x6d, 0xc7, 0x24, 0x6d, 0x8f, 0x38, 0xe4,
-	0x44, 0x1b, 0x43, 0xae, 0x0a, 0x83, 0xec, 0x50, 0x80, 0x4e, 0xd7, 0xd5, 0x0d, 0x28, 0xf7, 0x7b,
-	0x96, 0x11, 0x90, 0x36, 0x75, 0x51, 0xad, 0xb8, 0x5c, 0x58, 0x29, 0xaf, 0x2f, 0x34, 0x98, 0xff,
-	0x36, 0x84, 0xff, 0x36, 0x76, 0x85, 0xff, 0x6e, 0x4a, 0xdf, 0xff, 0xcf, 0x52, 0x41, 0x07, 0x46,
-	0x44, 0x97, 0xd5, 0x7b, 0x50, 0x73, 0x8c, 0x2e, 0xf1, 0x7b, 0x86, 0x49, 0xda, 0x8e, 0x1b, 0xd8,
-	0xfb, 0xb6, 0x89, 0x97, 0xa1, 0x7d, 0x4c, 0x0d, 0xe0, 0x3a, 0x5a, 0x09, 0xf5, 0x5e, 0x0c, 0xb1,
-	0x1e, 0xc5, 0x90, 0xbe, 0x60, 0x38, 0xea, 0xdf, 0x15, 0x60, 0xde, 0x23, 0xbd, 0x8e, 0xa0, 0xb5,
-	0x3a, 0xcf, 0xda, 0x86, 0x79, 0xd4, 0xee, 0x90, 0x63, 0xd2, 0xd1, 0xc6, 0x97, 0x47, 0x56, 0xca,
-	0xeb, 0xcd, 0xc6, 0xf9, 0x77, 0xb3, 0x11, 0x5a, 0xb5, 0xa1, 0x47, 0xec, 0xee, 0x75, 0x9e, 0x6d,
-	0x98, 0x47, 0x0f, 0x29, 0xaf, 0x6d, 0x27, 0xf0, 0x06, 0xfa, 0xac, 0x97, 0x09, 0x54, 0x8f, 0x40,
-	0xc1, 0x73, 0x8a, 0x64, 0xfb, 0x9a, 0x82, 0xc2, 0x37, 0x2e, 0x26, 0xfc, 0x33, 0xca, 0x45, 0xb0,
-	0xf5, 0x99, 0xd0, 0xea, 0xb3, 0xc4, 0xa2, 0x6a, 0x40, 0x85, 0x09, 0xf3, 0x03, 0x23, 0x20, 0xbe,
-	0x36, 0x89, 0x82, 0x3e, 0x7e, 0x09, 0x41, 0x3b, 0xc8, 0x80, 0x49, 0x29, 0x3f, 0x8b, 0x56, 0x16,
-	0x9a, 0xf0, 0xda, 0x19, 0x66, 0x50, 0x15, 0x18, 0x39, 0x22, 0x03, 0xf4, 0xb9, 0x92, 0x4e, 0x7f,
-	0x52, 0xa7, 0x3a, 0x36, 0x3a, 0x7d, 0xc2, 0x9d, 0x8d, 0x7d, 0x7c, 0x78, 0xf9, 0xfd, 0xc2, 0x42,
-	0x00, 0x53, 0x19, 0x9b, 0x8a, 0xb3, 0x18, 0x65, 0x2c, 0x3e, 0x89, 0xb3, 0x28, 0xaf, 0xaf, 0xe5,
-	0xd9, 0x4f, 0x82, 0x73, 0x5c, 0xaa, 0x03, 0x4a, 0x7a, 0x87, 0x19, 0x22, 0xef, 0x25, 0x45, 0x36,
-	0x72, 0x8b, 0x44, 0xb6, 0x31, 0x79, 0x2d, 0x49, 0x96, 0x94, 0xd1, 0x96, 0x24, 0x8f, 0x2a, 0x63,
-	0x2d, 0x49, 0x96, 0x95, 0x52, 0x4b, 0x92, 0x41, 0x29, 0xb7, 0x24, 0xb9, 0xac, 0x54, 0x5a, 0x92,
-	0x5c, 0x51, 0xc6, 0x5b, 0x92, 0x5c, 0x55, 0x26, 0x5a, 0x92, 0x3c, 0xa1, 0x28, 0xf5, 0x7f, 0xbd,
-	0x0a, 0x33, 0x4f, 0x79, 0x10, 0xd9, 0x16, 0xb9, 0x04, 0x2f, 0xf5, 0x55, 0xa8, 0x44, 0xf7, 0x82,
-	0x5f, 0xec, 0x92, 0x5e, 0x0e, 0xd7, 0x9a, 0x96, 0xba, 0x04, 0xe5, 0x30, 0x00, 0xf1, 0xfb, 0x5d,
-	0xd2, 0x41, 0x2c, 0x35, 0x2d, 0xb5, 0x01, 0x53, 0x3d, 0xc3, 0x23, 0x4e, 0xd0, 0x4e, 0xb0, 0x62,
-	0x17, 0x7e, 0x92, 0x81, 0x1e, 0xc5, 0x18, 0xde, 0x04, 0x95, 0xe3, 0xc7, 0xf9, 0x4a, 0x88, 0xae,
-	0x30, 0xc8, 0xd3, 0x88, 0x7b, 0x1d, 0xc6, 0x39, 0xb6, 0xd7, 0x77, 0x28, 0xe2, 0x28, 0x53, 0x91,
-	0x2d, 0xea, 0x7d, 0x27, 0xa1, 0x81, 0xed, 0xd8, 0x81, 0x6d, 0x04, 0x04, 0xa3, 0xd4, 0x18, 0x7a,
-	0x07, 0xd7, 0xa0, 0x29, 0x20, 0x4d, 0x4b, 0xfd, 0x00, 0xe6, 0x4d, 0xb7, 0xdb, 0xeb, 0x10, 0xbc,
-	0xc5, 0xe4, 0x98, 0x52, 0xee, 0x19, 0x81, 0x79, 0x48, 0xa9, 0x8a, 0x48, 0x35, 0x1b, 0x21, 0x6c,
-	0x53, 0xf8, 0x26, 0x05, 0x37, 0x2d, 0xf5, 0x0a, 0x00, 0x46, 0x61, 0xf4, 0x5f, 0x0c, 0x1a, 0x25,
-	0xbd, 0x44, 0x57, 0xf0, 0xa4, 0xe8, 0xde, 0xa2, 0x68, 0x3d, 0xe8, 0x11, 0x34, 0x89, 0x06, 0x6c,
-	0x6f, 0x02, 0xb2, 0x3b, 0xe8, 0x11, 0x6a, 0x10, 0xf5, 0x1b, 0x58, 0x08, 0xb1, 0xc3, 0x1c, 0x8f,
-	0x41, 0xce, 0xed, 0x07, 0x5a, 0x19, 0xdd, 0x64, 0x7e, 0x28, 0xce, 0xdd, 0xe3, 0x79, 0x7c, 0x53,
-	0xfa, 0x47, 0x1a, 0xe6, 0xb4, 0x93, 0xf4, 0xc9, 0xee, 0x32, 0x06, 0xea, 0x67, 0x30, 0x1d, 0xb2,
-	0xa7, 0xc6, 0x13, 0x8c, 0x2b, 0xf9, 0x18, 0x87, 0x3b, 0xd1, 0xfb, 0x21, 0xcb, 0x3d, 0xb8, 0x62,
-	0x91, 0x7d, 0xa3, 0xdf, 0x89, 0x1d, 0x1e, 0xcb, 0x4a, 0x9c, 0xf7, 0x78, 0x3e, 0xde, 0x0b, 0x9c,
-	0x8b, 0x38, 0xe8, 0x5d, 0xc3, 0x3f, 0x12, 0x32, 0xde, 0x06, 0xb5, 0x63, 0xf8, 0x01, 0x3f, 0x17,
-	0xe4, 0x6e, 0x5b, 0xda, 0x24, 0x1e, 0xcb, 0x04, 0x85, 0xe0, 0x81, 0x50, 0x8a, 0xa6, 0xa5, 0xbe,
-	0x03, 0x53, 0x88, 0xbc, 0x6f, 0x7b, 0x21, 0x89, 0x6d, 0x69, 0x2a, 0x62, 0x2b, 0x14, 0x74, 0x9f,
-	0x42, 0x90, 0xa4, 0x69, 0xa9, 0x7f, 0x05, 0xaf, 0x23, 0x7a, 0x52, 0x79, 0x3f, 0x30, 0x3c, 0xea,
-	0x33, 0x21, 0xf9, 0x14, 0x92, 0xd7, 0x28, 0x6a, 0x5c, 0xc3, 0x1d, 0x86, 0x27, 0x98, 0xdd, 0x05,
-	0x40, 0x4a, 0x96, 0x96, 0xa6, 0x73, 0xa6, 0xa5, 0x12, 0xd2, 0x60, 0x56, 0x6a, 0x01, 0x6a, 0xd8,
-	0x8e, 0x67, 0xb7, 0x99, 0x9c, 0x6c, 0xaa, 0x94, 0xf2, 0xf3, 0x28, 0xc3, 0xad, 0xc3, 0x4c, 0x72,
-	0x53, 0x22, 0xb1, 0xcd, 0xe2, 0x5e, 0xa6, 0x4e, 0x62, 0xfb, 0x10, 0xf9, 0xec, 0x3e, 0x2c, 0xa7,
-	0x0c, 0x61, 0x1e, 0x12, 0xab, 0xdf, 0x89, 0x9b, 0x62, 0x8e, 0xe5, 0xc5, 0x38, 0xf9, 0x8e, 0xc0,
-	0x12, 0x86, 0xd8, 0x84, 0xda, 0x39, 0x06, 0xd5, 0x90, 0xcb, 0xc2, 0xc9, 0xe9, 0xc6, 0xdc, 0x49,
-	0xeb, 0x2f, 0x3c, 0x6a, 0x3e, 0x9f, 0x47, 0x25, 0x36, 0x28, 0x5c, 0x69, 0xc8, 0x28, 0x46, 0x40,
-	0x83, 0x6e, 0xa0, 0x2d, 0x60, 0x58, 0x4e, 0xd0, 0x6c, 0x30, 0x50, 0xe2, 0x52, 0x26, 0x36, 0x83,
-	0xc7, 0xf3, 0x5a, 0xce, 0xe3, 0x99, 0xcb, 0xd8, 0x2a, 0x9e, 0x93, 0x01, 0x8b, 0xa7, 0xd9, 0x1c,
-	0x05, 0x2c, 0xe6, 0x14, 0x30, 0x9f, 0x79, 0x22, 0x28, 0xc2, 0x83, 0xeb, 0x49, 0x11, 0xae, 0x67,
-	0x1f, 0xd8, 0x8e, 0xd1, 0x49, 0xcb, 0xaa, 0xe5, 0x94, 0x75, 0x35, 0x2e, 0xeb, 0x31, 0x67, 0x96,
-	0x94, 0x79, 0x07, 0xb4, 0xa4, 0x4c, 0x8f, 0x3c, 0xeb, 0x13, 0x1f, 0x0f, 0x7f, 0x09, 0xc3, 0xdf,
-	0x4c, 0x9c, 0x89, 0xce, 0xa0, 0x4d, 0x4b, 0xfd, 0x3a, 0x1e, 0x31, 0x45, 0x7d, 0xab, 0xdd, 0x5b,
-	0x2e, 0xac, 0x54, 0x4f, 0x49, 0x91, 0x58, 0x17, 0xd3, 0xe4, 0x98, 0x08, 0x1e, 0x83, 0x1e, 0x89,
-	0x45, 0x58, 0xbe, 0xa2, 0x3e, 0x4e, 0x9b, 0xc2, 0xef, 0x1f, 0x1c, 0x50, 0xb5, 0x4c, 0xd7, 0x09,
-	0x6c, 0x87, 0xd6, 0x50, 0x7e, 0x9b, 0xd6, 0x9e, 0xdb, 0xcb, 0x85, 0x15, 0x59, 0x5f, 0x4e, 0x18,
-	0x95, 0xa1, 0x6e, 0x71, 0xcc, 0x0d, 0xff, 0x11, 0x39, 0x19, 0xbe, 0x32, 0xbc, 0xdc, 0x6e, 0xfb,
-	0xf6, 0xb7, 0xa4, 0xbd, 0x37, 0xa0, 0x25, 0xd2, 0xfd, 0xe1, 0x2b, 0xf3, 0x80, 0x61, 0xed, 0xd8,
-	0xdf, 0x92, 0x4d, 0x8a, 0xa3, 0xde, 0x00, 0xc5, 0x34, 0x1c, 0x93, 0x74, 0x84, 0xa1, 0x88, 0xa5,
-	0x5d, 0x41, 0x1d, 0x26, 0xd8, 0xba, 0x2e, 0x96, 0xd5, 0xb7, 0x60, 0x32, 0x89, 0x4a, 0x6d, 0xba,
-	0x8c, 0x36, 0x4d, 0xe2, 0x36, 0x11, 0xd7, 0x0f, 0x6c, 0xf3, 0x68, 0xd0, 0x8e, 0x65, 0xa9, 0xab,
-	0x0c, 0x97, 0x01, 0x76, 0xc3, 0x5c, 0x75, 0x00, 0xcb, 0x1c, 0x57, 0xb8, 0x45, 0x3b, 0x70, 0xdb,
-	0x51, 0x44, 0xa3, 0x97, 0xaf, 0x9e, 0xef, 0xf2, 0x2d, 0x32, 0x46, 0xc2, 0x25, 0x76, 0xdd, 0x1d,
-	0x11, 0xe3, 0xe8, 0x2d, 0xd4, 0xa0, 0x28, 0xee, 0xdd, 0xeb, 0xac, 0x71, 0xe0, 0x9f, 0xea, 0xe7,
-	0x30, 0xeb, 0x91, 0xc0, 0x1b, 0xf0, 0xbc, 0xdd, 0x69, 0xdb, 0x4e, 0x40, 0xbc, 0x63, 0xa3, 0xa3,
-	0x5d, 0xcb, 0x27, 0x78, 0x1a, 0xc9, 0x59, 0x6e, 0xef, 0x34, 0x39, 0x71, 0xc4, 0xb6, 0x6b, 0x3c,
-	0xb7, 0xbb, 0xfd, 0x6e, 0xc4, 0xf6, 0xfa, 0x45, 0xd8, 0x7e, 0xca, 0xa8, 0x43, 0xb6, 0xb7, 0xd3,
-	0x6c, 0xf9, 0x36, 0x7c, 0xed, 0x0d, 0xdc, 0x56, 0x82, 0x8a, 0x87, 0x13, 0x5f, 0xfd, 0x90, 0xf6,
-	0x0c, 0x94, 0x6a, 0xcf, 0x30, 0x8f, 0xdc, 0xfd, 0xfd, 0xb6, 0xe9, 0x92, 0xfd, 0x7d, 0xdb, 0xb4,
-	0x89, 0x13, 0x68, 0x6f, 0x2e, 0x17, 0x56, 0x0a, 0xfa, 0x1c, 0x22, 0x6c, 0x32, 0xf8, 0x56, 0x04,
-	0x56, 0xbb, 0x50, 0xcf, 0x28, 0x10, 0xc8, 0xf3, 0x9e, 0xcd, 0xd4, 0x65, 0xd7, 0x78, 0x25, 0xe7,
-	0x35, 0x5e, 0x1a, 0xaa, 0x14, 0xb6, 0x43, 0x4e, 0xbc, 0x4b, 0x5a, 0x62, 0xaa, 0x3a, 0xae, 0xd3,
-	0xc6, 0x5f, 0xc6, 0x5e, 0x87, 0xb4, 0x89, 0xe7, 0xb9, 0x1e, 0xde, 0x4b, 0x5f, 0xbb, 0xb1, 0x3c,
-	0xb2, 0x52, 0xd2, 0x5f, 0x43, 0xe0, 0x23, 0xd7, 0xd1, 0x05, 0xd2, 0x36, 0xc5, 0xa1, 0x57, 0xce,
-	0x57, 0x57, 0x40, 0x39, 0x34, 0x7c, 0x46, 0xdf, 0xee, 0xb9, 0x1d, 0xdb, 0x1c, 0x68, 0x6f, 0xa1,
-	0x6b, 0x57, 0x0f, 0x0d, 0x1f, 0x29, 0x9e, 0xe0, 0xaa, 0xfa, 0x3a, 0x8c, 0x9b, 0x9e, 0xeb, 0x84,
-	0xfe, 0xa7, 0xbd, 0x8d, 0x9e, 0x5a, 0xa1, 0x8b, 0xc2, 0x97, 0x68, 0x89, 0xea, 0xdb, 0x07, 0x34,
-	0x7a, 0x99, 0x6e, 0xdf, 0x09, 0xb4, 0x06, 0xde, 0xae, 0x32, 0x5b, 0xdb, 0xa2, 0x4b, 0xea, 0x67,
-	0x30, 0x69, 0xf4, 0x03, 0xb7, 0xed, 0x11, 0x9f, 0x04, 0xed, 0x9e, 0x6b, 0x3b, 0x81, 0xaf, 0xdd,
-	0x42, 0xab, 0x5c, 0x8f, 0x42, 0x08, 0x8d, 0x1d, 0x61, 0x83, 0x7e, 0xbc, 0xd6, 0xd0, 0x29, 0xf6,
-	0x13, 0x44, 0xd6, 0x27, 0x28, 0x7d, 0x6c, 0x41, 0xfd, 0x5b, 0x98, 0xf4, 0x89, 0xe1, 0x99, 0x87,
-	0xf4, 0x90, 0x3d, 0x7b, 0xaf, 0x4f, 0x2f, 0xf6, 0x6d, 0xec, 0x7d, 0x1e, 0xe7, 0x29, 0xdc, 0x33,
-	0xcb, 0xed, 0xc6, 0x0e, 0xb2, 0xdc, 0x08, 0x39, 0xb2, 0x66, 0x48, 0xf1, 0x53, 0xcb, 0xea, 0x53,
-	0x90, 0xba, 0xa4, 0xeb, 0x6a, 0xef, 0xa1, 0xc0, 0xad, 0x97, 0x17, 0xf8, 0x29, 0xe9, 0xba, 0x4c,
-	0x08, 0x32, 0x54, 0xbf, 0x81, 0x49, 0x5e, 0x17, 0xf0, 0xc0, 0x65, 0x13, 0x5f, 0xfb, 0x33, 0xb4,
-	0xd4, 0xbb, 0x99, 0x52, 0x78, 0x78, 0xa3, 0x12, 0x78, 0xd5, 0xf0, 0x40, 0xd0, 0xe9, 0xca, 0x71,
-	0x6a, 0x45, 0xbd, 0x05, 0xb3, 0xbc, 0x10, 0x0b, 0x9d, 0x95, 0x57, 0xed, 0x77, 0xf0, 0x64, 0xa7,
-	0x10, 0x1a, 0xaa, 0xc8, 0xaa, 0xf7, 0xbf, 0x86, 0x89, 0x08, 0x9d, 0x76, 0x99, 0xbe, 0xf6, 0x3e,
-	0x6a, 0xb4, 0x9e, 0x67, 0xdf, 0x21, 0x33, 0xda, 0x25, 0xf9, 0x7a, 0x95, 0x24, 0xbe, 0x13, 0xe9,
-	0x96, 0xaa, 0x92, 0xbe, 0x3b, 0x1f, 0x5c, 0x34, 0xdd, 0xea, 0xfd, 0xf4, 0xad, 0xb9, 0x0d, 0x73,
-	0x43, 0x25, 0x68, 0xf0, 0x1c, 0x77, 0xfd, 0x21, 0xab, 0xbd, 0x92, 0x65, 0xe8, 0xee, 0x73, 0xba,
-	0xeb, 0xdb, 0x30, 0x8b, 0x1d, 0x75, 0x3b, 0xf0, 0x0c, 0xc7, 0xb7, 0x51, 0x23, 0xe6, 0xe0, 0x1f,
-	0x21, 0xd1, 0x34, 0x42, 0x77, 0x43, 0x20, 0xf3, 0xf4, 0x4f, 0xa0, 0x9a, 0x6c, 0x14, 0xb4, 0x3f,
-	0xcf, 0xb9, 0x81, 0x71, 0x12, 0x6f, 0x0f, 0xd4, 0x55, 0x98, 0x76, 0xc8, 0xc9, 0xf0, 0x39, 0xfd,
-	0x05, 0xeb, 0xda, 0x1c, 0x72, 0x92, 0x3a, 0xa5, 0x87, 0x50, 0xe1, 0x3d, 0x16, 0x0e, 0xd1, 0xb4,
-	0x8f, 0x51, 0xee, 0x8d, 0xcc, 0x23, 0x42, 0x0c, 0xe6, 0x32, 0x66, 0xe0, 0x7a, 0x5b, 0xf4, 0x53,
-	0x74, 0x6c, 0xf8, 0xa1, 0xbe, 0x0f, 0xda, 0x50, 0xc7, 0x26, 0x0a, 0xd6, 0xbb, 0xac, 0x01, 0x4b,
-	0xb5, 0x6d, 0xa2, 0x66, 0xbd, 0x05, 0xb3, 0x66, 0xc7, 0xf5, 0xb9, 0xdd, 0xf6, 0x89, 0x17, 0x76,
-	0x08, 0x7f, 0xc9, 0x8c, 0x8d, 0xd0, 0x5d, 0x0e, 0xe4, 0x5d, 0xc2, 0x1d, 0xd0, 0x18, 0xd1, 0xb1,
-	0xed, 0xdb, 0x7b, 0x76, 0xc7, 0x0e, 0x06, 0x21, 0xd9, 0x06, 0x92, 0xcd, 0x20, 0xfc, 0x8b, 0x10,
-	0xcc, 0x09, 0xef, 0x02, 0x70, 0x69, 0xd4, 0xd6, 0x9b, 0x79, 0x4b, 0x7c, 0xa6, 0x03, 0xb5, 0xf3,
-	0x36, 0x2c, 0x65, 0x4b, 0xe6, 0xfd, 0x25, 0xb1, 0xb4, 0x2d, 0x8c, 0x8d, 0x8b, 0x19, 0x0a, 0x6c,
-	0x09, 0x9c, 0x05, 0x0b, 0x66, 0x32, 0x63, 0x47, 0xc6, 0x70, 0xe4, 0xbd, 0xe4, 0x98, 0x61, 0x29,
-	0x19, 0x00, 0xf9, 0x30, 0xf3, 0x78, 0xad, 0xf1, 0xc4, 0x18, 0x74, 0x5c, 0xc3, 0x8a, 0xcf, 0x31,
-	0xbe, 0x84, 0x52, 0x18, 0x30, 0x7e, 0x53, 0xce, 0xe1, 0x94, 0x22, 0x9c, 0x49, 0xb4, 0x24, 0x59,
-	0x51, 0x26, 0x5b, 0x92, 0x7c, 0x53, 0x79, 0xa7, 0x25, 0xc9, 0xef, 0x28, 0x8d, 0x96, 0x24, 0xaf,
-	0x2a, 0xef, 0xb6, 0x24, 0xf9, 0x5d, 0x65, 0xad, 0x25, 0xc9, 0x6b, 0xca, 0x7a, 0x4b, 0x92, 0xd7,
-	0x95, 0x5b, 0xf5, 0x5b, 0x50, 0x4d, 0x5e, 0x72, 0x9a, 0x12, 0xe2, 0x65, 0x17, 0xea, 0x38, 0xa2,
-	0x97, 0x0f, 0xa3, 0x22, 0xab, 0xfe, 0x4b, 0x01, 0x66, 0x87, 0x42, 0x22, 0x0e, 0x52, 0xb0, 0x9e,
-	0xf2, 0x08, 0xbd, 0x7a, 0xb1, 0x7a, 0xaa, 0xc0, 0xeb, 0x29, 0x04, 0x44, 0xf5, 0xd4, 0x0c, 0x8c,
-	0xf1, 0x8b, 0xc1, 0xe6, 0x1e, 0xa3, 0x1e, 0x5e, 0x86, 0x16, 0x8c, 0xe2, 0xf5, 0xc4, 0x21, 0x47,
-	0x75, 0xfd, 0x76, 0xbe, 0x3a, 0x35, 0xa9, 0x87, 0xce, 0x58, 0xa8, 0xf7, 0x61, 0x8c, 0xfe, 0xe8,
-	0xfb, 0x38, 0x02, 0x49, 0x14, 0xbd, 0xe7, 0x73, 0xe9, 0xfb, 0x3a, 0xa7, 0xae, 0xff, 0x61, 0x0c,
-	0x94, 0x84, 0xdb, 0xff, 0x56, 0xf3, 0x9d, 0xc8, 0x06, 0x23, 0x71, 0x1b, 0x6c, 0x41, 0x29, 0xaa,
-	0xd7, 0x99, 0xea, 0x6f, 0x9c, 0x6d, 0x87, 0xb0, 0x4e, 0x97, 0x03, 0x51, 0x9f, 0x37, 0x60, 0x2a,
-	0x30, 0xbc, 0x03, 0x92, 0x9a, 0x1d, 0xb1, 0x19, 0xcf, 0x24, 0x03, 0xa5, 0x66, 0x47, 0x1c, 0x3f,
-	0xae, 0xf3, 0x18, 0x9b, 0xaf, 0x30, 0x48, 0x72, 0x76, 0xc4, 0xb1, 0xf9, 0x06, 0x8a, 0x6c, 0xfb,
-	0x6c, 0x91, 0xc5, 0xb5, 0xe4, 0x40, 0x47, 0x4e, 0x0f, 0x74, 0x3e, 0x82, 0x05, 0xce, 0xc2, 0x3c,
-	0xb4, 0x3b, 0x56, 0x24, 0xd6, 0x75, 0x3a, 0x03, 0x9c, 0xff, 0xc8, 0xfa, 0x1c, 0xc3, 0xd8, 0xa2,
-	0x08, 0x42, 0xfa, 0x63, 0xa7, 0x33, 0xc0, 0x31, 0xf7, 0x70, 0x47, 0x0d, 0x6c, 0x36, 0xe1, 0xa7,
-	0xbb, 0x68, 0x0d, 0x8a, 0x22, 0x04, 0x96, 0xd9, 0x10, 0x9d, 0x7f, 0xaa, 0x73, 0x50, 0x14, 0xd1,
-	0xaa, 0x82, 0x90, 0xb1, 0x80, 0x85, 0xa7, 0x26, 0x4c, 0xc4, 0xe3, 0x0a, 0x8d, 0x51, 0xe3, 0x79,
-	0xe7, 0x07, 0x11, 0x21, 0x06, 0xaa, 0x9b, 0xa0, 0x5a, 0x84, 0x06, 0x9b, 0xb6, 0xb1, 0x1f, 0x10,
-	0xaf, 0x8d, 0xe1, 0x48, 0x9b, 0xc0, 0x0d, 0x2a, 0x0c, 0xb2, 0x41, 0x01, 0x5b, 0x74, 0x5d, 0xfd,
-	0x87, 0x02, 0xb0, 0x80, 0x15, 0x9f, 0x5b, 0x51, 0x15, 0x2d, 0x12, 0x18, 0x36, 0xce, 0xa3, 0xa9,
-	0x1a, 0x8f, 0xf2, 0x64, 0xf0, 0xb4, 0xd3, 0x36, 0x50, 0x44, 0x34, 0xcd, 0x32, 0xfc, 0xa3, 0x7b,
-	0x8c, 0xeb, 0x83, 0x4b, 0xfa, 0xbc, 0x79, 0x1a, 0x70, 0xe1, 0x6b, 0x98, 0x3f, 0x95, 0x52, 0xbd,
-	0x0b, 0x8b, 0xa6, 0xe1, 0xb4, 0xfd, 0x23, 0xbb, 0x17, 0x0f, 0xc5, 0x34, 0xa4, 0xda, 0xb4, 0x31,
-	0x28, 0xe0, 0x46, 0xe7, 0x4d, 0xc3, 0xd9, 0x39, 0xb2, 0x7b, 0x51, 0x18, 0xde, 0xe0, 0x08, 0x9b,
-	0x55, 0xa8, 0xc4, 0x37, 0xc8, 0x62, 0x59, 0xfd, 0x5f, 0x24, 0x98, 0x8a, 0xcd, 0xae, 0x7f, 0x37,
-	0xf7, 0x2e, 0xe6, 0x6b, 0xa3, 0x49, 0x5f, 0xbb, 0x06, 0xd5, 0xd4, 0x2c, 0x8d, 0x8d, 0x51, 0x2b,
-	0xfb, 0xf1, 0x39, 0x5a, 0x1d, 0xc6, 0x1d, 0xf2, 0x3c, 0x86, 0xc4, 0xa6, 0xa6, 0x65, 0xba, 0x28,
-	0x70, 0xb2, 0xbd, 0x5f, 0x3e, 0xc5, 0xfb, 0xaf, 0x42, 0x65, 0xcf, 0x33, 0x1c, 0xf3, 0xb0, 0x1d,
-	0xb8, 0x47, 0x84, 0x5d, 0x81, 0x8a, 0x5e, 0x66, 0x6b, 0xbb, 0x74, 0x49, 0xd4, 0x2c, 0xd4, 0x28,
-	0x09, 0xd4, 0x71, 0x44, 0xa5, 0x35, 0x8b, 0xde, 0x77, 0x36, 0x63, 0x04, 0xb1, 0x7b, 0x33, 0x71,
-	0xde, 0xbd, 0x51, 0x5e, 0xf2, 0xde, 0x2c, 0x02, 0x08, 0xa5, 0xf8, 0x94, 0xb2, 0xa4, 0xcb, 0x4c,
-	0x95, 0xa6, 0xd5, 0x92, 0xe4, 0x92, 0x02, 0xe1, 0x74, 0x3e, 0x9c, 0xcb, 0xd7, 0xff, 0x7f, 0x04,
-	0xd4, 0x54, 0xb1, 0xf1, 0xfb, 0x76, 0x9b, 0x98, 0xa9, 0xc7, 0xce, 0x33, 0x75, 0xf1, 0x25, 0x4d,
-	0x9d, 0x2c, 0xc6, 0xe4, 0x8b, 0x17, 0x63, 0xc9, 0x81, 0x6d, 0xe9, 0xe2, 0x03, 0xdb, 0xb3, 0xea,
-	0x48, 0x38, 0xa3, 0x8e, 0xac, 0xff, 0x22, 0xc1, 0x38, 0xe5, 0xf0, 0xfb, 0xc9, 0xcc, 0xdb, 0x50,
-	0xe1, 0x43, 0x20, 0xc6, 0x67, 0x14, 0xf9, 0xd4, 0x4f, 0x29, 0x4e, 0xf8, 0xa8, 0x07, 0x79, 0x94,
-	0x83, 0xe8, 0x43, 0x25, 0xb1, 0x09, 0xac, 0x18, 0x80, 0x20, 0xbf, 0x31, 0xe4, 0xb7, 0x96, 0xaf,
-	0x72, 0xe2, 0xa3, 0x11, 0x64, 0x1f, 0x0e, 0x6d, 0x63, 0x8b, 0x71, 0xc7, 0x2c, 0x26, 0x1d, 0xf3,
-	0x06, 0x84, 0xb1, 0x26, 0x9c, 0xfe, 0xca, 0x38, 0xae, 0x99, 0x10, 0xeb, 0x62, 0xf2, 0x3b, 0x0f,
-	0x72, 0x18, 0xa6, 0xd8, 0x73, 0x70, 0x91, 0xf0, 0xe8, 0x14, 0x73, 0x6f, 0x38, 0xcf, 0xbd, 0xcb,
-	0x2f, 0xe9, 0xde, 0xe9, 0x08, 0x58, 0x19, 0x8e, 0x80, 0x37, 0x40, 0x31, 0x3a, 0x1e, 0x31, 0x2c,
-	0x91, 0xb9, 0x88, 0x85, 0xd1, 0x4f, 0xd6, 0x27, 0xf8, 0xfa, 0x06, 0x5f, 0xae, 0xff, 0xf3, 0x65,
-	0x50, 0x44, 0xf2, 0x0a, 0x9d, 0x2e, 0xb6, 0x8d, 0x42, 0x62, 0x1b, 0x69, 0x6f, 0xbc, 0x7c, 0xae,
-	0x37, 0x8e, 0x9c, 0xe1, 0x8d, 0xd2, 0xa9, 0xde, 0x38, 0xfa, 0xa7, 0x07, 0x9e, 0xb1, 0xe4, 0xf9,
-	0xfe, 0x76, 0xf1, 0xa5, 0xfe, 0xf7, 0x55, 0xa8, 0x6c, 0x98, 0x81, 0x7d, 0x6c, 0x07, 0x03, 0x34,
-	0x57, 0x4c, 0x6a, 0x21, 0x29, 0xf5, 0x0e, 0x68, 0xe9, 0xdc, 0x16, 0x3e, 0x20, 0xb2, 0x47, 0xe9,
-	0x99, 0x64, 0x86, 0x13, 0xef, 0x87, 0x9f, 0x40, 0x35, 0x35, 0x84, 0x97, 0xf2, 0x36, 0xf0, 0x7e,
-	0x62, 0xe0, 0xbe, 0x02, 0xca, 0xd0, 0x2b, 0x0b, 0x8b, 0xc9, 0x55, 0x3f, 0xf9, 0xb2, 0xb2, 0x05,
-	0x95, 0xc4, 0x13, 0x46, 0x5e, 0xf3, 0x94, 0xfd, 0xd8, 0xb3, 0xc5, 0x12, 0x94, 0x0d, 0x6e, 0x1a,
-	0x91, 0xc5, 0x4b, 0x3a, 0x88, 0x25, 0x56, 0x47, 0xc7, 0xda, 0x29, 0xfe, 0x30, 0xea, 0x85, 0x8d,
-	0xd4, 0x57, 0x30, 0x7f, 0xfa, 0x94, 0x19, 0xf2, 0x4d, 0x65, 0x67, 0xfd, 0xec, 0xf9, 0x72, 0x8a,
-	0x77, 0x94, 0x23, 0x2e, 0xf0, 0x8a, 0x1a, 0xe3, 0xbd, 0x25, 0xf2, 0x05, 0xe5, 0xbd, 0x8b, 0x63,
-	0x1a, 0xaa, 0x6b, 0x9a, 0x71, 0xce, 0x57, 0xd4, 0x29, 0x96, 0x3d, 0x92, 0x5c, 0x1f, 0xc2, 0xe4,
-	0x21, 0x31, 0xbc, 0x60, 0x8f, 0x18, 0xc1, 0x45, 0x9f, 0x4e, 0x95, 0x90, 0x52, 0x70, 0xcb, 0x7a,
-	0x4b, 0xa8, 0x5e, 0xe0, 0x2d, 0x81, 0xd5, 0x46, 0x59, 0x6f, 0x09, 0x54, 0x35, 0x2f, 0x7c, 0x05,
-	0xa3, 0x3d, 0xaa, 0xc2, 0x42, 0x67, 0x20, 0x72, 0x19, 0x6b, 0x42, 0xe3, 0x23, 0xfe, 0xc9, 0xe4,
-	0x88, 0x3f, 0xd9, 0x5f, 0xa9, 0xe9, 0xfe, 0xea, 0x46, 0xe4, 0xc6, 0xb6, 0x45, 0x9c, 0xc0, 0x0e,
-	0x06, 0xf8, 0xfa, 0x8a, 0xef, 0x15, 0xb8, 0xde, 0xe4, 0xcb, 0x99, 0x73, 0xe5, 0xe9, 0xcc, 0xb9,
-	0xf2, 0xe9, 0xcf, 0x0a, 0x33, 0xaf, 0xe6, 0x59, 0x61, 0xf6, 0xd5, 0x3c, 0x2b, 0xcc, 0x9d, 0xf1,
-	0xac, 0xb0, 0x0b, 0x33, 0x8c, 0x2a, 0x3d, 0xd1, 0xd4, 0x72, 0x5e, 0xef, 0x29, 0x24, 0x4f, 0xcd,
-	0x32, 0xcf, 0x7c, 0xac, 0x98, 0x3f, 0xfb, 0xb1, 0x22, 0xc7, 0xeb, 0xc1, 0xc2, 0xf9, 0xaf, 0x07,
-	0x8f, 0x40, 0x65, 0x5c, 0xd8, 0x4c, 0x95, 0xfd, 0x0d, 0x90, 0x3f, 0xbb, 0x2e, 0x27, 0xab, 0x0f,
-	0x0e, 0xa4, 0x29, 0xe3, 0x3e, 0xfb, 0xa9, 0x2b, 0x48, 0xfb, 0xd0, 0xf0, 0x03, 0xbe, 0x42, 0x1b,
-	0xf8, 0x18, 0x3f, 0x9a, 0xae, 0x88, 0x17, 0xb9, 0xda, 0x22, 0xba, 0xda, 0x5c, 0x48, 0xf5, 0x14,
-	0xe1, 0xa1, 0xcb, 0x65, 0xb7, 0x30, 0xb5, 0x53, 0x5a, 0x98, 0x2f, 0x60, 0x16, 0x85, 0x44, 0x57,
-	0x5b, 0x74, 0xc3, 0x4b, 0x59, 0xea, 0x0f, 0x0d, 0xcc, 0x7c, 0x7d, 0x9a, 0xd2, 0x3f, 0x10, 0xe4,
-	0xa2, 0x77, 0xfd, 0x06, 0x16, 0x52, 0x7c, 0xe3, 0x7f, 0x18, 0x58, 0xce, 0xfb, 0x22, 0x9d, 0xe0,
-	0x1d, 0xfd, 0x73, 0xa0, 0x25, 0xc9, 0x23, 0x8a, 0xd4, 0x92, 0xe4, 0x31, 0xa5, 0xd8, 0x92, 0xe4,
-	0x2b, 0x4a, 0xad, 0xfe, 0x5f, 0x05, 0x28, 0x61, 0xb5, 0x7a, 0x4e, 0x16, 0xcc, 0xca, 0x41, 0x97,
-	0x33, 0x73, 0xd0, 0x06, 0x94, 0xd1, 0x4f, 0x79, 0x86, 0x1e, 0xc9, 0xfb, 0x17, 0x3e, 0x46, 0x24,
-	0x32, 0x50, 0x3c, 0x10, 0x49, 0x28, 0x07, 0x63, 0x0b, 0x8f, 0x41, 0xf3, 0x20, 0xb3, 0x78, 0x15,
-	0x0e, 0x90, 0x8a, 0xf8, 0xdd, 0xb4, 0xea, 0xff, 0x2d, 0x81, 0x8a, 0xe3, 0x99, 0xe4, 0xbf, 0x9f,
-	0xce, 0xcc, 0xef, 0xd1, 0x60, 0x3a, 0x3b, 0xbf, 0x87, 0xf0, 0x44, 0x7e, 0xcf, 0x32, 0xc9, 0x48,
-	0xa6, 0x49, 0x1a, 0x30, 0x25, 0x30, 0xe3, 0x75, 0x15, 0x1f, 0x7d, 0x71, 0x50, 0x6c, 0x98, 0x75,
-	0x0d, 0x04, 0x07, 0xd1, 0x6c, 0xb2, 0xb1, 0x97, 0x48, 0xee, 0x6c, 0x9c, 0x95, 0x39, 0xdc, 0x94,
-	0xb3, 0x87, 0x9b, 0x8b, 0x50, 0x0a, 0x0b, 0x3c, 0x91, 0xb1, 0xc3, 0x85, 0x0b, 0xfe, 0x95, 0xe9,
-	0xcb, 0xf0, 0x2f, 0x58, 0x2c, 0x4b, 0xf2, 0xf8, 0x5c, 0xc6, 0x7a, 0x6f, 0xe5, 0x94, 0xae, 0xe1,
-	0x89, 0x78, 0x11, 0xf0, 0x09, 0x8b, 0xdc, 0xe2, 0xcf, 0x5a, 0xb1, 0x25, 0xaa, 0x47, 0xfa, 0x28,
-	0xc2, 0x39, 0x98, 0x92, 0x3c, 0x04, 0x1c, 0xd8, 0x8f, 0xb2, 0xf7, 0x89, 0xf1, 0x8b, 0xbe, 0x4f,
-	0x30, 0xba, 0xa1, 0x4a, 0xb8, 0x3a, 0x54, 0x09, 0x87, 0x7f, 0xbf, 0x2b, 0x2a, 0x72, 0xfd, 0xdf,
-	0x0a, 0x30, 0xc9, 0x2d, 0xba, 0x85, 0xf9, 0xf3, 0x55, 0x39, 0x56, 0x66, 0xe6, 0x1e, 0xc9, 0xfe,
-	0x17, 0x40, 0xb6, 0xc9, 0xa4, 0x6c, 0x93, 0xd5, 0xff, 0xbd, 0x00, 0xb0, 0x83, 0xaf, 0xa9, 0xaf,
-	0x4a, 0xf7, 0x64, 0x6d, 0x38, 0x92, 0xae, 0x0d, 0xb3, 0xd5, 0x2d, 0x66, 0xab, 0x9b, 0xfa, 0xf3,
-	0x23, 0x0b, 0x5a, 0xb2, 0x52, 0xaa, 0x7f, 0x57, 0x00, 0x79, 0xeb, 0x90, 0x98, 0x47, 0x7e, 0xbf,
-	0x9b, 0xde, 0xc4, 0x68, 0xb4, 0x89, 0x7b, 0x30, 0xb6, 0xdf, 0x31, 0x8e, 0x5d, 0x0f, 0x55, 0xae,
-	0xae, 0xdf, 0x3c, 0xbb, 0x17, 0x11, 0x1c, 0xef, 0x23, 0x8d, 0xce, 0x69, 0xa3, 0x7f, 0xa0, 0x8e,
-	0x60, 0x93, 0xc6, 0x3e, 0x36, 0xff, 0xe6, 0x87, 0x9f, 0x6a, 0x97, 0x7e, 0xfc, 0xa9, 0x76, 0xe9,
-	0xd7, 0x9f, 0x6a, 0x85, 0xef, 0x5e, 0xd4, 0x0a, 0xff, 0xf4, 0xa2, 0x56, 0xf8, 0xcf, 0x17, 0xb5,
-	0xc2, 0x0f, 0x2f, 0x6a, 0x85, 0xff, 0x7d, 0x51, 0x2b, 0xfc, 0xdf, 0x8b, 0xda, 0xa5, 0x5f, 0x5f,
-	0xd4, 0x0a, 0xdf, 0xff, 0x5c, 0xbb, 0xf4, 0xc3, 0xcf, 0xb5, 0x4b, 0x3f, 0xfe, 0x5c, 0xbb, 0xf4,
-	0xd5, 0xed, 0x03, 0x37, 0xd2, 0xc1, 0x76, 0x4f, 0xff, 0x77, 0xf6, 0x47, 0xb1, 0xcf, 0xbd, 0x31,
-	0x0c, 0x9a, 0xb7, 0xfe, 0x18, 0x00, 0x00, 0xff, 0xff, 0xd8, 0x0b, 0x92, 0x45, 0x40, 0x30, 0x00,
+	// 3633 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3a, 0x4d, 0x77, 0xe3, 0x46,
+	0x72, 0xc3, 0x11, 0x44, 0x82, 0x45, 0x8a, 0x82, 0xa0, 0x2f, 0x48, 0x96, 0x29, 0x0d, 0xfd, 0xb1,
+	0x1a, 0x7b, 0x4c, 0x59, 0x9a, 0x71, 0xec, 0xb5, 0x93, 0x75, 0x24, 0x8d, 0xc6, 0x43, 0xc6, 0x3b,
+	0x1e, 0x43, 0x5a, 0x7b, 0xdf, 0x66, 0xfd, 0xf8, 0x20, 0xa0, 0x29, 0x21, 0x02, 0x01, 0x0e, 0x00,
+	0x4a, 0xc3, 0x7d, 0x39, 0xec, 0x21, 0x87, 0x1c, 0x37, 0xb7, 0x9c, 0x72, 0xce, 0x31, 0x97, 0xdc,
+	0x73, 0xd8, 0x97, 0x97, 0x53, 0x9e, 0x6f, 0xd9, 0x5b, 0xe2, 0xf1, 0x25, 0x97, 0xbc, 0xf5, 0xcb,
+	0x2f, 0xc8, 0xeb, 0xea, 0x6e, 0x7c, 0x11, 0x92, 0xa0, 0x89, 0x7d, 0xf0, 0x8d, 0xe8, 0xfa, 0xec,
+	0xea, 0xea, 0xaa, 0xea, 0x2a, 0xc2, 0xfd, 0x90, 0x0c, 0x86, 0x9e, 0x6f, 0x38, 0x5b, 0x01, 0xf1,
+	0xcf, 0x89, 0xbf, 0x65, 0x0c, 0xed, 0xad, 0x21, 0xf1, 0x03, 0x3b, 0x08, 0x89, 0x6b, 0x92, 0xad,
+	0xf3, 0xed, 0x2d, 0xf2, 0x9c, 0x98, 0xa3, 0xd0, 0xf6, 0xdc, 0xa0, 0x3d, 0xf4, 0xbd, 0xd0, 0x53,
+	0x5b, 0x82, 0xa8, 0xcd, 0x88, 0xda, 0xc6, 0xd0, 0x6e, 0x27, 0x88, 0xda, 0xe7, 0xdb, 0xab, 0xcd,
+	0x13, 0xcf, 0x3b, 0x71, 0xc8, 0x16, 0x52, 0x1c, 0x8f, 0xfa, 0x5b, 0xd6, 0xc8, 0x37, 0x28, 0x13,
+	0xc6, 0x63, 0x75, 0x3d, 0x0b, 0x0f, 0xed, 0x01, 0x09, 0x42, 0x63, 0x30, 0xe4, 0x08, 0x77, 0x2c,
+	0x32, 0x24, 0xae, 0x45, 0x5c, 0xd3, 0x26, 0xc1, 0xd6, 0x89, 0x77, 0xe2, 0xe1, 0x3a, 0xfe, 0xe2,
+	0x28, 0xaf, 0x47, 0xca, 0x53, 0xad, 0x4d, 0x6f, 0x30, 0xf0, 0x5c, 0xaa, 0xf0, 0x80, 0x04, 0x81,
+	0x71, 0x42, 0x72, 0xb1, 0x88, 0x3b, 0x1a, 0x04, 0x14, 0xe9, 0xc2, 0xf3, 0xcf, 0xfa, 0x8e, 0x77,
+	0xc1, 0xb1, 0xde, 0x48, 0x61, 0xf5, 0x0d, 0xdb, 0x19, 0xf9, 0x64, 0x92, 0xd9, 0x9b, 0x29, 0x34,
+	0xc1, 0x63, 0x12, 0xef, 0xad, 0x3c, 0xbb, 0x9a, 0x8e, 0x67, 0x9e, 0x4d, 0xe2, 0xde, 0xcd, 0xc3,
+	0x8d, 0xf4, 0x64, 0xdb, 0xe2, 0xa8, 0x6f, 0x5f, 0x89, 0x9a, 0xd9, 0xd2, 0x4f, 0xae, 0x44, 0x0e,
+	0x8d, 0xe0, 0x8c, 0x23, 0xbe, 0x57, 0x88, 0x6b, 0x8f, 0x52, 0xf4, 0xc2, 0xf1, 0x50, 0xe8, 0x7d,
+	0x2f, 0x8f, 0xec, 0xd4, 0x0e, 0x42, 0xcf, 0x1f, 0x4f, 0xee, 0x72, 0xab, 0x80, 0xa7, 0x3d, 0x1b,
+	0x91, 0x11, 0xe1, 0x5e, 0xd6, 0xfa, 0xd7, 0x0a, 0x54, 0x0f, 0x4f, 0x0d, 0xdf, 0xea, 0xb8, 0x7d,
+	0x4f, 0x5d, 0x01, 0x39, 0xa0, 0x1f, 0x3d, 0xdb, 0xd2, 0x4a, 0x1b, 0xa5, 0xcd, 0x69, 0xbd, 0x82,
+	0xdf, 0x1d, 0x8b, 0x82, 0x7c, 0xc3, 0x3d, 0x21, 0x14, 0x74, 0x7b, 0xa3, 0xb4, 0x39, 0xa5, 0x57,
+	0xf0, 0xbb, 0x63, 0xa9, 0x0b, 0x30, 0xed, 0x5d, 0xb8, 0xc4, 0xd7, 0xa6, 0x36, 0x4a, 0x9b, 0x55,
+	0x9d, 0x7d, 0xa8, 0xf7, 0x40, 0x0d, 0x42, 0xcf, 0x21, 0x6e, 0x2f, 0xb0, 0x5d, 0x93, 0xf4, 0x7c,
+	0xe2, 0x92, 0x0b, 0xad, 0x8c, 0x5c, 0x15, 0x06, 0x39, 0xa4, 0x00, 0x9d, 0xae, 0xab, 0xbb, 0x50,
+	0x1b, 0x0d, 0x2d, 0x23, 0x24, 0x3d, 0xea, 0xa2, 0x5a, 0x65, 0xa3, 0xb4, 0x59, 0xdb, 0x59, 0x6d,
+	0x33, 0xff, 0x6d, 0x0b, 0xff, 0x6d, 0x1f, 0x09, 0xff, 0xdd, 0x93, 0x7e, 0xf7, 0x9f, 0xeb, 0x25,
+	0x1d, 0x18, 0x11, 0x5d, 0x56, 0x1f, 0x42, 0xd3, 0x35, 0x06, 0x24, 0x18, 0x1a, 0x26, 0xe9, 0xb9,
+	0x5e, 0x68, 0xf7, 0x6d, 0x13, 0x2f, 0x43, 0xef, 0x9c, 0x1a, 0xc0, 0x73, 0xb5, 0x2a, 0xea, 0xbd,
+	0x16, 0x61, 0x3d, 0x49, 0x20, 0x7d, 0xc1, 0x70, 0xd4, 0xbf, 0x29, 0xc1, 0x8a, 0x4f, 0x86, 0x8e,
+	0xa0, 0xb5, 0x9c, 0x67, 0x3d, 0xc3, 0x3c, 0xeb, 0x39, 0xe4, 0x9c, 0x38, 0xda, 0xcc, 0xc6, 0xd4,
+	0x66, 0x6d, 0xa7, 0xd3, 0xbe, 0xfe, 0x6e, 0xb6, 0x23, 0xab, 0xb6, 0xf5, 0x98, 0xdd, 0x43, 0xe7,
+	0xd9, 0xae, 0x79, 0xf6, 0x29, 0xe5, 0x75, 0xe0, 0x86, 0xfe, 0x58, 0x5f, 0xf2, 0x73, 0x81, 0xea,
+	0x19, 0x28, 0x78, 0x4e, 0xb1, 0xec, 0x40, 0x53, 0x50, 0xf8, 0xee, 0xcd, 0x84, 0x7f, 0x4e, 0xb9,
+	0x08, 0xb6, 0x01, 0x13, 0xda, 0x78, 0x96, 0x5a, 0x54, 0x0d, 0xa8, 0x33, 0x61, 0x41, 0x68, 0x84,
+	0x24, 0xd0, 0xe6, 0x50, 0xd0, 0xcf, 0x5e, 0x42, 0xd0, 0x21, 0x32, 0x60, 0x52, 0x6a, 0xcf, 0xe2,
+	0x95, 0xd5, 0x0e, 0xbc, 0x72, 0x85, 0x19, 0x54, 0x05, 0xa6, 0xce, 0xc8, 0x18, 0x7d, 0xae, 0xaa,
+	0xd3, 0x9f, 0xd4, 0xa9, 0xce, 0x0d, 0x67, 0x44, 0xb8, 0xb3, 0xb1, 0x8f, 0x0f, 0x6f, 0x7f, 0x50,
+	0x5a, 0x0d, 0x61, 0x3e, 0x67, 0x53, 0x49, 0x16, 0xd3, 0x8c, 0xc5, 0x27, 0x49, 0x16, 0xb5, 0x9d,
+	0xed, 0x22, 0xfb, 0x49, 0x71, 0x4e, 0x4a, 0x75, 0x41, 0xc9, 0xee, 0x30, 0x47, 0xe4, 0xc3, 0xb4,
+	0xc8, 0x76, 0x61, 0x91, 0xc8, 0x36, 0x21, 0xaf, 0x2b, 0xc9, 0x92, 0x32, 0xdd, 0x95, 0xe4, 0x69,
+	0xa5, 0xdc, 0x95, 0x64, 0x59, 0xa9, 0x76, 0x25, 0x19, 0x94, 0x5a, 0x57, 0x92, 0x6b, 0x4a, 0xbd,
+	0x2b, 0xc9, 0x75, 0x65, 0xa6, 0x2b, 0xc9, 0x0d, 0x65, 0xb6, 0x2b, 0xc9, 0xb3, 0x8a, 0xd2, 0xfa,
+	0x7d, 0x0b, 0x16, 0xbf, 0xe4, 0x41, 0xe4, 0x40, 0xe4, 0x12, 0xbc, 0xd4, 0x77, 0xa0, 0x1e, 0xdf,
+	0x0b, 0x7e, 0xb1, 0xab, 0x7a, 0x2d, 0x5a, 0xeb, 0x58, 0xea, 0x3a, 0xd4, 0xa2, 0x00, 0xc4, 0xef,
+	0x77, 0x55, 0x07, 0xb1, 0xd4, 0xb1, 0xd4, 0x36, 0xcc, 0x0f, 0x0d, 0x9f, 0xb8, 0x61, 0x2f, 0xc5,
+	0x8a, 0x5d, 0xf8, 0x39, 0x06, 0x7a, 0x92, 0x60, 0x78, 0x0f, 0x54, 0x8e, 0x9f, 0xe4, 0x2b, 0x21,
+	0xba, 0xc2, 0x20, 0x5f, 0xc6, 0xdc, 0x5b, 0x30, 0xc3, 0xb1, 0xfd, 0x91, 0x4b, 0x11, 0xa7, 0x99,
+	0x8a, 0x6c, 0x51, 0x1f, 0xb9, 0x29, 0x0d, 0x6c, 0xd7, 0x0e, 0x6d, 0x23, 0x24, 0x18, 0xa5, 0xca,
+	0xe8, 0x1d, 0x5c, 0x83, 0x8e, 0x80, 0x74, 0x2c, 0xf5, 0xa7, 0xb0, 0x62, 0x7a, 0x83, 0xa1, 0x43,
+	0xf0, 0x16, 0x93, 0x73, 0x4a, 0x79, 0x6c, 0x84, 0xe6, 0x29, 0xa5, 0xaa, 0x20, 0xd5, 0x52, 0x8c,
+	0x70, 0x40, 0xe1, 0x7b, 0x14, 0xdc, 0xb1, 0xd4, 0x57, 0x01, 0x30, 0x0a, 0xa3, 0xff, 0x62, 0xd0,
+	0xa8, 0xea, 0x55, 0xba, 0x82, 0x27, 0x45, 0xf7, 0x16, 0x47, 0xeb, 0xf1, 0x90, 0xa0, 0x49, 0x34,
+	0x60, 0x7b, 0x13, 0x90, 0xa3, 0xf1, 0x90, 0x50, 0x83, 0xa8, 0x5f, 0xc1, 0x6a, 0x84, 0x1d, 0xe5,
+	0x78, 0x0c, 0x72, 0xde, 0x28, 0xd4, 0x6a, 0xe8, 0x26, 0x2b, 0x13, 0x71, 0xee, 0x21, 0xcf, 0xe3,
+	0x7b, 0xd2, 0xdf, 0xd3, 0x30, 0xa7, 0x5d, 0x64, 0x4f, 0xf6, 0x88, 0x31, 0x50, 0x3f, 0x87, 0x85,
+	0x88, 0xbd, 0x3f, 0x8a, 0x19, 0xd7, 0x8b, 0x31, 0x8e, 0x76, 0xa2, 0x8f, 0x22, 0x96, 0xc7, 0xf0,
+	0xaa, 0x45, 0xfa, 0xc6, 0xc8, 0x49, 0x1c, 0x1e, 0xcb, 0x4a, 0x9c, 0xf7, 0x4c, 0x31, 0xde, 0xab,
+	0x9c, 0x8b, 0x38, 0xe8, 0x23, 0x23, 0x38, 0x13, 0x32, 0xde, 0x06, 0xd5, 0x31, 0x82, 0x90, 0x9f,
+	0x0b, 0x72, 0xb7, 0x2d, 0x6d, 0x0e, 0x8f, 0x65, 0x96, 0x42, 0xf0, 0x40, 0x28, 0x45, 0xc7, 0x52,
+	0xdf, 0x81, 0x79, 0x44, 0xee, 0xdb, 0x7e, 0x44, 0x62, 0x5b, 0x9a, 0x8a, 0xd8, 0x0a, 0x05, 0x3d,
+	0xb2, 0x7d, 0x4e, 0xd2, 0xb1, 0xd4, 0xbf, 0x80, 0xd7, 0x10, 0x3d, 0xad, 0x7c, 0x10, 0x1a, 0x3e,
+	0xf5, 0x99, 0x88, 0x7c, 0x1e, 0xc9, 0x9b, 0x14, 0x35, 0xa9, 0xe1, 0x21, 0xc3, 0x13, 0xcc, 0x3e,
+	0x06, 0x40, 0x4a, 0x96, 0x96, 0x16, 0x0a, 0xa6, 0xa5, 0x2a, 0xd2, 0xd0, 0x55, 0xb5, 0x0b, 0xa8,
+	0x61, 0x2f, 0x99, 0xdd, 0x16, 0x0b, 0xb2, 0x69, 0x50, 0xca, 0x5f, 0xc4, 0x19, 0x6e, 0x07, 0x16,
+	0xd3, 0x9b, 0x12, 0x89, 0x6d, 0x09, 0xf7, 0x32, 0x7f, 0x91, 0xd8, 0x87, 0xc8, 0x67, 0x8f, 0x60,
+	0x23, 0x63, 0x08, 0xf3, 0x94, 0x58, 0x23, 0x27, 0x69, 0x8a, 0x65, 0x96, 0x17, 0x93, 0xe4, 0x87,
+	0x02, 0x4b, 0x18, 0x62, 0x0f, 0x9a, 0xd7, 0x18, 0x54, 0x43, 0x2e, 0xab, 0x17, 0x97, 0x1b, 0xf3,
+	0x30, 0xab, 0xbf, 0xf0, 0xa8, 0x95, 0x62, 0x1e, 0x95, 0xda, 0xa0, 0x70, 0xa5, 0x09, 0xa3, 0x18,
+	0x21, 0x0d, 0xba, 0xa1, 0xb6, 0x8a, 0x61, 0x39, 0x45, 0xb3, 0xcb, 0x40, 0xa9, 0x4b, 0x99, 0xda,
+	0x0c, 0x1e, 0xcf, 0x2b, 0x05, 0x8f, 0x67, 0x39, 0x67, 0xab, 0x78, 0x4e, 0x06, 0xac, 0x5d, 0x66,
+	0x73, 0x14, 0xb0, 0x56, 0x50, 0xc0, 0x4a, 0xee, 0x89, 0xa0, 0x08, 0x1f, 0xde, 0x48, 0x8b, 0xf0,
+	0x7c, 0xfb, 0xc4, 0x76, 0x0d, 0x27, 0x2b, 0xab, 0x59, 0x50, 0xd6, 0x9d, 0xa4, 0xac, 0xcf, 0x38,
+	0xb3, 0xb4, 0xcc, 0xf7, 0x41, 0x4b, 0xcb, 0xf4, 0xc9, 0xb3, 0x11, 0x09, 0xf0, 0xf0, 0xd7, 0x31,
+	0xfc, 0x2d, 0x26, 0x99, 0xe8, 0x0c, 0xda, 0xb1, 0xd4, 0x5f, 0x83, 0x9a, 0x26, 0xa4, 0x61, 0x53,
+	0x7b, 0xb8, 0x51, 0xda, 0x6c, 0x5c, 0x92, 0x22, 0xb1, 0x2e, 0xa6, 0xc9, 0x31, 0x15, 0x3c, 0xc6,
+	0x43, 0x92, 0x88, 0xb0, 0x7c, 0x45, 0xfd, 0x2c, 0x6b, 0x8a, 0x60, 0x74, 0x72, 0x42, 0xd5, 0x32,
+	0x3d, 0x37, 0xb4, 0x5d, 0x5a, 0x43, 0x05, 0x3d, 0x5a, 0x7b, 0x1e, 0x6c, 0x94, 0x36, 0x65, 0x7d,
+	0x23, 0x65, 0x54, 0x86, 0xba, 0xcf, 0x31, 0x77, 0x83, 0x27, 0xe4, 0x62, 0xf2, 0xca, 0xf0, 0x72,
+	0xbb, 0x17, 0xd8, 0xbf, 0x21, 0xbd, 0xe3, 0x31, 0x2d, 0x91, 0x1e, 0x4d, 0x5e, 0x99, 0xc7, 0x0c,
+	0xeb, 0xd0, 0xfe, 0x0d, 0xd9, 0xa3, 0x38, 0xea, 0x5d, 0x50, 0x4c, 0xc3, 0x35, 0x89, 0x23, 0x0c,
+	0x45, 0x2c, 0xed, 0x55, 0xd4, 0x61, 0x96, 0xad, 0xeb, 0x62, 0x59, 0x7d, 0x0b, 0xe6, 0xd2, 0xa8,
+	0xd4, 0xa6, 0x1b, 0x68, 0xd3, 0x34, 0x6e, 0x07, 0x71, 0x83, 0xd0, 0x36, 0xcf, 0xc6, 0xbd, 0x44,
+	0x96, 0xba, 0xc3, 0x70, 0x19, 0xe0, 0x28, 0xca, 0x55, 0x27, 0xb0, 0xc1, 0x71, 0x85, 0x5b, 0xf4,
+	0x42, 0xaf, 0x17, 0x47, 0x34, 0x7a, 0xf9, 0x5a, 0xc5, 0x2e, 0xdf, 0x1a, 0x63, 0x24, 0x5c, 0xe2,
+	0xc8, 0x3b, 0x14, 0x31, 0x8e, 0xde, 0x42, 0x0d, 0x2a, 0xe2, 0xde, 0xbd, 0xc6, 0x1e, 0x0e, 0xfc,
+	0x53, 0xfd, 0x05, 0x2c, 0xf9, 0x24, 0xf4, 0xc7, 0x3c, 0x6f, 0x3b, 0x3d, 0xdb, 0x0d, 0x89, 0x7f,
+	0x6e, 0x38, 0xda, 0xeb, 0xc5, 0x04, 0x2f, 0x20, 0x39, 0xcb, 0xed, 0x4e, 0x87, 0x13, 0xc7, 0x6c,
+	0x07, 0xc6, 0x73, 0x7b, 0x30, 0x1a, 0xc4, 0x6c, 0xdf, 0xb8, 0x09, 0xdb, 0x9f, 0x33, 0xea, 0x88,
+	0xed, 0x83, 0x2c, 0x5b, 0xbe, 0x8d, 0x40, 0x7b, 0x13, 0xb7, 0x95, 0xa2, 0xe2, 0xe1, 0x24, 0x50,
+	0x3f, 0x84, 0x15, 0x46, 0x75, 0x6c, 0x98, 0x67, 0x5e, 0xbf, 0xdf, 0x33, 0x3d, 0xd2, 0xef, 0xdb,
+	0xa6, 0x4d, 0xdc, 0x50, 0xfb, 0xc9, 0x46, 0x69, 0xb3, 0xa4, 0x2f, 0x23, 0xc2, 0x1e, 0x83, 0xef,
+	0xc7, 0x60, 0x75, 0x00, 0xad, 0x9c, 0x02, 0x81, 0x3c, 0x1f, 0xda, 0x4c, 0x5d, 0x76, 0x8d, 0x37,
+	0x0b, 0x5e, 0xe3, 0xf5, 0x89, 0x4a, 0xe1, 0x20, 0xe2, 0xc4, 0x5f, 0x49, 0xeb, 0x4c, 0x55, 0xd7,
+	0x73, 0x7b, 0xf8, 0xcb, 0x38, 0x76, 0x48, 0x8f, 0xf8, 0xbe, 0xe7, 0xe3, 0xbd, 0x0c, 0xb4, 0xbb,
+	0x1b, 0x53, 0x9b, 0x55, 0xfd, 0x15, 0x04, 0x3e, 0xf1, 0x5c, 0x5d, 0x20, 0x1d, 0x50, 0x1c, 0x7a,
+	0xe5, 0x02, 0x75, 0x13, 0x94, 0x53, 0x23, 0x60, 0xf4, 0xbd, 0xa1, 0xe7, 0xd8, 0xe6, 0x58, 0x7b,
+	0x0b, 0x5d, 0xbb, 0x71, 0x6a, 0x04, 0x48, 0xf1, 0x14, 0x57, 0xd5, 0xd7, 0x60, 0xc6, 0xf4, 0x3d,
+	0x37, 0xf2, 0x3f, 0xed, 0x6d, 0xf4, 0xd4, 0x3a, 0x5d, 0x14, 0xbe, 0x44, 0x4b, 0xd4, 0xc0, 0x3e,
+	0xa1, 0xd1, 0xcb, 0xf4, 0x46, 0x6e, 0xa8, 0xb5, 0xf1, 0x76, 0xd5, 0xd8, 0xda, 0x3e, 0x5d, 0x52,
+	0x3f, 0x87, 0x39, 0x63, 0x14, 0x7a, 0x3d, 0x9f, 0x04, 0x24, 0xec, 0x0d, 0x3d, 0xdb, 0x0d, 0x03,
+	0xed, 0x3e, 0x5a, 0xe5, 0x8d, 0x38, 0x84, 0xd0, 0xd8, 0x11, 0x3d, 0xd0, 0xcf, 0xb7, 0xdb, 0x3a,
+	0xc5, 0x7e, 0x8a, 0xc8, 0xfa, 0x2c, 0xa5, 0x4f, 0x2c, 0xa8, 0x7f, 0x0d, 0x73, 0x01, 0x31, 0x7c,
+	0xf3, 0x94, 0x1e, 0xb2, 0x6f, 0x1f, 0x8f, 0xe8, 0xc5, 0x7e, 0x80, 0x6f, 0x9f, 0xcf, 0x8a, 0x14,
+	0xee, 0xb9, 0xe5, 0x76, 0xfb, 0x10, 0x59, 0xee, 0x46, 0x1c, 0xd9, 0x63, 0x48, 0x09, 0x32, 0xcb,
+	0xea, 0x97, 0x20, 0x0d, 0xc8, 0xc0, 0xd3, 0xde, 0x43, 0x81, 0xfb, 0x2f, 0x2f, 0xf0, 0xe7, 0x64,
+	0xe0, 0x31, 0x21, 0xc8, 0x50, 0xfd, 0x0a, 0xe6, 0x78, 0x5d, 0xc0, 0x03, 0x97, 0x4d, 0x02, 0xed,
+	0x4f, 0xd0, 0x52, 0xef, 0xe6, 0x4a, 0xe1, 0xe1, 0x8d, 0x4a, 0xe0, 0x55, 0xc3, 0x63, 0x41, 0xa7,
+	0x2b, 0xe7, 0x99, 0x15, 0xf5, 0x3e, 0x2c, 0xf1, 0x42, 0x2c, 0x72, 0x56, 0x5e, 0xb5, 0xbf, 0x8f,
+	0x27, 0x3b, 0x8f, 0xd0, 0x48, 0x45, 0x56, 0xbd, 0xff, 0x25, 0xcc, 0xc6, 0xe8, 0xf4, 0x95, 0x19,
+	0x68, 0x1f, 0xa0, 0x46, 0x3b, 0x45, 0xf6, 0x1d, 0x31, 0xa3, 0xaf, 0xa4, 0x40, 0x6f, 0x90, 0xd4,
+	0x77, 0x2a, 0xdd, 0xfa, 0xa3, 0xc9, 0xbb, 0xf3, 0xd3, 0x9b, 0xa6, 0x5b, 0x7d, 0x94, 0xbd, 0x35,
+	0x0f, 0x60, 0x79, 0xa2, 0x04, 0x0d, 0x9f, 0xe3, 0xae, 0x3f, 0x64, 0xb5, 0x57, 0xba, 0x0c, 0x3d,
+	0x7a, 0x4e, 0x77, 0xfd, 0x00, 0x96, 0xe8, 0x5e, 0x49, 0x2f, 0xf4, 0x0d, 0x37, 0xb0, 0x51, 0x23,
+	0xe6, 0xe0, 0x1f, 0x21, 0xd1, 0x02, 0x42, 0x8f, 0x22, 0x20, 0xf3, 0xf4, 0x4f, 0xa0, 0x91, 0x7e,
+	0x28, 0x68, 0x7f, 0x5a, 0x70, 0x03, 0x33, 0x24, 0xf9, 0x3c, 0x50, 0xb7, 0x60, 0xc1, 0x25, 0x17,
+	0x93, 0xe7, 0xf4, 0x67, 0xec, 0xd5, 0xe6, 0x92, 0x8b, 0xcc, 0x29, 0x7d, 0x0a, 0x75, 0xfe, 0xc6,
+	0xc2, 0x26, 0x9a, 0xf6, 0x33, 0x94, 0x7b, 0x37, 0xf7, 0x88, 0x10, 0x83, 0xb9, 0x8c, 0x19, 0x7a,
+	0xfe, 0x3e, 0xfd, 0x14, 0x2f, 0x36, 0xfc, 0x50, 0x3f, 0x00, 0x6d, 0xe2, 0xc5, 0x26, 0x0a, 0xd6,
+	0x8f, 0xd9, 0x03, 0x2c, 0xf3, 0x6c, 0x13, 0x35, 0xeb, 0x7d, 0x58, 0x32, 0x1d, 0x2f, 0xe0, 0x76,
+	0xeb, 0x13, 0x3f, 0x7a, 0x21, 0xfc, 0x39, 0x33, 0x36, 0x42, 0x8f, 0x38, 0x90, 0xbf, 0x12, 0xde,
+	0x07, 0x8d, 0x11, 0x9d, 0xdb, 0x81, 0x7d, 0x6c, 0x3b, 0x76, 0x38, 0x8e, 0xc8, 0x76, 0x91, 0x6c,
+	0x11, 0xe1, 0x5f, 0x44, 0x60, 0x4e, 0xf8, 0x31, 0x00, 0x97, 0x46, 0x6d, 0xbd, 0x57, 0xb4, 0xc4,
+	0x67, 0x3a, 0x50, 0x3b, 0x1f, 0xc0, 0x7a, 0xbe, 0x64, 0xfe, 0xbe, 0x24, 0x96, 0xb6, 0x8f, 0xb1,
+	0x71, 0x2d, 0x47, 0x81, 0x7d, 0x81, 0xa3, 0x1e, 0x01, 0x0c, 0x8d, 0x51, 0x40, 0x7a, 0xb6, 0xdb,
+	0xf7, 0xb4, 0x4f, 0x50, 0x8f, 0xf7, 0x6e, 0x12, 0x16, 0x9e, 0x52, 0x6a, 0x1a, 0x12, 0xf4, 0xea,
+	0x50, 0xfc, 0x54, 0xb7, 0x61, 0x21, 0xd9, 0xce, 0x22, 0xcf, 0x4d, 0x67, 0x64, 0x11, 0x4b, 0x7b,
+	0x8c, 0x1a, 0xcd, 0x27, 0x60, 0x07, 0x1c, 0xb4, 0x6a, 0xc1, 0x62, 0x6e, 0x10, 0xcb, 0xe9, 0xd2,
+	0xbc, 0x97, 0xee, 0x77, 0xac, 0xa7, 0x23, 0x31, 0xef, 0xaa, 0x9e, 0x6f, 0xb7, 0x9f, 0x1a, 0x63,
+	0xc7, 0x33, 0xac, 0x64, 0x43, 0xe5, 0x97, 0x50, 0x8d, 0x22, 0xd7, 0xf7, 0xca, 0x39, 0x6a, 0x97,
+	0x44, 0xcd, 0x91, 0xae, 0x24, 0x2b, 0xca, 0x5c, 0x57, 0x92, 0xef, 0x29, 0xef, 0x74, 0x25, 0xf9,
+	0x1d, 0xa5, 0xdd, 0x95, 0xe4, 0x2d, 0xe5, 0xdd, 0xae, 0x24, 0xbf, 0xab, 0x6c, 0x77, 0x25, 0x79,
+	0x5b, 0xd9, 0xe9, 0x4a, 0xf2, 0x8e, 0x72, 0xbf, 0xf5, 0xb7, 0x25, 0x98, 0x9b, 0xb0, 0x27, 0x75,
+	0x11, 0x76, 0x34, 0xe8, 0x22, 0xa5, 0xa2, 0x2e, 0x82, 0x34, 0xe8, 0x22, 0x4b, 0x50, 0xf6, 0x89,
+	0x11, 0x78, 0x2e, 0xef, 0xad, 0xf0, 0x2f, 0x75, 0x15, 0x64, 0xdb, 0x22, 0x6e, 0x68, 0x87, 0x63,
+	0xde, 0x4c, 0x89, 0xbe, 0x5b, 0xf7, 0xa1, 0x91, 0x0e, 0x7c, 0x34, 0x4d, 0x26, 0x4b, 0x51, 0x54,
+	0x64, 0x4a, 0xaf, 0x9d, 0xc6, 0x85, 0x67, 0xeb, 0x8f, 0x25, 0x58, 0x9a, 0x48, 0x13, 0x94, 0x9a,
+	0x60, 0x8d, 0xe9, 0x13, 0x1a, 0x8e, 0x12, 0x35, 0x66, 0x89, 0xd7, 0x98, 0x08, 0x88, 0x6b, 0xcc,
+	0x45, 0x28, 0xf3, 0x60, 0xc1, 0xf4, 0x9d, 0xf6, 0x31, 0x40, 0x74, 0x61, 0x1a, 0x43, 0x16, 0xea,
+	0xda, 0xd8, 0x79, 0x50, 0xac, 0x76, 0x4f, 0xeb, 0xa1, 0x33, 0x16, 0xea, 0x23, 0x28, 0xd3, 0x1f,
+	0xa3, 0x40, 0x93, 0xb2, 0x0f, 0x81, 0xeb, 0xb9, 0x8c, 0x02, 0x9d, 0x53, 0xb7, 0xfe, 0xb7, 0x0c,
+	0x4a, 0x2a, 0x14, 0x7c, 0x5f, 0x3d, 0xaf, 0xd8, 0x06, 0x53, 0x49, 0x1b, 0xec, 0x43, 0x35, 0x7e,
+	0xc3, 0x30, 0xd5, 0xdf, 0xbc, 0xda, 0x0e, 0xd1, 0xdb, 0x45, 0x0e, 0xf9, 0x2f, 0xda, 0xcd, 0x0a,
+	0x0d, 0xff, 0x84, 0x64, 0xfa, 0x69, 0xac, 0xef, 0x35, 0xc7, 0x40, 0x99, 0x7e, 0x1a, 0xc7, 0x4f,
+	0xea, 0x5c, 0x46, 0x74, 0x85, 0x41, 0xd2, 0xfd, 0x34, 0x8e, 0xcd, 0x37, 0x50, 0x61, 0xdb, 0x67,
+	0x8b, 0x2c, 0xd6, 0xa7, 0x9b, 0x5c, 0x72, 0xb6, 0xc9, 0xf5, 0x11, 0xac, 0x72, 0x16, 0xe6, 0xa9,
+	0xed, 0x58, 0xb1, 0x58, 0xcf, 0x75, 0xc6, 0xd8, 0x13, 0x93, 0xf5, 0x65, 0x86, 0xb1, 0x4f, 0x11,
+	0x84, 0xf4, 0xcf, 0x5c, 0x67, 0x4c, 0xb5, 0xcd, 0xe9, 0x32, 0x00, 0xeb, 0xd7, 0x04, 0xd9, 0xce,
+	0x82, 0x06, 0x15, 0x91, 0x16, 0x6a, 0x6c, 0xb0, 0xc0, 0x3f, 0xd5, 0x65, 0xa8, 0x88, 0x08, 0x5e,
+	0x47, 0x48, 0x39, 0x64, 0x21, 0xbb, 0x03, 0xb3, 0xc9, 0x58, 0x4b, 0x2f, 0xe5, 0x4c, 0xd1, 0x9e,
+	0x4a, 0x4c, 0x88, 0x37, 0xf3, 0x1e, 0xa8, 0x16, 0xa1, 0x01, 0xb8, 0x67, 0xf4, 0x43, 0xe2, 0xf7,
+	0x30, 0x44, 0x6b, 0xb3, 0xb8, 0x41, 0x85, 0x41, 0x76, 0x29, 0x60, 0x9f, 0xae, 0xab, 0x7f, 0x57,
+	0x02, 0x16, 0xc4, 0x93, 0xbd, 0x3c, 0xaa, 0xa2, 0x45, 0x42, 0xc3, 0xc6, 0x1e, 0x3d, 0x55, 0xe3,
+	0x49, 0x91, 0xb0, 0x9d, 0x75, 0xda, 0x36, 0x8a, 0x88, 0x3b, 0x7c, 0x46, 0x70, 0xf6, 0x90, 0x71,
+	0x7d, 0x7c, 0x4b, 0x5f, 0x31, 0x2f, 0x03, 0xae, 0xfe, 0x1a, 0x56, 0x2e, 0xa5, 0x54, 0x3f, 0x86,
+	0x35, 0xd3, 0x70, 0x7b, 0xc1, 0x99, 0x3d, 0x4c, 0xa6, 0x27, 0x1a, 0xdd, 0x6d, 0xfa, 0x58, 0x2a,
+	0xe1, 0x46, 0x57, 0x4c, 0xc3, 0x3d, 0x3c, 0xb3, 0x87, 0x71, 0x6a, 0xda, 0xe5, 0x08, 0x7b, 0x0d,
+	0xa8, 0x27, 0x37, 0xc8, 0xc2, 0x6a, 0xeb, 0x9f, 0x25, 0x98, 0x4f, 0xf4, 0xf3, 0x7f, 0x34, 0xf7,
+	0x2e, 0xe1, 0x6b, 0xd3, 0x69, 0x5f, 0x7b, 0x1d, 0x1a, 0x99, 0xfe, 0x22, 0x6b, 0x2d, 0xd7, 0xfb,
+	0xc9, 0xde, 0x62, 0x0b, 0x66, 0x5c, 0xf2, 0x3c, 0x81, 0xc4, 0x3a, 0xc9, 0x35, 0xba, 0x28, 0x70,
+	0xf2, 0xbd, 0x5f, 0xbe, 0xc4, 0xfb, 0xef, 0x40, 0xfd, 0xd8, 0x37, 0x5c, 0xf3, 0xb4, 0x17, 0x7a,
+	0x67, 0x84, 0x5d, 0x81, 0xba, 0x5e, 0x63, 0x6b, 0x47, 0x74, 0x49, 0xd4, 0x71, 0xd4, 0x28, 0x29,
+	0xd4, 0x19, 0x44, 0xa5, 0x75, 0x9c, 0x3e, 0x72, 0xf7, 0x12, 0x04, 0x89, 0x7b, 0x33, 0x7b, 0xdd,
+	0xbd, 0x51, 0x5e, 0xf2, 0xde, 0xac, 0x01, 0x08, 0xa5, 0x78, 0xe7, 0xb6, 0xaa, 0xcb, 0x4c, 0x95,
+	0x8e, 0xd5, 0x95, 0xe4, 0xaa, 0x02, 0xd1, 0xc4, 0x22, 0x9a, 0x55, 0xb4, 0xfe, 0x67, 0x0a, 0xd4,
+	0x4c, 0x01, 0xf6, 0xe3, 0x76, 0x9b, 0x84, 0xa9, 0xcb, 0xd7, 0x99, 0xba, 0xf2, 0x92, 0xa6, 0x4e,
+	0x17, 0xa8, 0xf2, 0xcd, 0x0b, 0xd4, 0x74, 0x13, 0xbb, 0x7a, 0xf3, 0x26, 0xf6, 0x55, 0xb5, 0x35,
+	0x5c, 0x51, 0x5b, 0xb7, 0xfe, 0x28, 0xc1, 0x0c, 0xe5, 0xf0, 0xe3, 0xc9, 0xcc, 0x07, 0x50, 0xe7,
+	0x8d, 0x31, 0xc6, 0x67, 0x1a, 0xf9, 0xb4, 0x2e, 0x29, 0x4e, 0x78, 0xfb, 0x0b, 0x79, 0xd4, 0xc2,
+	0xf8, 0x43, 0x25, 0x89, 0xae, 0xb4, 0x68, 0x0a, 0x21, 0xbf, 0x32, 0xf2, 0xdb, 0x2e, 0x56, 0x39,
+	0xf1, 0x76, 0x11, 0xb2, 0x9f, 0xbf, 0x98, 0x5c, 0x4c, 0x3a, 0x66, 0x25, 0xed, 0x98, 0x77, 0x21,
+	0x8a, 0x35, 0x51, 0x47, 0x5c, 0xc6, 0x16, 0xd6, 0xac, 0x58, 0x17, 0xdd, 0xf0, 0x15, 0x90, 0xa3,
+	0x30, 0xc5, 0x46, 0xe4, 0x15, 0xc2, 0xa3, 0x53, 0xc2, 0xbd, 0xe1, 0x3a, 0xf7, 0xae, 0xbd, 0xa4,
+	0x7b, 0x67, 0x23, 0x60, 0x7d, 0x32, 0x02, 0xde, 0x05, 0xc5, 0x70, 0x7c, 0x62, 0x58, 0x22, 0x73,
+	0x11, 0x0b, 0xa3, 0x9f, 0xac, 0xcf, 0xf2, 0xf5, 0x5d, 0xbe, 0xdc, 0xfa, 0xa7, 0xdb, 0xa0, 0x88,
+	0xe4, 0x15, 0x39, 0x5d, 0x62, 0x1b, 0xa5, 0xd4, 0x36, 0xb2, 0xde, 0x78, 0xfb, 0x5a, 0x6f, 0x9c,
+	0xba, 0xc2, 0x1b, 0xa5, 0x4b, 0xbd, 0x71, 0xfa, 0xff, 0x1f, 0x78, 0xca, 0xe9, 0xf3, 0xfd, 0xfe,
+	0xe2, 0x4b, 0xeb, 0x1f, 0x1a, 0x50, 0xdf, 0x35, 0x43, 0xfb, 0xdc, 0x0e, 0xc7, 0x68, 0xae, 0x84,
+	0xd4, 0x52, 0x5a, 0xea, 0xfb, 0xa0, 0x65, 0x73, 0x5b, 0x34, 0x54, 0x65, 0x83, 0xfa, 0xc5, 0x74,
+	0x86, 0x13, 0x33, 0xd5, 0x4f, 0xa0, 0x91, 0x19, 0x4c, 0x48, 0x45, 0x9b, 0x1a, 0x41, 0x6a, 0x08,
+	0xb1, 0x09, 0xca, 0xc4, 0xe4, 0x89, 0xc5, 0xe4, 0x46, 0x90, 0x9e, 0x36, 0xed, 0x43, 0x3d, 0x35,
+	0xd6, 0x29, 0x6a, 0x9e, 0x5a, 0x90, 0x18, 0xe5, 0xac, 0x43, 0xcd, 0xe0, 0xa6, 0x11, 0x59, 0xbc,
+	0xaa, 0x83, 0x58, 0x62, 0x75, 0x74, 0xe2, 0x39, 0xc5, 0x87, 0xc5, 0x7e, 0xf4, 0x90, 0xfa, 0x15,
+	0xac, 0x5c, 0xde, 0x79, 0x87, 0x62, 0x9d, 0xea, 0xa5, 0x20, 0xbf, 0xe7, 0x9e, 0xe1, 0x1d, 0xe7,
+	0x88, 0x1b, 0x4c, 0x96, 0x13, 0xbc, 0xf7, 0x45, 0xbe, 0xa0, 0xbc, 0x8f, 0x60, 0x89, 0xeb, 0x9a,
+	0x65, 0x5c, 0x70, 0xb2, 0x3c, 0xcf, 0xb2, 0x47, 0x9a, 0xeb, 0xa7, 0x30, 0x77, 0x4a, 0x0c, 0x3f,
+	0x3c, 0x26, 0x46, 0x78, 0xd3, 0x71, 0xb2, 0x12, 0x51, 0x0a, 0x6e, 0x79, 0xf3, 0x95, 0xc6, 0x0d,
+	0xe6, 0x2b, 0xac, 0x36, 0xca, 0x9b, 0xaf, 0x50, 0xd5, 0xfc, 0x68, 0x32, 0x48, 0xdf, 0xa8, 0x0a,
+	0x0b, 0x9d, 0xa1, 0xc8, 0x65, 0xec, 0x11, 0x9a, 0x1c, 0x7b, 0xcc, 0xa5, 0xc7, 0x1e, 0xe9, 0xf7,
+	0x95, 0x9a, 0x7d, 0x5f, 0xdd, 0x8d, 0xdd, 0x38, 0x6a, 0x00, 0xcc, 0x8b, 0x19, 0x0e, 0xae, 0x77,
+	0xf8, 0x72, 0x6e, 0xaf, 0x7d, 0x21, 0xb7, 0xd7, 0x7e, 0xf9, 0xa8, 0x65, 0xf1, 0x87, 0x19, 0xb5,
+	0x2c, 0xfd, 0x30, 0xa3, 0x96, 0xe5, 0x2b, 0x46, 0x2d, 0x47, 0xb0, 0xc8, 0xa8, 0xb2, 0x5d, 0x5e,
+	0xad, 0xe0, 0xf5, 0x9e, 0x47, 0xf2, 0x4c, 0x7f, 0xf7, 0xca, 0x01, 0xce, 0xca, 0xd5, 0x03, 0x9c,
+	0x02, 0x13, 0x95, 0xd5, 0xeb, 0x27, 0x2a, 0x4f, 0x40, 0x65, 0x5c, 0x58, 0x9f, 0x99, 0xfd, 0x35,
+	0x92, 0x8f, 0xa2, 0x37, 0xd2, 0xd5, 0x07, 0x07, 0xd2, 0x94, 0xf1, 0x88, 0xfd, 0xd4, 0x15, 0xa4,
+	0xfd, 0x94, 0xf6, 0xa0, 0xd9, 0x0a, 0x7d, 0xc0, 0x27, 0xf8, 0xd1, 0x74, 0x45, 0xfc, 0xd8, 0xd5,
+	0xd6, 0xd0, 0xd5, 0x96, 0x23, 0xaa, 0x2f, 0x11, 0x1e, 0xb9, 0x5c, 0xfe, 0x13, 0xa6, 0x79, 0xc9,
+	0x13, 0xe6, 0x0b, 0x58, 0x42, 0x21, 0xf1, 0xd5, 0x16, 0xaf, 0xe1, 0xf5, 0x3c, 0xf5, 0x27, 0x7a,
+	0x77, 0x81, 0xbe, 0x40, 0xe9, 0x1f, 0x0b, 0x72, 0xf1, 0x76, 0xfd, 0x0a, 0x56, 0x33, 0x7c, 0x93,
+	0x7f, 0xa2, 0xd8, 0x28, 0x3a, 0xa5, 0x4f, 0xf1, 0x4e, 0xfc, 0x9b, 0x62, 0x09, 0xca, 0xd8, 0xa0,
+	0xb3, 0x70, 0x78, 0x2a, 0xeb, 0xfc, 0xab, 0x2b, 0xc9, 0x53, 0x8a, 0xd4, 0x95, 0xe4, 0xb2, 0x52,
+	0xe9, 0x4a, 0xf2, 0xab, 0x4a, 0xb3, 0xf5, 0xef, 0x25, 0xa8, 0x52, 0x12, 0xff, 0x9a, 0xec, 0x98,
+	0x97, 0x9b, 0x6e, 0xe7, 0xe6, 0xa6, 0x5d, 0xa8, 0xa1, 0xff, 0xf2, 0xcc, 0x3d, 0x55, 0x70, 0x2f,
+	0xc0, 0x88, 0x44, 0x66, 0x4a, 0x06, 0x28, 0x09, 0xe5, 0x40, 0x18, 0xc7, 0xa6, 0x15, 0x90, 0x59,
+	0x1c, 0x8b, 0x1a, 0x4b, 0x15, 0xfc, 0xee, 0x58, 0xad, 0xff, 0x90, 0x40, 0xc5, 0xb6, 0x4d, 0xfa,
+	0x9f, 0x62, 0x57, 0xe6, 0xfd, 0xb8, 0x89, 0x9f, 0x9f, 0xf7, 0x23, 0x78, 0x2a, 0xef, 0xe7, 0x99,
+	0x64, 0x2a, 0xd7, 0x24, 0x6d, 0x98, 0x17, 0x98, 0xc9, 0x7a, 0x8b, 0xb7, 0xc4, 0x38, 0x28, 0xd1,
+	0xe4, 0x7a, 0x1d, 0x04, 0x07, 0xf1, 0x08, 0x65, 0xed, 0x30, 0x91, 0xf4, 0x59, 0x9b, 0x2b, 0xb7,
+	0xe9, 0x29, 0xe7, 0x37, 0x3d, 0xd7, 0xa0, 0x1a, 0x15, 0x7e, 0x22, 0x93, 0x47, 0x0b, 0x37, 0xfc,
+	0xdb, 0xd7, 0x2f, 0xa3, 0xbf, 0xab, 0xb1, 0xec, 0xc9, 0xe3, 0x76, 0x0d, 0xeb, 0xc0, 0xcd, 0x4b,
+	0x5e, 0x13, 0x4f, 0xc5, 0xf4, 0x24, 0x20, 0x2c, 0xa2, 0x8b, 0x3f, 0xb6, 0x25, 0x96, 0xa8, 0x1e,
+	0xd9, 0xa3, 0x88, 0xfa, 0x63, 0x4a, 0xfa, 0x10, 0x70, 0xb8, 0x31, 0xcd, 0x66, 0x39, 0x33, 0x37,
+	0x9d, 0xe5, 0x30, 0xba, 0x89, 0x0a, 0xb9, 0x31, 0x51, 0x21, 0x47, 0x7f, 0x55, 0xac, 0x28, 0x72,
+	0xeb, 0x5f, 0x4a, 0x30, 0xc7, 0x2d, 0xba, 0x8f, 0x79, 0xf5, 0x87, 0x72, 0xac, 0xdc, 0x8c, 0x3e,
+	0x95, 0xff, 0x8f, 0x89, 0x7c, 0x93, 0x49, 0xf9, 0x26, 0x6b, 0xfd, 0xbe, 0x04, 0x70, 0x88, 0x93,
+	0xe7, 0x1f, 0x4a, 0xf7, 0x74, 0xcd, 0x38, 0x95, 0xad, 0x19, 0xf3, 0xd5, 0xad, 0xe4, 0xab, 0x9b,
+	0xf9, 0xa3, 0x28, 0x0b, 0x5a, 0xb2, 0x52, 0x6d, 0xfd, 0xb6, 0x04, 0xf2, 0xfe, 0x29, 0x31, 0xcf,
+	0x82, 0xd1, 0x20, 0xbb, 0x89, 0xe9, 0x78, 0x13, 0x0f, 0xa1, 0xdc, 0x77, 0x8c, 0x73, 0xcf, 0x47,
+	0x95, 0x1b, 0x3b, 0xf7, 0xae, 0x7e, 0xa3, 0x08, 0x8e, 0x8f, 0x90, 0x46, 0xe7, 0xb4, 0xf1, 0xbf,
+	0x75, 0xa7, 0xf0, 0xf1, 0xc6, 0x3e, 0xf6, 0xfe, 0xea, 0xeb, 0x6f, 0x9a, 0xb7, 0xfe, 0xf0, 0x4d,
+	0xf3, 0xd6, 0x77, 0xdf, 0x34, 0x4b, 0xbf, 0x7d, 0xd1, 0x2c, 0xfd, 0xe3, 0x8b, 0x66, 0xe9, 0xdf,
+	0x5e, 0x34, 0x4b, 0x5f, 0xbf, 0x68, 0x96, 0xfe, 0xeb, 0x45, 0xb3, 0xf4, 0xdf, 0x2f, 0x9a, 0xb7,
+	0xbe, 0x7b, 0xd1, 0x2c, 0xfd, 0xee, 0xdb, 0xe6, 0xad, 0xaf, 0xbf, 0x6d, 0xde, 0xfa, 0xc3, 0xb7,
+	0xcd, 0x5b, 0xbf, 0x7a, 0x70, 0xe2, 0xc5, 0x3a, 0xd8, 0xde, 0xe5, 0xff, 0x64, 0xff, 0x28, 0xf1,
+	0x79, 0x5c, 0xc6, 0xa0, 0x79, 0xff, 0xff, 0x06, 0x00, 0xe6, 0xf8, 0x1a, 0xaf, 0x6c, 0x31, 0x00,
 	0x00,
 }
 
@@ -2824,6 +2919,46 @@ func (this *WorkflowExecutionInfo) Equal(that interface{}) bool {
 	if this.CloseVisibilityTaskCompleted != that1.CloseVisibilityTaskCompleted {
 		return false
 	}
+	if !this.PauseInfo.Equal(that1.PauseInfo) {
+		return false
+	}
+	if this.ReplicationExcluded != that1.ReplicationExcluded {
+		return false
+	}
+	return true
+}
+func (this *WorkflowPauseInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WorkflowPauseInfo)
+	if !ok {
+		that2, ok := that.(WorkflowPauseInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.PauseTime == nil {
+		if this.PauseTime != nil {
+			return false
+		}
+	} else if !this.PauseTime.Equal(*that1.PauseTime) {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if this.Identity != that1.Identity {
+		return false
+	}
 	return true
 }
 func (this *ExecutionStats) Equal(that interface{}) bool {
@@ -3407,6 +3542,9 @@ func (this *ActivityInfo) Equal(that interface{}) bool {
 	} else if !this.LastHeartbeatUpdateTime.Equal(*that1.LastHeartbeatUpdateTime) {
 		return false
 	}
+	if this.Paused != that1.Paused {
+		return false
+	}
 	return true
 }
 func (this *TimerInfo) Equal(that interface{}) bool {
@@ -3660,7 +3798,7 @@ func (this *WorkflowExecutionInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 64)
+	s := make([]string, 0, 66)
 	s = append(s, "&persistence.WorkflowExecutionInfo{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
@@ -3754,6 +3892,22 @@ func (this *WorkflowExecutionInfo) GoString() string {
 	s = append(s, "CloseVisibilityTaskId: "+fmt.Sprintf("%#v", this.CloseVisibilityTaskId)+",\n")
 	s = append(s, "CloseTime: "+fmt.Sprintf("%#v", this.CloseTime)+",\n")
 	s = append(s, "CloseVisibilityTaskCompleted: "+fmt.Sprintf("%#v", this.CloseVisibilityTaskCompleted)+",\n")
+	if this.PauseInfo != nil {
+		s = append(s, "PauseInfo: "+fmt.Sprintf("%#v", this.PauseInfo)+",\n")
+	}
+	s = append(s, "ReplicationExcluded: "+fmt.Sprintf("%#v", this.ReplicationExcluded)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *WorkflowPauseInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&persistence.WorkflowPauseInfo{")
+	s = append(s, "PauseTime: "+fmt.Sprintf("%#v", this.PauseTime)+",\n")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -3907,7 +4061,7 @@ func (this *ActivityInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 33)
+	s := make([]string, 0, 34)
 	s = append(s, "&persistence.ActivityInfo{")
 	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
 	s = append(s, "ScheduledEventBatchId: "+fmt.Sprintf("%#v", this.ScheduledEventBatchId)+",\n")
@@ -3942,6 +4096,7 @@ func (this *ActivityInfo) GoString() string {
 		s = append(s, "LastHeartbeatDetails: "+fmt.Sprintf("%#v", this.LastHeartbeatDetails)+",\n")
 	}
 	s = append(s, "LastHeartbeatUpdateTime: "+fmt.Sprintf("%#v", this.LastHeartbeatUpdateTime)+",\n")
+	s = append(s, "Paused: "+fmt.Sprintf("%#v", this.Paused)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -4177,6 +4332,32 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ReplicationExcluded {
+		i--
+		if m.ReplicationExcluded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.PauseInfo != nil {
+		{
+			size, err := m.PauseInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExecutions(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0xba
+	}
 	if m.WorkflowTaskHistorySizeBytes != 0 {
 		i = encodeVarintExecutions(dAtA, i, uint64(m.WorkflowTaskHistorySizeBytes))
 		i--
@@ -4216,12 +4397,12 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x98
 	}
 	if m.CloseTime != nil {
-		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CloseTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CloseTime):])
-		if err4 != nil {
-			return 0, err4
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CloseTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CloseTime):])
+		if err5 != nil {
+			return 0, err5
 		}
-		i -= n4
-		i = encodeVarintExecutions(dAtA, i, uint64(n4))
+		i -= n5
+		i = encodeVarintExecutions(dAtA, i, uint64(n5))
 		i--
 		dAtA[i] = 0x4
 		i--
@@ -4272,12 +4453,12 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xea
 	}
 	if m.ExecutionTime != nil {
-		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExecutionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExecutionTime):])
-		if err6 != nil {
-			return 0, err6
+		n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExecutionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExecutionTime):])
+		if err7 != nil {
+			return 0, err7
 		}
-		i -= n6
-		i = encodeVarintExecutions(dAtA, i, uint64(n6))
+		i -= n7
+		i = encodeVarintExecutions(dAtA, i, uint64(n7))
 		i--
 		dAtA[i] = 0x3
 		i--
@@ -4298,12 +4479,12 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xd0
 	}
 	if m.WorkflowRunExpirationTime != nil {
-		n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowRunExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowRunExpirationTime):])
-		if err7 != nil {
-			return 0, err7
+		n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowRunExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowRunExpirationTime):])
+		if err8 != nil {
+			return 0, err8
 		}
-		i -= n7
-		i = encodeVarintExecutions(dAtA, i, uint64(n7))
+		i -= n8
+		i = encodeVarintExecutions(dAtA, i, uint64(n8))
 		i--
 		dAtA[i] = 0x3
 		i--
@@ -4456,12 +4637,12 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if m.WorkflowExecutionExpirationTime != nil {
-		n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowExecutionExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowExecutionExpirationTime):])
-		if err13 != nil {
-			return 0, err13
+		n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowExecutionExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowExecutionExpirationTime):])
+		if err14 != nil {
+			return 0, err14
 		}
-		i -= n13
-		i = encodeVarintExecutions(dAtA, i, uint64(n13))
+		i -= n14
+		i = encodeVarintExecutions(dAtA, i, uint64(n14))
 		i--
 		dAtA[i] = 0x2
 		i--
@@ -4483,24 +4664,24 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xb0
 	}
 	if m.RetryMaximumInterval != nil {
-		n14, err14 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryMaximumInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryMaximumInterval):])
-		if err14 != nil {
-			return 0, err14
+		n15, err15 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryMaximumInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryMaximumInterval):])
+		if err15 != nil {
+			return 0, err15
 		}
-		i -= n14
-		i = encodeVarintExecutions(dAtA, i, uint64(n14))
+		i -= n15
+		i = encodeVarintExecutions(dAtA, i, uint64(n15))
 		i--
 		dAtA[i] = 0x2
 		i--
 		dAtA[i] = 0xaa
 	}
 	if m.RetryInitialInterval != nil {
-		n15, err15 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryInitialInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryInitialInterval):])
-		if err15 != nil {
-			return 0, err15
+		n16, err16 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryInitialInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryInitialInterval):])
+		if err16 != nil {
+			return 0, err16
 		}
-		i -= n15
-		i = encodeVarintExecutions(dAtA, i, uint64(n15))
+		i -= n16
+		i = encodeVarintExecutions(dAtA, i, uint64(n16))
 		i--
 		dAtA[i] = 0x2
 		i--
@@ -4514,12 +4695,12 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x98
 	}
 	if m.StickyScheduleToStartTimeout != nil {
-		n16, err16 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StickyScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StickyScheduleToStartTimeout):])
-		if err16 != nil {
-			return 0, err16
+		n17, err17 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StickyScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StickyScheduleToStartTimeout):])
+		if err17 != nil {
+			return 0, err17
 		}
-		i -= n16
-		i = encodeVarintExecutions(dAtA, i, uint64(n16))
+		i -= n17
+		i = encodeVarintExecutions(dAtA, i, uint64(n17))
 		i--
 		dAtA[i] = 0x2
 		i--
@@ -4553,12 +4734,12 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xfa
 	}
 	if m.WorkflowTaskOriginalScheduledTime != nil {
-		n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskOriginalScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskOriginalScheduledTime):])
-		if err17 != nil {
-			return 0, err17
+		n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskOriginalScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskOriginalScheduledTime):])
+		if err18 != nil {
+			return 0, err18
 		}
-		i -= n17
-		i = encodeVarintExecutions(dAtA, i, uint64(n17))
+		i -= n18
+		i = encodeVarintExecutions(dAtA, i, uint64(n18))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -4577,24 +4758,24 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xe8
 	}
 	if m.WorkflowTaskScheduledTime != nil {
-		n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskScheduledTime):])
-		if err18 != nil {
-			return 0, err18
+		n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskScheduledTime):])
+		if err19 != nil {
+			return 0, err19
 		}
-		i -= n18
-		i = encodeVarintExecutions(dAtA, i, uint64(n18))
+		i -= n19
+		i = encodeVarintExecutions(dAtA, i, uint64(n19))
 		i--
 		dAtA[i] = 0x1
 		i--
 		dAtA[i] = 0xe2
 	}
 	if m.WorkflowTaskStartedTime != nil {
-		n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskStartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskStartedTime):])
-		if err19 != nil {
-			return 0, err19
+		n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskStartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskStartedTime):])
+		if err20 != nil {
+			return 0, err20
 		}
-		i -= n19
-		i = encodeVarintExecutions(dAtA, i, uint64(n19))
+		i -= n20
+		i = encodeVarintExecutions(dAtA, i, uint64(n20))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -4608,12 +4789,12 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xd0
 	}
 	if m.WorkflowTaskTimeout != nil {
-		n20, err20 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowTaskTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowTaskTimeout):])
-		if err20 != nil {
-			return 0, err20
+		n21, err21 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowTaskTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowTaskTimeout):])
+		if err21 != nil {
+			return 0, err21
 		}
-		i -= n20
-		i = encodeVarintExecutions(dAtA, i, uint64(n20))
+		i -= n21
+		i = encodeVarintExecutions(dAtA, i, uint64(n21))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -4641,24 +4822,24 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xb0
 	}
 	if m.LastUpdateTime != nil {
-		n21, err21 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpdateTime):])
-		if err21 != nil {
-			return 0, err21
+		n22, err22 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpdateTime):])
+		if err22 != nil {
+			return 0, err22
 		}
-		i -= n21
-		i = encodeVarintExecutions(dAtA, i, uint64(n21))
+		i -= n22
+		i = encodeVarintExecutions(dAtA, i, uint64(n22))
 		i--
 		dAtA[i] = 0x1
 		i--
 		dAtA[i] = 0xaa
 	}
 	if m.StartTime != nil {
-		n22, err22 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
-		if err22 != nil {
-			return 0, err22
+		n23, err23 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
+		if err23 != nil {
+			return 0, err23
 		}
-		i -= n22
-		i = encodeVarintExecutions(dAtA, i, uint64(n22))
+		i -= n23
+		i = encodeVarintExecutions(dAtA, i, uint64(n23))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -4686,33 +4867,33 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x88
 	}
 	if m.DefaultWorkflowTaskTimeout != nil {
-		n23, err23 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.DefaultWorkflowTaskTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.DefaultWorkflowTaskTimeout):])
-		if err23 != nil {
-			return 0, err23
-		}
-		i -= n23
-		i = encodeVarintExecutions(dAtA, i, uint64(n23))
-		i--
-		dAtA[i] = 0x6a
-	}
-	if m.WorkflowRunTimeout != nil {
-		n24, err24 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowRunTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowRunTimeout):])
+		n24, err24 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.DefaultWorkflowTaskTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.DefaultWorkflowTaskTimeout):])
 		if err24 != nil {
 			return 0, err24
 		}
 		i -= n24
 		i = encodeVarintExecutions(dAtA, i, uint64(n24))
 		i--
-		dAtA[i] = 0x62
+		dAtA[i] = 0x6a
 	}
-	if m.WorkflowExecutionTimeout != nil {
-		n25, err25 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowExecutionTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowExecutionTimeout):])
+	if m.WorkflowRunTimeout != nil {
+		n25, err25 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowRunTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowRunTimeout):])
 		if err25 != nil {
 			return 0, err25
 		}
 		i -= n25
 		i = encodeVarintExecutions(dAtA, i, uint64(n25))
 		i--
+		dAtA[i] = 0x62
+	}
+	if m.WorkflowExecutionTimeout != nil {
+		n26, err26 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowExecutionTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowExecutionTimeout):])
+		if err26 != nil {
+			return 0, err26
+		}
+		i -= n26
+		i = encodeVarintExecutions(dAtA, i, uint64(n26))
+		i--
 		dAtA[i] = 0x5a
 	}
 	if len(m.WorkflowTypeName) > 0 {
@@ -4777,6 +4958,53 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WorkflowPauseInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowPauseInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowPauseInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintExecutions(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintExecutions(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PauseTime != nil {
+		n27, err27 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.PauseTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.PauseTime):])
+		if err27 != nil {
+			return 0, err27
+		}
+		i -= n27
+		i = encodeVarintExecutions(dAtA, i, uint64(n27))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ExecutionStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4892,12 +5120,12 @@ func (m *TransferTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x78
 	}
 	if m.VisibilityTime != nil {
-		n26, err26 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
-		if err26 != nil {
-			return 0, err26
+		n28, err28 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+		if err28 != nil {
+			return 0, err28
 		}
-		i -= n26
-		i = encodeVarintExecutions(dAtA, i, uint64(n26))
+		i -= n28
+		i = encodeVarintExecutions(dAtA, i, uint64(n28))
 		i--
 		dAtA[i] = 0x6a
 	}
@@ -5069,12 +5297,12 @@ func (m *ReplicationTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x8a
 	}
 	if m.VisibilityTime != nil {
-		n28, err28 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
-		if err28 != nil {
-			return 0, err28
+		n30, err30 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+		if err30 != nil {
+			return 0, err30
 		}
-		i -= n28
-		i = encodeVarintExecutions(dAtA, i, uint64(n28))
+		i -= n30
+		i = encodeVarintExecutions(dAtA, i, uint64(n30))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -5174,32 +5402,32 @@ func (m *VisibilityTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x50
 	}
 	if m.StartTime != nil {
-		n29, err29 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
-		if err29 != nil {
-			return 0, err29
+		n31, err31 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
+		if err31 != nil {
+			return 0, err31
 		}
-		i -= n29
-		i = encodeVarintExecutions(dAtA, i, uint64(n29))
+		i -= n31
+		i = encodeVarintExecutions(dAtA, i, uint64(n31))
 		i--
 		dAtA[i] = 0x4a
 	}
 	if m.CloseTime != nil {
-		n30, err30 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CloseTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CloseTime):])
-		if err30 != nil {
-			return 0, err30
+		n32, err32 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CloseTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CloseTime):])
+		if err32 != nil {
+			return 0, err32
 		}
-		i -= n30
-		i = encodeVarintExecutions(dAtA, i, uint64(n30))
+		i -= n32
+		i = encodeVarintExecutions(dAtA, i, uint64(n32))
 		i--
 		dAtA[i] = 0x42
 	}
 	if m.VisibilityTime != nil {
-		n31, err31 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
-		if err31 != nil {
-			return 0, err31
+		n33, err33 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+		if err33 != nil {
+			return 0, err33
 		}
-		i -= n31
-		i = encodeVarintExecutions(dAtA, i, uint64(n31))
+		i -= n33
+		i = encodeVarintExecutions(dAtA, i, uint64(n33))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -5280,12 +5508,12 @@ func (m *TimerTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x62
 	}
 	if m.VisibilityTime != nil {
-		n32, err32 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
-		if err32 != nil {
-			return 0, err32
+		n34, err34 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+		if err34 != nil {
+			return 0, err34
 		}
-		i -= n32
-		i = encodeVarintExecutions(dAtA, i, uint64(n32))
+		i -= n34
+		i = encodeVarintExecutions(dAtA, i, uint64(n34))
 		i--
 		dAtA[i] = 0x5a
 	}
@@ -5369,12 +5597,12 @@ func (m *ArchivalTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.VisibilityTime != nil {
-		n33, err33 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
-		if err33 != nil {
-			return 0, err33
+		n35, err35 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+		if err35 != nil {
+			return 0, err35
 		}
-		i -= n33
-		i = encodeVarintExecutions(dAtA, i, uint64(n33))
+		i -= n35
+		i = encodeVarintExecutions(dAtA, i, uint64(n35))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -5437,13 +5665,25 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x88
+	}
 	if m.LastHeartbeatUpdateTime != nil {
-		n34, err34 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatUpdateTime):])
-		if err34 != nil {
-			return 0, err34
+		n36, err36 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatUpdateTime):])
+		if err36 != nil {
+			return 0, err36
 		}
-		i -= n34
-		i = encodeVarintExecutions(dAtA, i, uint64(n34))
+		i -= n36
+		i = encodeVarintExecutions(dAtA, i, uint64(n36))
 		i--
 		dAtA[i] = 0x2
 		i--
@@ -5513,12 +5753,12 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xc9
 	}
 	if m.RetryExpirationTime != nil {
-		n37, err37 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.RetryExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.RetryExpirationTime):])
-		if err37 != nil {
-			return 0, err37
+		n39, err39 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.RetryExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.RetryExpirationTime):])
+		if err39 != nil {
+			return 0, err39
 		}
-		i -= n37
-		i = encodeVarintExecutions(dAtA, i, uint64(n37))
+		i -= n39
+		i = encodeVarintExecutions(dAtA, i, uint64(n39))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -5532,24 +5772,24 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xb8
 	}
 	if m.RetryMaximumInterval != nil {
-		n38, err38 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryMaximumInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryMaximumInterval):])
-		if err38 != nil {
-			return 0, err38
+		n40, err40 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryMaximumInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryMaximumInterval):])
+		if err40 != nil {
+			return 0, err40
 		}
-		i -= n38
-		i = encodeVarintExecutions(dAtA, i, uint64(n38))
+		i -= n40
+		i = encodeVarintExecutions(dAtA, i, uint64(n40))
 		i--
 		dAtA[i] = 0x1
 		i--
 		dAtA[i] = 0xb2
 	}
 	if m.RetryInitialInterval != nil {
-		n39, err39 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryInitialInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryInitialInterval):])
-		if err39 != nil {
-			return 0, err39
+		n41, err41 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryInitialInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryInitialInterval):])
+		if err41 != nil {
+			return 0, err41
 		}
-		i -= n39
-		i = encodeVarintExecutions(dAtA, i, uint64(n39))
+		i -= n41
+		i = encodeVarintExecutions(dAtA, i, uint64(n41))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -5615,42 +5855,42 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x70
 	}
 	if m.HeartbeatTimeout != nil {
-		n40, err40 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.HeartbeatTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.HeartbeatTimeout):])
-		if err40 != nil {
-			return 0, err40
+		n42, err42 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.HeartbeatTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.HeartbeatTimeout):])
+		if err42 != nil {
+			return 0, err42
 		}
-		i -= n40
-		i = encodeVarintExecutions(dAtA, i, uint64(n40))
+		i -= n42
+		i = encodeVarintExecutions(dAtA, i, uint64(n42))
 		i--
 		dAtA[i] = 0x6a
 	}
 	if m.StartToCloseTimeout != nil {
-		n41, err41 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StartToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StartToCloseTimeout):])
-		if err41 != nil {
-			return 0, err41
+		n43, err43 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StartToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StartToCloseTimeout):])
+		if err43 != nil {
+			return 0, err43
 		}
-		i -= n41
-		i = encodeVarintExecutions(dAtA, i, uint64(n41))
+		i -= n43
+		i = encodeVarintExecutions(dAtA, i, uint64(n43))
 		i--
 		dAtA[i] = 0x62
 	}
 	if m.ScheduleToCloseTimeout != nil {
-		n42, err42 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToCloseTimeout):])
-		if err42 != nil {
-			return 0, err42
+		n44, err44 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToCloseTimeout):])
+		if err44 != nil {
+			return 0, err44
 		}
-		i -= n42
-		i = encodeVarintExecutions(dAtA, i, uint64(n42))
+		i -= n44
+		i = encodeVarintExecutions(dAtA, i, uint64(n44))
 		i--
 		dAtA[i] = 0x5a
 	}
 	if m.ScheduleToStartTimeout != nil {
-		n43, err43 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout):])
-		if err43 != nil {
-			return 0, err43
-		}
-		i -= n43
-		i = encodeVarintExecutions(dAtA, i, uint64(n43))
+		n45, err45 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout):])
+		if err45 != nil {
+			return 0, err45
+		}
+		i -= n45
+		i = encodeVarintExecutions(dAtA, i, uint64(n45))
 		i--
 		dAtA[i] = 0x52
 	}
@@ -5669,12 +5909,12 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x42
 	}
 	if m.StartedTime != nil {
-		n44, err44 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
-		if err44 != nil {
-			return 0, err44
+		n46, err46 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
+		if err46 != nil {
+			return 0, err46
 		}
-		i -= n44
-		i = encodeVarintExecutions(dAtA, i, uint64(n44))
+		i -= n46
+		i = encodeVarintExecutions(dAtA, i, uint64(n46))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -5684,12 +5924,12 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x28
 	}
 	if m.ScheduledTime != nil {
-		n45, err45 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
-		if err45 != nil {
-			return 0, err45
+		n47, err47 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
+		if err47 != nil {
+			return 0, err47
 		}
-		i -= n45
-		i = encodeVarintExecutions(dAtA, i, uint64(n45))
+		i -= n47
+		i = encodeVarintExecutions(dAtA, i, uint64(n47))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -5739,12 +5979,12 @@ func (m *TimerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x20
 	}
 	if m.ExpiryTime != nil {
-		n46, err46 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
-		if err46 != nil {
-			return 0, err46
+		n48, err48 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
+		if err48 != nil {
+			return 0, err48
 		}
-		i -= n46
-		i = encodeVarintExecutions(dAtA, i, uint64(n46))
+		i -= n48
+		i = encodeVarintExecutions(dAtA, i, uint64(n48))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -6309,6 +6549,34 @@ func (m *WorkflowExecutionInfo) Size() (n int) {
 	if m.WorkflowTaskHistorySizeBytes != 0 {
 		n += 2 + sovExecutions(uint64(m.WorkflowTaskHistorySizeBytes))
 	}
+	if m.PauseInfo != nil {
+		l = m.PauseInfo.Size()
+		n += 2 + l + sovExecutions(uint64(l))
+	}
+	if m.ReplicationExcluded {
+		n += 3
+	}
+	return n
+}
+
+func (m *WorkflowPauseInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PauseTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.PauseTime)
+		n += 1 + l + sovExecutions(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovExecutions(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovExecutions(uint64(l))
+	}
 	return n
 }
 
@@ -6733,6 +7001,9 @@ func (m *ActivityInfo) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatUpdateTime)
 		n += 2 + l + sovExecutions(uint64(l))
 	}
+	if m.Paused {
+		n += 3
+	}
 	return n
 }
 
@@ -7016,6 +7287,20 @@ func (this *WorkflowExecutionInfo) String() string {
 		`WorkflowTaskType:` + fmt.Sprintf("%v", this.WorkflowTaskType) + `,`,
 		`WorkflowTaskSuggestContinueAsNew:` + fmt.Sprintf("%v", this.WorkflowTaskSuggestContinueAsNew) + `,`,
 		`WorkflowTaskHistorySizeBytes:` + fmt.Sprintf("%v", this.WorkflowTaskHistorySizeBytes) + `,`,
+		`PauseInfo:` + strings.Replace(this.PauseInfo.String(), "WorkflowPauseInfo", "WorkflowPauseInfo", 1) + `,`,
+		`ReplicationExcluded:` + fmt.Sprintf("%v", this.ReplicationExcluded) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WorkflowPauseInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WorkflowPauseInfo{`,
+		`PauseTime:` + strings.Replace(fmt.Sprintf("%v", this.PauseTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -7200,6 +7485,7 @@ func (this *ActivityInfo) String() string {
 		`ScheduledEventId:` + fmt.Sprintf("%v", this.ScheduledEventId) + `,`,
 		`LastHeartbeatDetails:` + strings.Replace(fmt.Sprintf("%v", this.LastHeartbeatDetails), "Payloads", "v12.Payloads", 1) + `,`,
 		`LastHeartbeatUpdateTime:` + strings.Replace(fmt.Sprintf("%v", this.LastHeartbeatUpdateTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Paused:` + fmt.Sprintf("%v", this.Paused) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -9732,6 +10018,215 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 71:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PauseInfo == nil {
+				m.PauseInfo = &WorkflowPauseInfo{}
+			}
+			if err := m.PauseInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 72:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationExcluded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReplicationExcluded = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecutions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowPauseInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecutions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowPauseInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowPauseInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PauseTime == nil {
+				m.PauseTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.PauseTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExecutions(dAtA[iNdEx:])
@@ -12722,6 +13217,26 @@ func (m *ActivityInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExecutions(dAtA[iNdEx:])
@@ -66,6 +66,8 @@ type NamespaceDetail struct {
 	FailoverNotificationVersion int64                       `protobuf:"varint,5,opt,name=failover_notification_version,json=failoverNotificationVersion,proto3" json:"failover_notification_version,omitempty"`
 	FailoverVersion             int64                       `protobuf:"varint,6,opt,name=failover_version,json=failoverVersion,proto3" json:"failover_version,omitempty"`
 	FailoverEndTime             *time.Time                  `protobuf:"bytes,7,opt,name=failover_end_time,json=failoverEndTime,proto3,stdtime" json:"failover_end_time,omitempty"`
+	// API keys issued by the server for this namespace, keyed by key id.
+	ApiKeys map[string]*NamespaceApiKey `protobuf:"bytes,8,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *NamespaceDetail) Reset()      { *m = NamespaceDetail{} }
@@ -149,6 +151,13 @@ func (m *NamespaceDetail) GetFailoverEndTime() *time.Time {
 	return nil
 }
 
+func (m *NamespaceDetail) GetApiKeys() map[string]*NamespaceApiKey {
+	if m != nil {
+		return m.ApiKeys
+	}
+	return nil
+}
+
 type NamespaceInfo struct {
 	Id          string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	State       v1.NamespaceState `protobuf:"varint,2,opt,name=state,proto3,enum=temporal.api.enums.v1.NamespaceState" json:"state,omitempty"`
@@ -450,14 +459,174 @@ func (m *FailoverStatus) GetFailoverVersion() int64 {
 	return 0
 }
 
+// API key issued by the server for a namespace. Only a hash of the key secret is stored.
+type NamespaceApiKey struct {
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Role granted on the namespace: read, write, worker or admin.
+	Role       string     `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	SecretHash string     `protobuf:"bytes,4,opt,name=secret_hash,json=secretHash,proto3" json:"secret_hash,omitempty"`
+	CreatedBy  string     `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreateTime *time.Time `protobuf:"bytes,6,opt,name=create_time,json=createTime,proto3,stdtime" json:"create_time,omitempty"`
+	RotateTime *time.Time `protobuf:"bytes,7,opt,name=rotate_time,json=rotateTime,proto3,stdtime" json:"rotate_time,omitempty"`
+	ExpireTime *time.Time `protobuf:"bytes,8,opt,name=expire_time,json=expireTime,proto3,stdtime" json:"expire_time,omitempty"`
+	// Not stored with the namespace. It is set from the ApiKeyUsage record when keys are listed.
+	LastUsedTime *time.Time `protobuf:"bytes,9,opt,name=last_used_time,json=lastUsedTime,proto3,stdtime" json:"last_used_time,omitempty"`
+}
+
+func (m *NamespaceApiKey) Reset()      { *m = NamespaceApiKey{} }
+func (*NamespaceApiKey) ProtoMessage() {}
+func (*NamespaceApiKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0486d93c2107d6bc, []int{5}
+}
+func (m *NamespaceApiKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespaceApiKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NamespaceApiKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NamespaceApiKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceApiKey.Merge(m, src)
+}
+func (m *NamespaceApiKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespaceApiKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceApiKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceApiKey proto.InternalMessageInfo
+
+func (m *NamespaceApiKey) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *NamespaceApiKey) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *NamespaceApiKey) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *NamespaceApiKey) GetSecretHash() string {
+	if m != nil {
+		return m.SecretHash
+	}
+	return ""
+}
+
+func (m *NamespaceApiKey) GetCreatedBy() string {
+	if m != nil {
+		return m.CreatedBy
+	}
+	return ""
+}
+
+func (m *NamespaceApiKey) GetCreateTime() *time.Time {
+	if m != nil {
+		return m.CreateTime
+	}
+	return nil
+}
+
+func (m *NamespaceApiKey) GetRotateTime() *time.Time {
+	if m != nil {
+		return m.RotateTime
+	}
+	return nil
+}
+
+func (m *NamespaceApiKey) GetExpireTime() *time.Time {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return nil
+}
+
+func (m *NamespaceApiKey) GetLastUsedTime() *time.Time {
+	if m != nil {
+		return m.LastUsedTime
+	}
+	return nil
+}
+
+// Last used time of the API keys of all namespaces. It is kept apart from the namespace metadata so
+// authenticating requests doesn't update namespaces.
+type ApiKeyUsage struct {
+	// Keyed by key id.
+	LastUsedTimes map[string]*time.Time `protobuf:"bytes,1,rep,name=last_used_times,json=lastUsedTimes,proto3,stdtime" json:"last_used_times,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *ApiKeyUsage) Reset()      { *m = ApiKeyUsage{} }
+func (*ApiKeyUsage) ProtoMessage() {}
+func (*ApiKeyUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0486d93c2107d6bc, []int{6}
+}
+func (m *ApiKeyUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApiKeyUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApiKeyUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApiKeyUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiKeyUsage.Merge(m, src)
+}
+func (m *ApiKeyUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApiKeyUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiKeyUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiKeyUsage proto.InternalMessageInfo
+
+func (m *ApiKeyUsage) GetLastUsedTimes() map[string]*time.Time {
+	if m != nil {
+		return m.LastUsedTimes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*NamespaceDetail)(nil), "temporal.server.api.persistence.v1.NamespaceDetail")
+	proto.RegisterMapType((map[string]*NamespaceApiKey)(nil), "temporal.server.api.persistence.v1.NamespaceDetail.ApiKeysEntry")
 	proto.RegisterType((*NamespaceInfo)(nil), "temporal.server.api.persistence.v1.NamespaceInfo")
 	proto.RegisterMapType((map[string]string)(nil), "temporal.server.api.persistence.v1.NamespaceInfo.DataEntry")
 	proto.RegisterType((*NamespaceConfig)(nil), "temporal.server.api.persistence.v1.NamespaceConfig")
 	proto.RegisterMapType((map[string]string)(nil), "temporal.server.api.persistence.v1.NamespaceConfig.CustomSearchAttributeAliasesEntry")
 	proto.RegisterType((*NamespaceReplicationConfig)(nil), "temporal.server.api.persistence.v1.NamespaceReplicationConfig")
 	proto.RegisterType((*FailoverStatus)(nil), "temporal.server.api.persistence.v1.FailoverStatus")
+	proto.RegisterType((*NamespaceApiKey)(nil), "temporal.server.api.persistence.v1.NamespaceApiKey")
+	proto.RegisterType((*ApiKeyUsage)(nil), "temporal.server.api.persistence.v1.ApiKeyUsage")
+	proto.RegisterMapType((map[string]*time.Time)(nil), "temporal.server.api.persistence.v1.ApiKeyUsage.LastUsedTimesEntry")
 }
 
 func init() {
@@ -465,67 +634,80 @@ func init() {
 }
 
 var fileDescriptor_0486d93c2107d6bc = []byte{
-	// 949 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xda, 0x8e, 0x5b, 0x4f, 0x1a, 0xa7, 0x19, 0x02, 0x75, 0x0d, 0x6c, 0x5c, 0x8b, 0x90,
-	0x70, 0x59, 0x93, 0x04, 0x01, 0x22, 0x2a, 0x52, 0x9c, 0x04, 0x51, 0x81, 0x5a, 0x69, 0x4b, 0x39,
-	0x54, 0x42, 0xcb, 0x78, 0x77, 0xec, 0x0e, 0x5d, 0xef, 0xac, 0x66, 0x66, 0x17, 0xe5, 0x86, 0x38,
-	0x70, 0xee, 0x91, 0x03, 0x7f, 0x00, 0x67, 0xfe, 0x0a, 0x8e, 0x39, 0xf6, 0x80, 0x04, 0x71, 0x2e,
-	0x1c, 0x7b, 0xe4, 0x88, 0xe6, 0xc7, 0xee, 0x7a, 0x93, 0x98, 0xd6, 0xdc, 0x3c, 0xef, 0x7d, 0xdf,
-	0x37, 0x6f, 0xde, 0xf7, 0xf6, 0xc9, 0x60, 0x4f, 0xe0, 0x49, 0x4c, 0x19, 0x0a, 0xfb, 0x1c, 0xb3,
-	0x14, 0xb3, 0x3e, 0x8a, 0x49, 0x3f, 0xc6, 0x8c, 0x13, 0x2e, 0x70, 0xe4, 0xe3, 0x7e, 0xba, 0xd3,
-	0x8f, 0xd0, 0x04, 0xf3, 0x18, 0xf9, 0x98, 0x3b, 0x31, 0xa3, 0x82, 0xc2, 0x5e, 0x46, 0x72, 0x34,
-	0xc9, 0x41, 0x31, 0x71, 0x66, 0x48, 0x4e, 0xba, 0xd3, 0xb1, 0xc7, 0x94, 0x8e, 0x43, 0xdc, 0x57,
-	0x8c, 0x61, 0x32, 0xea, 0x07, 0x09, 0x43, 0x82, 0xd0, 0x48, 0x6b, 0x74, 0x36, 0x2e, 0xe6, 0x05,
-	0x99, 0x60, 0x2e, 0xd0, 0x24, 0x36, 0x80, 0x3b, 0x01, 0x8e, 0x71, 0x14, 0xe0, 0xc8, 0x27, 0x98,
-	0xf7, 0xc7, 0x74, 0x4c, 0x55, 0x5c, 0xfd, 0x32, 0x90, 0xcd, 0xbc, 0x78, 0x59, 0x35, 0x8e, 0x92,
-	0x09, 0x2f, 0xd5, 0x6b, 0x60, 0x5b, 0x25, 0x58, 0x9e, 0x95, 0xd0, 0x09, 0xe6, 0x1c, 0x8d, 0x0d,
-	0xb0, 0xf7, 0x4f, 0x0d, 0xac, 0xde, 0xcf, 0xd2, 0x47, 0x58, 0x20, 0x12, 0xc2, 0x63, 0x50, 0x27,
-	0xd1, 0x88, 0xb6, 0xad, 0xae, 0xb5, 0xbd, 0xbc, 0xbb, 0xe3, 0xbc, 0xfc, 0xe9, 0x4e, 0x2e, 0x71,
-	0x2f, 0x1a, 0x51, 0x57, 0xd1, 0xe1, 0x17, 0xa0, 0xe1, 0xd3, 0x68, 0x44, 0xc6, 0xed, 0xaa, 0x12,
-	0xda, 0x5b, 0x48, 0xe8, 0x50, 0x51, 0x5d, 0x23, 0x01, 0x27, 0x00, 0x32, 0x1c, 0x87, 0xc4, 0x57,
-	0x0d, 0xf5, 0x8c, 0x70, 0x4d, 0x09, 0x7f, 0xba, 0x90, 0xb0, 0x5b, 0xc8, 0x98, 0x3b, 0xd6, 0xd8,
-	0xc5, 0x10, 0xdc, 0x04, 0x2d, 0x7d, 0x85, 0x97, 0x4a, 0x19, 0x1a, 0xb5, 0xeb, 0x5d, 0x6b, 0xbb,
-	0xe6, 0xae, 0xe8, 0xe8, 0xd7, 0x3a, 0x08, 0x07, 0xe0, 0xed, 0x11, 0x22, 0x21, 0x4d, 0x31, 0xf3,
-	0x22, 0x2a, 0xc8, 0x28, 0xab, 0x2f, 0x63, 0x2d, 0x29, 0xd6, 0x9b, 0x19, 0xe8, 0xfe, 0x0c, 0x26,
-	0xd3, 0x78, 0x0f, 0xdc, 0xcc, 0x35, 0x32, 0x5a, 0x43, 0xd1, 0x56, 0xb3, 0x78, 0x06, 0xfd, 0x12,
-	0xac, 0xe5, 0x50, 0x1c, 0x05, 0x9e, 0x9c, 0x9f, 0xf6, 0x35, 0xd5, 0x83, 0x8e, 0xa3, 0x87, 0xcb,
-	0xc9, 0x86, 0xcb, 0xf9, 0x2a, 0x1b, 0xae, 0x41, 0xfd, 0xd9, 0x9f, 0x1b, 0x56, 0xa1, 0x76, 0x1c,
-	0x05, 0x32, 0xd7, 0xfb, 0xad, 0x0a, 0x56, 0x4a, 0xbe, 0xc1, 0x16, 0xa8, 0x92, 0x40, 0xd9, 0xde,
-	0x74, 0xab, 0x24, 0x80, 0xfb, 0x60, 0x89, 0x0b, 0x24, 0xb0, 0x32, 0xb0, 0xb5, 0xbb, 0x59, 0xf4,
-	0x59, 0x36, 0x58, 0x0d, 0x5f, 0xa9, 0xb5, 0x0f, 0x25, 0xd8, 0xd5, 0x1c, 0x08, 0x41, 0x5d, 0xce,
-	0x9d, 0xf2, 0xa8, 0xe9, 0xaa, 0xdf, 0xb0, 0x0b, 0x96, 0x03, 0xcc, 0x7d, 0x46, 0x62, 0x91, 0xf5,
-	0xb4, 0xe9, 0xce, 0x86, 0xe0, 0x3a, 0x58, 0xa2, 0xdf, 0x47, 0x98, 0xa9, 0xce, 0x35, 0x5d, 0x7d,
-	0x80, 0x0f, 0x40, 0x3d, 0x40, 0x02, 0xb5, 0x1b, 0xdd, 0xda, 0xf6, 0xf2, 0xee, 0xfe, 0xc2, 0x13,
-	0xe9, 0x1c, 0x21, 0x81, 0x8e, 0x23, 0xc1, 0x4e, 0x5c, 0x25, 0xd4, 0xf9, 0x08, 0x34, 0xf3, 0x10,
-	0xbc, 0x09, 0x6a, 0x4f, 0xf1, 0x89, 0x79, 0xb7, 0xfc, 0x29, 0xab, 0x48, 0x51, 0x98, 0xe8, 0x87,
-	0x37, 0x5d, 0x7d, 0xf8, 0xa4, 0xfa, 0xb1, 0xd5, 0xfb, 0x63, 0x69, 0xe6, 0x7b, 0x31, 0xc3, 0x72,
-	0x17, 0x34, 0x19, 0x16, 0x38, 0x52, 0x6f, 0xd2, 0x1f, 0xcd, 0xed, 0x4b, 0x76, 0x1c, 0x99, 0x5d,
-	0x30, 0xa8, 0xff, 0x2c, 0xdd, 0x28, 0x18, 0x70, 0x0b, 0xac, 0x22, 0xe6, 0x3f, 0x21, 0x29, 0x0a,
-	0xbd, 0x61, 0xe2, 0x3f, 0xc5, 0xc2, 0x5c, 0xdb, 0xca, 0xc2, 0x03, 0x15, 0x85, 0xf7, 0xc0, 0x8d,
-	0x21, 0x0a, 0xbc, 0x21, 0x89, 0x10, 0x23, 0x98, 0x9b, 0xe9, 0x7f, 0xb7, 0xec, 0x4a, 0xb1, 0x09,
-	0xd2, 0x1d, 0x67, 0x80, 0x82, 0x81, 0x41, 0xbb, 0xcb, 0xc3, 0xe2, 0x00, 0x1f, 0x83, 0x37, 0x9e,
-	0x10, 0x2e, 0x28, 0x3b, 0xf1, 0xf2, 0xbb, 0xb5, 0xd5, 0x75, 0x65, 0xf5, 0x3b, 0x73, 0xac, 0x3e,
-	0x30, 0x60, 0xed, 0xf4, 0xba, 0xd1, 0x28, 0x45, 0xe1, 0xfb, 0x60, 0xfd, 0x92, 0x76, 0xc2, 0x88,
-	0x71, 0x14, 0x5e, 0xe0, 0x3c, 0x62, 0x04, 0x7e, 0x0b, 0x6e, 0xa7, 0x84, 0x93, 0x21, 0x09, 0x89,
-	0xb8, 0x54, 0x50, 0x63, 0x81, 0x82, 0x6e, 0x15, 0x32, 0xe5, 0x9a, 0x3e, 0x04, 0xb7, 0xae, 0xba,
-	0x41, 0x96, 0x75, 0x4d, 0x95, 0xf5, 0xfa, 0x65, 0xa6, 0xac, 0xec, 0x17, 0x0b, 0x6c, 0xf8, 0x09,
-	0x17, 0x74, 0xe2, 0x71, 0x2c, 0x69, 0x1e, 0x12, 0x82, 0x91, 0x61, 0x22, 0xb0, 0x87, 0x42, 0x82,
-	0x38, 0xe6, 0xed, 0xeb, 0x6a, 0x28, 0x1f, 0xfd, 0x8f, 0xed, 0xe6, 0x1c, 0x2a, 0xe9, 0x87, 0x4a,
-	0xf9, 0x20, 0x13, 0x3e, 0xd0, 0xba, 0x7a, 0x5c, 0xdf, 0xf2, 0xff, 0x03, 0xd2, 0x79, 0x00, 0xee,
-	0xbc, 0x54, 0x62, 0xa1, 0xf1, 0xfe, 0xa9, 0x0a, 0x3a, 0xf3, 0x37, 0x25, 0x74, 0xc0, 0x6b, 0xc8,
-	0x17, 0x24, 0xc5, 0x9e, 0x1f, 0x26, 0x5c, 0xc8, 0xad, 0x27, 0x3f, 0x71, 0x2d, 0xbd, 0xa6, 0x53,
-	0x87, 0x3a, 0x23, 0x55, 0x60, 0x07, 0x5c, 0x37, 0x40, 0xde, 0xae, 0x76, 0x6b, 0xdb, 0x4d, 0x37,
-	0x3f, 0xc3, 0xbb, 0xd9, 0x72, 0xa9, 0x29, 0x83, 0xb7, 0xe6, 0x18, 0x3c, 0x53, 0x44, 0x69, 0xbd,
-	0x7c, 0x33, 0xb3, 0x36, 0xcd, 0x48, 0x19, 0x27, 0x76, 0x5f, 0xc5, 0x89, 0xcf, 0x0c, 0x57, 0x6a,
-	0x26, 0xbc, 0x58, 0x8e, 0x9f, 0x6b, 0xa9, 0xde, 0x8f, 0x16, 0x68, 0x95, 0x31, 0xf0, 0x18, 0xac,
-	0xe4, 0x37, 0xaa, 0xcd, 0x6b, 0xbd, 0xe2, 0xe6, 0xbd, 0x91, 0xd1, 0x64, 0xe2, 0xca, 0x7d, 0x5f,
-	0xbd, 0x72, 0xdf, 0x0f, 0xbe, 0x3b, 0x3d, 0xb3, 0x2b, 0xcf, 0xcf, 0xec, 0xca, 0x8b, 0x33, 0xdb,
-	0xfa, 0x61, 0x6a, 0x5b, 0xbf, 0x4e, 0x6d, 0xeb, 0xf7, 0xa9, 0x6d, 0x9d, 0x4e, 0x6d, 0xeb, 0xaf,
-	0xa9, 0x6d, 0xfd, 0x3d, 0xb5, 0x2b, 0x2f, 0xa6, 0xb6, 0xf5, 0xec, 0xdc, 0xae, 0x9c, 0x9e, 0xdb,
-	0x95, 0xe7, 0xe7, 0x76, 0xe5, 0xf1, 0x07, 0x63, 0x5a, 0x74, 0x80, 0xd0, 0xf9, 0xff, 0x72, 0xf6,
-	0x67, 0x8e, 0xc3, 0x86, 0x2a, 0x7f, 0xef, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xee, 0xc8, 0xf5,
-	0xd7, 0x1e, 0x09, 0x00, 0x00,
+	// 1168 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xda, 0x8e, 0x1b, 0x3f, 0x37, 0x4e, 0x3b, 0x04, 0xea, 0x1a, 0xba, 0x49, 0x2d, 0x4a,
+	0xc3, 0x65, 0xdd, 0x24, 0x08, 0x10, 0x51, 0x11, 0x76, 0x92, 0xaa, 0x51, 0xab, 0x56, 0xda, 0x12,
+	0x0e, 0x05, 0xb4, 0x8c, 0x77, 0xc7, 0xce, 0x90, 0xf5, 0xee, 0x6a, 0x66, 0x6c, 0xf0, 0x0d, 0x71,
+	0xe0, 0xdc, 0x23, 0x42, 0xfc, 0x00, 0xce, 0xfc, 0x08, 0xc4, 0x31, 0xc7, 0x1e, 0x40, 0x10, 0xe7,
+	0xc2, 0xb1, 0x3f, 0x01, 0xed, 0xcc, 0xac, 0xbd, 0x8e, 0x93, 0xd6, 0xee, 0xcd, 0xf3, 0xe6, 0x7d,
+	0xdf, 0x7b, 0x33, 0xef, 0x7b, 0x6f, 0xd6, 0xb0, 0x25, 0x48, 0x37, 0x0a, 0x19, 0xf6, 0xeb, 0x9c,
+	0xb0, 0x3e, 0x61, 0x75, 0x1c, 0xd1, 0x7a, 0x44, 0x18, 0xa7, 0x5c, 0x90, 0xc0, 0x25, 0xf5, 0xfe,
+	0x46, 0x3d, 0xc0, 0x5d, 0xc2, 0x23, 0xec, 0x12, 0x6e, 0x45, 0x2c, 0x14, 0x21, 0xaa, 0x25, 0x20,
+	0x4b, 0x81, 0x2c, 0x1c, 0x51, 0x2b, 0x05, 0xb2, 0xfa, 0x1b, 0x55, 0xb3, 0x13, 0x86, 0x1d, 0x9f,
+	0xd4, 0x25, 0xa2, 0xd5, 0x6b, 0xd7, 0xbd, 0x1e, 0xc3, 0x82, 0x86, 0x81, 0xe2, 0xa8, 0xae, 0x9e,
+	0xdd, 0x17, 0xb4, 0x4b, 0xb8, 0xc0, 0xdd, 0x48, 0x3b, 0xdc, 0xf4, 0x48, 0x44, 0x02, 0x8f, 0x04,
+	0x2e, 0x25, 0xbc, 0xde, 0x09, 0x3b, 0xa1, 0xb4, 0xcb, 0x5f, 0xda, 0xe5, 0xd6, 0x28, 0xf9, 0x38,
+	0x6b, 0x12, 0xf4, 0xba, 0x7c, 0x22, 0x5f, 0xed, 0x76, 0x7b, 0xc2, 0x6d, 0xb4, 0x1b, 0xbb, 0x76,
+	0x09, 0xe7, 0xb8, 0xa3, 0x1d, 0x6b, 0x7f, 0x2c, 0xc0, 0xf2, 0xa3, 0x64, 0x7b, 0x97, 0x08, 0x4c,
+	0x7d, 0xb4, 0x07, 0x79, 0x1a, 0xb4, 0xc3, 0x8a, 0xb1, 0x66, 0xac, 0x97, 0x36, 0x37, 0xac, 0x57,
+	0x1f, 0xdd, 0x1a, 0x51, 0xec, 0x07, 0xed, 0xd0, 0x96, 0x70, 0xf4, 0x00, 0x0a, 0x6e, 0x18, 0xb4,
+	0x69, 0xa7, 0x92, 0x95, 0x44, 0x5b, 0x73, 0x11, 0xed, 0x48, 0xa8, 0xad, 0x29, 0x50, 0x17, 0x10,
+	0x23, 0x91, 0x4f, 0x5d, 0x79, 0xa1, 0x8e, 0x26, 0xce, 0x49, 0xe2, 0x4f, 0xe7, 0x22, 0xb6, 0xc7,
+	0x34, 0x3a, 0xc6, 0x55, 0x76, 0xd6, 0x84, 0x6e, 0x41, 0x59, 0x85, 0x70, 0xfa, 0x31, 0x4d, 0x18,
+	0x54, 0xf2, 0x6b, 0xc6, 0x7a, 0xce, 0x5e, 0x52, 0xd6, 0x2f, 0x94, 0x11, 0x35, 0xe1, 0x46, 0x1b,
+	0x53, 0x3f, 0xec, 0x13, 0xe6, 0x04, 0xa1, 0xa0, 0xed, 0x24, 0xbf, 0x04, 0xb5, 0x20, 0x51, 0x6f,
+	0x27, 0x4e, 0x8f, 0x52, 0x3e, 0x09, 0xc7, 0xfb, 0x70, 0x65, 0xc4, 0x91, 0xc0, 0x0a, 0x12, 0xb6,
+	0x9c, 0xd8, 0x13, 0xd7, 0x87, 0x70, 0x75, 0xe4, 0x4a, 0x02, 0xcf, 0x89, 0xf5, 0x53, 0xb9, 0x24,
+	0xef, 0xa0, 0x6a, 0x29, 0x71, 0x59, 0x89, 0xb8, 0xac, 0xcf, 0x13, 0x71, 0x35, 0xf3, 0xcf, 0xfe,
+	0x59, 0x35, 0xc6, 0x6c, 0x7b, 0x81, 0x17, 0xef, 0xa1, 0x2f, 0x61, 0x11, 0x47, 0xd4, 0x39, 0x22,
+	0x03, 0x5e, 0x59, 0x5c, 0xcb, 0xad, 0x97, 0x36, 0x3f, 0x9b, 0xeb, 0x22, 0x95, 0x5a, 0xac, 0x46,
+	0x44, 0x1f, 0x90, 0x01, 0xdf, 0x0b, 0x04, 0x1b, 0xd8, 0x97, 0xb0, 0x5a, 0x55, 0x43, 0xb8, 0x9c,
+	0xde, 0x40, 0x57, 0x20, 0x77, 0x44, 0x06, 0x52, 0x52, 0x45, 0x3b, 0xfe, 0x89, 0xf6, 0x61, 0xa1,
+	0x8f, 0xfd, 0x1e, 0x79, 0x2d, 0x75, 0x28, 0x6e, 0x5b, 0x31, 0x7c, 0x92, 0xfd, 0xd8, 0xa8, 0xfd,
+	0x9e, 0x85, 0xa5, 0x09, 0x15, 0xa2, 0x32, 0x64, 0xa9, 0xa7, 0x23, 0x66, 0xa9, 0x87, 0xb6, 0x61,
+	0x81, 0x0b, 0x2c, 0x54, 0xc0, 0xf2, 0xe6, 0xad, 0x71, 0xc0, 0x38, 0x92, 0x6c, 0xa5, 0x89, 0x18,
+	0x4f, 0x62, 0x67, 0x5b, 0x61, 0x10, 0x82, 0x7c, 0xdc, 0x45, 0x52, 0x71, 0x45, 0x5b, 0xfe, 0x46,
+	0x6b, 0x50, 0xf2, 0x08, 0x77, 0x19, 0x8d, 0x44, 0xa2, 0x90, 0xa2, 0x9d, 0x36, 0xa1, 0x15, 0x58,
+	0x08, 0xbf, 0x0b, 0x08, 0x93, 0x3a, 0x28, 0xda, 0x6a, 0x81, 0x1e, 0x43, 0xde, 0xc3, 0x02, 0x57,
+	0x0a, 0xf2, 0xd2, 0xb7, 0xe7, 0xee, 0x2f, 0x6b, 0x17, 0x0b, 0xac, 0xee, 0x5b, 0x12, 0x55, 0x3f,
+	0x82, 0xe2, 0xc8, 0x74, 0xce, 0x4d, 0xaf, 0xa4, 0x6f, 0xba, 0x98, 0xbe, 0xb4, 0xbf, 0xd2, 0xdd,
+	0xaf, 0xa5, 0x7f, 0x17, 0x8a, 0x8c, 0x08, 0x12, 0xc8, 0x33, 0xa9, 0x11, 0x70, 0x7d, 0x4a, 0x5c,
+	0xbb, 0x7a, 0xb2, 0x35, 0xf3, 0x3f, 0xc7, 0xda, 0x1a, 0x23, 0xd0, 0x6d, 0x58, 0xc6, 0xcc, 0x3d,
+	0xa4, 0x7d, 0xec, 0x3b, 0xad, 0x9e, 0x7b, 0x44, 0x84, 0x0e, 0x5b, 0x4e, 0xcc, 0x4d, 0x69, 0x45,
+	0xfb, 0x70, 0xb9, 0x85, 0x3d, 0xa7, 0x45, 0x03, 0xcc, 0x28, 0xe1, 0xba, 0x97, 0xdf, 0x9b, 0xac,
+	0xca, 0x78, 0xae, 0xf5, 0x37, 0xac, 0x26, 0xf6, 0x9a, 0xda, 0xdb, 0x2e, 0xb5, 0xc6, 0x0b, 0xf4,
+	0x14, 0xde, 0x3a, 0xa4, 0x5c, 0x84, 0x6c, 0xe0, 0x8c, 0x62, 0xab, 0x52, 0xe7, 0x65, 0xa9, 0xdf,
+	0xbd, 0xa0, 0xd4, 0x0d, 0xed, 0xac, 0x2a, 0xbd, 0xa2, 0x39, 0x26, 0xac, 0xe8, 0x0e, 0xac, 0x4c,
+	0x71, 0xf7, 0x18, 0xd5, 0x15, 0x45, 0x67, 0x30, 0x07, 0x8c, 0xa2, 0x6f, 0xe0, 0x7a, 0x9f, 0x72,
+	0xda, 0xa2, 0x3e, 0x15, 0x53, 0x09, 0x15, 0xe6, 0x48, 0xe8, 0xda, 0x98, 0x66, 0x32, 0xa7, 0x0f,
+	0xe1, 0xda, 0x79, 0x11, 0xe2, 0xb4, 0x2e, 0xc9, 0xb4, 0xde, 0x9c, 0x46, 0xc6, 0x99, 0xfd, 0x6a,
+	0xc0, 0xaa, 0xdb, 0xe3, 0x22, 0xec, 0x3a, 0x9c, 0xc4, 0x30, 0x07, 0x0b, 0xc1, 0x68, 0xab, 0x27,
+	0x88, 0x83, 0x7d, 0x8a, 0x39, 0x49, 0x26, 0xc1, 0xc1, 0x6b, 0xcc, 0x6a, 0x6b, 0x47, 0x52, 0x3f,
+	0x91, 0xcc, 0x8d, 0x84, 0xb8, 0xa1, 0x78, 0x95, 0x5c, 0xdf, 0x71, 0x5f, 0xe2, 0x52, 0x7d, 0x0c,
+	0x37, 0x5f, 0x49, 0x31, 0x97, 0xbc, 0x7f, 0xca, 0x42, 0xf5, 0xe2, 0xb9, 0x8f, 0x2c, 0x78, 0x03,
+	0xbb, 0x82, 0xf6, 0x89, 0xe3, 0xfa, 0x3d, 0x2e, 0xe2, 0x19, 0x1e, 0xb7, 0xb8, 0xa2, 0xbe, 0xaa,
+	0xb6, 0x76, 0xd4, 0x4e, 0xcc, 0x82, 0xaa, 0xb0, 0xa8, 0x1d, 0x79, 0x25, 0xbb, 0x96, 0x5b, 0x2f,
+	0xda, 0xa3, 0x35, 0xba, 0x9b, 0x0c, 0x97, 0x9c, 0x2c, 0xf0, 0xed, 0x0b, 0x0a, 0x9c, 0x4a, 0x62,
+	0x62, 0xbc, 0x7c, 0x9d, 0x7a, 0x04, 0xb4, 0xa4, 0x74, 0x25, 0x36, 0x67, 0xa9, 0xc4, 0x3d, 0x8d,
+	0x8d, 0x39, 0x7b, 0x7c, 0x3c, 0xea, 0xef, 0x2b, 0xaa, 0xda, 0x8f, 0x06, 0x94, 0x27, 0x7d, 0xd0,
+	0x1e, 0x2c, 0x8d, 0x22, 0x0a, 0xaa, 0x8f, 0x3d, 0xcb, 0x3b, 0x72, 0x39, 0x81, 0xc5, 0x1b, 0xe7,
+	0xbe, 0x5e, 0xd9, 0x73, 0x5f, 0xaf, 0xda, 0x2f, 0x39, 0x58, 0x3e, 0x33, 0xc0, 0xa7, 0x66, 0x74,
+	0x32, 0x66, 0xb3, 0xa9, 0x31, 0x8b, 0x20, 0xcf, 0x42, 0x7f, 0x34, 0x7a, 0xe3, 0xdf, 0x68, 0x15,
+	0x4a, 0x9c, 0xb8, 0x8c, 0x08, 0xe7, 0x10, 0xf3, 0x43, 0x3d, 0x7a, 0x41, 0x99, 0xee, 0x63, 0x7e,
+	0x88, 0x6e, 0x00, 0xb8, 0x8c, 0x60, 0x41, 0x3c, 0xa7, 0x35, 0xd0, 0xcd, 0x5a, 0xd4, 0x96, 0xe6,
+	0x00, 0x35, 0xa0, 0xa4, 0x16, 0xea, 0xec, 0x85, 0x19, 0xcf, 0xae, 0x39, 0xe5, 0xc9, 0x1b, 0x50,
+	0x62, 0xa1, 0x18, 0x51, 0xcc, 0xfa, 0x0c, 0x83, 0x02, 0x25, 0x14, 0xe4, 0xfb, 0x88, 0x32, 0x4d,
+	0xb1, 0x38, 0x2b, 0x85, 0x02, 0x49, 0x8a, 0x7b, 0x50, 0xf6, 0x31, 0x17, 0x4e, 0x8f, 0x13, 0xfd,
+	0x3d, 0x50, 0x9c, 0xb5, 0x8e, 0x31, 0xee, 0x80, 0x13, 0xf9, 0x31, 0x50, 0xfb, 0xdb, 0x80, 0x92,
+	0xaa, 0xc9, 0x41, 0xfc, 0x75, 0x88, 0x22, 0x58, 0x9e, 0xe4, 0xe5, 0x15, 0x43, 0xea, 0xb1, 0x39,
+	0x8b, 0x1e, 0x53, 0x4c, 0xd6, 0xc3, 0x54, 0x18, 0xd5, 0xc3, 0x3a, 0x81, 0xa5, 0x74, 0x02, 0xbc,
+	0xfa, 0x15, 0xa0, 0x69, 0xd7, 0x73, 0xda, 0xfd, 0xce, 0xe4, 0x77, 0xc3, 0x4b, 0x0e, 0x9a, 0x1a,
+	0x05, 0xcd, 0x6f, 0x8f, 0x4f, 0xcc, 0xcc, 0xf3, 0x13, 0x33, 0xf3, 0xe2, 0xc4, 0x34, 0x7e, 0x18,
+	0x9a, 0xc6, 0x6f, 0x43, 0xd3, 0xf8, 0x73, 0x68, 0x1a, 0xc7, 0x43, 0xd3, 0xf8, 0x77, 0x68, 0x1a,
+	0xff, 0x0d, 0xcd, 0xcc, 0x8b, 0xa1, 0x69, 0x3c, 0x3b, 0x35, 0x33, 0xc7, 0xa7, 0x66, 0xe6, 0xf9,
+	0xa9, 0x99, 0x79, 0xfa, 0x41, 0x27, 0x1c, 0x1f, 0x97, 0x86, 0x17, 0xff, 0x61, 0xd8, 0x4e, 0x2d,
+	0x5b, 0x05, 0x99, 0xca, 0xd6, 0xff, 0x03, 0x00, 0xf0, 0x89, 0x3f, 0x08, 0x69, 0x0c, 0x00, 0x00,
 }
 
 func (this *NamespaceDetail) Equal(that interface{}) bool {
@@ -572,6 +754,14 @@ func (this *NamespaceDetail) Equal(that interface{}) bool {
 	} else if !this.FailoverEndTime.Equal(*that1.FailoverEndTime) {
 		return false
 	}
+	if len(this.ApiKeys) != len(that1.ApiKeys) {
+		return false
+	}
+	for i := range this.ApiKeys {
+		if !this.ApiKeys[i].Equal(that1.ApiKeys[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *NamespaceInfo) Equal(that interface{}) bool {
@@ -748,11 +938,104 @@ func (this *FailoverStatus) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *NamespaceApiKey) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NamespaceApiKey)
+	if !ok {
+		that2, ok := that.(NamespaceApiKey)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Role != that1.Role {
+		return false
+	}
+	if this.SecretHash != that1.SecretHash {
+		return false
+	}
+	if this.CreatedBy != that1.CreatedBy {
+		return false
+	}
+	if that1.CreateTime == nil {
+		if this.CreateTime != nil {
+			return false
+		}
+	} else if !this.CreateTime.Equal(*that1.CreateTime) {
+		return false
+	}
+	if that1.RotateTime == nil {
+		if this.RotateTime != nil {
+			return false
+		}
+	} else if !this.RotateTime.Equal(*that1.RotateTime) {
+		return false
+	}
+	if that1.ExpireTime == nil {
+		if this.ExpireTime != nil {
+			return false
+		}
+	} else if !this.ExpireTime.Equal(*that1.ExpireTime) {
+		return false
+	}
+	if that1.LastUsedTime == nil {
+		if this.LastUsedTime != nil {
+			return false
+		}
+	} else if !this.LastUsedTime.Equal(*that1.LastUsedTime) {
+		return false
+	}
+	return true
+}
+func (this *ApiKeyUsage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApiKeyUsage)
+	if !ok {
+		that2, ok := that.(ApiKeyUsage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.LastUsedTimes) != len(that1.LastUsedTimes) {
+		return false
+	}
+	for i := range this.LastUsedTimes {
+		if !this.LastUsedTimes[i].Equal(*that1.LastUsedTimes[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *NamespaceDetail) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 11)
+	s := make([]string, 0, 12)
 	s = append(s, "&persistence.NamespaceDetail{")
 	if this.Info != nil {
 		s = append(s, "Info: "+fmt.Sprintf("%#v", this.Info)+",\n")
@@ -767,6 +1050,19 @@ func (this *NamespaceDetail) GoString() string {
 	s = append(s, "FailoverNotificationVersion: "+fmt.Sprintf("%#v", this.FailoverNotificationVersion)+",\n")
 	s = append(s, "FailoverVersion: "+fmt.Sprintf("%#v", this.FailoverVersion)+",\n")
 	s = append(s, "FailoverEndTime: "+fmt.Sprintf("%#v", this.FailoverEndTime)+",\n")
+	keysForApiKeys := make([]string, 0, len(this.ApiKeys))
+	for k, _ := range this.ApiKeys {
+		keysForApiKeys = append(keysForApiKeys, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForApiKeys)
+	mapStringForApiKeys := "map[string]*NamespaceApiKey{"
+	for _, k := range keysForApiKeys {
+		mapStringForApiKeys += fmt.Sprintf("%#v: %#v,", k, this.ApiKeys[k])
+	}
+	mapStringForApiKeys += "}"
+	if this.ApiKeys != nil {
+		s = append(s, "ApiKeys: "+mapStringForApiKeys+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -854,6 +1150,46 @@ func (this *FailoverStatus) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *NamespaceApiKey) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 13)
+	s = append(s, "&persistence.NamespaceApiKey{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Role: "+fmt.Sprintf("%#v", this.Role)+",\n")
+	s = append(s, "SecretHash: "+fmt.Sprintf("%#v", this.SecretHash)+",\n")
+	s = append(s, "CreatedBy: "+fmt.Sprintf("%#v", this.CreatedBy)+",\n")
+	s = append(s, "CreateTime: "+fmt.Sprintf("%#v", this.CreateTime)+",\n")
+	s = append(s, "RotateTime: "+fmt.Sprintf("%#v", this.RotateTime)+",\n")
+	s = append(s, "ExpireTime: "+fmt.Sprintf("%#v", this.ExpireTime)+",\n")
+	s = append(s, "LastUsedTime: "+fmt.Sprintf("%#v", this.LastUsedTime)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ApiKeyUsage) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&persistence.ApiKeyUsage{")
+	keysForLastUsedTimes := make([]string, 0, len(this.LastUsedTimes))
+	for k, _ := range this.LastUsedTimes {
+		keysForLastUsedTimes = append(keysForLastUsedTimes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLastUsedTimes)
+	mapStringForLastUsedTimes := "map[string]*time.Time{"
+	for _, k := range keysForLastUsedTimes {
+		mapStringForLastUsedTimes += fmt.Sprintf("%#v: %#v,", k, this.LastUsedTimes[k])
+	}
+	mapStringForLastUsedTimes += "}"
+	if this.LastUsedTimes != nil {
+		s = append(s, "LastUsedTimes: "+mapStringForLastUsedTimes+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringNamespaces(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -882,13 +1218,39 @@ func (m *NamespaceDetail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ApiKeys) > 0 {
+		for k := range m.ApiKeys {
+			v := m.ApiKeys[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintNamespaces(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintNamespaces(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintNamespaces(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.FailoverEndTime != nil {
-		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.FailoverEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.FailoverEndTime):])
-		if err1 != nil {
-			return 0, err1
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.FailoverEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.FailoverEndTime):])
+		if err2 != nil {
+			return 0, err2
 		}
-		i -= n1
-		i = encodeVarintNamespaces(dAtA, i, uint64(n1))
+		i -= n2
+		i = encodeVarintNamespaces(dAtA, i, uint64(n2))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -1104,12 +1466,12 @@ func (m *NamespaceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 	}
 	if m.Retention != nil {
-		n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Retention, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Retention):])
-		if err6 != nil {
-			return 0, err6
+		n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Retention, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Retention):])
+		if err7 != nil {
+			return 0, err7
 		}
-		i -= n6
-		i = encodeVarintNamespaces(dAtA, i, uint64(n6))
+		i -= n7
+		i = encodeVarintNamespaces(dAtA, i, uint64(n7))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -1200,46 +1562,191 @@ func (m *FailoverStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x10
 	}
 	if m.FailoverTime != nil {
-		n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.FailoverTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.FailoverTime):])
-		if err7 != nil {
-			return 0, err7
+		n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.FailoverTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.FailoverTime):])
+		if err8 != nil {
+			return 0, err8
 		}
-		i -= n7
-		i = encodeVarintNamespaces(dAtA, i, uint64(n7))
+		i -= n8
+		i = encodeVarintNamespaces(dAtA, i, uint64(n8))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintNamespaces(dAtA []byte, offset int, v uint64) int {
-	offset -= sovNamespaces(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *NamespaceApiKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *NamespaceDetail) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *NamespaceApiKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamespaceApiKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Info != nil {
-		l = m.Info.Size()
-		n += 1 + l + sovNamespaces(uint64(l))
+	if m.LastUsedTime != nil {
+		n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUsedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUsedTime):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintNamespaces(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x4a
 	}
-	if m.Config != nil {
-		l = m.Config.Size()
-		n += 1 + l + sovNamespaces(uint64(l))
+	if m.ExpireTime != nil {
+		n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpireTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpireTime):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintNamespaces(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.ReplicationConfig != nil {
-		l = m.ReplicationConfig.Size()
-		n += 1 + l + sovNamespaces(uint64(l))
+	if m.RotateTime != nil {
+		n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.RotateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.RotateTime):])
+		if err11 != nil {
+			return 0, err11
+		}
+		i -= n11
+		i = encodeVarintNamespaces(dAtA, i, uint64(n11))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.CreateTime != nil {
+		n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreateTime):])
+		if err12 != nil {
+			return 0, err12
+		}
+		i -= n12
+		i = encodeVarintNamespaces(dAtA, i, uint64(n12))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.CreatedBy) > 0 {
+		i -= len(m.CreatedBy)
+		copy(dAtA[i:], m.CreatedBy)
+		i = encodeVarintNamespaces(dAtA, i, uint64(len(m.CreatedBy)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SecretHash) > 0 {
+		i -= len(m.SecretHash)
+		copy(dAtA[i:], m.SecretHash)
+		i = encodeVarintNamespaces(dAtA, i, uint64(len(m.SecretHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintNamespaces(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintNamespaces(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintNamespaces(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApiKeyUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApiKeyUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApiKeyUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LastUsedTimes) > 0 {
+		for k := range m.LastUsedTimes {
+			v := m.LastUsedTimes[k]
+			baseI := i
+			if v != nil {
+				n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo((*v), dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime((*v)):])
+				if err13 != nil {
+					return 0, err13
+				}
+				i -= n13
+				i = encodeVarintNamespaces(dAtA, i, uint64(n13))
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintNamespaces(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintNamespaces(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintNamespaces(dAtA []byte, offset int, v uint64) int {
+	offset -= sovNamespaces(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *NamespaceDetail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovNamespaces(uint64(l))
+	}
+	if m.Config != nil {
+		l = m.Config.Size()
+		n += 1 + l + sovNamespaces(uint64(l))
+	}
+	if m.ReplicationConfig != nil {
+		l = m.ReplicationConfig.Size()
+		n += 1 + l + sovNamespaces(uint64(l))
 	}
 	if m.ConfigVersion != 0 {
 		n += 1 + sovNamespaces(uint64(m.ConfigVersion))
@@ -1254,6 +1761,19 @@ func (m *NamespaceDetail) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.FailoverEndTime)
 		n += 1 + l + sovNamespaces(uint64(l))
 	}
+	if len(m.ApiKeys) > 0 {
+		for k, v := range m.ApiKeys {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovNamespaces(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovNamespaces(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovNamespaces(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -1380,6 +1900,73 @@ func (m *FailoverStatus) Size() (n int) {
 	return n
 }
 
+func (m *NamespaceApiKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovNamespaces(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovNamespaces(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovNamespaces(uint64(l))
+	}
+	l = len(m.SecretHash)
+	if l > 0 {
+		n += 1 + l + sovNamespaces(uint64(l))
+	}
+	l = len(m.CreatedBy)
+	if l > 0 {
+		n += 1 + l + sovNamespaces(uint64(l))
+	}
+	if m.CreateTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreateTime)
+		n += 1 + l + sovNamespaces(uint64(l))
+	}
+	if m.RotateTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.RotateTime)
+		n += 1 + l + sovNamespaces(uint64(l))
+	}
+	if m.ExpireTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpireTime)
+		n += 1 + l + sovNamespaces(uint64(l))
+	}
+	if m.LastUsedTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUsedTime)
+		n += 1 + l + sovNamespaces(uint64(l))
+	}
+	return n
+}
+
+func (m *ApiKeyUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LastUsedTimes) > 0 {
+		for k, v := range m.LastUsedTimes {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = github_com_gogo_protobuf_types.SizeOfStdTime(*v)
+				l += 1 + sovNamespaces(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovNamespaces(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovNamespaces(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
 func sovNamespaces(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1390,6 +1977,16 @@ func (this *NamespaceDetail) String() string {
 	if this == nil {
 		return "nil"
 	}
+	keysForApiKeys := make([]string, 0, len(this.ApiKeys))
+	for k, _ := range this.ApiKeys {
+		keysForApiKeys = append(keysForApiKeys, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForApiKeys)
+	mapStringForApiKeys := "map[string]*NamespaceApiKey{"
+	for _, k := range keysForApiKeys {
+		mapStringForApiKeys += fmt.Sprintf("%v: %v,", k, this.ApiKeys[k])
+	}
+	mapStringForApiKeys += "}"
 	s := strings.Join([]string{`&NamespaceDetail{`,
 		`Info:` + strings.Replace(this.Info.String(), "NamespaceInfo", "NamespaceInfo", 1) + `,`,
 		`Config:` + strings.Replace(this.Config.String(), "NamespaceConfig", "NamespaceConfig", 1) + `,`,
@@ -1398,6 +1995,7 @@ func (this *NamespaceDetail) String() string {
 		`FailoverNotificationVersion:` + fmt.Sprintf("%v", this.FailoverNotificationVersion) + `,`,
 		`FailoverVersion:` + fmt.Sprintf("%v", this.FailoverVersion) + `,`,
 		`FailoverEndTime:` + strings.Replace(fmt.Sprintf("%v", this.FailoverEndTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`ApiKeys:` + mapStringForApiKeys + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1483,6 +2081,44 @@ func (this *FailoverStatus) String() string {
 	}, "")
 	return s
 }
+func (this *NamespaceApiKey) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NamespaceApiKey{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Role:` + fmt.Sprintf("%v", this.Role) + `,`,
+		`SecretHash:` + fmt.Sprintf("%v", this.SecretHash) + `,`,
+		`CreatedBy:` + fmt.Sprintf("%v", this.CreatedBy) + `,`,
+		`CreateTime:` + strings.Replace(fmt.Sprintf("%v", this.CreateTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`RotateTime:` + strings.Replace(fmt.Sprintf("%v", this.RotateTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`ExpireTime:` + strings.Replace(fmt.Sprintf("%v", this.ExpireTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`LastUsedTime:` + strings.Replace(fmt.Sprintf("%v", this.LastUsedTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApiKeyUsage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForLastUsedTimes := make([]string, 0, len(this.LastUsedTimes))
+	for k, _ := range this.LastUsedTimes {
+		keysForLastUsedTimes = append(keysForLastUsedTimes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLastUsedTimes)
+	mapStringForLastUsedTimes := "map[string]*time.Time{"
+	for _, k := range keysForLastUsedTimes {
+		mapStringForLastUsedTimes += fmt.Sprintf("%v: %v,", k, this.LastUsedTimes[k])
+	}
+	mapStringForLastUsedTimes += "}"
+	s := strings.Join([]string{`&ApiKeyUsage{`,
+		`LastUsedTimes:` + mapStringForLastUsedTimes + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringNamespaces(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -1721,64 +2357,11 @@ func (m *NamespaceDetail) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipNamespaces(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthNamespaces
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthNamespaces
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NamespaceInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowNamespaces
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NamespaceInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NamespaceInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiKeys", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNamespaces
@@ -1788,46 +2371,228 @@ func (m *NamespaceInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthNamespaces
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthNamespaces
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
-			}
-			m.State = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNamespaces
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.State |= v1.NamespaceState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if m.ApiKeys == nil {
+				m.ApiKeys = make(map[string]*NamespaceApiKey)
+			}
+			var mapkey string
+			var mapvalue *NamespaceApiKey
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowNamespaces
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowNamespaces
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthNamespaces
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthNamespaces
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowNamespaces
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthNamespaces
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthNamespaces
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &NamespaceApiKey{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipNamespaces(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthNamespaces
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ApiKeys[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNamespaces(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespaceInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNamespaces
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespaces
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespaces
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= v1.NamespaceState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2736,6 +3501,544 @@ func (m *FailoverStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *NamespaceApiKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNamespaces
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceApiKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceApiKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespaces
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespaces
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespaces
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespaces
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecretHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespaces
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespaces
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreateTime == nil {
+				m.CreateTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CreateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RotateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespaces
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RotateTime == nil {
+				m.RotateTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.RotateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespaces
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpireTime == nil {
+				m.ExpireTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpireTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUsedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespaces
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastUsedTime == nil {
+				m.LastUsedTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastUsedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNamespaces(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApiKeyUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNamespaces
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApiKeyUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApiKeyUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUsedTimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespaces
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastUsedTimes == nil {
+				m.LastUsedTimes = make(map[string]*time.Time)
+			}
+			var mapkey string
+			mapvalue := new(time.Time)
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowNamespaces
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowNamespaces
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthNamespaces
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthNamespaces
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowNamespaces
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthNamespaces
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthNamespaces
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(mapvalue, dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipNamespaces(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthNamespaces
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.LastUsedTimes[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNamespaces(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipNamespaces(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
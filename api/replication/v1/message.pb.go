@@ -38,15 +38,17 @@ import (
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 	_ "github.com/gogo/protobuf/types"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
-	v13 "go.temporal.io/api/common/v1"
-	v14 "go.temporal.io/api/failure/v1"
+	v14 "go.temporal.io/api/common/v1"
+	v15 "go.temporal.io/api/failure/v1"
 	v11 "go.temporal.io/api/namespace/v1"
 	v12 "go.temporal.io/api/replication/v1"
 	v1 "go.temporal.io/server/api/enums/v1"
-	v15 "go.temporal.io/server/api/history/v1"
-	v16 "go.temporal.io/server/api/persistence/v1"
+	v16 "go.temporal.io/server/api/history/v1"
+	v13 "go.temporal.io/server/api/persistence/v1"
+	v17 "go.temporal.io/server/api/workflow/v1"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -508,6 +510,8 @@ type NamespaceTaskAttributes struct {
 	ConfigVersion      int64                           `protobuf:"varint,6,opt,name=config_version,json=configVersion,proto3" json:"config_version,omitempty"`
 	FailoverVersion    int64                           `protobuf:"varint,7,opt,name=failover_version,json=failoverVersion,proto3" json:"failover_version,omitempty"`
 	FailoverHistory    []*v12.FailoverStatus           `protobuf:"bytes,8,rep,name=failover_history,json=failoverHistory,proto3" json:"failover_history,omitempty"`
+	// Not set by clusters that predate API keys, in which case the receiving cluster keeps its keys.
+	ApiKeys *NamespaceApiKeys `protobuf:"bytes,9,opt,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
 }
 
 func (m *NamespaceTaskAttributes) Reset()      { *m = NamespaceTaskAttributes{} }
@@ -598,6 +602,56 @@ func (m *NamespaceTaskAttributes) GetFailoverHistory() []*v12.FailoverStatus {
 	return nil
 }
 
+func (m *NamespaceTaskAttributes) GetApiKeys() *NamespaceApiKeys {
+	if m != nil {
+		return m.ApiKeys
+	}
+	return nil
+}
+
+type NamespaceApiKeys struct {
+	Keys map[string]*v13.NamespaceApiKey `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *NamespaceApiKeys) Reset()      { *m = NamespaceApiKeys{} }
+func (*NamespaceApiKeys) ProtoMessage() {}
+func (*NamespaceApiKeys) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edd9fae2af6b0532, []int{6}
+}
+func (m *NamespaceApiKeys) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespaceApiKeys) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NamespaceApiKeys.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NamespaceApiKeys) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceApiKeys.Merge(m, src)
+}
+func (m *NamespaceApiKeys) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespaceApiKeys) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceApiKeys.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceApiKeys proto.InternalMessageInfo
+
+func (m *NamespaceApiKeys) GetKeys() map[string]*v13.NamespaceApiKey {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
 type SyncShardStatusTaskAttributes struct {
 	SourceCluster string     `protobuf:"bytes,1,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
 	ShardId       int32      `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
@@ -607,7 +661,7 @@ type SyncShardStatusTaskAttributes struct {
 func (m *SyncShardStatusTaskAttributes) Reset()      { *m = SyncShardStatusTaskAttributes{} }
 func (*SyncShardStatusTaskAttributes) ProtoMessage() {}
 func (*SyncShardStatusTaskAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edd9fae2af6b0532, []int{6}
+	return fileDescriptor_edd9fae2af6b0532, []int{7}
 }
 func (m *SyncShardStatusTaskAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -667,17 +721,21 @@ type SyncActivityTaskAttributes struct {
 	StartedEventId     int64               `protobuf:"varint,7,opt,name=started_event_id,json=startedEventId,proto3" json:"started_event_id,omitempty"`
 	StartedTime        *time.Time          `protobuf:"bytes,8,opt,name=started_time,json=startedTime,proto3,stdtime" json:"started_time,omitempty"`
 	LastHeartbeatTime  *time.Time          `protobuf:"bytes,9,opt,name=last_heartbeat_time,json=lastHeartbeatTime,proto3,stdtime" json:"last_heartbeat_time,omitempty"`
-	Details            *v13.Payloads       `protobuf:"bytes,10,opt,name=details,proto3" json:"details,omitempty"`
+	Details            *v14.Payloads       `protobuf:"bytes,10,opt,name=details,proto3" json:"details,omitempty"`
 	Attempt            int32               `protobuf:"varint,11,opt,name=attempt,proto3" json:"attempt,omitempty"`
-	LastFailure        *v14.Failure        `protobuf:"bytes,12,opt,name=last_failure,json=lastFailure,proto3" json:"last_failure,omitempty"`
+	LastFailure        *v15.Failure        `protobuf:"bytes,12,opt,name=last_failure,json=lastFailure,proto3" json:"last_failure,omitempty"`
 	LastWorkerIdentity string              `protobuf:"bytes,13,opt,name=last_worker_identity,json=lastWorkerIdentity,proto3" json:"last_worker_identity,omitempty"`
-	VersionHistory     *v15.VersionHistory `protobuf:"bytes,14,opt,name=version_history,json=versionHistory,proto3" json:"version_history,omitempty"`
+	VersionHistory     *v16.VersionHistory `protobuf:"bytes,14,opt,name=version_history,json=versionHistory,proto3" json:"version_history,omitempty"`
+	Paused             bool                `protobuf:"varint,15,opt,name=paused,proto3" json:"paused,omitempty"`
+	// activity_options are the current retry policy and timeouts of the activity
+	ActivityOptions     *v17.ActivityOptions `protobuf:"bytes,16,opt,name=activity_options,json=activityOptions,proto3" json:"activity_options,omitempty"`
+	RetryExpirationTime *time.Time           `protobuf:"bytes,17,opt,name=retry_expiration_time,json=retryExpirationTime,proto3,stdtime" json:"retry_expiration_time,omitempty"`
 }
 
 func (m *SyncActivityTaskAttributes) Reset()      { *m = SyncActivityTaskAttributes{} }
 func (*SyncActivityTaskAttributes) ProtoMessage() {}
 func (*SyncActivityTaskAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edd9fae2af6b0532, []int{7}
+	return fileDescriptor_edd9fae2af6b0532, []int{8}
 }
 func (m *SyncActivityTaskAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -769,7 +827,7 @@ func (m *SyncActivityTaskAttributes) GetLastHeartbeatTime() *time.Time {
 	return nil
 }
 
-func (m *SyncActivityTaskAttributes) GetDetails() *v13.Payloads {
+func (m *SyncActivityTaskAttributes) GetDetails() *v14.Payloads {
 	if m != nil {
 		return m.Details
 	}
@@ -783,7 +841,7 @@ func (m *SyncActivityTaskAttributes) GetAttempt() int32 {
 	return 0
 }
 
-func (m *SyncActivityTaskAttributes) GetLastFailure() *v14.Failure {
+func (m *SyncActivityTaskAttributes) GetLastFailure() *v15.Failure {
 	if m != nil {
 		return m.LastFailure
 	}
@@ -797,27 +855,48 @@ func (m *SyncActivityTaskAttributes) GetLastWorkerIdentity() string {
 	return ""
 }
 
-func (m *SyncActivityTaskAttributes) GetVersionHistory() *v15.VersionHistory {
+func (m *SyncActivityTaskAttributes) GetVersionHistory() *v16.VersionHistory {
 	if m != nil {
 		return m.VersionHistory
 	}
 	return nil
 }
 
+func (m *SyncActivityTaskAttributes) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *SyncActivityTaskAttributes) GetActivityOptions() *v17.ActivityOptions {
+	if m != nil {
+		return m.ActivityOptions
+	}
+	return nil
+}
+
+func (m *SyncActivityTaskAttributes) GetRetryExpirationTime() *time.Time {
+	if m != nil {
+		return m.RetryExpirationTime
+	}
+	return nil
+}
+
 type HistoryTaskAttributes struct {
 	NamespaceId         string                    `protobuf:"bytes,2,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	WorkflowId          string                    `protobuf:"bytes,3,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
 	RunId               string                    `protobuf:"bytes,4,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
-	VersionHistoryItems []*v15.VersionHistoryItem `protobuf:"bytes,5,rep,name=version_history_items,json=versionHistoryItems,proto3" json:"version_history_items,omitempty"`
-	Events              *v13.DataBlob             `protobuf:"bytes,6,opt,name=events,proto3" json:"events,omitempty"`
+	VersionHistoryItems []*v16.VersionHistoryItem `protobuf:"bytes,5,rep,name=version_history_items,json=versionHistoryItems,proto3" json:"version_history_items,omitempty"`
+	Events              *v14.DataBlob             `protobuf:"bytes,6,opt,name=events,proto3" json:"events,omitempty"`
 	// New run events does not need version history since there is no prior events.
-	NewRunEvents *v13.DataBlob `protobuf:"bytes,7,opt,name=new_run_events,json=newRunEvents,proto3" json:"new_run_events,omitempty"`
+	NewRunEvents *v14.DataBlob `protobuf:"bytes,7,opt,name=new_run_events,json=newRunEvents,proto3" json:"new_run_events,omitempty"`
 }
 
 func (m *HistoryTaskAttributes) Reset()      { *m = HistoryTaskAttributes{} }
 func (*HistoryTaskAttributes) ProtoMessage() {}
 func (*HistoryTaskAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edd9fae2af6b0532, []int{8}
+	return fileDescriptor_edd9fae2af6b0532, []int{9}
 }
 func (m *HistoryTaskAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -867,21 +946,21 @@ func (m *HistoryTaskAttributes) GetRunId() string {
 	return ""
 }
 
-func (m *HistoryTaskAttributes) GetVersionHistoryItems() []*v15.VersionHistoryItem {
+func (m *HistoryTaskAttributes) GetVersionHistoryItems() []*v16.VersionHistoryItem {
 	if m != nil {
 		return m.VersionHistoryItems
 	}
 	return nil
 }
 
-func (m *HistoryTaskAttributes) GetEvents() *v13.DataBlob {
+func (m *HistoryTaskAttributes) GetEvents() *v14.DataBlob {
 	if m != nil {
 		return m.Events
 	}
 	return nil
 }
 
-func (m *HistoryTaskAttributes) GetNewRunEvents() *v13.DataBlob {
+func (m *HistoryTaskAttributes) GetNewRunEvents() *v14.DataBlob {
 	if m != nil {
 		return m.NewRunEvents
 	}
@@ -889,13 +968,13 @@ func (m *HistoryTaskAttributes) GetNewRunEvents() *v13.DataBlob {
 }
 
 type SyncWorkflowStateTaskAttributes struct {
-	WorkflowState *v16.WorkflowMutableState `protobuf:"bytes,1,opt,name=workflow_state,json=workflowState,proto3" json:"workflow_state,omitempty"`
+	WorkflowState *v13.WorkflowMutableState `protobuf:"bytes,1,opt,name=workflow_state,json=workflowState,proto3" json:"workflow_state,omitempty"`
 }
 
 func (m *SyncWorkflowStateTaskAttributes) Reset()      { *m = SyncWorkflowStateTaskAttributes{} }
 func (*SyncWorkflowStateTaskAttributes) ProtoMessage() {}
 func (*SyncWorkflowStateTaskAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edd9fae2af6b0532, []int{9}
+	return fileDescriptor_edd9fae2af6b0532, []int{10}
 }
 func (m *SyncWorkflowStateTaskAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -924,13 +1003,176 @@ func (m *SyncWorkflowStateTaskAttributes) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SyncWorkflowStateTaskAttributes proto.InternalMessageInfo
 
-func (m *SyncWorkflowStateTaskAttributes) GetWorkflowState() *v16.WorkflowMutableState {
+func (m *SyncWorkflowStateTaskAttributes) GetWorkflowState() *v13.WorkflowMutableState {
 	if m != nil {
 		return m.WorkflowState
 	}
 	return nil
 }
 
+type ShardReplicationInboundStatus struct {
+	// One processor per shard of the remote cluster mapped to this shard
+	Processors []*ReplicationProcessorStatus `protobuf:"bytes,1,rep,name=processors,proto3" json:"processors,omitempty"`
+	// Number of tasks from the remote cluster in the replication DLQ of this shard
+	DlqTaskCount int64 `protobuf:"varint,2,opt,name=dlq_task_count,json=dlqTaskCount,proto3" json:"dlq_task_count,omitempty"`
+	// Set if the DLQ has more tasks than were counted
+	DlqTaskCountTruncated bool `protobuf:"varint,3,opt,name=dlq_task_count_truncated,json=dlqTaskCountTruncated,proto3" json:"dlq_task_count_truncated,omitempty"`
+}
+
+func (m *ShardReplicationInboundStatus) Reset()      { *m = ShardReplicationInboundStatus{} }
+func (*ShardReplicationInboundStatus) ProtoMessage() {}
+func (*ShardReplicationInboundStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edd9fae2af6b0532, []int{11}
+}
+func (m *ShardReplicationInboundStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShardReplicationInboundStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShardReplicationInboundStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ShardReplicationInboundStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardReplicationInboundStatus.Merge(m, src)
+}
+func (m *ShardReplicationInboundStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShardReplicationInboundStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardReplicationInboundStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardReplicationInboundStatus proto.InternalMessageInfo
+
+func (m *ShardReplicationInboundStatus) GetProcessors() []*ReplicationProcessorStatus {
+	if m != nil {
+		return m.Processors
+	}
+	return nil
+}
+
+func (m *ShardReplicationInboundStatus) GetDlqTaskCount() int64 {
+	if m != nil {
+		return m.DlqTaskCount
+	}
+	return 0
+}
+
+func (m *ShardReplicationInboundStatus) GetDlqTaskCountTruncated() bool {
+	if m != nil {
+		return m.DlqTaskCountTruncated
+	}
+	return false
+}
+
+type ReplicationProcessorStatus struct {
+	SourceShardId int32                        `protobuf:"varint,1,opt,name=source_shard_id,json=sourceShardId,proto3" json:"source_shard_id,omitempty"`
+	State         v1.ReplicationProcessorState `protobuf:"varint,2,opt,name=state,proto3,enum=temporal.server.api.enums.v1.ReplicationProcessorState" json:"state,omitempty"`
+	// Id and creation time of the last task of the remote cluster applied by this cluster
+	LastProcessedTaskId             int64      `protobuf:"varint,3,opt,name=last_processed_task_id,json=lastProcessedTaskId,proto3" json:"last_processed_task_id,omitempty"`
+	LastProcessedTaskVisibilityTime *time.Time `protobuf:"bytes,4,opt,name=last_processed_task_visibility_time,json=lastProcessedTaskVisibilityTime,proto3,stdtime" json:"last_processed_task_visibility_time,omitempty"`
+	LastReceivedTaskId              int64      `protobuf:"varint,5,opt,name=last_received_task_id,json=lastReceivedTaskId,proto3" json:"last_received_task_id,omitempty"`
+	// Time since the last applied task was created on the remote cluster
+	Lag           *time.Duration `protobuf:"bytes,6,opt,name=lag,proto3,stdduration" json:"lag,omitempty"`
+	LastError     string         `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	LastErrorTime *time.Time     `protobuf:"bytes,8,opt,name=last_error_time,json=lastErrorTime,proto3,stdtime" json:"last_error_time,omitempty"`
+}
+
+func (m *ReplicationProcessorStatus) Reset()      { *m = ReplicationProcessorStatus{} }
+func (*ReplicationProcessorStatus) ProtoMessage() {}
+func (*ReplicationProcessorStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edd9fae2af6b0532, []int{12}
+}
+func (m *ReplicationProcessorStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplicationProcessorStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplicationProcessorStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReplicationProcessorStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicationProcessorStatus.Merge(m, src)
+}
+func (m *ReplicationProcessorStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplicationProcessorStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicationProcessorStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicationProcessorStatus proto.InternalMessageInfo
+
+func (m *ReplicationProcessorStatus) GetSourceShardId() int32 {
+	if m != nil {
+		return m.SourceShardId
+	}
+	return 0
+}
+
+func (m *ReplicationProcessorStatus) GetState() v1.ReplicationProcessorState {
+	if m != nil {
+		return m.State
+	}
+	return v1.REPLICATION_PROCESSOR_STATE_UNSPECIFIED
+}
+
+func (m *ReplicationProcessorStatus) GetLastProcessedTaskId() int64 {
+	if m != nil {
+		return m.LastProcessedTaskId
+	}
+	return 0
+}
+
+func (m *ReplicationProcessorStatus) GetLastProcessedTaskVisibilityTime() *time.Time {
+	if m != nil {
+		return m.LastProcessedTaskVisibilityTime
+	}
+	return nil
+}
+
+func (m *ReplicationProcessorStatus) GetLastReceivedTaskId() int64 {
+	if m != nil {
+		return m.LastReceivedTaskId
+	}
+	return 0
+}
+
+func (m *ReplicationProcessorStatus) GetLag() *time.Duration {
+	if m != nil {
+		return m.Lag
+	}
+	return nil
+}
+
+func (m *ReplicationProcessorStatus) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *ReplicationProcessorStatus) GetLastErrorTime() *time.Time {
+	if m != nil {
+		return m.LastErrorTime
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ReplicationTask)(nil), "temporal.server.api.replication.v1.ReplicationTask")
 	proto.RegisterType((*ReplicationToken)(nil), "temporal.server.api.replication.v1.ReplicationToken")
@@ -938,10 +1180,14 @@ func init() {
 	proto.RegisterType((*ReplicationMessages)(nil), "temporal.server.api.replication.v1.ReplicationMessages")
 	proto.RegisterType((*ReplicationTaskInfo)(nil), "temporal.server.api.replication.v1.ReplicationTaskInfo")
 	proto.RegisterType((*NamespaceTaskAttributes)(nil), "temporal.server.api.replication.v1.NamespaceTaskAttributes")
+	proto.RegisterType((*NamespaceApiKeys)(nil), "temporal.server.api.replication.v1.NamespaceApiKeys")
+	proto.RegisterMapType((map[string]*v13.NamespaceApiKey)(nil), "temporal.server.api.replication.v1.NamespaceApiKeys.KeysEntry")
 	proto.RegisterType((*SyncShardStatusTaskAttributes)(nil), "temporal.server.api.replication.v1.SyncShardStatusTaskAttributes")
 	proto.RegisterType((*SyncActivityTaskAttributes)(nil), "temporal.server.api.replication.v1.SyncActivityTaskAttributes")
 	proto.RegisterType((*HistoryTaskAttributes)(nil), "temporal.server.api.replication.v1.HistoryTaskAttributes")
 	proto.RegisterType((*SyncWorkflowStateTaskAttributes)(nil), "temporal.server.api.replication.v1.SyncWorkflowStateTaskAttributes")
+	proto.RegisterType((*ShardReplicationInboundStatus)(nil), "temporal.server.api.replication.v1.ShardReplicationInboundStatus")
+	proto.RegisterType((*ReplicationProcessorStatus)(nil), "temporal.server.api.replication.v1.ReplicationProcessorStatus")
 }
 
 func init() {
@@ -949,101 +1195,126 @@ func init() {
 }
 
 var fileDescriptor_edd9fae2af6b0532 = []byte{
-	// 1497 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x6f, 0x1b, 0x45,
-	0x1b, 0xcf, 0xfa, 0x7f, 0xc6, 0x89, 0xe3, 0x4e, 0xde, 0xbc, 0x71, 0xfc, 0xaa, 0x9b, 0xd4, 0x6f,
-	0x0b, 0x29, 0x54, 0xeb, 0x26, 0x39, 0xd0, 0x52, 0x04, 0x4a, 0x02, 0xa5, 0xae, 0xd4, 0x52, 0x6d,
-	0xa3, 0x56, 0xe2, 0xb2, 0x8c, 0xbd, 0x63, 0x7b, 0x14, 0x7b, 0x77, 0x35, 0x33, 0x76, 0xf0, 0x0d,
-	0xc1, 0x05, 0x21, 0x21, 0x55, 0xe2, 0x0b, 0x70, 0xe0, 0xc0, 0x89, 0xcf, 0xc1, 0xb1, 0xc7, 0xde,
-	0x68, 0xdd, 0x0b, 0x37, 0xfa, 0x11, 0xd0, 0xcc, 0xce, 0xda, 0xbb, 0xde, 0x8d, 0xeb, 0x16, 0x71,
-	0xf3, 0x3e, 0xf3, 0x7b, 0x7e, 0xcf, 0x33, 0xcf, 0x3c, 0x7f, 0x66, 0x0c, 0xae, 0x73, 0xdc, 0xf7,
-	0x5c, 0x8a, 0x7a, 0x75, 0x86, 0xe9, 0x10, 0xd3, 0x3a, 0xf2, 0x48, 0x9d, 0x62, 0xaf, 0x47, 0x5a,
-	0x88, 0x13, 0xd7, 0xa9, 0x0f, 0xf7, 0xea, 0x7d, 0xcc, 0x18, 0xea, 0x60, 0xc3, 0xa3, 0x2e, 0x77,
-	0x61, 0x2d, 0xd0, 0x30, 0x7c, 0x0d, 0x03, 0x79, 0xc4, 0x08, 0x69, 0x18, 0xc3, 0xbd, 0xea, 0x76,
-	0xc7, 0x75, 0x3b, 0x3d, 0x5c, 0x97, 0x1a, 0xcd, 0x41, 0xbb, 0xce, 0x49, 0x1f, 0x33, 0x8e, 0xfa,
-	0x9e, 0x4f, 0x52, 0xbd, 0x64, 0x63, 0x0f, 0x3b, 0x36, 0x76, 0x5a, 0x04, 0xb3, 0x7a, 0xc7, 0xed,
-	0xb8, 0x52, 0x2e, 0x7f, 0x29, 0x88, 0x91, 0xe4, 0x19, 0x76, 0x06, 0x7d, 0x26, 0x7c, 0x0a, 0x1b,
-	0xf4, 0xf1, 0xef, 0xce, 0xc5, 0x73, 0xc4, 0x4e, 0x15, 0xf0, 0x5a, 0x12, 0xb0, 0x4b, 0x18, 0x77,
-	0xe9, 0x28, 0xb6, 0xdd, 0xea, 0x27, 0x49, 0x68, 0x0f, 0x53, 0x46, 0x18, 0xc7, 0x4e, 0x0b, 0x0b,
-	0x8d, 0x33, 0x97, 0x9e, 0xb6, 0x7b, 0xee, 0x99, 0xd5, 0x1f, 0x70, 0xd4, 0xec, 0x61, 0x8b, 0x71,
-	0xc4, 0x03, 0x82, 0xcb, 0x13, 0x02, 0xa1, 0xd9, 0x72, 0xfb, 0xfd, 0x84, 0xa8, 0x86, 0xbc, 0x17,
-	0x28, 0x07, 0xf5, 0x31, 0xf3, 0x90, 0xcf, 0x1e, 0x05, 0x5e, 0x8d, 0x00, 0xe7, 0x9d, 0x54, 0xf5,
-	0x4a, 0x04, 0xda, 0x46, 0xa4, 0x37, 0xa0, 0x71, 0xc6, 0xda, 0xf7, 0x79, 0xb0, 0x66, 0x4e, 0x79,
-	0x4e, 0x10, 0x3b, 0x85, 0xf7, 0xc1, 0xb2, 0x88, 0x98, 0xc5, 0x47, 0x1e, 0xae, 0x68, 0x3b, 0xda,
-	0x6e, 0x69, 0x7f, 0xcf, 0x48, 0x3a, 0x78, 0x19, 0x60, 0x63, 0xb8, 0x67, 0xcc, 0x30, 0x9c, 0x8c,
-	0x3c, 0x6c, 0x16, 0xb8, 0xfa, 0x05, 0x2f, 0x83, 0x12, 0x73, 0x07, 0xb4, 0x85, 0x2d, 0x49, 0x4b,
-	0xec, 0x4a, 0x6a, 0x47, 0xdb, 0x4d, 0x9b, 0x2b, 0xbe, 0x54, 0x68, 0x34, 0x6c, 0x38, 0x02, 0x5b,
-	0x93, 0x9d, 0xfb, 0x40, 0xc4, 0x39, 0x25, 0xcd, 0x01, 0xc7, 0xac, 0x92, 0xde, 0xd1, 0x76, 0x8b,
-	0xfb, 0xb7, 0x8c, 0xd7, 0xa7, 0x9f, 0x71, 0x3f, 0x20, 0x11, 0xbc, 0x87, 0x13, 0x8a, 0x3b, 0x4b,
-	0xe6, 0xa6, 0x93, 0xbc, 0x04, 0x7f, 0xd4, 0xc0, 0x25, 0x36, 0x72, 0x5a, 0x16, 0xeb, 0x22, 0x6a,
-	0xcb, 0x03, 0x1c, 0xb0, 0x98, 0x0f, 0x59, 0xe9, 0xc3, 0xe1, 0x22, 0x3e, 0x3c, 0x1c, 0x39, 0xad,
-	0x87, 0x82, 0xeb, 0xa1, 0xa4, 0x8a, 0x79, 0x72, 0x91, 0xcd, 0x03, 0xc0, 0xef, 0x34, 0x20, 0x11,
-	0x16, 0x6a, 0x71, 0x32, 0x24, 0x7c, 0x14, 0xf3, 0x25, 0x27, 0x7d, 0xf9, 0x78, 0x51, 0x5f, 0x0e,
-	0x15, 0x4f, 0xcc, 0x91, 0x2a, 0x3b, 0x77, 0x15, 0x32, 0xb0, 0xa9, 0x0a, 0x23, 0x66, 0xbe, 0x20,
-	0xcd, 0xdf, 0x5c, 0xc4, 0xfc, 0x1d, 0x9f, 0x22, 0x66, 0x79, 0xa3, 0x9b, 0xb4, 0x00, 0x7f, 0xd2,
-	0xc0, 0xff, 0xe5, 0xd6, 0x27, 0x65, 0x25, 0xcb, 0x29, 0xe6, 0x01, 0x90, 0x1e, 0x1c, 0x2f, 0x1a,
-	0x80, 0xc7, 0x8a, 0x4d, 0x84, 0x3b, 0x9e, 0x18, 0xdb, 0x6c, 0x3e, 0x04, 0x36, 0xc0, 0xda, 0x90,
-	0x30, 0xd2, 0x24, 0x3d, 0x79, 0x18, 0xa4, 0x8f, 0x2b, 0xcb, 0xd2, 0x81, 0xaa, 0xe1, 0x37, 0x3b,
-	0x23, 0x68, 0x76, 0xc6, 0x49, 0xd0, 0xec, 0x8e, 0x32, 0x4f, 0xfe, 0xd8, 0xd6, 0xcc, 0xd2, 0x54,
-	0x51, 0x2c, 0x1d, 0xad, 0x00, 0x30, 0xdd, 0xc6, 0xdd, 0x4c, 0x21, 0x53, 0xce, 0xde, 0xcd, 0x14,
-	0xf2, 0xe5, 0x42, 0xed, 0x87, 0x14, 0x28, 0x87, 0x0b, 0xc9, 0x3d, 0xc5, 0x0e, 0xdc, 0x02, 0x05,
-	0x3f, 0x29, 0x89, 0x2d, 0x4b, 0x31, 0x6b, 0xe6, 0xe5, 0x77, 0xc3, 0x86, 0x37, 0xc1, 0x56, 0x0f,
-	0x31, 0x6e, 0x51, 0xcc, 0x29, 0xc1, 0x43, 0x6c, 0x5b, 0xaa, 0xb4, 0xa7, 0x15, 0xf6, 0x5f, 0x01,
-	0x30, 0x83, 0xf5, 0x7b, 0xfe, 0x72, 0x48, 0xd5, 0xa3, 0x6e, 0x0b, 0x33, 0x16, 0x55, 0x4d, 0x4f,
-	0x55, 0x1f, 0x04, 0xeb, 0x53, 0x55, 0x0c, 0xf4, 0x19, 0xd5, 0xd9, 0xc8, 0x64, 0x16, 0x8c, 0xcc,
-	0xff, 0x22, 0x16, 0x1e, 0x45, 0xc2, 0x54, 0x3b, 0x01, 0x6b, 0x33, 0x45, 0x04, 0x0f, 0x41, 0x31,
-	0xa8, 0x4c, 0x61, 0x46, 0x5b, 0xd0, 0x0c, 0xf0, 0x95, 0x24, 0xeb, 0x6f, 0x29, 0xb0, 0x1e, 0x0a,
-	0xb1, 0xda, 0x15, 0x83, 0x5f, 0x81, 0x0b, 0xa1, 0xa4, 0x91, 0xc9, 0xc6, 0x2a, 0xda, 0x4e, 0x7a,
-	0xb7, 0xb8, 0x7f, 0xb0, 0x48, 0x8a, 0xcd, 0xf4, 0x3f, 0xb3, 0x4c, 0xa3, 0x02, 0xf6, 0x4f, 0x0e,
-	0x6b, 0x0b, 0x14, 0xba, 0x88, 0x59, 0x7d, 0x97, 0x62, 0x79, 0x36, 0x05, 0x33, 0xdf, 0x45, 0xec,
-	0x9e, 0x4b, 0x31, 0xb4, 0xc0, 0x85, 0x58, 0xdf, 0x52, 0xf1, 0x3f, 0x78, 0x8b, 0x3e, 0x65, 0xae,
-	0xcd, 0xf4, 0xa5, 0xda, 0xf3, 0x68, 0xc0, 0x64, 0xab, 0x76, 0xda, 0x2e, 0xbc, 0x04, 0x56, 0xa6,
-	0xcd, 0x5a, 0xa5, 0xe6, 0xb2, 0x59, 0x9c, 0xc8, 0x1a, 0x36, 0xdc, 0x06, 0xc5, 0x49, 0x0d, 0xab,
-	0x3d, 0x2e, 0x9b, 0x20, 0x10, 0x35, 0x6c, 0xb8, 0x01, 0x72, 0x74, 0xe0, 0x04, 0x19, 0xb7, 0x6c,
-	0x66, 0xe9, 0xc0, 0x69, 0xd8, 0xf0, 0x38, 0x3c, 0x7d, 0x32, 0x72, 0xfa, 0xbc, 0x33, 0x7f, 0xfa,
-	0x24, 0x8c, 0x9c, 0x4d, 0x90, 0x0f, 0x66, 0x4d, 0x56, 0x06, 0x37, 0xc7, 0xfd, 0x29, 0x53, 0x01,
-	0xf9, 0xa1, 0x98, 0xdf, 0xae, 0x23, 0x7b, 0x68, 0xda, 0x0c, 0x3e, 0xc5, 0x94, 0x6a, 0x13, 0xca,
-	0xb8, 0x85, 0x87, 0xd8, 0xe1, 0x42, 0x33, 0xef, 0x4f, 0x29, 0x29, 0xfd, 0x4c, 0x08, 0x1b, 0x36,
-	0xac, 0x81, 0x55, 0x07, 0x7f, 0x1d, 0x02, 0x15, 0x24, 0xa8, 0x28, 0x84, 0x01, 0xe6, 0x1a, 0x80,
-	0xac, 0xd5, 0xc5, 0xf6, 0xa0, 0x87, 0xed, 0x29, 0x70, 0x59, 0x02, 0xcb, 0x93, 0x15, 0x85, 0xae,
-	0xfd, 0x9c, 0x01, 0x9b, 0xe7, 0xcc, 0x2c, 0x88, 0xc0, 0xfa, 0x34, 0xcc, 0xae, 0x87, 0xa9, 0x3c,
-	0x05, 0x35, 0x93, 0xaf, 0xcf, 0x8f, 0xca, 0x84, 0xf3, 0x8b, 0x40, 0xcf, 0x84, 0x4e, 0x4c, 0x06,
-	0x4b, 0x20, 0x35, 0x39, 0x9d, 0x14, 0xb1, 0xe1, 0x47, 0x20, 0x43, 0x9c, 0xb6, 0xab, 0x26, 0xee,
-	0xee, 0xd4, 0x86, 0x20, 0x9f, 0xe8, 0x47, 0x0c, 0x88, 0x8c, 0x30, 0xa5, 0x16, 0x3c, 0x02, 0xb9,
-	0x96, 0xeb, 0xb4, 0x49, 0x47, 0x65, 0xe1, 0x7b, 0x8b, 0xe8, 0x1f, 0x4b, 0x0d, 0x53, 0x69, 0xc2,
-	0x36, 0x80, 0xe1, 0x62, 0x54, 0x7c, 0xfe, 0xf4, 0xfd, 0x20, 0xca, 0x77, 0xde, 0xe8, 0x0f, 0xa5,
-	0xac, 0x22, 0x0f, 0xd7, 0xb7, 0x2f, 0x82, 0x57, 0x40, 0xc9, 0xe7, 0xb6, 0xa2, 0x19, 0xb1, 0xea,
-	0x4b, 0x1f, 0xa9, 0xbc, 0xb8, 0x0a, 0xca, 0xe2, 0xf6, 0xe4, 0x0e, 0x31, 0x9d, 0x00, 0xfd, 0xcc,
-	0x58, 0x0b, 0xe4, 0x01, 0xf4, 0x51, 0x08, 0xaa, 0xc6, 0x5b, 0xa5, 0x20, 0xbb, 0xc8, 0xfb, 0x73,
-	0xfd, 0xbe, 0xad, 0x94, 0x82, 0x2a, 0x0c, 0x48, 0xd4, 0xec, 0xac, 0xfd, 0xa2, 0x81, 0x8b, 0x73,
-	0xaf, 0x14, 0x62, 0x2f, 0xea, 0x8a, 0xd5, 0xea, 0x0d, 0x18, 0xc7, 0x54, 0x55, 0xe4, 0xaa, 0x2f,
-	0x3d, 0xf6, 0x85, 0x91, 0x69, 0x92, 0x8a, 0x4e, 0x93, 0x99, 0xee, 0x9a, 0x7e, 0x8b, 0xee, 0xfa,
-	0x57, 0x16, 0x54, 0xcf, 0xbf, 0x6d, 0xfc, 0x9b, 0x3d, 0x23, 0x54, 0xd5, 0x99, 0x68, 0x55, 0x27,
-	0xd7, 0x62, 0x36, 0xb9, 0x16, 0xe1, 0xe7, 0xa0, 0x34, 0x45, 0xcb, 0x38, 0xe4, 0x16, 0x8c, 0xc3,
-	0xea, 0x44, 0x4f, 0xac, 0xc0, 0x5d, 0x50, 0x66, 0x1c, 0x51, 0x1e, 0x36, 0xea, 0x27, 0x4d, 0x49,
-	0xc9, 0x03, 0x93, 0xc7, 0x60, 0x25, 0x40, 0x4a, 0x83, 0x85, 0x05, 0x0d, 0x16, 0x95, 0x96, 0x34,
-	0xf7, 0x00, 0xac, 0xcb, 0xe9, 0xd2, 0xc5, 0x88, 0xf2, 0x26, 0x46, 0xfc, 0xcd, 0xee, 0x28, 0x17,
-	0x84, 0xf2, 0x9d, 0x40, 0x57, 0x32, 0x7e, 0x08, 0xf2, 0x36, 0xe6, 0x88, 0xf4, 0x82, 0xab, 0xd6,
-	0x4e, 0x34, 0x83, 0xfd, 0xa7, 0x8c, 0x48, 0xde, 0x07, 0x68, 0xd4, 0x73, 0x91, 0xcd, 0xcc, 0x40,
-	0x41, 0x9c, 0x06, 0xe2, 0x02, 0xcd, 0x2b, 0x45, 0x3f, 0xc9, 0xd4, 0xa7, 0xd8, 0xac, 0xf4, 0x53,
-	0x3d, 0x47, 0x2a, 0x2b, 0x49, 0xd4, 0x6a, 0x31, 0x28, 0x8c, 0x01, 0xc5, 0x66, 0x51, 0x68, 0xa9,
-	0x0f, 0x78, 0x1d, 0xfc, 0x47, 0x92, 0x88, 0xb4, 0xc0, 0xd4, 0x22, 0x36, 0x76, 0x38, 0xe1, 0xa3,
-	0xca, 0xaa, 0xcc, 0x08, 0x28, 0xd6, 0x1e, 0xcb, 0xa5, 0x86, 0x5a, 0x81, 0x8f, 0xc1, 0x9a, 0xca,
-	0x87, 0x49, 0x59, 0x96, 0xa4, 0x65, 0x23, 0xb1, 0x85, 0x2a, 0x8c, 0x70, 0x40, 0x55, 0xb6, 0x2a,
-	0x44, 0xb3, 0x34, 0x8c, 0x7c, 0xd7, 0xc6, 0x29, 0xb0, 0x91, 0x78, 0xc1, 0x8d, 0x25, 0x7b, 0xea,
-	0xb5, 0xc9, 0x9e, 0x9e, 0x93, 0xec, 0x99, 0x70, 0xb2, 0xb7, 0xc1, 0xc6, 0xcc, 0x6e, 0x2c, 0xc2,
-	0x71, 0x5f, 0x3c, 0x50, 0x44, 0xab, 0xd9, 0x7f, 0xb3, 0x3d, 0x35, 0x38, 0xee, 0x9b, 0xeb, 0xc3,
-	0x98, 0x8c, 0xc1, 0x1b, 0x20, 0x27, 0x73, 0x37, 0x78, 0x6d, 0x9c, 0x9b, 0x01, 0x9f, 0x22, 0x8e,
-	0x8e, 0x7a, 0x6e, 0xd3, 0x54, 0x78, 0x78, 0x1b, 0x94, 0x1c, 0x7c, 0x66, 0x09, 0xe7, 0x15, 0x43,
-	0x7e, 0x41, 0x86, 0x15, 0x07, 0x9f, 0x99, 0x03, 0x47, 0x16, 0x87, 0xb8, 0x1d, 0x6b, 0xe5, 0x54,
-	0xed, 0x5b, 0x0d, 0x6c, 0xbf, 0xe6, 0x0e, 0x0f, 0x2d, 0x50, 0x8a, 0x3e, 0x18, 0xd4, 0xf5, 0xf0,
-	0x46, 0x62, 0x30, 0x42, 0x2f, 0x78, 0x61, 0x3d, 0x20, 0xbe, 0xe7, 0x3f, 0xe0, 0x25, 0xbf, 0xb9,
-	0x7a, 0x16, 0x36, 0x77, 0x44, 0x9e, 0xbe, 0xd0, 0x97, 0x9e, 0xbd, 0xd0, 0x97, 0x5e, 0xbd, 0xd0,
-	0xb5, 0x6f, 0xc6, 0xba, 0xf6, 0xeb, 0x58, 0xd7, 0x7e, 0x1f, 0xeb, 0xda, 0xd3, 0xb1, 0xae, 0x3d,
-	0x1f, 0xeb, 0xda, 0x9f, 0x63, 0x7d, 0xe9, 0xd5, 0x58, 0xd7, 0x9e, 0xbc, 0xd4, 0x97, 0x9e, 0xbe,
-	0xd4, 0x97, 0x9e, 0xbd, 0xd4, 0x97, 0xbe, 0x3c, 0xe8, 0xb8, 0x53, 0x07, 0x88, 0x7b, 0xfe, 0xdf,
-	0x2c, 0xb7, 0x28, 0xf6, 0xd4, 0x57, 0x33, 0x27, 0xeb, 0xf4, 0xe0, 0xef, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xef, 0x7f, 0xb9, 0xb1, 0x9e, 0x11, 0x00, 0x00,
+	// 1900 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x6f, 0xdb, 0xc8,
+	0x15, 0x37, 0x25, 0xd9, 0x92, 0x9e, 0x6c, 0x49, 0x1e, 0xd7, 0x89, 0xac, 0x22, 0xb2, 0xa3, 0xfd,
+	0xe7, 0x6d, 0xb7, 0x72, 0x1c, 0x17, 0x48, 0x76, 0xb7, 0xd8, 0xc2, 0xf6, 0x66, 0x1b, 0x6d, 0x91,
+	0x4d, 0xc0, 0x18, 0x09, 0xd0, 0x02, 0x65, 0xc7, 0xe2, 0xc8, 0x26, 0x4c, 0x91, 0xdc, 0x99, 0xa1,
+	0xbc, 0xba, 0x15, 0xed, 0xa1, 0x45, 0x81, 0x02, 0x0b, 0xf4, 0xd2, 0x0f, 0xd0, 0x43, 0x4f, 0xfd,
+	0x00, 0xfd, 0x04, 0x3d, 0xe6, 0xd0, 0xc3, 0x9e, 0xda, 0x8d, 0x73, 0xe9, 0x31, 0xfd, 0x06, 0xc5,
+	0x0c, 0x67, 0x28, 0x52, 0x94, 0x15, 0xd9, 0x45, 0x2f, 0x82, 0xf8, 0xe6, 0xf7, 0x7e, 0xef, 0x71,
+	0xe6, 0xfd, 0x99, 0x47, 0xb8, 0xc3, 0xc9, 0x20, 0xf0, 0x29, 0x76, 0x77, 0x18, 0xa1, 0x43, 0x42,
+	0x77, 0x70, 0xe0, 0xec, 0x50, 0x12, 0xb8, 0x4e, 0x0f, 0x73, 0xc7, 0xf7, 0x76, 0x86, 0xbb, 0x3b,
+	0x03, 0xc2, 0x18, 0x3e, 0x21, 0x9d, 0x80, 0xfa, 0xdc, 0x47, 0x6d, 0xad, 0xd1, 0x89, 0x34, 0x3a,
+	0x38, 0x70, 0x3a, 0x09, 0x8d, 0xce, 0x70, 0xb7, 0xd9, 0x3a, 0xf1, 0xfd, 0x13, 0x97, 0xec, 0x48,
+	0x8d, 0xe3, 0xb0, 0xbf, 0x63, 0x87, 0x34, 0x5a, 0x94, 0x92, 0xe6, 0xe6, 0xe4, 0x3a, 0x77, 0x06,
+	0x84, 0x71, 0x3c, 0x08, 0x14, 0xe0, 0xb6, 0x4d, 0x02, 0xe2, 0xd9, 0xc4, 0xeb, 0x39, 0x84, 0xed,
+	0x9c, 0xf8, 0x27, 0xbe, 0x94, 0xcb, 0x7f, 0x0a, 0xd2, 0x99, 0xe6, 0x39, 0xf1, 0xc2, 0x01, 0x13,
+	0x3e, 0x27, 0x1d, 0x8a, 0xf0, 0xef, 0xcd, 0xc4, 0x73, 0xcc, 0xce, 0x14, 0xf0, 0x83, 0x69, 0xc0,
+	0x53, 0x87, 0x71, 0x9f, 0x8e, 0x32, 0xdb, 0xd1, 0xdc, 0x9b, 0x86, 0x0e, 0x08, 0x65, 0x0e, 0xe3,
+	0xc4, 0xeb, 0x11, 0xa1, 0xe1, 0xe1, 0x01, 0x61, 0x01, 0xee, 0x11, 0xa6, 0x94, 0x7e, 0x3c, 0x87,
+	0xd2, 0xb9, 0x4f, 0xcf, 0xfa, 0xae, 0x7f, 0x6e, 0x0d, 0x42, 0x8e, 0x8f, 0x5d, 0x62, 0x31, 0x8e,
+	0xb9, 0xb6, 0xfa, 0x83, 0x69, 0x04, 0x5a, 0x23, 0xeb, 0xe4, 0xdb, 0x31, 0x5c, 0xe0, 0x7a, 0xfe,
+	0x60, 0x30, 0xe5, 0x64, 0x9b, 0xef, 0xa5, 0x50, 0xb1, 0xd3, 0x59, 0xe0, 0xfb, 0x29, 0xe0, 0xac,
+	0x68, 0x69, 0xbe, 0x93, 0x82, 0xf6, 0xb1, 0xe3, 0x86, 0x34, 0xcb, 0xd8, 0xfe, 0x5d, 0x11, 0x6a,
+	0xe6, 0x98, 0xe7, 0x08, 0xb3, 0x33, 0xf4, 0x05, 0x94, 0xc5, 0xa9, 0x58, 0x7c, 0x14, 0x90, 0x86,
+	0xb1, 0x65, 0x6c, 0x57, 0xef, 0xee, 0x76, 0xa6, 0x05, 0x9f, 0x3c, 0xc4, 0xce, 0x70, 0xb7, 0x33,
+	0xc1, 0x70, 0x34, 0x0a, 0x88, 0x59, 0xe2, 0xea, 0x1f, 0x7a, 0x1b, 0xaa, 0xcc, 0x0f, 0x69, 0x8f,
+	0x58, 0x92, 0xd6, 0xb1, 0x1b, 0xb9, 0x2d, 0x63, 0x3b, 0x6f, 0x2e, 0x47, 0x52, 0xa1, 0xd1, 0xb5,
+	0xd1, 0x08, 0x36, 0xe2, 0x37, 0x8f, 0x80, 0x98, 0x73, 0xea, 0x1c, 0x87, 0x9c, 0xb0, 0x46, 0x7e,
+	0xcb, 0xd8, 0xae, 0xdc, 0xfd, 0xb8, 0xf3, 0xe6, 0x14, 0xe8, 0x7c, 0xa1, 0x49, 0x04, 0xef, 0x7e,
+	0x4c, 0xf1, 0x70, 0xc1, 0xbc, 0xe9, 0x4d, 0x5f, 0x42, 0x7f, 0x30, 0xe0, 0x36, 0x1b, 0x79, 0x3d,
+	0x8b, 0x9d, 0x62, 0x6a, 0xcb, 0xf3, 0x0e, 0x59, 0xc6, 0x87, 0x45, 0xe9, 0xc3, 0xfe, 0x3c, 0x3e,
+	0x3c, 0x1d, 0x79, 0xbd, 0xa7, 0x82, 0xeb, 0xa9, 0xa4, 0xca, 0x78, 0x72, 0x8b, 0xcd, 0x02, 0xa0,
+	0xdf, 0x18, 0x20, 0x11, 0x16, 0xee, 0x71, 0x67, 0xe8, 0xf0, 0x51, 0xc6, 0x97, 0x25, 0xe9, 0xcb,
+	0x27, 0xf3, 0xfa, 0xb2, 0xaf, 0x78, 0x32, 0x8e, 0x34, 0xd9, 0xa5, 0xab, 0x88, 0xc1, 0x4d, 0x95,
+	0x7c, 0x19, 0xf3, 0x25, 0x69, 0xfe, 0xc3, 0x79, 0xcc, 0x3f, 0x8c, 0x28, 0x32, 0x96, 0xd7, 0x4f,
+	0xa7, 0x2d, 0xa0, 0x3f, 0x1a, 0xf0, 0x96, 0x7c, 0xf5, 0x38, 0x0b, 0x65, 0xf6, 0x65, 0x3c, 0x00,
+	0xe9, 0xc1, 0xe1, 0xbc, 0x1b, 0xf0, 0x5c, 0xb1, 0x89, 0xed, 0xce, 0x06, 0xc6, 0x26, 0x9b, 0x0d,
+	0x41, 0x5d, 0xa8, 0x0d, 0x1d, 0xe6, 0x1c, 0x3b, 0xae, 0x3c, 0x0c, 0x67, 0x40, 0x1a, 0x65, 0xe9,
+	0x40, 0xb3, 0x13, 0x15, 0xd4, 0x8e, 0x2e, 0xa8, 0x9d, 0x23, 0x5d, 0x50, 0x0f, 0x0a, 0x5f, 0xff,
+	0x6b, 0xd3, 0x30, 0xab, 0x63, 0x45, 0xb1, 0x74, 0xb0, 0x0c, 0x30, 0x7e, 0x8d, 0xcf, 0x0b, 0xa5,
+	0x42, 0x7d, 0xf1, 0xf3, 0x42, 0xa9, 0x58, 0x2f, 0xb5, 0x7f, 0x9f, 0x83, 0x7a, 0x32, 0x91, 0xfc,
+	0x33, 0xe2, 0xa1, 0x0d, 0x28, 0x45, 0x41, 0xe9, 0xd8, 0x32, 0x15, 0x17, 0xcd, 0xa2, 0x7c, 0xee,
+	0xda, 0xe8, 0x43, 0xd8, 0x70, 0x31, 0xe3, 0x16, 0x25, 0x9c, 0x3a, 0x64, 0x48, 0x6c, 0x4b, 0xa5,
+	0xf6, 0x38, 0xc3, 0x6e, 0x08, 0x80, 0xa9, 0xd7, 0x1f, 0x45, 0xcb, 0x09, 0xd5, 0x80, 0xfa, 0x3d,
+	0xc2, 0x58, 0x5a, 0x35, 0x3f, 0x56, 0x7d, 0xa2, 0xd7, 0xc7, 0xaa, 0x04, 0x5a, 0x13, 0xaa, 0x93,
+	0x3b, 0x53, 0x98, 0x73, 0x67, 0xbe, 0x9b, 0xb2, 0xf0, 0x2c, 0xb5, 0x4d, 0xed, 0x23, 0xa8, 0x4d,
+	0x24, 0x11, 0xda, 0x87, 0x8a, 0xce, 0x4c, 0x61, 0xc6, 0x98, 0xd3, 0x0c, 0x44, 0x4a, 0x92, 0xf5,
+	0xaf, 0x39, 0x58, 0x4b, 0x6c, 0xb1, 0x7a, 0x2b, 0x86, 0x7e, 0x09, 0xab, 0x89, 0xa0, 0x91, 0xc1,
+	0xc6, 0x1a, 0xc6, 0x56, 0x7e, 0xbb, 0x72, 0x77, 0x6f, 0x9e, 0x10, 0x9b, 0xa8, 0x7f, 0x66, 0x9d,
+	0xa6, 0x05, 0xec, 0x7f, 0x39, 0xac, 0x0d, 0x28, 0x9d, 0x62, 0x66, 0x0d, 0x7c, 0x4a, 0xe4, 0xd9,
+	0x94, 0xcc, 0xe2, 0x29, 0x66, 0x8f, 0x7c, 0x4a, 0x90, 0x05, 0xab, 0x99, 0xba, 0xa5, 0xf6, 0x7f,
+	0xef, 0x1a, 0x75, 0xca, 0xac, 0x4d, 0xd4, 0xa5, 0xf6, 0xb7, 0xe9, 0x0d, 0x93, 0xa5, 0xda, 0xeb,
+	0xfb, 0xe8, 0x36, 0x2c, 0x8f, 0x8b, 0xb5, 0x0a, 0xcd, 0xb2, 0x59, 0x89, 0x65, 0x5d, 0x1b, 0x6d,
+	0x42, 0x25, 0xce, 0x61, 0xf5, 0x8e, 0x65, 0x13, 0xb4, 0xa8, 0x6b, 0xa3, 0x75, 0x58, 0xa2, 0xa1,
+	0xa7, 0x23, 0xae, 0x6c, 0x2e, 0xd2, 0xd0, 0xeb, 0xda, 0xe8, 0x30, 0xd9, 0x7d, 0x0a, 0xb2, 0xfb,
+	0xbc, 0x3b, 0xbb, 0xfb, 0x4c, 0x69, 0x39, 0x37, 0xa1, 0xa8, 0x7b, 0xcd, 0xa2, 0xdc, 0xdc, 0x25,
+	0x1e, 0x75, 0x99, 0x06, 0x14, 0x87, 0x84, 0x32, 0xc7, 0xf7, 0x64, 0x0d, 0xcd, 0x9b, 0xfa, 0x51,
+	0x74, 0xa9, 0xbe, 0x43, 0x19, 0xb7, 0xc8, 0x90, 0x78, 0x5c, 0x68, 0x16, 0xa3, 0x2e, 0x25, 0xa5,
+	0x0f, 0x84, 0xb0, 0x6b, 0xa3, 0x36, 0xac, 0x78, 0xe4, 0xab, 0x04, 0xa8, 0x24, 0x41, 0x15, 0x21,
+	0xd4, 0x98, 0x0f, 0x00, 0xb1, 0xde, 0x29, 0xb1, 0x43, 0x97, 0xd8, 0x63, 0x60, 0x59, 0x02, 0xeb,
+	0xf1, 0x8a, 0x42, 0xb7, 0xff, 0x53, 0x80, 0x9b, 0x97, 0xf4, 0x2c, 0x84, 0x61, 0x6d, 0xbc, 0xcd,
+	0x7e, 0x40, 0xa2, 0xbb, 0x9c, 0xea, 0xc9, 0x77, 0x66, 0xef, 0x4a, 0xcc, 0xf9, 0x58, 0xeb, 0x99,
+	0xc8, 0xcb, 0xc8, 0x50, 0x15, 0x72, 0xf1, 0xe9, 0xe4, 0x1c, 0x1b, 0xfd, 0x08, 0x0a, 0x8e, 0xd7,
+	0xf7, 0x55, 0xc7, 0xdd, 0x1e, 0xdb, 0x10, 0xe4, 0xb1, 0x7e, 0xca, 0x80, 0x88, 0x08, 0x53, 0x6a,
+	0xa1, 0x03, 0x58, 0xea, 0xf9, 0x5e, 0xdf, 0x39, 0x51, 0x51, 0xf8, 0xbd, 0x79, 0xf4, 0x0f, 0xa5,
+	0x86, 0xa9, 0x34, 0x51, 0x1f, 0x50, 0x32, 0x19, 0x15, 0x5f, 0xd4, 0x7d, 0xef, 0xa5, 0xf9, 0x2e,
+	0x6b, 0xfd, 0x89, 0x90, 0x55, 0xe4, 0xab, 0x74, 0x52, 0x84, 0xde, 0x81, 0x6a, 0xc4, 0x6d, 0xa5,
+	0x23, 0x62, 0x25, 0x92, 0x3e, 0x53, 0x71, 0xf1, 0x3e, 0xd4, 0xc5, 0xed, 0xc9, 0x1f, 0x12, 0x1a,
+	0x03, 0xa3, 0xc8, 0xa8, 0x69, 0xb9, 0x86, 0x3e, 0x4b, 0x40, 0x55, 0x7b, 0x6b, 0x94, 0x64, 0x15,
+	0xf9, 0xfe, 0x4c, 0xbf, 0x3f, 0x53, 0x4a, 0x3a, 0x0b, 0x35, 0x89, 0xea, 0x9d, 0xe8, 0x31, 0x94,
+	0x70, 0xe0, 0x58, 0x67, 0x64, 0xc4, 0x54, 0xdf, 0xf9, 0xe1, 0x95, 0x6e, 0x42, 0xfb, 0x81, 0xf3,
+	0x53, 0x32, 0x62, 0x66, 0x11, 0x47, 0x7f, 0xda, 0xff, 0x30, 0xa0, 0x3e, 0xb9, 0x8a, 0x4c, 0x28,
+	0x48, 0x0b, 0x51, 0xdd, 0xfb, 0xe4, 0x3a, 0x16, 0x3a, 0xe2, 0xe7, 0x81, 0xc7, 0xe9, 0xc8, 0x94,
+	0x5c, 0x4d, 0x17, 0xca, 0xb1, 0x08, 0xd5, 0x21, 0x7f, 0x46, 0x46, 0xaa, 0x56, 0x88, 0xbf, 0xa8,
+	0x0b, 0x8b, 0x43, 0xec, 0x86, 0xa4, 0x91, 0x9b, 0x51, 0xb3, 0x12, 0xd7, 0xf3, 0x29, 0x36, 0xcd,
+	0x88, 0xe1, 0xa3, 0xdc, 0x7d, 0xa3, 0xfd, 0x67, 0x03, 0x6e, 0xcd, 0xbc, 0x7a, 0x89, 0x33, 0x57,
+	0x57, 0xd1, 0x9e, 0x1b, 0x32, 0x4e, 0xa8, 0xf2, 0x66, 0x25, 0x92, 0x1e, 0x46, 0xc2, 0x54, 0xd7,
+	0xcd, 0xa5, 0xbb, 0xee, 0x44, 0x17, 0xca, 0x5f, 0xa3, 0x0b, 0xfd, 0xad, 0x08, 0xcd, 0xcb, 0x6f,
+	0x65, 0xff, 0xcf, 0xda, 0x9a, 0xa8, 0x7e, 0x85, 0x74, 0xf5, 0x9b, 0x5e, 0xb3, 0x16, 0xa7, 0xd7,
+	0x2c, 0xf4, 0x13, 0xa8, 0x8e, 0xd1, 0x72, 0x1f, 0x96, 0xe6, 0xdc, 0x87, 0x95, 0x58, 0x4f, 0xac,
+	0xa0, 0x6d, 0xa8, 0x33, 0x8e, 0x29, 0x4f, 0x1a, 0x8d, 0x92, 0xab, 0xaa, 0xe4, 0xda, 0xe4, 0x21,
+	0x2c, 0x6b, 0xa4, 0x34, 0x58, 0x9a, 0xd3, 0x60, 0x45, 0x69, 0x49, 0x73, 0x4f, 0x60, 0x4d, 0x76,
+	0xe1, 0x53, 0x82, 0x29, 0x3f, 0x26, 0x98, 0x5f, 0xed, 0x2e, 0xb7, 0x2a, 0x94, 0x1f, 0x6a, 0x5d,
+	0xc9, 0xf8, 0x11, 0x14, 0x6d, 0xc2, 0xb1, 0xe3, 0xea, 0x2b, 0xe9, 0x56, 0x3a, 0xd3, 0xa3, 0x91,
+	0x4f, 0xc4, 0xed, 0x13, 0x3c, 0x72, 0x7d, 0x6c, 0x33, 0x53, 0x2b, 0x88, 0xd3, 0xc0, 0x5c, 0xa0,
+	0x79, 0xa3, 0x12, 0x05, 0x99, 0x7a, 0x14, 0x2f, 0x2b, 0xfd, 0x54, 0x63, 0x5b, 0x63, 0x79, 0x1a,
+	0xb5, 0x5a, 0xd4, 0x05, 0x24, 0xa4, 0xc4, 0xac, 0x08, 0x2d, 0xf5, 0x80, 0xee, 0xc0, 0x77, 0x24,
+	0x89, 0x08, 0x0b, 0x42, 0x2d, 0xc7, 0x26, 0x1e, 0x77, 0xf8, 0xa8, 0xb1, 0x22, 0x23, 0x02, 0x89,
+	0xb5, 0xe7, 0x72, 0xa9, 0xab, 0x56, 0xd0, 0x73, 0xa8, 0xa9, 0x78, 0x88, 0xcb, 0x57, 0x55, 0x5a,
+	0xee, 0x4c, 0x4d, 0x4c, 0x85, 0x11, 0x0e, 0xa8, 0x0a, 0xa8, 0x0a, 0x96, 0x59, 0x1d, 0xa6, 0x9e,
+	0xd1, 0x0d, 0x58, 0x0a, 0x70, 0xc8, 0x88, 0xdd, 0xa8, 0xc9, 0x0b, 0x8c, 0x7a, 0x42, 0x3f, 0x87,
+	0x7a, 0x3c, 0xe2, 0xf8, 0x81, 0xa8, 0x2a, 0xac, 0x51, 0x97, 0x16, 0xa7, 0x37, 0x37, 0x1d, 0xe1,
+	0xc2, 0xa4, 0xce, 0x9e, 0xc7, 0x91, 0x9e, 0x59, 0xc3, 0x69, 0x01, 0x3a, 0x82, 0x75, 0x4a, 0x38,
+	0x1d, 0x59, 0xe4, 0xab, 0xc0, 0xa1, 0xea, 0x66, 0x27, 0x8e, 0x7b, 0x75, 0xce, 0xe3, 0x5e, 0x93,
+	0xea, 0x0f, 0x62, 0x6d, 0x99, 0xbc, 0x17, 0x39, 0x58, 0x9f, 0x3a, 0xd3, 0x64, 0xf2, 0x36, 0xf7,
+	0xc6, 0xbc, 0xcd, 0xcf, 0xc8, 0xdb, 0x42, 0x32, 0x6f, 0xfb, 0xb0, 0x3e, 0x71, 0x30, 0x96, 0xc3,
+	0xc9, 0x40, 0xcc, 0xa4, 0xa2, 0x56, 0xdf, 0xbd, 0xda, 0xf1, 0x74, 0x39, 0x19, 0x98, 0x6b, 0xc3,
+	0x8c, 0x8c, 0xa1, 0xfb, 0xb0, 0x24, 0xd3, 0x50, 0x0f, 0x98, 0x97, 0x06, 0xf3, 0xa7, 0x98, 0xe3,
+	0x03, 0xd7, 0x3f, 0x36, 0x15, 0x1e, 0x7d, 0x06, 0x55, 0x8f, 0x9c, 0x5b, 0xc2, 0x79, 0xc5, 0x50,
+	0x9c, 0x93, 0x61, 0xd9, 0x23, 0xe7, 0x66, 0xe8, 0xc9, 0x3c, 0x17, 0x03, 0x91, 0x51, 0xcf, 0xb5,
+	0x7f, 0x6d, 0xc0, 0xe6, 0x1b, 0xc6, 0x36, 0x64, 0x41, 0x35, 0x3d, 0x23, 0xaa, 0x89, 0xe0, 0xfe,
+	0x3c, 0x4d, 0x44, 0x13, 0x3f, 0x8a, 0x3e, 0xf1, 0x48, 0x7e, 0x73, 0xe5, 0x3c, 0x69, 0xae, 0xfd,
+	0x4f, 0xd1, 0x4d, 0x44, 0xd5, 0x4f, 0xdc, 0x26, 0xba, 0xde, 0xb1, 0x1f, 0x7a, 0x7a, 0x22, 0xf9,
+	0x05, 0x80, 0x1a, 0x83, 0x7c, 0x7a, 0xa5, 0xbe, 0x99, 0x60, 0x7c, 0xa2, 0x09, 0x54, 0xf3, 0x4f,
+	0x30, 0x8a, 0x2b, 0xa9, 0xed, 0x7e, 0x19, 0xcd, 0xbe, 0x3d, 0x3f, 0xf4, 0xb8, 0xfe, 0x70, 0x62,
+	0xbb, 0x5f, 0x8a, 0xdd, 0x38, 0x14, 0x32, 0x74, 0x0f, 0x1a, 0x69, 0x94, 0xc5, 0x69, 0xe8, 0xf5,
+	0x30, 0x27, 0xb6, 0x9a, 0x17, 0xd6, 0x93, 0xf8, 0x23, 0xbd, 0xd8, 0xfe, 0x6d, 0x01, 0x9a, 0x97,
+	0x7b, 0x82, 0xde, 0x85, 0x9a, 0xea, 0x95, 0x13, 0x13, 0xa8, 0x6a, 0x96, 0x4f, 0x55, 0x47, 0x7c,
+	0x04, 0x8b, 0xd1, 0xfe, 0xe7, 0xe4, 0xb5, 0xf4, 0xde, 0xdc, 0x9f, 0x8a, 0x52, 0x06, 0x89, 0x19,
+	0xb1, 0xa0, 0x3d, 0xb8, 0x31, 0x31, 0x60, 0xea, 0x9b, 0x7c, 0x34, 0x98, 0xae, 0xa5, 0xc6, 0x46,
+	0xf5, 0xf1, 0xc8, 0x83, 0xb7, 0xa6, 0x29, 0x5d, 0x77, 0x34, 0xdd, 0xcc, 0xd8, 0x48, 0x8f, 0xa7,
+	0x68, 0x17, 0xd6, 0xd5, 0x38, 0xd7, 0x23, 0xce, 0x30, 0xe1, 0x63, 0xd4, 0x31, 0x51, 0x34, 0xca,
+	0x45, 0x6b, 0xca, 0xc5, 0x5d, 0xc8, 0xbb, 0xf8, 0x44, 0x25, 0xd6, 0x46, 0xc6, 0x85, 0x4f, 0xd5,
+	0x87, 0xda, 0x83, 0xc2, 0x9f, 0x84, 0x07, 0x02, 0x8b, 0x6e, 0x01, 0x48, 0x2b, 0x84, 0x52, 0x9f,
+	0xca, 0x84, 0x2a, 0x9b, 0x65, 0x21, 0x79, 0x20, 0x04, 0xe8, 0x21, 0xd4, 0xc6, 0xcb, 0x57, 0xeb,
+	0x8a, 0x2b, 0x31, 0x8b, 0xfc, 0x28, 0xe1, 0xbc, 0x78, 0xd9, 0x5a, 0xf8, 0xe6, 0x65, 0x6b, 0xe1,
+	0xf5, 0xcb, 0x96, 0xf1, 0xab, 0x8b, 0x96, 0xf1, 0x97, 0x8b, 0x96, 0xf1, 0xf7, 0x8b, 0x96, 0xf1,
+	0xe2, 0xa2, 0x65, 0x7c, 0x7b, 0xd1, 0x32, 0xfe, 0x7d, 0xd1, 0x5a, 0x78, 0x7d, 0xd1, 0x32, 0xbe,
+	0x7e, 0xd5, 0x5a, 0x78, 0xf1, 0xaa, 0xb5, 0xf0, 0xcd, 0xab, 0xd6, 0xc2, 0xcf, 0xf6, 0x4e, 0xfc,
+	0xf1, 0x59, 0x3b, 0xfe, 0xe5, 0x1f, 0xb2, 0x3f, 0xa6, 0x24, 0x50, 0x4f, 0xc7, 0x4b, 0xd2, 0xa7,
+	0xbd, 0xff, 0x0e, 0x00, 0xd5, 0xb5, 0x83, 0x24, 0x00, 0x17, 0x00, 0x00,
 }
 
 func (this *ReplicationTask) Equal(that interface{}) bool {
@@ -1408,6 +1679,38 @@ func (this *NamespaceTaskAttributes) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !this.ApiKeys.Equal(that1.ApiKeys) {
+		return false
+	}
+	return true
+}
+func (this *NamespaceApiKeys) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NamespaceApiKeys)
+	if !ok {
+		that2, ok := that.(NamespaceApiKeys)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Keys) != len(that1.Keys) {
+		return false
+	}
+	for i := range this.Keys {
+		if !this.Keys[i].Equal(that1.Keys[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *SyncShardStatusTaskAttributes) Equal(that interface{}) bool {
@@ -1517,6 +1820,19 @@ func (this *SyncActivityTaskAttributes) Equal(that interface{}) bool {
 	if !this.VersionHistory.Equal(that1.VersionHistory) {
 		return false
 	}
+	if this.Paused != that1.Paused {
+		return false
+	}
+	if !this.ActivityOptions.Equal(that1.ActivityOptions) {
+		return false
+	}
+	if that1.RetryExpirationTime == nil {
+		if this.RetryExpirationTime != nil {
+			return false
+		}
+	} else if !this.RetryExpirationTime.Equal(*that1.RetryExpirationTime) {
+		return false
+	}
 	return true
 }
 func (this *HistoryTaskAttributes) Equal(that interface{}) bool {
@@ -1587,6 +1903,100 @@ func (this *SyncWorkflowStateTaskAttributes) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ShardReplicationInboundStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ShardReplicationInboundStatus)
+	if !ok {
+		that2, ok := that.(ShardReplicationInboundStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Processors) != len(that1.Processors) {
+		return false
+	}
+	for i := range this.Processors {
+		if !this.Processors[i].Equal(that1.Processors[i]) {
+			return false
+		}
+	}
+	if this.DlqTaskCount != that1.DlqTaskCount {
+		return false
+	}
+	if this.DlqTaskCountTruncated != that1.DlqTaskCountTruncated {
+		return false
+	}
+	return true
+}
+func (this *ReplicationProcessorStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReplicationProcessorStatus)
+	if !ok {
+		that2, ok := that.(ReplicationProcessorStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SourceShardId != that1.SourceShardId {
+		return false
+	}
+	if this.State != that1.State {
+		return false
+	}
+	if this.LastProcessedTaskId != that1.LastProcessedTaskId {
+		return false
+	}
+	if that1.LastProcessedTaskVisibilityTime == nil {
+		if this.LastProcessedTaskVisibilityTime != nil {
+			return false
+		}
+	} else if !this.LastProcessedTaskVisibilityTime.Equal(*that1.LastProcessedTaskVisibilityTime) {
+		return false
+	}
+	if this.LastReceivedTaskId != that1.LastReceivedTaskId {
+		return false
+	}
+	if this.Lag != nil && that1.Lag != nil {
+		if *this.Lag != *that1.Lag {
+			return false
+		}
+	} else if this.Lag != nil {
+		return false
+	} else if that1.Lag != nil {
+		return false
+	}
+	if this.LastError != that1.LastError {
+		return false
+	}
+	if that1.LastErrorTime == nil {
+		if this.LastErrorTime != nil {
+			return false
+		}
+	} else if !this.LastErrorTime.Equal(*that1.LastErrorTime) {
+		return false
+	}
+	return true
+}
 func (this *ReplicationTask) GoString() string {
 	if this == nil {
 		return "nil"
@@ -1704,7 +2114,7 @@ func (this *NamespaceTaskAttributes) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 12)
+	s := make([]string, 0, 13)
 	s = append(s, "&repication.NamespaceTaskAttributes{")
 	s = append(s, "NamespaceOperation: "+fmt.Sprintf("%#v", this.NamespaceOperation)+",\n")
 	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
@@ -1722,14 +2132,39 @@ func (this *NamespaceTaskAttributes) GoString() string {
 	if this.FailoverHistory != nil {
 		s = append(s, "FailoverHistory: "+fmt.Sprintf("%#v", this.FailoverHistory)+",\n")
 	}
+	if this.ApiKeys != nil {
+		s = append(s, "ApiKeys: "+fmt.Sprintf("%#v", this.ApiKeys)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *SyncShardStatusTaskAttributes) GoString() string {
+func (this *NamespaceApiKeys) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
+	s := make([]string, 0, 5)
+	s = append(s, "&repication.NamespaceApiKeys{")
+	keysForKeys := make([]string, 0, len(this.Keys))
+	for k, _ := range this.Keys {
+		keysForKeys = append(keysForKeys, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForKeys)
+	mapStringForKeys := "map[string]*v13.NamespaceApiKey{"
+	for _, k := range keysForKeys {
+		mapStringForKeys += fmt.Sprintf("%#v: %#v,", k, this.Keys[k])
+	}
+	mapStringForKeys += "}"
+	if this.Keys != nil {
+		s = append(s, "Keys: "+mapStringForKeys+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SyncShardStatusTaskAttributes) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
 	s = append(s, "&repication.SyncShardStatusTaskAttributes{")
 	s = append(s, "SourceCluster: "+fmt.Sprintf("%#v", this.SourceCluster)+",\n")
 	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
@@ -1741,7 +2176,7 @@ func (this *SyncActivityTaskAttributes) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 18)
+	s := make([]string, 0, 21)
 	s = append(s, "&repication.SyncActivityTaskAttributes{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
@@ -1763,6 +2198,11 @@ func (this *SyncActivityTaskAttributes) GoString() string {
 	if this.VersionHistory != nil {
 		s = append(s, "VersionHistory: "+fmt.Sprintf("%#v", this.VersionHistory)+",\n")
 	}
+	s = append(s, "Paused: "+fmt.Sprintf("%#v", this.Paused)+",\n")
+	if this.ActivityOptions != nil {
+		s = append(s, "ActivityOptions: "+fmt.Sprintf("%#v", this.ActivityOptions)+",\n")
+	}
+	s = append(s, "RetryExpirationTime: "+fmt.Sprintf("%#v", this.RetryExpirationTime)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1799,6 +2239,37 @@ func (this *SyncWorkflowStateTaskAttributes) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *ShardReplicationInboundStatus) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&repication.ShardReplicationInboundStatus{")
+	if this.Processors != nil {
+		s = append(s, "Processors: "+fmt.Sprintf("%#v", this.Processors)+",\n")
+	}
+	s = append(s, "DlqTaskCount: "+fmt.Sprintf("%#v", this.DlqTaskCount)+",\n")
+	s = append(s, "DlqTaskCountTruncated: "+fmt.Sprintf("%#v", this.DlqTaskCountTruncated)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ReplicationProcessorStatus) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&repication.ReplicationProcessorStatus{")
+	s = append(s, "SourceShardId: "+fmt.Sprintf("%#v", this.SourceShardId)+",\n")
+	s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
+	s = append(s, "LastProcessedTaskId: "+fmt.Sprintf("%#v", this.LastProcessedTaskId)+",\n")
+	s = append(s, "LastProcessedTaskVisibilityTime: "+fmt.Sprintf("%#v", this.LastProcessedTaskVisibilityTime)+",\n")
+	s = append(s, "LastReceivedTaskId: "+fmt.Sprintf("%#v", this.LastReceivedTaskId)+",\n")
+	s = append(s, "Lag: "+fmt.Sprintf("%#v", this.Lag)+",\n")
+	s = append(s, "LastError: "+fmt.Sprintf("%#v", this.LastError)+",\n")
+	s = append(s, "LastErrorTime: "+fmt.Sprintf("%#v", this.LastErrorTime)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringMessage(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -2203,6 +2674,18 @@ func (m *NamespaceTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.ApiKeys != nil {
+		{
+			size, err := m.ApiKeys.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.FailoverHistory) > 0 {
 		for iNdEx := len(m.FailoverHistory) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2278,6 +2761,55 @@ func (m *NamespaceTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *NamespaceApiKeys) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamespaceApiKeys) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamespaceApiKeys) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for k := range m.Keys {
+			v := m.Keys[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintMessage(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintMessage(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintMessage(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SyncShardStatusTaskAttributes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2299,12 +2831,12 @@ func (m *SyncShardStatusTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int,
 	var l int
 	_ = l
 	if m.StatusTime != nil {
-		n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StatusTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StatusTime):])
-		if err13 != nil {
-			return 0, err13
+		n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StatusTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StatusTime):])
+		if err15 != nil {
+			return 0, err15
 		}
-		i -= n13
-		i = encodeVarintMessage(dAtA, i, uint64(n13))
+		i -= n15
+		i = encodeVarintMessage(dAtA, i, uint64(n15))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -2343,6 +2875,42 @@ func (m *SyncActivityTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if m.RetryExpirationTime != nil {
+		n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.RetryExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.RetryExpirationTime):])
+		if err16 != nil {
+			return 0, err16
+		}
+		i -= n16
+		i = encodeVarintMessage(dAtA, i, uint64(n16))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.ActivityOptions != nil {
+		{
+			size, err := m.ActivityOptions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
 	if m.VersionHistory != nil {
 		{
 			size, err := m.VersionHistory.MarshalToSizedBuffer(dAtA[:i])
@@ -2392,22 +2960,22 @@ func (m *SyncActivityTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, err
 		dAtA[i] = 0x52
 	}
 	if m.LastHeartbeatTime != nil {
-		n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatTime):])
-		if err17 != nil {
-			return 0, err17
+		n21, err21 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatTime):])
+		if err21 != nil {
+			return 0, err21
 		}
-		i -= n17
-		i = encodeVarintMessage(dAtA, i, uint64(n17))
+		i -= n21
+		i = encodeVarintMessage(dAtA, i, uint64(n21))
 		i--
 		dAtA[i] = 0x4a
 	}
 	if m.StartedTime != nil {
-		n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
-		if err18 != nil {
-			return 0, err18
+		n22, err22 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
+		if err22 != nil {
+			return 0, err22
 		}
-		i -= n18
-		i = encodeVarintMessage(dAtA, i, uint64(n18))
+		i -= n22
+		i = encodeVarintMessage(dAtA, i, uint64(n22))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -2417,12 +2985,12 @@ func (m *SyncActivityTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, err
 		dAtA[i] = 0x38
 	}
 	if m.ScheduledTime != nil {
-		n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
-		if err19 != nil {
-			return 0, err19
+		n23, err23 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
+		if err23 != nil {
+			return 0, err23
 		}
-		i -= n19
-		i = encodeVarintMessage(dAtA, i, uint64(n19))
+		i -= n23
+		i = encodeVarintMessage(dAtA, i, uint64(n23))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -2577,6 +3145,138 @@ func (m *SyncWorkflowStateTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *ShardReplicationInboundStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShardReplicationInboundStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShardReplicationInboundStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DlqTaskCountTruncated {
+		i--
+		if m.DlqTaskCountTruncated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DlqTaskCount != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.DlqTaskCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Processors) > 0 {
+		for iNdEx := len(m.Processors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Processors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplicationProcessorStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicationProcessorStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicationProcessorStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastErrorTime != nil {
+		n27, err27 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastErrorTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastErrorTime):])
+		if err27 != nil {
+			return 0, err27
+		}
+		i -= n27
+		i = encodeVarintMessage(dAtA, i, uint64(n27))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Lag != nil {
+		n28, err28 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Lag, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Lag):])
+		if err28 != nil {
+			return 0, err28
+		}
+		i -= n28
+		i = encodeVarintMessage(dAtA, i, uint64(n28))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.LastReceivedTaskId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.LastReceivedTaskId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LastProcessedTaskVisibilityTime != nil {
+		n29, err29 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastProcessedTaskVisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastProcessedTaskVisibilityTime):])
+		if err29 != nil {
+			return 0, err29
+		}
+		i -= n29
+		i = encodeVarintMessage(dAtA, i, uint64(n29))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.LastProcessedTaskId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.LastProcessedTaskId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.State != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SourceShardId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.SourceShardId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMessage(v)
 	base := offset
@@ -2806,6 +3506,32 @@ func (m *NamespaceTaskAttributes) Size() (n int) {
 			n += 1 + l + sovMessage(uint64(l))
 		}
 	}
+	if m.ApiKeys != nil {
+		l = m.ApiKeys.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *NamespaceApiKeys) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for k, v := range m.Keys {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovMessage(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovMessage(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovMessage(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -2887,6 +3613,17 @@ func (m *SyncActivityTaskAttributes) Size() (n int) {
 		l = m.VersionHistory.Size()
 		n += 1 + l + sovMessage(uint64(l))
 	}
+	if m.Paused {
+		n += 2
+	}
+	if m.ActivityOptions != nil {
+		l = m.ActivityOptions.Size()
+		n += 2 + l + sovMessage(uint64(l))
+	}
+	if m.RetryExpirationTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.RetryExpirationTime)
+		n += 2 + l + sovMessage(uint64(l))
+	}
 	return n
 }
 
@@ -2938,6 +3675,64 @@ func (m *SyncWorkflowStateTaskAttributes) Size() (n int) {
 	return n
 }
 
+func (m *ShardReplicationInboundStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Processors) > 0 {
+		for _, e := range m.Processors {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if m.DlqTaskCount != 0 {
+		n += 1 + sovMessage(uint64(m.DlqTaskCount))
+	}
+	if m.DlqTaskCountTruncated {
+		n += 2
+	}
+	return n
+}
+
+func (m *ReplicationProcessorStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SourceShardId != 0 {
+		n += 1 + sovMessage(uint64(m.SourceShardId))
+	}
+	if m.State != 0 {
+		n += 1 + sovMessage(uint64(m.State))
+	}
+	if m.LastProcessedTaskId != 0 {
+		n += 1 + sovMessage(uint64(m.LastProcessedTaskId))
+	}
+	if m.LastProcessedTaskVisibilityTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastProcessedTaskVisibilityTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.LastReceivedTaskId != 0 {
+		n += 1 + sovMessage(uint64(m.LastReceivedTaskId))
+	}
+	if m.Lag != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Lag)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.LastErrorTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastErrorTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
 func sovMessage(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3084,6 +3879,27 @@ func (this *NamespaceTaskAttributes) String() string {
 		`ConfigVersion:` + fmt.Sprintf("%v", this.ConfigVersion) + `,`,
 		`FailoverVersion:` + fmt.Sprintf("%v", this.FailoverVersion) + `,`,
 		`FailoverHistory:` + repeatedStringForFailoverHistory + `,`,
+		`ApiKeys:` + strings.Replace(this.ApiKeys.String(), "NamespaceApiKeys", "NamespaceApiKeys", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NamespaceApiKeys) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForKeys := make([]string, 0, len(this.Keys))
+	for k, _ := range this.Keys {
+		keysForKeys = append(keysForKeys, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForKeys)
+	mapStringForKeys := "map[string]*v13.NamespaceApiKey{"
+	for _, k := range keysForKeys {
+		mapStringForKeys += fmt.Sprintf("%v: %v,", k, this.Keys[k])
+	}
+	mapStringForKeys += "}"
+	s := strings.Join([]string{`&NamespaceApiKeys{`,
+		`Keys:` + mapStringForKeys + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3114,11 +3930,14 @@ func (this *SyncActivityTaskAttributes) String() string {
 		`StartedEventId:` + fmt.Sprintf("%v", this.StartedEventId) + `,`,
 		`StartedTime:` + strings.Replace(fmt.Sprintf("%v", this.StartedTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`LastHeartbeatTime:` + strings.Replace(fmt.Sprintf("%v", this.LastHeartbeatTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`Details:` + strings.Replace(fmt.Sprintf("%v", this.Details), "Payloads", "v13.Payloads", 1) + `,`,
+		`Details:` + strings.Replace(fmt.Sprintf("%v", this.Details), "Payloads", "v14.Payloads", 1) + `,`,
 		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
-		`LastFailure:` + strings.Replace(fmt.Sprintf("%v", this.LastFailure), "Failure", "v14.Failure", 1) + `,`,
+		`LastFailure:` + strings.Replace(fmt.Sprintf("%v", this.LastFailure), "Failure", "v15.Failure", 1) + `,`,
 		`LastWorkerIdentity:` + fmt.Sprintf("%v", this.LastWorkerIdentity) + `,`,
-		`VersionHistory:` + strings.Replace(fmt.Sprintf("%v", this.VersionHistory), "VersionHistory", "v15.VersionHistory", 1) + `,`,
+		`VersionHistory:` + strings.Replace(fmt.Sprintf("%v", this.VersionHistory), "VersionHistory", "v16.VersionHistory", 1) + `,`,
+		`Paused:` + fmt.Sprintf("%v", this.Paused) + `,`,
+		`ActivityOptions:` + strings.Replace(fmt.Sprintf("%v", this.ActivityOptions), "ActivityOptions", "v17.ActivityOptions", 1) + `,`,
+		`RetryExpirationTime:` + strings.Replace(fmt.Sprintf("%v", this.RetryExpirationTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3129,7 +3948,7 @@ func (this *HistoryTaskAttributes) String() string {
 	}
 	repeatedStringForVersionHistoryItems := "[]*VersionHistoryItem{"
 	for _, f := range this.VersionHistoryItems {
-		repeatedStringForVersionHistoryItems += strings.Replace(fmt.Sprintf("%v", f), "VersionHistoryItem", "v15.VersionHistoryItem", 1) + ","
+		repeatedStringForVersionHistoryItems += strings.Replace(fmt.Sprintf("%v", f), "VersionHistoryItem", "v16.VersionHistoryItem", 1) + ","
 	}
 	repeatedStringForVersionHistoryItems += "}"
 	s := strings.Join([]string{`&HistoryTaskAttributes{`,
@@ -3137,8 +3956,8 @@ func (this *HistoryTaskAttributes) String() string {
 		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
 		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
 		`VersionHistoryItems:` + repeatedStringForVersionHistoryItems + `,`,
-		`Events:` + strings.Replace(fmt.Sprintf("%v", this.Events), "DataBlob", "v13.DataBlob", 1) + `,`,
-		`NewRunEvents:` + strings.Replace(fmt.Sprintf("%v", this.NewRunEvents), "DataBlob", "v13.DataBlob", 1) + `,`,
+		`Events:` + strings.Replace(fmt.Sprintf("%v", this.Events), "DataBlob", "v14.DataBlob", 1) + `,`,
+		`NewRunEvents:` + strings.Replace(fmt.Sprintf("%v", this.NewRunEvents), "DataBlob", "v14.DataBlob", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3148,7 +3967,41 @@ func (this *SyncWorkflowStateTaskAttributes) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&SyncWorkflowStateTaskAttributes{`,
-		`WorkflowState:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowState), "WorkflowMutableState", "v16.WorkflowMutableState", 1) + `,`,
+		`WorkflowState:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowState), "WorkflowMutableState", "v13.WorkflowMutableState", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ShardReplicationInboundStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForProcessors := "[]*ReplicationProcessorStatus{"
+	for _, f := range this.Processors {
+		repeatedStringForProcessors += strings.Replace(f.String(), "ReplicationProcessorStatus", "ReplicationProcessorStatus", 1) + ","
+	}
+	repeatedStringForProcessors += "}"
+	s := strings.Join([]string{`&ShardReplicationInboundStatus{`,
+		`Processors:` + repeatedStringForProcessors + `,`,
+		`DlqTaskCount:` + fmt.Sprintf("%v", this.DlqTaskCount) + `,`,
+		`DlqTaskCountTruncated:` + fmt.Sprintf("%v", this.DlqTaskCountTruncated) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReplicationProcessorStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReplicationProcessorStatus{`,
+		`SourceShardId:` + fmt.Sprintf("%v", this.SourceShardId) + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`LastProcessedTaskId:` + fmt.Sprintf("%v", this.LastProcessedTaskId) + `,`,
+		`LastProcessedTaskVisibilityTime:` + strings.Replace(fmt.Sprintf("%v", this.LastProcessedTaskVisibilityTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`LastReceivedTaskId:` + fmt.Sprintf("%v", this.LastReceivedTaskId) + `,`,
+		`Lag:` + strings.Replace(fmt.Sprintf("%v", this.Lag), "Duration", "types.Duration", 1) + `,`,
+		`LastError:` + fmt.Sprintf("%v", this.LastError) + `,`,
+		`LastErrorTime:` + strings.Replace(fmt.Sprintf("%v", this.LastErrorTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4383,12 +5236,230 @@ func (m *NamespaceTaskAttributes) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMessage(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ApiKeys == nil {
+				m.ApiKeys = &NamespaceApiKeys{}
+			}
+			if err := m.ApiKeys.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespaceApiKeys) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceApiKeys: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceApiKeys: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Keys == nil {
+				m.Keys = make(map[string]*v13.NamespaceApiKey)
+			}
+			var mapkey string
+			var mapvalue *v13.NamespaceApiKey
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthMessage
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v13.NamespaceApiKey{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipMessage(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Keys[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
 			if skippy < 0 {
 				return ErrInvalidLengthMessage
 			}
@@ -4867,7 +5938,7 @@ func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Details == nil {
-				m.Details = &v13.Payloads{}
+				m.Details = &v14.Payloads{}
 			}
 			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -4922,7 +5993,7 @@ func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.LastFailure == nil {
-				m.LastFailure = &v14.Failure{}
+				m.LastFailure = &v15.Failure{}
 			}
 			if err := m.LastFailure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -4990,12 +6061,104 @@ func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.VersionHistory == nil {
-				m.VersionHistory = &v15.VersionHistory{}
+				m.VersionHistory = &v16.VersionHistory{}
 			}
 			if err := m.VersionHistory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActivityOptions == nil {
+				m.ActivityOptions = &v17.ActivityOptions{}
+			}
+			if err := m.ActivityOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryExpirationTime == nil {
+				m.RetryExpirationTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.RetryExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -5174,7 +6337,7 @@ func (m *HistoryTaskAttributes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VersionHistoryItems = append(m.VersionHistoryItems, &v15.VersionHistoryItem{})
+			m.VersionHistoryItems = append(m.VersionHistoryItems, &v16.VersionHistoryItem{})
 			if err := m.VersionHistoryItems[len(m.VersionHistoryItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -5209,7 +6372,7 @@ func (m *HistoryTaskAttributes) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Events == nil {
-				m.Events = &v13.DataBlob{}
+				m.Events = &v14.DataBlob{}
 			}
 			if err := m.Events.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5245,7 +6408,7 @@ func (m *HistoryTaskAttributes) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.NewRunEvents == nil {
-				m.NewRunEvents = &v13.DataBlob{}
+				m.NewRunEvents = &v14.DataBlob{}
 			}
 			if err := m.NewRunEvents.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5334,7 +6497,7 @@ func (m *SyncWorkflowStateTaskAttributes) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.WorkflowState == nil {
-				m.WorkflowState = &v16.WorkflowMutableState{}
+				m.WorkflowState = &v13.WorkflowMutableState{}
 			}
 			if err := m.WorkflowState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5364,6 +6527,401 @@ func (m *SyncWorkflowStateTaskAttributes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ShardReplicationInboundStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShardReplicationInboundStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShardReplicationInboundStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Processors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Processors = append(m.Processors, &ReplicationProcessorStatus{})
+			if err := m.Processors[len(m.Processors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DlqTaskCount", wireType)
+			}
+			m.DlqTaskCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DlqTaskCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DlqTaskCountTruncated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DlqTaskCountTruncated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplicationProcessorStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplicationProcessorStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplicationProcessorStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceShardId", wireType)
+			}
+			m.SourceShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= v1.ReplicationProcessorState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastProcessedTaskId", wireType)
+			}
+			m.LastProcessedTaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastProcessedTaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastProcessedTaskVisibilityTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastProcessedTaskVisibilityTime == nil {
+				m.LastProcessedTaskVisibilityTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastProcessedTaskVisibilityTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastReceivedTaskId", wireType)
+			}
+			m.LastReceivedTaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastReceivedTaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lag", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Lag == nil {
+				m.Lag = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Lag, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastErrorTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastErrorTime == nil {
+				m.LastErrorTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastErrorTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMessage(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
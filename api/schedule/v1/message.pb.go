@@ -38,13 +38,15 @@ import (
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 	_ "github.com/gogo/protobuf/types"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
-	v11 "go.temporal.io/api/common/v1"
+	v12 "go.temporal.io/api/common/v1"
 	v1 "go.temporal.io/api/enums/v1"
-	v12 "go.temporal.io/api/failure/v1"
-	v13 "go.temporal.io/api/schedule/v1"
-	v14 "go.temporal.io/api/workflowservice/v1"
+	v13 "go.temporal.io/api/failure/v1"
+	v14 "go.temporal.io/api/schedule/v1"
+	v15 "go.temporal.io/api/workflowservice/v1"
+	v11 "go.temporal.io/server/api/enums/v1"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -67,6 +69,9 @@ type BufferedStart struct {
 	OverlapPolicy v1.ScheduleOverlapPolicy `protobuf:"varint,3,opt,name=overlap_policy,json=overlapPolicy,proto3,enum=temporal.api.enums.v1.ScheduleOverlapPolicy" json:"overlap_policy,omitempty"`
 	// Trigger-immediately or backfill
 	Manual bool `protobuf:"varint,4,opt,name=manual,proto3" json:"manual,omitempty"`
+	// If the schedule has a dependency, the time after which the dependency timeout policy
+	// is applied to this start. Unset for starts that don't wait on a dependency.
+	DependencyDeadline *time.Time `protobuf:"bytes,5,opt,name=dependency_deadline,json=dependencyDeadline,proto3,stdtime" json:"dependency_deadline,omitempty"`
 }
 
 func (m *BufferedStart) Reset()      { *m = BufferedStart{} }
@@ -129,6 +134,136 @@ func (m *BufferedStart) GetManual() bool {
 	return false
 }
 
+func (m *BufferedStart) GetDependencyDeadline() *time.Time {
+	if m != nil {
+		return m.DependencyDeadline
+	}
+	return nil
+}
+
+// A condition that makes a schedule start a workflow for a nominal time only after the run of
+// another schedule for the same nominal time completed successfully.
+type ScheduleDependency struct {
+	// Id of the upstream schedule, in the same namespace.
+	ScheduleId string `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	// How long to wait (from the nominal time) for the upstream result.
+	Timeout       *time.Duration                      `protobuf:"bytes,2,opt,name=timeout,proto3,stdduration" json:"timeout,omitempty"`
+	TimeoutPolicy v11.ScheduleDependencyTimeoutPolicy `protobuf:"varint,3,opt,name=timeout_policy,json=timeoutPolicy,proto3,enum=temporal.server.api.enums.v1.ScheduleDependencyTimeoutPolicy" json:"timeout_policy,omitempty"`
+}
+
+func (m *ScheduleDependency) Reset()      { *m = ScheduleDependency{} }
+func (*ScheduleDependency) ProtoMessage() {}
+func (*ScheduleDependency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6461b6986ba20ee7, []int{1}
+}
+func (m *ScheduleDependency) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleDependency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleDependency.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduleDependency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleDependency.Merge(m, src)
+}
+func (m *ScheduleDependency) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleDependency) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleDependency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleDependency proto.InternalMessageInfo
+
+func (m *ScheduleDependency) GetScheduleId() string {
+	if m != nil {
+		return m.ScheduleId
+	}
+	return ""
+}
+
+func (m *ScheduleDependency) GetTimeout() *time.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return nil
+}
+
+func (m *ScheduleDependency) GetTimeoutPolicy() v11.ScheduleDependencyTimeoutPolicy {
+	if m != nil {
+		return m.TimeoutPolicy
+	}
+	return v11.SCHEDULE_DEPENDENCY_TIMEOUT_POLICY_UNSPECIFIED
+}
+
+// Sent by a schedule to its dependents when a workflow it started closes.
+type DependencyResult struct {
+	ScheduleId  string                     `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	NominalTime *time.Time                 `protobuf:"bytes,2,opt,name=nominal_time,json=nominalTime,proto3,stdtime" json:"nominal_time,omitempty"`
+	Status      v1.WorkflowExecutionStatus `protobuf:"varint,3,opt,name=status,proto3,enum=temporal.api.enums.v1.WorkflowExecutionStatus" json:"status,omitempty"`
+}
+
+func (m *DependencyResult) Reset()      { *m = DependencyResult{} }
+func (*DependencyResult) ProtoMessage() {}
+func (*DependencyResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6461b6986ba20ee7, []int{2}
+}
+func (m *DependencyResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DependencyResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DependencyResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DependencyResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DependencyResult.Merge(m, src)
+}
+func (m *DependencyResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *DependencyResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_DependencyResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DependencyResult proto.InternalMessageInfo
+
+func (m *DependencyResult) GetScheduleId() string {
+	if m != nil {
+		return m.ScheduleId
+	}
+	return ""
+}
+
+func (m *DependencyResult) GetNominalTime() *time.Time {
+	if m != nil {
+		return m.NominalTime
+	}
+	return nil
+}
+
+func (m *DependencyResult) GetStatus() v1.WorkflowExecutionStatus {
+	if m != nil {
+		return m.Status
+	}
+	return v1.WORKFLOW_EXECUTION_STATUS_UNSPECIFIED
+}
+
 type InternalState struct {
 	Namespace   string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	NamespaceId string `protobuf:"bytes,2,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
@@ -138,17 +273,24 @@ type InternalState struct {
 	LastProcessedTime *time.Time       `protobuf:"bytes,3,opt,name=last_processed_time,json=lastProcessedTime,proto3,stdtime" json:"last_processed_time,omitempty"`
 	BufferedStarts    []*BufferedStart `protobuf:"bytes,4,rep,name=buffered_starts,json=bufferedStarts,proto3" json:"buffered_starts,omitempty"`
 	// last completion/failure
-	LastCompletionResult *v11.Payloads `protobuf:"bytes,5,opt,name=last_completion_result,json=lastCompletionResult,proto3" json:"last_completion_result,omitempty"`
-	ContinuedFailure     *v12.Failure  `protobuf:"bytes,6,opt,name=continued_failure,json=continuedFailure,proto3" json:"continued_failure,omitempty"`
+	LastCompletionResult *v12.Payloads `protobuf:"bytes,5,opt,name=last_completion_result,json=lastCompletionResult,proto3" json:"last_completion_result,omitempty"`
+	ContinuedFailure     *v13.Failure  `protobuf:"bytes,6,opt,name=continued_failure,json=continuedFailure,proto3" json:"continued_failure,omitempty"`
 	// conflict token is implemented as simple sequence number
 	ConflictToken int64 `protobuf:"varint,7,opt,name=conflict_token,json=conflictToken,proto3" json:"conflict_token,omitempty"`
 	NeedRefresh   bool  `protobuf:"varint,9,opt,name=need_refresh,json=needRefresh,proto3" json:"need_refresh,omitempty"`
+	// ids of schedules that registered a dependency on this one
+	DependentScheduleIds []string `protobuf:"bytes,10,rep,name=dependent_schedule_ids,json=dependentScheduleIds,proto3" json:"dependent_schedule_ids,omitempty"`
+	// nominal times of running workflows, by workflow id, reported to dependents on close
+	RunningNominalTimes map[string]*time.Time `protobuf:"bytes,11,rep,name=running_nominal_times,json=runningNominalTimes,proto3,stdtime" json:"running_nominal_times,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// upstream results received for our own dependency
+	DependencyResults    []*DependencyResult `protobuf:"bytes,12,rep,name=dependency_results,json=dependencyResults,proto3" json:"dependency_results,omitempty"`
+	DependencyRegistered bool                `protobuf:"varint,13,opt,name=dependency_registered,json=dependencyRegistered,proto3" json:"dependency_registered,omitempty"`
 }
 
 func (m *InternalState) Reset()      { *m = InternalState{} }
 func (*InternalState) ProtoMessage() {}
 func (*InternalState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6461b6986ba20ee7, []int{1}
+	return fileDescriptor_6461b6986ba20ee7, []int{3}
 }
 func (m *InternalState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -212,14 +354,14 @@ func (m *InternalState) GetBufferedStarts() []*BufferedStart {
 	return nil
 }
 
-func (m *InternalState) GetLastCompletionResult() *v11.Payloads {
+func (m *InternalState) GetLastCompletionResult() *v12.Payloads {
 	if m != nil {
 		return m.LastCompletionResult
 	}
 	return nil
 }
 
-func (m *InternalState) GetContinuedFailure() *v12.Failure {
+func (m *InternalState) GetContinuedFailure() *v13.Failure {
 	if m != nil {
 		return m.ContinuedFailure
 	}
@@ -240,17 +382,46 @@ func (m *InternalState) GetNeedRefresh() bool {
 	return false
 }
 
+func (m *InternalState) GetDependentScheduleIds() []string {
+	if m != nil {
+		return m.DependentScheduleIds
+	}
+	return nil
+}
+
+func (m *InternalState) GetRunningNominalTimes() map[string]*time.Time {
+	if m != nil {
+		return m.RunningNominalTimes
+	}
+	return nil
+}
+
+func (m *InternalState) GetDependencyResults() []*DependencyResult {
+	if m != nil {
+		return m.DependencyResults
+	}
+	return nil
+}
+
+func (m *InternalState) GetDependencyRegistered() bool {
+	if m != nil {
+		return m.DependencyRegistered
+	}
+	return false
+}
+
 type StartScheduleArgs struct {
-	Schedule     *v13.Schedule      `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
-	Info         *v13.ScheduleInfo  `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
-	InitialPatch *v13.SchedulePatch `protobuf:"bytes,3,opt,name=initial_patch,json=initialPatch,proto3" json:"initial_patch,omitempty"`
-	State        *InternalState     `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	Schedule     *v14.Schedule       `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	Info         *v14.ScheduleInfo   `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+	InitialPatch *v14.SchedulePatch  `protobuf:"bytes,3,opt,name=initial_patch,json=initialPatch,proto3" json:"initial_patch,omitempty"`
+	State        *InternalState      `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	Dependency   *ScheduleDependency `protobuf:"bytes,5,opt,name=dependency,proto3" json:"dependency,omitempty"`
 }
 
 func (m *StartScheduleArgs) Reset()      { *m = StartScheduleArgs{} }
 func (*StartScheduleArgs) ProtoMessage() {}
 func (*StartScheduleArgs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6461b6986ba20ee7, []int{2}
+	return fileDescriptor_6461b6986ba20ee7, []int{4}
 }
 func (m *StartScheduleArgs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -279,21 +450,21 @@ func (m *StartScheduleArgs) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_StartScheduleArgs proto.InternalMessageInfo
 
-func (m *StartScheduleArgs) GetSchedule() *v13.Schedule {
+func (m *StartScheduleArgs) GetSchedule() *v14.Schedule {
 	if m != nil {
 		return m.Schedule
 	}
 	return nil
 }
 
-func (m *StartScheduleArgs) GetInfo() *v13.ScheduleInfo {
+func (m *StartScheduleArgs) GetInfo() *v14.ScheduleInfo {
 	if m != nil {
 		return m.Info
 	}
 	return nil
 }
 
-func (m *StartScheduleArgs) GetInitialPatch() *v13.SchedulePatch {
+func (m *StartScheduleArgs) GetInitialPatch() *v14.SchedulePatch {
 	if m != nil {
 		return m.InitialPatch
 	}
@@ -307,15 +478,24 @@ func (m *StartScheduleArgs) GetState() *InternalState {
 	return nil
 }
 
+func (m *StartScheduleArgs) GetDependency() *ScheduleDependency {
+	if m != nil {
+		return m.Dependency
+	}
+	return nil
+}
+
 type FullUpdateRequest struct {
-	Schedule      *v13.Schedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	Schedule      *v14.Schedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
 	ConflictToken int64         `protobuf:"varint,2,opt,name=conflict_token,json=conflictToken,proto3" json:"conflict_token,omitempty"`
+	// Replaces the schedule's dependency. Unset removes it.
+	Dependency *ScheduleDependency `protobuf:"bytes,3,opt,name=dependency,proto3" json:"dependency,omitempty"`
 }
 
 func (m *FullUpdateRequest) Reset()      { *m = FullUpdateRequest{} }
 func (*FullUpdateRequest) ProtoMessage() {}
 func (*FullUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6461b6986ba20ee7, []int{3}
+	return fileDescriptor_6461b6986ba20ee7, []int{5}
 }
 func (m *FullUpdateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -344,7 +524,7 @@ func (m *FullUpdateRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_FullUpdateRequest proto.InternalMessageInfo
 
-func (m *FullUpdateRequest) GetSchedule() *v13.Schedule {
+func (m *FullUpdateRequest) GetSchedule() *v14.Schedule {
 	if m != nil {
 		return m.Schedule
 	}
@@ -358,16 +538,24 @@ func (m *FullUpdateRequest) GetConflictToken() int64 {
 	return 0
 }
 
+func (m *FullUpdateRequest) GetDependency() *ScheduleDependency {
+	if m != nil {
+		return m.Dependency
+	}
+	return nil
+}
+
 type DescribeResponse struct {
-	Schedule      *v13.Schedule     `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
-	Info          *v13.ScheduleInfo `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
-	ConflictToken int64             `protobuf:"varint,3,opt,name=conflict_token,json=conflictToken,proto3" json:"conflict_token,omitempty"`
+	Schedule      *v14.Schedule       `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	Info          *v14.ScheduleInfo   `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+	ConflictToken int64               `protobuf:"varint,3,opt,name=conflict_token,json=conflictToken,proto3" json:"conflict_token,omitempty"`
+	Dependency    *ScheduleDependency `protobuf:"bytes,4,opt,name=dependency,proto3" json:"dependency,omitempty"`
 }
 
 func (m *DescribeResponse) Reset()      { *m = DescribeResponse{} }
 func (*DescribeResponse) ProtoMessage() {}
 func (*DescribeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6461b6986ba20ee7, []int{4}
+	return fileDescriptor_6461b6986ba20ee7, []int{6}
 }
 func (m *DescribeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -396,14 +584,14 @@ func (m *DescribeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DescribeResponse proto.InternalMessageInfo
 
-func (m *DescribeResponse) GetSchedule() *v13.Schedule {
+func (m *DescribeResponse) GetSchedule() *v14.Schedule {
 	if m != nil {
 		return m.Schedule
 	}
 	return nil
 }
 
-func (m *DescribeResponse) GetInfo() *v13.ScheduleInfo {
+func (m *DescribeResponse) GetInfo() *v14.ScheduleInfo {
 	if m != nil {
 		return m.Info
 	}
@@ -417,10 +605,17 @@ func (m *DescribeResponse) GetConflictToken() int64 {
 	return 0
 }
 
+func (m *DescribeResponse) GetDependency() *ScheduleDependency {
+	if m != nil {
+		return m.Dependency
+	}
+	return nil
+}
+
 type WatchWorkflowRequest struct {
 	// Note: this will be sent to the activity with empty execution.run_id, and
 	// the run id that we started in first_execution_run_id.
-	Execution           *v11.WorkflowExecution `protobuf:"bytes,3,opt,name=execution,proto3" json:"execution,omitempty"`
+	Execution           *v12.WorkflowExecution `protobuf:"bytes,3,opt,name=execution,proto3" json:"execution,omitempty"`
 	FirstExecutionRunId string                 `protobuf:"bytes,4,opt,name=first_execution_run_id,json=firstExecutionRunId,proto3" json:"first_execution_run_id,omitempty"`
 	LongPoll            bool                   `protobuf:"varint,5,opt,name=long_poll,json=longPoll,proto3" json:"long_poll,omitempty"`
 }
@@ -428,7 +623,7 @@ type WatchWorkflowRequest struct {
 func (m *WatchWorkflowRequest) Reset()      { *m = WatchWorkflowRequest{} }
 func (*WatchWorkflowRequest) ProtoMessage() {}
 func (*WatchWorkflowRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6461b6986ba20ee7, []int{5}
+	return fileDescriptor_6461b6986ba20ee7, []int{7}
 }
 func (m *WatchWorkflowRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -457,7 +652,7 @@ func (m *WatchWorkflowRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_WatchWorkflowRequest proto.InternalMessageInfo
 
-func (m *WatchWorkflowRequest) GetExecution() *v11.WorkflowExecution {
+func (m *WatchWorkflowRequest) GetExecution() *v12.WorkflowExecution {
 	if m != nil {
 		return m.Execution
 	}
@@ -489,7 +684,7 @@ type WatchWorkflowResponse struct {
 func (m *WatchWorkflowResponse) Reset()      { *m = WatchWorkflowResponse{} }
 func (*WatchWorkflowResponse) ProtoMessage() {}
 func (*WatchWorkflowResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6461b6986ba20ee7, []int{6}
+	return fileDescriptor_6461b6986ba20ee7, []int{8}
 }
 func (m *WatchWorkflowResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -526,10 +721,10 @@ type isWatchWorkflowResponse_ResultFailure interface {
 }
 
 type WatchWorkflowResponse_Result struct {
-	Result *v11.Payloads `protobuf:"bytes,2,opt,name=result,proto3,oneof" json:"result,omitempty"`
+	Result *v12.Payloads `protobuf:"bytes,2,opt,name=result,proto3,oneof" json:"result,omitempty"`
 }
 type WatchWorkflowResponse_Failure struct {
-	Failure *v12.Failure `protobuf:"bytes,3,opt,name=failure,proto3,oneof" json:"failure,omitempty"`
+	Failure *v13.Failure `protobuf:"bytes,3,opt,name=failure,proto3,oneof" json:"failure,omitempty"`
 }
 
 func (*WatchWorkflowResponse_Result) isWatchWorkflowResponse_ResultFailure()  {}
@@ -549,14 +744,14 @@ func (m *WatchWorkflowResponse) GetStatus() v1.WorkflowExecutionStatus {
 	return v1.WORKFLOW_EXECUTION_STATUS_UNSPECIFIED
 }
 
-func (m *WatchWorkflowResponse) GetResult() *v11.Payloads {
+func (m *WatchWorkflowResponse) GetResult() *v12.Payloads {
 	if x, ok := m.GetResultFailure().(*WatchWorkflowResponse_Result); ok {
 		return x.Result
 	}
 	return nil
 }
 
-func (m *WatchWorkflowResponse) GetFailure() *v12.Failure {
+func (m *WatchWorkflowResponse) GetFailure() *v13.Failure {
 	if x, ok := m.GetResultFailure().(*WatchWorkflowResponse_Failure); ok {
 		return x.Failure
 	}
@@ -572,14 +767,14 @@ func (*WatchWorkflowResponse) XXX_OneofWrappers() []interface{} {
 }
 
 type StartWorkflowRequest struct {
-	Request                 *v14.StartWorkflowExecutionRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	Request                 *v15.StartWorkflowExecutionRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
 	CompletedRateLimitSleep bool                               `protobuf:"varint,6,opt,name=completed_rate_limit_sleep,json=completedRateLimitSleep,proto3" json:"completed_rate_limit_sleep,omitempty"`
 }
 
 func (m *StartWorkflowRequest) Reset()      { *m = StartWorkflowRequest{} }
 func (*StartWorkflowRequest) ProtoMessage() {}
 func (*StartWorkflowRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6461b6986ba20ee7, []int{7}
+	return fileDescriptor_6461b6986ba20ee7, []int{9}
 }
 func (m *StartWorkflowRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -608,7 +803,7 @@ func (m *StartWorkflowRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_StartWorkflowRequest proto.InternalMessageInfo
 
-func (m *StartWorkflowRequest) GetRequest() *v14.StartWorkflowExecutionRequest {
+func (m *StartWorkflowRequest) GetRequest() *v15.StartWorkflowExecutionRequest {
 	if m != nil {
 		return m.Request
 	}
@@ -630,7 +825,7 @@ type StartWorkflowResponse struct {
 func (m *StartWorkflowResponse) Reset()      { *m = StartWorkflowResponse{} }
 func (*StartWorkflowResponse) ProtoMessage() {}
 func (*StartWorkflowResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6461b6986ba20ee7, []int{8}
+	return fileDescriptor_6461b6986ba20ee7, []int{10}
 }
 func (m *StartWorkflowResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -677,14 +872,14 @@ type CancelWorkflowRequest struct {
 	RequestId string `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	Identity  string `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
 	// Note: run id in execution is first execution run id
-	Execution *v11.WorkflowExecution `protobuf:"bytes,5,opt,name=execution,proto3" json:"execution,omitempty"`
+	Execution *v12.WorkflowExecution `protobuf:"bytes,5,opt,name=execution,proto3" json:"execution,omitempty"`
 	Reason    string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
 func (m *CancelWorkflowRequest) Reset()      { *m = CancelWorkflowRequest{} }
 func (*CancelWorkflowRequest) ProtoMessage() {}
 func (*CancelWorkflowRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6461b6986ba20ee7, []int{9}
+	return fileDescriptor_6461b6986ba20ee7, []int{11}
 }
 func (m *CancelWorkflowRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -727,7 +922,7 @@ func (m *CancelWorkflowRequest) GetIdentity() string {
 	return ""
 }
 
-func (m *CancelWorkflowRequest) GetExecution() *v11.WorkflowExecution {
+func (m *CancelWorkflowRequest) GetExecution() *v12.WorkflowExecution {
 	if m != nil {
 		return m.Execution
 	}
@@ -745,14 +940,14 @@ type TerminateWorkflowRequest struct {
 	RequestId string `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	Identity  string `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
 	// Note: run id in execution is first execution run id
-	Execution *v11.WorkflowExecution `protobuf:"bytes,5,opt,name=execution,proto3" json:"execution,omitempty"`
+	Execution *v12.WorkflowExecution `protobuf:"bytes,5,opt,name=execution,proto3" json:"execution,omitempty"`
 	Reason    string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
 func (m *TerminateWorkflowRequest) Reset()      { *m = TerminateWorkflowRequest{} }
 func (*TerminateWorkflowRequest) ProtoMessage() {}
 func (*TerminateWorkflowRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6461b6986ba20ee7, []int{10}
+	return fileDescriptor_6461b6986ba20ee7, []int{12}
 }
 func (m *TerminateWorkflowRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -795,7 +990,7 @@ func (m *TerminateWorkflowRequest) GetIdentity() string {
 	return ""
 }
 
-func (m *TerminateWorkflowRequest) GetExecution() *v11.WorkflowExecution {
+func (m *TerminateWorkflowRequest) GetExecution() *v12.WorkflowExecution {
 	if m != nil {
 		return m.Execution
 	}
@@ -811,7 +1006,10 @@ func (m *TerminateWorkflowRequest) GetReason() string {
 
 func init() {
 	proto.RegisterType((*BufferedStart)(nil), "temporal.server.api.schedule.v1.BufferedStart")
+	proto.RegisterType((*ScheduleDependency)(nil), "temporal.server.api.schedule.v1.ScheduleDependency")
+	proto.RegisterType((*DependencyResult)(nil), "temporal.server.api.schedule.v1.DependencyResult")
 	proto.RegisterType((*InternalState)(nil), "temporal.server.api.schedule.v1.InternalState")
+	proto.RegisterMapType((map[string]*time.Time)(nil), "temporal.server.api.schedule.v1.InternalState.RunningNominalTimesEntry")
 	proto.RegisterType((*StartScheduleArgs)(nil), "temporal.server.api.schedule.v1.StartScheduleArgs")
 	proto.RegisterType((*FullUpdateRequest)(nil), "temporal.server.api.schedule.v1.FullUpdateRequest")
 	proto.RegisterType((*DescribeResponse)(nil), "temporal.server.api.schedule.v1.DescribeResponse")
@@ -828,79 +1026,97 @@ func init() {
 }
 
 var fileDescriptor_6461b6986ba20ee7 = []byte{
-	// 1148 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x4f, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xf6, 0xc6, 0x7f, 0x6a, 0x8f, 0x6b, 0xd7, 0xd9, 0x36, 0xf9, 0x59, 0xfe, 0xc1, 0xc6, 0xb5,
-	0x68, 0x09, 0x12, 0xac, 0x95, 0x54, 0xe2, 0xd0, 0xa2, 0x4a, 0x4d, 0x4a, 0x88, 0xa3, 0x22, 0xac,
-	0x71, 0x20, 0x88, 0xcb, 0x6a, 0xb2, 0x3b, 0x76, 0x46, 0x9d, 0x9d, 0x59, 0x76, 0x66, 0xd3, 0x86,
-	0x13, 0x07, 0xae, 0x48, 0xfd, 0x0e, 0x5c, 0xb8, 0x22, 0x04, 0x57, 0xae, 0x1c, 0x73, 0xec, 0x0d,
-	0xe2, 0x5c, 0x90, 0xb8, 0xf4, 0x23, 0xa0, 0x99, 0x9d, 0x75, 0x62, 0x27, 0x21, 0x41, 0x3d, 0x20,
-	0x6e, 0x3b, 0xef, 0x3c, 0xcf, 0x33, 0xef, 0xbc, 0xff, 0x66, 0xc1, 0x7b, 0x12, 0x87, 0x11, 0x8f,
-	0x11, 0xed, 0x0a, 0x1c, 0xef, 0xe3, 0xb8, 0x8b, 0x22, 0xd2, 0x15, 0xfe, 0x1e, 0x0e, 0x12, 0x8a,
-	0xbb, 0xfb, 0x2b, 0xdd, 0x10, 0x0b, 0x81, 0x46, 0xd8, 0x8d, 0x62, 0x2e, 0xb9, 0xbd, 0x94, 0xc1,
-	0xdd, 0x14, 0xee, 0xa2, 0x88, 0xb8, 0x19, 0xdc, 0xdd, 0x5f, 0x69, 0xbd, 0x35, 0xd1, 0x53, 0x42,
-	0x3e, 0x0f, 0x43, 0xce, 0xce, 0xc8, 0xb4, 0xee, 0x4c, 0xa1, 0x86, 0x88, 0xd0, 0x24, 0x3e, 0x7b,
-	0xda, 0x8c, 0x18, 0x66, 0x49, 0x28, 0x14, 0x68, 0x72, 0xde, 0xdf, 0xa2, 0x9e, 0xf1, 0xf8, 0xe9,
-	0x90, 0xf2, 0x67, 0x06, 0x75, 0x77, 0x0a, 0x75, 0xe1, 0x0d, 0x5b, 0xef, 0x4f, 0xe1, 0x32, 0x11,
-	0x75, 0x5b, 0xe2, 0x6b, 0x78, 0x8c, 0xbf, 0x4c, 0xb0, 0x90, 0x5e, 0x8c, 0x45, 0xc4, 0x99, 0xc8,
-	0x78, 0x4b, 0x23, 0xce, 0x47, 0x14, 0x77, 0xf5, 0x6a, 0x37, 0x19, 0x76, 0x25, 0x09, 0xb1, 0x90,
-	0x28, 0x8c, 0x0c, 0xe0, 0x76, 0x80, 0x23, 0xcc, 0x02, 0xcc, 0x7c, 0x82, 0x45, 0x77, 0xc4, 0x47,
-	0x5c, 0xdb, 0xf5, 0x57, 0x0a, 0xe9, 0x7c, 0x33, 0x07, 0x6a, 0x6b, 0xc9, 0x70, 0x88, 0x63, 0x1c,
-	0x0c, 0x24, 0x8a, 0xa5, 0xbd, 0x0e, 0xae, 0x33, 0x1e, 0x12, 0x86, 0xa8, 0xa7, 0xf4, 0x9a, 0x56,
-	0xdb, 0x5a, 0xae, 0xae, 0xb6, 0xdc, 0xf4, 0x30, 0x37, 0x3b, 0xcc, 0xdd, 0xce, 0x0e, 0x5b, 0x2b,
-	0xbc, 0xf8, 0x6d, 0xc9, 0x82, 0x55, 0xc3, 0x52, 0x76, 0xfb, 0x11, 0xa8, 0x22, 0x5f, 0x26, 0x99,
-	0xc6, 0xdc, 0x15, 0x35, 0x40, 0x4a, 0xd2, 0x12, 0x03, 0x50, 0xe7, 0xfb, 0x38, 0xa6, 0x28, 0xf2,
-	0x22, 0x4e, 0x89, 0x7f, 0xd0, 0xcc, 0xb7, 0xad, 0xe5, 0xfa, 0xea, 0xbb, 0xee, 0xa4, 0x20, 0x54,
-	0x25, 0xe8, 0xe0, 0xbb, 0xfb, 0x2b, 0xee, 0xc0, 0xc4, 0xf7, 0x93, 0x94, 0xd4, 0xd7, 0x1c, 0x58,
-	0xe3, 0xa7, 0x97, 0xf6, 0x22, 0x28, 0x85, 0x88, 0x25, 0x88, 0x36, 0x0b, 0x6d, 0x6b, 0xb9, 0x0c,
-	0xcd, 0xaa, 0xf3, 0x6d, 0x01, 0xd4, 0x7a, 0x4c, 0xe2, 0x98, 0x21, 0x3a, 0x90, 0x48, 0x62, 0xfb,
-	0x0d, 0x50, 0x61, 0x28, 0xc4, 0x22, 0x42, 0x7e, 0x1a, 0x83, 0x0a, 0x3c, 0x31, 0xd8, 0xb7, 0xc1,
-	0xf5, 0xc9, 0xc2, 0x23, 0x81, 0xbe, 0x60, 0x05, 0x56, 0x27, 0xb6, 0x5e, 0x60, 0x2f, 0x81, 0x6a,
-	0x96, 0x72, 0x85, 0x28, 0x6b, 0x04, 0xc8, 0x4c, 0xbd, 0xc0, 0xee, 0x83, 0x9b, 0x14, 0x09, 0xe9,
-	0x45, 0x31, 0xf7, 0xb1, 0x10, 0x38, 0x48, 0x63, 0x95, 0xbf, 0x62, 0xac, 0xe6, 0x15, 0xb9, 0x9f,
-	0x71, 0x75, 0xc8, 0x76, 0xc0, 0x8d, 0x5d, 0x93, 0x4b, 0x4f, 0xa8, 0x64, 0x8a, 0x66, 0xa1, 0x9d,
-	0x5f, 0xae, 0xae, 0xba, 0xee, 0x25, 0x4d, 0xe4, 0x4e, 0xd5, 0x00, 0xac, 0xef, 0x9e, 0x5e, 0x0a,
-	0xfb, 0x33, 0xb0, 0xa8, 0x5d, 0xf5, 0x79, 0x18, 0x51, 0x2c, 0x09, 0x67, 0xaa, 0x16, 0x13, 0x2a,
-	0x9b, 0x45, 0xed, 0x6d, 0x7b, 0x3a, 0x27, 0x69, 0x0f, 0x2a, 0xd9, 0x3e, 0x3a, 0xa0, 0x1c, 0x05,
-	0x02, 0xde, 0x52, 0xfc, 0xf5, 0x09, 0x1d, 0x6a, 0xb6, 0xfd, 0x31, 0x98, 0xf7, 0x39, 0x93, 0x84,
-	0x25, 0x38, 0xf0, 0x4c, 0x4f, 0x36, 0x4b, 0xe7, 0x49, 0x9a, 0x4d, 0xa5, 0xb9, 0x91, 0x7e, 0xc2,
-	0xc6, 0x84, 0x6a, 0x2c, 0xf6, 0x1d, 0x50, 0xf7, 0x39, 0x1b, 0x52, 0xe2, 0x4b, 0x4f, 0xf2, 0xa7,
-	0x98, 0x35, 0xaf, 0xb5, 0xad, 0xe5, 0x3c, 0xac, 0x65, 0xd6, 0x6d, 0x65, 0xd4, 0xc9, 0xc3, 0x38,
-	0xf0, 0x62, 0x3c, 0x8c, 0xb1, 0xd8, 0x6b, 0x56, 0x74, 0x29, 0x54, 0x95, 0x0d, 0xa6, 0xa6, 0xce,
-	0x77, 0x73, 0x60, 0x5e, 0xdf, 0x3d, 0xab, 0xaa, 0x47, 0xf1, 0x48, 0xd8, 0x0f, 0x41, 0x39, 0x8b,
-	0x99, 0x69, 0x8b, 0xce, 0xb4, 0x97, 0xa7, 0x23, 0x9a, 0x31, 0xe1, 0x84, 0x63, 0xdf, 0x07, 0x05,
-	0xc2, 0x86, 0xdc, 0xb4, 0xc3, 0xdd, 0xcb, 0xb9, 0x3d, 0x36, 0xe4, 0x50, 0x73, 0xec, 0x27, 0xa0,
-	0x46, 0x18, 0x91, 0x04, 0x51, 0x2f, 0x42, 0xd2, 0xdf, 0x33, 0x75, 0xf2, 0xf6, 0xe5, 0x22, 0x7d,
-	0x05, 0x87, 0xd7, 0x0d, 0x5b, 0xaf, 0xec, 0xc7, 0xa0, 0x28, 0x54, 0x99, 0xeb, 0x36, 0xb8, 0x4a,
-	0x7d, 0x4c, 0x35, 0x07, 0x4c, 0xc9, 0x9d, 0xaf, 0xc0, 0xfc, 0x46, 0x42, 0xe9, 0xa7, 0x51, 0xa0,
-	0x8c, 0xe9, 0x90, 0x7a, 0xed, 0x20, 0x9d, 0x4d, 0xe2, 0xdc, 0x39, 0x49, 0xec, 0xfc, 0x64, 0x81,
-	0xc6, 0x63, 0x2c, 0xfc, 0x98, 0xec, 0x62, 0x68, 0xe6, 0xe2, 0xbf, 0x9a, 0xa0, 0xb3, 0x7e, 0xe7,
-	0xcf, 0xf3, 0xfb, 0x07, 0x0b, 0xdc, 0xda, 0x51, 0x39, 0xd8, 0x31, 0x73, 0x3e, 0x8b, 0xdb, 0x47,
-	0xa0, 0x82, 0x9f, 0x63, 0x3f, 0x51, 0xed, 0x61, 0x92, 0xfb, 0xce, 0x45, 0x6d, 0x95, 0x71, 0x3f,
-	0xcc, 0x08, 0xf0, 0x84, 0x6b, 0xdf, 0x03, 0x8b, 0x43, 0x12, 0x0b, 0xe9, 0x4d, 0x4c, 0x5e, 0x9c,
-	0x30, 0x35, 0x83, 0x0a, 0x7a, 0x06, 0xdd, 0xd4, 0xbb, 0x27, 0xd4, 0x84, 0xf5, 0x02, 0xfb, 0xff,
-	0xa0, 0x42, 0x39, 0x1b, 0xa9, 0x51, 0x4b, 0x75, 0x53, 0x97, 0x61, 0x59, 0x19, 0xfa, 0x9c, 0xd2,
-	0xce, 0x9f, 0x16, 0x58, 0x98, 0xf1, 0xd9, 0x04, 0x7c, 0x03, 0x94, 0x54, 0x29, 0x24, 0x42, 0x87,
-	0xbb, 0x7e, 0xba, 0x90, 0xa6, 0x86, 0xf3, 0x19, 0x87, 0x07, 0x9a, 0x05, 0x0d, 0xdb, 0xbe, 0x0f,
-	0x4a, 0x66, 0xa0, 0xcc, 0x5d, 0x6d, 0xa0, 0x6c, 0xe6, 0xa0, 0x61, 0xd8, 0x1f, 0x80, 0x6b, 0xd9,
-	0xe8, 0xc8, 0x5f, 0x6d, 0x74, 0x6c, 0xe6, 0x60, 0x46, 0x59, 0x6b, 0x80, 0x7a, 0xaa, 0x93, 0xcd,
-	0x9f, 0xce, 0x2f, 0x16, 0xb8, 0xa5, 0x7b, 0x7f, 0x36, 0x43, 0x9f, 0x83, 0x6b, 0xe6, 0x25, 0x36,
-	0x5e, 0x3e, 0x9c, 0x3e, 0x68, 0xe6, 0xe5, 0xd6, 0x75, 0x72, 0x5a, 0xe7, 0x24, 0xe4, 0xa9, 0x0a,
-	0xcc, 0xe4, 0xec, 0x07, 0xa0, 0x65, 0x46, 0xab, 0x1a, 0x4b, 0x48, 0x62, 0x8f, 0x92, 0x90, 0x48,
-	0x4f, 0x50, 0x8c, 0x23, 0x3d, 0x10, 0xcb, 0xf0, 0x7f, 0x13, 0x04, 0x44, 0x12, 0x3f, 0x51, 0xfb,
-	0x03, 0xb5, 0xbd, 0x55, 0x28, 0xe7, 0x1b, 0x85, 0xad, 0x42, 0xb9, 0xd0, 0x28, 0x6e, 0x15, 0xca,
-	0xc5, 0x46, 0xa9, 0xf3, 0x1c, 0x2c, 0xcc, 0x5c, 0xc0, 0xa4, 0x6b, 0x01, 0x94, 0x4c, 0x29, 0xa4,
-	0x2f, 0x5a, 0x31, 0xd6, 0xc9, 0xdf, 0x04, 0x37, 0x62, 0x8c, 0x68, 0xfa, 0x66, 0xfc, 0xb3, 0x17,
-	0xbb, 0xa6, 0x88, 0xfa, 0x30, 0xb5, 0xd3, 0xf9, 0xd1, 0x02, 0x0b, 0xeb, 0x88, 0xf9, 0x98, 0xce,
-	0x06, 0xef, 0x4d, 0x00, 0xb2, 0xdf, 0x18, 0x12, 0xe8, 0x44, 0x55, 0x60, 0xc5, 0x58, 0x7a, 0x81,
-	0xdd, 0x02, 0x65, 0x12, 0x60, 0x26, 0x89, 0x3c, 0x30, 0x65, 0x3a, 0x59, 0x4f, 0x77, 0x46, 0xf1,
-	0x35, 0x3a, 0x63, 0x51, 0x55, 0x19, 0x12, 0x9c, 0xe9, 0x90, 0x56, 0xa0, 0x59, 0x75, 0x7e, 0xb6,
-	0x40, 0x73, 0x1b, 0xc7, 0xea, 0xf7, 0x45, 0xe2, 0xff, 0x90, 0xe3, 0x6b, 0xc1, 0xe1, 0x91, 0x93,
-	0x7b, 0x79, 0xe4, 0xe4, 0x5e, 0x1d, 0x39, 0xd6, 0xd7, 0x63, 0xc7, 0xfa, 0x7e, 0xec, 0x58, 0xbf,
-	0x8e, 0x1d, 0xeb, 0x70, 0xec, 0x58, 0xbf, 0x8f, 0x1d, 0xeb, 0x8f, 0xb1, 0x93, 0x7b, 0x35, 0x76,
-	0xac, 0x17, 0xc7, 0x4e, 0xee, 0xf0, 0xd8, 0xc9, 0xbd, 0x3c, 0x76, 0x72, 0x5f, 0xb8, 0x23, 0x7e,
-	0xe2, 0x05, 0xe1, 0x17, 0xfc, 0x86, 0x3f, 0xc8, 0xbe, 0x77, 0x4b, 0x3a, 0xfb, 0xf7, 0xfe, 0x0a,
-	0x00, 0x00, 0xff, 0xff, 0x24, 0x92, 0x56, 0x72, 0xb9, 0x0b, 0x00, 0x00,
+	// 1437 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xda, 0x4e, 0x62, 0x3f, 0x27, 0x69, 0x32, 0xf9, 0xc0, 0x04, 0x70, 0x52, 0x8b, 0x96,
+	0x20, 0x60, 0x4d, 0x1a, 0x84, 0xa0, 0x85, 0x4a, 0x4d, 0xd3, 0x8f, 0x54, 0x05, 0xc2, 0x24, 0x50,
+	0xc4, 0x65, 0x99, 0xec, 0x8e, 0xdd, 0x55, 0xc7, 0x33, 0x66, 0x67, 0x36, 0x6d, 0x6e, 0x70, 0xe5,
+	0xd4, 0x23, 0x7f, 0x02, 0x57, 0x84, 0xc4, 0x15, 0xc4, 0x89, 0x1b, 0x3d, 0x41, 0x6f, 0xd0, 0xf4,
+	0x82, 0xc4, 0xa5, 0x47, 0x8e, 0x68, 0x66, 0x67, 0xd7, 0x5f, 0x09, 0x09, 0xb4, 0x12, 0xe2, 0xb6,
+	0xf3, 0xde, 0xfb, 0xbd, 0x37, 0xf3, 0x7b, 0xef, 0xcd, 0x1b, 0x1b, 0x5e, 0x51, 0xb4, 0xdd, 0x11,
+	0x11, 0x61, 0x0d, 0x49, 0xa3, 0x5d, 0x1a, 0x35, 0x48, 0x27, 0x6c, 0x48, 0xff, 0x26, 0x0d, 0x62,
+	0x46, 0x1b, 0xbb, 0x2b, 0x8d, 0x36, 0x95, 0x92, 0xb4, 0xa8, 0xdb, 0x89, 0x84, 0x12, 0x68, 0x31,
+	0x35, 0x77, 0x13, 0x73, 0x97, 0x74, 0x42, 0x37, 0x35, 0x77, 0x77, 0x57, 0x16, 0x9e, 0xcf, 0xfc,
+	0x69, 0x47, 0xbe, 0x68, 0xb7, 0x05, 0x1f, 0x72, 0xb3, 0x70, 0xaa, 0xcf, 0xaa, 0x49, 0x42, 0x16,
+	0x47, 0xc3, 0xd1, 0x06, 0x9c, 0x51, 0x1e, 0xb7, 0xa5, 0x36, 0xca, 0xe2, 0xfd, 0xad, 0xd5, 0x6d,
+	0x11, 0xdd, 0x6a, 0x32, 0x71, 0xdb, 0x5a, 0x9d, 0xee, 0xb3, 0x3a, 0xf4, 0x84, 0x0b, 0xaf, 0xf7,
+	0xd9, 0xa5, 0x4e, 0xf4, 0x69, 0x43, 0xdf, 0x98, 0x47, 0xf4, 0xd3, 0x98, 0x4a, 0xe5, 0x45, 0x54,
+	0x76, 0x04, 0x97, 0x29, 0xee, 0xa5, 0x83, 0x88, 0x3c, 0x6c, 0xcb, 0xb5, 0x96, 0x10, 0x2d, 0x46,
+	0x1b, 0x66, 0xb5, 0x13, 0x37, 0x1b, 0x41, 0x1c, 0x11, 0x15, 0x0a, 0x6e, 0xf5, 0x8b, 0x83, 0x7a,
+	0x15, 0xb6, 0xa9, 0x54, 0xa4, 0xdd, 0xb1, 0x06, 0x27, 0x03, 0xda, 0xa1, 0x3c, 0xa0, 0xdc, 0x0f,
+	0xa9, 0x6c, 0xb4, 0x44, 0x4b, 0x18, 0xb9, 0xf9, 0x4a, 0x4c, 0xea, 0xbf, 0xe4, 0x61, 0x62, 0x2d,
+	0x6e, 0x36, 0x69, 0x44, 0x83, 0x2d, 0x45, 0x22, 0x85, 0x2e, 0xc2, 0x38, 0x17, 0xed, 0x90, 0x13,
+	0xe6, 0x69, 0x7f, 0x55, 0x67, 0xc9, 0x59, 0xae, 0x9c, 0x59, 0x70, 0x93, 0x60, 0x6e, 0x1a, 0xcc,
+	0xdd, 0x4e, 0x83, 0xad, 0x15, 0xef, 0xfe, 0xba, 0xe8, 0xe0, 0x8a, 0x45, 0x69, 0x39, 0xba, 0x00,
+	0x15, 0xe2, 0xab, 0x38, 0xf5, 0x91, 0x3f, 0xa6, 0x0f, 0x48, 0x40, 0xc6, 0xc5, 0x16, 0x4c, 0x8a,
+	0x5d, 0x1a, 0x31, 0xd2, 0xf1, 0x3a, 0x82, 0x85, 0xfe, 0x5e, 0xb5, 0xb0, 0xe4, 0x2c, 0x4f, 0x9e,
+	0x79, 0xd9, 0xcd, 0xaa, 0x4b, 0x97, 0x95, 0x21, 0xcf, 0xdd, 0x5d, 0x71, 0xb7, 0x2c, 0x79, 0xef,
+	0x25, 0xa0, 0x4d, 0x83, 0xc1, 0x13, 0xa2, 0x77, 0x89, 0xe6, 0x61, 0xb4, 0x4d, 0x78, 0x4c, 0x58,
+	0xb5, 0xb8, 0xe4, 0x2c, 0x97, 0xb0, 0x5d, 0xa1, 0xf7, 0x61, 0x26, 0xe3, 0x6a, 0xcf, 0x0b, 0x28,
+	0x09, 0x58, 0xc8, 0x69, 0x75, 0xe4, 0x98, 0xfb, 0x46, 0x5d, 0xf0, 0xba, 0xc5, 0xd6, 0x7f, 0x76,
+	0x00, 0xa5, 0x7b, 0x5a, 0xcf, 0xd4, 0x68, 0x11, 0x2a, 0x69, 0x9a, 0xbd, 0x30, 0x30, 0xec, 0x96,
+	0x31, 0xa4, 0xa2, 0x8d, 0x00, 0xbd, 0x09, 0x63, 0x9a, 0x33, 0x11, 0x2b, 0x4b, 0xdb, 0xd3, 0x43,
+	0xe1, 0xd7, 0x6d, 0x1d, 0xac, 0x15, 0xbf, 0xd4, 0xd1, 0x53, 0x7b, 0x14, 0xc0, 0xa4, 0xfd, 0xec,
+	0xa7, 0xec, 0x6d, 0xf7, 0xa0, 0x86, 0x1c, 0x62, 0xae, 0xbb, 0xcb, 0xed, 0xc4, 0x4b, 0xca, 0xa1,
+	0xea, 0x5d, 0xd6, 0xbf, 0x77, 0x60, 0xaa, 0x6b, 0x8a, 0xa9, 0x8c, 0x99, 0x3a, 0xfa, 0x58, 0x83,
+	0x65, 0x95, 0xff, 0x37, 0x65, 0x75, 0x19, 0x46, 0xa5, 0x22, 0x2a, 0x96, 0xf6, 0x60, 0xee, 0x21,
+	0xb5, 0x70, 0xc3, 0x36, 0xe4, 0xa5, 0x3b, 0xd4, 0x8f, 0x35, 0x53, 0x5b, 0x06, 0x85, 0x2d, 0xba,
+	0xfe, 0xc3, 0x18, 0x4c, 0x6c, 0x70, 0x45, 0x23, 0x4e, 0x98, 0x56, 0x51, 0xf4, 0x2c, 0x94, 0x39,
+	0x69, 0x53, 0xd9, 0x21, 0x3e, 0xb5, 0xbb, 0xef, 0x0a, 0xd0, 0x49, 0x18, 0xcf, 0x16, 0xfa, 0x78,
+	0x79, 0x63, 0x50, 0xc9, 0x64, 0x1b, 0xc1, 0x20, 0x01, 0xa5, 0x21, 0x02, 0x36, 0x61, 0x86, 0x11,
+	0xa9, 0xbc, 0x4e, 0x24, 0x7c, 0x2a, 0x25, 0x0d, 0x12, 0x1e, 0x0a, 0xc7, 0xe4, 0x61, 0x5a, 0x83,
+	0x37, 0x53, 0xac, 0x61, 0xe3, 0x06, 0x9c, 0xd8, 0xb1, 0xad, 0xeb, 0x49, 0xdd, 0xbb, 0xb2, 0x5a,
+	0x5c, 0x2a, 0x2c, 0x57, 0xce, 0xb8, 0x07, 0xe6, 0xbb, 0xe7, 0x02, 0x76, 0xfb, 0x5a, 0x1e, 0x4f,
+	0xee, 0xf4, 0x2e, 0x25, 0xfa, 0x10, 0xe6, 0xcd, 0x56, 0x7d, 0xd1, 0xee, 0x30, 0xaa, 0xf9, 0xd3,
+	0xf7, 0x58, 0xcc, 0x94, 0x6d, 0x88, 0xa5, 0x7e, 0xda, 0x93, 0xfb, 0x5b, 0xbb, 0xdd, 0x24, 0x7b,
+	0x4c, 0x90, 0x40, 0xe2, 0x59, 0x8d, 0xbf, 0x98, 0xc1, 0x6d, 0x91, 0xbc, 0x03, 0xd3, 0xbe, 0xe0,
+	0x2a, 0xe4, 0x31, 0x0d, 0x3c, 0x7b, 0x9f, 0x57, 0x47, 0x0f, 0x72, 0x69, 0x95, 0xda, 0xe7, 0xe5,
+	0xe4, 0x13, 0x4f, 0x65, 0x50, 0x2b, 0x41, 0xa7, 0x60, 0xd2, 0x17, 0xbc, 0xc9, 0x42, 0x5f, 0x79,
+	0x4a, 0xdc, 0xa2, 0xbc, 0x3a, 0xb6, 0xe4, 0x2c, 0x17, 0xf0, 0x44, 0x2a, 0xdd, 0xd6, 0x42, 0x93,
+	0x3c, 0x4a, 0x03, 0x2f, 0xa2, 0xcd, 0x88, 0xca, 0x9b, 0xd5, 0xb2, 0xe9, 0xfc, 0x8a, 0x96, 0xe1,
+	0x44, 0x84, 0x5e, 0x83, 0xf9, 0xb4, 0x83, 0x95, 0xd7, 0x93, 0x46, 0x59, 0x85, 0xa5, 0xc2, 0x72,
+	0x19, 0xcf, 0x66, 0xda, 0xad, 0x2c, 0xa1, 0x12, 0x7d, 0xee, 0xc0, 0x5c, 0x14, 0x73, 0x1e, 0xf2,
+	0x96, 0xd7, 0x5b, 0xdb, 0xb2, 0x5a, 0x31, 0x69, 0xb8, 0x72, 0x64, 0x1a, 0xfa, 0x6a, 0xd0, 0xc5,
+	0x89, 0xaf, 0x77, 0xbb, 0x05, 0x2f, 0x2f, 0x71, 0x15, 0xed, 0xd9, 0x0a, 0x98, 0x89, 0x86, 0xf5,
+	0xe8, 0x13, 0xe8, 0xb9, 0x7b, 0x6c, 0x96, 0x64, 0x75, 0xdc, 0xc4, 0x5f, 0x39, 0x32, 0xfe, 0x60,
+	0x1b, 0xe3, 0xe9, 0x60, 0x40, 0x22, 0xd1, 0x2a, 0xcc, 0xf5, 0x45, 0x68, 0x85, 0x52, 0xe9, 0x5a,
+	0xa9, 0x4e, 0x18, 0x1e, 0x67, 0x7b, 0x11, 0xa9, 0x6e, 0x61, 0x07, 0xaa, 0x87, 0x9d, 0x06, 0x4d,
+	0x41, 0xe1, 0x16, 0xdd, 0xb3, 0x4d, 0xa6, 0x3f, 0xd1, 0xab, 0x30, 0xb2, 0x4b, 0x58, 0x7c, 0x8c,
+	0x4b, 0x01, 0x27, 0x86, 0x67, 0xf3, 0x6f, 0x38, 0xf5, 0x3f, 0xf3, 0x30, 0x6d, 0x0a, 0x36, 0xcd,
+	0xc9, 0x85, 0xa8, 0x25, 0xd1, 0x79, 0x28, 0xa5, 0x27, 0xb4, 0xa3, 0xab, 0xde, 0x5f, 0x5a, 0xbd,
+	0xe7, 0x4f, 0x91, 0x38, 0xc3, 0xa0, 0xb3, 0x50, 0x0c, 0x79, 0x53, 0xd8, 0xad, 0x9c, 0x3e, 0x1a,
+	0xbb, 0xc1, 0x9b, 0x02, 0x1b, 0x0c, 0xba, 0x0e, 0x13, 0x21, 0x0f, 0x55, 0x48, 0x98, 0xd7, 0x21,
+	0xca, 0xbf, 0x69, 0x9b, 0xfb, 0x85, 0xa3, 0x9d, 0x6c, 0x6a, 0x73, 0x3c, 0x6e, 0xd1, 0x66, 0x85,
+	0xd6, 0x61, 0x44, 0x5f, 0x57, 0xd4, 0x8c, 0xaa, 0xe3, 0x34, 0x75, 0x5f, 0x35, 0xe1, 0x04, 0x8c,
+	0xb6, 0x00, 0xba, 0x19, 0xb2, 0xfd, 0xbb, 0x7a, 0xa4, 0xab, 0xe1, 0x91, 0x80, 0x7b, 0xdc, 0xd4,
+	0x7f, 0x72, 0x60, 0xfa, 0x72, 0xcc, 0xd8, 0x07, 0x9d, 0x40, 0x87, 0x4a, 0xde, 0x3a, 0x8f, 0x4d,
+	0xfd, 0x70, 0x3f, 0xe7, 0x0f, 0xea, 0xe7, 0xfe, 0x13, 0x15, 0x9e, 0xcc, 0x89, 0xbe, 0xc8, 0xeb,
+	0xa1, 0x26, 0xfd, 0x28, 0xdc, 0xa1, 0xd8, 0xbe, 0xd9, 0xfe, 0xd3, 0x5a, 0x1a, 0x26, 0xa3, 0x70,
+	0x34, 0x19, 0xc5, 0x27, 0x43, 0xc6, 0xd7, 0x0e, 0xcc, 0xde, 0xd0, 0x35, 0x98, 0xce, 0xd1, 0x34,
+	0xc3, 0x57, 0xa0, 0x4c, 0xd3, 0x91, 0x6a, 0x99, 0x7f, 0xf1, 0xb0, 0x59, 0x30, 0x34, 0x83, 0x71,
+	0x17, 0x8b, 0x56, 0x61, 0xbe, 0x19, 0x46, 0x52, 0x79, 0x99, 0xc8, 0x8b, 0x62, 0xae, 0x07, 0x67,
+	0xd1, 0x5c, 0x0b, 0x33, 0x46, 0xdb, 0x85, 0xc6, 0x7c, 0x23, 0x40, 0xcf, 0x40, 0x99, 0x09, 0xde,
+	0xd2, 0x6f, 0x1b, 0x66, 0x2a, 0xb9, 0x84, 0x4b, 0x5a, 0xb0, 0x29, 0x18, 0xab, 0xff, 0xe1, 0xc0,
+	0xdc, 0xc0, 0x9e, 0x6d, 0x16, 0xbb, 0x8f, 0x06, 0xe7, 0x71, 0x1e, 0x0d, 0xe8, 0x2c, 0x8c, 0xda,
+	0x29, 0x98, 0x3f, 0xde, 0x14, 0xbc, 0x9a, 0xc3, 0x16, 0x81, 0xde, 0x82, 0xb1, 0x74, 0xde, 0x15,
+	0x8e, 0x37, 0xef, 0xae, 0xe6, 0x70, 0x0a, 0x59, 0x9b, 0x82, 0xc9, 0xc4, 0x4f, 0x3a, 0x34, 0xeb,
+	0xdf, 0x39, 0x30, 0x6b, 0xee, 0xbe, 0xc1, 0x0c, 0x7d, 0x04, 0x63, 0xf6, 0xa7, 0x87, 0xdd, 0xe5,
+	0xf9, 0xfe, 0x40, 0x03, 0x3f, 0x55, 0x4c, 0x31, 0xf4, 0xfa, 0xe9, 0x52, 0x9e, 0x78, 0xc1, 0xa9,
+	0x3b, 0x74, 0x0e, 0x16, 0xec, 0x7b, 0x40, 0xcf, 0x52, 0xa2, 0xa8, 0xc7, 0xc2, 0x76, 0xa8, 0x3c,
+	0xc9, 0x28, 0xed, 0x98, 0x29, 0x5e, 0xc2, 0x4f, 0x65, 0x16, 0x98, 0x28, 0x7a, 0x5d, 0xeb, 0xb7,
+	0xb4, 0xfa, 0x5a, 0xb1, 0x54, 0x98, 0x2a, 0x5e, 0x2b, 0x96, 0x8a, 0x53, 0x23, 0xd7, 0x8a, 0xa5,
+	0x91, 0xa9, 0xd1, 0xfa, 0x1d, 0x98, 0x1b, 0x38, 0x80, 0x4d, 0xd7, 0x1c, 0x8c, 0xda, 0x52, 0x48,
+	0x26, 0xc4, 0x48, 0x64, 0x92, 0x7f, 0x15, 0x4e, 0x44, 0x94, 0xb0, 0xe4, 0xa1, 0xf3, 0xcf, 0x9e,
+	0x90, 0x13, 0x1a, 0x68, 0x82, 0x69, 0x4d, 0xfd, 0x1b, 0x07, 0xe6, 0x2e, 0x12, 0xee, 0x53, 0x36,
+	0x48, 0xde, 0x73, 0x00, 0xe9, 0xef, 0xb6, 0x30, 0x30, 0x89, 0x2a, 0xe3, 0xb2, 0x95, 0x6c, 0x04,
+	0x68, 0x01, 0x4a, 0x61, 0x40, 0xb9, 0x0a, 0xd5, 0x9e, 0x2d, 0xd3, 0x6c, 0xdd, 0xdf, 0x19, 0x23,
+	0x8f, 0xd1, 0x19, 0xf3, 0xba, 0xca, 0x88, 0x14, 0xdc, 0x50, 0x5a, 0xc6, 0x76, 0x55, 0xff, 0xd6,
+	0x81, 0xea, 0x36, 0x8d, 0xf4, 0x30, 0x55, 0xf4, 0x7f, 0xb4, 0xf1, 0xb5, 0xe0, 0xde, 0x83, 0x5a,
+	0xee, 0xfe, 0x83, 0x5a, 0xee, 0xd1, 0x83, 0x9a, 0xf3, 0xd9, 0x7e, 0xcd, 0xf9, 0x6a, 0xbf, 0xe6,
+	0xfc, 0xb8, 0x5f, 0x73, 0xee, 0xed, 0xd7, 0x9c, 0xdf, 0xf6, 0x6b, 0xce, 0xef, 0xfb, 0xb5, 0xdc,
+	0xa3, 0xfd, 0x9a, 0x73, 0xf7, 0x61, 0x2d, 0x77, 0xef, 0x61, 0x2d, 0x77, 0xff, 0x61, 0x2d, 0xf7,
+	0xb1, 0xdb, 0x12, 0xdd, 0x5d, 0x84, 0xe2, 0x90, 0xff, 0x1d, 0xce, 0xa5, 0xdf, 0x3b, 0xa3, 0x26,
+	0xfb, 0xab, 0x7f, 0x0d, 0x00, 0x3e, 0x62, 0xc1, 0x89, 0xaa, 0x10, 0x00, 0x00,
 }
 
 func (this *BufferedStart) Equal(that interface{}) bool {
@@ -942,6 +1158,83 @@ func (this *BufferedStart) Equal(that interface{}) bool {
 	if this.Manual != that1.Manual {
 		return false
 	}
+	if that1.DependencyDeadline == nil {
+		if this.DependencyDeadline != nil {
+			return false
+		}
+	} else if !this.DependencyDeadline.Equal(*that1.DependencyDeadline) {
+		return false
+	}
+	return true
+}
+func (this *ScheduleDependency) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ScheduleDependency)
+	if !ok {
+		that2, ok := that.(ScheduleDependency)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ScheduleId != that1.ScheduleId {
+		return false
+	}
+	if this.Timeout != nil && that1.Timeout != nil {
+		if *this.Timeout != *that1.Timeout {
+			return false
+		}
+	} else if this.Timeout != nil {
+		return false
+	} else if that1.Timeout != nil {
+		return false
+	}
+	if this.TimeoutPolicy != that1.TimeoutPolicy {
+		return false
+	}
+	return true
+}
+func (this *DependencyResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DependencyResult)
+	if !ok {
+		that2, ok := that.(DependencyResult)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ScheduleId != that1.ScheduleId {
+		return false
+	}
+	if that1.NominalTime == nil {
+		if this.NominalTime != nil {
+			return false
+		}
+	} else if !this.NominalTime.Equal(*that1.NominalTime) {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
 	return true
 }
 func (this *InternalState) Equal(that interface{}) bool {
@@ -999,6 +1292,33 @@ func (this *InternalState) Equal(that interface{}) bool {
 	if this.NeedRefresh != that1.NeedRefresh {
 		return false
 	}
+	if len(this.DependentScheduleIds) != len(that1.DependentScheduleIds) {
+		return false
+	}
+	for i := range this.DependentScheduleIds {
+		if this.DependentScheduleIds[i] != that1.DependentScheduleIds[i] {
+			return false
+		}
+	}
+	if len(this.RunningNominalTimes) != len(that1.RunningNominalTimes) {
+		return false
+	}
+	for i := range this.RunningNominalTimes {
+		if !this.RunningNominalTimes[i].Equal(*that1.RunningNominalTimes[i]) {
+			return false
+		}
+	}
+	if len(this.DependencyResults) != len(that1.DependencyResults) {
+		return false
+	}
+	for i := range this.DependencyResults {
+		if !this.DependencyResults[i].Equal(that1.DependencyResults[i]) {
+			return false
+		}
+	}
+	if this.DependencyRegistered != that1.DependencyRegistered {
+		return false
+	}
 	return true
 }
 func (this *StartScheduleArgs) Equal(that interface{}) bool {
@@ -1032,6 +1352,9 @@ func (this *StartScheduleArgs) Equal(that interface{}) bool {
 	if !this.State.Equal(that1.State) {
 		return false
 	}
+	if !this.Dependency.Equal(that1.Dependency) {
+		return false
+	}
 	return true
 }
 func (this *FullUpdateRequest) Equal(that interface{}) bool {
@@ -1059,6 +1382,9 @@ func (this *FullUpdateRequest) Equal(that interface{}) bool {
 	if this.ConflictToken != that1.ConflictToken {
 		return false
 	}
+	if !this.Dependency.Equal(that1.Dependency) {
+		return false
+	}
 	return true
 }
 func (this *DescribeResponse) Equal(that interface{}) bool {
@@ -1089,6 +1415,9 @@ func (this *DescribeResponse) Equal(that interface{}) bool {
 	if this.ConflictToken != that1.ConflictToken {
 		return false
 	}
+	if !this.Dependency.Equal(that1.Dependency) {
+		return false
+	}
 	return true
 }
 func (this *WatchWorkflowRequest) Equal(that interface{}) bool {
@@ -1330,12 +1659,37 @@ func (this *BufferedStart) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 8)
+	s := make([]string, 0, 9)
 	s = append(s, "&schedule.BufferedStart{")
 	s = append(s, "NominalTime: "+fmt.Sprintf("%#v", this.NominalTime)+",\n")
 	s = append(s, "ActualTime: "+fmt.Sprintf("%#v", this.ActualTime)+",\n")
 	s = append(s, "OverlapPolicy: "+fmt.Sprintf("%#v", this.OverlapPolicy)+",\n")
 	s = append(s, "Manual: "+fmt.Sprintf("%#v", this.Manual)+",\n")
+	s = append(s, "DependencyDeadline: "+fmt.Sprintf("%#v", this.DependencyDeadline)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ScheduleDependency) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&schedule.ScheduleDependency{")
+	s = append(s, "ScheduleId: "+fmt.Sprintf("%#v", this.ScheduleId)+",\n")
+	s = append(s, "Timeout: "+fmt.Sprintf("%#v", this.Timeout)+",\n")
+	s = append(s, "TimeoutPolicy: "+fmt.Sprintf("%#v", this.TimeoutPolicy)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DependencyResult) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&schedule.DependencyResult{")
+	s = append(s, "ScheduleId: "+fmt.Sprintf("%#v", this.ScheduleId)+",\n")
+	s = append(s, "NominalTime: "+fmt.Sprintf("%#v", this.NominalTime)+",\n")
+	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1343,7 +1697,7 @@ func (this *InternalState) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 13)
+	s := make([]string, 0, 17)
 	s = append(s, "&schedule.InternalState{")
 	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
@@ -1360,6 +1714,24 @@ func (this *InternalState) GoString() string {
 	}
 	s = append(s, "ConflictToken: "+fmt.Sprintf("%#v", this.ConflictToken)+",\n")
 	s = append(s, "NeedRefresh: "+fmt.Sprintf("%#v", this.NeedRefresh)+",\n")
+	s = append(s, "DependentScheduleIds: "+fmt.Sprintf("%#v", this.DependentScheduleIds)+",\n")
+	keysForRunningNominalTimes := make([]string, 0, len(this.RunningNominalTimes))
+	for k, _ := range this.RunningNominalTimes {
+		keysForRunningNominalTimes = append(keysForRunningNominalTimes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForRunningNominalTimes)
+	mapStringForRunningNominalTimes := "map[string]*time.Time{"
+	for _, k := range keysForRunningNominalTimes {
+		mapStringForRunningNominalTimes += fmt.Sprintf("%#v: %#v,", k, this.RunningNominalTimes[k])
+	}
+	mapStringForRunningNominalTimes += "}"
+	if this.RunningNominalTimes != nil {
+		s = append(s, "RunningNominalTimes: "+mapStringForRunningNominalTimes+",\n")
+	}
+	if this.DependencyResults != nil {
+		s = append(s, "DependencyResults: "+fmt.Sprintf("%#v", this.DependencyResults)+",\n")
+	}
+	s = append(s, "DependencyRegistered: "+fmt.Sprintf("%#v", this.DependencyRegistered)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1367,7 +1739,7 @@ func (this *StartScheduleArgs) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 8)
+	s := make([]string, 0, 9)
 	s = append(s, "&schedule.StartScheduleArgs{")
 	if this.Schedule != nil {
 		s = append(s, "Schedule: "+fmt.Sprintf("%#v", this.Schedule)+",\n")
@@ -1381,6 +1753,9 @@ func (this *StartScheduleArgs) GoString() string {
 	if this.State != nil {
 		s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
 	}
+	if this.Dependency != nil {
+		s = append(s, "Dependency: "+fmt.Sprintf("%#v", this.Dependency)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1388,12 +1763,15 @@ func (this *FullUpdateRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 7)
 	s = append(s, "&schedule.FullUpdateRequest{")
 	if this.Schedule != nil {
 		s = append(s, "Schedule: "+fmt.Sprintf("%#v", this.Schedule)+",\n")
 	}
 	s = append(s, "ConflictToken: "+fmt.Sprintf("%#v", this.ConflictToken)+",\n")
+	if this.Dependency != nil {
+		s = append(s, "Dependency: "+fmt.Sprintf("%#v", this.Dependency)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1401,7 +1779,7 @@ func (this *DescribeResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
+	s := make([]string, 0, 8)
 	s = append(s, "&schedule.DescribeResponse{")
 	if this.Schedule != nil {
 		s = append(s, "Schedule: "+fmt.Sprintf("%#v", this.Schedule)+",\n")
@@ -1410,6 +1788,9 @@ func (this *DescribeResponse) GoString() string {
 		s = append(s, "Info: "+fmt.Sprintf("%#v", this.Info)+",\n")
 	}
 	s = append(s, "ConflictToken: "+fmt.Sprintf("%#v", this.ConflictToken)+",\n")
+	if this.Dependency != nil {
+		s = append(s, "Dependency: "+fmt.Sprintf("%#v", this.Dependency)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1538,10 +1919,20 @@ func (m *BufferedStart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.Manual {
-		i--
-		if m.Manual {
-			dAtA[i] = 1
+	if m.DependencyDeadline != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.DependencyDeadline, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.DependencyDeadline):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintMessage(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Manual {
+		i--
+		if m.Manual {
+			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
@@ -1554,22 +1945,112 @@ func (m *BufferedStart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x18
 	}
 	if m.ActualTime != nil {
-		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ActualTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ActualTime):])
-		if err1 != nil {
-			return 0, err1
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ActualTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ActualTime):])
+		if err2 != nil {
+			return 0, err2
 		}
-		i -= n1
-		i = encodeVarintMessage(dAtA, i, uint64(n1))
+		i -= n2
+		i = encodeVarintMessage(dAtA, i, uint64(n2))
 		i--
 		dAtA[i] = 0x12
 	}
 	if m.NominalTime != nil {
-		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.NominalTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.NominalTime):])
-		if err2 != nil {
-			return 0, err2
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.NominalTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.NominalTime):])
+		if err3 != nil {
+			return 0, err3
 		}
-		i -= n2
-		i = encodeVarintMessage(dAtA, i, uint64(n2))
+		i -= n3
+		i = encodeVarintMessage(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleDependency) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleDependency) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleDependency) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutPolicy != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.TimeoutPolicy))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Timeout != nil {
+		n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintMessage(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScheduleId) > 0 {
+		i -= len(m.ScheduleId)
+		copy(dAtA[i:], m.ScheduleId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.ScheduleId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DependencyResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DependencyResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DependencyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NominalTime != nil {
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.NominalTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.NominalTime):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintMessage(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScheduleId) > 0 {
+		i -= len(m.ScheduleId)
+		copy(dAtA[i:], m.ScheduleId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.ScheduleId)))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -1596,6 +2077,63 @@ func (m *InternalState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DependencyRegistered {
+		i--
+		if m.DependencyRegistered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.DependencyResults) > 0 {
+		for iNdEx := len(m.DependencyResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DependencyResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.RunningNominalTimes) > 0 {
+		for k := range m.RunningNominalTimes {
+			v := m.RunningNominalTimes[k]
+			baseI := i
+			if v != nil {
+				n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo((*v), dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime((*v)):])
+				if err6 != nil {
+					return 0, err6
+				}
+				i -= n6
+				i = encodeVarintMessage(dAtA, i, uint64(n6))
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintMessage(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintMessage(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.DependentScheduleIds) > 0 {
+		for iNdEx := len(m.DependentScheduleIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DependentScheduleIds[iNdEx])
+			copy(dAtA[i:], m.DependentScheduleIds[iNdEx])
+			i = encodeVarintMessage(dAtA, i, uint64(len(m.DependentScheduleIds[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if m.NeedRefresh {
 		i--
 		if m.NeedRefresh {
@@ -1657,12 +2195,12 @@ func (m *InternalState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if m.LastProcessedTime != nil {
-		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastProcessedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastProcessedTime):])
-		if err5 != nil {
-			return 0, err5
+		n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastProcessedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastProcessedTime):])
+		if err9 != nil {
+			return 0, err9
 		}
-		i -= n5
-		i = encodeVarintMessage(dAtA, i, uint64(n5))
+		i -= n9
+		i = encodeVarintMessage(dAtA, i, uint64(n9))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -1703,6 +2241,18 @@ func (m *StartScheduleArgs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Dependency != nil {
+		{
+			size, err := m.Dependency.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.State != nil {
 		{
 			size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
@@ -1774,6 +2324,18 @@ func (m *FullUpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Dependency != nil {
+		{
+			size, err := m.Dependency.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.ConflictToken != 0 {
 		i = encodeVarintMessage(dAtA, i, uint64(m.ConflictToken))
 		i--
@@ -1814,6 +2376,18 @@ func (m *DescribeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Dependency != nil {
+		{
+			size, err := m.Dependency.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.ConflictToken != 0 {
 		i = encodeVarintMessage(dAtA, i, uint64(m.ConflictToken))
 		i--
@@ -2043,12 +2617,12 @@ func (m *StartWorkflowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.RealStartTime != nil {
-		n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.RealStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.RealStartTime):])
-		if err17 != nil {
-			return 0, err17
+		n24, err24 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.RealStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.RealStartTime):])
+		if err24 != nil {
+			return 0, err24
 		}
-		i -= n17
-		i = encodeVarintMessage(dAtA, i, uint64(n17))
+		i -= n24
+		i = encodeVarintMessage(dAtA, i, uint64(n24))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -2205,6 +2779,50 @@ func (m *BufferedStart) Size() (n int) {
 	if m.Manual {
 		n += 2
 	}
+	if m.DependencyDeadline != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.DependencyDeadline)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduleDependency) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScheduleId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Timeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.TimeoutPolicy != 0 {
+		n += 1 + sovMessage(uint64(m.TimeoutPolicy))
+	}
+	return n
+}
+
+func (m *DependencyResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScheduleId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.NominalTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.NominalTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovMessage(uint64(m.Status))
+	}
 	return n
 }
 
@@ -2250,6 +2868,34 @@ func (m *InternalState) Size() (n int) {
 	if m.NeedRefresh {
 		n += 2
 	}
+	if len(m.DependentScheduleIds) > 0 {
+		for _, s := range m.DependentScheduleIds {
+			l = len(s)
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if len(m.RunningNominalTimes) > 0 {
+		for k, v := range m.RunningNominalTimes {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = github_com_gogo_protobuf_types.SizeOfStdTime(*v)
+				l += 1 + sovMessage(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovMessage(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovMessage(uint64(mapEntrySize))
+		}
+	}
+	if len(m.DependencyResults) > 0 {
+		for _, e := range m.DependencyResults {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if m.DependencyRegistered {
+		n += 2
+	}
 	return n
 }
 
@@ -2275,6 +2921,10 @@ func (m *StartScheduleArgs) Size() (n int) {
 		l = m.State.Size()
 		n += 1 + l + sovMessage(uint64(l))
 	}
+	if m.Dependency != nil {
+		l = m.Dependency.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
 	return n
 }
 
@@ -2291,6 +2941,10 @@ func (m *FullUpdateRequest) Size() (n int) {
 	if m.ConflictToken != 0 {
 		n += 1 + sovMessage(uint64(m.ConflictToken))
 	}
+	if m.Dependency != nil {
+		l = m.Dependency.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
 	return n
 }
 
@@ -2311,6 +2965,10 @@ func (m *DescribeResponse) Size() (n int) {
 	if m.ConflictToken != 0 {
 		n += 1 + sovMessage(uint64(m.ConflictToken))
 	}
+	if m.Dependency != nil {
+		l = m.Dependency.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
 	return n
 }
 
@@ -2471,6 +3129,31 @@ func (this *BufferedStart) String() string {
 		`ActualTime:` + strings.Replace(fmt.Sprintf("%v", this.ActualTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`OverlapPolicy:` + fmt.Sprintf("%v", this.OverlapPolicy) + `,`,
 		`Manual:` + fmt.Sprintf("%v", this.Manual) + `,`,
+		`DependencyDeadline:` + strings.Replace(fmt.Sprintf("%v", this.DependencyDeadline), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScheduleDependency) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScheduleDependency{`,
+		`ScheduleId:` + fmt.Sprintf("%v", this.ScheduleId) + `,`,
+		`Timeout:` + strings.Replace(fmt.Sprintf("%v", this.Timeout), "Duration", "types.Duration", 1) + `,`,
+		`TimeoutPolicy:` + fmt.Sprintf("%v", this.TimeoutPolicy) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DependencyResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DependencyResult{`,
+		`ScheduleId:` + fmt.Sprintf("%v", this.ScheduleId) + `,`,
+		`NominalTime:` + strings.Replace(fmt.Sprintf("%v", this.NominalTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2484,16 +3167,35 @@ func (this *InternalState) String() string {
 		repeatedStringForBufferedStarts += strings.Replace(f.String(), "BufferedStart", "BufferedStart", 1) + ","
 	}
 	repeatedStringForBufferedStarts += "}"
+	repeatedStringForDependencyResults := "[]*DependencyResult{"
+	for _, f := range this.DependencyResults {
+		repeatedStringForDependencyResults += strings.Replace(f.String(), "DependencyResult", "DependencyResult", 1) + ","
+	}
+	repeatedStringForDependencyResults += "}"
+	keysForRunningNominalTimes := make([]string, 0, len(this.RunningNominalTimes))
+	for k, _ := range this.RunningNominalTimes {
+		keysForRunningNominalTimes = append(keysForRunningNominalTimes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForRunningNominalTimes)
+	mapStringForRunningNominalTimes := "map[string]*time.Time{"
+	for _, k := range keysForRunningNominalTimes {
+		mapStringForRunningNominalTimes += fmt.Sprintf("%v: %v,", k, this.RunningNominalTimes[k])
+	}
+	mapStringForRunningNominalTimes += "}"
 	s := strings.Join([]string{`&InternalState{`,
 		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
 		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
 		`LastProcessedTime:` + strings.Replace(fmt.Sprintf("%v", this.LastProcessedTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`BufferedStarts:` + repeatedStringForBufferedStarts + `,`,
-		`LastCompletionResult:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionResult), "Payloads", "v11.Payloads", 1) + `,`,
-		`ContinuedFailure:` + strings.Replace(fmt.Sprintf("%v", this.ContinuedFailure), "Failure", "v12.Failure", 1) + `,`,
+		`LastCompletionResult:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionResult), "Payloads", "v12.Payloads", 1) + `,`,
+		`ContinuedFailure:` + strings.Replace(fmt.Sprintf("%v", this.ContinuedFailure), "Failure", "v13.Failure", 1) + `,`,
 		`ConflictToken:` + fmt.Sprintf("%v", this.ConflictToken) + `,`,
 		`ScheduleId:` + fmt.Sprintf("%v", this.ScheduleId) + `,`,
 		`NeedRefresh:` + fmt.Sprintf("%v", this.NeedRefresh) + `,`,
+		`DependentScheduleIds:` + fmt.Sprintf("%v", this.DependentScheduleIds) + `,`,
+		`RunningNominalTimes:` + mapStringForRunningNominalTimes + `,`,
+		`DependencyResults:` + repeatedStringForDependencyResults + `,`,
+		`DependencyRegistered:` + fmt.Sprintf("%v", this.DependencyRegistered) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2503,10 +3205,11 @@ func (this *StartScheduleArgs) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&StartScheduleArgs{`,
-		`Schedule:` + strings.Replace(fmt.Sprintf("%v", this.Schedule), "Schedule", "v13.Schedule", 1) + `,`,
-		`Info:` + strings.Replace(fmt.Sprintf("%v", this.Info), "ScheduleInfo", "v13.ScheduleInfo", 1) + `,`,
-		`InitialPatch:` + strings.Replace(fmt.Sprintf("%v", this.InitialPatch), "SchedulePatch", "v13.SchedulePatch", 1) + `,`,
+		`Schedule:` + strings.Replace(fmt.Sprintf("%v", this.Schedule), "Schedule", "v14.Schedule", 1) + `,`,
+		`Info:` + strings.Replace(fmt.Sprintf("%v", this.Info), "ScheduleInfo", "v14.ScheduleInfo", 1) + `,`,
+		`InitialPatch:` + strings.Replace(fmt.Sprintf("%v", this.InitialPatch), "SchedulePatch", "v14.SchedulePatch", 1) + `,`,
 		`State:` + strings.Replace(this.State.String(), "InternalState", "InternalState", 1) + `,`,
+		`Dependency:` + strings.Replace(this.Dependency.String(), "ScheduleDependency", "ScheduleDependency", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2516,8 +3219,9 @@ func (this *FullUpdateRequest) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&FullUpdateRequest{`,
-		`Schedule:` + strings.Replace(fmt.Sprintf("%v", this.Schedule), "Schedule", "v13.Schedule", 1) + `,`,
+		`Schedule:` + strings.Replace(fmt.Sprintf("%v", this.Schedule), "Schedule", "v14.Schedule", 1) + `,`,
 		`ConflictToken:` + fmt.Sprintf("%v", this.ConflictToken) + `,`,
+		`Dependency:` + strings.Replace(this.Dependency.String(), "ScheduleDependency", "ScheduleDependency", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2527,9 +3231,10 @@ func (this *DescribeResponse) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&DescribeResponse{`,
-		`Schedule:` + strings.Replace(fmt.Sprintf("%v", this.Schedule), "Schedule", "v13.Schedule", 1) + `,`,
-		`Info:` + strings.Replace(fmt.Sprintf("%v", this.Info), "ScheduleInfo", "v13.ScheduleInfo", 1) + `,`,
+		`Schedule:` + strings.Replace(fmt.Sprintf("%v", this.Schedule), "Schedule", "v14.Schedule", 1) + `,`,
+		`Info:` + strings.Replace(fmt.Sprintf("%v", this.Info), "ScheduleInfo", "v14.ScheduleInfo", 1) + `,`,
 		`ConflictToken:` + fmt.Sprintf("%v", this.ConflictToken) + `,`,
+		`Dependency:` + strings.Replace(this.Dependency.String(), "ScheduleDependency", "ScheduleDependency", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2539,7 +3244,7 @@ func (this *WatchWorkflowRequest) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&WatchWorkflowRequest{`,
-		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v11.WorkflowExecution", 1) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v12.WorkflowExecution", 1) + `,`,
 		`FirstExecutionRunId:` + fmt.Sprintf("%v", this.FirstExecutionRunId) + `,`,
 		`LongPoll:` + fmt.Sprintf("%v", this.LongPoll) + `,`,
 		`}`,
@@ -2562,7 +3267,7 @@ func (this *WatchWorkflowResponse_Result) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&WatchWorkflowResponse_Result{`,
-		`Result:` + strings.Replace(fmt.Sprintf("%v", this.Result), "Payloads", "v11.Payloads", 1) + `,`,
+		`Result:` + strings.Replace(fmt.Sprintf("%v", this.Result), "Payloads", "v12.Payloads", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2572,7 +3277,7 @@ func (this *WatchWorkflowResponse_Failure) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&WatchWorkflowResponse_Failure{`,
-		`Failure:` + strings.Replace(fmt.Sprintf("%v", this.Failure), "Failure", "v12.Failure", 1) + `,`,
+		`Failure:` + strings.Replace(fmt.Sprintf("%v", this.Failure), "Failure", "v13.Failure", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2582,7 +3287,7 @@ func (this *StartWorkflowRequest) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&StartWorkflowRequest{`,
-		`Request:` + strings.Replace(fmt.Sprintf("%v", this.Request), "StartWorkflowExecutionRequest", "v14.StartWorkflowExecutionRequest", 1) + `,`,
+		`Request:` + strings.Replace(fmt.Sprintf("%v", this.Request), "StartWorkflowExecutionRequest", "v15.StartWorkflowExecutionRequest", 1) + `,`,
 		`CompletedRateLimitSleep:` + fmt.Sprintf("%v", this.CompletedRateLimitSleep) + `,`,
 		`}`,
 	}, "")
@@ -2606,7 +3311,7 @@ func (this *CancelWorkflowRequest) String() string {
 	s := strings.Join([]string{`&CancelWorkflowRequest{`,
 		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
 		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
-		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v11.WorkflowExecution", 1) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v12.WorkflowExecution", 1) + `,`,
 		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
 		`}`,
 	}, "")
@@ -2619,7 +3324,7 @@ func (this *TerminateWorkflowRequest) String() string {
 	s := strings.Join([]string{`&TerminateWorkflowRequest{`,
 		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
 		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
-		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v11.WorkflowExecution", 1) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v12.WorkflowExecution", 1) + `,`,
 		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
 		`}`,
 	}, "")
@@ -2773,13 +3478,329 @@ func (m *BufferedStart) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Manual = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMessage(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependencyDeadline", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DependencyDeadline == nil {
+				m.DependencyDeadline = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.DependencyDeadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleDependency) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleDependency: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleDependency: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timeout == nil {
+				m.Timeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutPolicy", wireType)
+			}
+			m.TimeoutPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutPolicy |= v11.ScheduleDependencyTimeoutPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DependencyResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DependencyResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DependencyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NominalTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NominalTime == nil {
+				m.NominalTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.NominalTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= v1.WorkflowExecutionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthMessage
 			}
 			if (iNdEx + skippy) < 0 {
@@ -2990,7 +4011,7 @@ func (m *InternalState) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.LastCompletionResult == nil {
-				m.LastCompletionResult = &v11.Payloads{}
+				m.LastCompletionResult = &v12.Payloads{}
 			}
 			if err := m.LastCompletionResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -3026,7 +4047,7 @@ func (m *InternalState) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ContinuedFailure == nil {
-				m.ContinuedFailure = &v12.Failure{}
+				m.ContinuedFailure = &v13.Failure{}
 			}
 			if err := m.ContinuedFailure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -3103,6 +4124,220 @@ func (m *InternalState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.NeedRefresh = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependentScheduleIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DependentScheduleIds = append(m.DependentScheduleIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunningNominalTimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RunningNominalTimes == nil {
+				m.RunningNominalTimes = make(map[string]*time.Time)
+			}
+			var mapkey string
+			mapvalue := new(time.Time)
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthMessage
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(mapvalue, dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipMessage(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.RunningNominalTimes[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependencyResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DependencyResults = append(m.DependencyResults, &DependencyResult{})
+			if err := m.DependencyResults[len(m.DependencyResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependencyRegistered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DependencyRegistered = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -3186,7 +4421,7 @@ func (m *StartScheduleArgs) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Schedule == nil {
-				m.Schedule = &v13.Schedule{}
+				m.Schedule = &v14.Schedule{}
 			}
 			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -3222,7 +4457,7 @@ func (m *StartScheduleArgs) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Info == nil {
-				m.Info = &v13.ScheduleInfo{}
+				m.Info = &v14.ScheduleInfo{}
 			}
 			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -3258,7 +4493,7 @@ func (m *StartScheduleArgs) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.InitialPatch == nil {
-				m.InitialPatch = &v13.SchedulePatch{}
+				m.InitialPatch = &v14.SchedulePatch{}
 			}
 			if err := m.InitialPatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -3300,6 +4535,42 @@ func (m *StartScheduleArgs) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependency", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Dependency == nil {
+				m.Dependency = &ScheduleDependency{}
+			}
+			if err := m.Dependency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -3383,7 +4654,7 @@ func (m *FullUpdateRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Schedule == nil {
-				m.Schedule = &v13.Schedule{}
+				m.Schedule = &v14.Schedule{}
 			}
 			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -3408,6 +4679,42 @@ func (m *FullUpdateRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependency", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Dependency == nil {
+				m.Dependency = &ScheduleDependency{}
+			}
+			if err := m.Dependency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -3491,7 +4798,7 @@ func (m *DescribeResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Schedule == nil {
-				m.Schedule = &v13.Schedule{}
+				m.Schedule = &v14.Schedule{}
 			}
 			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -3527,7 +4834,7 @@ func (m *DescribeResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Info == nil {
-				m.Info = &v13.ScheduleInfo{}
+				m.Info = &v14.ScheduleInfo{}
 			}
 			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -3552,6 +4859,42 @@ func (m *DescribeResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependency", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Dependency == nil {
+				m.Dependency = &ScheduleDependency{}
+			}
+			if err := m.Dependency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -3635,7 +4978,7 @@ func (m *WatchWorkflowRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Execution == nil {
-				m.Execution = &v11.WorkflowExecution{}
+				m.Execution = &v12.WorkflowExecution{}
 			}
 			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -3794,7 +5137,7 @@ func (m *WatchWorkflowResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &v11.Payloads{}
+			v := &v12.Payloads{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -3829,7 +5172,7 @@ func (m *WatchWorkflowResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &v12.Failure{}
+			v := &v13.Failure{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -3918,7 +5261,7 @@ func (m *StartWorkflowRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &v14.StartWorkflowExecutionRequest{}
+				m.Request = &v15.StartWorkflowExecutionRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -4212,7 +5555,7 @@ func (m *CancelWorkflowRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Execution == nil {
-				m.Execution = &v11.WorkflowExecution{}
+				m.Execution = &v12.WorkflowExecution{}
 			}
 			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -4397,7 +5740,7 @@ func (m *TerminateWorkflowRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Execution == nil {
-				m.Execution = &v11.WorkflowExecution{}
+				m.Execution = &v12.WorkflowExecution{}
 			}
 			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -34,8 +34,12 @@ import (
 	math_bits "math/bits"
 	reflect "reflect"
 	strings "strings"
+	time "time"
 
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	v1 "go.temporal.io/api/common/v1"
 	v11 "go.temporal.io/server/api/clock/v1"
 )
@@ -44,6 +48,7 @@ import (
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -134,8 +139,271 @@ func (m *ParentExecutionInfo) GetInitiatedVersion() int64 {
 	return 0
 }
 
+// Picks the event to reset a workflow execution to from its history.
+type ResetPointSelector struct {
+	// Types that are valid to be assigned to Selector:
+	//	*ResetPointSelector_BuildId
+	//	*ResetPointSelector_BinaryChecksum
+	//	*ResetPointSelector_FailedActivityType
+	Selector isResetPointSelector_Selector `protobuf_oneof:"selector"`
+}
+
+func (m *ResetPointSelector) Reset()      { *m = ResetPointSelector{} }
+func (*ResetPointSelector) ProtoMessage() {}
+func (*ResetPointSelector) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4f1ca48d03c9ded, []int{1}
+}
+func (m *ResetPointSelector) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResetPointSelector) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResetPointSelector.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResetPointSelector) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetPointSelector.Merge(m, src)
+}
+func (m *ResetPointSelector) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResetPointSelector) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetPointSelector.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetPointSelector proto.InternalMessageInfo
+
+type isResetPointSelector_Selector interface {
+	isResetPointSelector_Selector()
+	Equal(interface{}) bool
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type ResetPointSelector_BuildId struct {
+	BuildId string `protobuf:"bytes,1,opt,name=build_id,json=buildId,proto3,oneof" json:"build_id,omitempty"`
+}
+type ResetPointSelector_BinaryChecksum struct {
+	BinaryChecksum string `protobuf:"bytes,2,opt,name=binary_checksum,json=binaryChecksum,proto3,oneof" json:"binary_checksum,omitempty"`
+}
+type ResetPointSelector_FailedActivityType struct {
+	FailedActivityType string `protobuf:"bytes,3,opt,name=failed_activity_type,json=failedActivityType,proto3,oneof" json:"failed_activity_type,omitempty"`
+}
+
+func (*ResetPointSelector_BuildId) isResetPointSelector_Selector()            {}
+func (*ResetPointSelector_BinaryChecksum) isResetPointSelector_Selector()     {}
+func (*ResetPointSelector_FailedActivityType) isResetPointSelector_Selector() {}
+
+func (m *ResetPointSelector) GetSelector() isResetPointSelector_Selector {
+	if m != nil {
+		return m.Selector
+	}
+	return nil
+}
+
+func (m *ResetPointSelector) GetBuildId() string {
+	if x, ok := m.GetSelector().(*ResetPointSelector_BuildId); ok {
+		return x.BuildId
+	}
+	return ""
+}
+
+func (m *ResetPointSelector) GetBinaryChecksum() string {
+	if x, ok := m.GetSelector().(*ResetPointSelector_BinaryChecksum); ok {
+		return x.BinaryChecksum
+	}
+	return ""
+}
+
+func (m *ResetPointSelector) GetFailedActivityType() string {
+	if x, ok := m.GetSelector().(*ResetPointSelector_FailedActivityType); ok {
+		return x.FailedActivityType
+	}
+	return ""
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ResetPointSelector) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ResetPointSelector_BuildId)(nil),
+		(*ResetPointSelector_BinaryChecksum)(nil),
+		(*ResetPointSelector_FailedActivityType)(nil),
+	}
+}
+
+type ResetPointResolution struct {
+	Execution *v1.WorkflowExecution `protobuf:"bytes,1,opt,name=execution,proto3" json:"execution,omitempty"`
+	// Id of the workflow task finish event the execution is (or would be) reset to.
+	WorkflowTaskFinishEventId int64 `protobuf:"varint,2,opt,name=workflow_task_finish_event_id,json=workflowTaskFinishEventId,proto3" json:"workflow_task_finish_event_id,omitempty"`
+	// Number of events after the reset point that are (or would be) discarded.
+	DiscardedEventCount int64 `protobuf:"varint,3,opt,name=discarded_event_count,json=discardedEventCount,proto3" json:"discarded_event_count,omitempty"`
+	// Run id of the new run. Empty for dry runs.
+	ResetRunId string `protobuf:"bytes,4,opt,name=reset_run_id,json=resetRunId,proto3" json:"reset_run_id,omitempty"`
+	// Why the execution could not be resolved or reset.
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *ResetPointResolution) Reset()      { *m = ResetPointResolution{} }
+func (*ResetPointResolution) ProtoMessage() {}
+func (*ResetPointResolution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4f1ca48d03c9ded, []int{2}
+}
+func (m *ResetPointResolution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResetPointResolution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResetPointResolution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResetPointResolution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetPointResolution.Merge(m, src)
+}
+func (m *ResetPointResolution) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResetPointResolution) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetPointResolution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetPointResolution proto.InternalMessageInfo
+
+func (m *ResetPointResolution) GetExecution() *v1.WorkflowExecution {
+	if m != nil {
+		return m.Execution
+	}
+	return nil
+}
+
+func (m *ResetPointResolution) GetWorkflowTaskFinishEventId() int64 {
+	if m != nil {
+		return m.WorkflowTaskFinishEventId
+	}
+	return 0
+}
+
+func (m *ResetPointResolution) GetDiscardedEventCount() int64 {
+	if m != nil {
+		return m.DiscardedEventCount
+	}
+	return 0
+}
+
+func (m *ResetPointResolution) GetResetRunId() string {
+	if m != nil {
+		return m.ResetRunId
+	}
+	return ""
+}
+
+func (m *ResetPointResolution) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// New options of a pending activity. Unset fields are left unchanged.
+type ActivityOptions struct {
+	RetryPolicy *v1.RetryPolicy `protobuf:"bytes,1,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	// (-- api-linter: core::0140::prepositions=disabled
+	//     aip.dev/not-precedent: "to" is used to indicate interval. --)
+	ScheduleToCloseTimeout *time.Duration `protobuf:"bytes,2,opt,name=schedule_to_close_timeout,json=scheduleToCloseTimeout,proto3,stdduration" json:"schedule_to_close_timeout,omitempty"`
+	// (-- api-linter: core::0140::prepositions=disabled
+	//     aip.dev/not-precedent: "to" is used to indicate interval. --)
+	ScheduleToStartTimeout *time.Duration `protobuf:"bytes,3,opt,name=schedule_to_start_timeout,json=scheduleToStartTimeout,proto3,stdduration" json:"schedule_to_start_timeout,omitempty"`
+	// (-- api-linter: core::0140::prepositions=disabled
+	//     aip.dev/not-precedent: "to" is used to indicate interval. --)
+	StartToCloseTimeout *time.Duration `protobuf:"bytes,4,opt,name=start_to_close_timeout,json=startToCloseTimeout,proto3,stdduration" json:"start_to_close_timeout,omitempty"`
+	HeartbeatTimeout    *time.Duration `protobuf:"bytes,5,opt,name=heartbeat_timeout,json=heartbeatTimeout,proto3,stdduration" json:"heartbeat_timeout,omitempty"`
+}
+
+func (m *ActivityOptions) Reset()      { *m = ActivityOptions{} }
+func (*ActivityOptions) ProtoMessage() {}
+func (*ActivityOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4f1ca48d03c9ded, []int{3}
+}
+func (m *ActivityOptions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActivityOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActivityOptions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActivityOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActivityOptions.Merge(m, src)
+}
+func (m *ActivityOptions) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActivityOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActivityOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActivityOptions proto.InternalMessageInfo
+
+func (m *ActivityOptions) GetRetryPolicy() *v1.RetryPolicy {
+	if m != nil {
+		return m.RetryPolicy
+	}
+	return nil
+}
+
+func (m *ActivityOptions) GetScheduleToCloseTimeout() *time.Duration {
+	if m != nil {
+		return m.ScheduleToCloseTimeout
+	}
+	return nil
+}
+
+func (m *ActivityOptions) GetScheduleToStartTimeout() *time.Duration {
+	if m != nil {
+		return m.ScheduleToStartTimeout
+	}
+	return nil
+}
+
+func (m *ActivityOptions) GetStartToCloseTimeout() *time.Duration {
+	if m != nil {
+		return m.StartToCloseTimeout
+	}
+	return nil
+}
+
+func (m *ActivityOptions) GetHeartbeatTimeout() *time.Duration {
+	if m != nil {
+		return m.HeartbeatTimeout
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ParentExecutionInfo)(nil), "temporal.server.api.workflow.v1.ParentExecutionInfo")
+	proto.RegisterType((*ResetPointSelector)(nil), "temporal.server.api.workflow.v1.ResetPointSelector")
+	proto.RegisterType((*ResetPointResolution)(nil), "temporal.server.api.workflow.v1.ResetPointResolution")
+	proto.RegisterType((*ActivityOptions)(nil), "temporal.server.api.workflow.v1.ActivityOptions")
 }
 
 func init() {
@@ -143,30 +411,54 @@ func init() {
 }
 
 var fileDescriptor_c4f1ca48d03c9ded = []byte{
-	// 356 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0x3d, 0x4e, 0xc3, 0x30,
-	0x14, 0x8e, 0x5b, 0x5a, 0xa9, 0x2e, 0x03, 0x84, 0x25, 0xaa, 0x90, 0x29, 0x88, 0xa1, 0x80, 0x70,
-	0x54, 0x18, 0x19, 0x90, 0x40, 0x08, 0x75, 0x43, 0x19, 0x8a, 0xc4, 0x82, 0x4c, 0xe2, 0x56, 0x56,
-	0x9b, 0x38, 0x72, 0x4c, 0xca, 0xc8, 0x11, 0x38, 0x06, 0x23, 0xc7, 0x60, 0xec, 0xd8, 0x91, 0xba,
-	0x0b, 0x63, 0x8f, 0x80, 0x9c, 0x10, 0x07, 0x51, 0xd8, 0xf2, 0xbe, 0xf7, 0xbd, 0xef, 0x27, 0x09,
-	0x3c, 0x96, 0x34, 0x8c, 0xb9, 0x20, 0x63, 0x37, 0xa1, 0x22, 0xa5, 0xc2, 0x25, 0x31, 0x73, 0x27,
-	0x5c, 0x8c, 0x06, 0x63, 0x3e, 0x71, 0xd3, 0xae, 0x1b, 0xd2, 0x24, 0x21, 0x43, 0x8a, 0x63, 0xc1,
-	0x25, 0xb7, 0x77, 0x0a, 0x3a, 0xce, 0xe9, 0x98, 0xc4, 0x0c, 0x17, 0x74, 0x9c, 0x76, 0x5b, 0xfb,
-	0x46, 0x4f, 0x0b, 0xf9, 0x3c, 0x0c, 0x79, 0xb4, 0x22, 0xd3, 0x3a, 0xfc, 0xcb, 0xd5, 0x1f, 0x73,
-	0x7f, 0xb4, 0xc2, 0xdd, 0x7b, 0xab, 0xc0, 0xad, 0x1b, 0x22, 0x68, 0x24, 0xaf, 0x9e, 0xa8, 0xff,
-	0x28, 0x19, 0x8f, 0x7a, 0xd1, 0x80, 0xdb, 0xbb, 0x70, 0x3d, 0x22, 0x21, 0x4d, 0x62, 0xe2, 0xd3,
-	0x7b, 0x16, 0x38, 0xa0, 0x0d, 0x3a, 0x0d, 0xaf, 0x69, 0xb0, 0x5e, 0x60, 0x6f, 0xc3, 0x86, 0x19,
-	0x9d, 0x4a, 0xb6, 0x2f, 0x01, 0xfb, 0x1a, 0x36, 0x68, 0xa1, 0xe8, 0x54, 0xdb, 0xa0, 0xd3, 0x3c,
-	0x39, 0xc0, 0xa6, 0x9f, 0x2e, 0x96, 0xc7, 0xc7, 0x69, 0x17, 0xdf, 0x7e, 0x57, 0x34, 0x11, 0xbc,
-	0xf2, 0x56, 0x27, 0x61, 0x11, 0x93, 0x8c, 0x48, 0x1a, 0xe8, 0x24, 0x6b, 0x6d, 0xd0, 0xa9, 0x7a,
-	0x4d, 0x83, 0xf5, 0x02, 0xfb, 0x1c, 0xd6, 0xb2, 0x7a, 0x4e, 0xed, 0xb7, 0xcf, 0x8f, 0xf7, 0x98,
-	0x31, 0xb4, 0x5b, 0x9f, 0xfa, 0x92, 0x8b, 0x4b, 0x3d, 0x7a, 0xf9, 0x9d, 0x7d, 0x04, 0x37, 0x4b,
-	0x8f, 0x94, 0x8a, 0x44, 0x87, 0xae, 0x67, 0x46, 0x1b, 0x66, 0xd1, 0xcf, 0xf1, 0x8b, 0x60, 0x3a,
-	0x47, 0xd6, 0x6c, 0x8e, 0xac, 0xe5, 0x1c, 0x81, 0x67, 0x85, 0xc0, 0xab, 0x42, 0xe0, 0x5d, 0x21,
-	0x30, 0x55, 0x08, 0x7c, 0x28, 0x04, 0x3e, 0x15, 0xb2, 0x96, 0x0a, 0x81, 0x97, 0x05, 0xb2, 0xa6,
-	0x0b, 0x64, 0xcd, 0x16, 0xc8, 0xba, 0xc3, 0x43, 0x5e, 0xc6, 0x62, 0xfc, 0x9f, 0x1f, 0xe2, 0xac,
-	0x78, 0x7e, 0xa8, 0x67, 0xdf, 0xe7, 0xf4, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x03, 0xda, 0x6d, 0xa5,
-	0x43, 0x02, 0x00, 0x00,
+	// 752 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcd, 0x6e, 0x2b, 0x35,
+	0x18, 0x9d, 0x49, 0x9a, 0xcb, 0x8d, 0x73, 0xc5, 0x6d, 0xdd, 0x52, 0xa5, 0x05, 0xa6, 0x69, 0x60,
+	0xd1, 0x82, 0x98, 0x51, 0xc3, 0x92, 0x05, 0xd0, 0xd0, 0xd2, 0x48, 0x48, 0x54, 0xd3, 0xa8, 0x48,
+	0xdd, 0x8c, 0x9c, 0xf1, 0x97, 0xc4, 0xca, 0x64, 0x3c, 0xb2, 0x3d, 0x29, 0xd9, 0xf1, 0x02, 0x48,
+	0x2c, 0x59, 0xf0, 0x00, 0x2c, 0xd9, 0xf0, 0x0e, 0x2c, 0xbb, 0xec, 0x0e, 0x9a, 0x6e, 0x58, 0xf6,
+	0x11, 0x90, 0x3d, 0x3f, 0x81, 0x94, 0x4a, 0x45, 0xec, 0xc6, 0xdf, 0x77, 0xce, 0xf1, 0xf9, 0x8e,
+	0xed, 0x41, 0x1f, 0x29, 0x98, 0x26, 0x5c, 0x90, 0xc8, 0x93, 0x20, 0x66, 0x20, 0x3c, 0x92, 0x30,
+	0xef, 0x9a, 0x8b, 0xc9, 0x30, 0xe2, 0xd7, 0xde, 0xec, 0xc8, 0x9b, 0x82, 0x94, 0x64, 0x04, 0x6e,
+	0x22, 0xb8, 0xe2, 0x78, 0xaf, 0x80, 0xbb, 0x19, 0xdc, 0x25, 0x09, 0x73, 0x0b, 0xb8, 0x3b, 0x3b,
+	0xda, 0x75, 0x46, 0x9c, 0x8f, 0x22, 0xf0, 0x0c, 0x7c, 0x90, 0x0e, 0x3d, 0x9a, 0x0a, 0xa2, 0x18,
+	0x8f, 0x33, 0x81, 0xdd, 0x7d, 0x0a, 0x09, 0xc4, 0x14, 0xe2, 0x90, 0x81, 0xf4, 0x46, 0x7c, 0xc4,
+	0x4d, 0xdd, 0x7c, 0xe5, 0x90, 0xf7, 0x4b, 0x4b, 0xda, 0x4b, 0xc8, 0xa7, 0x53, 0x1e, 0x3f, 0x72,
+	0xb2, 0xfb, 0xc1, 0xbf, 0x19, 0x0f, 0x23, 0x1e, 0x4e, 0x1e, 0x61, 0xdb, 0xbf, 0x54, 0xd0, 0xe6,
+	0x39, 0x11, 0x10, 0xab, 0x93, 0x6f, 0x21, 0x4c, 0xb5, 0x9d, 0x5e, 0x3c, 0xe4, 0x78, 0x1f, 0xbd,
+	0x8a, 0xc9, 0x14, 0x64, 0x42, 0x42, 0x08, 0x18, 0x6d, 0xda, 0x2d, 0xfb, 0xa0, 0xee, 0x37, 0xca,
+	0x5a, 0x8f, 0xe2, 0x77, 0x50, 0xbd, 0x5c, 0x36, 0x2b, 0xa6, 0xbf, 0x2c, 0xe0, 0x2f, 0x51, 0x1d,
+	0x0a, 0xc5, 0x66, 0xb5, 0x65, 0x1f, 0x34, 0x3a, 0x87, 0x6e, 0x19, 0x91, 0xce, 0x26, 0xb3, 0xef,
+	0xce, 0x8e, 0xdc, 0x6f, 0xf2, 0x94, 0x4a, 0x0b, 0xfe, 0x92, 0xab, 0x9d, 0xb0, 0x98, 0x29, 0x46,
+	0x14, 0x50, 0xed, 0x64, 0xad, 0x65, 0x1f, 0x54, 0xfd, 0x46, 0x59, 0xeb, 0x51, 0xfc, 0x29, 0xaa,
+	0x99, 0xf1, 0x9a, 0xb5, 0xd5, 0x7d, 0xfe, 0x76, 0x14, 0x06, 0xa1, 0x77, 0xbb, 0x84, 0x50, 0x71,
+	0xd1, 0xd5, 0x4b, 0x3f, 0xe3, 0xe1, 0x0f, 0xd1, 0xc6, 0x72, 0x8f, 0x19, 0x08, 0xa9, 0x4d, 0xbf,
+	0x30, 0x1b, 0xad, 0x97, 0x8d, 0xcb, 0xac, 0xde, 0xfe, 0xc9, 0x46, 0xd8, 0x07, 0x09, 0xea, 0x9c,
+	0xb3, 0x58, 0x5d, 0x40, 0x64, 0xf4, 0xf0, 0xdb, 0xe8, 0xe5, 0x20, 0x65, 0x11, 0x2d, 0xd3, 0x3a,
+	0xb3, 0xfc, 0x37, 0x4c, 0xa5, 0x47, 0xf1, 0x21, 0x7a, 0x3d, 0x60, 0x31, 0x11, 0xf3, 0x20, 0x1c,
+	0x43, 0x38, 0x91, 0xe9, 0x34, 0x4b, 0xec, 0xcc, 0xf2, 0xdf, 0xcc, 0x1a, 0xdd, 0xbc, 0x8e, 0x3b,
+	0x68, 0x6b, 0x48, 0x58, 0x04, 0x34, 0x20, 0xa1, 0x62, 0x33, 0xa6, 0xe6, 0x81, 0x9a, 0x27, 0xd0,
+	0xac, 0xe6, 0x78, 0x9c, 0x75, 0x3f, 0xcf, 0x9b, 0xfd, 0x79, 0x02, 0xc7, 0x08, 0xbd, 0x94, 0xb9,
+	0x8f, 0xf6, 0xf7, 0x15, 0xb4, 0xb5, 0xb4, 0xe7, 0x83, 0xe4, 0x51, 0x16, 0xe4, 0x3f, 0x4e, 0xc4,
+	0xfe, 0x1f, 0x27, 0xf2, 0x19, 0x7a, 0xb7, 0xb8, 0xd7, 0x81, 0x22, 0x72, 0x12, 0x0c, 0x59, 0xcc,
+	0xe4, 0x38, 0x80, 0x19, 0xc4, 0x4a, 0x8f, 0x5f, 0x31, 0xc9, 0xed, 0x14, 0xa0, 0x3e, 0x91, 0x93,
+	0x53, 0x03, 0x39, 0xd1, 0x88, 0x1e, 0xc5, 0x1d, 0xf4, 0x16, 0x65, 0x32, 0x24, 0x82, 0x02, 0xcd,
+	0x69, 0x21, 0x4f, 0x63, 0x65, 0x86, 0xac, 0xfa, 0x9b, 0x65, 0xd3, 0x10, 0xba, 0xba, 0x85, 0x5b,
+	0xe8, 0x95, 0xd0, 0x63, 0x05, 0x22, 0x8d, 0x8b, 0x7b, 0x50, 0xf7, 0x91, 0xa9, 0xf9, 0x69, 0xdc,
+	0xa3, 0x78, 0x0b, 0xd5, 0x40, 0x08, 0x2e, 0xcc, 0x35, 0xa8, 0xfb, 0xd9, 0xa2, 0xfd, 0x6b, 0x15,
+	0xbd, 0x2e, 0xc2, 0xfa, 0x3a, 0xd1, 0x03, 0x48, 0x7c, 0xaa, 0xb5, 0x94, 0x98, 0x07, 0x09, 0x8f,
+	0x58, 0x38, 0xcf, 0xd3, 0x78, 0xef, 0xa9, 0x34, 0x7c, 0x8d, 0x3d, 0x37, 0x50, 0xbf, 0x21, 0x96,
+	0x0b, 0x7c, 0x85, 0x76, 0x64, 0x38, 0x06, 0x9a, 0x46, 0x10, 0x28, 0x1e, 0x84, 0x11, 0x97, 0x10,
+	0x28, 0x36, 0x05, 0x9e, 0x2a, 0x93, 0x42, 0xa3, 0xb3, 0xe3, 0x66, 0xcf, 0xde, 0x2d, 0x9e, 0xbd,
+	0xfb, 0x45, 0xfe, 0xec, 0x8f, 0xd7, 0x7e, 0xfc, 0x7d, 0xcf, 0xf6, 0xb7, 0x0b, 0x85, 0x3e, 0xef,
+	0x6a, 0x7e, 0x3f, 0xa3, 0xaf, 0x6a, 0x4b, 0x45, 0x84, 0x2a, 0xb5, 0xab, 0xff, 0x59, 0xfb, 0x42,
+	0xf3, 0x0b, 0xed, 0x3e, 0xda, 0xce, 0xf5, 0x56, 0x4d, 0xaf, 0x3d, 0x4f, 0x78, 0xd3, 0xd0, 0x57,
+	0x1c, 0x7f, 0x85, 0x36, 0xc6, 0x40, 0x84, 0x1a, 0x00, 0x59, 0x3a, 0xad, 0x3d, 0x4f, 0x70, 0xbd,
+	0x64, 0xe6, 0x6a, 0xc7, 0xf4, 0xe6, 0xce, 0xb1, 0x6e, 0xef, 0x1c, 0xeb, 0xe1, 0xce, 0xb1, 0xbf,
+	0x5b, 0x38, 0xf6, 0xcf, 0x0b, 0xc7, 0xfe, 0x6d, 0xe1, 0xd8, 0x37, 0x0b, 0xc7, 0xfe, 0x63, 0xe1,
+	0xd8, 0x7f, 0x2e, 0x1c, 0xeb, 0x61, 0xe1, 0xd8, 0x3f, 0xdc, 0x3b, 0xd6, 0xcd, 0xbd, 0x63, 0xdd,
+	0xde, 0x3b, 0xd6, 0x95, 0x3b, 0xe2, 0xcb, 0x53, 0x64, 0xfc, 0x89, 0x5f, 0xf7, 0x27, 0xc5, 0xf7,
+	0xe0, 0x85, 0x31, 0xf4, 0xf1, 0x5f, 0x03, 0x00, 0x7e, 0xaa, 0x9c, 0x86, 0xed, 0x05, 0x00, 0x00,
 }
 
 func (this *ParentExecutionInfo) Equal(that interface{}) bool {
@@ -208,176 +500,1192 @@ func (this *ParentExecutionInfo) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *ParentExecutionInfo) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *ResetPointSelector) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 10)
-	s = append(s, "&workflow.ParentExecutionInfo{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
-	if this.Execution != nil {
-		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+
+	that1, ok := that.(*ResetPointSelector)
+	if !ok {
+		that2, ok := that.(ResetPointSelector)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "InitiatedId: "+fmt.Sprintf("%#v", this.InitiatedId)+",\n")
-	if this.Clock != nil {
-		s = append(s, "Clock: "+fmt.Sprintf("%#v", this.Clock)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "InitiatedVersion: "+fmt.Sprintf("%#v", this.InitiatedVersion)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func valueToGoStringMessage(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	if that1.Selector == nil {
+		if this.Selector != nil {
+			return false
+		}
+	} else if this.Selector == nil {
+		return false
+	} else if !this.Selector.Equal(that1.Selector) {
+		return false
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+	return true
 }
-func (m *ParentExecutionInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ResetPointSelector_BuildId) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ParentExecutionInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *ParentExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.InitiatedVersion != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.InitiatedVersion))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.Clock != nil {
-		{
-			size, err := m.Clock.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMessage(dAtA, i, uint64(size))
+	that1, ok := that.(*ResetPointSelector_BuildId)
+	if !ok {
+		that2, ok := that.(ResetPointSelector_BuildId)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x2a
 	}
-	if m.InitiatedId != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.InitiatedId))
-		i--
-		dAtA[i] = 0x20
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Execution != nil {
-		{
-			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMessage(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	if this.BuildId != that1.BuildId {
+		return false
 	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0x12
+	return true
+}
+func (this *ResetPointSelector_BinaryChecksum) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if len(m.NamespaceId) > 0 {
-		i -= len(m.NamespaceId)
-		copy(dAtA[i:], m.NamespaceId)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.NamespaceId)))
-		i--
-		dAtA[i] = 0xa
+
+	that1, ok := that.(*ResetPointSelector_BinaryChecksum)
+	if !ok {
+		that2, ok := that.(ResetPointSelector_BinaryChecksum)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return len(dAtA) - i, nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BinaryChecksum != that1.BinaryChecksum {
+		return false
+	}
+	return true
 }
+func (this *ResetPointSelector_FailedActivityType) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMessage(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	that1, ok := that.(*ResetPointSelector_FailedActivityType)
+	if !ok {
+		that2, ok := that.(ResetPointSelector_FailedActivityType)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.FailedActivityType != that1.FailedActivityType {
+		return false
+	}
+	return true
 }
-func (m *ParentExecutionInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *ResetPointResolution) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ResetPointResolution)
+	if !ok {
+		that2, ok := that.(ResetPointResolution)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Execution.Equal(that1.Execution) {
+		return false
+	}
+	if this.WorkflowTaskFinishEventId != that1.WorkflowTaskFinishEventId {
+		return false
+	}
+	if this.DiscardedEventCount != that1.DiscardedEventCount {
+		return false
+	}
+	if this.ResetRunId != that1.ResetRunId {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
+}
+func (this *ActivityOptions) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ActivityOptions)
+	if !ok {
+		that2, ok := that.(ActivityOptions)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.RetryPolicy.Equal(that1.RetryPolicy) {
+		return false
+	}
+	if this.ScheduleToCloseTimeout != nil && that1.ScheduleToCloseTimeout != nil {
+		if *this.ScheduleToCloseTimeout != *that1.ScheduleToCloseTimeout {
+			return false
+		}
+	} else if this.ScheduleToCloseTimeout != nil {
+		return false
+	} else if that1.ScheduleToCloseTimeout != nil {
+		return false
+	}
+	if this.ScheduleToStartTimeout != nil && that1.ScheduleToStartTimeout != nil {
+		if *this.ScheduleToStartTimeout != *that1.ScheduleToStartTimeout {
+			return false
+		}
+	} else if this.ScheduleToStartTimeout != nil {
+		return false
+	} else if that1.ScheduleToStartTimeout != nil {
+		return false
+	}
+	if this.StartToCloseTimeout != nil && that1.StartToCloseTimeout != nil {
+		if *this.StartToCloseTimeout != *that1.StartToCloseTimeout {
+			return false
+		}
+	} else if this.StartToCloseTimeout != nil {
+		return false
+	} else if that1.StartToCloseTimeout != nil {
+		return false
+	}
+	if this.HeartbeatTimeout != nil && that1.HeartbeatTimeout != nil {
+		if *this.HeartbeatTimeout != *that1.HeartbeatTimeout {
+			return false
+		}
+	} else if this.HeartbeatTimeout != nil {
+		return false
+	} else if that1.HeartbeatTimeout != nil {
+		return false
+	}
+	return true
+}
+func (this *ParentExecutionInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&workflow.ParentExecutionInfo{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+	}
+	s = append(s, "InitiatedId: "+fmt.Sprintf("%#v", this.InitiatedId)+",\n")
+	if this.Clock != nil {
+		s = append(s, "Clock: "+fmt.Sprintf("%#v", this.Clock)+",\n")
+	}
+	s = append(s, "InitiatedVersion: "+fmt.Sprintf("%#v", this.InitiatedVersion)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ResetPointSelector) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&workflow.ResetPointSelector{")
+	if this.Selector != nil {
+		s = append(s, "Selector: "+fmt.Sprintf("%#v", this.Selector)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ResetPointSelector_BuildId) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&workflow.ResetPointSelector_BuildId{` +
+		`BuildId:` + fmt.Sprintf("%#v", this.BuildId) + `}`}, ", ")
+	return s
+}
+func (this *ResetPointSelector_BinaryChecksum) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&workflow.ResetPointSelector_BinaryChecksum{` +
+		`BinaryChecksum:` + fmt.Sprintf("%#v", this.BinaryChecksum) + `}`}, ", ")
+	return s
+}
+func (this *ResetPointSelector_FailedActivityType) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&workflow.ResetPointSelector_FailedActivityType{` +
+		`FailedActivityType:` + fmt.Sprintf("%#v", this.FailedActivityType) + `}`}, ", ")
+	return s
+}
+func (this *ResetPointResolution) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&workflow.ResetPointResolution{")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+	}
+	s = append(s, "WorkflowTaskFinishEventId: "+fmt.Sprintf("%#v", this.WorkflowTaskFinishEventId)+",\n")
+	s = append(s, "DiscardedEventCount: "+fmt.Sprintf("%#v", this.DiscardedEventCount)+",\n")
+	s = append(s, "ResetRunId: "+fmt.Sprintf("%#v", this.ResetRunId)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ActivityOptions) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&workflow.ActivityOptions{")
+	if this.RetryPolicy != nil {
+		s = append(s, "RetryPolicy: "+fmt.Sprintf("%#v", this.RetryPolicy)+",\n")
+	}
+	s = append(s, "ScheduleToCloseTimeout: "+fmt.Sprintf("%#v", this.ScheduleToCloseTimeout)+",\n")
+	s = append(s, "ScheduleToStartTimeout: "+fmt.Sprintf("%#v", this.ScheduleToStartTimeout)+",\n")
+	s = append(s, "StartToCloseTimeout: "+fmt.Sprintf("%#v", this.StartToCloseTimeout)+",\n")
+	s = append(s, "HeartbeatTimeout: "+fmt.Sprintf("%#v", this.HeartbeatTimeout)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringMessage(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
 	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *ParentExecutionInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParentExecutionInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParentExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
+	if m.InitiatedVersion != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.InitiatedVersion))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
+	if m.Clock != nil {
+		{
+			size, err := m.Clock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.InitiatedId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.InitiatedId))
+		i--
+		dAtA[i] = 0x20
 	}
 	if m.Execution != nil {
-		l = m.Execution.Size()
-		n += 1 + l + sovMessage(uint64(l))
+		{
+			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.InitiatedId != 0 {
-		n += 1 + sovMessage(uint64(m.InitiatedId))
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Clock != nil {
-		l = m.Clock.Size()
-		n += 1 + l + sovMessage(uint64(l))
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.InitiatedVersion != 0 {
-		n += 1 + sovMessage(uint64(m.InitiatedVersion))
+	return len(dAtA) - i, nil
+}
+
+func (m *ResetPointSelector) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResetPointSelector) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResetPointSelector) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Selector != nil {
+		{
+			size := m.Selector.Size()
+			i -= size
+			if _, err := m.Selector.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResetPointSelector_BuildId) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResetPointSelector_BuildId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.BuildId)
+	copy(dAtA[i:], m.BuildId)
+	i = encodeVarintMessage(dAtA, i, uint64(len(m.BuildId)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+func (m *ResetPointSelector_BinaryChecksum) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResetPointSelector_BinaryChecksum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.BinaryChecksum)
+	copy(dAtA[i:], m.BinaryChecksum)
+	i = encodeVarintMessage(dAtA, i, uint64(len(m.BinaryChecksum)))
+	i--
+	dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+func (m *ResetPointSelector_FailedActivityType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResetPointSelector_FailedActivityType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.FailedActivityType)
+	copy(dAtA[i:], m.FailedActivityType)
+	i = encodeVarintMessage(dAtA, i, uint64(len(m.FailedActivityType)))
+	i--
+	dAtA[i] = 0x1a
+	return len(dAtA) - i, nil
+}
+func (m *ResetPointResolution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResetPointResolution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResetPointResolution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ResetRunId) > 0 {
+		i -= len(m.ResetRunId)
+		copy(dAtA[i:], m.ResetRunId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.ResetRunId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.DiscardedEventCount != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.DiscardedEventCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WorkflowTaskFinishEventId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.WorkflowTaskFinishEventId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Execution != nil {
+		{
+			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActivityOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActivityOptions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActivityOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HeartbeatTimeout != nil {
+		n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.HeartbeatTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.HeartbeatTimeout):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintMessage(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.StartToCloseTimeout != nil {
+		n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StartToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StartToCloseTimeout):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintMessage(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ScheduleToStartTimeout != nil {
+		n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintMessage(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ScheduleToCloseTimeout != nil {
+		n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToCloseTimeout):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintMessage(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RetryPolicy != nil {
+		{
+			size, err := m.RetryPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMessage(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ParentExecutionInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.InitiatedId != 0 {
+		n += 1 + sovMessage(uint64(m.InitiatedId))
+	}
+	if m.Clock != nil {
+		l = m.Clock.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.InitiatedVersion != 0 {
+		n += 1 + sovMessage(uint64(m.InitiatedVersion))
+	}
+	return n
+}
+
+func (m *ResetPointSelector) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Selector != nil {
+		n += m.Selector.Size()
+	}
+	return n
+}
+
+func (m *ResetPointSelector_BuildId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BuildId)
+	n += 1 + l + sovMessage(uint64(l))
+	return n
+}
+func (m *ResetPointSelector_BinaryChecksum) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BinaryChecksum)
+	n += 1 + l + sovMessage(uint64(l))
+	return n
+}
+func (m *ResetPointSelector_FailedActivityType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FailedActivityType)
+	n += 1 + l + sovMessage(uint64(l))
+	return n
+}
+func (m *ResetPointResolution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.WorkflowTaskFinishEventId != 0 {
+		n += 1 + sovMessage(uint64(m.WorkflowTaskFinishEventId))
+	}
+	if m.DiscardedEventCount != 0 {
+		n += 1 + sovMessage(uint64(m.DiscardedEventCount))
+	}
+	l = len(m.ResetRunId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *ActivityOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RetryPolicy != nil {
+		l = m.RetryPolicy.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.ScheduleToCloseTimeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToCloseTimeout)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.ScheduleToStartTimeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.StartToCloseTimeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StartToCloseTimeout)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.HeartbeatTimeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.HeartbeatTimeout)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func sovMessage(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMessage(x uint64) (n int) {
+	return sovMessage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ParentExecutionInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ParentExecutionInfo{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
+		`InitiatedId:` + fmt.Sprintf("%v", this.InitiatedId) + `,`,
+		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v11.VectorClock", 1) + `,`,
+		`InitiatedVersion:` + fmt.Sprintf("%v", this.InitiatedVersion) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResetPointSelector) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResetPointSelector{`,
+		`Selector:` + fmt.Sprintf("%v", this.Selector) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResetPointSelector_BuildId) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResetPointSelector_BuildId{`,
+		`BuildId:` + fmt.Sprintf("%v", this.BuildId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResetPointSelector_BinaryChecksum) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResetPointSelector_BinaryChecksum{`,
+		`BinaryChecksum:` + fmt.Sprintf("%v", this.BinaryChecksum) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResetPointSelector_FailedActivityType) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResetPointSelector_FailedActivityType{`,
+		`FailedActivityType:` + fmt.Sprintf("%v", this.FailedActivityType) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResetPointResolution) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResetPointResolution{`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
+		`WorkflowTaskFinishEventId:` + fmt.Sprintf("%v", this.WorkflowTaskFinishEventId) + `,`,
+		`DiscardedEventCount:` + fmt.Sprintf("%v", this.DiscardedEventCount) + `,`,
+		`ResetRunId:` + fmt.Sprintf("%v", this.ResetRunId) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ActivityOptions) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ActivityOptions{`,
+		`RetryPolicy:` + strings.Replace(fmt.Sprintf("%v", this.RetryPolicy), "RetryPolicy", "v1.RetryPolicy", 1) + `,`,
+		`ScheduleToCloseTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ScheduleToCloseTimeout), "Duration", "types.Duration", 1) + `,`,
+		`ScheduleToStartTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ScheduleToStartTimeout), "Duration", "types.Duration", 1) + `,`,
+		`StartToCloseTimeout:` + strings.Replace(fmt.Sprintf("%v", this.StartToCloseTimeout), "Duration", "types.Duration", 1) + `,`,
+		`HeartbeatTimeout:` + strings.Replace(fmt.Sprintf("%v", this.HeartbeatTimeout), "Duration", "types.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringMessage(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ParentExecutionInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParentExecutionInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParentExecutionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Execution == nil {
+				m.Execution = &v1.WorkflowExecution{}
+			}
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedId", wireType)
+			}
+			m.InitiatedId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitiatedId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Clock == nil {
+				m.Clock = &v11.VectorClock{}
+			}
+			if err := m.Clock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedVersion", wireType)
+			}
+			m.InitiatedVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitiatedVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResetPointSelector) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResetPointSelector: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResetPointSelector: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Selector = &ResetPointSelector_BuildId{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryChecksum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Selector = &ResetPointSelector_BinaryChecksum{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedActivityType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Selector = &ResetPointSelector_FailedActivityType{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovMessage(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMessage(x uint64) (n int) {
-	return sovMessage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *ParentExecutionInfo) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ParentExecutionInfo{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
-		`InitiatedId:` + fmt.Sprintf("%v", this.InitiatedId) + `,`,
-		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v11.VectorClock", 1) + `,`,
-		`InitiatedVersion:` + fmt.Sprintf("%v", this.InitiatedVersion) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringMessage(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *ParentExecutionInfo) Unmarshal(dAtA []byte) error {
+func (m *ResetPointResolution) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -400,15 +1708,89 @@ func (m *ParentExecutionInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParentExecutionInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResetPointResolution: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParentExecutionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResetPointResolution: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Execution == nil {
+				m.Execution = &v1.WorkflowExecution{}
+			}
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTaskFinishEventId", wireType)
+			}
+			m.WorkflowTaskFinishEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkflowTaskFinishEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscardedEventCount", wireType)
+			}
+			m.DiscardedEventCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiscardedEventCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetRunId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -436,11 +1818,11 @@ func (m *ParentExecutionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			m.ResetRunId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -468,11 +1850,64 @@ func (m *ParentExecutionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActivityOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActivityOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActivityOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -499,18 +1934,18 @@ func (m *ParentExecutionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Execution == nil {
-				m.Execution = &v1.WorkflowExecution{}
+			if m.RetryPolicy == nil {
+				m.RetryPolicy = &v1.RetryPolicy{}
 			}
-			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedId", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToCloseTimeout", wireType)
 			}
-			m.InitiatedId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -520,14 +1955,31 @@ func (m *ParentExecutionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InitiatedId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduleToCloseTimeout == nil {
+				m.ScheduleToCloseTimeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.ScheduleToCloseTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Clock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToStartTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -554,18 +2006,18 @@ func (m *ParentExecutionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Clock == nil {
-				m.Clock = &v11.VectorClock{}
+			if m.ScheduleToStartTimeout == nil {
+				m.ScheduleToStartTimeout = new(time.Duration)
 			}
-			if err := m.Clock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.ScheduleToStartTimeout, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedVersion", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartToCloseTimeout", wireType)
 			}
-			m.InitiatedVersion = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -575,11 +2027,64 @@ func (m *ParentExecutionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InitiatedVersion |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartToCloseTimeout == nil {
+				m.StartToCloseTimeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.StartToCloseTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HeartbeatTimeout == nil {
+				m.HeartbeatTimeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.HeartbeatTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -63,6 +63,16 @@ func (c *clientImpl) CloseShard(
 	return c.client.CloseShard(ctx, request, opts...)
 }
 
+func (c *clientImpl) CreateNamespaceApiKey(
+	ctx context.Context,
+	request *adminservice.CreateNamespaceApiKeyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CreateNamespaceApiKeyResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.CreateNamespaceApiKey(ctx, request, opts...)
+}
+
 func (c *clientImpl) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -143,6 +153,16 @@ func (c *clientImpl) GetReplicationMessages(
 	return c.client.GetReplicationMessages(ctx, request, opts...)
 }
 
+func (c *clientImpl) GetReplicationStatus(
+	ctx context.Context,
+	request *adminservice.GetReplicationStatusRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetReplicationStatusResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.GetReplicationStatus(ctx, request, opts...)
+}
+
 func (c *clientImpl) GetSearchAttributes(
 	ctx context.Context,
 	request *adminservice.GetSearchAttributesRequest,
@@ -183,6 +203,16 @@ func (c *clientImpl) GetWorkflowExecutionRawHistoryV2(
 	return c.client.GetWorkflowExecutionRawHistoryV2(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListAuditRecords(
+	ctx context.Context,
+	request *adminservice.ListAuditRecordsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListAuditRecordsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListAuditRecords(ctx, request, opts...)
+}
+
 func (c *clientImpl) ListClusterMembers(
 	ctx context.Context,
 	request *adminservice.ListClusterMembersRequest,
@@ -213,6 +243,16 @@ func (c *clientImpl) ListHistoryTasks(
 	return c.client.ListHistoryTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListNamespaceApiKeys(
+	ctx context.Context,
+	request *adminservice.ListNamespaceApiKeysRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListNamespaceApiKeysResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListNamespaceApiKeys(ctx, request, opts...)
+}
+
 func (c *clientImpl) MergeDLQMessages(
 	ctx context.Context,
 	request *adminservice.MergeDLQMessagesRequest,
@@ -223,6 +263,26 @@ func (c *clientImpl) MergeDLQMessages(
 	return c.client.MergeDLQMessages(ctx, request, opts...)
 }
 
+func (c *clientImpl) PauseActivityExecution(
+	ctx context.Context,
+	request *adminservice.PauseActivityExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PauseActivityExecutionResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.PauseActivityExecution(ctx, request, opts...)
+}
+
+func (c *clientImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.PauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PauseWorkflowExecutionResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.PauseWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *clientImpl) PurgeDLQMessages(
 	ctx context.Context,
 	request *adminservice.PurgeDLQMessagesRequest,
@@ -263,6 +323,16 @@ func (c *clientImpl) RefreshWorkflowTasks(
 	return c.client.RefreshWorkflowTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) RemovePersistenceFault(
+	ctx context.Context,
+	request *adminservice.RemovePersistenceFaultRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RemovePersistenceFaultResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.RemovePersistenceFault(ctx, request, opts...)
+}
+
 func (c *clientImpl) RemoveRemoteCluster(
 	ctx context.Context,
 	request *adminservice.RemoveRemoteClusterRequest,
@@ -302,3 +372,83 @@ func (c *clientImpl) ResendReplicationTasks(
 	defer cancel()
 	return c.client.ResendReplicationTasks(ctx, request, opts...)
 }
+
+func (c *clientImpl) ResetActivityExecution(
+	ctx context.Context,
+	request *adminservice.ResetActivityExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ResetActivityExecutionResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ResetActivityExecution(ctx, request, opts...)
+}
+
+func (c *clientImpl) ResetWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.ResetWorkflowExecutionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ResetWorkflowExecutionsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ResetWorkflowExecutions(ctx, request, opts...)
+}
+
+func (c *clientImpl) RevokeNamespaceApiKey(
+	ctx context.Context,
+	request *adminservice.RevokeNamespaceApiKeyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RevokeNamespaceApiKeyResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.RevokeNamespaceApiKey(ctx, request, opts...)
+}
+
+func (c *clientImpl) RotateNamespaceApiKey(
+	ctx context.Context,
+	request *adminservice.RotateNamespaceApiKeyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RotateNamespaceApiKeyResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.RotateNamespaceApiKey(ctx, request, opts...)
+}
+
+func (c *clientImpl) SetPersistenceFault(
+	ctx context.Context,
+	request *adminservice.SetPersistenceFaultRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SetPersistenceFaultResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.SetPersistenceFault(ctx, request, opts...)
+}
+
+func (c *clientImpl) UnpauseActivityExecution(
+	ctx context.Context,
+	request *adminservice.UnpauseActivityExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UnpauseActivityExecutionResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UnpauseActivityExecution(ctx, request, opts...)
+}
+
+func (c *clientImpl) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.UnpauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UnpauseWorkflowExecutionResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UnpauseWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateActivityExecutionOptions(
+	ctx context.Context,
+	request *adminservice.UpdateActivityExecutionOptionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateActivityExecutionOptionsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UpdateActivityExecutionOptions(ctx, request, opts...)
+}
@@ -77,6 +77,20 @@ func (c *metricClient) CloseShard(
 	return c.client.CloseShard(ctx, request, opts...)
 }
 
+func (c *metricClient) CreateNamespaceApiKey(
+	ctx context.Context,
+	request *adminservice.CreateNamespaceApiKeyRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.CreateNamespaceApiKeyResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientCreateNamespaceApiKeyScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.CreateNamespaceApiKey(ctx, request, opts...)
+}
+
 func (c *metricClient) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -189,6 +203,20 @@ func (c *metricClient) GetReplicationMessages(
 	return c.client.GetReplicationMessages(ctx, request, opts...)
 }
 
+func (c *metricClient) GetReplicationStatus(
+	ctx context.Context,
+	request *adminservice.GetReplicationStatusRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.GetReplicationStatusResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientGetReplicationStatusScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.GetReplicationStatus(ctx, request, opts...)
+}
+
 func (c *metricClient) GetSearchAttributes(
 	ctx context.Context,
 	request *adminservice.GetSearchAttributesRequest,
@@ -245,6 +273,20 @@ func (c *metricClient) GetWorkflowExecutionRawHistoryV2(
 	return c.client.GetWorkflowExecutionRawHistoryV2(ctx, request, opts...)
 }
 
+func (c *metricClient) ListAuditRecords(
+	ctx context.Context,
+	request *adminservice.ListAuditRecordsRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListAuditRecordsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientListAuditRecordsScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListAuditRecords(ctx, request, opts...)
+}
+
 func (c *metricClient) ListClusterMembers(
 	ctx context.Context,
 	request *adminservice.ListClusterMembersRequest,
@@ -287,6 +329,20 @@ func (c *metricClient) ListHistoryTasks(
 	return c.client.ListHistoryTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) ListNamespaceApiKeys(
+	ctx context.Context,
+	request *adminservice.ListNamespaceApiKeysRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListNamespaceApiKeysResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientListNamespaceApiKeysScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListNamespaceApiKeys(ctx, request, opts...)
+}
+
 func (c *metricClient) MergeDLQMessages(
 	ctx context.Context,
 	request *adminservice.MergeDLQMessagesRequest,
@@ -301,6 +357,34 @@ func (c *metricClient) MergeDLQMessages(
 	return c.client.MergeDLQMessages(ctx, request, opts...)
 }
 
+func (c *metricClient) PauseActivityExecution(
+	ctx context.Context,
+	request *adminservice.PauseActivityExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.PauseActivityExecutionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientPauseActivityExecutionScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.PauseActivityExecution(ctx, request, opts...)
+}
+
+func (c *metricClient) PauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.PauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.PauseWorkflowExecutionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientPauseWorkflowExecutionScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.PauseWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *metricClient) PurgeDLQMessages(
 	ctx context.Context,
 	request *adminservice.PurgeDLQMessagesRequest,
@@ -357,6 +441,20 @@ func (c *metricClient) RefreshWorkflowTasks(
 	return c.client.RefreshWorkflowTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) RemovePersistenceFault(
+	ctx context.Context,
+	request *adminservice.RemovePersistenceFaultRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.RemovePersistenceFaultResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientRemovePersistenceFaultScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.RemovePersistenceFault(ctx, request, opts...)
+}
+
 func (c *metricClient) RemoveRemoteCluster(
 	ctx context.Context,
 	request *adminservice.RemoveRemoteClusterRequest,
@@ -412,3 +510,115 @@ func (c *metricClient) ResendReplicationTasks(
 
 	return c.client.ResendReplicationTasks(ctx, request, opts...)
 }
+
+func (c *metricClient) ResetActivityExecution(
+	ctx context.Context,
+	request *adminservice.ResetActivityExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ResetActivityExecutionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientResetActivityExecutionScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ResetActivityExecution(ctx, request, opts...)
+}
+
+func (c *metricClient) ResetWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.ResetWorkflowExecutionsRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ResetWorkflowExecutionsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientResetWorkflowExecutionsScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ResetWorkflowExecutions(ctx, request, opts...)
+}
+
+func (c *metricClient) RevokeNamespaceApiKey(
+	ctx context.Context,
+	request *adminservice.RevokeNamespaceApiKeyRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.RevokeNamespaceApiKeyResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientRevokeNamespaceApiKeyScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.RevokeNamespaceApiKey(ctx, request, opts...)
+}
+
+func (c *metricClient) RotateNamespaceApiKey(
+	ctx context.Context,
+	request *adminservice.RotateNamespaceApiKeyRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.RotateNamespaceApiKeyResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientRotateNamespaceApiKeyScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.RotateNamespaceApiKey(ctx, request, opts...)
+}
+
+func (c *metricClient) SetPersistenceFault(
+	ctx context.Context,
+	request *adminservice.SetPersistenceFaultRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.SetPersistenceFaultResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientSetPersistenceFaultScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.SetPersistenceFault(ctx, request, opts...)
+}
+
+func (c *metricClient) UnpauseActivityExecution(
+	ctx context.Context,
+	request *adminservice.UnpauseActivityExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UnpauseActivityExecutionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientUnpauseActivityExecutionScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UnpauseActivityExecution(ctx, request, opts...)
+}
+
+func (c *metricClient) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.UnpauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UnpauseWorkflowExecutionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientUnpauseWorkflowExecutionScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UnpauseWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *metricClient) UpdateActivityExecutionOptions(
+	ctx context.Context,
+	request *adminservice.UpdateActivityExecutionOptionsRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UpdateActivityExecutionOptionsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientUpdateActivityExecutionOptionsScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateActivityExecutionOptions(ctx, request, opts...)
+}
@@ -80,6 +80,21 @@ func (c *retryableClient) CloseShard(
 	return resp, err
 }
 
+func (c *retryableClient) CreateNamespaceApiKey(
+	ctx context.Context,
+	request *adminservice.CreateNamespaceApiKeyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CreateNamespaceApiKeyResponse, error) {
+	var resp *adminservice.CreateNamespaceApiKeyResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.CreateNamespaceApiKey(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -200,6 +215,21 @@ func (c *retryableClient) GetReplicationMessages(
 	return resp, err
 }
 
+func (c *retryableClient) GetReplicationStatus(
+	ctx context.Context,
+	request *adminservice.GetReplicationStatusRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetReplicationStatusResponse, error) {
+	var resp *adminservice.GetReplicationStatusResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetReplicationStatus(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GetSearchAttributes(
 	ctx context.Context,
 	request *adminservice.GetSearchAttributesRequest,
@@ -260,6 +290,21 @@ func (c *retryableClient) GetWorkflowExecutionRawHistoryV2(
 	return resp, err
 }
 
+func (c *retryableClient) ListAuditRecords(
+	ctx context.Context,
+	request *adminservice.ListAuditRecordsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListAuditRecordsResponse, error) {
+	var resp *adminservice.ListAuditRecordsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListAuditRecords(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ListClusterMembers(
 	ctx context.Context,
 	request *adminservice.ListClusterMembersRequest,
@@ -305,6 +350,21 @@ func (c *retryableClient) ListHistoryTasks(
 	return resp, err
 }
 
+func (c *retryableClient) ListNamespaceApiKeys(
+	ctx context.Context,
+	request *adminservice.ListNamespaceApiKeysRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListNamespaceApiKeysResponse, error) {
+	var resp *adminservice.ListNamespaceApiKeysResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListNamespaceApiKeys(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) MergeDLQMessages(
 	ctx context.Context,
 	request *adminservice.MergeDLQMessagesRequest,
@@ -320,6 +380,36 @@ func (c *retryableClient) MergeDLQMessages(
 	return resp, err
 }
 
+func (c *retryableClient) PauseActivityExecution(
+	ctx context.Context,
+	request *adminservice.PauseActivityExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PauseActivityExecutionResponse, error) {
+	var resp *adminservice.PauseActivityExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.PauseActivityExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) PauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.PauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PauseWorkflowExecutionResponse, error) {
+	var resp *adminservice.PauseWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.PauseWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) PurgeDLQMessages(
 	ctx context.Context,
 	request *adminservice.PurgeDLQMessagesRequest,
@@ -380,6 +470,21 @@ func (c *retryableClient) RefreshWorkflowTasks(
 	return resp, err
 }
 
+func (c *retryableClient) RemovePersistenceFault(
+	ctx context.Context,
+	request *adminservice.RemovePersistenceFaultRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RemovePersistenceFaultResponse, error) {
+	var resp *adminservice.RemovePersistenceFaultResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.RemovePersistenceFault(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) RemoveRemoteCluster(
 	ctx context.Context,
 	request *adminservice.RemoveRemoteClusterRequest,
@@ -439,3 +544,123 @@ func (c *retryableClient) ResendReplicationTasks(
 	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) ResetActivityExecution(
+	ctx context.Context,
+	request *adminservice.ResetActivityExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ResetActivityExecutionResponse, error) {
+	var resp *adminservice.ResetActivityExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ResetActivityExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ResetWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.ResetWorkflowExecutionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ResetWorkflowExecutionsResponse, error) {
+	var resp *adminservice.ResetWorkflowExecutionsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ResetWorkflowExecutions(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) RevokeNamespaceApiKey(
+	ctx context.Context,
+	request *adminservice.RevokeNamespaceApiKeyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RevokeNamespaceApiKeyResponse, error) {
+	var resp *adminservice.RevokeNamespaceApiKeyResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.RevokeNamespaceApiKey(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) RotateNamespaceApiKey(
+	ctx context.Context,
+	request *adminservice.RotateNamespaceApiKeyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RotateNamespaceApiKeyResponse, error) {
+	var resp *adminservice.RotateNamespaceApiKeyResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.RotateNamespaceApiKey(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) SetPersistenceFault(
+	ctx context.Context,
+	request *adminservice.SetPersistenceFaultRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SetPersistenceFaultResponse, error) {
+	var resp *adminservice.SetPersistenceFaultResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.SetPersistenceFault(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UnpauseActivityExecution(
+	ctx context.Context,
+	request *adminservice.UnpauseActivityExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UnpauseActivityExecutionResponse, error) {
+	var resp *adminservice.UnpauseActivityExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UnpauseActivityExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.UnpauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UnpauseWorkflowExecutionResponse, error) {
+	var resp *adminservice.UnpauseWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UnpauseWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateActivityExecutionOptions(
+	ctx context.Context,
+	request *adminservice.UpdateActivityExecutionOptionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateActivityExecutionOptionsResponse, error) {
+	var resp *adminservice.UpdateActivityExecutionOptionsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateActivityExecutionOptions(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
@@ -178,6 +178,30 @@ func (c *clientImpl) GenerateLastHistoryReplicationTasks(
 	return response, nil
 }
 
+func (c *clientImpl) GenerateVisibilityTasks(
+	ctx context.Context,
+	request *historyservice.GenerateVisibilityTasksRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.GenerateVisibilityTasksResponse, error) {
+	client, err := c.getClientForWorkflowID(request.NamespaceId, request.GetExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
+	var response *historyservice.GenerateVisibilityTasksResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.GenerateVisibilityTasks(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) GetDLQMessages(
 	ctx context.Context,
 	request *historyservice.GetDLQMessagesRequest,
@@ -302,6 +326,54 @@ func (c *clientImpl) MergeDLQMessages(
 	return response, nil
 }
 
+func (c *clientImpl) PauseActivityExecution(
+	ctx context.Context,
+	request *historyservice.PauseActivityExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.PauseActivityExecutionResponse, error) {
+	client, err := c.getClientForWorkflowID(request.NamespaceId, request.GetExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
+	var response *historyservice.PauseActivityExecutionResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.PauseActivityExecution(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.PauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.PauseWorkflowExecutionResponse, error) {
+	client, err := c.getClientForWorkflowID(request.NamespaceId, request.GetExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
+	var response *historyservice.PauseWorkflowExecutionResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.PauseWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) PollMutableState(
 	ctx context.Context,
 	request *historyservice.PollMutableStateRequest,
@@ -667,6 +739,30 @@ func (c *clientImpl) RequestCancelWorkflowExecution(
 	return response, nil
 }
 
+func (c *clientImpl) ResetActivityExecution(
+	ctx context.Context,
+	request *historyservice.ResetActivityExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ResetActivityExecutionResponse, error) {
+	client, err := c.getClientForWorkflowID(request.NamespaceId, request.GetExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
+	var response *historyservice.ResetActivityExecutionResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.ResetActivityExecution(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) ResetStickyTaskQueue(
 	ctx context.Context,
 	request *historyservice.ResetStickyTaskQueueRequest,
@@ -715,6 +811,30 @@ func (c *clientImpl) ResetWorkflowExecution(
 	return response, nil
 }
 
+func (c *clientImpl) ResolveResetPoint(
+	ctx context.Context,
+	request *historyservice.ResolveResetPointRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ResolveResetPointResponse, error) {
+	client, err := c.getClientForWorkflowID(request.NamespaceId, request.GetExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
+	var response *historyservice.ResolveResetPointResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.ResolveResetPoint(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) RespondActivityTaskCanceled(
 	ctx context.Context,
 	request *historyservice.RespondActivityTaskCanceledRequest,
@@ -1028,6 +1148,78 @@ func (c *clientImpl) TerminateWorkflowExecution(
 	return response, nil
 }
 
+func (c *clientImpl) UnpauseActivityExecution(
+	ctx context.Context,
+	request *historyservice.UnpauseActivityExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UnpauseActivityExecutionResponse, error) {
+	client, err := c.getClientForWorkflowID(request.NamespaceId, request.GetExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
+	var response *historyservice.UnpauseActivityExecutionResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.UnpauseActivityExecution(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.UnpauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UnpauseWorkflowExecutionResponse, error) {
+	client, err := c.getClientForWorkflowID(request.NamespaceId, request.GetExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
+	var response *historyservice.UnpauseWorkflowExecutionResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.UnpauseWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) UpdateActivityExecutionOptions(
+	ctx context.Context,
+	request *historyservice.UpdateActivityExecutionOptionsRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UpdateActivityExecutionOptionsResponse, error) {
+	client, err := c.getClientForWorkflowID(request.NamespaceId, request.GetExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
+	var response *historyservice.UpdateActivityExecutionOptionsResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.UpdateActivityExecutionOptions(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) UpdateWorkflowExecution(
 	ctx context.Context,
 	request *historyservice.UpdateWorkflowExecutionRequest,
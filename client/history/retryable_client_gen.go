@@ -140,6 +140,21 @@ func (c *retryableClient) GenerateLastHistoryReplicationTasks(
 	return resp, err
 }
 
+func (c *retryableClient) GenerateVisibilityTasks(
+	ctx context.Context,
+	request *historyservice.GenerateVisibilityTasksRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.GenerateVisibilityTasksResponse, error) {
+	var resp *historyservice.GenerateVisibilityTasksResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GenerateVisibilityTasks(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GetDLQMessages(
 	ctx context.Context,
 	request *historyservice.GetDLQMessagesRequest,
@@ -245,6 +260,36 @@ func (c *retryableClient) MergeDLQMessages(
 	return resp, err
 }
 
+func (c *retryableClient) PauseActivityExecution(
+	ctx context.Context,
+	request *historyservice.PauseActivityExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.PauseActivityExecutionResponse, error) {
+	var resp *historyservice.PauseActivityExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.PauseActivityExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) PauseWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.PauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.PauseWorkflowExecutionResponse, error) {
+	var resp *historyservice.PauseWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.PauseWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) PollMutableState(
 	ctx context.Context,
 	request *historyservice.PollMutableStateRequest,
@@ -470,6 +515,21 @@ func (c *retryableClient) RequestCancelWorkflowExecution(
 	return resp, err
 }
 
+func (c *retryableClient) ResetActivityExecution(
+	ctx context.Context,
+	request *historyservice.ResetActivityExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ResetActivityExecutionResponse, error) {
+	var resp *historyservice.ResetActivityExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ResetActivityExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ResetStickyTaskQueue(
 	ctx context.Context,
 	request *historyservice.ResetStickyTaskQueueRequest,
@@ -500,6 +560,21 @@ func (c *retryableClient) ResetWorkflowExecution(
 	return resp, err
 }
 
+func (c *retryableClient) ResolveResetPoint(
+	ctx context.Context,
+	request *historyservice.ResolveResetPointRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ResolveResetPointResponse, error) {
+	var resp *historyservice.ResolveResetPointResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ResolveResetPoint(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) RespondActivityTaskCanceled(
 	ctx context.Context,
 	request *historyservice.RespondActivityTaskCanceledRequest,
@@ -680,6 +755,51 @@ func (c *retryableClient) TerminateWorkflowExecution(
 	return resp, err
 }
 
+func (c *retryableClient) UnpauseActivityExecution(
+	ctx context.Context,
+	request *historyservice.UnpauseActivityExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UnpauseActivityExecutionResponse, error) {
+	var resp *historyservice.UnpauseActivityExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UnpauseActivityExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.UnpauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UnpauseWorkflowExecutionResponse, error) {
+	var resp *historyservice.UnpauseWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UnpauseWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateActivityExecutionOptions(
+	ctx context.Context,
+	request *historyservice.UpdateActivityExecutionOptionsRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UpdateActivityExecutionOptionsResponse, error) {
+	var resp *historyservice.UpdateActivityExecutionOptionsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateActivityExecutionOptions(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) UpdateWorkflowExecution(
 	ctx context.Context,
 	request *historyservice.UpdateWorkflowExecutionRequest,
@@ -165,10 +165,12 @@ func buildCLI() *cli.App {
 
 				authorizer, err := authorization.GetAuthorizerFromConfig(
 					&cfg.Global.Authorization,
+					logger,
 				)
 				if err != nil {
 					return cli.Exit(fmt.Sprintf("Unable to instantiate authorizer. Error: %v", err), 1)
 				}
+				defer authorization.CloseAuthorizer(authorizer)
 				if authorization.IsNoopAuthorizer(authorizer) && !allowNoAuth {
 					logger.Warn(
 						"Not using any authorizer and flag `--allow-no-auth` not detected. " +
@@ -221,7 +221,20 @@ func (i *historyIterator) readHistory(firstEventID int64) ([]*historypb.History,
 		PageSize:    i.historyPageSize,
 		ShardID:     i.request.ShardID,
 	}
-	historyBatches, _, _, err := persistence.ReadFullPageEventsByBatch(context.TODO(), i.executionManager, req)
+	ctx := context.TODO()
+	if firstEventID >= i.request.NextEventID {
+		// the end of history can only be detected reliably on the primary
+		historyBatches, _, _, err := persistence.ReadFullPageEventsByBatch(ctx, i.executionManager, req)
+		return historyBatches, err
+	}
+
+	// Events before NextEventID were written before the workflow closed, so they can be read from
+	// a replica. A replica that has not caught up yet returns fewer events, in which case the
+	// iterator reads the remaining events again, or NotFound, in which case the primary is asked.
+	historyBatches, _, _, err := persistence.ReadFullPageEventsByBatch(persistence.WithStaleReads(ctx), i.executionManager, req)
+	if _, isNotFound := err.(*serviceerror.NotFound); isNotFound {
+		historyBatches, _, _, err = persistence.ReadFullPageEventsByBatch(ctx, i.executionManager, req)
+	}
 	return historyBatches, err
 }
 
@@ -646,7 +646,12 @@ func (s *HistoryIteratorSuite) initMockExecutionManager(batchInfo []int, returnE
 			PageSize:    testDefaultPersistencePageSize,
 			ShardID:     testShardId,
 		}
-		s.mockExecutionMgr.EXPECT().ReadHistoryBranchByBatch(gomock.Any(), req).Return(nil, serviceerror.NewNotFound("Reach the end"))
+		// the end of history is read from a replica first if it is before testNextEventID
+		times := 1
+		if req.MinEventID < testNextEventID {
+			times = 2
+		}
+		s.mockExecutionMgr.EXPECT().ReadHistoryBranchByBatch(gomock.Any(), req).Return(nil, serviceerror.NewNotFound("Reach the end")).Times(times)
 	}
 }
 
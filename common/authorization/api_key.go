@@ -0,0 +1,223 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/namespace"
+)
+
+const (
+	// APIKeyTokenPrefix is the prefix of the bearer tokens of API keys issued by the server
+	APIKeyTokenPrefix = "tk_"
+
+	apiKeyIDLength      = 8
+	apiKeySecretLength  = 32
+	apiKeyTokenParts    = 3
+	apiKeySubjectPrefix = "apikey:"
+)
+
+var errInvalidAPIKeyToken = errors.New("invalid API key token")
+
+type (
+	// APIKeyToken is the bearer token of an API key: tk_<namespace id>_<key id>_<secret>.
+	// Only the hash of the secret is stored by the server.
+	APIKeyToken struct {
+		NamespaceID string
+		KeyID       string
+		Secret      string
+	}
+
+	// APIKeyUsageRecorder records the time an API key was used to authenticate a request
+	APIKeyUsageRecorder interface {
+		RecordUsage(keyID string)
+	}
+
+	// Claim mapper for tokens of API keys issued by the server. Other tokens are mapped by the
+	// wrapped claim mapper.
+	apiKeyClaimMapper struct {
+		claimMapper       ClaimMapper
+		namespaceRegistry namespace.Registry
+		usageRecorder     APIKeyUsageRecorder
+		timeSource        clock.TimeSource
+		enabled           dynamicconfig.BoolPropertyFn
+	}
+)
+
+var _ ClaimMapper = (*apiKeyClaimMapper)(nil)
+
+// NewAPIKeyToken generates a token with a new key id and secret for an API key of the namespace
+func NewAPIKeyToken(namespaceID namespace.ID) (*APIKeyToken, error) {
+	keyID, err := randomHex(apiKeyIDLength)
+	if err != nil {
+		return nil, err
+	}
+	token := &APIKeyToken{NamespaceID: namespaceID.String(), KeyID: keyID}
+	if err := token.RotateSecret(); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+// ParseAPIKeyToken parses a token returned by APIKeyToken.String
+func ParseAPIKeyToken(token string) (*APIKeyToken, error) {
+	if !strings.HasPrefix(token, APIKeyTokenPrefix) {
+		return nil, errInvalidAPIKeyToken
+	}
+	parts := strings.Split(token[len(APIKeyTokenPrefix):], "_")
+	if len(parts) != apiKeyTokenParts {
+		return nil, errInvalidAPIKeyToken
+	}
+	for _, part := range parts {
+		if part == "" {
+			return nil, errInvalidAPIKeyToken
+		}
+	}
+	return &APIKeyToken{NamespaceID: parts[0], KeyID: parts[1], Secret: parts[2]}, nil
+}
+
+// RotateSecret replaces the secret of the token with a new random one
+func (t *APIKeyToken) RotateSecret() error {
+	secret, err := randomHex(apiKeySecretLength)
+	if err != nil {
+		return err
+	}
+	t.Secret = secret
+	return nil
+}
+
+// SecretHash is the hash of the secret stored for the API key
+func (t *APIKeyToken) SecretHash() string {
+	return HashAPIKeySecret(t.Secret)
+}
+
+func (t *APIKeyToken) String() string {
+	return APIKeyTokenPrefix + t.NamespaceID + "_" + t.KeyID + "_" + t.Secret
+}
+
+// HashAPIKeySecret returns the hex encoded SHA-256 hash of the secret of an API key. Secrets are
+// random and long enough for a fast hash to be sufficient.
+func HashAPIKeySecret(secret string) string {
+	hash := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(hash[:])
+}
+
+// CanManageAPIKeys returns true if claims grant the admin role on the namespace or on the system
+func CanManageAPIKeys(claims *Claims, namespace string) bool {
+	if claims == nil {
+		return false
+	}
+	return claims.System >= RoleAdmin || claims.Namespaces[strings.ToLower(namespace)] >= RoleAdmin
+}
+
+// CanGrantAPIKeyRole returns true if the role of an API key is not above the role claims grant on the namespace
+func CanGrantAPIKeyRole(claims *Claims, namespace string, role string) bool {
+	if claims == nil {
+		return false
+	}
+	return permissionToRole(role) <= claims.System|claims.Namespaces[strings.ToLower(namespace)]
+}
+
+// ValidateAPIKeyRole returns an error if role is not a role that can be granted to an API key
+func ValidateAPIKeyRole(role string) error {
+	if permissionToRole(role) == RoleUndefined {
+		return fmt.Errorf("unknown role %q, expected one of %s, %s, %s or %s",
+			role, permissionRead, permissionWrite, permissionWorker, permissionAdmin)
+	}
+	return nil
+}
+
+// NewAPIKeyClaimMapper returns a claim mapper that maps the tokens of API keys issued by the server
+// to the role of the key on its namespace and passes any other token to claimMapper.
+func NewAPIKeyClaimMapper(
+	claimMapper ClaimMapper,
+	namespaceRegistry namespace.Registry,
+	usageRecorder APIKeyUsageRecorder,
+	timeSource clock.TimeSource,
+	enabled dynamicconfig.BoolPropertyFn,
+) ClaimMapper {
+	return &apiKeyClaimMapper{
+		claimMapper:       claimMapper,
+		namespaceRegistry: namespaceRegistry,
+		usageRecorder:     usageRecorder,
+		timeSource:        timeSource,
+		enabled:           enabled,
+	}
+}
+
+func (m *apiKeyClaimMapper) GetClaims(authInfo *AuthInfo) (*Claims, error) {
+	parts := strings.Split(authInfo.AuthToken, " ")
+	if len(parts) != 2 || !strings.EqualFold(parts[0], authorizationBearer) ||
+		!strings.HasPrefix(parts[1], APIKeyTokenPrefix) || !m.enabled() {
+		return m.claimMapper.GetClaims(authInfo)
+	}
+
+	token, err := ParseAPIKeyToken(parts[1])
+	if err != nil {
+		return nil, serviceerror.NewPermissionDenied(err.Error(), "")
+	}
+	ns, err := m.namespaceRegistry.GetNamespaceByID(namespace.ID(token.NamespaceID))
+	if err != nil {
+		return nil, serviceerror.NewPermissionDenied("unknown API key namespace", "")
+	}
+	key, ok := ns.APIKey(token.KeyID)
+	if !ok || subtle.ConstantTimeCompare([]byte(key.GetSecretHash()), []byte(token.SecretHash())) != 1 {
+		return nil, serviceerror.NewPermissionDenied("unknown API key", "")
+	}
+	if key.ExpireTime != nil && !m.timeSource.Now().Before(*key.ExpireTime) {
+		return nil, serviceerror.NewPermissionDenied("API key has expired", "")
+	}
+	role := permissionToRole(key.GetRole())
+	if role == RoleUndefined {
+		return nil, serviceerror.NewPermissionDenied("API key has no valid role", "")
+	}
+
+	if m.usageRecorder != nil {
+		m.usageRecorder.RecordUsage(key.GetId())
+	}
+	return &Claims{
+		Subject:    apiKeySubjectPrefix + key.GetId(),
+		Namespaces: map[string]Role{ns.Name().String(): role},
+	}, nil
+}
+
+func randomHex(length int) (string, error) {
+	b := make([]byte, length)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
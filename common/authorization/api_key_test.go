@@ -0,0 +1,182 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+)
+
+const testAPIKeyNamespaceID = "6ee7b4ce-3fd4-4f4f-a2a5-6e2ec7a3fd1c"
+
+func testAPIKeyNamespace(keys ...*persistencespb.NamespaceApiKey) *namespace.Namespace {
+	detail := &persistencespb.NamespaceDetail{
+		Info:              &persistencespb.NamespaceInfo{Id: testAPIKeyNamespaceID, Name: "test-namespace"},
+		Config:            &persistencespb.NamespaceConfig{},
+		ReplicationConfig: &persistencespb.NamespaceReplicationConfig{},
+		ApiKeys:           make(map[string]*persistencespb.NamespaceApiKey),
+	}
+	for _, key := range keys {
+		detail.ApiKeys[key.Id] = key
+	}
+	return namespace.FromPersistentState(&persistence.GetNamespaceResponse{Namespace: detail})
+}
+
+func TestAPIKeyToken(t *testing.T) {
+	token, err := NewAPIKeyToken(testAPIKeyNamespaceID)
+	require.NoError(t, err)
+	require.Len(t, token.KeyID, 2*apiKeyIDLength)
+	require.Len(t, token.Secret, 2*apiKeySecretLength)
+
+	parsed, err := ParseAPIKeyToken(token.String())
+	require.NoError(t, err)
+	require.Equal(t, token, parsed)
+	require.Equal(t, token.SecretHash(), parsed.SecretHash())
+
+	secret := token.Secret
+	require.NoError(t, token.RotateSecret())
+	require.NotEqual(t, secret, token.Secret)
+
+	for _, invalid := range []string{"", "tk_", "tk_a_b", "tk_a__c", "xx_a_b_c", "tk_a_b_c_d"} {
+		_, err := ParseAPIKeyToken(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestValidateAPIKeyRole(t *testing.T) {
+	for _, role := range []string{"read", "write", "worker", "admin", "Admin"} {
+		require.NoError(t, ValidateAPIKeyRole(role))
+	}
+	require.Error(t, ValidateAPIKeyRole("owner"))
+	require.Error(t, ValidateAPIKeyRole(""))
+}
+
+func TestAPIKeyClaimMapper(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	registry := namespace.NewMockRegistry(ctrl)
+	nextMapper := NewMockClaimMapper(ctrl)
+	timeSource := clock.NewEventTimeSource().Update(time.Unix(1000, 0))
+	tracker := NewAPIKeyUsageTracker(nil, timeSource, dynamicconfig.GetDurationPropertyFn(time.Minute), log.NewNoopLogger())
+
+	token, err := NewAPIKeyToken(testAPIKeyNamespaceID)
+	require.NoError(t, err)
+	expireTime := timeSource.Now().Add(time.Hour)
+	key := &persistencespb.NamespaceApiKey{
+		Id:         token.KeyID,
+		Role:       "write",
+		SecretHash: token.SecretHash(),
+		ExpireTime: &expireTime,
+	}
+	registry.EXPECT().GetNamespaceByID(namespace.ID(testAPIKeyNamespaceID)).Return(testAPIKeyNamespace(key), nil).AnyTimes()
+
+	enabled := true
+	mapper := NewAPIKeyClaimMapper(nextMapper, registry, tracker, timeSource, func() bool { return enabled })
+
+	claims, err := mapper.GetClaims(&AuthInfo{AuthToken: "Bearer " + token.String()})
+	require.NoError(t, err)
+	require.Equal(t, apiKeySubjectPrefix+token.KeyID, claims.Subject)
+	require.Equal(t, RoleUndefined, claims.System)
+	require.Equal(t, map[string]Role{"test-namespace": RoleWriter}, claims.Namespaces)
+	require.Contains(t, tracker.pending, token.KeyID)
+
+	wrongSecret := *token
+	require.NoError(t, wrongSecret.RotateSecret())
+	_, err = mapper.GetClaims(&AuthInfo{AuthToken: "Bearer " + wrongSecret.String()})
+	require.Error(t, err)
+
+	unknownKey := *token
+	unknownKey.KeyID = "0000"
+	_, err = mapper.GetClaims(&AuthInfo{AuthToken: "Bearer " + unknownKey.String()})
+	require.Error(t, err)
+
+	timeSource.Update(expireTime)
+	_, err = mapper.GetClaims(&AuthInfo{AuthToken: "Bearer " + token.String()})
+	require.Error(t, err)
+
+	// other tokens, and API key tokens while API keys are disabled, are mapped by the wrapped claim mapper
+	jwtAuthInfo := &AuthInfo{AuthToken: "Bearer header.payload.signature"}
+	nextMapper.EXPECT().GetClaims(jwtAuthInfo).Return(&Claims{Subject: "jwt"}, nil)
+	claims, err = mapper.GetClaims(jwtAuthInfo)
+	require.NoError(t, err)
+	require.Equal(t, "jwt", claims.Subject)
+
+	enabled = false
+	apiKeyAuthInfo := &AuthInfo{AuthToken: "Bearer " + token.String()}
+	nextMapper.EXPECT().GetClaims(apiKeyAuthInfo).Return(nil, errInvalidAPIKeyToken)
+	_, err = mapper.GetClaims(apiKeyAuthInfo)
+	require.ErrorIs(t, err, errInvalidAPIKeyToken)
+}
+
+func TestAPIKeyUsageTracker(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	usageManager := persistence.NewMockAPIKeyUsageManager(ctrl)
+	timeSource := clock.NewEventTimeSource().Update(time.Unix(1000, 0))
+	tracker := NewAPIKeyUsageTracker(usageManager, timeSource, dynamicconfig.GetDurationPropertyFn(time.Minute), log.NewNoopLogger())
+
+	firstUse := timeSource.Now()
+	tracker.RecordUsage("key-1")
+	timeSource.Update(firstUse.Add(time.Second))
+	tracker.RecordUsage("key-1")
+	tracker.RecordUsage("key-2")
+	require.Equal(t, map[string]time.Time{"key-1": firstUse, "key-2": timeSource.Now()}, tracker.pending)
+
+	// usage that fails to be written is written with the next flush
+	usageManager.EXPECT().UpdateLastUsedTimes(gomock.Any(), map[string]time.Time{"key-1": firstUse, "key-2": timeSource.Now()}).
+		Return(errors.New("unavailable"))
+	tracker.flush()
+	usageManager.EXPECT().UpdateLastUsedTimes(gomock.Any(), map[string]time.Time{"key-1": firstUse, "key-2": timeSource.Now()}).
+		Return(nil)
+	tracker.flush()
+	require.Empty(t, tracker.pending)
+	tracker.flush()
+
+	// usage is recorded again once the update interval passed
+	timeSource.Update(firstUse.Add(time.Minute))
+	tracker.RecordUsage("key-1")
+	require.Equal(t, map[string]time.Time{"key-1": timeSource.Now()}, tracker.pending)
+}
+
+func TestCanGrantAPIKeyRole(t *testing.T) {
+	writer := &Claims{Namespaces: map[string]Role{"ns": RoleWriter}}
+	require.True(t, CanGrantAPIKeyRole(writer, "NS", "read"))
+	require.True(t, CanGrantAPIKeyRole(writer, "ns", "write"))
+	require.False(t, CanGrantAPIKeyRole(writer, "ns", "admin"))
+	require.False(t, CanGrantAPIKeyRole(writer, "other", "read"))
+
+	admin := &Claims{System: RoleAdmin}
+	require.True(t, CanGrantAPIKeyRole(admin, "ns", "admin"))
+	require.False(t, CanGrantAPIKeyRole(nil, "ns", "read"))
+}
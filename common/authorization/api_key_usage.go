@@ -0,0 +1,146 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence"
+)
+
+const apiKeyUsageFlushTimeout = 10 * time.Second
+
+type (
+	// APIKeyUsageTracker keeps the last used time of API keys in memory and periodically writes it
+	// to the APIKeyUsageManager. The time is written at most once per update interval for a key.
+	APIKeyUsageTracker struct {
+		status         int32
+		usageManager   persistence.APIKeyUsageManager
+		timeSource     clock.TimeSource
+		updateInterval dynamicconfig.DurationPropertyFn
+		logger         log.Logger
+		shutdownChan   chan struct{}
+
+		sync.Mutex
+		// last used time recorded for each key, which throttles writes of the key
+		recorded map[string]time.Time
+		pending  map[string]time.Time
+	}
+)
+
+var _ APIKeyUsageRecorder = (*APIKeyUsageTracker)(nil)
+
+func NewAPIKeyUsageTracker(
+	usageManager persistence.APIKeyUsageManager,
+	timeSource clock.TimeSource,
+	updateInterval dynamicconfig.DurationPropertyFn,
+	logger log.Logger,
+) *APIKeyUsageTracker {
+	return &APIKeyUsageTracker{
+		status:         common.DaemonStatusInitialized,
+		usageManager:   usageManager,
+		timeSource:     timeSource,
+		updateInterval: updateInterval,
+		logger:         logger,
+		shutdownChan:   make(chan struct{}),
+		recorded:       make(map[string]time.Time),
+		pending:        make(map[string]time.Time),
+	}
+}
+
+func (t *APIKeyUsageTracker) Start() {
+	if !atomic.CompareAndSwapInt32(&t.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+	go t.flushLoop()
+}
+
+func (t *APIKeyUsageTracker) Stop() {
+	if !atomic.CompareAndSwapInt32(&t.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	close(t.shutdownChan)
+	t.flush()
+}
+
+// RecordUsage records that the key was used now. It is a no-op if the usage of the key was recorded
+// less than the update interval ago.
+func (t *APIKeyUsageTracker) RecordUsage(keyID string) {
+	now := t.timeSource.Now()
+
+	t.Lock()
+	defer t.Unlock()
+	if recorded, ok := t.recorded[keyID]; ok && now.Sub(recorded) < t.updateInterval() {
+		return
+	}
+	t.recorded[keyID] = now
+	t.pending[keyID] = now
+}
+
+func (t *APIKeyUsageTracker) flushLoop() {
+	timer := time.NewTimer(t.updateInterval())
+	defer timer.Stop()
+	for {
+		select {
+		case <-t.shutdownChan:
+			return
+		case <-timer.C:
+			t.flush()
+			timer.Reset(t.updateInterval())
+		}
+	}
+}
+
+func (t *APIKeyUsageTracker) flush() {
+	t.Lock()
+	pending := t.pending
+	t.pending = make(map[string]time.Time)
+	t.Unlock()
+	if len(pending) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), apiKeyUsageFlushTimeout)
+	defer cancel()
+	if err := t.usageManager.UpdateLastUsedTimes(ctx, pending); err != nil {
+		t.logger.Warn("Unable to update last used time of API keys", tag.Error(err))
+		// the usage is written with the next flush
+		t.Lock()
+		for keyID, lastUsedTime := range pending {
+			if current, ok := t.pending[keyID]; !ok || current.Before(lastUsedTime) {
+				t.pending[keyID] = lastUsedTime
+			}
+		}
+		t.Unlock()
+	}
+}
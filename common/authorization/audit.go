@@ -0,0 +1,542 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -copyright_file ../../LICENSE -package $GOPACKAGE -source $GOFILE -destination audit_mock.go
+
+package authorization
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pborman/uuid"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+const (
+	// AuditDropPolicyDrop drops the records that can't be buffered or written
+	AuditDropPolicyDrop = "drop"
+	// AuditDropPolicyBlock makes calls wait for room in the buffer, and retries failed writes
+	AuditDropPolicyBlock = "block"
+	// AuditDropPolicyFail retries failed writes, and rejects mutating calls while the buffer is
+	// full or writes fail
+	AuditDropPolicyFail = "fail"
+)
+
+const (
+	defaultAuditBufferSize    = 10000
+	defaultAuditBatchSize     = 100
+	defaultAuditFlushInterval = time.Second
+
+	auditWriteTimeout           = 10 * time.Second
+	auditRetentionCheckInterval = time.Hour
+	auditRetentionCheckTimeout  = time.Minute
+	auditWriteErrorLogPeriod    = time.Minute
+	auditWriteRetryInterval     = time.Second
+)
+
+// @@@SNIPSTART temporal-common-authorization-auditsink-interface
+// AuditSink records mutating API calls. It is invoked by the authorization interceptor after the
+// call completes, for calls that were allowed as well as for calls that were denied.
+type AuditSink interface {
+	Write(ctx context.Context, record *persistencespb.AuditRecord) error
+}
+
+// @@@SNIPEND
+
+var (
+	errAuditBufferFull  = errors.New("audit buffer is full")
+	errAuditUnavailable = serviceerror.NewUnavailable("Audit records can't be written.")
+)
+
+type (
+	hasWorkflowExecution interface {
+		GetWorkflowExecution() *commonpb.WorkflowExecution
+	}
+
+	hasExecution interface {
+		GetExecution() *commonpb.WorkflowExecution
+	}
+
+	hasWorkflowID interface {
+		GetWorkflowId() string
+	}
+
+	marshaler interface {
+		Marshal() ([]byte, error)
+	}
+
+	// auditChain links the records written by a sink into a hash chain
+	auditChain struct {
+		sync.Mutex
+		writerID       string
+		previousDigest string
+	}
+
+	// auditWriter writes batches of records for an asyncAuditSink. Batches are written one at a time.
+	auditWriter interface {
+		writeRecords(ctx context.Context, records []*persistencespb.AuditRecord) error
+	}
+
+	// auditGate is implemented by sinks that reject calls they can't record
+	auditGate interface {
+		accepting() bool
+	}
+
+	// asyncAuditSink buffers records and writes them in batches from a single goroutine, so that
+	// auditing doesn't add latency to the calls. What happens to records that can't be buffered or
+	// written depends on the drop policy.
+	asyncAuditSink struct {
+		status         int32
+		failing        int32
+		writer         auditWriter
+		dropPolicy     string
+		metricsHandler metrics.Handler
+		logger         log.Logger
+		batchSize      int
+		flushInterval  time.Duration
+		retryInterval  time.Duration
+		recordC        chan *persistencespb.AuditRecord
+		shutdownChan   chan struct{}
+		doneChan       chan struct{}
+	}
+
+	persistenceAuditWriter struct {
+		status       int32
+		chain        auditChain
+		manager      persistence.AuditLogManager
+		partition    int
+		retention    time.Duration
+		logger       log.Logger
+		shutdownChan chan struct{}
+	}
+)
+
+var (
+	_ AuditSink     = (*asyncAuditSink)(nil)
+	_ auditGate     = (*asyncAuditSink)(nil)
+	_ common.Daemon = (*asyncAuditSink)(nil)
+)
+
+var auditedServices = []string{
+	"/temporal.api.workflowservice.v1.WorkflowService/",
+	"/temporal.server.api.adminservice.v1.AdminService/",
+	"/temporal.api.operatorservice.v1.OperatorService/",
+}
+
+// prefixes of API names that do not change any state, or that are called by workers
+var nonMutatingAPIPrefixes = []string{
+	"Get",
+	"List",
+	"Describe",
+	"Count",
+	"Scan",
+	"Query",
+	"Poll",
+	"Respond",
+	"RecordActivityTaskHeartbeat",
+}
+
+// IsMutatingAPI returns true for frontend, admin and operator APIs that change state on behalf of
+// a user. Read-only APIs and APIs called by workers to process tasks are not mutating.
+func IsMutatingAPI(fullAPIName string) bool {
+	audited := false
+	for _, service := range auditedServices {
+		if strings.HasPrefix(fullAPIName, service) {
+			audited = true
+			break
+		}
+	}
+	if !audited {
+		return false
+	}
+
+	api := ApiName(fullAPIName)
+	if IsReadOnlyNamespaceAPI(api) || IsReadOnlyGlobalAPI(api) {
+		return false
+	}
+	for _, prefix := range nonMutatingAPIPrefixes {
+		if strings.HasPrefix(api, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsPersistenceAuditSink returns true if the audit sink configured for authorization writes to
+// persistence and so needs an AuditLogManager.
+func IsPersistenceAuditSink(config *config.Authorization) bool {
+	return strings.ToLower(config.Audit.Sink) == "persistence"
+}
+
+// GetAuditSinkFromConfig creates the audit sink configured for authorization, nil if auditing is
+// disabled. The persistence sink writes through the given manager. The sink must be started before
+// records are written, and stopping it writes the records that are still buffered.
+func GetAuditSinkFromConfig(
+	config *config.Authorization,
+	auditLogManager persistence.AuditLogManager,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) (AuditSink, error) {
+
+	if config.Audit.Sink == "" {
+		return nil, nil
+	}
+	switch strings.ToLower(config.Audit.DropPolicy) {
+	case "", AuditDropPolicyDrop, AuditDropPolicyBlock, AuditDropPolicyFail:
+	default:
+		return nil, fmt.Errorf("unknown audit drop policy: %s", config.Audit.DropPolicy)
+	}
+
+	switch strings.ToLower(config.Audit.Sink) {
+	case "file":
+		return NewFileAuditSink(config.Audit, metricsHandler, logger)
+	case "persistence":
+		if auditLogManager == nil {
+			return nil, fmt.Errorf("audit log manager is not configured")
+		}
+		return NewPersistenceAuditSink(config.Audit, auditLogManager, metricsHandler, logger), nil
+	}
+	return nil, fmt.Errorf("unknown audit sink: %s", config.Audit.Sink)
+}
+
+// NewPersistenceAuditSink creates an audit sink that appends records to the queue table. Each sink
+// writes to one partition of the audit log, picked by its writer ID. Sinks that share a partition
+// retry conflicting appends. Records older than the
+// configured retention are deleted. Records can be listed with the ListAuditRecords admin API.
+func NewPersistenceAuditSink(
+	cfg config.Audit,
+	manager persistence.AuditLogManager,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) AuditSink {
+	writer := &persistenceAuditWriter{
+		status:       common.DaemonStatusInitialized,
+		chain:        newAuditChain(),
+		manager:      manager,
+		retention:    cfg.Retention,
+		logger:       logger,
+		shutdownChan: make(chan struct{}),
+	}
+	partition := fnv.New32a()
+	_, _ = partition.Write([]byte(writer.chain.writerID))
+	writer.partition = int(partition.Sum32() % persistence.AuditLogQueuePartitions)
+	return newAsyncAuditSink(cfg, writer, metricsHandler, logger)
+}
+
+func newAsyncAuditSink(
+	cfg config.Audit,
+	writer auditWriter,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) *asyncAuditSink {
+	bufferSize := cfg.BufferSize
+	if bufferSize <= 0 {
+		bufferSize = defaultAuditBufferSize
+	}
+	batchSize := cfg.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultAuditBatchSize
+	}
+	flushInterval := cfg.FlushInterval
+	if flushInterval <= 0 {
+		flushInterval = defaultAuditFlushInterval
+	}
+	dropPolicy := strings.ToLower(cfg.DropPolicy)
+	if dropPolicy == "" {
+		dropPolicy = AuditDropPolicyDrop
+	}
+	return &asyncAuditSink{
+		status:         common.DaemonStatusInitialized,
+		writer:         writer,
+		dropPolicy:     dropPolicy,
+		metricsHandler: metricsHandler.WithTags(metrics.OperationTag(metrics.AuthorizationScope)),
+		logger:         log.NewThrottledLogger(logger, func() float64 { return 1 / auditWriteErrorLogPeriod.Seconds() }),
+		batchSize:      batchSize,
+		flushInterval:  flushInterval,
+		retryInterval:  auditWriteRetryInterval,
+		recordC:        make(chan *persistencespb.AuditRecord, bufferSize),
+		shutdownChan:   make(chan struct{}),
+		doneChan:       make(chan struct{}),
+	}
+}
+
+func (s *asyncAuditSink) Start() {
+	if !atomic.CompareAndSwapInt32(&s.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+	if daemon, ok := s.writer.(common.Daemon); ok {
+		daemon.Start()
+	}
+	go s.writeLoop()
+}
+
+func (s *asyncAuditSink) Stop() {
+	if !atomic.CompareAndSwapInt32(&s.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	close(s.shutdownChan)
+	<-s.doneChan
+	if daemon, ok := s.writer.(common.Daemon); ok {
+		daemon.Stop()
+	}
+}
+
+// Write buffers the record, and returns an error if the buffer is full. With the block drop
+// policy it waits for room in the buffer until the context is done.
+func (s *asyncAuditSink) Write(ctx context.Context, record *persistencespb.AuditRecord) error {
+	if s.dropPolicy == AuditDropPolicyBlock {
+		select {
+		case s.recordC <- record:
+			return nil
+		case <-ctx.Done():
+			s.recordDropped(1)
+			return ctx.Err()
+		}
+	}
+
+	select {
+	case s.recordC <- record:
+		return nil
+	default:
+		s.recordDropped(1)
+		return errAuditBufferFull
+	}
+}
+
+// accepting returns false if the fail drop policy rejects calls, because the buffer is full or
+// the last write failed
+func (s *asyncAuditSink) accepting() bool {
+	if s.dropPolicy != AuditDropPolicyFail {
+		return true
+	}
+	return atomic.LoadInt32(&s.failing) == 0 && len(s.recordC) < cap(s.recordC)
+}
+
+func (s *asyncAuditSink) writeLoop() {
+	defer close(s.doneChan)
+
+	ticker := time.NewTicker(s.flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*persistencespb.AuditRecord, 0, s.batchSize)
+	for {
+		select {
+		case record := <-s.recordC:
+			batch = append(batch, record)
+			if len(batch) >= s.batchSize {
+				batch = s.flush(batch)
+			}
+		case <-ticker.C:
+			batch = s.flush(batch)
+		case <-s.shutdownChan:
+			for {
+				select {
+				case record := <-s.recordC:
+					batch = append(batch, record)
+					if len(batch) >= s.batchSize {
+						batch = s.flush(batch)
+					}
+				default:
+					s.flush(batch)
+					return
+				}
+			}
+		}
+	}
+}
+
+// flush writes a batch and returns the emptied batch. A batch that can't be written is dropped with
+// the drop policy, and retried until the sink is stopped otherwise.
+func (s *asyncAuditSink) flush(batch []*persistencespb.AuditRecord) []*persistencespb.AuditRecord {
+	if len(batch) == 0 {
+		return batch
+	}
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), auditWriteTimeout)
+		err := s.writer.writeRecords(ctx, batch)
+		cancel()
+		if err == nil {
+			atomic.StoreInt32(&s.failing, 0)
+			return batch[:0]
+		}
+
+		s.logger.Error("Unable to write audit records", tag.Counter(len(batch)), tag.Error(err))
+		if s.dropPolicy == AuditDropPolicyDrop {
+			s.recordDropped(len(batch))
+			return batch[:0]
+		}
+		atomic.StoreInt32(&s.failing, 1)
+		select {
+		case <-s.shutdownChan:
+			s.recordDropped(len(batch))
+			return batch[:0]
+		case <-time.After(s.retryInterval):
+		}
+	}
+}
+
+func (s *asyncAuditSink) recordDropped(count int) {
+	s.metricsHandler.Counter(metrics.AuditRecordsDropped.GetMetricName()).Record(int64(count))
+}
+
+func (w *persistenceAuditWriter) Start() {
+	if !atomic.CompareAndSwapInt32(&w.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+	if w.retention > 0 {
+		go w.retentionLoop()
+	}
+}
+
+func (w *persistenceAuditWriter) Stop() {
+	if !atomic.CompareAndSwapInt32(&w.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	close(w.shutdownChan)
+}
+
+func (w *persistenceAuditWriter) writeRecords(ctx context.Context, records []*persistencespb.AuditRecord) error {
+	w.chain.Lock()
+	defer w.chain.Unlock()
+
+	// the chain only advances once the whole batch is written
+	previousDigest := w.chain.previousDigest
+	for _, record := range records {
+		w.chain.link(record)
+		if err := w.chain.commit(record); err != nil {
+			w.chain.previousDigest = previousDigest
+			return err
+		}
+	}
+	if err := w.manager.AppendRecords(ctx, w.partition, records); err != nil {
+		w.chain.previousDigest = previousDigest
+		return err
+	}
+	return nil
+}
+
+// retentionLoop deletes expired records of all partitions. Every frontend runs it, which is harmless
+// since deleting the same records twice is a no-op.
+func (w *persistenceAuditWriter) retentionLoop() {
+	ticker := time.NewTicker(auditRetentionCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-w.shutdownChan:
+			return
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), auditRetentionCheckTimeout)
+			err := w.manager.DeleteRecordsBefore(ctx, time.Now().Add(-w.retention))
+			cancel()
+			if err != nil {
+				w.logger.Warn("Unable to delete expired audit records", tag.Error(err))
+			}
+		}
+	}
+}
+
+// NewAuditRecord creates the audit record of a call
+func NewAuditRecord(
+	claims *Claims,
+	apiName string,
+	namespace string,
+	req interface{},
+	callErr error,
+) *persistencespb.AuditRecord {
+	record := &persistencespb.AuditRecord{
+		Time:      timestamp.TimePtr(time.Now().UTC()),
+		ApiName:   apiName,
+		Namespace: namespace,
+	}
+	if claims != nil {
+		record.Principal = claims.Subject
+	}
+	if callErr != nil {
+		record.Error = callErr.Error()
+	}
+
+	var execution *commonpb.WorkflowExecution
+	switch request := req.(type) {
+	case hasWorkflowExecution:
+		execution = request.GetWorkflowExecution()
+	case hasExecution:
+		execution = request.GetExecution()
+	case hasWorkflowID:
+		execution = &commonpb.WorkflowExecution{WorkflowId: request.GetWorkflowId()}
+	}
+	record.WorkflowId = execution.GetWorkflowId()
+	record.RunId = execution.GetRunId()
+
+	if request, ok := req.(marshaler); ok {
+		if data, err := request.Marshal(); err == nil {
+			record.RequestDigest = digest(data)
+		}
+	}
+	return record
+}
+
+func newAuditChain() auditChain {
+	return auditChain{writerID: uuid.New()}
+}
+
+// link sets the chain fields of a record, the caller must hold the lock
+func (c *auditChain) link(record *persistencespb.AuditRecord) *persistencespb.AuditRecord {
+	record.WriterId = c.writerID
+	record.PreviousRecordDigest = c.previousDigest
+	return record
+}
+
+// commit makes a written record the last one of the chain, the caller must hold the lock
+func (c *auditChain) commit(record *persistencespb.AuditRecord) error {
+	data, err := record.Marshal()
+	if err != nil {
+		return err
+	}
+	c.previousDigest = digest(data)
+	return nil
+}
+
+func digest(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
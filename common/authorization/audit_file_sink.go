@@ -0,0 +1,191 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+)
+
+const (
+	auditFileMode       = 0600
+	auditRotationFormat = "20060102T150405.000000000"
+)
+
+type (
+	// fileAuditWriter is a daemon so that the sink closes its file once the last records are written
+	fileAuditWriter struct {
+		chain   auditChain
+		config  config.FileAuditSink
+		logger  log.Logger
+		encoder *codec.JSONPBEncoder
+		file    *os.File
+		size    int64
+	}
+)
+
+var _ common.Daemon = (*fileAuditWriter)(nil)
+
+// NewFileAuditSink creates an audit sink that appends records to a JSON lines file. The file is
+// rotated by renaming it with a timestamp suffix once it reaches the configured size.
+func NewFileAuditSink(cfg config.Audit, metricsHandler metrics.Handler, logger log.Logger) (AuditSink, error) {
+	if cfg.File.Path == "" {
+		return nil, fmt.Errorf("audit file path is not configured")
+	}
+	s := &fileAuditWriter{
+		chain:   newAuditChain(),
+		config:  cfg.File,
+		logger:  log.NewThrottledLogger(logger, func() float64 { return 1 / auditWriteErrorLogPeriod.Seconds() }),
+		encoder: codec.NewJSONPBEncoder(),
+	}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+	return newAsyncAuditSink(cfg, s, metricsHandler, logger), nil
+}
+
+func (s *fileAuditWriter) Start() {}
+
+// Stop closes the file. The sink stops its writer after it wrote the buffered records.
+func (s *fileAuditWriter) Stop() {
+	s.chain.Lock()
+	defer s.chain.Unlock()
+
+	if s.file == nil {
+		return
+	}
+	if err := s.file.Close(); err != nil {
+		s.logger.Error("unable to close audit file", tag.Error(err))
+	}
+	s.file = nil
+}
+
+func (s *fileAuditWriter) writeRecords(_ context.Context, records []*persistencespb.AuditRecord) error {
+	s.chain.Lock()
+	defer s.chain.Unlock()
+
+	if s.file == nil {
+		// a previous rotation failed to reopen the file
+		if err := s.open(); err != nil {
+			return err
+		}
+	}
+	for _, record := range records {
+		if err := s.writeRecord(record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *fileAuditWriter) writeRecord(record *persistencespb.AuditRecord) error {
+	line, err := s.encoder.Encode(s.chain.link(record))
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	maxSize := int64(s.config.MaxSizeMB) * 1024 * 1024
+	if maxSize > 0 && s.size > 0 && s.size+int64(len(line)) > maxSize {
+		if err := s.rotate(); err != nil {
+			return err
+		}
+	}
+
+	n, err := s.file.Write(line)
+	s.size += int64(n)
+	if err != nil {
+		return err
+	}
+	return s.chain.commit(record)
+}
+
+func (s *fileAuditWriter) open() error {
+	file, err := os.OpenFile(s.config.Path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, auditFileMode)
+	if err != nil {
+		return fmt.Errorf("audit file: %s: %w", s.config.Path, err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return fmt.Errorf("audit file: %s: %w", s.config.Path, err)
+	}
+	s.file = file
+	s.size = info.Size()
+	return nil
+}
+
+// rotate renames the file and opens a new one. If the file can't be renamed, it is opened again and
+// records keep being appended to it.
+func (s *fileAuditWriter) rotate() error {
+	err := s.file.Close()
+	s.file = nil
+	if err != nil {
+		return err
+	}
+	rotatedPath := s.config.Path + "." + time.Now().UTC().Format(auditRotationFormat)
+	if err := os.Rename(s.config.Path, rotatedPath); err != nil {
+		s.logger.Error("unable to rotate audit file", tag.Error(err))
+		return s.open()
+	}
+	if err := s.open(); err != nil {
+		return err
+	}
+	s.removeOldBackups()
+	return nil
+}
+
+func (s *fileAuditWriter) removeOldBackups() {
+	if s.config.MaxBackups <= 0 {
+		return
+	}
+	backups, err := filepath.Glob(s.config.Path + ".*")
+	if err != nil {
+		s.logger.Error("unable to list rotated audit files", tag.Error(err))
+		return
+	}
+	if len(backups) <= s.config.MaxBackups {
+		return
+	}
+	// timestamp suffixes sort in chronological order
+	sort.Strings(backups)
+	for _, backup := range backups[:len(backups)-s.config.MaxBackups] {
+		if err := os.Remove(backup); err != nil {
+			s.logger.Error("unable to remove rotated audit file", tag.Error(err))
+		}
+	}
+}
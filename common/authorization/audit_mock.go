@@ -0,0 +1,298 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: audit.go
+
+// Package authorization is a generated GoMock package.
+package authorization
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	common "go.temporal.io/api/common/v1"
+	persistence "go.temporal.io/server/api/persistence/v1"
+)
+
+// MockAuditSink is a mock of AuditSink interface.
+type MockAuditSink struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditSinkMockRecorder
+}
+
+// MockAuditSinkMockRecorder is the mock recorder for MockAuditSink.
+type MockAuditSinkMockRecorder struct {
+	mock *MockAuditSink
+}
+
+// NewMockAuditSink creates a new mock instance.
+func NewMockAuditSink(ctrl *gomock.Controller) *MockAuditSink {
+	mock := &MockAuditSink{ctrl: ctrl}
+	mock.recorder = &MockAuditSinkMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditSink) EXPECT() *MockAuditSinkMockRecorder {
+	return m.recorder
+}
+
+// Write mocks base method.
+func (m *MockAuditSink) Write(ctx context.Context, record *persistence.AuditRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Write", ctx, record)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Write indicates an expected call of Write.
+func (mr *MockAuditSinkMockRecorder) Write(ctx, record interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockAuditSink)(nil).Write), ctx, record)
+}
+
+// MockhasWorkflowExecution is a mock of hasWorkflowExecution interface.
+type MockhasWorkflowExecution struct {
+	ctrl     *gomock.Controller
+	recorder *MockhasWorkflowExecutionMockRecorder
+}
+
+// MockhasWorkflowExecutionMockRecorder is the mock recorder for MockhasWorkflowExecution.
+type MockhasWorkflowExecutionMockRecorder struct {
+	mock *MockhasWorkflowExecution
+}
+
+// NewMockhasWorkflowExecution creates a new mock instance.
+func NewMockhasWorkflowExecution(ctrl *gomock.Controller) *MockhasWorkflowExecution {
+	mock := &MockhasWorkflowExecution{ctrl: ctrl}
+	mock.recorder = &MockhasWorkflowExecutionMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockhasWorkflowExecution) EXPECT() *MockhasWorkflowExecutionMockRecorder {
+	return m.recorder
+}
+
+// GetWorkflowExecution mocks base method.
+func (m *MockhasWorkflowExecution) GetWorkflowExecution() *common.WorkflowExecution {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkflowExecution")
+	ret0, _ := ret[0].(*common.WorkflowExecution)
+	return ret0
+}
+
+// GetWorkflowExecution indicates an expected call of GetWorkflowExecution.
+func (mr *MockhasWorkflowExecutionMockRecorder) GetWorkflowExecution() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecution", reflect.TypeOf((*MockhasWorkflowExecution)(nil).GetWorkflowExecution))
+}
+
+// MockhasExecution is a mock of hasExecution interface.
+type MockhasExecution struct {
+	ctrl     *gomock.Controller
+	recorder *MockhasExecutionMockRecorder
+}
+
+// MockhasExecutionMockRecorder is the mock recorder for MockhasExecution.
+type MockhasExecutionMockRecorder struct {
+	mock *MockhasExecution
+}
+
+// NewMockhasExecution creates a new mock instance.
+func NewMockhasExecution(ctrl *gomock.Controller) *MockhasExecution {
+	mock := &MockhasExecution{ctrl: ctrl}
+	mock.recorder = &MockhasExecutionMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockhasExecution) EXPECT() *MockhasExecutionMockRecorder {
+	return m.recorder
+}
+
+// GetExecution mocks base method.
+func (m *MockhasExecution) GetExecution() *common.WorkflowExecution {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExecution")
+	ret0, _ := ret[0].(*common.WorkflowExecution)
+	return ret0
+}
+
+// GetExecution indicates an expected call of GetExecution.
+func (mr *MockhasExecutionMockRecorder) GetExecution() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExecution", reflect.TypeOf((*MockhasExecution)(nil).GetExecution))
+}
+
+// MockhasWorkflowID is a mock of hasWorkflowID interface.
+type MockhasWorkflowID struct {
+	ctrl     *gomock.Controller
+	recorder *MockhasWorkflowIDMockRecorder
+}
+
+// MockhasWorkflowIDMockRecorder is the mock recorder for MockhasWorkflowID.
+type MockhasWorkflowIDMockRecorder struct {
+	mock *MockhasWorkflowID
+}
+
+// NewMockhasWorkflowID creates a new mock instance.
+func NewMockhasWorkflowID(ctrl *gomock.Controller) *MockhasWorkflowID {
+	mock := &MockhasWorkflowID{ctrl: ctrl}
+	mock.recorder = &MockhasWorkflowIDMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockhasWorkflowID) EXPECT() *MockhasWorkflowIDMockRecorder {
+	return m.recorder
+}
+
+// GetWorkflowId mocks base method.
+func (m *MockhasWorkflowID) GetWorkflowId() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkflowId")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetWorkflowId indicates an expected call of GetWorkflowId.
+func (mr *MockhasWorkflowIDMockRecorder) GetWorkflowId() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowId", reflect.TypeOf((*MockhasWorkflowID)(nil).GetWorkflowId))
+}
+
+// Mockmarshaler is a mock of marshaler interface.
+type Mockmarshaler struct {
+	ctrl     *gomock.Controller
+	recorder *MockmarshalerMockRecorder
+}
+
+// MockmarshalerMockRecorder is the mock recorder for Mockmarshaler.
+type MockmarshalerMockRecorder struct {
+	mock *Mockmarshaler
+}
+
+// NewMockmarshaler creates a new mock instance.
+func NewMockmarshaler(ctrl *gomock.Controller) *Mockmarshaler {
+	mock := &Mockmarshaler{ctrl: ctrl}
+	mock.recorder = &MockmarshalerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockmarshaler) EXPECT() *MockmarshalerMockRecorder {
+	return m.recorder
+}
+
+// Marshal mocks base method.
+func (m *Mockmarshaler) Marshal() ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Marshal")
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Marshal indicates an expected call of Marshal.
+func (mr *MockmarshalerMockRecorder) Marshal() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Marshal", reflect.TypeOf((*Mockmarshaler)(nil).Marshal))
+}
+
+// MockauditWriter is a mock of auditWriter interface.
+type MockauditWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockauditWriterMockRecorder
+}
+
+// MockauditWriterMockRecorder is the mock recorder for MockauditWriter.
+type MockauditWriterMockRecorder struct {
+	mock *MockauditWriter
+}
+
+// NewMockauditWriter creates a new mock instance.
+func NewMockauditWriter(ctrl *gomock.Controller) *MockauditWriter {
+	mock := &MockauditWriter{ctrl: ctrl}
+	mock.recorder = &MockauditWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockauditWriter) EXPECT() *MockauditWriterMockRecorder {
+	return m.recorder
+}
+
+// writeRecords mocks base method.
+func (m *MockauditWriter) writeRecords(ctx context.Context, records []*persistence.AuditRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "writeRecords", ctx, records)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// writeRecords indicates an expected call of writeRecords.
+func (mr *MockauditWriterMockRecorder) writeRecords(ctx, records interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "writeRecords", reflect.TypeOf((*MockauditWriter)(nil).writeRecords), ctx, records)
+}
+
+// MockauditGate is a mock of auditGate interface.
+type MockauditGate struct {
+	ctrl     *gomock.Controller
+	recorder *MockauditGateMockRecorder
+}
+
+// MockauditGateMockRecorder is the mock recorder for MockauditGate.
+type MockauditGateMockRecorder struct {
+	mock *MockauditGate
+}
+
+// NewMockauditGate creates a new mock instance.
+func NewMockauditGate(ctrl *gomock.Controller) *MockauditGate {
+	mock := &MockauditGate{ctrl: ctrl}
+	mock.recorder = &MockauditGateMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockauditGate) EXPECT() *MockauditGateMockRecorder {
+	return m.recorder
+}
+
+// accepting mocks base method.
+func (m *MockauditGate) accepting() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "accepting")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// accepting indicates an expected call of accepting.
+func (mr *MockauditGateMockRecorder) accepting() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "accepting", reflect.TypeOf((*MockauditGate)(nil).accepting))
+}
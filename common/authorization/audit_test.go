@@ -0,0 +1,288 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/workflowservice/v1"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+)
+
+func TestIsMutatingAPI(t *testing.T) {
+	require.True(t, IsMutatingAPI("/temporal.api.workflowservice.v1.WorkflowService/TerminateWorkflowExecution"))
+	require.True(t, IsMutatingAPI("/temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution"))
+	require.True(t, IsMutatingAPI("/temporal.server.api.adminservice.v1.AdminService/ResendReplicationTasks"))
+	require.True(t, IsMutatingAPI("/temporal.api.operatorservice.v1.OperatorService/DeleteNamespace"))
+
+	require.False(t, IsMutatingAPI("/temporal.api.workflowservice.v1.WorkflowService/DescribeWorkflowExecution"))
+	require.False(t, IsMutatingAPI("/temporal.api.workflowservice.v1.WorkflowService/PollWorkflowTaskQueue"))
+	require.False(t, IsMutatingAPI("/temporal.api.workflowservice.v1.WorkflowService/RespondActivityTaskCompleted"))
+	require.False(t, IsMutatingAPI("/temporal.api.workflowservice.v1.WorkflowService/GetSystemInfo"))
+	require.False(t, IsMutatingAPI("/temporal.server.api.adminservice.v1.AdminService/ListAuditRecords"))
+	require.False(t, IsMutatingAPI("/grpc.health.v1.Health/Check"))
+}
+
+func TestNewAuditRecord(t *testing.T) {
+	req := &workflowservice.TerminateWorkflowExecutionRequest{
+		Namespace:         "test-namespace",
+		WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: "wid", RunId: "rid"},
+	}
+	record := NewAuditRecord(&Claims{Subject: "alice"}, "TerminateWorkflowExecution", "test-namespace", req, errors.New("denied"))
+	require.Equal(t, "alice", record.GetPrincipal())
+	require.Equal(t, "TerminateWorkflowExecution", record.GetApiName())
+	require.Equal(t, "test-namespace", record.GetNamespace())
+	require.Equal(t, "wid", record.GetWorkflowId())
+	require.Equal(t, "rid", record.GetRunId())
+	require.Equal(t, "denied", record.GetError())
+	require.NotEmpty(t, record.GetRequestDigest())
+	require.NotNil(t, record.GetTime())
+
+	signal := NewAuditRecord(nil, "SignalWithStartWorkflowExecution", "test-namespace", &workflowservice.SignalWithStartWorkflowExecutionRequest{WorkflowId: "wid"}, nil)
+	require.Empty(t, signal.GetPrincipal())
+	require.Equal(t, "wid", signal.GetWorkflowId())
+	require.Empty(t, signal.GetError())
+}
+
+func TestPersistenceAuditSink_Chain(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	manager := persistence.NewMockAuditLogManager(controller)
+
+	var written []*persistencespb.AuditRecord
+	var partition int
+	manager.EXPECT().AppendRecords(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, p int, records []*persistencespb.AuditRecord) error {
+			partition = p
+			written = append(written, records...)
+			return nil
+		})
+	manager.EXPECT().AppendRecords(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("unavailable"))
+	manager.EXPECT().AppendRecords(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, p int, records []*persistencespb.AuditRecord) error {
+			require.Equal(t, partition, p)
+			written = append(written, records...)
+			return nil
+		})
+
+	sink := NewPersistenceAuditSink(config.Audit{BatchSize: 2}, manager, metrics.NoopMetricsHandler, log.NewNoopLogger()).(*asyncAuditSink)
+	writer := sink.writer.(*persistenceAuditWriter)
+	require.NoError(t, writer.writeRecords(context.Background(), []*persistencespb.AuditRecord{{ApiName: "A"}, {ApiName: "B"}}))
+	require.Error(t, writer.writeRecords(context.Background(), []*persistencespb.AuditRecord{{ApiName: "C"}}))
+	require.NoError(t, writer.writeRecords(context.Background(), []*persistencespb.AuditRecord{{ApiName: "D"}}))
+
+	require.Len(t, written, 3)
+	require.True(t, partition >= 0 && partition < persistence.AuditLogQueuePartitions)
+	require.NotEmpty(t, written[0].GetWriterId())
+	require.Equal(t, written[0].GetWriterId(), written[1].GetWriterId())
+	require.Empty(t, written[0].GetPreviousRecordDigest())
+	for i := 1; i < len(written); i++ {
+		// the record that failed to be written is not part of the chain
+		data, err := written[i-1].Marshal()
+		require.NoError(t, err)
+		require.Equal(t, digest(data), written[i].GetPreviousRecordDigest())
+	}
+}
+
+func TestAsyncAuditSink_Batches(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	manager := persistence.NewMockAuditLogManager(controller)
+
+	var batches [][]*persistencespb.AuditRecord
+	manager.EXPECT().AppendRecords(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ int, records []*persistencespb.AuditRecord) error {
+			batches = append(batches, append([]*persistencespb.AuditRecord(nil), records...))
+			return nil
+		}).AnyTimes()
+
+	sink := NewPersistenceAuditSink(
+		config.Audit{BufferSize: 3, BatchSize: 2, FlushInterval: time.Hour},
+		manager,
+		metrics.NoopMetricsHandler,
+		log.NewNoopLogger(),
+	).(*asyncAuditSink)
+	for _, api := range []string{"A", "B", "C"} {
+		require.NoError(t, sink.Write(context.Background(), &persistencespb.AuditRecord{ApiName: api}))
+	}
+	// the sink is not started, so the buffer is full and the call isn't blocked
+	require.ErrorIs(t, sink.Write(context.Background(), &persistencespb.AuditRecord{ApiName: "D"}), errAuditBufferFull)
+
+	sink.Start()
+	sink.Stop()
+	require.Len(t, batches, 2)
+	require.Len(t, batches[0], 2)
+	require.Equal(t, "C", batches[1][0].GetApiName())
+}
+
+type auditWriterFn func(ctx context.Context, records []*persistencespb.AuditRecord) error
+
+func (f auditWriterFn) writeRecords(ctx context.Context, records []*persistencespb.AuditRecord) error {
+	return f(ctx, records)
+}
+
+func newDroppedRecordsHandler(controller *gomock.Controller) (metrics.Handler, *metrics.MockCounterIface) {
+	handler := metrics.NewMockHandler(controller)
+	counter := metrics.NewMockCounterIface(controller)
+	handler.EXPECT().WithTags(gomock.Any()).Return(handler).AnyTimes()
+	handler.EXPECT().Counter(metrics.AuditRecordsDropped.GetMetricName()).Return(counter).AnyTimes()
+	return handler, counter
+}
+
+func TestAsyncAuditSink_DropPolicyDrop(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	handler, dropped := newDroppedRecordsHandler(controller)
+
+	writer := auditWriterFn(func(context.Context, []*persistencespb.AuditRecord) error {
+		return errors.New("unavailable")
+	})
+	sink := newAsyncAuditSink(config.Audit{BufferSize: 1}, writer, handler, log.NewNoopLogger())
+	require.True(t, sink.accepting())
+
+	require.NoError(t, sink.Write(context.Background(), &persistencespb.AuditRecord{ApiName: "A"}))
+	dropped.EXPECT().Record(int64(1))
+	require.ErrorIs(t, sink.Write(context.Background(), &persistencespb.AuditRecord{ApiName: "B"}), errAuditBufferFull)
+
+	// a batch that fails to be written is dropped
+	dropped.EXPECT().Record(int64(2))
+	require.Empty(t, sink.flush([]*persistencespb.AuditRecord{{ApiName: "C"}, {ApiName: "D"}}))
+	require.True(t, sink.accepting())
+}
+
+func TestAsyncAuditSink_DropPolicyBlock(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	handler, dropped := newDroppedRecordsHandler(controller)
+
+	sink := newAsyncAuditSink(
+		config.Audit{BufferSize: 1, DropPolicy: AuditDropPolicyBlock},
+		auditWriterFn(func(context.Context, []*persistencespb.AuditRecord) error { return nil }),
+		handler,
+		log.NewNoopLogger(),
+	)
+	require.NoError(t, sink.Write(context.Background(), &persistencespb.AuditRecord{ApiName: "A"}))
+
+	// the sink is not started, so the call waits for room in the buffer until its context is done
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	dropped.EXPECT().Record(int64(1))
+	require.ErrorIs(t, sink.Write(ctx, &persistencespb.AuditRecord{ApiName: "B"}), context.DeadlineExceeded)
+}
+
+func TestAsyncAuditSink_DropPolicyFail(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	handler, _ := newDroppedRecordsHandler(controller)
+
+	var written []*persistencespb.AuditRecord
+	var attempts int32
+	writer := auditWriterFn(func(_ context.Context, records []*persistencespb.AuditRecord) error {
+		if atomic.AddInt32(&attempts, 1) <= 2 {
+			return errors.New("unavailable")
+		}
+		written = append(written, records...)
+		return nil
+	})
+	sink := newAsyncAuditSink(
+		config.Audit{BatchSize: 1, FlushInterval: time.Hour, DropPolicy: AuditDropPolicyFail},
+		writer,
+		handler,
+		log.NewNoopLogger(),
+	)
+	sink.retryInterval = 100 * time.Millisecond
+	sink.Start()
+
+	// calls are rejected while the record is retried, and accepted again once it is written
+	require.NoError(t, sink.Write(context.Background(), &persistencespb.AuditRecord{ApiName: "A"}))
+	require.Eventually(t, func() bool { return !sink.accepting() }, 5*time.Second, 10*time.Millisecond)
+	require.Eventually(t, sink.accepting, 5*time.Second, 10*time.Millisecond)
+
+	sink.Stop()
+	require.Equal(t, int32(3), atomic.LoadInt32(&attempts))
+	require.Len(t, written, 1)
+	require.Equal(t, "A", written[0].GetApiName())
+}
+
+func TestGetAuditSinkFromConfig_UnknownDropPolicy(t *testing.T) {
+	_, err := GetAuditSinkFromConfig(
+		&config.Authorization{Audit: config.Audit{Sink: "file", DropPolicy: "maybe"}},
+		nil,
+		metrics.NoopMetricsHandler,
+		log.NewNoopLogger(),
+	)
+	require.Error(t, err)
+}
+
+func TestFileAuditSink_Rotate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	sink, err := NewFileAuditSink(
+		config.Audit{File: config.FileAuditSink{Path: path, MaxSizeMB: 1}},
+		metrics.NoopMetricsHandler,
+		log.NewNoopLogger(),
+	)
+	require.NoError(t, err)
+	writer := sink.(*asyncAuditSink).writer.(*fileAuditWriter)
+	write := func(api string) {
+		require.NoError(t, writer.writeRecords(context.Background(), []*persistencespb.AuditRecord{{ApiName: api}}))
+	}
+
+	write("A")
+	writer.size = 1024 * 1024
+	write("B")
+	backups, err := filepath.Glob(path + ".*")
+	require.NoError(t, err)
+	require.Len(t, backups, 1)
+
+	// the file can't be renamed once it is removed, it is opened again and written to
+	require.NoError(t, os.Remove(path))
+	writer.size = 1024 * 1024
+	write("C")
+	write("D")
+	backups, err = filepath.Glob(path + ".*")
+	require.NoError(t, err)
+	require.Len(t, backups, 1)
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Len(t, strings.Split(strings.TrimSpace(string(content)), "\n"), 2)
+
+	writer.Stop()
+	require.Nil(t, writer.file)
+}
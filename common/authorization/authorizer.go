@@ -32,6 +32,7 @@ import (
 	"strings"
 
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
 )
 
 const (
@@ -80,17 +81,27 @@ type hasNamespace interface {
 	GetNamespace() string
 }
 
-func GetAuthorizerFromConfig(config *config.Authorization) (Authorizer, error) {
+func GetAuthorizerFromConfig(config *config.Authorization, logger log.Logger) (Authorizer, error) {
 
 	switch strings.ToLower(config.Authorizer) {
 	case "":
 		return NewNoopAuthorizer(), nil
 	case "default":
 		return NewDefaultAuthorizer(), nil
+	case "policy":
+		return NewPolicyAuthorizer(config, logger)
 	}
 	return nil, fmt.Errorf("unknown authorizer: %s", config.Authorizer)
 }
 
+// CloseAuthorizer releases the resources of an authorizer that holds any, such as the policy
+// authorizer reloading its policy file.
+func CloseAuthorizer(authorizer Authorizer) {
+	if closer, ok := authorizer.(interface{ Close() }); ok {
+		closer.Close()
+	}
+}
+
 func IsNoopAuthorizer(authorizer Authorizer) bool {
 	_, ok := authorizer.(*noopAuthorizer)
 	return ok
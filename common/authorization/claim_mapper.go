@@ -77,6 +77,8 @@ func GetClaimMapperFromConfig(config *config.Authorization, logger log.Logger) (
 		return NewNoopClaimMapper(), nil
 	case "default":
 		return NewDefaultJWTClaimMapper(NewDefaultTokenKeyProvider(config, logger), config, logger), nil
+	case "mtls":
+		return NewMTLSClaimMapper(NewDefaultTokenKeyProvider(config, logger), config, logger)
 	}
 	return nil, fmt.Errorf("unknown claim mapper: %s", config.ClaimMapper)
 }
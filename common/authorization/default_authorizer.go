@@ -54,12 +54,20 @@ func (a *defaultAuthorizer) Authorize(_ context.Context, claims *Claims, target
 	if claims == nil {
 		return resultDeny, nil
 	}
+
+	api := ApiName(target.APIName)
+	if IsAPIKeyManagementAPI(api) {
+		if CanManageAPIKeys(claims, target.Namespace) {
+			return resultAllow, nil
+		}
+		return resultDeny, nil
+	}
+
 	// Check system level permissions
 	if claims.System >= RoleWriter {
 		return resultAllow, nil
 	}
 
-	api := ApiName(target.APIName)
 	readOnlyNamespaceAPI := IsReadOnlyNamespaceAPI(api)
 	readOnlyGlobalAPI := IsReadOnlyGlobalAPI(api)
 	if claims.System >= RoleReader && (readOnlyNamespaceAPI || readOnlyGlobalAPI) {
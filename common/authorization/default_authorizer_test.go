@@ -34,6 +34,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
 )
 
 var (
@@ -85,6 +86,10 @@ var (
 		APIName:   "/grpc.health.v1.Health/Check",
 		Namespace: "",
 	}
+	targetCreateNamespaceApiKey = CallTarget{
+		APIName:   "/temporal.server.api.adminservice.v1.AdminService/CreateNamespaceApiKey",
+		Namespace: "BAR",
+	}
 	targetGetSystemInfo = CallTarget{
 		APIName:   "/temporal.api.workflowservice.v1.WorkflowService/GetSystemInfo",
 		Namespace: "",
@@ -176,6 +181,22 @@ func (s *defaultAuthorizerSuite) TestSystemAdminListNamespaces() {
 	s.NoError(err)
 	s.Equal(DecisionAllow, result.Decision)
 }
+func (s *defaultAuthorizerSuite) TestAPIKeyManagement() {
+	for _, tc := range []struct {
+		claims   Claims
+		decision Decision
+	}{
+		{claims: claimsSystemAdmin, decision: DecisionAllow},
+		{claims: Claims{Namespaces: map[string]Role{"bar": RoleAdmin}}, decision: DecisionAllow},
+		{claims: claimsSystemWriter, decision: DecisionDeny},
+		{claims: Claims{Namespaces: map[string]Role{"bar": RoleWriter}}, decision: DecisionDeny},
+		{claims: Claims{Namespaces: map[string]Role{"foo": RoleAdmin}}, decision: DecisionDeny},
+	} {
+		result, err := s.authorizer.Authorize(context.TODO(), &tc.claims, &targetCreateNamespaceApiKey)
+		s.NoError(err)
+		s.Equal(tc.decision, result.Decision)
+	}
+}
 func (s *defaultAuthorizerSuite) TestNamespaceOnly() {
 	// don't need any system-level claims to do namespace-level apis
 	result, err := s.authorizer.Authorize(context.TODO(), &claimsNamespaceOnly, startWorkflowExecutionTarget)
@@ -213,7 +234,7 @@ func (s *defaultAuthorizerSuite) TestGetAuthorizerFromConfigUnknown() {
 func (s *defaultAuthorizerSuite) testGetAuthorizerFromConfig(name string, valid bool, authorizerType reflect.Type) {
 
 	cfg := config.Authorization{Authorizer: name}
-	auth, err := GetAuthorizerFromConfig(&cfg)
+	auth, err := GetAuthorizerFromConfig(&cfg, log.NewNoopLogger())
 	if valid {
 		s.NoError(err)
 		s.NotNil(auth)
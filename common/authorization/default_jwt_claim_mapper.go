@@ -40,6 +40,7 @@ const (
 	defaultPermissionsClaimName = "permissions"
 	authorizationBearer         = "bearer"
 	headerSubject               = "sub"
+	claimPathSeparator          = "."
 	permissionScopeSystem       = "system"
 	permissionRead              = "read"
 	permissionWrite             = "write"
@@ -52,6 +53,10 @@ type defaultJWTClaimMapper struct {
 	keyProvider          TokenKeyProvider
 	logger               log.Logger
 	permissionsClaimName string
+	issuer               string
+	audience             string
+	groupsClaimPaths     [][]string
+	groupPermissions     map[string][]interface{}
 }
 
 func NewDefaultJWTClaimMapper(provider TokenKeyProvider, cfg *config.Authorization, logger log.Logger) ClaimMapper {
@@ -59,7 +64,31 @@ func NewDefaultJWTClaimMapper(provider TokenKeyProvider, cfg *config.Authorizati
 	if claimName == "" {
 		claimName = defaultPermissionsClaimName
 	}
-	return &defaultJWTClaimMapper{keyProvider: provider, logger: logger, permissionsClaimName: claimName}
+	issuer := cfg.Issuer
+	if issuer == "" {
+		issuer = cfg.JWTKeyProvider.OIDCIssuer
+	}
+	var groupsClaimPaths [][]string
+	for _, path := range cfg.GroupsClaimPaths {
+		if strings.TrimSpace(path) != "" {
+			groupsClaimPaths = append(groupsClaimPaths, strings.Split(path, claimPathSeparator))
+		}
+	}
+	groupPermissions := make(map[string][]interface{}, len(cfg.GroupPermissions))
+	for group, permissions := range cfg.GroupPermissions {
+		for _, permission := range permissions {
+			groupPermissions[group] = append(groupPermissions[group], permission)
+		}
+	}
+	return &defaultJWTClaimMapper{
+		keyProvider:          provider,
+		logger:               logger,
+		permissionsClaimName: claimName,
+		issuer:               strings.TrimSuffix(strings.TrimSpace(issuer), "/"),
+		audience:             cfg.Audience,
+		groupsClaimPaths:     groupsClaimPaths,
+		groupPermissions:     groupPermissions,
+	}
 }
 
 var _ ClaimMapper = (*defaultJWTClaimMapper)(nil)
@@ -79,10 +108,20 @@ func (a *defaultJWTClaimMapper) GetClaims(authInfo *AuthInfo) (*Claims, error) {
 	if !strings.EqualFold(parts[0], authorizationBearer) {
 		return nil, serviceerror.NewPermissionDenied("unexpected name in authorization token", "")
 	}
-	jwtClaims, err := parseJWTWithAudience(parts[1], a.keyProvider, authInfo.Audience)
+	audience := authInfo.Audience
+	if audience == "" {
+		audience = a.audience
+	}
+	jwtClaims, err := parseJWTWithAudience(parts[1], a.keyProvider, audience)
 	if err != nil {
 		return nil, err
 	}
+	if a.issuer != "" {
+		issuer, _ := jwtClaims["iss"].(string)
+		if strings.TrimSuffix(issuer, "/") != a.issuer {
+			return nil, serviceerror.NewPermissionDenied("issuer mismatch", "")
+		}
+	}
 	subject, ok := jwtClaims[headerSubject].(string)
 	if !ok {
 		return nil, serviceerror.NewPermissionDenied("unexpected value type of \"sub\" claim", "")
@@ -95,9 +134,49 @@ func (a *defaultJWTClaimMapper) GetClaims(authInfo *AuthInfo) (*Claims, error) {
 			return nil, err
 		}
 	}
+	for _, group := range a.extractGroups(jwtClaims) {
+		if permissions, ok := a.groupPermissions[group]; ok {
+			if err := a.extractPermissions(permissions, &claims); err != nil {
+				return nil, err
+			}
+		}
+	}
 	return &claims, nil
 }
 
+// extractGroups returns the groups found at the configured claim paths. A claim can hold a list of
+// groups or a single one.
+func (a *defaultJWTClaimMapper) extractGroups(jwtClaims jwt.MapClaims) []string {
+	var groups []string
+	for _, path := range a.groupsClaimPaths {
+		switch value := lookupClaim(jwtClaims, path).(type) {
+		case string:
+			groups = append(groups, value)
+		case []interface{}:
+			for _, group := range value {
+				if g, ok := group.(string); ok {
+					groups = append(groups, g)
+				} else {
+					a.logger.Warn(fmt.Sprintf("ignoring group that is not a string: %v", group))
+				}
+			}
+		}
+	}
+	return groups
+}
+
+func lookupClaim(jwtClaims jwt.MapClaims, path []string) interface{} {
+	var value interface{} = map[string]interface{}(jwtClaims)
+	for _, name := range path {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = object[name]
+	}
+	return value
+}
+
 func (a *defaultJWTClaimMapper) extractPermissions(permissions []interface{}, claims *Claims) error {
 	for _, permission := range permissions {
 		p, ok := permission.(string)
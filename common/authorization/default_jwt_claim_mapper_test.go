@@ -194,6 +194,9 @@ func (s *defaultClaimMapperSuite) TestGetClaimMapperFromConfigNoop() {
 func (s *defaultClaimMapperSuite) TestGetClaimMapperFromConfigDefault() {
 	s.testGetClaimMapperFromConfig("default", true, reflect.TypeOf(&defaultJWTClaimMapper{}))
 }
+func (s *defaultClaimMapperSuite) TestGetClaimMapperFromConfigMTLS() {
+	s.testGetClaimMapperFromConfig("mtls", true, reflect.TypeOf(&mtlsClaimMapper{}))
+}
 
 func (s *defaultClaimMapperSuite) TestGetClaimMapperFromConfigUnknown() {
 	s.testGetClaimMapperFromConfig("foo", false, nil)
@@ -359,3 +362,69 @@ func (tg *tokenGenerator) SupportedMethods() []string {
 }
 func (tg *tokenGenerator) Close() {
 }
+
+func (s *defaultClaimMapperSuite) TestGroupPermissions() {
+	cfg := &config.Authorization{
+		GroupsClaimPaths: []string{"groups", "realm_access.roles"},
+		GroupPermissions: map[string][]string{
+			"temporal-admins":  {"system:admin"},
+			"orders-team":      {"orders:write"},
+			"orders-observers": {"orders:read", "billing:read"},
+		},
+	}
+	claimMapper := NewDefaultJWTClaimMapper(s.tokenGenerator, cfg, s.logger)
+	tokenString, err := s.tokenGenerator.generateTokenWithClaims(jwt.MapClaims{
+		"sub":          testSubject,
+		"groups":       []string{"orders-team", "unknown"},
+		"realm_access": map[string]interface{}{"roles": []string{"orders-observers"}},
+	})
+	s.NoError(err)
+
+	claims, err := claimMapper.GetClaims(&AuthInfo{AuthToken: AddBearer(tokenString)})
+	s.NoError(err)
+	s.Equal(RoleUndefined, claims.System)
+	s.Equal(map[string]Role{"orders": RoleWriter | RoleReader, "billing": RoleReader}, claims.Namespaces)
+
+	tokenString, err = s.tokenGenerator.generateTokenWithClaims(jwt.MapClaims{
+		"sub":    testSubject,
+		"groups": "temporal-admins",
+	})
+	s.NoError(err)
+	claims, err = claimMapper.GetClaims(&AuthInfo{AuthToken: AddBearer(tokenString)})
+	s.NoError(err)
+	s.Equal(RoleAdmin, claims.System)
+}
+
+func (s *defaultClaimMapperSuite) TestIssuer() {
+	cfg := &config.Authorization{Issuer: "https://issuer.example.com/"}
+	claimMapper := NewDefaultJWTClaimMapper(s.tokenGenerator, cfg, s.logger)
+
+	tokenString, err := s.tokenGenerator.generateTokenWithClaims(jwt.MapClaims{"sub": testSubject, "iss": "https://issuer.example.com"})
+	s.NoError(err)
+	_, err = claimMapper.GetClaims(&AuthInfo{AuthToken: AddBearer(tokenString)})
+	s.NoError(err)
+
+	tokenString, err = s.tokenGenerator.generateTokenWithClaims(jwt.MapClaims{"sub": testSubject, "iss": "https://other.example.com"})
+	s.NoError(err)
+	_, err = claimMapper.GetClaims(&AuthInfo{AuthToken: AddBearer(tokenString)})
+	s.Error(err)
+}
+
+func (s *defaultClaimMapperSuite) TestConfiguredAudience() {
+	tokenString, err := s.tokenGenerator.generateRSAToken(testSubject, permissionsAdmin, errorTestOptionNoError)
+	s.NoError(err)
+
+	claimMapper := NewDefaultJWTClaimMapper(s.tokenGenerator, &config.Authorization{Audience: "test-audience"}, s.logger)
+	_, err = claimMapper.GetClaims(&AuthInfo{AuthToken: AddBearer(tokenString)})
+	s.NoError(err)
+
+	claimMapper = NewDefaultJWTClaimMapper(s.tokenGenerator, &config.Authorization{Audience: "foo"}, s.logger)
+	_, err = claimMapper.GetClaims(&AuthInfo{AuthToken: AddBearer(tokenString)})
+	s.Error(err)
+}
+
+func (tg *tokenGenerator) generateTokenWithClaims(claims jwt.MapClaims) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = "test-key"
+	return token.SignedString(tg.rsaPrivateKey)
+}
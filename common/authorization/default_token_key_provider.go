@@ -43,21 +43,41 @@ import (
 	"go.temporal.io/server/common/log/tag"
 )
 
+const (
+	oidcDiscoveryPath = "/.well-known/openid-configuration"
+	// keys are refreshed when a token is signed with an unknown key, at most once per this interval
+	minKeyRefreshInterval = 10 * time.Second
+	keyRequestTimeout     = 10 * time.Second
+)
+
 // Default token key provider
 type defaultTokenKeyProvider struct {
-	config   config.JWTKeyProvider
-	rsaKeys  map[string]*rsa.PublicKey
-	ecKeys   map[string]*ecdsa.PublicKey
-	keysLock sync.RWMutex
-	ticker   *time.Ticker
-	logger   log.Logger
-	stop     chan bool
+	config     config.JWTKeyProvider
+	rsaKeys    map[string]*rsa.PublicKey
+	ecKeys     map[string]*ecdsa.PublicKey
+	keysLock   sync.RWMutex
+	updateLock sync.Mutex
+	lastUpdate time.Time
+	httpClient *http.Client
+	ticker     *time.Ticker
+	logger     log.Logger
+	stop       chan bool
+}
+
+// subset of the OpenID Connect discovery document
+type oidcDiscoveryDocument struct {
+	Issuer  string `json:"issuer"`
+	JWKSURI string `json:"jwks_uri"`
 }
 
 var _ TokenKeyProvider = (*defaultTokenKeyProvider)(nil)
 
 func NewDefaultTokenKeyProvider(cfg *config.Authorization, logger log.Logger) *defaultTokenKeyProvider {
-	provider := defaultTokenKeyProvider{config: cfg.JWTKeyProvider, logger: logger}
+	provider := defaultTokenKeyProvider{
+		config:     cfg.JWTKeyProvider,
+		logger:     logger,
+		httpClient: &http.Client{Timeout: keyRequestTimeout},
+	}
 	provider.initialize()
 	return &provider
 }
@@ -89,9 +109,10 @@ func (a *defaultTokenKeyProvider) RsaKey(alg string, kid string) (*rsa.PublicKey
 		return nil, fmt.Errorf("unexpected signing algorithm: %s", alg)
 	}
 
-	a.keysLock.RLock()
-	key, found := a.rsaKeys[kid]
-	a.keysLock.RUnlock()
+	key, found := a.rsaKey(kid)
+	if !found && a.refreshOnUnknownKey() {
+		key, found = a.rsaKey(kid)
+	}
 	if !found {
 		return nil, fmt.Errorf("RSA key not found for key ID: %s", kid)
 	}
@@ -103,15 +124,49 @@ func (a *defaultTokenKeyProvider) EcdsaKey(alg string, kid string) (*ecdsa.Publi
 		return nil, fmt.Errorf("unexpected signing algorithm: %s", alg)
 	}
 
-	a.keysLock.RLock()
-	key, found := a.ecKeys[kid]
-	a.keysLock.RUnlock()
+	key, found := a.ecKey(kid)
+	if !found && a.refreshOnUnknownKey() {
+		key, found = a.ecKey(kid)
+	}
 	if !found {
 		return nil, fmt.Errorf("ECDSA key not found for key ID: %s", kid)
 	}
 	return key, nil
 }
 
+func (a *defaultTokenKeyProvider) rsaKey(kid string) (*rsa.PublicKey, bool) {
+	a.keysLock.RLock()
+	defer a.keysLock.RUnlock()
+	key, found := a.rsaKeys[kid]
+	return key, found
+}
+
+func (a *defaultTokenKeyProvider) ecKey(kid string) (*ecdsa.PublicKey, bool) {
+	a.keysLock.RLock()
+	defer a.keysLock.RUnlock()
+	key, found := a.ecKeys[kid]
+	return key, found
+}
+
+// refreshOnUnknownKey picks up keys rotated by the issuer since the last refresh. It returns true
+// if the keys were refreshed.
+func (a *defaultTokenKeyProvider) refreshOnUnknownKey() bool {
+	if !a.config.HasSourceURIsConfigured() {
+		return false
+	}
+
+	a.updateLock.Lock()
+	defer a.updateLock.Unlock()
+	if time.Since(a.lastUpdate) < minKeyRefreshInterval {
+		return false
+	}
+	if err := a.updateKeysLocked(); err != nil {
+		a.logger.Error("error while refreshing token keys for unknown key ID: ", tag.Error(err))
+		return false
+	}
+	return true
+}
+
 func (a *defaultTokenKeyProvider) SupportedMethods() []string {
 	return []string{jwt.SigningMethodRS256.Name, jwt.SigningMethodES256.Name}
 }
@@ -133,14 +188,30 @@ func (a *defaultTokenKeyProvider) timerCallback() {
 }
 
 func (a *defaultTokenKeyProvider) updateKeys() error {
+	a.updateLock.Lock()
+	defer a.updateLock.Unlock()
+	return a.updateKeysLocked()
+}
+
+func (a *defaultTokenKeyProvider) updateKeysLocked() error {
 	if !a.config.HasSourceURIsConfigured() {
 		return fmt.Errorf("no URIs configured for retrieving token keys")
 	}
+	a.lastUpdate = time.Now()
 
 	rsaKeys := make(map[string]*rsa.PublicKey)
 	ecKeys := make(map[string]*ecdsa.PublicKey)
 
-	for _, uri := range a.config.KeySourceURIs {
+	uris := a.config.KeySourceURIs
+	if strings.TrimSpace(a.config.OIDCIssuer) != "" {
+		jwksURI, err := a.discoverJWKSURI(a.config.OIDCIssuer)
+		if err != nil {
+			return err
+		}
+		uris = append([]string{jwksURI}, uris...)
+	}
+
+	for _, uri := range uris {
 		if strings.TrimSpace(uri) == "" {
 			continue
 		}
@@ -163,13 +234,16 @@ func (a *defaultTokenKeyProvider) updateKeysFromURI(
 	ecKeys map[string]*ecdsa.PublicKey,
 ) (err error) {
 
-	resp, err := http.Get(uri)
+	resp, err := a.httpClient.Get(uri)
 	if err != nil {
 		return err
 	}
 	defer func() {
 		err = multierr.Combine(err, resp.Body.Close())
 	}()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status retrieving token keys from %s: %s", uri, resp.Status)
+	}
 
 	jwks := jose.JSONWebKeySet{}
 	err = json.NewDecoder(resp.Body).Decode(&jwks)
@@ -190,6 +264,33 @@ func (a *defaultTokenKeyProvider) updateKeysFromURI(
 	return nil
 }
 
+// discoverJWKSURI reads the JWKS URI from the OpenID Connect discovery document of the issuer
+func (a *defaultTokenKeyProvider) discoverJWKSURI(issuer string) (_ string, err error) {
+	issuer = strings.TrimSuffix(strings.TrimSpace(issuer), "/")
+	resp, err := a.httpClient.Get(issuer + oidcDiscoveryPath)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		err = multierr.Combine(err, resp.Body.Close())
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status retrieving OpenID configuration of %s: %s", issuer, resp.Status)
+	}
+
+	document := oidcDiscoveryDocument{}
+	if err := json.NewDecoder(resp.Body).Decode(&document); err != nil {
+		return "", err
+	}
+	if strings.TrimSuffix(document.Issuer, "/") != issuer {
+		return "", fmt.Errorf("OpenID configuration issuer %q does not match %q", document.Issuer, issuer)
+	}
+	if document.JWKSURI == "" {
+		return "", fmt.Errorf("OpenID configuration of %s has no jwks_uri", issuer)
+	}
+	return document.JWKSURI, nil
+}
+
 func (a *defaultTokenKeyProvider) HmacKey(alg string, kid string) ([]byte, error) {
 	return nil, fmt.Errorf("unsupported key type HMAC for: %s", alg)
 }
@@ -0,0 +1,117 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/square/go-jose.v2"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+)
+
+type testOIDCServer struct {
+	*httptest.Server
+
+	sync.Mutex
+	keys jose.JSONWebKeySet
+}
+
+func newTestOIDCServer() *testOIDCServer {
+	s := &testOIDCServer{}
+	mux := http.NewServeMux()
+	discovery := func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(oidcDiscoveryDocument{Issuer: s.URL, JWKSURI: s.URL + "/keys"})
+	}
+	mux.HandleFunc(oidcDiscoveryPath, discovery)
+	// advertises an issuer that differs from its URL
+	mux.HandleFunc("/other"+oidcDiscoveryPath, discovery)
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		s.Lock()
+		defer s.Unlock()
+		_ = json.NewEncoder(w).Encode(s.keys)
+	})
+	s.Server = httptest.NewServer(mux)
+	return s
+}
+
+func (s *testOIDCServer) setKey(kid string, key *rsa.PublicKey) {
+	s.Lock()
+	defer s.Unlock()
+	s.keys = jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{Key: key, KeyID: kid, Algorithm: "RS256", Use: "sig"}}}
+}
+
+func TestTokenKeyProvider_OIDCDiscovery(t *testing.T) {
+	server := newTestOIDCServer()
+	defer server.Close()
+	key1, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	server.setKey("key-1", &key1.PublicKey)
+
+	provider := NewDefaultTokenKeyProvider(&config.Authorization{
+		JWTKeyProvider: config.JWTKeyProvider{OIDCIssuer: server.URL + "/"},
+	}, log.NewNoopLogger())
+
+	key, err := provider.RsaKey("RS256", "key-1")
+	require.NoError(t, err)
+	require.True(t, key1.PublicKey.Equal(key))
+
+	// rotated keys are picked up on the first unknown key ID, refreshes are rate limited
+	key2, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	server.setKey("key-2", &key2.PublicKey)
+	_, err = provider.RsaKey("RS256", "key-2")
+	require.Error(t, err)
+
+	provider.updateLock.Lock()
+	provider.lastUpdate = time.Now().Add(-minKeyRefreshInterval)
+	provider.updateLock.Unlock()
+	key, err = provider.RsaKey("RS256", "key-2")
+	require.NoError(t, err)
+	require.True(t, key2.PublicKey.Equal(key))
+	_, err = provider.RsaKey("RS256", "key-1")
+	require.Error(t, err)
+}
+
+func TestTokenKeyProvider_OIDCIssuerMismatch(t *testing.T) {
+	server := newTestOIDCServer()
+	defer server.Close()
+
+	provider := &defaultTokenKeyProvider{logger: log.NewNoopLogger(), httpClient: server.Client()}
+	_, err := provider.discoverJWKSURI(server.URL + "/other")
+	require.Error(t, err)
+	uri, err := provider.discoverJWKSURI(server.URL)
+	require.NoError(t, err)
+	require.Equal(t, server.URL+"/keys", uri)
+}
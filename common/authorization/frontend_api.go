@@ -52,6 +52,15 @@ var readOnlyGlobalAPI = map[string]struct{}{
 	"GetSystemInfo":       {},
 }
 
+// apiKeyManagementAPI are the APIs that manage namespace API keys. API keys can be issued with any role, so
+// these require the admin role.
+var apiKeyManagementAPI = map[string]struct{}{
+	"CreateNamespaceApiKey": {},
+	"ListNamespaceApiKeys":  {},
+	"RotateNamespaceApiKey": {},
+	"RevokeNamespaceApiKey": {},
+}
+
 // note that these use the fully-qualified name
 var healthCheckAPI = map[string]struct{}{
 	"/grpc.health.v1.Health/Check":                                   {},
@@ -72,3 +81,8 @@ func IsHealthCheckAPI(fullApi string) bool {
 	_, found := healthCheckAPI[fullApi]
 	return found
 }
+
+func IsAPIKeyManagementAPI(api string) bool {
+	_, found := apiKeyManagementAPI[api]
+	return found
+}
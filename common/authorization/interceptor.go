@@ -69,10 +69,18 @@ func (a *interceptor) Interceptor(
 	req interface{},
 	info *grpc.UnaryServerInfo,
 	handler grpc.UnaryHandler,
-) (interface{}, error) {
+) (_ interface{}, retError error) {
 
 	var claims *Claims
 
+	// calls rejected by the claim mapper are audited as well, without a principal
+	if a.auditSink != nil && IsMutatingAPI(info.FullMethod) {
+		defer func() { a.audit(claims, info.FullMethod, req, retError) }()
+		if gate, ok := a.auditSink.(auditGate); ok && !gate.accepting() {
+			return nil, errAuditUnavailable
+		}
+	}
+
 	if a.claimMapper != nil && a.authorizer != nil {
 		var tlsSubject *pkix.Name
 		var authHeaders []string
@@ -165,6 +173,30 @@ func (a *interceptor) authorize(
 	return a.authorizer.Authorize(ctx, claims, callTarget)
 }
 
+// audit writes the audit record of a call. A failure to write it is logged and does not fail the call.
+func (a *interceptor) audit(
+	claims *Claims,
+	apiName string,
+	req interface{},
+	callErr error,
+) {
+	var namespace string
+	if requestWithNamespace, ok := req.(hasNamespace); ok {
+		namespace = requestWithNamespace.GetNamespace()
+	}
+	record := NewAuditRecord(claims, apiName, namespace, req, callErr)
+
+	// the call context may already be done, a sink that blocks waits at most for the write timeout
+	ctx, cancel := context.WithTimeout(context.Background(), auditWriteTimeout)
+	defer cancel()
+	if err := a.auditSink.Write(ctx, record); err != nil {
+		a.logger.Error("Unable to write audit record",
+			tag.NewStringTag("api-name", apiName),
+			tag.WorkflowNamespace(namespace),
+			tag.Error(err))
+	}
+}
+
 func (a *interceptor) logAuthError(err error) {
 	a.logger.Error("Authorization error", tag.Error(err))
 }
@@ -175,6 +207,7 @@ type interceptor struct {
 	metricsHandler metrics.Handler
 	logger         log.Logger
 	audienceGetter JWTAudienceMapper
+	auditSink      AuditSink
 }
 
 // NewAuthorizationInterceptor creates an authorization interceptor and return a func that points to its Interceptor method
@@ -184,6 +217,7 @@ func NewAuthorizationInterceptor(
 	metricsHandler metrics.Handler,
 	logger log.Logger,
 	audienceGetter JWTAudienceMapper,
+	auditSink AuditSink,
 ) grpc.UnaryServerInterceptor {
 	return (&interceptor{
 		claimMapper:    claimMapper,
@@ -191,6 +225,7 @@ func NewAuthorizationInterceptor(
 		metricsHandler: metricsHandler,
 		logger:         logger,
 		audienceGetter: audienceGetter,
+		auditSink:      auditSink,
 	}).Interceptor
 }
 
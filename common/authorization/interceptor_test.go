@@ -35,6 +35,8 @@ import (
 	"go.temporal.io/api/workflowservicemock/v1"
 	"google.golang.org/grpc"
 
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 )
@@ -88,6 +90,7 @@ func (s *authorizerInterceptorSuite) SetupTest() {
 		s.mockAuthorizer,
 		s.mockMetricsHandler,
 		log.NewNoopLogger(),
+		nil,
 		nil)
 	s.handler = func(ctx context.Context, req interface{}) (interface{}, error) { return true, nil }
 }
@@ -133,3 +136,27 @@ func (s *authorizerInterceptorSuite) TestAuthorizationFailed() {
 	s.Nil(res)
 	s.Error(err)
 }
+
+func TestInterceptorAuditUnavailable(t *testing.T) {
+	sink := newAsyncAuditSink(
+		config.Audit{BufferSize: 1, DropPolicy: AuditDropPolicyFail},
+		auditWriterFn(func(context.Context, []*persistencespb.AuditRecord) error { return nil }),
+		metrics.NoopMetricsHandler,
+		log.NewNoopLogger(),
+	)
+	interceptor := NewAuthorizationInterceptor(nil, nil, metrics.NoopMetricsHandler, log.NewNoopLogger(), nil, sink)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return true, nil }
+
+	// the record of the first call fills the buffer of the sink, which is not started
+	res, err := interceptor(ctx, startWorkflowExecutionRequest, startWorkflowExecutionInfo, handler)
+	require.NoError(t, err)
+	require.True(t, res.(bool))
+
+	_, err = interceptor(ctx, startWorkflowExecutionRequest, startWorkflowExecutionInfo, handler)
+	require.ErrorIs(t, err, errAuditUnavailable)
+
+	// calls that are not audited are not rejected
+	res, err = interceptor(ctx, describeNamespaceRequest, describeNamespaceInfo, handler)
+	require.NoError(t, err)
+	require.True(t, res.(bool))
+}
@@ -0,0 +1,150 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"crypto/x509"
+	"fmt"
+	"regexp"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+)
+
+const (
+	certFieldCommonName         = "commonName"
+	certFieldOrganization       = "organization"
+	certFieldOrganizationalUnit = "organizationalUnit"
+	certFieldURI                = "uri"
+	certFieldDNSName            = "dnsName"
+)
+
+type (
+	// Claim mapper that derives permissions from the client certificate of mTLS connections.
+	// Claims of a JWT token are added when the request carries one.
+	mtlsClaimMapper struct {
+		jwtClaimMapper *defaultJWTClaimMapper
+		rules          []mtlsRule
+	}
+
+	mtlsRule struct {
+		field       string
+		pattern     *regexp.Regexp
+		permissions []string
+	}
+)
+
+var _ ClaimMapper = (*mtlsClaimMapper)(nil)
+
+func NewMTLSClaimMapper(provider TokenKeyProvider, cfg *config.Authorization, logger log.Logger) (ClaimMapper, error) {
+	rules := make([]mtlsRule, 0, len(cfg.MTLS.Rules))
+	for i, rule := range cfg.MTLS.Rules {
+		switch rule.Field {
+		case certFieldCommonName, certFieldOrganization, certFieldOrganizationalUnit, certFieldURI, certFieldDNSName:
+		default:
+			return nil, fmt.Errorf("mtls rule %d: unknown certificate field: %q", i, rule.Field)
+		}
+		pattern, err := regexp.Compile("^(?:" + rule.Pattern + ")$")
+		if err != nil {
+			return nil, fmt.Errorf("mtls rule %d: invalid pattern: %w", i, err)
+		}
+		rules = append(rules, mtlsRule{field: rule.Field, pattern: pattern, permissions: rule.Permissions})
+	}
+	return &mtlsClaimMapper{
+		jwtClaimMapper: NewDefaultJWTClaimMapper(provider, cfg, logger).(*defaultJWTClaimMapper),
+		rules:          rules,
+	}, nil
+}
+
+func (m *mtlsClaimMapper) GetClaims(authInfo *AuthInfo) (*Claims, error) {
+	claims, err := m.jwtClaimMapper.GetClaims(authInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	cert := PeerCert(authInfo.TLSConnection)
+	var permissions []interface{}
+	for _, rule := range m.rules {
+		for _, value := range certFieldValues(authInfo, cert, rule.field) {
+			match := rule.pattern.FindStringSubmatchIndex(value)
+			if match == nil {
+				continue
+			}
+			for _, permission := range rule.permissions {
+				permissions = append(permissions, string(rule.pattern.ExpandString(nil, permission, value, match)))
+			}
+		}
+	}
+	if err := m.jwtClaimMapper.extractPermissions(permissions, claims); err != nil {
+		return nil, err
+	}
+
+	if claims.Subject == "" {
+		claims.Subject = certIdentity(authInfo, cert)
+	}
+	return claims, nil
+}
+
+func certFieldValues(authInfo *AuthInfo, cert *x509.Certificate, field string) []string {
+	switch field {
+	case certFieldCommonName:
+		if authInfo.TLSSubject != nil && authInfo.TLSSubject.CommonName != "" {
+			return []string{authInfo.TLSSubject.CommonName}
+		}
+	case certFieldOrganization:
+		if authInfo.TLSSubject != nil {
+			return authInfo.TLSSubject.Organization
+		}
+	case certFieldOrganizationalUnit:
+		if authInfo.TLSSubject != nil {
+			return authInfo.TLSSubject.OrganizationalUnit
+		}
+	case certFieldURI:
+		if cert != nil {
+			uris := make([]string, 0, len(cert.URIs))
+			for _, uri := range cert.URIs {
+				uris = append(uris, uri.String())
+			}
+			return uris
+		}
+	case certFieldDNSName:
+		if cert != nil {
+			return cert.DNSNames
+		}
+	}
+	return nil
+}
+
+// certIdentity is the subject of claims mapped from a certificate only: the common name, or the
+// first URI such as a SPIFFE ID
+func certIdentity(authInfo *AuthInfo, cert *x509.Certificate) string {
+	if authInfo.TLSSubject != nil && authInfo.TLSSubject.CommonName != "" {
+		return authInfo.TLSSubject.CommonName
+	}
+	if cert != nil && len(cert.URIs) > 0 {
+		return cert.URIs[0].String()
+	}
+	return ""
+}
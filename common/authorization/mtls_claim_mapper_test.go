@@ -0,0 +1,120 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/credentials"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+)
+
+var testMTLSConfig = &config.Authorization{
+	MTLS: config.MTLSClaimMapper{
+		Rules: []config.MTLSRule{
+			{Field: "commonName", Pattern: "temporal-admin", Permissions: []string{"system:admin"}},
+			{Field: "organizationalUnit", Pattern: "team-(.+)", Permissions: []string{"$1:write"}},
+			{Field: "uri", Pattern: "spiffe://prod/ns/(?P<namespace>[^/]+)/worker", Permissions: []string{"${namespace}:worker"}},
+		},
+	},
+}
+
+func testAuthInfo(subject pkix.Name, uris ...string) *AuthInfo {
+	cert := &x509.Certificate{Subject: subject}
+	for _, uri := range uris {
+		u, _ := url.Parse(uri)
+		cert.URIs = append(cert.URIs, u)
+	}
+	return &AuthInfo{
+		TLSSubject: &cert.Subject,
+		TLSConnection: &credentials.TLSInfo{
+			State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}},
+		},
+	}
+}
+
+func TestMTLSClaimMapper(t *testing.T) {
+	tokenGenerator := newTokenGenerator()
+	claimMapper, err := NewMTLSClaimMapper(tokenGenerator, testMTLSConfig, log.NewNoopLogger())
+	require.NoError(t, err)
+
+	claims, err := claimMapper.GetClaims(testAuthInfo(pkix.Name{CommonName: "temporal-admin"}))
+	require.NoError(t, err)
+	require.Equal(t, "temporal-admin", claims.Subject)
+	require.Equal(t, RoleAdmin, claims.System)
+
+	claims, err = claimMapper.GetClaims(testAuthInfo(
+		pkix.Name{OrganizationalUnit: []string{"team-orders", "other"}},
+		"spiffe://prod/ns/billing/worker",
+	))
+	require.NoError(t, err)
+	require.Equal(t, "spiffe://prod/ns/billing/worker", claims.Subject)
+	require.Equal(t, RoleUndefined, claims.System)
+	require.Equal(t, map[string]Role{"orders": RoleWriter, "billing": RoleWorker}, claims.Namespaces)
+
+	claims, err = claimMapper.GetClaims(testAuthInfo(pkix.Name{CommonName: "temporal-admin-2"}, "spiffe://prod/ns/billing/worker/extra"))
+	require.NoError(t, err)
+	require.Equal(t, RoleUndefined, claims.System)
+	require.Empty(t, claims.Namespaces)
+}
+
+func TestMTLSClaimMapper_CombinedWithJWT(t *testing.T) {
+	tokenGenerator := newTokenGenerator()
+	claimMapper, err := NewMTLSClaimMapper(tokenGenerator, testMTLSConfig, log.NewNoopLogger())
+	require.NoError(t, err)
+
+	tokenString, err := tokenGenerator.generateRSAToken(testSubject, []string{"orders:read"}, errorTestOptionNoError)
+	require.NoError(t, err)
+	authInfo := testAuthInfo(pkix.Name{CommonName: "worker", OrganizationalUnit: []string{"team-orders"}})
+	authInfo.AuthToken = AddBearer(tokenString)
+
+	claims, err := claimMapper.GetClaims(authInfo)
+	require.NoError(t, err)
+	require.Equal(t, testSubject, claims.Subject)
+	require.Equal(t, map[string]Role{"orders": RoleReader | RoleWriter}, claims.Namespaces)
+
+	authInfo.AuthToken = AddBearer("invalid")
+	_, err = claimMapper.GetClaims(authInfo)
+	require.Error(t, err)
+}
+
+func TestMTLSClaimMapper_InvalidConfig(t *testing.T) {
+	_, err := NewMTLSClaimMapper(nil, &config.Authorization{
+		MTLS: config.MTLSClaimMapper{Rules: []config.MTLSRule{{Field: "serialNumber", Pattern: ".*"}}},
+	}, log.NewNoopLogger())
+	require.Error(t, err)
+
+	_, err = NewMTLSClaimMapper(nil, &config.Authorization{
+		MTLS: config.MTLSClaimMapper{Rules: []config.MTLSRule{{Field: "commonName", Pattern: "("}}},
+	}, log.NewNoopLogger())
+	require.Error(t, err)
+}
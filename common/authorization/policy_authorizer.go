@@ -0,0 +1,304 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	commonpb "go.temporal.io/api/common/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"gopkg.in/yaml.v3"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/filewatcher"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+const (
+	// PolicyEffectAllow allows the calls matched by a rule
+	PolicyEffectAllow = "allow"
+	// PolicyEffectDeny denies the calls matched by a rule
+	PolicyEffectDeny = "deny"
+
+	policyWildcard = "*"
+)
+
+type (
+	// Policy is an ordered list of rules. The first rule that matches a call decides it. Calls that
+	// match no rule are decided by the role based default authorizer.
+	Policy struct {
+		Rules []PolicyRule `yaml:"rules"`
+	}
+
+	// PolicyRule matches calls by principal, API, namespace and request attributes. An empty list
+	// matches any value, and a value ending with "*" matches any value with that prefix.
+	//
+	// Workflow types are read from StartWorkflowExecution and SignalWithStartWorkflowExecution. For
+	// calls that target an existing workflow execution, such as SignalWorkflowExecution,
+	// TerminateWorkflowExecution, RequestCancelWorkflowExecution, QueryWorkflow and
+	// UpdateWorkflowExecution, they are looked up when a workflow type resolver is set. Task queues can only be determined for StartWorkflowExecution,
+	// SignalWithStartWorkflowExecution, PollWorkflowTaskQueue, PollActivityTaskQueue,
+	// DescribeTaskQueue and ListTaskQueuePartitions. For any other call, or if the lookup fails,
+	// these rules fail closed: a deny rule matches it and an allow rule doesn't. Scope such rules
+	// with APIs to avoid denying unrelated calls.
+	PolicyRule struct {
+		// Name is used in the reason of a decision
+		Name   string `yaml:"name"`
+		Effect string `yaml:"effect"`
+		// Principals are matched against the subject of the caller claims
+		Principals []string `yaml:"principals"`
+		// APIs are short API names, such as "TerminateWorkflowExecution"
+		APIs          []string `yaml:"apis"`
+		Namespaces    []string `yaml:"namespaces"`
+		WorkflowTypes []string `yaml:"workflowTypes"`
+		TaskQueues    []string `yaml:"taskQueues"`
+	}
+
+	policyAuthorizer struct {
+		config   config.PolicyAuthorizer
+		logger   log.Logger
+		policy   atomic.Value // *Policy
+		fallback Authorizer
+		watcher  *filewatcher.Watcher
+		resolver WorkflowTypeResolver
+	}
+
+	// WorkflowTypeResolver looks up the workflow type of an existing workflow execution
+	WorkflowTypeResolver interface {
+		GetWorkflowType(ctx context.Context, namespace string, execution *commonpb.WorkflowExecution) (string, error)
+	}
+
+	hasWorkflowType interface {
+		GetWorkflowType() *commonpb.WorkflowType
+	}
+
+	hasTaskQueue interface {
+		GetTaskQueue() *taskqueuepb.TaskQueue
+	}
+)
+
+var _ Authorizer = (*policyAuthorizer)(nil)
+
+// NewPolicyAuthorizer creates an authorizer driven by the rules of a policy file. The file is
+// reloaded when it changes if a refresh interval is configured, until Close is called.
+func NewPolicyAuthorizer(cfg *config.Authorization, logger log.Logger) (Authorizer, error) {
+	a := &policyAuthorizer{
+		config:   cfg.Policy,
+		logger:   logger,
+		fallback: NewDefaultAuthorizer(),
+	}
+	if err := a.initialize(); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// NewPolicyAuthorizerWithPolicy creates an authorizer with a fixed policy
+func NewPolicyAuthorizerWithPolicy(policy *Policy) (Authorizer, error) {
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+	a := &policyAuthorizer{fallback: NewDefaultAuthorizer()}
+	a.policy.Store(policy)
+	return a, nil
+}
+
+// SetWorkflowTypeResolver lets an authorizer that matches calls by workflow type look up the
+// workflow type of the execution targeted by a call. It has no effect on other authorizers.
+func SetWorkflowTypeResolver(authorizer Authorizer, resolver WorkflowTypeResolver) {
+	if a, ok := authorizer.(*policyAuthorizer); ok {
+		a.resolver = resolver
+	}
+}
+
+func (a *policyAuthorizer) initialize() error {
+	if a.config.PolicyFile == "" {
+		return fmt.Errorf("policy file is not configured")
+	}
+	watcher, err := filewatcher.New(a.config.PolicyFile, a.config.RefreshInterval, a.updatePolicy, a.logger)
+	if err != nil {
+		return fmt.Errorf("policy file: %w", err)
+	}
+	a.watcher = watcher
+	return nil
+}
+
+func (a *policyAuthorizer) Close() {
+	if a.watcher != nil {
+		a.watcher.Stop()
+	}
+}
+
+func (a *policyAuthorizer) Authorize(ctx context.Context, claims *Claims, target *CallTarget) (Result, error) {
+	// APIs that are essentially read-only health checks with no sensitive information are
+	// always allowed
+	if IsHealthCheckAPI(target.APIName) {
+		return resultAllow, nil
+	}
+
+	// the workflow type of an execution is looked up at most once, and only if a rule needs it
+	var workflowType *string
+	getWorkflowType := func() string {
+		if workflowType == nil {
+			name := a.workflowType(ctx, target)
+			workflowType = &name
+		}
+		return *workflowType
+	}
+
+	policy := a.policy.Load().(*Policy)
+	for _, rule := range policy.Rules {
+		if !rule.matches(claims, target, getWorkflowType) {
+			continue
+		}
+		reason := fmt.Sprintf("policy rule %q", rule.Name)
+		if rule.Effect == PolicyEffectAllow {
+			return Result{Decision: DecisionAllow, Reason: reason}, nil
+		}
+		return Result{Decision: DecisionDeny, Reason: reason}, nil
+	}
+	return a.fallback.Authorize(ctx, claims, target)
+}
+
+// workflowType returns the workflow type of the call, or "" if it can't be determined
+func (a *policyAuthorizer) workflowType(ctx context.Context, target *CallTarget) string {
+	if request, ok := target.Request.(hasWorkflowType); ok {
+		return request.GetWorkflowType().GetName()
+	}
+
+	var execution *commonpb.WorkflowExecution
+	switch request := target.Request.(type) {
+	case hasWorkflowExecution:
+		execution = request.GetWorkflowExecution()
+	case hasExecution:
+		execution = request.GetExecution()
+	}
+	if a.resolver == nil || execution.GetWorkflowId() == "" {
+		return ""
+	}
+	workflowType, err := a.resolver.GetWorkflowType(ctx, target.Namespace, execution)
+	if err != nil {
+		return ""
+	}
+	return workflowType
+}
+
+func (a *policyAuthorizer) updatePolicy(content []byte) error {
+	policy, err := ParsePolicy(content)
+	if err != nil {
+		return err
+	}
+
+	a.policy.Store(policy)
+	a.logger.Info("Loaded authorization policy",
+		tag.NewStringTag("policy-file", a.config.PolicyFile),
+		tag.Counter(len(policy.Rules)))
+	return nil
+}
+
+// ParsePolicy decodes and validates a YAML policy
+func ParsePolicy(content []byte) (*Policy, error) {
+	var policy Policy
+	if err := yaml.Unmarshal(content, &policy); err != nil {
+		return nil, fmt.Errorf("unable to decode policy: %w", err)
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// Validate checks that every rule of the policy has a known effect
+func (p *Policy) Validate() error {
+	for i, rule := range p.Rules {
+		switch rule.Effect {
+		case PolicyEffectAllow, PolicyEffectDeny:
+		default:
+			return fmt.Errorf("policy rule %d (%q): unknown effect %q", i, rule.Name, rule.Effect)
+		}
+	}
+	return nil
+}
+
+func (r *PolicyRule) matches(claims *Claims, target *CallTarget, workflowType func() string) bool {
+	var subject string
+	if claims != nil {
+		subject = claims.Subject
+	}
+	if !matchesAny(r.Principals, subject, false) ||
+		!matchesAny(r.APIs, ApiName(target.APIName), false) ||
+		!matchesAny(r.Namespaces, target.Namespace, true) {
+		return false
+	}
+	if len(r.WorkflowTypes) > 0 {
+		if !r.matchesRequestAttribute(r.WorkflowTypes, workflowType()) {
+			return false
+		}
+	}
+	if len(r.TaskQueues) > 0 {
+		var taskQueue string
+		if request, ok := target.Request.(hasTaskQueue); ok {
+			taskQueue = request.GetTaskQueue().GetName()
+		}
+		if !r.matchesRequestAttribute(r.TaskQueues, taskQueue) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesRequestAttribute matches an attribute read from the request. An attribute that can't be
+// determined fails closed: it matches deny rules and doesn't match allow rules.
+func (r *PolicyRule) matchesRequestAttribute(patterns []string, value string) bool {
+	if value == "" {
+		return r.Effect == PolicyEffectDeny
+	}
+	return matchesAny(patterns, value, false)
+}
+
+func matchesAny(patterns []string, value string, ignoreCase bool) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	if ignoreCase {
+		value = strings.ToLower(value)
+	}
+	for _, pattern := range patterns {
+		if ignoreCase {
+			pattern = strings.ToLower(pattern)
+		}
+		if pattern == policyWildcard || pattern == value {
+			return true
+		}
+		if strings.HasSuffix(pattern, policyWildcard) && strings.HasPrefix(value, strings.TrimSuffix(pattern, policyWildcard)) {
+			return true
+		}
+	}
+	return false
+}
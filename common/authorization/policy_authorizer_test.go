@@ -0,0 +1,277 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+)
+
+const testPolicy = `
+rules:
+  - name: signal-orders
+    effect: allow
+    principals: ["svc-orders"]
+    apis: ["SignalWithStartWorkflowExecution"]
+    workflowTypes: ["Order*"]
+  - name: admins-terminate
+    effect: allow
+    principals: ["admin"]
+    apis: ["TerminateWorkflowExecution"]
+  - name: no-terminate
+    effect: deny
+    apis: ["TerminateWorkflowExecution"]
+  - name: no-orders
+    effect: deny
+    principals: ["svc-orders"]
+  - name: no-payments
+    effect: deny
+    principals: ["svc-payments"]
+    apis: ["StartWorkflowExecution", "SignalWorkflowExecution"]
+    workflowTypes: ["Payment*"]
+`
+
+type (
+	policyAuthorizerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		authorizer Authorizer
+	}
+)
+
+func TestPolicyAuthorizerSuite(t *testing.T) {
+	s := new(policyAuthorizerSuite)
+	suite.Run(t, s)
+}
+
+func (s *policyAuthorizerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	policy, err := ParsePolicy([]byte(testPolicy))
+	s.NoError(err)
+	s.authorizer, err = NewPolicyAuthorizerWithPolicy(policy)
+	s.NoError(err)
+}
+
+func (s *policyAuthorizerSuite) TestAuthorize() {
+	signalWithStart := func(workflowType string) *workflowservice.SignalWithStartWorkflowExecutionRequest {
+		return &workflowservice.SignalWithStartWorkflowExecutionRequest{
+			Namespace:    testNamespace,
+			WorkflowType: &commonpb.WorkflowType{Name: workflowType},
+		}
+	}
+	testCases := []struct {
+		name     string
+		claims   *Claims
+		apiName  string
+		request  interface{}
+		decision Decision
+	}{
+		{
+			name:     "matching workflow type",
+			claims:   &Claims{Subject: "svc-orders"},
+			apiName:  "/temporal.api.workflowservice.v1.WorkflowService/SignalWithStartWorkflowExecution",
+			request:  signalWithStart("OrderWorkflow"),
+			decision: DecisionAllow,
+		},
+		{
+			name:     "other workflow type",
+			claims:   &Claims{Subject: "svc-orders"},
+			apiName:  "/temporal.api.workflowservice.v1.WorkflowService/SignalWithStartWorkflowExecution",
+			request:  signalWithStart("PaymentWorkflow"),
+			decision: DecisionDeny,
+		},
+		{
+			name:     "request without workflow type",
+			claims:   &Claims{Subject: "svc-orders", System: RoleAdmin},
+			apiName:  "/temporal.api.workflowservice.v1.WorkflowService/SignalWorkflowExecution",
+			request:  &workflowservice.SignalWorkflowExecutionRequest{Namespace: testNamespace},
+			decision: DecisionDeny,
+		},
+		{
+			name:    "deny rule matching workflow type",
+			claims:  &Claims{Subject: "svc-payments", Namespaces: map[string]Role{testNamespace: RoleWriter}},
+			apiName: "/temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution",
+			request: &workflowservice.StartWorkflowExecutionRequest{
+				Namespace:    testNamespace,
+				WorkflowType: &commonpb.WorkflowType{Name: "PaymentWorkflow"},
+			},
+			decision: DecisionDeny,
+		},
+		{
+			name:    "deny rule with other workflow type",
+			claims:  &Claims{Subject: "svc-payments", Namespaces: map[string]Role{testNamespace: RoleWriter}},
+			apiName: "/temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution",
+			request: &workflowservice.StartWorkflowExecutionRequest{
+				Namespace:    testNamespace,
+				WorkflowType: &commonpb.WorkflowType{Name: "RefundWorkflow"},
+			},
+			decision: DecisionAllow,
+		},
+		{
+			name:     "deny rule fails closed without workflow type",
+			claims:   &Claims{Subject: "svc-payments", Namespaces: map[string]Role{testNamespace: RoleWriter}},
+			apiName:  "/temporal.api.workflowservice.v1.WorkflowService/SignalWorkflowExecution",
+			request:  &workflowservice.SignalWorkflowExecutionRequest{Namespace: testNamespace},
+			decision: DecisionDeny,
+		},
+		{
+			name:     "admin terminate",
+			claims:   &Claims{Subject: "admin"},
+			apiName:  "/temporal.api.workflowservice.v1.WorkflowService/TerminateWorkflowExecution",
+			decision: DecisionAllow,
+		},
+		{
+			name:     "terminate denied for system admin",
+			claims:   &Claims{Subject: "user", System: RoleAdmin},
+			apiName:  "/temporal.api.workflowservice.v1.WorkflowService/TerminateWorkflowExecution",
+			decision: DecisionDeny,
+		},
+		{
+			name:     "no rule falls back to roles",
+			claims:   &Claims{Subject: "user", Namespaces: map[string]Role{testNamespace: RoleWriter}},
+			apiName:  "/temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution",
+			decision: DecisionAllow,
+		},
+		{
+			name:     "no rule and no role",
+			claims:   &Claims{Subject: "user"},
+			apiName:  "/temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution",
+			decision: DecisionDeny,
+		},
+		{
+			name:     "health check",
+			apiName:  "/grpc.health.v1.Health/Check",
+			decision: DecisionAllow,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			result, err := s.authorizer.Authorize(context.Background(), tc.claims, &CallTarget{
+				APIName:   tc.apiName,
+				Namespace: testNamespace,
+				Request:   tc.request,
+			})
+			s.NoError(err)
+			s.Equal(tc.decision, result.Decision)
+		})
+	}
+}
+
+type workflowTypesByID map[string]string
+
+func (w workflowTypesByID) GetWorkflowType(_ context.Context, _ string, execution *commonpb.WorkflowExecution) (string, error) {
+	workflowType, ok := w[execution.GetWorkflowId()]
+	if !ok {
+		return "", serviceerror.NewNotFound("workflow not found")
+	}
+	return workflowType, nil
+}
+
+func (s *policyAuthorizerSuite) TestAuthorizeResolvedWorkflowType() {
+	policy, err := ParsePolicy([]byte(`
+rules:
+  - name: signal-orders
+    effect: allow
+    principals: ["svc-orders"]
+    apis: ["SignalWorkflowExecution"]
+    workflowTypes: ["OrderWorkflow"]
+  - name: no-signal-payments
+    effect: deny
+    apis: ["SignalWorkflowExecution"]
+    workflowTypes: ["PaymentWorkflow"]
+`))
+	s.NoError(err)
+	authorizer, err := NewPolicyAuthorizerWithPolicy(policy)
+	s.NoError(err)
+	SetWorkflowTypeResolver(authorizer, workflowTypesByID{"order": "OrderWorkflow", "payment": "PaymentWorkflow"})
+
+	signal := func(claims *Claims, workflowID string) Decision {
+		result, err := authorizer.Authorize(context.Background(), claims, &CallTarget{
+			APIName:   "/temporal.api.workflowservice.v1.WorkflowService/SignalWorkflowExecution",
+			Namespace: testNamespace,
+			Request: &workflowservice.SignalWorkflowExecutionRequest{
+				Namespace:         testNamespace,
+				WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: workflowID},
+			},
+		})
+		s.NoError(err)
+		return result.Decision
+	}
+	writer := &Claims{Subject: "user", Namespaces: map[string]Role{testNamespace: RoleWriter}}
+	s.Equal(DecisionAllow, signal(&Claims{Subject: "svc-orders"}, "order"))
+	s.Equal(DecisionDeny, signal(&Claims{Subject: "svc-orders"}, "payment"))
+	s.Equal(DecisionDeny, signal(writer, "payment"))
+	s.Equal(DecisionAllow, signal(writer, "order"))
+	// an execution whose type can't be looked up fails closed
+	s.Equal(DecisionDeny, signal(writer, "missing"))
+}
+
+func (s *policyAuthorizerSuite) TestInvalidEffect() {
+	_, err := ParsePolicy([]byte("rules:\n  - name: bad\n    effect: maybe\n"))
+	s.Error(err)
+}
+
+func (s *policyAuthorizerSuite) TestReload() {
+	policyFile := filepath.Join(s.T().TempDir(), "policy.yaml")
+	s.NoError(os.WriteFile(policyFile, []byte("rules:\n  - name: deny-all\n    effect: deny\n"), 0644))
+
+	cfg := &config.Authorization{
+		Authorizer: "policy",
+		Policy: config.PolicyAuthorizer{
+			PolicyFile:      policyFile,
+			RefreshInterval: 10 * time.Millisecond,
+		},
+	}
+	authorizer, err := GetAuthorizerFromConfig(cfg, log.NewNoopLogger())
+	s.NoError(err)
+	defer CloseAuthorizer(authorizer)
+
+	target := &CallTarget{APIName: "StartWorkflowExecution", Namespace: testNamespace}
+	result, err := authorizer.Authorize(context.Background(), &claimsSystemAdmin, target)
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+
+	s.NoError(os.WriteFile(policyFile, []byte("rules:\n  - name: allow-all\n    effect: allow\n"), 0644))
+	future := time.Now().Add(time.Minute)
+	s.NoError(os.Chtimes(policyFile, future, future))
+	s.Eventually(func() bool {
+		result, err := authorizer.Authorize(context.Background(), &claimsSystemAdmin, target)
+		return err == nil && result.Decision == DecisionAllow
+	}, 5*time.Second, 10*time.Millisecond)
+}
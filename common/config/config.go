@@ -60,6 +60,8 @@ type (
 		Services map[string]Service `yaml:"services"`
 		// Archival is the config for archival
 		Archival Archival `yaml:"archival"`
+		// PayloadStore is the config for the external store that large payloads are offloaded to
+		PayloadStore PayloadStore `yaml:"payloadStore"`
 		// PublicClient is config for connecting to temporal frontend
 		PublicClient PublicClient `yaml:"publicClient"`
 		// DynamicConfigClient is the config for setting up the file based dynamic config client
@@ -210,7 +212,7 @@ type (
 		WarningWindow time.Duration `yaml:"warningWindow"`
 		// Log error for certificates expiring during this time window from now
 		ErrorWindow time.Duration `yaml:"errorWindow"`
-		// Interval between checks for certificate expiration
+		// Interval between checks for certificate expiration. Expiration metrics are emitted every hour if not set.
 		CheckInterval time.Duration `yaml:"checkInterval"`
 	}
 
@@ -239,6 +241,16 @@ type (
 		DataStores map[string]DataStore `yaml:"datastores"`
 		// TransactionSizeLimit is the largest allowed transaction size
 		TransactionSizeLimit dynamicconfig.IntPropertyFn `yaml:"-" json:"-"`
+		// Encryption contains the config for encryption at rest of history events and mutable state
+		Encryption *Encryption `yaml:"encryption"`
+	}
+
+	// Encryption is the config for encryption at rest of workflow data in the default store
+	Encryption struct {
+		// KeyFile is the path of the YAML file with the encryption keys and the active key of each namespace
+		KeyFile string `yaml:"keyFile" validate:"nonzero"`
+		// RefreshInterval is how often the key file is checked for changes. Zero disables reloading.
+		RefreshInterval time.Duration `yaml:"refreshInterval"`
 	}
 
 	// DataStore is the configuration for a single datastore
@@ -249,13 +261,22 @@ type (
 		Cassandra *Cassandra `yaml:"cassandra"`
 		// SQL contains the config for a SQL based datastore
 		SQL *SQL `yaml:"sql"`
+		// KV contains the config for an embedded key-value datastore
+		KV *KV `yaml:"kv"`
 		// Custom contains the config for custom datastore implementation
 		CustomDataStoreConfig *CustomDatastoreConfig `yaml:"customDatastore"`
 		// ElasticSearch contains the config for a ElasticSearch datastore
 		Elasticsearch *client.Config `yaml:"elasticsearch"`
 	}
 
+	// FaultInjection is the static fault injection config of a datastore. Faults can also be set at runtime,
+	// without a restart, with the SetPersistenceFault admin API if AllowRuntimeFaults is set.
 	FaultInjection struct {
+		// AllowRuntimeFaults enables the faults set at runtime with the SetPersistenceFault admin API. It is
+		// only read from the config of the default store, and it is off by default so that production clusters
+		// can't have faults injected into them.
+		AllowRuntimeFaults bool `yaml:"allowRuntimeFaults"`
+
 		// Rate is the probability that we will return an error from any call to any datastore.
 		// The value should be between 0.0 and 1.0.
 		// The fault injector will inject different errors depending on the data store and method. See the
@@ -400,6 +421,46 @@ type (
 		TaskScanPartitions int `yaml:"taskScanPartitions"`
 		// TLS is the configuration for TLS connections
 		TLS *auth.TLS `yaml:"tls"`
+		// ReadReplicas is an optional list of addresses of read replicas of the database. Replicas use the
+		// same credentials, TLS and connection pool settings as ConnectAddr. Reads that tolerate staleness
+		// are served by a healthy replica; writes and all other reads always go to ConnectAddr.
+		ReadReplicas []string `yaml:"readReplicas"`
+		// MaxReplicaLag is the replication lag above which a replica stops serving reads until it catches up.
+		// The default value for this param is 30s.
+		MaxReplicaLag time.Duration `yaml:"maxReplicaLag"`
+		// ShardDatabases is an optional list of additional databases that history shards are spread over.
+		// History shards that are not mapped to any of them, and all data that does not belong to a history
+		// shard (namespaces, cluster metadata, task queues, queues), are stored in this database.
+		// Only supported by the default store.
+		ShardDatabases []SQLShardDatabase `yaml:"shardDatabases"`
+	}
+
+	// SQLShardDatabase is a database that stores the history shards in [MinShardID, MaxShardID].
+	// Connection settings that are not set are inherited from the enclosing SQL config.
+	SQLShardDatabase struct {
+		// MinShardID is the first history shard stored in this database
+		MinShardID int32 `yaml:"minShardID"`
+		// MaxShardID is the last history shard stored in this database
+		MaxShardID int32 `yaml:"maxShardID"`
+		// ConnectAddr is the remote addr of the database
+		ConnectAddr string `yaml:"connectAddr"`
+		// DatabaseName is the name of SQL database to connect to
+		DatabaseName string `yaml:"databaseName"`
+		// User is the username to be used for the conn
+		User string `yaml:"user"`
+		// Password is the password corresponding to the user name
+		Password string `yaml:"password"`
+	}
+
+	// KV is the configuration for an embedded key-value datastore. It is meant for
+	// single-node deployments: the data directory can only be opened by one process.
+	KV struct {
+		// Path is the directory that holds the database files
+		Path string `yaml:"path"`
+		// InMemory keeps all data in memory and ignores Path. Data is lost on shutdown.
+		InMemory bool `yaml:"inMemory"`
+		// SyncWrites fsyncs every write before acknowledging it
+		SyncWrites bool `yaml:"syncWrites"`
 	}
 
 	// CustomDatastoreConfig is the configuration for connecting to a custom datastore that is not supported by temporal core
@@ -468,6 +529,17 @@ type (
 		Gstorage  *GstorageArchiver  `yaml:"gstorage"`
 	}
 
+	// PayloadStore contains the config for the external store of payloads offloaded from history.
+	// The store is shared by the frontend and history services of a cluster.
+	PayloadStore struct {
+		// URI of the store, either file:///path/to/dir or s3://bucket/path. Offloading is disabled if empty.
+		URI string `yaml:"uri"`
+		// Filestore contains the config for a store on the local filesystem
+		Filestore *FilestoreArchiver `yaml:"filestore"`
+		// S3store contains the config for a store on S3 or an S3 compatible service
+		S3store *S3Archiver `yaml:"s3store"`
+	}
+
 	// FilestoreArchiver contain the config for filestore archiver
 	FilestoreArchiver struct {
 		FileMode string `yaml:"fileMode"`
@@ -546,10 +618,26 @@ type (
 		// Signing key provider for validating JWT tokens
 		JWTKeyProvider       JWTKeyProvider `yaml:"jwtKeyProvider"`
 		PermissionsClaimName string         `yaml:"permissionsClaimName"`
-		// Empty string for noopAuthorizer or "default" for defaultAuthorizer
+		// Expected "iss" claim of JWT tokens. Defaults to the OIDC issuer of the key provider, if any.
+		Issuer string `yaml:"issuer"`
+		// Expected "aud" claim of JWT tokens, used when no audience is provided by the AudienceGetter
+		Audience string `yaml:"audience"`
+		// Dot separated paths of the claims with the groups or roles of the user, for example "groups"
+		// or "realm_access.roles"
+		GroupsClaimPaths []string `yaml:"groupsClaimPaths"`
+		// Permissions granted to the members of each group, in the same "namespace:role" format as the
+		// permissions claim
+		GroupPermissions map[string][]string `yaml:"groupPermissions"`
+		// Empty string for noopAuthorizer, "default" for defaultAuthorizer or "policy" for policyAuthorizer
 		Authorizer string `yaml:"authorizer"`
-		// Empty string for noopClaimMapper or "default" for defaultJWTClaimMapper
+		// Rules for policyAuthorizer
+		Policy PolicyAuthorizer `yaml:"policy"`
+		// Audit log of mutating API calls
+		Audit Audit `yaml:"audit"`
+		// Empty string for noopClaimMapper, "default" for defaultJWTClaimMapper or "mtls" for mtlsClaimMapper
 		ClaimMapper string `yaml:"claimMapper"`
+		// Rules for mtlsClaimMapper
+		MTLS MTLSClaimMapper `yaml:"mtls"`
 	}
 
 	// @@@SNIPSTART temporal-common-service-config-jwtkeyprovider
@@ -557,8 +645,66 @@ type (
 	JWTKeyProvider struct {
 		KeySourceURIs   []string      `yaml:"keySourceURIs"`
 		RefreshInterval time.Duration `yaml:"refreshInterval"`
+		// OpenID Connect issuer. The JWKS URI is discovered from its
+		// .well-known/openid-configuration document, in addition to KeySourceURIs.
+		OIDCIssuer string `yaml:"oidcIssuer"`
 	}
 	// @@@SNIPEND
+
+	// Contains the config for the policy authorizer
+	PolicyAuthorizer struct {
+		// Path of the YAML file with the policy rules
+		PolicyFile string `yaml:"policyFile"`
+		// How often the policy file is checked for changes. Zero disables reloading.
+		RefreshInterval time.Duration `yaml:"refreshInterval"`
+	}
+
+	// Contains the config for the mTLS claim mapper
+	MTLSClaimMapper struct {
+		// Rules mapping client certificate identities to permissions. All matching rules apply.
+		Rules []MTLSRule `yaml:"rules"`
+	}
+
+	// MTLSRule grants permissions to client certificates with a matching field
+	MTLSRule struct {
+		// Certificate field to match: "commonName", "organization", "organizationalUnit", "uri" or "dnsName"
+		Field string `yaml:"field"`
+		// Regular expression matched against the whole field value
+		Pattern string `yaml:"pattern"`
+		// Permissions in the "namespace:role" format of the JWT permissions claim. Submatches of the
+		// pattern can be referenced as $1 or ${name}.
+		Permissions []string `yaml:"permissions"`
+	}
+
+	// Audit contains the config for the audit log of mutating API calls
+	Audit struct {
+		// Empty string to disable auditing, "file" or "persistence"
+		Sink string `yaml:"sink"`
+		// Config for the "file" sink
+		File FileAuditSink `yaml:"file"`
+		// Records are buffered and written in batches. Defaults to 10000.
+		BufferSize int `yaml:"bufferSize"`
+		// What happens to records that can't be recorded because the buffer is full or a write
+		// fails. "drop" (the default) drops them. "block" makes calls wait for room in the
+		// buffer, and retries failed writes. "fail" retries failed writes as well, and rejects
+		// mutating calls while the buffer is full or writes fail.
+		DropPolicy string `yaml:"dropPolicy"`
+		// Maximum number of records written at once. Defaults to 100.
+		BatchSize int `yaml:"batchSize"`
+		// How long records wait for a batch to fill up before they are written. Defaults to 1s.
+		FlushInterval time.Duration `yaml:"flushInterval"`
+		// How long the "persistence" sink keeps records. Zero keeps them forever.
+		Retention time.Duration `yaml:"retention"`
+	}
+
+	// FileAuditSink contains the config for writing audit records to a JSON lines file
+	FileAuditSink struct {
+		Path string `yaml:"path"`
+		// The file is rotated when it reaches this size. Zero disables rotation.
+		MaxSizeMB int `yaml:"maxSizeMB"`
+		// Number of rotated files to keep. Zero keeps all of them.
+		MaxBackups int `yaml:"maxBackups"`
+	}
 )
 
 const (
@@ -620,6 +766,9 @@ func (r *GroupTLS) IsClientEnabled() bool {
 }
 
 func (p *JWTKeyProvider) HasSourceURIsConfigured() bool {
+	if strings.TrimSpace(p.OIDCIssuer) != "" {
+		return true
+	}
 	if len(p.KeySourceURIs) == 0 {
 		return false
 	}
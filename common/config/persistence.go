@@ -38,6 +38,8 @@ const (
 	StoreTypeSQL = "sql"
 	// StoreTypeNoSQL refers to nosql based storage as persistence store
 	StoreTypeNoSQL = "nosql"
+	// StoreTypeKV refers to embedded key-value storage as persistence store
+	StoreTypeKV = "kv"
 )
 
 // DefaultStoreType returns the storeType for the default persistence store
@@ -45,6 +47,9 @@ func (c *Persistence) DefaultStoreType() string {
 	if c.DataStores[c.DefaultStore].SQL != nil {
 		return StoreTypeSQL
 	}
+	if c.DataStores[c.DefaultStore].KV != nil {
+		return StoreTypeKV
+	}
 	return StoreTypeNoSQL
 }
 
@@ -68,6 +73,10 @@ func (c *Persistence) Validate() error {
 		return err
 	}
 
+	if err := c.validateShardDatabases(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -81,6 +90,12 @@ func (c *Persistence) AdvancedVisibilityConfigExist() bool {
 	return c.AdvancedVisibilityStore != ""
 }
 
+// RuntimeFaultInjectionEnabled returns true if the default store allows faults to be set at runtime
+func (c *Persistence) RuntimeFaultInjectionEnabled() bool {
+	faultInjection := c.DataStores[c.DefaultStore].FaultInjection
+	return faultInjection != nil && faultInjection.AllowRuntimeFaults
+}
+
 func (c *Persistence) IsSQLVisibilityStore() bool {
 	return c.StandardVisibilityConfigExist() && c.DataStores[c.VisibilityStore].SQL != nil
 }
@@ -115,11 +130,17 @@ func (ds *DataStore) Validate() error {
 	if ds.Cassandra != nil {
 		storeConfigCount++
 	}
+	if ds.KV != nil {
+		storeConfigCount++
+	}
 	if ds.CustomDataStoreConfig != nil {
 		storeConfigCount++
 	}
 	if storeConfigCount != 1 {
-		return errors.New("must provide config for one and only one for DataStore of cassandra or sql or custom stores")
+		return errors.New("must provide config for one and only one for DataStore of cassandra or sql or kv or custom stores")
+	}
+	if ds.KV != nil && !ds.KV.InMemory && ds.KV.Path == "" {
+		return errors.New("kv datastore requires a path unless inMemory is set")
 	}
 
 	if ds.SQL != nil && ds.SQL.TaskScanPartitions == 0 {
@@ -168,6 +189,57 @@ func ensureStoreConsistencyNotNil(c *CassandraStoreConsistency) *CassandraStoreC
 	return c
 }
 
+func (c *Persistence) validateShardDatabases() error {
+	for name, ds := range c.DataStores {
+		if ds.SQL == nil || len(ds.SQL.ShardDatabases) == 0 {
+			continue
+		}
+		if name != c.DefaultStore {
+			return fmt.Errorf("persistence config: datastore %q: shardDatabases is only supported by the default store", name)
+		}
+		if err := ds.SQL.validateShardDatabases(c.NumHistoryShards); err != nil {
+			return fmt.Errorf("persistence config: datastore %q: %s", name, err.Error())
+		}
+	}
+	return nil
+}
+
+func (c *SQL) validateShardDatabases(numHistoryShards int32) error {
+	for i, db := range c.ShardDatabases {
+		if db.MinShardID < 1 || db.MaxShardID < db.MinShardID || db.MaxShardID > numHistoryShards {
+			return fmt.Errorf("shard database %d: invalid shard ID range [%d, %d] for %d history shards",
+				i, db.MinShardID, db.MaxShardID, numHistoryShards)
+		}
+		for j, other := range c.ShardDatabases[:i] {
+			if db.MinShardID <= other.MaxShardID && other.MinShardID <= db.MaxShardID {
+				return fmt.Errorf("shard database %d: shard ID range overlaps with shard database %d", i, j)
+			}
+		}
+	}
+	return nil
+}
+
+// ShardDatabase returns the connection config of a shard database. Settings that the shard
+// database does not set are inherited from c. Read replicas are not inherited.
+func (c *SQL) ShardDatabase(db SQLShardDatabase) *SQL {
+	cfg := *c
+	cfg.ShardDatabases = nil
+	cfg.ReadReplicas = nil
+	if db.ConnectAddr != "" {
+		cfg.ConnectAddr = db.ConnectAddr
+	}
+	if db.DatabaseName != "" {
+		cfg.DatabaseName = db.DatabaseName
+	}
+	if db.User != "" {
+		cfg.User = db.User
+	}
+	if db.Password != "" {
+		cfg.Password = db.Password
+	}
+	return &cfg
+}
+
 func (c *Cassandra) validate() error {
 	return c.Consistency.validate()
 }
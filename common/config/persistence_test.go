@@ -230,3 +230,87 @@ func TestCassandraStoreConsistency_validate(t *testing.T) {
 		})
 	}
 }
+
+func TestSQL_validateShardDatabases(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   []SQLShardDatabase
+		wantErr bool
+	}{
+		{
+			name:    "No Shard Databases",
+			input:   nil,
+			wantErr: false,
+		},
+		{
+			name: "Disjoint Ranges",
+			input: []SQLShardDatabase{
+				{MinShardID: 1, MaxShardID: 2},
+				{MinShardID: 3, MaxShardID: 4},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Overlapping Ranges",
+			input: []SQLShardDatabase{
+				{MinShardID: 1, MaxShardID: 2},
+				{MinShardID: 2, MaxShardID: 3},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Empty Range",
+			input: []SQLShardDatabase{
+				{MinShardID: 2, MaxShardID: 1},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Range Beyond Shard Count",
+			input: []SQLShardDatabase{
+				{MinShardID: 3, MaxShardID: 5},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Zero Shard ID",
+			input: []SQLShardDatabase{
+				{MinShardID: 0, MaxShardID: 1},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &SQL{ShardDatabases: tt.input}
+			if err := c.validateShardDatabases(4); (err != nil) != tt.wantErr {
+				t.Errorf("SQL.validateShardDatabases() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSQL_ShardDatabase(t *testing.T) {
+	t.Parallel()
+
+	c := &SQL{
+		User:           "user",
+		Password:       "password",
+		DatabaseName:   "temporal",
+		ConnectAddr:    "primary:3306",
+		ReadReplicas:   []string{"replica:3306"},
+		ShardDatabases: []SQLShardDatabase{{MinShardID: 1, MaxShardID: 1}},
+	}
+	got := c.ShardDatabase(SQLShardDatabase{ConnectAddr: "shard:3306", DatabaseName: "temporal_1"})
+	want := &SQL{
+		User:         "user",
+		Password:     "password",
+		DatabaseName: "temporal_1",
+		ConnectAddr:  "shard:3306",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SQL.ShardDatabase() = %+v, want %+v", got, want)
+	}
+}
@@ -85,6 +85,7 @@ type (
 	MapPropertyFnWithNamespaceFilter           func(namespace string) map[string]any
 	StringPropertyFn                           func() string
 	StringPropertyFnWithNamespaceFilter        func(namespace string) string
+	StringPropertyFnWithNamespaceIDFilter      func(namespaceID string) string
 )
 
 const (
@@ -349,6 +350,19 @@ func (c *Collection) GetStringPropertyFnWithNamespaceFilter(key Key, defaultValu
 	}
 }
 
+// GetStringPropertyFnWithNamespaceIDFilter gets property with namespaceID filter and asserts that it's a string
+func (c *Collection) GetStringPropertyFnWithNamespaceIDFilter(key Key, defaultValue any) StringPropertyFnWithNamespaceIDFilter {
+	return func(namespaceID string) string {
+		return matchAndConvert(
+			c,
+			key,
+			defaultValue,
+			namespaceIDPrecedence(namespaceID),
+			convertString,
+		)
+	}
+}
+
 // GetMapPropertyFnWithNamespaceFilter gets property and asserts that it's a map
 func (c *Collection) GetMapPropertyFnWithNamespaceFilter(key Key, defaultValue any) MapPropertyFnWithNamespaceFilter {
 	return func(namespace string) map[string]interface{} {
@@ -56,6 +56,9 @@ const (
 	EnableReadFromSecondaryAdvancedVisibility = "system.enableReadFromSecondaryAdvancedVisibility"
 	// VisibilityDisableOrderByClause is the config to disable ORDERY BY clause for Elasticsearch
 	VisibilityDisableOrderByClause = "system.visibilityDisableOrderByClause"
+	// EnablePerNamespaceVisibilityIndex is the config to create dedicated Elasticsearch visibility index
+	// on namespace registration and replication. Visibility records of namespace are routed to its index if the index exists.
+	EnablePerNamespaceVisibilityIndex = "system.enablePerNamespaceVisibilityIndex"
 
 	// HistoryArchivalState is key for the state of history archival
 	HistoryArchivalState = "system.historyArchivalState"
@@ -94,6 +97,15 @@ const (
 	EnableEagerWorkflowStart = "system.enableEagerWorkflowStart"
 	// NamespaceCacheRefreshInterval is the key for namespace cache refresh interval dynamic config
 	NamespaceCacheRefreshInterval = "system.namespaceCacheRefreshInterval"
+	// PersistenceBlobCompression is the algorithm that compresses history events and mutable state of a
+	// namespace before they are written to the default store: zstd, snappy, or empty to not compress.
+	// Compressed blobs stay readable when it is turned off.
+	PersistenceBlobCompression = "system.persistenceBlobCompression"
+	// PersistenceBlobCompressionMinSize is the size in bytes below which blobs are not compressed
+	PersistenceBlobCompressionMinSize = "system.persistenceBlobCompressionMinSize"
+	// PersistenceFaultRefreshInterval is how often every host reloads the persistence faults set with the
+	// SetPersistenceFault admin API
+	PersistenceFaultRefreshInterval = "system.persistenceFaultRefreshInterval"
 
 	// Whether the deadlock detector should dump goroutines
 	DeadlockDumpGoroutines = "system.deadlock.DumpGoroutines"
@@ -112,6 +124,9 @@ const (
 	BlobSizeLimitError = "limit.blobSize.error"
 	// BlobSizeLimitWarn is the per event blob size limit for warning
 	BlobSizeLimitWarn = "limit.blobSize.warn"
+	// PayloadOffloadThreshold is the size above which payloads are offloaded to the payload store,
+	// so they don't count towards the blob size limits. Zero disables offloading.
+	PayloadOffloadThreshold = "limit.payloadOffloadThreshold"
 	// MemoSizeLimitError is the per event memo size limit
 	MemoSizeLimitError = "limit.memoSize.error"
 	// MemoSizeLimitWarn is the per event memo size limit for warning
@@ -260,6 +275,14 @@ const (
 	FrontendMaxExecutionCountBatchOperationPerNamespace = "frontend.MaxExecutionCountBatchOperationPerNamespace"
 	// FrontendEnableBatcher enables batcher-related RPCs in the frontend
 	FrontendEnableBatcher = "frontend.enableBatcher"
+	// FrontendMaxResetWorkflowExecutions is the max number of executions the ResetWorkflowExecutions admin API
+	// resets in one call
+	FrontendMaxResetWorkflowExecutions = "frontend.maxResetWorkflowExecutions"
+	// FrontendEnableNamespaceAPIKeys enables the API keys issued by the server for namespaces
+	FrontendEnableNamespaceAPIKeys = "frontend.enableNamespaceAPIKeys"
+	// FrontendNamespaceAPIKeyLastUsedUpdateInterval is the interval at which the last used time of API keys
+	// is written to the namespace metadata
+	FrontendNamespaceAPIKeyLastUsedUpdateInterval = "frontend.namespaceAPIKeyLastUsedUpdateInterval"
 
 	// FrontendEnableUpdateWorkflowExecution enables UpdateWorkflowExecution API in the frontend.
 	//  UpdateWorkflowExecution API is under active development and is not ready for production use.
@@ -649,6 +672,10 @@ const (
 	ReplicationTaskProcessorShardQPS = "history.ReplicationTaskProcessorShardQPS"
 	// ReplicationBypassCorruptedData is the flag to bypass corrupted workflow data in source cluster
 	ReplicationBypassCorruptedData = "history.ReplicationBypassCorruptedData"
+	// ReplicatedWorkflowTypes is the set of workflow types replicated to remote clusters for a global namespace,
+	// keyed by workflow type name with value true. All workflow executions are replicated if empty. The set is
+	// checked when a workflow execution starts, changing it does not affect executions already started.
+	ReplicatedWorkflowTypes = "history.replicatedWorkflowTypes"
 
 	// keys for worker
 
@@ -713,6 +740,23 @@ const (
 	HistoryScannerEnabled = "worker.historyScannerEnabled"
 	// ExecutionsScannerEnabled indicates if executions scanner should be started as part of worker.Scanner
 	ExecutionsScannerEnabled = "worker.executionsScannerEnabled"
+	// VisibilityScannerEnabled indicates if visibility scanner should be started as part of worker.Scanner
+	VisibilityScannerEnabled = "worker.visibilityScannerEnabled"
+	// VisibilityScannerPerHostQPS is the maximum rate of calls per host from visibility.Scanner
+	VisibilityScannerPerHostQPS = "worker.visibilityScannerPerHostQPS"
+	// VisibilityScannerPerShardQPS is the maximum rate of calls per shard from visibility.Scanner
+	VisibilityScannerPerShardQPS = "worker.visibilityScannerPerShardQPS"
+	// VisibilityScannerWorkerCount is the visibility scavenger worker count
+	VisibilityScannerWorkerCount = "worker.visibilityScannerWorkerCount"
+	// VisibilityScannerSampleRate is the fraction of executions the visibility scanner compares with their
+	// visibility records, between 0 and 1
+	VisibilityScannerSampleRate = "worker.visibilityScannerSampleRate"
+	// VisibilityScannerDataMinAge is how long an execution must be left untouched before the visibility scanner
+	// checks it, so visibility tasks that are still in flight are not reported as mismatches
+	VisibilityScannerDataMinAge = "worker.visibilityScannerDataMinAge"
+	// VisibilityScannerRepairEnabled indicates if the visibility scanner re-enqueues visibility tasks for
+	// mismatched records and deletes records of executions that no longer exist
+	VisibilityScannerRepairEnabled = "worker.visibilityScannerRepairEnabled"
 	// HistoryScannerDataMinAge indicates the history scanner cleanup minimum age.
 	HistoryScannerDataMinAge = "worker.historyScannerDataMinAge"
 	// HistoryScannerVerifyRetention indicates the history scanner verify data retention.
@@ -0,0 +1,125 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package filewatcher
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+type (
+	// LoadFunc parses the content of a watched file and applies it. If it returns an error, the
+	// last content that was loaded successfully stays in effect.
+	LoadFunc func(content []byte) error
+
+	// Watcher loads a file and reloads it when its modification time changes
+	Watcher struct {
+		path            string
+		refreshInterval time.Duration
+		load            LoadFunc
+		logger          log.Logger
+
+		lastModTime time.Time
+		stopOnce    sync.Once
+		stopC       chan struct{}
+		doneC       chan struct{}
+	}
+)
+
+// New loads the file once and returns an error if that fails. If the refresh interval is not
+// zero, the file is then checked for changes at that interval until Stop is called.
+func New(path string, refreshInterval time.Duration, load LoadFunc, logger log.Logger) (*Watcher, error) {
+	if path == "" {
+		return nil, fmt.Errorf("file is not configured")
+	}
+	w := &Watcher{
+		path:            path,
+		refreshInterval: refreshInterval,
+		load:            load,
+		logger:          logger,
+		stopC:           make(chan struct{}),
+		doneC:           make(chan struct{}),
+	}
+	if err := w.reload(); err != nil {
+		return nil, err
+	}
+	if refreshInterval > 0 {
+		go w.refreshLoop()
+	} else {
+		close(w.doneC)
+	}
+	return w, nil
+}
+
+// Stop stops watching the file and waits for a reload in progress to complete. It is safe to call
+// Stop more than once.
+func (w *Watcher) Stop() {
+	w.stopOnce.Do(func() { close(w.stopC) })
+	<-w.doneC
+}
+
+func (w *Watcher) refreshLoop() {
+	defer close(w.doneC)
+
+	ticker := time.NewTicker(w.refreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-w.stopC:
+			return
+		case <-ticker.C:
+		}
+		if err := w.reload(); err != nil {
+			w.logger.Error("Error while reloading file, keeping the last loaded content",
+				tag.NewStringTag("file", w.path),
+				tag.Error(err))
+		}
+	}
+}
+
+func (w *Watcher) reload() error {
+	info, err := os.Stat(w.path)
+	if err != nil {
+		return fmt.Errorf("%s: %w", w.path, err)
+	}
+	if !info.ModTime().After(w.lastModTime) {
+		return nil
+	}
+
+	content, err := os.ReadFile(w.path)
+	if err != nil {
+		return fmt.Errorf("%s: %w", w.path, err)
+	}
+	if err := w.load(content); err != nil {
+		return fmt.Errorf("%s: %w", w.path, err)
+	}
+	w.lastModTime = info.ModTime()
+	return nil
+}
@@ -0,0 +1,114 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package filewatcher
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/log"
+)
+
+type (
+	watcherSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		path string
+	}
+)
+
+func TestWatcherSuite(t *testing.T) {
+	s := new(watcherSuite)
+	suite.Run(t, s)
+}
+
+func (s *watcherSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.path = filepath.Join(s.T().TempDir(), "file")
+}
+
+func (s *watcherSuite) TestNew_LoadError() {
+	_, err := New(s.path, 0, func([]byte) error { return nil }, log.NewNoopLogger())
+	s.Error(err)
+
+	s.NoError(os.WriteFile(s.path, []byte("invalid"), 0644))
+	_, err = New(s.path, 0, func([]byte) error { return errors.New("invalid") }, log.NewNoopLogger())
+	s.Error(err)
+}
+
+func (s *watcherSuite) TestReload() {
+	s.NoError(os.WriteFile(s.path, []byte("v1"), 0644))
+
+	var content atomic.Value
+	load := func(c []byte) error {
+		if string(c) == "invalid" {
+			return errors.New("invalid")
+		}
+		content.Store(string(c))
+		return nil
+	}
+	w, err := New(s.path, 10*time.Millisecond, load, log.NewNoopLogger())
+	s.NoError(err)
+	defer w.Stop()
+	s.Equal("v1", content.Load())
+
+	s.write("invalid", time.Minute)
+	s.write("v2", 2*time.Minute)
+	s.Eventually(func() bool {
+		return content.Load() == "v2"
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func (s *watcherSuite) TestStop() {
+	s.NoError(os.WriteFile(s.path, []byte("v1"), 0644))
+
+	var loads atomic.Int32
+	load := func([]byte) error {
+		loads.Add(1)
+		return nil
+	}
+	w, err := New(s.path, 10*time.Millisecond, load, log.NewNoopLogger())
+	s.NoError(err)
+	w.Stop()
+	w.Stop()
+
+	s.write("v2", time.Minute)
+	time.Sleep(50 * time.Millisecond)
+	s.Equal(int32(1), loads.Load())
+}
+
+func (s *watcherSuite) write(content string, modTimeOffset time.Duration) {
+	s.NoError(os.WriteFile(s.path, []byte(content), 0644))
+	modTime := time.Now().Add(modTimeOffset)
+	s.NoError(os.Chtimes(s.path, modTime, modTime))
+}
@@ -41,6 +41,8 @@ var (
 	WorkflowActionWorkflowRecordMarker           = workflowAction("add-workflow-marker-record-event")
 	WorkflowActionUpsertWorkflowSearchAttributes = workflowAction("add-workflow-upsert-search-attributes-event")
 	WorkflowActionWorkflowPropertiesModified     = workflowAction("add-workflow-properties-modified-event")
+	WorkflowActionWorkflowPaused                 = workflowAction("add-workflow-paused-event")
+	WorkflowActionWorkflowUnpaused               = workflowAction("add-workflow-unpaused-event")
 
 	// workflow update
 	WorkflowActionUpdateAccepted  = workflowAction("add-workflow-update-accepted-event")
@@ -67,6 +69,7 @@ var (
 	WorkflowActionActivityTaskCancelRequested = workflowAction("add-activitytask-cancel-requested-event")
 	WorkflowActionActivityTaskCancelFailed    = workflowAction("add-activitytask-cancel-failed-event")
 	WorkflowActionActivityTaskRetry           = workflowAction("add-activitytask-retry-event")
+	WorkflowActionActivityTaskUpdated         = workflowAction("update-activitytask")
 
 	// timer
 	WorkflowActionTimerStarted      = workflowAction("add-timer-started-event")
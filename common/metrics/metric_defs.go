@@ -142,6 +142,36 @@ const (
 	AdminClientGetTaskQueueTasksScope = "AdminClientGetTaskQueueTasks"
 	// AdminClientDeleteWorkflowExecutionScope tracks RPC calls to admin service
 	AdminClientDeleteWorkflowExecutionScope = "AdminClientDeleteWorkflowExecution"
+	// AdminClientResetWorkflowExecutionsScope tracks RPC calls to admin service
+	AdminClientResetWorkflowExecutionsScope = "AdminClientResetWorkflowExecutions"
+	// AdminClientPauseWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientPauseWorkflowExecutionScope = "AdminClientPauseWorkflowExecution"
+	// AdminClientUnpauseWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientUnpauseWorkflowExecutionScope = "AdminClientUnpauseWorkflowExecution"
+	// AdminClientPauseActivityExecutionScope tracks RPC calls to admin service
+	AdminClientPauseActivityExecutionScope = "AdminClientPauseActivityExecution"
+	// AdminClientUnpauseActivityExecutionScope tracks RPC calls to admin service
+	AdminClientUnpauseActivityExecutionScope = "AdminClientUnpauseActivityExecution"
+	// AdminClientResetActivityExecutionScope tracks RPC calls to admin service
+	AdminClientResetActivityExecutionScope = "AdminClientResetActivityExecution"
+	// AdminClientUpdateActivityExecutionOptionsScope tracks RPC calls to admin service
+	AdminClientUpdateActivityExecutionOptionsScope = "AdminClientUpdateActivityExecutionOptions"
+	// AdminClientListAuditRecordsScope tracks RPC calls to admin service
+	AdminClientListAuditRecordsScope = "AdminClientListAuditRecords"
+	// AdminClientGetReplicationStatusScope tracks RPC calls to admin service
+	AdminClientGetReplicationStatusScope = "AdminClientGetReplicationStatus"
+	// AdminClientCreateNamespaceApiKeyScope tracks RPC calls to admin service
+	AdminClientCreateNamespaceApiKeyScope = "AdminClientCreateNamespaceApiKey"
+	// AdminClientListNamespaceApiKeysScope tracks RPC calls to admin service
+	AdminClientListNamespaceApiKeysScope = "AdminClientListNamespaceApiKeys"
+	// AdminClientRotateNamespaceApiKeyScope tracks RPC calls to admin service
+	AdminClientRotateNamespaceApiKeyScope = "AdminClientRotateNamespaceApiKey"
+	// AdminClientRevokeNamespaceApiKeyScope tracks RPC calls to admin service
+	AdminClientRevokeNamespaceApiKeyScope = "AdminClientRevokeNamespaceApiKey"
+	// AdminClientSetPersistenceFaultScope tracks RPC calls to admin service
+	AdminClientSetPersistenceFaultScope = "AdminClientSetPersistenceFault"
+	// AdminClientRemovePersistenceFaultScope tracks RPC calls to admin service
+	AdminClientRemovePersistenceFaultScope = "AdminClientRemovePersistenceFault"
 
 	// AdminDescribeHistoryHostScope is the metric scope for admin.AdminDescribeHistoryHost
 	AdminDescribeHistoryHostScope = "AdminDescribeHistoryHost"
@@ -197,6 +227,36 @@ const (
 	AdminRemoveRemoteClusterScope = "AdminRemoveRemoteCluster"
 	// AdminDeleteWorkflowExecutionScope is the metric scope for admin.AdminDeleteWorkflowExecution
 	AdminDeleteWorkflowExecutionScope = "AdminDeleteWorkflowExecution"
+	// AdminResetWorkflowExecutionsScope is the metric scope for admin.AdminResetWorkflowExecutions
+	AdminResetWorkflowExecutionsScope = "AdminResetWorkflowExecutions"
+	// AdminPauseWorkflowExecutionScope is the metric scope for admin.AdminPauseWorkflowExecution
+	AdminPauseWorkflowExecutionScope = "AdminPauseWorkflowExecution"
+	// AdminUnpauseWorkflowExecutionScope is the metric scope for admin.AdminUnpauseWorkflowExecution
+	AdminUnpauseWorkflowExecutionScope = "AdminUnpauseWorkflowExecution"
+	// AdminPauseActivityExecutionScope is the metric scope for admin.AdminPauseActivityExecution
+	AdminPauseActivityExecutionScope = "AdminPauseActivityExecution"
+	// AdminUnpauseActivityExecutionScope is the metric scope for admin.AdminUnpauseActivityExecution
+	AdminUnpauseActivityExecutionScope = "AdminUnpauseActivityExecution"
+	// AdminResetActivityExecutionScope is the metric scope for admin.AdminResetActivityExecution
+	AdminResetActivityExecutionScope = "AdminResetActivityExecution"
+	// AdminUpdateActivityExecutionOptionsScope is the metric scope for admin.AdminUpdateActivityExecutionOptions
+	AdminUpdateActivityExecutionOptionsScope = "AdminUpdateActivityExecutionOptions"
+	// AdminListAuditRecordsScope is the metric scope for admin.AdminListAuditRecords
+	AdminListAuditRecordsScope = "AdminListAuditRecords"
+	// AdminGetReplicationStatusScope is the metric scope for admin.AdminGetReplicationStatus
+	AdminGetReplicationStatusScope = "AdminGetReplicationStatus"
+	// AdminCreateNamespaceApiKeyScope is the metric scope for admin.AdminCreateNamespaceApiKey
+	AdminCreateNamespaceApiKeyScope = "AdminCreateNamespaceApiKey"
+	// AdminListNamespaceApiKeysScope is the metric scope for admin.AdminListNamespaceApiKeys
+	AdminListNamespaceApiKeysScope = "AdminListNamespaceApiKeys"
+	// AdminRotateNamespaceApiKeyScope is the metric scope for admin.AdminRotateNamespaceApiKey
+	AdminRotateNamespaceApiKeyScope = "AdminRotateNamespaceApiKey"
+	// AdminRevokeNamespaceApiKeyScope is the metric scope for admin.AdminRevokeNamespaceApiKey
+	AdminRevokeNamespaceApiKeyScope = "AdminRevokeNamespaceApiKey"
+	// AdminSetPersistenceFaultScope is the metric scope for admin.AdminSetPersistenceFault
+	AdminSetPersistenceFaultScope = "AdminSetPersistenceFault"
+	// AdminRemovePersistenceFaultScope is the metric scope for admin.AdminRemovePersistenceFault
+	AdminRemovePersistenceFaultScope = "AdminRemovePersistenceFault"
 
 	// OperatorAddSearchAttributesScope is the metric scope for operator.AddSearchAttributes
 	OperatorAddSearchAttributesScope
@@ -377,6 +437,22 @@ const (
 	HistoryClientDeleteWorkflowExecutionScope = "HistoryClientDeleteWorkflowExecution"
 	// HistoryClientResetWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientResetWorkflowExecutionScope = "HistoryClientResetWorkflowExecution"
+	// HistoryClientResolveResetPointScope tracks RPC calls to history service
+	HistoryClientResolveResetPointScope = "HistoryClientResolveResetPoint"
+	// HistoryClientPauseWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientPauseWorkflowExecutionScope = "HistoryClientPauseWorkflowExecution"
+	// HistoryClientUnpauseWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientUnpauseWorkflowExecutionScope = "HistoryClientUnpauseWorkflowExecution"
+	// HistoryClientPauseActivityExecutionScope tracks RPC calls to history service
+	HistoryClientPauseActivityExecutionScope = "HistoryClientPauseActivityExecution"
+	// HistoryClientUnpauseActivityExecutionScope tracks RPC calls to history service
+	HistoryClientUnpauseActivityExecutionScope = "HistoryClientUnpauseActivityExecution"
+	// HistoryClientResetActivityExecutionScope tracks RPC calls to history service
+	HistoryClientResetActivityExecutionScope = "HistoryClientResetActivityExecution"
+	// HistoryClientUpdateActivityExecutionOptionsScope tracks RPC calls to history service
+	HistoryClientUpdateActivityExecutionOptionsScope = "HistoryClientUpdateActivityExecutionOptions"
+	// HistoryClientGenerateVisibilityTasksScope tracks RPC calls to history service
+	HistoryClientGenerateVisibilityTasksScope = "HistoryClientGenerateVisibilityTasks"
 	// HistoryClientScheduleWorkflowTaskScope tracks RPC calls to history service
 	HistoryClientScheduleWorkflowTaskScope = "HistoryClientScheduleWorkflowTask"
 	// HistoryClientVerifyFirstWorkflowTaskScheduled tracks RPC calls to history service
@@ -471,6 +547,8 @@ const (
 	TaskQueueScavengerScope = "TaskQueueScavenger"
 	// ExecutionsScavengerScope is scope used by all metrics emitted by worker.executions.Scavenger module
 	ExecutionsScavengerScope = "ExecutionsScavenger"
+	// VisibilityScavengerScope is scope used by all metrics emitted by worker.visibility.Scavenger module
+	VisibilityScavengerScope = "VisibilityScavenger"
 )
 
 const (
@@ -916,6 +994,22 @@ const (
 	HistoryUpdateWorkflowExecutionScope = "UpdateWorkflowExecution"
 	// HistoryResetWorkflowExecutionScope tracks ResetWorkflowExecution API calls received by service
 	HistoryResetWorkflowExecutionScope = "ResetWorkflowExecution"
+	// HistoryResolveResetPointScope tracks ResolveResetPoint API calls received by service
+	HistoryResolveResetPointScope = "ResolveResetPoint"
+	// HistoryPauseWorkflowExecutionScope tracks PauseWorkflowExecution API calls received by service
+	HistoryPauseWorkflowExecutionScope = "PauseWorkflowExecution"
+	// HistoryUnpauseWorkflowExecutionScope tracks UnpauseWorkflowExecution API calls received by service
+	HistoryUnpauseWorkflowExecutionScope = "UnpauseWorkflowExecution"
+	// HistoryPauseActivityExecutionScope tracks PauseActivityExecution API calls received by service
+	HistoryPauseActivityExecutionScope = "PauseActivityExecution"
+	// HistoryUnpauseActivityExecutionScope tracks UnpauseActivityExecution API calls received by service
+	HistoryUnpauseActivityExecutionScope = "UnpauseActivityExecution"
+	// HistoryResetActivityExecutionScope tracks ResetActivityExecution API calls received by service
+	HistoryResetActivityExecutionScope = "ResetActivityExecution"
+	// HistoryUpdateActivityExecutionOptionsScope tracks UpdateActivityExecutionOptions API calls received by service
+	HistoryUpdateActivityExecutionOptionsScope = "UpdateActivityExecutionOptions"
+	// HistoryGenerateVisibilityTasksScope tracks GenerateVisibilityTasks API calls received by service
+	HistoryGenerateVisibilityTasksScope = "GenerateVisibilityTasks"
 	// HistoryQueryWorkflowScope tracks QueryWorkflow API calls received by service
 	HistoryQueryWorkflowScope = "QueryWorkflow"
 	// HistoryProcessDeleteHistoryEventScope tracks ProcessDeleteHistoryEvent processing calls
@@ -1237,7 +1331,9 @@ var (
 	ActionCounter                                 = NewCounterDef("action")
 	TlsCertsExpired                               = NewGaugeDef("certificates_expired")
 	TlsCertsExpiring                              = NewGaugeDef("certificates_expiring")
+	TlsCertExpirationSeconds                      = NewGaugeDef("certificate_expiration_seconds")
 	ServiceAuthorizationLatency                   = NewTimerDef("service_authorization_latency")
+	AuditRecordsDropped                           = NewCounterDef("audit_records_dropped")
 	EventBlobSize                                 = NewBytesHistogramDef("event_blob_size")
 	NamespaceCachePrepareCallbacksLatency         = NewTimerDef("namespace_cache_prepare_callbacks_latency")
 	NamespaceCacheCallbacksLatency                = NewTimerDef("namespace_cache_callbacks_latency")
@@ -1437,8 +1533,11 @@ var (
 	LastProcessedMessageID                         = NewGaugeDef("last_processed_message_id")
 	ReplicationTasksApplied                        = NewCounterDef("replication_tasks_applied")
 	ReplicationTasksFailed                         = NewCounterDef("replication_tasks_failed")
+	ReplicationTasksExcluded                       = NewCounterDef("replication_tasks_excluded")
 	ReplicationTasksLag                            = NewTimerDef("replication_tasks_lag")
 	ReplicationLatency                             = NewTimerDef("replication_latency")
+	ReplicationOutboundLag                         = NewTimerDef("replication_outbound_lag")
+	ReplicationInboundLag                          = NewTimerDef("replication_inbound_lag")
 	ReplicationTasksFetched                        = NewTimerDef("replication_tasks_fetched")
 	ReplicationTasksReturned                       = NewTimerDef("replication_tasks_returned")
 	ReplicationTasksAppliedLatency                 = NewTimerDef("replication_tasks_applied_latency")
@@ -1576,6 +1675,8 @@ var (
 	ScavengerValidationRequestsCount                          = NewCounterDef("scavenger_validation_requests")
 	ScavengerValidationFailuresCount                          = NewCounterDef("scavenger_validation_failures")
 	ScavengerValidationSkipsCount                             = NewCounterDef("scavenger_validation_skips")
+	ScavengerRepairRequestsCount                              = NewCounterDef("scavenger_repair_requests")
+	ScavengerRepairFailuresCount                              = NewCounterDef("scavenger_repair_failures")
 	AddSearchAttributesFailuresCount                          = NewCounterDef("add_search_attributes_failures")
 	DeleteNamespaceSuccessCount                               = NewCounterDef("delete_namespace_success")
 	RenameNamespaceSuccessCount                               = NewCounterDef("rename_namespace_success")
@@ -1588,6 +1689,7 @@ var (
 	CountExecutionsFailuresCount                              = NewCounterDef("count_executions_failures")
 	DeleteExecutionFailuresCount                              = NewCounterDef("delete_execution_failures")
 	DeleteExecutionNotFoundCount                              = NewCounterDef("delete_execution_not_found")
+	DeleteVisibilityIndexFailuresCount                        = NewCounterDef("delete_visibility_index_failures")
 	RateLimiterFailuresCount                                  = NewCounterDef("rate_limiter_failures")
 	BatcherProcessorSuccess                                   = NewCounterDef("batcher_processor_requests")
 	BatcherProcessorFailures                                  = NewCounterDef("batcher_processor_errors")
@@ -1620,6 +1722,9 @@ var (
 	ScheduleActionErrors                                      = NewCounterDef("schedule_action_errors")
 	ScheduleCancelWorkflowErrors                              = NewCounterDef("schedule_cancel_workflow_errors")
 	ScheduleTerminateWorkflowErrors                           = NewCounterDef("schedule_terminate_workflow_errors")
+	ScheduleDependencySkipped                                 = NewCounterDef("schedule_dependency_skipped")
+	ScheduleDependencyTimeouts                                = NewCounterDef("schedule_dependency_timeouts")
+	ScheduleSignalDependentErrors                             = NewCounterDef("schedule_signal_dependent_errors")
 
 	// Replication
 	NamespaceReplicationTaskAckLevelGauge = NewGaugeDef("namespace_replication_task_ack_level")
@@ -1642,6 +1747,15 @@ var (
 	PersistenceErrNamespaceAlreadyExistsCounter         = NewCounterDef("persistence_errors_namespace_already_exists")
 	PersistenceErrBadRequestCounter                     = NewCounterDef("persistence_errors_bad_request")
 	PersistenceErrResourceExhaustedCounter              = NewCounterDef("persistence_errors_resource_exhausted")
+	PersistenceBlobCompressionRatio                     = NewDimensionlessHistogramDef("persistence_blob_compression_ratio")
+	PersistenceBlobCompressionSavedBytes                = NewCounterDef("persistence_blob_compression_saved_bytes")
+	PersistenceBlobCompressionLatency                   = NewTimerDef("persistence_blob_compression_latency")
+	PersistenceBlobDecompressionLatency                 = NewTimerDef("persistence_blob_decompression_latency")
+	PersistenceSQLReplicaLag                            = NewGaugeDef("persistence_sql_replica_lag_seconds")
+	PersistenceSQLReplicaHealthy                        = NewGaugeDef("persistence_sql_replica_healthy")
+	PayloadOffloadLatency                               = NewTimerDef("payload_offload_latency")
+	PayloadRehydrationLatency                           = NewTimerDef("payload_rehydration_latency")
+	OffloadedPayloadSize                                = NewBytesHistogramDef("offloaded_payload_size")
 	VisibilityPersistenceRequests                       = NewCounterDef("visibility_persistence_requests")
 	VisibilityPersistenceErrorWithType                  = NewCounterDef("visibility_persistence_error_with_type")
 	VisibilityPersistenceFailures                       = NewCounterDef("visibility_persistence_errors")
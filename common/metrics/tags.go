@@ -41,16 +41,18 @@ const (
 	buildPlatformTag = "build_platform"
 	goVersionTag     = "go_version"
 
-	instance       = "instance"
-	namespace      = "namespace"
-	namespaceState = "namespace_state"
-	targetCluster  = "target_cluster"
-	taskQueue      = "taskqueue"
-	workflowType   = "workflowType"
-	activityType   = "activityType"
-	commandType    = "commandType"
-	serviceName    = "service_name"
-	actionType     = "action_type"
+	instance             = "instance"
+	namespace            = "namespace"
+	namespaceState       = "namespace_state"
+	targetCluster        = "target_cluster"
+	taskQueue            = "taskqueue"
+	workflowType         = "workflowType"
+	activityType         = "activityType"
+	commandType          = "commandType"
+	serviceName          = "service_name"
+	actionType           = "action_type"
+	compressionAlgorithm = "compression_algorithm"
+	replica              = "replica"
 	// Generic reason tag can be used anywhere a reason is needed.
 	reason = "reason"
 
@@ -269,6 +271,15 @@ func ActionType(value string) Tag {
 	return &tagImpl{key: actionType, value: value}
 }
 
+func CompressionAlgorithmTag(value string) Tag {
+	return &tagImpl{key: compressionAlgorithm, value: value}
+}
+
+// ReplicaTag returns a tag with the address of a read replica of a database.
+func ReplicaTag(value string) Tag {
+	return &tagImpl{key: replica, value: value}
+}
+
 func OperationTag(value string) Tag {
 	return &tagImpl{key: OperationTagName, value: value}
 }
@@ -71,6 +71,7 @@ type (
 		isGlobalNamespace           bool
 		failoverNotificationVersion int64
 		notificationVersion         int64
+		apiKeys                     map[string]*persistencespb.NamespaceApiKey
 
 		customSearchAttributesMapper CustomSearchAttributesMapper
 	}
@@ -100,6 +101,7 @@ func FromPersistentState(record *persistence.GetNamespaceResponse) *Namespace {
 		isGlobalNamespace:           record.IsGlobalNamespace,
 		failoverNotificationVersion: record.Namespace.FailoverNotificationVersion,
 		notificationVersion:         record.NotificationVersion,
+		apiKeys:                     record.Namespace.ApiKeys,
 		customSearchAttributesMapper: CustomSearchAttributesMapper{
 			fieldToAlias: record.Namespace.Config.CustomSearchAttributeAliases,
 			aliasToField: util.InverseMap(record.Namespace.Config.CustomSearchAttributeAliases),
@@ -117,6 +119,7 @@ func (ns *Namespace) Clone(ms ...Mutation) *Namespace {
 			ConfigVersion:               newns.configVersion,
 			FailoverNotificationVersion: newns.failoverNotificationVersion,
 			FailoverVersion:             newns.failoverVersion,
+			ApiKeys:                     newns.apiKeys,
 		},
 		IsGlobalNamespace:   newns.isGlobalNamespace,
 		NotificationVersion: newns.notificationVersion,
@@ -265,6 +268,12 @@ func (ns *Namespace) Retention() time.Duration {
 	return *ns.config.Retention
 }
 
+// APIKey returns the API key with the given id issued for this namespace.
+func (ns *Namespace) APIKey(keyID string) (*persistencespb.NamespaceApiKey, bool) {
+	key, ok := ns.apiKeys[keyID]
+	return key, ok
+}
+
 func (ns *Namespace) CustomSearchAttributesMapper() CustomSearchAttributesMapper {
 	return ns.customSearchAttributesMapper
 }
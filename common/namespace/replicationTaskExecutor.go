@@ -71,24 +71,33 @@ type (
 		Execute(ctx context.Context, task *replicationspb.NamespaceTaskAttributes) error
 	}
 
+	// VisibilityIndexCreator creates the dedicated visibility indices of a namespace
+	VisibilityIndexCreator interface {
+		CreateNamespaceIndices(ctx context.Context, namespaceID string, namespaceName string) error
+	}
+
 	namespaceReplicationTaskExecutorImpl struct {
-		currentCluster  string
-		metadataManager persistence.MetadataManager
-		logger          log.Logger
+		currentCluster         string
+		metadataManager        persistence.MetadataManager
+		visibilityIndexCreator VisibilityIndexCreator
+		logger                 log.Logger
 	}
 )
 
-// NewReplicationTaskExecutor create a new instance of namespace replicator
+// NewReplicationTaskExecutor create a new instance of namespace replicator.
+// visibilityIndexCreator can be nil if dedicated visibility indices are not used.
 func NewReplicationTaskExecutor(
 	currentCluster string,
 	metadataManagerV2 persistence.MetadataManager,
+	visibilityIndexCreator VisibilityIndexCreator,
 	logger log.Logger,
 ) ReplicationTaskExecutor {
 
 	return &namespaceReplicationTaskExecutorImpl{
-		currentCluster:  currentCluster,
-		metadataManager: metadataManagerV2,
-		logger:          logger,
+		currentCluster:         currentCluster,
+		metadataManager:        metadataManagerV2,
+		visibilityIndexCreator: visibilityIndexCreator,
+		logger:                 logger,
 	}
 }
 
@@ -177,10 +186,19 @@ func (h *namespaceReplicationTaskExecutorImpl) handleNamespaceCreationReplicatio
 			},
 			ConfigVersion:   task.GetConfigVersion(),
 			FailoverVersion: task.GetFailoverVersion(),
+			ApiKeys:         task.GetApiKeys().GetKeys(),
 		},
 		IsGlobalNamespace: true, // local namespace will not be replicated
 	}
 
+	// Create visibility indices before namespace, as the frontend does on registration, so no visibility
+	// records of the replicated namespace are written to shared index.
+	if h.visibilityIndexCreator != nil {
+		if err := h.visibilityIndexCreator.CreateNamespaceIndices(ctx, task.GetId(), task.Info.GetName()); err != nil {
+			return err
+		}
+	}
+
 	_, err = h.metadataManager.CreateNamespace(ctx, request)
 	if err != nil {
 		// SQL and Cassandra handle namespace UUID collision differently
@@ -292,6 +310,9 @@ func (h *namespaceReplicationTaskExecutorImpl) handleNamespaceUpdateReplicationT
 		}
 		request.Namespace.ReplicationConfig.Clusters = ConvertClusterReplicationConfigFromProto(task.ReplicationConfig.Clusters)
 		request.Namespace.ConfigVersion = task.GetConfigVersion()
+		if task.ApiKeys != nil {
+			request.Namespace.ApiKeys = task.ApiKeys.GetKeys()
+		}
 	}
 	if resp.Namespace.FailoverVersion < task.GetFailoverVersion() {
 		recordUpdated = true
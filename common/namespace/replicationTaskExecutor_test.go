@@ -74,6 +74,7 @@ func (s *namespaceReplicationTaskExecutorSuite) SetupTest() {
 	s.namespaceReplicator = NewReplicationTaskExecutor(
 		"some random standby cluster name",
 		s.mockMetadataMgr,
+		nil,
 		logger,
 	).(*namespaceReplicationTaskExecutorImpl)
 }
@@ -297,6 +298,49 @@ func (s *namespaceReplicationTaskExecutorSuite) TestExecute_RegisterNamespaceTas
 	s.Nil(err)
 }
 
+func (s *namespaceReplicationTaskExecutorSuite) TestExecute_RegisterNamespaceTask_VisibilityIndices() {
+	mockIndexCreator := NewMockVisibilityIndexCreator(s.controller)
+	s.namespaceReplicator.visibilityIndexCreator = mockIndexCreator
+
+	name := uuid.New()
+	id := uuid.New()
+	clusterActive := "some random active cluster name"
+	clusterStandby := "some random standby cluster name"
+	task := &replicationspb.NamespaceTaskAttributes{
+		Id:                 id,
+		NamespaceOperation: enumsspb.NAMESPACE_OPERATION_CREATE,
+		Info: &namespacepb.NamespaceInfo{
+			Name:  name,
+			State: enumspb.NAMESPACE_STATE_REGISTERED,
+		},
+		Config: &namespacepb.NamespaceConfig{},
+		ReplicationConfig: &replicationpb.NamespaceReplicationConfig{
+			ActiveClusterName: clusterActive,
+			Clusters: []*replicationpb.ClusterReplicationConfig{
+				{ClusterName: clusterActive},
+				{ClusterName: clusterStandby},
+			},
+		},
+	}
+	s.mockMetadataMgr.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{
+		Name: name,
+	}).Return(nil, &serviceerror.NamespaceNotFound{}).Times(2)
+
+	// Indices are created before namespace.
+	gomock.InOrder(
+		mockIndexCreator.EXPECT().CreateNamespaceIndices(gomock.Any(), id, name).Return(nil),
+		s.mockMetadataMgr.EXPECT().CreateNamespace(gomock.Any(), gomock.Any()).Return(&persistence.CreateNamespaceResponse{ID: id}, nil),
+	)
+	err := s.namespaceReplicator.Execute(context.Background(), task)
+	s.NoError(err)
+
+	// Namespace is not created if indices can't be created, so the task is retried.
+	indexErr := serviceerror.NewUnavailable("unavailable")
+	mockIndexCreator.EXPECT().CreateNamespaceIndices(gomock.Any(), id, name).Return(indexErr)
+	err = s.namespaceReplicator.Execute(context.Background(), task)
+	s.Equal(indexErr, err)
+}
+
 func (s *namespaceReplicationTaskExecutorSuite) TestExecute_UpdateNamespaceTask_NamespaceNotExist() {
 	operation := enumsspb.NAMESPACE_OPERATION_UPDATE
 	id := uuid.New()
@@ -730,3 +774,53 @@ func (s *namespaceReplicationTaskExecutorSuite) TestExecute_UpdateNamespaceTask_
 	err := s.namespaceReplicator.Execute(context.Background(), updateTask)
 	s.Nil(err)
 }
+
+func (s *namespaceReplicationTaskExecutorSuite) TestExecute_UpdateNamespaceTask_ApiKeys() {
+	id := uuid.New()
+	name := "some random namespace test name"
+	retention := 10 * time.Hour * 24
+	oldKeys := map[string]*persistencespb.NamespaceApiKey{"old-key": {Id: "old-key", Role: "read"}}
+	newKeys := map[string]*persistencespb.NamespaceApiKey{"new-key": {Id: "new-key", Role: "write"}}
+	updateTask := func(configVersion int64, apiKeys *replicationspb.NamespaceApiKeys) *replicationspb.NamespaceTaskAttributes {
+		return &replicationspb.NamespaceTaskAttributes{
+			NamespaceOperation: enumsspb.NAMESPACE_OPERATION_UPDATE,
+			Id:                 id,
+			Info: &namespacepb.NamespaceInfo{
+				Name:  name,
+				State: enumspb.NAMESPACE_STATE_REGISTERED,
+			},
+			Config: &namespacepb.NamespaceConfig{
+				WorkflowExecutionRetentionTtl: &retention,
+			},
+			ReplicationConfig: &replicationpb.NamespaceReplicationConfig{},
+			ConfigVersion:     configVersion,
+			ApiKeys:           apiKeys,
+		}
+	}
+
+	s.mockMetadataMgr.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{
+		Name: name,
+	}).DoAndReturn(func(_ context.Context, _ *persistence.GetNamespaceRequest) (*persistence.GetNamespaceResponse, error) {
+		return &persistence.GetNamespaceResponse{Namespace: &persistencespb.NamespaceDetail{
+			Info:              &persistencespb.NamespaceInfo{Id: id},
+			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{},
+			ApiKeys:           oldKeys,
+		}}, nil
+	}).AnyTimes()
+	s.mockMetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{}, nil).AnyTimes()
+
+	// tasks of clusters that predate API keys keep the keys of the namespace
+	s.mockMetadataMgr.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateNamespaceRequest) error {
+			s.Equal(oldKeys, request.Namespace.ApiKeys)
+			return nil
+		})
+	s.NoError(s.namespaceReplicator.Execute(context.Background(), updateTask(1, nil)))
+
+	s.mockMetadataMgr.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateNamespaceRequest) error {
+			s.Equal(newKeys, request.Namespace.ApiKeys)
+			return nil
+		})
+	s.NoError(s.namespaceReplicator.Execute(context.Background(), updateTask(1, &replicationspb.NamespaceApiKeys{Keys: newKeys})))
+}
@@ -72,3 +72,40 @@ func (mr *MockReplicationTaskExecutorMockRecorder) Execute(ctx, task interface{}
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockReplicationTaskExecutor)(nil).Execute), ctx, task)
 }
+
+// MockVisibilityIndexCreator is a mock of VisibilityIndexCreator interface.
+type MockVisibilityIndexCreator struct {
+	ctrl     *gomock.Controller
+	recorder *MockVisibilityIndexCreatorMockRecorder
+}
+
+// MockVisibilityIndexCreatorMockRecorder is the mock recorder for MockVisibilityIndexCreator.
+type MockVisibilityIndexCreatorMockRecorder struct {
+	mock *MockVisibilityIndexCreator
+}
+
+// NewMockVisibilityIndexCreator creates a new mock instance.
+func NewMockVisibilityIndexCreator(ctrl *gomock.Controller) *MockVisibilityIndexCreator {
+	mock := &MockVisibilityIndexCreator{ctrl: ctrl}
+	mock.recorder = &MockVisibilityIndexCreatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVisibilityIndexCreator) EXPECT() *MockVisibilityIndexCreatorMockRecorder {
+	return m.recorder
+}
+
+// CreateNamespaceIndices mocks base method.
+func (m *MockVisibilityIndexCreator) CreateNamespaceIndices(ctx context.Context, namespaceID, namespaceName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNamespaceIndices", ctx, namespaceID, namespaceName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateNamespaceIndices indicates an expected call of CreateNamespaceIndices.
+func (mr *MockVisibilityIndexCreatorMockRecorder) CreateNamespaceIndices(ctx, namespaceID, namespaceName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNamespaceIndices", reflect.TypeOf((*MockVisibilityIndexCreator)(nil).CreateNamespaceIndices), ctx, namespaceID, namespaceName)
+}
@@ -54,6 +54,7 @@ type (
 			failoverVersion int64,
 			isGlobalNamespace bool,
 			failoverHistoy []*persistencespb.FailoverStatus,
+			apiKeys map[string]*persistencespb.NamespaceApiKey,
 		) error
 	}
 
@@ -86,6 +87,7 @@ func (namespaceReplicator *namespaceReplicatorImpl) HandleTransmissionTask(
 	failoverVersion int64,
 	isGlobalNamespace bool,
 	failoverHistoy []*persistencespb.FailoverStatus,
+	apiKeys map[string]*persistencespb.NamespaceApiKey,
 ) error {
 
 	if !isGlobalNamespace {
@@ -127,6 +129,7 @@ func (namespaceReplicator *namespaceReplicatorImpl) HandleTransmissionTask(
 			ConfigVersion:   configVersion,
 			FailoverVersion: failoverVersion,
 			FailoverHistory: convertFailoverHistoryToReplicationProto(failoverHistoy),
+			ApiKeys:         &replicationspb.NamespaceApiKeys{Keys: apiKeys},
 		},
 	}
 
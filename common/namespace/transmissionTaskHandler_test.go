@@ -148,6 +148,7 @@ func (s *transmissionTaskSuite) TestHandleTransmissionTask_RegisterNamespaceTask
 				},
 				ConfigVersion:   configVersion,
 				FailoverVersion: failoverVersion,
+				ApiKeys:         &replicationspb.NamespaceApiKeys{},
 			},
 		},
 	}).Return(nil)
@@ -163,6 +164,7 @@ func (s *transmissionTaskSuite) TestHandleTransmissionTask_RegisterNamespaceTask
 		failoverVersion,
 		isGlobalNamespace,
 		nil,
+		nil,
 	)
 	s.Nil(err)
 }
@@ -218,6 +220,7 @@ func (s *transmissionTaskSuite) TestHandleTransmissionTask_RegisterNamespaceTask
 		failoverVersion,
 		isGlobalNamespace,
 		nil,
+		nil,
 	)
 	s.Nil(err)
 }
@@ -263,6 +266,7 @@ func (s *transmissionTaskSuite) TestHandleTransmissionTask_UpdateNamespaceTask_I
 		Clusters:          clusters,
 	}
 	isGlobalNamespace := true
+	apiKeys := map[string]*persistencespb.NamespaceApiKey{"key-id": {Id: "key-id", Role: "read", SecretHash: "hash"}}
 
 	s.namespaceReplicationQueue.EXPECT().Publish(gomock.Any(), &replicationspb.ReplicationTask{
 		TaskType: taskType,
@@ -290,7 +294,8 @@ func (s *transmissionTaskSuite) TestHandleTransmissionTask_UpdateNamespaceTask_I
 					Clusters:          convertClusterReplicationConfigToProto(clusters),
 				},
 				ConfigVersion:   configVersion,
-				FailoverVersion: failoverVersion},
+				FailoverVersion: failoverVersion,
+				ApiKeys:         &replicationspb.NamespaceApiKeys{Keys: apiKeys}},
 		},
 	}).Return(nil)
 
@@ -305,6 +310,7 @@ func (s *transmissionTaskSuite) TestHandleTransmissionTask_UpdateNamespaceTask_I
 		failoverVersion,
 		isGlobalNamespace,
 		nil,
+		apiKeys,
 	)
 	s.Nil(err)
 }
@@ -359,6 +365,7 @@ func (s *transmissionTaskSuite) TestHandleTransmissionTask_UpdateNamespaceTask_N
 		failoverVersion,
 		isGlobalNamespace,
 		nil,
+		nil,
 	)
 	s.Nil(err)
 }
@@ -431,7 +438,8 @@ func (s *transmissionTaskSuite) TestHandleTransmissionTask_UpdateNamespaceTask_R
 					Clusters:          convertClusterReplicationConfigToProto(singleClusterList),
 				},
 				ConfigVersion:   configVersion,
-				FailoverVersion: failoverVersion},
+				FailoverVersion: failoverVersion,
+				ApiKeys:         &replicationspb.NamespaceApiKeys{}},
 		},
 	}).Return(nil).Times(1)
 
@@ -446,6 +454,7 @@ func (s *transmissionTaskSuite) TestHandleTransmissionTask_UpdateNamespaceTask_R
 		failoverVersion,
 		isGlobalNamespace,
 		nil,
+		nil,
 	)
 	s.Nil(err)
 
@@ -460,6 +469,7 @@ func (s *transmissionTaskSuite) TestHandleTransmissionTask_UpdateNamespaceTask_R
 		failoverVersion,
 		isGlobalNamespace,
 		nil,
+		nil,
 	)
 	s.Nil(err)
 }
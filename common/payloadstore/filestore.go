@@ -0,0 +1,145 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package payloadstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/config"
+)
+
+type (
+	filestore struct {
+		dir      string
+		fileMode os.FileMode
+		dirMode  os.FileMode
+	}
+)
+
+var (
+	errEmptyDirectoryPath = errors.New("directory path of payload store is empty")
+)
+
+func newFilestore(uri archiver.URI, cfg *config.FilestoreArchiver) (*filestore, error) {
+	if uri.Path() == "" {
+		return nil, errEmptyDirectoryPath
+	}
+	fileMode, err := strconv.ParseUint(cfg.FileMode, 0, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid file mode %q: %w", cfg.FileMode, err)
+	}
+	dirMode, err := strconv.ParseUint(cfg.DirMode, 0, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid directory mode %q: %w", cfg.DirMode, err)
+	}
+	return &filestore{
+		dir:      uri.Path(),
+		fileMode: os.FileMode(fileMode),
+		dirMode:  os.FileMode(dirMode),
+	}, nil
+}
+
+func (s *filestore) Put(_ context.Context, key string, data []byte) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), s.dirMode); err != nil {
+		return err
+	}
+	// write to a temporary file first so readers never see a partial blob
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, s.fileMode); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+func (s *filestore) Get(_ context.Context, key string) ([]byte, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	// #nosec path is checked to be in the payload store directory
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("offloaded payload %s not found", key))
+	}
+	return data, err
+}
+
+func (s *filestore) Delete(_ context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (s *filestore) DeletePrefix(_ context.Context, prefix string, before time.Time) error {
+	dir, err := s.path(prefix)
+	if err != nil {
+		return err
+	}
+	return filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !info.ModTime().Before(before) {
+			return nil
+		}
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	})
+}
+
+// path returns the path of the blob with the key. Keys can come from history, so keys that resolve
+// outside of the payload store directory are refused.
+func (s *filestore) path(key string) (string, error) {
+	path := filepath.Join(s.dir, filepath.FromSlash(key))
+	rel, err := filepath.Rel(s.dir, path)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", serviceerror.NewInvalidArgument(fmt.Sprintf("invalid offloaded payload key %s", key))
+	}
+	return path, nil
+}
@@ -0,0 +1,65 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package payloadstore
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/config"
+)
+
+func TestFilestore_KeyOutsideDirectory(t *testing.T) {
+	root := t.TempDir()
+	secret := filepath.Join(root, "secret")
+	require.NoError(t, os.WriteFile(secret, []byte("secret"), 0600))
+	uri, err := archiver.NewURI("file://" + filepath.Join(root, "payloads"))
+	require.NoError(t, err)
+	store, err := newFilestore(uri, &config.FilestoreArchiver{FileMode: "0666", DirMode: "0766"})
+	require.NoError(t, err)
+
+	require.NoError(t, store.Put(context.Background(), "namespace-id/1/blob", []byte("data")))
+	data, err := store.Get(context.Background(), "namespace-id/1/blob")
+	require.NoError(t, err)
+	require.Equal(t, []byte("data"), data)
+
+	for _, key := range []string{"../secret", "namespace-id/../../secret", "namespace-id/1/../../../secret"} {
+		_, err = store.Get(context.Background(), key)
+		require.IsType(t, &serviceerror.InvalidArgument{}, err, key)
+		require.IsType(t, &serviceerror.InvalidArgument{}, store.Put(context.Background(), key, []byte("data")), key)
+		require.IsType(t, &serviceerror.InvalidArgument{}, store.Delete(context.Background(), key), key)
+		require.IsType(t, &serviceerror.InvalidArgument{}, store.DeletePrefix(context.Background(), key, time.Now()), key)
+	}
+	data, err = os.ReadFile(secret)
+	require.NoError(t, err)
+	require.Equal(t, []byte("secret"), data)
+}
@@ -0,0 +1,274 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package payloadstore
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dgryski/go-farm"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pborman/uuid"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/proxy"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+)
+
+var (
+	errReferenceNotAllowed = serviceerror.NewInvalidArgument("payloads with the " + referenceEncoding + " encoding are reserved for offloaded payloads")
+)
+
+const (
+	// referenceEncoding is the encoding of the payloads that refer to offloaded payloads. SDKs don't
+	// know the encoding, so a reference that reaches a worker fails loudly instead of being misread.
+	referenceEncoding = "binary/temporal-offloaded-payload"
+)
+
+type (
+	// Offloader moves large payloads to a Store and replaces them with references that are kept in
+	// history instead. Blobs are grouped by workflow ID, since runs of the same workflow ID share
+	// payloads through continue-as-new, retries, cron and reset. A nil Offloader leaves payloads as is.
+	Offloader struct {
+		store          Store
+		metricsHandler metrics.Handler
+		logger         log.Logger
+	}
+)
+
+func NewOffloader(
+	store Store,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) *Offloader {
+	return &Offloader{
+		store:          store,
+		metricsHandler: metricsHandler,
+		logger:         logger,
+	}
+}
+
+// IsReference returns true if the payload refers to an offloaded payload
+func IsReference(payload *commonpb.Payload) bool {
+	return payload != nil && bytes.Equal(payload.GetMetadata()["encoding"], []byte(referenceEncoding))
+}
+
+// RejectReferences returns an InvalidArgument error if msg has a payload with the reference encoding.
+// References are only created by the Offloader, so clients must not send them.
+func RejectReferences(ctx context.Context, msg proto.Message) error {
+	return proxy.VisitPayloads(ctx, msg, proxy.VisitPayloadsOptions{
+		Visitor: func(_ *proxy.VisitPayloadsContext, payloads []*commonpb.Payload) ([]*commonpb.Payload, error) {
+			for _, payload := range payloads {
+				if IsReference(payload) {
+					return nil, errReferenceNotAllowed
+				}
+			}
+			return payloads, nil
+		},
+	})
+}
+
+// Offload replaces the payloads of msg larger than threshold bytes with references to blobs of the workflow,
+// and returns the keys of the blobs it wrote. Search attributes are never offloaded since visibility indexes them.
+// If it fails, the blobs written so far are discarded.
+func (o *Offloader) Offload(
+	ctx context.Context,
+	msg proto.Message,
+	namespaceID string,
+	workflowID string,
+	threshold int,
+) ([]string, error) {
+	if o == nil || threshold <= 0 {
+		return nil, nil
+	}
+	var keys []string
+	err := o.visit(ctx, msg, func(payload *commonpb.Payload) (*commonpb.Payload, error) {
+		if IsReference(payload) || payload.Size() <= threshold {
+			return payload, nil
+		}
+		reference, err := o.put(ctx, namespaceID, workflowID, payload)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, string(reference.Data))
+		return reference, nil
+	})
+	if err != nil {
+		o.Discard(ctx, namespaceID, workflowID, keys)
+		return nil, err
+	}
+	return keys, nil
+}
+
+// Discard deletes the blobs with the given keys, which were written by Offload for a request that
+// was not written to history. It is best effort: blobs it fails to delete are left for DeleteWorkflow.
+func (o *Offloader) Discard(
+	ctx context.Context,
+	namespaceID string,
+	workflowID string,
+	keys []string,
+) {
+	if o == nil {
+		return
+	}
+	for _, key := range keys {
+		if err := o.store.Delete(ctx, key); err != nil {
+			o.logger.Warn("Unable to discard offloaded payload", tag.WorkflowNamespaceID(namespaceID), tag.WorkflowID(workflowID), tag.Key(key), tag.Error(err))
+		}
+	}
+}
+
+// Rehydrate replaces the references in msg with the payloads they refer to. Only blobs of the given
+// namespace are read.
+func (o *Offloader) Rehydrate(ctx context.Context, msg proto.Message, namespaceID string) error {
+	if o == nil {
+		return nil
+	}
+	return o.visit(ctx, msg, func(payload *commonpb.Payload) (*commonpb.Payload, error) {
+		if !IsReference(payload) {
+			return payload, nil
+		}
+		return o.get(ctx, namespaceID, payload)
+	})
+}
+
+// Rescope copies the blobs referenced by msg that belong to another workflow to blobs of the given
+// workflow, so they outlive the workflow that offloaded them. It is used when payloads cross to
+// another workflow ID, such as the input of a child workflow or of a signal to an external workflow.
+// The referenced blobs must belong to the source namespace.
+func (o *Offloader) Rescope(
+	ctx context.Context,
+	msg proto.Message,
+	sourceNamespaceID string,
+	namespaceID string,
+	workflowID string,
+) error {
+	if o == nil {
+		return nil
+	}
+	prefix := workflowPrefix(namespaceID, workflowID)
+	return o.visit(ctx, msg, func(payload *commonpb.Payload) (*commonpb.Payload, error) {
+		if !IsReference(payload) || strings.HasPrefix(string(payload.Data), prefix) {
+			return payload, nil
+		}
+		original, err := o.get(ctx, sourceNamespaceID, payload)
+		if err != nil {
+			return nil, err
+		}
+		return o.put(ctx, namespaceID, workflowID, original)
+	})
+}
+
+// DeleteWorkflow deletes the blobs of a workflow ID that were written before the given time
+func (o *Offloader) DeleteWorkflow(
+	ctx context.Context,
+	namespaceID string,
+	workflowID string,
+	before time.Time,
+) error {
+	if o == nil {
+		return nil
+	}
+	return o.store.DeletePrefix(ctx, workflowPrefix(namespaceID, workflowID), before)
+}
+
+func (o *Offloader) visit(
+	ctx context.Context,
+	msg proto.Message,
+	fn func(payload *commonpb.Payload) (*commonpb.Payload, error),
+) error {
+	return proxy.VisitPayloads(ctx, msg, proxy.VisitPayloadsOptions{
+		Visitor: func(_ *proxy.VisitPayloadsContext, payloads []*commonpb.Payload) ([]*commonpb.Payload, error) {
+			for i, payload := range payloads {
+				result, err := fn(payload)
+				if err != nil {
+					return nil, err
+				}
+				payloads[i] = result
+			}
+			return payloads, nil
+		},
+		SkipSearchAttributes: true,
+	})
+}
+
+func (o *Offloader) put(
+	ctx context.Context,
+	namespaceID string,
+	workflowID string,
+	payload *commonpb.Payload,
+) (*commonpb.Payload, error) {
+	data, err := payload.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	key := workflowPrefix(namespaceID, workflowID) + uuid.New()
+	startTime := time.Now().UTC()
+	if err := o.store.Put(ctx, key, data); err != nil {
+		o.logger.Error("Unable to offload payload", tag.WorkflowNamespaceID(namespaceID), tag.WorkflowID(workflowID), tag.Error(err))
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf("unable to offload payload: %v", err))
+	}
+	o.metricsHandler.Timer(metrics.PayloadOffloadLatency.GetMetricName()).Record(time.Since(startTime))
+	o.metricsHandler.Histogram(metrics.OffloadedPayloadSize.GetMetricName(), metrics.OffloadedPayloadSize.GetMetricUnit()).Record(int64(len(data)))
+	return &commonpb.Payload{
+		Metadata: map[string][]byte{"encoding": []byte(referenceEncoding)},
+		Data:     []byte(key),
+	}, nil
+}
+
+func (o *Offloader) get(ctx context.Context, namespaceID string, reference *commonpb.Payload) (*commonpb.Payload, error) {
+	key := string(reference.Data)
+	if namespaceID == "" || !strings.HasPrefix(key, namespacePrefix(namespaceID)) {
+		return nil, serviceerror.NewPermissionDenied(fmt.Sprintf("offloaded payload %s doesn't belong to namespace %s", key, namespaceID), "")
+	}
+	startTime := time.Now().UTC()
+	data, err := o.store.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	o.metricsHandler.Timer(metrics.PayloadRehydrationLatency.GetMetricName()).Record(time.Since(startTime))
+	payload := &commonpb.Payload{}
+	if err := payload.Unmarshal(data); err != nil {
+		return nil, serviceerror.NewDataLoss(fmt.Sprintf("offloaded payload %s is corrupted: %v", key, err))
+	}
+	return payload, nil
+}
+
+// workflowPrefix is the key prefix of the blobs of a workflow ID. The workflow ID is hashed since
+// it can contain any character.
+func workflowPrefix(namespaceID string, workflowID string) string {
+	return fmt.Sprintf("%s%d/", namespacePrefix(namespaceID), farm.Fingerprint64([]byte(workflowID)))
+}
+
+// namespacePrefix is the key prefix of the blobs of a namespace
+func namespacePrefix(namespaceID string) string {
+	return namespaceID + "/"
+}
@@ -0,0 +1,177 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package payloadstore
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+)
+
+func newTestOffloader(t *testing.T) *Offloader {
+	store, err := NewStore(&config.PayloadStore{
+		URI:       "file://" + t.TempDir(),
+		Filestore: &config.FilestoreArchiver{FileMode: "0666", DirMode: "0766"},
+	})
+	require.NoError(t, err)
+	return NewOffloader(store, metrics.NoopMetricsHandler, log.NewNoopLogger())
+}
+
+func payload(data string) *commonpb.Payload {
+	return &commonpb.Payload{
+		Metadata: map[string][]byte{"encoding": []byte("json/plain")},
+		Data:     []byte(data),
+	}
+}
+
+func TestOffloader_OffloadAndRehydrate(t *testing.T) {
+	offloader := newTestOffloader(t)
+	large := payload(strings.Repeat("x", 1000))
+	small := payload("small")
+	request := &workflowservice.StartWorkflowExecutionRequest{
+		WorkflowId: "workflow-id",
+		Input:      &commonpb.Payloads{Payloads: []*commonpb.Payload{large, small}},
+		Memo:       &commonpb.Memo{Fields: map[string]*commonpb.Payload{"memo": payload(strings.Repeat("x", 1000))}},
+	}
+
+	_, err := offloader.Offload(context.Background(), request, "namespace-id", "workflow-id", 100)
+	require.NoError(t, err)
+	require.True(t, IsReference(request.Input.Payloads[0]))
+	require.Equal(t, small, request.Input.Payloads[1])
+	require.True(t, IsReference(request.Memo.Fields["memo"]))
+
+	// references are kept in history and rehydrated when the history is read
+	history := &historypb.History{Events: []*historypb.HistoryEvent{{
+		Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{
+			WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+				Input: request.Input,
+				Memo:  request.Memo,
+			},
+		},
+	}}}
+	require.NoError(t, offloader.Rehydrate(context.Background(), history, "namespace-id"))
+	attributes := history.Events[0].GetWorkflowExecutionStartedEventAttributes()
+	require.Equal(t, []*commonpb.Payload{large, small}, attributes.Input.Payloads)
+	require.Equal(t, large, attributes.Memo.Fields["memo"])
+}
+
+func TestOffloader_Rescope(t *testing.T) {
+	offloader := newTestOffloader(t)
+	input := &commonpb.Payloads{Payloads: []*commonpb.Payload{payload(strings.Repeat("x", 1000))}}
+	_, err := offloader.Offload(context.Background(), &workflowservice.SignalWorkflowExecutionRequest{Input: input}, "namespace-id", "parent-id", 100)
+	require.NoError(t, err)
+	reference := input.Payloads[0]
+
+	attributes := &historypb.StartChildWorkflowExecutionInitiatedEventAttributes{WorkflowId: "child-id", Input: input}
+	require.NoError(t, offloader.Rescope(context.Background(), attributes, "namespace-id", "namespace-id", "child-id"))
+	require.True(t, IsReference(attributes.Input.Payloads[0]))
+	require.NotEqual(t, reference, attributes.Input.Payloads[0])
+
+	// the child keeps its copy when the parent is deleted
+	require.NoError(t, offloader.DeleteWorkflow(context.Background(), "namespace-id", "parent-id", time.Now().Add(time.Minute)))
+	require.NoError(t, offloader.Rehydrate(context.Background(), attributes, "namespace-id"))
+	require.Equal(t, payload(strings.Repeat("x", 1000)), attributes.Input.Payloads[0])
+	_, err = offloader.get(context.Background(), "namespace-id", reference)
+	require.IsType(t, &serviceerror.NotFound{}, err)
+}
+
+func TestOffloader_DeleteWorkflow(t *testing.T) {
+	offloader := newTestOffloader(t)
+	offload := func(workflowID string) *commonpb.Payload {
+		input := &commonpb.Payloads{Payloads: []*commonpb.Payload{payload(strings.Repeat("x", 1000))}}
+		_, err := offloader.Offload(context.Background(), &workflowservice.SignalWorkflowExecutionRequest{Input: input}, "namespace-id", workflowID, 100)
+		require.NoError(t, err)
+		return input.Payloads[0]
+	}
+	deleted := offload("workflow-id")
+	otherWorkflow := offload("other-workflow-id")
+	cutoff := time.Now().Add(time.Second)
+	time.Sleep(1100 * time.Millisecond)
+	offloadedLater := offload("workflow-id")
+
+	require.NoError(t, offloader.DeleteWorkflow(context.Background(), "namespace-id", "workflow-id", cutoff))
+	_, err := offloader.get(context.Background(), "namespace-id", deleted)
+	require.IsType(t, &serviceerror.NotFound{}, err)
+	_, err = offloader.get(context.Background(), "namespace-id", otherWorkflow)
+	require.NoError(t, err)
+	_, err = offloader.get(context.Background(), "namespace-id", offloadedLater)
+	require.NoError(t, err)
+}
+
+func TestOffloader_Discard(t *testing.T) {
+	offloader := newTestOffloader(t)
+	input := &commonpb.Payloads{Payloads: []*commonpb.Payload{payload(strings.Repeat("x", 1000)), payload("small")}}
+	keys, err := offloader.Offload(context.Background(), &workflowservice.SignalWorkflowExecutionRequest{Input: input}, "namespace-id", "workflow-id", 100)
+	require.NoError(t, err)
+	require.Equal(t, []string{string(input.Payloads[0].Data)}, keys)
+
+	offloader.Discard(context.Background(), "namespace-id", "workflow-id", keys)
+	_, err = offloader.get(context.Background(), "namespace-id", input.Payloads[0])
+	require.IsType(t, &serviceerror.NotFound{}, err)
+}
+
+func TestOffloader_RehydrateOtherNamespace(t *testing.T) {
+	offloader := newTestOffloader(t)
+	input := &commonpb.Payloads{Payloads: []*commonpb.Payload{payload(strings.Repeat("x", 1000))}}
+	_, err := offloader.Offload(context.Background(), &workflowservice.SignalWorkflowExecutionRequest{Input: input}, "namespace-id", "workflow-id", 100)
+	require.NoError(t, err)
+
+	err = offloader.Rehydrate(context.Background(), input, "other-namespace-id")
+	require.IsType(t, &serviceerror.PermissionDenied{}, err)
+	err = offloader.Rescope(context.Background(), input, "other-namespace-id", "other-namespace-id", "workflow-id")
+	require.IsType(t, &serviceerror.PermissionDenied{}, err)
+}
+
+func TestRejectReferences(t *testing.T) {
+	request := &workflowservice.SignalWorkflowExecutionRequest{
+		Input: &commonpb.Payloads{Payloads: []*commonpb.Payload{payload("small")}},
+	}
+	require.NoError(t, RejectReferences(context.Background(), request))
+
+	request.Input.Payloads = append(request.Input.Payloads, &commonpb.Payload{
+		Metadata: map[string][]byte{"encoding": []byte(referenceEncoding)},
+		Data:     []byte("other-namespace-id/1/blob"),
+	})
+	require.IsType(t, &serviceerror.InvalidArgument{}, RejectReferences(context.Background(), request))
+}
+
+func TestOffloader_Nil(t *testing.T) {
+	var offloader *Offloader
+	input := &commonpb.Payloads{Payloads: []*commonpb.Payload{payload(strings.Repeat("x", 1000))}}
+	_, err := offloader.Offload(context.Background(), &workflowservice.SignalWorkflowExecutionRequest{Input: input}, "namespace-id", "workflow-id", 100)
+	require.NoError(t, err)
+	require.False(t, IsReference(input.Payloads[0]))
+}
@@ -0,0 +1,132 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package payloadstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/s3store"
+	"go.temporal.io/server/common/config"
+)
+
+// maxDeleteObjects is the most keys a DeleteObjects request accepts
+const maxDeleteObjects = 1000
+
+type (
+	s3Store struct {
+		s3cli s3iface.S3API
+		uri   archiver.URI
+	}
+)
+
+var (
+	errEmptyAwsRegion = errors.New("AWS region of payload store is empty")
+)
+
+func newS3Store(uri archiver.URI, cfg *config.S3Archiver) (*s3Store, error) {
+	if len(cfg.Region) == 0 {
+		return nil, errEmptyAwsRegion
+	}
+	sess, err := session.NewSession(&aws.Config{
+		Endpoint:         cfg.Endpoint,
+		Region:           aws.String(cfg.Region),
+		S3ForcePathStyle: aws.Bool(cfg.S3ForcePathStyle),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &s3Store{
+		s3cli: s3.New(sess),
+		uri:   uri,
+	}, nil
+}
+
+func (s *s3Store) Put(ctx context.Context, key string, data []byte) error {
+	return s3store.Upload(ctx, s.s3cli, s.uri, s.key(key), data)
+}
+
+func (s *s3Store) Get(ctx context.Context, key string) ([]byte, error) {
+	data, err := s3store.Download(ctx, s.s3cli, s.uri, s.key(key))
+	if _, ok := err.(*serviceerror.NotFound); ok {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("offloaded payload %s not found", key))
+	}
+	return data, err
+}
+
+func (s *s3Store) Delete(ctx context.Context, key string) error {
+	_, err := s.s3cli.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.uri.Hostname()),
+		Key:    aws.String(s.key(key)),
+	})
+	return err
+}
+
+func (s *s3Store) DeletePrefix(ctx context.Context, prefix string, before time.Time) error {
+	var keys []*s3.ObjectIdentifier
+	err := s.s3cli.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(s.uri.Hostname()),
+		Prefix: aws.String(s.key(prefix)),
+	}, func(page *s3.ListObjectsV2Output, _ bool) bool {
+		for _, object := range page.Contents {
+			if object.LastModified != nil && object.LastModified.Before(before) {
+				keys = append(keys, &s3.ObjectIdentifier{Key: object.Key})
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	for len(keys) > 0 {
+		batch := keys
+		if len(batch) > maxDeleteObjects {
+			batch = batch[:maxDeleteObjects]
+		}
+		keys = keys[len(batch):]
+		if _, err := s.s3cli.DeleteObjectsWithContext(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(s.uri.Hostname()),
+			Delete: &s3.Delete{Objects: batch, Quiet: aws.Bool(true)},
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *s3Store) key(key string) string {
+	return strings.TrimLeft(s.uri.Path()+"/"+key, "/")
+}
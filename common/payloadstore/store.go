@@ -0,0 +1,76 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package payloadstore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/config"
+)
+
+const (
+	// URISchemeFile is the scheme of stores on the local filesystem
+	URISchemeFile = "file"
+	// URISchemeS3 is the scheme of stores on S3 or an S3 compatible service
+	URISchemeS3 = "s3"
+)
+
+type (
+	// Store keeps the blobs of offloaded payloads. Keys are slash separated paths.
+	Store interface {
+		Put(ctx context.Context, key string, data []byte) error
+		// Get returns a serviceerror.NotFound if there is no blob with the key
+		Get(ctx context.Context, key string) ([]byte, error)
+		// Delete deletes the blob with the key. Deleting a missing blob is not an error.
+		Delete(ctx context.Context, key string) error
+		// DeletePrefix deletes the blobs with keys starting with prefix that were written before the given time
+		DeletePrefix(ctx context.Context, prefix string, before time.Time) error
+	}
+)
+
+// NewStore returns the Store at the URI of the config, using the archiver connector of its scheme
+func NewStore(cfg *config.PayloadStore) (Store, error) {
+	uri, err := archiver.NewURI(cfg.URI)
+	if err != nil {
+		return nil, fmt.Errorf("invalid payload store URI %q: %w", cfg.URI, err)
+	}
+	switch uri.Scheme() {
+	case URISchemeFile:
+		if cfg.Filestore == nil {
+			return nil, fmt.Errorf("payload store %q requires filestore config", cfg.URI)
+		}
+		return newFilestore(uri, cfg.Filestore)
+	case URISchemeS3:
+		if cfg.S3store == nil {
+			return nil, fmt.Errorf("payload store %q requires s3store config", cfg.URI)
+		}
+		return newS3Store(uri, cfg.S3store)
+	default:
+		return nil, fmt.Errorf("unsupported payload store URI scheme: %s", uri.Scheme())
+	}
+}
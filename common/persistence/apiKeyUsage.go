@@ -0,0 +1,175 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -copyright_file ../../LICENSE -package $GOPACKAGE -source $GOFILE -destination apiKeyUsage_mock.go
+
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+// number of attempts of an update that conflicts with a concurrent update
+const apiKeyUsageUpdateAttempts = 5
+
+type (
+	// APIKeyUsageManager stores the last used time of API keys. The times of all namespaces are kept
+	// in the metadata of a dedicated queue, which is updated with a version check, so recording usage
+	// doesn't update the namespace metadata.
+	APIKeyUsageManager interface {
+		Close()
+		// GetLastUsedTimes returns the last used time of the API keys that were used, keyed by key id
+		GetLastUsedTimes(ctx context.Context) (map[string]time.Time, error)
+		// UpdateLastUsedTimes records the given last used times. Times older than the stored ones are
+		// ignored.
+		UpdateLastUsedTimes(ctx context.Context, lastUsedTimes map[string]time.Time) error
+		// DeleteLastUsedTimes removes the last used time of API keys that were revoked
+		DeleteLastUsedTimes(ctx context.Context, keyIDs []string) error
+	}
+
+	apiKeyUsageManagerImpl struct {
+		queue Queue
+	}
+)
+
+var _ APIKeyUsageManager = (*apiKeyUsageManagerImpl)(nil)
+
+// NewAPIKeyUsageManager creates a new APIKeyUsageManager instance from its queue
+func NewAPIKeyUsageManager(queue Queue) (APIKeyUsageManager, error) {
+	blob, err := serialization.ProtoEncodeBlob(&persistencespb.ApiKeyUsage{}, enumspb.ENCODING_TYPE_PROTO3)
+	if err != nil {
+		return nil, err
+	}
+	if err := queue.Init(context.TODO(), blob); err != nil {
+		return nil, err
+	}
+	return &apiKeyUsageManagerImpl{queue: queue}, nil
+}
+
+func (m *apiKeyUsageManagerImpl) Close() {
+	m.queue.Close()
+}
+
+func (m *apiKeyUsageManagerImpl) GetLastUsedTimes(ctx context.Context) (map[string]time.Time, error) {
+	metadata, err := m.queue.GetAckLevels(ctx)
+	if err != nil {
+		return nil, err
+	}
+	usage, err := decodeAPIKeyUsage(metadata.Blob)
+	if err != nil {
+		return nil, err
+	}
+	lastUsedTimes := make(map[string]time.Time, len(usage.LastUsedTimes))
+	for keyID, lastUsedTime := range usage.LastUsedTimes {
+		lastUsedTimes[keyID] = *lastUsedTime
+	}
+	return lastUsedTimes, nil
+}
+
+func (m *apiKeyUsageManagerImpl) UpdateLastUsedTimes(ctx context.Context, lastUsedTimes map[string]time.Time) error {
+	return m.update(ctx, func(usage *persistencespb.ApiKeyUsage) bool {
+		updated := false
+		for keyID, lastUsedTime := range lastUsedTimes {
+			if stored, ok := usage.LastUsedTimes[keyID]; ok && !stored.Before(lastUsedTime) {
+				continue
+			}
+			lastUsedTime := lastUsedTime
+			usage.LastUsedTimes[keyID] = &lastUsedTime
+			updated = true
+		}
+		return updated
+	})
+}
+
+func (m *apiKeyUsageManagerImpl) DeleteLastUsedTimes(ctx context.Context, keyIDs []string) error {
+	return m.update(ctx, func(usage *persistencespb.ApiKeyUsage) bool {
+		updated := false
+		for _, keyID := range keyIDs {
+			if _, ok := usage.LastUsedTimes[keyID]; ok {
+				delete(usage.LastUsedTimes, keyID)
+				updated = true
+			}
+		}
+		return updated
+	})
+}
+
+// update applies mutate to the stored usage and writes it back if mutate returns true. The update
+// is retried if the usage was changed concurrently.
+func (m *apiKeyUsageManagerImpl) update(
+	ctx context.Context,
+	mutate func(usage *persistencespb.ApiKeyUsage) bool,
+) error {
+	var err error
+	for attempt := 0; attempt < apiKeyUsageUpdateAttempts; attempt++ {
+		var metadata *InternalQueueMetadata
+		metadata, err = m.queue.GetAckLevels(ctx)
+		if err != nil {
+			return err
+		}
+		var usage *persistencespb.ApiKeyUsage
+		usage, err = decodeAPIKeyUsage(metadata.Blob)
+		if err != nil {
+			return err
+		}
+		if !mutate(usage) {
+			return nil
+		}
+		metadata.Blob, err = serialization.ProtoEncodeBlob(usage, enumspb.ENCODING_TYPE_PROTO3)
+		if err != nil {
+			return err
+		}
+		// stores report a version conflict either as a condition failure or as an unavailable error,
+		// so the update is retried on any error
+		if err = m.queue.UpdateAckLevel(ctx, metadata); err == nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return err
+		}
+	}
+	return fmt.Errorf("failed to update API key usage: %w", err)
+}
+
+func decodeAPIKeyUsage(blob *commonpb.DataBlob) (*persistencespb.ApiKeyUsage, error) {
+	usage := &persistencespb.ApiKeyUsage{}
+	// an empty usage is stored without data
+	if blob != nil {
+		if err := serialization.ProtoDecodeBlob(blob, usage); err != nil {
+			return nil, fmt.Errorf("failed to decode API key usage: %v", err)
+		}
+	}
+	if usage.LastUsedTimes == nil {
+		usage.LastUsedTimes = make(map[string]*time.Time)
+	}
+	return usage, nil
+}
@@ -0,0 +1,115 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: apiKeyUsage.go
+
+// Package persistence is a generated GoMock package.
+package persistence
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAPIKeyUsageManager is a mock of APIKeyUsageManager interface.
+type MockAPIKeyUsageManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIKeyUsageManagerMockRecorder
+}
+
+// MockAPIKeyUsageManagerMockRecorder is the mock recorder for MockAPIKeyUsageManager.
+type MockAPIKeyUsageManagerMockRecorder struct {
+	mock *MockAPIKeyUsageManager
+}
+
+// NewMockAPIKeyUsageManager creates a new mock instance.
+func NewMockAPIKeyUsageManager(ctrl *gomock.Controller) *MockAPIKeyUsageManager {
+	mock := &MockAPIKeyUsageManager{ctrl: ctrl}
+	mock.recorder = &MockAPIKeyUsageManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPIKeyUsageManager) EXPECT() *MockAPIKeyUsageManagerMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockAPIKeyUsageManager) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockAPIKeyUsageManagerMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockAPIKeyUsageManager)(nil).Close))
+}
+
+// DeleteLastUsedTimes mocks base method.
+func (m *MockAPIKeyUsageManager) DeleteLastUsedTimes(ctx context.Context, keyIDs []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLastUsedTimes", ctx, keyIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLastUsedTimes indicates an expected call of DeleteLastUsedTimes.
+func (mr *MockAPIKeyUsageManagerMockRecorder) DeleteLastUsedTimes(ctx, keyIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLastUsedTimes", reflect.TypeOf((*MockAPIKeyUsageManager)(nil).DeleteLastUsedTimes), ctx, keyIDs)
+}
+
+// GetLastUsedTimes mocks base method.
+func (m *MockAPIKeyUsageManager) GetLastUsedTimes(ctx context.Context) (map[string]time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastUsedTimes", ctx)
+	ret0, _ := ret[0].(map[string]time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastUsedTimes indicates an expected call of GetLastUsedTimes.
+func (mr *MockAPIKeyUsageManagerMockRecorder) GetLastUsedTimes(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastUsedTimes", reflect.TypeOf((*MockAPIKeyUsageManager)(nil).GetLastUsedTimes), ctx)
+}
+
+// UpdateLastUsedTimes mocks base method.
+func (m *MockAPIKeyUsageManager) UpdateLastUsedTimes(ctx context.Context, lastUsedTimes map[string]time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLastUsedTimes", ctx, lastUsedTimes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLastUsedTimes indicates an expected call of UpdateLastUsedTimes.
+func (mr *MockAPIKeyUsageManagerMockRecorder) UpdateLastUsedTimes(ctx, lastUsedTimes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLastUsedTimes", reflect.TypeOf((*MockAPIKeyUsageManager)(nil).UpdateLastUsedTimes), ctx, lastUsedTimes)
+}
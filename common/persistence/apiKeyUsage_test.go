@@ -0,0 +1,97 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+)
+
+// metadataQueue implements the parts of Queue used by the API key usage manager
+type metadataQueue struct {
+	Queue
+	metadata InternalQueueMetadata
+	// number of updates that fail with a version conflict
+	conflicts int
+}
+
+func (q *metadataQueue) Init(_ context.Context, blob *commonpb.DataBlob) error {
+	if q.metadata.Blob == nil {
+		q.metadata.Blob = NewDataBlob(blob.Data, blob.EncodingType.String())
+	}
+	return nil
+}
+
+func (q *metadataQueue) GetAckLevels(context.Context) (*InternalQueueMetadata, error) {
+	metadata := q.metadata
+	return &metadata, nil
+}
+
+func (q *metadataQueue) UpdateAckLevel(_ context.Context, metadata *InternalQueueMetadata) error {
+	if q.conflicts > 0 {
+		q.conflicts--
+		q.metadata.Version++
+	}
+	if metadata.Version != q.metadata.Version {
+		return &ConditionFailedError{Msg: "version mismatch"}
+	}
+	q.metadata = InternalQueueMetadata{Blob: metadata.Blob, Version: metadata.Version + 1}
+	return nil
+}
+
+func TestAPIKeyUsageManager(t *testing.T) {
+	queue := &metadataQueue{}
+	manager, err := NewAPIKeyUsageManager(queue)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	lastUsedTimes, err := manager.GetLastUsedTimes(ctx)
+	require.NoError(t, err)
+	require.Empty(t, lastUsedTimes)
+
+	now := time.Unix(1000, 0).UTC()
+	require.NoError(t, manager.UpdateLastUsedTimes(ctx, map[string]time.Time{"key-1": now, "key-2": now}))
+	// older times are ignored, and updates are retried on version conflicts
+	queue.conflicts = 2
+	require.NoError(t, manager.UpdateLastUsedTimes(ctx, map[string]time.Time{
+		"key-1": now.Add(-time.Minute),
+		"key-2": now.Add(time.Minute),
+	}))
+	lastUsedTimes, err = manager.GetLastUsedTimes(ctx)
+	require.NoError(t, err)
+	require.Equal(t, map[string]time.Time{"key-1": now, "key-2": now.Add(time.Minute)}, lastUsedTimes)
+
+	require.NoError(t, manager.DeleteLastUsedTimes(ctx, []string{"key-1", "unknown"}))
+	lastUsedTimes, err = manager.GetLastUsedTimes(ctx)
+	require.NoError(t, err)
+	require.Equal(t, map[string]time.Time{"key-2": now.Add(time.Minute)}, lastUsedTimes)
+
+	queue.conflicts = apiKeyUsageUpdateAttempts
+	require.Error(t, manager.UpdateLastUsedTimes(ctx, map[string]time.Time{"key-3": now}))
+}
@@ -0,0 +1,217 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -copyright_file ../../LICENSE -package $GOPACKAGE -source $GOFILE -destination auditLog_mock.go
+
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+const (
+	// number of messages read per partition at a time when looking for expired records
+	auditLogDeletePageSize = 100
+	// bounds the messages read per partition by a single DeleteRecordsBefore call
+	auditLogDeleteMaxReads = 100
+	// bounds the attempts to append a batch to a partition that other frontends append to as well
+	auditLogAppendMaxAttempts = 10
+)
+
+type (
+	// AuditLogManager stores audit records of mutating API calls in the queue table. The log is
+	// spread over AuditLogQueuePartitions partitions, and each batch of records is stored as one
+	// queue message.
+	AuditLogManager interface {
+		Close()
+		// AppendRecords appends a batch of records to a partition. Appends that conflict with an
+		// append to the same partition by another writer are retried.
+		AppendRecords(ctx context.Context, partition int, records []*persistencespb.AuditRecord) error
+		// ReadRecords returns the records of up to maxCount messages of a partition written after
+		// lastMessageID, and the message ID of the last message read.
+		ReadRecords(ctx context.Context, partition int, lastMessageID int64, maxCount int) ([]*persistencespb.AuditRecord, int64, error)
+		// DeleteRecordsBefore deletes the batches of all partitions whose records were all written
+		// before the given time.
+		DeleteRecordsBefore(ctx context.Context, before time.Time) error
+	}
+
+	auditLogManagerImpl struct {
+		partitions []Queue
+	}
+)
+
+var _ AuditLogManager = (*auditLogManagerImpl)(nil)
+
+// NewAuditLogManager creates a new AuditLogManager instance from the queues of its partitions
+func NewAuditLogManager(
+	partitions []Queue,
+	serializer serialization.Serializer,
+) (AuditLogManager, error) {
+
+	blob, err := serializer.QueueMetadataToBlob(
+		&persistencespb.QueueMetadata{
+			ClusterAckLevels: make(map[string]int64),
+		}, enumspb.ENCODING_TYPE_PROTO3)
+	if err != nil {
+		return nil, err
+	}
+	for _, queue := range partitions {
+		if err := queue.Init(context.TODO(), blob); err != nil {
+			return nil, err
+		}
+	}
+	return &auditLogManagerImpl{partitions: partitions}, nil
+}
+
+func (m *auditLogManagerImpl) Close() {
+	for _, queue := range m.partitions {
+		queue.Close()
+	}
+}
+
+func (m *auditLogManagerImpl) AppendRecords(
+	ctx context.Context,
+	partition int,
+	records []*persistencespb.AuditRecord,
+) error {
+	queue, err := m.partition(partition)
+	if err != nil {
+		return err
+	}
+	blob, err := serialization.ProtoEncodeBlob(
+		&persistencespb.AuditRecordBatch{Records: records},
+		enumspb.ENCODING_TYPE_PROTO3,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to encode audit records: %v", err)
+	}
+
+	// writers share partitions, and an append fails if another writer took the next message ID
+	for attempt := 1; ; attempt++ {
+		err := queue.EnqueueMessage(ctx, *blob)
+		if _, conflict := err.(*ConditionFailedError); !conflict || attempt >= auditLogAppendMaxAttempts || ctx.Err() != nil {
+			return err
+		}
+	}
+}
+
+func (m *auditLogManagerImpl) ReadRecords(
+	ctx context.Context,
+	partition int,
+	lastMessageID int64,
+	maxCount int,
+) ([]*persistencespb.AuditRecord, int64, error) {
+	queue, err := m.partition(partition)
+	if err != nil {
+		return nil, lastMessageID, err
+	}
+	messages, err := queue.ReadMessages(ctx, lastMessageID, maxCount)
+	if err != nil {
+		return nil, lastMessageID, err
+	}
+
+	var records []*persistencespb.AuditRecord
+	for _, message := range messages {
+		batch, err := decodeAuditRecordBatch(message)
+		if err != nil {
+			return nil, lastMessageID, err
+		}
+		lastMessageID = message.ID
+		records = append(records, batch.Records...)
+	}
+	return records, lastMessageID, nil
+}
+
+func (m *auditLogManagerImpl) DeleteRecordsBefore(
+	ctx context.Context,
+	before time.Time,
+) error {
+	for _, queue := range m.partitions {
+		if err := m.deletePartitionRecordsBefore(ctx, queue, before); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deletePartitionRecordsBefore deletes the leading messages of a partition whose records were all
+// written before the given time. Messages are appended in time order, so the scan stops at the
+// first message with a newer record.
+func (m *auditLogManagerImpl) deletePartitionRecordsBefore(
+	ctx context.Context,
+	queue Queue,
+	before time.Time,
+) error {
+	lastExpiredMessageID := EmptyQueueMessageID
+scan:
+	for i := 0; i < auditLogDeleteMaxReads; i++ {
+		messages, err := queue.ReadMessages(ctx, lastExpiredMessageID, auditLogDeletePageSize)
+		if err != nil {
+			return err
+		}
+		for _, message := range messages {
+			batch, err := decodeAuditRecordBatch(message)
+			if err != nil {
+				return err
+			}
+			for _, record := range batch.Records {
+				if !timestamp.TimeValue(record.GetTime()).Before(before) {
+					break scan
+				}
+			}
+			lastExpiredMessageID = message.ID
+		}
+		if len(messages) < auditLogDeletePageSize {
+			break
+		}
+	}
+
+	if lastExpiredMessageID == EmptyQueueMessageID {
+		return nil
+	}
+	return queue.DeleteMessagesBefore(ctx, lastExpiredMessageID+1)
+}
+
+func (m *auditLogManagerImpl) partition(partition int) (Queue, error) {
+	if partition < 0 || partition >= len(m.partitions) {
+		return nil, fmt.Errorf("unknown audit log partition: %d", partition)
+	}
+	return m.partitions[partition], nil
+}
+
+func decodeAuditRecordBatch(message *QueueMessage) (*persistencespb.AuditRecordBatch, error) {
+	batch := &persistencespb.AuditRecordBatch{}
+	if err := serialization.ProtoDecodeBlob(NewDataBlob(message.Data, message.Encoding), batch); err != nil {
+		return nil, fmt.Errorf("failed to decode audit records: %v", err)
+	}
+	return batch, nil
+}
@@ -0,0 +1,117 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: auditLog.go
+
+// Package persistence is a generated GoMock package.
+package persistence
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	persistence "go.temporal.io/server/api/persistence/v1"
+)
+
+// MockAuditLogManager is a mock of AuditLogManager interface.
+type MockAuditLogManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditLogManagerMockRecorder
+}
+
+// MockAuditLogManagerMockRecorder is the mock recorder for MockAuditLogManager.
+type MockAuditLogManagerMockRecorder struct {
+	mock *MockAuditLogManager
+}
+
+// NewMockAuditLogManager creates a new mock instance.
+func NewMockAuditLogManager(ctrl *gomock.Controller) *MockAuditLogManager {
+	mock := &MockAuditLogManager{ctrl: ctrl}
+	mock.recorder = &MockAuditLogManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditLogManager) EXPECT() *MockAuditLogManagerMockRecorder {
+	return m.recorder
+}
+
+// AppendRecords mocks base method.
+func (m *MockAuditLogManager) AppendRecords(ctx context.Context, partition int, records []*persistence.AuditRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendRecords", ctx, partition, records)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AppendRecords indicates an expected call of AppendRecords.
+func (mr *MockAuditLogManagerMockRecorder) AppendRecords(ctx, partition, records interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendRecords", reflect.TypeOf((*MockAuditLogManager)(nil).AppendRecords), ctx, partition, records)
+}
+
+// Close mocks base method.
+func (m *MockAuditLogManager) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockAuditLogManagerMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockAuditLogManager)(nil).Close))
+}
+
+// DeleteRecordsBefore mocks base method.
+func (m *MockAuditLogManager) DeleteRecordsBefore(ctx context.Context, before time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRecordsBefore", ctx, before)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRecordsBefore indicates an expected call of DeleteRecordsBefore.
+func (mr *MockAuditLogManagerMockRecorder) DeleteRecordsBefore(ctx, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRecordsBefore", reflect.TypeOf((*MockAuditLogManager)(nil).DeleteRecordsBefore), ctx, before)
+}
+
+// ReadRecords mocks base method.
+func (m *MockAuditLogManager) ReadRecords(ctx context.Context, partition int, lastMessageID int64, maxCount int) ([]*persistence.AuditRecord, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadRecords", ctx, partition, lastMessageID, maxCount)
+	ret0, _ := ret[0].([]*persistence.AuditRecord)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ReadRecords indicates an expected call of ReadRecords.
+func (mr *MockAuditLogManagerMockRecorder) ReadRecords(ctx, partition, lastMessageID, maxCount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRecords", reflect.TypeOf((*MockAuditLogManager)(nil).ReadRecords), ctx, partition, lastMessageID, maxCount)
+}
@@ -0,0 +1,133 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+type (
+	// memoryQueue implements the parts of Queue used by the audit log manager
+	memoryQueue struct {
+		Queue
+		messages []*QueueMessage
+		nextID   int64
+		// number of enqueues that fail as if another writer took the next message ID
+		conflicts int
+	}
+)
+
+func (q *memoryQueue) Init(context.Context, *commonpb.DataBlob) error {
+	return nil
+}
+
+func (q *memoryQueue) EnqueueMessage(_ context.Context, blob commonpb.DataBlob) error {
+	if q.conflicts > 0 {
+		q.conflicts--
+		return &ConditionFailedError{Msg: "message ID exists in queue"}
+	}
+	q.messages = append(q.messages, &QueueMessage{ID: q.nextID, Data: blob.Data, Encoding: blob.EncodingType.String()})
+	q.nextID++
+	return nil
+}
+
+func (q *memoryQueue) ReadMessages(_ context.Context, lastMessageID int64, maxCount int) ([]*QueueMessage, error) {
+	var messages []*QueueMessage
+	for _, message := range q.messages {
+		if message.ID > lastMessageID && len(messages) < maxCount {
+			messages = append(messages, message)
+		}
+	}
+	return messages, nil
+}
+
+func (q *memoryQueue) DeleteMessagesBefore(_ context.Context, messageID int64) error {
+	var messages []*QueueMessage
+	for _, message := range q.messages {
+		if message.ID >= messageID {
+			messages = append(messages, message)
+		}
+	}
+	q.messages = messages
+	return nil
+}
+
+func TestAuditLogManager(t *testing.T) {
+	partitions := []Queue{&memoryQueue{}, &memoryQueue{}}
+	manager, err := NewAuditLogManager(partitions, serialization.NewSerializer())
+	require.NoError(t, err)
+
+	now := time.Now().UTC()
+	record := func(api string, age time.Duration) *persistencespb.AuditRecord {
+		return &persistencespb.AuditRecord{ApiName: api, Time: timestamp.TimePtr(now.Add(-age))}
+	}
+	ctx := context.Background()
+	require.NoError(t, manager.AppendRecords(ctx, 0, []*persistencespb.AuditRecord{record("A", 3*time.Hour), record("B", 3*time.Hour)}))
+	require.NoError(t, manager.AppendRecords(ctx, 0, []*persistencespb.AuditRecord{record("C", 2*time.Hour), record("D", time.Minute)}))
+	require.NoError(t, manager.AppendRecords(ctx, 1, []*persistencespb.AuditRecord{record("E", 3*time.Hour)}))
+	require.Error(t, manager.AppendRecords(ctx, 2, []*persistencespb.AuditRecord{record("F", 0)}))
+
+	records, lastMessageID, err := manager.ReadRecords(ctx, 0, EmptyQueueMessageID, 1)
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	records, _, err = manager.ReadRecords(ctx, 0, lastMessageID, 10)
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	require.Equal(t, "C", records[0].GetApiName())
+
+	// a batch is only deleted once all of its records expired
+	require.NoError(t, manager.DeleteRecordsBefore(ctx, now.Add(-time.Hour)))
+	records, _, err = manager.ReadRecords(ctx, 0, EmptyQueueMessageID, 10)
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	require.Equal(t, "C", records[0].GetApiName())
+	records, _, err = manager.ReadRecords(ctx, 1, EmptyQueueMessageID, 10)
+	require.NoError(t, err)
+	require.Empty(t, records)
+}
+
+func TestAuditLogManager_AppendConflict(t *testing.T) {
+	ctx := context.Background()
+	queue := &memoryQueue{conflicts: 2}
+	manager, err := NewAuditLogManager([]Queue{queue}, serialization.NewSerializer())
+	require.NoError(t, err)
+
+	require.NoError(t, manager.AppendRecords(ctx, 0, []*persistencespb.AuditRecord{{ApiName: "A"}}))
+	require.Len(t, queue.messages, 1)
+
+	queue.conflicts = auditLogAppendMaxAttempts
+	err = manager.AppendRecords(ctx, 0, []*persistencespb.AuditRecord{{ApiName: "B"}})
+	require.IsType(t, &ConditionFailedError{}, err)
+	require.Len(t, queue.messages, 1)
+}
@@ -0,0 +1,309 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	commonpb "go.temporal.io/api/common/v1"
+
+	"go.temporal.io/server/common/persistence"
+)
+
+type (
+	// blobCodec transforms the blobs of history events and mutable state before they are written to
+	// the store and after they are read from it. decode returns blobs that were not encoded as is, so
+	// blobs written before a codec was enabled stay readable.
+	blobCodec interface {
+		encode(namespaceID string, blob *commonpb.DataBlob) (*commonpb.DataBlob, error)
+		decode(blob *commonpb.DataBlob) (*commonpb.DataBlob, error)
+	}
+
+	// blobCodecExecutionStore applies a blobCodec to the blobs of history events and mutable state.
+	// Workflow execution state and history tree info are left as is since the stores decode them.
+	blobCodecExecutionStore struct {
+		persistence.ExecutionStore
+		codec blobCodec
+	}
+)
+
+func newBlobCodecExecutionStore(
+	executionStore persistence.ExecutionStore,
+	codec blobCodec,
+) *blobCodecExecutionStore {
+	return &blobCodecExecutionStore{
+		ExecutionStore: executionStore,
+		codec:          codec,
+	}
+}
+
+func (s *blobCodecExecutionStore) CreateWorkflowExecution(
+	ctx context.Context,
+	request *persistence.InternalCreateWorkflowExecutionRequest,
+) (*persistence.InternalCreateWorkflowExecutionResponse, error) {
+	if err := s.encodeSnapshot(&request.NewWorkflowSnapshot); err != nil {
+		return nil, err
+	}
+	if err := s.encodeEvents(request.NewWorkflowSnapshot.NamespaceID, request.NewWorkflowNewEvents); err != nil {
+		return nil, err
+	}
+	return s.ExecutionStore.CreateWorkflowExecution(ctx, request)
+}
+
+func (s *blobCodecExecutionStore) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *persistence.InternalUpdateWorkflowExecutionRequest,
+) error {
+	if err := s.encodeMutation(&request.UpdateWorkflowMutation); err != nil {
+		return err
+	}
+	if err := s.encodeEvents(request.UpdateWorkflowMutation.NamespaceID, request.UpdateWorkflowNewEvents); err != nil {
+		return err
+	}
+	if request.NewWorkflowSnapshot != nil {
+		if err := s.encodeSnapshot(request.NewWorkflowSnapshot); err != nil {
+			return err
+		}
+		if err := s.encodeEvents(request.NewWorkflowSnapshot.NamespaceID, request.NewWorkflowNewEvents); err != nil {
+			return err
+		}
+	}
+	return s.ExecutionStore.UpdateWorkflowExecution(ctx, request)
+}
+
+func (s *blobCodecExecutionStore) ConflictResolveWorkflowExecution(
+	ctx context.Context,
+	request *persistence.InternalConflictResolveWorkflowExecutionRequest,
+) error {
+	if err := s.encodeSnapshot(&request.ResetWorkflowSnapshot); err != nil {
+		return err
+	}
+	if err := s.encodeEvents(request.ResetWorkflowSnapshot.NamespaceID, request.ResetWorkflowEventsNewEvents); err != nil {
+		return err
+	}
+	if request.NewWorkflowSnapshot != nil {
+		if err := s.encodeSnapshot(request.NewWorkflowSnapshot); err != nil {
+			return err
+		}
+		if err := s.encodeEvents(request.NewWorkflowSnapshot.NamespaceID, request.NewWorkflowEventsNewEvents); err != nil {
+			return err
+		}
+	}
+	if request.CurrentWorkflowMutation != nil {
+		if err := s.encodeMutation(request.CurrentWorkflowMutation); err != nil {
+			return err
+		}
+		if err := s.encodeEvents(request.CurrentWorkflowMutation.NamespaceID, request.CurrentWorkflowEventsNewEvents); err != nil {
+			return err
+		}
+	}
+	return s.ExecutionStore.ConflictResolveWorkflowExecution(ctx, request)
+}
+
+func (s *blobCodecExecutionStore) SetWorkflowExecution(
+	ctx context.Context,
+	request *persistence.InternalSetWorkflowExecutionRequest,
+) error {
+	if err := s.encodeSnapshot(&request.SetWorkflowSnapshot); err != nil {
+		return err
+	}
+	return s.ExecutionStore.SetWorkflowExecution(ctx, request)
+}
+
+func (s *blobCodecExecutionStore) GetWorkflowExecution(
+	ctx context.Context,
+	request *persistence.GetWorkflowExecutionRequest,
+) (*persistence.InternalGetWorkflowExecutionResponse, error) {
+	response, err := s.ExecutionStore.GetWorkflowExecution(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.decodeMutableState(response.State); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (s *blobCodecExecutionStore) ListConcreteExecutions(
+	ctx context.Context,
+	request *persistence.ListConcreteExecutionsRequest,
+) (*persistence.InternalListConcreteExecutionsResponse, error) {
+	response, err := s.ExecutionStore.ListConcreteExecutions(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	for _, state := range response.States {
+		if err := s.decodeMutableState(state); err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
+
+// AppendHistoryNodes encodes nodes appended outside a workflow transaction. The namespace is taken
+// from the garbage cleanup info of the request, and appends without it are rejected since their
+// events can't be encoded.
+func (s *blobCodecExecutionStore) AppendHistoryNodes(
+	ctx context.Context,
+	request *persistence.InternalAppendHistoryNodesRequest,
+) error {
+	namespaceID, _, _, err := persistence.SplitHistoryGarbageCleanupInfo(request.Info)
+	if err != nil {
+		return &persistence.InvalidPersistenceRequestError{
+			Msg: fmt.Sprintf("unable to encode history node without namespace: %v", err),
+		}
+	}
+	if err := s.encodeEvents(namespaceID, []*persistence.InternalAppendHistoryNodesRequest{request}); err != nil {
+		return err
+	}
+	return s.ExecutionStore.AppendHistoryNodes(ctx, request)
+}
+
+func (s *blobCodecExecutionStore) ReadHistoryBranch(
+	ctx context.Context,
+	request *persistence.InternalReadHistoryBranchRequest,
+) (*persistence.InternalReadHistoryBranchResponse, error) {
+	response, err := s.ExecutionStore.ReadHistoryBranch(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	for i := range response.Nodes {
+		if response.Nodes[i].Events, err = s.codec.decode(response.Nodes[i].Events); err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
+
+func (s *blobCodecExecutionStore) encodeSnapshot(snapshot *persistence.InternalWorkflowSnapshot) error {
+	var err error
+	if snapshot.ExecutionInfoBlob, err = s.codec.encode(snapshot.NamespaceID, snapshot.ExecutionInfoBlob); err != nil {
+		return err
+	}
+	if err := encodeBlobs(s.codec, snapshot.NamespaceID, snapshot.ActivityInfos); err != nil {
+		return err
+	}
+	if err := encodeBlobs(s.codec, snapshot.NamespaceID, snapshot.TimerInfos); err != nil {
+		return err
+	}
+	if err := encodeBlobs(s.codec, snapshot.NamespaceID, snapshot.ChildExecutionInfos); err != nil {
+		return err
+	}
+	if err := encodeBlobs(s.codec, snapshot.NamespaceID, snapshot.RequestCancelInfos); err != nil {
+		return err
+	}
+	return encodeBlobs(s.codec, snapshot.NamespaceID, snapshot.SignalInfos)
+}
+
+func (s *blobCodecExecutionStore) encodeMutation(mutation *persistence.InternalWorkflowMutation) error {
+	var err error
+	if mutation.ExecutionInfoBlob, err = s.codec.encode(mutation.NamespaceID, mutation.ExecutionInfoBlob); err != nil {
+		return err
+	}
+	if mutation.NewBufferedEvents, err = s.codec.encode(mutation.NamespaceID, mutation.NewBufferedEvents); err != nil {
+		return err
+	}
+	if err := encodeBlobs(s.codec, mutation.NamespaceID, mutation.UpsertActivityInfos); err != nil {
+		return err
+	}
+	if err := encodeBlobs(s.codec, mutation.NamespaceID, mutation.UpsertTimerInfos); err != nil {
+		return err
+	}
+	if err := encodeBlobs(s.codec, mutation.NamespaceID, mutation.UpsertChildExecutionInfos); err != nil {
+		return err
+	}
+	if err := encodeBlobs(s.codec, mutation.NamespaceID, mutation.UpsertRequestCancelInfos); err != nil {
+		return err
+	}
+	return encodeBlobs(s.codec, mutation.NamespaceID, mutation.UpsertSignalInfos)
+}
+
+func (s *blobCodecExecutionStore) encodeEvents(
+	namespaceID string,
+	requests []*persistence.InternalAppendHistoryNodesRequest,
+) error {
+	for _, request := range requests {
+		var err error
+		if request.Node.Events, err = s.codec.encode(namespaceID, request.Node.Events); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *blobCodecExecutionStore) decodeMutableState(state *persistence.InternalWorkflowMutableState) error {
+	var err error
+	if state.ExecutionInfo, err = s.codec.decode(state.ExecutionInfo); err != nil {
+		return err
+	}
+	for i := range state.BufferedEvents {
+		if state.BufferedEvents[i], err = s.codec.decode(state.BufferedEvents[i]); err != nil {
+			return err
+		}
+	}
+	if err := decodeBlobs(s.codec, state.ActivityInfos); err != nil {
+		return err
+	}
+	if err := decodeBlobs(s.codec, state.TimerInfos); err != nil {
+		return err
+	}
+	if err := decodeBlobs(s.codec, state.ChildExecutionInfos); err != nil {
+		return err
+	}
+	if err := decodeBlobs(s.codec, state.RequestCancelInfos); err != nil {
+		return err
+	}
+	return decodeBlobs(s.codec, state.SignalInfos)
+}
+
+func encodeBlobs[K comparable](
+	codec blobCodec,
+	namespaceID string,
+	blobs map[K]*commonpb.DataBlob,
+) error {
+	for key, blob := range blobs {
+		encoded, err := codec.encode(namespaceID, blob)
+		if err != nil {
+			return err
+		}
+		blobs[key] = encoded
+	}
+	return nil
+}
+
+func decodeBlobs[K comparable](
+	codec blobCodec,
+	blobs map[K]*commonpb.DataBlob,
+) error {
+	for key, blob := range blobs {
+		decoded, err := codec.decode(blob)
+		if err != nil {
+			return err
+		}
+		blobs[key] = decoded
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+type (
+	// CompressionDataStoreFactory compresses history events and mutable state of the namespaces that
+	// enable it in dynamic config. Other stores are returned by the base factory as is.
+	CompressionDataStoreFactory struct {
+		DataStoreFactory
+		compressor serialization.BlobCompressor
+		codec      *compressionCodec
+	}
+
+	compressionCodec struct {
+		compressor     serialization.BlobCompressor
+		algorithm      dynamicconfig.StringPropertyFnWithNamespaceIDFilter
+		minSize        dynamicconfig.IntPropertyFn
+		metricsHandler metrics.Handler
+		logger         log.Logger
+	}
+)
+
+func NewCompressionDataStoreFactory(
+	dc *dynamicconfig.Collection,
+	baseFactory DataStoreFactory,
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+) (*CompressionDataStoreFactory, error) {
+	compressor, err := serialization.NewBlobCompressor()
+	if err != nil {
+		return nil, err
+	}
+	if metricsHandler == nil {
+		metricsHandler = metrics.NoopMetricsHandler
+	}
+	return &CompressionDataStoreFactory{
+		DataStoreFactory: baseFactory,
+		compressor:       compressor,
+		codec: &compressionCodec{
+			compressor:     compressor,
+			algorithm:      dc.GetStringPropertyFnWithNamespaceIDFilter(dynamicconfig.PersistenceBlobCompression, ""),
+			minSize:        dc.GetIntProperty(dynamicconfig.PersistenceBlobCompressionMinSize, 1024),
+			metricsHandler: metricsHandler,
+			logger:         log.NewThrottledLogger(logger, func() float64 { return 1 }),
+		},
+	}, nil
+}
+
+func (d *CompressionDataStoreFactory) Close() {
+	d.DataStoreFactory.Close()
+	d.compressor.Close()
+}
+
+func (d *CompressionDataStoreFactory) NewExecutionStore() (persistence.ExecutionStore, error) {
+	store, err := d.DataStoreFactory.NewExecutionStore()
+	if err != nil {
+		return nil, err
+	}
+	return newBlobCodecExecutionStore(store, d.codec), nil
+}
+
+func (c *compressionCodec) encode(namespaceID string, blob *commonpb.DataBlob) (*commonpb.DataBlob, error) {
+	if blob == nil || len(blob.Data) == 0 || len(blob.Data) < c.minSize() {
+		return blob, nil
+	}
+	algorithm := c.algorithm(namespaceID)
+	if algorithm == "" {
+		return blob, nil
+	}
+	if err := serialization.ValidateCompressionAlgorithm(algorithm); err != nil {
+		// a typo in dynamic config must not fail writes
+		c.logger.Warn("Blob is not compressed", tag.WorkflowNamespaceID(namespaceID), tag.Error(err))
+		return blob, nil
+	}
+
+	startTime := time.Now().UTC()
+	compressed, err := c.compressor.Compress(algorithm, blob)
+	if err != nil {
+		return nil, err
+	}
+	handler := c.metricsHandler.WithTags(metrics.CompressionAlgorithmTag(algorithm))
+	handler.Timer(metrics.PersistenceBlobCompressionLatency.GetMetricName()).Record(time.Since(startTime))
+	// the ratio is the compressed size in percent of the original size
+	handler.Histogram(
+		metrics.PersistenceBlobCompressionRatio.GetMetricName(),
+		metrics.PersistenceBlobCompressionRatio.GetMetricUnit(),
+	).Record(int64(len(compressed.Data) * 100 / len(blob.Data)))
+	handler.Counter(metrics.PersistenceBlobCompressionSavedBytes.GetMetricName()).Record(int64(len(blob.Data) - len(compressed.Data)))
+	return compressed, nil
+}
+
+func (c *compressionCodec) decode(blob *commonpb.DataBlob) (*commonpb.DataBlob, error) {
+	if blob == nil || !serialization.IsCompressedBlob(blob) {
+		return blob, nil
+	}
+	startTime := time.Now().UTC()
+	decompressed, err := c.compressor.Decompress(blob)
+	if err != nil {
+		return nil, err
+	}
+	c.metricsHandler.Timer(metrics.PersistenceBlobDecompressionLatency.GetMetricName()).Record(time.Since(startTime))
+	return decompressed, nil
+}
@@ -0,0 +1,108 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+type executionStoreFactory struct {
+	DataStoreFactory
+	store persistence.ExecutionStore
+}
+
+func (f *executionStoreFactory) NewExecutionStore() (persistence.ExecutionStore, error) {
+	return f.store, nil
+}
+
+func (f *executionStoreFactory) Close() {}
+
+func TestCompressionExecutionStore(t *testing.T) {
+	dc := dynamicconfig.NewCollection(dynamicconfig.StaticClient(map[dynamicconfig.Key]any{
+		dynamicconfig.PersistenceBlobCompression: []dynamicconfig.ConstrainedValue{
+			{Constraints: dynamicconfig.Constraints{NamespaceID: "namespace-id"}, Value: serialization.CompressionZstd},
+		},
+		dynamicconfig.PersistenceBlobCompressionMinSize: 64,
+	}), log.NewNoopLogger())
+	base := &recordingExecutionStore{}
+	factory, err := NewCompressionDataStoreFactory(dc, &executionStoreFactory{store: base}, log.NewNoopLogger(), metrics.NoopMetricsHandler)
+	require.NoError(t, err)
+	defer factory.Close()
+	store, err := factory.NewExecutionStore()
+	require.NoError(t, err)
+
+	blob := func(data string) *commonpb.DataBlob {
+		return &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte(data)}
+	}
+	large := func(data string) *commonpb.DataBlob {
+		return blob(strings.Repeat(data, 20))
+	}
+	update := func(namespaceID string) *persistence.InternalUpdateWorkflowExecutionRequest {
+		return &persistence.InternalUpdateWorkflowExecutionRequest{
+			UpdateWorkflowMutation: persistence.InternalWorkflowMutation{
+				NamespaceID:         namespaceID,
+				ExecutionInfoBlob:   large("execution info"),
+				ExecutionStateBlob:  large("execution state"),
+				UpsertActivityInfos: map[int64]*commonpb.DataBlob{5: blob("activity info")},
+				NewBufferedEvents:   large("buffered events"),
+			},
+			UpdateWorkflowNewEvents: []*persistence.InternalAppendHistoryNodesRequest{
+				{Node: persistence.InternalHistoryNode{Events: large("events")}},
+			},
+		}
+	}
+
+	require.NoError(t, store.UpdateWorkflowExecution(context.Background(), update("other-namespace-id")))
+	require.Equal(t, large("execution info"), base.update.UpdateWorkflowMutation.ExecutionInfoBlob)
+	require.Equal(t, large("events"), base.update.UpdateWorkflowNewEvents[0].Node.Events)
+
+	require.NoError(t, store.UpdateWorkflowExecution(context.Background(), update("namespace-id")))
+	mutation := base.update.UpdateWorkflowMutation
+	require.True(t, serialization.IsCompressedBlob(mutation.ExecutionInfoBlob))
+	require.True(t, serialization.IsCompressedBlob(mutation.NewBufferedEvents))
+	require.True(t, serialization.IsCompressedBlob(base.update.UpdateWorkflowNewEvents[0].Node.Events))
+	// below the minimum size
+	require.Equal(t, blob("activity info"), mutation.UpsertActivityInfos[5])
+	// the stores decode the execution state
+	require.Equal(t, large("execution state"), mutation.ExecutionStateBlob)
+
+	response, err := store.GetWorkflowExecution(context.Background(), &persistence.GetWorkflowExecutionRequest{})
+	require.NoError(t, err)
+	require.Equal(t, large("execution info"), response.State.ExecutionInfo)
+	require.Equal(t, blob("activity info"), response.State.ActivityInfos[5])
+	require.Equal(t, large("buffered events"), response.State.BufferedEvents[0])
+}
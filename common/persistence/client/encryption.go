@@ -0,0 +1,95 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	commonpb "go.temporal.io/api/common/v1"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+type (
+	// EncryptionDataStoreFactory encrypts history events and mutable state at rest. Other stores
+	// are returned by the base factory as is.
+	EncryptionDataStoreFactory struct {
+		DataStoreFactory
+		keyProvider *serialization.FileKeyProvider
+		encryptor   serialization.BlobEncryptor
+	}
+
+	encryptionCodec struct {
+		encryptor serialization.BlobEncryptor
+	}
+)
+
+func NewEncryptionDataStoreFactory(
+	config *config.Encryption,
+	baseFactory DataStoreFactory,
+	logger log.Logger,
+) (*EncryptionDataStoreFactory, error) {
+	keyProvider, err := serialization.NewFileKeyProvider(config.KeyFile, config.RefreshInterval, logger)
+	if err != nil {
+		return nil, err
+	}
+	return &EncryptionDataStoreFactory{
+		DataStoreFactory: baseFactory,
+		keyProvider:      keyProvider,
+		encryptor:        serialization.NewBlobEncryptor(keyProvider),
+	}, nil
+}
+
+func (d *EncryptionDataStoreFactory) Close() {
+	d.keyProvider.Close()
+	d.DataStoreFactory.Close()
+}
+
+func (d *EncryptionDataStoreFactory) NewExecutionStore() (persistence.ExecutionStore, error) {
+	store, err := d.DataStoreFactory.NewExecutionStore()
+	if err != nil {
+		return nil, err
+	}
+	return NewEncryptionExecutionStore(store, d.encryptor), nil
+}
+
+// NewEncryptionExecutionStore returns a store that encrypts the blobs of history events and mutable
+// state with the active key of their namespace before they are written, and decrypts them when they
+// are read. Workflow execution state and history tree info are not encrypted since the stores decode them.
+func NewEncryptionExecutionStore(
+	executionStore persistence.ExecutionStore,
+	encryptor serialization.BlobEncryptor,
+) persistence.ExecutionStore {
+	return newBlobCodecExecutionStore(executionStore, &encryptionCodec{encryptor: encryptor})
+}
+
+func (c *encryptionCodec) encode(namespaceID string, blob *commonpb.DataBlob) (*commonpb.DataBlob, error) {
+	return c.encryptor.Encrypt(namespaceID, blob)
+}
+
+func (c *encryptionCodec) decode(blob *commonpb.DataBlob) (*commonpb.DataBlob, error) {
+	return c.encryptor.Decrypt(blob)
+}
@@ -0,0 +1,139 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+type recordingExecutionStore struct {
+	persistence.ExecutionStore
+	update *persistence.InternalUpdateWorkflowExecutionRequest
+	append *persistence.InternalAppendHistoryNodesRequest
+}
+
+func (s *recordingExecutionStore) AppendHistoryNodes(
+	_ context.Context,
+	request *persistence.InternalAppendHistoryNodesRequest,
+) error {
+	s.append = request
+	return nil
+}
+
+func (s *recordingExecutionStore) UpdateWorkflowExecution(
+	_ context.Context,
+	request *persistence.InternalUpdateWorkflowExecutionRequest,
+) error {
+	s.update = request
+	return nil
+}
+
+func (s *recordingExecutionStore) GetWorkflowExecution(
+	_ context.Context,
+	_ *persistence.GetWorkflowExecutionRequest,
+) (*persistence.InternalGetWorkflowExecutionResponse, error) {
+	mutation := s.update.UpdateWorkflowMutation
+	return &persistence.InternalGetWorkflowExecutionResponse{
+		State: &persistence.InternalWorkflowMutableState{
+			ExecutionInfo:  mutation.ExecutionInfoBlob,
+			ExecutionState: mutation.ExecutionStateBlob,
+			ActivityInfos:  mutation.UpsertActivityInfos,
+			BufferedEvents: []*commonpb.DataBlob{mutation.NewBufferedEvents},
+		},
+	}, nil
+}
+
+func TestEncryptionExecutionStore(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "keys.yaml")
+	require.NoError(t, os.WriteFile(keyFile, []byte(`
+keys:
+  key-1: MTExMTExMTExMTExMTExMQ==
+namespaces:
+  namespace-id: key-1
+`), 0600))
+	keyProvider, err := serialization.NewFileKeyProvider(keyFile, 0, log.NewNoopLogger())
+	require.NoError(t, err)
+
+	base := &recordingExecutionStore{}
+	store := NewEncryptionExecutionStore(base, serialization.NewBlobEncryptor(keyProvider))
+
+	blob := func(data string) *commonpb.DataBlob {
+		return &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte(data)}
+	}
+	err = store.UpdateWorkflowExecution(context.Background(), &persistence.InternalUpdateWorkflowExecutionRequest{
+		UpdateWorkflowMutation: persistence.InternalWorkflowMutation{
+			NamespaceID:         "namespace-id",
+			ExecutionInfoBlob:   blob("execution info"),
+			ExecutionStateBlob:  blob("execution state"),
+			UpsertActivityInfos: map[int64]*commonpb.DataBlob{5: blob("activity info")},
+			NewBufferedEvents:   blob("buffered events"),
+		},
+		UpdateWorkflowNewEvents: []*persistence.InternalAppendHistoryNodesRequest{
+			{Node: persistence.InternalHistoryNode{Events: blob("events")}},
+		},
+	})
+	require.NoError(t, err)
+
+	mutation := base.update.UpdateWorkflowMutation
+	require.True(t, serialization.IsEncryptedBlob(mutation.ExecutionInfoBlob))
+	require.True(t, serialization.IsEncryptedBlob(mutation.UpsertActivityInfos[5]))
+	require.True(t, serialization.IsEncryptedBlob(mutation.NewBufferedEvents))
+	require.True(t, serialization.IsEncryptedBlob(base.update.UpdateWorkflowNewEvents[0].Node.Events))
+	// the stores decode the execution state
+	require.Equal(t, blob("execution state"), mutation.ExecutionStateBlob)
+
+	response, err := store.GetWorkflowExecution(context.Background(), &persistence.GetWorkflowExecutionRequest{})
+	require.NoError(t, err)
+	require.Equal(t, blob("execution info"), response.State.ExecutionInfo)
+	require.Equal(t, blob("activity info"), response.State.ActivityInfos[5])
+	require.Equal(t, blob("buffered events"), response.State.BufferedEvents[0])
+
+	err = store.AppendHistoryNodes(context.Background(), &persistence.InternalAppendHistoryNodesRequest{
+		Info: persistence.BuildHistoryGarbageCleanupInfo("namespace-id", "workflow-id", "run-id"),
+		Node: persistence.InternalHistoryNode{Events: blob("events")},
+	})
+	require.NoError(t, err)
+	require.True(t, serialization.IsEncryptedBlob(base.append.Node.Events))
+
+	// events of an unknown namespace are not written unencrypted
+	base.append = nil
+	err = store.AppendHistoryNodes(context.Background(), &persistence.InternalAppendHistoryNodesRequest{
+		Node: persistence.InternalHistoryNode{Events: blob("events")},
+	})
+	var invalidRequestErr *persistence.InvalidPersistenceRequestError
+	require.ErrorAs(t, err, &invalidRequestErr)
+	require.Nil(t, base.append)
+}
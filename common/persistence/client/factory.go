@@ -59,6 +59,10 @@ type (
 		NewNamespaceReplicationQueue() (p.NamespaceReplicationQueue, error)
 		// NewClusterMetadataManager returns a new manager for cluster specific metadata
 		NewClusterMetadataManager() (p.ClusterMetadataManager, error)
+		// NewAuditLogManager returns a new manager for audit records
+		NewAuditLogManager() (p.AuditLogManager, error)
+		// NewAPIKeyUsageManager returns a new manager for the last used time of API keys
+		NewAPIKeyUsageManager() (p.APIKeyUsageManager, error)
 	}
 
 	factoryImpl struct {
@@ -204,6 +208,41 @@ func (f *factoryImpl) NewNamespaceReplicationQueue() (p.NamespaceReplicationQueu
 	return p.NewNamespaceReplicationQueue(result, f.serializer, f.clusterName, f.metricsHandler, f.logger)
 }
 
+func (f *factoryImpl) NewAuditLogManager() (p.AuditLogManager, error) {
+	partitions := make([]p.Queue, p.AuditLogQueuePartitions)
+	for i := range partitions {
+		result, err := f.dataStoreFactory.NewQueue(p.AuditLogQueueType + p.QueueType(i))
+		if err != nil {
+			return nil, err
+		}
+
+		if f.ratelimiter != nil {
+			result = p.NewQueuePersistenceRateLimitedClient(result, f.ratelimiter, f.logger)
+		}
+		if f.metricsHandler != nil {
+			result = p.NewQueuePersistenceMetricsClient(result, f.metricsHandler, f.logger)
+		}
+		partitions[i] = p.NewQueuePersistenceRetryableClient(result, retryPolicy, IsPersistenceTransientError)
+	}
+	return p.NewAuditLogManager(partitions, f.serializer)
+}
+
+func (f *factoryImpl) NewAPIKeyUsageManager() (p.APIKeyUsageManager, error) {
+	result, err := f.dataStoreFactory.NewQueue(p.APIKeyUsageQueueType)
+	if err != nil {
+		return nil, err
+	}
+
+	if f.ratelimiter != nil {
+		result = p.NewQueuePersistenceRateLimitedClient(result, f.ratelimiter, f.logger)
+	}
+	if f.metricsHandler != nil {
+		result = p.NewQueuePersistenceMetricsClient(result, f.metricsHandler, f.logger)
+	}
+	result = p.NewQueuePersistenceRetryableClient(result, retryPolicy, IsPersistenceTransientError)
+	return p.NewAPIKeyUsageManager(result)
+}
+
 // Close closes this factory
 func (f *factoryImpl) Close() {
 	f.dataStoreFactory.Close()
@@ -41,6 +41,7 @@ type (
 		baseFactory    DataStoreFactory
 		config         *config.FaultInjection
 		ErrorGenerator ErrorGenerator
+		faultRegistry  *PersistenceFaultRegistry
 
 		TaskStore      *FaultInjectionTaskStore
 		ShardStore     *FaultInjectionShardStore
@@ -53,16 +54,19 @@ type (
 	FaultInjectionShardStore struct {
 		baseShardStore persistence.ShardStore
 		ErrorGenerator ErrorGenerator
+		faultRegistry  *PersistenceFaultRegistry
 	}
 
 	FaultInjectionTaskStore struct {
 		baseTaskStore  persistence.TaskStore
 		ErrorGenerator ErrorGenerator
+		faultRegistry  *PersistenceFaultRegistry
 	}
 
 	FaultInjectionMetadataStore struct {
 		baseMetadataStore persistence.MetadataStore
 		ErrorGenerator    ErrorGenerator
+		faultRegistry     *PersistenceFaultRegistry
 	}
 
 	FaultInjectionClusterMetadataStore struct {
@@ -73,11 +77,13 @@ type (
 	FaultInjectionExecutionStore struct {
 		baseExecutionStore persistence.ExecutionStore
 		ErrorGenerator     ErrorGenerator
+		faultRegistry      *PersistenceFaultRegistry
 	}
 
 	FaultInjectionQueue struct {
 		baseQueue      persistence.Queue
 		ErrorGenerator ErrorGenerator
+		faultRegistry  *PersistenceFaultRegistry
 	}
 )
 
@@ -111,6 +117,7 @@ func newErrorGenerator(rate float64, errorWeights []FaultWeight) ErrorGenerator
 func NewFaultInjectionDatastoreFactory(
 	config *config.FaultInjection,
 	baseFactory DataStoreFactory,
+	faultRegistry *PersistenceFaultRegistry,
 ) *FaultInjectionDataStoreFactory {
 	errorGenerator := newErrorGenerator(
 		config.Rate,
@@ -125,6 +132,7 @@ func NewFaultInjectionDatastoreFactory(
 		baseFactory:    baseFactory,
 		config:         config,
 		ErrorGenerator: errorGenerator,
+		faultRegistry:  faultRegistry,
 	}
 }
 
@@ -158,6 +166,7 @@ func (d *FaultInjectionDataStoreFactory) NewTaskStore() (persistence.TaskStore,
 				return nil, err
 			}
 		}
+		d.TaskStore.faultRegistry = d.faultRegistry
 	}
 	return d.TaskStore, nil
 }
@@ -179,6 +188,7 @@ func (d *FaultInjectionDataStoreFactory) NewShardStore() (persistence.ShardStore
 				return nil, err
 			}
 		}
+		d.ShardStore.faultRegistry = d.faultRegistry
 	}
 	return d.ShardStore, nil
 }
@@ -200,6 +210,7 @@ func (d *FaultInjectionDataStoreFactory) NewMetadataStore() (persistence.Metadat
 				return nil, err
 			}
 		}
+		d.MetadataStore.faultRegistry = d.faultRegistry
 	}
 	return d.MetadataStore, nil
 }
@@ -221,7 +232,7 @@ func (d *FaultInjectionDataStoreFactory) NewExecutionStore() (persistence.Execut
 				return nil, err
 			}
 		}
-
+		d.ExecutionStore.faultRegistry = d.faultRegistry
 	}
 	return d.ExecutionStore, nil
 }
@@ -243,6 +254,7 @@ func (d *FaultInjectionDataStoreFactory) NewQueue(queueType persistence.QueueTyp
 				return nil, err
 			}
 		}
+		d.Queue.faultRegistry = d.faultRegistry
 	}
 	return d.Queue, nil
 }
@@ -299,7 +311,7 @@ func (q *FaultInjectionQueue) Init(
 	blob *commonpb.DataBlob,
 ) error {
 	// potentially Init can return golang errors from blob.go encode/decode.
-	if err := q.ErrorGenerator.Generate(); err != nil {
+	if err := q.injectFault(ctx, "Init", nil); err != nil {
 		return err
 	}
 	return q.baseQueue.Init(ctx, blob)
@@ -309,7 +321,7 @@ func (q *FaultInjectionQueue) EnqueueMessage(
 	ctx context.Context,
 	blob commonpb.DataBlob,
 ) error {
-	if err := q.ErrorGenerator.Generate(); err != nil {
+	if err := q.injectFault(ctx, "EnqueueMessage", nil); err != nil {
 		return err
 	}
 	return q.baseQueue.EnqueueMessage(ctx, blob)
@@ -320,7 +332,7 @@ func (q *FaultInjectionQueue) ReadMessages(
 	lastMessageID int64,
 	maxCount int,
 ) ([]*persistence.QueueMessage, error) {
-	if err := q.ErrorGenerator.Generate(); err != nil {
+	if err := q.injectFault(ctx, "ReadMessages", nil); err != nil {
 		return nil, err
 	}
 	return q.baseQueue.ReadMessages(ctx, lastMessageID, maxCount)
@@ -330,7 +342,7 @@ func (q *FaultInjectionQueue) DeleteMessagesBefore(
 	ctx context.Context,
 	messageID int64,
 ) error {
-	if err := q.ErrorGenerator.Generate(); err != nil {
+	if err := q.injectFault(ctx, "DeleteMessagesBefore", nil); err != nil {
 		return err
 	}
 	return q.baseQueue.DeleteMessagesBefore(ctx, messageID)
@@ -340,7 +352,7 @@ func (q *FaultInjectionQueue) UpdateAckLevel(
 	ctx context.Context,
 	metadata *persistence.InternalQueueMetadata,
 ) error {
-	if err := q.ErrorGenerator.Generate(); err != nil {
+	if err := q.injectFault(ctx, "UpdateAckLevel", nil); err != nil {
 		return err
 	}
 	return q.baseQueue.UpdateAckLevel(ctx, metadata)
@@ -349,7 +361,7 @@ func (q *FaultInjectionQueue) UpdateAckLevel(
 func (q *FaultInjectionQueue) GetAckLevels(
 	ctx context.Context,
 ) (*persistence.InternalQueueMetadata, error) {
-	if err := q.ErrorGenerator.Generate(); err != nil {
+	if err := q.injectFault(ctx, "GetAckLevels", nil); err != nil {
 		return nil, err
 	}
 	return q.baseQueue.GetAckLevels(ctx)
@@ -359,7 +371,7 @@ func (q *FaultInjectionQueue) EnqueueMessageToDLQ(
 	ctx context.Context,
 	blob commonpb.DataBlob,
 ) (int64, error) {
-	if err := q.ErrorGenerator.Generate(); err != nil {
+	if err := q.injectFault(ctx, "EnqueueMessageToDLQ", nil); err != nil {
 		return 0, err
 	}
 	return q.baseQueue.EnqueueMessageToDLQ(ctx, blob)
@@ -372,7 +384,7 @@ func (q *FaultInjectionQueue) ReadMessagesFromDLQ(
 	pageSize int,
 	pageToken []byte,
 ) ([]*persistence.QueueMessage, []byte, error) {
-	if err := q.ErrorGenerator.Generate(); err != nil {
+	if err := q.injectFault(ctx, "ReadMessagesFromDLQ", nil); err != nil {
 		return nil, nil, err
 	}
 	return q.baseQueue.ReadMessagesFromDLQ(ctx, firstMessageID, lastMessageID, pageSize, pageToken)
@@ -382,7 +394,7 @@ func (q *FaultInjectionQueue) DeleteMessageFromDLQ(
 	ctx context.Context,
 	messageID int64,
 ) error {
-	if err := q.ErrorGenerator.Generate(); err != nil {
+	if err := q.injectFault(ctx, "DeleteMessageFromDLQ", nil); err != nil {
 		return err
 	}
 	return q.baseQueue.DeleteMessageFromDLQ(ctx, messageID)
@@ -393,7 +405,7 @@ func (q *FaultInjectionQueue) RangeDeleteMessagesFromDLQ(
 	firstMessageID int64,
 	lastMessageID int64,
 ) error {
-	if err := q.ErrorGenerator.Generate(); err != nil {
+	if err := q.injectFault(ctx, "RangeDeleteMessagesFromDLQ", nil); err != nil {
 		return err
 	}
 	return q.baseQueue.RangeDeleteMessagesFromDLQ(ctx, firstMessageID, lastMessageID)
@@ -403,7 +415,7 @@ func (q *FaultInjectionQueue) UpdateDLQAckLevel(
 	ctx context.Context,
 	metadata *persistence.InternalQueueMetadata,
 ) error {
-	if err := q.ErrorGenerator.Generate(); err != nil {
+	if err := q.injectFault(ctx, "UpdateDLQAckLevel", nil); err != nil {
 		return err
 	}
 	return q.baseQueue.UpdateDLQAckLevel(ctx, metadata)
@@ -412,7 +424,7 @@ func (q *FaultInjectionQueue) UpdateDLQAckLevel(
 func (q *FaultInjectionQueue) GetDLQAckLevels(
 	ctx context.Context,
 ) (*persistence.InternalQueueMetadata, error) {
-	if err := q.ErrorGenerator.Generate(); err != nil {
+	if err := q.injectFault(ctx, "GetDLQAckLevels", nil); err != nil {
 		return nil, err
 	}
 	return q.baseQueue.GetDLQAckLevels(ctx)
@@ -422,6 +434,13 @@ func (q *FaultInjectionQueue) UpdateRate(rate float64) {
 	q.ErrorGenerator.UpdateRate(rate)
 }
 
+func (q *FaultInjectionQueue) injectFault(ctx context.Context, method string, request any) error {
+	if err := q.faultRegistry.Inject(ctx, config.QueueName, method, request); err != nil {
+		return err
+	}
+	return generateForMethod(q.ErrorGenerator, method)
+}
+
 func NewFaultInjectionExecutionStore(
 	rate float64,
 	executionStore persistence.ExecutionStore,
@@ -451,7 +470,7 @@ func (e *FaultInjectionExecutionStore) GetWorkflowExecution(
 	ctx context.Context,
 	request *persistence.GetWorkflowExecutionRequest,
 ) (*persistence.InternalGetWorkflowExecutionResponse, error) {
-	if err := e.ErrorGenerator.Generate(); err != nil {
+	if err := e.injectFault(ctx, "GetWorkflowExecution", request); err != nil {
 		return nil, err
 	}
 	return e.baseExecutionStore.GetWorkflowExecution(ctx, request)
@@ -461,7 +480,7 @@ func (e *FaultInjectionExecutionStore) SetWorkflowExecution(
 	ctx context.Context,
 	request *persistence.InternalSetWorkflowExecutionRequest,
 ) error {
-	if err := e.ErrorGenerator.Generate(); err != nil {
+	if err := e.injectFault(ctx, "SetWorkflowExecution", request); err != nil {
 		return err
 	}
 	return e.baseExecutionStore.SetWorkflowExecution(ctx, request)
@@ -471,7 +490,7 @@ func (e *FaultInjectionExecutionStore) UpdateWorkflowExecution(
 	ctx context.Context,
 	request *persistence.InternalUpdateWorkflowExecutionRequest,
 ) error {
-	if err := e.ErrorGenerator.Generate(); err != nil {
+	if err := e.injectFault(ctx, "UpdateWorkflowExecution", request); err != nil {
 		return err
 	}
 	return e.baseExecutionStore.UpdateWorkflowExecution(ctx, request)
@@ -481,7 +500,7 @@ func (e *FaultInjectionExecutionStore) ConflictResolveWorkflowExecution(
 	ctx context.Context,
 	request *persistence.InternalConflictResolveWorkflowExecutionRequest,
 ) error {
-	if err := e.ErrorGenerator.Generate(); err != nil {
+	if err := e.injectFault(ctx, "ConflictResolveWorkflowExecution", request); err != nil {
 		return err
 	}
 	return e.baseExecutionStore.ConflictResolveWorkflowExecution(ctx, request)
@@ -491,7 +510,7 @@ func (e *FaultInjectionExecutionStore) CreateWorkflowExecution(
 	ctx context.Context,
 	request *persistence.InternalCreateWorkflowExecutionRequest,
 ) (*persistence.InternalCreateWorkflowExecutionResponse, error) {
-	if err := e.ErrorGenerator.Generate(); err != nil {
+	if err := e.injectFault(ctx, "CreateWorkflowExecution", request); err != nil {
 		return nil, err
 	}
 	return e.baseExecutionStore.CreateWorkflowExecution(ctx, request)
@@ -501,7 +520,7 @@ func (e *FaultInjectionExecutionStore) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *persistence.DeleteWorkflowExecutionRequest,
 ) error {
-	if err := e.ErrorGenerator.Generate(); err != nil {
+	if err := e.injectFault(ctx, "DeleteWorkflowExecution", request); err != nil {
 		return err
 	}
 	return e.baseExecutionStore.DeleteWorkflowExecution(ctx, request)
@@ -511,7 +530,7 @@ func (e *FaultInjectionExecutionStore) DeleteCurrentWorkflowExecution(
 	ctx context.Context,
 	request *persistence.DeleteCurrentWorkflowExecutionRequest,
 ) error {
-	if err := e.ErrorGenerator.Generate(); err != nil {
+	if err := e.injectFault(ctx, "DeleteCurrentWorkflowExecution", request); err != nil {
 		return err
 	}
 	return e.baseExecutionStore.DeleteCurrentWorkflowExecution(ctx, request)
@@ -521,7 +540,7 @@ func (e *FaultInjectionExecutionStore) GetCurrentExecution(
 	ctx context.Context,
 	request *persistence.GetCurrentExecutionRequest,
 ) (*persistence.InternalGetCurrentExecutionResponse, error) {
-	if err := e.ErrorGenerator.Generate(); err != nil {
+	if err := e.injectFault(ctx, "GetCurrentExecution", request); err != nil {
 		return nil, err
 	}
 	return e.baseExecutionStore.GetCurrentExecution(ctx, request)
@@ -531,7 +550,7 @@ func (e *FaultInjectionExecutionStore) ListConcreteExecutions(
 	ctx context.Context,
 	request *persistence.ListConcreteExecutionsRequest,
 ) (*persistence.InternalListConcreteExecutionsResponse, error) {
-	if err := e.ErrorGenerator.Generate(); err != nil {
+	if err := e.injectFault(ctx, "ListConcreteExecutions", request); err != nil {
 		return nil, err
 	}
 	return e.baseExecutionStore.ListConcreteExecutions(ctx, request)
@@ -541,7 +560,7 @@ func (e *FaultInjectionExecutionStore) AddHistoryTasks(
 	ctx context.Context,
 	request *persistence.InternalAddHistoryTasksRequest,
 ) error {
-	if err := e.ErrorGenerator.Generate(); err != nil {
+	if err := e.injectFault(ctx, "AddHistoryTasks", request); err != nil {
 		return err
 	}
 	return e.baseExecutionStore.AddHistoryTasks(ctx, request)
@@ -551,7 +570,7 @@ func (e *FaultInjectionExecutionStore) GetHistoryTask(
 	ctx context.Context,
 	request *persistence.GetHistoryTaskRequest,
 ) (*persistence.InternalGetHistoryTaskResponse, error) {
-	if err := e.ErrorGenerator.Generate(); err != nil {
+	if err := e.injectFault(ctx, "GetHistoryTask", request); err != nil {
 		return nil, err
 	}
 	return e.baseExecutionStore.GetHistoryTask(ctx, request)
@@ -561,7 +580,7 @@ func (e *FaultInjectionExecutionStore) GetHistoryTasks(
 	ctx context.Context,
 	request *persistence.GetHistoryTasksRequest,
 ) (*persistence.InternalGetHistoryTasksResponse, error) {
-	if err := e.ErrorGenerator.Generate(); err != nil {
+	if err := e.injectFault(ctx, "GetHistoryTasks", request); err != nil {
 		return nil, err
 	}
 	return e.baseExecutionStore.GetHistoryTasks(ctx, request)
@@ -571,7 +590,7 @@ func (e *FaultInjectionExecutionStore) CompleteHistoryTask(
 	ctx context.Context,
 	request *persistence.CompleteHistoryTaskRequest,
 ) error {
-	if err := e.ErrorGenerator.Generate(); err != nil {
+	if err := e.injectFault(ctx, "CompleteHistoryTask", request); err != nil {
 		return err
 	}
 	return e.baseExecutionStore.CompleteHistoryTask(ctx, request)
@@ -581,7 +600,7 @@ func (e *FaultInjectionExecutionStore) RangeCompleteHistoryTasks(
 	ctx context.Context,
 	request *persistence.RangeCompleteHistoryTasksRequest,
 ) error {
-	if err := e.ErrorGenerator.Generate(); err != nil {
+	if err := e.injectFault(ctx, "RangeCompleteHistoryTasks", request); err != nil {
 		return err
 	}
 	return e.baseExecutionStore.RangeCompleteHistoryTasks(ctx, request)
@@ -591,7 +610,7 @@ func (e *FaultInjectionExecutionStore) PutReplicationTaskToDLQ(
 	ctx context.Context,
 	request *persistence.PutReplicationTaskToDLQRequest,
 ) error {
-	if err := e.ErrorGenerator.Generate(); err != nil {
+	if err := e.injectFault(ctx, "PutReplicationTaskToDLQ", request); err != nil {
 		return err
 	}
 	return e.baseExecutionStore.PutReplicationTaskToDLQ(ctx, request)
@@ -604,7 +623,7 @@ func (e *FaultInjectionExecutionStore) GetReplicationTasksFromDLQ(
 	*persistence.InternalGetHistoryTasksResponse,
 	error,
 ) {
-	if err := e.ErrorGenerator.Generate(); err != nil {
+	if err := e.injectFault(ctx, "GetReplicationTasksFromDLQ", request); err != nil {
 		return nil, err
 	}
 	return e.baseExecutionStore.GetReplicationTasksFromDLQ(ctx, request)
@@ -614,7 +633,7 @@ func (e *FaultInjectionExecutionStore) DeleteReplicationTaskFromDLQ(
 	ctx context.Context,
 	request *persistence.DeleteReplicationTaskFromDLQRequest,
 ) error {
-	if err := e.ErrorGenerator.Generate(); err != nil {
+	if err := e.injectFault(ctx, "DeleteReplicationTaskFromDLQ", request); err != nil {
 		return err
 	}
 	return e.baseExecutionStore.DeleteReplicationTaskFromDLQ(ctx, request)
@@ -624,7 +643,7 @@ func (e *FaultInjectionExecutionStore) RangeDeleteReplicationTaskFromDLQ(
 	ctx context.Context,
 	request *persistence.RangeDeleteReplicationTaskFromDLQRequest,
 ) error {
-	if err := e.ErrorGenerator.Generate(); err != nil {
+	if err := e.injectFault(ctx, "RangeDeleteReplicationTaskFromDLQ", request); err != nil {
 		return err
 	}
 	return e.baseExecutionStore.RangeDeleteReplicationTaskFromDLQ(ctx, request)
@@ -634,7 +653,7 @@ func (e *FaultInjectionExecutionStore) AppendHistoryNodes(
 	ctx context.Context,
 	request *persistence.InternalAppendHistoryNodesRequest,
 ) error {
-	if err := e.ErrorGenerator.Generate(); err != nil {
+	if err := e.injectFault(ctx, "AppendHistoryNodes", request); err != nil {
 		return err
 	}
 	return e.baseExecutionStore.AppendHistoryNodes(ctx, request)
@@ -644,7 +663,7 @@ func (e *FaultInjectionExecutionStore) DeleteHistoryNodes(
 	ctx context.Context,
 	request *persistence.InternalDeleteHistoryNodesRequest,
 ) error {
-	if err := e.ErrorGenerator.Generate(); err != nil {
+	if err := e.injectFault(ctx, "DeleteHistoryNodes", request); err != nil {
 		return err
 	}
 	return e.baseExecutionStore.DeleteHistoryNodes(ctx, request)
@@ -654,7 +673,7 @@ func (e *FaultInjectionExecutionStore) ParseHistoryBranchInfo(
 	ctx context.Context,
 	request *persistence.ParseHistoryBranchInfoRequest,
 ) (*persistence.ParseHistoryBranchInfoResponse, error) {
-	if err := e.ErrorGenerator.Generate(); err != nil {
+	if err := e.injectFault(ctx, "ParseHistoryBranchInfo", request); err != nil {
 		return nil, err
 	}
 	return e.baseExecutionStore.ParseHistoryBranchInfo(ctx, request)
@@ -664,7 +683,7 @@ func (e *FaultInjectionExecutionStore) UpdateHistoryBranchInfo(
 	ctx context.Context,
 	request *persistence.UpdateHistoryBranchInfoRequest,
 ) (*persistence.UpdateHistoryBranchInfoResponse, error) {
-	if err := e.ErrorGenerator.Generate(); err != nil {
+	if err := e.injectFault(ctx, "UpdateHistoryBranchInfo", request); err != nil {
 		return nil, err
 	}
 	return e.baseExecutionStore.UpdateHistoryBranchInfo(ctx, request)
@@ -674,7 +693,7 @@ func (e *FaultInjectionExecutionStore) NewHistoryBranch(
 	ctx context.Context,
 	request *persistence.NewHistoryBranchRequest,
 ) (*persistence.NewHistoryBranchResponse, error) {
-	if err := e.ErrorGenerator.Generate(); err != nil {
+	if err := e.injectFault(ctx, "NewHistoryBranch", request); err != nil {
 		return nil, err
 	}
 	return e.baseExecutionStore.NewHistoryBranch(ctx, request)
@@ -684,7 +703,7 @@ func (e *FaultInjectionExecutionStore) ReadHistoryBranch(
 	ctx context.Context,
 	request *persistence.InternalReadHistoryBranchRequest,
 ) (*persistence.InternalReadHistoryBranchResponse, error) {
-	if err := e.ErrorGenerator.Generate(); err != nil {
+	if err := e.injectFault(ctx, "ReadHistoryBranch", request); err != nil {
 		return nil, err
 	}
 	return e.baseExecutionStore.ReadHistoryBranch(ctx, request)
@@ -694,7 +713,7 @@ func (e *FaultInjectionExecutionStore) ForkHistoryBranch(
 	ctx context.Context,
 	request *persistence.InternalForkHistoryBranchRequest,
 ) error {
-	if err := e.ErrorGenerator.Generate(); err != nil {
+	if err := e.injectFault(ctx, "ForkHistoryBranch", request); err != nil {
 		return err
 	}
 	return e.baseExecutionStore.ForkHistoryBranch(ctx, request)
@@ -704,7 +723,7 @@ func (e *FaultInjectionExecutionStore) DeleteHistoryBranch(
 	ctx context.Context,
 	request *persistence.InternalDeleteHistoryBranchRequest,
 ) error {
-	if err := e.ErrorGenerator.Generate(); err != nil {
+	if err := e.injectFault(ctx, "DeleteHistoryBranch", request); err != nil {
 		return err
 	}
 	return e.baseExecutionStore.DeleteHistoryBranch(ctx, request)
@@ -714,7 +733,7 @@ func (e *FaultInjectionExecutionStore) GetHistoryTree(
 	ctx context.Context,
 	request *persistence.GetHistoryTreeRequest,
 ) (*persistence.InternalGetHistoryTreeResponse, error) {
-	if err := e.ErrorGenerator.Generate(); err != nil {
+	if err := e.injectFault(ctx, "GetHistoryTree", request); err != nil {
 		return nil, err
 	}
 	return e.baseExecutionStore.GetHistoryTree(ctx, request)
@@ -724,7 +743,7 @@ func (e *FaultInjectionExecutionStore) GetAllHistoryTreeBranches(
 	ctx context.Context,
 	request *persistence.GetAllHistoryTreeBranchesRequest,
 ) (*persistence.InternalGetAllHistoryTreeBranchesResponse, error) {
-	if err := e.ErrorGenerator.Generate(); err != nil {
+	if err := e.injectFault(ctx, "GetAllHistoryTreeBranches", request); err != nil {
 		return nil, err
 	}
 	return e.baseExecutionStore.GetAllHistoryTreeBranches(ctx, request)
@@ -734,6 +753,13 @@ func (e *FaultInjectionExecutionStore) UpdateRate(rate float64) {
 	e.ErrorGenerator.UpdateRate(rate)
 }
 
+func (e *FaultInjectionExecutionStore) injectFault(ctx context.Context, method string, request any) error {
+	if err := e.faultRegistry.Inject(ctx, config.ExecutionStoreName, method, request); err != nil {
+		return err
+	}
+	return generateForMethod(e.ErrorGenerator, method)
+}
+
 func NewFaultInjectionClusterMetadataStore(
 	rate float64,
 	baseStore persistence.ClusterMetadataStore,
@@ -850,7 +876,7 @@ func (m *FaultInjectionMetadataStore) CreateNamespace(
 	ctx context.Context,
 	request *persistence.InternalCreateNamespaceRequest,
 ) (*persistence.CreateNamespaceResponse, error) {
-	if err := m.ErrorGenerator.Generate(); err != nil {
+	if err := m.injectFault(ctx, "CreateNamespace", request); err != nil {
 		return nil, err
 	}
 	return m.baseMetadataStore.CreateNamespace(ctx, request)
@@ -860,7 +886,7 @@ func (m *FaultInjectionMetadataStore) GetNamespace(
 	ctx context.Context,
 	request *persistence.GetNamespaceRequest,
 ) (*persistence.InternalGetNamespaceResponse, error) {
-	if err := m.ErrorGenerator.Generate(); err != nil {
+	if err := m.injectFault(ctx, "GetNamespace", request); err != nil {
 		return nil, err
 	}
 	return m.baseMetadataStore.GetNamespace(ctx, request)
@@ -870,7 +896,7 @@ func (m *FaultInjectionMetadataStore) UpdateNamespace(
 	ctx context.Context,
 	request *persistence.InternalUpdateNamespaceRequest,
 ) error {
-	if err := m.ErrorGenerator.Generate(); err != nil {
+	if err := m.injectFault(ctx, "UpdateNamespace", request); err != nil {
 		return err
 	}
 	return m.baseMetadataStore.UpdateNamespace(ctx, request)
@@ -880,7 +906,7 @@ func (m *FaultInjectionMetadataStore) RenameNamespace(
 	ctx context.Context,
 	request *persistence.InternalRenameNamespaceRequest,
 ) error {
-	if err := m.ErrorGenerator.Generate(); err != nil {
+	if err := m.injectFault(ctx, "RenameNamespace", request); err != nil {
 		return err
 	}
 	return m.baseMetadataStore.RenameNamespace(ctx, request)
@@ -890,7 +916,7 @@ func (m *FaultInjectionMetadataStore) DeleteNamespace(
 	ctx context.Context,
 	request *persistence.DeleteNamespaceRequest,
 ) error {
-	if err := m.ErrorGenerator.Generate(); err != nil {
+	if err := m.injectFault(ctx, "DeleteNamespace", request); err != nil {
 		return err
 	}
 	return m.baseMetadataStore.DeleteNamespace(ctx, request)
@@ -900,7 +926,7 @@ func (m *FaultInjectionMetadataStore) DeleteNamespaceByName(
 	ctx context.Context,
 	request *persistence.DeleteNamespaceByNameRequest,
 ) error {
-	if err := m.ErrorGenerator.Generate(); err != nil {
+	if err := m.injectFault(ctx, "DeleteNamespaceByName", request); err != nil {
 		return err
 	}
 	return m.baseMetadataStore.DeleteNamespaceByName(ctx, request)
@@ -910,7 +936,7 @@ func (m *FaultInjectionMetadataStore) ListNamespaces(
 	ctx context.Context,
 	request *persistence.InternalListNamespacesRequest,
 ) (*persistence.InternalListNamespacesResponse, error) {
-	if err := m.ErrorGenerator.Generate(); err != nil {
+	if err := m.injectFault(ctx, "ListNamespaces", request); err != nil {
 		return nil, err
 	}
 	return m.baseMetadataStore.ListNamespaces(ctx, request)
@@ -919,7 +945,7 @@ func (m *FaultInjectionMetadataStore) ListNamespaces(
 func (m *FaultInjectionMetadataStore) GetMetadata(
 	ctx context.Context,
 ) (*persistence.GetMetadataResponse, error) {
-	if err := m.ErrorGenerator.Generate(); err != nil {
+	if err := m.injectFault(ctx, "GetMetadata", nil); err != nil {
 		return nil, err
 	}
 	return m.baseMetadataStore.GetMetadata(ctx)
@@ -929,6 +955,13 @@ func (m *FaultInjectionMetadataStore) UpdateRate(rate float64) {
 	m.ErrorGenerator.UpdateRate(rate)
 }
 
+func (m *FaultInjectionMetadataStore) injectFault(ctx context.Context, method string, request any) error {
+	if err := m.faultRegistry.Inject(ctx, config.MetadataStoreName, method, request); err != nil {
+		return err
+	}
+	return generateForMethod(m.ErrorGenerator, method)
+}
+
 func NewFaultInjectionTaskStore(
 	rate float64,
 	baseTaskStore persistence.TaskStore,
@@ -953,7 +986,7 @@ func (t *FaultInjectionTaskStore) CreateTaskQueue(
 	ctx context.Context,
 	request *persistence.InternalCreateTaskQueueRequest,
 ) error {
-	if err := t.ErrorGenerator.Generate(); err != nil {
+	if err := t.injectFault(ctx, "CreateTaskQueue", request); err != nil {
 		return err
 	}
 	return t.baseTaskStore.CreateTaskQueue(ctx, request)
@@ -963,7 +996,7 @@ func (t *FaultInjectionTaskStore) GetTaskQueue(
 	ctx context.Context,
 	request *persistence.InternalGetTaskQueueRequest,
 ) (*persistence.InternalGetTaskQueueResponse, error) {
-	if err := t.ErrorGenerator.Generate(); err != nil {
+	if err := t.injectFault(ctx, "GetTaskQueue", request); err != nil {
 		return nil, err
 	}
 	return t.baseTaskStore.GetTaskQueue(ctx, request)
@@ -973,7 +1006,7 @@ func (t *FaultInjectionTaskStore) UpdateTaskQueue(
 	ctx context.Context,
 	request *persistence.InternalUpdateTaskQueueRequest,
 ) (*persistence.UpdateTaskQueueResponse, error) {
-	if err := t.ErrorGenerator.Generate(); err != nil {
+	if err := t.injectFault(ctx, "UpdateTaskQueue", request); err != nil {
 		return nil, err
 	}
 	return t.baseTaskStore.UpdateTaskQueue(ctx, request)
@@ -983,7 +1016,7 @@ func (t *FaultInjectionTaskStore) ListTaskQueue(
 	ctx context.Context,
 	request *persistence.ListTaskQueueRequest,
 ) (*persistence.InternalListTaskQueueResponse, error) {
-	if err := t.ErrorGenerator.Generate(); err != nil {
+	if err := t.injectFault(ctx, "ListTaskQueue", request); err != nil {
 		return nil, err
 	}
 	return t.baseTaskStore.ListTaskQueue(ctx, request)
@@ -993,7 +1026,7 @@ func (t *FaultInjectionTaskStore) DeleteTaskQueue(
 	ctx context.Context,
 	request *persistence.DeleteTaskQueueRequest,
 ) error {
-	if err := t.ErrorGenerator.Generate(); err != nil {
+	if err := t.injectFault(ctx, "DeleteTaskQueue", request); err != nil {
 		return err
 	}
 	return t.baseTaskStore.DeleteTaskQueue(ctx, request)
@@ -1003,7 +1036,7 @@ func (t *FaultInjectionTaskStore) CreateTasks(
 	ctx context.Context,
 	request *persistence.InternalCreateTasksRequest,
 ) (*persistence.CreateTasksResponse, error) {
-	if err := t.ErrorGenerator.Generate(); err != nil {
+	if err := t.injectFault(ctx, "CreateTasks", request); err != nil {
 		return nil, err
 	}
 	return t.baseTaskStore.CreateTasks(ctx, request)
@@ -1013,7 +1046,7 @@ func (t *FaultInjectionTaskStore) GetTasks(
 	ctx context.Context,
 	request *persistence.GetTasksRequest,
 ) (*persistence.InternalGetTasksResponse, error) {
-	if err := t.ErrorGenerator.Generate(); err != nil {
+	if err := t.injectFault(ctx, "GetTasks", request); err != nil {
 		return nil, err
 	}
 	return t.baseTaskStore.GetTasks(ctx, request)
@@ -1023,7 +1056,7 @@ func (t *FaultInjectionTaskStore) CompleteTask(
 	ctx context.Context,
 	request *persistence.CompleteTaskRequest,
 ) error {
-	if err := t.ErrorGenerator.Generate(); err != nil {
+	if err := t.injectFault(ctx, "CompleteTask", request); err != nil {
 		return err
 	}
 	return t.baseTaskStore.CompleteTask(ctx, request)
@@ -1033,7 +1066,7 @@ func (t *FaultInjectionTaskStore) CompleteTasksLessThan(
 	ctx context.Context,
 	request *persistence.CompleteTasksLessThanRequest,
 ) (int, error) {
-	if err := t.ErrorGenerator.Generate(); err != nil {
+	if err := t.injectFault(ctx, "CompleteTasksLessThan", request); err != nil {
 		return 0, err
 	}
 	return t.baseTaskStore.CompleteTasksLessThan(ctx, request)
@@ -1043,6 +1076,13 @@ func (t *FaultInjectionTaskStore) UpdateRate(rate float64) {
 	t.ErrorGenerator.UpdateRate(rate)
 }
 
+func (t *FaultInjectionTaskStore) injectFault(ctx context.Context, method string, request any) error {
+	if err := t.faultRegistry.Inject(ctx, config.TaskStoreName, method, request); err != nil {
+		return err
+	}
+	return generateForMethod(t.ErrorGenerator, method)
+}
+
 func NewFaultInjectionShardStore(
 	rate float64,
 	baseShardStore persistence.ShardStore,
@@ -1082,7 +1122,7 @@ func (s *FaultInjectionShardStore) GetOrCreateShard(
 	ctx context.Context,
 	request *persistence.InternalGetOrCreateShardRequest,
 ) (*persistence.InternalGetOrCreateShardResponse, error) {
-	if err := s.ErrorGenerator.Generate(); err != nil {
+	if err := s.injectFault(ctx, "GetOrCreateShard", request); err != nil {
 		return nil, err
 	}
 	return s.baseShardStore.GetOrCreateShard(ctx, request)
@@ -1092,7 +1132,7 @@ func (s *FaultInjectionShardStore) UpdateShard(
 	ctx context.Context,
 	request *persistence.InternalUpdateShardRequest,
 ) error {
-	if err := s.ErrorGenerator.Generate(); err != nil {
+	if err := s.injectFault(ctx, "UpdateShard", request); err != nil {
 		return err
 	}
 	return s.baseShardStore.UpdateShard(ctx, request)
@@ -1102,7 +1142,7 @@ func (s *FaultInjectionShardStore) AssertShardOwnership(
 	ctx context.Context,
 	request *persistence.AssertShardOwnershipRequest,
 ) error {
-	if err := s.ErrorGenerator.Generate(); err != nil {
+	if err := s.injectFault(ctx, "AssertShardOwnership", request); err != nil {
 		return err
 	}
 	return s.baseShardStore.AssertShardOwnership(ctx, request)
@@ -1111,3 +1151,10 @@ func (s *FaultInjectionShardStore) AssertShardOwnership(
 func (s *FaultInjectionShardStore) UpdateRate(rate float64) {
 	s.ErrorGenerator.UpdateRate(rate)
 }
+
+func (s *FaultInjectionShardStore) injectFault(ctx context.Context, method string, request any) error {
+	if err := s.faultRegistry.Inject(ctx, config.ShardStoreName, method, request); err != nil {
+		return err
+	}
+	return generateForMethod(s.ErrorGenerator, method)
+}
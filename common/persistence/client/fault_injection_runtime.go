@@ -0,0 +1,298 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"reflect"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/internal/goro"
+)
+
+const (
+	persistenceFaultRefreshInterval = 10 * time.Second
+	persistenceFaultRefreshTimeout  = 10 * time.Second
+)
+
+type (
+	// PersistenceFaultRegistry holds the persistence faults set at runtime with the SetPersistenceFault admin API.
+	// The faults are stored in the cluster metadata and reloaded periodically, so that every host injects the
+	// faults targeting its service without a restart.
+	PersistenceFaultRegistry struct {
+		status          int32
+		serviceName     primitives.ServiceName
+		refreshInterval dynamicconfig.DurationPropertyFn
+		timeSource      clock.TimeSource
+		logger          log.Logger
+
+		clusterMetadataManager persistence.ClusterMetadataManager
+		refresher              *goro.Handle
+		// faults is a []*runtimeFault, replaced as a whole on every refresh
+		faults atomic.Value
+	}
+
+	runtimeFault struct {
+		fault          *persistencespb.PersistenceFault
+		errorGenerator ErrorGenerator
+	}
+)
+
+func NewPersistenceFaultRegistry(
+	serviceName primitives.ServiceName,
+	refreshInterval dynamicconfig.DurationPropertyFn,
+	timeSource clock.TimeSource,
+	logger log.Logger,
+) *PersistenceFaultRegistry {
+	return &PersistenceFaultRegistry{
+		status:          common.DaemonStatusInitialized,
+		serviceName:     serviceName,
+		refreshInterval: refreshInterval,
+		timeSource:      timeSource,
+		logger:          logger,
+	}
+}
+
+// Start loads the faults from the cluster metadata and keeps refreshing them. The cluster metadata manager is given
+// here rather than to the constructor, since it is built on top of the data stores the faults are injected into.
+func (r *PersistenceFaultRegistry) Start(clusterMetadataManager persistence.ClusterMetadataManager) {
+	if !atomic.CompareAndSwapInt32(&r.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+
+	r.clusterMetadataManager = clusterMetadataManager
+	ctx := headers.SetCallerInfo(
+		context.Background(),
+		headers.SystemBackgroundCallerInfo,
+	)
+	if err := r.refresh(ctx); err != nil {
+		r.logger.Warn("Unable to load persistence faults", tag.Error(err))
+	}
+	r.refresher = goro.NewHandle(ctx).Go(r.refreshLoop)
+}
+
+func (r *PersistenceFaultRegistry) Stop() {
+	if !atomic.CompareAndSwapInt32(&r.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+
+	r.refresher.Cancel()
+	<-r.refresher.Done()
+}
+
+func (r *PersistenceFaultRegistry) refreshLoop(ctx context.Context) error {
+	timer := time.NewTimer(r.refreshInterval())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-timer.C:
+			if err := r.refresh(ctx); err != nil {
+				r.logger.Warn("Unable to refresh persistence faults", tag.Error(err))
+			}
+			timer.Reset(r.refreshInterval())
+		}
+	}
+}
+
+func (r *PersistenceFaultRegistry) refresh(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, persistenceFaultRefreshTimeout)
+	defer cancel()
+
+	resp, err := r.clusterMetadataManager.GetCurrentClusterMetadata(ctx)
+	if err != nil {
+		return err
+	}
+	r.UpdateFaults(resp.PersistenceFaults)
+	return nil
+}
+
+// UpdateFaults replaces the injected faults with the ones of the given faults that target this service and have
+// not expired. Invalid faults are skipped.
+func (r *PersistenceFaultRegistry) UpdateFaults(faults []*persistencespb.PersistenceFault) {
+	now := r.timeSource.Now()
+	var runtimeFaults []*runtimeFault
+	for _, fault := range faults {
+		if fault.Service != "" && fault.Service != string(r.serviceName) {
+			continue
+		}
+		if !now.Before(timestamp.TimeValue(fault.ExpireTime)) {
+			continue
+		}
+		if err := ValidatePersistenceFault(fault); err != nil {
+			r.logger.Warn("Skipping invalid persistence fault", tag.Value(fault.Id), tag.Error(err))
+			continue
+		}
+		runtimeFaults = append(runtimeFaults, newRuntimeFault(fault))
+	}
+	if len(runtimeFaults) > 0 || len(r.loadFaults()) > 0 {
+		r.logger.Info("Persistence faults updated", tag.Counter(len(runtimeFaults)))
+	}
+	r.faults.Store(runtimeFaults)
+}
+
+func (r *PersistenceFaultRegistry) loadFaults() []*runtimeFault {
+	faults, _ := r.faults.Load().([]*runtimeFault)
+	return faults
+}
+
+// Inject sleeps for the latency and returns the error of the faults matching a call of the given data store method.
+// Faults are never injected into the cluster metadata store, which is used to remove them.
+func (r *PersistenceFaultRegistry) Inject(
+	ctx context.Context,
+	dataStore config.DataStoreName,
+	method string,
+	request any,
+) error {
+	if r == nil || dataStore == config.ClusterMDStoreName {
+		return nil
+	}
+	faults := r.loadFaults()
+	if len(faults) == 0 {
+		return nil
+	}
+
+	now := r.timeSource.Now()
+	for _, f := range faults {
+		if !f.matches(ctx, now, dataStore, method, request) {
+			continue
+		}
+		if latency := timestamp.DurationValue(f.fault.Latency); latency > 0 {
+			timer := time.NewTimer(latency)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return ctx.Err()
+			case <-timer.C:
+			}
+		}
+		if err := f.errorGenerator.Generate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidatePersistenceFault returns an InvalidArgument error if the fault cannot be injected.
+func ValidatePersistenceFault(fault *persistencespb.PersistenceFault) error {
+	if fault == nil {
+		return serviceerror.NewInvalidArgument("Persistence fault is not set.")
+	}
+	switch config.DataStoreName(fault.DataStore) {
+	case "", config.ShardStoreName, config.TaskStoreName, config.MetadataStoreName, config.ExecutionStoreName, config.QueueName:
+	default:
+		return serviceerror.NewInvalidArgument("Persistence fault data store is unknown or cannot be faulted: " + fault.DataStore)
+	}
+	if fault.ErrorRate < 0 || fault.ErrorRate > 1 {
+		return serviceerror.NewInvalidArgument("Persistence fault error rate must be between 0 and 1.")
+	}
+	if fault.ErrorType != "" && lookupErrorFromName(fault.ErrorType) == nil {
+		return serviceerror.NewInvalidArgument("Persistence fault error type is unknown: " + fault.ErrorType)
+	}
+	if timestamp.DurationValue(fault.Latency) < 0 {
+		return serviceerror.NewInvalidArgument("Persistence fault latency must not be negative.")
+	}
+	if fault.ErrorRate == 0 && timestamp.DurationValue(fault.Latency) == 0 {
+		return serviceerror.NewInvalidArgument("Persistence fault must set an error rate or a latency.")
+	}
+	return nil
+}
+
+func newRuntimeFault(fault *persistencespb.PersistenceFault) *runtimeFault {
+	errorWeights := defaultErrors
+	if fault.ErrorType != "" {
+		err := lookupErrorFromName(fault.ErrorType)
+		errorWeights = []FaultWeight{
+			{
+				errFactory: func(string) error { return err },
+				weight:     1,
+			},
+		}
+	}
+	return &runtimeFault{
+		fault:          fault,
+		errorGenerator: NewDefaultErrorGenerator(fault.ErrorRate, errorWeights),
+	}
+}
+
+func (f *runtimeFault) matches(
+	ctx context.Context,
+	now time.Time,
+	dataStore config.DataStoreName,
+	method string,
+	request any,
+) bool {
+	fault := f.fault
+	if !now.Before(timestamp.TimeValue(fault.ExpireTime)) {
+		return false
+	}
+	if fault.DataStore != "" && fault.DataStore != string(dataStore) {
+		return false
+	}
+	if fault.Method != "" && fault.Method != method {
+		return false
+	}
+	if fault.Namespace != "" && fault.Namespace != headers.GetCallerInfo(ctx).CallerName {
+		return false
+	}
+	if fault.ShardId != 0 && fault.ShardId != requestShardID(request) {
+		return false
+	}
+	return true
+}
+
+// requestShardID returns the ShardID field of a persistence request, or 0 if the request does not have one.
+// Reflection is only used when a fault targets a shard.
+func requestShardID(request any) int32 {
+	v := reflect.ValueOf(request)
+	if v.Kind() != reflect.Pointer || v.IsNil() {
+		return 0
+	}
+	v = v.Elem()
+	if v.Kind() != reflect.Struct {
+		return 0
+	}
+	field := v.FieldByName("ShardID")
+	if !field.IsValid() || field.Kind() != reflect.Int32 {
+		return 0
+	}
+	return int32(field.Int())
+}
@@ -0,0 +1,212 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/mock"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+type shardStoreFactory struct {
+	DataStoreFactory
+	store persistence.ShardStore
+}
+
+func (f *shardStoreFactory) NewShardStore() (persistence.ShardStore, error) {
+	return f.store, nil
+}
+
+func newTestPersistenceFaultRegistry(timeSource clock.TimeSource) *PersistenceFaultRegistry {
+	return NewPersistenceFaultRegistry(
+		primitives.HistoryService,
+		dynamicconfig.GetDurationPropertyFn(time.Minute),
+		timeSource,
+		log.NewNoopLogger(),
+	)
+}
+
+func TestPersistenceFaultRegistry_Filters(t *testing.T) {
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	registry := newTestPersistenceFaultRegistry(timeSource)
+	registry.UpdateFaults([]*persistencespb.PersistenceFault{
+		{
+			Id:         "fault",
+			Service:    string(primitives.HistoryService),
+			DataStore:  string(config.ExecutionStoreName),
+			Method:     "UpdateWorkflowExecution",
+			Namespace:  "test-namespace",
+			ShardId:    3,
+			ErrorRate:  1,
+			ErrorType:  "ShardOwnershipLostError",
+			ExpireTime: timestamp.TimePtr(timeSource.Now().Add(time.Minute)),
+		},
+	})
+
+	ctx := headers.SetCallerInfo(context.Background(), headers.NewBackgroundCallerInfo("test-namespace"))
+	request := &persistence.InternalUpdateWorkflowExecutionRequest{ShardID: 3}
+
+	err := registry.Inject(ctx, config.ExecutionStoreName, "UpdateWorkflowExecution", request)
+	require.IsType(t, &persistence.ShardOwnershipLostError{}, err)
+
+	otherNamespaceCtx := headers.SetCallerInfo(context.Background(), headers.NewBackgroundCallerInfo("other-namespace"))
+	require.NoError(t, registry.Inject(otherNamespaceCtx, config.ExecutionStoreName, "UpdateWorkflowExecution", request))
+	require.NoError(t, registry.Inject(ctx, config.ExecutionStoreName, "UpdateWorkflowExecution", &persistence.InternalUpdateWorkflowExecutionRequest{ShardID: 4}))
+	require.NoError(t, registry.Inject(ctx, config.ExecutionStoreName, "GetWorkflowExecution", request))
+	require.NoError(t, registry.Inject(ctx, config.ShardStoreName, "UpdateWorkflowExecution", request))
+	require.NoError(t, registry.Inject(ctx, config.ExecutionStoreName, "UpdateWorkflowExecution", nil))
+
+	timeSource.Update(timeSource.Now().Add(time.Minute))
+	require.NoError(t, registry.Inject(ctx, config.ExecutionStoreName, "UpdateWorkflowExecution", request))
+}
+
+func TestPersistenceFaultRegistry_UpdateFaults(t *testing.T) {
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	registry := newTestPersistenceFaultRegistry(timeSource)
+	expireTime := timestamp.TimePtr(timeSource.Now().Add(time.Minute))
+	registry.UpdateFaults([]*persistencespb.PersistenceFault{
+		{Id: "other-service", Service: string(primitives.MatchingService), ErrorRate: 1, ExpireTime: expireTime},
+		{Id: "expired", ErrorRate: 1, ExpireTime: timestamp.TimePtr(timeSource.Now())},
+		{Id: "no-expiration", ErrorRate: 1},
+		{Id: "invalid", ErrorRate: 2, ExpireTime: expireTime},
+		{Id: "all-services", ErrorRate: 1, ErrorType: "UnavailableError", ExpireTime: expireTime},
+	})
+
+	faults := registry.loadFaults()
+	require.Len(t, faults, 1)
+	require.Equal(t, "all-services", faults[0].fault.Id)
+	err := registry.Inject(context.Background(), config.TaskStoreName, "CreateTasks", nil)
+	require.IsType(t, &serviceerror.Unavailable{}, err)
+	require.NoError(t, registry.Inject(context.Background(), config.ClusterMDStoreName, "SaveClusterMetadata", nil))
+
+	registry.UpdateFaults(nil)
+	require.NoError(t, registry.Inject(context.Background(), config.TaskStoreName, "CreateTasks", nil))
+}
+
+func TestPersistenceFaultRegistry_Latency(t *testing.T) {
+	registry := newTestPersistenceFaultRegistry(clock.NewRealTimeSource())
+	registry.UpdateFaults([]*persistencespb.PersistenceFault{
+		{
+			Id:         "latency",
+			Latency:    timestamp.DurationPtr(50 * time.Millisecond),
+			ExpireTime: timestamp.TimePtr(time.Now().Add(time.Minute)),
+		},
+	})
+
+	start := time.Now()
+	require.NoError(t, registry.Inject(context.Background(), config.QueueName, "ReadMessages", nil))
+	require.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.ErrorIs(t, registry.Inject(ctx, config.QueueName, "ReadMessages", nil), context.Canceled)
+}
+
+func TestPersistenceFaultRegistry_Nil(t *testing.T) {
+	var registry *PersistenceFaultRegistry
+	require.NoError(t, registry.Inject(context.Background(), config.ExecutionStoreName, "UpdateWorkflowExecution", nil))
+}
+
+func TestValidatePersistenceFault(t *testing.T) {
+	require.NoError(t, ValidatePersistenceFault(&persistencespb.PersistenceFault{ErrorRate: 0.5}))
+	require.NoError(t, ValidatePersistenceFault(&persistencespb.PersistenceFault{Latency: timestamp.DurationPtr(time.Second)}))
+
+	for _, fault := range []*persistencespb.PersistenceFault{
+		nil,
+		{},
+		{ErrorRate: 1.5},
+		{ErrorRate: 1, ErrorType: "UnknownError"},
+		{ErrorRate: 1, DataStore: string(config.ClusterMDStoreName)},
+		{Latency: timestamp.DurationPtr(-time.Second)},
+	} {
+		require.IsType(t, &serviceerror.InvalidArgument{}, ValidatePersistenceFault(fault))
+	}
+}
+
+func TestRequestShardID(t *testing.T) {
+	require.Equal(t, int32(5), requestShardID(&persistence.GetHistoryTasksRequest{ShardID: 5}))
+	require.Equal(t, int32(0), requestShardID(&persistence.GetNamespaceRequest{}))
+	require.Equal(t, int32(0), requestShardID((*persistence.GetHistoryTasksRequest)(nil)))
+	require.Equal(t, int32(0), requestShardID(nil))
+}
+
+func TestFaultInjectionShardStore_RuntimeAndTargetedFaults(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	baseShardStore := mock.NewMockShardStore(ctrl)
+	baseFactory := &shardStoreFactory{store: baseShardStore}
+
+	registry := newTestPersistenceFaultRegistry(clock.NewRealTimeSource())
+	factory := NewFaultInjectionDatastoreFactory(
+		&config.FaultInjection{
+			Targets: config.FaultInjectionTargets{
+				DataStores: map[config.DataStoreName]config.FaultInjectionDataStoreConfig{
+					config.ShardStoreName: {
+						Methods: map[string]config.FaultInjectionMethodConfig{
+							"GetOrCreateShard": {Errors: map[string]float64{"DeadlineExceededError": 1}},
+						},
+					},
+				},
+			},
+		},
+		baseFactory,
+		registry,
+	)
+	shardStore, err := factory.NewShardStore()
+	require.NoError(t, err)
+
+	_, err = shardStore.GetOrCreateShard(context.Background(), &persistence.InternalGetOrCreateShardRequest{ShardID: 1})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	baseShardStore.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).Return(nil)
+	require.NoError(t, shardStore.UpdateShard(context.Background(), &persistence.InternalUpdateShardRequest{ShardID: 1}))
+
+	registry.UpdateFaults([]*persistencespb.PersistenceFault{
+		{
+			Id:         "fault",
+			Method:     "UpdateShard",
+			ShardId:    1,
+			ErrorRate:  1,
+			ErrorType:  "ShardOwnershipLostError",
+			ExpireTime: timestamp.TimePtr(time.Now().Add(time.Minute)),
+		},
+	})
+	err = shardStore.UpdateShard(context.Background(), &persistence.InternalUpdateShardRequest{ShardID: 1})
+	require.IsType(t, &persistence.ShardOwnershipLostError{}, err)
+}
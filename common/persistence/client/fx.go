@@ -25,14 +25,19 @@
 package client
 
 import (
+	"context"
+
 	"go.uber.org/fx"
 
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/quotas"
 )
 
@@ -61,13 +66,56 @@ type (
 var Module = fx.Options(
 	BeanModule,
 	fx.Provide(ClusterNameProvider),
+	fx.Provide(PersistenceFaultRegistryProvider),
 	fx.Provide(DataStoreFactoryProvider),
+	fx.Invoke(PersistenceFaultRegistryLifetimeHooks),
 )
 
 func ClusterNameProvider(config *cluster.Config) ClusterName {
 	return ClusterName(config.CurrentClusterName)
 }
 
+// PersistenceFaultRegistryProvider returns nil, so that faults set at runtime are not injected, unless the
+// persistence config allows runtime faults
+func PersistenceFaultRegistryProvider(
+	persistenceConfig *config.Persistence,
+	serviceName primitives.ServiceName,
+	dc *dynamicconfig.Collection,
+	logger log.Logger,
+) *PersistenceFaultRegistry {
+	if !persistenceConfig.RuntimeFaultInjectionEnabled() {
+		return nil
+	}
+	return NewPersistenceFaultRegistry(
+		serviceName,
+		dc.GetDurationProperty(dynamicconfig.PersistenceFaultRefreshInterval, persistenceFaultRefreshInterval),
+		clock.NewRealTimeSource(),
+		logger,
+	)
+}
+
+func PersistenceFaultRegistryLifetimeHooks(
+	lc fx.Lifecycle,
+	registry *PersistenceFaultRegistry,
+	clusterMetadataManager persistence.ClusterMetadataManager,
+) {
+	if registry == nil {
+		return
+	}
+	lc.Append(
+		fx.Hook{
+			OnStart: func(context.Context) error {
+				registry.Start(clusterMetadataManager)
+				return nil
+			},
+			OnStop: func(context.Context) error {
+				registry.Stop()
+				return nil
+			},
+		},
+	)
+}
+
 func FactoryProvider(
 	params NewFactoryParams,
 ) Factory {
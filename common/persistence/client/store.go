@@ -26,10 +26,13 @@ package client
 
 import (
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/cassandra"
+	"go.temporal.io/server/common/persistence/kv"
 	"go.temporal.io/server/common/persistence/sql"
 	"go.temporal.io/server/common/resolver"
 )
@@ -71,6 +74,8 @@ func DataStoreFactoryProvider(
 	r resolver.ServiceResolver,
 	config *config.Persistence,
 	abstractDataStoreFactory AbstractDataStoreFactory,
+	dc *dynamicconfig.Collection,
+	faultRegistry *PersistenceFaultRegistry,
 	logger log.Logger,
 	metricsHandler metrics.Handler,
 ) (DataStoreFactory, *FaultInjectionDataStoreFactory) {
@@ -81,16 +86,39 @@ func DataStoreFactoryProvider(
 	case defaultCfg.Cassandra != nil:
 		dataStoreFactory = cassandra.NewFactory(*defaultCfg.Cassandra, r, string(clusterName), logger)
 	case defaultCfg.SQL != nil:
-		dataStoreFactory = sql.NewFactory(*defaultCfg.SQL, r, string(clusterName), logger)
+		dataStoreFactory = sql.NewFactory(*defaultCfg.SQL, r, string(clusterName), logger, metricsHandler)
+	case defaultCfg.KV != nil:
+		dataStoreFactory = kv.NewFactory(*defaultCfg.KV, string(clusterName), logger)
 	case defaultCfg.CustomDataStoreConfig != nil:
 		dataStoreFactory = abstractDataStoreFactory.NewFactory(*defaultCfg.CustomDataStoreConfig, r, string(clusterName), logger, metricsHandler)
 	default:
-		logger.Fatal("invalid config: one of cassandra or sql params must be specified for default data store")
+		logger.Fatal("invalid config: one of cassandra, sql or kv params must be specified for default data store")
 	}
 
+	if config.Encryption != nil {
+		encryptionFactory, err := NewEncryptionDataStoreFactory(config.Encryption, dataStoreFactory, logger)
+		if err != nil {
+			logger.Fatal("invalid config: unable to load persistence encryption keys", tag.Error(err))
+		}
+		dataStoreFactory = encryptionFactory
+	}
+
+	// compressed blobs are read even when no namespace enables compression, so it can be turned off
+	// without losing access to them. Blobs are compressed before they are encrypted.
+	compressionFactory, err := NewCompressionDataStoreFactory(dc, dataStoreFactory, logger, metricsHandler)
+	if err != nil {
+		logger.Fatal("unable to create persistence blob compressor", tag.Error(err))
+	}
+	dataStoreFactory = compressionFactory
+
 	var faultInjection *FaultInjectionDataStoreFactory
 	if defaultCfg.FaultInjection != nil {
-		dataStoreFactory = NewFaultInjectionDatastoreFactory(defaultCfg.FaultInjection, dataStoreFactory)
+		// the registry is only set if the fault injection config allows runtime faults
+		dataStoreFactory = NewFaultInjectionDatastoreFactory(
+			defaultCfg.FaultInjection,
+			dataStoreFactory,
+			faultRegistry,
+		)
 	}
 
 	return dataStoreFactory, faultInjection
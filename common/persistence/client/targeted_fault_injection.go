@@ -32,6 +32,9 @@ import (
 	"strings"
 	"time"
 
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/persistence"
 )
@@ -88,6 +91,11 @@ func (d *dataStoreErrorGenerator) Generate() error {
 	}
 	parts := strings.Split(runtimeFunc.Name(), ".")
 	methodName := parts[len(parts)-1]
+	return d.generateForMethod(methodName)
+}
+
+// generateForMethod is the same as Generate, but for a method name given by the caller.
+func (d *dataStoreErrorGenerator) generateForMethod(methodName string) error {
 	methodErrorGenerator, ok := d.MethodErrorGenerators[methodName]
 	if !ok {
 		return nil
@@ -96,16 +104,40 @@ func (d *dataStoreErrorGenerator) Generate() error {
 	return err
 }
 
+// generateForMethod returns an error from the error generator of a data store for the given method. The method name
+// is passed to the targeted error generator instead of inferred, as the caller is not the data store method itself.
+func generateForMethod(errorGenerator ErrorGenerator, methodName string) error {
+	if d, ok := errorGenerator.(*dataStoreErrorGenerator); ok {
+		return d.generateForMethod(methodName)
+	}
+	return errorGenerator.Generate()
+}
+
 // getErrorFromName returns an error based on the provided name. If the name is not recognized, then this method will
 // panic.
 func getErrorFromName(name string) error {
+	err := lookupErrorFromName(name)
+	if err == nil {
+		panic(fmt.Sprintf("unknown error type: %v", name))
+	}
+	return err
+}
+
+// lookupErrorFromName returns an error based on the provided name, or nil if the name is not recognized.
+func lookupErrorFromName(name string) error {
 	switch name {
 	case "ShardOwnershipLostError":
 		return &persistence.ShardOwnershipLostError{}
 	case "DeadlineExceededError":
 		return context.DeadlineExceeded
+	case "UnavailableError":
+		return serviceerror.NewUnavailable("fault injected")
+	case "ResourceExhaustedError":
+		return serviceerror.NewResourceExhausted(enumspb.RESOURCE_EXHAUSTED_CAUSE_SYSTEM_OVERLOADED, "fault injected")
+	case "TimeoutError":
+		return &persistence.TimeoutError{Msg: "fault injected"}
 	default:
-		panic(fmt.Sprintf("unknown error type: %v", name))
+		return nil
 	}
 }
 
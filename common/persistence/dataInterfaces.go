@@ -58,6 +58,16 @@ const (
 	NamespaceReplicationQueueType QueueType = iota + 1
 )
 
+const (
+	// AuditLogQueueType is the queue type of the first audit log partition, partition i uses queue
+	// type AuditLogQueueType + i
+	AuditLogQueueType QueueType = 1000
+	// AuditLogQueuePartitions is the number of queue partitions the audit log is spread over
+	AuditLogQueuePartitions = 8
+	// APIKeyUsageQueueType is the queue type whose metadata holds the last used time of API keys
+	APIKeyUsageQueueType QueueType = 2000
+)
+
 // Create Workflow Execution Mode
 const (
 	// CreateWorkflowModeBrandNew fail if current record exists
@@ -693,7 +703,7 @@ type (
 		ShardID int32
 		// true if this is the first append request to the branch
 		IsNewBranch bool
-		// the info for clean up data in background
+		// the info for clean up data in background, also identifies the namespace of the events
 		Info string
 		// The branch to be appended
 		BranchToken []byte
@@ -717,7 +727,7 @@ type (
 		ShardID int32
 		// true if this is the first append request to the branch
 		IsNewBranch bool
-		// the info for clean up data in background
+		// the info for clean up data in background, also identifies the namespace of the events
 		Info string
 		// The branch to be appended
 		BranchToken []byte
@@ -0,0 +1,270 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package kv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/dgraph-io/badger/v3"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/log"
+	p "go.temporal.io/server/common/persistence"
+)
+
+type (
+	kvClusterMetadataStore struct {
+		Store
+	}
+
+	clusterMetadataRecord struct {
+		Metadata blobRecord
+		Version  int64
+	}
+
+	clusterMemberRecord struct {
+		Role          p.ServiceType
+		HostID        []byte
+		RPCAddress    string
+		RPCPort       uint16
+		SessionStart  time.Time
+		LastHeartbeat time.Time
+		RecordExpiry  time.Time
+	}
+)
+
+var _ p.ClusterMetadataStore = (*kvClusterMetadataStore)(nil)
+
+func newClusterMetadataPersistence(
+	db *DB,
+	logger log.Logger,
+) p.ClusterMetadataStore {
+	return &kvClusterMetadataStore{
+		Store: NewStore(db, logger),
+	}
+}
+
+func (s *kvClusterMetadataStore) ListClusterMetadata(
+	ctx context.Context,
+	request *p.InternalListClusterMetadataRequest,
+) (*p.InternalListClusterMetadataResponse, error) {
+	response := &p.InternalListClusterMetadataResponse{}
+	if err := s.DB.View(ctx, "ListClusterMetadata", func(txn *badger.Txn) error {
+		prefix := NewKey(TableClusterMetadata)
+		nextPageToken, err := ScanPage(txn, prefix, prefix.PrefixEnd(), request.PageSize, request.NextPageToken, func(_ Key, value []byte) error {
+			var record clusterMetadataRecord
+			if err := Decode(value, &record); err != nil {
+				return err
+			}
+			response.ClusterMetadata = append(response.ClusterMetadata, record.toResponse())
+			return nil
+		})
+		response.NextPageToken = nextPageToken
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (s *kvClusterMetadataStore) GetClusterMetadata(
+	ctx context.Context,
+	request *p.InternalGetClusterMetadataRequest,
+) (*p.InternalGetClusterMetadataResponse, error) {
+	var record clusterMetadataRecord
+	if err := s.DB.View(ctx, "GetClusterMetadata", func(txn *badger.Txn) error {
+		found, err := Get(txn, clusterMetadataKey(request.ClusterName), &record)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return serviceerror.NewNotFound(fmt.Sprintf("GetClusterMetadata operation failed. Cluster %v not found.", request.ClusterName))
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return record.toResponse(), nil
+}
+
+func (s *kvClusterMetadataStore) SaveClusterMetadata(
+	ctx context.Context,
+	request *p.InternalSaveClusterMetadataRequest,
+) (bool, error) {
+	if err := s.DB.Update(ctx, "SaveClusterMetadata", func(txn *badger.Txn) error {
+		var record clusterMetadataRecord
+		if _, err := Get(txn, clusterMetadataKey(request.ClusterName), &record); err != nil {
+			return err
+		}
+		if request.Version != record.Version {
+			return serviceerror.NewUnavailable(fmt.Sprintf("SaveClusterMetadata encountered version mismatch, expected %v but got %v.",
+				request.Version, record.Version))
+		}
+		return Put(txn, clusterMetadataKey(request.ClusterName), &clusterMetadataRecord{
+			Metadata: newBlobRecord(request.ClusterMetadata),
+			Version:  request.Version + 1,
+		})
+	}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *kvClusterMetadataStore) DeleteClusterMetadata(
+	ctx context.Context,
+	request *p.InternalDeleteClusterMetadataRequest,
+) error {
+	return s.DB.Update(ctx, "DeleteClusterMetadata", func(txn *badger.Txn) error {
+		return txn.Delete(clusterMetadataKey(request.ClusterName))
+	})
+}
+
+func (s *kvClusterMetadataStore) GetClusterMembers(
+	ctx context.Context,
+	request *p.GetClusterMembersRequest,
+) (*p.GetClusterMembersResponse, error) {
+	var lastSeenHostID []byte
+	if len(request.NextPageToken) == 16 {
+		lastSeenHostID = request.NextPageToken
+	} else if len(request.NextPageToken) > 0 {
+		return nil, serviceerror.NewInternal("page token is corrupted.")
+	}
+
+	now := time.Now().UTC()
+	var lastHeartbeatAfter time.Time
+	if request.LastHeartbeatWithin > 0 {
+		lastHeartbeatAfter = now.Add(-request.LastHeartbeatWithin)
+	}
+
+	prefix := NewKey(TableClusterMembership)
+	start := prefix
+	end := prefix.PrefixEnd()
+	if request.HostIDEquals != nil {
+		start = clusterMembershipKey(request.HostIDEquals)
+		end = start.Next()
+	} else if lastSeenHostID != nil {
+		start = clusterMembershipKey(lastSeenHostID).Next()
+	}
+
+	response := &p.GetClusterMembersResponse{}
+	if err := s.DB.View(ctx, "GetClusterMembers", func(txn *badger.Txn) error {
+		return ScanRecords(txn, start, end, false, func(_ Key, record *clusterMemberRecord) (bool, error) {
+			switch {
+			case !record.RecordExpiry.After(now),
+				request.RoleEquals != p.All && record.Role != request.RoleEquals,
+				request.RPCAddressEquals != nil && record.RPCAddress != request.RPCAddressEquals.String(),
+				!request.SessionStartedAfter.IsZero() && !record.SessionStart.After(request.SessionStartedAfter),
+				!lastHeartbeatAfter.IsZero() && !record.LastHeartbeat.After(lastHeartbeatAfter):
+				return true, nil
+			}
+			response.ActiveMembers = append(response.ActiveMembers, record.toMember())
+			return request.PageSize <= 0 || len(response.ActiveMembers) < request.PageSize, nil
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	if request.PageSize > 0 && len(response.ActiveMembers) == request.PageSize {
+		response.NextPageToken = bytes.Clone(response.ActiveMembers[len(response.ActiveMembers)-1].HostID)
+	}
+	return response, nil
+}
+
+func (s *kvClusterMetadataStore) UpsertClusterMembership(
+	ctx context.Context,
+	request *p.UpsertClusterMembershipRequest,
+) error {
+	now := time.Now().UTC()
+	return s.DB.Update(ctx, "UpsertClusterMembership", func(txn *badger.Txn) error {
+		return Put(txn, clusterMembershipKey(request.HostID), &clusterMemberRecord{
+			Role:          request.Role,
+			HostID:        request.HostID,
+			RPCAddress:    request.RPCAddress.String(),
+			RPCPort:       request.RPCPort,
+			SessionStart:  request.SessionStart,
+			LastHeartbeat: now,
+			RecordExpiry:  now.Add(request.RecordExpiry),
+		})
+	})
+}
+
+func (s *kvClusterMetadataStore) PruneClusterMembership(
+	ctx context.Context,
+	request *p.PruneClusterMembershipRequest,
+) error {
+	now := time.Now().UTC()
+	var expired []Key
+	if err := s.DB.View(ctx, "PruneClusterMembership", func(txn *badger.Txn) error {
+		prefix := NewKey(TableClusterMembership)
+		return ScanRecords(txn, prefix, prefix.PrefixEnd(), false, func(key Key, record *clusterMemberRecord) (bool, error) {
+			if record.RecordExpiry.Before(now) {
+				expired = append(expired, key)
+			}
+			return true, nil
+		})
+	}); err != nil {
+		return err
+	}
+
+	return s.DB.Update(ctx, "PruneClusterMembership", func(txn *badger.Txn) error {
+		for _, key := range expired {
+			if err := txn.Delete(key); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *clusterMetadataRecord) toResponse() *p.InternalGetClusterMetadataResponse {
+	return &p.InternalGetClusterMetadataResponse{
+		ClusterMetadata: r.Metadata.toBlob(),
+		Version:         r.Version,
+	}
+}
+
+func (r *clusterMemberRecord) toMember() *p.ClusterMember {
+	return &p.ClusterMember{
+		Role:          r.Role,
+		HostID:        r.HostID,
+		RPCAddress:    net.ParseIP(r.RPCAddress),
+		RPCPort:       r.RPCPort,
+		SessionStart:  r.SessionStart.UTC(),
+		LastHeartbeat: r.LastHeartbeat.UTC(),
+		RecordExpiry:  r.RecordExpiry.UTC(),
+	}
+}
+
+func clusterMetadataKey(clusterName string) Key {
+	return NewKey(TableClusterMetadata).String(clusterName)
+}
+
+func clusterMembershipKey(hostID []byte) Key {
+	return NewKey(TableClusterMembership).String(string(hostID))
+}
@@ -0,0 +1,250 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package kv
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+	"sync"
+
+	"github.com/dgraph-io/badger/v3"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/log"
+	p "go.temporal.io/server/common/persistence"
+)
+
+type (
+	// Store is the base of all stores backed by the embedded key-value engine
+	Store struct {
+		DB        *DB
+		logger    log.Logger
+		closeOnce *sync.Once
+	}
+
+	// blobRecord is the stored form of a commonpb.DataBlob
+	blobRecord struct {
+		Data     []byte
+		Encoding string
+	}
+)
+
+// NewStore returns a store holding a reference to db
+func NewStore(db *DB, logger log.Logger) Store {
+	return Store{
+		DB:        db,
+		logger:    logger,
+		closeOnce: &sync.Once{},
+	}
+}
+
+func (s *Store) GetName() string {
+	return PluginName
+}
+
+// Close releases the store's database reference; closing a store more than once has no effect
+func (s *Store) Close() {
+	s.closeOnce.Do(s.DB.Close)
+}
+
+func newBlobRecord(blob *commonpb.DataBlob) blobRecord {
+	if blob == nil {
+		return blobRecord{}
+	}
+	return blobRecord{
+		Data:     blob.Data,
+		Encoding: blob.EncodingType.String(),
+	}
+}
+
+func (r blobRecord) toBlob() *commonpb.DataBlob {
+	return p.NewDataBlob(r.Data, r.Encoding)
+}
+
+// Encode serializes a record for storage
+func Encode(x interface{}) ([]byte, error) {
+	b := bytes.Buffer{}
+	if err := gob.NewEncoder(&b).Encode(x); err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("Error in serialization: %v", err))
+	}
+	return b.Bytes(), nil
+}
+
+// Decode deserializes a record written by Encode
+func Decode(data []byte, x interface{}) error {
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(x); err != nil {
+		return serviceerror.NewInternal(fmt.Sprintf("Error in deserialization: %v", err))
+	}
+	return nil
+}
+
+// Get reads and decodes the record stored under key. It returns false if the key does not exist.
+func Get(txn *badger.Txn, key Key, x interface{}) (bool, error) {
+	item, err := txn.Get(key)
+	switch err {
+	case nil:
+	case badger.ErrKeyNotFound:
+		return false, nil
+	default:
+		return false, err
+	}
+	return true, item.Value(func(val []byte) error {
+		return Decode(val, x)
+	})
+}
+
+// Exists reports whether key exists
+func Exists(txn *badger.Txn, key Key) (bool, error) {
+	_, err := txn.Get(key)
+	switch err {
+	case nil:
+		return true, nil
+	case badger.ErrKeyNotFound:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+// Put encodes and stores a record under key
+func Put(txn *badger.Txn, key Key, x interface{}) error {
+	data, err := Encode(x)
+	if err != nil {
+		return err
+	}
+	return txn.Set(key, data)
+}
+
+// Scan visits the records in [start, end) in key order, or in reverse key order
+// if reverse is set. A nil end means no upper bound. Iteration stops when fn
+// returns false or an error.
+func Scan(
+	txn *badger.Txn,
+	start Key,
+	end Key,
+	reverse bool,
+	fn func(key Key, value []byte) (bool, error),
+) error {
+	opts := badger.DefaultIteratorOptions
+	opts.Reverse = reverse
+	it := txn.NewIterator(opts)
+	defer it.Close()
+
+	if reverse {
+		if end == nil {
+			return serviceerror.NewInternal("reverse scan requires an upper bound")
+		}
+		// in reverse mode Seek positions at the largest key <= the seek key,
+		// end itself is excluded below
+		it.Seek(end)
+	} else {
+		it.Seek(start)
+	}
+
+	for ; it.Valid(); it.Next() {
+		item := it.Item()
+		key := Key(item.KeyCopy(nil))
+		if reverse {
+			if key.Compare(end) >= 0 {
+				continue
+			}
+			if key.Compare(start) < 0 {
+				return nil
+			}
+		} else if end != nil && key.Compare(end) >= 0 {
+			return nil
+		}
+		value, err := item.ValueCopy(nil)
+		if err != nil {
+			return err
+		}
+		more, err := fn(key, value)
+		if err != nil || !more {
+			return err
+		}
+	}
+	return nil
+}
+
+// ScanRecords is Scan with values decoded into records of type T
+func ScanRecords[T any](
+	txn *badger.Txn,
+	start Key,
+	end Key,
+	reverse bool,
+	fn func(key Key, record *T) (bool, error),
+) error {
+	return Scan(txn, start, end, reverse, func(key Key, value []byte) (bool, error) {
+		var record T
+		if err := Decode(value, &record); err != nil {
+			return false, err
+		}
+		return fn(key, &record)
+	})
+}
+
+// ScanPage visits up to pageSize records in [start, end), resuming after the
+// position encoded in pageToken. The returned token is set only if the page is
+// full and more keys may follow; it is the key to resume the scan from.
+func ScanPage(
+	txn *badger.Txn,
+	start Key,
+	end Key,
+	pageSize int,
+	pageToken []byte,
+	fn func(key Key, value []byte) error,
+) ([]byte, error) {
+	if len(pageToken) > 0 {
+		resume := Key(pageToken)
+		if resume.Compare(start) < 0 || (end != nil && resume.Compare(end) > 0) {
+			return nil, serviceerror.NewInvalidArgument("invalid page token")
+		}
+		start = resume
+	}
+
+	var lastKey Key
+	count := 0
+	if err := Scan(txn, start, end, false, func(key Key, value []byte) (bool, error) {
+		if err := fn(key, value); err != nil {
+			return false, err
+		}
+		lastKey = key
+		count++
+		return pageSize <= 0 || count < pageSize, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if pageSize <= 0 || count < pageSize {
+		return nil, nil
+	}
+	next := lastKey.Next()
+	if end != nil && next.Compare(end) >= 0 {
+		return nil, nil
+	}
+	return next, nil
+}
@@ -0,0 +1,258 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package kv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
+
+	"github.com/dgraph-io/badger/v3"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	p "go.temporal.io/server/common/persistence"
+)
+
+const (
+	// PluginName is the name reported by all stores backed by the embedded key-value engine
+	PluginName = "kv"
+
+	// maxConflictRetries bounds how often a read-write transaction is retried
+	// after losing an optimistic concurrency check to another writer.
+	maxConflictRetries = 16
+	// deleteBatchSize is the number of keys removed per transaction by range deletes.
+	deleteBatchSize = 1000
+)
+
+type (
+	// DB is a reference counted handle to an embedded badger database.
+	// Stores created by the same factory, as well as a visibility store configured
+	// with the same path, share one handle since badger locks its data directory.
+	DB struct {
+		db     *badger.DB
+		key    string
+		logger log.Logger
+
+		sync.Mutex
+		refCnt int
+	}
+
+	badgerLogger struct {
+		logger log.Logger
+	}
+)
+
+var (
+	openDBsLock sync.Mutex
+	openDBs     = make(map[string]*DB)
+)
+
+// OpenDB returns a handle to the database described by cfg, opening it if needed.
+// Every call must be paired with a call to Close.
+func OpenDB(cfg config.KV, logger log.Logger) (*DB, error) {
+	if cfg.InMemory {
+		// in-memory databases are private to the caller
+		return newDB(cfg, "", logger)
+	}
+
+	path, err := filepath.Abs(cfg.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	openDBsLock.Lock()
+	defer openDBsLock.Unlock()
+	if db, ok := openDBs[path]; ok {
+		db.Lock()
+		defer db.Unlock()
+		db.refCnt++
+		return db, nil
+	}
+
+	db, err := newDB(cfg, path, logger)
+	if err != nil {
+		return nil, err
+	}
+	openDBs[path] = db
+	return db, nil
+}
+
+func newDB(cfg config.KV, path string, logger log.Logger) (*DB, error) {
+	opts := badger.DefaultOptions(path).
+		WithInMemory(cfg.InMemory).
+		WithSyncWrites(cfg.SyncWrites).
+		WithLogger(&badgerLogger{logger: logger})
+	db, err := badger.Open(opts)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open kv database at %q: %w", path, err)
+	}
+	return &DB{
+		db:     db,
+		key:    path,
+		logger: logger,
+		refCnt: 1,
+	}, nil
+}
+
+// Acquire adds a reference to an open handle; it must be paired with a call to Close
+func (d *DB) Acquire() {
+	d.Lock()
+	defer d.Unlock()
+	d.refCnt++
+}
+
+// Close releases the handle and closes the database once no references remain
+func (d *DB) Close() {
+	if d.key != "" {
+		openDBsLock.Lock()
+		defer openDBsLock.Unlock()
+	}
+
+	d.Lock()
+	defer d.Unlock()
+	if d.refCnt <= 0 {
+		return
+	}
+	d.refCnt--
+	if d.refCnt > 0 {
+		return
+	}
+	if d.key != "" {
+		delete(openDBs, d.key)
+	}
+	if err := d.db.Close(); err != nil {
+		d.logger.Error("Error closing kv database", tag.Error(err))
+	}
+}
+
+// Update runs fn in a serializable read-write transaction. Transactions that
+// conflict with a concurrent writer are retried, so fn must be idempotent.
+func (d *DB) Update(ctx context.Context, operation string, fn func(txn *badger.Txn) error) error {
+	for attempt := 0; ; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return convertError(operation, err)
+		}
+		err := d.db.Update(fn)
+		if err == badger.ErrConflict && attempt < maxConflictRetries {
+			continue
+		}
+		return convertError(operation, err)
+	}
+}
+
+// View runs fn in a read-only transaction
+func (d *DB) View(ctx context.Context, operation string, fn func(txn *badger.Txn) error) error {
+	if err := ctx.Err(); err != nil {
+		return convertError(operation, err)
+	}
+	return convertError(operation, d.db.View(fn))
+}
+
+// DeleteRange removes all keys in [start, end). Large ranges are removed over
+// several transactions, so the delete is not atomic.
+func (d *DB) DeleteRange(ctx context.Context, operation string, start Key, end Key) error {
+	for {
+		var keys [][]byte
+		if err := d.View(ctx, operation, func(txn *badger.Txn) error {
+			opts := badger.DefaultIteratorOptions
+			opts.PrefetchValues = false
+			it := txn.NewIterator(opts)
+			defer it.Close()
+			for it.Seek(start); it.Valid() && len(keys) < deleteBatchSize; it.Next() {
+				key := it.Item().KeyCopy(nil)
+				if end != nil && Key(key).Compare(end) >= 0 {
+					break
+				}
+				keys = append(keys, key)
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		if len(keys) == 0 {
+			return nil
+		}
+
+		if err := d.Update(ctx, operation, func(txn *badger.Txn) error {
+			for _, key := range keys {
+				if err := txn.Delete(key); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		if len(keys) < deleteBatchSize {
+			return nil
+		}
+	}
+}
+
+func convertError(operation string, err error) error {
+	if err == nil {
+		return nil
+	}
+	switch err.(type) {
+	case *p.ConditionFailedError,
+		*p.CurrentWorkflowConditionFailedError,
+		*p.WorkflowConditionFailedError,
+		*p.ShardOwnershipLostError,
+		*p.InvalidPersistenceRequestError,
+		*p.AppendHistoryTimeoutError,
+		*serviceerror.NamespaceAlreadyExists,
+		*serviceerror.NamespaceNotFound,
+		*serviceerror.NotFound,
+		*serviceerror.InvalidArgument,
+		*serviceerror.Internal,
+		*serviceerror.Unavailable:
+		return err
+	}
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return err
+	}
+	return serviceerror.NewUnavailable(fmt.Sprintf("%v operation failed. Error: %v", operation, err))
+}
+
+func (l *badgerLogger) Errorf(format string, args ...interface{}) {
+	l.logger.Error(fmt.Sprintf(format, args...))
+}
+
+func (l *badgerLogger) Warningf(format string, args ...interface{}) {
+	l.logger.Warn(fmt.Sprintf(format, args...))
+}
+
+func (l *badgerLogger) Infof(format string, args ...interface{}) {
+	l.logger.Debug(fmt.Sprintf(format, args...))
+}
+
+func (l *badgerLogger) Debugf(format string, args ...interface{}) {
+	l.logger.Debug(fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,497 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package kv
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dgraph-io/badger/v3"
+	"go.temporal.io/api/serviceerror"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log"
+	p "go.temporal.io/server/common/persistence"
+)
+
+type kvExecutionStore struct {
+	Store
+}
+
+var _ p.ExecutionStore = (*kvExecutionStore)(nil)
+
+// NewExecutionStore creates an instance of ExecutionStore
+func NewExecutionStore(
+	db *DB,
+	logger log.Logger,
+) p.ExecutionStore {
+	return &kvExecutionStore{
+		Store: NewStore(db, logger),
+	}
+}
+
+// updateShardLocked runs fn in a read-write transaction after verifying the shard is still owned at rangeID
+func (m *kvExecutionStore) updateShardLocked(
+	ctx context.Context,
+	operation string,
+	shardID int32,
+	rangeID int64,
+	fn func(txn *badger.Txn) error,
+) error {
+	return m.DB.Update(ctx, operation, func(txn *badger.Txn) error {
+		if err := checkShardRangeID(txn, shardID, rangeID, "Failed to lock shard"); err != nil {
+			return err
+		}
+		return fn(txn)
+	})
+}
+
+func (m *kvExecutionStore) CreateWorkflowExecution(
+	ctx context.Context,
+	request *p.InternalCreateWorkflowExecutionRequest,
+) (*p.InternalCreateWorkflowExecutionResponse, error) {
+	for _, req := range request.NewWorkflowNewEvents {
+		if err := m.AppendHistoryNodes(ctx, req); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := m.updateShardLocked(ctx,
+		"CreateWorkflowExecution",
+		request.ShardID,
+		request.RangeID,
+		func(txn *badger.Txn) error {
+			return m.createWorkflowExecutionTxn(txn, request)
+		},
+	); err != nil {
+		return nil, err
+	}
+	return &p.InternalCreateWorkflowExecutionResponse{}, nil
+}
+
+func (m *kvExecutionStore) createWorkflowExecutionTxn(
+	txn *badger.Txn,
+	request *p.InternalCreateWorkflowExecutionRequest,
+) error {
+	newWorkflow := request.NewWorkflowSnapshot
+	shardID := request.ShardID
+	namespaceID := newWorkflow.NamespaceID
+	workflowID := newWorkflow.WorkflowID
+
+	current, err := getCurrentExecution(txn, shardID, namespaceID, workflowID)
+	if err != nil {
+		return err
+	}
+
+	// current run ID, last write version, current workflow state check
+	switch request.Mode {
+	case p.CreateWorkflowModeBrandNew:
+		if current != nil && current.RunID != request.PreviousRunID {
+			return extractCurrentWorkflowConflictError(
+				current,
+				fmt.Sprintf(
+					"Workflow execution creation condition failed. workflow ID: %v, current run ID: %v, request run ID: %v",
+					workflowID,
+					current.RunID,
+					request.PreviousRunID,
+				),
+			)
+		}
+
+	case p.CreateWorkflowModeUpdateCurrent:
+		if current == nil {
+			return extractCurrentWorkflowConflictError(current, "")
+		}
+		if current.RunID != request.PreviousRunID {
+			return extractCurrentWorkflowConflictError(
+				current,
+				fmt.Sprintf(
+					"Workflow execution creation condition failed. workflow ID: %v, current run ID: %v, request run ID: %v",
+					workflowID,
+					current.RunID,
+					request.PreviousRunID,
+				),
+			)
+		}
+		if request.PreviousLastWriteVersion != current.LastWriteVersion {
+			return extractCurrentWorkflowConflictError(
+				current,
+				fmt.Sprintf(
+					"Workflow execution creation condition failed. workflow ID: %v, current last write version: %v, request last write version: %v",
+					workflowID,
+					current.LastWriteVersion,
+					request.PreviousLastWriteVersion,
+				),
+			)
+		}
+		if current.State != enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED {
+			return extractCurrentWorkflowConflictError(
+				current,
+				fmt.Sprintf(
+					"Workflow execution creation condition failed. workflow ID: %v, current state: %v, request state: %v",
+					workflowID,
+					current.State,
+					enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED,
+				),
+			)
+		}
+
+	case p.CreateWorkflowModeBypassCurrent:
+		if err := assertRunIDMismatch(newWorkflow.ExecutionState.RunId, current); err != nil {
+			return err
+		}
+
+	default:
+		return serviceerror.NewInternal(fmt.Sprintf("CreteWorkflowExecution: unknown mode: %v", request.Mode))
+	}
+
+	switch request.Mode {
+	case p.CreateWorkflowModeBrandNew, p.CreateWorkflowModeUpdateCurrent:
+		if err := putCurrentExecution(txn, shardID, namespaceID, workflowID, newWorkflow.ExecutionState, newWorkflow.LastWriteVersion); err != nil {
+			return err
+		}
+	}
+
+	return applyWorkflowSnapshotAsNew(txn, shardID, &newWorkflow)
+}
+
+func (m *kvExecutionStore) GetWorkflowExecution(
+	ctx context.Context,
+	request *p.GetWorkflowExecutionRequest,
+) (*p.InternalGetWorkflowExecutionResponse, error) {
+	var state *p.InternalWorkflowMutableState
+	if err := m.DB.View(ctx, "GetWorkflowExecution", func(txn *badger.Txn) error {
+		var record executionRecord
+		found, err := Get(txn, executionKey(request.ShardID, request.NamespaceID, request.WorkflowID, request.RunID), &record)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return serviceerror.NewNotFound(fmt.Sprintf("Workflow execution not found.  WorkflowId: %v, RunId: %v", request.WorkflowID, request.RunID))
+		}
+		state, err = getMutableState(txn, request.ShardID, request.NamespaceID, request.WorkflowID, request.RunID, &record)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return &p.InternalGetWorkflowExecutionResponse{
+		State:           state,
+		DBRecordVersion: state.DBRecordVersion,
+	}, nil
+}
+
+func (m *kvExecutionStore) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *p.InternalUpdateWorkflowExecutionRequest,
+) error {
+	// first append history
+	for _, req := range request.UpdateWorkflowNewEvents {
+		if err := m.AppendHistoryNodes(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, req := range request.NewWorkflowNewEvents {
+		if err := m.AppendHistoryNodes(ctx, req); err != nil {
+			return err
+		}
+	}
+
+	// then update mutable state
+	return m.updateShardLocked(ctx,
+		"UpdateWorkflowExecution",
+		request.ShardID,
+		request.RangeID,
+		func(txn *badger.Txn) error {
+			return m.updateWorkflowExecutionTxn(txn, request)
+		},
+	)
+}
+
+func (m *kvExecutionStore) updateWorkflowExecutionTxn(
+	txn *badger.Txn,
+	request *p.InternalUpdateWorkflowExecutionRequest,
+) error {
+	updateWorkflow := request.UpdateWorkflowMutation
+	newWorkflow := request.NewWorkflowSnapshot
+
+	namespaceID := updateWorkflow.NamespaceID
+	workflowID := updateWorkflow.WorkflowID
+	runID := updateWorkflow.ExecutionState.RunId
+	shardID := request.ShardID
+
+	switch request.Mode {
+	case p.UpdateWorkflowModeBypassCurrent:
+		if err := assertNotCurrentExecution(txn, shardID, namespaceID, workflowID, runID); err != nil {
+			return err
+		}
+
+	case p.UpdateWorkflowModeUpdateCurrent:
+		if newWorkflow != nil {
+			if namespaceID != newWorkflow.NamespaceID {
+				return serviceerror.NewUnavailable("UpdateWorkflowExecution: cannot continue as new to another namespace")
+			}
+			if err := assertRunIDAndUpdateCurrentExecution(txn,
+				shardID,
+				namespaceID,
+				workflowID,
+				runID,
+				newWorkflow.ExecutionState,
+				newWorkflow.LastWriteVersion,
+			); err != nil {
+				return err
+			}
+		} else {
+			// this is only to update the current record
+			if err := assertRunIDAndUpdateCurrentExecution(txn,
+				shardID,
+				namespaceID,
+				workflowID,
+				runID,
+				updateWorkflow.ExecutionState,
+				updateWorkflow.LastWriteVersion,
+			); err != nil {
+				return err
+			}
+		}
+
+	default:
+		return serviceerror.NewUnavailable(fmt.Sprintf("UpdateWorkflowExecution: unknown mode: %v", request.Mode))
+	}
+
+	if err := applyWorkflowMutation(txn, shardID, &updateWorkflow); err != nil {
+		return err
+	}
+	if newWorkflow != nil {
+		if err := applyWorkflowSnapshotAsNew(txn, shardID, newWorkflow); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *kvExecutionStore) ConflictResolveWorkflowExecution(
+	ctx context.Context,
+	request *p.InternalConflictResolveWorkflowExecutionRequest,
+) error {
+	// first append history
+	for _, req := range request.CurrentWorkflowEventsNewEvents {
+		if err := m.AppendHistoryNodes(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, req := range request.ResetWorkflowEventsNewEvents {
+		if err := m.AppendHistoryNodes(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, req := range request.NewWorkflowEventsNewEvents {
+		if err := m.AppendHistoryNodes(ctx, req); err != nil {
+			return err
+		}
+	}
+
+	return m.updateShardLocked(ctx,
+		"ConflictResolveWorkflowExecution",
+		request.ShardID,
+		request.RangeID,
+		func(txn *badger.Txn) error {
+			return m.conflictResolveWorkflowExecutionTxn(txn, request)
+		},
+	)
+}
+
+func (m *kvExecutionStore) conflictResolveWorkflowExecutionTxn(
+	txn *badger.Txn,
+	request *p.InternalConflictResolveWorkflowExecutionRequest,
+) error {
+	currentWorkflow := request.CurrentWorkflowMutation
+	resetWorkflow := request.ResetWorkflowSnapshot
+	newWorkflow := request.NewWorkflowSnapshot
+
+	shardID := request.ShardID
+	namespaceID := resetWorkflow.NamespaceID
+	workflowID := resetWorkflow.WorkflowID
+
+	switch request.Mode {
+	case p.ConflictResolveWorkflowModeBypassCurrent:
+		if err := assertNotCurrentExecution(txn,
+			shardID,
+			namespaceID,
+			workflowID,
+			resetWorkflow.ExecutionState.RunId,
+		); err != nil {
+			return err
+		}
+
+	case p.ConflictResolveWorkflowModeUpdateCurrent:
+		executionState := resetWorkflow.ExecutionState
+		lastWriteVersion := resetWorkflow.LastWriteVersion
+		if newWorkflow != nil {
+			executionState = newWorkflow.ExecutionState
+			lastWriteVersion = newWorkflow.LastWriteVersion
+		}
+
+		// reset workflow is current if there is no current workflow mutation
+		prevRunID := resetWorkflow.ExecutionState.RunId
+		if currentWorkflow != nil {
+			prevRunID = currentWorkflow.ExecutionState.RunId
+		}
+		if err := assertRunIDAndUpdateCurrentExecution(txn,
+			shardID,
+			namespaceID,
+			workflowID,
+			prevRunID,
+			executionState,
+			lastWriteVersion,
+		); err != nil {
+			return err
+		}
+
+	default:
+		return serviceerror.NewUnavailable(fmt.Sprintf("ConflictResolveWorkflowExecution: unknown mode: %v", request.Mode))
+	}
+
+	if err := applyWorkflowSnapshotAsReset(txn, shardID, &resetWorkflow); err != nil {
+		return err
+	}
+	if currentWorkflow != nil {
+		if err := applyWorkflowMutation(txn, shardID, currentWorkflow); err != nil {
+			return err
+		}
+	}
+	if newWorkflow != nil {
+		if err := applyWorkflowSnapshotAsNew(txn, shardID, newWorkflow); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *kvExecutionStore) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *p.DeleteWorkflowExecutionRequest,
+) error {
+	if err := m.DB.Update(ctx, "DeleteWorkflowExecution", func(txn *badger.Txn) error {
+		return txn.Delete(executionKey(request.ShardID, request.NamespaceID, request.WorkflowID, request.RunID))
+	}); err != nil {
+		return err
+	}
+	prefix := mutableStateMapPrefix(request.ShardID, request.NamespaceID, request.WorkflowID, request.RunID)
+	return m.DB.DeleteRange(ctx, "DeleteWorkflowExecution", prefix, prefix.PrefixEnd())
+}
+
+// its possible for a new run of the same workflow to have started after the run we are deleting
+// here was finished. In that case, the current execution record will have the same workflowID but
+// different runID. The following code will delete the current record if and only if the runID is
+// same as the one we are trying to delete here
+func (m *kvExecutionStore) DeleteCurrentWorkflowExecution(
+	ctx context.Context,
+	request *p.DeleteCurrentWorkflowExecutionRequest,
+) error {
+	return m.DB.Update(ctx, "DeleteCurrentWorkflowExecution", func(txn *badger.Txn) error {
+		current, err := getCurrentExecution(txn, request.ShardID, request.NamespaceID, request.WorkflowID)
+		if err != nil || current == nil || current.RunID != request.RunID {
+			return err
+		}
+		return txn.Delete(currentExecutionKey(request.ShardID, request.NamespaceID, request.WorkflowID))
+	})
+}
+
+func (m *kvExecutionStore) GetCurrentExecution(
+	ctx context.Context,
+	request *p.GetCurrentExecutionRequest,
+) (*p.InternalGetCurrentExecutionResponse, error) {
+	var current *currentExecutionRecord
+	if err := m.DB.View(ctx, "GetCurrentExecution", func(txn *badger.Txn) error {
+		var err error
+		current, err = getCurrentExecution(txn, request.ShardID, request.NamespaceID, request.WorkflowID)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	if current == nil {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("Current workflow execution not found. WorkflowId: %v", request.WorkflowID))
+	}
+
+	return &p.InternalGetCurrentExecutionResponse{
+		RunID: current.RunID,
+		ExecutionState: &persistencespb.WorkflowExecutionState{
+			CreateRequestId: current.CreateRequestID,
+			State:           current.State,
+			Status:          current.Status,
+		},
+	}, nil
+}
+
+func (m *kvExecutionStore) SetWorkflowExecution(
+	ctx context.Context,
+	request *p.InternalSetWorkflowExecutionRequest,
+) error {
+	return m.updateShardLocked(ctx,
+		"SetWorkflowExecution",
+		request.ShardID,
+		request.RangeID,
+		func(txn *badger.Txn) error {
+			return applyWorkflowSnapshotAsReset(txn, request.ShardID, &request.SetWorkflowSnapshot)
+		},
+	)
+}
+
+func (m *kvExecutionStore) ListConcreteExecutions(
+	ctx context.Context,
+	request *p.ListConcreteExecutionsRequest,
+) (*p.InternalListConcreteExecutionsResponse, error) {
+	response := &p.InternalListConcreteExecutionsResponse{}
+	if err := m.DB.View(ctx, "ListConcreteExecutions", func(txn *badger.Txn) error {
+		prefix := NewKey(TableExecution).Int32(request.ShardID)
+		var records []*executionRecord
+		nextPageToken, err := ScanPage(txn, prefix, prefix.PrefixEnd(), request.PageSize, request.PageToken, func(_ Key, value []byte) error {
+			var record executionRecord
+			if err := Decode(value, &record); err != nil {
+				return err
+			}
+			records = append(records, &record)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		response.NextPageToken = nextPageToken
+		for _, record := range records {
+			state, err := getMutableState(txn, request.ShardID, record.NamespaceID, record.WorkflowID, record.RunID, record)
+			if err != nil {
+				return err
+			}
+			response.States = append(response.States, state)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
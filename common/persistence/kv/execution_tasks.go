@@ -0,0 +1,280 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package kv
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dgraph-io/badger/v3"
+	"go.temporal.io/api/serviceerror"
+
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+type historyTaskRecord struct {
+	TaskID   int64
+	FireTime time.Time
+	Blob     blobRecord
+}
+
+func (m *kvExecutionStore) AddHistoryTasks(
+	ctx context.Context,
+	request *p.InternalAddHistoryTasksRequest,
+) error {
+	return m.updateShardLocked(ctx,
+		"AddHistoryTasks",
+		request.ShardID,
+		request.RangeID,
+		func(txn *badger.Txn) error {
+			return applyTasks(txn, request.ShardID, request.Tasks)
+		},
+	)
+}
+
+func (m *kvExecutionStore) GetHistoryTask(
+	ctx context.Context,
+	request *p.GetHistoryTaskRequest,
+) (*p.InternalGetHistoryTaskResponse, error) {
+	key, err := historyTaskKey(request.ShardID, request.TaskCategory, request.TaskKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var record historyTaskRecord
+	if err := m.DB.View(ctx, "GetHistoryTask", func(txn *badger.Txn) error {
+		found, err := Get(txn, key, &record)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return serviceerror.NewNotFound(
+				fmt.Sprintf("GetHistoryTask operation failed. CategoryID: %v. Task with ID %v not found.", request.TaskCategory.ID(), request.TaskKey.TaskID),
+			)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &p.InternalGetHistoryTaskResponse{
+		InternalHistoryTask: record.toTask(request.TaskCategory),
+	}, nil
+}
+
+func (m *kvExecutionStore) GetHistoryTasks(
+	ctx context.Context,
+	request *p.GetHistoryTasksRequest,
+) (*p.InternalGetHistoryTasksResponse, error) {
+	start, end, err := historyTaskRange(request.ShardID, request.TaskCategory, request.InclusiveMinTaskKey, request.ExclusiveMaxTaskKey)
+	if err != nil {
+		return nil, err
+	}
+	return m.getHistoryTasks(ctx, "GetHistoryTasks", request.TaskCategory, start, end, request.BatchSize, request.NextPageToken)
+}
+
+func (m *kvExecutionStore) getHistoryTasks(
+	ctx context.Context,
+	operation string,
+	category tasks.Category,
+	start Key,
+	end Key,
+	batchSize int,
+	pageToken []byte,
+) (*p.InternalGetHistoryTasksResponse, error) {
+	response := &p.InternalGetHistoryTasksResponse{}
+	if err := m.DB.View(ctx, operation, func(txn *badger.Txn) error {
+		nextPageToken, err := ScanPage(txn, start, end, batchSize, pageToken, func(_ Key, value []byte) error {
+			var record historyTaskRecord
+			if err := Decode(value, &record); err != nil {
+				return err
+			}
+			response.Tasks = append(response.Tasks, record.toTask(category))
+			return nil
+		})
+		response.NextPageToken = nextPageToken
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (m *kvExecutionStore) CompleteHistoryTask(
+	ctx context.Context,
+	request *p.CompleteHistoryTaskRequest,
+) error {
+	key, err := historyTaskKey(request.ShardID, request.TaskCategory, request.TaskKey)
+	if err != nil {
+		return err
+	}
+	return m.DB.Update(ctx, "CompleteHistoryTask", func(txn *badger.Txn) error {
+		return txn.Delete(key)
+	})
+}
+
+func (m *kvExecutionStore) RangeCompleteHistoryTasks(
+	ctx context.Context,
+	request *p.RangeCompleteHistoryTasksRequest,
+) error {
+	start, end, err := historyTaskRange(request.ShardID, request.TaskCategory, request.InclusiveMinTaskKey, request.ExclusiveMaxTaskKey)
+	if err != nil {
+		return err
+	}
+	return m.DB.DeleteRange(ctx, "RangeCompleteHistoryTasks", start, end)
+}
+
+func (m *kvExecutionStore) PutReplicationTaskToDLQ(
+	ctx context.Context,
+	request *p.PutReplicationTaskToDLQRequest,
+) error {
+	replicationTask := request.TaskInfo
+	blob, err := serialization.ReplicationTaskInfoToBlob(replicationTask)
+	if err != nil {
+		return err
+	}
+
+	// Tasks are immutable. So it's fine if we already persisted it before.
+	// This can happen when tasks are retried (ack and cleanup can have lag on source side).
+	return m.DB.Update(ctx, "PutReplicationTaskToDLQ", func(txn *badger.Txn) error {
+		return Put(txn, replicationDLQTaskKey(request.ShardID, request.SourceClusterName, replicationTask.GetTaskId()), &historyTaskRecord{
+			TaskID: replicationTask.GetTaskId(),
+			Blob:   newBlobRecord(&blob),
+		})
+	})
+}
+
+func (m *kvExecutionStore) GetReplicationTasksFromDLQ(
+	ctx context.Context,
+	request *p.GetReplicationTasksFromDLQRequest,
+) (*p.InternalGetHistoryTasksResponse, error) {
+	return m.getHistoryTasks(ctx,
+		"GetReplicationTasksFromDLQ",
+		tasks.CategoryReplication,
+		replicationDLQTaskKey(request.ShardID, request.SourceClusterName, request.InclusiveMinTaskKey.TaskID),
+		replicationDLQTaskKey(request.ShardID, request.SourceClusterName, request.ExclusiveMaxTaskKey.TaskID),
+		request.BatchSize,
+		request.NextPageToken,
+	)
+}
+
+func (m *kvExecutionStore) DeleteReplicationTaskFromDLQ(
+	ctx context.Context,
+	request *p.DeleteReplicationTaskFromDLQRequest,
+) error {
+	return m.DB.Update(ctx, "DeleteReplicationTaskFromDLQ", func(txn *badger.Txn) error {
+		return txn.Delete(replicationDLQTaskKey(request.ShardID, request.SourceClusterName, request.TaskKey.TaskID))
+	})
+}
+
+func (m *kvExecutionStore) RangeDeleteReplicationTaskFromDLQ(
+	ctx context.Context,
+	request *p.RangeDeleteReplicationTaskFromDLQRequest,
+) error {
+	return m.DB.DeleteRange(ctx,
+		"RangeDeleteReplicationTaskFromDLQ",
+		replicationDLQTaskKey(request.ShardID, request.SourceClusterName, request.InclusiveMinTaskKey.TaskID),
+		replicationDLQTaskKey(request.ShardID, request.SourceClusterName, request.ExclusiveMaxTaskKey.TaskID),
+	)
+}
+
+func applyTasks(
+	txn *badger.Txn,
+	shardID int32,
+	insertTasks map[tasks.Category][]p.InternalHistoryTask,
+) error {
+	for category, tasksByCategory := range insertTasks {
+		for _, task := range tasksByCategory {
+			key, err := historyTaskKey(shardID, category, task.Key)
+			if err != nil {
+				return err
+			}
+			blob := task.Blob
+			if err := Put(txn, key, &historyTaskRecord{
+				TaskID:   task.Key.TaskID,
+				FireTime: task.Key.FireTime,
+				Blob:     newBlobRecord(&blob),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (r *historyTaskRecord) toTask(category tasks.Category) p.InternalHistoryTask {
+	key := tasks.NewImmediateKey(r.TaskID)
+	if category.Type() == tasks.CategoryTypeScheduled {
+		key = tasks.NewKey(r.FireTime.UTC(), r.TaskID)
+	}
+	return p.InternalHistoryTask{
+		Key:  key,
+		Blob: *r.Blob.toBlob(),
+	}
+}
+
+func historyTaskKey(
+	shardID int32,
+	category tasks.Category,
+	taskKey tasks.Key,
+) (Key, error) {
+	switch category.Type() {
+	case tasks.CategoryTypeImmediate:
+		return NewKey(TableImmediateTask).Int32(shardID).Int32(category.ID()).Int64(taskKey.TaskID), nil
+	case tasks.CategoryTypeScheduled:
+		return NewKey(TableScheduledTask).Int32(shardID).Int32(category.ID()).Time(taskKey.FireTime).Int64(taskKey.TaskID), nil
+	default:
+		return nil, serviceerror.NewInternal(fmt.Sprintf("Unknown task category type: %v", category))
+	}
+}
+
+// historyTaskRange returns the key range of the tasks in [inclusiveMinTaskKey, exclusiveMaxTaskKey).
+// Scheduled tasks are bounded by fire time only.
+func historyTaskRange(
+	shardID int32,
+	category tasks.Category,
+	inclusiveMinTaskKey tasks.Key,
+	exclusiveMaxTaskKey tasks.Key,
+) (Key, Key, error) {
+	if category.Type() == tasks.CategoryTypeScheduled {
+		prefix := NewKey(TableScheduledTask).Int32(shardID).Int32(category.ID())
+		return prefix.Time(inclusiveMinTaskKey.FireTime), prefix.Time(exclusiveMaxTaskKey.FireTime), nil
+	}
+	start, err := historyTaskKey(shardID, category, inclusiveMinTaskKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	end, err := historyTaskKey(shardID, category, exclusiveMaxTaskKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	return start, end, nil
+}
+
+func replicationDLQTaskKey(shardID int32, sourceClusterName string, taskID int64) Key {
+	return NewKey(TableReplicationDLQ).Int32(shardID).String(sourceClusterName).Int64(taskID)
+}
@@ -0,0 +1,613 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package kv
+
+import (
+	"fmt"
+
+	"github.com/dgraph-io/badger/v3"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+type (
+	executionRecord struct {
+		NamespaceID      string
+		WorkflowID       string
+		RunID            string
+		Info             blobRecord
+		State            blobRecord
+		NextEventID      int64
+		LastWriteVersion int64
+		DBRecordVersion  int64
+	}
+
+	currentExecutionRecord struct {
+		RunID            string
+		CreateRequestID  string
+		State            enumsspb.WorkflowExecutionState
+		Status           enumspb.WorkflowExecutionStatus
+		LastWriteVersion int64
+	}
+
+	// mutableStateMapRecord is an entry of one of the maps of a mutable state
+	mutableStateMapRecord struct {
+		IntKey    int64
+		StringKey string
+		Blob      blobRecord
+	}
+
+	mutableStateMap uint8
+)
+
+const (
+	mutableStateMapActivity mutableStateMap = iota + 1
+	mutableStateMapTimer
+	mutableStateMapChildExecution
+	mutableStateMapRequestCancel
+	mutableStateMapSignal
+	mutableStateMapSignalRequested
+	mutableStateMapBufferedEvents
+)
+
+func executionKey(shardID int32, namespaceID string, workflowID string, runID string) Key {
+	return NewKey(TableExecution).Int32(shardID).String(namespaceID).String(workflowID).String(runID)
+}
+
+func currentExecutionKey(shardID int32, namespaceID string, workflowID string) Key {
+	return NewKey(TableCurrentExecution).Int32(shardID).String(namespaceID).String(workflowID)
+}
+
+func mutableStateMapPrefix(shardID int32, namespaceID string, workflowID string, runID string) Key {
+	return NewKey(TableMutableStateMap).Int32(shardID).String(namespaceID).String(workflowID).String(runID)
+}
+
+func applyWorkflowMutation(
+	txn *badger.Txn,
+	shardID int32,
+	workflowMutation *p.InternalWorkflowMutation,
+) error {
+	namespaceID := workflowMutation.NamespaceID
+	workflowID := workflowMutation.WorkflowID
+	runID := workflowMutation.ExecutionState.RunId
+
+	if err := lockAndCheckExecution(txn,
+		shardID,
+		namespaceID,
+		workflowID,
+		runID,
+		workflowMutation.Condition,
+		workflowMutation.DBRecordVersion,
+	); err != nil {
+		return err
+	}
+
+	if err := putExecution(txn,
+		shardID,
+		namespaceID,
+		workflowID,
+		workflowMutation.ExecutionInfoBlob,
+		workflowMutation.ExecutionState,
+		workflowMutation.NextEventID,
+		workflowMutation.LastWriteVersion,
+		workflowMutation.DBRecordVersion,
+	); err != nil {
+		return err
+	}
+
+	if err := applyTasks(txn, shardID, workflowMutation.Tasks); err != nil {
+		return err
+	}
+
+	prefix := mutableStateMapPrefix(shardID, namespaceID, workflowID, runID)
+	if err := updateInt64Map(txn, prefix.Uint8(uint8(mutableStateMapActivity)), workflowMutation.UpsertActivityInfos, workflowMutation.DeleteActivityInfos); err != nil {
+		return err
+	}
+	if err := updateStringMap(txn, prefix.Uint8(uint8(mutableStateMapTimer)), workflowMutation.UpsertTimerInfos, workflowMutation.DeleteTimerInfos); err != nil {
+		return err
+	}
+	if err := updateInt64Map(txn, prefix.Uint8(uint8(mutableStateMapChildExecution)), workflowMutation.UpsertChildExecutionInfos, workflowMutation.DeleteChildExecutionInfos); err != nil {
+		return err
+	}
+	if err := updateInt64Map(txn, prefix.Uint8(uint8(mutableStateMapRequestCancel)), workflowMutation.UpsertRequestCancelInfos, workflowMutation.DeleteRequestCancelInfos); err != nil {
+		return err
+	}
+	if err := updateInt64Map(txn, prefix.Uint8(uint8(mutableStateMapSignal)), workflowMutation.UpsertSignalInfos, workflowMutation.DeleteSignalInfos); err != nil {
+		return err
+	}
+	if err := updateStringSet(txn, prefix.Uint8(uint8(mutableStateMapSignalRequested)), workflowMutation.UpsertSignalRequestedIDs, workflowMutation.DeleteSignalRequestedIDs); err != nil {
+		return err
+	}
+
+	bufferedEventsPrefix := prefix.Uint8(uint8(mutableStateMapBufferedEvents))
+	if workflowMutation.ClearBufferedEvents {
+		if err := deletePrefix(txn, bufferedEventsPrefix); err != nil {
+			return err
+		}
+	}
+	return appendBufferedEvents(txn, bufferedEventsPrefix, workflowMutation.NewBufferedEvents)
+}
+
+func applyWorkflowSnapshotAsReset(
+	txn *badger.Txn,
+	shardID int32,
+	workflowSnapshot *p.InternalWorkflowSnapshot,
+) error {
+	if err := lockAndCheckExecution(txn,
+		shardID,
+		workflowSnapshot.NamespaceID,
+		workflowSnapshot.WorkflowID,
+		workflowSnapshot.ExecutionState.RunId,
+		workflowSnapshot.Condition,
+		workflowSnapshot.DBRecordVersion,
+	); err != nil {
+		return err
+	}
+
+	// all maps are rewritten from the snapshot
+	if err := deletePrefix(txn, mutableStateMapPrefix(
+		shardID,
+		workflowSnapshot.NamespaceID,
+		workflowSnapshot.WorkflowID,
+		workflowSnapshot.ExecutionState.RunId,
+	)); err != nil {
+		return err
+	}
+	return applyWorkflowSnapshot(txn, shardID, workflowSnapshot)
+}
+
+func applyWorkflowSnapshotAsNew(
+	txn *badger.Txn,
+	shardID int32,
+	workflowSnapshot *p.InternalWorkflowSnapshot,
+) error {
+	exists, err := Exists(txn, executionKey(
+		shardID,
+		workflowSnapshot.NamespaceID,
+		workflowSnapshot.WorkflowID,
+		workflowSnapshot.ExecutionState.RunId,
+	))
+	if err != nil {
+		return err
+	}
+	if exists {
+		return &p.WorkflowConditionFailedError{
+			Msg:             fmt.Sprintf("Workflow execution already running. WorkflowId: %v", workflowSnapshot.WorkflowID),
+			NextEventID:     0,
+			DBRecordVersion: 0,
+		}
+	}
+	return applyWorkflowSnapshot(txn, shardID, workflowSnapshot)
+}
+
+func applyWorkflowSnapshot(
+	txn *badger.Txn,
+	shardID int32,
+	workflowSnapshot *p.InternalWorkflowSnapshot,
+) error {
+	namespaceID := workflowSnapshot.NamespaceID
+	workflowID := workflowSnapshot.WorkflowID
+	runID := workflowSnapshot.ExecutionState.RunId
+
+	if err := putExecution(txn,
+		shardID,
+		namespaceID,
+		workflowID,
+		workflowSnapshot.ExecutionInfoBlob,
+		workflowSnapshot.ExecutionState,
+		workflowSnapshot.NextEventID,
+		workflowSnapshot.LastWriteVersion,
+		workflowSnapshot.DBRecordVersion,
+	); err != nil {
+		return err
+	}
+
+	if err := applyTasks(txn, shardID, workflowSnapshot.Tasks); err != nil {
+		return err
+	}
+
+	prefix := mutableStateMapPrefix(shardID, namespaceID, workflowID, runID)
+	if err := updateInt64Map(txn, prefix.Uint8(uint8(mutableStateMapActivity)), workflowSnapshot.ActivityInfos, nil); err != nil {
+		return err
+	}
+	if err := updateStringMap(txn, prefix.Uint8(uint8(mutableStateMapTimer)), workflowSnapshot.TimerInfos, nil); err != nil {
+		return err
+	}
+	if err := updateInt64Map(txn, prefix.Uint8(uint8(mutableStateMapChildExecution)), workflowSnapshot.ChildExecutionInfos, nil); err != nil {
+		return err
+	}
+	if err := updateInt64Map(txn, prefix.Uint8(uint8(mutableStateMapRequestCancel)), workflowSnapshot.RequestCancelInfos, nil); err != nil {
+		return err
+	}
+	if err := updateInt64Map(txn, prefix.Uint8(uint8(mutableStateMapSignal)), workflowSnapshot.SignalInfos, nil); err != nil {
+		return err
+	}
+	return updateStringSet(txn, prefix.Uint8(uint8(mutableStateMapSignalRequested)), workflowSnapshot.SignalRequestedIDs, nil)
+}
+
+func putExecution(
+	txn *badger.Txn,
+	shardID int32,
+	namespaceID string,
+	workflowID string,
+	executionInfo *commonpb.DataBlob,
+	executionState *persistencespb.WorkflowExecutionState,
+	nextEventID int64,
+	lastWriteVersion int64,
+	dbRecordVersion int64,
+) error {
+	stateBlob, err := serialization.WorkflowExecutionStateToBlob(executionState)
+	if err != nil {
+		return err
+	}
+	return Put(txn, executionKey(shardID, namespaceID, workflowID, executionState.RunId), &executionRecord{
+		NamespaceID:      namespaceID,
+		WorkflowID:       workflowID,
+		RunID:            executionState.RunId,
+		Info:             newBlobRecord(executionInfo),
+		State:            newBlobRecord(&stateBlob),
+		NextEventID:      nextEventID,
+		LastWriteVersion: lastWriteVersion,
+		DBRecordVersion:  dbRecordVersion,
+	})
+}
+
+func lockAndCheckExecution(
+	txn *badger.Txn,
+	shardID int32,
+	namespaceID string,
+	workflowID string,
+	runID string,
+	condition int64,
+	dbRecordVersion int64,
+) error {
+	var record executionRecord
+	found, err := Get(txn, executionKey(shardID, namespaceID, workflowID, runID), &record)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return &p.ConditionFailedError{
+			Msg: fmt.Sprintf("lockAndCheckExecution failed. Unable to lock (shard, namespace, workflow, run) = (%v,%v,%v,%v) which does not exist.",
+				shardID,
+				namespaceID,
+				workflowID,
+				runID),
+		}
+	}
+
+	if dbRecordVersion == 0 {
+		if record.NextEventID != condition {
+			return &p.WorkflowConditionFailedError{
+				Msg:             fmt.Sprintf("lockAndCheckExecution failed. Next_event_id was %v when it should have been %v.", record.NextEventID, condition),
+				NextEventID:     record.NextEventID,
+				DBRecordVersion: record.DBRecordVersion,
+			}
+		}
+	} else {
+		dbRecordVersion -= 1
+		if record.DBRecordVersion != dbRecordVersion {
+			return &p.WorkflowConditionFailedError{
+				Msg:             fmt.Sprintf("lockAndCheckExecution failed. DBRecordVersion expected: %v, actually %v.", dbRecordVersion, record.DBRecordVersion),
+				NextEventID:     record.NextEventID,
+				DBRecordVersion: record.DBRecordVersion,
+			}
+		}
+	}
+	return nil
+}
+
+// getCurrentExecution returns the current execution record or nil if none exists.
+// The last write version is taken from the current run itself, as it changes with
+// every update of the run, not only with updates of the current record.
+func getCurrentExecution(
+	txn *badger.Txn,
+	shardID int32,
+	namespaceID string,
+	workflowID string,
+) (*currentExecutionRecord, error) {
+	var current currentExecutionRecord
+	found, err := Get(txn, currentExecutionKey(shardID, namespaceID, workflowID), &current)
+	if err != nil || !found {
+		return nil, err
+	}
+
+	var execution executionRecord
+	found, err = Get(txn, executionKey(shardID, namespaceID, workflowID, current.RunID), &execution)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		current.LastWriteVersion = execution.LastWriteVersion
+	}
+	return &current, nil
+}
+
+func putCurrentExecution(
+	txn *badger.Txn,
+	shardID int32,
+	namespaceID string,
+	workflowID string,
+	executionState *persistencespb.WorkflowExecutionState,
+	lastWriteVersion int64,
+) error {
+	return Put(txn, currentExecutionKey(shardID, namespaceID, workflowID), &currentExecutionRecord{
+		RunID:            executionState.RunId,
+		CreateRequestID:  executionState.CreateRequestId,
+		State:            executionState.State,
+		Status:           executionState.Status,
+		LastWriteVersion: lastWriteVersion,
+	})
+}
+
+func assertNotCurrentExecution(
+	txn *badger.Txn,
+	shardID int32,
+	namespaceID string,
+	workflowID string,
+	runID string,
+) error {
+	current, err := getCurrentExecution(txn, shardID, namespaceID, workflowID)
+	if err != nil {
+		return err
+	}
+	// allow bypassing no current record
+	return assertRunIDMismatch(runID, current)
+}
+
+func assertRunIDAndUpdateCurrentExecution(
+	txn *badger.Txn,
+	shardID int32,
+	namespaceID string,
+	workflowID string,
+	previousRunID string,
+	executionState *persistencespb.WorkflowExecutionState,
+	lastWriteVersion int64,
+) error {
+	current, err := getCurrentExecution(txn, shardID, namespaceID, workflowID)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		return serviceerror.NewUnavailable("assertCurrentExecution failed. Unable to load current record.")
+	}
+	if current.RunID != previousRunID {
+		return extractCurrentWorkflowConflictError(
+			current,
+			fmt.Sprintf(
+				"assertRunIDAndUpdateCurrentExecution failed. current run ID: %v, request run ID: %v",
+				current.RunID,
+				previousRunID,
+			),
+		)
+	}
+	return putCurrentExecution(txn, shardID, namespaceID, workflowID, executionState, lastWriteVersion)
+}
+
+func assertRunIDMismatch(requestRunID string, current *currentExecutionRecord) error {
+	// zombie workflow creation with existence of current record, this is a noop
+	if current == nil {
+		return nil
+	}
+	if current.RunID == requestRunID {
+		return extractCurrentWorkflowConflictError(
+			current,
+			fmt.Sprintf(
+				"assertRunIDMismatch failed. request run ID: %v, current run ID: %v",
+				requestRunID,
+				current.RunID,
+			),
+		)
+	}
+	return nil
+}
+
+func extractCurrentWorkflowConflictError(
+	current *currentExecutionRecord,
+	message string,
+) error {
+	if current == nil {
+		return &p.CurrentWorkflowConditionFailedError{
+			Msg:              message,
+			RequestID:        "",
+			RunID:            "",
+			State:            enumsspb.WORKFLOW_EXECUTION_STATE_UNSPECIFIED,
+			Status:           enumspb.WORKFLOW_EXECUTION_STATUS_UNSPECIFIED,
+			LastWriteVersion: 0,
+		}
+	}
+
+	return &p.CurrentWorkflowConditionFailedError{
+		Msg:              message,
+		RequestID:        current.CreateRequestID,
+		RunID:            current.RunID,
+		State:            current.State,
+		Status:           current.Status,
+		LastWriteVersion: current.LastWriteVersion,
+	}
+}
+
+func getMutableState(
+	txn *badger.Txn,
+	shardID int32,
+	namespaceID string,
+	workflowID string,
+	runID string,
+	record *executionRecord,
+) (*p.InternalWorkflowMutableState, error) {
+	state := &p.InternalWorkflowMutableState{
+		ActivityInfos:       make(map[int64]*commonpb.DataBlob),
+		TimerInfos:          make(map[string]*commonpb.DataBlob),
+		ChildExecutionInfos: make(map[int64]*commonpb.DataBlob),
+		RequestCancelInfos:  make(map[int64]*commonpb.DataBlob),
+		SignalInfos:         make(map[int64]*commonpb.DataBlob),
+		ExecutionInfo:       record.Info.toBlob(),
+		ExecutionState:      record.State.toBlob(),
+		NextEventID:         record.NextEventID,
+		DBRecordVersion:     record.DBRecordVersion,
+	}
+
+	prefix := mutableStateMapPrefix(shardID, namespaceID, workflowID, runID)
+	if err := ScanRecords(txn, prefix, prefix.PrefixEnd(), false, func(key Key, item *mutableStateMapRecord) (bool, error) {
+		switch mutableStateMap(key[len(prefix)]) {
+		case mutableStateMapActivity:
+			state.ActivityInfos[item.IntKey] = item.Blob.toBlob()
+		case mutableStateMapTimer:
+			state.TimerInfos[item.StringKey] = item.Blob.toBlob()
+		case mutableStateMapChildExecution:
+			state.ChildExecutionInfos[item.IntKey] = item.Blob.toBlob()
+		case mutableStateMapRequestCancel:
+			state.RequestCancelInfos[item.IntKey] = item.Blob.toBlob()
+		case mutableStateMapSignal:
+			state.SignalInfos[item.IntKey] = item.Blob.toBlob()
+		case mutableStateMapSignalRequested:
+			state.SignalRequestedIDs = append(state.SignalRequestedIDs, item.StringKey)
+		case mutableStateMapBufferedEvents:
+			state.BufferedEvents = append(state.BufferedEvents, item.Blob.toBlob())
+		default:
+			return false, serviceerror.NewInternal(fmt.Sprintf("unknown mutable state map type: %v", key[len(prefix)]))
+		}
+		return true, nil
+	}); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+func updateInt64Map(
+	txn *badger.Txn,
+	prefix Key,
+	upserts map[int64]*commonpb.DataBlob,
+	deletes map[int64]struct{},
+) error {
+	for key, blob := range upserts {
+		if err := Put(txn, prefix.Int64(key), &mutableStateMapRecord{
+			IntKey: key,
+			Blob:   newBlobRecord(blob),
+		}); err != nil {
+			return err
+		}
+	}
+	for key := range deletes {
+		if err := txn.Delete(prefix.Int64(key)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func updateStringMap(
+	txn *badger.Txn,
+	prefix Key,
+	upserts map[string]*commonpb.DataBlob,
+	deletes map[string]struct{},
+) error {
+	for key, blob := range upserts {
+		if err := Put(txn, prefix.String(key), &mutableStateMapRecord{
+			StringKey: key,
+			Blob:      newBlobRecord(blob),
+		}); err != nil {
+			return err
+		}
+	}
+	for key := range deletes {
+		if err := txn.Delete(prefix.String(key)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func updateStringSet(
+	txn *badger.Txn,
+	prefix Key,
+	upserts map[string]struct{},
+	deletes map[string]struct{},
+) error {
+	for key := range upserts {
+		if err := Put(txn, prefix.String(key), &mutableStateMapRecord{
+			StringKey: key,
+		}); err != nil {
+			return err
+		}
+	}
+	for key := range deletes {
+		if err := txn.Delete(prefix.String(key)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appendBufferedEvents stores a batch of buffered events after all batches buffered before
+func appendBufferedEvents(
+	txn *badger.Txn,
+	prefix Key,
+	events *commonpb.DataBlob,
+) error {
+	if events == nil || len(events.Data) == 0 {
+		return nil
+	}
+
+	var seq int64
+	if err := Scan(txn, prefix, prefix.PrefixEnd(), true, func(_ Key, value []byte) (bool, error) {
+		var last mutableStateMapRecord
+		if err := Decode(value, &last); err != nil {
+			return false, err
+		}
+		seq = last.IntKey + 1
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	return Put(txn, prefix.Int64(seq), &mutableStateMapRecord{
+		IntKey: seq,
+		Blob:   newBlobRecord(events),
+	})
+}
+
+// deletePrefix removes all keys with the given prefix within txn
+func deletePrefix(txn *badger.Txn, prefix Key) error {
+	var keys []Key
+	if err := Scan(txn, prefix, prefix.PrefixEnd(), false, func(key Key, _ []byte) (bool, error) {
+		keys = append(keys, key)
+		return true, nil
+	}); err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := txn.Delete(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
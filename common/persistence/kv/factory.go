@@ -0,0 +1,140 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package kv
+
+import (
+	"sync"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	p "go.temporal.io/server/common/persistence"
+)
+
+type (
+	// Factory vends store objects backed by an embedded key-value database
+	Factory struct {
+		cfg         config.KV
+		clusterName string
+		logger      log.Logger
+
+		sync.Mutex
+		db *DB
+	}
+)
+
+// NewFactory returns an instance of a factory object which can be used to create
+// datastores backed by an embedded key-value database
+func NewFactory(
+	cfg config.KV,
+	clusterName string,
+	logger log.Logger,
+) *Factory {
+	return &Factory{
+		cfg:         cfg,
+		clusterName: clusterName,
+		logger:      logger,
+	}
+}
+
+// NewTaskStore returns a new task store
+func (f *Factory) NewTaskStore() (p.TaskStore, error) {
+	db, err := f.getDB()
+	if err != nil {
+		return nil, err
+	}
+	return newTaskPersistence(db, f.logger), nil
+}
+
+// NewShardStore returns a new shard store
+func (f *Factory) NewShardStore() (p.ShardStore, error) {
+	db, err := f.getDB()
+	if err != nil {
+		return nil, err
+	}
+	return newShardPersistence(db, f.clusterName, f.logger), nil
+}
+
+// NewMetadataStore returns a new metadata store
+func (f *Factory) NewMetadataStore() (p.MetadataStore, error) {
+	db, err := f.getDB()
+	if err != nil {
+		return nil, err
+	}
+	return newMetadataPersistence(db, f.logger), nil
+}
+
+// NewClusterMetadataStore returns a new ClusterMetadata store
+func (f *Factory) NewClusterMetadataStore() (p.ClusterMetadataStore, error) {
+	db, err := f.getDB()
+	if err != nil {
+		return nil, err
+	}
+	return newClusterMetadataPersistence(db, f.logger), nil
+}
+
+// NewExecutionStore returns a new ExecutionStore
+func (f *Factory) NewExecutionStore() (p.ExecutionStore, error) {
+	db, err := f.getDB()
+	if err != nil {
+		return nil, err
+	}
+	return NewExecutionStore(db, f.logger), nil
+}
+
+// NewQueue returns a new queue backed by the key-value database
+func (f *Factory) NewQueue(queueType p.QueueType) (p.Queue, error) {
+	db, err := f.getDB()
+	if err != nil {
+		return nil, err
+	}
+	return newQueue(db, f.logger, queueType), nil
+}
+
+// Close closes the factory
+func (f *Factory) Close() {
+	f.Lock()
+	defer f.Unlock()
+	if f.db != nil {
+		f.db.Close()
+		f.db = nil
+	}
+}
+
+// getDB returns a database reference for a new store. The factory holds one
+// reference of its own, so the database stays open until the factory is closed
+// even if all stores are closed before.
+func (f *Factory) getDB() (*DB, error) {
+	f.Lock()
+	defer f.Unlock()
+	if f.db == nil {
+		db, err := OpenDB(f.cfg, f.logger)
+		if err != nil {
+			return nil, err
+		}
+		f.db = db
+	}
+	f.db.Acquire()
+	return f.db, nil
+}
@@ -0,0 +1,329 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package kv
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dgraph-io/badger/v3"
+	"go.temporal.io/api/serviceerror"
+
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives"
+)
+
+type (
+	historyNodeRecord struct {
+		NodeID    int64
+		TxnID     int64
+		PrevTxnID int64
+		Events    blobRecord
+	}
+
+	historyTreeRecord struct {
+		TreeID   string
+		BranchID string
+		Info     blobRecord
+	}
+)
+
+// AppendHistoryNodes add(or override) a node to a history branch
+func (m *kvExecutionStore) AppendHistoryNodes(
+	ctx context.Context,
+	request *p.InternalAppendHistoryNodesRequest,
+) error {
+	branchInfo := request.BranchInfo
+	node := request.Node
+	nodeKey := historyNodeKey(request.ShardID, branchInfo.GetTreeId(), branchInfo.GetBranchId(), node.NodeID, node.TransactionID)
+	nodeRecord := &historyNodeRecord{
+		NodeID:    node.NodeID,
+		TxnID:     node.TransactionID,
+		PrevTxnID: node.PrevTransactionID,
+		Events:    newBlobRecord(node.Events),
+	}
+
+	err := m.DB.Update(ctx, "AppendHistoryNodes", func(txn *badger.Txn) error {
+		if !request.IsNewBranch {
+			exists, err := Exists(txn, nodeKey)
+			if err != nil {
+				return err
+			}
+			if exists {
+				return &p.ConditionFailedError{Msg: "AppendHistoryNodes: node already exist"}
+			}
+			return Put(txn, nodeKey, nodeRecord)
+		}
+
+		if err := Put(txn, nodeKey, nodeRecord); err != nil {
+			return err
+		}
+		return Put(txn, historyTreeKey(request.ShardID, branchInfo.GetTreeId(), branchInfo.GetBranchId()), &historyTreeRecord{
+			TreeID:   branchInfo.GetTreeId(),
+			BranchID: branchInfo.GetBranchId(),
+			Info:     newBlobRecord(request.TreeInfo),
+		})
+	})
+	switch err {
+	case context.DeadlineExceeded, context.Canceled:
+		return &p.AppendHistoryTimeoutError{
+			Msg: err.Error(),
+		}
+	default:
+		return err
+	}
+}
+
+func (m *kvExecutionStore) DeleteHistoryNodes(
+	ctx context.Context,
+	request *p.InternalDeleteHistoryNodesRequest,
+) error {
+	branchInfo := request.BranchInfo
+	if request.NodeID < p.GetBeginNodeID(branchInfo) {
+		return &p.InvalidPersistenceRequestError{
+			Msg: "cannot append to ancestors' nodes",
+		}
+	}
+
+	return m.DB.Update(ctx, "DeleteHistoryNodes", func(txn *badger.Txn) error {
+		return txn.Delete(historyNodeKey(request.ShardID, branchInfo.GetTreeId(), branchInfo.GetBranchId(), request.NodeID, request.TransactionID))
+	})
+}
+
+// ParseHistoryBranchInfo parses the history branch for branch information
+func (m *kvExecutionStore) ParseHistoryBranchInfo(
+	ctx context.Context,
+	request *p.ParseHistoryBranchInfoRequest,
+) (*p.ParseHistoryBranchInfoResponse, error) {
+
+	branchInfo, err := p.ParseHistoryBranchToken(request.BranchToken)
+	if err != nil {
+		return nil, err
+	}
+	return &p.ParseHistoryBranchInfoResponse{
+		BranchInfo: branchInfo,
+	}, nil
+}
+
+// UpdateHistoryBranchInfo updates the history branch with branch information
+func (m *kvExecutionStore) UpdateHistoryBranchInfo(
+	ctx context.Context,
+	request *p.UpdateHistoryBranchInfoRequest,
+) (*p.UpdateHistoryBranchInfoResponse, error) {
+
+	branchToken, err := p.UpdateHistoryBranchToken(request.BranchToken, request.BranchInfo)
+	if err != nil {
+		return nil, err
+	}
+	return &p.UpdateHistoryBranchInfoResponse{
+		BranchToken: branchToken,
+	}, nil
+}
+
+// NewHistoryBranch initializes a new history branch
+func (m *kvExecutionStore) NewHistoryBranch(
+	ctx context.Context,
+	request *p.NewHistoryBranchRequest,
+) (*p.NewHistoryBranchResponse, error) {
+	var branchID string
+	if request.BranchID == nil {
+		branchID = primitives.NewUUID().String()
+	} else {
+		branchID = *request.BranchID
+	}
+	branchToken, err := p.NewHistoryBranchToken(request.TreeID, branchID, request.Ancestors)
+	if err != nil {
+		return nil, err
+	}
+	return &p.NewHistoryBranchResponse{
+		BranchToken: branchToken,
+	}, nil
+}
+
+// ReadHistoryBranch returns history node data for a branch
+func (m *kvExecutionStore) ReadHistoryBranch(
+	ctx context.Context,
+	request *p.InternalReadHistoryBranchRequest,
+) (*p.InternalReadHistoryBranchResponse, error) {
+	prefix := historyNodePrefix(request.ShardID, request.TreeID, request.BranchID)
+	start := prefix.Int64(request.MinNodeID)
+	end := prefix.Int64(request.MaxNodeID)
+
+	response := &p.InternalReadHistoryBranchResponse{}
+	appendNode := func(value []byte) error {
+		var record historyNodeRecord
+		if err := Decode(value, &record); err != nil {
+			return err
+		}
+		node := p.InternalHistoryNode{
+			NodeID:            record.NodeID,
+			PrevTransactionID: record.PrevTxnID,
+			TransactionID:     record.TxnID,
+		}
+		if !request.MetadataOnly {
+			node.Events = record.Events.toBlob()
+		}
+		response.Nodes = append(response.Nodes, node)
+		return nil
+	}
+
+	if err := m.DB.View(ctx, "ReadHistoryBranch", func(txn *badger.Txn) error {
+		if !request.ReverseOrder {
+			nextPageToken, err := ScanPage(txn, start, end, request.PageSize, request.NextPageToken, func(_ Key, value []byte) error {
+				return appendNode(value)
+			})
+			response.NextPageToken = nextPageToken
+			return err
+		}
+
+		// reverse pages end before the first node returned by the previous page
+		if len(request.NextPageToken) > 0 {
+			resume := Key(request.NextPageToken)
+			if resume.Compare(start) < 0 || resume.Compare(end) > 0 {
+				return serviceerror.NewInvalidArgument("invalid page token")
+			}
+			end = resume
+		}
+		var lastKey Key
+		if err := Scan(txn, start, end, true, func(key Key, value []byte) (bool, error) {
+			if err := appendNode(value); err != nil {
+				return false, err
+			}
+			lastKey = key
+			return len(response.Nodes) < request.PageSize, nil
+		}); err != nil {
+			return err
+		}
+		if len(response.Nodes) >= request.PageSize {
+			response.NextPageToken = lastKey
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// ForkHistoryBranch forks a new branch from an existing branch
+// Note that application must provide a void forking nodeID, it must be a valid nodeID in that branch.
+// See the SQL store for a detailed description of the branch layout.
+func (m *kvExecutionStore) ForkHistoryBranch(
+	ctx context.Context,
+	request *p.InternalForkHistoryBranchRequest,
+) error {
+	treeID := request.ForkBranchInfo.GetTreeId()
+	return m.DB.Update(ctx, "ForkHistoryBranch", func(txn *badger.Txn) error {
+		return Put(txn, historyTreeKey(request.ShardID, treeID, request.NewBranchID), &historyTreeRecord{
+			TreeID:   treeID,
+			BranchID: request.NewBranchID,
+			Info:     newBlobRecord(request.TreeInfo),
+		})
+	})
+}
+
+// DeleteHistoryBranch removes a branch
+func (m *kvExecutionStore) DeleteHistoryBranch(
+	ctx context.Context,
+	request *p.InternalDeleteHistoryBranchRequest,
+) error {
+	// delete each branch range before the branch itself, so an interrupted
+	// delete leaves the branch behind for the scavenger to retry
+	for _, br := range request.BranchRanges {
+		prefix := historyNodePrefix(request.ShardID, request.TreeId, br.BranchId)
+		if err := m.DB.DeleteRange(ctx, "DeleteHistoryBranch", prefix.Int64(br.BeginNodeId), prefix.PrefixEnd()); err != nil {
+			return err
+		}
+	}
+	return m.DB.Update(ctx, "DeleteHistoryBranch", func(txn *badger.Txn) error {
+		return txn.Delete(historyTreeKey(request.ShardID, request.TreeId, request.BranchId))
+	})
+}
+
+func (m *kvExecutionStore) GetAllHistoryTreeBranches(
+	ctx context.Context,
+	request *p.GetAllHistoryTreeBranchesRequest,
+) (*p.InternalGetAllHistoryTreeBranchesResponse, error) {
+	pageSize := request.PageSize
+	if pageSize <= 0 {
+		return nil, fmt.Errorf("PageSize must be greater than 0, but was %d", pageSize)
+	}
+
+	response := &p.InternalGetAllHistoryTreeBranchesResponse{
+		Branches: make([]p.InternalHistoryBranchDetail, 0, pageSize),
+	}
+	if err := m.DB.View(ctx, "GetAllHistoryTreeBranches", func(txn *badger.Txn) error {
+		prefix := NewKey(TableHistoryTree)
+		nextPageToken, err := ScanPage(txn, prefix, prefix.PrefixEnd(), pageSize, request.NextPageToken, func(_ Key, value []byte) error {
+			var record historyTreeRecord
+			if err := Decode(value, &record); err != nil {
+				return err
+			}
+			response.Branches = append(response.Branches, p.InternalHistoryBranchDetail{
+				TreeID:   record.TreeID,
+				BranchID: record.BranchID,
+				Data:     record.Info.Data,
+				Encoding: record.Info.Encoding,
+			})
+			return nil
+		})
+		response.NextPageToken = nextPageToken
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// GetHistoryTree returns all branch information of a tree
+func (m *kvExecutionStore) GetHistoryTree(
+	ctx context.Context,
+	request *p.GetHistoryTreeRequest,
+) (*p.InternalGetHistoryTreeResponse, error) {
+	response := &p.InternalGetHistoryTreeResponse{}
+	if err := m.DB.View(ctx, "GetHistoryTree", func(txn *badger.Txn) error {
+		prefix := NewKey(TableHistoryTree).Int32(*request.ShardID).String(request.TreeID)
+		return ScanRecords(txn, prefix, prefix.PrefixEnd(), false, func(_ Key, record *historyTreeRecord) (bool, error) {
+			response.TreeInfos = append(response.TreeInfos, record.Info.toBlob())
+			return true, nil
+		})
+	}); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func historyNodePrefix(shardID int32, treeID string, branchID string) Key {
+	return NewKey(TableHistoryNode).Int32(shardID).String(treeID).String(branchID)
+}
+
+// historyNodeKey orders the nodes of a branch by node ID, and the transactions
+// of the same node with the latest first
+func historyNodeKey(shardID int32, treeID string, branchID string, nodeID int64, txnID int64) Key {
+	return historyNodePrefix(shardID, treeID, branchID).Int64(nodeID).Int64(-txnID)
+}
+
+func historyTreeKey(shardID int32, treeID string, branchID string) Key {
+	return NewKey(TableHistoryTree).Int32(shardID).String(treeID).String(branchID)
+}
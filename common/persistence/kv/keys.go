@@ -0,0 +1,177 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package kv
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"time"
+)
+
+type (
+	// Table identifies the key space a record lives in. It is the first byte of every key.
+	Table byte
+
+	// Key is an order preserving encoding of a tuple. Keys of the same table sort
+	// the way their tuples would sort field by field, so range scans over a key
+	// prefix visit rows in primary key order.
+	Key []byte
+)
+
+const (
+	TableShard Table = iota + 1
+	TableExecution
+	TableCurrentExecution
+	TableMutableStateMap
+	TableImmediateTask
+	TableScheduledTask
+	TableReplicationDLQ
+	TableHistoryNode
+	TableHistoryTree
+	TableTaskQueue
+	TableTask
+	TableNamespace
+	TableNamespaceName
+	TableNamespaceMetadata
+	TableClusterMetadata
+	TableClusterMembership
+	TableQueueMessage
+	TableQueueMessageSeq
+	TableQueueMetadata
+	TableVisibility
+	TableVisibilityOpen
+	TableVisibilityClosed
+)
+
+const (
+	stringEscape     byte = 0x00
+	stringEscapedNul byte = 0xff
+	stringTerminator byte = 0x01
+)
+
+// NewKey returns the key prefix of the given table
+func NewKey(table Table) Key {
+	return Key{byte(table)}
+}
+
+// String appends a string; strings are escaped and terminated so no encoded
+// string is a prefix of another
+func (k Key) String(s string) Key {
+	k = k.detach()
+	for i := 0; i < len(s); i++ {
+		if s[i] == stringEscape {
+			k = append(k, stringEscape, stringEscapedNul)
+			continue
+		}
+		k = append(k, s[i])
+	}
+	return append(k, stringEscape, stringTerminator)
+}
+
+// Int64 appends a signed integer
+func (k Key) Int64(v int64) Key {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], uint64(v)^(1<<63))
+	return append(k.detach(), buf[:]...)
+}
+
+// Int32 appends a signed integer
+func (k Key) Int32(v int32) Key {
+	var buf [4]byte
+	binary.BigEndian.PutUint32(buf[:], uint32(v)^(1<<31))
+	return append(k.detach(), buf[:]...)
+}
+
+// Uint8 appends a single byte
+func (k Key) Uint8(v uint8) Key {
+	return append(k.detach(), v)
+}
+
+// Time appends a timestamp with nanosecond precision
+func (k Key) Time(t time.Time) Key {
+	return k.Int64(UnixNano(t))
+}
+
+// DescTime appends a timestamp such that later times sort first
+func (k Key) DescTime(t time.Time) Key {
+	return k.Int64(^UnixNano(t))
+}
+
+// detach caps k so appending to it never writes into memory shared with
+// another key built from the same prefix
+func (k Key) detach() Key {
+	return k[:len(k):len(k)]
+}
+
+// Compare compares two keys lexicographically
+func (k Key) Compare(other Key) int {
+	return bytes.Compare(k, other)
+}
+
+// HasPrefix reports whether k starts with prefix
+func (k Key) HasPrefix(prefix Key) bool {
+	return bytes.HasPrefix(k, prefix)
+}
+
+// PrefixEnd returns the smallest key that is greater than every key starting with k
+func (k Key) PrefixEnd() Key {
+	end := append(Key(nil), k...)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	// all bytes are 0xff; there is no upper bound
+	return nil
+}
+
+// Next returns the smallest key that is greater than k
+func (k Key) Next() Key {
+	return append(append(Key(nil), k...), 0)
+}
+
+// UnixNano converts t to nanoseconds since epoch, clamping times outside of the int64 range
+func UnixNano(t time.Time) int64 {
+	switch {
+	case t.Before(minTime):
+		return math.MinInt64
+	case t.After(maxTime):
+		return math.MaxInt64
+	default:
+		return t.UnixNano()
+	}
+}
+
+// FromUnixNano is the inverse of UnixNano
+func FromUnixNano(n int64) time.Time {
+	return time.Unix(0, n).UTC()
+}
+
+var (
+	minTime = time.Unix(0, math.MinInt64)
+	maxTime = time.Unix(0, math.MaxInt64)
+)
@@ -0,0 +1,88 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package kv
+
+import (
+	"os"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+)
+
+// TestCluster allows executing key-value store operations in testing.
+type TestCluster struct {
+	cfg    config.KV
+	logger log.Logger
+}
+
+// NewTestCluster returns a new key-value test cluster. An empty path keeps
+// all data in memory.
+func NewTestCluster(
+	path string,
+	logger log.Logger,
+) *TestCluster {
+	return &TestCluster{
+		cfg: config.KV{
+			Path:     path,
+			InMemory: path == "",
+		},
+		logger: logger,
+	}
+}
+
+// SetupTestDatabase from PersistenceTestCluster interface
+func (s *TestCluster) SetupTestDatabase() {
+	if s.cfg.InMemory {
+		return
+	}
+	if err := os.MkdirAll(s.cfg.Path, 0700); err != nil {
+		panic(err)
+	}
+}
+
+// Config returns the persistence config for connecting to this test cluster
+func (s *TestCluster) Config() config.Persistence {
+	cfg := s.cfg
+	return config.Persistence{
+		DefaultStore:    "test",
+		VisibilityStore: "test",
+		DataStores: map[string]config.DataStore{
+			"test": {KV: &cfg},
+		},
+		TransactionSizeLimit: dynamicconfig.GetIntPropertyFn(common.DefaultTransactionSizeLimit),
+	}
+}
+
+// TearDownTestDatabase from PersistenceTestCluster interface
+func (s *TestCluster) TearDownTestDatabase() {
+	if s.cfg.InMemory {
+		return
+	}
+	if err := os.RemoveAll(s.cfg.Path); err != nil {
+		panic(err)
+	}
+}
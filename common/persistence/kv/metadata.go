@@ -0,0 +1,314 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package kv
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dgraph-io/badger/v3"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/log"
+	p "go.temporal.io/server/common/persistence"
+)
+
+type (
+	kvMetadataStore struct {
+		Store
+	}
+
+	namespaceRecord struct {
+		ID                  string
+		Name                string
+		Info                blobRecord
+		IsGlobal            bool
+		NotificationVersion int64
+	}
+)
+
+var _ p.MetadataStore = (*kvMetadataStore)(nil)
+
+// newMetadataPersistence creates an instance of MetadataStore
+func newMetadataPersistence(
+	db *DB,
+	logger log.Logger,
+) p.MetadataStore {
+	return &kvMetadataStore{
+		Store: NewStore(db, logger),
+	}
+}
+
+func (m *kvMetadataStore) CreateNamespace(
+	ctx context.Context,
+	request *p.InternalCreateNamespaceRequest,
+) (*p.CreateNamespaceResponse, error) {
+	if err := m.DB.Update(ctx, "CreateNamespace", func(txn *badger.Txn) error {
+		notificationVersion, err := getNamespaceNotificationVersion(txn)
+		if err != nil {
+			return err
+		}
+
+		for _, key := range []Key{namespaceKey(request.ID), namespaceNameKey(request.Name)} {
+			exists, err := Exists(txn, key)
+			if err != nil {
+				return err
+			}
+			if exists {
+				return serviceerror.NewNamespaceAlreadyExists(fmt.Sprintf("name: %v", request.Name))
+			}
+		}
+
+		if err := putNamespace(txn, &namespaceRecord{
+			ID:                  request.ID,
+			Name:                request.Name,
+			Info:                newBlobRecord(request.Namespace),
+			IsGlobal:            request.IsGlobal,
+			NotificationVersion: notificationVersion,
+		}); err != nil {
+			return err
+		}
+		return Put(txn, NewKey(TableNamespaceMetadata), notificationVersion+1)
+	}); err != nil {
+		return nil, err
+	}
+	return &p.CreateNamespaceResponse{ID: request.ID}, nil
+}
+
+func (m *kvMetadataStore) GetNamespace(
+	ctx context.Context,
+	request *p.GetNamespaceRequest,
+) (*p.InternalGetNamespaceResponse, error) {
+	switch {
+	case request.Name != "" && request.ID != "":
+		return nil, serviceerror.NewInvalidArgument("GetNamespace operation failed.  Both ID and Name specified in request.")
+	case request.Name == "" && request.ID == "":
+		return nil, serviceerror.NewInvalidArgument("GetNamespace operation failed.  Both ID and Name are empty.")
+	}
+
+	var record *namespaceRecord
+	if err := m.DB.View(ctx, "GetNamespace", func(txn *badger.Txn) error {
+		id := request.ID
+		if request.Name != "" {
+			found, err := Get(txn, namespaceNameKey(request.Name), &id)
+			if err != nil || !found {
+				return err
+			}
+		}
+		var err error
+		record, err = getNamespace(txn, id)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	if record == nil {
+		identity := request.Name
+		if len(request.ID) > 0 {
+			identity = request.ID
+		}
+		return nil, serviceerror.NewNamespaceNotFound(identity)
+	}
+	return record.toResponse(), nil
+}
+
+func (m *kvMetadataStore) UpdateNamespace(
+	ctx context.Context,
+	request *p.InternalUpdateNamespaceRequest,
+) error {
+	return m.updateNamespace(ctx, request, "UpdateNamespace")
+}
+
+func (m *kvMetadataStore) RenameNamespace(
+	ctx context.Context,
+	request *p.InternalRenameNamespaceRequest,
+) error {
+	return m.updateNamespace(ctx, request.InternalUpdateNamespaceRequest, "RenameNamespace")
+}
+
+func (m *kvMetadataStore) updateNamespace(
+	ctx context.Context,
+	request *p.InternalUpdateNamespaceRequest,
+	operationName string,
+) error {
+	return m.DB.Update(ctx, operationName, func(txn *badger.Txn) error {
+		notificationVersion, err := getNamespaceNotificationVersion(txn)
+		if err != nil {
+			return err
+		}
+		if notificationVersion != request.NotificationVersion {
+			return fmt.Errorf(
+				"conditional update error: expect: %v, actual: %v",
+				request.NotificationVersion,
+				notificationVersion,
+			)
+		}
+
+		record, err := getNamespace(txn, request.Id)
+		if err != nil {
+			return err
+		}
+		if record == nil {
+			return serviceerror.NewNamespaceNotFound(request.Id)
+		}
+		if record.Name != request.Name {
+			exists, err := Exists(txn, namespaceNameKey(request.Name))
+			if err != nil {
+				return err
+			}
+			if exists {
+				return serviceerror.NewNamespaceAlreadyExists(fmt.Sprintf("name: %v", request.Name))
+			}
+			if err := txn.Delete(namespaceNameKey(record.Name)); err != nil {
+				return err
+			}
+		}
+
+		if err := putNamespace(txn, &namespaceRecord{
+			ID:                  request.Id,
+			Name:                request.Name,
+			Info:                newBlobRecord(request.Namespace),
+			IsGlobal:            request.IsGlobal,
+			NotificationVersion: request.NotificationVersion,
+		}); err != nil {
+			return err
+		}
+		return Put(txn, NewKey(TableNamespaceMetadata), notificationVersion+1)
+	})
+}
+
+func (m *kvMetadataStore) DeleteNamespace(
+	ctx context.Context,
+	request *p.DeleteNamespaceRequest,
+) error {
+	return m.DB.Update(ctx, "DeleteNamespace", func(txn *badger.Txn) error {
+		record, err := getNamespace(txn, request.ID)
+		if err != nil || record == nil {
+			return err
+		}
+		return deleteNamespace(txn, record)
+	})
+}
+
+func (m *kvMetadataStore) DeleteNamespaceByName(
+	ctx context.Context,
+	request *p.DeleteNamespaceByNameRequest,
+) error {
+	return m.DB.Update(ctx, "DeleteNamespaceByName", func(txn *badger.Txn) error {
+		var id string
+		found, err := Get(txn, namespaceNameKey(request.Name), &id)
+		if err != nil || !found {
+			return err
+		}
+		record, err := getNamespace(txn, id)
+		if err != nil || record == nil {
+			return err
+		}
+		return deleteNamespace(txn, record)
+	})
+}
+
+func (m *kvMetadataStore) GetMetadata(
+	ctx context.Context,
+) (*p.GetMetadataResponse, error) {
+	var notificationVersion int64
+	if err := m.DB.View(ctx, "GetMetadata", func(txn *badger.Txn) error {
+		var err error
+		notificationVersion, err = getNamespaceNotificationVersion(txn)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return &p.GetMetadataResponse{NotificationVersion: notificationVersion}, nil
+}
+
+func (m *kvMetadataStore) ListNamespaces(
+	ctx context.Context,
+	request *p.InternalListNamespacesRequest,
+) (*p.InternalListNamespacesResponse, error) {
+	response := &p.InternalListNamespacesResponse{}
+	if err := m.DB.View(ctx, "ListNamespaces", func(txn *badger.Txn) error {
+		prefix := NewKey(TableNamespace)
+		nextPageToken, err := ScanPage(txn, prefix, prefix.PrefixEnd(), request.PageSize, request.NextPageToken, func(_ Key, value []byte) error {
+			var record namespaceRecord
+			if err := Decode(value, &record); err != nil {
+				return err
+			}
+			response.Namespaces = append(response.Namespaces, record.toResponse())
+			return nil
+		})
+		response.NextPageToken = nextPageToken
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (r *namespaceRecord) toResponse() *p.InternalGetNamespaceResponse {
+	return &p.InternalGetNamespaceResponse{
+		Namespace:           r.Info.toBlob(),
+		IsGlobal:            r.IsGlobal,
+		NotificationVersion: r.NotificationVersion,
+	}
+}
+
+func getNamespace(txn *badger.Txn, id string) (*namespaceRecord, error) {
+	var record namespaceRecord
+	found, err := Get(txn, namespaceKey(id), &record)
+	if err != nil || !found {
+		return nil, err
+	}
+	return &record, nil
+}
+
+func putNamespace(txn *badger.Txn, record *namespaceRecord) error {
+	if err := Put(txn, namespaceKey(record.ID), record); err != nil {
+		return err
+	}
+	return Put(txn, namespaceNameKey(record.Name), record.ID)
+}
+
+func deleteNamespace(txn *badger.Txn, record *namespaceRecord) error {
+	if err := txn.Delete(namespaceKey(record.ID)); err != nil {
+		return err
+	}
+	return txn.Delete(namespaceNameKey(record.Name))
+}
+
+// getNamespaceNotificationVersion returns the version of the namespace metadata,
+// which is incremented by every namespace create and update
+func getNamespaceNotificationVersion(txn *badger.Txn) (int64, error) {
+	var notificationVersion int64
+	_, err := Get(txn, NewKey(TableNamespaceMetadata), &notificationVersion)
+	return notificationVersion, err
+}
+
+func namespaceKey(id string) Key {
+	return NewKey(TableNamespace).String(id)
+}
+
+func namespaceNameKey(name string) Key {
+	return NewKey(TableNamespaceName).String(name)
+}
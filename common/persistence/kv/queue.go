@@ -0,0 +1,313 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package kv
+
+import (
+	"context"
+	"math"
+
+	"github.com/dgraph-io/badger/v3"
+	commonpb "go.temporal.io/api/common/v1"
+
+	"go.temporal.io/server/common/log"
+	p "go.temporal.io/server/common/persistence"
+)
+
+type (
+	kvQueue struct {
+		queueType p.QueueType
+		Store
+	}
+
+	queueMessageRecord struct {
+		ID   int64
+		Blob blobRecord
+	}
+
+	queueMetadataRecord struct {
+		Blob    blobRecord
+		Version int64
+	}
+)
+
+var _ p.Queue = (*kvQueue)(nil)
+
+func newQueue(
+	db *DB,
+	logger log.Logger,
+	queueType p.QueueType,
+) p.Queue {
+	return &kvQueue{
+		Store:     NewStore(db, logger),
+		queueType: queueType,
+	}
+}
+
+func (q *kvQueue) Init(
+	ctx context.Context,
+	blob *commonpb.DataBlob,
+) error {
+	return q.DB.Update(ctx, "Init", func(txn *badger.Txn) error {
+		for _, queueType := range []p.QueueType{q.queueType, q.getDLQTypeFromQueueType()} {
+			exists, err := Exists(txn, queueMetadataKey(queueType))
+			if err != nil {
+				return err
+			}
+			if exists {
+				continue
+			}
+			if err := Put(txn, queueMetadataKey(queueType), &queueMetadataRecord{
+				Blob: newBlobRecord(blob),
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (q *kvQueue) EnqueueMessage(
+	ctx context.Context,
+	blob commonpb.DataBlob,
+) error {
+	_, err := q.enqueue(ctx, "EnqueueMessage", q.queueType, &blob)
+	return err
+}
+
+func (q *kvQueue) ReadMessages(
+	ctx context.Context,
+	lastMessageID int64,
+	pageSize int,
+) ([]*p.QueueMessage, error) {
+	messages, _, err := q.readMessages(ctx, "ReadMessages", q.queueType, lastMessageID, p.MaxQueueMessageID, pageSize, nil)
+	return messages, err
+}
+
+func (q *kvQueue) DeleteMessagesBefore(
+	ctx context.Context,
+	messageID int64,
+) error {
+	return q.DB.DeleteRange(ctx,
+		"DeleteMessagesBefore",
+		queueMessageKey(q.queueType, math.MinInt64),
+		queueMessageKey(q.queueType, messageID),
+	)
+}
+
+func (q *kvQueue) UpdateAckLevel(
+	ctx context.Context,
+	metadata *p.InternalQueueMetadata,
+) error {
+	return q.updateAckLevel(ctx, "UpdateAckLevel", q.queueType, metadata, true)
+}
+
+func (q *kvQueue) GetAckLevels(
+	ctx context.Context,
+) (*p.InternalQueueMetadata, error) {
+	return q.getAckLevels(ctx, "GetAckLevels", q.queueType)
+}
+
+func (q *kvQueue) EnqueueMessageToDLQ(
+	ctx context.Context,
+	blob commonpb.DataBlob,
+) (int64, error) {
+	return q.enqueue(ctx, "EnqueueMessageToDLQ", q.getDLQTypeFromQueueType(), &blob)
+}
+
+func (q *kvQueue) ReadMessagesFromDLQ(
+	ctx context.Context,
+	firstMessageID int64,
+	lastMessageID int64,
+	pageSize int,
+	pageToken []byte,
+) ([]*p.QueueMessage, []byte, error) {
+	return q.readMessages(ctx, "ReadMessagesFromDLQ", q.getDLQTypeFromQueueType(), firstMessageID, lastMessageID, pageSize, pageToken)
+}
+
+func (q *kvQueue) DeleteMessageFromDLQ(
+	ctx context.Context,
+	messageID int64,
+) error {
+	return q.DB.Update(ctx, "DeleteMessageFromDLQ", func(txn *badger.Txn) error {
+		return txn.Delete(queueMessageKey(q.getDLQTypeFromQueueType(), messageID))
+	})
+}
+
+func (q *kvQueue) RangeDeleteMessagesFromDLQ(
+	ctx context.Context,
+	firstMessageID int64,
+	lastMessageID int64,
+) error {
+	queueType := q.getDLQTypeFromQueueType()
+	return q.DB.DeleteRange(ctx,
+		"RangeDeleteMessagesFromDLQ",
+		queueMessageKey(queueType, firstMessageID).Next(),
+		queueMessageKey(queueType, lastMessageID).Next(),
+	)
+}
+
+func (q *kvQueue) UpdateDLQAckLevel(
+	ctx context.Context,
+	metadata *p.InternalQueueMetadata,
+) error {
+	return q.updateAckLevel(ctx, "UpdateDLQAckLevel", q.getDLQTypeFromQueueType(), metadata, false)
+}
+
+func (q *kvQueue) GetDLQAckLevels(
+	ctx context.Context,
+) (*p.InternalQueueMetadata, error) {
+	return q.getAckLevels(ctx, "GetDLQAckLevels", q.getDLQTypeFromQueueType())
+}
+
+func (q *kvQueue) getDLQTypeFromQueueType() p.QueueType {
+	return -q.queueType
+}
+
+// enqueue appends a message to the queue and returns its ID. Message IDs are
+// never reused, even after all messages of the queue are deleted.
+func (q *kvQueue) enqueue(
+	ctx context.Context,
+	operation string,
+	queueType p.QueueType,
+	blob *commonpb.DataBlob,
+) (int64, error) {
+	var messageID int64
+	if err := q.DB.Update(ctx, operation, func(txn *badger.Txn) error {
+		lastMessageID := int64(p.EmptyQueueMessageID)
+		if _, err := Get(txn, queueMessageSeqKey(queueType), &lastMessageID); err != nil {
+			return err
+		}
+		messageID = lastMessageID + 1
+		if err := Put(txn, queueMessageSeqKey(queueType), messageID); err != nil {
+			return err
+		}
+		return Put(txn, queueMessageKey(queueType, messageID), &queueMessageRecord{
+			ID:   messageID,
+			Blob: newBlobRecord(blob),
+		})
+	}); err != nil {
+		return p.EmptyQueueMessageID, err
+	}
+	return messageID, nil
+}
+
+// readMessages returns the messages with IDs in (afterMessageID, lastMessageID]
+func (q *kvQueue) readMessages(
+	ctx context.Context,
+	operation string,
+	queueType p.QueueType,
+	afterMessageID int64,
+	lastMessageID int64,
+	pageSize int,
+	pageToken []byte,
+) ([]*p.QueueMessage, []byte, error) {
+	var messages []*p.QueueMessage
+	var nextPageToken []byte
+	if err := q.DB.View(ctx, operation, func(txn *badger.Txn) error {
+		var err error
+		nextPageToken, err = ScanPage(txn,
+			queueMessageKey(queueType, afterMessageID).Next(),
+			queueMessageKey(queueType, lastMessageID).Next(),
+			pageSize,
+			pageToken,
+			func(_ Key, value []byte) error {
+				var record queueMessageRecord
+				if err := Decode(value, &record); err != nil {
+					return err
+				}
+				messages = append(messages, &p.QueueMessage{
+					QueueType: queueType,
+					ID:        record.ID,
+					Data:      record.Blob.Data,
+					Encoding:  record.Blob.Encoding,
+				})
+				return nil
+			},
+		)
+		return err
+	}); err != nil {
+		return nil, nil, err
+	}
+	return messages, nextPageToken, nil
+}
+
+func (q *kvQueue) updateAckLevel(
+	ctx context.Context,
+	operation string,
+	queueType p.QueueType,
+	metadata *p.InternalQueueMetadata,
+	conditional bool,
+) error {
+	return q.DB.Update(ctx, operation, func(txn *badger.Txn) error {
+		var record queueMetadataRecord
+		found, err := Get(txn, queueMetadataKey(queueType), &record)
+		if err != nil {
+			return err
+		}
+		if !found || (conditional && record.Version != metadata.Version) {
+			return &p.ConditionFailedError{Msg: operation + " operation encountered concurrent write."}
+		}
+		return Put(txn, queueMetadataKey(queueType), &queueMetadataRecord{
+			Blob:    newBlobRecord(metadata.Blob),
+			Version: record.Version + 1,
+		})
+	})
+}
+
+func (q *kvQueue) getAckLevels(
+	ctx context.Context,
+	operation string,
+	queueType p.QueueType,
+) (*p.InternalQueueMetadata, error) {
+	var record queueMetadataRecord
+	if err := q.DB.View(ctx, operation, func(txn *badger.Txn) error {
+		found, err := Get(txn, queueMetadataKey(queueType), &record)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return &p.ConditionFailedError{Msg: operation + " operation failed. Queue metadata not found."}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &p.InternalQueueMetadata{
+		Blob:    record.Blob.toBlob(),
+		Version: record.Version,
+	}, nil
+}
+
+func queueMessageKey(queueType p.QueueType, messageID int64) Key {
+	return NewKey(TableQueueMessage).Int32(int32(queueType)).Int64(messageID)
+}
+
+func queueMessageSeqKey(queueType p.QueueType) Key {
+	return NewKey(TableQueueMessageSeq).Int32(int32(queueType))
+}
+
+func queueMetadataKey(queueType p.QueueType) Key {
+	return NewKey(TableQueueMetadata).Int32(int32(queueType))
+}
@@ -0,0 +1,287 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package kv
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dgraph-io/badger/v3"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/log"
+	p "go.temporal.io/server/common/persistence"
+)
+
+type (
+	kvTaskStore struct {
+		Store
+	}
+
+	taskQueueRecord struct {
+		RangeID int64
+		Info    blobRecord
+	}
+)
+
+var _ p.TaskStore = (*kvTaskStore)(nil)
+
+// newTaskPersistence creates a new instance of TaskStore
+func newTaskPersistence(
+	db *DB,
+	logger log.Logger,
+) p.TaskStore {
+	return &kvTaskStore{
+		Store: NewStore(db, logger),
+	}
+}
+
+func (m *kvTaskStore) CreateTaskQueue(
+	ctx context.Context,
+	request *p.InternalCreateTaskQueueRequest,
+) error {
+	key := taskQueueKey(request.NamespaceID, request.TaskQueue, request.TaskType)
+	return m.DB.Update(ctx, "CreateTaskQueue", func(txn *badger.Txn) error {
+		exists, err := Exists(txn, key)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return &p.ConditionFailedError{
+				Msg: fmt.Sprintf("CreateTaskQueue operation failed. Task queue %v of type %v already exists.", request.TaskQueue, request.TaskType),
+			}
+		}
+		return Put(txn, key, &taskQueueRecord{
+			RangeID: request.RangeID,
+			Info:    newBlobRecord(request.TaskQueueInfo),
+		})
+	})
+}
+
+func (m *kvTaskStore) GetTaskQueue(
+	ctx context.Context,
+	request *p.InternalGetTaskQueueRequest,
+) (*p.InternalGetTaskQueueResponse, error) {
+	var record taskQueueRecord
+	if err := m.DB.View(ctx, "GetTaskQueue", func(txn *badger.Txn) error {
+		found, err := Get(txn, taskQueueKey(request.NamespaceID, request.TaskQueue, request.TaskType), &record)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return serviceerror.NewNotFound(
+				fmt.Sprintf("GetTaskQueue operation failed. TaskQueue: %v, TaskQueueType: %v", request.TaskQueue, request.TaskType),
+			)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &p.InternalGetTaskQueueResponse{
+		RangeID:       record.RangeID,
+		TaskQueueInfo: record.Info.toBlob(),
+	}, nil
+}
+
+func (m *kvTaskStore) UpdateTaskQueue(
+	ctx context.Context,
+	request *p.InternalUpdateTaskQueueRequest,
+) (*p.UpdateTaskQueueResponse, error) {
+	key := taskQueueKey(request.NamespaceID, request.TaskQueue, request.TaskType)
+	if err := m.DB.Update(ctx, "UpdateTaskQueue", func(txn *badger.Txn) error {
+		if err := checkTaskQueueRangeID(txn, key, request.PrevRangeID); err != nil {
+			return err
+		}
+		return Put(txn, key, &taskQueueRecord{
+			RangeID: request.RangeID,
+			Info:    newBlobRecord(request.TaskQueueInfo),
+		})
+	}); err != nil {
+		return nil, err
+	}
+	return &p.UpdateTaskQueueResponse{}, nil
+}
+
+func (m *kvTaskStore) ListTaskQueue(
+	ctx context.Context,
+	request *p.ListTaskQueueRequest,
+) (*p.InternalListTaskQueueResponse, error) {
+	response := &p.InternalListTaskQueueResponse{}
+	if err := m.DB.View(ctx, "ListTaskQueue", func(txn *badger.Txn) error {
+		prefix := NewKey(TableTaskQueue)
+		nextPageToken, err := ScanPage(txn, prefix, prefix.PrefixEnd(), request.PageSize, request.PageToken, func(_ Key, value []byte) error {
+			var record taskQueueRecord
+			if err := Decode(value, &record); err != nil {
+				return err
+			}
+			response.Items = append(response.Items, &p.InternalListTaskQueueItem{
+				TaskQueue: record.Info.toBlob(),
+				RangeID:   record.RangeID,
+			})
+			return nil
+		})
+		response.NextPageToken = nextPageToken
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (m *kvTaskStore) DeleteTaskQueue(
+	ctx context.Context,
+	request *p.DeleteTaskQueueRequest,
+) error {
+	key := taskQueueKey(request.TaskQueue.NamespaceID, request.TaskQueue.TaskQueueName, request.TaskQueue.TaskQueueType)
+	return m.DB.Update(ctx, "DeleteTaskQueue", func(txn *badger.Txn) error {
+		if err := checkTaskQueueRangeID(txn, key, request.RangeID); err != nil {
+			return err
+		}
+		return txn.Delete(key)
+	})
+}
+
+func (m *kvTaskStore) CreateTasks(
+	ctx context.Context,
+	request *p.InternalCreateTasksRequest,
+) (*p.CreateTasksResponse, error) {
+	key := taskQueueKey(request.NamespaceID, request.TaskQueue, request.TaskType)
+	if err := m.DB.Update(ctx, "CreateTasks", func(txn *badger.Txn) error {
+		if err := checkTaskQueueRangeID(txn, key, request.RangeID); err != nil {
+			return err
+		}
+		prefix := taskPrefix(request.NamespaceID, request.TaskQueue, request.TaskType)
+		for _, task := range request.Tasks {
+			if err := Put(txn, prefix.Int64(task.TaskId), newBlobRecord(task.Task)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &p.CreateTasksResponse{}, nil
+}
+
+func (m *kvTaskStore) GetTasks(
+	ctx context.Context,
+	request *p.GetTasksRequest,
+) (*p.InternalGetTasksResponse, error) {
+	prefix := taskPrefix(request.NamespaceID, request.TaskQueue, request.TaskType)
+	response := &p.InternalGetTasksResponse{}
+	if err := m.DB.View(ctx, "GetTasks", func(txn *badger.Txn) error {
+		nextPageToken, err := ScanPage(txn,
+			prefix.Int64(request.InclusiveMinTaskID),
+			prefix.Int64(request.ExclusiveMaxTaskID),
+			request.PageSize,
+			request.NextPageToken,
+			func(_ Key, value []byte) error {
+				var record blobRecord
+				if err := Decode(value, &record); err != nil {
+					return err
+				}
+				response.Tasks = append(response.Tasks, record.toBlob())
+				return nil
+			},
+		)
+		response.NextPageToken = nextPageToken
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (m *kvTaskStore) CompleteTask(
+	ctx context.Context,
+	request *p.CompleteTaskRequest,
+) error {
+	prefix := taskPrefix(request.TaskQueue.NamespaceID, request.TaskQueue.TaskQueueName, request.TaskQueue.TaskQueueType)
+	return m.DB.Update(ctx, "CompleteTask", func(txn *badger.Txn) error {
+		return txn.Delete(prefix.Int64(request.TaskID))
+	})
+}
+
+func (m *kvTaskStore) CompleteTasksLessThan(
+	ctx context.Context,
+	request *p.CompleteTasksLessThanRequest,
+) (int, error) {
+	prefix := taskPrefix(request.NamespaceID, request.TaskQueueName, request.TaskType)
+	var keys []Key
+	if err := m.DB.View(ctx, "CompleteTasksLessThan", func(txn *badger.Txn) error {
+		return Scan(txn, prefix, prefix.Int64(request.ExclusiveMaxTaskID), false, func(key Key, _ []byte) (bool, error) {
+			keys = append(keys, key)
+			return request.Limit <= 0 || len(keys) < request.Limit, nil
+		})
+	}); err != nil {
+		return 0, err
+	}
+
+	if err := m.DB.Update(ctx, "CompleteTasksLessThan", func(txn *badger.Txn) error {
+		for _, key := range keys {
+			if err := txn.Delete(key); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return len(keys), nil
+}
+
+// checkTaskQueueRangeID verifies the task queue is still owned at rangeID
+func checkTaskQueueRangeID(
+	txn *badger.Txn,
+	key Key,
+	rangeID int64,
+) error {
+	var record taskQueueRecord
+	found, err := Get(txn, key, &record)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return &p.ConditionFailedError{
+			Msg: "Failed to lock task queue. Task queue does not exist.",
+		}
+	}
+	if record.RangeID != rangeID {
+		return &p.ConditionFailedError{
+			Msg: fmt.Sprintf("Task queue range ID was %v when it was should have been %v", record.RangeID, rangeID),
+		}
+	}
+	return nil
+}
+
+func taskQueueKey(namespaceID string, taskQueue string, taskType enumspb.TaskQueueType) Key {
+	return NewKey(TableTaskQueue).String(namespaceID).String(taskQueue).Int32(int32(taskType))
+}
+
+// taskPrefix is the common prefix of the keys of all tasks of a task queue
+func taskPrefix(namespaceID string, taskQueue string, taskType enumspb.TaskQueueType) Key {
+	return NewKey(TableTask).String(namespaceID).String(taskQueue).Int32(int32(taskType))
+}
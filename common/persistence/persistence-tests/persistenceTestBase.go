@@ -28,6 +28,8 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"os"
+	"path/filepath"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -48,6 +50,7 @@ import (
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/cassandra"
 	"go.temporal.io/server/common/persistence/client"
+	"go.temporal.io/server/common/persistence/kv"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/sql"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/mysql"
@@ -162,11 +165,25 @@ func NewTestBaseWithSQL(options *TestBaseOptions) TestBase {
 	return NewTestBaseForCluster(testCluster, logger)
 }
 
-// NewTestBase returns a persistence test base backed by either cassandra or sql
+// NewTestBaseWithKV returns a new persistence test base backed by the embedded key-value store.
+// Data is kept in memory unless a database name is given.
+func NewTestBaseWithKV(options *TestBaseOptions) TestBase {
+	path := ""
+	if options.DBName != "" {
+		path = filepath.Join(os.TempDir(), options.DBName)
+	}
+	logger := log.NewTestLogger()
+	testCluster := kv.NewTestCluster(path, logger)
+	return NewTestBaseForCluster(testCluster, logger)
+}
+
+// NewTestBase returns a persistence test base backed by either cassandra, sql or kv
 func NewTestBase(options *TestBaseOptions) TestBase {
 	switch options.StoreType {
 	case config.StoreTypeSQL:
 		return NewTestBaseWithSQL(options)
+	case config.StoreTypeKV:
+		return NewTestBaseWithKV(options)
 	case config.StoreTypeNoSQL:
 		return NewTestBaseWithCassandra(options)
 	default:
@@ -199,6 +216,8 @@ func (s *TestBase) Setup(clusterMetadataConfig *cluster.Config) {
 		resolver.NewNoopResolver(),
 		&cfg,
 		s.AbstractDataStoreFactory,
+		dynamicconfig.NewNoopCollection(),
+		nil,
 		s.Logger,
 		metrics.NoopMetricsHandler,
 	)
@@ -129,3 +129,18 @@ func GetSQLiteMemoryTestClusterOption() *TestBaseOptions {
 		ConnectAttributes: map[string]string{"mode": testSQLiteMode, "cache": testSQLiteCache},
 	}
 }
+
+// GetKVMemoryTestClusterOption return test options
+func GetKVMemoryTestClusterOption() *TestBaseOptions {
+	return &TestBaseOptions{
+		StoreType: config.StoreTypeKV,
+	}
+}
+
+// GetKVFileTestClusterOption return test options
+func GetKVFileTestClusterOption() *TestBaseOptions {
+	return &TestBaseOptions{
+		DBName:    "test_" + GenerateRandomDBName(3),
+		StoreType: config.StoreTypeKV,
+	}
+}
@@ -0,0 +1,172 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package serialization
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+	commonpb "go.temporal.io/api/common/v1"
+)
+
+const (
+	// CompressionZstd compresses blobs with zstd, which favors the compression ratio
+	CompressionZstd = "zstd"
+	// CompressionSnappy compresses blobs with snappy, which favors speed
+	CompressionSnappy = "snappy"
+)
+
+type (
+	// BlobCompressor compresses blobs and records the algorithm in the encoding metadata of the blob, so
+	// blobs written with different algorithms, or without compression, can be mixed in the same store.
+	BlobCompressor interface {
+		// Compress returns the blob as is if the algorithm is empty or compression doesn't make it smaller
+		Compress(algorithm string, blob *commonpb.DataBlob) (*commonpb.DataBlob, error)
+		// Decompress returns blobs that are not compressed as is
+		Decompress(blob *commonpb.DataBlob) (*commonpb.DataBlob, error)
+		// Close releases the resources of the compressor
+		Close()
+	}
+
+	blobCompressorImpl struct {
+		zstdEncoder *zstd.Encoder
+		zstdDecoder *zstd.Decoder
+	}
+)
+
+var (
+	errCompressedBlobTruncated = errors.New("compressed blob is truncated")
+	errCompressedBlobEncrypted = errors.New("compressed blob must be decrypted before it is decompressed")
+)
+
+// NewBlobCompressor returns a BlobCompressor supporting zstd and snappy
+func NewBlobCompressor() (BlobCompressor, error) {
+	zstdEncoder, err := zstd.NewWriter(nil)
+	if err != nil {
+		return nil, err
+	}
+	zstdDecoder, err := zstd.NewReader(nil)
+	if err != nil {
+		return nil, err
+	}
+	return &blobCompressorImpl{
+		zstdEncoder: zstdEncoder,
+		zstdDecoder: zstdDecoder,
+	}, nil
+}
+
+// IsCompressedBlob returns true if the blob was compressed by a BlobCompressor
+func IsCompressedBlob(blob *commonpb.DataBlob) bool {
+	if !IsEncodedBlob(blob) {
+		return false
+	}
+	encoded, err := encodedBlobFromDataBlob(blob)
+	return err == nil && encoded.Compression != ""
+}
+
+// ValidateCompressionAlgorithm returns an error if the algorithm is neither empty nor supported
+func ValidateCompressionAlgorithm(algorithm string) error {
+	switch algorithm {
+	case "", CompressionZstd, CompressionSnappy:
+		return nil
+	default:
+		return fmt.Errorf("unknown compression algorithm: %s", algorithm)
+	}
+}
+
+func (c *blobCompressorImpl) Close() {
+	c.zstdDecoder.Close()
+}
+
+func (c *blobCompressorImpl) Compress(algorithm string, blob *commonpb.DataBlob) (*commonpb.DataBlob, error) {
+	if algorithm == "" || blob == nil || len(blob.Data) == 0 {
+		return blob, nil
+	}
+	if err := ValidateCompressionAlgorithm(algorithm); err != nil {
+		return nil, err
+	}
+	encoded, err := encodedBlobFromDataBlob(blob)
+	if err != nil {
+		return nil, err
+	}
+	// encrypted data doesn't compress
+	if encoded.Compression != "" || encoded.EncryptionKeyId != "" {
+		return blob, nil
+	}
+
+	switch algorithm {
+	case CompressionZstd:
+		encoded.Data = c.zstdEncoder.EncodeAll(encoded.Data, nil)
+	case CompressionSnappy:
+		encoded.Data = snappy.Encode(nil, encoded.Data)
+	}
+	encoded.Compression = algorithm
+	compressed, err := encodedBlobToDataBlob(encoded)
+	if err != nil {
+		return nil, err
+	}
+	if len(compressed.Data) >= len(blob.Data) {
+		return blob, nil
+	}
+	return compressed, nil
+}
+
+func (c *blobCompressorImpl) Decompress(blob *commonpb.DataBlob) (*commonpb.DataBlob, error) {
+	if !IsEncodedBlob(blob) {
+		return blob, nil
+	}
+	encoded, err := encodedBlobFromDataBlob(blob)
+	if err != nil {
+		return nil, err
+	}
+	if encoded.Compression == "" {
+		return blob, nil
+	}
+	if encoded.EncryptionKeyId != "" {
+		return nil, NewDeserializationError(encoded.EncodingType, errCompressedBlobEncrypted)
+	}
+
+	var decompressed []byte
+	switch encoded.Compression {
+	case CompressionZstd:
+		decompressed, err = c.zstdDecoder.DecodeAll(encoded.Data, nil)
+	case CompressionSnappy:
+		decompressed, err = snappy.Decode(nil, encoded.Data)
+	default:
+		err = ValidateCompressionAlgorithm(encoded.Compression)
+	}
+	if err == nil && len(decompressed) == 0 {
+		// empty blobs are never compressed
+		err = errCompressedBlobTruncated
+	}
+	if err != nil {
+		return nil, NewDeserializationError(encoded.EncodingType, err)
+	}
+	encoded.Compression = ""
+	encoded.Data = decompressed
+	return encodedBlobToDataBlob(encoded)
+}
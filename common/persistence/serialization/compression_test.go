@@ -0,0 +1,140 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package serialization
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log"
+)
+
+func TestBlobCompressor(t *testing.T) {
+	compressor, err := NewBlobCompressor()
+	require.NoError(t, err)
+	defer compressor.Close()
+	blob := &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte(strings.Repeat("history events ", 100))}
+
+	plain, err := compressor.Compress("", blob)
+	require.NoError(t, err)
+	require.Equal(t, blob, plain)
+
+	for _, algorithm := range []string{CompressionZstd, CompressionSnappy} {
+		compressed, err := compressor.Compress(algorithm, blob)
+		require.NoError(t, err)
+		require.True(t, IsCompressedBlob(compressed), algorithm)
+		require.Less(t, len(compressed.Data), len(blob.Data), algorithm)
+		encoded, err := encodedBlobFromDataBlob(compressed)
+		require.NoError(t, err)
+		require.Equal(t, algorithm, encoded.Compression)
+		require.Equal(t, enumspb.ENCODING_TYPE_PROTO3, encoded.EncodingType)
+
+		decompressed, err := compressor.Decompress(compressed)
+		require.NoError(t, err)
+		require.Equal(t, blob, decompressed, algorithm)
+	}
+
+	decompressed, err := compressor.Decompress(blob)
+	require.NoError(t, err)
+	require.Equal(t, blob, decompressed)
+
+	_, err = compressor.Compress("gzip", blob)
+	require.Error(t, err)
+}
+
+func TestBlobCompressor_Incompressible(t *testing.T) {
+	compressor, err := NewBlobCompressor()
+	require.NoError(t, err)
+	defer compressor.Close()
+	blob := &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte("short")}
+
+	compressed, err := compressor.Compress(CompressionZstd, blob)
+	require.NoError(t, err)
+	require.Equal(t, blob, compressed)
+}
+
+func TestBlobCompressor_Encrypted(t *testing.T) {
+	compressor, err := NewBlobCompressor()
+	require.NoError(t, err)
+	defer compressor.Close()
+	keyProvider, err := NewFileKeyProvider(testEncryptionKeyFile(t, "key-1"), 0, log.NewNoopLogger())
+	require.NoError(t, err)
+	encryptor := NewBlobEncryptor(keyProvider)
+	blob := &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte(strings.Repeat("history events ", 100))}
+
+	compressed, err := compressor.Compress(CompressionZstd, blob)
+	require.NoError(t, err)
+	encrypted, err := encryptor.Encrypt("encrypted-namespace", compressed)
+	require.NoError(t, err)
+	require.True(t, IsCompressedBlob(encrypted))
+	require.True(t, IsEncryptedBlob(encrypted))
+
+	// encrypted blobs are not compressed again and have to be decrypted first
+	recompressed, err := compressor.Compress(CompressionSnappy, encrypted)
+	require.NoError(t, err)
+	require.Equal(t, encrypted, recompressed)
+	_, err = compressor.Decompress(encrypted)
+	var deserializationErr *DeserializationError
+	require.ErrorAs(t, err, &deserializationErr)
+
+	decrypted, err := encryptor.Decrypt(encrypted)
+	require.NoError(t, err)
+	require.Equal(t, compressed, decrypted)
+	decompressed, err := compressor.Decompress(decrypted)
+	require.NoError(t, err)
+	require.Equal(t, blob, decompressed)
+}
+
+func TestBlobCompressor_Corrupted(t *testing.T) {
+	compressor, err := NewBlobCompressor()
+	require.NoError(t, err)
+	defer compressor.Close()
+	compressed := func(algorithm string, data []byte) *commonpb.DataBlob {
+		blob, err := encodedBlobToDataBlob(&persistencespb.EncodedDataBlob{
+			EncodingType: enumspb.ENCODING_TYPE_PROTO3,
+			Compression:  algorithm,
+			Data:         data,
+		})
+		require.NoError(t, err)
+		return blob
+	}
+
+	for _, blob := range []*commonpb.DataBlob{
+		{EncodingType: enumspb.ENCODING_TYPE_UNSPECIFIED, Data: []byte("not encoding metadata")},
+		compressed(CompressionZstd, nil),
+		compressed("gzip", []byte{1, 2, 3}),
+		compressed(CompressionZstd, []byte{1, 2, 3}),
+		compressed(CompressionSnappy, []byte("not snappy")),
+	} {
+		_, err := compressor.Decompress(blob)
+		var deserializationErr *DeserializationError
+		require.ErrorAs(t, err, &deserializationErr)
+	}
+}
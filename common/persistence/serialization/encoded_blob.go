@@ -0,0 +1,73 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package serialization
+
+import (
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+)
+
+// IsEncodedBlob returns true if the blob was encrypted or compressed. An encoded blob has an unspecified
+// encoding type and holds a persistencespb.EncodedDataBlob with the encoding metadata, so blobs written
+// before encryption or compression was enabled are told apart by their encoding type and stay readable.
+func IsEncodedBlob(blob *commonpb.DataBlob) bool {
+	return blob != nil && blob.EncodingType == enumspb.ENCODING_TYPE_UNSPECIFIED && len(blob.Data) > 0
+}
+
+// encodedBlobFromDataBlob returns the encoding metadata of the blob. Blobs that are not encoded are
+// returned without metadata.
+func encodedBlobFromDataBlob(blob *commonpb.DataBlob) (*persistencespb.EncodedDataBlob, error) {
+	if !IsEncodedBlob(blob) {
+		return &persistencespb.EncodedDataBlob{
+			EncodingType: blob.EncodingType,
+			Data:         blob.Data,
+		}, nil
+	}
+	encoded := &persistencespb.EncodedDataBlob{}
+	if err := encoded.Unmarshal(blob.Data); err != nil {
+		return nil, NewDeserializationError(blob.EncodingType, err)
+	}
+	return encoded, nil
+}
+
+// encodedBlobToDataBlob returns the original blob once all encodings are removed
+func encodedBlobToDataBlob(encoded *persistencespb.EncodedDataBlob) (*commonpb.DataBlob, error) {
+	if encoded.EncryptionKeyId == "" && encoded.Compression == "" {
+		return &commonpb.DataBlob{
+			EncodingType: encoded.EncodingType,
+			Data:         encoded.Data,
+		}, nil
+	}
+	data, err := encoded.Marshal()
+	if err != nil {
+		return nil, NewSerializationError(enumspb.ENCODING_TYPE_PROTO3, err)
+	}
+	return &commonpb.DataBlob{
+		EncodingType: enumspb.ENCODING_TYPE_UNSPECIFIED,
+		Data:         data,
+	}, nil
+}
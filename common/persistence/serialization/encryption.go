@@ -0,0 +1,177 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package serialization
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+)
+
+// maxEncryptionKeyIDLength bounds key IDs since the ID is stored with every encrypted blob
+const maxEncryptionKeyIDLength = 255
+
+type (
+	// KeyProvider supplies the keys that encrypt blobs at rest. Keys are AES keys of 16, 24 or 32
+	// bytes. An implementation backed by a KMS would unwrap the data keys it returns.
+	KeyProvider interface {
+		// ActiveKeyID returns the ID of the key that encrypts new blobs of a namespace, or an empty
+		// string if blobs of the namespace are not encrypted.
+		ActiveKeyID(namespaceID string) string
+		// Key returns the key with the given ID. A key must stay available after it is rotated out
+		// for as long as blobs encrypted with it are stored.
+		Key(keyID string) ([]byte, error)
+	}
+
+	// BlobEncryptor encrypts blobs with the active key of their namespace. The ID of the key is kept
+	// in the encoding metadata of the blob so blobs remain readable after key rotation.
+	BlobEncryptor interface {
+		Encrypt(namespaceID string, blob *commonpb.DataBlob) (*commonpb.DataBlob, error)
+		// Decrypt returns blobs that are not encrypted as is
+		Decrypt(blob *commonpb.DataBlob) (*commonpb.DataBlob, error)
+	}
+
+	blobEncryptorImpl struct {
+		keyProvider KeyProvider
+	}
+)
+
+var (
+	errEncryptedBlobTruncated = errors.New("encrypted blob is truncated")
+)
+
+// NewBlobEncryptor returns a BlobEncryptor using AES-GCM with the keys of the given provider
+func NewBlobEncryptor(keyProvider KeyProvider) BlobEncryptor {
+	return &blobEncryptorImpl{keyProvider: keyProvider}
+}
+
+// IsEncryptedBlob returns true if the blob was encrypted by a BlobEncryptor
+func IsEncryptedBlob(blob *commonpb.DataBlob) bool {
+	if !IsEncodedBlob(blob) {
+		return false
+	}
+	encoded, err := encodedBlobFromDataBlob(blob)
+	return err == nil && encoded.EncryptionKeyId != ""
+}
+
+func (e *blobEncryptorImpl) Encrypt(namespaceID string, blob *commonpb.DataBlob) (*commonpb.DataBlob, error) {
+	if blob == nil || len(blob.Data) == 0 {
+		return blob, nil
+	}
+	encoded, err := encodedBlobFromDataBlob(blob)
+	if err != nil {
+		return nil, err
+	}
+	if encoded.EncryptionKeyId != "" {
+		return blob, nil
+	}
+	keyID := e.keyProvider.ActiveKeyID(namespaceID)
+	if keyID == "" {
+		return blob, nil
+	}
+	aead, err := e.aead(keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	encoded.EncryptionKeyId = keyID
+	additionalData, err := encryptionAdditionalData(encoded)
+	if err != nil {
+		return nil, err
+	}
+	// data: nonce | ciphertext
+	data := make([]byte, aead.NonceSize(), aead.NonceSize()+len(encoded.Data)+aead.Overhead())
+	if _, err := io.ReadFull(rand.Reader, data); err != nil {
+		return nil, err
+	}
+	encoded.Data = aead.Seal(data, data, encoded.Data, additionalData)
+	return encodedBlobToDataBlob(encoded)
+}
+
+func (e *blobEncryptorImpl) Decrypt(blob *commonpb.DataBlob) (*commonpb.DataBlob, error) {
+	if !IsEncodedBlob(blob) {
+		return blob, nil
+	}
+	encoded, err := encodedBlobFromDataBlob(blob)
+	if err != nil {
+		return nil, err
+	}
+	keyID := encoded.EncryptionKeyId
+	if keyID == "" {
+		return blob, nil
+	}
+
+	aead, err := e.aead(keyID)
+	if err != nil {
+		return nil, NewDeserializationError(encoded.EncodingType, err)
+	}
+	if len(encoded.Data) < aead.NonceSize() {
+		return nil, NewDeserializationError(encoded.EncodingType, errEncryptedBlobTruncated)
+	}
+	additionalData, err := encryptionAdditionalData(encoded)
+	if err != nil {
+		return nil, err
+	}
+	nonce := encoded.Data[:aead.NonceSize()]
+	plaintext, err := aead.Open(nil, nonce, encoded.Data[aead.NonceSize():], additionalData)
+	if err != nil {
+		return nil, NewDeserializationError(encoded.EncodingType, fmt.Errorf("unable to decrypt blob with key %s: %w", keyID, err))
+	}
+	encoded.EncryptionKeyId = ""
+	encoded.Data = plaintext
+	return encodedBlobToDataBlob(encoded)
+}
+
+// encryptionAdditionalData returns the encoding metadata of the blob, which is authenticated with the
+// ciphertext so the key ID and the encodings can't be swapped
+func encryptionAdditionalData(encoded *persistencespb.EncodedDataBlob) ([]byte, error) {
+	metadata := *encoded
+	metadata.Data = nil
+	data, err := metadata.Marshal()
+	if err != nil {
+		return nil, NewSerializationError(enumspb.ENCODING_TYPE_PROTO3, err)
+	}
+	return data, nil
+}
+
+func (e *blobEncryptorImpl) aead(keyID string) (cipher.AEAD, error) {
+	key, err := e.keyProvider.Key(keyID)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid encryption key %s: %w", keyID, err)
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,134 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package serialization
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/server/common/log"
+)
+
+func testEncryptionKeyFile(t *testing.T, activeKeyID string) string {
+	key1 := base64.StdEncoding.EncodeToString([]byte(strings.Repeat("1", 32)))
+	key2 := base64.StdEncoding.EncodeToString([]byte(strings.Repeat("2", 16)))
+	content := `
+keys:
+  key-1: ` + key1 + `
+  key-2: ` + key2 + `
+namespaces:
+  encrypted-namespace: ` + activeKeyID + `
+`
+	path := filepath.Join(t.TempDir(), "keys.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func TestBlobEncryptor(t *testing.T) {
+	keyProvider, err := NewFileKeyProvider(testEncryptionKeyFile(t, "key-1"), 0, log.NewNoopLogger())
+	require.NoError(t, err)
+	encryptor := NewBlobEncryptor(keyProvider)
+	blob := &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte("history events")}
+
+	plain, err := encryptor.Encrypt("other-namespace", blob)
+	require.NoError(t, err)
+	require.Equal(t, blob, plain)
+
+	encrypted, err := encryptor.Encrypt("encrypted-namespace", blob)
+	require.NoError(t, err)
+	require.True(t, IsEncryptedBlob(encrypted))
+	require.NotContains(t, string(encrypted.Data), "history events")
+	require.Equal(t, enumspb.ENCODING_TYPE_UNSPECIFIED, encrypted.EncodingType)
+	metadata, err := encodedBlobFromDataBlob(encrypted)
+	require.NoError(t, err)
+	require.Equal(t, "key-1", metadata.EncryptionKeyId)
+	require.Equal(t, enumspb.ENCODING_TYPE_PROTO3, metadata.EncodingType)
+
+	decrypted, err := encryptor.Decrypt(encrypted)
+	require.NoError(t, err)
+	require.Equal(t, blob, decrypted)
+	decrypted, err = encryptor.Decrypt(blob)
+	require.NoError(t, err)
+	require.Equal(t, blob, decrypted)
+
+	tampered := &commonpb.DataBlob{EncodingType: encrypted.EncodingType, Data: append([]byte{}, encrypted.Data...)}
+	tampered.Data[len(tampered.Data)-1] ^= 1
+	_, err = encryptor.Decrypt(tampered)
+	require.Error(t, err)
+	// the encoding metadata is authenticated
+	swapped := *metadata
+	swapped.EncodingType = enumspb.ENCODING_TYPE_JSON
+	swappedBlob, err := encodedBlobToDataBlob(&swapped)
+	require.NoError(t, err)
+	_, err = encryptor.Decrypt(swappedBlob)
+	require.Error(t, err)
+	truncated := *metadata
+	truncated.Data = truncated.Data[:3]
+	truncatedBlob, err := encodedBlobToDataBlob(&truncated)
+	require.NoError(t, err)
+	_, err = encryptor.Decrypt(truncatedBlob)
+	require.Error(t, err)
+	_, err = encryptor.Decrypt(&commonpb.DataBlob{Data: []byte("not encoded")})
+	require.Error(t, err)
+
+	// blobs encrypted with a rotated key stay readable
+	rotatedProvider, err := NewFileKeyProvider(testEncryptionKeyFile(t, "key-2"), 0, log.NewNoopLogger())
+	require.NoError(t, err)
+	rotated := NewBlobEncryptor(rotatedProvider)
+	decrypted, err = rotated.Decrypt(encrypted)
+	require.NoError(t, err)
+	require.Equal(t, blob, decrypted)
+}
+
+func TestParseEncryptionKeys(t *testing.T) {
+	_, err := parseEncryptionKeys([]byte(`
+keys:
+  key-1: c2hvcnQ=
+`))
+	require.ErrorContains(t, err, "must be 16, 24 or 32 bytes")
+
+	_, err = parseEncryptionKeys([]byte(`
+keys:
+  key-1: MTExMTExMTExMTExMTExMQ==
+namespaces:
+  namespace: key-2
+`))
+	require.ErrorContains(t, err, "unknown key ID")
+
+	keys, err := parseEncryptionKeys([]byte(`
+keys:
+  key-1: MTExMTExMTExMTExMTExMQ==
+defaultKeyID: key-1
+`))
+	require.NoError(t, err)
+	require.Equal(t, "key-1", keys.defaultKeyID)
+}
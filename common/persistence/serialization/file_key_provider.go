@@ -0,0 +1,162 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package serialization
+
+import (
+	"encoding/base64"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"go.temporal.io/server/common/filewatcher"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+type (
+	// EncryptionKeys is the content of the key file of FileKeyProvider, for example
+	//
+	//	keys:
+	//	  key-2023-01: <base64 encoded AES key>
+	//	  key-2023-07: <base64 encoded AES key>
+	//	namespaces:
+	//	  <namespace ID>: key-2023-07
+	//	defaultKeyID: key-2023-01
+	//
+	// Keys are rotated by adding a new key and making it the active key of the namespace. The old
+	// key must stay in the file to read existing data.
+	EncryptionKeys struct {
+		// Keys by ID, base64 encoded
+		Keys map[string]string `yaml:"keys"`
+		// Active key ID by namespace ID
+		Namespaces map[string]string `yaml:"namespaces"`
+		// Active key ID of namespaces that are not listed, empty to leave them unencrypted
+		DefaultKeyID string `yaml:"defaultKeyID"`
+	}
+
+	// FileKeyProvider is a KeyProvider reading keys from a local file
+	FileKeyProvider struct {
+		keyFile string
+		logger  log.Logger
+		keys    atomic.Value // *fileKeys
+		watcher *filewatcher.Watcher
+	}
+
+	fileKeys struct {
+		keys         map[string][]byte
+		namespaces   map[string]string
+		defaultKeyID string
+	}
+)
+
+var _ KeyProvider = (*FileKeyProvider)(nil)
+
+// NewFileKeyProvider creates a KeyProvider reading keys from a file. The file is reloaded when it
+// changes if the refresh interval is not zero.
+func NewFileKeyProvider(keyFile string, refreshInterval time.Duration, logger log.Logger) (*FileKeyProvider, error) {
+	if keyFile == "" {
+		return nil, fmt.Errorf("encryption key file is not configured")
+	}
+	p := &FileKeyProvider{keyFile: keyFile, logger: logger}
+	watcher, err := filewatcher.New(keyFile, refreshInterval, p.updateKeys, logger)
+	if err != nil {
+		return nil, fmt.Errorf("encryption key file: %w", err)
+	}
+	p.watcher = watcher
+	return p, nil
+}
+
+func (p *FileKeyProvider) Close() {
+	p.watcher.Stop()
+}
+
+func (p *FileKeyProvider) ActiveKeyID(namespaceID string) string {
+	keys := p.keys.Load().(*fileKeys)
+	if keyID, ok := keys.namespaces[namespaceID]; ok {
+		return keyID
+	}
+	return keys.defaultKeyID
+}
+
+func (p *FileKeyProvider) Key(keyID string) ([]byte, error) {
+	key, ok := p.keys.Load().(*fileKeys).keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("encryption key not found: %s", keyID)
+	}
+	return key, nil
+}
+
+func (p *FileKeyProvider) updateKeys(content []byte) error {
+	keys, err := parseEncryptionKeys(content)
+	if err != nil {
+		return err
+	}
+
+	p.keys.Store(keys)
+	p.logger.Info("Loaded encryption keys",
+		tag.NewStringTag("key-file", p.keyFile),
+		tag.Counter(len(keys.keys)))
+	return nil
+}
+
+// parseEncryptionKeys decodes and validates the YAML content of a key file
+func parseEncryptionKeys(content []byte) (*fileKeys, error) {
+	var encryptionKeys EncryptionKeys
+	if err := yaml.Unmarshal(content, &encryptionKeys); err != nil {
+		return nil, err
+	}
+
+	keys := &fileKeys{
+		keys:         make(map[string][]byte, len(encryptionKeys.Keys)),
+		namespaces:   encryptionKeys.Namespaces,
+		defaultKeyID: encryptionKeys.DefaultKeyID,
+	}
+	for keyID, encodedKey := range encryptionKeys.Keys {
+		if len(keyID) > maxEncryptionKeyIDLength {
+			return nil, fmt.Errorf("key ID is longer than %d bytes: %s", maxEncryptionKeyIDLength, keyID)
+		}
+		key, err := base64.StdEncoding.DecodeString(encodedKey)
+		if err != nil {
+			return nil, fmt.Errorf("key %s: %w", keyID, err)
+		}
+		switch len(key) {
+		case 16, 24, 32:
+		default:
+			return nil, fmt.Errorf("key %s: AES keys must be 16, 24 or 32 bytes, got %d", keyID, len(key))
+		}
+		keys.keys[keyID] = key
+	}
+	for namespaceID, keyID := range encryptionKeys.Namespaces {
+		if _, ok := keys.keys[keyID]; !ok {
+			return nil, fmt.Errorf("namespace %s: unknown key ID: %s", namespaceID, keyID)
+		}
+	}
+	if _, ok := keys.keys[keys.defaultKeyID]; keys.defaultKeyID != "" && !ok {
+		return nil, fmt.Errorf("unknown default key ID: %s", keys.defaultKeyID)
+	}
+	return keys, nil
+}
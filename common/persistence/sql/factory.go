@@ -30,6 +30,7 @@ import (
 
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
 	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 	"go.temporal.io/server/common/resolver"
@@ -38,19 +39,29 @@ import (
 type (
 	// Factory vends store objects backed by MySQL
 	Factory struct {
-		cfg         config.SQL
-		mainDBConn  DbConn
-		clusterName string
-		logger      log.Logger
+		cfg          config.SQL
+		mainDBConn   DbConn
+		shardDBConns []shardDBConn
+		clusterName  string
+		logger       log.Logger
+	}
+
+	// shardDBConn is the connection to a database that stores the history shards in [minShardID, maxShardID]
+	shardDBConn struct {
+		minShardID int32
+		maxShardID int32
+		conn       *DbConn
 	}
 
 	// DbConn represents a logical mysql connection - its a
 	// wrapper around the standard sql connection pool with
 	// additional reference counting
 	DbConn struct {
-		dbKind   sqlplugin.DbKind
-		cfg      *config.SQL
-		resolver resolver.ServiceResolver
+		dbKind         sqlplugin.DbKind
+		cfg            *config.SQL
+		resolver       resolver.ServiceResolver
+		logger         log.Logger
+		metricsHandler metrics.Handler
 
 		sqlplugin.DB
 
@@ -66,12 +77,23 @@ func NewFactory(
 	r resolver.ServiceResolver,
 	clusterName string,
 	logger log.Logger,
+	metricsHandler metrics.Handler,
 ) *Factory {
+	shardDBConns := make([]shardDBConn, 0, len(cfg.ShardDatabases))
+	for _, db := range cfg.ShardDatabases {
+		conn := NewRefCountedDBConn(sqlplugin.DbKindMain, cfg.ShardDatabase(db), r, logger, metricsHandler)
+		shardDBConns = append(shardDBConns, shardDBConn{
+			minShardID: db.MinShardID,
+			maxShardID: db.MaxShardID,
+			conn:       &conn,
+		})
+	}
 	return &Factory{
-		cfg:         cfg,
-		clusterName: clusterName,
-		logger:      logger,
-		mainDBConn:  NewRefCountedDBConn(sqlplugin.DbKindMain, &cfg, r),
+		cfg:          cfg,
+		clusterName:  clusterName,
+		logger:       logger,
+		mainDBConn:   NewRefCountedDBConn(sqlplugin.DbKindMain, &cfg, r, logger, metricsHandler),
+		shardDBConns: shardDBConns,
 	}
 }
 
@@ -90,7 +112,18 @@ func (f *Factory) NewShardStore() (p.ShardStore, error) {
 	if err != nil {
 		return nil, err
 	}
-	return newShardPersistence(conn, f.clusterName, f.logger)
+	store, err := newShardPersistence(conn, f.clusterName, f.logger)
+	if err != nil || len(f.shardDBConns) == 0 {
+		return store, err
+	}
+
+	stores, err := newShardedStores(store, f.shardDBConns, func(conn sqlplugin.DB) (p.ShardStore, error) {
+		return newShardPersistence(conn, f.clusterName, f.logger)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &shardedShardStore{shardedStores: stores}, nil
 }
 
 // NewMetadataStore returns a new metadata store
@@ -117,7 +150,18 @@ func (f *Factory) NewExecutionStore() (p.ExecutionStore, error) {
 	if err != nil {
 		return nil, err
 	}
-	return NewSQLExecutionStore(conn, f.logger)
+	store, err := NewSQLExecutionStore(conn, f.logger)
+	if err != nil || len(f.shardDBConns) == 0 {
+		return store, err
+	}
+
+	stores, err := newShardedStores(store, f.shardDBConns, func(conn sqlplugin.DB) (p.ExecutionStore, error) {
+		return NewSQLExecutionStore(conn, f.logger)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &shardedExecutionStore{shardedStores: stores}, nil
 }
 
 // NewQueue returns a new queue backed by sql
@@ -133,6 +177,9 @@ func (f *Factory) NewQueue(queueType p.QueueType) (p.Queue, error) {
 // Close closes the factory
 func (f *Factory) Close() {
 	f.mainDBConn.ForceClose()
+	for _, shardConn := range f.shardDBConns {
+		shardConn.conn.ForceClose()
+	}
 }
 
 // NewRefCountedDBConn returns a  logical mysql connection that
@@ -143,11 +190,15 @@ func NewRefCountedDBConn(
 	dbKind sqlplugin.DbKind,
 	cfg *config.SQL,
 	r resolver.ServiceResolver,
+	logger log.Logger,
+	metricsHandler metrics.Handler,
 ) DbConn {
 	return DbConn{
-		dbKind:   dbKind,
-		cfg:      cfg,
-		resolver: r,
+		dbKind:         dbKind,
+		cfg:            cfg,
+		resolver:       r,
+		logger:         logger,
+		metricsHandler: metricsHandler,
 	}
 }
 
@@ -158,7 +209,7 @@ func (c *DbConn) Get() (sqlplugin.DB, error) {
 	c.Lock()
 	defer c.Unlock()
 	if c.refCnt == 0 {
-		conn, err := NewSQLDB(c.dbKind, c.cfg, c.resolver)
+		conn, err := NewSQLDB(c.dbKind, c.cfg, c.resolver, c.logger, c.metricsHandler)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,395 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"context"
+	"encoding/json"
+
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+)
+
+type (
+	// shardedDatabase is a database that stores the history shards in [minShardID, maxShardID]
+	shardedDatabase[T p.Closeable] struct {
+		minShardID int32
+		maxShardID int32
+		store      T
+	}
+
+	// shardedStores routes requests of a history shard to the store of the database
+	// it is mapped to. Shards that are not mapped to any database use the primary store.
+	shardedStores[T p.Closeable] struct {
+		primary T
+		shards  []shardedDatabase[T]
+	}
+
+	// shardedShardStore is a ShardStore spread over multiple databases
+	shardedShardStore struct {
+		shardedStores[p.ShardStore]
+	}
+
+	// shardedExecutionStore is an ExecutionStore spread over multiple databases. Every shard
+	// row lives in the same database as the executions of the shard, so that shard-locked
+	// transactions stay local to one database.
+	shardedExecutionStore struct {
+		shardedStores[p.ExecutionStore]
+	}
+
+	// getAllHistoryTreeBranchesShardedPageToken points into the database with the given index,
+	// where 0 is the primary and i is the i-th shard database
+	getAllHistoryTreeBranchesShardedPageToken struct {
+		DatabaseIndex int
+		NextPageToken []byte
+	}
+)
+
+var _ p.ShardStore = (*shardedShardStore)(nil)
+var _ p.ExecutionStore = (*shardedExecutionStore)(nil)
+
+// newShardedStores creates a store with newStore for each of the shard databases.
+// The primary store is closed if any of them cannot be created.
+func newShardedStores[T p.Closeable](
+	primary T,
+	shardDBConns []shardDBConn,
+	newStore func(conn sqlplugin.DB) (T, error),
+) (shardedStores[T], error) {
+	stores := shardedStores[T]{primary: primary}
+	for _, shardConn := range shardDBConns {
+		conn, err := shardConn.conn.Get()
+		if err != nil {
+			stores.Close()
+			return shardedStores[T]{}, err
+		}
+		store, err := newStore(conn)
+		if err != nil {
+			_ = conn.Close()
+			stores.Close()
+			return shardedStores[T]{}, err
+		}
+		stores.shards = append(stores.shards, shardedDatabase[T]{
+			minShardID: shardConn.minShardID,
+			maxShardID: shardConn.maxShardID,
+			store:      store,
+		})
+	}
+	return stores, nil
+}
+
+func (s *shardedStores[T]) Close() {
+	for _, store := range s.all() {
+		store.Close()
+	}
+}
+
+func (s *shardedStores[T]) forShard(shardID int32) T {
+	for _, db := range s.shards {
+		if shardID >= db.minShardID && shardID <= db.maxShardID {
+			return db.store
+		}
+	}
+	return s.primary
+}
+
+func (s *shardedStores[T]) all() []T {
+	stores := make([]T, 0, len(s.shards)+1)
+	stores = append(stores, s.primary)
+	for _, db := range s.shards {
+		stores = append(stores, db.store)
+	}
+	return stores
+}
+
+func (s *shardedShardStore) GetName() string {
+	return s.primary.GetName()
+}
+
+func (s *shardedShardStore) GetClusterName() string {
+	return s.primary.GetClusterName()
+}
+
+func (s *shardedShardStore) GetOrCreateShard(
+	ctx context.Context,
+	request *p.InternalGetOrCreateShardRequest,
+) (*p.InternalGetOrCreateShardResponse, error) {
+	return s.forShard(request.ShardID).GetOrCreateShard(ctx, request)
+}
+
+func (s *shardedShardStore) UpdateShard(
+	ctx context.Context,
+	request *p.InternalUpdateShardRequest,
+) error {
+	return s.forShard(request.ShardID).UpdateShard(ctx, request)
+}
+
+func (s *shardedShardStore) AssertShardOwnership(
+	ctx context.Context,
+	request *p.AssertShardOwnershipRequest,
+) error {
+	return s.forShard(request.ShardID).AssertShardOwnership(ctx, request)
+}
+
+func (s *shardedExecutionStore) GetName() string {
+	return s.primary.GetName()
+}
+
+func (s *shardedExecutionStore) CreateWorkflowExecution(
+	ctx context.Context,
+	request *p.InternalCreateWorkflowExecutionRequest,
+) (*p.InternalCreateWorkflowExecutionResponse, error) {
+	return s.forShard(request.ShardID).CreateWorkflowExecution(ctx, request)
+}
+
+func (s *shardedExecutionStore) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *p.InternalUpdateWorkflowExecutionRequest,
+) error {
+	return s.forShard(request.ShardID).UpdateWorkflowExecution(ctx, request)
+}
+
+func (s *shardedExecutionStore) ConflictResolveWorkflowExecution(
+	ctx context.Context,
+	request *p.InternalConflictResolveWorkflowExecutionRequest,
+) error {
+	return s.forShard(request.ShardID).ConflictResolveWorkflowExecution(ctx, request)
+}
+
+func (s *shardedExecutionStore) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *p.DeleteWorkflowExecutionRequest,
+) error {
+	return s.forShard(request.ShardID).DeleteWorkflowExecution(ctx, request)
+}
+
+func (s *shardedExecutionStore) DeleteCurrentWorkflowExecution(
+	ctx context.Context,
+	request *p.DeleteCurrentWorkflowExecutionRequest,
+) error {
+	return s.forShard(request.ShardID).DeleteCurrentWorkflowExecution(ctx, request)
+}
+
+func (s *shardedExecutionStore) GetCurrentExecution(
+	ctx context.Context,
+	request *p.GetCurrentExecutionRequest,
+) (*p.InternalGetCurrentExecutionResponse, error) {
+	return s.forShard(request.ShardID).GetCurrentExecution(ctx, request)
+}
+
+func (s *shardedExecutionStore) GetWorkflowExecution(
+	ctx context.Context,
+	request *p.GetWorkflowExecutionRequest,
+) (*p.InternalGetWorkflowExecutionResponse, error) {
+	return s.forShard(request.ShardID).GetWorkflowExecution(ctx, request)
+}
+
+func (s *shardedExecutionStore) SetWorkflowExecution(
+	ctx context.Context,
+	request *p.InternalSetWorkflowExecutionRequest,
+) error {
+	return s.forShard(request.ShardID).SetWorkflowExecution(ctx, request)
+}
+
+func (s *shardedExecutionStore) ListConcreteExecutions(
+	ctx context.Context,
+	request *p.ListConcreteExecutionsRequest,
+) (*p.InternalListConcreteExecutionsResponse, error) {
+	return s.forShard(request.ShardID).ListConcreteExecutions(ctx, request)
+}
+
+func (s *shardedExecutionStore) AddHistoryTasks(
+	ctx context.Context,
+	request *p.InternalAddHistoryTasksRequest,
+) error {
+	return s.forShard(request.ShardID).AddHistoryTasks(ctx, request)
+}
+
+func (s *shardedExecutionStore) GetHistoryTask(
+	ctx context.Context,
+	request *p.GetHistoryTaskRequest,
+) (*p.InternalGetHistoryTaskResponse, error) {
+	return s.forShard(request.ShardID).GetHistoryTask(ctx, request)
+}
+
+func (s *shardedExecutionStore) GetHistoryTasks(
+	ctx context.Context,
+	request *p.GetHistoryTasksRequest,
+) (*p.InternalGetHistoryTasksResponse, error) {
+	return s.forShard(request.ShardID).GetHistoryTasks(ctx, request)
+}
+
+func (s *shardedExecutionStore) CompleteHistoryTask(
+	ctx context.Context,
+	request *p.CompleteHistoryTaskRequest,
+) error {
+	return s.forShard(request.ShardID).CompleteHistoryTask(ctx, request)
+}
+
+func (s *shardedExecutionStore) RangeCompleteHistoryTasks(
+	ctx context.Context,
+	request *p.RangeCompleteHistoryTasksRequest,
+) error {
+	return s.forShard(request.ShardID).RangeCompleteHistoryTasks(ctx, request)
+}
+
+func (s *shardedExecutionStore) PutReplicationTaskToDLQ(
+	ctx context.Context,
+	request *p.PutReplicationTaskToDLQRequest,
+) error {
+	return s.forShard(request.ShardID).PutReplicationTaskToDLQ(ctx, request)
+}
+
+func (s *shardedExecutionStore) GetReplicationTasksFromDLQ(
+	ctx context.Context,
+	request *p.GetReplicationTasksFromDLQRequest,
+) (*p.InternalGetReplicationTasksFromDLQResponse, error) {
+	return s.forShard(request.ShardID).GetReplicationTasksFromDLQ(ctx, request)
+}
+
+func (s *shardedExecutionStore) DeleteReplicationTaskFromDLQ(
+	ctx context.Context,
+	request *p.DeleteReplicationTaskFromDLQRequest,
+) error {
+	return s.forShard(request.ShardID).DeleteReplicationTaskFromDLQ(ctx, request)
+}
+
+func (s *shardedExecutionStore) RangeDeleteReplicationTaskFromDLQ(
+	ctx context.Context,
+	request *p.RangeDeleteReplicationTaskFromDLQRequest,
+) error {
+	return s.forShard(request.ShardID).RangeDeleteReplicationTaskFromDLQ(ctx, request)
+}
+
+func (s *shardedExecutionStore) AppendHistoryNodes(
+	ctx context.Context,
+	request *p.InternalAppendHistoryNodesRequest,
+) error {
+	return s.forShard(request.ShardID).AppendHistoryNodes(ctx, request)
+}
+
+func (s *shardedExecutionStore) DeleteHistoryNodes(
+	ctx context.Context,
+	request *p.InternalDeleteHistoryNodesRequest,
+) error {
+	return s.forShard(request.ShardID).DeleteHistoryNodes(ctx, request)
+}
+
+// ParseHistoryBranchInfo does not access the database
+func (s *shardedExecutionStore) ParseHistoryBranchInfo(
+	ctx context.Context,
+	request *p.ParseHistoryBranchInfoRequest,
+) (*p.ParseHistoryBranchInfoResponse, error) {
+	return s.primary.ParseHistoryBranchInfo(ctx, request)
+}
+
+// UpdateHistoryBranchInfo does not access the database
+func (s *shardedExecutionStore) UpdateHistoryBranchInfo(
+	ctx context.Context,
+	request *p.UpdateHistoryBranchInfoRequest,
+) (*p.UpdateHistoryBranchInfoResponse, error) {
+	return s.primary.UpdateHistoryBranchInfo(ctx, request)
+}
+
+// NewHistoryBranch does not access the database
+func (s *shardedExecutionStore) NewHistoryBranch(
+	ctx context.Context,
+	request *p.NewHistoryBranchRequest,
+) (*p.NewHistoryBranchResponse, error) {
+	return s.primary.NewHistoryBranch(ctx, request)
+}
+
+func (s *shardedExecutionStore) ReadHistoryBranch(
+	ctx context.Context,
+	request *p.InternalReadHistoryBranchRequest,
+) (*p.InternalReadHistoryBranchResponse, error) {
+	return s.forShard(request.ShardID).ReadHistoryBranch(ctx, request)
+}
+
+func (s *shardedExecutionStore) ForkHistoryBranch(
+	ctx context.Context,
+	request *p.InternalForkHistoryBranchRequest,
+) error {
+	return s.forShard(request.ShardID).ForkHistoryBranch(ctx, request)
+}
+
+func (s *shardedExecutionStore) DeleteHistoryBranch(
+	ctx context.Context,
+	request *p.InternalDeleteHistoryBranchRequest,
+) error {
+	return s.forShard(request.ShardID).DeleteHistoryBranch(ctx, request)
+}
+
+func (s *shardedExecutionStore) GetHistoryTree(
+	ctx context.Context,
+	request *p.GetHistoryTreeRequest,
+) (*p.InternalGetHistoryTreeResponse, error) {
+	if request.ShardID == nil {
+		return s.primary.GetHistoryTree(ctx, request)
+	}
+	return s.forShard(*request.ShardID).GetHistoryTree(ctx, request)
+}
+
+// GetAllHistoryTreeBranches pages through the branches of the primary first, then through
+// the branches of each shard database in turn. A page never spans two databases.
+func (s *shardedExecutionStore) GetAllHistoryTreeBranches(
+	ctx context.Context,
+	request *p.GetAllHistoryTreeBranchesRequest,
+) (*p.InternalGetAllHistoryTreeBranchesResponse, error) {
+	token := &getAllHistoryTreeBranchesShardedPageToken{}
+	if len(request.NextPageToken) > 0 {
+		if err := json.Unmarshal(request.NextPageToken, token); err != nil {
+			return nil, err
+		}
+	}
+
+	stores := s.all()
+	if token.DatabaseIndex < 0 || token.DatabaseIndex >= len(stores) {
+		return &p.InternalGetAllHistoryTreeBranchesResponse{}, nil
+	}
+	resp, err := stores[token.DatabaseIndex].GetAllHistoryTreeBranches(ctx, &p.GetAllHistoryTreeBranchesRequest{
+		NextPageToken: token.NextPageToken,
+		PageSize:      request.PageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	next := &getAllHistoryTreeBranchesShardedPageToken{
+		DatabaseIndex: token.DatabaseIndex,
+		NextPageToken: resp.NextPageToken,
+	}
+	if len(resp.NextPageToken) == 0 {
+		next.DatabaseIndex++
+	}
+	if next.DatabaseIndex >= len(stores) {
+		resp.NextPageToken = nil
+		return resp, nil
+	}
+	resp.NextPageToken, err = json.Marshal(next)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
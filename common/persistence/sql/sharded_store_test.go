@@ -0,0 +1,148 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/mock"
+)
+
+type (
+	shardedStoreSuite struct {
+		suite.Suite
+
+		controller *gomock.Controller
+		primary    *mock.MockExecutionStore
+		shard1     *mock.MockExecutionStore
+		shard2     *mock.MockExecutionStore
+
+		store *shardedExecutionStore
+	}
+)
+
+func TestShardedStoreSuite(t *testing.T) {
+	s := new(shardedStoreSuite)
+	suite.Run(t, s)
+}
+
+func (s *shardedStoreSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.primary = mock.NewMockExecutionStore(s.controller)
+	s.shard1 = mock.NewMockExecutionStore(s.controller)
+	s.shard2 = mock.NewMockExecutionStore(s.controller)
+
+	s.store = &shardedExecutionStore{
+		shardedStores: shardedStores[p.ExecutionStore]{
+			primary: s.primary,
+			shards: []shardedDatabase[p.ExecutionStore]{
+				{minShardID: 3, maxShardID: 4, store: s.shard1},
+				{minShardID: 5, maxShardID: 8, store: s.shard2},
+			},
+		},
+	}
+}
+
+func (s *shardedStoreSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *shardedStoreSuite) TestRouteByShardID() {
+	for shardID, store := range map[int32]*mock.MockExecutionStore{
+		1: s.primary,
+		2: s.primary,
+		3: s.shard1,
+		4: s.shard1,
+		5: s.shard2,
+		8: s.shard2,
+		9: s.primary,
+	} {
+		request := &p.GetWorkflowExecutionRequest{ShardID: shardID}
+		store.EXPECT().GetWorkflowExecution(gomock.Any(), request).Return(&p.InternalGetWorkflowExecutionResponse{}, nil)
+		_, err := s.store.GetWorkflowExecution(context.Background(), request)
+		s.NoError(err)
+	}
+}
+
+func (s *shardedStoreSuite) TestGetHistoryTree_NoShardID() {
+	request := &p.GetHistoryTreeRequest{TreeID: "tree"}
+	s.primary.EXPECT().GetHistoryTree(gomock.Any(), request).Return(&p.InternalGetHistoryTreeResponse{}, nil)
+	_, err := s.store.GetHistoryTree(context.Background(), request)
+	s.NoError(err)
+}
+
+func (s *shardedStoreSuite) TestGetAllHistoryTreeBranches_PagesThroughAllDatabases() {
+	page := func(treeID string, nextPageToken []byte) *p.InternalGetAllHistoryTreeBranchesResponse {
+		return &p.InternalGetAllHistoryTreeBranchesResponse{
+			Branches:      []p.InternalHistoryBranchDetail{{TreeID: treeID}},
+			NextPageToken: nextPageToken,
+		}
+	}
+	gomock.InOrder(
+		s.primary.EXPECT().GetAllHistoryTreeBranches(gomock.Any(), &p.GetAllHistoryTreeBranchesRequest{
+			PageSize: 1,
+		}).Return(page("primary-1", []byte("primary-token")), nil),
+		s.primary.EXPECT().GetAllHistoryTreeBranches(gomock.Any(), &p.GetAllHistoryTreeBranchesRequest{
+			PageSize:      1,
+			NextPageToken: []byte("primary-token"),
+		}).Return(page("primary-2", nil), nil),
+		s.shard1.EXPECT().GetAllHistoryTreeBranches(gomock.Any(), &p.GetAllHistoryTreeBranchesRequest{
+			PageSize: 1,
+		}).Return(&p.InternalGetAllHistoryTreeBranchesResponse{}, nil),
+		s.shard2.EXPECT().GetAllHistoryTreeBranches(gomock.Any(), &p.GetAllHistoryTreeBranchesRequest{
+			PageSize: 1,
+		}).Return(page("shard2-1", nil), nil),
+	)
+
+	var treeIDs []string
+	var pageToken []byte
+	for {
+		resp, err := s.store.GetAllHistoryTreeBranches(context.Background(), &p.GetAllHistoryTreeBranchesRequest{
+			PageSize:      1,
+			NextPageToken: pageToken,
+		})
+		s.NoError(err)
+		for _, branch := range resp.Branches {
+			treeIDs = append(treeIDs, branch.TreeID)
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+	s.Equal([]string{"primary-1", "primary-2", "shard2-1"}, treeIDs)
+}
+
+func (s *shardedStoreSuite) TestClose() {
+	s.primary.EXPECT().Close()
+	s.shard1.EXPECT().Close()
+	s.shard2.EXPECT().Close()
+	s.store.Close()
+}
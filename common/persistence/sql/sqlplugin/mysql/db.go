@@ -30,6 +30,7 @@ import (
 
 	"github.com/go-sql-driver/mysql"
 	"github.com/jmoiron/sqlx"
+	"go.uber.org/multierr"
 
 	"go.temporal.io/server/common/persistence/schema"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
@@ -45,6 +46,7 @@ type db struct {
 	tx        *sqlx.Tx
 	conn      sqlplugin.Conn
 	converter DataConverter
+	replicas  *sqlplugin.Replicas
 }
 
 var _ sqlplugin.AdminDB = (*db)(nil)
@@ -103,7 +105,13 @@ func (mdb *db) Rollback() error {
 
 // Close closes the connection to the mysql db
 func (mdb *db) Close() error {
-	return mdb.db.Close()
+	return multierr.Combine(mdb.replicas.Close(), mdb.db.Close())
+}
+
+// useReplicas routes reads that tolerate staleness to the given read replicas
+func (mdb *db) useReplicas(replicas *sqlplugin.Replicas) {
+	mdb.replicas = replicas
+	mdb.conn = replicas.Conn(mdb.db)
 }
 
 // PluginName returns the name of the mysql plugin
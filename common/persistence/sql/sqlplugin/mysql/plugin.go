@@ -28,6 +28,8 @@ import (
 	"github.com/jmoiron/sqlx"
 
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence/sql"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/mysql/session"
@@ -52,12 +54,20 @@ func (p *plugin) CreateDB(
 	dbKind sqlplugin.DbKind,
 	cfg *config.SQL,
 	r resolver.ServiceResolver,
+	logger log.Logger,
+	metricsHandler metrics.Handler,
 ) (sqlplugin.DB, error) {
 	conn, err := p.createDBConnection(cfg, r)
 	if err != nil {
 		return nil, err
 	}
+	replicas, err := p.createReplicas(cfg, r, replicaLag, logger, metricsHandler)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
 	db := newDB(dbKind, cfg.DatabaseName, conn, nil)
+	db.useReplicas(replicas)
 	return db, nil
 }
 
@@ -89,3 +99,16 @@ func (p *plugin) createDBConnection(
 	}
 	return mysqlSession.DB, nil
 }
+
+// createReplicas connects to the read replicas configured in cfg, if any
+func (p *plugin) createReplicas(
+	cfg *config.SQL,
+	r resolver.ServiceResolver,
+	lagFn sqlplugin.ReplicaLagFn,
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+) (*sqlplugin.Replicas, error) {
+	return sqlplugin.NewReplicas(cfg, func(cfg *config.SQL) (*sqlx.DB, error) {
+		return p.createDBConnection(cfg, r)
+	}, lagFn, logger, metricsHandler)
+}
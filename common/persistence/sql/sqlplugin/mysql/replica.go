@@ -0,0 +1,113 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mysql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const (
+	showSlaveStatusQuery   = `SHOW SLAVE STATUS`
+	showReplicaStatusQuery = `SHOW REPLICA STATUS`
+
+	secondsBehindMasterColumn = "Seconds_Behind_Master"
+	secondsBehindSourceColumn = "Seconds_Behind_Source"
+)
+
+var errNotReplica = errors.New("database is not a replica")
+
+// replicaLag returns the replication lag reported by a MySQL 5.7 replica
+func replicaLag(ctx context.Context, conn *sqlx.DB) (time.Duration, error) {
+	return queryReplicaLag(ctx, conn, showSlaveStatusQuery, secondsBehindMasterColumn)
+}
+
+// replicaLagV8 returns the replication lag reported by a MySQL 8 replica
+func replicaLagV8(ctx context.Context, conn *sqlx.DB) (time.Duration, error) {
+	return queryReplicaLag(ctx, conn, showReplicaStatusQuery, secondsBehindSourceColumn)
+}
+
+// queryReplicaLag returns the largest lag over all replication channels of the replica
+func queryReplicaLag(
+	ctx context.Context,
+	conn *sqlx.DB,
+	query string,
+	column string,
+) (time.Duration, error) {
+	rows, err := conn.QueryxContext(ctx, query)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var maxLag time.Duration
+	channels := 0
+	for rows.Next() {
+		status := make(map[string]interface{})
+		if err := rows.MapScan(status); err != nil {
+			return 0, err
+		}
+		lag, err := parseSecondsBehind(status[column])
+		if err != nil {
+			return 0, err
+		}
+		if lag > maxLag {
+			maxLag = lag
+		}
+		channels++
+	}
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+	if channels == 0 {
+		return 0, errNotReplica
+	}
+	return maxLag, nil
+}
+
+func parseSecondsBehind(value interface{}) (time.Duration, error) {
+	var seconds int64
+	switch v := value.(type) {
+	case nil:
+		// NULL means the replication SQL thread is not running
+		return 0, errors.New("replication is not running")
+	case int64:
+		seconds = v
+	case []byte:
+		parsed, err := strconv.ParseInt(string(v), 10, 64)
+		if err != nil {
+			return 0, err
+		}
+		seconds = parsed
+	default:
+		return 0, fmt.Errorf("unexpected replication lag value type %T", value)
+	}
+	return time.Duration(seconds) * time.Second, nil
+}
@@ -30,6 +30,7 @@ import (
 
 	"github.com/jmoiron/sqlx"
 	"github.com/lib/pq"
+	"go.uber.org/multierr"
 
 	"go.temporal.io/server/common/persistence/schema"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
@@ -54,6 +55,7 @@ type db struct {
 	tx        *sqlx.Tx
 	conn      sqlplugin.Conn
 	converter DataConverter
+	replicas  *sqlplugin.Replicas
 }
 
 var _ sqlplugin.DB = (*db)(nil)
@@ -102,7 +104,13 @@ func (pdb *db) Rollback() error {
 
 // Close closes the connection to the mysql db
 func (pdb *db) Close() error {
-	return pdb.db.Close()
+	return multierr.Combine(pdb.replicas.Close(), pdb.db.Close())
+}
+
+// useReplicas routes reads that tolerate staleness to the given read replicas
+func (pdb *db) useReplicas(replicas *sqlplugin.Replicas) {
+	pdb.replicas = replicas
+	pdb.conn = replicas.Conn(pdb.db)
 }
 
 // PluginName returns the name of the mysql plugin
@@ -29,9 +29,12 @@ import (
 	"strings"
 
 	"github.com/jmoiron/sqlx"
+
 	"go.temporal.io/api/serviceerror"
 
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence/sql"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/postgresql/session"
@@ -63,12 +66,20 @@ func (d *plugin) CreateDB(
 	dbKind sqlplugin.DbKind,
 	cfg *config.SQL,
 	r resolver.ServiceResolver,
+	logger log.Logger,
+	metricsHandler metrics.Handler,
 ) (sqlplugin.DB, error) {
 	conn, err := d.createDBConnection(cfg, r)
 	if err != nil {
 		return nil, err
 	}
+	replicas, err := d.createReplicas(cfg, r, replicaLag, logger, metricsHandler)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
 	db := newDB(dbKind, cfg.DatabaseName, conn, nil)
+	db.useReplicas(replicas)
 	return db, nil
 }
 
@@ -122,3 +133,16 @@ func (d *plugin) createDBConnection(
 		fmt.Sprintf("unable to connect to DB, tried default DB names: %v, errors: %v", strings.Join(defaultDatabaseNames, ","), errors),
 	)
 }
+
+// createReplicas connects to the read replicas configured in cfg, if any
+func (d *plugin) createReplicas(
+	cfg *config.SQL,
+	r resolver.ServiceResolver,
+	lagFn sqlplugin.ReplicaLagFn,
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+) (*sqlplugin.Replicas, error) {
+	return sqlplugin.NewReplicas(cfg, func(cfg *config.SQL) (*sqlx.DB, error) {
+		return d.createDBConnection(cfg, r)
+	}, lagFn, logger, metricsHandler)
+}
@@ -26,6 +26,8 @@ package postgresql
 
 import (
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence/sql"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 	"go.temporal.io/server/common/resolver"
@@ -51,12 +53,20 @@ func (d *pluginV12) CreateDB(
 	dbKind sqlplugin.DbKind,
 	cfg *config.SQL,
 	r resolver.ServiceResolver,
+	logger log.Logger,
+	metricsHandler metrics.Handler,
 ) (sqlplugin.DB, error) {
 	conn, err := d.createDBConnection(cfg, r)
 	if err != nil {
 		return nil, err
 	}
+	replicas, err := d.createReplicas(cfg, r, replicaLag, logger, metricsHandler)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
 	db := newDBV12(dbKind, cfg.DatabaseName, conn, nil)
+	db.useReplicas(replicas)
 	return db, nil
 }
 
@@ -0,0 +1,52 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// replicaLagQuery returns the seconds since the last replayed transaction, or 0 if the standby
+// has replayed everything it received so that an idle primary does not show up as lag.
+// It returns NULL if the database is not a standby.
+const replicaLagQuery = `SELECT CASE WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0 ` +
+	`ELSE EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()) END`
+
+// replicaLag returns the replication lag reported by a PostgreSQL standby
+func replicaLag(ctx context.Context, conn *sqlx.DB) (time.Duration, error) {
+	var seconds sql.NullFloat64
+	if err := conn.GetContext(ctx, &seconds, replicaLagQuery); err != nil {
+		return 0, err
+	}
+	if !seconds.Valid {
+		return 0, errors.New("database is not a replica")
+	}
+	return time.Duration(seconds.Float64 * float64(time.Second)), nil
+}
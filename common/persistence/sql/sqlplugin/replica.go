@@ -0,0 +1,279 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlplugin
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/atomic"
+	"go.uber.org/multierr"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+)
+
+const (
+	defaultMaxReplicaLag    = 30 * time.Second
+	replicaLagCheckInterval = 10 * time.Second
+	replicaLagCheckTimeout  = 5 * time.Second
+	// lag check errors usually persist (e.g. a missing privilege), log them once a minute
+	replicaLagErrorLogPeriod = time.Minute
+)
+
+type (
+	// ReplicaLagFn returns how far the database behind conn lags behind its primary.
+	// It must return an error if conn is not connected to a replica that is replicating.
+	ReplicaLagFn func(ctx context.Context, conn *sqlx.DB) (time.Duration, error)
+
+	// Replicas is a set of read replicas of a SQL database. Reads that tolerate staleness
+	// (see persistence.WithStaleReads) are spread over the healthy replicas. A replica is
+	// unhealthy while its replication lag exceeds the configured maximum, or after a query
+	// on it fails, until the next successful lag check. Reads fall back to the primary when
+	// no replica is healthy or a replica query fails. The lag and health of each replica are
+	// emitted as gauges tagged with the replica address.
+	Replicas struct {
+		maxLag         time.Duration
+		lagFn          ReplicaLagFn
+		replicas       []*replica
+		next           *atomic.Uint32
+		logger         log.Logger
+		metricsHandler metrics.Handler
+
+		shutdownOnce sync.Once
+		shutdownCh   chan struct{}
+		shutdownWG   sync.WaitGroup
+	}
+
+	replica struct {
+		addr    string
+		db      *sqlx.DB
+		healthy *atomic.Bool
+	}
+
+	replicaConn struct {
+		Conn
+		replicas *Replicas
+	}
+)
+
+// NewReplicas connects to the read replicas configured in cfg.ReadReplicas, using connect
+// with a copy of cfg whose ConnectAddr is set to the replica address, and starts monitoring
+// their replication lag with lagFn. It returns nil if no replicas are configured.
+func NewReplicas(
+	cfg *config.SQL,
+	connect func(cfg *config.SQL) (*sqlx.DB, error),
+	lagFn ReplicaLagFn,
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+) (*Replicas, error) {
+	if len(cfg.ReadReplicas) == 0 {
+		return nil, nil
+	}
+
+	replicas := make([]*replica, 0, len(cfg.ReadReplicas))
+	for _, addr := range cfg.ReadReplicas {
+		replicaCfg := *cfg
+		replicaCfg.ConnectAddr = addr
+		replicaCfg.ReadReplicas = nil
+		db, err := connect(&replicaCfg)
+		if err != nil {
+			for _, replica := range replicas {
+				_ = replica.db.Close()
+			}
+			return nil, fmt.Errorf("unable to connect to read replica %v: %w", addr, err)
+		}
+		replicas = append(replicas, newReplica(addr, db))
+	}
+
+	maxLag := cfg.MaxReplicaLag
+	if maxLag <= 0 {
+		maxLag = defaultMaxReplicaLag
+	}
+	r := newReplicas(replicas, maxLag, lagFn, logger, metricsHandler)
+	r.shutdownWG.Add(1)
+	go r.monitorLoop()
+	return r, nil
+}
+
+func newReplicas(
+	replicas []*replica,
+	maxLag time.Duration,
+	lagFn ReplicaLagFn,
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+) *Replicas {
+	r := &Replicas{
+		maxLag:         maxLag,
+		lagFn:          lagFn,
+		replicas:       replicas,
+		next:           atomic.NewUint32(0),
+		logger:         log.NewThrottledLogger(logger, func() float64 { return 1 / replicaLagErrorLogPeriod.Seconds() }),
+		metricsHandler: metricsHandler,
+		shutdownCh:     make(chan struct{}),
+	}
+	r.checkLag()
+	return r
+}
+
+func newReplica(addr string, db *sqlx.DB) *replica {
+	return &replica{
+		addr:    addr,
+		db:      db,
+		healthy: atomic.NewBool(false),
+	}
+}
+
+// Conn returns a Conn that serves stale reads from a healthy replica and everything else
+// from primary. Transactions must not be wrapped: all statements of a transaction have to
+// run on the primary.
+func (r *Replicas) Conn(primary Conn) Conn {
+	if r == nil {
+		return primary
+	}
+	return &replicaConn{
+		Conn:     primary,
+		replicas: r,
+	}
+}
+
+// Close stops lag monitoring and closes the replica connections.
+func (r *Replicas) Close() error {
+	if r == nil {
+		return nil
+	}
+	r.shutdownOnce.Do(func() { close(r.shutdownCh) })
+	r.shutdownWG.Wait()
+
+	var err error
+	for _, replica := range r.replicas {
+		err = multierr.Append(err, replica.db.Close())
+	}
+	return err
+}
+
+func (r *Replicas) monitorLoop() {
+	defer r.shutdownWG.Done()
+
+	ticker := time.NewTicker(replicaLagCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.shutdownCh:
+			return
+		case <-ticker.C:
+			r.checkLag()
+		}
+	}
+}
+
+func (r *Replicas) checkLag() {
+	for _, replica := range r.replicas {
+		ctx, cancel := context.WithTimeout(context.Background(), replicaLagCheckTimeout)
+		lag, err := r.lagFn(ctx, replica.db)
+		cancel()
+		if err != nil {
+			r.logger.Warn("Unable to get replication lag of read replica", tag.Address(replica.addr), tag.Error(err))
+		} else {
+			r.metricsHandler.Gauge(metrics.PersistenceSQLReplicaLag.GetMetricName()).
+				Record(lag.Seconds(), metrics.ReplicaTag(replica.addr))
+		}
+		r.setHealthy(replica, err == nil && lag <= r.maxLag)
+	}
+}
+
+func (r *Replicas) setHealthy(replica *replica, healthy bool) {
+	replica.healthy.Store(healthy)
+	value := 0.0
+	if healthy {
+		value = 1
+	}
+	r.metricsHandler.Gauge(metrics.PersistenceSQLReplicaHealthy.GetMetricName()).
+		Record(value, metrics.ReplicaTag(replica.addr))
+}
+
+// pick returns a healthy replica to serve a read with ctx, or nil if the read has to be
+// served by the primary.
+func (r *Replicas) pick(ctx context.Context) *replica {
+	if !persistence.StaleReadsAllowed(ctx) {
+		return nil
+	}
+	start := r.next.Inc()
+	for i := range r.replicas {
+		replica := r.replicas[(int(start)+i)%len(r.replicas)]
+		if replica.healthy.Load() {
+			return replica
+		}
+	}
+	return nil
+}
+
+// fallback reports whether a read that failed on replica with err should be retried on
+// the primary. The replica stops serving reads until the next successful lag check.
+func (r *Replicas) fallback(ctx context.Context, replica *replica, err error) bool {
+	if err == nil || errors.Is(err, sql.ErrNoRows) || ctx.Err() != nil {
+		return false
+	}
+	r.setHealthy(replica, false)
+	return true
+}
+
+func (c *replicaConn) GetContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error {
+	if replica := c.replicas.pick(ctx); replica != nil {
+		err := replica.db.GetContext(ctx, dest, query, args...)
+		if !c.replicas.fallback(ctx, replica, err) {
+			return err
+		}
+	}
+	return c.Conn.GetContext(ctx, dest, query, args...)
+}
+
+func (c *replicaConn) SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error {
+	if replica := c.replicas.pick(ctx); replica != nil {
+		err := replica.db.SelectContext(ctx, dest, query, args...)
+		if !c.replicas.fallback(ctx, replica, err) {
+			return err
+		}
+		// rows scanned before the failure have been appended to dest already
+		resetSlice(dest)
+	}
+	return c.Conn.SelectContext(ctx, dest, query, args...)
+}
+
+func resetSlice(dest interface{}) {
+	v := reflect.ValueOf(dest)
+	if v.Kind() == reflect.Ptr && v.Elem().Kind() == reflect.Slice {
+		v.Elem().SetLen(0)
+	}
+}
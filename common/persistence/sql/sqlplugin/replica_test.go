@@ -0,0 +1,182 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlplugin
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+	_ "modernc.org/sqlite"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+)
+
+const replicaTestQuery = `SELECT name FROM source`
+
+func newReplicaTestDB(t *testing.T, name string) *sqlx.DB {
+	db, err := sqlx.Open("sqlite", ":memory:")
+	require.NoError(t, err)
+	// every connection to :memory: opens a new database
+	db.SetMaxOpenConns(1)
+	_, err = db.Exec(`CREATE TABLE source (name TEXT)`)
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO source (name) VALUES (?)`, name)
+	require.NoError(t, err)
+	return db
+}
+
+func staticLag(lag time.Duration, err error) ReplicaLagFn {
+	return func(context.Context, *sqlx.DB) (time.Duration, error) {
+		return lag, err
+	}
+}
+
+func getSource(t *testing.T, conn Conn, ctx context.Context) string {
+	var name string
+	require.NoError(t, conn.GetContext(ctx, &name, replicaTestQuery))
+	return name
+}
+
+func selectSource(t *testing.T, conn Conn, ctx context.Context) []string {
+	var names []string
+	require.NoError(t, conn.SelectContext(ctx, &names, replicaTestQuery))
+	return names
+}
+
+func TestReplicas_StaleReadsUseReplica(t *testing.T) {
+	primary := newReplicaTestDB(t, "primary")
+	defer func() { _ = primary.Close() }()
+	replicas := newReplicas([]*replica{newReplica("replica", newReplicaTestDB(t, "replica"))}, time.Second, staticLag(0, nil), log.NewNoopLogger(), metrics.NoopMetricsHandler)
+	defer func() { _ = replicas.Close() }()
+
+	conn := replicas.Conn(primary)
+	staleCtx := persistence.WithStaleReads(context.Background())
+	require.Equal(t, "primary", getSource(t, conn, context.Background()))
+	require.Equal(t, "replica", getSource(t, conn, staleCtx))
+	require.Equal(t, []string{"primary"}, selectSource(t, conn, context.Background()))
+	require.Equal(t, []string{"replica"}, selectSource(t, conn, staleCtx))
+}
+
+func TestReplicas_UnhealthyReplicaUsesPrimary(t *testing.T) {
+	primary := newReplicaTestDB(t, "primary")
+	defer func() { _ = primary.Close() }()
+	staleCtx := persistence.WithStaleReads(context.Background())
+
+	lagging := newReplicas([]*replica{newReplica("replica", newReplicaTestDB(t, "replica"))}, time.Second, staticLag(time.Minute, nil), log.NewNoopLogger(), metrics.NoopMetricsHandler)
+	defer func() { _ = lagging.Close() }()
+	require.Equal(t, "primary", getSource(t, lagging.Conn(primary), staleCtx))
+
+	failing := newReplicas([]*replica{newReplica("replica", newReplicaTestDB(t, "replica"))}, time.Second, staticLag(0, errors.New("not a replica")), log.NewNoopLogger(), metrics.NoopMetricsHandler)
+	defer func() { _ = failing.Close() }()
+	require.Equal(t, "primary", getSource(t, failing.Conn(primary), staleCtx))
+}
+
+func TestReplicas_FallbackOnReplicaError(t *testing.T) {
+	primary := newReplicaTestDB(t, "primary")
+	defer func() { _ = primary.Close() }()
+	replicaDB := newReplicaTestDB(t, "replica")
+	replicas := newReplicas([]*replica{newReplica("replica", replicaDB)}, time.Second, staticLag(0, nil), log.NewNoopLogger(), metrics.NoopMetricsHandler)
+	defer func() { _ = replicas.Close() }()
+
+	conn := replicas.Conn(primary)
+	staleCtx := persistence.WithStaleReads(context.Background())
+	_, err := replicaDB.Exec(`DROP TABLE source`)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"primary"}, selectSource(t, conn, staleCtx))
+	require.False(t, replicas.replicas[0].healthy.Load())
+	require.Equal(t, "primary", getSource(t, conn, staleCtx))
+
+	// the replica serves reads again after the next successful lag check
+	_, err = replicaDB.Exec(`CREATE TABLE source (name TEXT)`)
+	require.NoError(t, err)
+	_, err = replicaDB.Exec(`INSERT INTO source (name) VALUES ('replica')`)
+	require.NoError(t, err)
+	replicas.checkLag()
+	require.Equal(t, "replica", getSource(t, conn, staleCtx))
+}
+
+func TestReplicas_NoRowsDoesNotFallback(t *testing.T) {
+	primary := newReplicaTestDB(t, "primary")
+	defer func() { _ = primary.Close() }()
+	replicaDB := newReplicaTestDB(t, "replica")
+	replicas := newReplicas([]*replica{newReplica("replica", replicaDB)}, time.Second, staticLag(0, nil), log.NewNoopLogger(), metrics.NoopMetricsHandler)
+	defer func() { _ = replicas.Close() }()
+
+	_, err := replicaDB.Exec(`DELETE FROM source`)
+	require.NoError(t, err)
+
+	var name string
+	err = replicas.Conn(primary).GetContext(persistence.WithStaleReads(context.Background()), &name, replicaTestQuery)
+	require.Error(t, err)
+	require.True(t, replicas.replicas[0].healthy.Load())
+}
+
+func TestReplicas_LagMetrics(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := log.NewMockLogger(ctrl)
+	metricsHandler := metrics.NewMockHandler(ctrl)
+	lagGauge := metrics.NewMockGaugeIface(ctrl)
+	healthyGauge := metrics.NewMockGaugeIface(ctrl)
+	metricsHandler.EXPECT().Gauge(metrics.PersistenceSQLReplicaLag.GetMetricName()).Return(lagGauge).AnyTimes()
+	metricsHandler.EXPECT().Gauge(metrics.PersistenceSQLReplicaHealthy.GetMetricName()).Return(healthyGauge).AnyTimes()
+
+	var lagErr error
+	lagFn := func(context.Context, *sqlx.DB) (time.Duration, error) {
+		return 2 * time.Second, lagErr
+	}
+	lagGauge.EXPECT().Record(float64(2), metrics.ReplicaTag("replica"))
+	healthyGauge.EXPECT().Record(float64(0), metrics.ReplicaTag("replica"))
+	replicas := newReplicas([]*replica{newReplica("replica", newReplicaTestDB(t, "replica"))}, time.Second, lagFn, logger, metricsHandler)
+	defer func() { _ = replicas.Close() }()
+
+	// a failed lag check is logged, but doesn't report a lag
+	lagErr = errors.New("Access denied; you need the REPLICATION CLIENT privilege")
+	logger.EXPECT().Warn(gomock.Any(), gomock.Any())
+	healthyGauge.EXPECT().Record(float64(0), metrics.ReplicaTag("replica"))
+	replicas.checkLag()
+
+	replicas.maxLag = time.Minute
+	lagErr = nil
+	lagGauge.EXPECT().Record(float64(2), metrics.ReplicaTag("replica"))
+	healthyGauge.EXPECT().Record(float64(1), metrics.ReplicaTag("replica"))
+	replicas.checkLag()
+}
+
+func TestReplicas_NilReplicas(t *testing.T) {
+	primary := newReplicaTestDB(t, "primary")
+	defer func() { _ = primary.Close() }()
+
+	var replicas *Replicas
+	require.Equal(t, "primary", getSource(t, replicas.Conn(primary), persistence.WithStaleReads(context.Background())))
+	require.NoError(t, replicas.Close())
+}
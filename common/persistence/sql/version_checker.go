@@ -51,8 +51,16 @@ func checkMainDatabase(
 	r resolver.ServiceResolver,
 ) error {
 	ds, ok := cfg.DataStores[cfg.DefaultStore]
-	if ok && ds.SQL != nil {
-		return checkCompatibleVersion(ds.SQL, r, sqlplugin.DbKindMain)
+	if !ok || ds.SQL == nil {
+		return nil
+	}
+	if err := checkCompatibleVersion(ds.SQL, r, sqlplugin.DbKindMain); err != nil {
+		return err
+	}
+	for _, db := range ds.SQL.ShardDatabases {
+		if err := checkCompatibleVersion(ds.SQL.ShardDatabase(db), r, sqlplugin.DbKindMain); err != nil {
+			return err
+		}
 	}
 	return nil
 }
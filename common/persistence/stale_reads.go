@@ -0,0 +1,48 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+)
+
+type (
+	staleReadsContextKey struct{}
+)
+
+var staleReadsCtxKey = staleReadsContextKey{}
+
+// WithStaleReads marks the context as tolerating reads that may lag behind the latest writes.
+// Stores that have read replicas may serve such reads from a replica instead of the primary.
+// Only use it for reads whose results are not used to make consistency-critical decisions.
+func WithStaleReads(ctx context.Context) context.Context {
+	return context.WithValue(ctx, staleReadsCtxKey, true)
+}
+
+// StaleReadsAllowed reports whether the context was marked with WithStaleReads.
+func StaleReadsAllowed(ctx context.Context) bool {
+	allowed, ok := ctx.Value(staleReadsCtxKey).(bool)
+	return ok && allowed
+}
@@ -0,0 +1,224 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence/kv"
+	persistencetests "go.temporal.io/server/common/persistence/persistence-tests"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+const (
+	testKVClusterName = "temporal_kv_cluster"
+)
+
+// NewKVMemoryConfig returns a new in-memory key-value store config for test
+func NewKVMemoryConfig() *config.KV {
+	return &config.KV{
+		InMemory: true,
+	}
+}
+
+// NewKVFileConfig returns a new on-disk key-value store config for test
+func NewKVFileConfig(t *testing.T) *config.KV {
+	return &config.KV{
+		Path: t.TempDir(),
+	}
+}
+
+func TestKVExecutionMutableStateStoreSuite(t *testing.T) {
+	testKVExecutionMutableStateStoreSuite(t, NewKVMemoryConfig())
+}
+
+func TestKVFileExecutionMutableStateStoreSuite(t *testing.T) {
+	testKVExecutionMutableStateStoreSuite(t, NewKVFileConfig(t))
+}
+
+func testKVExecutionMutableStateStoreSuite(t *testing.T, cfg *config.KV) {
+	logger := log.NewNoopLogger()
+	factory := kv.NewFactory(*cfg, testKVClusterName, logger)
+	shardStore, err := factory.NewShardStore()
+	if err != nil {
+		t.Fatalf("unable to create KV DB: %v", err)
+	}
+	executionStore, err := factory.NewExecutionStore()
+	if err != nil {
+		t.Fatalf("unable to create KV DB: %v", err)
+	}
+	defer func() {
+		executionStore.Close()
+		shardStore.Close()
+		factory.Close()
+	}()
+
+	s := NewExecutionMutableStateSuite(
+		t,
+		shardStore,
+		executionStore,
+		serialization.NewSerializer(),
+		logger,
+	)
+	suite.Run(t, s)
+}
+
+func TestKVExecutionMutableStateTaskStoreSuite(t *testing.T) {
+	cfg := NewKVMemoryConfig()
+	logger := log.NewNoopLogger()
+	factory := kv.NewFactory(*cfg, testKVClusterName, logger)
+	shardStore, err := factory.NewShardStore()
+	if err != nil {
+		t.Fatalf("unable to create KV DB: %v", err)
+	}
+	executionStore, err := factory.NewExecutionStore()
+	if err != nil {
+		t.Fatalf("unable to create KV DB: %v", err)
+	}
+	defer func() {
+		executionStore.Close()
+		shardStore.Close()
+		factory.Close()
+	}()
+
+	s := NewExecutionMutableStateTaskSuite(
+		t,
+		shardStore,
+		executionStore,
+		serialization.NewSerializer(),
+		logger,
+	)
+	suite.Run(t, s)
+}
+
+func TestKVHistoryStoreSuite(t *testing.T) {
+	testKVHistoryStoreSuite(t, NewKVMemoryConfig())
+}
+
+func TestKVFileHistoryStoreSuite(t *testing.T) {
+	testKVHistoryStoreSuite(t, NewKVFileConfig(t))
+}
+
+func testKVHistoryStoreSuite(t *testing.T, cfg *config.KV) {
+	logger := log.NewNoopLogger()
+	factory := kv.NewFactory(*cfg, testKVClusterName, logger)
+	store, err := factory.NewExecutionStore()
+	if err != nil {
+		t.Fatalf("unable to create KV DB: %v", err)
+	}
+	defer func() {
+		store.Close()
+		factory.Close()
+	}()
+
+	s := NewHistoryEventsSuite(t, store, logger)
+	suite.Run(t, s)
+}
+
+func TestKVTaskQueueSuite(t *testing.T) {
+	cfg := NewKVMemoryConfig()
+	logger := log.NewNoopLogger()
+	factory := kv.NewFactory(*cfg, testKVClusterName, logger)
+	taskQueueStore, err := factory.NewTaskStore()
+	if err != nil {
+		t.Fatalf("unable to create KV DB: %v", err)
+	}
+	defer func() {
+		taskQueueStore.Close()
+		factory.Close()
+	}()
+
+	s := NewTaskQueueSuite(t, taskQueueStore, logger)
+	suite.Run(t, s)
+}
+
+func TestKVTaskQueueTaskSuite(t *testing.T) {
+	cfg := NewKVMemoryConfig()
+	logger := log.NewNoopLogger()
+	factory := kv.NewFactory(*cfg, testKVClusterName, logger)
+	taskQueueStore, err := factory.NewTaskStore()
+	if err != nil {
+		t.Fatalf("unable to create KV DB: %v", err)
+	}
+	defer func() {
+		taskQueueStore.Close()
+		factory.Close()
+	}()
+
+	s := NewTaskQueueTaskSuite(t, taskQueueStore, logger)
+	suite.Run(t, s)
+}
+
+func TestKVVisibilityPersistenceSuite(t *testing.T) {
+	s := new(VisibilityPersistenceSuite)
+	s.TestBase = persistencetests.NewTestBaseWithKV(persistencetests.GetKVMemoryTestClusterOption())
+	suite.Run(t, s)
+}
+
+func TestKVHistoryV2PersistenceSuite(t *testing.T) {
+	s := new(persistencetests.HistoryV2PersistenceSuite)
+	s.TestBase = persistencetests.NewTestBaseWithKV(persistencetests.GetKVMemoryTestClusterOption())
+	s.TestBase.Setup(nil)
+	suite.Run(t, s)
+}
+
+func TestKVMetadataPersistenceSuiteV2(t *testing.T) {
+	s := new(persistencetests.MetadataPersistenceSuiteV2)
+	s.TestBase = persistencetests.NewTestBaseWithKV(persistencetests.GetKVMemoryTestClusterOption())
+	s.TestBase.Setup(nil)
+	suite.Run(t, s)
+}
+
+func TestKVClusterMetadataPersistence(t *testing.T) {
+	s := new(persistencetests.ClusterMetadataManagerSuite)
+	s.TestBase = persistencetests.NewTestBaseWithKV(persistencetests.GetKVMemoryTestClusterOption())
+	s.TestBase.Setup(nil)
+	suite.Run(t, s)
+}
+
+func TestKVQueuePersistence(t *testing.T) {
+	s := new(persistencetests.QueuePersistenceSuite)
+	s.TestBase = persistencetests.NewTestBaseWithKV(persistencetests.GetKVMemoryTestClusterOption())
+	s.TestBase.Setup(nil)
+	suite.Run(t, s)
+}
+
+func TestKVFileMetadataPersistenceSuiteV2(t *testing.T) {
+	s := new(persistencetests.MetadataPersistenceSuiteV2)
+	s.TestBase = persistencetests.NewTestBaseWithKV(persistencetests.GetKVFileTestClusterOption())
+	s.TestBase.Setup(nil)
+	suite.Run(t, s)
+}
+
+func TestKVFileQueuePersistence(t *testing.T) {
+	s := new(persistencetests.QueuePersistenceSuite)
+	s.TestBase = persistencetests.NewTestBaseWithKV(persistencetests.GetKVFileTestClusterOption())
+	s.TestBase.Setup(nil)
+	suite.Run(t, s)
+}
@@ -35,6 +35,7 @@ import (
 
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
 	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/sql"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
@@ -80,6 +81,7 @@ func setUpMySQLTest(t *testing.T) (MySQLTestData, func()) {
 		resolver.NewNoopResolver(),
 		testMySQLClusterName,
 		testData.Logger,
+		metrics.NoopMetricsHandler,
 	)
 
 	tearDown := func() {
@@ -36,6 +36,7 @@ import (
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
 	persistencetests "go.temporal.io/server/common/persistence/persistence-tests"
 	"go.temporal.io/server/common/persistence/serialization"
@@ -116,6 +117,7 @@ func TestSQLiteExecutionMutableStateStoreSuite(t *testing.T) {
 		resolver.NewNoopResolver(),
 		testSQLiteClusterName,
 		logger,
+		metrics.NoopMetricsHandler,
 	)
 	shardStore, err := factory.NewShardStore()
 	if err != nil {
@@ -147,6 +149,7 @@ func TestSQLiteExecutionMutableStateTaskStoreSuite(t *testing.T) {
 		resolver.NewNoopResolver(),
 		testSQLiteClusterName,
 		logger,
+		metrics.NoopMetricsHandler,
 	)
 	shardStore, err := factory.NewShardStore()
 	if err != nil {
@@ -178,6 +181,7 @@ func TestSQLiteHistoryStoreSuite(t *testing.T) {
 		resolver.NewNoopResolver(),
 		testSQLiteClusterName,
 		logger,
+		metrics.NoopMetricsHandler,
 	)
 	store, err := factory.NewExecutionStore()
 	if err != nil {
@@ -199,6 +203,7 @@ func TestSQLiteTaskQueueSuite(t *testing.T) {
 		resolver.NewNoopResolver(),
 		testSQLiteClusterName,
 		logger,
+		metrics.NoopMetricsHandler,
 	)
 	taskQueueStore, err := factory.NewTaskStore()
 	if err != nil {
@@ -220,6 +225,7 @@ func TestSQLiteTaskQueueTaskSuite(t *testing.T) {
 		resolver.NewNoopResolver(),
 		testSQLiteClusterName,
 		logger,
+		metrics.NoopMetricsHandler,
 	)
 	taskQueueStore, err := factory.NewTaskStore()
 	if err != nil {
@@ -245,6 +251,7 @@ func TestSQLiteFileExecutionMutableStateStoreSuite(t *testing.T) {
 		resolver.NewNoopResolver(),
 		testSQLiteClusterName,
 		logger,
+		metrics.NoopMetricsHandler,
 	)
 	shardStore, err := factory.NewShardStore()
 	if err != nil {
@@ -280,6 +287,7 @@ func TestSQLiteFileExecutionMutableStateTaskStoreSuite(t *testing.T) {
 		resolver.NewNoopResolver(),
 		testSQLiteClusterName,
 		logger,
+		metrics.NoopMetricsHandler,
 	)
 	shardStore, err := factory.NewShardStore()
 	if err != nil {
@@ -315,6 +323,7 @@ func TestSQLiteFileHistoryStoreSuite(t *testing.T) {
 		resolver.NewNoopResolver(),
 		testSQLiteClusterName,
 		logger,
+		metrics.NoopMetricsHandler,
 	)
 	store, err := factory.NewExecutionStore()
 	if err != nil {
@@ -340,6 +349,7 @@ func TestSQLiteFileTaskQueueSuite(t *testing.T) {
 		resolver.NewNoopResolver(),
 		testSQLiteClusterName,
 		logger,
+		metrics.NoopMetricsHandler,
 	)
 	taskQueueStore, err := factory.NewTaskStore()
 	if err != nil {
@@ -365,6 +375,7 @@ func TestSQLiteFileTaskQueueTaskSuite(t *testing.T) {
 		resolver.NewNoopResolver(),
 		testSQLiteClusterName,
 		logger,
+		metrics.NoopMetricsHandler,
 	)
 	taskQueueStore, err := factory.NewTaskStore()
 	if err != nil {
@@ -446,7 +457,7 @@ func TestSQLiteFileQueuePersistence(t *testing.T) {
 
 func TestSQLiteNamespaceSuite(t *testing.T) {
 	cfg := NewSQLiteMemoryConfig()
-	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver(), log.NewNoopLogger(), metrics.NoopMetricsHandler)
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
@@ -460,7 +471,7 @@ func TestSQLiteNamespaceSuite(t *testing.T) {
 
 func TestSQLiteQueueMessageSuite(t *testing.T) {
 	cfg := NewSQLiteMemoryConfig()
-	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver(), log.NewNoopLogger(), metrics.NoopMetricsHandler)
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
@@ -474,7 +485,7 @@ func TestSQLiteQueueMessageSuite(t *testing.T) {
 
 func TestSQLiteQueueMetadataSuite(t *testing.T) {
 	cfg := NewSQLiteMemoryConfig()
-	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver(), log.NewNoopLogger(), metrics.NoopMetricsHandler)
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
@@ -488,7 +499,7 @@ func TestSQLiteQueueMetadataSuite(t *testing.T) {
 
 func TestSQLiteMatchingTaskSuite(t *testing.T) {
 	cfg := NewSQLiteMemoryConfig()
-	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver(), log.NewNoopLogger(), metrics.NoopMetricsHandler)
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
@@ -502,7 +513,7 @@ func TestSQLiteMatchingTaskSuite(t *testing.T) {
 
 func TestSQLiteMatchingTaskQueueSuite(t *testing.T) {
 	cfg := NewSQLiteMemoryConfig()
-	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver(), log.NewNoopLogger(), metrics.NoopMetricsHandler)
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
@@ -516,7 +527,7 @@ func TestSQLiteMatchingTaskQueueSuite(t *testing.T) {
 
 func TestSQLiteHistoryShardSuite(t *testing.T) {
 	cfg := NewSQLiteMemoryConfig()
-	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver(), log.NewNoopLogger(), metrics.NoopMetricsHandler)
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
@@ -530,7 +541,7 @@ func TestSQLiteHistoryShardSuite(t *testing.T) {
 
 func TestSQLiteHistoryNodeSuite(t *testing.T) {
 	cfg := NewSQLiteMemoryConfig()
-	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver(), log.NewNoopLogger(), metrics.NoopMetricsHandler)
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
@@ -544,7 +555,7 @@ func TestSQLiteHistoryNodeSuite(t *testing.T) {
 
 func TestSQLiteHistoryTreeSuite(t *testing.T) {
 	cfg := NewSQLiteMemoryConfig()
-	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver(), log.NewNoopLogger(), metrics.NoopMetricsHandler)
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
@@ -558,7 +569,7 @@ func TestSQLiteHistoryTreeSuite(t *testing.T) {
 
 func TestSQLiteHistoryCurrentExecutionSuite(t *testing.T) {
 	cfg := NewSQLiteMemoryConfig()
-	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver(), log.NewNoopLogger(), metrics.NoopMetricsHandler)
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
@@ -572,7 +583,7 @@ func TestSQLiteHistoryCurrentExecutionSuite(t *testing.T) {
 
 func TestSQLiteHistoryExecutionSuite(t *testing.T) {
 	cfg := NewSQLiteMemoryConfig()
-	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver(), log.NewNoopLogger(), metrics.NoopMetricsHandler)
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
@@ -586,7 +597,7 @@ func TestSQLiteHistoryExecutionSuite(t *testing.T) {
 
 func TestSQLiteHistoryTransferTaskSuite(t *testing.T) {
 	cfg := NewSQLiteMemoryConfig()
-	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver(), log.NewNoopLogger(), metrics.NoopMetricsHandler)
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
@@ -600,7 +611,7 @@ func TestSQLiteHistoryTransferTaskSuite(t *testing.T) {
 
 func TestSQLiteHistoryTimerTaskSuite(t *testing.T) {
 	cfg := NewSQLiteMemoryConfig()
-	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver(), log.NewNoopLogger(), metrics.NoopMetricsHandler)
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
@@ -614,7 +625,7 @@ func TestSQLiteHistoryTimerTaskSuite(t *testing.T) {
 
 func TestSQLiteHistoryReplicationTaskSuite(t *testing.T) {
 	cfg := NewSQLiteMemoryConfig()
-	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver(), log.NewNoopLogger(), metrics.NoopMetricsHandler)
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
@@ -628,7 +639,7 @@ func TestSQLiteHistoryReplicationTaskSuite(t *testing.T) {
 
 func TestSQLiteHistoryVisibilityTaskSuite(t *testing.T) {
 	cfg := NewSQLiteMemoryConfig()
-	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver(), log.NewNoopLogger(), metrics.NoopMetricsHandler)
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
@@ -642,7 +653,7 @@ func TestSQLiteHistoryVisibilityTaskSuite(t *testing.T) {
 
 func TestSQLiteHistoryReplicationDLQTaskSuite(t *testing.T) {
 	cfg := NewSQLiteMemoryConfig()
-	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver(), log.NewNoopLogger(), metrics.NoopMetricsHandler)
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
@@ -656,7 +667,7 @@ func TestSQLiteHistoryReplicationDLQTaskSuite(t *testing.T) {
 
 func TestSQLiteHistoryExecutionBufferSuite(t *testing.T) {
 	cfg := NewSQLiteMemoryConfig()
-	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver(), log.NewNoopLogger(), metrics.NoopMetricsHandler)
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
@@ -670,7 +681,7 @@ func TestSQLiteHistoryExecutionBufferSuite(t *testing.T) {
 
 func TestSQLiteHistoryExecutionActivitySuite(t *testing.T) {
 	cfg := NewSQLiteMemoryConfig()
-	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver(), log.NewNoopLogger(), metrics.NoopMetricsHandler)
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
@@ -684,7 +695,7 @@ func TestSQLiteHistoryExecutionActivitySuite(t *testing.T) {
 
 func TestSQLiteHistoryExecutionChildWorkflowSuite(t *testing.T) {
 	cfg := NewSQLiteMemoryConfig()
-	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver(), log.NewNoopLogger(), metrics.NoopMetricsHandler)
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
@@ -698,7 +709,7 @@ func TestSQLiteHistoryExecutionChildWorkflowSuite(t *testing.T) {
 
 func TestSQLiteHistoryExecutionTimerSuite(t *testing.T) {
 	cfg := NewSQLiteMemoryConfig()
-	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver(), log.NewNoopLogger(), metrics.NoopMetricsHandler)
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
@@ -712,7 +723,7 @@ func TestSQLiteHistoryExecutionTimerSuite(t *testing.T) {
 
 func TestSQLiteHistoryExecutionRequestCancelSuite(t *testing.T) {
 	cfg := NewSQLiteMemoryConfig()
-	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver(), log.NewNoopLogger(), metrics.NoopMetricsHandler)
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
@@ -726,7 +737,7 @@ func TestSQLiteHistoryExecutionRequestCancelSuite(t *testing.T) {
 
 func TestSQLiteHistoryExecutionSignalSuite(t *testing.T) {
 	cfg := NewSQLiteMemoryConfig()
-	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver(), log.NewNoopLogger(), metrics.NoopMetricsHandler)
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
@@ -740,7 +751,7 @@ func TestSQLiteHistoryExecutionSignalSuite(t *testing.T) {
 
 func TestSQLiteHistoryExecutionSignalRequestSuite(t *testing.T) {
 	cfg := NewSQLiteMemoryConfig()
-	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver(), log.NewNoopLogger(), metrics.NoopMetricsHandler)
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
@@ -754,7 +765,7 @@ func TestSQLiteHistoryExecutionSignalRequestSuite(t *testing.T) {
 
 func TestSQLiteVisibilitySuite(t *testing.T) {
 	cfg := NewSQLiteMemoryConfig()
-	store, err := sql.NewSQLDB(sqlplugin.DbKindVisibility, cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(sqlplugin.DbKindVisibility, cfg, resolver.NewNoopResolver(), log.NewNoopLogger(), metrics.NoopMetricsHandler)
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
@@ -769,7 +780,7 @@ func TestSQLiteVisibilitySuite(t *testing.T) {
 func TestSQLiteFileNamespaceSuite(t *testing.T) {
 	cfg := NewSQLiteFileConfig()
 	SetupSQLiteDatabase(cfg)
-	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver(), log.NewNoopLogger(), metrics.NoopMetricsHandler)
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
@@ -782,7 +793,7 @@ func TestSQLiteFileNamespaceSuite(t *testing.T) {
 func TestSQLiteFileQueueMessageSuite(t *testing.T) {
 	cfg := NewSQLiteFileConfig()
 	SetupSQLiteDatabase(cfg)
-	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver(), log.NewNoopLogger(), metrics.NoopMetricsHandler)
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
@@ -795,7 +806,7 @@ func TestSQLiteFileQueueMessageSuite(t *testing.T) {
 func TestSQLiteFileQueueMetadataSuite(t *testing.T) {
 	cfg := NewSQLiteFileConfig()
 	SetupSQLiteDatabase(cfg)
-	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver(), log.NewNoopLogger(), metrics.NoopMetricsHandler)
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
@@ -808,7 +819,7 @@ func TestSQLiteFileQueueMetadataSuite(t *testing.T) {
 func TestSQLiteFileMatchingTaskSuite(t *testing.T) {
 	cfg := NewSQLiteFileConfig()
 	SetupSQLiteDatabase(cfg)
-	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver(), log.NewNoopLogger(), metrics.NoopMetricsHandler)
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
@@ -821,7 +832,7 @@ func TestSQLiteFileMatchingTaskSuite(t *testing.T) {
 func TestSQLiteFileMatchingTaskQueueSuite(t *testing.T) {
 	cfg := NewSQLiteFileConfig()
 	SetupSQLiteDatabase(cfg)
-	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver(), log.NewNoopLogger(), metrics.NoopMetricsHandler)
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
@@ -834,7 +845,7 @@ func TestSQLiteFileMatchingTaskQueueSuite(t *testing.T) {
 func TestSQLiteFileHistoryShardSuite(t *testing.T) {
 	cfg := NewSQLiteFileConfig()
 	SetupSQLiteDatabase(cfg)
-	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver(), log.NewNoopLogger(), metrics.NoopMetricsHandler)
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
@@ -847,7 +858,7 @@ func TestSQLiteFileHistoryShardSuite(t *testing.T) {
 func TestSQLiteFileHistoryNodeSuite(t *testing.T) {
 	cfg := NewSQLiteFileConfig()
 	SetupSQLiteDatabase(cfg)
-	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver(), log.NewNoopLogger(), metrics.NoopMetricsHandler)
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
@@ -860,7 +871,7 @@ func TestSQLiteFileHistoryNodeSuite(t *testing.T) {
 func TestSQLiteFileHistoryTreeSuite(t *testing.T) {
 	cfg := NewSQLiteFileConfig()
 	SetupSQLiteDatabase(cfg)
-	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver(), log.NewNoopLogger(), metrics.NoopMetricsHandler)
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
@@ -873,7 +884,7 @@ func TestSQLiteFileHistoryTreeSuite(t *testing.T) {
 func TestSQLiteFileHistoryCurrentExecutionSuite(t *testing.T) {
 	cfg := NewSQLiteFileConfig()
 	SetupSQLiteDatabase(cfg)
-	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver(), log.NewNoopLogger(), metrics.NoopMetricsHandler)
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
@@ -886,7 +897,7 @@ func TestSQLiteFileHistoryCurrentExecutionSuite(t *testing.T) {
 func TestSQLiteFileHistoryExecutionSuite(t *testing.T) {
 	cfg := NewSQLiteFileConfig()
 	SetupSQLiteDatabase(cfg)
-	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver(), log.NewNoopLogger(), metrics.NoopMetricsHandler)
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
@@ -899,7 +910,7 @@ func TestSQLiteFileHistoryExecutionSuite(t *testing.T) {
 func TestSQLiteFileHistoryTransferTaskSuite(t *testing.T) {
 	cfg := NewSQLiteFileConfig()
 	SetupSQLiteDatabase(cfg)
-	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver(), log.NewNoopLogger(), metrics.NoopMetricsHandler)
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
@@ -912,7 +923,7 @@ func TestSQLiteFileHistoryTransferTaskSuite(t *testing.T) {
 func TestSQLiteFileHistoryTimerTaskSuite(t *testing.T) {
 	cfg := NewSQLiteFileConfig()
 	SetupSQLiteDatabase(cfg)
-	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver(), log.NewNoopLogger(), metrics.NoopMetricsHandler)
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
@@ -925,7 +936,7 @@ func TestSQLiteFileHistoryTimerTaskSuite(t *testing.T) {
 func TestSQLiteFileHistoryReplicationTaskSuite(t *testing.T) {
 	cfg := NewSQLiteFileConfig()
 	SetupSQLiteDatabase(cfg)
-	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver(), log.NewNoopLogger(), metrics.NoopMetricsHandler)
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
@@ -938,7 +949,7 @@ func TestSQLiteFileHistoryReplicationTaskSuite(t *testing.T) {
 func TestSQLiteFileHistoryVisibilityTaskSuite(t *testing.T) {
 	cfg := NewSQLiteFileConfig()
 	SetupSQLiteDatabase(cfg)
-	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver(), log.NewNoopLogger(), metrics.NoopMetricsHandler)
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
@@ -951,7 +962,7 @@ func TestSQLiteFileHistoryVisibilityTaskSuite(t *testing.T) {
 func TestSQLiteFileHistoryReplicationDLQTaskSuite(t *testing.T) {
 	cfg := NewSQLiteFileConfig()
 	SetupSQLiteDatabase(cfg)
-	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver(), log.NewNoopLogger(), metrics.NoopMetricsHandler)
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
@@ -964,7 +975,7 @@ func TestSQLiteFileHistoryReplicationDLQTaskSuite(t *testing.T) {
 func TestSQLiteFileHistoryExecutionBufferSuite(t *testing.T) {
 	cfg := NewSQLiteFileConfig()
 	SetupSQLiteDatabase(cfg)
-	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver(), log.NewNoopLogger(), metrics.NoopMetricsHandler)
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
@@ -977,7 +988,7 @@ func TestSQLiteFileHistoryExecutionBufferSuite(t *testing.T) {
 func TestSQLiteFileHistoryExecutionActivitySuite(t *testing.T) {
 	cfg := NewSQLiteFileConfig()
 	SetupSQLiteDatabase(cfg)
-	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver(), log.NewNoopLogger(), metrics.NoopMetricsHandler)
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
@@ -990,7 +1001,7 @@ func TestSQLiteFileHistoryExecutionActivitySuite(t *testing.T) {
 func TestSQLiteFileHistoryExecutionChildWorkflowSuite(t *testing.T) {
 	cfg := NewSQLiteFileConfig()
 	SetupSQLiteDatabase(cfg)
-	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver(), log.NewNoopLogger(), metrics.NoopMetricsHandler)
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
@@ -1003,7 +1014,7 @@ func TestSQLiteFileHistoryExecutionChildWorkflowSuite(t *testing.T) {
 func TestSQLiteFileHistoryExecutionTimerSuite(t *testing.T) {
 	cfg := NewSQLiteFileConfig()
 	SetupSQLiteDatabase(cfg)
-	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver(), log.NewNoopLogger(), metrics.NoopMetricsHandler)
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
@@ -1016,7 +1027,7 @@ func TestSQLiteFileHistoryExecutionTimerSuite(t *testing.T) {
 func TestSQLiteFileHistoryExecutionRequestCancelSuite(t *testing.T) {
 	cfg := NewSQLiteFileConfig()
 	SetupSQLiteDatabase(cfg)
-	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver(), log.NewNoopLogger(), metrics.NoopMetricsHandler)
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
@@ -1029,7 +1040,7 @@ func TestSQLiteFileHistoryExecutionRequestCancelSuite(t *testing.T) {
 func TestSQLiteFileHistoryExecutionSignalSuite(t *testing.T) {
 	cfg := NewSQLiteFileConfig()
 	SetupSQLiteDatabase(cfg)
-	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver(), log.NewNoopLogger(), metrics.NoopMetricsHandler)
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
@@ -1042,7 +1053,7 @@ func TestSQLiteFileHistoryExecutionSignalSuite(t *testing.T) {
 func TestSQLiteFileHistoryExecutionSignalRequestSuite(t *testing.T) {
 	cfg := NewSQLiteFileConfig()
 	SetupSQLiteDatabase(cfg)
-	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(sqlplugin.DbKindMain, cfg, resolver.NewNoopResolver(), log.NewNoopLogger(), metrics.NoopMetricsHandler)
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
@@ -1055,7 +1066,7 @@ func TestSQLiteFileHistoryExecutionSignalRequestSuite(t *testing.T) {
 func TestSQLiteFileVisibilitySuite(t *testing.T) {
 	cfg := NewSQLiteFileConfig()
 	SetupSQLiteDatabase(cfg)
-	store, err := sql.NewSQLDB(sqlplugin.DbKindVisibility, cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(sqlplugin.DbKindVisibility, cfg, resolver.NewNoopResolver(), log.NewNoopLogger(), metrics.NoopMetricsHandler)
 	if err != nil {
 		t.Fatalf("unable to create SQLite DB: %v", err)
 	}
@@ -39,6 +39,7 @@ import (
 	"go.temporal.io/server/common/persistence/visibility/store/sql"
 	"go.temporal.io/server/common/persistence/visibility/store/standard"
 	"go.temporal.io/server/common/persistence/visibility/store/standard/cassandra"
+	standardKV "go.temporal.io/server/common/persistence/visibility/store/standard/kv"
 	standardSql "go.temporal.io/server/common/persistence/visibility/store/standard/sql"
 	"go.temporal.io/server/common/resolver"
 	"go.temporal.io/server/common/searchattribute"
@@ -178,6 +179,7 @@ func NewStandardManager(
 		persistenceResolver,
 		searchAttributesProvider,
 		searchAttributesMapperProvider,
+		metricsHandler,
 		logger)
 	if err != nil {
 		return nil, err
@@ -264,6 +266,7 @@ func newStandardVisibilityStore(
 	persistenceResolver resolver.ServiceResolver,
 	searchAttributesProvider searchattribute.Provider,
 	searchAttributesMapperProvider searchattribute.MapperProvider,
+	metricsHandler metrics.Handler,
 	logger log.Logger,
 ) (store.VisibilityStore, error) {
 	// If standard visibility is not configured.
@@ -292,11 +295,15 @@ func newStandardVisibilityStore(
 				searchAttributesProvider,
 				searchAttributesMapperProvider,
 				logger,
+				metricsHandler,
 			)
 		default:
 			isStandard = true
-			visStore, err = standardSql.NewSQLVisibilityStore(*visibilityStoreCfg.SQL, persistenceResolver, logger)
+			visStore, err = standardSql.NewSQLVisibilityStore(*visibilityStoreCfg.SQL, persistenceResolver, logger, metricsHandler)
 		}
+	case visibilityStoreCfg.KV != nil:
+		visStore, err = standardKV.NewVisibilityStore(*visibilityStoreCfg.KV, logger)
+		isStandard = true
 	}
 
 	if err != nil {
@@ -304,7 +311,7 @@ func newStandardVisibilityStore(
 	}
 
 	if visStore == nil {
-		logger.Fatal("invalid config: one of cassandra or sql params must be specified for visibility store")
+		logger.Fatal("invalid config: one of cassandra, sql or kv params must be specified for visibility store")
 		return nil, nil
 	}
 
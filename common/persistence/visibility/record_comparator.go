@@ -0,0 +1,189 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibility
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+// Fields of a visibility record compared by CompareRecord
+const (
+	RecordFieldStatus           = "status"
+	RecordFieldWorkflowType     = "workflow-type"
+	RecordFieldStartTime        = "start-time"
+	RecordFieldCloseTime        = "close-time"
+	RecordFieldSearchAttributes = "search-attributes"
+)
+
+type (
+	// RecordMismatch describes a field of a visibility record that differs from its execution
+	RecordMismatch struct {
+		// Field is one of the RecordField constants
+		Field string
+		// Details describes the values that differ
+		Details string
+	}
+)
+
+// CompareRecord compares the visibility record of an execution with its mutable state and returns
+// the mismatched fields in the order of the RecordField constants.
+func CompareRecord(
+	mutableState *persistencespb.WorkflowMutableState,
+	record *workflowpb.WorkflowExecutionInfo,
+) []RecordMismatch {
+	executionInfo := mutableState.GetExecutionInfo()
+	executionState := mutableState.GetExecutionState()
+
+	var mismatches []RecordMismatch
+	if record.GetStatus() != executionState.GetStatus() {
+		mismatches = append(mismatches, RecordMismatch{
+			Field: RecordFieldStatus,
+			Details: fmt.Sprintf("visibility status %v, execution status %v",
+				record.GetStatus(), executionState.GetStatus()),
+		})
+	}
+	if record.GetType().GetName() != executionInfo.GetWorkflowTypeName() {
+		mismatches = append(mismatches, RecordMismatch{
+			Field: RecordFieldWorkflowType,
+			Details: fmt.Sprintf("visibility workflow type %v, execution workflow type %v",
+				record.GetType().GetName(), executionInfo.GetWorkflowTypeName()),
+		})
+	}
+	if !equalTime(record.GetStartTime(), executionInfo.GetStartTime()) {
+		mismatches = append(mismatches, RecordMismatch{
+			Field: RecordFieldStartTime,
+			Details: fmt.Sprintf("visibility start time %v, execution start time %v",
+				timestamp.TimeValue(record.GetStartTime()), timestamp.TimeValue(executionInfo.GetStartTime())),
+		})
+	}
+	if executionState.GetState() == enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED &&
+		executionInfo.GetCloseTime() != nil &&
+		record.GetStatus() != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING &&
+		!equalTime(record.GetCloseTime(), executionInfo.GetCloseTime()) {
+		mismatches = append(mismatches, RecordMismatch{
+			Field: RecordFieldCloseTime,
+			Details: fmt.Sprintf("visibility close time %v, execution close time %v",
+				timestamp.TimeValue(record.GetCloseTime()), timestamp.TimeValue(executionInfo.GetCloseTime())),
+		})
+	}
+	// Standard visibility stores do not keep search attributes, so there is nothing to compare.
+	if record.GetSearchAttributes() != nil {
+		if mismatched := mismatchedSearchAttributes(
+			executionInfo.GetSearchAttributes(),
+			record.GetSearchAttributes().GetIndexedFields(),
+		); len(mismatched) > 0 {
+			mismatches = append(mismatches, RecordMismatch{
+				Field:   RecordFieldSearchAttributes,
+				Details: "search attributes differ: " + strings.Join(mismatched, ", "),
+			})
+		}
+	}
+	return mismatches
+}
+
+// mismatchedSearchAttributes returns the sorted names of the search attributes in expected
+// whose value in actual is missing or different
+func mismatchedSearchAttributes(
+	expected map[string]*commonpb.Payload,
+	actual map[string]*commonpb.Payload,
+) []string {
+	var mismatched []string
+	for name, expectedValue := range expected {
+		actualValue, ok := actual[name]
+		if !ok || !equalSearchAttributeValue(expectedValue, actualValue) {
+			mismatched = append(mismatched, name)
+		}
+	}
+	sort.Strings(mismatched)
+	return mismatched
+}
+
+// equalSearchAttributeValue compares two encoded search attribute values. Visibility stores
+// may encode a value differently from how it was upserted, so values are compared decoded
+// whenever the type is known.
+func equalSearchAttributeValue(expected *commonpb.Payload, actual *commonpb.Payload) bool {
+	if bytes.Equal(expected.GetData(), actual.GetData()) {
+		return true
+	}
+
+	saType := searchAttributeType(actual)
+	if saType == enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED {
+		saType = searchAttributeType(expected)
+	}
+	if saType == enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED {
+		return false
+	}
+	expectedValue, err := searchattribute.DecodeValue(expected, saType, true)
+	if err != nil {
+		return false
+	}
+	actualValue, err := searchattribute.DecodeValue(actual, saType, true)
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(normalizeSearchAttributeValue(expectedValue), normalizeSearchAttributeValue(actualValue))
+}
+
+func searchAttributeType(value *commonpb.Payload) enumspb.IndexedValueType {
+	return enumspb.IndexedValueType(
+		enumspb.IndexedValueType_value[string(value.GetMetadata()[searchattribute.MetadataType])],
+	)
+}
+
+// normalizeSearchAttributeValue drops the time zone and sub-millisecond part of times,
+// which visibility stores do not preserve
+func normalizeSearchAttributeValue(value any) any {
+	switch v := value.(type) {
+	case time.Time:
+		return v.UTC().Truncate(time.Millisecond)
+	case []time.Time:
+		normalized := make([]time.Time, len(v))
+		for i, t := range v {
+			normalized[i] = t.UTC().Truncate(time.Millisecond)
+		}
+		return normalized
+	default:
+		return value
+	}
+}
+
+// equalTime compares timestamps at the millisecond precision every visibility store supports
+func equalTime(t1 *time.Time, t2 *time.Time) bool {
+	return timestamp.TimeValue(t1).Truncate(time.Millisecond).Equal(timestamp.TimeValue(t2).Truncate(time.Millisecond))
+}
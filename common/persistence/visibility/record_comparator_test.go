@@ -0,0 +1,104 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibility
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/payload"
+)
+
+func TestCompareRecord(t *testing.T) {
+	startTime := time.Date(2022, 1, 1, 0, 0, 0, 123456789, time.UTC)
+	closeTime := startTime.Add(time.Hour)
+	otherTime := startTime.Add(time.Second)
+	mutableState := &persistencespb.WorkflowMutableState{
+		ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+			WorkflowTypeName: "test-type",
+			StartTime:        &startTime,
+			CloseTime:        &closeTime,
+		},
+		ExecutionState: &persistencespb.WorkflowExecutionState{
+			State:  enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED,
+			Status: enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		},
+	}
+	newRecord := func() *workflowpb.WorkflowExecutionInfo {
+		// visibility stores keep times at millisecond precision
+		recordStartTime := startTime.Truncate(time.Millisecond)
+		recordCloseTime := closeTime.Truncate(time.Millisecond)
+		return &workflowpb.WorkflowExecutionInfo{
+			Type:      &commonpb.WorkflowType{Name: "test-type"},
+			StartTime: &recordStartTime,
+			CloseTime: &recordCloseTime,
+			Status:    enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		}
+	}
+	fields := func(mismatches []RecordMismatch) []string {
+		var fields []string
+		for _, mismatch := range mismatches {
+			fields = append(fields, mismatch.Field)
+		}
+		return fields
+	}
+
+	require.Empty(t, CompareRecord(mutableState, newRecord()))
+
+	record := newRecord()
+	record.Status = enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING
+	require.Equal(t, []string{RecordFieldStatus}, fields(CompareRecord(mutableState, record)))
+
+	record = newRecord()
+	record.Type.Name = "other-type"
+	record.StartTime = &otherTime
+	require.Equal(t, []string{RecordFieldWorkflowType, RecordFieldStartTime}, fields(CompareRecord(mutableState, record)))
+
+	record = newRecord()
+	record.CloseTime = &otherTime
+	require.Equal(t, []string{RecordFieldCloseTime}, fields(CompareRecord(mutableState, record)))
+}
+
+func TestMismatchedSearchAttributes(t *testing.T) {
+	mismatched := mismatchedSearchAttributes(
+		map[string]*commonpb.Payload{
+			"B": payload.EncodeString("b"),
+			"A": payload.EncodeString("a"),
+			"C": payload.EncodeString("c"),
+		},
+		map[string]*commonpb.Payload{
+			"A": payload.EncodeString("a"),
+			"B": payload.EncodeString("stale"),
+		},
+	)
+	require.Equal(t, []string{"B", "C"}, mismatched)
+}
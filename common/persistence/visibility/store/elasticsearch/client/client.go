@@ -55,6 +55,9 @@ type (
 		PutMapping(ctx context.Context, index string, mapping map[string]enumspb.IndexedValueType) (bool, error)
 		WaitForYellowStatus(ctx context.Context, index string) (string, error)
 		GetMapping(ctx context.Context, index string) (map[string]string, error)
+		CreateIndex(ctx context.Context, index string) (bool, error)
+		IndexExists(ctx context.Context, indexName string) (bool, error)
+		DeleteIndex(ctx context.Context, indexName string) (bool, error)
 
 		IsPointInTimeSupported(ctx context.Context) bool
 		OpenPointInTime(ctx context.Context, index string, keepAliveInterval string) (string, error)
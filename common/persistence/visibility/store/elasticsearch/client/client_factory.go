@@ -31,29 +31,46 @@ import (
 	"go.temporal.io/server/common/log"
 )
 
+const (
+	VersionV7          = "v7"
+	VersionV8          = "v8"
+	VersionOpenSearch2 = "opensearch2"
+)
+
 func NewClient(config *Config, httpClient *http.Client, logger log.Logger) (Client, error) {
-	switch config.Version {
-	case "v8", "v7", "":
-		return newClient(config, httpClient, logger)
-	default:
-		return nil, fmt.Errorf("not supported Elasticsearch version: %v", config.Version)
-	}
+	return newVersionedClient(config, httpClient, logger)
 }
 
 func NewCLIClient(config *Config, logger log.Logger) (CLIClient, error) {
-	switch config.Version {
-	case "v8", "v7", "":
-		return newClient(config, nil, logger)
-	default:
-		return nil, fmt.Errorf("not supported Elasticsearch version: %v", config.Version)
-	}
+	return newVersionedClient(config, nil, logger)
 }
 
 func NewIntegrationTestsClient(config *Config, logger log.Logger) (IntegrationTestsClient, error) {
+	return newVersionedClient(config, nil, logger)
+}
+
+// versionedClient is implemented by every version specific client.
+type versionedClient interface {
+	Client
+	CLIClient
+	IntegrationTestsClient
+}
+
+func newVersionedClient(config *Config, httpClient *http.Client, logger log.Logger) (versionedClient, error) {
+	var client versionedClient
+	var err error
 	switch config.Version {
-	case "v8", "v7", "":
-		return newClient(config, nil, logger)
+	case VersionV7, "":
+		client, err = newClient(config, httpClient, logger)
+	case VersionV8:
+		client, err = newClientV8(config, httpClient, logger)
+	case VersionOpenSearch2:
+		client, err = newClientOpenSearchV2(config, httpClient, logger)
 	default:
 		return nil, fmt.Errorf("not supported Elasticsearch version: %v", config.Version)
 	}
+	if err != nil {
+		return nil, err
+	}
+	return client, nil
 }
@@ -104,6 +104,36 @@ func (mr *MockClientMockRecorder) Count(ctx, index, query interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockClient)(nil).Count), ctx, index, query)
 }
 
+// CreateIndex mocks base method.
+func (m *MockClient) CreateIndex(ctx context.Context, index string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIndex", ctx, index)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIndex indicates an expected call of CreateIndex.
+func (mr *MockClientMockRecorder) CreateIndex(ctx, index interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIndex", reflect.TypeOf((*MockClient)(nil).CreateIndex), ctx, index)
+}
+
+// DeleteIndex mocks base method.
+func (m *MockClient) DeleteIndex(ctx context.Context, indexName string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIndex", ctx, indexName)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteIndex indicates an expected call of DeleteIndex.
+func (mr *MockClientMockRecorder) DeleteIndex(ctx, indexName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIndex", reflect.TypeOf((*MockClient)(nil).DeleteIndex), ctx, indexName)
+}
+
 // Get mocks base method.
 func (m *MockClient) Get(ctx context.Context, index, docID string) (*v7.GetResult, error) {
 	m.ctrl.T.Helper()
@@ -134,6 +164,21 @@ func (mr *MockClientMockRecorder) GetMapping(ctx, index interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMapping", reflect.TypeOf((*MockClient)(nil).GetMapping), ctx, index)
 }
 
+// IndexExists mocks base method.
+func (m *MockClient) IndexExists(ctx context.Context, indexName string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IndexExists", ctx, indexName)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IndexExists indicates an expected call of IndexExists.
+func (mr *MockClientMockRecorder) IndexExists(ctx, indexName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IndexExists", reflect.TypeOf((*MockClient)(nil).IndexExists), ctx, indexName)
+}
+
 // IsPointInTimeSupported mocks base method.
 func (m *MockClient) IsPointInTimeSupported(ctx context.Context) bool {
 	m.ctrl.T.Helper()
@@ -320,6 +365,21 @@ func (mr *MockCLIClientMockRecorder) Count(ctx, index, query interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockCLIClient)(nil).Count), ctx, index, query)
 }
 
+// CreateIndex mocks base method.
+func (m *MockCLIClient) CreateIndex(ctx context.Context, index string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIndex", ctx, index)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIndex indicates an expected call of CreateIndex.
+func (mr *MockCLIClientMockRecorder) CreateIndex(ctx, index interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIndex", reflect.TypeOf((*MockCLIClient)(nil).CreateIndex), ctx, index)
+}
+
 // Delete mocks base method.
 func (m *MockCLIClient) Delete(ctx context.Context, indexName, docID string, version int64) error {
 	m.ctrl.T.Helper()
@@ -334,6 +394,21 @@ func (mr *MockCLIClientMockRecorder) Delete(ctx, indexName, docID, version inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCLIClient)(nil).Delete), ctx, indexName, docID, version)
 }
 
+// DeleteIndex mocks base method.
+func (m *MockCLIClient) DeleteIndex(ctx context.Context, indexName string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIndex", ctx, indexName)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteIndex indicates an expected call of DeleteIndex.
+func (mr *MockCLIClientMockRecorder) DeleteIndex(ctx, indexName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIndex", reflect.TypeOf((*MockCLIClient)(nil).DeleteIndex), ctx, indexName)
+}
+
 // Get mocks base method.
 func (m *MockCLIClient) Get(ctx context.Context, index, docID string) (*v7.GetResult, error) {
 	m.ctrl.T.Helper()
@@ -364,6 +439,21 @@ func (mr *MockCLIClientMockRecorder) GetMapping(ctx, index interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMapping", reflect.TypeOf((*MockCLIClient)(nil).GetMapping), ctx, index)
 }
 
+// IndexExists mocks base method.
+func (m *MockCLIClient) IndexExists(ctx context.Context, indexName string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IndexExists", ctx, indexName)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IndexExists indicates an expected call of IndexExists.
+func (mr *MockCLIClientMockRecorder) IndexExists(ctx, indexName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IndexExists", reflect.TypeOf((*MockCLIClient)(nil).IndexExists), ctx, indexName)
+}
+
 // IsPointInTimeSupported mocks base method.
 func (m *MockCLIClient) IsPointInTimeSupported(ctx context.Context) bool {
 	m.ctrl.T.Helper()
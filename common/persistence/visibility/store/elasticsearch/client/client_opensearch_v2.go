@@ -0,0 +1,167 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sync"
+
+	"github.com/blang/semver/v4"
+	"github.com/olivere/elastic/v7"
+	"github.com/olivere/elastic/v7/uritemplates"
+
+	"go.temporal.io/server/common/log"
+)
+
+type (
+	// clientOpenSearchV2 implements Client for OpenSearch 2.x. OpenSearch keeps ES7 compatible
+	// search, bulk, and mapping APIs but has its own point in time API and version info.
+	clientOpenSearchV2 struct {
+		*clientImpl
+
+		initIsPointInTimeSupported sync.Once
+		isPointInTimeSupported     bool
+	}
+
+	openSearchPingResponse struct {
+		Version struct {
+			Distribution string `json:"distribution"`
+			Number       string `json:"number"`
+		} `json:"version"`
+	}
+
+	openSearchOpenPointInTimeResponse struct {
+		PitID string `json:"pit_id"`
+	}
+
+	openSearchClosePointInTimeResponse struct {
+		Pits []struct {
+			PitID      string `json:"pit_id"`
+			Successful bool   `json:"successful"`
+		} `json:"pits"`
+	}
+)
+
+const (
+	openSearchDistribution = "opensearch"
+)
+
+var (
+	openSearchPointInTimeSupportedIn = semver.MustParseRange(">=2.4.0")
+)
+
+var _ Client = (*clientOpenSearchV2)(nil)
+
+func newClientOpenSearchV2(cfg *Config, httpClient *http.Client, logger log.Logger) (*clientOpenSearchV2, error) {
+	client, err := newClient(cfg, httpClient, logger)
+	if err != nil {
+		return nil, err
+	}
+	return &clientOpenSearchV2{clientImpl: client}, nil
+}
+
+func (c *clientOpenSearchV2) IsPointInTimeSupported(ctx context.Context) bool {
+	c.initIsPointInTimeSupported.Do(func() {
+		c.isPointInTimeSupported = c.queryPointInTimeSupported(ctx)
+	})
+	return c.isPointInTimeSupported
+}
+
+func (c *clientOpenSearchV2) queryPointInTimeSupported(ctx context.Context) bool {
+	// olivere/elastic/v7 ping result doesn't have distribution field, therefore request is built manually.
+	res, err := c.esClient.PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method:  "GET",
+		Path:    "/",
+		Params:  url.Values{},
+		Headers: http.Header{},
+	})
+	if err != nil {
+		return false
+	}
+	var resp openSearchPingResponse
+	if err := json.Unmarshal(res.Body, &resp); err != nil {
+		return false
+	}
+	if resp.Version.Distribution != openSearchDistribution {
+		return false
+	}
+	version, err := semver.ParseTolerant(resp.Version.Number)
+	if err != nil {
+		return false
+	}
+	return openSearchPointInTimeSupportedIn(version)
+}
+
+func (c *clientOpenSearchV2) OpenPointInTime(ctx context.Context, index string, keepAliveInterval string) (string, error) {
+	path, err := uritemplates.Expand("/{index}/_search/point_in_time", map[string]string{
+		"index": index,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	res, err := c.esClient.PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method:  "POST",
+		Path:    path,
+		Params:  url.Values{"keep_alive": []string{keepAliveInterval}},
+		Headers: http.Header{},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var resp openSearchOpenPointInTimeResponse
+	if err := json.Unmarshal(res.Body, &resp); err != nil {
+		return "", err
+	}
+	return resp.PitID, nil
+}
+
+func (c *clientOpenSearchV2) ClosePointInTime(ctx context.Context, id string) (bool, error) {
+	res, err := c.esClient.PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method:  "DELETE",
+		Path:    "/_search/point_in_time",
+		Params:  url.Values{},
+		Body:    map[string]interface{}{"pit_id": []string{id}},
+		Headers: http.Header{},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	var resp openSearchClosePointInTimeResponse
+	if err := json.Unmarshal(res.Body, &resp); err != nil {
+		return false, err
+	}
+	for _, pit := range resp.Pits {
+		if pit.PitID == id {
+			return pit.Successful, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,109 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/common/log"
+)
+
+func TestClientOpenSearchV2_IsPointInTimeSupported(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		expected bool
+	}{
+		{
+			name:     "opensearch 2.4",
+			response: `{"version":{"distribution":"opensearch","number":"2.4.0"}}`,
+			expected: true,
+		},
+		{
+			name:     "opensearch 2.3",
+			response: `{"version":{"distribution":"opensearch","number":"2.3.0"}}`,
+			expected: false,
+		},
+		{
+			name:     "elasticsearch",
+			response: `{"version":{"build_flavor":"default","number":"7.17.0"}}`,
+			expected: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "/", r.URL.Path)
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(tc.response))
+			}))
+			defer server.Close()
+
+			client, err := NewClient(newTestConfig(t, server.URL, VersionOpenSearch2), nil, log.NewNoopLogger())
+			require.NoError(t, err)
+			require.IsType(t, &clientOpenSearchV2{}, client)
+			require.Equal(t, tc.expected, client.IsPointInTimeSupported(context.Background()))
+		})
+	}
+}
+
+func TestClientOpenSearchV2_PointInTime(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/temporal_visibility_v1/_search/point_in_time":
+			require.Equal(t, "1m", r.URL.Query().Get("keep_alive"))
+			_, _ = w.Write([]byte(`{"pit_id":"pit-1","creation_time":1}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/_search/point_in_time":
+			body, _ := io.ReadAll(r.Body)
+			var req map[string][]string
+			require.NoError(t, json.Unmarshal(body, &req))
+			require.Equal(t, []string{"pit-1"}, req["pit_id"])
+			_, _ = w.Write([]byte(`{"pits":[{"pit_id":"pit-1","successful":true}]}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(newTestConfig(t, server.URL, VersionOpenSearch2), nil, log.NewNoopLogger())
+	require.NoError(t, err)
+
+	id, err := client.OpenPointInTime(context.Background(), "temporal_visibility_v1", "1m")
+	require.NoError(t, err)
+	require.Equal(t, "pit-1", id)
+
+	closed, err := client.ClosePointInTime(context.Background(), id)
+	require.NoError(t, err)
+	require.True(t, closed)
+}
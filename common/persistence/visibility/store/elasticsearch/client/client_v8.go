@@ -0,0 +1,143 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/olivere/elastic/v7"
+	"github.com/olivere/elastic/v7/uritemplates"
+
+	"go.temporal.io/server/common/log"
+)
+
+type (
+	// clientV8 implements Client for Elasticsearch 8.x. It reuses the v7 transport,
+	// which talks to ES8 in compatibility mode, and only overrides APIs that were removed or changed.
+	clientV8 struct {
+		*clientImpl
+	}
+
+	// legacyIndexTemplate is the body format of the legacy _template API used by index_template_v7.json.
+	legacyIndexTemplate struct {
+		Order         *int                   `json:"order,omitempty"`
+		Version       *int                   `json:"version,omitempty"`
+		IndexPatterns []string               `json:"index_patterns"`
+		Settings      map[string]interface{} `json:"settings,omitempty"`
+		Mappings      map[string]interface{} `json:"mappings,omitempty"`
+		Aliases       map[string]interface{} `json:"aliases,omitempty"`
+		Template      map[string]interface{} `json:"template,omitempty"`
+		Priority      *int                   `json:"priority,omitempty"`
+	}
+)
+
+var _ Client = (*clientV8)(nil)
+
+func newClientV8(cfg *Config, httpClient *http.Client, logger log.Logger) (*clientV8, error) {
+	client, err := newClient(cfg, httpClient, logger)
+	if err != nil {
+		return nil, err
+	}
+	return &clientV8{clientImpl: client}, nil
+}
+
+// IsPointInTimeSupported always returns true because every ES8 distribution supports point in time.
+func (c *clientV8) IsPointInTimeSupported(_ context.Context) bool {
+	return true
+}
+
+// IndexPutTemplate uses composable index template API because legacy _template API is deprecated in ES8.
+func (c *clientV8) IndexPutTemplate(ctx context.Context, templateName string, bodyString string) (bool, error) {
+	body, err := toComposableIndexTemplate(bodyString)
+	if err != nil {
+		return false, err
+	}
+
+	path, err := uritemplates.Expand("/_index_template/{name}", map[string]string{
+		"name": templateName,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	res, err := c.esClient.PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method:  "PUT",
+		Path:    path,
+		Params:  url.Values{},
+		Body:    body,
+		Headers: http.Header{},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	var resp elastic.IndicesPutTemplateResponse
+	if err := json.Unmarshal(res.Body, &resp); err != nil {
+		return false, err
+	}
+	return resp.Acknowledged, nil
+}
+
+// toComposableIndexTemplate converts legacy index template body to composable index template body.
+// Body which is already in composable format is returned as is.
+func toComposableIndexTemplate(bodyString string) (map[string]interface{}, error) {
+	var legacy legacyIndexTemplate
+	if err := json.Unmarshal([]byte(bodyString), &legacy); err != nil {
+		return nil, err
+	}
+
+	body := map[string]interface{}{
+		"index_patterns": legacy.IndexPatterns,
+	}
+	if legacy.Version != nil {
+		body["version"] = *legacy.Version
+	}
+	if legacy.Template != nil {
+		body["template"] = legacy.Template
+		if legacy.Priority != nil {
+			body["priority"] = *legacy.Priority
+		}
+		return body, nil
+	}
+
+	if legacy.Order != nil {
+		body["priority"] = *legacy.Order
+	}
+	template := map[string]interface{}{}
+	if legacy.Settings != nil {
+		template["settings"] = legacy.Settings
+	}
+	if legacy.Mappings != nil {
+		template["mappings"] = legacy.Mappings
+	}
+	if legacy.Aliases != nil {
+		template["aliases"] = legacy.Aliases
+	}
+	body["template"] = template
+	return body, nil
+}
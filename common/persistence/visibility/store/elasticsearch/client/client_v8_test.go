@@ -0,0 +1,115 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/common/log"
+)
+
+func newTestConfig(t *testing.T, serverURL string, version string) *Config {
+	u, err := url.Parse(serverURL)
+	require.NoError(t, err)
+	return &Config{
+		Version: version,
+		URL:     *u,
+	}
+}
+
+func TestClientV8_IndexPutTemplate(t *testing.T) {
+	var gotMethod, gotPath string
+	var gotBody map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		gotPath = r.URL.Path
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &gotBody)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"acknowledged":true}`))
+	}))
+	defer server.Close()
+
+	client, err := NewIntegrationTestsClient(newTestConfig(t, server.URL, VersionV8), log.NewNoopLogger())
+	require.NoError(t, err)
+	require.IsType(t, &clientV8{}, client)
+
+	ack, err := client.IndexPutTemplate(context.Background(), "temporal_visibility_v1_template", `{
+  "order": 1,
+  "index_patterns": ["temporal_visibility_v1*"],
+  "settings": {"index": {"number_of_shards": "1"}},
+  "mappings": {"dynamic": "false"},
+  "aliases": {}
+}`)
+	require.NoError(t, err)
+	require.True(t, ack)
+	require.Equal(t, http.MethodPut, gotMethod)
+	require.Equal(t, "/_index_template/temporal_visibility_v1_template", gotPath)
+	require.Equal(t, map[string]interface{}{
+		"index_patterns": []interface{}{"temporal_visibility_v1*"},
+		"priority":       float64(1),
+		"template": map[string]interface{}{
+			"settings": map[string]interface{}{"index": map[string]interface{}{"number_of_shards": "1"}},
+			"mappings": map[string]interface{}{"dynamic": "false"},
+			"aliases":  map[string]interface{}{},
+		},
+	}, gotBody)
+}
+
+func TestToComposableIndexTemplate_AlreadyComposable(t *testing.T) {
+	body, err := toComposableIndexTemplate(`{"index_patterns":["a*"],"priority":5,"version":2,"template":{"mappings":{}}}`)
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{
+		"index_patterns": []string{"a*"},
+		"priority":       5,
+		"version":        2,
+		"template":       map[string]interface{}{"mappings": map[string]interface{}{}},
+	}, body)
+}
+
+func TestClientV8_IsPointInTimeSupported(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(newTestConfig(t, server.URL, VersionV8), nil, log.NewNoopLogger())
+	require.NoError(t, err)
+	require.True(t, client.IsPointInTimeSupported(context.Background()))
+}
+
+func TestNewClient_UnsupportedVersion(t *testing.T) {
+	client, err := NewClient(newTestConfig(t, "http://localhost:9200", "v6"), nil, log.NewNoopLogger())
+	require.Error(t, err)
+	require.Nil(t, client)
+}
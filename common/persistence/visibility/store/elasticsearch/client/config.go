@@ -39,6 +39,7 @@ const (
 // Config for connecting to Elasticsearch
 type (
 	Config struct {
+		// Version is one of "v7" (default), "v8", or "opensearch2".
 		Version                      string                    `yaml:"version"`
 		URL                          url.URL                   `yaml:"url"`
 		Username                     string                    `yaml:"username"`
@@ -0,0 +1,176 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/olivere/elastic/v7"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+const (
+	namespaceIndexInfix = "_ns_"
+)
+
+type (
+	// NamespaceIndexManager creates and deletes the dedicated visibility indices of namespaces, in every
+	// configured visibility index. It does nothing if Elasticsearch is not configured or dedicated indices
+	// are not enabled for the namespace.
+	NamespaceIndexManager struct {
+		esClient   Client
+		indices    []string
+		saProvider searchattribute.Provider
+		enabled    dynamicconfig.BoolPropertyFnWithNamespaceFilter
+		logger     log.Logger
+	}
+)
+
+func NewNamespaceIndexManager(
+	esClient Client,
+	esConfig *Config,
+	saProvider searchattribute.Provider,
+	enabled dynamicconfig.BoolPropertyFnWithNamespaceFilter,
+	logger log.Logger,
+) *NamespaceIndexManager {
+	var indices []string
+	for _, index := range []string{esConfig.GetVisibilityIndex(), esConfig.GetSecondaryVisibilityIndex()} {
+		if index != "" {
+			indices = append(indices, index)
+		}
+	}
+	return &NamespaceIndexManager{
+		esClient:   esClient,
+		indices:    indices,
+		saProvider: saProvider,
+		enabled:    enabled,
+		logger:     logger,
+	}
+}
+
+// CreateNamespaceIndices creates the dedicated visibility indices of namespace. It must be called before
+// the namespace is created, so no visibility records of the namespace are written to the shared index.
+func (m *NamespaceIndexManager) CreateNamespaceIndices(ctx context.Context, namespaceID string, namespaceName string) error {
+	if !m.isEnabled(namespaceName) {
+		return nil
+	}
+
+	for _, index := range m.indices {
+		saTypeMap, err := m.saProvider.GetSearchAttributes(index, true)
+		if err != nil {
+			return serviceerror.NewUnavailable(fmt.Sprintf("Unable to read search attributes: %v", err))
+		}
+		if err := CreateNamespaceIndex(ctx, m.esClient, index, namespaceID, saTypeMap.Custom()); err != nil {
+			m.logger.Error("Unable to create namespace visibility index.",
+				tag.WorkflowNamespace(namespaceName),
+				tag.ESIndex(index),
+				tag.Error(err),
+			)
+			return serviceerror.NewUnavailable(fmt.Sprintf("Unable to create namespace visibility index: %v", err))
+		}
+	}
+	return nil
+}
+
+// DeleteNamespaceIndices deletes the dedicated visibility indices of namespace. Errors are logged only.
+func (m *NamespaceIndexManager) DeleteNamespaceIndices(ctx context.Context, namespaceID string, namespaceName string) {
+	if !m.isEnabled(namespaceName) {
+		return
+	}
+
+	for _, index := range m.indices {
+		if err := DeleteNamespaceIndex(ctx, m.esClient, index, namespaceID); err != nil {
+			m.logger.Warn("Unable to delete namespace visibility index.",
+				tag.WorkflowNamespace(namespaceName),
+				tag.ESIndex(index),
+				tag.Error(err),
+			)
+		}
+	}
+}
+
+func (m *NamespaceIndexManager) isEnabled(namespaceName string) bool {
+	return m != nil && m.esClient != nil && m.enabled(namespaceName)
+}
+
+// NamespaceIndexName returns name of the dedicated visibility index of namespace.
+// Index name starts with the shared visibility index name, therefore the same index template applies to it.
+func NamespaceIndexName(index string, namespaceID string) string {
+	// Elasticsearch index names must be lowercase.
+	return index + namespaceIndexInfix + strings.ToLower(namespaceID)
+}
+
+// NamespaceIndexPattern returns wildcard pattern which matches dedicated visibility indices of all namespaces.
+func NamespaceIndexPattern(index string) string {
+	return index + namespaceIndexInfix + "*"
+}
+
+// CreateNamespaceIndex creates dedicated visibility index of namespace if it doesn't exist yet.
+// Settings and predefined mappings come from the index template, custom search attributes are added explicitly.
+func CreateNamespaceIndex(
+	ctx context.Context,
+	esClient Client,
+	index string,
+	namespaceID string,
+	customSearchAttributes map[string]enumspb.IndexedValueType,
+) error {
+	nsIndex := NamespaceIndexName(index, namespaceID)
+	exists, err := esClient.IndexExists(ctx, nsIndex)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		if _, err = esClient.CreateIndex(ctx, nsIndex); err != nil {
+			return err
+		}
+	}
+	if len(customSearchAttributes) == 0 {
+		return nil
+	}
+	_, err = esClient.PutMapping(ctx, nsIndex, customSearchAttributes)
+	return err
+}
+
+// DeleteNamespaceIndex deletes dedicated visibility index of namespace if it exists.
+func DeleteNamespaceIndex(ctx context.Context, esClient Client, index string, namespaceID string) error {
+	nsIndex := NamespaceIndexName(index, namespaceID)
+	exists, err := esClient.IndexExists(ctx, nsIndex)
+	if err != nil || !exists {
+		return err
+	}
+	_, err = esClient.DeleteIndex(ctx, nsIndex)
+	if err != nil && elastic.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
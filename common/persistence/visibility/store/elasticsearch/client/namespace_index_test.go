@@ -0,0 +1,54 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+)
+
+func TestNamespaceIndexName(t *testing.T) {
+	require.Equal(t, "temporal_visibility_v1_ns_f1b3c5d7-aaaa", NamespaceIndexName("temporal_visibility_v1", "F1B3C5D7-AAAA"))
+	require.Equal(t, "temporal_visibility_v1_ns_*", NamespaceIndexPattern("temporal_visibility_v1"))
+}
+
+func TestCreateNamespaceIndex(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	esClient := NewMockClient(ctrl)
+	customSearchAttributes := map[string]enumspb.IndexedValueType{"CustomKeywordField": enumspb.INDEXED_VALUE_TYPE_KEYWORD}
+
+	esClient.EXPECT().IndexExists(gomock.Any(), "index_ns_nsid").Return(false, nil)
+	esClient.EXPECT().CreateIndex(gomock.Any(), "index_ns_nsid").Return(true, nil)
+	esClient.EXPECT().PutMapping(gomock.Any(), "index_ns_nsid", customSearchAttributes).Return(true, nil)
+	require.NoError(t, CreateNamespaceIndex(context.Background(), esClient, "index", "nsid", customSearchAttributes))
+
+	// Existing index is not created again.
+	esClient.EXPECT().IndexExists(gomock.Any(), "index_ns_nsid").Return(true, nil)
+	require.NoError(t, CreateNamespaceIndex(context.Background(), esClient, "index", "nsid", nil))
+}
@@ -40,6 +40,7 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 
+	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
@@ -57,6 +58,11 @@ const (
 	delimiter                    = "~"
 	pointInTimeKeepAliveInterval = "1m"
 	scrollKeepAliveInterval      = "1m"
+
+	namespaceIndexCacheMaxSize = 10000
+	// Dedicated namespace index is created on namespace registration and deleted with namespace,
+	// therefore index existence changes rarely and can be cached for a while.
+	namespaceIndexCacheTTL = time.Minute
 )
 
 // Default sort by uses the sorting order defined in the index template, so no
@@ -76,6 +82,8 @@ type (
 		processorAckTimeout            dynamicconfig.DurationPropertyFn
 		disableOrderByClause           dynamicconfig.BoolPropertyFn
 		metricsHandler                 metrics.Handler
+		// namespaceIndexCache caches if namespace ID has dedicated index.
+		namespaceIndexCache cache.Cache
 	}
 
 	visibilityPageToken struct {
@@ -116,6 +124,7 @@ func NewVisibilityStore(
 		processorAckTimeout:            processorAckTimeout,
 		disableOrderByClause:           disableOrderByClause,
 		metricsHandler:                 metricsHandler.WithTags(metrics.OperationTag(metrics.ElasticsearchVisibility)),
+		namespaceIndexCache:            cache.New(namespaceIndexCacheMaxSize, &cache.Options{TTL: namespaceIndexCacheTTL}),
 	}
 }
 
@@ -134,6 +143,27 @@ func (s *visibilityStore) GetIndexName() string {
 	return s.index
 }
 
+// getNamespaceIndex returns dedicated index of namespace if it exists, otherwise shared index.
+func (s *visibilityStore) getNamespaceIndex(ctx context.Context, namespaceID string) (string, error) {
+	if exists, ok := s.namespaceIndexCache.Get(namespaceID).(bool); ok {
+		if exists {
+			return client.NamespaceIndexName(s.index, namespaceID), nil
+		}
+		return s.index, nil
+	}
+
+	nsIndex := client.NamespaceIndexName(s.index, namespaceID)
+	exists, err := s.esClient.IndexExists(ctx, nsIndex)
+	if err != nil {
+		return "", convertElasticsearchClientError("Unable to check namespace index existence", err)
+	}
+	s.namespaceIndexCache.Put(namespaceID, exists)
+	if exists {
+		return nsIndex, nil
+	}
+	return s.index, nil
+}
+
 func (s *visibilityStore) RecordWorkflowExecutionStarted(
 	ctx context.Context,
 	request *store.InternalRecordWorkflowExecutionStartedRequest,
@@ -185,8 +215,13 @@ func (s *visibilityStore) DeleteWorkflowExecution(
 ) error {
 	docID := getDocID(request.WorkflowID, request.RunID)
 
+	index, err := s.getNamespaceIndex(ctx, request.NamespaceID.String())
+	if err != nil {
+		return err
+	}
+
 	bulkDeleteRequest := &client.BulkableRequest{
-		Index:       s.index,
+		Index:       index,
 		ID:          docID,
 		Version:     request.TaskID,
 		RequestType: client.BulkableRequestTypeDelete,
@@ -223,8 +258,13 @@ func (s *visibilityStore) addBulkIndexRequestAndWait(
 	esDoc map[string]interface{},
 	visibilityTaskKey string,
 ) error {
+	index, err := s.getNamespaceIndex(ctx, request.NamespaceID)
+	if err != nil {
+		return err
+	}
+
 	bulkIndexRequest := &client.BulkableRequest{
-		Index:       s.index,
+		Index:       index,
 		ID:          getDocID(request.WorkflowID, request.RunID),
 		Version:     request.TaskID,
 		RequestType: client.BulkableRequestTypeIndex,
@@ -290,7 +330,7 @@ func (s *visibilityStore) ListOpenWorkflowExecutions(
 	boolQuery := elastic.NewBoolQuery().
 		Filter(elastic.NewTermQuery(searchattribute.ExecutionStatus, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING.String()))
 
-	p, err := s.buildSearchParameters(request, boolQuery, true)
+	p, err := s.buildSearchParameters(ctx, request, boolQuery, true)
 	if err != nil {
 		return nil, err
 	}
@@ -311,7 +351,7 @@ func (s *visibilityStore) ListClosedWorkflowExecutions(
 	boolQuery := elastic.NewBoolQuery().
 		MustNot(elastic.NewTermQuery(searchattribute.ExecutionStatus, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING.String()))
 
-	p, err := s.buildSearchParameters(request, boolQuery, false)
+	p, err := s.buildSearchParameters(ctx, request, boolQuery, false)
 	if err != nil {
 		return nil, err
 	}
@@ -334,7 +374,7 @@ func (s *visibilityStore) ListOpenWorkflowExecutionsByType(
 			elastic.NewTermQuery(searchattribute.WorkflowType, request.WorkflowTypeName),
 			elastic.NewTermQuery(searchattribute.ExecutionStatus, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING.String()))
 
-	p, err := s.buildSearchParameters(request.ListWorkflowExecutionsRequest, boolQuery, true)
+	p, err := s.buildSearchParameters(ctx, request.ListWorkflowExecutionsRequest, boolQuery, true)
 	if err != nil {
 		return nil, err
 	}
@@ -356,7 +396,7 @@ func (s *visibilityStore) ListClosedWorkflowExecutionsByType(
 		Filter(elastic.NewTermQuery(searchattribute.WorkflowType, request.WorkflowTypeName)).
 		MustNot(elastic.NewTermQuery(searchattribute.ExecutionStatus, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING.String()))
 
-	p, err := s.buildSearchParameters(request.ListWorkflowExecutionsRequest, boolQuery, false)
+	p, err := s.buildSearchParameters(ctx, request.ListWorkflowExecutionsRequest, boolQuery, false)
 	if err != nil {
 		return nil, err
 	}
@@ -379,7 +419,7 @@ func (s *visibilityStore) ListOpenWorkflowExecutionsByWorkflowID(
 			elastic.NewTermQuery(searchattribute.WorkflowID, request.WorkflowID),
 			elastic.NewTermQuery(searchattribute.ExecutionStatus, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING.String()))
 
-	p, err := s.buildSearchParameters(request.ListWorkflowExecutionsRequest, boolQuery, true)
+	p, err := s.buildSearchParameters(ctx, request.ListWorkflowExecutionsRequest, boolQuery, true)
 	if err != nil {
 		return nil, err
 	}
@@ -401,7 +441,7 @@ func (s *visibilityStore) ListClosedWorkflowExecutionsByWorkflowID(
 		Filter(elastic.NewTermQuery(searchattribute.WorkflowID, request.WorkflowID)).
 		MustNot(elastic.NewTermQuery(searchattribute.ExecutionStatus, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING.String()))
 
-	p, err := s.buildSearchParameters(request.ListWorkflowExecutionsRequest, boolQuery, false)
+	p, err := s.buildSearchParameters(ctx, request.ListWorkflowExecutionsRequest, boolQuery, false)
 	if err != nil {
 		return nil, err
 	}
@@ -422,7 +462,7 @@ func (s *visibilityStore) ListClosedWorkflowExecutionsByStatus(
 	boolQuery := elastic.NewBoolQuery().
 		Filter(elastic.NewTermQuery(searchattribute.ExecutionStatus, request.Status.String()))
 
-	p, err := s.buildSearchParameters(request.ListWorkflowExecutionsRequest, boolQuery, false)
+	p, err := s.buildSearchParameters(ctx, request.ListWorkflowExecutionsRequest, boolQuery, false)
 	if err != nil {
 		return nil, err
 	}
@@ -439,7 +479,7 @@ func (s *visibilityStore) ListWorkflowExecutions(
 	ctx context.Context,
 	request *manager.ListWorkflowExecutionsRequestV2,
 ) (*store.InternalListWorkflowExecutionsResponse, error) {
-	p, err := s.buildSearchParametersV2(request)
+	p, err := s.buildSearchParametersV2(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -478,14 +518,14 @@ func (s *visibilityStore) scanWorkflowExecutionsWithPit(
 	ctx context.Context,
 	request *manager.ListWorkflowExecutionsRequestV2,
 ) (*store.InternalListWorkflowExecutionsResponse, error) {
-	p, err := s.buildSearchParametersV2(request)
+	p, err := s.buildSearchParametersV2(ctx, request)
 	if err != nil {
 		return nil, err
 	}
 
 	// First call doesn't have token with PointInTimeID.
 	if len(request.NextPageToken) == 0 {
-		pitID, err := s.esClient.OpenPointInTime(ctx, s.index, pointInTimeKeepAliveInterval)
+		pitID, err := s.esClient.OpenPointInTime(ctx, p.Index, pointInTimeKeepAliveInterval)
 		if err != nil {
 			return nil, convertElasticsearchClientError("Unable to create point in time", err)
 		}
@@ -527,7 +567,7 @@ func (s *visibilityStore) scanWorkflowExecutionsWithScroll(ctx context.Context,
 	// First call doesn't have token with ScrollID.
 	if len(request.NextPageToken) == 0 {
 		// First page.
-		p, err := s.buildSearchParametersV2(request)
+		p, err := s.buildSearchParametersV2(ctx, request)
 		if err != nil {
 			return nil, err
 		}
@@ -568,7 +608,12 @@ func (s *visibilityStore) CountWorkflowExecutions(
 		return nil, err
 	}
 
-	count, err := s.esClient.Count(ctx, s.index, boolQuery)
+	index, err := s.getNamespaceIndex(ctx, request.NamespaceID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.esClient.Count(ctx, index, boolQuery)
 	if err != nil {
 		return nil, convertElasticsearchClientError("CountWorkflowExecutions failed", err)
 	}
@@ -582,7 +627,12 @@ func (s *visibilityStore) GetWorkflowExecution(
 	request *manager.GetWorkflowExecutionRequest,
 ) (*store.InternalGetWorkflowExecutionResponse, error) {
 	docID := getDocID(request.WorkflowID, request.RunID)
-	result, err := s.esClient.Get(ctx, s.index, docID)
+	index, err := s.getNamespaceIndex(ctx, request.NamespaceID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.esClient.Get(ctx, index, docID)
 	if err != nil {
 		return nil, convertElasticsearchClientError("GetWorkflowExecution failed", err)
 	}
@@ -611,6 +661,7 @@ func (s *visibilityStore) GetWorkflowExecution(
 }
 
 func (s *visibilityStore) buildSearchParameters(
+	ctx context.Context,
 	request *manager.ListWorkflowExecutionsRequest,
 	boolQuery *elastic.BoolQuery,
 	overStartTime bool,
@@ -647,8 +698,13 @@ func (s *visibilityStore) buildSearchParameters(
 		boolQuery.Filter(rangeQuery)
 	}
 
+	index, err := s.getNamespaceIndex(ctx, request.NamespaceID.String())
+	if err != nil {
+		return nil, err
+	}
+
 	params := &client.SearchParameters{
-		Index:    s.index,
+		Index:    index,
 		Query:    boolQuery,
 		PageSize: request.PageSize,
 		Sorter:   defaultSorter,
@@ -662,6 +718,7 @@ func (s *visibilityStore) buildSearchParameters(
 }
 
 func (s *visibilityStore) buildSearchParametersV2(
+	ctx context.Context,
 	request *manager.ListWorkflowExecutionsRequestV2,
 ) (*client.SearchParameters, error) {
 
@@ -683,8 +740,13 @@ func (s *visibilityStore) buildSearchParametersV2(
 		return nil, serviceerror.NewInvalidArgument("ORDER BY clause is not supported")
 	}
 
+	index, err := s.getNamespaceIndex(ctx, request.NamespaceID.String())
+	if err != nil {
+		return nil, err
+	}
+
 	params := &client.SearchParameters{
-		Index:    s.index,
+		Index:    index,
 		Query:    boolQuery,
 		PageSize: request.PageSize,
 		Sorter:   s.setDefaultFieldSort(fieldSorts),
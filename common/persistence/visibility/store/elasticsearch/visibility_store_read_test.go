@@ -126,6 +126,8 @@ func (s *ESVisibilitySuite) SetupTest() {
 	s.mockMetricsHandler.EXPECT().WithTags(metrics.OperationTag(metrics.ElasticsearchVisibility)).Return(s.mockMetricsHandler).AnyTimes()
 	s.mockProcessor = NewMockProcessor(s.controller)
 	s.mockESClient = client.NewMockClient(s.controller)
+	// By default, namespaces don't have dedicated index.
+	s.mockESClient.EXPECT().IndexExists(gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
 	s.mockSearchAttributesMapperProvider = searchattribute.NewMockMapperProvider(s.controller)
 	s.visibilityStore = NewVisibilityStore(
 		s.mockESClient,
@@ -329,7 +331,7 @@ func (s *ESVisibilitySuite) TestBuildSearchParameters() {
 	// test for open
 	rangeQuery := elastic.NewRangeQuery(searchattribute.StartTime).Gte(request.EarliestStartTime).Lte(request.LatestStartTime)
 	boolQuery := elastic.NewBoolQuery().Filter(runningQuery).Filter(matchNamespaceQuery).Filter(rangeQuery).MustNot(namespaceDivisionExists)
-	p, err := s.visibilityStore.buildSearchParameters(request, elastic.NewBoolQuery().Filter(elastic.NewTermQuery(searchattribute.ExecutionStatus, int(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING))), true)
+	p, err := s.visibilityStore.buildSearchParameters(context.Background(), request, elastic.NewBoolQuery().Filter(elastic.NewTermQuery(searchattribute.ExecutionStatus, int(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING))), true)
 	s.NoError(err)
 	s.Equal(&client.SearchParameters{
 		Index:       testIndex,
@@ -343,7 +345,7 @@ func (s *ESVisibilitySuite) TestBuildSearchParameters() {
 	request.LatestStartTime = time.Unix(0, math.MaxInt64).UTC()
 	rangeQuery = elastic.NewRangeQuery(searchattribute.StartTime).Gte(request.EarliestStartTime).Lte(request.LatestStartTime)
 	boolQuery = elastic.NewBoolQuery().Filter(runningQuery).Filter(matchNamespaceQuery).Filter(rangeQuery).MustNot(namespaceDivisionExists)
-	p, err = s.visibilityStore.buildSearchParameters(request, elastic.NewBoolQuery().Filter(elastic.NewTermQuery(searchattribute.ExecutionStatus, int(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING))), true)
+	p, err = s.visibilityStore.buildSearchParameters(context.Background(), request, elastic.NewBoolQuery().Filter(elastic.NewTermQuery(searchattribute.ExecutionStatus, int(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING))), true)
 	s.NoError(err)
 	s.Equal(&client.SearchParameters{
 		Index:       testIndex,
@@ -357,7 +359,7 @@ func (s *ESVisibilitySuite) TestBuildSearchParameters() {
 	// test for closed
 	rangeQuery = elastic.NewRangeQuery(searchattribute.CloseTime).Gte(request.EarliestStartTime).Lte(request.LatestStartTime)
 	boolQuery = elastic.NewBoolQuery().MustNot(runningQuery).Filter(matchNamespaceQuery).Filter(rangeQuery).MustNot(namespaceDivisionExists)
-	p, err = s.visibilityStore.buildSearchParameters(request, elastic.NewBoolQuery().MustNot(elastic.NewTermQuery(searchattribute.ExecutionStatus, int(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING))), false)
+	p, err = s.visibilityStore.buildSearchParameters(context.Background(), request, elastic.NewBoolQuery().MustNot(elastic.NewTermQuery(searchattribute.ExecutionStatus, int(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING))), false)
 	s.NoError(err)
 	s.Equal(&client.SearchParameters{
 		Index:       testIndex,
@@ -371,7 +373,7 @@ func (s *ESVisibilitySuite) TestBuildSearchParameters() {
 	rangeQuery = elastic.NewRangeQuery(searchattribute.StartTime).Gte(request.EarliestStartTime).Lte(request.LatestStartTime)
 	matchQuery := elastic.NewTermQuery(searchattribute.ExecutionStatus, int32(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING))
 	boolQuery = elastic.NewBoolQuery().Filter(matchQuery).Filter(matchNamespaceQuery).Filter(rangeQuery).MustNot(namespaceDivisionExists)
-	p, err = s.visibilityStore.buildSearchParameters(request, elastic.NewBoolQuery().Filter(matchQuery), true)
+	p, err = s.visibilityStore.buildSearchParameters(context.Background(), request, elastic.NewBoolQuery().Filter(matchQuery), true)
 	s.NoError(err)
 	s.Equal(&client.SearchParameters{
 		Index:       testIndex,
@@ -388,7 +390,7 @@ func (s *ESVisibilitySuite) TestBuildSearchParameters() {
 
 	rangeQuery = elastic.NewRangeQuery(searchattribute.StartTime).Gte(request.EarliestStartTime).Lte(request.LatestStartTime)
 	boolQuery = elastic.NewBoolQuery().Filter(matchNamespaceQuery).Filter(rangeQuery).MustNot(namespaceDivisionExists)
-	p, err = s.visibilityStore.buildSearchParameters(request, elastic.NewBoolQuery(), true)
+	p, err = s.visibilityStore.buildSearchParameters(context.Background(), request, elastic.NewBoolQuery(), true)
 	s.NoError(err)
 	s.Equal(&client.SearchParameters{
 		Index:       testIndex,
@@ -403,7 +405,7 @@ func (s *ESVisibilitySuite) TestBuildSearchParameters() {
 	rangeQuery = elastic.NewRangeQuery(searchattribute.StartTime).Gte(request.EarliestStartTime).Lte(request.LatestStartTime)
 	boolQuery = elastic.NewBoolQuery().Filter(matchNamespaceQuery).Filter(rangeQuery).MustNot(namespaceDivisionExists)
 	request.NextPageToken = nil
-	p, err = s.visibilityStore.buildSearchParameters(request, elastic.NewBoolQuery(), true)
+	p, err = s.visibilityStore.buildSearchParameters(context.Background(), request, elastic.NewBoolQuery(), true)
 	s.NoError(err)
 	s.Equal(&client.SearchParameters{
 		Index:       testIndex,
@@ -428,7 +430,7 @@ func (s *ESVisibilitySuite) TestBuildSearchParametersV2() {
 	request.Query = `WorkflowId="guid-2208"`
 	filterQuery := elastic.NewBoolQuery().Filter(elastic.NewMatchQuery(searchattribute.WorkflowID, "guid-2208"))
 	boolQuery := elastic.NewBoolQuery().Filter(matchNamespaceQuery, filterQuery).MustNot(namespaceDivisionExists)
-	p, err := s.visibilityStore.buildSearchParametersV2(request)
+	p, err := s.visibilityStore.buildSearchParametersV2(context.Background(), request)
 	s.NoError(err)
 	s.Equal(&client.SearchParameters{
 		Index:       testIndex,
@@ -445,7 +447,7 @@ func (s *ESVisibilitySuite) TestBuildSearchParametersV2() {
 	// note namespace division appears in the filterQuery, not the boolQuery like the negative version
 	filterQuery = elastic.NewBoolQuery().Filter(elastic.NewMatchQuery(searchattribute.WorkflowID, "guid-2208"), matchNSDivision)
 	boolQuery = elastic.NewBoolQuery().Filter(matchNamespaceQuery, filterQuery)
-	p, err = s.visibilityStore.buildSearchParametersV2(request)
+	p, err = s.visibilityStore.buildSearchParametersV2(context.Background(), request)
 	s.NoError(err)
 	s.Equal(&client.SearchParameters{
 		Index:       testIndex,
@@ -460,7 +462,7 @@ func (s *ESVisibilitySuite) TestBuildSearchParametersV2() {
 	// test custom sort
 	request.Query = `Order bY WorkflowId`
 	boolQuery = elastic.NewBoolQuery().Filter(matchNamespaceQuery).MustNot(namespaceDivisionExists)
-	p, err = s.visibilityStore.buildSearchParametersV2(request)
+	p, err = s.visibilityStore.buildSearchParametersV2(context.Background(), request)
 	s.NoError(err)
 	s.Equal(&client.SearchParameters{
 		Index:       testIndex,
@@ -477,7 +479,7 @@ func (s *ESVisibilitySuite) TestBuildSearchParametersV2() {
 
 	// test for wrong query
 	request.Query = "invalid query"
-	p, err = s.visibilityStore.buildSearchParametersV2(request)
+	p, err = s.visibilityStore.buildSearchParametersV2(context.Background(), request)
 	s.Nil(p)
 	s.Error(err)
 	request.Query = ""
@@ -499,7 +501,7 @@ func (s *ESVisibilitySuite) TestBuildSearchParametersV2DisableOrderByClause() {
 	request.Query = `WorkflowId="guid-2208"`
 	filterQuery := elastic.NewBoolQuery().Filter(elastic.NewMatchQuery(searchattribute.WorkflowID, "guid-2208"))
 	boolQuery := elastic.NewBoolQuery().Filter(matchNamespaceQuery, filterQuery).MustNot(namespaceDivisionExists)
-	p, err := s.visibilityStore.buildSearchParametersV2(request)
+	p, err := s.visibilityStore.buildSearchParametersV2(context.Background(), request)
 	s.NoError(err)
 	s.Equal(&client.SearchParameters{
 		Index:       testIndex,
@@ -513,7 +515,7 @@ func (s *ESVisibilitySuite) TestBuildSearchParametersV2DisableOrderByClause() {
 
 	// test invalid query with ORDER BY
 	request.Query = `ORDER BY WorkflowId`
-	p, err = s.visibilityStore.buildSearchParametersV2(request)
+	p, err = s.visibilityStore.buildSearchParametersV2(context.Background(), request)
 	s.Nil(p)
 	s.Error(err)
 	var invalidArgumentErr *serviceerror.InvalidArgument
@@ -1205,6 +1207,55 @@ func (s *ESVisibilitySuite) TestScanWorkflowExecutions_PIT() {
 	s.Contains(err.Error(), "ScanWorkflowExecutions failed")
 }
 
+func (s *ESVisibilitySuite) TestCountWorkflowExecutions_NamespaceIndex() {
+	s.visibilityStore.namespaceIndexCache.Put(testNamespaceID.String(), true)
+	nsIndex := client.NamespaceIndexName(testIndex, testNamespaceID.String())
+	s.mockESClient.EXPECT().Count(gomock.Any(), nsIndex, gomock.Any()).Return(int64(1), nil)
+
+	request := &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+	}
+	resp, err := s.visibilityStore.CountWorkflowExecutions(context.Background(), request)
+	s.NoError(err)
+	s.Equal(int64(1), resp.Count)
+
+	p, err := s.visibilityStore.buildSearchParametersV2(context.Background(), &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		PageSize:    testPageSize,
+	})
+	s.NoError(err)
+	s.Equal(nsIndex, p.Index)
+}
+
+func (s *ESVisibilitySuite) Test_getNamespaceIndex() {
+	esClient := client.NewMockClient(s.controller)
+	s.visibilityStore.esClient = esClient
+	nsIndex := client.NamespaceIndexName(testIndex, testNamespaceID.String())
+
+	// Existence of the dedicated index is cached.
+	esClient.EXPECT().IndexExists(gomock.Any(), nsIndex).Return(true, nil).Times(1)
+	for i := 0; i < 2; i++ {
+		index, err := s.visibilityStore.getNamespaceIndex(context.Background(), testNamespaceID.String())
+		s.NoError(err)
+		s.Equal(nsIndex, index)
+	}
+
+	esClient.EXPECT().IndexExists(gomock.Any(), client.NamespaceIndexName(testIndex, "ns-without-index")).Return(false, nil).Times(1)
+	index, err := s.visibilityStore.getNamespaceIndex(context.Background(), "ns-without-index")
+	s.NoError(err)
+	s.Equal(testIndex, index)
+
+	// Errors are not cached.
+	esClient.EXPECT().IndexExists(gomock.Any(), client.NamespaceIndexName(testIndex, "ns-error")).Return(false, errTestESSearch).Times(2)
+	for i := 0; i < 2; i++ {
+		_, err = s.visibilityStore.getNamespaceIndex(context.Background(), "ns-error")
+		s.Error(err)
+		s.IsType(&serviceerror.Unavailable{}, err)
+	}
+}
+
 func (s *ESVisibilitySuite) TestCountWorkflowExecutions() {
 	s.mockESClient.EXPECT().Count(gomock.Any(), testIndex, gomock.Any()).DoAndReturn(
 		func(ctx context.Context, index string, query elastic.Query) (int64, error) {
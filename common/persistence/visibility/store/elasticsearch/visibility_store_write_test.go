@@ -252,6 +252,28 @@ func (s *ESVisibilitySuite) TestDeleteExecution() {
 	s.NoError(err)
 }
 
+func (s *ESVisibilitySuite) TestDeleteExecution_NamespaceIndex() {
+	request := &manager.VisibilityDeleteWorkflowExecutionRequest{
+		NamespaceID: "namespaceID",
+		RunID:       "rid",
+		WorkflowID:  "wid",
+		TaskID:      int64(111),
+	}
+	s.visibilityStore.namespaceIndexCache.Put(request.NamespaceID.String(), true)
+
+	s.mockProcessor.EXPECT().Add(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(bulkRequest *client.BulkableRequest, visibilityTaskKey string) future.Future[bool] {
+			s.Equal("test-index_ns_namespaceid", bulkRequest.Index)
+
+			f := future.NewFuture[bool]()
+			f.Set(true, nil)
+			return f
+		})
+
+	err := s.visibilityStore.DeleteWorkflowExecution(context.Background(), request)
+	s.NoError(err)
+}
+
 func (s *ESVisibilitySuite) TestDeleteExecution_EmptyRequest() {
 	// test empty request
 	request := &manager.VisibilityDeleteWorkflowExecutionRequest{}
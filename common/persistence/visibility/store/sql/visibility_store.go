@@ -26,6 +26,7 @@ package sql
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"strings"
 	"time"
@@ -36,6 +37,7 @@ import (
 
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
 	persistencesql "go.temporal.io/server/common/persistence/sql"
@@ -65,8 +67,9 @@ func NewSQLVisibilityStore(
 	searchAttributesProvider searchattribute.Provider,
 	searchAttributesMapperProvider searchattribute.MapperProvider,
 	logger log.Logger,
+	metricsHandler metrics.Handler,
 ) (*VisibilityStore, error) {
-	refDbConn := persistencesql.NewRefCountedDBConn(sqlplugin.DbKindVisibility, &cfg, r)
+	refDbConn := persistencesql.NewRefCountedDBConn(sqlplugin.DbKindVisibility, &cfg, r, logger, metricsHandler)
 	db, err := refDbConn.Get()
 	if err != nil {
 		return nil, err
@@ -377,7 +380,8 @@ func (s *VisibilityStore) ListWorkflowExecutions(
 		return nil, err
 	}
 
-	rows, err := s.sqlStore.Db.SelectFromVisibility(ctx, *selectFilter)
+	// Listing tolerates replication lag, so it may be served by a read replica.
+	rows, err := s.sqlStore.Db.SelectFromVisibility(persistence.WithStaleReads(ctx), *selectFilter)
 	if err != nil {
 		return nil, serviceerror.NewUnavailable(
 			fmt.Sprintf("ListWorkflowExecutions operation failed. Select failed: %v", err))
@@ -450,7 +454,7 @@ func (s *VisibilityStore) CountWorkflowExecutions(
 		return nil, err
 	}
 
-	count, err := s.sqlStore.Db.CountFromVisibility(ctx, *selectFilter)
+	count, err := s.sqlStore.Db.CountFromVisibility(persistence.WithStaleReads(ctx), *selectFilter)
 	if err != nil {
 		return nil, serviceerror.NewUnavailable(
 			fmt.Sprintf("CountWorkflowExecutions operation failed. Query failed: %v", err))
@@ -467,6 +471,10 @@ func (s *VisibilityStore) GetWorkflowExecution(
 		NamespaceID: request.NamespaceID.String(),
 		RunID:       request.RunID,
 	})
+	if err == sql.ErrNoRows {
+		return nil, serviceerror.NewNotFound(
+			fmt.Sprintf("Workflow execution with run ID %v not found.", request.RunID))
+	}
 	if err != nil {
 		return nil, serviceerror.NewUnavailable(
 			fmt.Sprintf("GetWorkflowExecution operation failed. Select failed: %v", err))
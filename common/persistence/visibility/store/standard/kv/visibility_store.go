@@ -0,0 +1,365 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package kv
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dgraph-io/badger/v3"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/kv"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/persistence/visibility/store"
+)
+
+type (
+	visibilityStore struct {
+		kv.Store
+	}
+
+	// visibilityRecord is stored under the primary key of an execution as well as
+	// under its entry in either the open or the closed index
+	visibilityRecord struct {
+		WorkflowID       string
+		RunID            string
+		WorkflowTypeName string
+		StartTime        time.Time
+		ExecutionTime    time.Time
+		CloseTime        time.Time
+		Status           enumspb.WorkflowExecutionStatus
+		HistoryLength    int64
+		HistorySizeBytes int64
+		Memo             []byte
+		Encoding         string
+		TaskQueue        string
+	}
+)
+
+var _ store.VisibilityStore = (*visibilityStore)(nil)
+
+// NewVisibilityStore creates an instance of VisibilityStore
+func NewVisibilityStore(
+	cfg config.KV,
+	logger log.Logger,
+) (*visibilityStore, error) {
+	db, err := kv.OpenDB(cfg, logger)
+	if err != nil {
+		return nil, err
+	}
+	return &visibilityStore{
+		Store: kv.NewStore(db, logger),
+	}, nil
+}
+
+func (s *visibilityStore) GetIndexName() string {
+	// GetIndexName is used to get cluster metadata, which in verstions < v1.20
+	// were stored in an empty string key.
+	return ""
+}
+
+func (s *visibilityStore) RecordWorkflowExecutionStarted(
+	ctx context.Context,
+	request *store.InternalRecordWorkflowExecutionStartedRequest,
+) error {
+	record := newVisibilityRecord(request.InternalVisibilityRequestBase)
+	record.Status = enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING
+	return s.DB.Update(ctx, "RecordWorkflowExecutionStarted", func(txn *badger.Txn) error {
+		key := executionKey(request.NamespaceID, request.RunID)
+		exists, err := kv.Exists(txn, key)
+		if err != nil || exists {
+			// the execution may already be recorded, possibly as closed
+			return err
+		}
+		if err := kv.Put(txn, key, record); err != nil {
+			return err
+		}
+		return kv.Put(txn, indexKey(request.NamespaceID, record), record)
+	})
+}
+
+func (s *visibilityStore) RecordWorkflowExecutionClosed(
+	ctx context.Context,
+	request *store.InternalRecordWorkflowExecutionClosedRequest,
+) error {
+	record := newVisibilityRecord(request.InternalVisibilityRequestBase)
+	record.CloseTime = request.CloseTime
+	record.HistoryLength = request.HistoryLength
+	record.HistorySizeBytes = request.HistorySizeBytes
+	return s.DB.Update(ctx, "RecordWorkflowExecutionClosed", func(txn *badger.Txn) error {
+		if err := deleteExecution(txn, request.NamespaceID, request.RunID); err != nil {
+			return err
+		}
+		if err := kv.Put(txn, executionKey(request.NamespaceID, request.RunID), record); err != nil {
+			return err
+		}
+		return kv.Put(txn, indexKey(request.NamespaceID, record), record)
+	})
+}
+
+func (s *visibilityStore) UpsertWorkflowExecution(
+	_ context.Context,
+	_ *store.InternalUpsertWorkflowExecutionRequest,
+) error {
+	// Not OperationNotSupportedErr!
+	return nil
+}
+
+func (s *visibilityStore) ListOpenWorkflowExecutions(
+	ctx context.Context,
+	request *manager.ListWorkflowExecutionsRequest,
+) (*store.InternalListWorkflowExecutionsResponse, error) {
+	return s.listWorkflowExecutions(ctx, "ListOpenWorkflowExecutions", request, false, nil)
+}
+
+func (s *visibilityStore) ListClosedWorkflowExecutions(
+	ctx context.Context,
+	request *manager.ListWorkflowExecutionsRequest,
+) (*store.InternalListWorkflowExecutionsResponse, error) {
+	return s.listWorkflowExecutions(ctx, "ListClosedWorkflowExecutions", request, true, nil)
+}
+
+func (s *visibilityStore) ListOpenWorkflowExecutionsByType(
+	ctx context.Context,
+	request *manager.ListWorkflowExecutionsByTypeRequest,
+) (*store.InternalListWorkflowExecutionsResponse, error) {
+	return s.listWorkflowExecutions(ctx, "ListOpenWorkflowExecutionsByType", request.ListWorkflowExecutionsRequest, false,
+		func(record *visibilityRecord) bool {
+			return record.WorkflowTypeName == request.WorkflowTypeName
+		})
+}
+
+func (s *visibilityStore) ListClosedWorkflowExecutionsByType(
+	ctx context.Context,
+	request *manager.ListWorkflowExecutionsByTypeRequest,
+) (*store.InternalListWorkflowExecutionsResponse, error) {
+	return s.listWorkflowExecutions(ctx, "ListClosedWorkflowExecutionsByType", request.ListWorkflowExecutionsRequest, true,
+		func(record *visibilityRecord) bool {
+			return record.WorkflowTypeName == request.WorkflowTypeName
+		})
+}
+
+func (s *visibilityStore) ListOpenWorkflowExecutionsByWorkflowID(
+	ctx context.Context,
+	request *manager.ListWorkflowExecutionsByWorkflowIDRequest,
+) (*store.InternalListWorkflowExecutionsResponse, error) {
+	return s.listWorkflowExecutions(ctx, "ListOpenWorkflowExecutionsByWorkflowID", request.ListWorkflowExecutionsRequest, false,
+		func(record *visibilityRecord) bool {
+			return record.WorkflowID == request.WorkflowID
+		})
+}
+
+func (s *visibilityStore) ListClosedWorkflowExecutionsByWorkflowID(
+	ctx context.Context,
+	request *manager.ListWorkflowExecutionsByWorkflowIDRequest,
+) (*store.InternalListWorkflowExecutionsResponse, error) {
+	return s.listWorkflowExecutions(ctx, "ListClosedWorkflowExecutionsByWorkflowID", request.ListWorkflowExecutionsRequest, true,
+		func(record *visibilityRecord) bool {
+			return record.WorkflowID == request.WorkflowID
+		})
+}
+
+func (s *visibilityStore) ListClosedWorkflowExecutionsByStatus(
+	ctx context.Context,
+	request *manager.ListClosedWorkflowExecutionsByStatusRequest,
+) (*store.InternalListWorkflowExecutionsResponse, error) {
+	return s.listWorkflowExecutions(ctx, "ListClosedWorkflowExecutionsByStatus", request.ListWorkflowExecutionsRequest, true,
+		func(record *visibilityRecord) bool {
+			return record.Status == request.Status
+		})
+}
+
+func (s *visibilityStore) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *manager.VisibilityDeleteWorkflowExecutionRequest,
+) error {
+	return s.DB.Update(ctx, "DeleteWorkflowExecution", func(txn *badger.Txn) error {
+		return deleteExecution(txn, request.NamespaceID.String(), request.RunID)
+	})
+}
+
+func (s *visibilityStore) ListWorkflowExecutions(
+	_ context.Context,
+	_ *manager.ListWorkflowExecutionsRequestV2,
+) (*store.InternalListWorkflowExecutionsResponse, error) {
+	return nil, store.OperationNotSupportedErr
+}
+
+func (s *visibilityStore) ScanWorkflowExecutions(
+	_ context.Context,
+	_ *manager.ListWorkflowExecutionsRequestV2,
+) (*store.InternalListWorkflowExecutionsResponse, error) {
+	return nil, store.OperationNotSupportedErr
+}
+
+func (s *visibilityStore) CountWorkflowExecutions(
+	_ context.Context,
+	_ *manager.CountWorkflowExecutionsRequest,
+) (*manager.CountWorkflowExecutionsResponse, error) {
+	return nil, store.OperationNotSupportedErr
+}
+
+func (s *visibilityStore) GetWorkflowExecution(
+	ctx context.Context,
+	request *manager.GetWorkflowExecutionRequest,
+) (*store.InternalGetWorkflowExecutionResponse, error) {
+	var record visibilityRecord
+	var found bool
+	if err := s.DB.View(ctx, "GetWorkflowExecution", func(txn *badger.Txn) error {
+		var err error
+		found, err = kv.Get(txn, executionKey(request.NamespaceID.String(), request.RunID), &record)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("Workflow execution with run ID %v not found.", request.RunID))
+	}
+	return &store.InternalGetWorkflowExecutionResponse{
+		Execution: record.toInfo(),
+	}, nil
+}
+
+// listWorkflowExecutions pages through the open or closed index of a namespace,
+// newest first, within the requested time range. Records rejected by filter
+// are skipped and do not count towards the page size.
+func (s *visibilityStore) listWorkflowExecutions(
+	ctx context.Context,
+	operation string,
+	request *manager.ListWorkflowExecutionsRequest,
+	closed bool,
+	filter func(record *visibilityRecord) bool,
+) (*store.InternalListWorkflowExecutionsResponse, error) {
+	prefix := indexPrefix(request.NamespaceID.String(), closed)
+	start := prefix.DescTime(request.LatestStartTime)
+	end := prefix.DescTime(request.EarliestStartTime).PrefixEnd()
+	if len(request.NextPageToken) > 0 {
+		token := kv.Key(request.NextPageToken)
+		if token.Compare(start) < 0 || token.Compare(end) > 0 {
+			return nil, serviceerror.NewInvalidArgument("invalid page token")
+		}
+		start = token
+	}
+
+	response := &store.InternalListWorkflowExecutionsResponse{}
+	if err := s.DB.View(ctx, operation, func(txn *badger.Txn) error {
+		return kv.ScanRecords(txn, start, end, false, func(key kv.Key, record *visibilityRecord) (bool, error) {
+			if request.PageSize > 0 && len(response.Executions) == request.PageSize {
+				response.NextPageToken = key
+				return false, nil
+			}
+			if filter == nil || filter(record) {
+				response.Executions = append(response.Executions, record.toInfo())
+			}
+			return true, nil
+		})
+	}); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// deleteExecution removes the execution and its index entry, if present
+func deleteExecution(txn *badger.Txn, namespaceID string, runID string) error {
+	key := executionKey(namespaceID, runID)
+	var record visibilityRecord
+	found, err := kv.Get(txn, key, &record)
+	if err != nil || !found {
+		return err
+	}
+	if err := txn.Delete(indexKey(namespaceID, &record)); err != nil {
+		return err
+	}
+	return txn.Delete(key)
+}
+
+func newVisibilityRecord(request *store.InternalVisibilityRequestBase) *visibilityRecord {
+	record := &visibilityRecord{
+		WorkflowID:       request.WorkflowID,
+		RunID:            request.RunID,
+		WorkflowTypeName: request.WorkflowTypeName,
+		StartTime:        request.StartTime,
+		ExecutionTime:    request.ExecutionTime,
+		Status:           request.Status,
+		TaskQueue:        request.TaskQueue,
+	}
+	if request.Memo != nil {
+		record.Memo = request.Memo.Data
+		record.Encoding = request.Memo.EncodingType.String()
+	}
+	return record
+}
+
+func (r *visibilityRecord) isClosed() bool {
+	return r.Status != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING
+}
+
+func (r *visibilityRecord) toInfo() *store.InternalWorkflowExecutionInfo {
+	info := &store.InternalWorkflowExecutionInfo{
+		WorkflowID:       r.WorkflowID,
+		RunID:            r.RunID,
+		TypeName:         r.WorkflowTypeName,
+		StartTime:        r.StartTime.UTC(),
+		ExecutionTime:    r.ExecutionTime.UTC(),
+		Status:           r.Status,
+		HistoryLength:    r.HistoryLength,
+		HistorySizeBytes: r.HistorySizeBytes,
+		Memo:             persistence.NewDataBlob(r.Memo, r.Encoding),
+		TaskQueue:        r.TaskQueue,
+	}
+	if r.ExecutionTime.IsZero() {
+		info.ExecutionTime = info.StartTime
+	}
+	if r.isClosed() {
+		info.CloseTime = r.CloseTime.UTC()
+	}
+	return info
+}
+
+func executionKey(namespaceID string, runID string) kv.Key {
+	return kv.NewKey(kv.TableVisibility).String(namespaceID).String(runID)
+}
+
+func indexPrefix(namespaceID string, closed bool) kv.Key {
+	if closed {
+		return kv.NewKey(kv.TableVisibilityClosed).String(namespaceID)
+	}
+	return kv.NewKey(kv.TableVisibilityOpen).String(namespaceID)
+}
+
+// indexKey orders open executions by start time and closed executions by
+// close time, newest first
+func indexKey(namespaceID string, record *visibilityRecord) kv.Key {
+	if record.isClosed() {
+		return indexPrefix(namespaceID, true).DescTime(record.CloseTime).String(record.RunID)
+	}
+	return indexPrefix(namespaceID, false).DescTime(record.StartTime).String(record.RunID)
+}
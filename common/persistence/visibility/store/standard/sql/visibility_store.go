@@ -26,6 +26,7 @@ package sql
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"fmt"
 	"time"
@@ -35,6 +36,7 @@ import (
 
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
 	persistencesql "go.temporal.io/server/common/persistence/sql"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
@@ -61,8 +63,9 @@ func NewSQLVisibilityStore(
 	cfg config.SQL,
 	r resolver.ServiceResolver,
 	logger log.Logger,
+	metricsHandler metrics.Handler,
 ) (*visibilityStore, error) {
-	refDbConn := persistencesql.NewRefCountedDBConn(sqlplugin.DbKindVisibility, &cfg, r)
+	refDbConn := persistencesql.NewRefCountedDBConn(sqlplugin.DbKindVisibility, &cfg, r, logger, metricsHandler)
 	db, err := refDbConn.Get()
 	if err != nil {
 		return nil, err
@@ -150,12 +153,13 @@ func (s *visibilityStore) ListOpenWorkflowExecutions(
 	request *manager.ListWorkflowExecutionsRequest,
 ) (*store.InternalListWorkflowExecutionsResponse, error) {
 	return s.listWorkflowExecutions(
+		ctx,
 		"ListOpenWorkflowExecutions",
 		request.NextPageToken,
 		request.PageSize,
 		request.LatestStartTime,
 		false,
-		func(readLevel *visibilityPageToken) ([]sqlplugin.VisibilityRow, error) {
+		func(ctx context.Context, readLevel *visibilityPageToken) ([]sqlplugin.VisibilityRow, error) {
 			return s.sqlStore.Db.SelectFromVisibility(ctx, sqlplugin.VisibilitySelectFilter{
 				NamespaceID: request.NamespaceID.String(),
 				MinTime:     &request.EarliestStartTime,
@@ -171,12 +175,12 @@ func (s *visibilityStore) ListClosedWorkflowExecutions(
 	ctx context.Context,
 	request *manager.ListWorkflowExecutionsRequest,
 ) (*store.InternalListWorkflowExecutionsResponse, error) {
-	return s.listWorkflowExecutions("ListClosedWorkflowExecutions",
+	return s.listWorkflowExecutions(ctx, "ListClosedWorkflowExecutions",
 		request.NextPageToken,
 		request.PageSize,
 		request.LatestStartTime,
 		true,
-		func(readLevel *visibilityPageToken) ([]sqlplugin.VisibilityRow, error) {
+		func(ctx context.Context, readLevel *visibilityPageToken) ([]sqlplugin.VisibilityRow, error) {
 			return s.sqlStore.Db.SelectFromVisibility(ctx, sqlplugin.VisibilitySelectFilter{
 				NamespaceID: request.NamespaceID.String(),
 				MinTime:     &request.EarliestStartTime,
@@ -191,12 +195,12 @@ func (s *visibilityStore) ListOpenWorkflowExecutionsByType(
 	ctx context.Context,
 	request *manager.ListWorkflowExecutionsByTypeRequest,
 ) (*store.InternalListWorkflowExecutionsResponse, error) {
-	return s.listWorkflowExecutions("ListOpenWorkflowExecutionsByType",
+	return s.listWorkflowExecutions(ctx, "ListOpenWorkflowExecutionsByType",
 		request.NextPageToken,
 		request.PageSize,
 		request.LatestStartTime,
 		false,
-		func(readLevel *visibilityPageToken) ([]sqlplugin.VisibilityRow, error) {
+		func(ctx context.Context, readLevel *visibilityPageToken) ([]sqlplugin.VisibilityRow, error) {
 			return s.sqlStore.Db.SelectFromVisibility(ctx, sqlplugin.VisibilitySelectFilter{
 				NamespaceID:      request.NamespaceID.String(),
 				MinTime:          &request.EarliestStartTime,
@@ -213,12 +217,12 @@ func (s *visibilityStore) ListClosedWorkflowExecutionsByType(
 	ctx context.Context,
 	request *manager.ListWorkflowExecutionsByTypeRequest,
 ) (*store.InternalListWorkflowExecutionsResponse, error) {
-	return s.listWorkflowExecutions("ListClosedWorkflowExecutionsByType",
+	return s.listWorkflowExecutions(ctx, "ListClosedWorkflowExecutionsByType",
 		request.NextPageToken,
 		request.PageSize,
 		request.LatestStartTime,
 		true,
-		func(readLevel *visibilityPageToken) ([]sqlplugin.VisibilityRow, error) {
+		func(ctx context.Context, readLevel *visibilityPageToken) ([]sqlplugin.VisibilityRow, error) {
 			return s.sqlStore.Db.SelectFromVisibility(ctx, sqlplugin.VisibilitySelectFilter{
 				NamespaceID:      request.NamespaceID.String(),
 				MinTime:          &request.EarliestStartTime,
@@ -234,12 +238,12 @@ func (s *visibilityStore) ListOpenWorkflowExecutionsByWorkflowID(
 	ctx context.Context,
 	request *manager.ListWorkflowExecutionsByWorkflowIDRequest,
 ) (*store.InternalListWorkflowExecutionsResponse, error) {
-	return s.listWorkflowExecutions("ListOpenWorkflowExecutionsByWorkflowID",
+	return s.listWorkflowExecutions(ctx, "ListOpenWorkflowExecutionsByWorkflowID",
 		request.NextPageToken,
 		request.PageSize,
 		request.LatestStartTime,
 		false,
-		func(readLevel *visibilityPageToken) ([]sqlplugin.VisibilityRow, error) {
+		func(ctx context.Context, readLevel *visibilityPageToken) ([]sqlplugin.VisibilityRow, error) {
 			return s.sqlStore.Db.SelectFromVisibility(ctx, sqlplugin.VisibilitySelectFilter{
 				NamespaceID: request.NamespaceID.String(),
 				MinTime:     &request.EarliestStartTime,
@@ -256,12 +260,12 @@ func (s *visibilityStore) ListClosedWorkflowExecutionsByWorkflowID(
 	ctx context.Context,
 	request *manager.ListWorkflowExecutionsByWorkflowIDRequest,
 ) (*store.InternalListWorkflowExecutionsResponse, error) {
-	return s.listWorkflowExecutions("ListClosedWorkflowExecutionsByWorkflowID",
+	return s.listWorkflowExecutions(ctx, "ListClosedWorkflowExecutionsByWorkflowID",
 		request.NextPageToken,
 		request.PageSize,
 		request.LatestStartTime,
 		true,
-		func(readLevel *visibilityPageToken) ([]sqlplugin.VisibilityRow, error) {
+		func(ctx context.Context, readLevel *visibilityPageToken) ([]sqlplugin.VisibilityRow, error) {
 			return s.sqlStore.Db.SelectFromVisibility(ctx, sqlplugin.VisibilitySelectFilter{
 				NamespaceID: request.NamespaceID.String(),
 				MinTime:     &request.EarliestStartTime,
@@ -277,12 +281,12 @@ func (s *visibilityStore) ListClosedWorkflowExecutionsByStatus(
 	ctx context.Context,
 	request *manager.ListClosedWorkflowExecutionsByStatusRequest,
 ) (*store.InternalListWorkflowExecutionsResponse, error) {
-	return s.listWorkflowExecutions("ListClosedWorkflowExecutionsByStatus",
+	return s.listWorkflowExecutions(ctx, "ListClosedWorkflowExecutionsByStatus",
 		request.NextPageToken,
 		request.PageSize,
 		request.LatestStartTime,
 		true,
-		func(readLevel *visibilityPageToken) ([]sqlplugin.VisibilityRow, error) {
+		func(ctx context.Context, readLevel *visibilityPageToken) ([]sqlplugin.VisibilityRow, error) {
 			return s.sqlStore.Db.SelectFromVisibility(ctx, sqlplugin.VisibilitySelectFilter{
 				NamespaceID: request.NamespaceID.String(),
 				MinTime:     &request.EarliestStartTime,
@@ -337,6 +341,10 @@ func (s *visibilityStore) GetWorkflowExecution(
 		NamespaceID: request.NamespaceID.String(),
 		RunID:       request.RunID,
 	})
+	if err == sql.ErrNoRows {
+		return nil, serviceerror.NewNotFound(
+			fmt.Sprintf("Workflow execution with run ID %v not found.", request.RunID))
+	}
 	if err != nil {
 		return nil, serviceerror.NewUnavailable(
 			fmt.Sprintf("GetWorkflowExecution operation failed. Select failed: %v", err))
@@ -373,12 +381,13 @@ func (s *visibilityStore) rowToInfo(
 }
 
 func (s *visibilityStore) listWorkflowExecutions(
+	ctx context.Context,
 	opName string,
 	pageToken []byte,
 	pageSize int,
 	latestTime time.Time,
 	closeQuery bool,
-	selectOp func(readLevel *visibilityPageToken) ([]sqlplugin.VisibilityRow, error),
+	selectOp func(ctx context.Context, readLevel *visibilityPageToken) ([]sqlplugin.VisibilityRow, error),
 ) (*store.InternalListWorkflowExecutionsResponse, error) {
 	var readLevel *visibilityPageToken
 	var err error
@@ -390,7 +399,8 @@ func (s *visibilityStore) listWorkflowExecutions(
 	} else {
 		readLevel = &visibilityPageToken{Time: latestTime, RunID: ""}
 	}
-	rows, err := selectOp(readLevel)
+	// Listing tolerates replication lag, so it may be served by a read replica.
+	rows, err := selectOp(persistence.WithStaleReads(ctx), readLevel)
 	if err != nil {
 		return nil, serviceerror.NewUnavailable(fmt.Sprintf("%v operation failed. Select failed: %v", opName, err))
 	}
@@ -55,6 +55,15 @@ func NewVisibilityManagerDual(
 	}
 }
 
+// SecondaryManager returns the manager of the store a dual visibility manager migrates to,
+// or nil if visibilityManager writes to a single store.
+func SecondaryManager(visibilityManager manager.VisibilityManager) manager.VisibilityManager {
+	if dual, ok := visibilityManager.(*visibilityManagerDual); ok {
+		return dual.secondaryVisibilityManager
+	}
+	return nil
+}
+
 func (v *visibilityManagerDual) Close() {
 	v.visibilityManager.Close()
 	v.secondaryVisibilityManager.Close()
@@ -57,6 +57,7 @@ import (
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloadstore"
 	"go.temporal.io/server/common/persistence"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
 	"go.temporal.io/server/common/persistence/serialization"
@@ -131,6 +132,7 @@ var DefaultOptions = fx.Options(
 	fx.Provide(RPCFactoryProvider),
 	fx.Provide(ArchivalMetadataProvider),
 	fx.Provide(ArchiverProviderProvider),
+	fx.Provide(PayloadOffloaderProvider),
 	fx.Provide(ThrottledLoggerProvider),
 	fx.Provide(SdkClientFactoryProvider),
 	fx.Provide(DCRedirectionPolicyProvider),
@@ -387,6 +389,22 @@ func PersistenceConfigProvider(persistenceConfig config.Persistence, dc *dynamic
 	return &persistenceConfig
 }
 
+// PayloadOffloaderProvider returns a nil offloader if no payload store is configured
+func PayloadOffloaderProvider(
+	cfg *config.Config,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) (*payloadstore.Offloader, error) {
+	if cfg.PayloadStore.URI == "" {
+		return nil, nil
+	}
+	store, err := payloadstore.NewStore(&cfg.PayloadStore)
+	if err != nil {
+		return nil, err
+	}
+	return payloadstore.NewOffloader(store, metricsHandler, logger), nil
+}
+
 func ArchivalMetadataProvider(dc *dynamicconfig.Collection, cfg *config.Config) archiver.ArchivalMetadata {
 	return archiver.NewArchivalMetadata(
 		dc,
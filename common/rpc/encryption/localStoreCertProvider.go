@@ -429,6 +429,7 @@ func checkCertForExpiration(
 		record := CertExpirationData{
 			Thumbprint: md5.Sum(cert.Raw),
 			IsCA:       cert.IsCA,
+			CommonName: cert.Subject.CommonName,
 			DNSNames:   cert.DNSNames,
 			Expiration: cert.NotAfter,
 		}
@@ -541,6 +542,11 @@ func (s *localStoreCertProvider) refreshCerts() {
 		if currentCerts.isEqual(newCerts) {
 			continue
 		}
+		if err := newCerts.validate(time.Now().UTC(), s.logger); err != nil {
+			// keep serving the current certificates until valid ones are in place
+			s.logger.Error("rejected reloaded TLS certificates", tag.Error(err))
+			continue
+		}
 
 		s.logger.Info("loaded new TLS certificates")
 		s.Lock()
@@ -553,6 +559,47 @@ func (s *localStoreCertProvider) isTLSEnabled() bool {
 	return s.tlsSettings != nil || s.workerTLSSettings != nil
 }
 
+// validate checks that reloaded certificates are usable before they replace the current ones. CA
+// certificates outside their validity period are skipped with a warning since a CA bundle commonly
+// keeps an expired CA next to its replacement.
+func (c *certCache) validate(now time.Time, logger log.Logger) error {
+	if c == nil {
+		return nil
+	}
+	for _, cert := range []*tls.Certificate{c.serverCert, c.workerCert} {
+		if cert == nil {
+			continue
+		}
+		x509cert, err := x509.ParseCertificate(cert.Certificate[0])
+		if err != nil {
+			return err
+		}
+		if err := validateCertPeriod(x509cert, now); err != nil {
+			return err
+		}
+	}
+	for _, certs := range [][]*x509.Certificate{c.clientCACerts, c.serverCACerts, c.serverCACertsWorker} {
+		for _, cert := range certs {
+			if err := validateCertPeriod(cert, now); err != nil {
+				logger.Warn("reloaded CA certificate is not valid", tag.Error(err))
+			}
+		}
+	}
+	return nil
+}
+
+func validateCertPeriod(cert *x509.Certificate, now time.Time) error {
+	if now.Before(cert.NotBefore) {
+		return fmt.Errorf("certificate %q with thumbprint=%x is not valid before %v",
+			cert.Subject.CommonName, md5.Sum(cert.Raw), cert.NotBefore)
+	}
+	if now.After(cert.NotAfter) {
+		return fmt.Errorf("certificate %q with thumbprint=%x has expired on %v",
+			cert.Subject.CommonName, md5.Sum(cert.Raw), cert.NotAfter)
+	}
+	return nil
+}
+
 func (c *certCache) isEqual(other *certCache) bool {
 
 	if c == other {
@@ -25,10 +25,20 @@
 package encryption
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"errors"
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+
+	"go.temporal.io/server/common/log"
 )
 
 func TestAppendError(t *testing.T) {
@@ -47,3 +57,40 @@ func TestAppendError(t *testing.T) {
 	assert.Equal(err2, errors.Unwrap(err))
 	assert.Equal("error1, error2", err.Error())
 }
+
+func TestCertCacheValidate(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Now().UTC()
+	newCert := func(notBefore, notAfter time.Time) *x509.Certificate {
+		return &x509.Certificate{
+			Subject:   pkix.Name{CommonName: "test"},
+			NotBefore: notBefore,
+			NotAfter:  notAfter,
+		}
+	}
+
+	var nilCache *certCache
+	assert.NoError(nilCache.validate(now, log.NewNoopLogger()))
+
+	valid := &certCache{clientCACerts: []*x509.Certificate{newCert(now.Add(-time.Hour), now.Add(time.Hour))}}
+	assert.NoError(valid.validate(now, log.NewNoopLogger()))
+
+	// CAs outside their validity period are skipped
+	expiredCA := &certCache{serverCACerts: []*x509.Certificate{
+		newCert(now.Add(-2*time.Hour), now.Add(-time.Hour)),
+		newCert(now.Add(-time.Hour), now.Add(time.Hour)),
+	}}
+	assert.NoError(expiredCA.validate(now, log.NewNoopLogger()))
+	notYetValidCA := &certCache{serverCACertsWorker: []*x509.Certificate{newCert(now.Add(time.Hour), now.Add(2*time.Hour))}}
+	assert.NoError(notYetValidCA.validate(now, log.NewNoopLogger()))
+
+	// expired server certificates are rejected
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(err)
+	template := newCert(now.Add(-2*time.Hour), now.Add(-time.Hour))
+	template.SerialNumber = big.NewInt(1)
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(err)
+	expiredServer := &certCache{serverCert: &tls.Certificate{Certificate: [][]byte{der}}}
+	assert.ErrorContains(expiredServer.validate(now, log.NewNoopLogger()), "has expired")
+}
@@ -27,13 +27,16 @@ package encryption
 import (
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/hex"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 
+	clusterspb "go.temporal.io/server/api/cluster/v1"
 	"go.temporal.io/server/common/auth"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
@@ -72,6 +75,20 @@ type localStoreTlsProvider struct {
 
 var _ TLSConfigProvider = (*localStoreTlsProvider)(nil)
 var _ CertExpirationChecker = (*localStoreTlsProvider)(nil)
+var _ CertInfoProvider = (*localStoreTlsProvider)(nil)
+
+const (
+	certGroupInternode           = "internode"
+	certGroupFrontend            = "frontend"
+	certGroupSystemWorker        = "system-worker"
+	certGroupFrontendHostPrefix  = "frontend-host/"
+	certGroupRemoteClusterPrefix = "remote-cluster/"
+
+	// window that makes GetExpiringCerts return all certificates
+	allCertsWindow = 100 * 365 * 24 * time.Hour
+	// interval between expiration metrics when periodic expiration checks are not configured
+	defaultCertExpirationMetricsInterval = time.Hour
+)
 
 func NewLocalStoreTlsProvider(tlsConfig *config.RootTLS, metricsHandler metrics.Handler, logger log.Logger, certProviderFactory CertProviderFactory,
 ) (TLSConfigProvider, error) {
@@ -110,12 +127,14 @@ func NewLocalStoreTlsProvider(tlsConfig *config.RootTLS, metricsHandler metrics.
 
 func (s *localStoreTlsProvider) initialize() {
 	period := s.settings.ExpirationChecks.CheckInterval
-	if period != 0 {
-		s.stop = make(chan bool)
-		s.ticker = time.NewTicker(period)
-		s.checkCertExpiration() // perform initial check to emit metrics and logs right away
-		go s.timerCallback()
+	if period == 0 {
+		// expiration metrics are emitted even if expiration checks are not configured
+		period = defaultCertExpirationMetricsInterval
 	}
+	s.stop = make(chan bool)
+	s.ticker = time.NewTicker(period)
+	s.checkCertExpiration() // perform initial check to emit metrics and logs right away
+	go s.timerCallback()
 }
 
 func (s *localStoreTlsProvider) Close() {
@@ -219,6 +238,52 @@ func (s *localStoreTlsProvider) GetExpiringCerts(timeWindow time.Duration,
 	return expiring, expired, err
 }
 
+// GetCertificates returns the certificates of each config group, CAs included
+func (s *localStoreTlsProvider) GetCertificates() ([]*clusterspb.CertificateInfo, error) {
+	var certificates []*clusterspb.CertificateInfo
+	var err error
+	for group, provider := range s.certGroups() {
+		expiring := make(CertExpirationMap)
+		expired := make(CertExpirationMap)
+		checkError := checkExpiration(provider, allCertsWindow, expiring, expired)
+		err = appendError(err, checkError)
+		mergeMaps(expiring, expired)
+		for _, cert := range expiring {
+			expiration := cert.Expiration
+			certificates = append(certificates, &clusterspb.CertificateInfo{
+				Group:          group,
+				Thumbprint:     hex.EncodeToString(cert.Thumbprint[:]),
+				CommonName:     cert.CommonName,
+				DnsNames:       cert.DNSNames,
+				IsCa:           cert.IsCA,
+				ExpirationTime: &expiration,
+			})
+		}
+	}
+	sort.Slice(certificates, func(i, j int) bool {
+		if certificates[i].Group != certificates[j].Group {
+			return certificates[i].Group < certificates[j].Group
+		}
+		return certificates[i].ExpirationTime.Before(*certificates[j].ExpirationTime)
+	})
+	return certificates, err
+}
+
+func (s *localStoreTlsProvider) certGroups() map[string]CertExpirationChecker {
+	groups := map[string]CertExpirationChecker{
+		certGroupInternode:    s.internodeCertProvider,
+		certGroupFrontend:     s.frontendCertProvider,
+		certGroupSystemWorker: s.workerCertProvider,
+	}
+	for host, provider := range s.frontendPerHostCertProviderMap.certProviderCache {
+		groups[certGroupFrontendHostPrefix+host] = provider
+	}
+	for host, provider := range s.remoteClusterClientCertProvider {
+		groups[certGroupRemoteClusterPrefix+host] = provider
+	}
+	return groups
+}
+
 func checkExpiration(
 	provider CertExpirationChecker,
 	timeWindow time.Duration,
@@ -450,18 +515,52 @@ func (s *localStoreTlsProvider) checkCertExpiration() {
 	if window == 0 && s.settings.ExpirationChecks.ErrorWindow != 0 {
 		window = s.settings.ExpirationChecks.ErrorWindow
 	}
-	if window != 0 {
-		expiring, expired, err := s.GetExpiringCerts(window)
-		if err != nil {
-			s.logger.Error(fmt.Sprintf("error while checking for certificate expiration: %v", err))
-			return
-		}
+	// with an empty window only expired certificates are returned
+	expiring, expired, err := s.GetExpiringCerts(window)
+	if err != nil {
+		s.logger.Error(fmt.Sprintf("error while checking for certificate expiration: %v", err))
+	} else {
 		if s.metricsHandler != nil {
 			s.metricsHandler.Gauge(metrics.TlsCertsExpired.GetMetricName()).Record(float64(len(expired)))
 			s.metricsHandler.Gauge(metrics.TlsCertsExpiring.GetMetricName()).Record(float64(len(expiring)))
 		}
-		s.logCerts(expired, true, errorTime)
-		s.logCerts(expiring, false, errorTime)
+		if window != 0 {
+			s.logCerts(expired, true, errorTime)
+			s.logCerts(expiring, false, errorTime)
+		}
+	}
+	s.recordCertExpirations()
+}
+
+// recordCertExpirations emits the time until the soonest expiration of the certificates of each
+// config group and common name. Certificates are not tagged by thumbprint so rotations don't grow the
+// number of series.
+func (s *localStoreTlsProvider) recordCertExpirations() {
+	if s.metricsHandler == nil {
+		return
+	}
+	certificates, err := s.GetCertificates()
+	if err != nil {
+		s.logger.Error("error while listing certificates", tag.Error(err))
+	}
+	type certKey struct {
+		group      string
+		commonName string
+	}
+	expirations := make(map[certKey]time.Time)
+	for _, cert := range certificates {
+		key := certKey{group: cert.Group, commonName: cert.CommonName}
+		if expiration, ok := expirations[key]; !ok || cert.ExpirationTime.Before(expiration) {
+			expirations[key] = *cert.ExpirationTime
+		}
+	}
+	now := time.Now().UTC()
+	for key, expiration := range expirations {
+		s.metricsHandler.Gauge(metrics.TlsCertExpirationSeconds.GetMetricName()).Record(
+			expiration.Sub(now).Seconds(),
+			metrics.StringTag("tls_cert_group", key.group),
+			metrics.StringTag("tls_cert_common_name", key.commonName),
+		)
 	}
 }
 
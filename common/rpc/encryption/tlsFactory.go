@@ -34,6 +34,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 
+	clusterspb "go.temporal.io/server/api/cluster/v1"
 	"go.temporal.io/server/common/config"
 )
 
@@ -69,6 +70,7 @@ type (
 	CertExpirationData struct {
 		Thumbprint CertThumbprint
 		IsCA       bool
+		CommonName string
 		DNSNames   []string
 		Expiration time.Time
 	}
@@ -79,6 +81,11 @@ type (
 		GetExpiringCerts(timeWindow time.Duration) (expiring CertExpirationMap, expired CertExpirationMap, err error)
 	}
 
+	// CertInfoProvider lists the certificates used by a TLSConfigProvider
+	CertInfoProvider interface {
+		GetCertificates() ([]*clusterspb.CertificateInfo, error)
+	}
+
 	tlsConfigConstructor func() (*tls.Config, error)
 )
 
@@ -100,6 +107,15 @@ func NewTLSConfigProviderFromConfig(
 	return NewLocalStoreTlsProvider(&encryptionSettings, metricsHandler.WithTags(metrics.OperationTag(metrics.ServerTlsScope)), logger, certProviderFactory)
 }
 
+// GetCertificates returns the certificates used by a TLS config provider, or nil if the provider
+// can't list them
+func GetCertificates(provider TLSConfigProvider) ([]*clusterspb.CertificateInfo, error) {
+	if infoProvider, ok := provider.(CertInfoProvider); ok {
+		return infoProvider.GetCertificates()
+	}
+	return nil, nil
+}
+
 func validateRootTLS(cfg *config.RootTLS) error {
 	if err := validateGroupTLS(&cfg.Internode); err != nil {
 		return err
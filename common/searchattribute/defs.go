@@ -64,6 +64,9 @@ const (
 	// Used by scheduler workflow.
 	TemporalSchedulePaused = "TemporalSchedulePaused"
 
+	// Set while a workflow execution is paused.
+	TemporalWorkflowPaused = "TemporalWorkflowPaused"
+
 	ReservedPrefix = "Temporal"
 )
 
@@ -93,6 +96,7 @@ var (
 		TemporalScheduledStartTime: enumspb.INDEXED_VALUE_TYPE_DATETIME,
 		TemporalScheduledById:      enumspb.INDEXED_VALUE_TYPE_KEYWORD,
 		TemporalSchedulePaused:     enumspb.INDEXED_VALUE_TYPE_BOOL,
+		TemporalWorkflowPaused:     enumspb.INDEXED_VALUE_TYPE_BOOL,
 		TemporalNamespaceDivision:  enumspb.INDEXED_VALUE_TYPE_KEYWORD,
 	}
 
@@ -0,0 +1,4 @@
+ALTER TABLE executions_visibility
+  ADD COLUMN TemporalWorkflowPaused BOOLEAN GENERATED ALWAYS AS (search_attributes->"$.TemporalWorkflowPaused");
+
+CREATE INDEX by_temporal_workflow_paused ON executions_visibility (namespace_id, TemporalWorkflowPaused, (COALESCE(close_time, CAST('9999-12-31 23:59:59' AS DATETIME))) DESC, start_time DESC, run_id);
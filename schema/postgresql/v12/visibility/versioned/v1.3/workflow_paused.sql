@@ -0,0 +1,4 @@
+ALTER TABLE executions_visibility
+  ADD COLUMN TemporalWorkflowPaused BOOLEAN GENERATED ALWAYS AS ((search_attributes->'TemporalWorkflowPaused')::boolean) STORED;
+
+CREATE INDEX by_temporal_workflow_paused ON executions_visibility (namespace_id, TemporalWorkflowPaused, (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
@@ -29,6 +29,8 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"sort"
+	"strconv"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -58,12 +60,14 @@ import (
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
 	tokenspb "go.temporal.io/server/api/token/v1"
+	workflowspb "go.temporal.io/server/api/workflow/v1"
 	serverClient "go.temporal.io/server/client"
 	"go.temporal.io/server/client/admin"
 	"go.temporal.io/server/client/frontend"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/provider"
+	"go.temporal.io/server/common/authorization"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/convert"
@@ -74,6 +78,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	persistenceClient "go.temporal.io/server/common/persistence/client"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/persistence/visibility/manager"
@@ -81,6 +86,7 @@ import (
 	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
 	"go.temporal.io/server/common/persistence/visibility/store/standard/cassandra"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/rpc/encryption"
 	"go.temporal.io/server/common/sdk"
 	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/common/xdc"
@@ -93,6 +99,9 @@ const (
 	getNamespaceReplicationMessageBatchSize = 100
 	defaultLastMessageID                    = -1
 	listClustersPageSize                    = 100
+	listAuditRecordsPageSize                = 1000
+	listAuditRecordsMaxReads                = 10
+	maxPersistenceFaultTTL                  = 24 * time.Hour
 )
 
 type (
@@ -102,9 +111,11 @@ type (
 
 		logger                      log.Logger
 		numberOfHistoryShards       int32
+		persistenceFaultsAllowed    bool
 		ESClient                    esclient.Client
 		config                      *Config
 		namespaceDLQHandler         namespace.DLQMessageHandler
+		namespaceReplicator         namespace.Replicator
 		eventSerializer             serialization.Serializer
 		visibilityMgr               manager.VisibilityManager
 		persistenceExecutionManager persistence.ExecutionManager
@@ -123,6 +134,10 @@ type (
 		saManager                   searchattribute.Manager
 		clusterMetadata             cluster.Metadata
 		healthServer                *health.Server
+		auditLogManager             persistence.AuditLogManager
+		apiKeyUsageManager          persistence.APIKeyUsageManager
+		tlsConfigProvider           encryption.TLSConfigProvider
+		timeSource                  clock.TimeSource
 	}
 
 	NewAdminHandlerArgs struct {
@@ -152,6 +167,10 @@ type (
 		HealthServer                        *health.Server
 		EventSerializer                     serialization.Serializer
 		TimeSource                          clock.TimeSource
+		AuditLogManager                     persistence.AuditLogManager
+		TLSConfigProvider                   encryption.TLSConfigProvider
+		APIKeyUsageManager                  persistence.APIKeyUsageManager
+		NamespaceIndexManager               *esclient.NamespaceIndexManager
 	}
 )
 
@@ -168,19 +187,22 @@ func NewAdminHandler(
 	namespaceReplicationTaskExecutor := namespace.NewReplicationTaskExecutor(
 		args.ClusterMetadata.GetCurrentClusterName(),
 		args.PersistenceMetadataManager,
+		args.NamespaceIndexManager,
 		args.Logger,
 	)
 
 	return &AdminHandler{
-		logger:                args.Logger,
-		status:                common.DaemonStatusInitialized,
-		numberOfHistoryShards: args.PersistenceConfig.NumHistoryShards,
-		config:                args.Config,
+		logger:                   args.Logger,
+		status:                   common.DaemonStatusInitialized,
+		numberOfHistoryShards:    args.PersistenceConfig.NumHistoryShards,
+		persistenceFaultsAllowed: args.PersistenceConfig.RuntimeFaultInjectionEnabled(),
+		config:                   args.Config,
 		namespaceDLQHandler: namespace.NewDLQMessageHandler(
 			namespaceReplicationTaskExecutor,
 			args.NamespaceReplicationQueue,
 			args.Logger,
 		),
+		namespaceReplicator:         namespace.NewNamespaceReplicator(args.ReplicatorNamespaceReplicationQueue, args.Logger),
 		eventSerializer:             args.EventSerializer,
 		visibilityMgr:               args.VisibilityMrg,
 		ESClient:                    args.EsClient,
@@ -200,6 +222,10 @@ func NewAdminHandler(
 		saManager:                   args.SaManager,
 		clusterMetadata:             args.ClusterMetadata,
 		healthServer:                args.HealthServer,
+		auditLogManager:             args.AuditLogManager,
+		apiKeyUsageManager:          args.APIKeyUsageManager,
+		tlsConfigProvider:           args.TLSConfigProvider,
+		timeSource:                  args.TimeSource,
 	}
 }
 
@@ -829,6 +855,7 @@ func (adh *AdminHandler) DescribeHistoryHost(ctx context.Context, request *admin
 		ShardIds:       resp.GetShardIds(),
 		NamespaceCache: resp.GetNamespaceCache(),
 		Address:        resp.GetAddress(),
+		Certificates:   resp.GetCertificates(),
 	}, err
 }
 
@@ -1030,6 +1057,11 @@ func (adh *AdminHandler) DescribeCluster(
 		return nil, err
 	}
 
+	certificates, err := encryption.GetCertificates(adh.tlsConfigProvider)
+	if err != nil {
+		adh.logger.Warn("Unable to list TLS certificates", tag.Error(err))
+	}
+
 	return &adminservice.DescribeClusterResponse{
 		SupportedClients:         headers.SupportedClients,
 		ServerVersion:            headers.ServerVersion,
@@ -1043,6 +1075,8 @@ func (adh *AdminHandler) DescribeCluster(
 		FailoverVersionIncrement: metadata.FailoverVersionIncrement,
 		InitialFailoverVersion:   metadata.InitialFailoverVersion,
 		IsGlobalNamespaceEnabled: metadata.IsGlobalNamespaceEnabled,
+		Certificates:             certificates,
+		PersistenceFaults:        activePersistenceFaults(adh.timeSource.Now(), metadata.PersistenceFaults),
 	}, nil
 }
 
@@ -1510,6 +1544,676 @@ func (adh *AdminHandler) RefreshWorkflowTasks(
 	return &adminservice.RefreshWorkflowTasksResponse{}, nil
 }
 
+// PauseWorkflowExecution pauses a workflow execution
+func (adh *AdminHandler) PauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.PauseWorkflowExecutionRequest,
+) (_ *adminservice.PauseWorkflowExecutionResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = adh.historyClient.PauseWorkflowExecution(ctx, &historyservice.PauseWorkflowExecutionRequest{
+		NamespaceId: namespaceID.String(),
+		Execution:   request.Execution,
+		Reason:      request.GetReason(),
+		Identity:    request.GetIdentity(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.PauseWorkflowExecutionResponse{}, nil
+}
+
+// UnpauseWorkflowExecution unpauses a workflow execution
+func (adh *AdminHandler) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.UnpauseWorkflowExecutionRequest,
+) (_ *adminservice.UnpauseWorkflowExecutionResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = adh.historyClient.UnpauseWorkflowExecution(ctx, &historyservice.UnpauseWorkflowExecutionRequest{
+		NamespaceId: namespaceID.String(),
+		Execution:   request.Execution,
+		Identity:    request.GetIdentity(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.UnpauseWorkflowExecutionResponse{}, nil
+}
+
+// PauseActivityExecution pauses a pending activity
+func (adh *AdminHandler) PauseActivityExecution(
+	ctx context.Context,
+	request *adminservice.PauseActivityExecutionRequest,
+) (_ *adminservice.PauseActivityExecutionResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+	if request.GetActivityId() == "" {
+		return nil, errActivityIDNotSet
+	}
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = adh.historyClient.PauseActivityExecution(ctx, &historyservice.PauseActivityExecutionRequest{
+		NamespaceId: namespaceID.String(),
+		Execution:   request.Execution,
+		ActivityId:  request.GetActivityId(),
+		Reason:      request.GetReason(),
+		Identity:    request.GetIdentity(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.PauseActivityExecutionResponse{}, nil
+}
+
+// UnpauseActivityExecution unpauses a paused activity
+func (adh *AdminHandler) UnpauseActivityExecution(
+	ctx context.Context,
+	request *adminservice.UnpauseActivityExecutionRequest,
+) (_ *adminservice.UnpauseActivityExecutionResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+	if request.GetActivityId() == "" {
+		return nil, errActivityIDNotSet
+	}
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = adh.historyClient.UnpauseActivityExecution(ctx, &historyservice.UnpauseActivityExecutionRequest{
+		NamespaceId: namespaceID.String(),
+		Execution:   request.Execution,
+		ActivityId:  request.GetActivityId(),
+		Identity:    request.GetIdentity(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.UnpauseActivityExecutionResponse{}, nil
+}
+
+// ResetActivityExecution resets the attempt count of a pending activity
+func (adh *AdminHandler) ResetActivityExecution(
+	ctx context.Context,
+	request *adminservice.ResetActivityExecutionRequest,
+) (_ *adminservice.ResetActivityExecutionResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+	if request.GetActivityId() == "" {
+		return nil, errActivityIDNotSet
+	}
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = adh.historyClient.ResetActivityExecution(ctx, &historyservice.ResetActivityExecutionRequest{
+		NamespaceId: namespaceID.String(),
+		Execution:   request.Execution,
+		ActivityId:  request.GetActivityId(),
+		Identity:    request.GetIdentity(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.ResetActivityExecutionResponse{}, nil
+}
+
+// UpdateActivityExecutionOptions updates the retry policy and timeouts of a pending activity
+func (adh *AdminHandler) UpdateActivityExecutionOptions(
+	ctx context.Context,
+	request *adminservice.UpdateActivityExecutionOptionsRequest,
+) (_ *adminservice.UpdateActivityExecutionOptionsResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+	if request.GetActivityId() == "" {
+		return nil, errActivityIDNotSet
+	}
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = adh.historyClient.UpdateActivityExecutionOptions(ctx, &historyservice.UpdateActivityExecutionOptionsRequest{
+		NamespaceId: namespaceID.String(),
+		Execution:   request.Execution,
+		ActivityId:  request.GetActivityId(),
+		Options:     request.GetOptions(),
+		Identity:    request.GetIdentity(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.UpdateActivityExecutionOptionsResponse{}, nil
+}
+
+// ListAuditRecords lists the audit records written by the persistence audit sink. Records are listed
+// partition by partition, in the order they were written to each partition.
+func (adh *AdminHandler) ListAuditRecords(
+	ctx context.Context,
+	request *adminservice.ListAuditRecordsRequest,
+) (_ *adminservice.ListAuditRecordsResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if adh.auditLogManager == nil {
+		return nil, errAuditLogNotInPersistence
+	}
+	pageSize := int(request.GetPageSize())
+	if pageSize <= 0 || pageSize > listAuditRecordsPageSize {
+		pageSize = listAuditRecordsPageSize
+	}
+	partition := 0
+	lastMessageID := persistence.EmptyQueueMessageID
+	if len(request.NextPageToken) > 0 {
+		partition, lastMessageID, err = parseAuditRecordsPageToken(request.NextPageToken)
+		if err != nil {
+			return nil, errInvalidNextPageToken
+		}
+	}
+
+	var records []*persistencespb.AuditRecord
+	// records are filtered after they are read, so bound the number of reads for a single page
+	for i := 0; i < listAuditRecordsMaxReads && len(records) < pageSize && partition < persistence.AuditLogQueuePartitions; i++ {
+		// each message holds a batch of records, so a page may hold a few more records than its size
+		batch, batchLastMessageID, err := adh.auditLogManager.ReadRecords(ctx, partition, lastMessageID, pageSize-len(records))
+		if err != nil {
+			return nil, err
+		}
+		if batchLastMessageID == lastMessageID {
+			partition++
+			lastMessageID = persistence.EmptyQueueMessageID
+			continue
+		}
+		lastMessageID = batchLastMessageID
+		for _, record := range batch {
+			if auditRecordMatches(record, request) {
+				records = append(records, record)
+			}
+		}
+	}
+
+	response := &adminservice.ListAuditRecordsResponse{Records: records}
+	if partition < persistence.AuditLogQueuePartitions {
+		response.NextPageToken = []byte(fmt.Sprintf("%d:%d", partition, lastMessageID))
+	}
+	return response, nil
+}
+
+func parseAuditRecordsPageToken(token []byte) (int, int64, error) {
+	partition, messageID, ok := strings.Cut(string(token), ":")
+	if !ok {
+		return 0, 0, errInvalidNextPageToken
+	}
+	p, err := strconv.Atoi(partition)
+	if err != nil || p < 0 || p >= persistence.AuditLogQueuePartitions {
+		return 0, 0, errInvalidNextPageToken
+	}
+	id, err := strconv.ParseInt(messageID, 10, 64)
+	if err != nil {
+		return 0, 0, errInvalidNextPageToken
+	}
+	return p, id, nil
+}
+
+func auditRecordMatches(record *persistencespb.AuditRecord, request *adminservice.ListAuditRecordsRequest) bool {
+	if request.GetNamespace() != "" && record.GetNamespace() != request.GetNamespace() {
+		return false
+	}
+	if request.GetPrincipal() != "" && record.GetPrincipal() != request.GetPrincipal() {
+		return false
+	}
+	if request.GetApiName() != "" && authorization.ApiName(record.GetApiName()) != authorization.ApiName(request.GetApiName()) {
+		return false
+	}
+	recordTime := timestamp.TimeValue(record.GetTime())
+	if request.StartTime != nil && recordTime.Before(*request.StartTime) {
+		return false
+	}
+	if request.EndTime != nil && recordTime.After(*request.EndTime) {
+		return false
+	}
+	return true
+}
+
+// GetReplicationStatus returns the replication lag, DLQ size and processor state between this cluster
+// and the remote clusters, summarized over all shards
+func (adh *AdminHandler) GetReplicationStatus(
+	ctx context.Context,
+	request *adminservice.GetReplicationStatusRequest,
+) (_ *adminservice.GetReplicationStatusResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	for _, clusterName := range request.GetRemoteClusters() {
+		if clusterName == adh.clusterMetadata.GetCurrentClusterName() {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("%v is the current cluster", clusterName))
+		}
+		if _, ok := adh.clusterMetadata.GetAllClusterInfo()[clusterName]; !ok {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("unknown cluster %v", clusterName))
+		}
+	}
+
+	resp, err := adh.historyClient.GetReplicationStatus(ctx, &historyservice.GetReplicationStatusRequest{
+		RemoteClusters: request.GetRemoteClusters(),
+		IncludeInbound: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return summarizeReplicationStatus(resp.GetShards(), request.GetIncludeShards()), nil
+}
+
+func summarizeReplicationStatus(
+	shards []*historyservice.ShardReplicationStatus,
+	includeShards bool,
+) *adminservice.GetReplicationStatusResponse {
+	response := &adminservice.GetReplicationStatusResponse{
+		RemoteClusters: make(map[string]*adminservice.ClusterReplicationStatus),
+	}
+	clusterStatus := func(clusterName string) *adminservice.ClusterReplicationStatus {
+		status, ok := response.RemoteClusters[clusterName]
+		if !ok {
+			status = &adminservice.ClusterReplicationStatus{}
+			response.RemoteClusters[clusterName] = status
+		}
+		return status
+	}
+	maxDuration := func(current *time.Duration, d *time.Duration) *time.Duration {
+		if d == nil || (current != nil && *current >= *d) {
+			return current
+		}
+		return timestamp.DurationPtr(*d)
+	}
+
+	for _, shard := range shards {
+		shardStatus := &adminservice.ShardReplicationStatus{
+			ShardId:                          shard.GetShardId(),
+			MaxReplicationTaskId:             shard.GetMaxReplicationTaskId(),
+			MaxReplicationTaskVisibilityTime: shard.GetMaxReplicationTaskVisibilityTime(),
+			RemoteClusters:                   make(map[string]*adminservice.ShardClusterReplicationStatus),
+		}
+		shardClusterStatus := func(clusterName string) *adminservice.ShardClusterReplicationStatus {
+			status, ok := shardStatus.RemoteClusters[clusterName]
+			if !ok {
+				status = &adminservice.ShardClusterReplicationStatus{}
+				shardStatus.RemoteClusters[clusterName] = status
+				clusterStatus(clusterName).ShardCount++
+			}
+			return status
+		}
+
+		for clusterName, outbound := range shard.GetRemoteClusters() {
+			status := shardClusterStatus(clusterName)
+			status.AckedTaskId = outbound.GetAckedTaskId()
+			status.AckedTaskVisibilityTime = outbound.GetAckedTaskVisibilityTime()
+			status.OutboundLag = outbound.GetLag()
+
+			summary := clusterStatus(clusterName)
+			summary.MaxOutboundLag = maxDuration(summary.MaxOutboundLag, outbound.GetLag())
+		}
+		for clusterName, inbound := range shard.GetInboundClusters() {
+			shardClusterStatus(clusterName).Inbound = inbound
+
+			summary := clusterStatus(clusterName)
+			summary.InboundDlqTaskCount += inbound.GetDlqTaskCount()
+			for _, processor := range inbound.GetProcessors() {
+				summary.MaxInboundLag = maxDuration(summary.MaxInboundLag, processor.GetLag())
+				switch processor.GetState() {
+				case enumsspb.REPLICATION_PROCESSOR_STATE_RETRYING:
+					summary.RetryingProcessorCount++
+				case enumsspb.REPLICATION_PROCESSOR_STATE_STOPPED:
+					summary.StoppedProcessorCount++
+				}
+			}
+		}
+
+		for namespaceName, handover := range shard.GetHandoverNamespaces() {
+			if shardStatus.HandoverReplicationTaskIds == nil {
+				shardStatus.HandoverReplicationTaskIds = make(map[string]int64)
+			}
+			shardStatus.HandoverReplicationTaskIds[namespaceName] = handover.GetHandoverReplicationTaskId()
+		}
+
+		if includeShards {
+			response.Shards = append(response.Shards, shardStatus)
+		}
+	}
+
+	sort.Slice(response.Shards, func(i, j int) bool {
+		return response.Shards[i].GetShardId() < response.Shards[j].GetShardId()
+	})
+	return response
+}
+
+// CreateNamespaceApiKey issues an API key granting a role on a namespace
+func (adh *AdminHandler) CreateNamespaceApiKey(
+	ctx context.Context,
+	request *adminservice.CreateNamespaceApiKeyRequest,
+) (_ *adminservice.CreateNamespaceApiKeyResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if !adh.config.EnableNamespaceAPIKeys() {
+		return nil, errNamespaceAPIKeysNotAllowed
+	}
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
+	}
+	if request.GetName() == "" {
+		return nil, errAPIKeyNameNotSet
+	}
+	if err := authorization.ValidateAPIKeyRole(request.GetRole()); err != nil {
+		return nil, serviceerror.NewInvalidArgument(err.Error())
+	}
+	if request.Ttl != nil && *request.Ttl <= 0 {
+		return nil, errInvalidAPIKeyTTL
+	}
+	if err := checkAPIKeyPermission(ctx, request.GetNamespace(), request.GetRole()); err != nil {
+		return nil, err
+	}
+
+	var key *persistencespb.NamespaceApiKey
+	var token *authorization.APIKeyToken
+	err = adh.updateNamespaceAPIKeys(ctx, request.GetNamespace(), func(namespaceID namespace.ID, keys map[string]*persistencespb.NamespaceApiKey) error {
+		var err error
+		token, err = authorization.NewAPIKeyToken(namespaceID)
+		if err != nil {
+			return err
+		}
+		now := adh.timeSource.Now().UTC()
+		key = &persistencespb.NamespaceApiKey{
+			Id:         token.KeyID,
+			Name:       request.GetName(),
+			Role:       strings.ToLower(request.GetRole()),
+			SecretHash: token.SecretHash(),
+			CreatedBy:  request.GetIdentity(),
+			CreateTime: &now,
+			ExpireTime: apiKeyExpireTime(now, request.Ttl),
+		}
+		keys[key.Id] = key
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.CreateNamespaceApiKeyResponse{
+		ApiKey: redactAPIKey(key),
+		Token:  token.String(),
+	}, nil
+}
+
+// ListNamespaceApiKeys lists the API keys of a namespace
+func (adh *AdminHandler) ListNamespaceApiKeys(
+	ctx context.Context,
+	request *adminservice.ListNamespaceApiKeysRequest,
+) (_ *adminservice.ListNamespaceApiKeysResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
+	}
+	if err := checkAPIKeyPermission(ctx, request.GetNamespace(), ""); err != nil {
+		return nil, err
+	}
+
+	resp, err := adh.persistenceMetadataManager.GetNamespace(ctx, &persistence.GetNamespaceRequest{Name: request.GetNamespace()})
+	if err != nil {
+		return nil, err
+	}
+	// last used times are tracked by the frontends of each cluster apart from the namespace metadata
+	lastUsedTimes, err := adh.apiKeyUsageManager.GetLastUsedTimes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]*persistencespb.NamespaceApiKey, 0, len(resp.Namespace.ApiKeys))
+	for _, key := range resp.Namespace.ApiKeys {
+		key = redactAPIKey(key)
+		key.LastUsedTime = nil
+		if lastUsedTime, ok := lastUsedTimes[key.Id]; ok {
+			key.LastUsedTime = &lastUsedTime
+		}
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return timestamp.TimeValue(keys[i].CreateTime).Before(timestamp.TimeValue(keys[j].CreateTime))
+	})
+	return &adminservice.ListNamespaceApiKeysResponse{ApiKeys: keys}, nil
+}
+
+// RotateNamespaceApiKey replaces the secret of an API key
+func (adh *AdminHandler) RotateNamespaceApiKey(
+	ctx context.Context,
+	request *adminservice.RotateNamespaceApiKeyRequest,
+) (_ *adminservice.RotateNamespaceApiKeyResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if !adh.config.EnableNamespaceAPIKeys() {
+		return nil, errNamespaceAPIKeysNotAllowed
+	}
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
+	}
+	if request.GetKeyId() == "" {
+		return nil, errAPIKeyIDNotSet
+	}
+	if request.Ttl != nil && *request.Ttl <= 0 {
+		return nil, errInvalidAPIKeyTTL
+	}
+
+	var key *persistencespb.NamespaceApiKey
+	var token *authorization.APIKeyToken
+	err = adh.updateNamespaceAPIKeys(ctx, request.GetNamespace(), func(namespaceID namespace.ID, keys map[string]*persistencespb.NamespaceApiKey) error {
+		var ok bool
+		if key, ok = keys[request.GetKeyId()]; !ok {
+			return errAPIKeyNotFound
+		}
+		// the new secret grants the role of the key
+		if err := checkAPIKeyPermission(ctx, request.GetNamespace(), key.GetRole()); err != nil {
+			return err
+		}
+		token = &authorization.APIKeyToken{NamespaceID: namespaceID.String(), KeyID: key.Id}
+		if err := token.RotateSecret(); err != nil {
+			return err
+		}
+		now := adh.timeSource.Now().UTC()
+		key.SecretHash = token.SecretHash()
+		key.RotateTime = &now
+		if request.Ttl != nil {
+			key.ExpireTime = apiKeyExpireTime(now, request.Ttl)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.RotateNamespaceApiKeyResponse{
+		ApiKey: redactAPIKey(key),
+		Token:  token.String(),
+	}, nil
+}
+
+// RevokeNamespaceApiKey deletes an API key
+func (adh *AdminHandler) RevokeNamespaceApiKey(
+	ctx context.Context,
+	request *adminservice.RevokeNamespaceApiKeyRequest,
+) (_ *adminservice.RevokeNamespaceApiKeyResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
+	}
+	if request.GetKeyId() == "" {
+		return nil, errAPIKeyIDNotSet
+	}
+
+	err = adh.updateNamespaceAPIKeys(ctx, request.GetNamespace(), func(_ namespace.ID, keys map[string]*persistencespb.NamespaceApiKey) error {
+		key, ok := keys[request.GetKeyId()]
+		if !ok {
+			return errAPIKeyNotFound
+		}
+		if err := checkAPIKeyPermission(ctx, request.GetNamespace(), key.GetRole()); err != nil {
+			return err
+		}
+		delete(keys, request.GetKeyId())
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// the last used time of a revoked key is of no further use, failing to delete it only leaves a stale entry behind
+	if err := adh.apiKeyUsageManager.DeleteLastUsedTimes(ctx, []string{request.GetKeyId()}); err != nil {
+		adh.logger.Warn("Failed to delete last used time of revoked API key.", tag.NewStringTag("api-key-id", request.GetKeyId()), tag.Error(err))
+	}
+	return &adminservice.RevokeNamespaceApiKeyResponse{}, nil
+}
+
+// checkAPIKeyPermission returns an error if the caller can't manage the API keys of the namespace, or holds a
+// role below the given role of an API key. Without claims in the context, authorization is not configured.
+func checkAPIKeyPermission(ctx context.Context, namespaceName string, role string) error {
+	claims, ok := ctx.Value(authorization.MappedClaims).(*authorization.Claims)
+	if !ok {
+		return nil
+	}
+	if !authorization.CanManageAPIKeys(claims, namespaceName) {
+		return errAPIKeyManagementNotAllowed
+	}
+	if role != "" && !authorization.CanGrantAPIKeyRole(claims, namespaceName, role) {
+		return errAPIKeyRoleNotAllowed
+	}
+	return nil
+}
+
+// updateNamespaceAPIKeys applies update to the API keys of a namespace, writes back its metadata and
+// replicates the keys to the other clusters of a global namespace.
+// Frontends see the change when they refresh their namespace cache.
+func (adh *AdminHandler) updateNamespaceAPIKeys(
+	ctx context.Context,
+	namespaceName string,
+	update func(namespaceID namespace.ID, keys map[string]*persistencespb.NamespaceApiKey) error,
+) error {
+	metadata, err := adh.persistenceMetadataManager.GetMetadata(ctx)
+	if err != nil {
+		return err
+	}
+	resp, err := adh.persistenceMetadataManager.GetNamespace(ctx, &persistence.GetNamespaceRequest{Name: namespaceName})
+	if err != nil {
+		return err
+	}
+	if resp.Namespace.ApiKeys == nil {
+		resp.Namespace.ApiKeys = make(map[string]*persistencespb.NamespaceApiKey)
+	}
+	if err := update(namespace.ID(resp.Namespace.Info.Id), resp.Namespace.ApiKeys); err != nil {
+		return err
+	}
+	// drop last used times written by older versions, they are kept by the APIKeyUsageManager now
+	for _, key := range resp.Namespace.ApiKeys {
+		key.LastUsedTime = nil
+	}
+	// the config version orders the change against other namespace updates on the receiving clusters
+	resp.Namespace.ConfigVersion++
+	if err := adh.persistenceMetadataManager.UpdateNamespace(ctx, &persistence.UpdateNamespaceRequest{
+		Namespace:           resp.Namespace,
+		IsGlobalNamespace:   resp.IsGlobalNamespace,
+		NotificationVersion: metadata.NotificationVersion,
+	}); err != nil {
+		return err
+	}
+	return adh.namespaceReplicator.HandleTransmissionTask(
+		ctx,
+		enumsspb.NAMESPACE_OPERATION_UPDATE,
+		resp.Namespace.Info,
+		resp.Namespace.Config,
+		resp.Namespace.ReplicationConfig,
+		false,
+		resp.Namespace.ConfigVersion,
+		resp.Namespace.FailoverVersion,
+		resp.IsGlobalNamespace,
+		resp.Namespace.ReplicationConfig.GetFailoverHistory(),
+		resp.Namespace.ApiKeys,
+	)
+}
+
+func apiKeyExpireTime(now time.Time, ttl *time.Duration) *time.Time {
+	if ttl == nil {
+		return nil
+	}
+	expireTime := now.Add(*ttl)
+	return &expireTime
+}
+
+// redactAPIKey returns a copy of key without its secret hash
+func redactAPIKey(key *persistencespb.NamespaceApiKey) *persistencespb.NamespaceApiKey {
+	redacted := *key
+	redacted.SecretHash = ""
+	return &redacted
+}
+
 // ResendReplicationTasks requests replication task from remote cluster
 func (adh *AdminHandler) ResendReplicationTasks(
 	ctx context.Context,
@@ -1563,7 +2267,8 @@ func (adh *AdminHandler) GetTaskQueueTasks(
 		return nil, err
 	}
 
-	resp, err := adh.taskManager.GetTasks(ctx, &persistence.GetTasksRequest{
+	// tasks are only inspected here, so they may be read from a replica
+	resp, err := adh.taskManager.GetTasks(persistence.WithStaleReads(ctx), &persistence.GetTasksRequest{
 		NamespaceID:        namespaceID.String(),
 		TaskQueue:          request.GetTaskQueue(),
 		TaskType:           request.GetTaskQueueType(),
@@ -1722,6 +2427,132 @@ func (adh *AdminHandler) DeleteWorkflowExecution(
 	}, nil
 }
 
+// ResetWorkflowExecutions resets the given workflow executions to the reset point chosen by the
+// selector. A dry run only resolves the reset points, for either the given executions or one page
+// of executions matching a visibility query. Resets never page through a query: the executions it
+// matches change as they are reset, so callers snapshot the list with a dry run first. The number of
+// executions reset in one call is limited, callers reset a longer list in chunks.
+func (adh *AdminHandler) ResetWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.ResetWorkflowExecutionsRequest,
+) (_ *adminservice.ResetWorkflowExecutionsResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetResetPointSelector().GetSelector() == nil {
+		return nil, errResetPointSelectorNotSet
+	}
+	if !request.GetDryRun() && request.GetQuery() != "" {
+		return nil, errResetByQueryRequiresDryRun
+	}
+	if request.GetQuery() == "" && len(request.GetExecutions()) == 0 {
+		return nil, errExecutionsNotSet
+	}
+	if request.GetQuery() != "" && len(request.GetExecutions()) > 0 {
+		return nil, errQueryAndExecutionsBothSet
+	}
+
+	namespaceName := namespace.Name(request.GetNamespace())
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	maxPageSize := int32(adh.config.VisibilityMaxPageSize(request.GetNamespace()))
+	// executions are reset one by one within the deadline of the call
+	maxExecutions := int(maxPageSize)
+	if !request.GetDryRun() {
+		maxExecutions = adh.config.MaxResetWorkflowExecutions(request.GetNamespace())
+	}
+	if len(request.GetExecutions()) > maxExecutions {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Too many executions in request: %d, limit is %d.", len(request.GetExecutions()), maxExecutions))
+	}
+	if request.GetMaximumPageSize() <= 0 || request.GetMaximumPageSize() > maxPageSize {
+		request.MaximumPageSize = maxPageSize
+	}
+
+	executions := request.GetExecutions()
+	var nextPageToken []byte
+	if request.GetQuery() != "" {
+		listResp, err := adh.visibilityMgr.ListWorkflowExecutions(ctx, &manager.ListWorkflowExecutionsRequestV2{
+			NamespaceID:   namespaceID,
+			Namespace:     namespaceName,
+			PageSize:      int(request.GetMaximumPageSize()),
+			NextPageToken: request.GetNextPageToken(),
+			Query:         request.GetQuery(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, execution := range listResp.Executions {
+			executions = append(executions, execution.GetExecution())
+		}
+		nextPageToken = listResp.NextPageToken
+	}
+
+	if !request.GetDryRun() {
+		adh.logger.Info("Resetting workflow executions",
+			tag.WorkflowNamespace(request.GetNamespace()),
+			tag.NewStringTag("identity", request.GetIdentity()),
+			tag.Counter(len(executions)))
+	}
+
+	results := make([]*workflowspb.ResetPointResolution, 0, len(executions))
+	for _, execution := range executions {
+		result := &workflowspb.ResetPointResolution{
+			Execution: execution,
+		}
+		results = append(results, result)
+
+		resolveResp, err := adh.historyClient.ResolveResetPoint(ctx, &historyservice.ResolveResetPointRequest{
+			NamespaceId:        namespaceID.String(),
+			Execution:          execution,
+			ResetPointSelector: request.GetResetPointSelector(),
+		})
+		if err != nil {
+			result.Error = err.Error()
+			continue
+		}
+		result.WorkflowTaskFinishEventId = resolveResp.GetWorkflowTaskFinishEventId()
+		result.DiscardedEventCount = resolveResp.GetDiscardedEventCount()
+		if request.GetDryRun() {
+			continue
+		}
+
+		resetResp, err := adh.historyClient.ResetWorkflowExecution(ctx, &historyservice.ResetWorkflowExecutionRequest{
+			NamespaceId: namespaceID.String(),
+			ResetRequest: &workflowservice.ResetWorkflowExecutionRequest{
+				Namespace: request.GetNamespace(),
+				WorkflowExecution: &commonpb.WorkflowExecution{
+					WorkflowId: execution.GetWorkflowId(),
+					RunId:      resolveResp.GetRunId(),
+				},
+				Reason:                    request.GetReason(),
+				WorkflowTaskFinishEventId: resolveResp.GetWorkflowTaskFinishEventId(),
+				RequestId:                 uuid.New(),
+				ResetReapplyType:          request.GetResetReapplyType(),
+			},
+		})
+		if err != nil {
+			adh.logger.Warn("Failed to reset workflow execution",
+				tag.WorkflowNamespace(request.GetNamespace()),
+				tag.WorkflowID(execution.GetWorkflowId()),
+				tag.WorkflowRunID(resolveResp.GetRunId()),
+				tag.Error(err))
+			result.Error = err.Error()
+			continue
+		}
+		result.ResetRunId = resetResp.GetRunId()
+	}
+
+	return &adminservice.ResetWorkflowExecutionsResponse{
+		Results:       results,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
 func (adh *AdminHandler) validateGetWorkflowExecutionRawHistoryV2Request(
 	request *adminservice.GetWorkflowExecutionRawHistoryV2Request,
 ) error {
@@ -1912,3 +2743,112 @@ func (adh *AdminHandler) getWorkflowCompletionEvent(
 
 	return nil, serviceerror.NewInternal("Unable to find closed event for workflow")
 }
+
+// SetPersistenceFault adds or replaces a fault injected into the persistence layer of the current cluster
+func (adh *AdminHandler) SetPersistenceFault(
+	ctx context.Context,
+	request *adminservice.SetPersistenceFaultRequest,
+) (_ *adminservice.SetPersistenceFaultResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if !adh.persistenceFaultsAllowed {
+		return nil, errPersistenceFaultsNotAllowed
+	}
+	if err := persistenceClient.ValidatePersistenceFault(request.GetFault()); err != nil {
+		return nil, err
+	}
+	ttl := timestamp.DurationValue(request.GetTtl())
+	if ttl <= 0 || ttl > maxPersistenceFaultTTL {
+		return nil, errInvalidPersistenceFaultTTL
+	}
+
+	fault := *request.GetFault()
+	if fault.Id == "" {
+		fault.Id = uuid.New()
+	}
+	expireTime := adh.timeSource.Now().UTC().Add(ttl)
+	fault.ExpireTime = &expireTime
+
+	err := adh.updatePersistenceFaults(ctx, func(faults []*persistencespb.PersistenceFault) ([]*persistencespb.PersistenceFault, error) {
+		for i, f := range faults {
+			if f.Id == fault.Id {
+				faults[i] = &fault
+				return faults, nil
+			}
+		}
+		return append(faults, &fault), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.SetPersistenceFaultResponse{Fault: &fault}, nil
+}
+
+// RemovePersistenceFault removes a fault injected into the persistence layer of the current cluster
+func (adh *AdminHandler) RemovePersistenceFault(
+	ctx context.Context,
+	request *adminservice.RemovePersistenceFaultRequest,
+) (_ *adminservice.RemovePersistenceFaultResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetId() == "" {
+		return nil, errPersistenceFaultIDNotSet
+	}
+
+	err := adh.updatePersistenceFaults(ctx, func(faults []*persistencespb.PersistenceFault) ([]*persistencespb.PersistenceFault, error) {
+		for i, f := range faults {
+			if f.Id == request.GetId() {
+				return append(faults[:i], faults[i+1:]...), nil
+			}
+		}
+		return nil, errPersistenceFaultNotFound
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.RemovePersistenceFaultResponse{}, nil
+}
+
+// updatePersistenceFaults updates the persistence faults stored in the current cluster metadata, dropping the
+// expired ones. The hosts of all services pick up the change when they refresh the faults.
+func (adh *AdminHandler) updatePersistenceFaults(
+	ctx context.Context,
+	update func(faults []*persistencespb.PersistenceFault) ([]*persistencespb.PersistenceFault, error),
+) error {
+	resp, err := adh.clusterMetadataManager.GetCurrentClusterMetadata(ctx)
+	if err != nil {
+		return err
+	}
+	faults, err := update(activePersistenceFaults(adh.timeSource.Now(), resp.PersistenceFaults))
+	if err != nil {
+		return err
+	}
+	resp.PersistenceFaults = faults
+	applied, err := adh.clusterMetadataManager.SaveClusterMetadata(ctx, &persistence.SaveClusterMetadataRequest{
+		ClusterMetadata: resp.ClusterMetadata,
+		Version:         resp.Version,
+	})
+	if err != nil {
+		return err
+	}
+	if !applied {
+		return serviceerror.NewUnavailable("Cluster metadata was updated concurrently, please retry.")
+	}
+	return nil
+}
+
+func activePersistenceFaults(now time.Time, faults []*persistencespb.PersistenceFault) []*persistencespb.PersistenceFault {
+	var active []*persistencespb.PersistenceFault
+	for _, fault := range faults {
+		if now.Before(timestamp.TimeValue(fault.ExpireTime)) {
+			active = append(active, fault)
+		}
+	}
+	return active
+}
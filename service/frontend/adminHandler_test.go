@@ -40,6 +40,8 @@ import (
 	"go.temporal.io/server/api/adminservicemock/v1"
 	"go.temporal.io/server/api/enums/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	replicationspb "go.temporal.io/server/api/replication/v1"
+	workflowspb "go.temporal.io/server/api/workflow/v1"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -53,6 +55,7 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
 
 	"go.temporal.io/server/api/adminservice/v1"
@@ -61,7 +64,9 @@ import (
 	"go.temporal.io/server/api/historyservicemock/v1"
 	clientmocks "go.temporal.io/server/client"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/authorization"
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
@@ -133,6 +138,10 @@ func (s *adminHandlerSuite) SetupTest() {
 
 	persistenceConfig := &config.Persistence{
 		NumHistoryShards: 1,
+		DefaultStore:     "default",
+		DataStores: map[string]config.DataStore{
+			"default": {FaultInjection: &config.FaultInjection{AllowRuntimeFaults: true}},
+		},
 	}
 
 	cfg := &Config{
@@ -165,6 +174,10 @@ func (s *adminHandlerSuite) SetupTest() {
 		health.NewServer(),
 		serialization.NewSerializer(),
 		clock.NewRealTimeSource(),
+		nil,
+		nil,
+		persistence.NewMockAPIKeyUsageManager(s.controller),
+		nil,
 	}
 	s.mockMetadata.EXPECT().GetCurrentClusterName().Return(uuid.New()).AnyTimes()
 	s.handler = NewAdminHandler(args)
@@ -1173,6 +1186,10 @@ func (s *adminHandlerSuite) Test_DescribeCluster_CurrentCluster_Success() {
 				FailoverVersionIncrement: 0,
 				InitialFailoverVersion:   0,
 				IsGlobalNamespaceEnabled: true,
+				PersistenceFaults: []*persistencespb.PersistenceFault{
+					{Id: "active", ErrorRate: 1, ExpireTime: timestamp.TimePtr(time.Now().Add(time.Hour))},
+					{Id: "expired", ErrorRate: 1, ExpireTime: timestamp.TimePtr(time.Now().Add(-time.Hour))},
+				},
 			},
 			Version: 1,
 		}, nil)
@@ -1185,6 +1202,8 @@ func (s *adminHandlerSuite) Test_DescribeCluster_CurrentCluster_Success() {
 	s.Equal(resp.GetFailoverVersionIncrement(), int64(0))
 	s.Equal(resp.GetInitialFailoverVersion(), int64(0))
 	s.True(resp.GetIsGlobalNamespaceEnabled())
+	s.Len(resp.GetPersistenceFaults(), 1)
+	s.Equal("active", resp.GetPersistenceFaults()[0].GetId())
 }
 
 func (s *adminHandlerSuite) Test_DescribeCluster_NonCurrentCluster_Success() {
@@ -1444,3 +1463,357 @@ func (s *adminHandlerSuite) TestDeleteWorkflowExecution_CassandraVisibilityBacke
 	_, err = s.handler.DeleteWorkflowExecution(context.Background(), request)
 	s.NoError(err)
 }
+
+func (s *adminHandlerSuite) Test_GetReplicationStatus_Summary() {
+	s.mockHistoryClient.EXPECT().GetReplicationStatus(gomock.Any(), &historyservice.GetReplicationStatusRequest{
+		IncludeInbound: true,
+	}).Return(&historyservice.GetReplicationStatusResponse{
+		Shards: []*historyservice.ShardReplicationStatus{
+			{
+				ShardId: 2,
+				RemoteClusters: map[string]*historyservice.ShardReplicationStatusPerCluster{
+					cluster.TestAlternativeClusterName: {AckedTaskId: 5, Lag: timestamp.DurationPtr(time.Second)},
+				},
+				InboundClusters: map[string]*replicationspb.ShardReplicationInboundStatus{
+					cluster.TestAlternativeClusterName: {
+						Processors: []*replicationspb.ReplicationProcessorStatus{
+							{State: enums.REPLICATION_PROCESSOR_STATE_RETRYING, Lag: timestamp.DurationPtr(time.Minute)},
+						},
+						DlqTaskCount: 3,
+					},
+				},
+			},
+			{
+				ShardId: 1,
+				RemoteClusters: map[string]*historyservice.ShardReplicationStatusPerCluster{
+					cluster.TestAlternativeClusterName: {AckedTaskId: 7, Lag: timestamp.DurationPtr(time.Hour)},
+				},
+				InboundClusters: map[string]*replicationspb.ShardReplicationInboundStatus{
+					cluster.TestAlternativeClusterName: {
+						Processors: []*replicationspb.ReplicationProcessorStatus{
+							{State: enums.REPLICATION_PROCESSOR_STATE_STOPPED, Lag: timestamp.DurationPtr(time.Second)},
+						},
+						DlqTaskCount: 4,
+					},
+				},
+			},
+		},
+	}, nil)
+
+	resp, err := s.handler.GetReplicationStatus(context.Background(), &adminservice.GetReplicationStatusRequest{
+		IncludeShards: true,
+	})
+	s.NoError(err)
+	s.Equal(&adminservice.ClusterReplicationStatus{
+		ShardCount:             2,
+		MaxOutboundLag:         timestamp.DurationPtr(time.Hour),
+		MaxInboundLag:          timestamp.DurationPtr(time.Minute),
+		InboundDlqTaskCount:    7,
+		RetryingProcessorCount: 1,
+		StoppedProcessorCount:  1,
+	}, resp.RemoteClusters[cluster.TestAlternativeClusterName])
+	s.Len(resp.Shards, 2)
+	s.Equal(int32(1), resp.Shards[0].ShardId)
+	s.Equal(int64(7), resp.Shards[0].RemoteClusters[cluster.TestAlternativeClusterName].AckedTaskId)
+	s.Equal(int64(4), resp.Shards[0].RemoteClusters[cluster.TestAlternativeClusterName].Inbound.DlqTaskCount)
+}
+
+func (s *adminHandlerSuite) Test_GetReplicationStatus_UnknownCluster() {
+	s.mockMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestAllClusterInfo).AnyTimes()
+	_, err := s.handler.GetReplicationStatus(context.Background(), &adminservice.GetReplicationStatusRequest{
+		RemoteClusters: []string{"unknown-cluster"},
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
+func (s *adminHandlerSuite) Test_SetPersistenceFault() {
+	existing := &persistencespb.PersistenceFault{
+		Id:         "existing",
+		ErrorRate:  0.1,
+		ExpireTime: timestamp.TimePtr(time.Now().Add(time.Hour)),
+	}
+	expired := &persistencespb.PersistenceFault{
+		Id:         "expired",
+		ErrorRate:  0.1,
+		ExpireTime: timestamp.TimePtr(time.Now().Add(-time.Hour)),
+	}
+	s.mockClusterMetadataManager.EXPECT().GetCurrentClusterMetadata(gomock.Any()).Return(
+		&persistence.GetClusterMetadataResponse{
+			ClusterMetadata: persistencespb.ClusterMetadata{
+				ClusterName:       cluster.TestCurrentClusterName,
+				PersistenceFaults: []*persistencespb.PersistenceFault{existing, expired},
+			},
+			Version: 3,
+		}, nil)
+	var saved *persistence.SaveClusterMetadataRequest
+	s.mockClusterMetadataManager.EXPECT().SaveClusterMetadata(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.SaveClusterMetadataRequest) (bool, error) {
+			saved = request
+			return true, nil
+		})
+
+	resp, err := s.handler.SetPersistenceFault(context.Background(), &adminservice.SetPersistenceFaultRequest{
+		Fault: &persistencespb.PersistenceFault{
+			Service:   "history",
+			DataStore: "ExecutionStore",
+			Latency:   timestamp.DurationPtr(time.Second),
+		},
+		Ttl: timestamp.DurationPtr(time.Minute),
+	})
+	s.NoError(err)
+	s.NotEmpty(resp.Fault.Id)
+	s.WithinDuration(time.Now().Add(time.Minute), *resp.Fault.ExpireTime, 10*time.Second)
+	s.Equal(int64(3), saved.Version)
+	s.Equal([]*persistencespb.PersistenceFault{existing, resp.Fault}, saved.PersistenceFaults)
+}
+
+func (s *adminHandlerSuite) Test_SetPersistenceFault_InvalidRequest() {
+	_, err := s.handler.SetPersistenceFault(context.Background(), &adminservice.SetPersistenceFaultRequest{
+		Fault: &persistencespb.PersistenceFault{ErrorRate: 2},
+		Ttl:   timestamp.DurationPtr(time.Minute),
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	_, err = s.handler.SetPersistenceFault(context.Background(), &adminservice.SetPersistenceFaultRequest{
+		Fault: &persistencespb.PersistenceFault{ErrorRate: 1},
+		Ttl:   timestamp.DurationPtr(48 * time.Hour),
+	})
+	s.Equal(errInvalidPersistenceFaultTTL, err)
+}
+
+func (s *adminHandlerSuite) Test_SetPersistenceFault_NotAllowed() {
+	s.handler.persistenceFaultsAllowed = false
+	_, err := s.handler.SetPersistenceFault(context.Background(), &adminservice.SetPersistenceFaultRequest{
+		Fault: &persistencespb.PersistenceFault{ErrorRate: 1},
+		Ttl:   timestamp.DurationPtr(time.Minute),
+	})
+	s.Equal(errPersistenceFaultsNotAllowed, err)
+}
+
+func (s *adminHandlerSuite) Test_CreateNamespaceApiKey_NamespaceWriter() {
+	s.handler.config.EnableNamespaceAPIKeys = dynamicconfig.GetBoolPropertyFn(true)
+	claims := &authorization.Claims{Namespaces: map[string]authorization.Role{s.namespace.String(): authorization.RoleWriter}}
+	ctx := context.WithValue(context.Background(), authorization.MappedClaims, claims)
+	_, err := s.handler.CreateNamespaceApiKey(ctx, &adminservice.CreateNamespaceApiKeyRequest{
+		Namespace: s.namespace.String(),
+		Name:      "key",
+		Role:      "admin",
+	})
+	s.Equal(errAPIKeyManagementNotAllowed, err)
+}
+
+func (s *adminHandlerSuite) Test_RemovePersistenceFault() {
+	fault := &persistencespb.PersistenceFault{
+		Id:         "fault",
+		ErrorRate:  0.1,
+		ExpireTime: timestamp.TimePtr(time.Now().Add(time.Hour)),
+	}
+	s.mockClusterMetadataManager.EXPECT().GetCurrentClusterMetadata(gomock.Any()).Return(
+		&persistence.GetClusterMetadataResponse{
+			ClusterMetadata: persistencespb.ClusterMetadata{
+				ClusterName:       cluster.TestCurrentClusterName,
+				PersistenceFaults: []*persistencespb.PersistenceFault{fault},
+			},
+			Version: 3,
+		}, nil).Times(2)
+	s.mockClusterMetadataManager.EXPECT().SaveClusterMetadata(gomock.Any(), &persistence.SaveClusterMetadataRequest{
+		ClusterMetadata: persistencespb.ClusterMetadata{
+			ClusterName:       cluster.TestCurrentClusterName,
+			PersistenceFaults: []*persistencespb.PersistenceFault{},
+		},
+		Version: 3,
+	}).Return(true, nil)
+
+	_, err := s.handler.RemovePersistenceFault(context.Background(), &adminservice.RemovePersistenceFaultRequest{Id: "fault"})
+	s.NoError(err)
+
+	_, err = s.handler.RemovePersistenceFault(context.Background(), &adminservice.RemovePersistenceFaultRequest{Id: "unknown"})
+	s.Equal(errPersistenceFaultNotFound, err)
+}
+
+func (s *adminHandlerSuite) Test_ListAuditRecords() {
+	ctx := context.Background()
+	_, err := s.handler.ListAuditRecords(ctx, &adminservice.ListAuditRecordsRequest{})
+	s.Equal(errAuditLogNotInPersistence, err)
+
+	auditLogManager := persistence.NewMockAuditLogManager(s.controller)
+	s.handler.auditLogManager = auditLogManager
+	records := []*persistencespb.AuditRecord{
+		{ApiName: "/temporal.api.workflowservice.v1.WorkflowService/TerminateWorkflowExecution", Namespace: "a"},
+		{ApiName: "/temporal.api.workflowservice.v1.WorkflowService/SignalWorkflowExecution", Namespace: "b"},
+	}
+
+	// a full page stops within the partition
+	auditLogManager.EXPECT().ReadRecords(gomock.Any(), 0, persistence.EmptyQueueMessageID, 1).Return(records[:1], int64(3), nil)
+	resp, err := s.handler.ListAuditRecords(ctx, &adminservice.ListAuditRecordsRequest{PageSize: 1})
+	s.NoError(err)
+	s.Len(resp.Records, 1)
+	s.Equal("0:3", string(resp.NextPageToken))
+
+	// the rest of the log is read partition by partition
+	auditLogManager.EXPECT().ReadRecords(gomock.Any(), 0, int64(3), 10).Return(records[1:], int64(5), nil)
+	auditLogManager.EXPECT().ReadRecords(gomock.Any(), 0, int64(5), 9).Return(nil, int64(5), nil)
+	for partition := 1; partition < persistence.AuditLogQueuePartitions; partition++ {
+		auditLogManager.EXPECT().ReadRecords(gomock.Any(), partition, persistence.EmptyQueueMessageID, 9).Return(nil, persistence.EmptyQueueMessageID, nil)
+	}
+	resp, err = s.handler.ListAuditRecords(ctx, &adminservice.ListAuditRecordsRequest{
+		Namespace:     "b",
+		PageSize:      10,
+		NextPageToken: resp.NextPageToken,
+	})
+	s.NoError(err)
+	s.Len(resp.Records, 1)
+	s.Equal("b", resp.Records[0].GetNamespace())
+	s.Empty(resp.NextPageToken)
+
+	_, err = s.handler.ListAuditRecords(ctx, &adminservice.ListAuditRecordsRequest{NextPageToken: []byte("8:0")})
+	s.Equal(errInvalidNextPageToken, err)
+}
+
+func (s *adminHandlerSuite) Test_ResetWorkflowExecutions_InvalidRequest() {
+	selector := &workflowspb.ResetPointSelector{Selector: &workflowspb.ResetPointSelector_BuildId{BuildId: "build-1"}}
+	execution := &commonpb.WorkflowExecution{WorkflowId: "workflow-1"}
+
+	_, err := s.handler.ResetWorkflowExecutions(context.Background(), &adminservice.ResetWorkflowExecutionsRequest{
+		Namespace:          s.namespace.String(),
+		Query:              "WorkflowType='order'",
+		ResetPointSelector: selector,
+	})
+	s.Equal(errResetByQueryRequiresDryRun, err)
+
+	_, err = s.handler.ResetWorkflowExecutions(context.Background(), &adminservice.ResetWorkflowExecutionsRequest{
+		Namespace:          s.namespace.String(),
+		ResetPointSelector: selector,
+		DryRun:             true,
+	})
+	s.Equal(errExecutionsNotSet, err)
+
+	_, err = s.handler.ResetWorkflowExecutions(context.Background(), &adminservice.ResetWorkflowExecutionsRequest{
+		Namespace:          s.namespace.String(),
+		Query:              "WorkflowType='order'",
+		Executions:         []*commonpb.WorkflowExecution{execution},
+		ResetPointSelector: selector,
+		DryRun:             true,
+	})
+	s.Equal(errQueryAndExecutionsBothSet, err)
+
+	_, err = s.handler.ResetWorkflowExecutions(context.Background(), &adminservice.ResetWorkflowExecutionsRequest{
+		Namespace:  s.namespace.String(),
+		Executions: []*commonpb.WorkflowExecution{execution},
+	})
+	s.Equal(errResetPointSelectorNotSet, err)
+
+	s.handler.config.VisibilityMaxPageSize = dynamicconfig.GetIntPropertyFilteredByNamespace(1)
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	_, err = s.handler.ResetWorkflowExecutions(context.Background(), &adminservice.ResetWorkflowExecutionsRequest{
+		Namespace:          s.namespace.String(),
+		Executions:         []*commonpb.WorkflowExecution{execution, execution},
+		ResetPointSelector: selector,
+		DryRun:             true,
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	s.handler.config.VisibilityMaxPageSize = dynamicconfig.GetIntPropertyFilteredByNamespace(10)
+	s.handler.config.MaxResetWorkflowExecutions = dynamicconfig.GetIntPropertyFilteredByNamespace(1)
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	_, err = s.handler.ResetWorkflowExecutions(context.Background(), &adminservice.ResetWorkflowExecutionsRequest{
+		Namespace:          s.namespace.String(),
+		Executions:         []*commonpb.WorkflowExecution{execution, execution},
+		ResetPointSelector: selector,
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
+func (s *adminHandlerSuite) Test_ResetWorkflowExecutions_DryRunQuery() {
+	selector := &workflowspb.ResetPointSelector{Selector: &workflowspb.ResetPointSelector_BuildId{BuildId: "build-1"}}
+	execution1 := &commonpb.WorkflowExecution{WorkflowId: "workflow-1", RunId: uuid.New()}
+	execution2 := &commonpb.WorkflowExecution{WorkflowId: "workflow-2", RunId: uuid.New()}
+
+	s.handler.config.VisibilityMaxPageSize = dynamicconfig.GetIntPropertyFilteredByNamespace(10)
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockVisibilityMgr.EXPECT().ListWorkflowExecutions(gomock.Any(), &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID:   s.namespaceID,
+		Namespace:     s.namespace,
+		PageSize:      2,
+		NextPageToken: []byte("token-1"),
+		Query:         "WorkflowType='order'",
+	}).Return(&manager.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{
+			{Execution: execution1},
+			{Execution: execution2},
+		},
+		NextPageToken: []byte("token-2"),
+	}, nil)
+	s.mockHistoryClient.EXPECT().ResolveResetPoint(gomock.Any(), &historyservice.ResolveResetPointRequest{
+		NamespaceId:        s.namespaceID.String(),
+		Execution:          execution1,
+		ResetPointSelector: selector,
+	}).Return(&historyservice.ResolveResetPointResponse{
+		RunId:                     execution1.RunId,
+		WorkflowTaskFinishEventId: 4,
+		DiscardedEventCount:       6,
+	}, nil)
+	s.mockHistoryClient.EXPECT().ResolveResetPoint(gomock.Any(), &historyservice.ResolveResetPointRequest{
+		NamespaceId:        s.namespaceID.String(),
+		Execution:          execution2,
+		ResetPointSelector: selector,
+	}).Return(nil, serviceerror.NewNotFound("No reset point matches the selector."))
+
+	resp, err := s.handler.ResetWorkflowExecutions(context.Background(), &adminservice.ResetWorkflowExecutionsRequest{
+		Namespace:          s.namespace.String(),
+		Query:              "WorkflowType='order'",
+		ResetPointSelector: selector,
+		DryRun:             true,
+		MaximumPageSize:    2,
+		NextPageToken:      []byte("token-1"),
+	})
+	s.NoError(err)
+	s.Equal([]byte("token-2"), resp.NextPageToken)
+	s.Equal([]*workflowspb.ResetPointResolution{
+		{Execution: execution1, WorkflowTaskFinishEventId: 4, DiscardedEventCount: 6},
+		{Execution: execution2, Error: "No reset point matches the selector."},
+	}, resp.Results)
+}
+
+func (s *adminHandlerSuite) Test_ResetWorkflowExecutions_Executions() {
+	selector := &workflowspb.ResetPointSelector{Selector: &workflowspb.ResetPointSelector_FailedActivityType{FailedActivityType: "charge"}}
+	execution1 := &commonpb.WorkflowExecution{WorkflowId: "workflow-1", RunId: uuid.New()}
+	execution2 := &commonpb.WorkflowExecution{WorkflowId: "workflow-2", RunId: uuid.New()}
+	newRunID := uuid.New()
+
+	s.handler.config.VisibilityMaxPageSize = dynamicconfig.GetIntPropertyFilteredByNamespace(10)
+	s.handler.config.MaxResetWorkflowExecutions = dynamicconfig.GetIntPropertyFilteredByNamespace(10)
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	// the execution list is used as is, visibility is not consulted
+	s.mockVisibilityMgr.EXPECT().ListWorkflowExecutions(gomock.Any(), gomock.Any()).Times(0)
+	s.mockHistoryClient.EXPECT().ResolveResetPoint(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.ResolveResetPointRequest, _ ...interface{}) (*historyservice.ResolveResetPointResponse, error) {
+			return &historyservice.ResolveResetPointResponse{
+				RunId:                     request.Execution.RunId,
+				WorkflowTaskFinishEventId: 4,
+				DiscardedEventCount:       6,
+			}, nil
+		}).Times(2)
+	s.mockHistoryClient.EXPECT().ResetWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.ResetWorkflowExecutionRequest, _ ...interface{}) (*historyservice.ResetWorkflowExecutionResponse, error) {
+			s.Equal(execution1, request.ResetRequest.WorkflowExecution)
+			s.Equal(int64(4), request.ResetRequest.WorkflowTaskFinishEventId)
+			s.Equal("bad deploy", request.ResetRequest.Reason)
+			return &historyservice.ResetWorkflowExecutionResponse{RunId: newRunID}, nil
+		})
+	s.mockHistoryClient.EXPECT().ResetWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound("workflow not found"))
+
+	resp, err := s.handler.ResetWorkflowExecutions(context.Background(), &adminservice.ResetWorkflowExecutionsRequest{
+		Namespace:          s.namespace.String(),
+		Executions:         []*commonpb.WorkflowExecution{execution1, execution2},
+		ResetPointSelector: selector,
+		Reason:             "bad deploy",
+	})
+	s.NoError(err)
+	s.Empty(resp.NextPageToken)
+	s.Equal([]*workflowspb.ResetPointResolution{
+		{Execution: execution1, WorkflowTaskFinishEventId: 4, DiscardedEventCount: 6, ResetRunId: newRunID},
+		{Execution: execution2, WorkflowTaskFinishEventId: 4, DiscardedEventCount: 6, Error: "workflow not found"},
+	}, resp.Results)
+}
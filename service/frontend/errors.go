@@ -76,6 +76,10 @@ var (
 	errUnableDeleteSystemNamespace                        = serviceerror.NewInvalidArgument("Unable to delete system namespace.")
 	errBatchJobIDNotSet                                   = serviceerror.NewInvalidArgument("JobId is not set on request.")
 	errNamespaceNotSet                                    = serviceerror.NewInvalidArgument("Namespace is not set on request.")
+	errResetPointSelectorNotSet                           = serviceerror.NewInvalidArgument("ResetPointSelector is not set on request.")
+	errResetByQueryRequiresDryRun                         = serviceerror.NewInvalidArgument("Resetting by query is only allowed for dry runs, reset the executions returned by a dry run instead.")
+	errExecutionsNotSet                                   = serviceerror.NewInvalidArgument("Executions are not set on request.")
+	errQueryAndExecutionsBothSet                          = serviceerror.NewInvalidArgument("Only one of Query and Executions can be set on request.")
 	errReasonNotSet                                       = serviceerror.NewInvalidArgument("Reason is not set on request.")
 	errBatchOperationNotSet                               = serviceerror.NewInvalidArgument("Batch operation is not set on request.")
 
@@ -112,4 +116,19 @@ var (
 	errBatchOpsMaxWorkflowExecutionCount = serviceerror.NewInvalidArgument("Workflow executions count exceeded.")
 
 	errUpdateWorkflowExecutionAPINotAllowed = serviceerror.NewPermissionDenied("UpdateWorkflowExecution operation is disabled on this namespace.", "")
+
+	errNamespaceAPIKeysNotAllowed = serviceerror.NewPermissionDenied("Namespace API keys are disabled.", "")
+	errAPIKeyNameNotSet           = serviceerror.NewInvalidArgument("API key name is not set on request.")
+	errAPIKeyIDNotSet             = serviceerror.NewInvalidArgument("API key id is not set on request.")
+	errInvalidAPIKeyTTL           = serviceerror.NewInvalidArgument("API key ttl must be positive.")
+	errAPIKeyNotFound             = serviceerror.NewNotFound("API key not found.")
+	errAPIKeyManagementNotAllowed = serviceerror.NewPermissionDenied("Managing API keys requires the admin role on the namespace.", "")
+	errAPIKeyRoleNotAllowed       = serviceerror.NewPermissionDenied("API key role is above the role of the caller.", "")
+
+	errAuditLogNotInPersistence = serviceerror.NewFailedPrecondition("Audit records are not written to persistence.")
+
+	errInvalidPersistenceFaultTTL  = serviceerror.NewInvalidArgument("Persistence fault ttl must be positive and at most 24h.")
+	errPersistenceFaultIDNotSet    = serviceerror.NewInvalidArgument("Persistence fault id is not set on request.")
+	errPersistenceFaultNotFound    = serviceerror.NewNotFound("Persistence fault not found.")
+	errPersistenceFaultsNotAllowed = serviceerror.NewFailedPrecondition("Persistence faults are not allowed at runtime, set allowRuntimeFaults in the fault injection config of the default store.")
 )
@@ -49,6 +49,7 @@ import (
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloadstore"
 	"go.temporal.io/server/common/persistence"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
 	"go.temporal.io/server/common/persistence/serialization"
@@ -60,6 +61,7 @@ import (
 	"go.temporal.io/server/common/resolver"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/rpc"
+	"go.temporal.io/server/common/rpc/encryption"
 	"go.temporal.io/server/common/rpc/interceptor"
 	"go.temporal.io/server/common/sdk"
 	"go.temporal.io/server/common/searchattribute"
@@ -84,11 +86,17 @@ var Module = fx.Options(
 	fx.Provide(NamespaceRateLimitInterceptorProvider),
 	fx.Provide(SDKVersionInterceptorProvider),
 	fx.Provide(CallerInfoInterceptorProvider),
+	fx.Provide(PayloadOffloadInterceptorProvider),
 	fx.Provide(GrpcServerOptionsProvider),
 	fx.Provide(VisibilityManagerProvider),
+	fx.Provide(NamespaceIndexManagerProvider),
 	fx.Provide(ThrottledLoggerRpsFnProvider),
 	fx.Provide(PersistenceRateLimitingParamsProvider),
 	fx.Provide(FEReplicatorNamespaceReplicationQueueProvider),
+	fx.Provide(AuditLogManagerProvider),
+	fx.Provide(AuditSinkProvider),
+	fx.Provide(APIKeyUsageManagerProvider),
+	fx.Provide(APIKeyUsageTrackerProvider),
 	fx.Provide(func(so []grpc.ServerOption) *grpc.Server { return grpc.NewServer(so...) }),
 	fx.Provide(HandlerProvider),
 	fx.Provide(AdminHandlerProvider),
@@ -145,9 +153,15 @@ func GrpcServerOptionsProvider(
 	traceInterceptor telemetry.ServerTraceInterceptor,
 	sdkVersionInterceptor *interceptor.SDKVersionInterceptor,
 	callerInfoInterceptor *interceptor.CallerInfoInterceptor,
+	payloadOffloadInterceptor *PayloadOffloadInterceptor,
 	authorizer authorization.Authorizer,
 	claimMapper authorization.ClaimMapper,
 	audienceGetter authorization.JWTAudienceMapper,
+	auditSink authorization.AuditSink,
+	namespaceRegistry namespace.Registry,
+	historyClient historyservice.HistoryServiceClient,
+	apiKeyUsageTracker *authorization.APIKeyUsageTracker,
+	timeSource clock.TimeSource,
 	customInterceptors []grpc.UnaryServerInterceptor,
 	metricsHandler metrics.Handler,
 ) []grpc.ServerOption {
@@ -175,6 +189,7 @@ func GrpcServerOptionsProvider(
 	if err != nil {
 		logger.Fatal("creating gRPC server options failed", tag.Error(err))
 	}
+	authorization.SetWorkflowTypeResolver(authorizer, newWorkflowTypeResolver(historyClient, namespaceRegistry))
 	interceptors := []grpc.UnaryServerInterceptor{
 		// Service Error Interceptor should be the most outer interceptor on error handling
 		rpc.ServiceErrorInterceptor,
@@ -185,11 +200,18 @@ func GrpcServerOptionsProvider(
 		redirectionInterceptor.Intercept,
 		telemetryInterceptor.Intercept,
 		authorization.NewAuthorizationInterceptor(
-			claimMapper,
+			authorization.NewAPIKeyClaimMapper(
+				claimMapper,
+				namespaceRegistry,
+				apiKeyUsageTracker,
+				timeSource,
+				serviceConfig.EnableNamespaceAPIKeys,
+			),
 			authorizer,
 			metricsHandler,
 			logger,
 			audienceGetter,
+			auditSink,
 		),
 		namespaceValidatorInterceptor.StateValidationIntercept,
 		namespaceCountLimiterInterceptor.Intercept,
@@ -197,6 +219,7 @@ func GrpcServerOptionsProvider(
 		rateLimitInterceptor.Intercept,
 		sdkVersionInterceptor.Intercept,
 		callerInfoInterceptor.Intercept,
+		payloadOffloadInterceptor.Intercept,
 	}
 	if len(customInterceptors) > 0 {
 		// TODO: Deprecate WithChainedFrontendGrpcInterceptors and provide a inner custom interceptor
@@ -374,6 +397,24 @@ func CallerInfoInterceptorProvider(
 	return interceptor.NewCallerInfoInterceptor(namespaceRegistry)
 }
 
+func PayloadOffloadInterceptorProvider(
+	offloader *payloadstore.Offloader,
+	namespaceRegistry namespace.Registry,
+	serviceConfig *Config,
+) *PayloadOffloadInterceptor {
+	return NewPayloadOffloadInterceptor(offloader, namespaceRegistry, serviceConfig.PayloadOffloadThreshold)
+}
+
+func NamespaceIndexManagerProvider(
+	esClient esclient.Client,
+	esConfig *esclient.Config,
+	saProvider searchattribute.Provider,
+	serviceConfig *Config,
+	logger log.Logger,
+) *esclient.NamespaceIndexManager {
+	return esclient.NewNamespaceIndexManager(esClient, esConfig, saProvider, serviceConfig.EnablePerNamespaceVisibilityIndex, logger)
+}
+
 func PersistenceRateLimitingParamsProvider(
 	serviceConfig *Config,
 ) service.PersistenceRateLimitingParams {
@@ -430,6 +471,121 @@ func FEReplicatorNamespaceReplicationQueueProvider(
 	return replicatorNamespaceReplicationQueue
 }
 
+type AuditLogManagerParams struct {
+	fx.In
+
+	Lifecycle          fx.Lifecycle
+	Cfg                *config.Config `optional:"true"`
+	PersistenceFactory persistenceClient.Factory
+}
+
+// AuditLogManagerProvider returns the manager of the audit log stored in persistence, nil if audit
+// records are not written to persistence
+func AuditLogManagerProvider(params AuditLogManagerParams) (persistence.AuditLogManager, error) {
+	if params.Cfg == nil || !authorization.IsPersistenceAuditSink(&params.Cfg.Global.Authorization) {
+		return nil, nil
+	}
+	auditLogManager, err := params.PersistenceFactory.NewAuditLogManager()
+	if err != nil {
+		return nil, err
+	}
+	params.Lifecycle.Append(fx.Hook{
+		OnStop: func(ctx context.Context) error {
+			auditLogManager.Close()
+			return nil
+		},
+	})
+	return auditLogManager, nil
+}
+
+func APIKeyUsageManagerProvider(
+	lc fx.Lifecycle,
+	persistenceFactory persistenceClient.Factory,
+) (persistence.APIKeyUsageManager, error) {
+	usageManager, err := persistenceFactory.NewAPIKeyUsageManager()
+	if err != nil {
+		return nil, err
+	}
+	lc.Append(fx.Hook{
+		OnStop: func(ctx context.Context) error {
+			usageManager.Close()
+			return nil
+		},
+	})
+	return usageManager, nil
+}
+
+func APIKeyUsageTrackerProvider(
+	lc fx.Lifecycle,
+	usageManager persistence.APIKeyUsageManager,
+	timeSource clock.TimeSource,
+	serviceConfig *Config,
+	logger log.Logger,
+) *authorization.APIKeyUsageTracker {
+	tracker := authorization.NewAPIKeyUsageTracker(
+		usageManager,
+		timeSource,
+		serviceConfig.NamespaceAPIKeyLastUsedUpdateInterval,
+		logger,
+	)
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			tracker.Start()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			tracker.Stop()
+			return nil
+		},
+	})
+	return tracker
+}
+
+type TLSConfigProviderParams struct {
+	fx.In
+
+	TLSConfigProvider encryption.TLSConfigProvider `optional:"true"`
+}
+
+type AuditSinkParams struct {
+	fx.In
+
+	Lifecycle       fx.Lifecycle
+	Cfg             *config.Config `optional:"true"`
+	AuditLogManager persistence.AuditLogManager
+	MetricsHandler  metrics.Handler
+	Logger          log.Logger
+}
+
+// AuditSinkProvider returns the audit sink configured for authorization, nil if auditing is disabled
+func AuditSinkProvider(params AuditSinkParams) (authorization.AuditSink, error) {
+	if params.Cfg == nil {
+		return nil, nil
+	}
+	auditSink, err := authorization.GetAuditSinkFromConfig(
+		&params.Cfg.Global.Authorization,
+		params.AuditLogManager,
+		params.MetricsHandler,
+		params.Logger,
+	)
+	if err != nil || auditSink == nil {
+		return nil, err
+	}
+	if daemon, ok := auditSink.(common.Daemon); ok {
+		params.Lifecycle.Append(fx.Hook{
+			OnStart: func(ctx context.Context) error {
+				daemon.Start()
+				return nil
+			},
+			OnStop: func(ctx context.Context) error {
+				daemon.Stop()
+				return nil
+			},
+		})
+	}
+	return auditSink, nil
+}
+
 func ServiceResolverProvider(
 	membershipMonitor membership.Monitor,
 	serviceName primitives.ServiceName,
@@ -464,6 +620,10 @@ func AdminHandlerProvider(
 	healthServer *health.Server,
 	eventSerializer serialization.Serializer,
 	timeSource clock.TimeSource,
+	auditLogManager persistence.AuditLogManager,
+	tlsParams TLSConfigProviderParams,
+	apiKeyUsageManager persistence.APIKeyUsageManager,
+	namespaceIndexManager *esclient.NamespaceIndexManager,
 ) *AdminHandler {
 	args := NewAdminHandlerArgs{
 		persistenceConfig,
@@ -492,6 +652,10 @@ func AdminHandlerProvider(
 		healthServer,
 		eventSerializer,
 		timeSource,
+		auditLogManager,
+		tlsParams.TLSConfigProvider,
+		apiKeyUsageManager,
+		namespaceIndexManager,
 	}
 	return NewAdminHandler(args)
 }
@@ -556,6 +720,7 @@ func HandlerProvider(
 	archivalMetadata archiver.ArchivalMetadata,
 	healthServer *health.Server,
 	membershipMonitor membership.Monitor,
+	namespaceIndexManager *esclient.NamespaceIndexManager,
 ) Handler {
 	wfHandler := NewWorkflowHandler(
 		serviceConfig,
@@ -578,6 +743,7 @@ func HandlerProvider(
 		healthServer,
 		timeSource,
 		membershipMonitor,
+		namespaceIndexManager,
 	)
 	return wfHandler
 }
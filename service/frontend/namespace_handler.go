@@ -50,6 +50,7 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/primitives/timestamp"
 )
@@ -92,6 +93,10 @@ type (
 		archiverProvider       provider.ArchiverProvider
 		supportsSchedules      dynamicconfig.BoolPropertyFnWithNamespaceFilter
 		timeSource             clock.TimeSource
+
+		// Dedicated Elasticsearch visibility indices are created for namespace on registration
+		// if they are enabled and Elasticsearch is configured.
+		visibilityIndexManager *esclient.NamespaceIndexManager
 	}
 )
 
@@ -120,6 +125,7 @@ func newNamespaceHandler(
 	archiverProvider provider.ArchiverProvider,
 	supportsSchedules dynamicconfig.BoolPropertyFnWithNamespaceFilter,
 	timeSource clock.TimeSource,
+	visibilityIndexManager *esclient.NamespaceIndexManager,
 ) *namespaceHandlerImpl {
 	return &namespaceHandlerImpl{
 		maxBadBinaryCount:      maxBadBinaryCount,
@@ -132,6 +138,8 @@ func newNamespaceHandler(
 		archiverProvider:       archiverProvider,
 		supportsSchedules:      supportsSchedules,
 		timeSource:             timeSource,
+
+		visibilityIndexManager: visibilityIndexManager,
 	}
 }
 
@@ -285,8 +293,14 @@ func (d *namespaceHandlerImpl) RegisterNamespace(
 		IsGlobalNamespace: isGlobalNamespace,
 	}
 
+	// Create visibility indices before namespace, so no visibility records are written to shared index.
+	if err := d.visibilityIndexManager.CreateNamespaceIndices(ctx, info.GetId(), info.GetName()); err != nil {
+		return nil, err
+	}
+
 	namespaceResponse, err := d.metadataMgr.CreateNamespace(ctx, namespaceRequest)
 	if err != nil {
+		d.visibilityIndexManager.DeleteNamespaceIndices(ctx, info.GetId(), info.GetName())
 		return nil, err
 	}
 
@@ -301,6 +315,7 @@ func (d *namespaceHandlerImpl) RegisterNamespace(
 		namespaceRequest.Namespace.FailoverVersion,
 		namespaceRequest.IsGlobalNamespace,
 		nil,
+		namespaceRequest.Namespace.ApiKeys,
 	)
 	if err != nil {
 		return nil, err
@@ -610,6 +625,7 @@ func (d *namespaceHandlerImpl) UpdateNamespace(
 				ConfigVersion:               configVersion,
 				FailoverVersion:             failoverVersion,
 				FailoverNotificationVersion: failoverNotificationVersion,
+				ApiKeys:                     getResponse.Namespace.ApiKeys,
 			},
 			IsGlobalNamespace:   isGlobalNamespace,
 			NotificationVersion: notificationVersion,
@@ -631,6 +647,7 @@ func (d *namespaceHandlerImpl) UpdateNamespace(
 		failoverVersion,
 		isGlobalNamespace,
 		failoverHistory,
+		getResponse.Namespace.ApiKeys,
 	)
 	if err != nil {
 		return nil, err
@@ -686,6 +703,7 @@ func (d *namespaceHandlerImpl) DeprecateNamespace(
 			ConfigVersion:               getResponse.Namespace.ConfigVersion,
 			FailoverVersion:             getResponse.Namespace.FailoverVersion,
 			FailoverNotificationVersion: getResponse.Namespace.FailoverNotificationVersion,
+			ApiKeys:                     getResponse.Namespace.ApiKeys,
 		},
 		NotificationVersion: notificationVersion,
 		IsGlobalNamespace:   getResponse.IsGlobalNamespace,
@@ -54,7 +54,9 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
 )
 
 type (
@@ -118,6 +120,7 @@ func (s *namespaceHandlerCommonSuite) SetupTest() {
 		s.mockArchiverProvider,
 		func(s string) bool { return strings.HasSuffix(s, "sched") },
 		s.fakeClock,
+		nil,
 	)
 }
 
@@ -420,6 +423,60 @@ func (s *namespaceHandlerCommonSuite) TestRegisterNamespace() {
 	s.NoError(err)
 }
 
+func (s *namespaceHandlerCommonSuite) TestRegisterNamespace_PerNamespaceVisibilityIndex() {
+	const namespace = "namespace-to-register"
+	mockESClient := esclient.NewMockClient(s.controller)
+	esConfig := &esclient.Config{
+		Indices: map[string]string{esclient.VisibilityAppName: "test-index"},
+	}
+	s.handler.visibilityIndexManager = esclient.NewNamespaceIndexManager(
+		mockESClient,
+		esConfig,
+		searchattribute.NewTestProvider(),
+		dc.GetBoolPropertyFnFilteredByNamespace(true),
+		log.NewNoopLogger(),
+	)
+
+	registerRequest := &workflowservice.RegisterNamespaceRequest{
+		Namespace:                        namespace,
+		WorkflowExecutionRetentionPeriod: timestamp.DurationPtr(10 * 24 * time.Hour),
+	}
+	s.mockClusterMetadata.EXPECT().IsGlobalNamespaceEnabled().Return(false).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestAllClusterInfo).AnyTimes()
+	s.mockMetadataMgr.EXPECT().GetNamespace(gomock.Any(), gomock.Any()).Return(nil, &serviceerror.NamespaceNotFound{}).Times(2)
+
+	var nsIndex string
+	mockESClient.EXPECT().IndexExists(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, index string) (bool, error) {
+			nsIndex = index
+			return false, nil
+		})
+	mockESClient.EXPECT().CreateIndex(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, index string) (bool, error) {
+			s.Equal(nsIndex, index)
+			return true, nil
+		})
+	mockESClient.EXPECT().PutMapping(gomock.Any(), gomock.Any(), searchattribute.TestNameTypeMap.Custom()).Return(true, nil)
+	s.mockMetadataMgr.EXPECT().CreateNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.CreateNamespaceRequest) (*persistence.CreateNamespaceResponse, error) {
+			s.Equal(esclient.NamespaceIndexName("test-index", request.Namespace.Info.GetId()), nsIndex)
+			return &persistence.CreateNamespaceResponse{ID: request.Namespace.Info.GetId()}, nil
+		})
+	_, err := s.handler.RegisterNamespace(context.Background(), registerRequest)
+	s.NoError(err)
+
+	// Index is deleted if namespace can't be created.
+	mockESClient.EXPECT().IndexExists(gomock.Any(), gomock.Any()).Return(false, nil)
+	mockESClient.EXPECT().CreateIndex(gomock.Any(), gomock.Any()).Return(true, nil)
+	mockESClient.EXPECT().PutMapping(gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil)
+	s.mockMetadataMgr.EXPECT().CreateNamespace(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewUnavailable("unavailable"))
+	mockESClient.EXPECT().IndexExists(gomock.Any(), gomock.Any()).Return(true, nil)
+	mockESClient.EXPECT().DeleteIndex(gomock.Any(), gomock.Any()).Return(true, nil)
+	_, err = s.handler.RegisterNamespace(context.Background(), registerRequest)
+	s.Error(err)
+}
+
 func (s *namespaceHandlerCommonSuite) TestRegisterNamespace_InvalidRetentionPeriod() {
 	clusterName := "cluster1"
 	s.mockClusterMetadata.EXPECT().IsGlobalNamespaceEnabled().Return(true).AnyTimes()
@@ -0,0 +1,196 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/proto"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloadstore"
+	"go.temporal.io/server/common/rpc/interceptor"
+)
+
+type (
+	// PayloadOffloadInterceptor offloads the large payloads of requests before they are checked
+	// against the blob size limits and written to history, and rehydrates the references in responses,
+	// so offloading is transparent to clients and workers.
+	PayloadOffloadInterceptor struct {
+		offloader         *payloadstore.Offloader
+		namespaceRegistry namespace.Registry
+		tokenSerializer   common.TaskTokenSerializer
+		threshold         func(namespace string) int
+	}
+)
+
+var _ grpc.UnaryServerInterceptor = (*PayloadOffloadInterceptor)(nil).Intercept
+
+func NewPayloadOffloadInterceptor(
+	offloader *payloadstore.Offloader,
+	namespaceRegistry namespace.Registry,
+	threshold func(namespace string) int,
+) *PayloadOffloadInterceptor {
+	return &PayloadOffloadInterceptor{
+		offloader:         offloader,
+		namespaceRegistry: namespaceRegistry,
+		tokenSerializer:   common.NewProtoTaskTokenSerializer(),
+		threshold:         threshold,
+	}
+}
+
+func (i *PayloadOffloadInterceptor) Intercept(
+	ctx context.Context,
+	req interface{},
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if i.offloader == nil {
+		return handler(ctx, req)
+	}
+
+	if msg, ok := req.(proto.Message); ok {
+		// references sent by clients would let them read blobs the offloader didn't write for them
+		if err := payloadstore.RejectReferences(ctx, msg); err != nil {
+			return nil, err
+		}
+	}
+	namespaceID := i.namespaceID(req)
+	workflowID, keys, err := i.offload(ctx, req, namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := handler(ctx, req)
+	if err != nil {
+		if isRejected(err) {
+			// the request never reached history, so nothing refers to the blobs
+			i.offloader.Discard(ctx, namespaceID, workflowID, keys)
+		}
+		return nil, err
+	}
+	if msg, ok := resp.(proto.Message); ok {
+		if err := i.offloader.Rehydrate(ctx, msg, namespaceID); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// namespaceID returns the ID of the namespace of req, or an empty string if it is unknown, in which
+// case the handler rejects the request
+func (i *PayloadOffloadInterceptor) namespaceID(req interface{}) string {
+	namespaceName := interceptor.MustGetNamespaceName(i.namespaceRegistry, req)
+	if namespaceName == namespace.EmptyName {
+		return ""
+	}
+	id, err := i.namespaceRegistry.GetNamespaceID(namespaceName)
+	if err != nil {
+		return ""
+	}
+	return id.String()
+}
+
+// offload offloads the large payloads of req and returns the keys of the blobs it wrote, along with
+// the workflow ID they belong to
+func (i *PayloadOffloadInterceptor) offload(
+	ctx context.Context,
+	req interface{},
+	namespaceID string,
+) (workflowID string, keys []string, err error) {
+	workflowID = i.workflowID(req)
+	if workflowID == "" || namespaceID == "" {
+		return "", nil, nil
+	}
+	threshold := i.threshold(interceptor.MustGetNamespaceName(i.namespaceRegistry, req).String())
+	if threshold <= 0 {
+		return "", nil, nil
+	}
+	keys, err = i.offloader.Offload(ctx, req.(proto.Message), namespaceID, workflowID, threshold)
+	if err != nil {
+		return "", nil, err
+	}
+	return workflowID, keys, nil
+}
+
+// isRejected returns true if err means the request was rejected before it was written to history.
+// Other errors, such as timeouts, leave the blobs in place since history may refer to them.
+func isRejected(err error) bool {
+	switch err.(type) {
+	case *serviceerror.InvalidArgument,
+		*serviceerror.NotFound,
+		*serviceerror.NamespaceNotFound,
+		*serviceerror.NamespaceNotActive,
+		*serviceerror.NamespaceInvalidState,
+		*serviceerror.FailedPrecondition,
+		*serviceerror.PermissionDenied,
+		*serviceerror.ResourceExhausted,
+		*serviceerror.WorkflowExecutionAlreadyStarted:
+		return true
+	default:
+		return false
+	}
+}
+
+// workflowID returns the workflow ID of requests whose payloads are written to history, or an empty
+// string for other requests. Payloads of workflow updates are not offloaded since they reach workers
+// inside protocol messages, which are not rehydrated.
+func (i *PayloadOffloadInterceptor) workflowID(req interface{}) string {
+	switch request := req.(type) {
+	case *workflowservice.StartWorkflowExecutionRequest:
+		return request.GetWorkflowId()
+	case *workflowservice.SignalWithStartWorkflowExecutionRequest:
+		return request.GetWorkflowId()
+	case *workflowservice.SignalWorkflowExecutionRequest:
+		return request.GetWorkflowExecution().GetWorkflowId()
+	case *workflowservice.TerminateWorkflowExecutionRequest:
+		return request.GetWorkflowExecution().GetWorkflowId()
+	case *workflowservice.RespondActivityTaskCompletedByIdRequest:
+		return request.GetWorkflowId()
+	case *workflowservice.RespondActivityTaskFailedByIdRequest:
+		return request.GetWorkflowId()
+	case *workflowservice.RespondActivityTaskCanceledByIdRequest:
+		return request.GetWorkflowId()
+	case *workflowservice.RecordActivityTaskHeartbeatByIdRequest:
+		return request.GetWorkflowId()
+	case *workflowservice.RespondWorkflowTaskCompletedRequest,
+		*workflowservice.RespondWorkflowTaskFailedRequest,
+		*workflowservice.RespondActivityTaskCompletedRequest,
+		*workflowservice.RespondActivityTaskFailedRequest,
+		*workflowservice.RespondActivityTaskCanceledRequest,
+		*workflowservice.RecordActivityTaskHeartbeatRequest:
+		token, err := i.tokenSerializer.Deserialize(request.(interface{ GetTaskToken() []byte }).GetTaskToken())
+		if err != nil {
+			// the handler rejects the request
+			return ""
+		}
+		return token.GetWorkflowId()
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,139 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloadstore"
+)
+
+func TestPayloadOffloadInterceptor(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	namespaceRegistry := namespace.NewMockRegistry(controller)
+	namespaceRegistry.EXPECT().GetNamespace(namespace.Name("test-namespace")).Return(nil, nil).AnyTimes()
+	namespaceRegistry.EXPECT().GetNamespaceID(namespace.Name("test-namespace")).Return(namespace.ID("test-namespace-id"), nil).AnyTimes()
+	store, err := payloadstore.NewStore(&config.PayloadStore{
+		URI:       "file://" + t.TempDir(),
+		Filestore: &config.FilestoreArchiver{FileMode: "0666", DirMode: "0766"},
+	})
+	require.NoError(t, err)
+	offloader := payloadstore.NewOffloader(store, metrics.NoopMetricsHandler, log.NewNoopLogger())
+	interceptor := NewPayloadOffloadInterceptor(offloader, namespaceRegistry, func(string) int { return 100 })
+
+	input := func() *commonpb.Payloads {
+		return &commonpb.Payloads{Payloads: []*commonpb.Payload{{Data: []byte(strings.Repeat("x", 1000))}}}
+	}
+	var stored *commonpb.Payloads
+	resp, err := interceptor.Intercept(
+		context.Background(),
+		&workflowservice.StartWorkflowExecutionRequest{
+			Namespace:  "test-namespace",
+			WorkflowId: "workflow-id",
+			Input:      input(),
+		},
+		&grpc.UnaryServerInfo{FullMethod: "/temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			stored = req.(*workflowservice.StartWorkflowExecutionRequest).Input
+			require.True(t, payloadstore.IsReference(stored.Payloads[0]))
+			return &workflowservice.StartWorkflowExecutionResponse{RunId: "run-id"}, nil
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, "run-id", resp.(*workflowservice.StartWorkflowExecutionResponse).RunId)
+
+	resp, err = interceptor.Intercept(
+		context.Background(),
+		&workflowservice.GetWorkflowExecutionHistoryRequest{Namespace: "test-namespace"},
+		&grpc.UnaryServerInfo{FullMethod: "/temporal.api.workflowservice.v1.WorkflowService/GetWorkflowExecutionHistory"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return &workflowservice.GetWorkflowExecutionHistoryResponse{
+				History: &historypb.History{Events: []*historypb.HistoryEvent{{
+					Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{
+						WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{Input: stored},
+					},
+				}}},
+			}, nil
+		},
+	)
+	require.NoError(t, err)
+	event := resp.(*workflowservice.GetWorkflowExecutionHistoryResponse).History.Events[0]
+	require.Equal(t, input(), event.GetWorkflowExecutionStartedEventAttributes().Input)
+
+	// the blobs of rejected requests are discarded
+	var rejected *commonpb.Payloads
+	_, err = interceptor.Intercept(
+		context.Background(),
+		&workflowservice.StartWorkflowExecutionRequest{
+			Namespace:  "test-namespace",
+			WorkflowId: "workflow-id",
+			Input:      input(),
+		},
+		&grpc.UnaryServerInfo{FullMethod: "/temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			rejected = req.(*workflowservice.StartWorkflowExecutionRequest).Input
+			return nil, serviceerror.NewInvalidArgument("invalid request")
+		},
+	)
+	require.IsType(t, &serviceerror.InvalidArgument{}, err)
+	err = offloader.Rehydrate(context.Background(), rejected, "test-namespace-id")
+	require.IsType(t, &serviceerror.NotFound{}, err)
+
+	// references can't be sent by clients, even if offloading is disabled for the namespace
+	interceptor = NewPayloadOffloadInterceptor(offloader, namespaceRegistry, func(string) int { return 0 })
+	_, err = interceptor.Intercept(
+		context.Background(),
+		&workflowservice.SignalWorkflowExecutionRequest{
+			Namespace:         "test-namespace",
+			WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: "workflow-id"},
+			Input: &commonpb.Payloads{Payloads: []*commonpb.Payload{{
+				Metadata: map[string][]byte{"encoding": []byte("binary/temporal-offloaded-payload")},
+				Data:     []byte("other-namespace-id/1/blob"),
+			}}},
+		},
+		&grpc.UnaryServerInfo{FullMethod: "/temporal.api.workflowservice.v1.WorkflowService/SignalWorkflowExecution"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			t.Fatal("handler must not be called")
+			return nil, nil
+		},
+	)
+	require.IsType(t, &serviceerror.InvalidArgument{}, err)
+}
@@ -68,6 +68,7 @@ type Config struct {
 	EnableReadFromSecondaryAdvancedVisibility dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	ESIndexMaxResultWindow                    dynamicconfig.IntPropertyFn
 	VisibilityDisableOrderByClause            dynamicconfig.BoolPropertyFn
+	EnablePerNamespaceVisibilityIndex         dynamicconfig.BoolPropertyFnWithNamespaceFilter
 
 	HistoryMaxPageSize                     dynamicconfig.IntPropertyFnWithNamespaceFilter
 	RPS                                    dynamicconfig.IntPropertyFn
@@ -94,6 +95,8 @@ type Config struct {
 	// size limit system protection
 	BlobSizeLimitError dynamicconfig.IntPropertyFnWithNamespaceFilter
 	BlobSizeLimitWarn  dynamicconfig.IntPropertyFnWithNamespaceFilter
+	// PayloadOffloadThreshold is the size above which payloads are offloaded to the payload store
+	PayloadOffloadThreshold dynamicconfig.IntPropertyFnWithNamespaceFilter
 
 	ThrottledLogRPS dynamicconfig.IntPropertyFn
 
@@ -165,8 +168,15 @@ type Config struct {
 	// Batch operation dynamic configs
 	MaxConcurrentBatchOperation     dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MaxExecutionCountBatchOperation dynamicconfig.IntPropertyFnWithNamespaceFilter
+	// Max number of executions ResetWorkflowExecutions resets in one call
+	MaxResetWorkflowExecutions dynamicconfig.IntPropertyFnWithNamespaceFilter
 
 	EnableUpdateWorkflowExecution dynamicconfig.BoolPropertyFnWithNamespaceFilter
+
+	// Enable API keys issued by the server for namespaces
+	EnableNamespaceAPIKeys dynamicconfig.BoolPropertyFn
+	// Interval at which the last used time of API keys is written to the namespace metadata
+	NamespaceAPIKeyLastUsedUpdateInterval dynamicconfig.DurationPropertyFn
 }
 
 // NewConfig returns new service config with default values
@@ -187,6 +197,7 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int32, enableReadF
 		EnableReadFromSecondaryAdvancedVisibility: dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.EnableReadFromSecondaryAdvancedVisibility, false),
 		ESIndexMaxResultWindow:                    dc.GetIntProperty(dynamicconfig.FrontendESIndexMaxResultWindow, 10000),
 		VisibilityDisableOrderByClause:            dc.GetBoolProperty(dynamicconfig.VisibilityDisableOrderByClause, false),
+		EnablePerNamespaceVisibilityIndex:         dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.EnablePerNamespaceVisibilityIndex, false),
 
 		HistoryMaxPageSize:                     dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendHistoryMaxPageSize, common.GetHistoryMaxPageSize),
 		RPS:                                    dc.GetIntProperty(dynamicconfig.FrontendRPS, 2400),
@@ -205,6 +216,7 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int32, enableReadF
 		DisableListVisibilityByFilter:          dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.DisableListVisibilityByFilter, false),
 		BlobSizeLimitError:                     dc.GetIntPropertyFilteredByNamespace(dynamicconfig.BlobSizeLimitError, 2*1024*1024),
 		BlobSizeLimitWarn:                      dc.GetIntPropertyFilteredByNamespace(dynamicconfig.BlobSizeLimitWarn, 256*1024),
+		PayloadOffloadThreshold:                dc.GetIntPropertyFilteredByNamespace(dynamicconfig.PayloadOffloadThreshold, 0),
 		ThrottledLogRPS:                        dc.GetIntProperty(dynamicconfig.FrontendThrottledLogRPS, 20),
 		ShutdownDrainDuration:                  dc.GetDurationProperty(dynamicconfig.FrontendShutdownDrainDuration, 0*time.Second),
 		ShutdownFailHealthCheckDuration:        dc.GetDurationProperty(dynamicconfig.FrontendShutdownFailHealthCheckDuration, 0*time.Second),
@@ -238,8 +250,12 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int32, enableReadF
 		EnableBatcher:                   dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.FrontendEnableBatcher, true),
 		MaxConcurrentBatchOperation:     dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendMaxConcurrentBatchOperationPerNamespace, 1),
 		MaxExecutionCountBatchOperation: dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendMaxExecutionCountBatchOperationPerNamespace, 1000),
+		MaxResetWorkflowExecutions:      dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendMaxResetWorkflowExecutions, 20),
 
 		EnableUpdateWorkflowExecution: dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.FrontendEnableUpdateWorkflowExecution, false),
+
+		EnableNamespaceAPIKeys:                dc.GetBoolProperty(dynamicconfig.FrontendEnableNamespaceAPIKeys, false),
+		NamespaceAPIKeyLastUsedUpdateInterval: dc.GetDurationProperty(dynamicconfig.FrontendNamespaceAPIKeyLastUsedUpdateInterval, 5*time.Minute),
 	}
 }
 
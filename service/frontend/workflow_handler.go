@@ -76,6 +76,7 @@ import (
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/persistence/visibility/manager"
+	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/rpc/interceptor"
@@ -150,6 +151,7 @@ func NewWorkflowHandler(
 	healthServer *health.Server,
 	timeSource clock.TimeSource,
 	membershipMonitor membership.Monitor,
+	namespaceIndexManager *esclient.NamespaceIndexManager,
 ) *WorkflowHandler {
 
 	handler := &WorkflowHandler{
@@ -167,6 +169,7 @@ func NewWorkflowHandler(
 			archiverProvider,
 			config.EnableSchedules,
 			timeSource,
+			namespaceIndexManager,
 		),
 		getDefaultWorkflowRetrySettings: config.DefaultWorkflowRetryPolicy,
 		visibilityMrg:                   visibilityMrg,
@@ -2966,6 +2969,10 @@ func (wh *WorkflowHandler) CreateSchedule(ctx context.Context, request *workflow
 	if err != nil {
 		return nil, err
 	}
+	dependency, err := scheduler.ExtractDependency(request.Schedule, request.ScheduleId)
+	if err != nil {
+		return nil, err
+	}
 
 	// Add namespace division before unaliasing search attributes.
 	searchattribute.AddSearchAttribute(&request.SearchAttributes, searchattribute.TemporalNamespaceDivision, payload.EncodeString(scheduler.NamespaceDivision))
@@ -2998,6 +3005,7 @@ func (wh *WorkflowHandler) CreateSchedule(ctx context.Context, request *workflow
 			ScheduleId:    request.ScheduleId,
 			ConflictToken: scheduler.InitialConflictToken,
 		},
+		Dependency: dependency,
 	}
 	inputPayloads, err := sdk.PreferProtoDataConverter.ToPayloads(input)
 	if err != nil {
@@ -3250,6 +3258,10 @@ func (wh *WorkflowHandler) DescribeSchedule(ctx context.Context, request *workfl
 		return nil, err
 	}
 
+	if err := scheduler.AttachDependency(queryResponse.Schedule, queryResponse.Dependency); err != nil {
+		return nil, err
+	}
+
 	token := make([]byte, 8)
 	binary.BigEndian.PutUint64(token, uint64(queryResponse.ConflictToken))
 
@@ -3303,13 +3315,18 @@ func (wh *WorkflowHandler) UpdateSchedule(ctx context.Context, request *workflow
 	if err != nil {
 		return nil, err
 	}
+	dependency, err := scheduler.ExtractDependency(request.Schedule, request.ScheduleId)
+	if err != nil {
+		return nil, err
+	}
 
 	if err = wh.validateStartWorkflowArgsForSchedule(namespaceName, request.GetSchedule().GetAction().GetStartWorkflow()); err != nil {
 		return nil, err
 	}
 
 	input := &schedspb.FullUpdateRequest{
-		Schedule: request.Schedule,
+		Schedule:   request.Schedule,
+		Dependency: dependency,
 	}
 	if len(request.ConflictToken) >= 8 {
 		input.ConflictToken = int64(binary.BigEndian.Uint64(request.ConflictToken))
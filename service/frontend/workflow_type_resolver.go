@@ -0,0 +1,107 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/namespace"
+)
+
+const (
+	workflowTypeCacheMaxSize = 10000
+	// workflowTypeCacheTTL bounds how long a call to the current run of a workflow is authorized by the
+	// type of a previous run, after the workflow is started again with another type
+	workflowTypeCacheTTL = 10 * time.Second
+)
+
+type (
+	// workflowTypeResolver looks up the workflow types of executions in history for the authorizer,
+	// and caches them.
+	workflowTypeResolver struct {
+		historyClient     historyservice.HistoryServiceClient
+		namespaceRegistry namespace.Registry
+		cache             cache.Cache
+	}
+
+	workflowTypeCacheKey struct {
+		namespaceID namespace.ID
+		workflowID  string
+		runID       string
+	}
+)
+
+var _ authorization.WorkflowTypeResolver = (*workflowTypeResolver)(nil)
+
+func newWorkflowTypeResolver(
+	historyClient historyservice.HistoryServiceClient,
+	namespaceRegistry namespace.Registry,
+) *workflowTypeResolver {
+	return &workflowTypeResolver{
+		historyClient:     historyClient,
+		namespaceRegistry: namespaceRegistry,
+		cache:             cache.New(workflowTypeCacheMaxSize, &cache.Options{TTL: workflowTypeCacheTTL}),
+	}
+}
+
+func (r *workflowTypeResolver) GetWorkflowType(
+	ctx context.Context,
+	namespaceName string,
+	execution *commonpb.WorkflowExecution,
+) (string, error) {
+	namespaceID, err := r.namespaceRegistry.GetNamespaceID(namespace.Name(namespaceName))
+	if err != nil {
+		return "", err
+	}
+	key := workflowTypeCacheKey{
+		namespaceID: namespaceID,
+		workflowID:  execution.GetWorkflowId(),
+		runID:       execution.GetRunId(),
+	}
+	if workflowType, ok := r.cache.Get(key).(string); ok {
+		return workflowType, nil
+	}
+
+	resp, err := r.historyClient.DescribeWorkflowExecution(ctx, &historyservice.DescribeWorkflowExecutionRequest{
+		NamespaceId: namespaceID.String(),
+		Request: &workflowservice.DescribeWorkflowExecutionRequest{
+			Namespace: namespaceName,
+			Execution: execution,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	workflowType := resp.GetWorkflowExecutionInfo().GetType().GetName()
+	r.cache.Put(key, workflowType)
+	return workflowType, nil
+}
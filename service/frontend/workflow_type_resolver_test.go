@@ -0,0 +1,66 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/historyservicemock/v1"
+	"go.temporal.io/server/common/namespace"
+)
+
+func TestWorkflowTypeResolver(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	namespaceRegistry := namespace.NewMockRegistry(controller)
+	namespaceRegistry.EXPECT().GetNamespaceID(namespace.Name("test-namespace")).Return(namespace.ID("test-namespace-id"), nil).AnyTimes()
+	historyClient := historyservicemock.NewMockHistoryServiceClient(controller)
+	resolver := newWorkflowTypeResolver(historyClient, namespaceRegistry)
+
+	execution := &commonpb.WorkflowExecution{WorkflowId: "wid"}
+	historyClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.DescribeWorkflowExecutionRequest, _ ...interface{}) (*historyservice.DescribeWorkflowExecutionResponse, error) {
+			require.Equal(t, "test-namespace-id", request.GetNamespaceId())
+			require.Equal(t, execution, request.GetRequest().GetExecution())
+			return &historyservice.DescribeWorkflowExecutionResponse{
+				WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{Type: &commonpb.WorkflowType{Name: "OrderWorkflow"}},
+			}, nil
+		})
+
+	// the second lookup is served from the cache
+	for i := 0; i < 2; i++ {
+		workflowType, err := resolver.GetWorkflowType(context.Background(), "test-namespace", execution)
+		require.NoError(t, err)
+		require.Equal(t, "OrderWorkflow", workflowType)
+	}
+}
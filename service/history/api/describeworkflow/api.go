@@ -104,6 +104,7 @@ func Invoke(
 			StateTransitionCount: executionInfo.StateTransitionCount,
 			HistorySizeBytes:     executionInfo.GetExecutionStats().GetHistorySize(),
 		},
+		PauseInfo: executionInfo.PauseInfo,
 	}
 
 	if executionInfo.ParentRunId != "" {
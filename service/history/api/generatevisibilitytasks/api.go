@@ -0,0 +1,115 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package generatevisibilitytasks
+
+import (
+	"context"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+func Invoke(
+	ctx context.Context,
+	request *historyservice.GenerateVisibilityTasksRequest,
+	shard shard.Context,
+	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+) (_ *historyservice.GenerateVisibilityTasksResponse, retError error) {
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	if err := api.ValidateNamespaceUUID(namespaceID); err != nil {
+		return nil, err
+	}
+
+	workflowKey := definition.NewWorkflowKey(
+		namespaceID.String(),
+		request.GetExecution().GetWorkflowId(),
+		request.GetExecution().GetRunId(),
+	)
+	wfContext, err := workflowConsistencyChecker.GetWorkflowContext(
+		ctx,
+		nil,
+		api.BypassMutableStateConsistencyPredicate,
+		workflowKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { wfContext.GetReleaseFn()(retError) }()
+
+	mutableState := wfContext.GetMutableState()
+	workflowKey = mutableState.GetWorkflowKey()
+
+	// Visibility tasks are processed against the latest mutable state, so the
+	// versions below only need to pass the task version check of the executor.
+	var visibilityTasks []tasks.Task
+	if mutableState.IsWorkflowExecutionRunning() {
+		startVersion, err := mutableState.GetStartVersion()
+		if err != nil {
+			return nil, err
+		}
+		visibilityTasks = append(visibilityTasks,
+			&tasks.StartExecutionVisibilityTask{
+				// TaskID, VisibilityTimestamp is set by shard
+				WorkflowKey: workflowKey,
+				Version:     startVersion,
+			},
+			&tasks.UpsertExecutionVisibilityTask{
+				// TaskID, VisibilityTimestamp is set by shard
+				WorkflowKey: workflowKey,
+				Version:     mutableState.GetCurrentVersion(),
+			},
+		)
+	} else {
+		lastWriteVersion, err := mutableState.GetLastWriteVersion()
+		if err != nil {
+			return nil, err
+		}
+		visibilityTasks = append(visibilityTasks, &tasks.CloseExecutionVisibilityTask{
+			// TaskID, VisibilityTimestamp is set by shard
+			WorkflowKey: workflowKey,
+			Version:     lastWriteVersion,
+		})
+	}
+
+	err = shard.AddTasks(ctx, &persistence.AddHistoryTasksRequest{
+		ShardID: shard.GetShardID(),
+		// RangeID is set by shard
+		NamespaceID: workflowKey.NamespaceID,
+		WorkflowID:  workflowKey.WorkflowID,
+		RunID:       workflowKey.RunID,
+		Tasks: map[tasks.Category][]tasks.Task{
+			tasks.CategoryVisibility: visibilityTasks,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &historyservice.GenerateVisibilityTasksResponse{}, nil
+}
@@ -67,6 +67,9 @@ func Invoke(
 			if !mutableState.IsWorkflowExecutionRunning() {
 				return nil, consts.ErrWorkflowCompleted
 			}
+			if mutableState.IsWorkflowExecutionPaused() {
+				return nil, consts.ErrWorkflowPaused
+			}
 
 			scheduledEventID := request.GetScheduledEventId()
 			requestID := request.GetRequestId()
@@ -112,6 +115,10 @@ func Invoke(
 				return nil, serviceerrors.NewTaskAlreadyStarted("Activity")
 			}
 
+			if ai.Paused {
+				return nil, consts.ErrActivityPaused
+			}
+
 			if _, err := mutableState.AddActivityTaskStartedEvent(
 				ai, scheduledEventID, requestID, request.PollRequest.GetIdentity(),
 			); err != nil {
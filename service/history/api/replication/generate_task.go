@@ -63,7 +63,13 @@ func GenerateTask(
 	}
 	defer func() { wfContext.GetReleaseFn()(retError) }()
 
-	task, err := wfContext.GetMutableState().GenerateMigrationTasks()
+	mutableState := wfContext.GetMutableState()
+	if mutableState.GetExecutionInfo().GetReplicationExcluded() {
+		// the workflow type is not selected for replication
+		return &historyservice.GenerateLastHistoryReplicationTasksResponse{}, nil
+	}
+
+	task, err := mutableState.GenerateMigrationTasks()
 	if err != nil {
 		return nil, err
 	}
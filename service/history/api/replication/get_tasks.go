@@ -30,6 +30,7 @@ import (
 
 	replicationspb "go.temporal.io/server/api/replication/v1"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/service/history/replication"
 	"go.temporal.io/server/service/history/shard"
@@ -55,6 +56,15 @@ func GetTasks(
 			shard.GetLogger().Error("error updating replication level for shard", tag.Error(err), tag.OperationFailed)
 		}
 		shard.UpdateRemoteClusterInfo(pollingCluster, ackMessageID, ackTimestamp)
+
+		maxTaskID, _ := replicationAckMgr.GetMaxTaskInfo()
+		if lag, ok := OutboundLag(shard.GetTimeSource().Now(), maxTaskID, ackMessageID, ackTimestamp); ok {
+			shard.GetMetricsHandler().Timer(metrics.ReplicationOutboundLag.GetMetricName()).Record(
+				lag,
+				metrics.OperationTag(metrics.HistoryGetReplicationMessagesScope),
+				metrics.TargetClusterTag(pollingCluster),
+			)
+		}
 	}
 
 	replicationMessages, err := replicationAckMgr.GetTasks(
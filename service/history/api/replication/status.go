@@ -26,22 +26,34 @@ package replication
 
 import (
 	"context"
+	"math"
+	"time"
+
+	"golang.org/x/exp/slices"
 
 	"go.temporal.io/server/api/historyservice/v1"
+	replicationspb "go.temporal.io/server/api/replication/v1"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/service/history/replication"
 	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tasks"
 )
 
+// dlqTaskCountLimit bounds the number of DLQ tasks read to report the DLQ size
+const dlqTaskCountLimit = 1000
+
 func GetStatus(
 	ctx context.Context,
 	request *historyservice.GetReplicationStatusRequest,
 	shard shard.Context,
 	replicationAckMgr replication.AckManager,
+	replicationProcessorMgr replication.TaskProcessorManager,
 ) (_ *historyservice.ShardReplicationStatus, retError error) {
+	now := shard.GetTimeSource().Now()
 	resp := &historyservice.ShardReplicationStatus{
 		ShardId:        shard.GetShardID(),
-		ShardLocalTime: timestamp.TimePtr(shard.GetTimeSource().Now()),
+		ShardLocalTime: timestamp.TimePtr(now),
 	}
 
 	maxReplicationTaskId, maxTaskVisibilityTimeStamp := replicationAckMgr.GetMaxTaskInfo()
@@ -52,7 +64,72 @@ func GetStatus(
 	if err != nil {
 		return nil, err
 	}
+	for _, status := range remoteClusters {
+		if lag, ok := OutboundLag(now, maxReplicationTaskId, status.AckedTaskId, timestamp.TimeValue(status.AckedTaskVisibilityTime)); ok {
+			status.Lag = timestamp.DurationPtr(lag)
+		}
+	}
 	resp.RemoteClusters = remoteClusters
 	resp.HandoverNamespaces = handoverNamespaces
+
+	if request.GetIncludeInbound() {
+		resp.InboundClusters = make(map[string]*replicationspb.ShardReplicationInboundStatus)
+		for clusterName, processors := range replicationProcessorMgr.ProcessorStatus() {
+			if len(request.RemoteClusters) > 0 && !slices.Contains(request.RemoteClusters, clusterName) {
+				continue
+			}
+			dlqTaskCount, truncated, err := countDLQTasks(ctx, shard, clusterName)
+			if err != nil {
+				return nil, err
+			}
+			resp.InboundClusters[clusterName] = &replicationspb.ShardReplicationInboundStatus{
+				Processors:            processors,
+				DlqTaskCount:          dlqTaskCount,
+				DlqTaskCountTruncated: truncated,
+			}
+		}
+	}
 	return resp, nil
 }
+
+// OutboundLag returns an upper bound of the time replication tasks wait to be acked by a remote
+// cluster: the tasks that are not acked yet were created after the last acked task. It returns false
+// if the remote cluster has not acked any task yet.
+func OutboundLag(
+	now time.Time,
+	maxTaskID int64,
+	ackedTaskID int64,
+	ackedTaskVisibilityTime time.Time,
+) (time.Duration, bool) {
+	if ackedTaskID >= maxTaskID {
+		return 0, true
+	}
+	if ackedTaskVisibilityTime.IsZero() {
+		return 0, false
+	}
+	if lag := now.Sub(ackedTaskVisibilityTime); lag > 0 {
+		return lag, true
+	}
+	return 0, true
+}
+
+func countDLQTasks(
+	ctx context.Context,
+	shard shard.Context,
+	sourceCluster string,
+) (int64, bool, error) {
+	resp, err := shard.GetExecutionManager().GetReplicationTasksFromDLQ(ctx, &persistence.GetReplicationTasksFromDLQRequest{
+		GetHistoryTasksRequest: persistence.GetHistoryTasksRequest{
+			ShardID:             shard.GetShardID(),
+			TaskCategory:        tasks.CategoryReplication,
+			InclusiveMinTaskKey: tasks.NewImmediateKey(shard.GetReplicatorDLQAckLevel(sourceCluster) + 1),
+			ExclusiveMaxTaskKey: tasks.NewImmediateKey(math.MaxInt64),
+			BatchSize:           dlqTaskCountLimit,
+		},
+		SourceClusterName: sourceCluster,
+	})
+	if err != nil {
+		return 0, false, err
+	}
+	return int64(len(resp.Tasks)), len(resp.NextPageToken) > 0, nil
+}
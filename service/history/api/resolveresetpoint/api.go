@@ -0,0 +1,195 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package resolveresetpoint
+
+import (
+	"context"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	workflowspb "go.temporal.io/server/api/workflow/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/shard"
+)
+
+const historyPageSize = 256
+
+type (
+	executionSnapshot struct {
+		runID       string
+		nextEventID int64
+		branchToken []byte
+		resetPoints []*workflowpb.ResetPointInfo
+	}
+
+	// resetPointMatcher looks at history events in order and reports the first workflow task
+	// finish event that matches the selector.
+	resetPointMatcher struct {
+		selector *workflowspb.ResetPointSelector
+		// scheduled event id -> workflow task completed event id, for activities of the
+		// selected type
+		scheduledActivities map[int64]int64
+	}
+)
+
+// Invoke finds the workflow task finish event a workflow should be reset to for the given
+// selector. It does not modify the workflow.
+func Invoke(
+	ctx context.Context,
+	req *historyservice.ResolveResetPointRequest,
+	shard shard.Context,
+	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+) (*historyservice.ResolveResetPointResponse, error) {
+	namespaceID := namespace.ID(req.GetNamespaceId())
+	err := api.ValidateNamespaceUUID(namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetResetPointSelector().GetSelector() == nil {
+		return nil, serviceerror.NewInvalidArgument("Reset point selector is not set on request.")
+	}
+
+	// history is read after the workflow lock is released
+	snapshot, err := loadExecutionSnapshot(ctx, req, workflowConsistencyChecker)
+	if err != nil {
+		return nil, err
+	}
+
+	// binary checksums are recorded as auto-reset points, use them to avoid reading history
+	if checksum := req.ResetPointSelector.GetBinaryChecksum(); checksum != "" {
+		for _, point := range snapshot.resetPoints {
+			if point.GetBinaryChecksum() == checksum && point.GetRunId() == snapshot.runID && point.GetResettable() {
+				return newResponse(snapshot, point.GetFirstWorkflowTaskCompletedId()), nil
+			}
+		}
+	}
+
+	matcher := newResetPointMatcher(req.ResetPointSelector)
+	var nextPageToken []byte
+	for {
+		resp, err := shard.GetExecutionManager().ReadHistoryBranch(ctx, &persistence.ReadHistoryBranchRequest{
+			ShardID:       shard.GetShardID(),
+			BranchToken:   snapshot.branchToken,
+			MinEventID:    common.FirstEventID,
+			MaxEventID:    snapshot.nextEventID,
+			PageSize:      historyPageSize,
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, event := range resp.HistoryEvents {
+			if eventID, ok := matcher.match(event); ok {
+				return newResponse(snapshot, eventID), nil
+			}
+		}
+		nextPageToken = resp.NextPageToken
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+	return nil, serviceerror.NewNotFound("No reset point matches the selector.")
+}
+
+func loadExecutionSnapshot(
+	ctx context.Context,
+	req *historyservice.ResolveResetPointRequest,
+	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+) (_ *executionSnapshot, retError error) {
+	weCtx, err := workflowConsistencyChecker.GetWorkflowContext(
+		ctx,
+		nil,
+		api.BypassMutableStateConsistencyPredicate,
+		definition.NewWorkflowKey(
+			req.NamespaceId,
+			req.Execution.GetWorkflowId(),
+			req.Execution.GetRunId(),
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { weCtx.GetReleaseFn()(retError) }()
+
+	mutableState := weCtx.GetMutableState()
+	branchToken, err := mutableState.GetCurrentBranchToken()
+	if err != nil {
+		return nil, err
+	}
+	return &executionSnapshot{
+		runID:       mutableState.GetExecutionState().GetRunId(),
+		nextEventID: mutableState.GetNextEventID(),
+		branchToken: branchToken,
+		resetPoints: mutableState.GetExecutionInfo().GetAutoResetPoints().GetPoints(),
+	}, nil
+}
+
+func newResponse(snapshot *executionSnapshot, finishEventID int64) *historyservice.ResolveResetPointResponse {
+	return &historyservice.ResolveResetPointResponse{
+		RunId:                     snapshot.runID,
+		WorkflowTaskFinishEventId: finishEventID,
+		DiscardedEventCount:       snapshot.nextEventID - finishEventID,
+	}
+}
+
+func newResetPointMatcher(selector *workflowspb.ResetPointSelector) *resetPointMatcher {
+	return &resetPointMatcher{
+		selector:            selector,
+		scheduledActivities: make(map[int64]int64),
+	}
+}
+
+func (m *resetPointMatcher) match(event *historypb.HistoryEvent) (int64, bool) {
+	switch event.GetEventType() {
+	case enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED:
+		attrs := event.GetWorkflowTaskCompletedEventAttributes()
+		if buildID := m.selector.GetBuildId(); buildID != "" && attrs.GetWorkerVersioningId().GetWorkerBuildId() == buildID {
+			return event.GetEventId(), true
+		}
+		if checksum := m.selector.GetBinaryChecksum(); checksum != "" && attrs.GetBinaryChecksum() == checksum {
+			return event.GetEventId(), true
+		}
+	case enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED:
+		attrs := event.GetActivityTaskScheduledEventAttributes()
+		if activityType := m.selector.GetFailedActivityType(); activityType != "" && attrs.GetActivityType().GetName() == activityType {
+			m.scheduledActivities[event.GetEventId()] = attrs.GetWorkflowTaskCompletedEventId()
+		}
+	case enumspb.EVENT_TYPE_ACTIVITY_TASK_FAILED:
+		// reset to the workflow task that scheduled the failed activity, so it is scheduled again
+		attrs := event.GetActivityTaskFailedEventAttributes()
+		if eventID, ok := m.scheduledActivities[attrs.GetScheduledEventId()]; ok {
+			return eventID, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,137 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package resolveresetpoint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+
+	workflowspb "go.temporal.io/server/api/workflow/v1"
+)
+
+func testHistory() []*historypb.HistoryEvent {
+	return []*historypb.HistoryEvent{
+		{EventId: 1, EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED},
+		{EventId: 2, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED},
+		{EventId: 3, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_STARTED},
+		{
+			EventId:   4,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED,
+			Attributes: &historypb.HistoryEvent_WorkflowTaskCompletedEventAttributes{
+				WorkflowTaskCompletedEventAttributes: &historypb.WorkflowTaskCompletedEventAttributes{
+					BinaryChecksum: "checksum-1",
+				},
+			},
+		},
+		{
+			EventId:   5,
+			EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED,
+			Attributes: &historypb.HistoryEvent_ActivityTaskScheduledEventAttributes{
+				ActivityTaskScheduledEventAttributes: &historypb.ActivityTaskScheduledEventAttributes{
+					ActivityType:                 &commonpb.ActivityType{Name: "charge"},
+					WorkflowTaskCompletedEventId: 4,
+				},
+			},
+		},
+		{EventId: 6, EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_STARTED},
+		{
+			EventId:   7,
+			EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_FAILED,
+			Attributes: &historypb.HistoryEvent_ActivityTaskFailedEventAttributes{
+				ActivityTaskFailedEventAttributes: &historypb.ActivityTaskFailedEventAttributes{
+					ScheduledEventId: 5,
+				},
+			},
+		},
+		{EventId: 8, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED},
+		{EventId: 9, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_STARTED},
+		{
+			EventId:   10,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED,
+			Attributes: &historypb.HistoryEvent_WorkflowTaskCompletedEventAttributes{
+				WorkflowTaskCompletedEventAttributes: &historypb.WorkflowTaskCompletedEventAttributes{
+					BinaryChecksum:     "checksum-2",
+					WorkerVersioningId: &taskqueuepb.VersionId{WorkerBuildId: "build-2"},
+				},
+			},
+		},
+	}
+}
+
+func TestResetPointMatcher(t *testing.T) {
+	testCases := []struct {
+		name     string
+		selector *workflowspb.ResetPointSelector
+		eventID  int64
+		found    bool
+	}{
+		{
+			name:     "build id",
+			selector: &workflowspb.ResetPointSelector{Selector: &workflowspb.ResetPointSelector_BuildId{BuildId: "build-2"}},
+			eventID:  10,
+			found:    true,
+		},
+		{
+			name:     "build id does not match binary checksum",
+			selector: &workflowspb.ResetPointSelector{Selector: &workflowspb.ResetPointSelector_BuildId{BuildId: "checksum-1"}},
+		},
+		{
+			name:     "binary checksum",
+			selector: &workflowspb.ResetPointSelector{Selector: &workflowspb.ResetPointSelector_BinaryChecksum{BinaryChecksum: "checksum-1"}},
+			eventID:  4,
+			found:    true,
+		},
+		{
+			name:     "failed activity type",
+			selector: &workflowspb.ResetPointSelector{Selector: &workflowspb.ResetPointSelector_FailedActivityType{FailedActivityType: "charge"}},
+			eventID:  4,
+			found:    true,
+		},
+		{
+			name:     "no match",
+			selector: &workflowspb.ResetPointSelector{Selector: &workflowspb.ResetPointSelector_FailedActivityType{FailedActivityType: "refund"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			matcher := newResetPointMatcher(tc.selector)
+			var eventID int64
+			var found bool
+			for _, event := range testHistory() {
+				if eventID, found = matcher.match(event); found {
+					break
+				}
+			}
+			require.Equal(t, tc.found, found)
+			require.Equal(t, tc.eventID, eventID)
+		})
+	}
+}
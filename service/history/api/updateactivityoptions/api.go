@@ -0,0 +1,125 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package updateactivityoptions
+
+import (
+	"context"
+
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	workflowspb "go.temporal.io/server/api/workflow/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/consts"
+	"go.temporal.io/server/service/history/shard"
+)
+
+func Invoke(
+	ctx context.Context,
+	req *historyservice.UpdateActivityExecutionOptionsRequest,
+	shard shard.Context,
+	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+) (resp *historyservice.UpdateActivityExecutionOptionsResponse, retError error) {
+	namespaceEntry, err := api.GetActiveNamespace(shard, namespace.ID(req.GetNamespaceId()))
+	if err != nil {
+		return nil, err
+	}
+
+	options := req.GetOptions()
+	if err := validateOptions(shard, namespaceEntry, options); err != nil {
+		return nil, err
+	}
+
+	err = api.GetAndUpdateWorkflowWithNew(
+		ctx,
+		nil,
+		api.BypassMutableStateConsistencyPredicate,
+		definition.NewWorkflowKey(
+			namespaceEntry.ID().String(),
+			req.Execution.GetWorkflowId(),
+			req.Execution.GetRunId(),
+		),
+		func(workflowContext api.WorkflowContext) (*api.UpdateWorkflowAction, error) {
+			mutableState := workflowContext.GetMutableState()
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, consts.ErrWorkflowCompleted
+			}
+
+			scheduledEventID, err := api.GetActivityScheduledEventID(req.GetActivityId(), mutableState)
+			if err != nil {
+				return nil, err
+			}
+			ai, ok := mutableState.GetActivityInfo(scheduledEventID)
+			if !ok {
+				return nil, consts.ErrActivityTaskNotFound
+			}
+
+			if err := mutableState.UpdateActivityOptions(ctx, ai, options); err != nil {
+				return nil, err
+			}
+			return &api.UpdateWorkflowAction{
+				Noop:               false,
+				CreateWorkflowTask: false,
+			}, nil
+		},
+		nil,
+		shard,
+		workflowConsistencyChecker,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &historyservice.UpdateActivityExecutionOptionsResponse{}, nil
+}
+
+func validateOptions(
+	shard shard.Context,
+	namespaceEntry *namespace.Namespace,
+	options *workflowspb.ActivityOptions,
+) error {
+	if options == nil {
+		return serviceerror.NewInvalidArgument("activity options are not set")
+	}
+	if timestamp.DurationValue(options.GetScheduleToCloseTimeout()) < 0 ||
+		timestamp.DurationValue(options.GetScheduleToStartTimeout()) < 0 ||
+		timestamp.DurationValue(options.GetStartToCloseTimeout()) < 0 ||
+		timestamp.DurationValue(options.GetHeartbeatTimeout()) < 0 {
+		return serviceerror.NewInvalidArgument("activity timeouts cannot be negative")
+	}
+	if options.GetRetryPolicy() != nil {
+		defaultActivityRetrySettings := common.FromConfigToDefaultRetrySettings(
+			shard.GetConfig().DefaultActivityRetryPolicy(namespaceEntry.Name().String()),
+		)
+		common.EnsureRetryPolicyDefaults(options.RetryPolicy, defaultActivityRetrySettings)
+		if err := common.ValidateRetryPolicy(options.RetryPolicy); err != nil {
+			return err
+		}
+	}
+	return nil
+}
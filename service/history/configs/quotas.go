@@ -59,6 +59,14 @@ var (
 		"RequestCancelWorkflowExecution":         0,
 		"ResetStickyTaskQueue":                   0,
 		"ResetWorkflowExecution":                 0,
+		"ResolveResetPoint":                      0,
+		"PauseWorkflowExecution":                 0,
+		"UnpauseWorkflowExecution":               0,
+		"PauseActivityExecution":                 0,
+		"UnpauseActivityExecution":               0,
+		"ResetActivityExecution":                 0,
+		"UpdateActivityExecutionOptions":         0,
+		"GenerateVisibilityTasks":                0,
 		"RespondActivityTaskCanceled":            0,
 		"RespondActivityTaskCompleted":           0,
 		"RespondActivityTaskFailed":              0,
@@ -60,6 +60,12 @@ var (
 	ErrActivityTaskNotCancelRequested = serviceerror.NewInvalidArgument("unable to mark activity as canceled without activity being request canceled first")
 	// ErrWorkflowCompleted is the error to indicate workflow execution already completed
 	ErrWorkflowCompleted = serviceerror.NewNotFound("workflow execution already completed")
+	// ErrWorkflowPaused is the error to indicate workflow execution is paused and its tasks must not be dispatched.
+	// Matching drops the task, it is regenerated when the workflow is unpaused.
+	ErrWorkflowPaused = serviceerror.NewNotFound("workflow execution is paused")
+	// ErrActivityPaused is the error to indicate activity is paused and must not be dispatched.
+	// Matching drops the task, it is regenerated when the activity is unpaused.
+	ErrActivityPaused = serviceerror.NewNotFound("activity is paused")
 	// ErrWorkflowExecutionNotFound is the error to indicate workflow execution does not exist
 	ErrWorkflowExecutionNotFound = serviceerror.NewNotFound("workflow execution not found")
 	// ErrWorkflowParent is the error to parent execution is given and mismatch
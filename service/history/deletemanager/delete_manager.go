@@ -32,6 +32,7 @@ import (
 
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/common/clock"
@@ -40,6 +41,7 @@ import (
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/shard"
@@ -238,6 +240,10 @@ func (m *DeleteManagerImpl) deleteWorkflowExecutionInternal(
 		}
 	}
 
+	if err := m.deleteOffloadedPayloads(ctx, namespaceID, we, ms); err != nil {
+		return err
+	}
+
 	if err := m.shard.DeleteWorkflowExecution(
 		ctx,
 		definition.WorkflowKey{
@@ -261,6 +267,47 @@ func (m *DeleteManagerImpl) deleteWorkflowExecutionInternal(
 	return nil
 }
 
+// deleteOffloadedPayloads deletes the offloaded payloads of the workflow ID when its current run is
+// deleted. Runs of a workflow ID share payloads, and earlier runs close, and are deleted, before the
+// current one. Payloads offloaded after the run closed belong to a later run and are kept.
+// Payloads are also kept when history archival is enabled, since archived history refers to them.
+func (m *DeleteManagerImpl) deleteOffloadedPayloads(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	we commonpb.WorkflowExecution,
+	ms workflow.MutableState,
+) error {
+	offloader := m.shard.GetPayloadOffloader()
+	if offloader == nil || m.historyArchivalEnabled(ms.GetNamespaceEntry()) {
+		return nil
+	}
+
+	resp, err := m.shard.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+		ShardID:     m.shard.GetShardID(),
+		NamespaceID: namespaceID.String(),
+		WorkflowID:  we.GetWorkflowId(),
+	})
+	if err != nil {
+		if _, isNotFound := err.(*serviceerror.NotFound); isNotFound {
+			return nil
+		}
+		return err
+	}
+	if resp.RunID != we.GetRunId() {
+		return nil
+	}
+
+	before := m.timeSource.Now()
+	if ms.GetExecutionState().State == enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED {
+		closeTime, err := ms.GetWorkflowCloseTime(ctx)
+		if err != nil {
+			return err
+		}
+		before = timestamp.TimeValue(closeTime)
+	}
+	return offloader.DeleteWorkflow(ctx, namespaceID.String(), we.GetWorkflowId(), before)
+}
+
 func (m *DeleteManagerImpl) archiveWorkflowIfEnabled(
 	ctx context.Context,
 	namespaceID namespace.ID,
@@ -273,12 +320,8 @@ func (m *DeleteManagerImpl) archiveWorkflowIfEnabled(
 
 	namespaceRegistryEntry := ms.GetNamespaceEntry()
 
-	clusterConfiguredForHistoryArchival := m.shard.GetArchivalMetadata().GetHistoryConfig().ClusterConfiguredForArchival()
-	namespaceConfiguredForHistoryArchival := namespaceRegistryEntry.HistoryArchivalState().State == enumspb.ARCHIVAL_STATE_ENABLED
-	archiveHistory := clusterConfiguredForHistoryArchival && namespaceConfiguredForHistoryArchival
-
 	// TODO: @ycyang once archival backfill is in place cluster:paused && namespace:enabled should be a nop rather than a delete
-	if !archiveHistory {
+	if !m.historyArchivalEnabled(namespaceRegistryEntry) {
 		return false, nil
 	}
 
@@ -332,3 +375,9 @@ func (m *DeleteManagerImpl) archiveWorkflowIfEnabled(
 	// only archival through archival workflow will
 	return !resp.HistoryArchivedInline, nil
 }
+
+func (m *DeleteManagerImpl) historyArchivalEnabled(namespaceEntry *namespace.Namespace) bool {
+	clusterConfiguredForHistoryArchival := m.shard.GetArchivalMetadata().GetHistoryConfig().ClusterConfiguredForArchival()
+	namespaceConfiguredForHistoryArchival := namespaceEntry.HistoryArchivalState().State == enumspb.ARCHIVAL_STATE_ENABLED
+	return clusterConfiguredForHistoryArchival && namespaceConfiguredForHistoryArchival
+}
@@ -44,8 +44,11 @@ import (
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloadstore"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/service/history/shard"
@@ -101,6 +104,7 @@ func (s *deleteManagerWorkflowSuite) SetupTest() {
 	s.mockShardContext.EXPECT().GetMetricsHandler().Return(metrics.NoopMetricsHandler).AnyTimes()
 	s.mockShardContext.EXPECT().GetNamespaceRegistry().Return(s.mockNamespaceRegistry).AnyTimes()
 	s.mockShardContext.EXPECT().GetClusterMetadata().Return(s.mockMetadata).AnyTimes()
+	s.mockShardContext.EXPECT().GetPayloadOffloader().Return(nil).AnyTimes()
 
 	s.deleteManager = NewDeleteManager(
 		s.mockShardContext,
@@ -419,3 +423,111 @@ func (m archiverClientRequestMatcher) Matches(x interface{}) bool {
 func (m archiverClientRequestMatcher) String() string {
 	return "archiverClientRequestMatcher"
 }
+
+func (s *deleteManagerWorkflowSuite) TestDeleteOffloadedPayloads() {
+	closeTime := time.Date(1978, 8, 22, 1, 2, 3, 4, time.UTC)
+	now := time.Date(1978, 8, 23, 1, 2, 3, 4, time.UTC)
+	testCases := []struct {
+		name            string
+		currentRunID    string
+		state           enumsspb.WorkflowExecutionState
+		historyArchival bool
+		expectedBefore  *time.Time
+	}{
+		{
+			name:           "current closed run",
+			currentRunID:   tests.RunID,
+			state:          enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED,
+			expectedBefore: &closeTime,
+		},
+		{
+			name:           "current open run",
+			currentRunID:   tests.RunID,
+			state:          enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+			expectedBefore: &now,
+		},
+		{
+			name:         "not current run",
+			currentRunID: "other-run-id",
+			state:        enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED,
+		},
+		{
+			name:            "history archival enabled",
+			currentRunID:    tests.RunID,
+			state:           enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED,
+			historyArchival: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			store := &fakePayloadStore{}
+			mockShardContext := shard.NewMockContext(s.controller)
+			mockShardContext.EXPECT().GetMetricsHandler().Return(metrics.NoopMetricsHandler).AnyTimes()
+			mockShardContext.EXPECT().GetPayloadOffloader().Return(payloadstore.NewOffloader(store, metrics.NoopMetricsHandler, log.NewNoopLogger()))
+			mockShardContext.EXPECT().GetShardID().Return(int32(1)).AnyTimes()
+			mockClusterArchivalMetadata := carchiver.NewMockArchivalMetadata(s.controller)
+			mockClusterArchivalConfig := carchiver.NewMockArchivalConfig(s.controller)
+			mockShardContext.EXPECT().GetArchivalMetadata().Return(mockClusterArchivalMetadata)
+			mockClusterArchivalMetadata.EXPECT().GetHistoryConfig().Return(mockClusterArchivalConfig)
+			mockClusterArchivalConfig.EXPECT().ClusterConfiguredForArchival().Return(true)
+
+			historyArchivalState := enums.ARCHIVAL_STATE_DISABLED
+			if tc.historyArchival {
+				historyArchivalState = enums.ARCHIVAL_STATE_ENABLED
+			}
+			mockMutableState := workflow.NewMockMutableState(s.controller)
+			mockMutableState.EXPECT().GetNamespaceEntry().Return(namespace.NewLocalNamespaceForTest(
+				&persistencespb.NamespaceInfo{Name: tests.Namespace.String()},
+				&persistencespb.NamespaceConfig{HistoryArchivalState: historyArchivalState},
+				"target-cluster",
+			))
+			mockMutableState.EXPECT().GetExecutionState().Return(&persistencespb.WorkflowExecutionState{State: tc.state}).AnyTimes()
+			mockMutableState.EXPECT().GetWorkflowCloseTime(gomock.Any()).Return(&closeTime, nil).AnyTimes()
+			if !tc.historyArchival {
+				mockShardContext.EXPECT().GetCurrentExecution(gomock.Any(), &persistence.GetCurrentExecutionRequest{
+					ShardID:     1,
+					NamespaceID: tests.NamespaceID.String(),
+					WorkflowID:  tests.WorkflowID,
+				}).Return(&persistence.GetCurrentExecutionResponse{RunID: tc.currentRunID}, nil)
+			}
+
+			deleteManager := NewDeleteManager(
+				mockShardContext,
+				s.mockCache,
+				tests.NewDynamicConfig(),
+				s.mockArchivalClient,
+				clock.NewEventTimeSource().Update(now),
+			)
+			err := deleteManager.deleteOffloadedPayloads(
+				context.Background(),
+				tests.NamespaceID,
+				commonpb.WorkflowExecution{WorkflowId: tests.WorkflowID, RunId: tests.RunID},
+				mockMutableState,
+			)
+			s.NoError(err)
+			if tc.expectedBefore == nil {
+				s.Nil(store.deletedBefore)
+			} else {
+				s.NotNil(store.deletedBefore)
+				s.Equal(*tc.expectedBefore, *store.deletedBefore)
+			}
+		})
+	}
+}
+
+// fakePayloadStore records the cutoff of the blobs it is asked to delete
+type fakePayloadStore struct {
+	deletedBefore *time.Time
+}
+
+func (f *fakePayloadStore) Put(context.Context, string, []byte) error { return nil }
+
+func (f *fakePayloadStore) Get(context.Context, string) ([]byte, error) { return nil, nil }
+
+func (f *fakePayloadStore) Delete(context.Context, string) error { return nil }
+
+func (f *fakePayloadStore) DeletePrefix(_ context.Context, _ string, before time.Time) error {
+	f.deletedBefore = &before
+	return nil
+}
@@ -145,6 +145,7 @@ func HandlerProvider(args NewHandlerArgs) *Handler {
 		eventNotifier:                 args.EventNotifier,
 		replicationTaskFetcherFactory: args.ReplicationTaskFetcherFactory,
 		tracer:                        args.TracerProvider.Tracer(consts.LibraryName),
+		tlsConfigProvider:             args.TLSConfigProvider,
 	}
 
 	// prevent us from trying to serve requests before shard controller is started and ready
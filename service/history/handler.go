@@ -58,6 +58,7 @@ import (
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store/standard/cassandra"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/rpc/encryption"
 	"go.temporal.io/server/common/searchattribute"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
 	"go.temporal.io/server/service/history/api"
@@ -95,6 +96,7 @@ type (
 		hostInfoProvider              membership.HostInfoProvider
 		controller                    shard.Controller
 		tracer                        trace.Tracer
+		tlsConfigProvider             encryption.TLSConfigProvider
 	}
 
 	NewHandlerArgs struct {
@@ -119,6 +121,7 @@ type (
 		EventNotifier                 events.Notifier
 		ReplicationTaskFetcherFactory replication.TaskFetcherFactory
 		TracerProvider                trace.TracerProvider
+		TLSConfigProvider             encryption.TLSConfigProvider `optional:"true"`
 	}
 )
 
@@ -553,6 +556,12 @@ func (h *Handler) DescribeHistoryHost(_ context.Context, _ *historyservice.Descr
 		},
 		Address: h.hostInfoProvider.HostInfo().GetAddress(),
 	}
+
+	certificates, err := encryption.GetCertificates(h.tlsConfigProvider)
+	if err != nil {
+		h.logger.Warn("Unable to list TLS certificates", tag.Error(err))
+	}
+	resp.Certificates = certificates
 	return resp, nil
 }
 
@@ -1041,6 +1050,262 @@ func (h *Handler) ResetWorkflowExecution(ctx context.Context, request *historyse
 	return resp, nil
 }
 
+// ResolveResetPoint finds the reset point of a workflow for the given selector.
+func (h *Handler) ResolveResetPoint(ctx context.Context, request *historyservice.ResolveResetPointRequest) (_ *historyservice.ResolveResetPointResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	if namespaceID == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+
+	workflowID := request.Execution.GetWorkflowId()
+	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	resp, err := engine.ResolveResetPoint(ctx, request)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	return resp, nil
+}
+
+// PauseWorkflowExecution pauses a workflow execution.
+func (h *Handler) PauseWorkflowExecution(ctx context.Context, request *historyservice.PauseWorkflowExecutionRequest) (_ *historyservice.PauseWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	if namespaceID == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+
+	workflowID := request.Execution.GetWorkflowId()
+	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	resp, err := engine.PauseWorkflowExecution(ctx, request)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	return resp, nil
+}
+
+// UnpauseWorkflowExecution unpauses a workflow execution.
+func (h *Handler) UnpauseWorkflowExecution(ctx context.Context, request *historyservice.UnpauseWorkflowExecutionRequest) (_ *historyservice.UnpauseWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	if namespaceID == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+
+	workflowID := request.Execution.GetWorkflowId()
+	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	resp, err := engine.UnpauseWorkflowExecution(ctx, request)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	return resp, nil
+}
+
+// PauseActivityExecution stops dispatching a pending activity until it is unpaused.
+func (h *Handler) PauseActivityExecution(ctx context.Context, request *historyservice.PauseActivityExecutionRequest) (_ *historyservice.PauseActivityExecutionResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	if namespaceID == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+
+	workflowID := request.GetExecution().GetWorkflowId()
+	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	resp, err := engine.PauseActivityExecution(ctx, request)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	return resp, nil
+}
+
+// UnpauseActivityExecution resumes dispatching a paused activity.
+func (h *Handler) UnpauseActivityExecution(ctx context.Context, request *historyservice.UnpauseActivityExecutionRequest) (_ *historyservice.UnpauseActivityExecutionResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	if namespaceID == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+
+	workflowID := request.GetExecution().GetWorkflowId()
+	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	resp, err := engine.UnpauseActivityExecution(ctx, request)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	return resp, nil
+}
+
+// ResetActivityExecution resets the attempt count of a pending activity.
+func (h *Handler) ResetActivityExecution(ctx context.Context, request *historyservice.ResetActivityExecutionRequest) (_ *historyservice.ResetActivityExecutionResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	if namespaceID == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+
+	workflowID := request.GetExecution().GetWorkflowId()
+	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	resp, err := engine.ResetActivityExecution(ctx, request)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	return resp, nil
+}
+
+// UpdateActivityExecutionOptions updates the retry policy and timeouts of a pending activity.
+func (h *Handler) UpdateActivityExecutionOptions(ctx context.Context, request *historyservice.UpdateActivityExecutionOptionsRequest) (_ *historyservice.UpdateActivityExecutionOptionsResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	if namespaceID == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+
+	workflowID := request.GetExecution().GetWorkflowId()
+	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	resp, err := engine.UpdateActivityExecutionOptions(ctx, request)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	return resp, nil
+}
+
+// GenerateVisibilityTasks re-enqueues the visibility tasks of a workflow execution
+func (h *Handler) GenerateVisibilityTasks(ctx context.Context, request *historyservice.GenerateVisibilityTasksRequest) (_ *historyservice.GenerateVisibilityTasksResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	if namespaceID == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+
+	workflowID := request.GetExecution().GetWorkflowId()
+	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	resp, err := engine.GenerateVisibilityTasks(ctx, request)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	return resp, nil
+}
+
 // QueryWorkflow queries a workflow.
 func (h *Handler) QueryWorkflow(ctx context.Context, request *historyservice.QueryWorkflowRequest) (_ *historyservice.QueryWorkflowResponse, retError error) {
 	defer log.CapturePanic(h.logger, &retError)
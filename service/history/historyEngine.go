@@ -56,6 +56,9 @@ import (
 	"go.temporal.io/server/service/history/api/deleteworkflow"
 	"go.temporal.io/server/service/history/api/describemutablestate"
 	"go.temporal.io/server/service/history/api/describeworkflow"
+	"go.temporal.io/server/service/history/api/generatevisibilitytasks"
+	"go.temporal.io/server/service/history/api/pauseactivity"
+	"go.temporal.io/server/service/history/api/pauseworkflow"
 	"go.temporal.io/server/service/history/api/queryworkflow"
 	"go.temporal.io/server/service/history/api/reapplyevents"
 	"go.temporal.io/server/service/history/api/recordactivitytaskheartbeat"
@@ -66,8 +69,10 @@ import (
 	replicationapi "go.temporal.io/server/service/history/api/replication"
 	"go.temporal.io/server/service/history/api/replicationadmin"
 	"go.temporal.io/server/service/history/api/requestcancelworkflow"
+	"go.temporal.io/server/service/history/api/resetactivity"
 	"go.temporal.io/server/service/history/api/resetstickytaskqueue"
 	"go.temporal.io/server/service/history/api/resetworkflow"
+	"go.temporal.io/server/service/history/api/resolveresetpoint"
 	"go.temporal.io/server/service/history/api/respondactivitytaskcanceled"
 	"go.temporal.io/server/service/history/api/respondactivitytaskcompleted"
 	"go.temporal.io/server/service/history/api/respondactivitytaskfailed"
@@ -75,6 +80,9 @@ import (
 	"go.temporal.io/server/service/history/api/signalworkflow"
 	"go.temporal.io/server/service/history/api/startworkflow"
 	"go.temporal.io/server/service/history/api/terminateworkflow"
+	"go.temporal.io/server/service/history/api/unpauseactivity"
+	"go.temporal.io/server/service/history/api/unpauseworkflow"
+	"go.temporal.io/server/service/history/api/updateactivityoptions"
 	"go.temporal.io/server/service/history/api/updateworkflow"
 	"go.temporal.io/server/service/history/api/verifychildworkflowcompletionrecorded"
 	"go.temporal.io/server/service/history/configs"
@@ -107,7 +115,7 @@ type (
 		replicationAckMgr          replication.AckManager
 		nDCReplicator              ndc.HistoryReplicator
 		nDCActivityReplicator      ndc.ActivityReplicator
-		replicationProcessorMgr    common.Daemon
+		replicationProcessorMgr    replication.TaskProcessorManager
 		eventNotifier              events.Notifier
 		tokenSerializer            common.TaskTokenSerializer
 		metricsHandler             metrics.Handler
@@ -633,6 +641,70 @@ func (e *historyEngineImpl) ResetWorkflowExecution(
 	return resetworkflow.Invoke(ctx, req, e.shard, e.workflowConsistencyChecker)
 }
 
+// ResolveResetPoint finds the reset point of a workflow for the given selector without resetting it
+func (e *historyEngineImpl) ResolveResetPoint(
+	ctx context.Context,
+	req *historyservice.ResolveResetPointRequest,
+) (*historyservice.ResolveResetPointResponse, error) {
+	return resolveresetpoint.Invoke(ctx, req, e.shard, e.workflowConsistencyChecker)
+}
+
+// PauseWorkflowExecution stops dispatching tasks of a workflow execution and holds its timers
+func (e *historyEngineImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	req *historyservice.PauseWorkflowExecutionRequest,
+) (*historyservice.PauseWorkflowExecutionResponse, error) {
+	return pauseworkflow.Invoke(ctx, req, e.shard, e.workflowConsistencyChecker)
+}
+
+// UnpauseWorkflowExecution resumes a paused workflow execution and regenerates its tasks
+func (e *historyEngineImpl) UnpauseWorkflowExecution(
+	ctx context.Context,
+	req *historyservice.UnpauseWorkflowExecutionRequest,
+) (*historyservice.UnpauseWorkflowExecutionResponse, error) {
+	return unpauseworkflow.Invoke(ctx, req, e.shard, e.workflowConsistencyChecker)
+}
+
+// PauseActivityExecution stops dispatching a pending activity until it is unpaused
+func (e *historyEngineImpl) PauseActivityExecution(
+	ctx context.Context,
+	req *historyservice.PauseActivityExecutionRequest,
+) (*historyservice.PauseActivityExecutionResponse, error) {
+	return pauseactivity.Invoke(ctx, req, e.shard, e.workflowConsistencyChecker)
+}
+
+// UnpauseActivityExecution resumes dispatching a paused activity
+func (e *historyEngineImpl) UnpauseActivityExecution(
+	ctx context.Context,
+	req *historyservice.UnpauseActivityExecutionRequest,
+) (*historyservice.UnpauseActivityExecutionResponse, error) {
+	return unpauseactivity.Invoke(ctx, req, e.shard, e.workflowConsistencyChecker)
+}
+
+// ResetActivityExecution resets the attempt count of a pending activity
+func (e *historyEngineImpl) ResetActivityExecution(
+	ctx context.Context,
+	req *historyservice.ResetActivityExecutionRequest,
+) (*historyservice.ResetActivityExecutionResponse, error) {
+	return resetactivity.Invoke(ctx, req, e.shard, e.workflowConsistencyChecker)
+}
+
+// UpdateActivityExecutionOptions updates the retry policy and timeouts of a pending activity
+func (e *historyEngineImpl) UpdateActivityExecutionOptions(
+	ctx context.Context,
+	req *historyservice.UpdateActivityExecutionOptionsRequest,
+) (*historyservice.UpdateActivityExecutionOptionsResponse, error) {
+	return updateactivityoptions.Invoke(ctx, req, e.shard, e.workflowConsistencyChecker)
+}
+
+// GenerateVisibilityTasks re-enqueues the visibility tasks of a workflow execution
+func (e *historyEngineImpl) GenerateVisibilityTasks(
+	ctx context.Context,
+	req *historyservice.GenerateVisibilityTasksRequest,
+) (*historyservice.GenerateVisibilityTasksResponse, error) {
+	return generatevisibilitytasks.Invoke(ctx, req, e.shard, e.workflowConsistencyChecker)
+}
+
 func (e *historyEngineImpl) NotifyNewHistoryEvent(
 	notification *events.Notification,
 ) {
@@ -746,5 +818,5 @@ func (e *historyEngineImpl) GetReplicationStatus(
 	ctx context.Context,
 	request *historyservice.GetReplicationStatusRequest,
 ) (_ *historyservice.ShardReplicationStatus, retError error) {
-	return replicationapi.GetStatus(ctx, request, e.shard, e.replicationAckMgr)
+	return replicationapi.GetStatus(ctx, request, e.shard, e.replicationAckMgr, e.replicationProcessorMgr)
 }
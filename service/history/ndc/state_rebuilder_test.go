@@ -366,3 +366,64 @@ func (s *stateRebuilderSuite) TestRebuild() {
 	s.Equal(timestamp.TimeValue(rebuildMutableState.GetExecutionInfo().StartTime), s.now)
 	s.Equal(expectedLastFirstTransactionID, rebuildExecutionInfo.LastFirstEventTxnId)
 }
+
+func (s *stateRebuilderSuite) TestRebuild_ReplicationExcluded() {
+	requestID := uuid.New()
+	version := int64(12)
+	lastEventID := int64(1)
+	branchToken := []byte("other random branch token")
+	targetBranchToken := []byte("some other random branch token")
+
+	targetNamespaceID := namespace.ID(uuid.New())
+	targetNamespace := namespace.Name("other random namespace name")
+	targetRunID := uuid.New()
+
+	// the workflow type is not replicated, a reset run rebuilt from its history has to stay excluded
+	s.mockShard.GetConfig().ReplicatedWorkflowTypes = func(namespace string) map[string]any {
+		return map[string]any{"some replicated workflow type": true}
+	}
+	events := []*historypb.HistoryEvent{{
+		EventId:   1,
+		Version:   version,
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+		Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+			WorkflowType:             &commonpb.WorkflowType{Name: "some random workflow type"},
+			TaskQueue:                &taskqueuepb.TaskQueue{Name: "some random workflow type"},
+			WorkflowExecutionTimeout: timestamp.DurationPtr(123 * time.Second),
+			WorkflowRunTimeout:       timestamp.DurationPtr(233 * time.Second),
+			WorkflowTaskTimeout:      timestamp.DurationPtr(45 * time.Second),
+		}},
+	}}
+	s.mockExecutionManager.EXPECT().ReadHistoryBranchByBatch(gomock.Any(), gomock.Any()).Return(&persistence.ReadHistoryBranchByBatchResponse{
+		History:        []*historypb.History{{Events: events}},
+		TransactionIDs: []int64{10},
+		Size:           12345,
+	}, nil)
+	s.mockNamespaceCache.EXPECT().GetNamespaceByID(targetNamespaceID).Return(namespace.NewGlobalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: targetNamespaceID.String(), Name: targetNamespace.String()},
+		&persistencespb.NamespaceConfig{},
+		&persistencespb.NamespaceReplicationConfig{
+			ActiveClusterName: cluster.TestCurrentClusterName,
+			Clusters: []string{
+				cluster.TestCurrentClusterName,
+				cluster.TestAlternativeClusterName,
+			},
+		},
+		1234,
+	), nil).AnyTimes()
+	s.mockTaskRefresher.EXPECT().RefreshTasks(gomock.Any(), gomock.Any()).Return(nil)
+
+	rebuildMutableState, _, err := s.nDCStateRebuilder.Rebuild(
+		context.Background(),
+		s.now,
+		definition.NewWorkflowKey(s.namespaceID.String(), s.workflowID, s.runID),
+		branchToken,
+		lastEventID,
+		convert.Int64Ptr(version),
+		definition.NewWorkflowKey(targetNamespaceID.String(), s.workflowID, targetRunID),
+		targetBranchToken,
+		requestID,
+	)
+	s.NoError(err)
+	s.True(rebuildMutableState.GetExecutionInfo().GetReplicationExcluded())
+}
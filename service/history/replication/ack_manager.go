@@ -436,20 +436,23 @@ func (p *ackMgrImpl) generateSyncActivityTask(
 				SourceTaskId: taskID,
 				Attributes: &replicationspb.ReplicationTask_SyncActivityTaskAttributes{
 					SyncActivityTaskAttributes: &replicationspb.SyncActivityTaskAttributes{
-						NamespaceId:        namespaceID.String(),
-						WorkflowId:         workflowID,
-						RunId:              runID,
-						Version:            activityInfo.Version,
-						ScheduledEventId:   activityInfo.ScheduledEventId,
-						ScheduledTime:      activityInfo.ScheduledTime,
-						StartedEventId:     activityInfo.StartedEventId,
-						StartedTime:        startedTime,
-						LastHeartbeatTime:  activityInfo.LastHeartbeatUpdateTime,
-						Details:            activityInfo.LastHeartbeatDetails,
-						Attempt:            activityInfo.Attempt,
-						LastFailure:        activityInfo.RetryLastFailure,
-						LastWorkerIdentity: activityInfo.RetryLastWorkerIdentity,
-						VersionHistory:     versionhistory.CopyVersionHistory(currentVersionHistory),
+						NamespaceId:         namespaceID.String(),
+						WorkflowId:          workflowID,
+						RunId:               runID,
+						Version:             activityInfo.Version,
+						ScheduledEventId:    activityInfo.ScheduledEventId,
+						ScheduledTime:       activityInfo.ScheduledTime,
+						StartedEventId:      activityInfo.StartedEventId,
+						StartedTime:         startedTime,
+						LastHeartbeatTime:   activityInfo.LastHeartbeatUpdateTime,
+						Details:             activityInfo.LastHeartbeatDetails,
+						Attempt:             activityInfo.Attempt,
+						LastFailure:         activityInfo.RetryLastFailure,
+						LastWorkerIdentity:  activityInfo.RetryLastWorkerIdentity,
+						VersionHistory:      versionhistory.CopyVersionHistory(currentVersionHistory),
+						Paused:              activityInfo.Paused,
+						ActivityOptions:     workflow.GetActivityOptions(activityInfo),
+						RetryExpirationTime: activityInfo.RetryExpirationTime,
 					},
 				},
 				VisibilityTime: &taskInfo.VisibilityTimestamp,
@@ -624,6 +627,11 @@ func (p *ackMgrImpl) processReplication(
 			// workflow already finished, no need to process the replication task
 			return nil, nil
 		}
+		if ms.GetExecutionInfo().GetReplicationExcluded() {
+			// workflow type is not selected for replication, the remote cluster never sees the workflow
+			p.metricsHandler.Counter(metrics.ReplicationTasksExcluded.GetMetricName()).Record(1)
+			return nil, nil
+		}
 		return action(ms)
 	case *serviceerror.NotFound, *serviceerror.NamespaceNotFound:
 		return nil, nil
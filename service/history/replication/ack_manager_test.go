@@ -40,6 +40,7 @@ import (
 	historyspb "go.temporal.io/server/api/history/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
+	workflowspb "go.temporal.io/server/api/workflow/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/convert"
@@ -316,6 +317,7 @@ func (s *ackManagerSuite) TestSyncActivity_ActivityCompleted() {
 	release(nil)
 	s.mockMutableState.EXPECT().StartTransaction(gomock.Any()).Return(false, nil)
 	s.mockMutableState.EXPECT().IsWorkflowExecutionRunning().Return(true).AnyTimes()
+	s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistencespb.WorkflowExecutionInfo{}).AnyTimes()
 	s.mockMutableState.EXPECT().GetActivityInfo(scheduledEventID).Return(nil, false).AnyTimes()
 	s.mockNamespaceRegistry.EXPECT().GetNamespaceByID(namespaceID).Return(tests.GlobalNamespaceEntry, nil).AnyTimes()
 
@@ -324,6 +326,46 @@ func (s *ackManagerSuite) TestSyncActivity_ActivityCompleted() {
 	s.Nil(result)
 }
 
+func (s *ackManagerSuite) TestSyncActivity_ReplicationExcluded() {
+	ctx := context.Background()
+	namespaceID := tests.NamespaceID
+	workflowID := "some random workflow ID"
+	runID := uuid.New()
+	scheduledEventID := int64(144)
+	task := &tasks.SyncActivityTask{
+		WorkflowKey: definition.NewWorkflowKey(
+			namespaceID.String(),
+			workflowID,
+			runID,
+		),
+		VisibilityTimestamp: time.Now().UTC(),
+		TaskID:              int64(1444),
+		Version:             int64(2333),
+		ScheduledEventID:    scheduledEventID,
+	}
+
+	context, release, _ := s.replicationAckManager.workflowCache.GetOrCreateWorkflowExecution(
+		ctx,
+		namespaceID,
+		commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      runID,
+		},
+		workflow.CallerTypeTask,
+	)
+
+	context.(*workflow.ContextImpl).MutableState = s.mockMutableState
+	release(nil)
+	s.mockMutableState.EXPECT().StartTransaction(gomock.Any()).Return(false, nil)
+	s.mockMutableState.EXPECT().IsWorkflowExecutionRunning().Return(true).AnyTimes()
+	s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistencespb.WorkflowExecutionInfo{ReplicationExcluded: true}).AnyTimes()
+	s.mockNamespaceRegistry.EXPECT().GetNamespaceByID(namespaceID).Return(tests.GlobalNamespaceEntry, nil).AnyTimes()
+
+	result, err := s.replicationAckManager.generateSyncActivityTask(ctx, task)
+	s.NoError(err)
+	s.Nil(result)
+}
+
 func (s *ackManagerSuite) TestSyncActivity_ActivityRetry() {
 	ctx := context.Background()
 	namespaceID := tests.NamespaceID
@@ -419,6 +461,7 @@ func (s *ackManagerSuite) TestSyncActivity_ActivityRetry() {
 				LastFailure:        activityLastFailure,
 				LastWorkerIdentity: activityLastWorkerIdentity,
 				VersionHistory:     versionHistory,
+				ActivityOptions:    &workflowspb.ActivityOptions{},
 			},
 		},
 		VisibilityTime: timestamp.TimePtr(taskTimestamp),
@@ -482,6 +525,9 @@ func (s *ackManagerSuite) TestSyncActivity_ActivityRunning() {
 		Attempt:                 activityAttempt,
 		RetryLastFailure:        activityLastFailure,
 		RetryLastWorkerIdentity: activityLastWorkerIdentity,
+		StartToCloseTimeout:     timestamp.DurationPtr(time.Minute),
+		HasRetryPolicy:          true,
+		RetryMaximumAttempts:    3,
 	}, true).AnyTimes()
 	versionHistory := &historyspb.VersionHistory{
 		BranchToken: []byte{},
@@ -522,6 +568,10 @@ func (s *ackManagerSuite) TestSyncActivity_ActivityRunning() {
 				LastFailure:        activityLastFailure,
 				LastWorkerIdentity: activityLastWorkerIdentity,
 				VersionHistory:     versionHistory,
+				ActivityOptions: &workflowspb.ActivityOptions{
+					StartToCloseTimeout: timestamp.DurationPtr(time.Minute),
+					RetryPolicy:         &commonpb.RetryPolicy{MaximumAttempts: 3},
+				},
 			},
 		},
 		VisibilityTime: timestamp.TimePtr(taskTimestamp),
@@ -29,6 +29,7 @@ package replication
 import (
 	"context"
 	"fmt"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -70,6 +71,7 @@ type (
 	// TaskProcessor is the interface for task processor
 	TaskProcessor interface {
 		common.Daemon
+		Status() *replicationspb.ReplicationProcessorStatus
 	}
 
 	// taskProcessorImpl is responsible for processing replication tasks for a shard.
@@ -91,11 +93,14 @@ type (
 		taskRetryPolicy backoff.RetryPolicy
 		dlqRetryPolicy  backoff.RetryPolicy
 
-		// recv side
+		// recv side, the task ids and timestamp are written by the event loop and read by Status
+		statusLock              sync.Mutex
 		maxRxProcessedTaskID    int64
 		maxRxProcessedTimestamp time.Time
 		maxRxReceivedTaskID     int64
 		rxTaskBackoff           time.Duration
+		lastError               error
+		lastErrorTime           time.Time
 
 		requestChan   chan<- *replicationTaskRequest
 		syncShardChan chan *replicationspb.SyncShardStatus
@@ -190,6 +195,42 @@ func (p *taskProcessorImpl) Stop() {
 	p.logger.Info("ReplicationTaskProcessor shutting down.")
 }
 
+// Status returns the progress and state of the processor
+func (p *taskProcessorImpl) Status() *replicationspb.ReplicationProcessorStatus {
+	p.statusLock.Lock()
+	defer p.statusLock.Unlock()
+
+	status := &replicationspb.ReplicationProcessorStatus{
+		SourceShardId:       p.sourceShardID,
+		State:               enumsspb.REPLICATION_PROCESSOR_STATE_RUNNING,
+		LastProcessedTaskId: p.maxRxProcessedTaskID,
+		LastReceivedTaskId:  p.maxRxReceivedTaskID,
+	}
+	if !p.maxRxProcessedTimestamp.IsZero() {
+		status.LastProcessedTaskVisibilityTime = timestamp.TimePtr(p.maxRxProcessedTimestamp)
+		status.Lag = timestamp.DurationPtr(p.lag())
+	}
+	if p.lastError != nil {
+		status.State = enumsspb.REPLICATION_PROCESSOR_STATE_RETRYING
+		status.LastError = p.lastError.Error()
+		status.LastErrorTime = timestamp.TimePtr(p.lastErrorTime)
+	}
+	if p.isStopped() {
+		status.State = enumsspb.REPLICATION_PROCESSOR_STATE_STOPPED
+	}
+	return status
+}
+
+// lag is the time since the last applied task was created on the source cluster. When there are
+// no tasks, the processed timestamp is the source cluster time of the last poll.
+func (p *taskProcessorImpl) lag() time.Duration {
+	lag := p.shard.GetTimeSource().Now().Sub(p.maxRxProcessedTimestamp)
+	if lag < 0 {
+		return 0
+	}
+	return lag
+}
+
 func (p *taskProcessorImpl) eventLoop() {
 	syncShardTimer := time.NewTimer(backoff.Jitter(
 		p.config.ShardSyncMinInterval(),
@@ -224,6 +265,7 @@ func (p *taskProcessorImpl) eventLoop() {
 			if err := p.pollProcessReplicationTasks(); err != nil {
 				p.logger.Error("unable to process replication tasks", tag.Error(err))
 			}
+			p.emitLagMetrics()
 			replicationTimer.Reset(p.rxTaskBackoff)
 		}
 	}
@@ -231,9 +273,15 @@ func (p *taskProcessorImpl) eventLoop() {
 
 func (p *taskProcessorImpl) pollProcessReplicationTasks() (retError error) {
 	defer func() {
+		p.statusLock.Lock()
+		defer p.statusLock.Unlock()
 		if retError != nil {
 			p.maxRxReceivedTaskID = p.maxRxProcessedTaskID
 			p.rxTaskBackoff = p.config.ReplicationTaskFetcherErrorRetryWait()
+			p.lastError = retError
+			p.lastErrorTime = p.shard.GetTimeSource().Now()
+		} else {
+			p.lastError = nil
 		}
 	}()
 
@@ -255,15 +303,19 @@ func (p *taskProcessorImpl) pollProcessReplicationTasks() (retError error) {
 		if err = p.applyReplicationTask(replicationTask); err != nil {
 			return err
 		}
+		p.statusLock.Lock()
 		p.maxRxProcessedTaskID = replicationTask.GetSourceTaskId()
 		p.maxRxProcessedTimestamp = timestamp.TimeValue(replicationTask.GetVisibilityTime())
+		p.statusLock.Unlock()
 	}
 
 	if !p.isStopped() {
 		// all tasks fetched successfully processed
 		// setting the receiver side max processed task ID to max received task ID
 		// since task ID is not contiguous
+		p.statusLock.Lock()
 		p.maxRxProcessedTaskID = p.maxRxReceivedTaskID
+		p.statusLock.Unlock()
 	}
 
 	return nil
@@ -467,13 +519,16 @@ func (p *taskProcessorImpl) convertTaskToDLQTask(
 
 func (p *taskProcessorImpl) paginationFn(_ []byte) ([]interface{}, []byte, error) {
 	respChan := make(chan *replicationspb.ReplicationMessages, 1)
+	p.statusLock.Lock()
+	token := &replicationspb.ReplicationToken{
+		ShardId:                     p.sourceShardID,
+		LastProcessedMessageId:      p.maxRxProcessedTaskID,
+		LastProcessedVisibilityTime: timestamp.TimePtr(p.maxRxProcessedTimestamp),
+		LastRetrievedMessageId:      p.maxRxReceivedTaskID,
+	}
+	p.statusLock.Unlock()
 	p.requestChan <- &replicationTaskRequest{
-		token: &replicationspb.ReplicationToken{
-			ShardId:                     p.sourceShardID,
-			LastProcessedMessageId:      p.maxRxProcessedTaskID,
-			LastProcessedVisibilityTime: &p.maxRxProcessedTimestamp,
-			LastRetrievedMessageId:      p.maxRxReceivedTaskID,
-		},
+		token:    token,
 		respChan: respChan,
 	}
 
@@ -495,11 +550,13 @@ func (p *taskProcessorImpl) paginationFn(_ []byte) ([]interface{}, []byte, error
 		for _, task := range resp.GetReplicationTasks() {
 			tasks = append(tasks, task)
 		}
+		p.statusLock.Lock()
 		p.maxRxReceivedTaskID = resp.GetLastRetrievedMessageId()
 		if len(tasks) == 0 {
 			// Update processed timestamp to the source cluster time when there is no replication task
 			p.maxRxProcessedTimestamp = timestamp.TimeValue(resp.GetSyncShardStatus().GetStatusTime())
 		}
+		p.statusLock.Unlock()
 
 		if resp.GetHasMore() {
 			p.rxTaskBackoff = time.Duration(0)
@@ -513,6 +570,19 @@ func (p *taskProcessorImpl) paginationFn(_ []byte) ([]interface{}, []byte, error
 	}
 }
 
+func (p *taskProcessorImpl) emitLagMetrics() {
+	p.statusLock.Lock()
+	defer p.statusLock.Unlock()
+	if p.maxRxProcessedTimestamp.IsZero() {
+		return
+	}
+	p.metricsHandler.Timer(metrics.ReplicationInboundLag.GetMetricName()).Record(
+		p.lag(),
+		metrics.OperationTag(metrics.ReplicationTaskFetcherScope),
+		metrics.TargetClusterTag(p.sourceCluster),
+	)
+}
+
 func (p *taskProcessorImpl) emitTaskMetrics(operation string, err error) {
 	metricsScope := p.metricsHandler.WithTags(metrics.OperationTag(operation))
 	if common.IsContextDeadlineExceededErr(err) || common.IsContextCanceledErr(err) {
@@ -31,6 +31,7 @@ import (
 	"time"
 
 	"go.temporal.io/server/api/historyservice/v1"
+	replicationspb "go.temporal.io/server/api/replication/v1"
 	"go.temporal.io/server/client"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
@@ -54,6 +55,14 @@ const (
 )
 
 type (
+	// TaskProcessorManager manages the replication task processors of a shard, one for each shard of
+	// each remote cluster mapped to the shard
+	TaskProcessorManager interface {
+		common.Daemon
+		// ProcessorStatus returns the status of the task processors, keyed by remote cluster name
+		ProcessorStatus() map[string][]*replicationspb.ReplicationProcessorStatus
+	}
+
 	// taskProcessorManagerImpl is to manage replication task processors
 	taskProcessorManagerImpl struct {
 		config                        *configs.Config
@@ -77,7 +86,7 @@ type (
 	}
 )
 
-var _ common.Daemon = (*taskProcessorManagerImpl)(nil)
+var _ TaskProcessorManager = (*taskProcessorManagerImpl)(nil)
 
 func NewTaskProcessorManager(
 	config *configs.Config,
@@ -156,6 +165,19 @@ func (r *taskProcessorManagerImpl) Stop() {
 	r.taskProcessorLock.Unlock()
 }
 
+func (r *taskProcessorManagerImpl) ProcessorStatus() map[string][]*replicationspb.ReplicationProcessorStatus {
+	r.taskProcessorLock.RLock()
+	defer r.taskProcessorLock.RUnlock()
+
+	status := make(map[string][]*replicationspb.ReplicationProcessorStatus, len(r.taskProcessors))
+	for clusterName, taskProcessors := range r.taskProcessors {
+		for _, processor := range taskProcessors {
+			status[clusterName] = append(status[clusterName], processor.Status())
+		}
+	}
+	return status
+}
+
 func (r *taskProcessorManagerImpl) listenToClusterMetadataChange() {
 	clusterMetadata := r.shard.GetClusterMetadata()
 	clusterMetadata.RegisterMetadataChangeCallback(
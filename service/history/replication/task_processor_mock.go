@@ -32,6 +32,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	repication "go.temporal.io/server/api/replication/v1"
 )
 
 // MockTaskProcessor is a mock of TaskProcessor interface.
@@ -69,6 +70,20 @@ func (mr *MockTaskProcessorMockRecorder) Start() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockTaskProcessor)(nil).Start))
 }
 
+// Status mocks base method.
+func (m *MockTaskProcessor) Status() *repication.ReplicationProcessorStatus {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Status")
+	ret0, _ := ret[0].(*repication.ReplicationProcessorStatus)
+	return ret0
+}
+
+// Status indicates an expected call of Status.
+func (mr *MockTaskProcessorMockRecorder) Status() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Status", reflect.TypeOf((*MockTaskProcessor)(nil).Status))
+}
+
 // Stop mocks base method.
 func (m *MockTaskProcessor) Stop() {
 	m.ctrl.T.Helper()
@@ -26,6 +26,7 @@ package replication
 
 import (
 	"context"
+	"errors"
 	"math/rand"
 	"testing"
 	"time"
@@ -622,3 +623,25 @@ func (s *taskProcessorSuite) TestPaginationFn_Error() {
 		s.Equal(rxTaskBackoff, s.replicationTaskProcessor.rxTaskBackoff)
 	}
 }
+
+func (s *taskProcessorSuite) TestStatus() {
+	status := s.replicationTaskProcessor.Status()
+	s.Equal(s.shardID, status.SourceShardId)
+	s.Equal(enumsspb.REPLICATION_PROCESSOR_STATE_RUNNING, status.State)
+	s.Nil(status.Lag)
+
+	processedTime := time.Now().Add(-time.Minute)
+	s.replicationTaskProcessor.maxRxProcessedTaskID = 10
+	s.replicationTaskProcessor.maxRxProcessedTimestamp = processedTime
+	s.replicationTaskProcessor.maxRxReceivedTaskID = 12
+	s.replicationTaskProcessor.lastError = errors.New("some random error")
+	s.replicationTaskProcessor.lastErrorTime = time.Now()
+
+	status = s.replicationTaskProcessor.Status()
+	s.Equal(enumsspb.REPLICATION_PROCESSOR_STATE_RETRYING, status.State)
+	s.Equal(int64(10), status.LastProcessedTaskId)
+	s.Equal(int64(12), status.LastReceivedTaskId)
+	s.Equal(processedTime, *status.LastProcessedTaskVisibilityTime)
+	s.GreaterOrEqual(*status.Lag, time.Minute)
+	s.Equal("some random error", status.LastError)
+}
@@ -41,6 +41,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloadstore"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/searchattribute"
@@ -121,6 +122,8 @@ type (
 		GetSearchAttributesProvider() searchattribute.Provider
 		GetSearchAttributesMapperProvider() searchattribute.MapperProvider
 		GetArchivalMetadata() archiver.ArchivalMetadata
+		// GetPayloadOffloader returns nil if no payload store is configured
+		GetPayloadOffloader() *payloadstore.Offloader
 
 		Unload()
 	}
@@ -58,6 +58,7 @@ import (
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloadstore"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -114,6 +115,7 @@ type (
 		saMapperProvider        searchattribute.MapperProvider
 		clusterMetadata         cluster.Metadata
 		archivalMetadata        archiver.ArchivalMetadata
+		payloadOffloader        *payloadstore.Offloader
 		hostInfoProvider        membership.HostInfoProvider
 
 		// Context that lives for the lifetime of the shard context
@@ -1982,6 +1984,7 @@ func newContext(
 	saMapperProvider searchattribute.MapperProvider,
 	clusterMetadata cluster.Metadata,
 	archivalMetadata archiver.ArchivalMetadata,
+	payloadOffloader *payloadstore.Offloader,
 	hostInfoProvider membership.HostInfoProvider,
 ) (*ContextImpl, error) {
 	hostIdentity := hostInfoProvider.HostInfo().Identity()
@@ -2009,6 +2012,7 @@ func newContext(
 		saMapperProvider:        saMapperProvider,
 		clusterMetadata:         clusterMetadata,
 		archivalMetadata:        archivalMetadata,
+		payloadOffloader:        payloadOffloader,
 		hostInfoProvider:        hostInfoProvider,
 		handoverNamespaces:      make(map[namespace.Name]*namespaceHandOverInfo),
 		lifecycleCtx:            lifecycleCtx,
@@ -2092,6 +2096,10 @@ func (s *ContextImpl) GetArchivalMetadata() archiver.ArchivalMetadata {
 	return s.archivalMetadata
 }
 
+func (s *ContextImpl) GetPayloadOffloader() *payloadstore.Offloader {
+	return s.payloadOffloader
+}
+
 // newDetachedContext creates a detached context with the same deadline
 // and values from the given context. Detached context won't be affected
 // if the context it bases on is cancelled.
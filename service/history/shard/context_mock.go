@@ -46,6 +46,7 @@ import (
 	log "go.temporal.io/server/common/log"
 	metrics "go.temporal.io/server/common/metrics"
 	namespace "go.temporal.io/server/common/namespace"
+	payloadstore "go.temporal.io/server/common/payloadstore"
 	persistence "go.temporal.io/server/common/persistence"
 	serialization "go.temporal.io/server/common/persistence/serialization"
 	searchattribute "go.temporal.io/server/common/searchattribute"
@@ -420,6 +421,20 @@ func (mr *MockContextMockRecorder) GetNamespaceRegistry() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaceRegistry", reflect.TypeOf((*MockContext)(nil).GetNamespaceRegistry))
 }
 
+// GetPayloadOffloader mocks base method.
+func (m *MockContext) GetPayloadOffloader() *payloadstore.Offloader {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPayloadOffloader")
+	ret0, _ := ret[0].(*payloadstore.Offloader)
+	return ret0
+}
+
+// GetPayloadOffloader indicates an expected call of GetPayloadOffloader.
+func (mr *MockContextMockRecorder) GetPayloadOffloader() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayloadOffloader", reflect.TypeOf((*MockContext)(nil).GetPayloadOffloader))
+}
+
 // GetPayloadSerializer mocks base method.
 func (m *MockContext) GetPayloadSerializer() serialization.Serializer {
 	m.ctrl.T.Helper()
@@ -47,6 +47,7 @@ import (
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloadstore"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/searchattribute"
@@ -92,6 +93,7 @@ type (
 		saMapperProvider            searchattribute.MapperProvider
 		clusterMetadata             cluster.Metadata
 		archivalMetadata            archiver.ArchivalMetadata
+		payloadOffloader            *payloadstore.Offloader
 		hostInfoProvider            membership.HostInfoProvider
 		tracer                      trace.Tracer
 	}
@@ -308,6 +310,7 @@ func (c *ControllerImpl) getOrCreateShardContext(shardID int32) (*ContextImpl, e
 		c.saMapperProvider,
 		c.clusterMetadata,
 		c.archivalMetadata,
+		c.payloadOffloader,
 		c.hostInfoProvider,
 	)
 	if err != nil {
@@ -126,6 +126,21 @@ func (mr *MockEngineMockRecorder) GenerateLastHistoryReplicationTasks(ctx, reque
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateLastHistoryReplicationTasks", reflect.TypeOf((*MockEngine)(nil).GenerateLastHistoryReplicationTasks), ctx, request)
 }
 
+// GenerateVisibilityTasks mocks base method.
+func (m *MockEngine) GenerateVisibilityTasks(ctx context.Context, request *historyservice.GenerateVisibilityTasksRequest) (*historyservice.GenerateVisibilityTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateVisibilityTasks", ctx, request)
+	ret0, _ := ret[0].(*historyservice.GenerateVisibilityTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateVisibilityTasks indicates an expected call of GenerateVisibilityTasks.
+func (mr *MockEngineMockRecorder) GenerateVisibilityTasks(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateVisibilityTasks", reflect.TypeOf((*MockEngine)(nil).GenerateVisibilityTasks), ctx, request)
+}
+
 // GetDLQMessages mocks base method.
 func (m *MockEngine) GetDLQMessages(ctx context.Context, messagesRequest *historyservice.GetDLQMessagesRequest) (*historyservice.GetDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -240,6 +255,36 @@ func (mr *MockEngineMockRecorder) NotifyNewTasks(tasks interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyNewTasks", reflect.TypeOf((*MockEngine)(nil).NotifyNewTasks), tasks)
 }
 
+// PauseActivityExecution mocks base method.
+func (m *MockEngine) PauseActivityExecution(ctx context.Context, request *historyservice.PauseActivityExecutionRequest) (*historyservice.PauseActivityExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseActivityExecution", ctx, request)
+	ret0, _ := ret[0].(*historyservice.PauseActivityExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseActivityExecution indicates an expected call of PauseActivityExecution.
+func (mr *MockEngineMockRecorder) PauseActivityExecution(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseActivityExecution", reflect.TypeOf((*MockEngine)(nil).PauseActivityExecution), ctx, request)
+}
+
+// PauseWorkflowExecution mocks base method.
+func (m *MockEngine) PauseWorkflowExecution(ctx context.Context, request *historyservice.PauseWorkflowExecutionRequest) (*historyservice.PauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", ctx, request)
+	ret0, _ := ret[0].(*historyservice.PauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseWorkflowExecution indicates an expected call of PauseWorkflowExecution.
+func (mr *MockEngineMockRecorder) PauseWorkflowExecution(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).PauseWorkflowExecution), ctx, request)
+}
+
 // PollMutableState mocks base method.
 func (m *MockEngine) PollMutableState(ctx context.Context, request *historyservice.PollMutableStateRequest) (*historyservice.PollMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -445,6 +490,21 @@ func (mr *MockEngineMockRecorder) RequestCancelWorkflowExecution(ctx, request in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestCancelWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).RequestCancelWorkflowExecution), ctx, request)
 }
 
+// ResetActivityExecution mocks base method.
+func (m *MockEngine) ResetActivityExecution(ctx context.Context, request *historyservice.ResetActivityExecutionRequest) (*historyservice.ResetActivityExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetActivityExecution", ctx, request)
+	ret0, _ := ret[0].(*historyservice.ResetActivityExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetActivityExecution indicates an expected call of ResetActivityExecution.
+func (mr *MockEngineMockRecorder) ResetActivityExecution(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetActivityExecution", reflect.TypeOf((*MockEngine)(nil).ResetActivityExecution), ctx, request)
+}
+
 // ResetStickyTaskQueue mocks base method.
 func (m *MockEngine) ResetStickyTaskQueue(ctx context.Context, resetRequest *historyservice.ResetStickyTaskQueueRequest) (*historyservice.ResetStickyTaskQueueResponse, error) {
 	m.ctrl.T.Helper()
@@ -475,6 +535,21 @@ func (mr *MockEngineMockRecorder) ResetWorkflowExecution(ctx, request interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).ResetWorkflowExecution), ctx, request)
 }
 
+// ResolveResetPoint mocks base method.
+func (m *MockEngine) ResolveResetPoint(ctx context.Context, request *historyservice.ResolveResetPointRequest) (*historyservice.ResolveResetPointResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveResetPoint", ctx, request)
+	ret0, _ := ret[0].(*historyservice.ResolveResetPointResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveResetPoint indicates an expected call of ResolveResetPoint.
+func (mr *MockEngineMockRecorder) ResolveResetPoint(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveResetPoint", reflect.TypeOf((*MockEngine)(nil).ResolveResetPoint), ctx, request)
+}
+
 // RespondActivityTaskCanceled mocks base method.
 func (m *MockEngine) RespondActivityTaskCanceled(ctx context.Context, request *historyservice.RespondActivityTaskCanceledRequest) (*historyservice.RespondActivityTaskCanceledResponse, error) {
 	m.ctrl.T.Helper()
@@ -675,6 +750,51 @@ func (mr *MockEngineMockRecorder) TerminateWorkflowExecution(ctx, request interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).TerminateWorkflowExecution), ctx, request)
 }
 
+// UnpauseActivityExecution mocks base method.
+func (m *MockEngine) UnpauseActivityExecution(ctx context.Context, request *historyservice.UnpauseActivityExecutionRequest) (*historyservice.UnpauseActivityExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseActivityExecution", ctx, request)
+	ret0, _ := ret[0].(*historyservice.UnpauseActivityExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseActivityExecution indicates an expected call of UnpauseActivityExecution.
+func (mr *MockEngineMockRecorder) UnpauseActivityExecution(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseActivityExecution", reflect.TypeOf((*MockEngine)(nil).UnpauseActivityExecution), ctx, request)
+}
+
+// UnpauseWorkflowExecution mocks base method.
+func (m *MockEngine) UnpauseWorkflowExecution(ctx context.Context, request *historyservice.UnpauseWorkflowExecutionRequest) (*historyservice.UnpauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseWorkflowExecution", ctx, request)
+	ret0, _ := ret[0].(*historyservice.UnpauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseWorkflowExecution indicates an expected call of UnpauseWorkflowExecution.
+func (mr *MockEngineMockRecorder) UnpauseWorkflowExecution(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).UnpauseWorkflowExecution), ctx, request)
+}
+
+// UpdateActivityExecutionOptions mocks base method.
+func (m *MockEngine) UpdateActivityExecutionOptions(ctx context.Context, request *historyservice.UpdateActivityExecutionOptionsRequest) (*historyservice.UpdateActivityExecutionOptionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateActivityExecutionOptions", ctx, request)
+	ret0, _ := ret[0].(*historyservice.UpdateActivityExecutionOptionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateActivityExecutionOptions indicates an expected call of UpdateActivityExecutionOptions.
+func (mr *MockEngineMockRecorder) UpdateActivityExecutionOptions(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivityExecutionOptions", reflect.TypeOf((*MockEngine)(nil).UpdateActivityExecutionOptions), ctx, request)
+}
+
 // UpdateWorkflowExecution mocks base method.
 func (m *MockEngine) UpdateWorkflowExecution(ctx context.Context, request *historyservice.UpdateWorkflowExecutionRequest) (*historyservice.UpdateWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -38,6 +38,7 @@ import (
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloadstore"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/searchattribute"
@@ -70,6 +71,7 @@ func ControllerProvider(
 	saMapperProvider searchattribute.MapperProvider,
 	clusterMetadata cluster.Metadata,
 	archivalMetadata archiver.ArchivalMetadata,
+	payloadOffloader *payloadstore.Offloader,
 	hostInfoProvider membership.HostInfoProvider,
 	engineFactory EngineFactory,
 	tracerProvider trace.TracerProvider,
@@ -97,6 +99,7 @@ func ControllerProvider(
 		saMapperProvider:            saMapperProvider,
 		clusterMetadata:             clusterMetadata,
 		archivalMetadata:            archivalMetadata,
+		payloadOffloader:            payloadOffloader,
 		hostInfoProvider:            hostInfoProvider,
 		engineFactory:               engineFactory,
 		tracer:                      tracerProvider.Tracer(consts.LibraryName),
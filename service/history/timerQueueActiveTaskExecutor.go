@@ -153,6 +153,10 @@ func (t *timerQueueActiveTaskExecutor) executeUserTimerTimeoutTask(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if mutableState.IsWorkflowExecutionPaused() {
+		// timers are held while the workflow is paused and regenerated when it is unpaused
+		return nil
+	}
 
 	timerSequence := t.getTimerSequence(mutableState)
 	referenceTime := t.shard.GetTimeSource().Now()
@@ -206,6 +210,10 @@ func (t *timerQueueActiveTaskExecutor) executeActivityTimeoutTask(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if mutableState.IsWorkflowExecutionPaused() {
+		// timers are held while the workflow is paused and regenerated when it is unpaused
+		return nil
+	}
 
 	timerSequence := t.getTimerSequence(mutableState)
 	referenceTime := t.shard.GetTimeSource().Now()
@@ -239,6 +247,10 @@ Loop:
 			//  retry could update activity attempt, should not timeouts new attempt
 			continue Loop
 		}
+		if activityInfo.Paused && activityInfo.StartedEventId == common.EmptyEventID {
+			// timeouts of an attempt that is not dispatched are held until the activity is unpaused
+			continue Loop
+		}
 
 		if !queues.IsTimeExpired(referenceTime, timerSequenceID.Timestamp) {
 			// timer sequence IDs are sorted, once there is one timer
@@ -309,6 +321,10 @@ func (t *timerQueueActiveTaskExecutor) executeWorkflowTaskTimeoutTask(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if mutableState.IsWorkflowExecutionPaused() {
+		// timers are held while the workflow is paused and regenerated when it is unpaused
+		return nil
+	}
 
 	workflowTask, ok := mutableState.GetWorkflowTaskInfo(task.EventID)
 	if !ok {
@@ -379,6 +395,10 @@ func (t *timerQueueActiveTaskExecutor) executeWorkflowBackoffTimerTask(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if mutableState.IsWorkflowExecutionPaused() {
+		// timers are held while the workflow is paused and regenerated when it is unpaused
+		return nil
+	}
 
 	if task.WorkflowBackoffType == enumsspb.WORKFLOW_BACKOFF_TYPE_RETRY {
 		t.metricHandler.Counter(metrics.WorkflowRetryBackoffTimerCount.GetMetricName()).Record(
@@ -421,6 +441,10 @@ func (t *timerQueueActiveTaskExecutor) executeActivityRetryTimerTask(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if mutableState.IsWorkflowExecutionPaused() {
+		// timers are held while the workflow is paused and regenerated when it is unpaused
+		return nil
+	}
 
 	// generate activity task
 	activityInfo, ok := mutableState.GetActivityInfo(task.EventID)
@@ -438,6 +462,10 @@ func (t *timerQueueActiveTaskExecutor) executeActivityRetryTimerTask(
 		}
 		return nil
 	}
+	if activityInfo.Paused {
+		// the retry timer is regenerated when the activity is unpaused
+		return nil
+	}
 	err = CheckTaskVersion(t.shard, t.logger, mutableState.GetNamespaceEntry(), activityInfo.Version, task.Version, task)
 	if err != nil {
 		return err
@@ -127,6 +127,11 @@ func (t *timerQueueStandbyTaskExecutor) executeUserTimerTimeoutTask(
 	timerTask *tasks.UserTimerTask,
 ) error {
 	actionFn := func(_ context.Context, wfContext workflow.Context, mutableState workflow.MutableState) (interface{}, error) {
+		if mutableState.IsWorkflowExecutionPaused() {
+			// tasks are regenerated when the unpause is replicated
+			return nil, nil
+		}
+
 		timerSequence := t.getTimerSequence(mutableState)
 		timerSequenceIDs := timerSequence.LoadAndSortUserTimers()
 		if len(timerSequenceIDs) > 0 {
@@ -182,17 +187,24 @@ func (t *timerQueueStandbyTaskExecutor) executeActivityTimeoutTask(
 	// the overall solution is to attempt to generate a new activity timer task whenever the
 	// task passed in is safe to be throw away.
 	actionFn := func(ctx context.Context, wfContext workflow.Context, mutableState workflow.MutableState) (interface{}, error) {
+		if mutableState.IsWorkflowExecutionPaused() {
+			// tasks are regenerated when the unpause is replicated
+			return nil, nil
+		}
+
 		timerSequence := t.getTimerSequence(mutableState)
 		updateMutableState := false
-		timerSequenceIDs := timerSequence.LoadAndSortActivityTimers()
-		if len(timerSequenceIDs) > 0 {
-			timerSequenceID := timerSequenceIDs[0]
-			_, ok := mutableState.GetActivityInfo(timerSequenceID.EventID)
+		for _, timerSequenceID := range timerSequence.LoadAndSortActivityTimers() {
+			activityInfo, ok := mutableState.GetActivityInfo(timerSequenceID.EventID)
 			if !ok {
 				errString := fmt.Sprintf("failed to find in memory activity timer: %v", timerSequenceID.EventID)
 				t.logger.Error(errString)
 				return nil, serviceerror.NewInternal(errString)
 			}
+			if activityInfo.Paused && activityInfo.StartedEventId == common.EmptyEventID {
+				// the active cluster holds timeouts of an attempt that is not dispatched until the activity is unpaused
+				continue
+			}
 
 			if queues.IsTimeExpired(
 				timerTask.GetVisibilityTime(),
@@ -202,6 +214,7 @@ func (t *timerQueueStandbyTaskExecutor) executeActivityTimeoutTask(
 			}
 			// Since the activity timers are already sorted, then if there is one timer which is not expired,
 			// all activity timers after that timer are not expired.
+			break
 		}
 
 		// for reason to update mutable state & generate a new activity task,
@@ -271,10 +284,19 @@ func (t *timerQueueStandbyTaskExecutor) executeActivityRetryTimerTask(
 	task *tasks.ActivityRetryTimerTask,
 ) (retError error) {
 	actionFn := func(_ context.Context, wfContext workflow.Context, mutableState workflow.MutableState) (interface{}, error) {
+		if mutableState.IsWorkflowExecutionPaused() {
+			// tasks are regenerated when the unpause is replicated
+			return nil, nil
+		}
+
 		activityInfo, ok := mutableState.GetActivityInfo(task.EventID) // activity schedule ID
 		if !ok {
 			return nil, nil
 		}
+		if activityInfo.Paused && activityInfo.StartedEventId == common.EmptyEventID {
+			// the active cluster doesn't dispatch a paused activity
+			return nil, nil
+		}
 
 		err := CheckTaskVersion(t.shard, t.logger, mutableState.GetNamespaceEntry(), activityInfo.Version, task.Version, task)
 		if err != nil {
@@ -319,6 +341,11 @@ func (t *timerQueueStandbyTaskExecutor) executeWorkflowTaskTimeoutTask(
 	}
 
 	actionFn := func(_ context.Context, wfContext workflow.Context, mutableState workflow.MutableState) (interface{}, error) {
+		if mutableState.IsWorkflowExecutionPaused() {
+			// tasks are regenerated when the unpause is replicated
+			return nil, nil
+		}
+
 		workflowTask, isPending := mutableState.GetWorkflowTaskInfo(timerTask.EventID)
 		if !isPending {
 			return nil, nil
@@ -352,6 +379,11 @@ func (t *timerQueueStandbyTaskExecutor) executeWorkflowBackoffTimerTask(
 	timerTask *tasks.WorkflowBackoffTimerTask,
 ) error {
 	actionFn := func(_ context.Context, wfContext workflow.Context, mutableState workflow.MutableState) (interface{}, error) {
+		if mutableState.IsWorkflowExecutionPaused() {
+			// tasks are regenerated when the unpause is replicated
+			return nil, nil
+		}
+
 		if mutableState.HasProcessedOrPendingWorkflowTask() {
 			// if there is one workflow task already been processed
 			// or has pending workflow task, meaning workflow has already running
@@ -476,6 +508,13 @@ func (t *timerQueueStandbyTaskExecutor) processTimer(
 	if err != nil {
 		return err
 	}
+	if historyResendInfo != nil && mutableState.GetExecutionInfo().GetReplicationExcluded() {
+		// the workflow is not replicated, so the remote cluster has no history to fetch and the task
+		// would be pending forever, the task is processed by the cluster the workflow runs on
+		release(nil)
+		t.logger.Warn("Discarding standby timer task of workflow excluded from replication.", tag.Task(timerTask))
+		return consts.ErrTaskDiscarded
+	}
 
 	// NOTE: do not access anything related mutable state after this lock release
 	release(nil)
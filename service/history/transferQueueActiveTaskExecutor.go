@@ -29,6 +29,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/pborman/uuid"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
@@ -183,11 +184,19 @@ func (t *transferQueueActiveTaskExecutor) processActivityTask(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if mutableState.IsWorkflowExecutionPaused() {
+		// the task is regenerated when the workflow is unpaused
+		return nil
+	}
 
 	ai, ok := mutableState.GetActivityInfo(task.ScheduledEventID)
 	if !ok {
 		return nil
 	}
+	if ai.Paused {
+		// the activity is dispatched through a retry timer task when it is unpaused
+		return nil
+	}
 	err = CheckTaskVersion(t.shard, t.logger, mutableState.GetNamespaceEntry(), ai.Version, task.Version, task)
 	if err != nil {
 		return err
@@ -222,6 +231,10 @@ func (t *transferQueueActiveTaskExecutor) processWorkflowTask(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if mutableState.IsWorkflowExecutionPaused() {
+		// the task is regenerated when the workflow is unpaused
+		return nil
+	}
 
 	workflowTask, found := mutableState.GetWorkflowTaskInfo(task.ScheduledEventID)
 	if !found {
@@ -1290,6 +1303,14 @@ func (t *transferQueueActiveTaskExecutor) signalExternalExecution(
 	signalInfo *persistencespb.SignalInfo,
 	attributes *historypb.SignalExternalWorkflowExecutionInitiatedEventAttributes,
 ) error {
+	if offloader := t.shard.GetPayloadOffloader(); offloader != nil {
+		// the attributes are shared with the events cache
+		attributes = proto.Clone(attributes).(*historypb.SignalExternalWorkflowExecutionInitiatedEventAttributes)
+		if err := offloader.Rescope(ctx, attributes, task.NamespaceID, task.TargetNamespaceID, task.TargetWorkflowID); err != nil {
+			return err
+		}
+	}
+
 	request := &historyservice.SignalWorkflowExecutionRequest{
 		NamespaceId: task.TargetNamespaceID,
 		SignalRequest: &workflowservice.SignalWorkflowExecutionRequest{
@@ -1325,6 +1346,14 @@ func (t *transferQueueActiveTaskExecutor) startWorkflow(
 	childRequestID string,
 	attributes *historypb.StartChildWorkflowExecutionInitiatedEventAttributes,
 ) (string, *clockspb.VectorClock, error) {
+	if offloader := t.shard.GetPayloadOffloader(); offloader != nil {
+		// the attributes are shared with the events cache
+		attributes = proto.Clone(attributes).(*historypb.StartChildWorkflowExecutionInitiatedEventAttributes)
+		if err := offloader.Rescope(ctx, attributes, task.NamespaceID, task.TargetNamespaceID, attributes.WorkflowId); err != nil {
+			return "", nil, err
+		}
+	}
+
 	request := common.CreateHistoryStartWorkflowRequest(
 		task.TargetNamespaceID,
 		&workflowservice.StartWorkflowExecutionRequest{
@@ -135,10 +135,19 @@ func (t *transferQueueStandbyTaskExecutor) processActivityTask(
 ) error {
 	processTaskIfClosed := false
 	actionFn := func(_ context.Context, wfContext workflow.Context, mutableState workflow.MutableState) (interface{}, error) {
+		if mutableState.IsWorkflowExecutionPaused() {
+			// tasks are regenerated when the unpause is replicated
+			return nil, nil
+		}
+
 		activityInfo, ok := mutableState.GetActivityInfo(transferTask.ScheduledEventID)
 		if !ok {
 			return nil, nil
 		}
+		if activityInfo.Paused && activityInfo.StartedEventId == common.EmptyEventID {
+			// the active cluster doesn't dispatch a paused activity
+			return nil, nil
+		}
 
 		err := CheckTaskVersion(t.shard, t.logger, mutableState.GetNamespaceEntry(), activityInfo.Version, transferTask.Version, transferTask)
 		if err != nil {
@@ -174,6 +183,11 @@ func (t *transferQueueStandbyTaskExecutor) processWorkflowTask(
 ) error {
 	processTaskIfClosed := false
 	actionFn := func(_ context.Context, wfContext workflow.Context, mutableState workflow.MutableState) (interface{}, error) {
+		if mutableState.IsWorkflowExecutionPaused() {
+			// tasks are regenerated when the unpause is replicated
+			return nil, nil
+		}
+
 		wtInfo, ok := mutableState.GetWorkflowTaskInfo(transferTask.ScheduledEventID)
 		if !ok {
 			return nil, nil
@@ -531,6 +545,13 @@ func (t *transferQueueStandbyTaskExecutor) processTransfer(
 	if err != nil {
 		return err
 	}
+	if historyResendInfo != nil && mutableState.GetExecutionInfo().GetReplicationExcluded() {
+		// the workflow is not replicated, so the remote cluster has no history to fetch and the task
+		// would be pending forever, the task is processed by the cluster the workflow runs on
+		release(nil)
+		t.logger.Warn("Discarding standby transfer task of workflow excluded from replication.", tag.Task(taskInfo))
+		return consts.ErrTaskDiscarded
+	}
 
 	// NOTE: do not access anything related mutable state after this lock release
 	release(nil)
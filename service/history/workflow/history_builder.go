@@ -785,6 +785,17 @@ func (b *HistoryBuilder) AddWorkflowPropertiesModifiedEvent(
 	return b.appendEvents(event)
 }
 
+func (b *HistoryBuilder) AddWorkflowPropertiesModifiedExternallyEvent(
+	attributes *historypb.WorkflowPropertiesModifiedExternallyEventAttributes,
+) *historypb.HistoryEvent {
+	event := b.createNewHistoryEvent(enumspb.EVENT_TYPE_WORKFLOW_PROPERTIES_MODIFIED_EXTERNALLY, b.timeSource.Now())
+	event.Attributes = &historypb.HistoryEvent_WorkflowPropertiesModifiedExternallyEventAttributes{
+		WorkflowPropertiesModifiedExternallyEventAttributes: attributes,
+	}
+
+	return b.appendEvents(event)
+}
+
 func (b *HistoryBuilder) AddSignalExternalWorkflowExecutionFailedEvent(
 	workflowTaskCompletedEventID int64,
 	initiatedEventID int64,
@@ -45,6 +45,7 @@ import (
 	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	workflowspb "go.temporal.io/server/api/workflow/v1"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
@@ -153,6 +154,10 @@ type (
 		CheckResettable() error
 		CloneToProto() *persistencespb.WorkflowMutableState
 		RetryActivity(ai *persistencespb.ActivityInfo, failure *failurepb.Failure) (enumspb.RetryState, error)
+		PauseActivity(ai *persistencespb.ActivityInfo) error
+		UnpauseActivity(ai *persistencespb.ActivityInfo) error
+		ResetActivityAttempt(ai *persistencespb.ActivityInfo) error
+		UpdateActivityOptions(ctx context.Context, ai *persistencespb.ActivityInfo, options *workflowspb.ActivityOptions) error
 		GetTransientWorkflowTaskInfo(workflowTask *WorkflowTaskInfo, identity string) *historyspb.TransientWorkflowTaskInfo
 		DeleteWorkflowTask()
 		DeleteSignalRequested(requestID string)
@@ -212,6 +217,7 @@ type (
 		IsStickyTaskQueueEnabled() bool
 		TaskQueue() *taskqueuepb.TaskQueue
 		IsWorkflowExecutionRunning() bool
+		IsWorkflowExecutionPaused() bool
 		IsResourceDuplicated(resourceDedupKey definition.DeduplicationID) bool
 		IsWorkflowPendingOnWorkflowTaskBackoff() bool
 		UpdateDuplicatedResource(resourceDedupKey definition.DeduplicationID)
@@ -247,6 +253,7 @@ type (
 		ReplicateTimerStartedEvent(*historypb.HistoryEvent) (*persistencespb.TimerInfo, error)
 		ReplicateTransientWorkflowTaskScheduled() (*WorkflowTaskInfo, error)
 		ReplicateWorkflowPropertiesModifiedEvent(*historypb.HistoryEvent)
+		ReplicateWorkflowPropertiesModifiedExternallyEvent(*historypb.HistoryEvent) error
 		ReplicateUpsertWorkflowSearchAttributesEvent(*historypb.HistoryEvent)
 		ReplicateWorkflowExecutionCancelRequestedEvent(*historypb.HistoryEvent) error
 		ReplicateWorkflowExecutionCanceledEvent(int64, *historypb.HistoryEvent) error
@@ -266,6 +273,8 @@ type (
 		UpdateUserTimer(*persistencespb.TimerInfo) error
 		UpdateCurrentVersion(version int64, forceUpdate bool) error
 		UpdateWorkflowStateStatus(state enumsspb.WorkflowExecutionState, status enumspb.WorkflowExecutionStatus) error
+		PauseWorkflowExecution(reason string, identity string) error
+		UnpauseWorkflowExecution() error
 
 		AddTasks(tasks ...tasks.Task)
 		PopTasks() map[tasks.Category][]tasks.Task
@@ -76,6 +76,11 @@ import (
 const (
 	emptyUUID = "emptyUuid"
 
+	// WorkflowPauseMemoKey is the key in the upserted memo of a WorkflowPropertiesModifiedExternally
+	// event that pauses or unpauses the workflow execution. The value is the pause info, or an empty
+	// payload for unpause. It is never merged into the workflow memo.
+	WorkflowPauseMemoKey = "TemporalWorkflowPause"
+
 	mutableStateInvalidHistoryActionMsg         = "invalid history builder state for action"
 	mutableStateInvalidHistoryActionMsgTemplate = mutableStateInvalidHistoryActionMsg + ": %v, %v"
 )
@@ -1101,6 +1106,26 @@ func (ms *MutableStateImpl) ReplicateActivityInfo(
 	ai.Attempt = request.GetAttempt()
 	ai.RetryLastWorkerIdentity = request.GetLastWorkerIdentity()
 	ai.RetryLastFailure = request.GetLastFailure()
+	ai.Paused = request.GetPaused()
+	// senders that predate activity options don't set them
+	if options := request.GetActivityOptions(); options != nil {
+		if !options.Equal(GetActivityOptions(ai)) {
+			// the timers of the activity are created again with the updated timeouts
+			resetActivityTimerTaskStatus = true
+		}
+		ai.ScheduleToCloseTimeout = options.GetScheduleToCloseTimeout()
+		ai.ScheduleToStartTimeout = options.GetScheduleToStartTimeout()
+		ai.StartToCloseTimeout = options.GetStartToCloseTimeout()
+		ai.HeartbeatTimeout = options.GetHeartbeatTimeout()
+		retryPolicy := options.GetRetryPolicy()
+		ai.HasRetryPolicy = retryPolicy != nil
+		ai.RetryInitialInterval = retryPolicy.GetInitialInterval()
+		ai.RetryBackoffCoefficient = retryPolicy.GetBackoffCoefficient()
+		ai.RetryMaximumInterval = retryPolicy.GetMaximumInterval()
+		ai.RetryMaximumAttempts = retryPolicy.GetMaximumAttempts()
+		ai.RetryNonRetryableErrorTypes = retryPolicy.GetNonRetryableErrorTypes()
+		ai.RetryExpirationTime = request.GetRetryExpirationTime()
+	}
 
 	if resetActivityTimerTaskStatus {
 		ai.TimerTaskStatus = TimerTaskStatusNone
@@ -1388,6 +1413,93 @@ func (ms *MutableStateImpl) IsWorkflowExecutionRunning() bool {
 	}
 }
 
+// IsWorkflowExecutionPaused returns true if the workflow execution is paused. Tasks of a paused
+// workflow are skipped by the task executors and regenerated when it is unpaused.
+func (ms *MutableStateImpl) IsWorkflowExecutionPaused() bool {
+	return ms.executionInfo.PauseInfo != nil
+}
+
+// PauseWorkflowExecution records the pause in history, so that it replicates and survives
+// rebuilds, as a WorkflowPropertiesModifiedExternally event carrying the pause info under
+// WorkflowPauseMemoKey.
+func (ms *MutableStateImpl) PauseWorkflowExecution(
+	reason string,
+	identity string,
+) error {
+	opTag := tag.WorkflowActionWorkflowPaused
+	if err := ms.checkMutability(opTag); err != nil {
+		return err
+	}
+	pauseInfo, err := payload.Encode(&persistencespb.WorkflowPauseInfo{
+		PauseTime: timestamp.TimePtr(ms.timeSource.Now()),
+		Reason:    reason,
+		Identity:  identity,
+	})
+	if err != nil {
+		return err
+	}
+	return ms.addWorkflowPauseEvent(pauseInfo)
+}
+
+// UnpauseWorkflowExecution records the unpause in history with an empty pause info.
+func (ms *MutableStateImpl) UnpauseWorkflowExecution() error {
+	opTag := tag.WorkflowActionWorkflowUnpaused
+	if err := ms.checkMutability(opTag); err != nil {
+		return err
+	}
+	return ms.addWorkflowPauseEvent(&commonpb.Payload{})
+}
+
+func (ms *MutableStateImpl) addWorkflowPauseEvent(
+	pauseInfo *commonpb.Payload,
+) error {
+	event := ms.hBuilder.AddWorkflowPropertiesModifiedExternallyEvent(&historypb.WorkflowPropertiesModifiedExternallyEventAttributes{
+		UpsertedMemo: &commonpb.Memo{Fields: map[string]*commonpb.Payload{
+			WorkflowPauseMemoKey: pauseInfo,
+		}},
+	})
+	if err := ms.ReplicateWorkflowPropertiesModifiedExternallyEvent(event); err != nil {
+		return err
+	}
+	// TODO merge active & passive task generation
+	return ms.taskGenerator.GenerateUpsertVisibilityTask()
+}
+
+// ReplicateWorkflowPropertiesModifiedExternallyEvent applies a pause or unpause. Other property
+// modifications are not supported yet.
+func (ms *MutableStateImpl) ReplicateWorkflowPropertiesModifiedExternallyEvent(
+	event *historypb.HistoryEvent,
+) error {
+	attr := event.GetWorkflowPropertiesModifiedExternallyEventAttributes()
+	fields := attr.GetUpsertedMemo().GetFields()
+	pauseInfo, ok := fields[WorkflowPauseMemoKey]
+	if !ok || len(fields) != 1 || attr.GetNewTaskQueue() != "" || attr.NewWorkflowTaskTimeout != nil ||
+		attr.NewWorkflowRunTimeout != nil || attr.NewWorkflowExecutionTimeout != nil {
+		return serviceerror.NewUnimplemented("Workflow property modification not implemented")
+	}
+
+	exeInfo := ms.executionInfo
+	if len(pauseInfo.GetData()) == 0 {
+		exeInfo.PauseInfo = nil
+		delete(exeInfo.SearchAttributes, searchattribute.TemporalWorkflowPaused)
+		return nil
+	}
+
+	exeInfo.PauseInfo = &persistencespb.WorkflowPauseInfo{}
+	if err := payload.Decode(pauseInfo, exeInfo.PauseInfo); err != nil {
+		return err
+	}
+	pausedPayload, err := searchattribute.EncodeValue(true, enumspb.INDEXED_VALUE_TYPE_BOOL)
+	if err != nil {
+		return err
+	}
+	if exeInfo.SearchAttributes == nil {
+		exeInfo.SearchAttributes = make(map[string]*commonpb.Payload, 1)
+	}
+	exeInfo.SearchAttributes[searchattribute.TemporalWorkflowPaused] = pausedPayload
+	return nil
+}
+
 func (ms *MutableStateImpl) IsCancelRequested() bool {
 	return ms.executionInfo.CancelRequested
 }
@@ -1635,6 +1747,7 @@ func (ms *MutableStateImpl) ReplicateWorkflowExecutionStartedEvent(
 	ms.executionInfo.FirstExecutionRunId = event.GetFirstExecutionRunId()
 	ms.executionInfo.TaskQueue = event.TaskQueue.GetName()
 	ms.executionInfo.WorkflowTypeName = event.WorkflowType.GetName()
+	ms.executionInfo.ReplicationExcluded = ms.isReplicationExcluded(ms.executionInfo.WorkflowTypeName)
 	ms.executionInfo.WorkflowRunTimeout = event.GetWorkflowRunTimeout()
 	ms.executionInfo.WorkflowExecutionTimeout = event.GetWorkflowExecutionTimeout()
 	ms.executionInfo.DefaultWorkflowTaskTimeout = event.GetWorkflowTaskTimeout()
@@ -3851,6 +3964,140 @@ func (ms *MutableStateImpl) RetryActivity(
 	return enumspb.RETRY_STATE_IN_PROGRESS, nil
 }
 
+// PauseActivity stops dispatching the activity until it is unpaused. Transfer and retry timer tasks
+// of a paused activity are skipped by the task executors, and so are the timeouts of an attempt
+// that has not started. An attempt that is already running is allowed to complete, a retry after it
+// fails is held as well.
+func (ms *MutableStateImpl) PauseActivity(
+	ai *persistencespb.ActivityInfo,
+) error {
+
+	if err := ms.checkMutability(tag.WorkflowActionActivityTaskUpdated); err != nil {
+		return err
+	}
+
+	ai.Version = ms.GetCurrentVersion()
+	ai.Paused = true
+	if err := ms.UpdateActivity(ai); err != nil {
+		return err
+	}
+	ms.syncActivityTasks[ai.ScheduledEventId] = struct{}{}
+	return nil
+}
+
+// UnpauseActivity resumes dispatching a paused activity and regenerates its tasks. An activity that
+// has not started is dispatched again if its scheduled time has passed, as its dispatch tasks were
+// skipped while it was paused. Otherwise its pending retry timer dispatches it.
+func (ms *MutableStateImpl) UnpauseActivity(
+	ai *persistencespb.ActivityInfo,
+) error {
+
+	if err := ms.checkMutability(tag.WorkflowActionActivityTaskUpdated); err != nil {
+		return err
+	}
+
+	dispatch := ai.Paused &&
+		ai.StartedEventId == common.EmptyEventID &&
+		!timestamp.TimeValue(ai.ScheduledTime).After(ms.timeSource.Now())
+	ai.Version = ms.GetCurrentVersion()
+	ai.Paused = false
+	return ms.regenerateActivityTasks(ai, dispatch)
+}
+
+// ResetActivityAttempt resets the attempt count of the activity. The activity is not dispatched
+// again: an activity waiting for its next retry is dispatched by its pending retry timer, and a
+// running attempt keeps running with its retries counting from 1.
+func (ms *MutableStateImpl) ResetActivityAttempt(
+	ai *persistencespb.ActivityInfo,
+) error {
+
+	if err := ms.checkMutability(tag.WorkflowActionActivityTaskUpdated); err != nil {
+		return err
+	}
+
+	ai.Version = ms.GetCurrentVersion()
+	ai.Attempt = 1
+	return ms.regenerateActivityTasks(ai, false)
+}
+
+// UpdateActivityOptions updates the retry policy and timeouts of the activity in place. Options that
+// are not set are left unchanged. The retry expiration time is recomputed from the time the activity
+// was scheduled. The options are replicated to the other clusters with the activity info.
+func (ms *MutableStateImpl) UpdateActivityOptions(
+	ctx context.Context,
+	ai *persistencespb.ActivityInfo,
+	options *workflowspb.ActivityOptions,
+) error {
+
+	if err := ms.checkMutability(tag.WorkflowActionActivityTaskUpdated); err != nil {
+		return err
+	}
+
+	if options.GetScheduleToCloseTimeout() != nil {
+		ai.ScheduleToCloseTimeout = options.GetScheduleToCloseTimeout()
+	}
+	if options.GetScheduleToStartTimeout() != nil {
+		ai.ScheduleToStartTimeout = options.GetScheduleToStartTimeout()
+	}
+	if options.GetStartToCloseTimeout() != nil {
+		ai.StartToCloseTimeout = options.GetStartToCloseTimeout()
+	}
+	if options.GetHeartbeatTimeout() != nil {
+		ai.HeartbeatTimeout = options.GetHeartbeatTimeout()
+	}
+	if retryPolicy := options.GetRetryPolicy(); retryPolicy != nil {
+		ai.HasRetryPolicy = true
+		ai.RetryInitialInterval = retryPolicy.GetInitialInterval()
+		ai.RetryBackoffCoefficient = retryPolicy.GetBackoffCoefficient()
+		ai.RetryMaximumInterval = retryPolicy.GetMaximumInterval()
+		ai.RetryMaximumAttempts = retryPolicy.GetMaximumAttempts()
+		ai.RetryNonRetryableErrorTypes = retryPolicy.GetNonRetryableErrorTypes()
+	}
+
+	if ai.HasRetryPolicy && (options.GetRetryPolicy() != nil || options.GetScheduleToCloseTimeout() != nil) {
+		scheduledEvent, err := ms.GetActivityScheduledEvent(ctx, ai.ScheduledEventId)
+		if err != nil {
+			return err
+		}
+		if scheduleToCloseTimeout := timestamp.DurationValue(ai.ScheduleToCloseTimeout); scheduleToCloseTimeout > 0 {
+			ai.RetryExpirationTime = timestamp.TimePtr(
+				timestamp.TimeValue(scheduledEvent.GetEventTime()).Add(scheduleToCloseTimeout),
+			)
+		} else {
+			ai.RetryExpirationTime = timestamp.TimePtr(time.Time{})
+		}
+	}
+
+	ai.Version = ms.GetCurrentVersion()
+	return ms.regenerateActivityTasks(ai, false)
+}
+
+// regenerateActivityTasks clears the timer task mask of the activity and generates its timers again,
+// so that they reflect the updated activity info. If dispatch is set, the activity is dispatched
+// through a retry timer task at its scheduled time.
+func (ms *MutableStateImpl) regenerateActivityTasks(
+	ai *persistencespb.ActivityInfo,
+	dispatch bool,
+) error {
+
+	ai.TimerTaskStatus = TimerTaskStatusNone
+	if err := ms.UpdateActivity(ai); err != nil {
+		return err
+	}
+	ms.syncActivityTasks[ai.ScheduledEventId] = struct{}{}
+
+	if dispatch {
+		if err := ms.taskGenerator.GenerateActivityRetryTasks(
+			ai.ScheduledEventId,
+		); err != nil {
+			return err
+		}
+	}
+
+	_, err := NewTimerSequence(ms).CreateNextActivityTimer()
+	return err
+}
+
 // TODO mutable state should generate corresponding transfer / timer tasks according to
 //  updates accumulated, while currently all transfer / timer tasks are managed manually
 
@@ -4315,7 +4562,23 @@ func (ms *MutableStateImpl) updateWithLastWriteEvent(
 }
 
 func (ms *MutableStateImpl) canReplicateEvents() bool {
-	return ms.namespaceEntry.ReplicationPolicy() == namespace.ReplicationPolicyMultiCluster
+	return ms.namespaceEntry.ReplicationPolicy() == namespace.ReplicationPolicyMultiCluster &&
+		!ms.executionInfo.ReplicationExcluded
+}
+
+// isReplicationExcluded returns true if the namespace is global but the workflow type is not selected for
+// replication. It is called whenever the started event is applied, so that the flag is also derived when
+// mutable state is rebuilt from history, e.g. on reset.
+func (ms *MutableStateImpl) isReplicationExcluded(workflowType string) bool {
+	if ms.namespaceEntry.ReplicationPolicy() != namespace.ReplicationPolicyMultiCluster {
+		return false
+	}
+	replicatedWorkflowTypes := ms.config.ReplicatedWorkflowTypes(ms.namespaceEntry.Name().String())
+	if len(replicatedWorkflowTypes) == 0 {
+		return false
+	}
+	replicated, _ := replicatedWorkflowTypes[workflowType].(bool)
+	return !replicated
 }
 
 // validateNoEventsAfterWorkflowFinish perform check on history event batch
@@ -36,11 +36,13 @@ import (
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 
 	"go.temporal.io/server/api/clock/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
+	"go.temporal.io/server/api/historyservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/definition"
@@ -50,9 +52,11 @@ import (
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/events"
 	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/history/tests"
 )
 
@@ -384,6 +388,227 @@ func (s *mutableStateSuite) TestEventReapplied() {
 	s.True(isReapplied)
 }
 
+func (s *mutableStateSuite) TestPauseUnpauseWorkflowExecution() {
+	s.mutableState = TestGlobalMutableState(
+		s.mockShard,
+		s.mockEventsCache,
+		s.logger,
+		int64(12),
+		uuid.New(),
+	)
+	// a standby cluster applies the replicated events to its own copy
+	replica := TestGlobalMutableState(
+		s.mockShard,
+		s.mockEventsCache,
+		s.logger,
+		int64(12),
+		uuid.New(),
+	)
+	lastEvent := func() *historypb.HistoryEvent {
+		// buffered, since the test workflow has a workflow task in flight
+		events := s.mutableState.hBuilder.memBufferBatch
+		s.NotEmpty(events)
+		return events[len(events)-1]
+	}
+	s.False(s.mutableState.IsWorkflowExecutionPaused())
+
+	err := s.mutableState.PauseWorkflowExecution("dependency down", "operator")
+	s.NoError(err)
+	s.True(s.mutableState.IsWorkflowExecutionPaused())
+	s.Equal("dependency down", s.mutableState.GetExecutionInfo().GetPauseInfo().GetReason())
+	s.Contains(s.mutableState.GetExecutionInfo().SearchAttributes, searchattribute.TemporalWorkflowPaused)
+
+	event := lastEvent()
+	s.Equal(enumspb.EVENT_TYPE_WORKFLOW_PROPERTIES_MODIFIED_EXTERNALLY, event.GetEventType())
+	s.NoError(replica.ReplicateWorkflowPropertiesModifiedExternallyEvent(event))
+	s.True(replica.IsWorkflowExecutionPaused())
+	s.Equal(s.mutableState.GetExecutionInfo().GetPauseInfo(), replica.GetExecutionInfo().GetPauseInfo())
+	s.Contains(replica.GetExecutionInfo().SearchAttributes, searchattribute.TemporalWorkflowPaused)
+	// the pause is not part of the workflow memo
+	s.NotContains(replica.GetExecutionInfo().GetMemo(), WorkflowPauseMemoKey)
+
+	err = s.mutableState.UnpauseWorkflowExecution()
+	s.NoError(err)
+	s.False(s.mutableState.IsWorkflowExecutionPaused())
+	s.NotContains(s.mutableState.GetExecutionInfo().SearchAttributes, searchattribute.TemporalWorkflowPaused)
+
+	s.NoError(replica.ReplicateWorkflowPropertiesModifiedExternallyEvent(lastEvent()))
+	s.False(replica.IsWorkflowExecutionPaused())
+	s.NotContains(replica.GetExecutionInfo().SearchAttributes, searchattribute.TemporalWorkflowPaused)
+}
+
+func (s *mutableStateSuite) TestReplicateWorkflowPropertiesModifiedExternallyEvent_Unsupported() {
+	s.mutableState = TestGlobalMutableState(
+		s.mockShard,
+		s.mockEventsCache,
+		s.logger,
+		int64(12),
+		uuid.New(),
+	)
+	err := s.mutableState.ReplicateWorkflowPropertiesModifiedExternallyEvent(&historypb.HistoryEvent{
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_PROPERTIES_MODIFIED_EXTERNALLY,
+		Attributes: &historypb.HistoryEvent_WorkflowPropertiesModifiedExternallyEventAttributes{
+			WorkflowPropertiesModifiedExternallyEventAttributes: &historypb.WorkflowPropertiesModifiedExternallyEventAttributes{
+				NewTaskQueue: "other",
+			},
+		},
+	})
+	s.IsType(&serviceerror.Unimplemented{}, err)
+}
+
+func (s *mutableStateSuite) TestPauseUnpauseResetActivity() {
+	s.mutableState = TestGlobalMutableState(
+		s.mockShard,
+		s.mockEventsCache,
+		s.logger,
+		int64(12),
+		uuid.New(),
+	)
+	s.mockEventsCache.EXPECT().PutEvent(gomock.Any(), gomock.Any()).AnyTimes()
+
+	ai, err := s.mutableState.ReplicateActivityTaskScheduledEvent(int64(5), &historypb.HistoryEvent{
+		EventId:   int64(5),
+		EventTime: timestamp.TimePtr(time.Now().UTC()),
+		Version:   int64(12),
+		EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED,
+		Attributes: &historypb.HistoryEvent_ActivityTaskScheduledEventAttributes{ActivityTaskScheduledEventAttributes: &historypb.ActivityTaskScheduledEventAttributes{
+			ActivityId:             "activity-id",
+			TaskQueue:              &taskqueuepb.TaskQueue{Name: "task-queue"},
+			ScheduleToCloseTimeout: timestamp.DurationPtr(time.Hour),
+			RetryPolicy:            &commonpb.RetryPolicy{MaximumAttempts: 10},
+		}},
+	})
+	s.NoError(err)
+	ai.Attempt = 5
+	s.mutableState.PopTasks()
+
+	// the activity is already dispatched, resetting its attempt must not dispatch it again
+	s.NoError(s.mutableState.ResetActivityAttempt(ai))
+	s.Equal(int32(1), ai.Attempt)
+	for _, task := range s.mutableState.PopTasks()[tasks.CategoryTimer] {
+		s.NotEqual(enumsspb.TASK_TYPE_ACTIVITY_RETRY_TIMER, task.GetType())
+	}
+	ai.Attempt = 5
+
+	s.mutableState.syncActivityTasks = make(map[int64]struct{})
+	s.NoError(s.mutableState.PauseActivity(ai))
+	s.True(ai.Paused)
+	s.Contains(s.mutableState.syncActivityTasks, ai.ScheduledEventId)
+
+	s.NoError(s.mutableState.ResetActivityAttempt(ai))
+	s.Equal(int32(1), ai.Attempt)
+	for _, task := range s.mutableState.PopTasks()[tasks.CategoryTimer] {
+		s.NotEqual(enumsspb.TASK_TYPE_ACTIVITY_RETRY_TIMER, task.GetType())
+	}
+
+	s.NoError(s.mutableState.UnpauseActivity(ai))
+	s.False(ai.Paused)
+	var retryTask *tasks.ActivityRetryTimerTask
+	for _, task := range s.mutableState.PopTasks()[tasks.CategoryTimer] {
+		if t, ok := task.(*tasks.ActivityRetryTimerTask); ok {
+			retryTask = t
+		}
+	}
+	s.NotNil(retryTask)
+	s.Equal(int32(1), retryTask.Attempt)
+	s.Equal(ai.ScheduledEventId, retryTask.EventID)
+
+	// unpausing an activity that is not paused doesn't dispatch it
+	s.NoError(s.mutableState.UnpauseActivity(ai))
+	for _, task := range s.mutableState.PopTasks()[tasks.CategoryTimer] {
+		s.NotEqual(enumsspb.TASK_TYPE_ACTIVITY_RETRY_TIMER, task.GetType())
+	}
+}
+
+func (s *mutableStateSuite) TestReplicateActivityInfo_Paused() {
+	s.mutableState = TestGlobalMutableState(
+		s.mockShard,
+		s.mockEventsCache,
+		s.logger,
+		int64(12),
+		uuid.New(),
+	)
+	s.mockEventsCache.EXPECT().PutEvent(gomock.Any(), gomock.Any()).AnyTimes()
+
+	ai, err := s.mutableState.ReplicateActivityTaskScheduledEvent(int64(5), &historypb.HistoryEvent{
+		EventId:   int64(5),
+		EventTime: timestamp.TimePtr(time.Now().UTC()),
+		Version:   int64(12),
+		EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED,
+		Attributes: &historypb.HistoryEvent_ActivityTaskScheduledEventAttributes{ActivityTaskScheduledEventAttributes: &historypb.ActivityTaskScheduledEventAttributes{
+			ActivityId: "activity-id",
+			TaskQueue:  &taskqueuepb.TaskQueue{Name: "task-queue"},
+		}},
+	})
+	s.NoError(err)
+
+	request := &historyservice.SyncActivityRequest{
+		Version:          int64(12),
+		ScheduledEventId: ai.ScheduledEventId,
+		ScheduledTime:    ai.ScheduledTime,
+		StartedEventId:   common.EmptyEventID,
+		Attempt:          ai.Attempt,
+		Paused:           true,
+	}
+	s.NoError(s.mutableState.ReplicateActivityInfo(request, false))
+	s.True(ai.Paused)
+
+	request.Paused = false
+	s.NoError(s.mutableState.ReplicateActivityInfo(request, false))
+	s.False(ai.Paused)
+}
+
+func (s *mutableStateSuite) TestReplicateActivityInfo_ActivityOptions() {
+	s.mutableState = TestGlobalMutableState(
+		s.mockShard,
+		s.mockEventsCache,
+		s.logger,
+		int64(12),
+		uuid.New(),
+	)
+	s.mockEventsCache.EXPECT().PutEvent(gomock.Any(), gomock.Any()).AnyTimes()
+
+	ai, err := s.mutableState.ReplicateActivityTaskScheduledEvent(int64(5), &historypb.HistoryEvent{
+		EventId:   int64(5),
+		EventTime: timestamp.TimePtr(time.Now().UTC()),
+		Version:   int64(12),
+		EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED,
+		Attributes: &historypb.HistoryEvent_ActivityTaskScheduledEventAttributes{ActivityTaskScheduledEventAttributes: &historypb.ActivityTaskScheduledEventAttributes{
+			ActivityId:             "activity-id",
+			TaskQueue:              &taskqueuepb.TaskQueue{Name: "task-queue"},
+			ScheduleToCloseTimeout: timestamp.DurationPtr(time.Hour),
+			StartToCloseTimeout:    timestamp.DurationPtr(time.Minute),
+		}},
+	})
+	s.NoError(err)
+	ai.TimerTaskStatus = TimerTaskStatusCreatedStartToClose
+
+	request := &historyservice.SyncActivityRequest{
+		Version:          int64(12),
+		ScheduledEventId: ai.ScheduledEventId,
+		ScheduledTime:    ai.ScheduledTime,
+		StartedEventId:   common.EmptyEventID,
+		Attempt:          ai.Attempt,
+	}
+	// a sync without options leaves them unchanged
+	s.NoError(s.mutableState.ReplicateActivityInfo(request, false))
+	s.Equal(time.Minute, timestamp.DurationValue(ai.StartToCloseTimeout))
+	s.Equal(int32(TimerTaskStatusCreatedStartToClose), ai.TimerTaskStatus)
+
+	options := GetActivityOptions(ai)
+	options.StartToCloseTimeout = timestamp.DurationPtr(2 * time.Minute)
+	options.RetryPolicy = &commonpb.RetryPolicy{MaximumAttempts: 3}
+	request.ActivityOptions = options
+	request.RetryExpirationTime = timestamp.TimePtr(time.Now().Add(time.Hour).UTC())
+	s.NoError(s.mutableState.ReplicateActivityInfo(request, false))
+	s.Equal(2*time.Minute, timestamp.DurationValue(ai.StartToCloseTimeout))
+	s.Equal(time.Hour, timestamp.DurationValue(ai.ScheduleToCloseTimeout))
+	s.True(ai.HasRetryPolicy)
+	s.Equal(int32(3), ai.RetryMaximumAttempts)
+	s.Equal(request.RetryExpirationTime, ai.RetryExpirationTime)
+	s.Equal(int32(TimerTaskStatusNone), ai.TimerTaskStatus)
+}
+
 func (s *mutableStateSuite) TestTransientWorkflowTaskSchedule_CurrentVersionChanged() {
 	version := int64(2000)
 	runID := uuid.New()
@@ -834,3 +1059,25 @@ func (s *mutableStateSuite) TestReplicateActivityTaskStartedEvent() {
 	s.Assert().Equal(requestID, ai.RequestId)
 	s.Assert().Nil(ai.LastHeartbeatDetails)
 }
+
+func (s *mutableStateSuite) TestReplicationExcluded() {
+	s.mockConfig.ReplicatedWorkflowTypes = func(namespace string) map[string]any {
+		return map[string]any{"critical-workflow": true}
+	}
+
+	s.mutableState = NewMutableState(s.mockShard, s.mockEventsCache, s.logger, tests.LocalNamespaceEntry, time.Now().UTC())
+	s.False(s.mutableState.isReplicationExcluded("other-workflow"))
+
+	s.mutableState = NewMutableState(s.mockShard, s.mockEventsCache, s.logger, tests.GlobalNamespaceEntry, time.Now().UTC())
+	s.False(s.mutableState.isReplicationExcluded("critical-workflow"))
+	s.True(s.mutableState.isReplicationExcluded("other-workflow"))
+
+	s.True(s.mutableState.canReplicateEvents())
+	s.mutableState.executionInfo.ReplicationExcluded = true
+	s.False(s.mutableState.canReplicateEvents())
+
+	s.mockConfig.ReplicatedWorkflowTypes = func(namespace string) map[string]any {
+		return map[string]any{}
+	}
+	s.False(s.mutableState.isReplicationExcluded("other-workflow"))
+}
@@ -48,6 +48,7 @@ import (
 	v110 "go.temporal.io/server/api/history/v1"
 	v111 "go.temporal.io/server/api/historyservice/v1"
 	v112 "go.temporal.io/server/api/persistence/v1"
+	v113 "go.temporal.io/server/api/workflow/v1"
 	definition "go.temporal.io/server/common/definition"
 	namespace "go.temporal.io/server/common/namespace"
 	persistence "go.temporal.io/server/common/persistence"
@@ -1739,6 +1740,20 @@ func (mr *MockMutableStateMockRecorder) IsTransientWorkflowTask() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsTransientWorkflowTask", reflect.TypeOf((*MockMutableState)(nil).IsTransientWorkflowTask))
 }
 
+// IsWorkflowExecutionPaused mocks base method.
+func (m *MockMutableState) IsWorkflowExecutionPaused() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsWorkflowExecutionPaused")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsWorkflowExecutionPaused indicates an expected call of IsWorkflowExecutionPaused.
+func (mr *MockMutableStateMockRecorder) IsWorkflowExecutionPaused() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWorkflowExecutionPaused", reflect.TypeOf((*MockMutableState)(nil).IsWorkflowExecutionPaused))
+}
+
 // IsWorkflowExecutionRunning mocks base method.
 func (m *MockMutableState) IsWorkflowExecutionRunning() bool {
 	m.ctrl.T.Helper()
@@ -1767,6 +1782,34 @@ func (mr *MockMutableStateMockRecorder) IsWorkflowPendingOnWorkflowTaskBackoff()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWorkflowPendingOnWorkflowTaskBackoff", reflect.TypeOf((*MockMutableState)(nil).IsWorkflowPendingOnWorkflowTaskBackoff))
 }
 
+// PauseActivity mocks base method.
+func (m *MockMutableState) PauseActivity(ai *v112.ActivityInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseActivity", ai)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseActivity indicates an expected call of PauseActivity.
+func (mr *MockMutableStateMockRecorder) PauseActivity(ai interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseActivity", reflect.TypeOf((*MockMutableState)(nil).PauseActivity), ai)
+}
+
+// PauseWorkflowExecution mocks base method.
+func (m *MockMutableState) PauseWorkflowExecution(reason, identity string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", reason, identity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseWorkflowExecution indicates an expected call of PauseWorkflowExecution.
+func (mr *MockMutableStateMockRecorder) PauseWorkflowExecution(reason, identity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkflowExecution", reflect.TypeOf((*MockMutableState)(nil).PauseWorkflowExecution), reason, identity)
+}
+
 // PopTasks mocks base method.
 func (m *MockMutableState) PopTasks() map[tasks.Category][]tasks.Task {
 	m.ctrl.T.Helper()
@@ -2315,6 +2358,20 @@ func (mr *MockMutableStateMockRecorder) ReplicateWorkflowPropertiesModifiedEvent
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateWorkflowPropertiesModifiedEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateWorkflowPropertiesModifiedEvent), arg0)
 }
 
+// ReplicateWorkflowPropertiesModifiedExternallyEvent mocks base method.
+func (m *MockMutableState) ReplicateWorkflowPropertiesModifiedExternallyEvent(arg0 *v13.HistoryEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateWorkflowPropertiesModifiedExternallyEvent", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplicateWorkflowPropertiesModifiedExternallyEvent indicates an expected call of ReplicateWorkflowPropertiesModifiedExternallyEvent.
+func (mr *MockMutableStateMockRecorder) ReplicateWorkflowPropertiesModifiedExternallyEvent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateWorkflowPropertiesModifiedExternallyEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateWorkflowPropertiesModifiedExternallyEvent), arg0)
+}
+
 // ReplicateWorkflowTaskCompletedEvent mocks base method.
 func (m *MockMutableState) ReplicateWorkflowTaskCompletedEvent(arg0 *v13.HistoryEvent) error {
 	m.ctrl.T.Helper()
@@ -2387,6 +2444,20 @@ func (mr *MockMutableStateMockRecorder) ReplicateWorkflowTaskTimedOutEvent(arg0
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateWorkflowTaskTimedOutEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateWorkflowTaskTimedOutEvent), arg0)
 }
 
+// ResetActivityAttempt mocks base method.
+func (m *MockMutableState) ResetActivityAttempt(ai *v112.ActivityInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetActivityAttempt", ai)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetActivityAttempt indicates an expected call of ResetActivityAttempt.
+func (mr *MockMutableStateMockRecorder) ResetActivityAttempt(ai interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetActivityAttempt", reflect.TypeOf((*MockMutableState)(nil).ResetActivityAttempt), ai)
+}
+
 // RetryActivity mocks base method.
 func (m *MockMutableState) RetryActivity(ai *v112.ActivityInfo, failure *v12.Failure) (v11.RetryState, error) {
 	m.ctrl.T.Helper()
@@ -2483,6 +2554,34 @@ func (mr *MockMutableStateMockRecorder) TaskQueue() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskQueue", reflect.TypeOf((*MockMutableState)(nil).TaskQueue))
 }
 
+// UnpauseActivity mocks base method.
+func (m *MockMutableState) UnpauseActivity(ai *v112.ActivityInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseActivity", ai)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpauseActivity indicates an expected call of UnpauseActivity.
+func (mr *MockMutableStateMockRecorder) UnpauseActivity(ai interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseActivity", reflect.TypeOf((*MockMutableState)(nil).UnpauseActivity), ai)
+}
+
+// UnpauseWorkflowExecution mocks base method.
+func (m *MockMutableState) UnpauseWorkflowExecution() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseWorkflowExecution")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpauseWorkflowExecution indicates an expected call of UnpauseWorkflowExecution.
+func (mr *MockMutableStateMockRecorder) UnpauseWorkflowExecution() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowExecution", reflect.TypeOf((*MockMutableState)(nil).UnpauseWorkflowExecution))
+}
+
 // UpdateActivity mocks base method.
 func (m *MockMutableState) UpdateActivity(arg0 *v112.ActivityInfo) error {
 	m.ctrl.T.Helper()
@@ -2497,6 +2596,20 @@ func (mr *MockMutableStateMockRecorder) UpdateActivity(arg0 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivity", reflect.TypeOf((*MockMutableState)(nil).UpdateActivity), arg0)
 }
 
+// UpdateActivityOptions mocks base method.
+func (m *MockMutableState) UpdateActivityOptions(ctx context.Context, ai *v112.ActivityInfo, options *v113.ActivityOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateActivityOptions", ctx, ai, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateActivityOptions indicates an expected call of UpdateActivityOptions.
+func (mr *MockMutableStateMockRecorder) UpdateActivityOptions(ctx, ai, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivityOptions", reflect.TypeOf((*MockMutableState)(nil).UpdateActivityOptions), ctx, ai, options)
+}
+
 // UpdateActivityProgress mocks base method.
 func (m *MockMutableState) UpdateActivityProgress(ai *v112.ActivityInfo, request *v17.RecordActivityTaskHeartbeatRequest) {
 	m.ctrl.T.Helper()
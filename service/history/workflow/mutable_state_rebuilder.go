@@ -648,9 +648,29 @@ func (b *MutableStateRebuilderImpl) ApplyEvents(
 
 			return nil, nil
 
-		case enumspb.EVENT_TYPE_ACTIVITY_PROPERTIES_MODIFIED_EXTERNALLY,
-			enumspb.EVENT_TYPE_WORKFLOW_PROPERTIES_MODIFIED_EXTERNALLY:
-			return nil, serviceerror.NewUnimplemented("Workflow/activity property modification not implemented")
+		case enumspb.EVENT_TYPE_WORKFLOW_PROPERTIES_MODIFIED_EXTERNALLY:
+			wasPaused := b.mutableState.IsWorkflowExecutionPaused()
+			if err := b.mutableState.ReplicateWorkflowPropertiesModifiedExternallyEvent(event); err != nil {
+				return nil, err
+			}
+			if err := taskGenerator.GenerateUpsertVisibilityTask(); err != nil {
+				return nil, err
+			}
+			if wasPaused && !b.mutableState.IsWorkflowExecutionPaused() {
+				// tasks were skipped while the workflow was paused
+				if err := NewTaskRefresher(
+					b.shard,
+					b.shard.GetConfig(),
+					b.namespaceRegistry,
+					b.shard.GetEventsCache(),
+					b.logger,
+				).RefreshTasks(ctx, b.mutableState); err != nil {
+					return nil, err
+				}
+			}
+
+		case enumspb.EVENT_TYPE_ACTIVITY_PROPERTIES_MODIFIED_EXTERNALLY:
+			return nil, serviceerror.NewUnimplemented("Activity property modification not implemented")
 
 		default:
 			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Unknown event type: %v", event.GetEventType()))
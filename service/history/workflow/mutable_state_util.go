@@ -27,7 +27,10 @@ package workflow
 import (
 	"time"
 
+	commonpb "go.temporal.io/api/common/v1"
+
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	workflowspb "go.temporal.io/server/api/workflow/v1"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/service/history/tasks"
 )
@@ -55,3 +58,23 @@ func convertSyncActivityInfos(
 	}
 	return outputs
 }
+
+// GetActivityOptions returns the current retry policy and timeouts of an activity
+func GetActivityOptions(ai *persistencespb.ActivityInfo) *workflowspb.ActivityOptions {
+	options := &workflowspb.ActivityOptions{
+		ScheduleToCloseTimeout: ai.ScheduleToCloseTimeout,
+		ScheduleToStartTimeout: ai.ScheduleToStartTimeout,
+		StartToCloseTimeout:    ai.StartToCloseTimeout,
+		HeartbeatTimeout:       ai.HeartbeatTimeout,
+	}
+	if ai.HasRetryPolicy {
+		options.RetryPolicy = &commonpb.RetryPolicy{
+			InitialInterval:        ai.RetryInitialInterval,
+			BackoffCoefficient:     ai.RetryBackoffCoefficient,
+			MaximumInterval:        ai.RetryMaximumInterval,
+			MaximumAttempts:        ai.RetryMaximumAttempts,
+			NonRetryableErrorTypes: ai.RetryNonRetryableErrorTypes,
+		}
+	}
+	return options
+}
@@ -327,6 +327,7 @@ func persistNonFirstWorkflowEvents(
 		execution,
 		&persistence.AppendHistoryNodesRequest{
 			IsNewBranch:       false,
+			Info:              persistence.BuildHistoryGarbageCleanupInfo(namespaceID.String(), execution.GetWorkflowId(), execution.GetRunId()),
 			BranchToken:       branchToken,
 			Events:            events,
 			PrevTransactionID: prevTxnID,
@@ -117,6 +117,8 @@ func (r *workflowRebuilderImpl) rebuild(
 	if err != nil {
 		return err
 	}
+	// keep whether the execution is replicated even if the replicated workflow types changed since it started
+	rebuildMutableState.GetExecutionInfo().ReplicationExcluded = mutableState.GetExecutionInfo().GetReplicationExcluded()
 	return r.persistToDB(ctx, rebuildMutableState, rebuildHistorySize)
 }
 
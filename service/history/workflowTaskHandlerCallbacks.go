@@ -186,6 +186,9 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskStarted(
 			if !mutableState.IsWorkflowExecutionRunning() {
 				return nil, consts.ErrWorkflowCompleted
 			}
+			if mutableState.IsWorkflowExecutionPaused() {
+				return nil, consts.ErrWorkflowPaused
+			}
 
 			workflowTask, isRunning := mutableState.GetWorkflowTaskInfo(scheduledEventID)
 			metricsScope := handler.metricsHandler.WithTags(metrics.OperationTag(metrics.HistoryRecordWorkflowTaskStartedScope))
@@ -152,6 +152,15 @@ func (a *activities) AddESMappingFieldActivity(ctx context.Context, params Workf
 	}
 	a.logger.Info("Elasticsearch mapping created.", tag.ESIndex(params.IndexName), tag.ESMapping(params.CustomAttributesToAdd))
 
+	// Dedicated namespace indices must have the same mapping as the shared index.
+	nsIndexPattern := esclient.NamespaceIndexPattern(params.IndexName)
+	_, err = a.esClient.PutMapping(ctx, nsIndexPattern, params.CustomAttributesToAdd)
+	if err != nil && !elastic.IsNotFound(err) {
+		a.metricsHandler.Counter(metrics.AddSearchAttributesFailuresCount.GetMetricName()).Record(1)
+		a.logger.Error("Unable to update Elasticsearch mapping of namespace indices.", tag.ESIndex(nsIndexPattern), tag.Error(err))
+		return fmt.Errorf("%w: %v", ErrUnableToUpdateESMapping, err)
+	}
+
 	return nil
 }
 
@@ -34,6 +34,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/visibility/manager"
+	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
 	workercommon "go.temporal.io/server/service/worker/common"
 	"go.temporal.io/server/service/worker/deletenamespace/deleteexecutions"
 	"go.temporal.io/server/service/worker/deletenamespace/reclaimresources"
@@ -45,6 +46,8 @@ type (
 		visibilityManager manager.VisibilityManager
 		metadataManager   persistence.MetadataManager
 		historyClient     historyservice.HistoryServiceClient
+		esClient          esclient.Client
+		esConfig          *esclient.Config
 		metricsHandler    metrics.Handler
 		logger            log.Logger
 	}
@@ -63,6 +66,8 @@ func newComponent(
 	visibilityManager manager.VisibilityManager,
 	metadataManager persistence.MetadataManager,
 	historyClient historyservice.HistoryServiceClient,
+	esClient esclient.Client,
+	esConfig *esclient.Config,
 	metricsHandler metrics.Handler,
 	logger log.Logger,
 ) component {
@@ -71,6 +76,8 @@ func newComponent(
 			visibilityManager: visibilityManager,
 			metadataManager:   metadataManager,
 			historyClient:     historyClient,
+			esClient:          esClient,
+			esConfig:          esConfig,
 			metricsHandler:    metricsHandler,
 			logger:            logger,
 		}}
@@ -97,7 +104,7 @@ func (wc *deleteNamespaceComponent) deleteNamespaceActivities() *activities {
 }
 
 func (wc *deleteNamespaceComponent) reclaimResourcesActivities() *reclaimresources.Activities {
-	return reclaimresources.NewActivities(wc.visibilityManager, wc.metadataManager, wc.metricsHandler, wc.logger)
+	return reclaimresources.NewActivities(wc.visibilityManager, wc.metadataManager, wc.esClient, wc.esConfig, wc.metricsHandler, wc.logger)
 }
 
 func (wc *deleteNamespaceComponent) deleteExecutionsActivities() *deleteexecutions.Activities {
@@ -40,6 +40,7 @@ import (
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
+	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
 	"go.temporal.io/server/service/worker/deletenamespace/errors"
 )
 
@@ -47,6 +48,8 @@ type (
 	Activities struct {
 		visibilityManager manager.VisibilityManager
 		metadataManager   persistence.MetadataManager
+		esClient          esclient.Client
+		visibilityIndices []string
 		metricsHandler    metrics.Handler
 		logger            log.Logger
 	}
@@ -55,12 +58,23 @@ type (
 func NewActivities(
 	visibilityManager manager.VisibilityManager,
 	metadataManager persistence.MetadataManager,
+	esClient esclient.Client,
+	esConfig *esclient.Config,
 	metricsHandler metrics.Handler,
 	logger log.Logger,
 ) *Activities {
+	var visibilityIndices []string
+	for _, index := range []string{esConfig.GetVisibilityIndex(), esConfig.GetSecondaryVisibilityIndex()} {
+		if index != "" {
+			visibilityIndices = append(visibilityIndices, index)
+		}
+	}
+
 	return &Activities{
 		visibilityManager: visibilityManager,
 		metadataManager:   metadataManager,
+		esClient:          esClient,
+		visibilityIndices: visibilityIndices,
 		metricsHandler:    metricsHandler.WithTags(metrics.OperationTag(metrics.ReclaimResourcesWorkflowScope)),
 		logger:            logger,
 	}
@@ -173,6 +187,23 @@ func (a *Activities) EnsureNoExecutionsStdVisibilityActivity(ctx context.Context
 	return nil
 }
 
+// DeleteVisibilityIndexActivity deletes dedicated Elasticsearch visibility indices of namespace (if any).
+func (a *Activities) DeleteVisibilityIndexActivity(ctx context.Context, nsID namespace.ID, nsName namespace.Name) error {
+	if a.esClient == nil {
+		return nil
+	}
+
+	for _, index := range a.visibilityIndices {
+		err := esclient.DeleteNamespaceIndex(ctx, a.esClient, index, nsID.String())
+		if err != nil {
+			a.metricsHandler.Counter(metrics.DeleteVisibilityIndexFailuresCount.GetMetricName()).Record(1)
+			a.logger.Error("Unable to delete namespace visibility index.", tag.WorkflowNamespace(nsName.String()), tag.ESIndex(index), tag.Error(err))
+			return err
+		}
+	}
+	return nil
+}
+
 func (a *Activities) DeleteNamespaceActivity(ctx context.Context, nsID namespace.ID, nsName namespace.Name) error {
 	ctx = headers.SetCallerName(ctx, nsName.String())
 
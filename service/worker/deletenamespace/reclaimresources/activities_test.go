@@ -38,6 +38,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence/visibility/manager"
+	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
 )
 
 func Test_EnsureNoExecutionsAdvVisibilityActivity_NoExecutions(t *testing.T) {
@@ -169,3 +170,27 @@ func Test_EnsureNoExecutionsStdVisibilityActivity_ExecutionsExist(t *testing.T)
 	require.ErrorAs(t, err, &appErr)
 	require.Equal(t, "ExecutionsStillExist", appErr.Type())
 }
+
+func Test_DeleteVisibilityIndexActivity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	esClient := esclient.NewMockClient(ctrl)
+	esConfig := &esclient.Config{
+		Indices: map[string]string{
+			esclient.VisibilityAppName:          "visibility-index",
+			esclient.SecondaryVisibilityAppName: "secondary-visibility-index",
+		},
+	}
+
+	esClient.EXPECT().IndexExists(gomock.Any(), "visibility-index_ns_namespace-id").Return(true, nil)
+	esClient.EXPECT().DeleteIndex(gomock.Any(), "visibility-index_ns_namespace-id").Return(true, nil)
+	esClient.EXPECT().IndexExists(gomock.Any(), "secondary-visibility-index_ns_namespace-id").Return(false, nil)
+
+	a := NewActivities(nil, nil, esClient, esConfig, metrics.NoopMetricsHandler, log.NewNoopLogger())
+	err := a.DeleteVisibilityIndexActivity(context.Background(), "namespace-id", "namespace")
+	require.NoError(t, err)
+
+	// Elasticsearch is not configured.
+	a = NewActivities(nil, nil, nil, nil, metrics.NoopMetricsHandler, log.NewNoopLogger())
+	err = a.DeleteVisibilityIndexActivity(context.Background(), "namespace-id", "namespace")
+	require.NoError(t, err)
+}
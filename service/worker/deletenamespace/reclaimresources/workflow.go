@@ -41,6 +41,8 @@ const (
 	WorkflowName = "temporal-sys-reclaim-namespace-resources-workflow"
 
 	namespaceCacheRefreshDelay = 11 * time.Second
+
+	deleteVisibilityIndexChangeID = "delete-visibility-index"
 )
 
 type (
@@ -133,13 +135,22 @@ func ReclaimResourcesWorkflow(ctx workflow.Context, params ReclaimResourcesParam
 		return result, err
 	}
 
-	// Step 2. Sleep before deleting namespace from database.
+	// Step 2. Delete dedicated visibility index of namespace. All executions are deleted at this point.
+	if workflow.GetVersion(ctx, deleteVisibilityIndexChangeID, workflow.DefaultVersion, 1) > workflow.DefaultVersion {
+		ctx2 := workflow.WithLocalActivityOptions(ctx, localActivityOptions)
+		err = workflow.ExecuteLocalActivity(ctx2, a.DeleteVisibilityIndexActivity, params.NamespaceID, params.Namespace).Get(ctx, nil)
+		if err != nil {
+			return result, fmt.Errorf("%w: DeleteVisibilityIndexActivity: %v", errors.ErrUnableToExecuteActivity, err)
+		}
+	}
+
+	// Step 3. Sleep before deleting namespace from database.
 	err = workflow.Sleep(ctx, params.NamespaceDeleteDelay)
 	if err != nil {
 		return result, fmt.Errorf("%w: %v", errors.ErrUnableToSleep, err)
 	}
 
-	// Step 3. Delete namespace from database.
+	// Step 4. Delete namespace from database.
 	ctx5 := workflow.WithLocalActivityOptions(ctx, localActivityOptions)
 	err = workflow.ExecuteLocalActivity(ctx5, a.DeleteNamespaceActivity, params.NamespaceID, params.Namespace).Get(ctx, nil)
 	if err != nil {
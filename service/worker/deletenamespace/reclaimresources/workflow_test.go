@@ -72,6 +72,7 @@ func Test_ReclaimResourcesWorkflow_Success(t *testing.T) {
 	env.OnActivity(a.CountExecutionsAdvVisibilityActivity, mock.Anything, namespace.ID("namespace-id"), namespace.Name("namespace")).Return(int64(10), nil).Once()
 	env.OnActivity(a.EnsureNoExecutionsAdvVisibilityActivity, mock.Anything, namespace.ID("namespace-id"), namespace.Name("namespace"), 0).Return(nil).Once()
 
+	env.OnActivity(a.DeleteVisibilityIndexActivity, mock.Anything, namespace.ID("namespace-id"), namespace.Name("namespace")).Return(nil).Once()
 	env.OnActivity(a.DeleteNamespaceActivity, mock.Anything, namespace.ID("namespace-id"), namespace.Name("namespace")).Return(nil).Once()
 
 	env.ExecuteWorkflow(ReclaimResourcesWorkflow, ReclaimResourcesParams{
@@ -235,6 +236,7 @@ func Test_ReclaimResourcesWorkflow_NoActivityMocks_Success(t *testing.T) {
 	env.RegisterActivity(a.IsAdvancedVisibilityActivity)
 	env.RegisterActivity(a.CountExecutionsAdvVisibilityActivity)
 	env.RegisterActivity(a.EnsureNoExecutionsAdvVisibilityActivity)
+	env.RegisterActivity(a.DeleteVisibilityIndexActivity)
 	env.RegisterActivity(a.DeleteNamespaceActivity)
 
 	env.RegisterWorkflow(deleteexecutions.DeleteExecutionsWorkflow)
@@ -60,6 +60,7 @@ var Module = fx.Options(
 	fx.Provide(ThrottledLoggerRpsFnProvider),
 	fx.Provide(ConfigProvider),
 	fx.Provide(PersistenceRateLimitingParamsProvider),
+	fx.Provide(NamespaceIndexManagerProvider),
 	fx.Provide(NewService),
 	fx.Provide(NewWorkerManager),
 	fx.Provide(NewPerNamespaceWorkerManager),
@@ -126,6 +127,16 @@ func VisibilityManagerProvider(
 	)
 }
 
+func NamespaceIndexManagerProvider(
+	esClient esclient.Client,
+	esConfig *esclient.Config,
+	saProvider searchattribute.Provider,
+	serviceConfig *Config,
+	logger log.Logger,
+) *esclient.NamespaceIndexManager {
+	return esclient.NewNamespaceIndexManager(esClient, esConfig, saProvider, serviceConfig.EnablePerNamespaceVisibilityIndex, logger)
+}
+
 func ServiceLifetimeHooks(
 	lc fx.Lifecycle,
 	svcStoppedCh chan struct{},
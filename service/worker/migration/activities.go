@@ -32,19 +32,31 @@ import (
 	"time"
 
 	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	filterpb "go.temporal.io/api/filter/v1"
 	replicationpb "go.temporal.io/api/replication/v1"
 	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/converter"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/quotas"
 )
 
-// GetMetadata returns history shard count and namespaceID for requested namespace.
+// GetMetadata returns history shard count, namespaceID and active cluster for requested namespace.
 func (a *activities) GetMetadata(ctx context.Context, request metadataRequest) (*metadataResponse, error) {
 	nsEntry, err := a.namespaceRegistry.GetNamespace(namespace.Name(request.Namespace))
 	if err != nil {
@@ -52,8 +64,9 @@ func (a *activities) GetMetadata(ctx context.Context, request metadataRequest) (
 	}
 
 	return &metadataResponse{
-		ShardCount:  a.historyShardCount,
-		NamespaceID: string(nsEntry.ID()),
+		ShardCount:    a.historyShardCount,
+		NamespaceID:   string(nsEntry.ID()),
+		ActiveCluster: nsEntry.ActiveClusterName(),
 	}, nil
 }
 
@@ -175,20 +188,21 @@ func (a *activities) WaitHandover(ctx context.Context, waitRequest waitHandoverR
 // Check if remote cluster has caught up on all shards on replication tasks
 func (a *activities) checkHandoverOnce(ctx context.Context, waitRequest waitHandoverRequest) (bool, error) {
 
-	resp, err := a.historyClient.GetReplicationStatus(ctx, &historyservice.GetReplicationStatusRequest{
-		RemoteClusters: []string{waitRequest.RemoteCluster},
-	})
+	var shards []*historyservice.ShardReplicationStatus
+	var err error
+	if waitRequest.ActiveCluster == "" {
+		shards, err = a.getHandoverStatus(ctx, waitRequest)
+	} else {
+		shards, err = a.getRemoteHandoverStatus(ctx, waitRequest)
+	}
 	if err != nil {
 		return false, err
 	}
-	if int(waitRequest.ShardCount) != len(resp.Shards) {
-		return false, fmt.Errorf("GetReplicationStatus returns %d shards, expecting %d", len(resp.Shards), waitRequest.ShardCount)
-	}
 
 	readyShardCount := 0
 	logged := false
 	// check that every shard is ready to handover
-	for _, shard := range resp.Shards {
+	for _, shard := range shards {
 		clusterInfo, hasClusterInfo := shard.RemoteClusters[waitRequest.RemoteCluster]
 		handoverInfo, hasHandoverInfo := shard.HandoverNamespaces[waitRequest.Namespace]
 		if hasClusterInfo && hasHandoverInfo {
@@ -233,7 +247,67 @@ func (a *activities) checkHandoverOnce(ctx context.Context, waitRequest waitHand
 		tag.NewStringTag("Namespace", waitRequest.Namespace),
 		tag.NewStringTag("RemoteCluster", waitRequest.RemoteCluster))
 
-	return readyShardCount == len(resp.Shards), nil
+	return readyShardCount == len(shards), nil
+}
+
+// getHandoverStatus returns the replication status of the shards of the current cluster.
+func (a *activities) getHandoverStatus(ctx context.Context, waitRequest waitHandoverRequest) ([]*historyservice.ShardReplicationStatus, error) {
+	resp, err := a.historyClient.GetReplicationStatus(ctx, &historyservice.GetReplicationStatusRequest{
+		RemoteClusters: []string{waitRequest.RemoteCluster},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if int(waitRequest.ShardCount) != len(resp.Shards) {
+		return nil, fmt.Errorf("GetReplicationStatus returns %d shards, expecting %d", len(resp.Shards), waitRequest.ShardCount)
+	}
+	return resp.Shards, nil
+}
+
+// getRemoteHandoverStatus returns the replication status of the shards of the cluster the namespace is in
+// handover on, which is a remote cluster when a failover is rolled back.
+func (a *activities) getRemoteHandoverStatus(ctx context.Context, waitRequest waitHandoverRequest) ([]*historyservice.ShardReplicationStatus, error) {
+	remoteAdminClient, err := a.clientBean.GetRemoteAdminClient(waitRequest.ActiveCluster)
+	if err != nil {
+		return nil, err
+	}
+	clusterResp, err := remoteAdminClient.DescribeCluster(ctx, &adminservice.DescribeClusterRequest{})
+	if err != nil {
+		return nil, err
+	}
+	resp, err := remoteAdminClient.GetReplicationStatus(ctx, &adminservice.GetReplicationStatusRequest{
+		RemoteClusters: []string{waitRequest.RemoteCluster},
+		IncludeShards:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if int(clusterResp.GetHistoryShardCount()) != len(resp.Shards) {
+		return nil, fmt.Errorf("GetReplicationStatus of cluster %s returns %d shards, expecting %d",
+			waitRequest.ActiveCluster, len(resp.Shards), clusterResp.GetHistoryShardCount())
+	}
+
+	shards := make([]*historyservice.ShardReplicationStatus, 0, len(resp.Shards))
+	for _, shard := range resp.Shards {
+		status := &historyservice.ShardReplicationStatus{
+			ShardId:              shard.GetShardId(),
+			MaxReplicationTaskId: shard.GetMaxReplicationTaskId(),
+			RemoteClusters:       make(map[string]*historyservice.ShardReplicationStatusPerCluster),
+			HandoverNamespaces:   make(map[string]*historyservice.HandoverNamespaceInfo),
+		}
+		if clusterStatus, ok := shard.GetRemoteClusters()[waitRequest.RemoteCluster]; ok {
+			status.RemoteClusters[waitRequest.RemoteCluster] = &historyservice.ShardReplicationStatusPerCluster{
+				AckedTaskId: clusterStatus.GetAckedTaskId(),
+			}
+		}
+		if taskID, ok := shard.GetHandoverReplicationTaskIds()[waitRequest.Namespace]; ok {
+			status.HandoverNamespaces[waitRequest.Namespace] = &historyservice.HandoverNamespaceInfo{
+				HandoverReplicationTaskId: taskID,
+			}
+		}
+		shards = append(shards, status)
+	}
+	return shards, nil
 }
 
 func (a *activities) generateWorkflowReplicationTask(ctx context.Context, wKey definition.WorkflowKey) error {
@@ -346,3 +420,330 @@ func (a *activities) GenerateReplicationTasks(ctx context.Context, request *gene
 
 	return nil
 }
+
+// CheckReplicationDLQ checks that the remote cluster has no replication task from the source cluster in its DLQ.
+func (a *activities) CheckReplicationDLQ(ctx context.Context, request checkReplicationDLQRequest) error {
+	remoteAdminClient, err := a.clientBean.GetRemoteAdminClient(request.RemoteCluster)
+	if err != nil {
+		return err
+	}
+	resp, err := remoteAdminClient.GetReplicationStatus(ctx, &adminservice.GetReplicationStatusRequest{
+		RemoteClusters: []string{request.SourceCluster},
+	})
+	if err != nil {
+		return err
+	}
+	if dlqTaskCount := resp.RemoteClusters[request.SourceCluster].GetInboundDlqTaskCount(); dlqTaskCount > 0 {
+		return fmt.Errorf("remote cluster %s has %d replication tasks from %s in DLQ", request.RemoteCluster, dlqTaskCount, request.SourceCluster)
+	}
+	return nil
+}
+
+// CheckReplicationLag checks that replication to the remote cluster is not lagging more than allowed on every shard.
+func (a *activities) CheckReplicationLag(ctx context.Context, request checkReplicationLagRequest) error {
+	resp, err := a.historyClient.GetReplicationStatus(ctx, &historyservice.GetReplicationStatusRequest{
+		RemoteClusters: []string{request.RemoteCluster},
+	})
+	if err != nil {
+		return err
+	}
+	if int(a.historyShardCount) != len(resp.Shards) {
+		return fmt.Errorf("GetReplicationStatus returns %d shards, expecting %d", len(resp.Shards), a.historyShardCount)
+	}
+
+	for _, shard := range resp.Shards {
+		clusterInfo, ok := shard.RemoteClusters[request.RemoteCluster]
+		if !ok {
+			return fmt.Errorf("GetReplicationStatus response for shard %d does not contains remote cluster %s", shard.ShardId, request.RemoteCluster)
+		}
+		if clusterInfo.AckedTaskId >= shard.MaxReplicationTaskId {
+			continue
+		}
+		if clusterInfo.Lag == nil {
+			return fmt.Errorf("remote cluster %s has not acked any replication task of shard %d", request.RemoteCluster, shard.ShardId)
+		}
+		if *clusterInfo.Lag > request.AllowedLagging {
+			return fmt.Errorf("replication to remote cluster %s lags %v on shard %d, allowed lagging is %v",
+				request.RemoteCluster, *clusterInfo.Lag, shard.ShardId, request.AllowedLagging)
+		}
+	}
+	return nil
+}
+
+// CheckForceReplication checks that no force-replication workflow is running for the namespace.
+func (a *activities) CheckForceReplication(ctx context.Context, request checkForceReplicationRequest) error {
+	var nextPageToken []byte
+	for {
+		resp, err := a.frontendClient.ListOpenWorkflowExecutions(ctx, &workflowservice.ListOpenWorkflowExecutionsRequest{
+			Namespace:       primitives.SystemLocalNamespace,
+			MaximumPageSize: defaultListWorkflowsPageSize,
+			NextPageToken:   nextPageToken,
+			Filters: &workflowservice.ListOpenWorkflowExecutionsRequest_TypeFilter{
+				TypeFilter: &filterpb.WorkflowTypeFilter{Name: forceReplicationWorkflowName},
+			},
+		})
+		if err != nil {
+			return err
+		}
+		for _, execution := range resp.Executions {
+			params, err := a.getForceReplicationParams(ctx, execution.Execution)
+			if err != nil {
+				return err
+			}
+			if params.Namespace == request.Namespace {
+				return fmt.Errorf("force-replication workflow %s is running for namespace %s", execution.Execution.GetWorkflowId(), request.Namespace)
+			}
+		}
+		nextPageToken = resp.NextPageToken
+		if len(nextPageToken) == 0 {
+			return nil
+		}
+	}
+}
+
+func (a *activities) getForceReplicationParams(ctx context.Context, execution *commonpb.WorkflowExecution) (*ForceReplicationParams, error) {
+	resp, err := a.frontendClient.GetWorkflowExecutionHistory(ctx, &workflowservice.GetWorkflowExecutionHistoryRequest{
+		Namespace:       primitives.SystemLocalNamespace,
+		Execution:       execution,
+		MaximumPageSize: 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	events := resp.GetHistory().GetEvents()
+	if len(events) == 0 {
+		return nil, fmt.Errorf("force-replication workflow %s has no history", execution.GetWorkflowId())
+	}
+	var params ForceReplicationParams
+	input := events[0].GetWorkflowExecutionStartedEventAttributes().GetInput()
+	if err := converter.GetDefaultDataConverter().FromPayloads(input, &params); err != nil {
+		return nil, err
+	}
+	return &params, nil
+}
+
+func (a *activities) WaitRemoteHealthy(ctx context.Context, request waitRemoteHealthyRequest) error {
+	for {
+		done, err := a.checkRemoteHealthyOnce(ctx, request)
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+		// keep waiting and check again
+		time.Sleep(time.Second)
+		activity.RecordHeartbeat(ctx, nil)
+	}
+}
+
+// Check if the namespace is active and serving on the remote cluster, and replication from the source cluster is healthy
+func (a *activities) checkRemoteHealthyOnce(ctx context.Context, request waitRemoteHealthyRequest) (bool, error) {
+	_, remoteFrontendClient, err := a.clientBean.GetRemoteFrontendClient(request.RemoteCluster)
+	if err != nil {
+		return false, err
+	}
+	descResp, err := remoteFrontendClient.DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{
+		Namespace: request.Namespace,
+	})
+	if err != nil {
+		return false, err
+	}
+	if descResp.ReplicationConfig.GetActiveClusterName() != request.RemoteCluster ||
+		descResp.ReplicationConfig.GetState() != enumspb.REPLICATION_STATE_NORMAL {
+		a.logger.Info("Wait remote healthy namespace not active",
+			tag.WorkflowNamespace(request.Namespace),
+			tag.ClusterName(request.RemoteCluster),
+			tag.NewStringTag("ActiveCluster", descResp.ReplicationConfig.GetActiveClusterName()),
+			tag.NewStringTag("ReplicationState", descResp.ReplicationConfig.GetState().String()),
+		)
+		return false, nil
+	}
+
+	remoteAdminClient, err := a.clientBean.GetRemoteAdminClient(request.RemoteCluster)
+	if err != nil {
+		return false, err
+	}
+	statusResp, err := remoteAdminClient.GetReplicationStatus(ctx, &adminservice.GetReplicationStatusRequest{
+		RemoteClusters: []string{request.SourceCluster},
+	})
+	if err != nil {
+		return false, err
+	}
+	status := statusResp.RemoteClusters[request.SourceCluster]
+	if status.GetStoppedProcessorCount() > 0 || status.GetInboundDlqTaskCount() > 0 {
+		a.logger.Info("Wait remote healthy replication not healthy",
+			tag.WorkflowNamespace(request.Namespace),
+			tag.ClusterName(request.RemoteCluster),
+			tag.NewInt32("StoppedProcessorCount", status.GetStoppedProcessorCount()),
+			tag.NewInt64("DLQTaskCount", status.GetInboundDlqTaskCount()),
+		)
+		return false, nil
+	}
+	return true, nil
+}
+
+// GetVisibilityBackfillMetadata returns history shard count and, if a namespace is requested, its namespace ID.
+func (a *activities) GetVisibilityBackfillMetadata(ctx context.Context, request visibilityBackfillMetadataRequest) (*metadataResponse, error) {
+	if request.Verify && visibility.SecondaryManager(a.visibilityManager) == nil {
+		return nil, temporal.NewNonRetryableApplicationError("secondary visibility store is not configured", "", nil)
+	}
+
+	resp := &metadataResponse{ShardCount: a.historyShardCount}
+	if request.Namespace == "" {
+		return resp, nil
+	}
+	nsEntry, err := a.namespaceRegistry.GetNamespace(namespace.Name(request.Namespace))
+	if _, isNotFound := err.(*serviceerror.NamespaceNotFound); isNotFound {
+		return nil, temporal.NewNonRetryableApplicationError(fmt.Sprintf("namespace %s is not found", request.Namespace), "", err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	resp.NamespaceID = nsEntry.ID().String()
+	return resp, nil
+}
+
+// BackfillVisibility regenerates the visibility tasks of one page of executions of a shard. With dual visibility
+// write enabled, the regenerated tasks rebuild the records of the executions in the secondary visibility store.
+func (a *activities) BackfillVisibility(ctx context.Context, request *visibilityBackfillPageRequest) (*visibilityBackfillPageResponse, error) {
+	states, resp, err := a.listVisibilityBackfillPage(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	rateLimiter := quotas.NewRateLimiter(request.RPS, int(math.Ceil(request.RPS)))
+	for i, state := range states {
+		if err := rateLimiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+		_, err := a.historyClient.GenerateVisibilityTasks(ctx, &historyservice.GenerateVisibilityTasksRequest{
+			NamespaceId: state.ExecutionInfo.NamespaceId,
+			Execution: &commonpb.WorkflowExecution{
+				WorkflowId: state.ExecutionInfo.WorkflowId,
+				RunId:      state.ExecutionState.RunId,
+			},
+		})
+		switch err.(type) {
+		case nil:
+			resp.ProcessedCount++
+		case *serviceerror.NotFound, *serviceerror.NamespaceNotFound:
+			// deleted since the page was listed
+			resp.SkippedCount++
+		default:
+			a.logger.Info("Visibility backfill failed",
+				tag.WorkflowNamespaceID(state.ExecutionInfo.NamespaceId),
+				tag.WorkflowID(state.ExecutionInfo.WorkflowId),
+				tag.WorkflowRunID(state.ExecutionState.RunId),
+				tag.Error(err))
+			return nil, err
+		}
+		activity.RecordHeartbeat(ctx, i)
+	}
+	return resp, nil
+}
+
+// VerifyVisibility compares the records of one page of executions of a shard in the secondary visibility store
+// with their mutable state and reports the executions that do not match.
+func (a *activities) VerifyVisibility(ctx context.Context, request *visibilityBackfillPageRequest) (*visibilityBackfillPageResponse, error) {
+	secondaryVisibilityManager := visibility.SecondaryManager(a.visibilityManager)
+	if secondaryVisibilityManager == nil {
+		return nil, temporal.NewNonRetryableApplicationError("secondary visibility store is not configured", "", nil)
+	}
+
+	states, resp, err := a.listVisibilityBackfillPage(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	rateLimiter := quotas.NewRateLimiter(request.RPS, int(math.Ceil(request.RPS)))
+	for i, state := range states {
+		if err := rateLimiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+		nsEntry, err := a.namespaceRegistry.GetNamespaceByID(namespace.ID(state.ExecutionInfo.NamespaceId))
+		if _, isNotFound := err.(*serviceerror.NamespaceNotFound); isNotFound {
+			resp.SkippedCount++
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		reason := visibilityMismatchReasonMissing
+		record, err := secondaryVisibilityManager.GetWorkflowExecution(ctx, &manager.GetWorkflowExecutionRequest{
+			NamespaceID: nsEntry.ID(),
+			Namespace:   nsEntry.Name(),
+			WorkflowID:  state.ExecutionInfo.WorkflowId,
+			RunID:       state.ExecutionState.RunId,
+		})
+		switch err.(type) {
+		case nil:
+			if record.Execution != nil {
+				reason = visibilityMismatchReason(state, record.Execution)
+			}
+		case *serviceerror.NotFound:
+		default:
+			return nil, err
+		}
+
+		resp.ProcessedCount++
+		if reason != "" {
+			resp.MismatchCount++
+			if len(resp.Mismatches) < request.MaxMismatches {
+				resp.Mismatches = append(resp.Mismatches, VisibilityMismatch{
+					NamespaceID: state.ExecutionInfo.NamespaceId,
+					WorkflowID:  state.ExecutionInfo.WorkflowId,
+					RunID:       state.ExecutionState.RunId,
+					Reason:      reason,
+				})
+			}
+		}
+		activity.RecordHeartbeat(ctx, i)
+	}
+	return resp, nil
+}
+
+// listVisibilityBackfillPage lists one page of executions of a shard, leaving out executions of other
+// namespaces if a namespace is requested and executions that are never recorded in visibility.
+func (a *activities) listVisibilityBackfillPage(
+	ctx context.Context,
+	request *visibilityBackfillPageRequest,
+) ([]*persistencespb.WorkflowMutableState, *visibilityBackfillPageResponse, error) {
+	listResp, err := a.executionManager.ListConcreteExecutions(ctx, &persistence.ListConcreteExecutionsRequest{
+		ShardID:   request.ShardID,
+		PageSize:  request.PageSize,
+		PageToken: request.NextPageToken,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := &visibilityBackfillPageResponse{NextPageToken: listResp.PageToken}
+	states := make([]*persistencespb.WorkflowMutableState, 0, len(listResp.States))
+	for _, state := range listResp.States {
+		if request.NamespaceID != "" && state.ExecutionInfo.NamespaceId != request.NamespaceID {
+			continue
+		}
+		switch state.ExecutionState.State {
+		case enumsspb.WORKFLOW_EXECUTION_STATE_ZOMBIE, enumsspb.WORKFLOW_EXECUTION_STATE_VOID:
+			resp.SkippedCount++
+			continue
+		}
+		states = append(states, state)
+	}
+	return states, resp, nil
+}
+
+// visibilityMismatchReason returns the first field in which the visibility record differs from the execution,
+// or an empty string if the record is in sync.
+func visibilityMismatchReason(
+	state *persistencespb.WorkflowMutableState,
+	record *workflowpb.WorkflowExecutionInfo,
+) string {
+	mismatches := visibility.CompareRecord(state, record)
+	if len(mismatches) == 0 {
+		return ""
+	}
+	return mismatches[0].Field
+}
@@ -0,0 +1,333 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package migration
+
+import (
+	"errors"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+const (
+	namespaceFailoverWorkflowName    = "namespace-failover"
+	namespaceFailoverStatusQueryType = "namespace-failover-status"
+
+	minimumReadinessTimeoutSeconds   = 30
+	minimumHealthCheckTimeoutSeconds = 30
+
+	failoverStepCheckReplicationDLQ   = "check-replication-dlq"
+	failoverStepCheckReplicationLag   = "check-replication-lag"
+	failoverStepCheckForceReplication = "check-force-replication"
+	failoverStepHandover              = "handover"
+	failoverStepWaitHandover          = "wait-handover"
+	failoverStepUpdateActiveCluster   = "update-active-cluster"
+	failoverStepWaitRemoteHealthy     = "wait-remote-healthy"
+	failoverStepRollbackHandover      = "rollback-handover"
+	failoverStepRollbackWaitHandover  = "rollback-wait-handover"
+	failoverStepRollback              = "rollback"
+
+	failoverStepStateRunning   = "running"
+	failoverStepStateCompleted = "completed"
+	failoverStepStateFailed    = "failed"
+)
+
+type (
+	NamespaceFailoverParams struct {
+		Namespace     string
+		RemoteCluster string
+
+		// how far behind on replication is allowed for remote cluster before handover is initiated
+		AllowedLaggingSeconds int
+
+		// how long to wait for the namespace to pass the readiness checks before giving up,
+		// the namespace is not changed if the readiness checks do not pass
+		ReadinessTimeoutSeconds int
+
+		// how long to wait for handover to complete before the failover is aborted, or the rollback is given up
+		HandoverTimeoutSeconds int
+
+		// how long to wait for the remote cluster to become healthy once active before rollback
+		HealthCheckTimeoutSeconds int
+	}
+
+	NamespaceFailoverStatus struct {
+		// Step is the step being run, or the last step run once the workflow completes
+		Step  string
+		Steps []FailoverStepStatus
+		// Aborted is set when handover did not complete and the namespace stayed active on the source cluster
+		Aborted bool
+		// RolledBack is set when the namespace was made active on the source cluster again after failover
+		RolledBack bool
+	}
+
+	FailoverStepStatus struct {
+		Name      string
+		State     string
+		StartTime time.Time
+		CloseTime time.Time
+		Error     string
+	}
+
+	checkReplicationDLQRequest struct {
+		SourceCluster string // cluster the namespace is active on
+		RemoteCluster string
+	}
+
+	checkReplicationLagRequest struct {
+		RemoteCluster  string
+		AllowedLagging time.Duration
+	}
+
+	checkForceReplicationRequest struct {
+		Namespace string
+	}
+
+	waitRemoteHealthyRequest struct {
+		Namespace     string
+		SourceCluster string // cluster the namespace was active on before failover
+		RemoteCluster string
+	}
+)
+
+// NamespaceFailoverWorkflow makes the namespace active on the remote cluster. It validates that the namespace
+// is ready for failover, moves it to handover state until the remote cluster caught up, flips the active
+// cluster and rolls back the same way if the remote cluster does not become healthy in time.
+func NamespaceFailoverWorkflow(ctx workflow.Context, params NamespaceFailoverParams) (retErr error) {
+	status := &NamespaceFailoverStatus{}
+	if err := workflow.SetQueryHandler(ctx, namespaceFailoverStatusQueryType, func() (*NamespaceFailoverStatus, error) {
+		return status, nil
+	}); err != nil {
+		return err
+	}
+
+	if err := validateAndSetNamespaceFailoverParams(&params); err != nil {
+		return err
+	}
+
+	retryPolicy := &temporal.RetryPolicy{
+		InitialInterval:    time.Second,
+		MaximumInterval:    time.Second,
+		BackoffCoefficient: 1,
+	}
+	ao := workflow.ActivityOptions{
+		StartToCloseTimeout: time.Second * 10,
+		RetryPolicy:         retryPolicy,
+	}
+	ctx = workflow.WithActivityOptions(ctx, ao)
+
+	var a *activities
+
+	var metadataResp metadataResponse
+	metadataRequest := metadataRequest{Namespace: params.Namespace}
+	err := workflow.ExecuteActivity(ctx, a.GetMetadata, metadataRequest).Get(ctx, &metadataResp)
+	if err != nil {
+		return err
+	}
+	if metadataResp.ActiveCluster == params.RemoteCluster {
+		return temporal.NewNonRetryableApplicationError("namespace is already active on remote cluster", "InvalidArgument", nil)
+	}
+
+	// ** Readiness checks: the namespace is not changed until all of them pass, they are retried until the
+	//    readiness timeout so that operators can fix the cause without restarting the failover.
+	readinessCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout:    time.Second * 30,
+		ScheduleToCloseTimeout: time.Second * time.Duration(params.ReadinessTimeoutSeconds),
+		RetryPolicy:            retryPolicy,
+	})
+	err = runFailoverStep(readinessCtx, status, failoverStepCheckReplicationDLQ, a.CheckReplicationDLQ, checkReplicationDLQRequest{
+		SourceCluster: metadataResp.ActiveCluster,
+		RemoteCluster: params.RemoteCluster,
+	})
+	if err != nil {
+		return err
+	}
+	err = runFailoverStep(readinessCtx, status, failoverStepCheckReplicationLag, a.CheckReplicationLag, checkReplicationLagRequest{
+		RemoteCluster:  params.RemoteCluster,
+		AllowedLagging: time.Duration(params.AllowedLaggingSeconds) * time.Second,
+	})
+	if err != nil {
+		return err
+	}
+	err = runFailoverStep(readinessCtx, status, failoverStepCheckForceReplication, a.CheckForceReplication, checkForceReplicationRequest{
+		Namespace: params.Namespace,
+	})
+	if err != nil {
+		return err
+	}
+
+	// ** Handover (WARNING: Namespace cannot serve traffic while in this state)
+	err = runFailoverStep(ctx, status, failoverStepHandover, a.UpdateNamespaceState, updateStateRequest{
+		Namespace: params.Namespace,
+		NewState:  enumspb.REPLICATION_STATE_HANDOVER,
+	})
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		// Reset namespace state from Handover -> Normal whether failover succeeded, failed or was rolled back,
+		// so that the namespace is able to process traffic again on whichever cluster it is active on.
+		resetStateRequest := updateStateRequest{
+			Namespace: params.Namespace,
+			NewState:  enumspb.REPLICATION_STATE_NORMAL,
+		}
+		err := workflow.ExecuteActivity(ctx, a.UpdateNamespaceState, resetStateRequest).Get(ctx, nil)
+		if err != nil {
+			retErr = err
+			return
+		}
+	}()
+
+	// ** Wait for remote cluster to completely drain its replication tasks, the namespace stays active on
+	//    this cluster if it does not before the handover timeout.
+	handoverCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout:    time.Second * 30,
+		HeartbeatTimeout:       time.Second * 10,
+		ScheduleToCloseTimeout: time.Second * time.Duration(params.HandoverTimeoutSeconds),
+		RetryPolicy:            retryPolicy,
+	})
+	err = runFailoverStep(handoverCtx, status, failoverStepWaitHandover, a.WaitHandover, waitHandoverRequest{
+		ShardCount:    metadataResp.ShardCount,
+		Namespace:     params.Namespace,
+		RemoteCluster: params.RemoteCluster,
+	})
+	if err != nil {
+		status.Aborted = true
+		return err
+	}
+
+	// ** Remote cluster is caught up, make the namespace active on it.
+	err = runFailoverStep(ctx, status, failoverStepUpdateActiveCluster, a.UpdateActiveCluster, updateActiveClusterRequest{
+		Namespace:     params.Namespace,
+		ActiveCluster: params.RemoteCluster,
+	})
+	if err != nil {
+		return err
+	}
+	err = workflow.ExecuteActivity(ctx, a.UpdateNamespaceState, updateStateRequest{
+		Namespace: params.Namespace,
+		NewState:  enumspb.REPLICATION_STATE_NORMAL,
+	}).Get(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	// ** Make the namespace active on the source cluster again if the remote cluster does not become healthy.
+	healthCheckCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout:    time.Second * 30,
+		HeartbeatTimeout:       time.Second * 10,
+		ScheduleToCloseTimeout: time.Second * time.Duration(params.HealthCheckTimeoutSeconds),
+		RetryPolicy:            retryPolicy,
+	})
+	healthErr := runFailoverStep(healthCheckCtx, status, failoverStepWaitRemoteHealthy, a.WaitRemoteHealthy, waitRemoteHealthyRequest{
+		Namespace:     params.Namespace,
+		SourceCluster: metadataResp.ActiveCluster,
+		RemoteCluster: params.RemoteCluster,
+	})
+	if healthErr == nil {
+		return nil
+	}
+
+	// ** Roll back with handover from the remote cluster, so that no replication task of the remote cluster is
+	//    lost when the source cluster becomes active again. The namespace stays active on the remote cluster
+	//    if the source cluster does not catch up before the handover timeout.
+	err = runFailoverStep(ctx, status, failoverStepRollbackHandover, a.UpdateNamespaceState, updateStateRequest{
+		Namespace: params.Namespace,
+		NewState:  enumspb.REPLICATION_STATE_HANDOVER,
+	})
+	if err != nil {
+		return err
+	}
+	err = runFailoverStep(handoverCtx, status, failoverStepRollbackWaitHandover, a.WaitHandover, waitHandoverRequest{
+		Namespace:     params.Namespace,
+		RemoteCluster: metadataResp.ActiveCluster,
+		ActiveCluster: params.RemoteCluster,
+	})
+	if err != nil {
+		return err
+	}
+	err = runFailoverStep(ctx, status, failoverStepRollback, a.UpdateActiveCluster, updateActiveClusterRequest{
+		Namespace:     params.Namespace,
+		ActiveCluster: metadataResp.ActiveCluster,
+	})
+	if err != nil {
+		return err
+	}
+	status.RolledBack = true
+	return healthErr
+}
+
+// runFailoverStep runs the activity of a failover step and records its progress in the status.
+func runFailoverStep(
+	ctx workflow.Context,
+	status *NamespaceFailoverStatus,
+	name string,
+	activity interface{},
+	request interface{},
+) error {
+	status.Step = name
+	status.Steps = append(status.Steps, FailoverStepStatus{
+		Name:      name,
+		State:     failoverStepStateRunning,
+		StartTime: workflow.Now(ctx),
+	})
+	step := &status.Steps[len(status.Steps)-1]
+
+	err := workflow.ExecuteActivity(ctx, activity, request).Get(ctx, nil)
+	step.CloseTime = workflow.Now(ctx)
+	if err != nil {
+		step.State = failoverStepStateFailed
+		step.Error = err.Error()
+		return err
+	}
+	step.State = failoverStepStateCompleted
+	return nil
+}
+
+func validateAndSetNamespaceFailoverParams(params *NamespaceFailoverParams) error {
+	if len(params.Namespace) == 0 {
+		return errors.New("InvalidArgument: Namespace is required")
+	}
+	if len(params.RemoteCluster) == 0 {
+		return errors.New("InvalidArgument: RemoteCluster is required")
+	}
+	if params.AllowedLaggingSeconds <= minimumAllowedLaggingSeconds {
+		params.AllowedLaggingSeconds = minimumAllowedLaggingSeconds
+	}
+	if params.ReadinessTimeoutSeconds <= minimumReadinessTimeoutSeconds {
+		params.ReadinessTimeoutSeconds = minimumReadinessTimeoutSeconds
+	}
+	if params.HandoverTimeoutSeconds <= minimumHandoverTimeoutSeconds {
+		params.HandoverTimeoutSeconds = minimumHandoverTimeoutSeconds
+	}
+	if params.HealthCheckTimeoutSeconds <= minimumHealthCheckTimeoutSeconds {
+		params.HealthCheckTimeoutSeconds = minimumHealthCheckTimeoutSeconds
+	}
+
+	return nil
+}
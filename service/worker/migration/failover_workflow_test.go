@@ -0,0 +1,171 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package migration
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/testsuite"
+)
+
+func TestFailoverWorkflow(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	namespaceID := uuid.New()
+
+	var a *activities
+	env.OnActivity(a.GetMetadata, mock.Anything, metadataRequest{Namespace: "test-ns"}).Return(
+		&metadataResponse{ShardCount: 4, NamespaceID: namespaceID, ActiveCluster: "test-source"}, nil)
+	env.OnActivity(a.CheckReplicationDLQ, mock.Anything, checkReplicationDLQRequest{SourceCluster: "test-source", RemoteCluster: "test-remote"}).Return(nil)
+	env.OnActivity(a.CheckReplicationLag, mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity(a.CheckForceReplication, mock.Anything, checkForceReplicationRequest{Namespace: "test-ns"}).Return(nil)
+	env.OnActivity(a.UpdateNamespaceState, mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity(a.WaitHandover, mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity(a.UpdateActiveCluster, mock.Anything, updateActiveClusterRequest{Namespace: "test-ns", ActiveCluster: "test-remote"}).Return(nil).Once()
+	env.OnActivity(a.WaitRemoteHealthy, mock.Anything, mock.Anything).Return(nil)
+
+	env.ExecuteWorkflow(NamespaceFailoverWorkflow, NamespaceFailoverParams{
+		Namespace:     "test-ns",
+		RemoteCluster: "test-remote",
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+
+	status := queryFailoverStatus(t, env)
+	require.False(t, status.RolledBack)
+	require.Equal(t, failoverStepWaitRemoteHealthy, status.Step)
+	require.Len(t, status.Steps, 7)
+	for _, step := range status.Steps {
+		require.Equal(t, failoverStepStateCompleted, step.State)
+	}
+}
+
+func TestFailoverWorkflow_NotReady(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var a *activities
+	env.OnActivity(a.GetMetadata, mock.Anything, mock.Anything).Return(
+		&metadataResponse{ShardCount: 4, NamespaceID: uuid.New(), ActiveCluster: "test-source"}, nil)
+	env.OnActivity(a.CheckReplicationDLQ, mock.Anything, mock.Anything).Return(errors.New("replication tasks in DLQ"))
+
+	env.ExecuteWorkflow(NamespaceFailoverWorkflow, NamespaceFailoverParams{
+		Namespace:     "test-ns",
+		RemoteCluster: "test-remote",
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.Error(t, env.GetWorkflowError())
+	// namespace is not changed when readiness checks do not pass
+	env.AssertNotCalled(t, "UpdateNamespaceState", mock.Anything, mock.Anything)
+	env.AssertNotCalled(t, "UpdateActiveCluster", mock.Anything, mock.Anything)
+
+	status := queryFailoverStatus(t, env)
+	require.Equal(t, failoverStepCheckReplicationDLQ, status.Step)
+	require.Equal(t, failoverStepStateFailed, status.Steps[0].State)
+	require.NotEmpty(t, status.Steps[0].Error)
+}
+
+func TestFailoverWorkflow_RollbackWhenRemoteNotHealthy(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var a *activities
+	env.OnActivity(a.GetMetadata, mock.Anything, mock.Anything).Return(
+		&metadataResponse{ShardCount: 4, NamespaceID: uuid.New(), ActiveCluster: "test-source"}, nil)
+	env.OnActivity(a.CheckReplicationDLQ, mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity(a.CheckReplicationLag, mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity(a.CheckForceReplication, mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity(a.UpdateNamespaceState, mock.Anything, updateStateRequest{Namespace: "test-ns", NewState: enumspb.REPLICATION_STATE_HANDOVER}).Return(nil).Twice()
+	env.OnActivity(a.UpdateNamespaceState, mock.Anything, updateStateRequest{Namespace: "test-ns", NewState: enumspb.REPLICATION_STATE_NORMAL}).Return(nil)
+	env.OnActivity(a.WaitHandover, mock.Anything, waitHandoverRequest{ShardCount: 4, Namespace: "test-ns", RemoteCluster: "test-remote"}).Return(nil).Once()
+	env.OnActivity(a.UpdateActiveCluster, mock.Anything, updateActiveClusterRequest{Namespace: "test-ns", ActiveCluster: "test-remote"}).Return(nil).Once()
+	env.OnActivity(a.WaitRemoteHealthy, mock.Anything, mock.Anything).Return(errors.New("remote cluster not healthy"))
+	// the source cluster has to catch up with the remote cluster before it becomes active again
+	env.OnActivity(a.WaitHandover, mock.Anything, waitHandoverRequest{Namespace: "test-ns", RemoteCluster: "test-source", ActiveCluster: "test-remote"}).Return(nil).Once()
+	env.OnActivity(a.UpdateActiveCluster, mock.Anything, updateActiveClusterRequest{Namespace: "test-ns", ActiveCluster: "test-source"}).Return(nil).Once()
+
+	env.ExecuteWorkflow(NamespaceFailoverWorkflow, NamespaceFailoverParams{
+		Namespace:     "test-ns",
+		RemoteCluster: "test-remote",
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.Error(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+
+	status := queryFailoverStatus(t, env)
+	require.True(t, status.RolledBack)
+	require.False(t, status.Aborted)
+	require.Equal(t, failoverStepRollback, status.Step)
+	require.Equal(t, failoverStepRollbackHandover, status.Steps[len(status.Steps)-3].Name)
+	require.Equal(t, failoverStepRollbackWaitHandover, status.Steps[len(status.Steps)-2].Name)
+}
+
+func TestFailoverWorkflow_AbortWhenHandoverFails(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var a *activities
+	env.OnActivity(a.GetMetadata, mock.Anything, mock.Anything).Return(
+		&metadataResponse{ShardCount: 4, NamespaceID: uuid.New(), ActiveCluster: "test-source"}, nil)
+	env.OnActivity(a.CheckReplicationDLQ, mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity(a.CheckReplicationLag, mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity(a.CheckForceReplication, mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity(a.UpdateNamespaceState, mock.Anything, updateStateRequest{Namespace: "test-ns", NewState: enumspb.REPLICATION_STATE_HANDOVER}).Return(nil).Once()
+	env.OnActivity(a.UpdateNamespaceState, mock.Anything, updateStateRequest{Namespace: "test-ns", NewState: enumspb.REPLICATION_STATE_NORMAL}).Return(nil).Once()
+	env.OnActivity(a.WaitHandover, mock.Anything, mock.Anything).Return(errors.New("handover timed out"))
+
+	env.ExecuteWorkflow(NamespaceFailoverWorkflow, NamespaceFailoverParams{
+		Namespace:     "test-ns",
+		RemoteCluster: "test-remote",
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.Error(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+	// the namespace stays active on the source cluster
+	env.AssertNotCalled(t, "UpdateActiveCluster", mock.Anything, mock.Anything)
+
+	status := queryFailoverStatus(t, env)
+	require.True(t, status.Aborted)
+	require.False(t, status.RolledBack)
+	require.Equal(t, failoverStepWaitHandover, status.Step)
+}
+
+func queryFailoverStatus(t *testing.T, env *testsuite.TestWorkflowEnvironment) *NamespaceFailoverStatus {
+	encodedStatus, err := env.QueryWorkflow(namespaceFailoverStatusQueryType)
+	require.NoError(t, err)
+	var status NamespaceFailoverStatus
+	require.NoError(t, encodedStatus.Get(&status))
+	return &status
+}
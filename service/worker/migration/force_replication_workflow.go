@@ -77,8 +77,9 @@ type (
 	}
 
 	metadataResponse struct {
-		ShardCount  int32
-		NamespaceID string
+		ShardCount    int32
+		NamespaceID   string
+		ActiveCluster string
 	}
 )
 
@@ -31,11 +31,13 @@ import (
 	"go.uber.org/fx"
 
 	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/client"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
 	workercommon "go.temporal.io/server/service/worker/common"
 )
 
@@ -47,6 +49,8 @@ type (
 		NamespaceRegistry namespace.Registry
 		HistoryClient     historyservice.HistoryServiceClient
 		FrontendClient    workflowservice.WorkflowServiceClient
+		ClientBean        client.Bean
+		VisibilityManager manager.VisibilityManager
 		Logger            log.Logger
 		MetricsHandler    metrics.Handler
 	}
@@ -77,6 +81,8 @@ func NewResult(params initParams) fxResult {
 func (wc *replicationWorkerComponent) Register(worker sdkworker.Worker) {
 	worker.RegisterWorkflowWithOptions(ForceReplicationWorkflow, workflow.RegisterOptions{Name: forceReplicationWorkflowName})
 	worker.RegisterWorkflowWithOptions(NamespaceHandoverWorkflow, workflow.RegisterOptions{Name: namespaceHandoverWorkflowName})
+	worker.RegisterWorkflowWithOptions(NamespaceFailoverWorkflow, workflow.RegisterOptions{Name: namespaceFailoverWorkflowName})
+	worker.RegisterWorkflowWithOptions(VisibilityBackfillWorkflow, workflow.RegisterOptions{Name: visibilityBackfillWorkflowName})
 	worker.RegisterActivity(wc.activities())
 }
 
@@ -92,6 +98,8 @@ func (wc *replicationWorkerComponent) activities() *activities {
 		namespaceRegistry: wc.NamespaceRegistry,
 		historyClient:     wc.HistoryClient,
 		frontendClient:    wc.FrontendClient,
+		clientBean:        wc.ClientBean,
+		visibilityManager: wc.VisibilityManager,
 		logger:            wc.Logger,
 		metricsHandler:    wc.MetricsHandler,
 	}
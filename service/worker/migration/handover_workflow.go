@@ -34,10 +34,12 @@ import (
 	"go.temporal.io/sdk/workflow"
 
 	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/client"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
 )
 
 const (
@@ -71,6 +73,8 @@ type (
 		namespaceRegistry namespace.Registry
 		historyClient     historyservice.HistoryServiceClient
 		frontendClient    workflowservice.WorkflowServiceClient
+		clientBean        client.Bean
+		visibilityManager manager.VisibilityManager
 		logger            log.Logger
 		metricsHandler    metrics.Handler
 	}
@@ -98,9 +102,10 @@ type (
 	}
 
 	waitHandoverRequest struct {
-		ShardCount    int32
+		ShardCount    int32 // shard count of the current cluster, unused when ActiveCluster is set
 		Namespace     string
 		RemoteCluster string // remote cluster name
+		ActiveCluster string // cluster the namespace is in handover on if not the current cluster
 	}
 )
 
@@ -0,0 +1,295 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package migration
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+const (
+	visibilityBackfillWorkflowName    = "visibility-backfill"
+	visibilityBackfillStatusQueryType = "visibility-backfill-status"
+
+	visibilityBackfillPhaseBackfill         = "backfill"
+	visibilityBackfillPhaseWaitVerification = "wait-verification"
+	visibilityBackfillPhaseVerify           = "verify"
+	visibilityBackfillPhaseCompleted        = "completed"
+
+	// other reasons are the record fields compared by visibility.CompareRecord
+	visibilityMismatchReasonMissing = "missing"
+
+	defaultVisibilityBackfillPageSize        = 100
+	defaultVisibilityVerificationDelay       = 5 * time.Minute
+	defaultVisibilityMaxReportedMismatches   = 1000
+	visibilityBackfillActivityStartToClose   = time.Hour
+	visibilityBackfillActivityHeartbeatDelay = time.Second * 30
+)
+
+type (
+	// VisibilityBackfillParams configures the rebuild of visibility records in the secondary
+	// visibility store from mutable state, followed by a parity check against mutable state.
+	VisibilityBackfillParams struct {
+		Namespace        string  // optional, limits the backfill to executions of this namespace
+		SkipBackfill     bool    // only verify parity of the secondary store
+		SkipVerification bool    // only backfill the secondary store
+		OverallRps       float64 // executions backfilled or verified per second
+		PageSize         int     // executions listed per page of a shard
+		// number of pages to be processed before continue as new, max is 1000.
+		PageCountPerExecution int
+		// how long to wait after the backfill for the generated visibility tasks to be processed
+		VerificationDelaySeconds int
+		// number of mismatches kept in the report, mismatches past this number are only counted
+		MaxReportedMismatches int
+
+		// used by continue as new to resume where the previous run stopped
+		ShardCount          int32
+		NamespaceID         string
+		Phase               string
+		ShardID             int32
+		NextPageToken       []byte
+		Report              VisibilityBackfillReport
+		ContinuedAsNewCount int
+	}
+
+	// VisibilityBackfillReport is the result of the visibility backfill workflow
+	VisibilityBackfillReport struct {
+		BackfilledCount int64
+		VerifiedCount   int64
+		// executions skipped in any phase because they have no visibility record,
+		// or because they or their namespace were deleted while being processed
+		SkippedCount  int64
+		MismatchCount int64
+		Mismatches    []VisibilityMismatch
+	}
+
+	// VisibilityMismatch is an execution whose record in the secondary visibility store
+	// does not match its mutable state
+	VisibilityMismatch struct {
+		NamespaceID string
+		WorkflowID  string
+		RunID       string
+		Reason      string
+	}
+
+	VisibilityBackfillStatus struct {
+		Phase               string
+		ShardID             int32
+		ShardCount          int32
+		Report              VisibilityBackfillReport
+		ContinuedAsNewCount int
+	}
+
+	visibilityBackfillMetadataRequest struct {
+		Namespace string
+		Verify    bool
+	}
+
+	visibilityBackfillPageRequest struct {
+		ShardID       int32
+		NamespaceID   string
+		PageSize      int
+		NextPageToken []byte
+		RPS           float64
+		MaxMismatches int
+	}
+
+	visibilityBackfillPageResponse struct {
+		NextPageToken  []byte
+		ProcessedCount int64
+		SkippedCount   int64
+		MismatchCount  int64
+		Mismatches     []VisibilityMismatch
+	}
+)
+
+var (
+	visibilityBackfillActivityRetryPolicy = &temporal.RetryPolicy{
+		InitialInterval: time.Second,
+		MaximumInterval: time.Minute,
+	}
+)
+
+// VisibilityBackfillWorkflow scans the executions of every history shard and rebuilds their visibility
+// records in the secondary visibility store, then verifies the records match mutable state.
+// Progress is kept per shard and page, so the workflow resumes where it stopped after continue as new.
+func VisibilityBackfillWorkflow(ctx workflow.Context, params VisibilityBackfillParams) (VisibilityBackfillReport, error) {
+	workflow.SetQueryHandler(ctx, visibilityBackfillStatusQueryType, func() (VisibilityBackfillStatus, error) {
+		return VisibilityBackfillStatus{
+			Phase:               params.Phase,
+			ShardID:             params.ShardID,
+			ShardCount:          params.ShardCount,
+			Report:              params.Report,
+			ContinuedAsNewCount: params.ContinuedAsNewCount,
+		}, nil
+	})
+
+	if err := validateAndSetVisibilityBackfillParams(&params); err != nil {
+		return params.Report, err
+	}
+
+	if params.Phase == "" {
+		if err := startVisibilityBackfill(ctx, &params); err != nil {
+			return params.Report, err
+		}
+	}
+
+	ao := workflow.ActivityOptions{
+		StartToCloseTimeout: visibilityBackfillActivityStartToClose,
+		HeartbeatTimeout:    visibilityBackfillActivityHeartbeatDelay,
+		RetryPolicy:         visibilityBackfillActivityRetryPolicy,
+	}
+	actx := workflow.WithActivityOptions(ctx, ao)
+	var a *activities
+
+	for i := 0; i < params.PageCountPerExecution; i++ {
+		switch params.Phase {
+		case visibilityBackfillPhaseCompleted:
+			return params.Report, nil
+
+		case visibilityBackfillPhaseWaitVerification:
+			if err := workflow.Sleep(ctx, time.Duration(params.VerificationDelaySeconds)*time.Second); err != nil {
+				return params.Report, err
+			}
+			params.Phase = visibilityBackfillPhaseVerify
+			params.ShardID = 1
+			params.NextPageToken = nil
+
+		case visibilityBackfillPhaseBackfill, visibilityBackfillPhaseVerify:
+			request := &visibilityBackfillPageRequest{
+				ShardID:       params.ShardID,
+				NamespaceID:   params.NamespaceID,
+				PageSize:      params.PageSize,
+				NextPageToken: params.NextPageToken,
+				RPS:           params.OverallRps,
+				MaxMismatches: params.MaxReportedMismatches - len(params.Report.Mismatches),
+			}
+			activityFn := a.BackfillVisibility
+			if params.Phase == visibilityBackfillPhaseVerify {
+				activityFn = a.VerifyVisibility
+			}
+			var resp visibilityBackfillPageResponse
+			if err := workflow.ExecuteActivity(actx, activityFn, request).Get(ctx, &resp); err != nil {
+				return params.Report, err
+			}
+			params.Report.add(params.Phase, &resp)
+			params.NextPageToken = resp.NextPageToken
+			if params.NextPageToken == nil {
+				params.ShardID++
+				if params.ShardID > params.ShardCount {
+					params.Phase = nextVisibilityBackfillPhase(&params)
+					params.ShardID = 1
+				}
+			}
+
+		default:
+			return params.Report, temporal.NewNonRetryableApplicationError("unknown visibility backfill phase "+params.Phase, "", nil)
+		}
+	}
+
+	if params.Phase == visibilityBackfillPhaseCompleted {
+		return params.Report, nil
+	}
+
+	params.ContinuedAsNewCount++
+
+	// There are still more executions to process. Continue-as-new to process on a new run.
+	// This prevents history size from exceeding the server-defined limit
+	return params.Report, workflow.NewContinueAsNewError(ctx, VisibilityBackfillWorkflow, params)
+}
+
+func validateAndSetVisibilityBackfillParams(params *VisibilityBackfillParams) error {
+	if params.SkipBackfill && params.SkipVerification {
+		return temporal.NewNonRetryableApplicationError("InvalidArgument: at most one of SkipBackfill or SkipVerification can be set", "", nil)
+	}
+	if params.OverallRps <= 0 {
+		params.OverallRps = 1
+	}
+	if params.PageSize <= 0 {
+		params.PageSize = defaultVisibilityBackfillPageSize
+	}
+	if params.PageCountPerExecution <= 0 {
+		params.PageCountPerExecution = defaultPageCountPerExecution
+	}
+	if params.PageCountPerExecution > maxPageCountPerExecution {
+		params.PageCountPerExecution = maxPageCountPerExecution
+	}
+	if params.VerificationDelaySeconds <= 0 {
+		params.VerificationDelaySeconds = int(defaultVisibilityVerificationDelay / time.Second)
+	}
+	if params.MaxReportedMismatches <= 0 {
+		params.MaxReportedMismatches = defaultVisibilityMaxReportedMismatches
+	}
+	return nil
+}
+
+func startVisibilityBackfill(ctx workflow.Context, params *VisibilityBackfillParams) error {
+	var a *activities
+
+	lao := workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Second * 10,
+		RetryPolicy:         visibilityBackfillActivityRetryPolicy,
+	}
+	actx := workflow.WithLocalActivityOptions(ctx, lao)
+
+	var metadataResp metadataResponse
+	request := visibilityBackfillMetadataRequest{
+		Namespace: params.Namespace,
+		Verify:    !params.SkipVerification,
+	}
+	if err := workflow.ExecuteLocalActivity(actx, a.GetVisibilityBackfillMetadata, request).Get(ctx, &metadataResp); err != nil {
+		return err
+	}
+
+	params.ShardCount = metadataResp.ShardCount
+	params.NamespaceID = metadataResp.NamespaceID
+	params.ShardID = 1
+	if params.SkipBackfill {
+		params.Phase = visibilityBackfillPhaseVerify
+	} else {
+		params.Phase = visibilityBackfillPhaseBackfill
+	}
+	return nil
+}
+
+func nextVisibilityBackfillPhase(params *VisibilityBackfillParams) string {
+	if params.Phase == visibilityBackfillPhaseBackfill && !params.SkipVerification {
+		return visibilityBackfillPhaseWaitVerification
+	}
+	return visibilityBackfillPhaseCompleted
+}
+
+func (r *VisibilityBackfillReport) add(phase string, resp *visibilityBackfillPageResponse) {
+	if phase == visibilityBackfillPhaseBackfill {
+		r.BackfilledCount += resp.ProcessedCount
+	} else {
+		r.VerifiedCount += resp.ProcessedCount
+	}
+	r.SkippedCount += resp.SkippedCount
+	r.MismatchCount += resp.MismatchCount
+	r.Mismatches = append(r.Mismatches, resp.Mismatches...)
+}
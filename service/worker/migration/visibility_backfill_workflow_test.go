@@ -0,0 +1,179 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package migration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/sdk/testsuite"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/persistence/visibility"
+)
+
+func TestVisibilityBackfillWorkflow(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var a *activities
+	env.OnActivity(a.GetVisibilityBackfillMetadata, mock.Anything, visibilityBackfillMetadataRequest{Namespace: "test-ns", Verify: true}).
+		Return(&metadataResponse{ShardCount: 2, NamespaceID: "test-ns-id"}, nil)
+
+	// two pages on shard 1, one page on shard 2
+	pageFn := func(mismatches []VisibilityMismatch) func(context.Context, *visibilityBackfillPageRequest) (*visibilityBackfillPageResponse, error) {
+		return func(ctx context.Context, request *visibilityBackfillPageRequest) (*visibilityBackfillPageResponse, error) {
+			assert.Equal(t, "test-ns-id", request.NamespaceID)
+			if request.ShardID == 1 && request.NextPageToken == nil {
+				return &visibilityBackfillPageResponse{NextPageToken: []byte("page-2"), ProcessedCount: 10}, nil
+			}
+			return &visibilityBackfillPageResponse{
+				ProcessedCount: 5,
+				MismatchCount:  int64(len(mismatches)),
+				Mismatches:     mismatches,
+			}, nil
+		}
+	}
+	mismatch := VisibilityMismatch{NamespaceID: "test-ns-id", WorkflowID: "wf", RunID: "run", Reason: visibilityMismatchReasonMissing}
+	env.OnActivity(a.BackfillVisibility, mock.Anything, mock.Anything).Return(pageFn(nil)).Times(3)
+	env.OnActivity(a.VerifyVisibility, mock.Anything, mock.Anything).Return(pageFn([]VisibilityMismatch{mismatch})).Times(3)
+
+	env.ExecuteWorkflow(VisibilityBackfillWorkflow, VisibilityBackfillParams{
+		Namespace: "test-ns",
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+
+	var report VisibilityBackfillReport
+	require.NoError(t, env.GetWorkflowResult(&report))
+	assert.Equal(t, int64(20), report.BackfilledCount)
+	assert.Equal(t, int64(20), report.VerifiedCount)
+	assert.Equal(t, int64(2), report.MismatchCount)
+	assert.Equal(t, []VisibilityMismatch{mismatch, mismatch}, report.Mismatches)
+
+	envValue, err := env.QueryWorkflow(visibilityBackfillStatusQueryType)
+	require.NoError(t, err)
+	var status VisibilityBackfillStatus
+	require.NoError(t, envValue.Get(&status))
+	assert.Equal(t, visibilityBackfillPhaseCompleted, status.Phase)
+	assert.Equal(t, int32(2), status.ShardCount)
+}
+
+func TestVisibilityBackfillWorkflow_VerifyOnly_ContinueAsNew(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var a *activities
+	env.OnActivity(a.GetVisibilityBackfillMetadata, mock.Anything, visibilityBackfillMetadataRequest{Verify: true}).
+		Return(&metadataResponse{ShardCount: 4}, nil)
+	env.OnActivity(a.VerifyVisibility, mock.Anything, mock.Anything).Return(&visibilityBackfillPageResponse{ProcessedCount: 1}, nil).Times(2)
+
+	env.ExecuteWorkflow(VisibilityBackfillWorkflow, VisibilityBackfillParams{
+		SkipBackfill:          true,
+		PageCountPerExecution: 2,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	err := env.GetWorkflowError()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "continue as new")
+	env.AssertExpectations(t)
+
+	envValue, err := env.QueryWorkflow(visibilityBackfillStatusQueryType)
+	require.NoError(t, err)
+	var status VisibilityBackfillStatus
+	require.NoError(t, envValue.Get(&status))
+	assert.Equal(t, visibilityBackfillPhaseVerify, status.Phase)
+	assert.Equal(t, int32(3), status.ShardID)
+	assert.Equal(t, int64(2), status.Report.VerifiedCount)
+	assert.Equal(t, 1, status.ContinuedAsNewCount)
+}
+
+func TestVisibilityBackfillWorkflow_InvalidParams(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	env.ExecuteWorkflow(VisibilityBackfillWorkflow, VisibilityBackfillParams{
+		SkipBackfill:     true,
+		SkipVerification: true,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.Error(t, env.GetWorkflowError())
+}
+
+func TestVisibilityMismatchReason(t *testing.T) {
+	startTime := time.Date(2023, 1, 1, 0, 0, 0, 123456789, time.UTC)
+	closeTime := startTime.Add(time.Hour)
+	state := &persistencespb.WorkflowMutableState{
+		ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+			WorkflowTypeName: "test-type",
+			StartTime:        &startTime,
+			CloseTime:        &closeTime,
+		},
+		ExecutionState: &persistencespb.WorkflowExecutionState{
+			State:  enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED,
+			Status: enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		},
+	}
+	newRecord := func() *workflowpb.WorkflowExecutionInfo {
+		recordStartTime := startTime.Truncate(time.Millisecond)
+		recordCloseTime := closeTime.Truncate(time.Millisecond)
+		return &workflowpb.WorkflowExecutionInfo{
+			Type:      &commonpb.WorkflowType{Name: "test-type"},
+			StartTime: &recordStartTime,
+			CloseTime: &recordCloseTime,
+			Status:    enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		}
+	}
+
+	assert.Equal(t, "", visibilityMismatchReason(state, newRecord()))
+
+	record := newRecord()
+	record.Status = enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING
+	assert.Equal(t, visibility.RecordFieldStatus, visibilityMismatchReason(state, record))
+
+	record = newRecord()
+	record.Type.Name = "other-type"
+	assert.Equal(t, visibility.RecordFieldWorkflowType, visibilityMismatchReason(state, record))
+
+	record = newRecord()
+	record.StartTime = &closeTime
+	assert.Equal(t, visibility.RecordFieldStartTime, visibilityMismatchReason(state, record))
+
+	record = newRecord()
+	record.CloseTime = nil
+	assert.Equal(t, visibility.RecordFieldCloseTime, visibilityMismatchReason(state, record))
+}
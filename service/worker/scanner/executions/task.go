@@ -211,7 +211,7 @@ func (t *task) getPaginationFn() collection.PaginationFn[*persistencespb.Workflo
 			PageSize:  executionsPageSize,
 			PageToken: paginationToken,
 		}
-		resp, err := t.executionManager.ListConcreteExecutions(t.ctx, req)
+		resp, err := t.executionManager.ListConcreteExecutions(persistence.WithStaleReads(t.ctx), req)
 		if err != nil {
 			return nil, nil, err
 		}
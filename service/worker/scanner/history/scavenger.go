@@ -321,7 +321,8 @@ func (s *Scavenger) getPaginationFn(
 			PageSize:      pageSize,
 			NextPageToken: paginationToken,
 		}
-		resp, err := s.db.GetAllHistoryTreeBranches(ctx, req)
+		// branches missing from a stale page are too young to be scavenged anyway
+		resp, err := s.db.GetAllHistoryTreeBranches(persistence.WithStaleReads(ctx), req)
 		if err != nil {
 			return nil, nil, err
 		}
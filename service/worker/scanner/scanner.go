@@ -44,6 +44,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/sdk"
 
 	"go.temporal.io/server/common/backoff"
@@ -82,6 +83,20 @@ type (
 		ExecutionDataDurationBuffer dynamicconfig.DurationPropertyFn
 		// ExecutionScannerWorkerCount is the execution scavenger task worker number
 		ExecutionScannerWorkerCount dynamicconfig.IntPropertyFn
+		// VisibilityScannerEnabled indicates if visibility scanner should be started as part of scanner
+		VisibilityScannerEnabled dynamicconfig.BoolPropertyFn
+		// VisibilityScannerPerHostQPS the max rate of calls to check visibility records per host
+		VisibilityScannerPerHostQPS dynamicconfig.IntPropertyFn
+		// VisibilityScannerPerShardQPS the max rate of calls to check visibility records per shard
+		VisibilityScannerPerShardQPS dynamicconfig.IntPropertyFn
+		// VisibilityScannerWorkerCount is the visibility scavenger task worker number
+		VisibilityScannerWorkerCount dynamicconfig.IntPropertyFn
+		// VisibilityScannerSampleRate is the fraction of executions compared with their visibility records
+		VisibilityScannerSampleRate dynamicconfig.FloatPropertyFn
+		// VisibilityScannerDataMinAge is the minimum time since an execution was last updated before it is checked
+		VisibilityScannerDataMinAge dynamicconfig.DurationPropertyFn
+		// VisibilityScannerRepairEnabled indicates if the visibility scavenger repairs the mismatches it finds
+		VisibilityScannerRepairEnabled dynamicconfig.BoolPropertyFn
 	}
 
 	// scannerContext is the context object that get's
@@ -93,6 +108,8 @@ type (
 		metricsHandler    metrics.Handler
 		executionManager  persistence.ExecutionManager
 		taskManager       persistence.TaskManager
+		metadataManager   persistence.MetadataManager
+		visibilityManager manager.VisibilityManager
 		historyClient     historyservice.HistoryServiceClient
 		adminClient       adminservice.AdminServiceClient
 		namespaceRegistry namespace.Registry
@@ -120,6 +137,8 @@ func New(
 	metricsHandler metrics.Handler,
 	executionManager persistence.ExecutionManager,
 	taskManager persistence.TaskManager,
+	metadataManager persistence.MetadataManager,
+	visibilityManager manager.VisibilityManager,
 	historyClient historyservice.HistoryServiceClient,
 	adminClient adminservice.AdminServiceClient,
 	registry namespace.Registry,
@@ -132,6 +151,8 @@ func New(
 			metricsHandler:    metricsHandler,
 			executionManager:  executionManager,
 			taskManager:       taskManager,
+			metadataManager:   metadataManager,
+			visibilityManager: visibilityManager,
 			historyClient:     historyClient,
 			adminClient:       adminClient,
 			namespaceRegistry: registry,
@@ -161,7 +182,15 @@ func (s *Scanner) Start() error {
 		workerTaskQueueNames = append(workerTaskQueueNames, executionsScannerTaskQueueName)
 	}
 
-	if s.context.cfg.Persistence.DefaultStoreType() == config.StoreTypeSQL && s.context.cfg.TaskQueueScannerEnabled() {
+	if s.context.cfg.VisibilityScannerEnabled() {
+		s.wg.Add(1)
+		go s.startWorkflowWithRetry(ctx, visibilityScannerWFStartOptions, visibilityScannerWFTypeName)
+		workerTaskQueueNames = append(workerTaskQueueNames, visibilityScannerTaskQueueName)
+	}
+
+	// Stores without native TTL support need the task queue scavenger to clean up expired tasks.
+	storeType := s.context.cfg.Persistence.DefaultStoreType()
+	if (storeType == config.StoreTypeSQL || storeType == config.StoreTypeKV) && s.context.cfg.TaskQueueScannerEnabled() {
 		s.wg.Add(1)
 		go s.startWorkflowWithRetry(ctx, tlScannerWFStartOptions, tqScannerWFTypeName)
 		workerTaskQueueNames = append(workerTaskQueueNames, tqScannerTaskQueueName)
@@ -179,9 +208,11 @@ func (s *Scanner) Start() error {
 		work.RegisterWorkflowWithOptions(TaskQueueScannerWorkflow, workflow.RegisterOptions{Name: tqScannerWFTypeName})
 		work.RegisterWorkflowWithOptions(HistoryScannerWorkflow, workflow.RegisterOptions{Name: historyScannerWFTypeName})
 		work.RegisterWorkflowWithOptions(ExecutionsScannerWorkflow, workflow.RegisterOptions{Name: executionsScannerWFTypeName})
+		work.RegisterWorkflowWithOptions(VisibilityScannerWorkflow, workflow.RegisterOptions{Name: visibilityScannerWFTypeName})
 		work.RegisterActivityWithOptions(TaskQueueScavengerActivity, activity.RegisterOptions{Name: taskQueueScavengerActivityName})
 		work.RegisterActivityWithOptions(HistoryScavengerActivity, activity.RegisterOptions{Name: historyScavengerActivityName})
 		work.RegisterActivityWithOptions(ExecutionsScavengerActivity, activity.RegisterOptions{Name: executionsScavengerActivityName})
+		work.RegisterActivityWithOptions(VisibilityScavengerActivity, activity.RegisterOptions{Name: visibilityScavengerActivityName})
 
 		if err := work.Start(); err != nil {
 			return err
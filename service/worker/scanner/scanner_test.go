@@ -41,6 +41,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/sdk"
 	"go.temporal.io/server/common/testing/mocksdk"
 )
@@ -71,12 +72,17 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 		WFTypeName:    historyScannerWFTypeName,
 		TaskQueueName: historyScannerTaskQueueName,
 	}
+	visibilityScanner := expectedScanner{
+		WFTypeName:    visibilityScannerWFTypeName,
+		TaskQueueName: visibilityScannerTaskQueueName,
+	}
 
 	type testCase struct {
 		Name                     string
 		ExecutionsScannerEnabled bool
 		TaskQueueScannerEnabled  bool
 		HistoryScannerEnabled    bool
+		VisibilityScannerEnabled bool
 		DefaultStore             string
 		ExpectedScanners         []expectedScanner
 	}
@@ -146,13 +152,20 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 			DefaultStore:             config.StoreTypeSQL,
 			ExpectedScanners:         []expectedScanner{executionScanner},
 		},
+		{
+			Name:                     "VisibilityScannerNoSQL",
+			VisibilityScannerEnabled: true,
+			DefaultStore:             config.StoreTypeNoSQL,
+			ExpectedScanners:         []expectedScanner{visibilityScanner},
+		},
 		{
 			Name:                     "AllScannersSQL",
 			ExecutionsScannerEnabled: true,
 			TaskQueueScannerEnabled:  true,
 			HistoryScannerEnabled:    true,
+			VisibilityScannerEnabled: true,
 			DefaultStore:             config.StoreTypeSQL,
-			ExpectedScanners:         []expectedScanner{historyScanner, taskQueueScanner, executionScanner},
+			ExpectedScanners:         []expectedScanner{historyScanner, taskQueueScanner, executionScanner, visibilityScanner},
 		},
 	} {
 		s.Run(c.Name, func() {
@@ -171,6 +184,7 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 					HistoryScannerEnabled:                  dynamicconfig.GetBoolPropertyFn(c.HistoryScannerEnabled),
 					ExecutionsScannerEnabled:               dynamicconfig.GetBoolPropertyFn(c.ExecutionsScannerEnabled),
 					TaskQueueScannerEnabled:                dynamicconfig.GetBoolPropertyFn(c.TaskQueueScannerEnabled),
+					VisibilityScannerEnabled:               dynamicconfig.GetBoolPropertyFn(c.VisibilityScannerEnabled),
 					Persistence: &config.Persistence{
 						DefaultStore: c.DefaultStore,
 						DataStores: map[string]config.DataStore{
@@ -185,6 +199,8 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 				metrics.NoopMetricsHandler,
 				p.NewMockExecutionManager(ctrl),
 				p.NewMockTaskManager(ctrl),
+				p.NewMockMetadataManager(ctrl),
+				manager.NewMockVisibilityManager(ctrl),
 				historyservicemock.NewMockHistoryServiceClient(ctrl),
 				mockAdminClient,
 				mockNamespaceRegistry,
@@ -243,6 +259,7 @@ func (s *scannerTestSuite) TestScannerShutdown() {
 			HistoryScannerEnabled:                  dynamicconfig.GetBoolPropertyFn(true),
 			ExecutionsScannerEnabled:               dynamicconfig.GetBoolPropertyFn(false),
 			TaskQueueScannerEnabled:                dynamicconfig.GetBoolPropertyFn(false),
+			VisibilityScannerEnabled:               dynamicconfig.GetBoolPropertyFn(false),
 			Persistence: &config.Persistence{
 				DefaultStore: config.StoreTypeNoSQL,
 				DataStores: map[string]config.DataStore{
@@ -254,6 +271,8 @@ func (s *scannerTestSuite) TestScannerShutdown() {
 		metrics.NoopMetricsHandler,
 		p.NewMockExecutionManager(ctrl),
 		p.NewMockTaskManager(ctrl),
+		p.NewMockMetadataManager(ctrl),
+		manager.NewMockVisibilityManager(ctrl),
 		historyservicemock.NewMockHistoryServiceClient(ctrl),
 		mockAdminClient,
 		mockNamespaceRegistry,
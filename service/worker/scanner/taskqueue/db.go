@@ -91,7 +91,8 @@ func (s *Scavenger) listTaskQueue(
 	var err error
 	var resp *p.ListTaskQueueResponse
 	err = s.retryForever(func() error {
-		resp, err = s.db.ListTaskQueue(ctx, &p.ListTaskQueueRequest{
+		// deleting a task queue is conditioned on its range ID, so a stale listing is harmless
+		resp, err = s.db.ListTaskQueue(p.WithStaleReads(ctx), &p.ListTaskQueueRequest{
 			PageSize:  pageSize,
 			PageToken: pageToken,
 		})
@@ -0,0 +1,239 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibility
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/collection"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/quotas"
+	"go.temporal.io/server/service/worker/scanner/executor"
+)
+
+const (
+	executionsPageSize = 100
+
+	taskStartupDelayRatio              = 100 * time.Millisecond
+	taskStartupDelayRandomizationRatio = 1.0
+)
+
+type (
+	// executionTask is a runnable task that adheres to the executor.Task interface.
+	// Each executionTask walks the executions of one shard and compares them with
+	// their visibility records.
+	executionTask struct {
+		shardID   int32
+		scavenger *Scavenger
+
+		ctx             context.Context
+		rateLimiter     quotas.RateLimiter
+		metricsHandler  metrics.Handler
+		logger          log.Logger
+		paginationToken []byte
+	}
+)
+
+// newExecutionTask returns a new instance of an executable task which will validate the executions of a single shard
+func newExecutionTask(
+	ctx context.Context,
+	shardID int32,
+	scavenger *Scavenger,
+	rateLimiter quotas.RateLimiter,
+) executor.Task {
+	return &executionTask{
+		shardID:   shardID,
+		scavenger: scavenger,
+
+		ctx:            ctx,
+		rateLimiter:    rateLimiter,
+		metricsHandler: scavenger.metricsHandler,
+		logger:         log.With(scavenger.logger, tag.ShardID(shardID)),
+	}
+}
+
+// Run runs the task
+func (t *executionTask) Run() executor.TaskStatus {
+	time.Sleep(backoff.Jitter(
+		taskStartupDelayRatio*time.Duration(t.scavenger.numHistoryShards),
+		taskStartupDelayRandomizationRatio,
+	))
+
+	iter := collection.NewPagingIteratorWithToken(t.getPaginationFn(), t.paginationToken)
+	var retryTask bool
+	for iter.HasNext() {
+		record, err := iter.Next()
+		if err != nil {
+			t.metricsHandler.Counter(metrics.ScavengerValidationSkipsCount.GetMetricName()).Record(1)
+			// continue validation process and retry after all workflow records has been iterated.
+			t.logger.Error("unable to paginate concrete execution", tag.Error(err))
+			retryTask = true
+			break
+		}
+		if !t.shouldValidate(record) {
+			continue
+		}
+
+		_ = t.rateLimiter.Wait(t.ctx)
+		if err := t.validate(record); err != nil {
+			// continue validation process and retry after all workflow records has been iterated.
+			t.metricsHandler.Counter(metrics.ScavengerValidationSkipsCount.GetMetricName()).Record(1)
+			t.logger.Error("unable to validate visibility record",
+				tag.WorkflowNamespaceID(record.GetExecutionInfo().GetNamespaceId()),
+				tag.WorkflowID(record.GetExecutionInfo().GetWorkflowId()),
+				tag.WorkflowRunID(record.GetExecutionState().GetRunId()),
+				tag.Error(err),
+			)
+			retryTask = true
+		}
+	}
+	if retryTask {
+		return executor.TaskStatusDefer
+	}
+	return executor.TaskStatusDone
+}
+
+// shouldValidate filters out executions without a visibility record, executions whose
+// visibility tasks may still be in flight and executions not picked by sampling
+func (t *executionTask) shouldValidate(mutableState *persistencespb.WorkflowMutableState) bool {
+	if !isVisibleExecution(mutableState) {
+		return false
+	}
+	lastUpdateTime := mutableState.GetExecutionInfo().GetLastUpdateTime()
+	if lastUpdateTime != nil && time.Since(*lastUpdateTime) < t.scavenger.dataMinAge() {
+		return false
+	}
+	sampleRate := t.scavenger.sampleRate()
+	return sampleRate >= 1 || rand.Float64() < sampleRate
+}
+
+func (t *executionTask) validate(mutableState *persistencespb.WorkflowMutableState) error {
+	executionInfo := mutableState.GetExecutionInfo()
+	nsEntry, err := t.scavenger.registry.GetNamespaceByID(namespace.ID(executionInfo.GetNamespaceId()))
+	switch err.(type) {
+	case nil:
+	case *serviceerror.NotFound, *serviceerror.NamespaceNotFound:
+		// execution of a deleted namespace, left to the executions scavenger
+		return nil
+	default:
+		return err
+	}
+
+	request := &manager.GetWorkflowExecutionRequest{
+		NamespaceID: nsEntry.ID(),
+		Namespace:   nsEntry.Name(),
+		RunID:       mutableState.GetExecutionState().GetRunId(),
+		WorkflowID:  executionInfo.GetWorkflowId(),
+		StartTime:   executionInfo.GetStartTime(),
+		CloseTime:   executionInfo.GetCloseTime(),
+	}
+	var record *workflowpb.WorkflowExecutionInfo
+	resp, err := t.scavenger.visibilityManager.GetWorkflowExecution(t.ctx, request)
+	switch err.(type) {
+	case nil:
+		record = resp.Execution
+	case *serviceerror.NotFound:
+	default:
+		return err
+	}
+
+	results := validateRecord(mutableState, record)
+	t.recordValidationResults(nsEntry.Name(), executionInfo.GetWorkflowId(), request.RunID, results)
+	if len(results) == 0 || !t.scavenger.repairEnabled() {
+		return nil
+	}
+
+	t.metricsHandler.Counter(metrics.ScavengerRepairRequestsCount.GetMetricName()).Record(1)
+	_, err = t.scavenger.historyClient.GenerateVisibilityTasks(t.ctx, &historyservice.GenerateVisibilityTasksRequest{
+		NamespaceId: nsEntry.ID().String(),
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: executionInfo.GetWorkflowId(),
+			RunId:      request.RunID,
+		},
+	})
+	switch err.(type) {
+	case nil, *serviceerror.NotFound, *serviceerror.NamespaceNotFound:
+		// the execution may have been deleted since it was read
+		return nil
+	default:
+		t.metricsHandler.Counter(metrics.ScavengerRepairFailuresCount.GetMetricName()).Record(1)
+		return err
+	}
+}
+
+func (t *executionTask) recordValidationResults(
+	namespaceName namespace.Name,
+	workflowID string,
+	runID string,
+	results []validationResult,
+) {
+	t.metricsHandler.Counter(metrics.ScavengerValidationRequestsCount.GetMetricName()).Record(1)
+	if len(results) == 0 {
+		return
+	}
+
+	t.metricsHandler.Counter(metrics.ScavengerValidationFailuresCount.GetMetricName()).Record(1)
+	for _, result := range results {
+		t.metricsHandler.Counter(metrics.ScavengerValidationFailuresCount.GetMetricName()).Record(1, metrics.FailureTag(result.failureType))
+		t.logger.Info(
+			"visibility record differs from execution.",
+			tag.WorkflowNamespace(namespaceName.String()),
+			tag.WorkflowID(workflowID),
+			tag.WorkflowRunID(runID),
+			tag.Value(result.failureDetails),
+		)
+	}
+}
+
+func (t *executionTask) getPaginationFn() collection.PaginationFn[*persistencespb.WorkflowMutableState] {
+	return func(paginationToken []byte) ([]*persistencespb.WorkflowMutableState, []byte, error) {
+		req := &persistence.ListConcreteExecutionsRequest{
+			ShardID:   t.shardID,
+			PageSize:  executionsPageSize,
+			PageToken: paginationToken,
+		}
+		// repairs regenerate visibility tasks from the current mutable state, so a stale listing is harmless
+		resp, err := t.scavenger.executionManager.ListConcreteExecutions(persistence.WithStaleReads(t.ctx), req)
+		if err != nil {
+			return nil, nil, err
+		}
+		t.paginationToken = resp.PageToken
+		return resp.States, resp.PageToken, nil
+	}
+}
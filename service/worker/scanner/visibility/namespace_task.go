@@ -0,0 +1,181 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibility
+
+import (
+	"context"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/collection"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/quotas"
+	"go.temporal.io/server/service/worker/scanner/executor"
+)
+
+const (
+	recordsPageSize = 100
+)
+
+type (
+	// namespaceTask is a runnable task that adheres to the executor.Task interface.
+	// Each namespaceTask walks the open visibility records of one namespace and looks for
+	// records whose execution no longer exists.
+	namespaceTask struct {
+		namespaceID   namespace.ID
+		namespaceName namespace.Name
+		scavenger     *Scavenger
+
+		ctx             context.Context
+		rateLimiter     quotas.RateLimiter
+		metricsHandler  metrics.Handler
+		logger          log.Logger
+		paginationToken []byte
+		latestStartTime time.Time
+	}
+)
+
+// newNamespaceTask returns a new instance of an executable task which will validate the open visibility records of a namespace
+func newNamespaceTask(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	namespaceName namespace.Name,
+	scavenger *Scavenger,
+	rateLimiter quotas.RateLimiter,
+) executor.Task {
+	return &namespaceTask{
+		namespaceID:   namespaceID,
+		namespaceName: namespaceName,
+		scavenger:     scavenger,
+
+		ctx:            ctx,
+		rateLimiter:    rateLimiter,
+		metricsHandler: scavenger.metricsHandler,
+		logger:         log.With(scavenger.logger, tag.WorkflowNamespace(namespaceName.String())),
+		// records of executions started recently may be written before the execution is visible to this scan
+		latestStartTime: time.Now().UTC().Add(-scavenger.dataMinAge()),
+	}
+}
+
+// Run runs the task
+func (t *namespaceTask) Run() executor.TaskStatus {
+	iter := collection.NewPagingIteratorWithToken(t.getPaginationFn(), t.paginationToken)
+	var retryTask bool
+	for iter.HasNext() {
+		record, err := iter.Next()
+		if err != nil {
+			t.metricsHandler.Counter(metrics.ScavengerValidationSkipsCount.GetMetricName()).Record(1)
+			// continue validation process and retry after all visibility records has been iterated.
+			t.logger.Error("unable to paginate open visibility records", tag.Error(err))
+			retryTask = true
+			break
+		}
+
+		_ = t.rateLimiter.Wait(t.ctx)
+		if err := t.validate(record); err != nil {
+			// continue validation process and retry after all visibility records has been iterated.
+			t.metricsHandler.Counter(metrics.ScavengerValidationSkipsCount.GetMetricName()).Record(1)
+			t.logger.Error("unable to validate visibility record",
+				tag.WorkflowID(record.GetExecution().GetWorkflowId()),
+				tag.WorkflowRunID(record.GetExecution().GetRunId()),
+				tag.Error(err),
+			)
+			retryTask = true
+		}
+	}
+	if retryTask {
+		return executor.TaskStatusDefer
+	}
+	return executor.TaskStatusDone
+}
+
+func (t *namespaceTask) validate(record *workflowpb.WorkflowExecutionInfo) error {
+	workflowID := record.GetExecution().GetWorkflowId()
+	_, err := t.scavenger.executionManager.GetWorkflowExecution(t.ctx, &persistence.GetWorkflowExecutionRequest{
+		ShardID:     common.WorkflowIDToHistoryShard(t.namespaceID.String(), workflowID, t.scavenger.numHistoryShards),
+		NamespaceID: t.namespaceID.String(),
+		WorkflowID:  workflowID,
+		RunID:       record.GetExecution().GetRunId(),
+	})
+	switch err.(type) {
+	case *serviceerror.NotFound:
+		// the execution is gone but visibility still lists it
+	default:
+		// executions that exist are validated by the shard scan
+		return err
+	}
+
+	t.metricsHandler.Counter(metrics.ScavengerValidationRequestsCount.GetMetricName()).Record(1)
+	t.metricsHandler.Counter(metrics.ScavengerValidationFailuresCount.GetMetricName()).Record(1)
+	t.metricsHandler.Counter(metrics.ScavengerValidationFailuresCount.GetMetricName()).Record(1, metrics.FailureTag(recordOrphanedFailureType))
+	t.logger.Info(
+		"visibility record differs from execution.",
+		tag.WorkflowID(workflowID),
+		tag.WorkflowRunID(record.GetExecution().GetRunId()),
+		tag.Value("execution not found"),
+	)
+	if !t.scavenger.repairEnabled() {
+		return nil
+	}
+
+	t.metricsHandler.Counter(metrics.ScavengerRepairRequestsCount.GetMetricName()).Record(1)
+	_, err = t.scavenger.historyClient.DeleteWorkflowVisibilityRecord(t.ctx, &historyservice.DeleteWorkflowVisibilityRecordRequest{
+		NamespaceId:       t.namespaceID.String(),
+		Execution:         record.GetExecution(),
+		WorkflowStartTime: record.GetStartTime(),
+	})
+	if err != nil {
+		t.metricsHandler.Counter(metrics.ScavengerRepairFailuresCount.GetMetricName()).Record(1)
+		return err
+	}
+	return nil
+}
+
+func (t *namespaceTask) getPaginationFn() collection.PaginationFn[*workflowpb.WorkflowExecutionInfo] {
+	return func(paginationToken []byte) ([]*workflowpb.WorkflowExecutionInfo, []byte, error) {
+		resp, err := t.scavenger.visibilityManager.ListOpenWorkflowExecutions(t.ctx, &manager.ListWorkflowExecutionsRequest{
+			NamespaceID:       t.namespaceID,
+			Namespace:         t.namespaceName,
+			EarliestStartTime: time.Unix(0, 0).UTC(),
+			LatestStartTime:   t.latestStartTime,
+			PageSize:          recordsPageSize,
+			NextPageToken:     paginationToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		t.paginationToken = resp.NextPageToken
+		return resp.Executions, resp.NextPageToken, nil
+	}
+}
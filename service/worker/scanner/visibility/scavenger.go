@@ -0,0 +1,293 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibility
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/collection"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/quotas"
+	"go.temporal.io/server/service/worker/scanner/executor"
+)
+
+const (
+	executorPollInterval     = time.Minute
+	executorMaxDeferredTasks = 50000
+
+	namespacesPageSize = 100
+)
+
+type (
+	// Scavenger is the type that holds the state for visibility scavenger daemon
+	Scavenger struct {
+		status           int32
+		numHistoryShards int32
+		activityContext  context.Context
+
+		executionManager  persistence.ExecutionManager
+		metadataManager   persistence.MetadataManager
+		visibilityManager manager.VisibilityManager
+		registry          namespace.Registry
+		historyClient     historyservice.HistoryServiceClient
+		executor          executor.Executor
+		rateLimiter       quotas.RateLimiter
+		perShardQPS       dynamicconfig.IntPropertyFn
+		sampleRate        dynamicconfig.FloatPropertyFn
+		dataMinAge        dynamicconfig.DurationPropertyFn
+		repairEnabled     dynamicconfig.BoolPropertyFn
+		metricsHandler    metrics.Handler
+		logger            log.Logger
+
+		stopC  chan struct{}
+		stopWG sync.WaitGroup
+	}
+)
+
+// NewScavenger returns an instance of visibility scavenger daemon
+// The Scavenger can be started by calling the Start() method on the
+// returned object. Calling the Start() method will result in one
+// complete iteration over all of the workflow executions in the system, followed
+// by one iteration over the open visibility records of every namespace. Each
+// execution is compared with its visibility record, and each open record with its
+// execution; mismatches are reported through metrics and logs. When repair is
+// enabled, visibility tasks are re-enqueued for mismatched executions and records
+// of executions that no longer exist are deleted.
+//
+// The scavenger will retry on all persistence errors infinitely and will only stop under
+// two conditions
+//   - either all executions and records are processed successfully (or)
+//   - Stop() method is called to stop the scavenger
+func NewScavenger(
+	activityContext context.Context,
+	numHistoryShards int32,
+	perHostQPS dynamicconfig.IntPropertyFn,
+	perShardQPS dynamicconfig.IntPropertyFn,
+	workerCount dynamicconfig.IntPropertyFn,
+	sampleRate dynamicconfig.FloatPropertyFn,
+	dataMinAge dynamicconfig.DurationPropertyFn,
+	repairEnabled dynamicconfig.BoolPropertyFn,
+	executionManager persistence.ExecutionManager,
+	metadataManager persistence.MetadataManager,
+	visibilityManager manager.VisibilityManager,
+	registry namespace.Registry,
+	historyClient historyservice.HistoryServiceClient,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) *Scavenger {
+	return &Scavenger{
+		activityContext:   activityContext,
+		numHistoryShards:  numHistoryShards,
+		executionManager:  executionManager,
+		metadataManager:   metadataManager,
+		visibilityManager: visibilityManager,
+		registry:          registry,
+		historyClient:     historyClient,
+		executor: executor.NewFixedSizePoolExecutor(
+			workerCount(),
+			executorMaxDeferredTasks,
+			metricsHandler,
+			metrics.VisibilityScavengerScope,
+		),
+		rateLimiter: quotas.NewDefaultOutgoingRateLimiter(
+			func() float64 { return float64(perHostQPS()) },
+		),
+		perShardQPS:    perShardQPS,
+		sampleRate:     sampleRate,
+		dataMinAge:     dataMinAge,
+		repairEnabled:  repairEnabled,
+		metricsHandler: metricsHandler.WithTags(metrics.OperationTag(metrics.VisibilityScavengerScope)),
+		logger:         logger,
+
+		stopC: make(chan struct{}),
+	}
+}
+
+// Start starts the scavenger
+func (s *Scavenger) Start() {
+	if !atomic.CompareAndSwapInt32(
+		&s.status,
+		common.DaemonStatusInitialized,
+		common.DaemonStatusStarted,
+	) {
+		return
+	}
+	s.logger.Info("Visibility scavenger starting")
+	s.stopWG.Add(1)
+	s.executor.Start()
+	go s.run()
+	s.metricsHandler.Counter(metrics.StartedCount.GetMetricName()).Record(1)
+	s.logger.Info("Visibility scavenger started")
+}
+
+// Stop stops the scavenger
+func (s *Scavenger) Stop() {
+	if !atomic.CompareAndSwapInt32(
+		&s.status,
+		common.DaemonStatusStarted,
+		common.DaemonStatusStopped,
+	) {
+		return
+	}
+	s.metricsHandler.Counter(metrics.StoppedCount.GetMetricName()).Record(1)
+	s.logger.Info("Visibility scavenger stopping")
+	close(s.stopC)
+	s.executor.Stop()
+	s.stopWG.Wait()
+	s.logger.Info("Visibility scavenger stopped")
+}
+
+// Alive returns true if the scavenger is still running
+func (s *Scavenger) Alive() bool {
+	return atomic.LoadInt32(&s.status) == common.DaemonStatusStarted
+}
+
+// run does a single run over all executions and open visibility records and validates them
+func (s *Scavenger) run() {
+	defer func() {
+		go s.Stop()
+		s.stopWG.Done()
+	}()
+
+	for shardID := int32(1); shardID <= s.numHistoryShards; shardID++ {
+		submitted := s.executor.Submit(newExecutionTask(
+			s.activityContext,
+			shardID,
+			s,
+			s.newTaskRateLimiter(),
+		))
+		if !submitted {
+			s.logger.Error("unable to submit task to executor", tag.ShardID(shardID))
+		}
+	}
+
+	namespaces, err := s.listNamespaces()
+	if err != nil {
+		s.metricsHandler.Counter(metrics.ScavengerValidationSkipsCount.GetMetricName()).Record(1)
+		s.logger.Error("unable to list namespaces, skipping open visibility records", tag.Error(err))
+	}
+	for _, ns := range namespaces {
+		submitted := s.executor.Submit(newNamespaceTask(
+			s.activityContext,
+			namespace.ID(ns.Info.Id),
+			namespace.Name(ns.Info.Name),
+			s,
+			s.newTaskRateLimiter(),
+		))
+		if !submitted {
+			s.logger.Error("unable to submit task to executor", tag.WorkflowNamespace(ns.Info.Name))
+		}
+	}
+
+	s.awaitExecutor()
+}
+
+func (s *Scavenger) newTaskRateLimiter() quotas.RateLimiter {
+	return quotas.NewMultiRateLimiter([]quotas.RateLimiter{
+		quotas.NewDefaultOutgoingRateLimiter(
+			func() float64 { return float64(s.perShardQPS()) },
+		),
+		s.rateLimiter,
+	})
+}
+
+// listNamespaces returns the namespaces whose visibility records are checked
+func (s *Scavenger) listNamespaces() ([]*persistencespb.NamespaceDetail, error) {
+	var namespaces []*persistencespb.NamespaceDetail
+	iter := collection.NewPagingIterator(func(paginationToken []byte) ([]*persistence.GetNamespaceResponse, []byte, error) {
+		var resp *persistence.ListNamespacesResponse
+		err := backoff.ThrottleRetryContext(
+			s.activityContext,
+			func(ctx context.Context) error {
+				var err error
+				resp, err = s.metadataManager.ListNamespaces(ctx, &persistence.ListNamespacesRequest{
+					PageSize:      namespacesPageSize,
+					NextPageToken: paginationToken,
+				})
+				return err
+			},
+			backoff.NewExponentialRetryPolicy(time.Second).WithExpirationInterval(time.Minute),
+			common.IsPersistenceTransientError,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Namespaces, resp.NextPageToken, nil
+	})
+	for iter.HasNext() {
+		ns, err := iter.Next()
+		if err != nil {
+			return namespaces, err
+		}
+		if ns.Namespace.GetInfo().GetState() == enumspb.NAMESPACE_STATE_DELETED {
+			continue
+		}
+		namespaces = append(namespaces, ns.Namespace)
+	}
+	return namespaces, nil
+}
+
+func (s *Scavenger) awaitExecutor() {
+	// gauge value persists, so we want to reset it to 0
+	defer s.metricsHandler.Gauge(metrics.ExecutionsOutstandingCount.GetMetricName()).Record(float64(0))
+
+	outstanding := s.executor.TaskCount()
+	for outstanding > 0 {
+		select {
+		case <-time.After(executorPollInterval):
+			outstanding = s.executor.TaskCount()
+			s.metricsHandler.Gauge(metrics.ExecutionsOutstandingCount.GetMetricName()).Record(float64(outstanding))
+		case <-s.stopC:
+			return
+		}
+	}
+}
+
+// isVisibleExecution reports whether an execution is expected to have a visibility record
+func isVisibleExecution(mutableState *persistencespb.WorkflowMutableState) bool {
+	switch mutableState.GetExecutionState().GetState() {
+	case enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+		enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,282 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibility
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/historyservicemock/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/service/worker/scanner/executor"
+)
+
+const (
+	testNamespaceID   = "deadbeef-0123-4567-890a-bcdef0123456"
+	testNamespaceName = "test-namespace"
+)
+
+type (
+	scavengerSuite struct {
+		suite.Suite
+		controller *gomock.Controller
+
+		mockExecutionManager  *persistence.MockExecutionManager
+		mockMetadataManager   *persistence.MockMetadataManager
+		mockVisibilityManager *manager.MockVisibilityManager
+		mockRegistry          *namespace.MockRegistry
+		mockHistoryClient     *historyservicemock.MockHistoryServiceClient
+
+		repairEnabled bool
+		scavenger     *Scavenger
+	}
+)
+
+func TestScavengerSuite(t *testing.T) {
+	suite.Run(t, new(scavengerSuite))
+}
+
+func (s *scavengerSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.mockExecutionManager = persistence.NewMockExecutionManager(s.controller)
+	s.mockMetadataManager = persistence.NewMockMetadataManager(s.controller)
+	s.mockVisibilityManager = manager.NewMockVisibilityManager(s.controller)
+	s.mockRegistry = namespace.NewMockRegistry(s.controller)
+	s.mockHistoryClient = historyservicemock.NewMockHistoryServiceClient(s.controller)
+	s.repairEnabled = true
+
+	s.scavenger = NewScavenger(
+		context.Background(),
+		1,
+		dynamicconfig.GetIntPropertyFn(1000),
+		dynamicconfig.GetIntPropertyFn(1000),
+		dynamicconfig.GetIntPropertyFn(1),
+		dynamicconfig.GetFloatPropertyFn(1),
+		dynamicconfig.GetDurationPropertyFn(time.Minute),
+		func() bool { return s.repairEnabled },
+		s.mockExecutionManager,
+		s.mockMetadataManager,
+		s.mockVisibilityManager,
+		s.mockRegistry,
+		s.mockHistoryClient,
+		metrics.NoopMetricsHandler,
+		log.NewTestLogger(),
+	)
+	s.mockRegistry.EXPECT().GetNamespaceByID(namespace.ID(testNamespaceID)).Return(
+		namespace.NewLocalNamespaceForTest(&persistencespb.NamespaceInfo{Id: testNamespaceID, Name: testNamespaceName}, nil, "active"),
+		nil,
+	).AnyTimes()
+}
+
+func (s *scavengerSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *scavengerSuite) TestExecutionTask_RepairsMismatchedRecord() {
+	running := s.newMutableState("wf-running", enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)
+	closed := s.newMutableState("wf-closed", enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED, enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED)
+	missing := s.newMutableState("wf-missing", enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED, enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED)
+	recent := s.newMutableState("wf-recent", enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)
+	recent.ExecutionInfo.LastUpdateTime = timePtr(time.Now())
+	zombie := s.newMutableState("wf-zombie", enumsspb.WORKFLOW_EXECUTION_STATE_ZOMBIE, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)
+
+	s.mockExecutionManager.EXPECT().ListConcreteExecutions(gomock.Any(), &persistence.ListConcreteExecutionsRequest{
+		ShardID:  1,
+		PageSize: executionsPageSize,
+	}).Return(&persistence.ListConcreteExecutionsResponse{
+		States: []*persistencespb.WorkflowMutableState{running, closed, missing, recent, zombie},
+	}, nil)
+
+	// running execution is in sync with its record
+	s.expectRecord(running, &workflowpb.WorkflowExecutionInfo{Status: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING}, nil)
+	// closed execution is still listed as running
+	s.expectRecord(closed, &workflowpb.WorkflowExecutionInfo{Status: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING}, nil)
+	// execution has no record at all
+	s.expectRecord(missing, nil, serviceerror.NewNotFound("not found"))
+
+	s.expectRepair("wf-closed")
+	s.expectRepair("wf-missing")
+
+	task := newExecutionTask(context.Background(), 1, s.scavenger, s.scavenger.newTaskRateLimiter())
+	s.Equal(executor.TaskStatusDone, task.Run())
+}
+
+func (s *scavengerSuite) TestExecutionTask_RepairDisabled() {
+	s.repairEnabled = false
+	closed := s.newMutableState("wf-closed", enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED, enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED)
+
+	s.mockExecutionManager.EXPECT().ListConcreteExecutions(gomock.Any(), gomock.Any()).Return(&persistence.ListConcreteExecutionsResponse{
+		States: []*persistencespb.WorkflowMutableState{closed},
+	}, nil)
+	s.expectRecord(closed, &workflowpb.WorkflowExecutionInfo{Status: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING}, nil)
+
+	task := newExecutionTask(context.Background(), 1, s.scavenger, s.scavenger.newTaskRateLimiter())
+	s.Equal(executor.TaskStatusDone, task.Run())
+}
+
+func (s *scavengerSuite) TestExecutionTask_DeferOnVisibilityError() {
+	closed := s.newMutableState("wf-closed", enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED, enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED)
+
+	s.mockExecutionManager.EXPECT().ListConcreteExecutions(gomock.Any(), gomock.Any()).Return(&persistence.ListConcreteExecutionsResponse{
+		States: []*persistencespb.WorkflowMutableState{closed},
+	}, nil)
+	s.expectRecord(closed, nil, serviceerror.NewUnavailable("unavailable"))
+
+	task := newExecutionTask(context.Background(), 1, s.scavenger, s.scavenger.newTaskRateLimiter())
+	s.Equal(executor.TaskStatusDefer, task.Run())
+}
+
+func (s *scavengerSuite) TestNamespaceTask_DeletesOrphanedRecord() {
+	startTime := time.Now().Add(-time.Hour).UTC()
+	existing := &workflowpb.WorkflowExecutionInfo{
+		Execution: &commonpb.WorkflowExecution{WorkflowId: "wf-existing", RunId: "run-existing"},
+		StartTime: &startTime,
+	}
+	orphaned := &workflowpb.WorkflowExecutionInfo{
+		Execution: &commonpb.WorkflowExecution{WorkflowId: "wf-orphaned", RunId: "run-orphaned"},
+		StartTime: &startTime,
+	}
+
+	s.mockVisibilityManager.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *manager.ListWorkflowExecutionsRequest) (*manager.ListWorkflowExecutionsResponse, error) {
+			s.Equal(namespace.ID(testNamespaceID), request.NamespaceID)
+			s.True(request.LatestStartTime.Before(time.Now().Add(-time.Minute).Add(time.Second)))
+			return &manager.ListWorkflowExecutionsResponse{
+				Executions: []*workflowpb.WorkflowExecutionInfo{existing, orphaned},
+			}, nil
+		},
+	)
+	s.mockExecutionManager.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.GetWorkflowExecutionRequest) (*persistence.GetWorkflowExecutionResponse, error) {
+			if request.WorkflowID == "wf-orphaned" {
+				return nil, serviceerror.NewNotFound("not found")
+			}
+			return &persistence.GetWorkflowExecutionResponse{}, nil
+		},
+	).Times(2)
+	s.mockHistoryClient.EXPECT().DeleteWorkflowVisibilityRecord(gomock.Any(), &historyservice.DeleteWorkflowVisibilityRecordRequest{
+		NamespaceId:       testNamespaceID,
+		Execution:         orphaned.Execution,
+		WorkflowStartTime: &startTime,
+	}).Return(&historyservice.DeleteWorkflowVisibilityRecordResponse{}, nil)
+
+	task := newNamespaceTask(context.Background(), testNamespaceID, testNamespaceName, s.scavenger, s.scavenger.newTaskRateLimiter())
+	s.Equal(executor.TaskStatusDone, task.Run())
+}
+
+func (s *scavengerSuite) TestListNamespaces_SkipsDeleted() {
+	s.mockMetadataManager.EXPECT().ListNamespaces(gomock.Any(), gomock.Any()).Return(&persistence.ListNamespacesResponse{
+		Namespaces: []*persistence.GetNamespaceResponse{
+			{Namespace: &persistencespb.NamespaceDetail{Info: &persistencespb.NamespaceInfo{Id: "1", Name: "registered", State: enumspb.NAMESPACE_STATE_REGISTERED}}},
+			{Namespace: &persistencespb.NamespaceDetail{Info: &persistencespb.NamespaceInfo{Id: "2", Name: "deleted", State: enumspb.NAMESPACE_STATE_DELETED}}},
+		},
+	}, nil)
+
+	namespaces, err := s.scavenger.listNamespaces()
+	s.NoError(err)
+	s.Len(namespaces, 1)
+	s.Equal("registered", namespaces[0].Info.Name)
+}
+
+func (s *scavengerSuite) newMutableState(
+	workflowID string,
+	state enumsspb.WorkflowExecutionState,
+	status enumspb.WorkflowExecutionStatus,
+) *persistencespb.WorkflowMutableState {
+	startTime := time.Now().Add(-time.Hour).UTC()
+	executionInfo := &persistencespb.WorkflowExecutionInfo{
+		NamespaceId:    testNamespaceID,
+		WorkflowId:     workflowID,
+		StartTime:      &startTime,
+		LastUpdateTime: &startTime,
+	}
+	if state == enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED {
+		executionInfo.CloseTime = &startTime
+	}
+	return &persistencespb.WorkflowMutableState{
+		ExecutionInfo: executionInfo,
+		ExecutionState: &persistencespb.WorkflowExecutionState{
+			RunId:  workflowID + "-run",
+			State:  state,
+			Status: status,
+		},
+	}
+}
+
+func (s *scavengerSuite) expectRecord(
+	mutableState *persistencespb.WorkflowMutableState,
+	record *workflowpb.WorkflowExecutionInfo,
+	err error,
+) {
+	s.mockVisibilityManager.EXPECT().GetWorkflowExecution(gomock.Any(), &manager.GetWorkflowExecutionRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespaceName,
+		RunID:       mutableState.ExecutionState.RunId,
+		WorkflowID:  mutableState.ExecutionInfo.WorkflowId,
+		StartTime:   mutableState.ExecutionInfo.StartTime,
+		CloseTime:   mutableState.ExecutionInfo.CloseTime,
+	}).DoAndReturn(func(_ context.Context, _ *manager.GetWorkflowExecutionRequest) (*manager.GetWorkflowExecutionResponse, error) {
+		if err != nil {
+			return nil, err
+		}
+		if record.StartTime == nil {
+			record.StartTime = mutableState.ExecutionInfo.StartTime
+		}
+		if record.CloseTime == nil {
+			record.CloseTime = mutableState.ExecutionInfo.CloseTime
+		}
+		return &manager.GetWorkflowExecutionResponse{Execution: record}, nil
+	})
+}
+
+func (s *scavengerSuite) expectRepair(workflowID string) {
+	s.mockHistoryClient.EXPECT().GenerateVisibilityTasks(gomock.Any(), &historyservice.GenerateVisibilityTasksRequest{
+		NamespaceId: testNamespaceID,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      workflowID + "-run",
+		},
+	}).Return(&historyservice.GenerateVisibilityTasksResponse{}, nil)
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
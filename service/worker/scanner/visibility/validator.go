@@ -0,0 +1,84 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibility
+
+import (
+	workflowpb "go.temporal.io/api/workflow/v1"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	persistencevisibility "go.temporal.io/server/common/persistence/visibility"
+)
+
+const (
+	// failure types, used as metric tags
+	recordMissingFailureType           = "record-missing"
+	recordOrphanedFailureType          = "record-orphaned"
+	statusMismatchFailureType          = "status-mismatch"
+	workflowTypeMismatchFailureType    = "workflow-type-mismatch"
+	startTimeMismatchFailureType       = "start-time-mismatch"
+	closeTimeMismatchFailureType       = "close-time-mismatch"
+	searchAttributeMismatchFailureType = "search-attribute-mismatch"
+)
+
+type (
+	// validationResult describes one way a visibility record differs from its execution
+	validationResult struct {
+		// type tag used for metrics
+		failureType string
+		// failure details used for logging
+		failureDetails string
+	}
+)
+
+var mismatchFailureTypes = map[string]string{
+	persistencevisibility.RecordFieldStatus:           statusMismatchFailureType,
+	persistencevisibility.RecordFieldWorkflowType:     workflowTypeMismatchFailureType,
+	persistencevisibility.RecordFieldStartTime:        startTimeMismatchFailureType,
+	persistencevisibility.RecordFieldCloseTime:        closeTimeMismatchFailureType,
+	persistencevisibility.RecordFieldSearchAttributes: searchAttributeMismatchFailureType,
+}
+
+// validateRecord compares the visibility record of an execution with its mutable state.
+// A nil record means visibility has no record of the execution.
+func validateRecord(
+	mutableState *persistencespb.WorkflowMutableState,
+	record *workflowpb.WorkflowExecutionInfo,
+) []validationResult {
+	if record == nil {
+		return []validationResult{{
+			failureType:    recordMissingFailureType,
+			failureDetails: "visibility record not found",
+		}}
+	}
+
+	var results []validationResult
+	for _, mismatch := range persistencevisibility.CompareRecord(mutableState, record) {
+		results = append(results, validationResult{
+			failureType:    mismatchFailureTypes[mismatch.Field],
+			failureDetails: mismatch.Details,
+		})
+	}
+	return results
+}
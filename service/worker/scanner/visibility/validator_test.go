@@ -0,0 +1,140 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibility
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+func TestValidateRecord(t *testing.T) {
+	closeTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	otherCloseTime := closeTime.Add(time.Second)
+	mutableState := &persistencespb.WorkflowMutableState{
+		ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+			CloseTime: &closeTime,
+			SearchAttributes: map[string]*commonpb.Payload{
+				"CustomKeywordField":  payload.EncodeString("value"),
+				"CustomDatetimeField": payload.EncodeString("2022-01-01T00:00:00Z"),
+			},
+		},
+		ExecutionState: &persistencespb.WorkflowExecutionState{
+			State:  enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED,
+			Status: enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		},
+	}
+
+	keyword := payload.EncodeString("value")
+	keyword.Metadata[searchattribute.MetadataType] = []byte(enumspb.INDEXED_VALUE_TYPE_KEYWORD.String())
+	datetime := payload.EncodeString("2022-01-01T00:00:00.000Z")
+	datetime.Metadata[searchattribute.MetadataType] = []byte(enumspb.INDEXED_VALUE_TYPE_DATETIME.String())
+	staleKeyword := payload.EncodeString("stale")
+	staleKeyword.Metadata[searchattribute.MetadataType] = []byte(enumspb.INDEXED_VALUE_TYPE_KEYWORD.String())
+
+	testCases := []struct {
+		name         string
+		record       *workflowpb.WorkflowExecutionInfo
+		failureTypes []string
+	}{
+		{
+			name:         "missing",
+			record:       nil,
+			failureTypes: []string{recordMissingFailureType},
+		},
+		{
+			name: "standard visibility in sync",
+			record: &workflowpb.WorkflowExecutionInfo{
+				Status:    enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+				CloseTime: &closeTime,
+			},
+		},
+		{
+			name: "still running",
+			record: &workflowpb.WorkflowExecutionInfo{
+				Status: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+			},
+			failureTypes: []string{statusMismatchFailureType},
+		},
+		{
+			name: "close time",
+			record: &workflowpb.WorkflowExecutionInfo{
+				Status:    enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+				CloseTime: &otherCloseTime,
+			},
+			failureTypes: []string{closeTimeMismatchFailureType},
+		},
+		{
+			name: "workflow type",
+			record: &workflowpb.WorkflowExecutionInfo{
+				Type:      &commonpb.WorkflowType{Name: "other-type"},
+				Status:    enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+				CloseTime: &closeTime,
+			},
+			failureTypes: []string{workflowTypeMismatchFailureType},
+		},
+		{
+			name: "search attributes re-encoded",
+			record: &workflowpb.WorkflowExecutionInfo{
+				Status:    enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+				CloseTime: &closeTime,
+				SearchAttributes: &commonpb.SearchAttributes{IndexedFields: map[string]*commonpb.Payload{
+					"CustomKeywordField":  keyword,
+					"CustomDatetimeField": datetime,
+				}},
+			},
+		},
+		{
+			name: "search attributes stale",
+			record: &workflowpb.WorkflowExecutionInfo{
+				Status:    enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+				CloseTime: &closeTime,
+				SearchAttributes: &commonpb.SearchAttributes{IndexedFields: map[string]*commonpb.Payload{
+					"CustomKeywordField": staleKeyword,
+				}},
+			},
+			failureTypes: []string{searchAttributeMismatchFailureType},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var failureTypes []string
+			for _, result := range validateRecord(mutableState, tc.record) {
+				failureTypes = append(failureTypes, result.failureType)
+			}
+			require.Equal(t, tc.failureTypes, failureTypes)
+		})
+	}
+}
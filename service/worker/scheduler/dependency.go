@@ -0,0 +1,315 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	schedpb "go.temporal.io/api/schedule/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/converter"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	"golang.org/x/exp/slices"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	schedspb "go.temporal.io/server/api/schedule/v1"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+// DependencyMemoKey is the key in the memo of a schedule's start workflow action that carries
+// the schedule's dependency through the public schedule API, which has no field for it. The
+// value is a ScheduleDependency in JSON form. Frontend moves it out of the action on create and
+// update and puts it back on describe, so it never ends up in the memo of started workflows.
+const DependencyMemoKey = "TemporalScheduleDependency"
+
+// ExtractDependency removes the dependency from the schedule's start workflow action and
+// returns it, or nil if the schedule doesn't have one.
+func ExtractDependency(sched *schedpb.Schedule, scheduleID string) (*schedspb.ScheduleDependency, error) {
+	fields := sched.GetAction().GetStartWorkflow().GetMemo().GetFields()
+	p, ok := fields[DependencyMemoKey]
+	if !ok {
+		return nil, nil
+	}
+	delete(fields, DependencyMemoKey)
+
+	switch encoding := string(p.GetMetadata()[converter.MetadataEncoding]); encoding {
+	case converter.MetadataEncodingJSON, converter.MetadataEncodingProtoJSON:
+	default:
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("schedule dependency has unsupported encoding %q", encoding))
+	}
+	dependency := &schedspb.ScheduleDependency{}
+	if err := jsonpb.Unmarshal(bytes.NewReader(p.GetData()), dependency); err != nil {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("invalid schedule dependency: %v", err))
+	}
+	if dependency.ScheduleId == "" {
+		return nil, serviceerror.NewInvalidArgument("schedule dependency is missing the upstream schedule id")
+	}
+	if dependency.ScheduleId == scheduleID {
+		return nil, serviceerror.NewInvalidArgument("schedule can't depend on itself")
+	}
+	if timestamp.DurationValue(dependency.Timeout) < 0 {
+		return nil, serviceerror.NewInvalidArgument("schedule dependency timeout can't be negative")
+	}
+	return dependency, nil
+}
+
+// AttachDependency is the inverse of ExtractDependency: it puts the dependency back in the
+// schedule's start workflow action so it shows up in describe.
+func AttachDependency(sched *schedpb.Schedule, dependency *schedspb.ScheduleDependency) error {
+	startWorkflow := sched.GetAction().GetStartWorkflow()
+	if dependency == nil || startWorkflow == nil {
+		return nil
+	}
+	var buf bytes.Buffer
+	if err := (&jsonpb.Marshaler{}).Marshal(&buf, dependency); err != nil {
+		return err
+	}
+	if startWorkflow.Memo == nil {
+		startWorkflow.Memo = &commonpb.Memo{}
+	}
+	if startWorkflow.Memo.Fields == nil {
+		startWorkflow.Memo.Fields = make(map[string]*commonpb.Payload)
+	}
+	startWorkflow.Memo.Fields[DependencyMemoKey] = &commonpb.Payload{
+		Metadata: map[string][]byte{converter.MetadataEncoding: []byte(converter.MetadataEncodingJSON)},
+		Data:     buf.Bytes(),
+	}
+	return nil
+}
+
+// Dependency chaining works like this: a schedule with a dependency (the downstream) signals
+// the upstream schedule once to register itself. Whenever a workflow started by the upstream
+// closes, the upstream signals the result for that workflow's nominal time to all registered
+// downstreams. The downstream keeps its buffered (non-manual) starts in the buffer until it
+// gets a result for the same nominal time, or until the dependency timeout passes. When the
+// downstream changes or removes its dependency, it signals the old upstream to unregister.
+
+func (s *scheduler) registerDependency() {
+	upstreamID := WorkflowIDPrefix + s.Dependency.ScheduleId
+	err := workflow.SignalExternalWorkflow(s.ctx, upstreamID, "", SignalNameRegisterDependent, s.State.ScheduleId).Get(s.ctx, nil)
+	if err != nil {
+		// starts will be handled by the timeout policy. we'll try again after continue-as-new.
+		s.logger.Error("failed to register with upstream schedule", "upstream", s.Dependency.ScheduleId, "error", err)
+		return
+	}
+	s.State.DependencyRegistered = true
+}
+
+func (s *scheduler) unregisterDependency(upstreamScheduleID string) {
+	upstreamID := WorkflowIDPrefix + upstreamScheduleID
+	err := workflow.SignalExternalWorkflow(s.ctx, upstreamID, "", SignalNameUnregisterDependent, s.State.ScheduleId).Get(s.ctx, nil)
+	var unknownErr *temporal.UnknownExternalWorkflowExecutionError
+	if err != nil && !errors.As(err, &unknownErr) {
+		// the upstream forgets about us when it fails to signal us after we're deleted, until
+		// then it keeps sending results that we ignore.
+		s.logger.Error("failed to unregister from upstream schedule", "upstream", upstreamScheduleID, "error", err)
+	}
+}
+
+func (s *scheduler) handleRegisterDependentSignal(ch workflow.ReceiveChannel, _ bool) {
+	var scheduleID string
+	ch.Receive(s.ctx, &scheduleID)
+	s.logger.Debug("got register dependent signal", "dependent", scheduleID)
+	if scheduleID == "" || slices.Contains(s.State.DependentScheduleIds, scheduleID) {
+		return
+	}
+	s.State.DependentScheduleIds = append(s.State.DependentScheduleIds, scheduleID)
+}
+
+func (s *scheduler) handleUnregisterDependentSignal(ch workflow.ReceiveChannel, _ bool) {
+	var scheduleID string
+	ch.Receive(s.ctx, &scheduleID)
+	s.logger.Debug("got unregister dependent signal", "dependent", scheduleID)
+	if idx := slices.Index(s.State.DependentScheduleIds, scheduleID); idx >= 0 {
+		s.State.DependentScheduleIds = slices.Delete(s.State.DependentScheduleIds, idx, idx+1)
+	}
+}
+
+func (s *scheduler) handleDependencyResultSignal(ch workflow.ReceiveChannel, _ bool) {
+	var res *schedspb.DependencyResult
+	ch.Receive(s.ctx, &res)
+	if res == nil || s.Dependency == nil || res.ScheduleId != s.Dependency.ScheduleId {
+		s.logger.Warn("ignoring unexpected dependency result", "result", res.String())
+		return
+	}
+	s.logger.Debug("got dependency result", "upstream", res.ScheduleId, "nominal-time", res.NominalTime, "status", res.Status)
+
+	// a later result for the same nominal time (e.g. from a backfill) replaces the earlier one
+	match := func(r *schedspb.DependencyResult) bool {
+		return timestamp.TimeValue(r.NominalTime).Equal(timestamp.TimeValue(res.NominalTime))
+	}
+	if idx := slices.IndexFunc(s.State.DependencyResults, match); idx >= 0 {
+		s.State.DependencyResults = slices.Delete(s.State.DependencyResults, idx, idx+1)
+	}
+	s.State.DependencyResults = append(s.State.DependencyResults, res)
+	if s.tweakables.MaxBufferSize > 0 && len(s.State.DependencyResults) > s.tweakables.MaxBufferSize {
+		s.State.DependencyResults = s.State.DependencyResults[len(s.State.DependencyResults)-s.tweakables.MaxBufferSize:]
+	}
+}
+
+func (s *scheduler) notifyDependents(nominalTime time.Time, status enumspb.WorkflowExecutionStatus) {
+	if len(s.State.DependentScheduleIds) == 0 {
+		return
+	}
+	res := &schedspb.DependencyResult{
+		ScheduleId:  s.State.ScheduleId,
+		NominalTime: timestamp.TimePtr(nominalTime),
+		Status:      status,
+	}
+	futures := make([]workflow.Future, len(s.State.DependentScheduleIds))
+	for i, id := range s.State.DependentScheduleIds {
+		futures[i] = workflow.SignalExternalWorkflow(s.ctx, WorkflowIDPrefix+id, "", SignalNameDependencyResult, res)
+	}
+	var remaining []string
+	for i, id := range s.State.DependentScheduleIds {
+		err := futures[i].Get(s.ctx, nil)
+		var unknownErr *temporal.UnknownExternalWorkflowExecutionError
+		if errors.As(err, &unknownErr) {
+			// the dependent schedule was deleted, forget about it
+			s.logger.Info("dependent schedule not found, removing", "dependent", id)
+			continue
+		} else if err != nil {
+			s.logger.Error("failed to signal dependent schedule", "dependent", id, "error", err)
+			s.metrics.Counter(metrics.ScheduleSignalDependentErrors.GetMetricName()).Inc(1)
+		}
+		remaining = append(remaining, id)
+	}
+	s.State.DependentScheduleIds = remaining
+}
+
+// updateDependency replaces the dependency on an update. Results from the old upstream don't
+// apply to a new one, and starts waiting on a removed dependency can go right away.
+func (s *scheduler) updateDependency(dependency *schedspb.ScheduleDependency) {
+	if dependency.GetScheduleId() != s.Dependency.GetScheduleId() {
+		if s.Dependency != nil {
+			s.unregisterDependency(s.Dependency.ScheduleId)
+		}
+		s.State.DependencyResults = nil
+		s.State.DependencyRegistered = false
+	}
+	s.Dependency = dependency
+	if s.Dependency == nil {
+		for _, start := range s.State.BufferedStarts {
+			start.DependencyDeadline = nil
+		}
+	} else if !s.State.DependencyRegistered {
+		s.registerDependency()
+	}
+}
+
+// resolveDependencies splits buffered starts into ones that can be processed now and ones that
+// are still waiting for the upstream schedule. Starts whose upstream run did not succeed are
+// dropped, and the timeout policy is applied to starts whose deadline has passed.
+func (s *scheduler) resolveDependencies(starts []*schedspb.BufferedStart) (ready, waiting []*schedspb.BufferedStart) {
+	now := s.now()
+	for _, start := range starts {
+		if start.DependencyDeadline == nil {
+			ready = append(ready, start)
+			continue
+		}
+		nominalTime := timestamp.TimeValue(start.NominalTime)
+		switch status := s.takeDependencyResult(nominalTime); status {
+		case enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED:
+			start.DependencyDeadline = nil
+			ready = append(ready, start)
+		case enumspb.WORKFLOW_EXECUTION_STATUS_UNSPECIFIED:
+			if now.Before(timestamp.TimeValue(start.DependencyDeadline)) {
+				waiting = append(waiting, start)
+				continue
+			}
+			s.metrics.Counter(metrics.ScheduleDependencyTimeouts.GetMetricName()).Inc(1)
+			switch s.Dependency.GetTimeoutPolicy() {
+			case enumsspb.SCHEDULE_DEPENDENCY_TIMEOUT_POLICY_START:
+				s.logger.Warn("Dependency timed out, starting anyway", "start-time", nominalTime)
+				start.DependencyDeadline = nil
+				ready = append(ready, start)
+			case enumsspb.SCHEDULE_DEPENDENCY_TIMEOUT_POLICY_FAIL:
+				s.logger.Warn("Dependency timed out, pausing", "start-time", nominalTime)
+				if !s.Schedule.State.Paused {
+					s.Schedule.State.Paused = true
+					s.Schedule.State.Notes = fmt.Sprintf("paused due to dependency timeout: %s at %s",
+						s.Dependency.ScheduleId, nominalTime.Format(time.RFC3339))
+					s.incSeqNo()
+				}
+			default:
+				s.logger.Warn("Dependency timed out, skipping", "start-time", nominalTime)
+				s.metrics.Counter(metrics.ScheduleDependencySkipped.GetMetricName()).Inc(1)
+			}
+		default:
+			s.logger.Info("Upstream run did not succeed, skipping", "start-time", nominalTime, "status", status)
+			s.metrics.Counter(metrics.ScheduleDependencySkipped.GetMetricName()).Inc(1)
+		}
+	}
+	return ready, waiting
+}
+
+// takeDependencyResult returns the upstream status for the given nominal time (or unspecified
+// if we don't know it yet) and removes a found result from the state.
+func (s *scheduler) takeDependencyResult(nominalTime time.Time) enumspb.WorkflowExecutionStatus {
+	match := func(r *schedspb.DependencyResult) bool {
+		return timestamp.TimeValue(r.NominalTime).Equal(nominalTime)
+	}
+	idx := slices.IndexFunc(s.State.DependencyResults, match)
+	if idx < 0 {
+		return enumspb.WORKFLOW_EXECUTION_STATUS_UNSPECIFIED
+	}
+	status := s.State.DependencyResults[idx].Status
+	s.State.DependencyResults = slices.Delete(s.State.DependencyResults, idx, idx+1)
+	return status
+}
+
+// limitSleepForDependency makes sure we wake up to apply the timeout policy to starts that
+// are waiting on the upstream schedule.
+func (s *scheduler) limitSleepForDependency(nextSleep time.Duration) time.Duration {
+	now := s.now()
+	for _, start := range s.State.BufferedStarts {
+		if start.DependencyDeadline == nil {
+			continue
+		}
+		d := start.DependencyDeadline.Sub(now)
+		if d < 0 {
+			d = 0
+		}
+		if nextSleep == invalidDuration || d < nextSleep {
+			nextSleep = d
+		}
+	}
+	return nextSleep
+}
+
+func (s *scheduler) getDependencyTimeout() time.Duration {
+	if timeout := timestamp.DurationValue(s.Dependency.GetTimeout()); timeout > 0 {
+		return timeout
+	}
+	return s.tweakables.DefaultDependencyTimeout
+}
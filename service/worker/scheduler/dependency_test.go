@@ -0,0 +1,123 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	schedpb "go.temporal.io/api/schedule/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/sdk/converter"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	schedspb "go.temporal.io/server/api/schedule/v1"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+type (
+	dependencyMemoSuite struct {
+		suite.Suite
+	}
+)
+
+func TestDependencyMemo(t *testing.T) {
+	suite.Run(t, new(dependencyMemoSuite))
+}
+
+func (s *dependencyMemoSuite) scheduleWithMemo(fields map[string]*commonpb.Payload) *schedpb.Schedule {
+	return &schedpb.Schedule{
+		Action: &schedpb.ScheduleAction{
+			Action: &schedpb.ScheduleAction_StartWorkflow{
+				StartWorkflow: &workflowpb.NewWorkflowExecutionInfo{
+					WorkflowId: "myid",
+					Memo:       &commonpb.Memo{Fields: fields},
+				},
+			},
+		},
+	}
+}
+
+func (s *dependencyMemoSuite) TestRoundTrip() {
+	dependency := &schedspb.ScheduleDependency{
+		ScheduleId:    "upstream",
+		Timeout:       timestamp.DurationPtr(90 * time.Minute),
+		TimeoutPolicy: enumsspb.SCHEDULE_DEPENDENCY_TIMEOUT_POLICY_FAIL,
+	}
+	sched := s.scheduleWithMemo(map[string]*commonpb.Payload{"other": payload.EncodeString("value")})
+	s.NoError(AttachDependency(sched, dependency))
+	s.Len(sched.GetAction().GetStartWorkflow().GetMemo().GetFields(), 2)
+
+	extracted, err := ExtractDependency(sched, "myschedule")
+	s.NoError(err)
+	s.Equal(dependency, extracted)
+	// removed from the action so it doesn't end up in started workflows
+	fields := sched.GetAction().GetStartWorkflow().GetMemo().GetFields()
+	s.Len(fields, 1)
+	s.Contains(fields, "other")
+}
+
+func (s *dependencyMemoSuite) TestExtract_PlainJSON() {
+	sched := s.scheduleWithMemo(map[string]*commonpb.Payload{
+		DependencyMemoKey: {
+			Metadata: map[string][]byte{converter.MetadataEncoding: []byte(converter.MetadataEncodingJSON)},
+			Data:     []byte(`{"scheduleId":"upstream","timeout":"600s","timeoutPolicy":"Start"}`),
+		},
+	})
+	dependency, err := ExtractDependency(sched, "myschedule")
+	s.NoError(err)
+	s.Equal("upstream", dependency.ScheduleId)
+	s.Equal(10*time.Minute, timestamp.DurationValue(dependency.Timeout))
+	s.Equal(enumsspb.SCHEDULE_DEPENDENCY_TIMEOUT_POLICY_START, dependency.TimeoutPolicy)
+}
+
+func (s *dependencyMemoSuite) TestExtract_None() {
+	dependency, err := ExtractDependency(s.scheduleWithMemo(nil), "myschedule")
+	s.NoError(err)
+	s.Nil(dependency)
+
+	dependency, err = ExtractDependency(&schedpb.Schedule{}, "myschedule")
+	s.NoError(err)
+	s.Nil(dependency)
+}
+
+func (s *dependencyMemoSuite) TestExtract_Invalid() {
+	for _, p := range []*commonpb.Payload{
+		payload.EncodeBytes([]byte(`{"scheduleId":"upstream"}`)),
+		payload.EncodeString(`not json`),
+		payload.EncodeString(`{"timeout":"60s"}`),
+		payload.EncodeString(`{"scheduleId":"myschedule"}`),
+		payload.EncodeString(`{"scheduleId":"upstream","timeout":"-60s"}`),
+	} {
+		sched := s.scheduleWithMemo(map[string]*commonpb.Payload{DependencyMemoKey: p})
+		_, err := ExtractDependency(sched, "myschedule")
+		var invalidArgument *serviceerror.InvalidArgument
+		s.ErrorAs(err, &invalidArgument, string(p.Data))
+	}
+}
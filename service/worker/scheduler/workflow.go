@@ -65,6 +65,11 @@ const (
 	SignalNamePatch   = "patch"
 	SignalNameRefresh = "refresh"
 
+	// Signals exchanged between schedules for dependency chaining.
+	SignalNameRegisterDependent   = "registerDependent"
+	SignalNameUnregisterDependent = "unregisterDependent"
+	SignalNameDependencyResult    = "dependencyResult"
+
 	QueryNameDescribe          = "describe"
 	QueryNameListMatchingTimes = "listMatchingTimes"
 
@@ -115,7 +120,8 @@ type (
 		FutureActionCountForList          int           // The number of future action times to include in List (search attr).
 		RecentActionCountForList          int           // The number of recent actual action results to include in List (search attr).
 		IterationsBeforeContinueAsNew     int
-		SleepWhilePaused                  bool // If true, don't set timers while paused/out of actions
+		SleepWhilePaused                  bool          // If true, don't set timers while paused/out of actions
+		DefaultDependencyTimeout          time.Duration // Default for how long to wait for an upstream schedule
 		// MaxBufferSize limits the number of buffered starts. This also limits the number of
 		// workflows that can be backfilled at once (since they all have to fit in the buffer).
 		MaxBufferSize int
@@ -149,6 +155,7 @@ var (
 		RecentActionCountForList:          5,
 		IterationsBeforeContinueAsNew:     500,
 		SleepWhilePaused:                  true,
+		DefaultDependencyTimeout:          24 * time.Hour,
 		MaxBufferSize:                     1000,
 	}
 
@@ -191,6 +198,10 @@ func (s *scheduler) run() error {
 		s.Info.CreateTime = s.State.LastProcessedTime
 	}
 
+	if s.Dependency != nil && !s.State.DependencyRegistered {
+		s.registerDependency()
+	}
+
 	// A schedule may be created with an initial Patch, e.g. start one immediately. Put that in
 	// the state so it takes effect below.
 	s.pendingPatch = s.InitialPatch
@@ -221,6 +232,7 @@ func (s *scheduler) run() error {
 		//nolint:revive
 		for s.processBuffer() {
 		}
+		nextSleep = s.limitSleepForDependency(nextSleep)
 		s.updateMemoAndSearchAttributes()
 		// sleep returns on any of:
 		// 1. requested time elapsed
@@ -263,6 +275,9 @@ func (s *scheduler) ensureFields() {
 	if s.State == nil {
 		s.State = &schedspb.InternalState{}
 	}
+	if s.State.RunningNominalTimes == nil {
+		s.State.RunningNominalTimes = make(map[string]*time.Time)
+	}
 }
 
 func (s *scheduler) compileSpec() {
@@ -411,6 +426,15 @@ func (s *scheduler) sleep(nextSleep time.Duration) {
 	refreshCh := workflow.GetSignalChannel(s.ctx, SignalNameRefresh)
 	sel.AddReceive(refreshCh, s.handleRefreshSignal)
 
+	registerCh := workflow.GetSignalChannel(s.ctx, SignalNameRegisterDependent)
+	sel.AddReceive(registerCh, s.handleRegisterDependentSignal)
+
+	unregisterCh := workflow.GetSignalChannel(s.ctx, SignalNameUnregisterDependent)
+	sel.AddReceive(unregisterCh, s.handleUnregisterDependentSignal)
+
+	dependencyCh := workflow.GetSignalChannel(s.ctx, SignalNameDependencyResult)
+	sel.AddReceive(dependencyCh, s.handleDependencyResultSignal)
+
 	// if we're paused or out of actions, we don't need to wake up until we get an update
 	if s.tweakables.SleepWhilePaused && !s.canTakeScheduledAction(false, false) {
 		nextSleep = invalidDuration
@@ -461,6 +485,12 @@ func (s *scheduler) processWatcherResult(id string, f workflow.Future) {
 		s.logger.Error("closed workflow not found in running list", "workflow", id)
 	}
 
+	// let schedules that depend on us know how the run for this nominal time went
+	if nominalTime, ok := s.State.RunningNominalTimes[id]; ok {
+		delete(s.State.RunningNominalTimes, id)
+		s.notifyDependents(timestamp.TimeValue(nominalTime), res.Status)
+	}
+
 	// handle pause-on-failure
 	failedStatus := res.Status == enumspb.WORKFLOW_EXECUTION_STATUS_FAILED ||
 		res.Status == enumspb.WORKFLOW_EXECUTION_STATUS_TIMED_OUT ||
@@ -504,6 +534,7 @@ func (s *scheduler) processUpdate(req *schedspb.FullUpdateRequest) {
 	s.Schedule.Policies = req.Schedule.GetPolicies()
 	s.Schedule.State = req.Schedule.GetState()
 	// don't touch Info
+	s.updateDependency(req.Dependency)
 
 	s.ensureFields()
 	s.compileSpec()
@@ -563,6 +594,7 @@ func (s *scheduler) handleDescribeQuery() (*schedspb.DescribeResponse, error) {
 		Schedule:      s.Schedule,
 		Info:          &infoCopy,
 		ConflictToken: s.State.ConflictToken,
+		Dependency:    s.Dependency,
 	}, nil
 }
 
@@ -693,11 +725,16 @@ func (s *scheduler) addStart(nominalTime, actualTime time.Time, overlapPolicy en
 		s.metrics.Counter(metrics.ScheduleBufferOverruns.GetMetricName()).Inc(1)
 		return
 	}
+	var dependencyDeadline *time.Time
+	if s.Dependency != nil && !manual {
+		dependencyDeadline = timestamp.TimePtr(nominalTime.Add(s.getDependencyTimeout()))
+	}
 	s.State.BufferedStarts = append(s.State.BufferedStarts, &schedspb.BufferedStart{
-		NominalTime:   timestamp.TimePtr(nominalTime),
-		ActualTime:    timestamp.TimePtr(actualTime),
-		OverlapPolicy: overlapPolicy,
-		Manual:        manual,
+		NominalTime:        timestamp.TimePtr(nominalTime),
+		ActualTime:         timestamp.TimePtr(actualTime),
+		OverlapPolicy:      overlapPolicy,
+		Manual:             manual,
+		DependencyDeadline: dependencyDeadline,
 	})
 	// we have a new start to process, so we need to make sure that we have up-to-date status
 	// on any workflows that we started.
@@ -724,15 +761,19 @@ func (s *scheduler) processBuffer() bool {
 	req := s.Schedule.Action.GetStartWorkflow()
 	if req == nil || len(s.State.BufferedStarts) == 0 {
 		s.State.BufferedStarts = nil
+		s.ensureWatcher(false)
 		return false
 	}
 
+	// Starts that are still waiting on an upstream schedule stay in the buffer untouched.
+	ready, waiting := s.resolveDependencies(s.State.BufferedStarts)
+
 	isRunning := len(s.Info.RunningWorkflows) > 0
 	tryAgain := false
 
-	action := processBuffer(s.State.BufferedStarts, isRunning, s.resolveOverlapPolicy)
+	action := processBuffer(ready, isRunning, s.resolveOverlapPolicy)
 
-	s.State.BufferedStarts = action.newBuffer
+	s.State.BufferedStarts = append(waiting, action.newBuffer...)
 	s.Info.OverlapSkipped += action.overlapSkipped
 
 	// Try starting whatever we're supposed to start now
@@ -760,6 +801,7 @@ func (s *scheduler) processBuffer() bool {
 		}
 		metricsWithTag.Counter(metrics.ScheduleActionSuccess.GetMetricName()).Inc(1)
 		s.recordAction(result)
+		s.State.RunningNominalTimes[result.StartWorkflowResult.GetWorkflowId()] = start.NominalTime
 	}
 
 	// Terminate or cancel if required (terminate overrides cancel if both are present)
@@ -773,21 +815,26 @@ func (s *scheduler) processBuffer() bool {
 		}
 	}
 
-	// If we still have a buffer here, then we're waiting for started workflow(s) to be closed
-	// (maybe one we just started). In order to get woken up, we need to be watching at least
-	// one of them with an activity. We only need one watcher at a time, though: after that one
-	// returns, we'll end up back here and start the next one.
-	if len(s.State.BufferedStarts) > 0 && s.watchingFuture == nil {
-		if len(s.Info.RunningWorkflows) > 0 {
-			s.startLongPollWatcher(s.Info.RunningWorkflows[0])
-		} else {
-			s.logger.Error("have buffered workflows but none running")
-		}
-	}
+	s.ensureWatcher(len(action.newBuffer) > 0)
 
 	return tryAgain
 }
 
+// ensureWatcher starts a long-poll watcher on a running workflow if we need to find out when
+// it closes: either because we still have a buffer (maybe with one we just started), or
+// because dependent schedules are waiting for its result. We only need one watcher at a time,
+// though: after that one returns, we'll end up back here and start the next one.
+func (s *scheduler) ensureWatcher(haveBuffer bool) {
+	if s.watchingFuture != nil || (!haveBuffer && len(s.State.DependentScheduleIds) == 0) {
+		return
+	}
+	if len(s.Info.RunningWorkflows) > 0 {
+		s.startLongPollWatcher(s.Info.RunningWorkflows[0])
+	} else if haveBuffer {
+		s.logger.Error("have buffered workflows but none running")
+	}
+}
+
 func (s *scheduler) recordAction(result *schedpb.ScheduleActionResult) {
 	s.Info.ActionCount++
 	s.Info.RecentActions = util.SliceTail(append(s.Info.RecentActions, result), s.tweakables.RecentActionCount)
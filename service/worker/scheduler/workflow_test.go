@@ -42,6 +42,7 @@ import (
 	"go.temporal.io/sdk/testsuite"
 	"go.temporal.io/sdk/workflow"
 
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	schedspb "go.temporal.io/server/api/schedule/v1"
 	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
@@ -1356,3 +1357,307 @@ func (s *workflowSuite) TestLimitedActions() {
 	s.True(s.env.IsWorkflowCompleted())
 	// doesn't end properly since it sleeps forever after pausing
 }
+
+func (s *workflowSuite) runWithDependency(dependency *schedspb.ScheduleDependency, iterations int) {
+	currentTweakablePolicies.IterationsBeforeContinueAsNew = iterations
+	s.env.SetStartTime(baseStartTime)
+
+	s.env.OnSignalExternalWorkflow(mock.Anything, WorkflowIDPrefix+"upstream", "", SignalNameRegisterDependent, "myschedule").Once().Return(nil)
+
+	s.env.ExecuteWorkflow(SchedulerWorkflow, &schedspb.StartScheduleArgs{
+		Schedule: &schedpb.Schedule{
+			Spec: &schedpb.ScheduleSpec{
+				Interval: []*schedpb.IntervalSpec{{
+					Interval: timestamp.DurationPtr(1 * time.Hour),
+				}},
+			},
+			Action: s.defaultAction("myid"),
+		},
+		State: &schedspb.InternalState{
+			Namespace:     "myns",
+			NamespaceId:   "mynsid",
+			ScheduleId:    "myschedule",
+			ConflictToken: InitialConflictToken,
+		},
+		Dependency: dependency,
+	})
+}
+
+func (s *workflowSuite) TestDependencySatisfied() {
+	s.expectStart(func(req *schedspb.StartWorkflowRequest) (*schedspb.StartWorkflowResponse, error) {
+		s.True(time.Date(2022, 6, 1, 1, 10, 0, 0, time.UTC).Equal(s.now()))
+		s.Equal("myid-2022-06-01T01:00:00Z", req.Request.WorkflowId)
+		return nil, nil
+	})
+	s.env.RegisterDelayedCallback(func() {
+		s.Empty(s.runningWorkflows())
+		// unrelated result should not release the start
+		s.env.SignalWorkflow(SignalNameDependencyResult, &schedspb.DependencyResult{
+			ScheduleId:  "upstream",
+			NominalTime: timestamp.TimePtr(time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)),
+			Status:      enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		})
+	}, 65*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		s.Empty(s.runningWorkflows())
+		s.env.SignalWorkflow(SignalNameDependencyResult, &schedspb.DependencyResult{
+			ScheduleId:  "upstream",
+			NominalTime: timestamp.TimePtr(time.Date(2022, 6, 1, 1, 0, 0, 0, time.UTC)),
+			Status:      enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		})
+	}, 70*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		s.Equal([]string{"myid-2022-06-01T01:00:00Z"}, s.runningWorkflows())
+	}, 71*time.Minute)
+
+	s.runWithDependency(&schedspb.ScheduleDependency{
+		ScheduleId: "upstream",
+		Timeout:    timestamp.DurationPtr(30 * time.Minute),
+	}, 4)
+	s.True(s.env.IsWorkflowCompleted())
+	s.True(workflow.IsContinueAsNewError(s.env.GetWorkflowError()))
+}
+
+func (s *workflowSuite) TestDependencyFailed() {
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(SignalNameDependencyResult, &schedspb.DependencyResult{
+			ScheduleId:  "upstream",
+			NominalTime: timestamp.TimePtr(time.Date(2022, 6, 1, 1, 0, 0, 0, time.UTC)),
+			Status:      enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
+		})
+	}, 70*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		desc := s.describe()
+		s.Empty(desc.Info.RunningWorkflows)
+		s.False(desc.Schedule.State.Paused)
+	}, 71*time.Minute)
+
+	s.runWithDependency(&schedspb.ScheduleDependency{
+		ScheduleId:    "upstream",
+		Timeout:       timestamp.DurationPtr(30 * time.Minute),
+		TimeoutPolicy: enumsspb.SCHEDULE_DEPENDENCY_TIMEOUT_POLICY_START,
+	}, 3)
+	s.True(s.env.IsWorkflowCompleted())
+	s.True(workflow.IsContinueAsNewError(s.env.GetWorkflowError()))
+}
+
+func (s *workflowSuite) TestDependencyTimeoutSkip() {
+	s.env.RegisterDelayedCallback(func() {
+		s.Len(s.describe().Info.RunningWorkflows, 0)
+	}, 95*time.Minute)
+
+	s.runWithDependency(&schedspb.ScheduleDependency{
+		ScheduleId: "upstream",
+		Timeout:    timestamp.DurationPtr(30 * time.Minute),
+	}, 3)
+	s.True(s.env.IsWorkflowCompleted())
+	s.True(workflow.IsContinueAsNewError(s.env.GetWorkflowError()))
+}
+
+func (s *workflowSuite) TestDependencyTimeoutStart() {
+	s.expectStart(func(req *schedspb.StartWorkflowRequest) (*schedspb.StartWorkflowResponse, error) {
+		s.True(time.Date(2022, 6, 1, 1, 30, 0, 0, time.UTC).Equal(s.now()))
+		s.Equal("myid-2022-06-01T01:00:00Z", req.Request.WorkflowId)
+		return nil, nil
+	})
+
+	s.runWithDependency(&schedspb.ScheduleDependency{
+		ScheduleId:    "upstream",
+		Timeout:       timestamp.DurationPtr(30 * time.Minute),
+		TimeoutPolicy: enumsspb.SCHEDULE_DEPENDENCY_TIMEOUT_POLICY_START,
+	}, 3)
+	s.True(s.env.IsWorkflowCompleted())
+	s.True(workflow.IsContinueAsNewError(s.env.GetWorkflowError()))
+}
+
+func (s *workflowSuite) TestDependencyTimeoutFail() {
+	s.env.RegisterDelayedCallback(func() {
+		desc := s.describe()
+		s.True(desc.Schedule.State.Paused)
+		s.Contains(desc.Schedule.State.Notes, "paused due to dependency timeout")
+	}, 95*time.Minute)
+
+	s.runWithDependency(&schedspb.ScheduleDependency{
+		ScheduleId:    "upstream",
+		Timeout:       timestamp.DurationPtr(30 * time.Minute),
+		TimeoutPolicy: enumsspb.SCHEDULE_DEPENDENCY_TIMEOUT_POLICY_FAIL,
+	}, 3)
+	s.True(s.env.IsWorkflowCompleted())
+	// doesn't end properly since it sleeps forever after pausing
+}
+
+func (s *workflowSuite) TestNotifyDependents() {
+	s.expectStart(func(req *schedspb.StartWorkflowRequest) (*schedspb.StartWorkflowResponse, error) {
+		s.True(time.Date(2022, 6, 1, 0, 5, 0, 0, time.UTC).Equal(s.now()))
+		return nil, nil
+	})
+	s.expectWatch(func(req *schedspb.WatchWorkflowRequest) (*schedspb.WatchWorkflowResponse, error) {
+		s.True(time.Date(2022, 6, 1, 0, 8, 0, 0, time.UTC).Equal(s.now()))
+		s.Equal("myid-2022-06-01T00:05:00Z", req.Execution.WorkflowId)
+		return &schedspb.WatchWorkflowResponse{Status: enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED}, nil
+	}).After(3 * time.Minute)
+	s.expectStart(func(req *schedspb.StartWorkflowRequest) (*schedspb.StartWorkflowResponse, error) {
+		s.True(time.Date(2022, 6, 1, 0, 10, 0, 0, time.UTC).Equal(s.now()))
+		return nil, nil
+	})
+	matchResult := mock.MatchedBy(func(res *schedspb.DependencyResult) bool {
+		return res.ScheduleId == "myschedule" &&
+			res.Status == enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED &&
+			time.Date(2022, 6, 1, 0, 5, 0, 0, time.UTC).Equal(timestamp.TimeValue(res.NominalTime))
+	})
+	s.env.OnSignalExternalWorkflow(mock.Anything, WorkflowIDPrefix+"downstream", "", SignalNameDependencyResult, matchResult).Once().Return(nil)
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(SignalNameRegisterDependent, "downstream")
+	}, 1*time.Minute)
+
+	s.run(&schedpb.Schedule{
+		Spec: &schedpb.ScheduleSpec{
+			Interval: []*schedpb.IntervalSpec{{
+				Interval: timestamp.DurationPtr(5 * time.Minute),
+			}},
+		},
+	}, 5)
+	s.True(s.env.IsWorkflowCompleted())
+	s.True(workflow.IsContinueAsNewError(s.env.GetWorkflowError()))
+}
+
+func (s *workflowSuite) TestDependencyChangeUpstream() {
+	s.env.OnSignalExternalWorkflow(mock.Anything, WorkflowIDPrefix+"upstream", "", SignalNameUnregisterDependent, "myschedule").Once().Return(nil)
+	s.env.OnSignalExternalWorkflow(mock.Anything, WorkflowIDPrefix+"other", "", SignalNameRegisterDependent, "myschedule").Once().Return(nil)
+	s.env.RegisterDelayedCallback(func() {
+		desc := s.describe()
+		s.env.SignalWorkflow(SignalNameUpdate, &schedspb.FullUpdateRequest{
+			ConflictToken: desc.ConflictToken,
+			Schedule:      desc.Schedule,
+			Dependency: &schedspb.ScheduleDependency{
+				ScheduleId: "other",
+				Timeout:    timestamp.DurationPtr(30 * time.Minute),
+			},
+		})
+	}, 5*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		s.Equal("other", s.describe().Dependency.GetScheduleId())
+	}, 6*time.Minute)
+
+	s.runWithDependency(&schedspb.ScheduleDependency{
+		ScheduleId: "upstream",
+		Timeout:    timestamp.DurationPtr(30 * time.Minute),
+	}, 2)
+	s.True(s.env.IsWorkflowCompleted())
+}
+
+func (s *workflowSuite) TestUnregisterDependent() {
+	s.expectStart(func(req *schedspb.StartWorkflowRequest) (*schedspb.StartWorkflowResponse, error) {
+		s.True(time.Date(2022, 6, 1, 0, 5, 0, 0, time.UTC).Equal(s.now()))
+		return nil, nil
+	})
+	s.expectWatch(func(req *schedspb.WatchWorkflowRequest) (*schedspb.WatchWorkflowResponse, error) {
+		return &schedspb.WatchWorkflowResponse{Status: enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED}, nil
+	}).After(3 * time.Minute)
+	s.expectStart(func(req *schedspb.StartWorkflowRequest) (*schedspb.StartWorkflowResponse, error) {
+		s.True(time.Date(2022, 6, 1, 0, 10, 0, 0, time.UTC).Equal(s.now()))
+		return nil, nil
+	})
+	// only the dependent that is still registered gets the result
+	s.env.OnSignalExternalWorkflow(mock.Anything, WorkflowIDPrefix+"downstream2", "", SignalNameDependencyResult, mock.Anything).Once().Return(nil)
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(SignalNameRegisterDependent, "downstream1")
+		s.env.SignalWorkflow(SignalNameRegisterDependent, "downstream2")
+	}, 1*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(SignalNameUnregisterDependent, "downstream1")
+	}, 2*time.Minute)
+
+	s.run(&schedpb.Schedule{
+		Spec: &schedpb.ScheduleSpec{
+			Interval: []*schedpb.IntervalSpec{{
+				Interval: timestamp.DurationPtr(5 * time.Minute),
+			}},
+		},
+	}, 7)
+	s.True(s.env.IsWorkflowCompleted())
+	s.True(workflow.IsContinueAsNewError(s.env.GetWorkflowError()))
+}
+
+func (s *workflowSuite) TestNotifyDependentsWithoutBuffer() {
+	// Nothing is buffered while the upstream workflow runs, but the schedule still has to
+	// watch it to tell the dependent schedule when it closes.
+	var result *schedspb.DependencyResult
+	s.expectStart(func(req *schedspb.StartWorkflowRequest) (*schedspb.StartWorkflowResponse, error) {
+		s.True(time.Date(2022, 6, 1, 1, 0, 0, 0, time.UTC).Equal(s.now()))
+		return nil, nil
+	})
+	s.expectWatch(func(req *schedspb.WatchWorkflowRequest) (*schedspb.WatchWorkflowResponse, error) {
+		s.True(req.LongPoll)
+		s.Equal("myid-2022-06-01T01:00:00Z", req.Execution.WorkflowId)
+		return &schedspb.WatchWorkflowResponse{Status: enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED}, nil
+	}).After(20 * time.Minute)
+	s.env.OnSignalExternalWorkflow(mock.Anything, WorkflowIDPrefix+"downstream", "", SignalNameDependencyResult, mock.Anything).Once().Return(
+		func(_, _, _ string, _ string, arg interface{}) error {
+			s.True(time.Date(2022, 6, 1, 1, 20, 0, 0, time.UTC).Equal(s.now()))
+			result = arg.(*schedspb.DependencyResult)
+			return nil
+		})
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(SignalNameRegisterDependent, "downstream")
+	}, 1*time.Minute)
+
+	s.run(&schedpb.Schedule{
+		Spec: &schedpb.ScheduleSpec{
+			Interval: []*schedpb.IntervalSpec{{
+				Interval: timestamp.DurationPtr(1 * time.Hour),
+			}},
+		},
+	}, 4)
+	s.True(s.env.IsWorkflowCompleted())
+	s.True(workflow.IsContinueAsNewError(s.env.GetWorkflowError()))
+	s.Equal("myschedule", result.GetScheduleId())
+	s.Equal(enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, result.GetStatus())
+
+	// Feed the result that the upstream sent into a schedule that depends on it.
+	s.env = s.NewTestWorkflowEnvironment()
+	s.expectStart(func(req *schedspb.StartWorkflowRequest) (*schedspb.StartWorkflowResponse, error) {
+		s.True(time.Date(2022, 6, 1, 1, 20, 0, 0, time.UTC).Equal(s.now()))
+		s.Equal("myid-2022-06-01T01:00:00Z", req.Request.WorkflowId)
+		return nil, nil
+	})
+	s.env.RegisterDelayedCallback(func() {
+		result.ScheduleId = "upstream"
+		s.env.SignalWorkflow(SignalNameDependencyResult, result)
+	}, 80*time.Minute)
+
+	s.runWithDependency(&schedspb.ScheduleDependency{
+		ScheduleId: "upstream",
+		Timeout:    timestamp.DurationPtr(30 * time.Minute),
+	}, 4)
+	s.True(s.env.IsWorkflowCompleted())
+	s.True(workflow.IsContinueAsNewError(s.env.GetWorkflowError()))
+}
+
+func (s *workflowSuite) TestDependencyUpdate() {
+	s.env.OnSignalExternalWorkflow(mock.Anything, WorkflowIDPrefix+"upstream", "", SignalNameUnregisterDependent, "myschedule").Once().Return(nil)
+	s.env.RegisterDelayedCallback(func() {
+		desc := s.describe()
+		s.Equal("upstream", desc.Dependency.GetScheduleId())
+		// remove the dependency: the waiting start goes right away
+		s.env.SignalWorkflow(SignalNameUpdate, &schedspb.FullUpdateRequest{
+			ConflictToken: desc.ConflictToken,
+			Schedule:      desc.Schedule,
+		})
+	}, 65*time.Minute)
+	s.expectStart(func(req *schedspb.StartWorkflowRequest) (*schedspb.StartWorkflowResponse, error) {
+		s.True(time.Date(2022, 6, 1, 1, 5, 0, 0, time.UTC).Equal(s.now()))
+		s.Equal("myid-2022-06-01T01:00:00Z", req.Request.WorkflowId)
+		return nil, nil
+	})
+	s.env.RegisterDelayedCallback(func() {
+		s.Nil(s.describe().Dependency)
+	}, 66*time.Minute)
+
+	s.runWithDependency(&schedspb.ScheduleDependency{
+		ScheduleId: "upstream",
+		Timeout:    timestamp.DurationPtr(30 * time.Minute),
+	}, 3)
+	s.True(s.env.IsWorkflowCompleted())
+	s.True(workflow.IsContinueAsNewError(s.env.GetWorkflowError()))
+}
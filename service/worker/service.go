@@ -93,6 +93,8 @@ type (
 		esClient         esclient.Client
 		config           *Config
 
+		namespaceIndexManager *esclient.NamespaceIndexManager
+
 		workerManager             *workerManager
 		perNamespaceWorkerManager *perNamespaceWorkerManager
 		scanner                   *scanner.Scanner
@@ -122,6 +124,7 @@ type (
 		EnableReadVisibilityFromES                dynamicconfig.BoolPropertyFnWithNamespaceFilter
 		EnableReadFromSecondaryAdvancedVisibility dynamicconfig.BoolPropertyFnWithNamespaceFilter
 		VisibilityDisableOrderByClause            dynamicconfig.BoolPropertyFn
+		EnablePerNamespaceVisibilityIndex         dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	}
 )
 
@@ -147,6 +150,7 @@ func NewService(
 	workerManager *workerManager,
 	perNamespaceWorkerManager *perNamespaceWorkerManager,
 	visibilityManager manager.VisibilityManager,
+	namespaceIndexManager *esclient.NamespaceIndexManager,
 ) (*Service, error) {
 	workerServiceResolver, err := membershipMonitor.GetResolver(primitives.WorkerService)
 	if err != nil {
@@ -176,6 +180,7 @@ func NewService(
 		taskManager:               taskManager,
 		historyClient:             historyClient,
 		visibilityManager:         visibilityManager,
+		namespaceIndexManager:     namespaceIndexManager,
 
 		workerManager:             workerManager,
 		perNamespaceWorkerManager: perNamespaceWorkerManager,
@@ -302,6 +307,34 @@ func NewConfig(dc *dynamicconfig.Collection, persistenceConfig *config.Persisten
 				dynamicconfig.ExecutionScannerWorkerCount,
 				8,
 			),
+			VisibilityScannerEnabled: dc.GetBoolProperty(
+				dynamicconfig.VisibilityScannerEnabled,
+				false,
+			),
+			VisibilityScannerPerHostQPS: dc.GetIntProperty(
+				dynamicconfig.VisibilityScannerPerHostQPS,
+				10,
+			),
+			VisibilityScannerPerShardQPS: dc.GetIntProperty(
+				dynamicconfig.VisibilityScannerPerShardQPS,
+				1,
+			),
+			VisibilityScannerWorkerCount: dc.GetIntProperty(
+				dynamicconfig.VisibilityScannerWorkerCount,
+				8,
+			),
+			VisibilityScannerSampleRate: dc.GetFloat64Property(
+				dynamicconfig.VisibilityScannerSampleRate,
+				1.0,
+			),
+			VisibilityScannerDataMinAge: dc.GetDurationProperty(
+				dynamicconfig.VisibilityScannerDataMinAge,
+				10*time.Minute,
+			),
+			VisibilityScannerRepairEnabled: dc.GetBoolProperty(
+				dynamicconfig.VisibilityScannerRepairEnabled,
+				false,
+			),
 		},
 		EnableBatcher:      dc.GetBoolProperty(dynamicconfig.EnableBatcher, true),
 		BatcherRPS:         dc.GetIntPropertyFilteredByNamespace(dynamicconfig.BatcherRPS, batcher.DefaultRPS),
@@ -346,6 +379,7 @@ func NewConfig(dc *dynamicconfig.Collection, persistenceConfig *config.Persisten
 		EnableReadVisibilityFromES:                dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.EnableReadVisibilityFromES, enableReadFromES),
 		EnableReadFromSecondaryAdvancedVisibility: dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.EnableReadFromSecondaryAdvancedVisibility, false),
 		VisibilityDisableOrderByClause:            dc.GetBoolProperty(dynamicconfig.VisibilityDisableOrderByClause, false),
+		EnablePerNamespaceVisibilityIndex:         dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.EnablePerNamespaceVisibilityIndex, false),
 	}
 	return config
 }
@@ -489,6 +523,8 @@ func (s *Service) initScanner() error {
 		s.metricsHandler,
 		s.executionManager,
 		s.taskManager,
+		s.metadataManager,
+		s.visibilityManager,
 		s.historyClient,
 		adminClient,
 		s.namespaceRegistry,
@@ -509,6 +545,7 @@ func (s *Service) startReplicator() {
 	namespaceReplicationTaskExecutor := namespace.NewReplicationTaskExecutor(
 		s.clusterMetadata.GetCurrentClusterName(),
 		s.metadataManager,
+		s.namespaceIndexManager,
 		s.logger,
 	)
 	msgReplicator := replicator.NewReplicator(
@@ -623,6 +623,8 @@ func ApplyClusterMetadataConfigProvider(
 		persistenceServiceResolver,
 		&config.Persistence,
 		customDataStoreFactory,
+		dynamicconfig.NewNoopCollection(),
+		nil,
 		logger,
 		nil,
 	)
@@ -195,6 +195,8 @@ func initSystemNamespaces(
 		persistenceServiceResolver,
 		cfg,
 		customDataStoreFactory,
+		dynamicconfig.NewNoopCollection(),
+		nil,
 		logger,
 		nil,
 	)
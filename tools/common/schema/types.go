@@ -93,6 +93,8 @@ const (
 	CLIOptPluginName = "plugin"
 	// CLIOptConnectAttributes is the cli option for connect attributes (key/values via a url query string)
 	CLIOptConnectAttributes = "connect-attributes"
+	// CLIOptShardDatabases is the cli option for the additional databases that history shards are spread over
+	CLIOptShardDatabases = "shard-databases"
 	// CLIOptVersion is the cli option for version
 	CLIOptVersion = "version"
 	// CLIOptSchemaFile is the cli option for schema file
@@ -138,6 +140,8 @@ const (
 	CLIFlagPluginName = CLIOptPluginName + ", pl"
 	// CLIFlagConnectAttributes allows arbitrary connect attributes
 	CLIFlagConnectAttributes = CLIOptConnectAttributes + ", ca"
+	// CLIFlagShardDatabases is the cli flag for the additional databases that history shards are spread over
+	CLIFlagShardDatabases = CLIOptShardDatabases + ", sd"
 	// CLIFlagVersion is the cli flag for version
 	CLIFlagVersion = CLIOptVersion + ", v"
 	// CLIFlagSchemaFile is the cli flag for schema file
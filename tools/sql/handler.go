@@ -28,6 +28,7 @@ import (
 	"fmt"
 	"net"
 	"net/url"
+	"strings"
 
 	"github.com/urfave/cli"
 
@@ -42,20 +43,23 @@ import (
 // using the given command line arguments
 // as input
 func setupSchema(cli *cli.Context, logger log.Logger) error {
-	cfg, err := parseConnectConfig(cli)
+	cfgs, err := parseConnectConfigs(cli)
 	if err != nil {
 		logger.Error("Unable to read config.", tag.Error(schema.NewConfigError(err.Error())))
 		return err
 	}
-	conn, err := NewConnection(cfg)
-	if err != nil {
-		logger.Error("Unable to connect to SQL database.", tag.Error(err))
-		return err
-	}
-	defer conn.Close()
-	if err := schema.Setup(cli, conn, logger); err != nil {
-		logger.Error("Unable to setup SQL schema.", tag.Error(err))
-		return err
+	for _, cfg := range cfgs {
+		conn, err := NewConnection(cfg)
+		if err != nil {
+			logger.Error("Unable to connect to SQL database.", tag.Error(err), tag.Address(cfg.ConnectAddr))
+			return err
+		}
+		err = schema.Setup(cli, conn, logger)
+		conn.Close()
+		if err != nil {
+			logger.Error("Unable to setup SQL schema.", tag.Error(err), tag.Address(cfg.ConnectAddr))
+			return err
+		}
 	}
 	return nil
 }
@@ -63,36 +67,41 @@ func setupSchema(cli *cli.Context, logger log.Logger) error {
 // updateSchema executes the updateSchemaTask
 // using the given command lien args as input
 func updateSchema(cli *cli.Context, logger log.Logger) error {
-	cfg, err := parseConnectConfig(cli)
+	cfgs, err := parseConnectConfigs(cli)
 	if err != nil {
 		logger.Error("Unable to read config.", tag.Error(schema.NewConfigError(err.Error())))
 		return err
 	}
-	conn, err := NewConnection(cfg)
-	if err != nil {
-		logger.Error("Unable to connect to SQL database.", tag.Error(err))
-		return err
-	}
-	defer conn.Close()
-	if err := schema.Update(cli, conn, logger); err != nil {
-		logger.Error("Unable to update SQL schema.", tag.Error(err))
-		return err
+	for _, cfg := range cfgs {
+		conn, err := NewConnection(cfg)
+		if err != nil {
+			logger.Error("Unable to connect to SQL database.", tag.Error(err), tag.Address(cfg.ConnectAddr))
+			return err
+		}
+		err = schema.Update(cli, conn, logger)
+		conn.Close()
+		if err != nil {
+			logger.Error("Unable to update SQL schema.", tag.Error(err), tag.Address(cfg.ConnectAddr))
+			return err
+		}
 	}
 	return nil
 }
 
 // createDatabase creates a sql database
 func createDatabase(cli *cli.Context, logger log.Logger) error {
-	cfg, err := parseConnectConfig(cli)
+	cfgs, err := parseConnectConfigs(cli)
 	if err != nil {
 		logger.Error("Unable to read config.", tag.Error(schema.NewConfigError(err.Error())))
 		return err
 	}
 	defaultDb := cli.String(schema.CLIOptDefaultDb)
-	err = DoCreateDatabase(cfg, defaultDb)
-	if err != nil {
-		logger.Error("Unable to create SQL database.", tag.Error(err))
-		return err
+	for _, cfg := range cfgs {
+		err = DoCreateDatabase(cfg, defaultDb)
+		if err != nil {
+			logger.Error("Unable to create SQL database.", tag.Error(err), tag.Address(cfg.ConnectAddr))
+			return err
+		}
 	}
 	return nil
 }
@@ -110,16 +119,18 @@ func DoCreateDatabase(cfg *config.SQL, defaultDb string) error {
 
 // dropDatabase drops a sql database
 func dropDatabase(cli *cli.Context, logger log.Logger) error {
-	cfg, err := parseConnectConfig(cli)
+	cfgs, err := parseConnectConfigs(cli)
 	if err != nil {
 		logger.Error("Unable to read config.", tag.Error(schema.NewConfigError(err.Error())))
 		return err
 	}
 	defaultDb := cli.String(schema.CLIOptDefaultDb)
-	err = DoDropDatabase(cfg, defaultDb)
-	if err != nil {
-		logger.Error("Unable to drop SQL database.", tag.Error(err))
-		return err
+	for _, cfg := range cfgs {
+		err = DoDropDatabase(cfg, defaultDb)
+		if err != nil {
+			logger.Error("Unable to drop SQL database.", tag.Error(err), tag.Address(cfg.ConnectAddr))
+			return err
+		}
 	}
 	return nil
 }
@@ -139,6 +150,39 @@ func DoDropDatabase(cfg *config.SQL, defaultDb string) error {
 	return nil
 }
 
+// parseConnectConfigs returns the connect config of the database given by the
+// command line arguments, followed by the configs of its shard databases
+func parseConnectConfigs(cli *cli.Context) ([]*config.SQL, error) {
+	cfg, err := parseConnectConfig(cli)
+	if err != nil {
+		return nil, err
+	}
+	cfgs := []*config.SQL{cfg}
+	for _, shardDatabase := range cli.GlobalStringSlice(schema.CLIOptShardDatabases) {
+		shardCfg, err := parseShardDatabase(cfg, shardDatabase)
+		if err != nil {
+			return nil, err
+		}
+		cfgs = append(cfgs, shardCfg)
+	}
+	return cfgs, nil
+}
+
+// parseShardDatabase returns the connect config of a shard database given as host:port
+// or host:port/database. All other settings, and the database name if it is not given,
+// are taken from cfg.
+func parseShardDatabase(cfg *config.SQL, shardDatabase string) (*config.SQL, error) {
+	addr, databaseName, _ := strings.Cut(strings.TrimSpace(shardDatabase), "/")
+	shardCfg := cfg.ShardDatabase(config.SQLShardDatabase{
+		ConnectAddr:  addr,
+		DatabaseName: databaseName,
+	})
+	if err := ValidateConnectConfig(shardCfg); err != nil {
+		return nil, err
+	}
+	return shardCfg, nil
+}
+
 func parseConnectConfig(cli *cli.Context) (*config.SQL, error) {
 	cfg := new(config.SQL)
 
@@ -107,6 +107,11 @@ func BuildCLIOptions() *cli.App {
 			Usage:  "sql connect attributes",
 			EnvVar: "SQL_CONNECT_ATTRIBUTES",
 		},
+		cli.StringSliceFlag{
+			Name:   schema.CLIFlagShardDatabases,
+			Usage:  "additional databases that history shards are spread over, as host:port or host:port/database; commands are applied to each of them too",
+			EnvVar: "SQL_SHARD_DATABASES",
+		},
 		cli.BoolFlag{
 			Name:   schema.CLIFlagEnableTLS,
 			Usage:  "enable TLS over sql connection",
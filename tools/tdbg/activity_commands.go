@@ -0,0 +1,216 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+	commonpb "go.temporal.io/api/common/v1"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	workflowspb "go.temporal.io/server/api/workflow/v1"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+// AdminPauseActivity pauses a pending activity
+func AdminPauseActivity(c *cli.Context) error {
+	nsName, execution, activityID, err := getActivityExecution(c)
+	if err != nil {
+		return err
+	}
+
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err = adminClient.PauseActivityExecution(ctx, &adminservice.PauseActivityExecutionRequest{
+		Namespace:  nsName,
+		Execution:  execution,
+		ActivityId: activityID,
+		Reason:     c.String(FlagReason),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to pause activity: %s", err)
+	}
+	fmt.Println("Activity paused.")
+	return nil
+}
+
+// AdminUnpauseActivity unpauses a paused activity
+func AdminUnpauseActivity(c *cli.Context) error {
+	nsName, execution, activityID, err := getActivityExecution(c)
+	if err != nil {
+		return err
+	}
+
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err = adminClient.UnpauseActivityExecution(ctx, &adminservice.UnpauseActivityExecutionRequest{
+		Namespace:  nsName,
+		Execution:  execution,
+		ActivityId: activityID,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to unpause activity: %s", err)
+	}
+	fmt.Println("Activity unpaused.")
+	return nil
+}
+
+// AdminResetActivity resets the attempt count of a pending activity
+func AdminResetActivity(c *cli.Context) error {
+	nsName, execution, activityID, err := getActivityExecution(c)
+	if err != nil {
+		return err
+	}
+
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err = adminClient.ResetActivityExecution(ctx, &adminservice.ResetActivityExecutionRequest{
+		Namespace:  nsName,
+		Execution:  execution,
+		ActivityId: activityID,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to reset activity: %s", err)
+	}
+	fmt.Println("Activity attempt count reset.")
+	return nil
+}
+
+// AdminUpdateActivityOptions updates the retry policy and timeouts of a pending activity
+func AdminUpdateActivityOptions(c *cli.Context) error {
+	nsName, execution, activityID, err := getActivityExecution(c)
+	if err != nil {
+		return err
+	}
+
+	options := &workflowspb.ActivityOptions{}
+	if c.IsSet(FlagScheduleToCloseTimeout) {
+		options.ScheduleToCloseTimeout = timestamp.DurationPtr(c.Duration(FlagScheduleToCloseTimeout))
+	}
+	if c.IsSet(FlagScheduleToStartTimeout) {
+		options.ScheduleToStartTimeout = timestamp.DurationPtr(c.Duration(FlagScheduleToStartTimeout))
+	}
+	if c.IsSet(FlagStartToCloseTimeout) {
+		options.StartToCloseTimeout = timestamp.DurationPtr(c.Duration(FlagStartToCloseTimeout))
+	}
+	if c.IsSet(FlagHeartbeatTimeout) {
+		options.HeartbeatTimeout = timestamp.DurationPtr(c.Duration(FlagHeartbeatTimeout))
+	}
+	if c.IsSet(FlagRetryInitialInterval) ||
+		c.IsSet(FlagRetryMaximumInterval) ||
+		c.IsSet(FlagRetryBackoffCoefficient) ||
+		c.IsSet(FlagRetryMaximumAttempts) ||
+		c.IsSet(FlagRetryNonRetryableErrorTypes) {
+		// the server replaces the retry policy as a whole, so start from the current one
+		retryPolicy, err := getActivityRetryPolicy(c, activityID)
+		if err != nil {
+			return err
+		}
+		if c.IsSet(FlagRetryInitialInterval) {
+			retryPolicy.InitialInterval = timestamp.DurationPtr(c.Duration(FlagRetryInitialInterval))
+		}
+		if c.IsSet(FlagRetryMaximumInterval) {
+			retryPolicy.MaximumInterval = timestamp.DurationPtr(c.Duration(FlagRetryMaximumInterval))
+		}
+		if c.IsSet(FlagRetryBackoffCoefficient) {
+			retryPolicy.BackoffCoefficient = c.Float64(FlagRetryBackoffCoefficient)
+		}
+		if c.IsSet(FlagRetryMaximumAttempts) {
+			retryPolicy.MaximumAttempts = int32(c.Int(FlagRetryMaximumAttempts))
+		}
+		if c.IsSet(FlagRetryNonRetryableErrorTypes) {
+			retryPolicy.NonRetryableErrorTypes = c.StringSlice(FlagRetryNonRetryableErrorTypes)
+		}
+		options.RetryPolicy = retryPolicy
+	}
+
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err = adminClient.UpdateActivityExecutionOptions(ctx, &adminservice.UpdateActivityExecutionOptionsRequest{
+		Namespace:  nsName,
+		Execution:  execution,
+		ActivityId: activityID,
+		Options:    options,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to update activity options: %s", err)
+	}
+	fmt.Println("Activity options updated.")
+	return nil
+}
+
+func getActivityExecution(c *cli.Context) (string, *commonpb.WorkflowExecution, string, error) {
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return "", nil, "", err
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return "", nil, "", err
+	}
+	activityID, err := getRequiredOption(c, FlagActivityID)
+	if err != nil {
+		return "", nil, "", err
+	}
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: wid,
+		RunId:      c.String(FlagRunID),
+	}
+	return nsName, execution, activityID, nil
+}
+
+// getActivityRetryPolicy returns the current retry policy of a pending activity. Fields of an
+// activity without a retry policy are left unset and take the namespace defaults.
+func getActivityRetryPolicy(c *cli.Context, activityID string) (*commonpb.RetryPolicy, error) {
+	resp, err := describeMutableState(c)
+	if err != nil {
+		return nil, err
+	}
+	for _, ai := range resp.GetDatabaseMutableState().GetActivityInfos() {
+		if ai.GetActivityId() != activityID {
+			continue
+		}
+		if !ai.GetHasRetryPolicy() {
+			return &commonpb.RetryPolicy{}, nil
+		}
+		return &commonpb.RetryPolicy{
+			InitialInterval:        ai.GetRetryInitialInterval(),
+			MaximumInterval:        ai.GetRetryMaximumInterval(),
+			BackoffCoefficient:     ai.GetRetryBackoffCoefficient(),
+			MaximumAttempts:        ai.GetRetryMaximumAttempts(),
+			NonRetryableErrorTypes: ai.GetRetryNonRetryableErrorTypes(),
+		}, nil
+	}
+	return nil, fmt.Errorf("activity %s is not pending", activityID)
+}
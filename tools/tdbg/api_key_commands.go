@@ -0,0 +1,136 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+// AdminCreateAPIKey creates an API key for a namespace
+func AdminCreateAPIKey(c *cli.Context) error {
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	request := &adminservice.CreateNamespaceApiKeyRequest{
+		Namespace: nsName,
+		Name:      c.String(FlagAPIKeyName),
+		Role:      c.String(FlagRole),
+	}
+	if c.IsSet(FlagTTL) {
+		request.Ttl = timestamp.DurationPtr(c.Duration(FlagTTL))
+	}
+
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.CreateNamespaceApiKey(ctx, request)
+	if err != nil {
+		return fmt.Errorf("unable to create API key: %s", err)
+	}
+	prettyPrintJSONObject(resp.ApiKey)
+	fmt.Println("Token, it cannot be retrieved later:")
+	fmt.Println(resp.Token)
+	return nil
+}
+
+// AdminListAPIKeys lists the API keys of a namespace
+func AdminListAPIKeys(c *cli.Context) error {
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.ListNamespaceApiKeys(ctx, &adminservice.ListNamespaceApiKeysRequest{
+		Namespace: nsName,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list API keys: %s", err)
+	}
+	prettyPrintJSONObject(resp)
+	return nil
+}
+
+// AdminRotateAPIKey replaces the secret of an API key
+func AdminRotateAPIKey(c *cli.Context) error {
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	request := &adminservice.RotateNamespaceApiKeyRequest{
+		Namespace: nsName,
+		KeyId:     c.String(FlagAPIKeyID),
+	}
+	if c.IsSet(FlagTTL) {
+		request.Ttl = timestamp.DurationPtr(c.Duration(FlagTTL))
+	}
+
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.RotateNamespaceApiKey(ctx, request)
+	if err != nil {
+		return fmt.Errorf("unable to rotate API key: %s", err)
+	}
+	prettyPrintJSONObject(resp.ApiKey)
+	fmt.Println("Token, it cannot be retrieved later:")
+	fmt.Println(resp.Token)
+	return nil
+}
+
+// AdminRevokeAPIKey revokes an API key
+func AdminRevokeAPIKey(c *cli.Context) error {
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err = adminClient.RevokeNamespaceApiKey(ctx, &adminservice.RevokeNamespaceApiKeyRequest{
+		Namespace: nsName,
+		KeyId:     c.String(FlagAPIKeyID),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to revoke API key: %s", err)
+	}
+	fmt.Println("API key revoked.")
+	return nil
+}
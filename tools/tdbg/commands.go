@@ -33,12 +33,14 @@ import (
 	"github.com/temporalio/tctl-kit/pkg/color"
 	"github.com/urfave/cli/v2"
 	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
 
 	"go.temporal.io/server/api/adminservice/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/history/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	workflowspb "go.temporal.io/server/api/workflow/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/codec"
 	"go.temporal.io/server/common/namespace"
@@ -46,6 +48,7 @@ import (
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/util"
 )
 
 // AdminShowWorkflow shows history
@@ -241,6 +244,105 @@ func AdminDeleteWorkflow(c *cli.Context) error {
 	return nil
 }
 
+// AdminResetWorkflows resets all workflow executions matching a visibility query to the reset point
+// chosen by the selector flags, or prints the reset points for a dry run. The matching executions
+// are collected with a dry run first, since resetting them changes what the query matches.
+func AdminResetWorkflows(c *cli.Context) error {
+	adminClient := cFactory.AdminClient(c)
+
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	selector, err := getResetPointSelector(c)
+	if err != nil {
+		return err
+	}
+	query := c.String(FlagQuery)
+	dryRun := c.Bool(FlagDryRun)
+	pageSize := c.Int(FlagPageSize)
+	if pageSize <= 0 {
+		return fmt.Errorf("--%s must be positive", FlagPageSize)
+	}
+
+	var executions []*commonpb.WorkflowExecution
+	var nextPageToken []byte
+	for {
+		ctx, cancel := newContext(c)
+		resp, err := adminClient.ResetWorkflowExecutions(ctx, &adminservice.ResetWorkflowExecutionsRequest{
+			Namespace:          namespace,
+			Query:              query,
+			ResetPointSelector: selector,
+			DryRun:             true,
+			MaximumPageSize:    int32(pageSize),
+			NextPageToken:      nextPageToken,
+		})
+		cancel()
+		if err != nil {
+			return fmt.Errorf("unable to resolve reset points: %s", err)
+		}
+		for _, result := range resp.Results {
+			prettyPrintJSONObject(result)
+			if result.GetError() == "" {
+				executions = append(executions, result.GetExecution())
+			}
+		}
+		nextPageToken = resp.NextPageToken
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+	if dryRun || len(executions) == 0 {
+		return nil
+	}
+
+	msg := fmt.Sprintf("Namespace: %s Query: %s\nReset %d workflow executions[Yes/No]?", namespace, query, len(executions))
+	prompt(msg, c.Bool(FlagYes))
+	for len(executions) > 0 {
+		batch := executions[:util.Min(pageSize, len(executions))]
+		executions = executions[len(batch):]
+		ctx, cancel := newContext(c)
+		resp, err := adminClient.ResetWorkflowExecutions(ctx, &adminservice.ResetWorkflowExecutionsRequest{
+			Namespace:          namespace,
+			Executions:         batch,
+			ResetPointSelector: selector,
+			Reason:             c.String(FlagReason),
+			ResetReapplyType:   enumspb.RESET_REAPPLY_TYPE_SIGNAL,
+		})
+		cancel()
+		if err != nil {
+			return fmt.Errorf("unable to reset workflow executions: %s", err)
+		}
+		for _, result := range resp.Results {
+			prettyPrintJSONObject(result)
+		}
+	}
+	return nil
+}
+
+func getResetPointSelector(c *cli.Context) (*workflowspb.ResetPointSelector, error) {
+	var selectors []*workflowspb.ResetPointSelector
+	if c.IsSet(FlagBuildID) {
+		selectors = append(selectors, &workflowspb.ResetPointSelector{
+			Selector: &workflowspb.ResetPointSelector_BuildId{BuildId: c.String(FlagBuildID)},
+		})
+	}
+	if c.IsSet(FlagBinaryChecksum) {
+		selectors = append(selectors, &workflowspb.ResetPointSelector{
+			Selector: &workflowspb.ResetPointSelector_BinaryChecksum{BinaryChecksum: c.String(FlagBinaryChecksum)},
+		})
+	}
+	if c.IsSet(FlagFailedActivityType) {
+		selectors = append(selectors, &workflowspb.ResetPointSelector{
+			Selector: &workflowspb.ResetPointSelector_FailedActivityType{FailedActivityType: c.String(FlagFailedActivityType)},
+		})
+	}
+	if len(selectors) != 1 {
+		return nil, fmt.Errorf("exactly one of --%s, --%s or --%s is required", FlagBuildID, FlagBinaryChecksum, FlagFailedActivityType)
+	}
+	return selectors[0], nil
+}
+
 // AdminGetShardID get shardID
 func AdminGetShardID(c *cli.Context) error {
 	namespaceID := c.String(FlagNamespaceID)
@@ -541,6 +643,69 @@ func AdminRefreshWorkflowTasks(c *cli.Context) error {
 	return nil
 }
 
+// AdminPauseWorkflow pauses a workflow execution
+func AdminPauseWorkflow(c *cli.Context) error {
+	adminClient := cFactory.AdminClient(c)
+
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return err
+	}
+	rid := c.String(FlagRunID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err = adminClient.PauseWorkflowExecution(ctx, &adminservice.PauseWorkflowExecutionRequest{
+		Namespace: nsName,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+		Reason: c.String(FlagReason),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to pause workflow execution: %s", err)
+	}
+	fmt.Println("Workflow execution paused.")
+	return nil
+}
+
+// AdminUnpauseWorkflow unpauses a workflow execution
+func AdminUnpauseWorkflow(c *cli.Context) error {
+	adminClient := cFactory.AdminClient(c)
+
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return err
+	}
+	rid := c.String(FlagRunID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err = adminClient.UnpauseWorkflowExecution(ctx, &adminservice.UnpauseWorkflowExecutionRequest{
+		Namespace: nsName,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("unable to unpause workflow execution: %s", err)
+	}
+	fmt.Println("Workflow execution unpaused.")
+	return nil
+}
+
 // AdminRebuildMutableState rebuild a workflow mutable state using persisted history events
 func AdminRebuildMutableState(c *cli.Context) error {
 	adminClient := cFactory.AdminClient(c)
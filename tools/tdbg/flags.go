@@ -26,56 +26,88 @@ package tdbg
 
 // Flags used to specify cli command line arguments
 var (
-	FlagAddress                    = "address"
-	FlagHistoryAddress             = "history-address"
-	FlagNamespaceID                = "namespace-id"
-	FlagNamespace                  = "namespace"
-	FlagNamespaceAlias             = []string{"n"}
-	FlagShardID                    = "shard-id"
-	FlagWorkflowID                 = "workflow-id"
-	FlagWorkflowIDAlias            = []string{"wid"}
-	FlagRunID                      = "run-id"
-	FlagRunIDAlias                 = []string{"rid"}
-	FlagNumberOfShards             = "number-of-shards"
-	FlagMinEventID                 = "min-event-id"
-	FlagMaxEventID                 = "max-event-id"
-	FlagTaskQueue                  = "task-queue"
-	FlagTaskQueueType              = "task-queue-type"
-	FlagContextTimeout             = "context-timeout"
-	FlagContextTimeoutAlias        = []string{"ct"}
-	FlagCluster                    = "cluster"
-	FlagPageSize                   = "pagesize"
-	FlagFrom                       = "from"
-	FlagPrintFullyDetail           = "print-full"
-	FlagPrintJSON                  = "print-json"
-	FlagHeartbeatedWithin          = "heartbeated-within"
-	FlagOutputFilename             = "output-filename"
-	FlagClusterMembershipRole      = "role"
-	FlagSkipErrorMode              = "skip-errors"
-	FlagTaskID                     = "task-id"
-	FlagTaskType                   = "task-type"
-	FlagTaskVisibilityTimestamp    = "task-timestamp"
-	FlagMinVisibilityTimestamp     = "min-visibility-ts"
-	FlagMaxVisibilityTimestamp     = "max-visibility-ts"
-	FlagEnableTLS                  = "tls"
-	FlagTLSCertPath                = "tls-cert-path"
-	FlagTLSKeyPath                 = "tls-key-path"
-	FlagTLSCaPath                  = "tls-ca-path"
-	FlagTLSDisableHostVerification = "tls-disable-host-verification"
-	FlagTLSServerName              = "tls-server-name"
-	FlagLastMessageID              = "last-message-id"
-	FlagYes                        = "yes"
-	FlagMore                       = "more"
-	FlagMinEventVersion            = "min-event-version"
-	FlagMaxEventVersion            = "max-event-version"
-	FlagMinTaskID                  = "min-task-id"
-	FlagMaxTaskID                  = "max-task-id"
-	FlagDLQType                    = "dlq-type"
-	FlagMaxMessageCount            = "max-message-count"
-	FlagProtoType                  = "type"
-	FlagHexData                    = "hex-data"
-	FlagHexFile                    = "hex-file"
-	FlagBinaryFile                 = "binary-file"
-	FlagBase64Data                 = "base64-data"
-	FlagBase64File                 = "base64-file"
+	FlagAddress                     = "address"
+	FlagHistoryAddress              = "history-address"
+	FlagNamespaceID                 = "namespace-id"
+	FlagNamespace                   = "namespace"
+	FlagNamespaceAlias              = []string{"n"}
+	FlagShardID                     = "shard-id"
+	FlagWorkflowID                  = "workflow-id"
+	FlagWorkflowIDAlias             = []string{"wid"}
+	FlagRunID                       = "run-id"
+	FlagRunIDAlias                  = []string{"rid"}
+	FlagNumberOfShards              = "number-of-shards"
+	FlagMinEventID                  = "min-event-id"
+	FlagMaxEventID                  = "max-event-id"
+	FlagTaskQueue                   = "task-queue"
+	FlagTaskQueueType               = "task-queue-type"
+	FlagContextTimeout              = "context-timeout"
+	FlagContextTimeoutAlias         = []string{"ct"}
+	FlagCluster                     = "cluster"
+	FlagPageSize                    = "pagesize"
+	FlagFrom                        = "from"
+	FlagPrintFullyDetail            = "print-full"
+	FlagPrintJSON                   = "print-json"
+	FlagHeartbeatedWithin           = "heartbeated-within"
+	FlagOutputFilename              = "output-filename"
+	FlagClusterMembershipRole       = "role"
+	FlagSkipErrorMode               = "skip-errors"
+	FlagTaskID                      = "task-id"
+	FlagTaskType                    = "task-type"
+	FlagTaskVisibilityTimestamp     = "task-timestamp"
+	FlagMinVisibilityTimestamp      = "min-visibility-ts"
+	FlagMaxVisibilityTimestamp      = "max-visibility-ts"
+	FlagEnableTLS                   = "tls"
+	FlagTLSCertPath                 = "tls-cert-path"
+	FlagTLSKeyPath                  = "tls-key-path"
+	FlagTLSCaPath                   = "tls-ca-path"
+	FlagTLSDisableHostVerification  = "tls-disable-host-verification"
+	FlagTLSServerName               = "tls-server-name"
+	FlagLastMessageID               = "last-message-id"
+	FlagYes                         = "yes"
+	FlagMore                        = "more"
+	FlagMinEventVersion             = "min-event-version"
+	FlagMaxEventVersion             = "max-event-version"
+	FlagMinTaskID                   = "min-task-id"
+	FlagMaxTaskID                   = "max-task-id"
+	FlagDLQType                     = "dlq-type"
+	FlagMaxMessageCount             = "max-message-count"
+	FlagProtoType                   = "type"
+	FlagHexData                     = "hex-data"
+	FlagHexFile                     = "hex-file"
+	FlagBinaryFile                  = "binary-file"
+	FlagBase64Data                  = "base64-data"
+	FlagBase64File                  = "base64-file"
+	FlagQuery                       = "query"
+	FlagQueryAlias                  = []string{"q"}
+	FlagBuildID                     = "build-id"
+	FlagBinaryChecksum              = "binary-checksum"
+	FlagFailedActivityType          = "failed-activity-type"
+	FlagDryRun                      = "dry-run"
+	FlagReason                      = "reason"
+	FlagActivityID                  = "activity-id"
+	FlagActivityIDAlias             = []string{"aid"}
+	FlagScheduleToCloseTimeout      = "schedule-to-close-timeout"
+	FlagScheduleToStartTimeout      = "schedule-to-start-timeout"
+	FlagStartToCloseTimeout         = "start-to-close-timeout"
+	FlagHeartbeatTimeout            = "heartbeat-timeout"
+	FlagRetryInitialInterval        = "retry-initial-interval"
+	FlagRetryMaximumInterval        = "retry-maximum-interval"
+	FlagRetryBackoffCoefficient     = "retry-backoff-coefficient"
+	FlagRetryMaximumAttempts        = "retry-maximum-attempts"
+	FlagRetryNonRetryableErrorTypes = "retry-non-retryable-error-types"
+	FlagAPIKeyName                  = "name"
+	FlagAPIKeyID                    = "key-id"
+	FlagRole                        = "role"
+	FlagTTL                         = "ttl"
+	FlagRemoteCluster               = "remote-cluster"
+	FlagShards                      = "shards"
+	FlagFaultID                     = "fault-id"
+	FlagService                     = "service"
+	FlagDataStore                   = "data-store"
+	FlagMethod                      = "method"
+	FlagTargetNamespace             = "target-namespace"
+	FlagErrorRate                   = "error-rate"
+	FlagErrorType                   = "error-type"
+	FlagLatency                     = "latency"
 )
@@ -0,0 +1,97 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+// AdminSetPersistenceFault adds or replaces a fault injected into persistence
+func AdminSetPersistenceFault(c *cli.Context) error {
+	fault := &persistencespb.PersistenceFault{
+		Id:        c.String(FlagFaultID),
+		Service:   c.String(FlagService),
+		DataStore: c.String(FlagDataStore),
+		Method:    c.String(FlagMethod),
+		Namespace: c.String(FlagTargetNamespace),
+		ShardId:   int32(c.Int(FlagShardID)),
+		ErrorRate: c.Float64(FlagErrorRate),
+		ErrorType: c.String(FlagErrorType),
+	}
+	if c.IsSet(FlagLatency) {
+		fault.Latency = timestamp.DurationPtr(c.Duration(FlagLatency))
+	}
+
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.SetPersistenceFault(ctx, &adminservice.SetPersistenceFaultRequest{
+		Fault: fault,
+		Ttl:   timestamp.DurationPtr(c.Duration(FlagTTL)),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to set persistence fault: %s", err)
+	}
+	prettyPrintJSONObject(resp.Fault)
+	return nil
+}
+
+// AdminRemovePersistenceFault removes a fault injected into persistence
+func AdminRemovePersistenceFault(c *cli.Context) error {
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err := adminClient.RemovePersistenceFault(ctx, &adminservice.RemovePersistenceFaultRequest{
+		Id: c.String(FlagFaultID),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to remove persistence fault: %s", err)
+	}
+	return nil
+}
+
+// AdminListPersistenceFaults lists the faults injected into persistence
+func AdminListPersistenceFaults(c *cli.Context) error {
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.DescribeCluster(ctx, &adminservice.DescribeClusterRequest{})
+	if err != nil {
+		return fmt.Errorf("unable to describe cluster: %s", err)
+	}
+	for _, fault := range resp.PersistenceFaults {
+		prettyPrintJSONObject(fault)
+	}
+	return nil
+}
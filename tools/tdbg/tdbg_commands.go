@@ -35,6 +35,12 @@ var commands = []*cli.Command{
 		Usage:       "Run admin operation on workflow",
 		Subcommands: newAdminWorkflowCommands(),
 	},
+	{
+		Name:        "activity",
+		Aliases:     []string{"a"},
+		Usage:       "Run admin operation on a pending activity",
+		Subcommands: newAdminActivityCommands(),
+	},
 	{
 		Name:        "shard",
 		Aliases:     []string{"s"},
@@ -64,6 +70,21 @@ var commands = []*cli.Command{
 		Usage:       "Run admin operation on DLQ",
 		Subcommands: newAdminDLQCommands(),
 	},
+	{
+		Name:        "api-key",
+		Usage:       "Run admin operation on API keys of a namespace",
+		Subcommands: newAdminAPIKeyCommands(),
+	},
+	{
+		Name:        "replication",
+		Usage:       "Run admin operation on cross cluster replication",
+		Subcommands: newAdminReplicationCommands(),
+	},
+	{
+		Name:        "persistence-fault",
+		Usage:       "Run admin operation on faults injected into persistence",
+		Subcommands: newAdminPersistenceFaultCommands(),
+	},
 	{
 		Name:        "decode",
 		Usage:       "Decode payload",
@@ -192,6 +213,394 @@ func newAdminWorkflowCommands() []*cli.Command {
 				return AdminDeleteWorkflow(c)
 			},
 		},
+		{
+			Name:  "reset-batch",
+			Usage: "Reset workflow executions matching a visibility query to a reset point chosen by build ID, binary checksum or failed activity type",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagQuery,
+					Aliases:  FlagQueryAlias,
+					Usage:    "Visibility query that selects the workflow executions to reset",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagBuildID,
+					Usage: "Reset to the first workflow task completed by this build ID",
+				},
+				&cli.StringFlag{
+					Name:  FlagBinaryChecksum,
+					Usage: "Reset to the first workflow task completed by this binary checksum",
+				},
+				&cli.StringFlag{
+					Name:  FlagFailedActivityType,
+					Usage: "Reset to the workflow task that scheduled the first failed activity of this type",
+				},
+				&cli.StringFlag{
+					Name:  FlagReason,
+					Usage: "Reason for the reset",
+				},
+				&cli.BoolFlag{
+					Name:  FlagDryRun,
+					Usage: "Only print the reset points, don't reset",
+				},
+				&cli.IntFlag{
+					Name:  FlagPageSize,
+					Usage: "Number of workflow executions to reset per request, at most the server's frontend.maxResetWorkflowExecutions",
+					Value: 20,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminResetWorkflows(c)
+			},
+		},
+		{
+			Name:  "pause",
+			Usage: "Pause a workflow execution: stop dispatching its workflow and activity tasks and hold its timers",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: FlagWorkflowIDAlias,
+					Usage:   "Workflow ID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: FlagRunIDAlias,
+					Usage:   "Run ID",
+				},
+				&cli.StringFlag{
+					Name:  FlagReason,
+					Usage: "Reason for pausing",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminPauseWorkflow(c)
+			},
+		},
+		{
+			Name:  "unpause",
+			Usage: "Unpause a paused workflow execution",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: FlagWorkflowIDAlias,
+					Usage:   "Workflow ID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: FlagRunIDAlias,
+					Usage:   "Run ID",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminUnpauseWorkflow(c)
+			},
+		},
+	}
+}
+
+func newAdminReplicationCommands() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "status",
+			Usage: "Show the replication lag, DLQ size and processor state with remote clusters",
+			Flags: []cli.Flag{
+				&cli.StringSliceFlag{
+					Name:  FlagRemoteCluster,
+					Usage: "Remote cluster to report on, all remote clusters if not set",
+				},
+				&cli.BoolFlag{
+					Name:  FlagShards,
+					Usage: "Show the status of every shard",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminGetReplicationStatus(c)
+			},
+		},
+	}
+}
+
+func newAdminAPIKeyCommands() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "create",
+			Usage: "Create an API key granting a role on the namespace, the token is only printed once",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagAPIKeyName,
+					Usage:    "Name of the API key",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     FlagRole,
+					Usage:    "Role granted on the namespace: read, write, worker or admin",
+					Required: true,
+				},
+				&cli.DurationFlag{
+					Name:  FlagTTL,
+					Usage: "Lifetime of the API key, the key does not expire if not set",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminCreateAPIKey(c)
+			},
+		},
+		{
+			Name:  "list",
+			Usage: "List the API keys of the namespace",
+			Action: func(c *cli.Context) error {
+				return AdminListAPIKeys(c)
+			},
+		},
+		{
+			Name:  "rotate",
+			Usage: "Replace the secret of an API key, the previous token stops working",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagAPIKeyID,
+					Usage:    "ID of the API key",
+					Required: true,
+				},
+				&cli.DurationFlag{
+					Name:  FlagTTL,
+					Usage: "New lifetime of the API key, the current expiration is kept if not set",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminRotateAPIKey(c)
+			},
+		},
+		{
+			Name:  "revoke",
+			Usage: "Revoke an API key",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagAPIKeyID,
+					Usage:    "ID of the API key",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminRevokeAPIKey(c)
+			},
+		},
+	}
+}
+
+func newAdminPersistenceFaultCommands() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "set",
+			Usage: "Inject errors or latency into the persistence calls matching all given filters until the ttl expires",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  FlagFaultID,
+					Usage: "ID of the fault to replace, a new fault is added if not set",
+				},
+				&cli.StringFlag{
+					Name:  FlagService,
+					Usage: "Service to inject the fault into, e.g. history",
+				},
+				&cli.StringFlag{
+					Name:  FlagDataStore,
+					Usage: "Data store to inject the fault into: ShardStore, TaskStore, MetadataStore, ExecutionStore or Queue",
+				},
+				&cli.StringFlag{
+					Name:  FlagMethod,
+					Usage: "Data store method to inject the fault into, e.g. UpdateWorkflowExecution",
+				},
+				&cli.StringFlag{
+					Name:  FlagTargetNamespace,
+					Usage: "Namespace whose calls get the fault",
+				},
+				&cli.IntFlag{
+					Name:  FlagShardID,
+					Usage: "Shard whose calls get the fault",
+				},
+				&cli.Float64Flag{
+					Name:  FlagErrorRate,
+					Usage: "Probability to fail a call, between 0 and 1",
+				},
+				&cli.StringFlag{
+					Name:  FlagErrorType,
+					Usage: "Error returned: UnavailableError, ResourceExhaustedError, TimeoutError, ShardOwnershipLostError or DeadlineExceededError, a random persistence error if not set",
+				},
+				&cli.DurationFlag{
+					Name:  FlagLatency,
+					Usage: "Delay added to every call",
+				},
+				&cli.DurationFlag{
+					Name:     FlagTTL,
+					Usage:    "Lifetime of the fault, at most 24h",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminSetPersistenceFault(c)
+			},
+		},
+		{
+			Name:  "remove",
+			Usage: "Remove a fault before it expires",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagFaultID,
+					Usage:    "ID of the fault",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminRemovePersistenceFault(c)
+			},
+		},
+		{
+			Name:  "list",
+			Usage: "List the faults that have not expired",
+			Action: func(c *cli.Context) error {
+				return AdminListPersistenceFaults(c)
+			},
+		},
+	}
+}
+
+func newAdminActivityCommands() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "pause",
+			Usage: "Pause a pending activity: stop dispatching it and its retries",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: FlagWorkflowIDAlias,
+					Usage:   "Workflow ID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: FlagRunIDAlias,
+					Usage:   "Run ID",
+				},
+				&cli.StringFlag{
+					Name:    FlagActivityID,
+					Aliases: FlagActivityIDAlias,
+					Usage:   "Activity ID",
+				},
+				&cli.StringFlag{
+					Name:  FlagReason,
+					Usage: "Reason for pausing",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminPauseActivity(c)
+			},
+		},
+		{
+			Name:  "unpause",
+			Usage: "Unpause a paused activity",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: FlagWorkflowIDAlias,
+					Usage:   "Workflow ID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: FlagRunIDAlias,
+					Usage:   "Run ID",
+				},
+				&cli.StringFlag{
+					Name:    FlagActivityID,
+					Aliases: FlagActivityIDAlias,
+					Usage:   "Activity ID",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminUnpauseActivity(c)
+			},
+		},
+		{
+			Name:  "reset",
+			Usage: "Reset the attempt count of a pending activity, without dispatching it again",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: FlagWorkflowIDAlias,
+					Usage:   "Workflow ID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: FlagRunIDAlias,
+					Usage:   "Run ID",
+				},
+				&cli.StringFlag{
+					Name:    FlagActivityID,
+					Aliases: FlagActivityIDAlias,
+					Usage:   "Activity ID",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminResetActivity(c)
+			},
+		},
+		{
+			Name:  "update-options",
+			Usage: "Update the retry policy and timeouts of a pending activity",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: FlagWorkflowIDAlias,
+					Usage:   "Workflow ID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: FlagRunIDAlias,
+					Usage:   "Run ID",
+				},
+				&cli.StringFlag{
+					Name:    FlagActivityID,
+					Aliases: FlagActivityIDAlias,
+					Usage:   "Activity ID",
+				},
+				&cli.DurationFlag{
+					Name:  FlagScheduleToCloseTimeout,
+					Usage: "Schedule to close timeout",
+				},
+				&cli.DurationFlag{
+					Name:  FlagScheduleToStartTimeout,
+					Usage: "Schedule to start timeout",
+				},
+				&cli.DurationFlag{
+					Name:  FlagStartToCloseTimeout,
+					Usage: "Start to close timeout",
+				},
+				&cli.DurationFlag{
+					Name:  FlagHeartbeatTimeout,
+					Usage: "Heartbeat timeout",
+				},
+				&cli.DurationFlag{
+					Name:  FlagRetryInitialInterval,
+					Usage: "Retry initial interval, setting any retry flag replaces the whole retry policy",
+				},
+				&cli.DurationFlag{
+					Name:  FlagRetryMaximumInterval,
+					Usage: "Retry maximum interval",
+				},
+				&cli.Float64Flag{
+					Name:  FlagRetryBackoffCoefficient,
+					Usage: "Retry backoff coefficient",
+				},
+				&cli.IntFlag{
+					Name:  FlagRetryMaximumAttempts,
+					Usage: "Retry maximum attempts, 0 means unlimited",
+				},
+				&cli.StringSliceFlag{
+					Name:  FlagRetryNonRetryableErrorTypes,
+					Usage: "Non retryable error types",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminUpdateActivityOptions(c)
+			},
+		},
 	}
 }
 